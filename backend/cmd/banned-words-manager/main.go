@@ -1,23 +1,20 @@
 package main
 
 import (
-	"bufio"
 	"context"
 	"errors"
 	"flag"
 	"fmt"
 	"log/slog"
-	"net/http"
 	"os"
 	"strings"
 	"time"
 
 	"github.com/joho/godotenv"
 	"github.com/kelseyhightower/envconfig"
+	"github.com/nicolas-martin/dankfolio/backend/internal/app"
 	"github.com/nicolas-martin/dankfolio/backend/internal/db"
-	"github.com/nicolas-martin/dankfolio/backend/internal/db/postgres"
-	"github.com/nicolas-martin/dankfolio/backend/internal/logger"
-	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/coin"
 )
 
 // Config represents the application configuration
@@ -27,42 +24,10 @@ type Config struct {
 }
 
 // Language represents a supported language in the banned words repository
-type Language struct {
-	Code string
-	Name string
-}
+type Language = coin.NaughtyWordLanguage
 
 // All available languages from the LDNOOBW repository
-var availableLanguages = []Language{
-	{"ar", "Arabic"},
-	{"cs", "Czech"},
-	{"da", "Danish"},
-	{"de", "German"},
-	{"en", "English"},
-	{"eo", "Esperanto"},
-	{"es", "Spanish"},
-	{"fa", "Persian"},
-	{"fi", "Finnish"},
-	{"fil", "Filipino"},
-	{"fr", "French"},
-	{"fr-CA-u-sd-caqc", "Canadian French"},
-	{"hi", "Hindi"},
-	{"hu", "Hungarian"},
-	{"it", "Italian"},
-	{"ja", "Japanese"},
-	{"kab", "Kabyle"},
-	{"ko", "Korean"},
-	{"nl", "Dutch"},
-	{"no", "Norwegian"},
-	{"pl", "Polish"},
-	{"pt", "Portuguese"},
-	{"ru", "Russian"},
-	{"sv", "Swedish"},
-	{"th", "Thai"},
-	{"tlh", "Klingon"},
-	{"tr", "Turkish"},
-	{"zh", "Chinese"},
-}
+var availableLanguages = coin.NaughtyWordLanguages
 
 var (
 	download    = flag.Bool("download", false, "Download and populate banned words from all languages")
@@ -100,25 +65,23 @@ func main() {
 		os.Exit(1)
 	}
 
-	// Setup logging
-	logLevel := slog.LevelDebug
-	var handler slog.Handler
-	if config.Env != "development" {
-		handler = slog.NewJSONHandler(os.Stdout, nil)
-	} else {
-		handler = logger.NewColorHandler(logLevel, os.Stdout, os.Stderr)
-	}
-	slog.SetDefault(slog.New(handler))
+	app.SetupLogging(config.Env)
 
 	ctx := context.Background()
 
 	// Initialize database
-	store, err := postgres.NewStore(config.DatabaseURL, true, logLevel, config.Env)
+	tooling, err := app.NewTooling(app.ToolingConfig{
+		ServiceName: "banned-words-manager",
+		Env:         config.Env,
+		DBURL:       config.DatabaseURL,
+		AutoMigrate: true,
+	})
 	if err != nil {
 		slog.Error("Failed to initialize database", slog.Any("error", err))
 		os.Exit(1)
 	}
-	defer store.Close()
+	defer tooling.Close()
+	store := tooling.Store
 
 	// Execute database commands
 	switch {
@@ -232,13 +195,19 @@ func downloadBannedWords(ctx context.Context, store db.Store) {
 		}
 	}
 
+	listed, err := coin.ListedNaughtyWords(ctx, store.NaughtyWords())
+	if err != nil {
+		slog.Error("Failed to load existing banned words", slog.Any("error", err))
+		return
+	}
+
 	totalWordsAdded := 0
 	totalWordsFetched := 0
 
 	for _, lang := range languagesToDownload {
 		fmt.Printf("Downloading %s (%s) banned words...\n", lang.Name, lang.Code)
 		
-		wordsAdded, wordsFetched, err := downloadLanguage(ctx, store, lang)
+		wordsAdded, wordsFetched, err := downloadLanguage(ctx, store, lang, listed)
 		if err != nil {
 			slog.Error("Failed to download language", 
 				slog.String("language", lang.Name), 
@@ -263,55 +232,18 @@ func downloadBannedWords(ctx context.Context, store db.Store) {
 	fmt.Printf("Duplicates skipped: %d\n", totalWordsFetched-totalWordsAdded)
 }
 
-func downloadLanguage(ctx context.Context, store db.Store, lang Language) (int, int, error) {
-	url := fmt.Sprintf("https://raw.githubusercontent.com/LDNOOBW/List-of-Dirty-Naughty-Obscene-and-Otherwise-Bad-Words/master/%s", lang.Code)
-	
-	client := &http.Client{Timeout: 30 * time.Second}
-	resp, err := client.Get(url)
+func downloadLanguage(ctx context.Context, store db.Store, lang Language, listed map[string]bool) (int, int, error) {
+	words, err := coin.FetchNaughtyWordList(ctx, lang.Code)
 	if err != nil {
-		return 0, 0, fmt.Errorf("failed to fetch %s: %w", url, err)
+		return 0, 0, err
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return 0, 0, fmt.Errorf("failed to fetch %s: status %d", url, resp.StatusCode)
-	}
-
-	scanner := bufio.NewScanner(resp.Body)
-	var wordsToCreate []model.NaughtyWord
-	
-	for scanner.Scan() {
-		word := strings.TrimSpace(scanner.Text())
-		if word != "" && !strings.HasPrefix(word, "#") { // Skip comments
-			wordsToCreate = append(wordsToCreate, model.NaughtyWord{
-				Word:     word,
-				Language: lang.Code,
-			})
-		}
-	}
-
-	if err := scanner.Err(); err != nil {
-		return 0, len(wordsToCreate), fmt.Errorf("error reading response: %w", err)
-	}
-
-	// Create words in database
-	wordsAdded := 0
-	for _, nw := range wordsToCreate {
-		createCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
-		
-		if err := store.NaughtyWords().Create(createCtx, &nw); err != nil {
-			// Log but continue - word might already exist
-			slog.Debug("Failed to create word entry", 
-				slog.String("word", nw.Word), 
-				slog.String("language", lang.Code),
-				slog.Any("error", err))
-		} else {
-			wordsAdded++
-		}
-		cancel()
+	// Words already in the database are skipped; the rest go in one batch
+	wordsAdded, err := coin.SaveNewNaughtyWords(ctx, store.NaughtyWords(), words, lang.Code, listed)
+	if err != nil {
+		return 0, len(words), err
 	}
-
-	return wordsAdded, len(wordsToCreate), nil
+	return wordsAdded, len(words), nil
 }
 
 func getLanguagesToDownload() []Language {
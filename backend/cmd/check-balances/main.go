@@ -2,13 +2,14 @@ package main
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"log"
 	"os"
 
 	"github.com/gagliardetto/solana-go"
 	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/nicolas-martin/dankfolio/backend/internal/app"
+	solanaclient "github.com/nicolas-martin/dankfolio/backend/internal/clients/solana"
 	"github.com/nicolas-martin/dankfolio/backend/internal/service/wallet"
 	"github.com/olekukonko/tablewriter"
 )
@@ -45,47 +46,23 @@ func main() {
 	if err != nil {
 		log.Fatalf("failed to get SOL balance: %v", err)
 	}
-	// Get regular SPL token accounts
-	accounts, err := solRPC.GetTokenAccountsByOwner(
-		context.Background(),
-		k,
-		&rpc.GetTokenAccountsConfig{
-			ProgramId: solana.TokenProgramID.ToPointer(),
-		},
-		&rpc.GetTokenAccountsOpts{
-			Encoding:   solana.EncodingJSONParsed,
-			Commitment: rpc.CommitmentConfirmed,
-		},
-	)
+	// Token accounts are read the way the app reads them, across SPL Token and Token-2022
+	tooling, err := app.NewTooling(app.ToolingConfig{
+		ServiceName:        "check-balances",
+		Env:                app.EnvCLI,
+		SolanaRPCEndpoints: []solanaclient.Endpoint{{Name: "primary", URL: solEndpoint, Headers: header}},
+	})
 	if err != nil {
-		log.Fatalf("failed to get SPL token accounts: %v", err)
+		log.Fatalf("failed to connect to Solana RPC: %v", err)
 	}
-
-	// Get Token2022 accounts
-	token2022ProgramID := solana.MustPublicKeyFromBase58("TokenzQdBNbLqP5VEhdkAS6EPFLC1PHnBqCXEpPxuEb")
-	accounts2022, err := solRPC.GetTokenAccountsByOwner(
-		context.Background(),
-		k,
-		&rpc.GetTokenAccountsConfig{
-			ProgramId: &token2022ProgramID,
-		},
-		&rpc.GetTokenAccountsOpts{
-			Encoding:   solana.EncodingJSONParsed,
-			Commitment: rpc.CommitmentConfirmed,
-		},
-	)
+	defer tooling.Close()
+	walletService := wallet.New(tooling.Solana, nil, nil, nil, nil)
+	accounts, err := walletService.GetTokenAccounts(context.Background(), k.String())
 	if err != nil {
-		log.Printf("Note: No Token2022 accounts found (this is normal): %v", err)
-	}
-	var parsedAccount struct {
-		Parsed struct {
-			Info struct {
-				Mint        string `json:"mint"`
-				TokenAmount struct {
-					UiAmount float64 `json:"uiAmount"`
-				} `json:"tokenAmount"`
-			} `json:"info"`
-		} `json:"parsed"`
+		if len(accounts) == 0 {
+			log.Fatalf("failed to get token accounts: %v", err)
+		}
+		log.Printf("Note: some token accounts could not be read: %v", err)
 	}
 
 	table := tablewriter.NewWriter(os.Stdout)
@@ -95,33 +72,12 @@ func main() {
 	solBalance := float64(solData.Value) / float64(solana.LAMPORTS_PER_SOL)
 	table.Append([]string{"SOL (Native)", fmt.Sprintf("%.9f", solBalance), "System"})
 
-	// Process regular SPL tokens
-	for _, account := range accounts.Value {
-		parsedData := account.Account.Data.GetRawJSON()
-		if len(parsedData) == 0 {
-			continue
-		}
-		if err := json.Unmarshal(parsedData, &parsedAccount); err != nil {
-			log.Printf("failed to parse account data: %v", err)
-			continue
-		}
-		table.Append([]string{parsedAccount.Parsed.Info.Mint, fmt.Sprintf("%.9f", parsedAccount.Parsed.Info.TokenAmount.UiAmount), "SPL Token"})
-	}
-
-	// Process Token2022 accounts
-	if accounts2022 != nil {
-		for _, account := range accounts2022.Value {
-			parsedData := account.Account.Data.GetRawJSON()
-			if len(parsedData) == 0 {
-				continue
-			}
-			if err := json.Unmarshal(parsedData, &parsedAccount); err != nil {
-				log.Printf("failed to parse Token2022 account data: %v", err)
-				continue
-			}
-			// Add Token2022 label to distinguish from regular SPL tokens
-			table.Append([]string{parsedAccount.Parsed.Info.Mint, fmt.Sprintf("%.9f", parsedAccount.Parsed.Info.TokenAmount.UiAmount), "Token2022"})
+	for _, account := range accounts {
+		program := "SPL Token"
+		if account.ProgramID == wallet.Token2022ProgramID {
+			program = "Token2022"
 		}
+		table.Append([]string{string(account.MintAddress), fmt.Sprintf("%.9f", account.UIAmount), program})
 	}
 
 	table.Render()
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/app"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/coin"
+)
+
+const coinsUsage = `Usage: dankctl coins <command> [flags]
+
+Commands:
+  archive   Move coins with no volume or liquidity to the coins_archive table
+`
+
+func runCoins(args []string) error {
+	if len(args) < 1 {
+		fmt.Fprint(os.Stderr, coinsUsage)
+		return errors.New("missing coins command")
+	}
+	switch args[0] {
+	case "archive":
+		return runCoinsArchive(args[1:])
+	case "-h", "--help", "help":
+		fmt.Print(coinsUsage)
+		return nil
+	default:
+		return fmt.Errorf("unknown coins command %q", args[0])
+	}
+}
+
+func runCoinsArchive(args []string) error {
+	fs := flag.NewFlagSet("coins archive", flag.ContinueOnError)
+	dbURL := fs.String("db-url", os.Getenv("DB_URL"), "Postgres DSN (default $DB_URL)")
+	inactiveFor := fs.Duration("inactive-for", coin.DefaultArchiveInactiveFor, "How long a coin must have gone without updates or trades")
+	maxVolume := fs.Float64("max-volume", coin.ArchiveMaxVolume24hUSD, "Archive coins with 24h volume at or below this, in USD")
+	maxLiquidity := fs.Float64("max-liquidity", coin.ArchiveMaxLiquidity, "Archive coins with liquidity at or below this, in USD")
+	limit := fs.Int("limit", 0, "Archive at most this many coins; 0 for all")
+	dryRun := fs.Bool("dry-run", false, "List the coins that would be archived without moving them")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), `Usage: dankctl coins archive [flags]
+
+Moves coins that match the same criteria as the API's scheduled archive job
+to coins_archive, in batches. Archived coins are still returned by search
+requests with include_archived set.
+
+Flags:
+`)
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *dbURL == "" {
+		return errors.New("-db-url or $DB_URL is required")
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	tooling, err := app.NewTooling(app.ToolingConfig{ServiceName: "dankctl", Env: app.EnvCLI, DBURL: *dbURL})
+	if err != nil {
+		return err
+	}
+	defer tooling.Close()
+	store := tooling.Store
+
+	criteria := coin.NewArchiveCriteria(*inactiveFor, time.Now())
+	criteria.MaxVolume24hUSD = *maxVolume
+	criteria.MaxLiquidity = *maxLiquidity
+	criteria.DryRun = *dryRun
+	if *dryRun {
+		criteria.Limit = *limit
+	}
+
+	total := 0
+	for *limit == 0 || total < *limit {
+		if !*dryRun && *limit > 0 {
+			criteria.Limit = min(criteria.Limit, *limit-total)
+		}
+		addresses, err := store.ArchiveInactiveCoins(ctx, criteria)
+		if err != nil {
+			return fmt.Errorf("archived %d coins before failing: %w", total, err)
+		}
+		for _, address := range addresses {
+			fmt.Println(address)
+		}
+		total += len(addresses)
+		if *dryRun || len(addresses) < criteria.Limit {
+			break
+		}
+	}
+
+	verb := "Archived"
+	if *dryRun {
+		verb = "Would archive"
+	}
+	fmt.Fprintf(os.Stderr, "%s %d coins inactive since %s\n", verb, total, criteria.InactiveSince.Format(time.RFC3339))
+	return nil
+}
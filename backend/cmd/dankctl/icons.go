@@ -0,0 +1,148 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1"
+	"github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1/v1connect"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/coin"
+)
+
+const iconsUsage = `Usage: dankctl icons <command> [flags]
+
+Commands:
+  revalidate   Re-fetch and re-validate coin icons through the API's image proxy
+`
+
+func runIcons(args []string) error {
+	if len(args) < 1 {
+		fmt.Fprint(os.Stderr, iconsUsage)
+		return errors.New("missing icons command")
+	}
+	switch args[0] {
+	case "revalidate":
+		return runIconsRevalidate(args[1:])
+	case "-h", "--help", "help":
+		fmt.Print(iconsUsage)
+		return nil
+	default:
+		return fmt.Errorf("unknown icons command %q", args[0])
+	}
+}
+
+func runIconsRevalidate(args []string) error {
+	fs := flag.NewFlagSet("icons revalidate", flag.ContinueOnError)
+	apiURL := fs.String("url", "http://localhost:9000", "API base URL")
+	adminKey := fs.String("admin-key", os.Getenv("ADMIN_API_KEY"), "Admin API key (default $ADMIN_API_KEY)")
+	status := fs.String("status", coin.IconStatusBroken, "Icons to re-validate: broken, placeholder or all")
+	gateway := fs.String("gateway", "", "Only icons whose source URL contains this, e.g. gateway.pinata.cloud")
+	sinceFlag := fs.String("since", "", "Only coins created since, RFC3339 or a duration ago such as 72h")
+	untilFlag := fs.String("until", "", "Only coins created before, RFC3339 or a duration ago")
+	limit := fs.Int("limit", 0, "Re-validate at most this many coins; 0 for all matching")
+	wait := fs.Bool("wait", true, "Report progress until the re-validation finishes")
+	interval := fs.Duration("interval", 2*time.Second, "How often progress is reported")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), `Usage: dankctl icons revalidate [flags]
+
+Asks the API to re-validate the icons of a slice of the coins: stored icons
+are checked in place, the others are fetched again through the IPFS gateways,
+validated and stored over the old copy, invalidating it at the CDN. Icons that
+still fail are served generated placeholders. The re-validation runs on the
+server; interrupting this command only stops the progress reports.
+
+Flags:
+`)
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return err
+	}
+	if *adminKey == "" {
+		return errors.New("-admin-key or $ADMIN_API_KEY is required")
+	}
+
+	req := &pb.RevalidateIconsRequest{Status: *status, Gateway: *gateway, Limit: int32(*limit)}
+	now := time.Now()
+	if *sinceFlag != "" {
+		since, err := parseTimeOrAgo(*sinceFlag, now)
+		if err != nil {
+			return fmt.Errorf("invalid -since: %w", err)
+		}
+		req.Since = timestamppb.New(since)
+	}
+	if *untilFlag != "" {
+		until, err := parseTimeOrAgo(*untilFlag, now)
+		if err != nil {
+			return fmt.Errorf("invalid -until: %w", err)
+		}
+		req.Until = timestamppb.New(until)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	auth := connect.WithInterceptors(connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			req.Header().Set("Authorization", "Bearer "+*adminKey)
+			return next(ctx, req)
+		}
+	}))
+	admin := v1connect.NewAdminServiceClient(http.DefaultClient, *apiURL, auth)
+
+	resp, err := admin.RevalidateIcons(ctx, connect.NewRequest(req))
+	if err != nil {
+		return err
+	}
+	run := resp.Msg.Revalidation
+	fmt.Fprintf(os.Stderr, "Started icon revalidation %s\n", run.Id)
+	if !*wait {
+		fmt.Println(run.Id)
+		return nil
+	}
+
+	ticker := time.NewTicker(*interval)
+	defer ticker.Stop()
+	for run.FinishedAt == nil {
+		select {
+		case <-ctx.Done():
+			fmt.Fprintf(os.Stderr, "Stopped reporting; revalidation %s keeps running on the server\n", run.Id)
+			return nil
+		case <-ticker.C:
+		}
+		progress, err := admin.GetIconRevalidation(ctx, connect.NewRequest(&pb.GetIconRevalidationRequest{Id: run.Id}))
+		if err != nil {
+			return fmt.Errorf("failed to get progress: %w", err)
+		}
+		run = progress.Msg.Revalidation
+		fmt.Fprintf(os.Stderr, "scanned %d, matched %d, processed %d\n", run.Scanned, run.Matched, run.Processed)
+	}
+
+	fmt.Printf("Revalidated %d icons in %s: %d valid, %d replaced, %d placeholders, %d skipped, %d failed\n",
+		run.Processed, run.FinishedAt.AsTime().Sub(run.StartedAt.AsTime()).Round(time.Second),
+		run.Valid, run.Replaced, run.Placeholders, run.Skipped, run.Failed)
+	if run.Error != "" {
+		return fmt.Errorf("revalidation stopped early: %s", run.Error)
+	}
+	return nil
+}
+
+// parseTimeOrAgo parses an RFC3339 time, or a duration before now
+func parseTimeOrAgo(value string, now time.Time) (time.Time, error) {
+	if ago, err := time.ParseDuration(value); err == nil {
+		return now.Add(-ago), nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
@@ -0,0 +1,232 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+
+	pb "github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1"
+	"github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1/v1connect"
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/tracker"
+	"github.com/nicolas-martin/dankfolio/backend/internal/middleware"
+)
+
+type loadTestConfig struct {
+	url           string
+	appCheckToken string
+	profile       profile
+	stages        []int
+	stageDuration time.Duration
+	timeout       time.Duration
+	wallet        string
+}
+
+func parseLoadTestFlags(args []string) (*loadTestConfig, error) {
+	fs := flag.NewFlagSet("loadtest", flag.ContinueOnError)
+	url := fs.String("url", "http://localhost:9000", "API base URL")
+	token := fs.String("app-check-token", os.Getenv("DEV_APP_CHECK_TOKEN"), "App Check token sent with every request (default $DEV_APP_CHECK_TOKEN)")
+	profileName := fs.String("profile", "mixed", "Traffic profile: "+profileNames())
+	mix := fs.String("mix", "", `Custom operation weights overriding the profile's, e.g. "search=3,prices=1"`)
+	concurrency := fs.String("concurrency", "1,5,10,25,50", "Comma-separated concurrency levels to ramp through")
+	stageDuration := fs.Duration("stage-duration", 30*time.Second, "How long to hold each concurrency level")
+	timeout := fs.Duration("timeout", 30*time.Second, "Per-request timeout")
+	wallet := fs.String("wallet", "", "Wallet address for prepare_swap; without it prepare_swap is dropped from the mix")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), `Usage: dankctl loadtest [flags]
+
+Ramps through the concurrency levels, holding each for -stage-duration, and
+reports P50/P95/P99 latency per request type. Start the API with
+REPORT_UPSTREAM_CALLS=true to also report upstream API calls per request, and
+raise RATE_LIMIT_RPS so a single client isn't throttled.
+
+Operations: search, coin, trending, prices, price_history, swap_quote, prepare_swap.
+prepare_swap stores a prepared trade per call; point it at a non-production database.
+
+Flags:
+`)
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+
+	p, ok := profiles[*profileName]
+	if !ok {
+		return nil, fmt.Errorf("unknown profile %q (want one of %s)", *profileName, profileNames())
+	}
+	if *mix != "" {
+		weights, err := parseMix(*mix)
+		if err != nil {
+			return nil, err
+		}
+		p.Weights = weights
+	}
+	if *wallet == "" && p.Weights["prepare_swap"] > 0 {
+		weights := make(map[string]int, len(p.Weights))
+		for op, w := range p.Weights {
+			if op != "prepare_swap" {
+				weights[op] = w
+			}
+		}
+		p.Weights = weights
+	}
+
+	var stages []int
+	for level := range strings.SplitSeq(*concurrency, ",") {
+		n, err := strconv.Atoi(strings.TrimSpace(level))
+		if err != nil || n < 1 {
+			return nil, fmt.Errorf("invalid concurrency level %q", level)
+		}
+		stages = append(stages, n)
+	}
+
+	return &loadTestConfig{
+		url:           strings.TrimSuffix(*url, "/"),
+		appCheckToken: *token,
+		profile:       p,
+		stages:        stages,
+		stageDuration: *stageDuration,
+		timeout:       *timeout,
+		wallet:        *wallet,
+	}, nil
+}
+
+func runLoadTest(args []string) error {
+	cfg, err := parseLoadTestFlags(args)
+	if errors.Is(err, flag.ErrHelp) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	ops, err := newPicker(cfg.profile.Weights)
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	a := newAPI(cfg)
+	if err := a.seed(ctx); err != nil {
+		fmt.Fprintf(os.Stderr, "warning: could not seed coins from the API, using defaults: %v\n", err)
+	}
+
+	fmt.Printf("Load testing %s with profile %s, concurrency %v, %s per stage\n\n", cfg.url, cfg.profile.Name, cfg.stages, cfg.stageDuration)
+	var reports []*stageReport
+	for _, workers := range cfg.stages {
+		if ctx.Err() != nil {
+			break
+		}
+		report := runStage(ctx, cfg, a, ops, workers)
+		report.print(os.Stdout)
+		reports = append(reports, report)
+	}
+	printSummary(os.Stdout, reports)
+	return nil
+}
+
+func newAPI(cfg *loadTestConfig) *api {
+	httpClient := &http.Client{
+		Transport: &http.Transport{MaxIdleConnsPerHost: 256},
+	}
+	headers := connect.WithInterceptors(connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if cfg.appCheckToken != "" {
+				req.Header().Set("X-Firebase-AppCheck", cfg.appCheckToken)
+			}
+			req.Header().Set(middleware.ReportUpstreamCallsHeader, "true")
+			return next(ctx, req)
+		}
+	}))
+
+	return &api{
+		coins:     v1connect.NewCoinServiceClient(httpClient, cfg.url, headers),
+		prices:    v1connect.NewPriceServiceClient(httpClient, cfg.url, headers),
+		trades:    v1connect.NewTradeServiceClient(httpClient, cfg.url, headers),
+		addresses: defaultAddresses,
+		wallet:    cfg.wallet,
+	}
+}
+
+// seed replaces the default coin addresses with the API's trending coins, so
+// requests hit coins the server actually knows about
+func (a *api) seed(ctx context.Context) error {
+	limit := int32(50)
+	resp, err := a.coins.GetTrendingCoins(ctx, connect.NewRequest(&pb.GetTrendingCoinsRequest{Limit: &limit}))
+	if err != nil {
+		return err
+	}
+	var addresses []string
+	for _, coin := range resp.Msg.Coins {
+		addresses = append(addresses, coin.Address)
+	}
+	if len(addresses) > 0 {
+		a.addresses = addresses
+	}
+	return nil
+}
+
+// runStage runs workers concurrent clients for the stage duration
+func runStage(ctx context.Context, cfg *loadTestConfig, a *api, ops *picker, workers int) *stageReport {
+	ctx, cancel := context.WithTimeout(ctx, cfg.stageDuration)
+	defer cancel()
+
+	report := newStageReport(workers)
+	var wg sync.WaitGroup
+	for i := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			r := rand.New(rand.NewPCG(uint64(time.Now().UnixNano()), uint64(i)))
+			for sent := 1; ctx.Err() == nil; sent++ {
+				op := ops.pick(r)
+				reqCtx, reqCancel := context.WithTimeout(ctx, cfg.timeout)
+				start := time.Now()
+				header, err := op.call(reqCtx, a, r)
+				latency := time.Since(start)
+				reqCancel()
+
+				// Requests cut off by the end of the stage say nothing about the server
+				if ctx.Err() != nil {
+					return
+				}
+				report.record(op.name, latency, err, header)
+
+				if cfg.profile.Burst > 0 && sent%cfg.profile.Burst == 0 {
+					select {
+					case <-ctx.Done():
+					case <-time.After(cfg.profile.Pause):
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	report.elapsed = cfg.stageDuration
+	return report
+}
+
+// upstreamCalls reads the server's upstream call counts from a response
+func upstreamCalls(header http.Header) (map[string]int, bool) {
+	value := header.Values(middleware.UpstreamCallsHeader)
+	if len(value) == 0 {
+		return nil, false
+	}
+	counts, err := tracker.ParseCallCounts(value[0])
+	if err != nil {
+		return nil, false
+	}
+	return counts, true
+}
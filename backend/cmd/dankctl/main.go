@@ -0,0 +1,54 @@
+// Command dankctl holds operational tools for the dankfolio API.
+//
+// Usage:
+//
+//	dankctl loadtest [flags]
+//	dankctl coins archive [flags]
+//	dankctl prices backfill [flags]
+//	dankctl icons revalidate [flags]
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+const usage = `Usage: dankctl <command> [flags]
+
+Commands:
+  loadtest   Drive the API with a traffic profile and report latencies and upstream call amplification
+  coins      Maintain the coins table (archive)
+  prices     Maintain stored price history (backfill)
+  icons      Maintain coin icons (revalidate)
+
+Run "dankctl <command> -h" for the command's flags.
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "loadtest":
+		err = runLoadTest(os.Args[2:])
+	case "coins":
+		err = runCoins(os.Args[2:])
+	case "prices":
+		err = runPrices(os.Args[2:])
+	case "icons":
+		err = runIcons(os.Args[2:])
+	case "-h", "--help", "help":
+		fmt.Print(usage)
+		return
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command %q\n\n%s", os.Args[1], usage)
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "dankctl %s: %v\n", os.Args[1], err)
+		os.Exit(1)
+	}
+}
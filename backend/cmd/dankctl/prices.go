@@ -0,0 +1,143 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/app"
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/price"
+)
+
+const pricesUsage = `Usage: dankctl prices <command> [flags]
+
+Commands:
+  backfill   Find gaps in stored price history and re-request them from Birdeye
+`
+
+func runPrices(args []string) error {
+	if len(args) < 1 {
+		fmt.Fprint(os.Stderr, pricesUsage)
+		return errors.New("missing prices command")
+	}
+	switch args[0] {
+	case "backfill":
+		return runPricesBackfill(args[1:])
+	case "-h", "--help", "help":
+		fmt.Print(pricesUsage)
+		return nil
+	default:
+		return fmt.Errorf("unknown prices command %q", args[0])
+	}
+}
+
+func runPricesBackfill(args []string) error {
+	fs := flag.NewFlagSet("prices backfill", flag.ContinueOnError)
+	mints := fs.String("mint", "", "Comma-separated mint addresses to backfill (required)")
+	fromFlag := fs.String("from", "", "Start of the window, RFC3339 (default 24h before -to)")
+	toFlag := fs.String("to", "", "End of the window, RFC3339 (default now)")
+	resolution := fs.Duration("resolution", 0, "Sample spacing to check and request, e.g. 1m or 1h (default: the spacing the store keeps at -from's age)")
+	dryRun := fs.Bool("dry-run", false, "Only report the gaps")
+	requestRate := fs.Float64("rate", price.DefaultBackfillRate, "Maximum Birdeye requests per second")
+	dbURL := fs.String("db-url", os.Getenv("DB_URL"), "Postgres DSN (default $DB_URL)")
+	birdeyeURL := fs.String("birdeye-url", os.Getenv("BIRDEYE_ENDPOINT"), "Birdeye API base URL (default $BIRDEYE_ENDPOINT)")
+	birdeyeKey := fs.String("birdeye-key", os.Getenv("BIRDEYE_API_KEY"), "Birdeye API key (default $BIRDEYE_API_KEY)")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), `Usage: dankctl prices backfill -mint <address>[,<address>...] [flags]
+
+Scans the stored price series for windows with no samples, such as provider
+outages, and re-requests exactly those windows, waiting out rate limits.
+
+Flags:
+`)
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *mints == "" {
+		return errors.New("-mint is required")
+	}
+	if *dbURL == "" {
+		return errors.New("-db-url or $DB_URL is required")
+	}
+
+	to := time.Now().UTC()
+	if *toFlag != "" {
+		t, err := time.Parse(time.RFC3339, *toFlag)
+		if err != nil {
+			return fmt.Errorf("invalid -to: %w", err)
+		}
+		to = t
+	}
+	from := to.Add(-24 * time.Hour)
+	if *fromFlag != "" {
+		t, err := time.Parse(time.RFC3339, *fromFlag)
+		if err != nil {
+			return fmt.Errorf("invalid -from: %w", err)
+		}
+		from = t
+	}
+	if !from.Before(to) {
+		return errors.New("-from must be before -to")
+	}
+	if *resolution == 0 {
+		*resolution = price.TierResolution(time.Since(from))
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	tooling, err := app.NewTooling(app.ToolingConfig{
+		ServiceName:     "dankctl",
+		Env:             app.EnvCLI,
+		DBURL:           *dbURL,
+		BirdEyeEndpoint: *birdeyeURL,
+		BirdEyeAPIKey:   *birdeyeKey,
+		HTTPTransport:   clients.TransportConfig{Timeout: 30 * time.Second},
+	})
+	if err != nil {
+		return err
+	}
+	defer tooling.Close()
+
+	priceService := price.NewService(tooling.BirdEye, nil, tooling.Store, nil)
+	priceService.SetPersistHistory(true)
+	backfiller := price.NewBackfiller(priceService, *requestRate)
+
+	for mint := range strings.SplitSeq(*mints, ",") {
+		mint = strings.TrimSpace(mint)
+		if *dryRun {
+			gaps, err := priceService.DetectGaps(ctx, mint, *resolution, from, to)
+			if err != nil {
+				return fmt.Errorf("%s: %w", mint, err)
+			}
+			printGaps(mint, "gap", gaps)
+			continue
+		}
+
+		if *birdeyeURL == "" || *birdeyeKey == "" {
+			return errors.New("-birdeye-url and -birdeye-key (or $BIRDEYE_ENDPOINT and $BIRDEYE_API_KEY) are required")
+		}
+		result, err := backfiller.Backfill(ctx, mint, *resolution, from, to)
+		if err != nil {
+			return fmt.Errorf("%s: %w", mint, err)
+		}
+		printGaps(mint, "gap", result.Gaps)
+		printGaps(mint, "unfilled", result.Remaining)
+		fmt.Printf("%s: %d gaps, %d requests, %d points stored, %d gaps left\n", mint, len(result.Gaps), result.Requests, result.Points, len(result.Remaining))
+	}
+	return nil
+}
+
+func printGaps(mint, label string, gaps []price.PriceGap) {
+	for _, gap := range gaps {
+		fmt.Printf("%s\t%s\t%s\t%s\t%s\n", mint, label, gap.From.Format(time.RFC3339), gap.To.Format(time.RFC3339), gap.To.Sub(gap.From))
+	}
+}
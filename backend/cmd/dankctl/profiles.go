@@ -0,0 +1,217 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+
+	pb "github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1"
+	"github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1/v1connect"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+// api holds the clients and seed data operations draw from
+type api struct {
+	coins  v1connect.CoinServiceClient
+	prices v1connect.PriceServiceClient
+	trades v1connect.TradeServiceClient
+
+	addresses []string
+	wallet    string
+}
+
+// defaultAddresses are used when the API returns no trending coins to seed from
+var defaultAddresses = []string{
+	"EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v", // USDC
+	"DezXAZ8z7PnrnRJjz3wXBoRgixCa6xjnB7YaB1pPB263", // BONK
+	"JUPyiwrYJFskUPiHa7hkeR8VUtAeFoSYbKedZNsDvCN",  // JUP
+	"EKpQGSJtjMFqKZ9KQanSqYXRcF8fBopzLHYxdM65zcjm", // WIF
+}
+
+var searchQueries = []string{"bonk", "wif", "jup", "sol", "usdc", "pepe", "dog", "cat", "ai", "trump"}
+
+// operation is one request type in a traffic mix. It returns the response or
+// error metadata so upstream call counts can be read from it.
+type operation struct {
+	name string
+	call func(ctx context.Context, a *api, r *rand.Rand) (http.Header, error)
+}
+
+var operations = map[string]operation{
+	"search": {"search", func(ctx context.Context, a *api, r *rand.Rand) (http.Header, error) {
+		return header(a.coins.Search(ctx, connect.NewRequest(&pb.SearchRequest{Query: pick(r, searchQueries), Limit: 20})))
+	}},
+	"coin": {"coin", func(ctx context.Context, a *api, r *rand.Rand) (http.Header, error) {
+		return header(a.coins.GetCoinByID(ctx, connect.NewRequest(&pb.GetCoinByIDRequest{Address: pick(r, a.addresses)})))
+	}},
+	"trending": {"trending", func(ctx context.Context, a *api, r *rand.Rand) (http.Header, error) {
+		limit := int32(20)
+		return header(a.coins.GetTrendingCoins(ctx, connect.NewRequest(&pb.GetTrendingCoinsRequest{Limit: &limit})))
+	}},
+	"prices": {"prices", func(ctx context.Context, a *api, r *rand.Rand) (http.Header, error) {
+		// The app polls prices for the coins on screen, typically a handful at a time
+		ids := make([]string, 0, 10)
+		for range 10 {
+			ids = append(ids, pick(r, a.addresses))
+		}
+		return header(a.prices.GetCoinPrices(ctx, connect.NewRequest(&pb.GetCoinPricesRequest{CoinIds: ids})))
+	}},
+	"price_history": {"price_history", func(ctx context.Context, a *api, r *rand.Rand) (http.Header, error) {
+		types := []pb.GetPriceHistoryRequest_PriceHistoryType{
+			pb.GetPriceHistoryRequest_FIFTEEN_MINUTE,
+			pb.GetPriceHistoryRequest_ONE_HOUR,
+			pb.GetPriceHistoryRequest_FOUR_HOUR,
+		}
+		return header(a.prices.GetPriceHistory(ctx, connect.NewRequest(&pb.GetPriceHistoryRequest{
+			Address: pick(r, a.addresses),
+			Type:    pick(r, types),
+			Time:    time.Now().UTC().Format(time.RFC3339),
+		})))
+	}},
+	"swap_quote": {"swap_quote", func(ctx context.Context, a *api, r *rand.Rand) (http.Header, error) {
+		return header(a.trades.GetSwapQuote(ctx, connect.NewRequest(&pb.GetSwapQuoteRequest{
+			FromCoinId:  model.SolMint,
+			ToCoinId:    pick(r, a.addresses),
+			Amount:      randomLamports(r),
+			SlippageBps: "50",
+		})))
+	}},
+	"prepare_swap": {"prepare_swap", func(ctx context.Context, a *api, r *rand.Rand) (http.Header, error) {
+		// Amounts vary so identical-call deduplication doesn't hide the cost
+		return header(a.trades.PrepareSwap(ctx, connect.NewRequest(&pb.PrepareSwapRequest{
+			FromCoinId:    model.SolMint,
+			ToCoinId:      pick(r, a.addresses),
+			Amount:        randomLamports(r),
+			SlippageBps:   "50",
+			UserPublicKey: a.wallet,
+		})))
+	}},
+}
+
+// profile is a weighted mix of operations. Bursty profiles send Burst
+// requests back to back from each worker, then pause.
+type profile struct {
+	Name    string
+	Weights map[string]int
+	Burst   int
+	Pause   time.Duration
+}
+
+var profiles = map[string]profile{
+	"search-heavy": {
+		Name:    "search-heavy",
+		Weights: map[string]int{"search": 60, "coin": 20, "trending": 10, "prices": 10},
+	},
+	"price-poll-heavy": {
+		Name:    "price-poll-heavy",
+		Weights: map[string]int{"prices": 60, "price_history": 25, "coin": 10, "trending": 5},
+	},
+	"swap-burst": {
+		Name:    "swap-burst",
+		Weights: map[string]int{"swap_quote": 70, "prepare_swap": 30},
+		Burst:   5,
+		Pause:   2 * time.Second,
+	},
+	"mixed": {
+		Name:    "mixed",
+		Weights: map[string]int{"search": 25, "coin": 20, "trending": 10, "prices": 25, "price_history": 10, "swap_quote": 10},
+	},
+}
+
+func profileNames() string {
+	names := make([]string, 0, len(profiles))
+	for name := range profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+// parseMix reads a custom mix such as "search=3,prices=1"
+func parseMix(s string) (map[string]int, error) {
+	weights := make(map[string]int)
+	for part := range strings.SplitSeq(s, ",") {
+		name, w, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid mix entry %q, want op=weight", part)
+		}
+		if _, known := operations[name]; !known {
+			return nil, fmt.Errorf("unknown operation %q", name)
+		}
+		weight, err := strconv.Atoi(w)
+		if err != nil || weight < 0 {
+			return nil, fmt.Errorf("invalid weight in %q", part)
+		}
+		weights[name] = weight
+	}
+	return weights, nil
+}
+
+// picker chooses operations in proportion to their weights
+type picker struct {
+	ops   []operation
+	upTo  []int
+	total int
+}
+
+func newPicker(weights map[string]int) (*picker, error) {
+	names := make([]string, 0, len(weights))
+	for name := range weights {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	p := &picker{}
+	for _, name := range names {
+		if weights[name] == 0 {
+			continue
+		}
+		op, ok := operations[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown operation %q", name)
+		}
+		p.total += weights[name]
+		p.ops = append(p.ops, op)
+		p.upTo = append(p.upTo, p.total)
+	}
+	if p.total == 0 {
+		return nil, errors.New("traffic mix has no operations")
+	}
+	return p, nil
+}
+
+func (p *picker) pick(r *rand.Rand) operation {
+	n := r.IntN(p.total)
+	i, _ := slices.BinarySearch(p.upTo, n+1)
+	return p.ops[i]
+}
+
+// header returns the metadata a response or connect error carries
+func header[T any](resp *connect.Response[T], err error) (http.Header, error) {
+	if err != nil {
+		var connectErr *connect.Error
+		if errors.As(err, &connectErr) {
+			return connectErr.Meta(), err
+		}
+		return nil, err
+	}
+	return resp.Header(), nil
+}
+
+func pick[T any](r *rand.Rand, items []T) T {
+	return items[r.IntN(len(items))]
+}
+
+// randomLamports returns a swap size between 0.01 and 1 SOL
+func randomLamports(r *rand.Rand) string {
+	return strconv.Itoa(10_000_000 + r.IntN(990_000_000))
+}
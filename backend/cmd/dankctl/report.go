@@ -0,0 +1,176 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+// opStats collects the results of one request type within a stage
+type opStats struct {
+	latencies   []time.Duration
+	errors      int
+	rateLimited int
+	// reported counts responses carrying upstream call counts
+	reported int
+	upstream map[string]int
+}
+
+// stageReport collects results for one concurrency level
+type stageReport struct {
+	workers int
+	elapsed time.Duration
+
+	mu  sync.Mutex
+	ops map[string]*opStats
+}
+
+func newStageReport(workers int) *stageReport {
+	return &stageReport{workers: workers, ops: make(map[string]*opStats)}
+}
+
+func (s *stageReport) record(op string, latency time.Duration, err error, header http.Header) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stats, ok := s.ops[op]
+	if !ok {
+		stats = &opStats{upstream: make(map[string]int)}
+		s.ops[op] = stats
+	}
+	stats.latencies = append(stats.latencies, latency)
+	if err != nil {
+		stats.errors++
+		if connect.CodeOf(err) == connect.CodeResourceExhausted || isHTTPTooManyRequests(err) {
+			stats.rateLimited++
+		}
+	}
+	if counts, ok := upstreamCalls(header); ok {
+		stats.reported++
+		for service, n := range counts {
+			stats.upstream[service] += n
+		}
+	}
+}
+
+// isHTTPTooManyRequests reports whether the rate limiter rejected the request
+// before it reached a handler, which connect surfaces as Unavailable
+func isHTTPTooManyRequests(err error) bool {
+	var connectErr *connect.Error
+	return errors.As(err, &connectErr) && strings.Contains(connectErr.Message(), "429")
+}
+
+func (s *stageReport) totals() (requests, errs, rateLimited int) {
+	for _, stats := range s.ops {
+		requests += len(stats.latencies)
+		errs += stats.errors
+		rateLimited += stats.rateLimited
+	}
+	return requests, errs, rateLimited
+}
+
+func (s *stageReport) print(w io.Writer) {
+	requests, errs, rateLimited := s.totals()
+	fmt.Fprintf(w, "concurrency=%d  requests=%d  rps=%.1f  errors=%d (rate limited %d)\n",
+		s.workers, requests, float64(requests)/s.elapsed.Seconds(), errs, rateLimited)
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "  OP\tREQS\tERRS\tP50\tP95\tP99\tUPSTREAM/REQ")
+	names := make([]string, 0, len(s.ops))
+	for name := range s.ops {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		stats := s.ops[name]
+		sorted := slices.Clone(stats.latencies)
+		slices.Sort(sorted)
+		fmt.Fprintf(tw, "  %s\t%d\t%d\t%s\t%s\t%s\t%s\n", name, len(sorted), stats.errors,
+			fmtLatency(percentile(sorted, 50)), fmtLatency(percentile(sorted, 95)), fmtLatency(percentile(sorted, 99)),
+			stats.amplification())
+	}
+	tw.Flush()
+	fmt.Fprintln(w)
+}
+
+// amplification formats upstream calls per request, in total and by service
+func (o *opStats) amplification() string {
+	if o.reported == 0 {
+		return "-"
+	}
+	services := make([]string, 0, len(o.upstream))
+	total := 0
+	for service, n := range o.upstream {
+		services = append(services, service)
+		total += n
+	}
+	sort.Strings(services)
+
+	parts := make([]string, len(services))
+	for i, service := range services {
+		parts[i] = fmt.Sprintf("%s=%.2f", service, float64(o.upstream[service])/float64(o.reported))
+	}
+	perRequest := fmt.Sprintf("%.2f", float64(total)/float64(o.reported))
+	if len(parts) == 0 {
+		return perRequest
+	}
+	return perRequest + " (" + strings.Join(parts, " ") + ")"
+}
+
+func printSummary(w io.Writer, reports []*stageReport) {
+	if len(reports) == 0 {
+		return
+	}
+	fmt.Fprintln(w, "Summary")
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "  CONCURRENCY\tRPS\tERROR %\tP50\tP95\tP99")
+	reported := false
+	for _, report := range reports {
+		requests, errs, _ := report.totals()
+		var all []time.Duration
+		for _, stats := range report.ops {
+			all = append(all, stats.latencies...)
+			reported = reported || stats.reported > 0
+		}
+		slices.Sort(all)
+		errorRate := 0.0
+		if requests > 0 {
+			errorRate = 100 * float64(errs) / float64(requests)
+		}
+		fmt.Fprintf(tw, "  %d\t%.1f\t%.1f\t%s\t%s\t%s\n", report.workers, float64(requests)/report.elapsed.Seconds(), errorRate,
+			fmtLatency(percentile(all, 50)), fmtLatency(percentile(all, 95)), fmtLatency(percentile(all, 99)))
+	}
+	tw.Flush()
+	if !reported {
+		fmt.Fprintln(w, "\nThe server did not report upstream calls; start it with REPORT_UPSTREAM_CALLS=true to measure amplification.")
+	}
+}
+
+// percentile returns the nearest-rank percentile p of sorted latencies
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	return sorted[max(rank-1, 0)]
+}
+
+func fmtLatency(d time.Duration) string {
+	if d == 0 {
+		return "-"
+	}
+	if d < time.Millisecond {
+		return d.Round(time.Microsecond).String()
+	}
+	return d.Round(100 * time.Microsecond).String()
+}
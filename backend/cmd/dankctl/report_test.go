@@ -0,0 +1,68 @@
+package main
+
+import (
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPercentile(t *testing.T) {
+	var sorted []time.Duration
+	for i := 1; i <= 100; i++ {
+		sorted = append(sorted, time.Duration(i)*time.Millisecond)
+	}
+	assert.Equal(t, 50*time.Millisecond, percentile(sorted, 50))
+	assert.Equal(t, 95*time.Millisecond, percentile(sorted, 95))
+	assert.Equal(t, 99*time.Millisecond, percentile(sorted, 99))
+	assert.Equal(t, time.Millisecond, percentile(sorted[:1], 99))
+	assert.Zero(t, percentile(nil, 50))
+}
+
+func TestStageReport_Amplification(t *testing.T) {
+	report := newStageReport(1)
+	report.record("search", time.Millisecond, nil, http.Header{"X-Upstream-Calls": {"birdeye=2"}})
+	report.record("search", time.Millisecond, nil, http.Header{"X-Upstream-Calls": {"birdeye=1,jupiter=1"}})
+	report.record("search", time.Millisecond, connect.NewError(connect.CodeResourceExhausted, errors.New("slow down")), nil)
+
+	stats := report.ops["search"]
+	assert.Equal(t, 1, stats.errors)
+	assert.Equal(t, 1, stats.rateLimited)
+	assert.Equal(t, "2.00 (birdeye=1.50 jupiter=0.50)", stats.amplification())
+
+	report.record("coin", time.Millisecond, nil, nil)
+	assert.Equal(t, "-", report.ops["coin"].amplification(), "servers not reporting counts show no amplification")
+}
+
+func TestPicker_FollowsWeights(t *testing.T) {
+	p, err := newPicker(map[string]int{"search": 3, "prices": 1, "coin": 0})
+	require.NoError(t, err)
+
+	r := rand.New(rand.NewPCG(1, 2))
+	counts := map[string]int{}
+	for range 4000 {
+		counts[p.pick(r).name]++
+	}
+	assert.Zero(t, counts["coin"])
+	assert.InDelta(t, 3000, counts["search"], 150)
+	assert.InDelta(t, 1000, counts["prices"], 150)
+
+	_, err = newPicker(map[string]int{"search": 0})
+	assert.Error(t, err)
+}
+
+func TestParseMix(t *testing.T) {
+	weights, err := parseMix("search=3, prices=1")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"search": 3, "prices": 1}, weights)
+
+	_, err = parseMix("teleport=1")
+	assert.Error(t, err)
+	_, err = parseMix("search")
+	assert.Error(t, err)
+}
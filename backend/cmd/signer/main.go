@@ -0,0 +1,95 @@
+// Command signer runs the platform signing sidecar. It is the only process
+// that loads the platform private key; the API server asks it for signatures
+// over SignerService.
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+
+	dankfoliov1connect "github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1/v1connect"
+	"github.com/nicolas-martin/dankfolio/backend/internal/middleware"
+	"github.com/nicolas-martin/dankfolio/backend/internal/secrets"
+	"github.com/nicolas-martin/dankfolio/backend/internal/signer"
+)
+
+type Config struct {
+	Port                      int    `envconfig:"SIGNER_PORT" default:"9100"`
+	SecretsBackend            string `envconfig:"SECRETS_BACKEND" default:"env"` // env, gcp or aws
+	SecretsGCPProjectID       string `envconfig:"SECRETS_GCP_PROJECT_ID" default:"dankfolio"`
+	SecretsAWSRegion          string `envconfig:"SECRETS_AWS_REGION"`
+	PlatformPrivateKeySecret  string `envconfig:"PLATFORM_PRIVATE_KEY_SECRET" default:"PLATFORM_PRIVATE_KEY"`
+	PlatformSignerTokenSecret string `envconfig:"PLATFORM_SIGNER_TOKEN_SECRET" default:"PLATFORM_SIGNER_TOKEN"`
+}
+
+func main() {
+	var config Config
+	if err := envconfig.Process("", &config); err != nil {
+		log.Fatalf("Error processing environment variables: %v", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	provider, err := secrets.NewProvider(ctx, secrets.Config{
+		Backend:      config.SecretsBackend,
+		GCPProjectID: config.SecretsGCPProjectID,
+		AWSRegion:    config.SecretsAWSRegion,
+	})
+	if err != nil {
+		log.Fatalf("Error creating secrets provider: %v", err)
+	}
+
+	rawKey, err := provider.GetSecret(ctx, config.PlatformPrivateKeySecret)
+	if err != nil {
+		log.Fatalf("Error loading platform private key: %v", err)
+	}
+	key, err := signer.ParsePrivateKey(rawKey)
+	if err != nil {
+		log.Fatalf("Error parsing platform private key: %v", err)
+	}
+	token, err := provider.GetSecret(ctx, config.PlatformSignerTokenSecret)
+	if err != nil {
+		log.Fatalf("Error loading signer token: %v", err)
+	}
+
+	server := signer.NewServer(signer.NewLocalSigner(key), signer.DefaultAllowedPrograms)
+	path, handler := dankfoliov1connect.NewSignerServiceHandler(server)
+
+	mux := http.NewServeMux()
+	// The same static bearer check as the admin API, with the sidecar's own token
+	mux.Handle(path, middleware.AdminKeyMiddleware(token).Wrap(handler))
+
+	httpServer := &http.Server{
+		Addr:              fmt.Sprintf(":%d", config.Port),
+		Handler:           h2c.NewHandler(mux, &http2.Server{}),
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := httpServer.Shutdown(shutdownCtx); err != nil {
+			slog.Error("Signer shutdown error", slog.Any("error", err))
+		}
+	}()
+
+	slog.Info("Starting platform signer", slog.String("addr", httpServer.Addr), slog.String("publicKey", key.PublicKey().String()))
+	if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		slog.Error("Signer server error", slog.Any("error", err))
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,7272 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: dankfolio/v1/admin.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Setting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Value kind: "string", "int", "bool", "duration" or "string_list".
+	Kind        string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Effective value.
+	Value string `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	// Value from env/defaults, used when no override is stored.
+	DefaultValue  string                 `protobuf:"bytes,5,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	Overridden    bool                   `protobuf:"varint,6,opt,name=overridden,proto3" json:"overridden,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at,omitempty"`
+	UpdatedBy     *string                `protobuf:"bytes,8,opt,name=updated_by,json=updatedBy,proto3,oneof" json:"updated_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Setting) Reset() {
+	*x = Setting{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Setting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Setting) ProtoMessage() {}
+
+func (x *Setting) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Setting.ProtoReflect.Descriptor instead.
+func (*Setting) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Setting) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Setting) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Setting) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Setting) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Setting) GetDefaultValue() string {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return ""
+}
+
+func (x *Setting) GetOverridden() bool {
+	if x != nil {
+		return x.Overridden
+	}
+	return false
+}
+
+func (x *Setting) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Setting) GetUpdatedBy() string {
+	if x != nil && x.UpdatedBy != nil {
+		return *x.UpdatedBy
+	}
+	return ""
+}
+
+type ListSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSettingsRequest) Reset() {
+	*x = ListSettingsRequest{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSettingsRequest) ProtoMessage() {}
+
+func (x *ListSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSettingsRequest.ProtoReflect.Descriptor instead.
+func (*ListSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{1}
+}
+
+type ListSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      []*Setting             `protobuf:"bytes,1,rep,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSettingsResponse) Reset() {
+	*x = ListSettingsResponse{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSettingsResponse) ProtoMessage() {}
+
+func (x *ListSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSettingsResponse.ProtoReflect.Descriptor instead.
+func (*ListSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListSettingsResponse) GetSettings() []*Setting {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type UpdateSettingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Raw value, e.g. "90s" for durations or "a,b,c" for lists.
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// Free-form identifier of the operator making the change, recorded for audit.
+	UpdatedBy     string `protobuf:"bytes,3,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSettingRequest) Reset() {
+	*x = UpdateSettingRequest{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSettingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSettingRequest) ProtoMessage() {}
+
+func (x *UpdateSettingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSettingRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSettingRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateSettingRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateSettingRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *UpdateSettingRequest) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+type UpdateSettingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Setting       *Setting               `protobuf:"bytes,1,opt,name=setting,proto3" json:"setting,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSettingResponse) Reset() {
+	*x = UpdateSettingResponse{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSettingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSettingResponse) ProtoMessage() {}
+
+func (x *UpdateSettingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSettingResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSettingResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateSettingResponse) GetSetting() *Setting {
+	if x != nil {
+		return x.Setting
+	}
+	return nil
+}
+
+type ResetSettingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetSettingRequest) Reset() {
+	*x = ResetSettingRequest{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetSettingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetSettingRequest) ProtoMessage() {}
+
+func (x *ResetSettingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetSettingRequest.ProtoReflect.Descriptor instead.
+func (*ResetSettingRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ResetSettingRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ResetSettingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Setting       *Setting               `protobuf:"bytes,1,opt,name=setting,proto3" json:"setting,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetSettingResponse) Reset() {
+	*x = ResetSettingResponse{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetSettingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetSettingResponse) ProtoMessage() {}
+
+func (x *ResetSettingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetSettingResponse.ProtoReflect.Descriptor instead.
+func (*ResetSettingResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ResetSettingResponse) GetSetting() *Setting {
+	if x != nil {
+		return x.Setting
+	}
+	return nil
+}
+
+type FeatureFlag struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Key         string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Kill switch; when false the flag is off for everyone.
+	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Percentage of identities (0-100) that get the feature.
+	RolloutPercent int32 `protobuf:"varint,4,opt,name=rollout_percent,json=rolloutPercent,proto3" json:"rollout_percent,omitempty"`
+	// Wallet addresses or App Check subjects that always get the feature.
+	AllowList     []string               `protobuf:"bytes,5,rep,name=allow_list,json=allowList,proto3" json:"allow_list,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeatureFlag) Reset() {
+	*x = FeatureFlag{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeatureFlag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeatureFlag) ProtoMessage() {}
+
+func (x *FeatureFlag) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeatureFlag.ProtoReflect.Descriptor instead.
+func (*FeatureFlag) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *FeatureFlag) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *FeatureFlag) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *FeatureFlag) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *FeatureFlag) GetRolloutPercent() int32 {
+	if x != nil {
+		return x.RolloutPercent
+	}
+	return 0
+}
+
+func (x *FeatureFlag) GetAllowList() []string {
+	if x != nil {
+		return x.AllowList
+	}
+	return nil
+}
+
+func (x *FeatureFlag) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListFeatureFlagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFeatureFlagsRequest) Reset() {
+	*x = ListFeatureFlagsRequest{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFeatureFlagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFeatureFlagsRequest) ProtoMessage() {}
+
+func (x *ListFeatureFlagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFeatureFlagsRequest.ProtoReflect.Descriptor instead.
+func (*ListFeatureFlagsRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{8}
+}
+
+type ListFeatureFlagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Flags         []*FeatureFlag         `protobuf:"bytes,1,rep,name=flags,proto3" json:"flags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFeatureFlagsResponse) Reset() {
+	*x = ListFeatureFlagsResponse{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFeatureFlagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFeatureFlagsResponse) ProtoMessage() {}
+
+func (x *ListFeatureFlagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFeatureFlagsResponse.ProtoReflect.Descriptor instead.
+func (*ListFeatureFlagsResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListFeatureFlagsResponse) GetFlags() []*FeatureFlag {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+type SetFeatureFlagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Flag          *FeatureFlag           `protobuf:"bytes,1,opt,name=flag,proto3" json:"flag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFeatureFlagRequest) Reset() {
+	*x = SetFeatureFlagRequest{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFeatureFlagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFeatureFlagRequest) ProtoMessage() {}
+
+func (x *SetFeatureFlagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFeatureFlagRequest.ProtoReflect.Descriptor instead.
+func (*SetFeatureFlagRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SetFeatureFlagRequest) GetFlag() *FeatureFlag {
+	if x != nil {
+		return x.Flag
+	}
+	return nil
+}
+
+type SetFeatureFlagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Flag          *FeatureFlag           `protobuf:"bytes,1,opt,name=flag,proto3" json:"flag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFeatureFlagResponse) Reset() {
+	*x = SetFeatureFlagResponse{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFeatureFlagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFeatureFlagResponse) ProtoMessage() {}
+
+func (x *SetFeatureFlagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFeatureFlagResponse.ProtoReflect.Descriptor instead.
+func (*SetFeatureFlagResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SetFeatureFlagResponse) GetFlag() *FeatureFlag {
+	if x != nil {
+		return x.Flag
+	}
+	return nil
+}
+
+type DeleteFeatureFlagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFeatureFlagRequest) Reset() {
+	*x = DeleteFeatureFlagRequest{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFeatureFlagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFeatureFlagRequest) ProtoMessage() {}
+
+func (x *DeleteFeatureFlagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFeatureFlagRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFeatureFlagRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteFeatureFlagRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type DeleteFeatureFlagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFeatureFlagResponse) Reset() {
+	*x = DeleteFeatureFlagResponse{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFeatureFlagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFeatureFlagResponse) ProtoMessage() {}
+
+func (x *DeleteFeatureFlagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFeatureFlagResponse.ProtoReflect.Descriptor instead.
+func (*DeleteFeatureFlagResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{13}
+}
+
+type SpamToken struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Mint  string                 `protobuf:"bytes,1,opt,name=mint,proto3" json:"mint,omitempty"`
+	// "deny" always hides the token from wallet balances, "allow" never does.
+	Verdict       string                 `protobuf:"bytes,2,opt,name=verdict,proto3" json:"verdict,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,4,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpamToken) Reset() {
+	*x = SpamToken{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpamToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpamToken) ProtoMessage() {}
+
+func (x *SpamToken) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpamToken.ProtoReflect.Descriptor instead.
+func (*SpamToken) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SpamToken) GetMint() string {
+	if x != nil {
+		return x.Mint
+	}
+	return ""
+}
+
+func (x *SpamToken) GetVerdict() string {
+	if x != nil {
+		return x.Verdict
+	}
+	return ""
+}
+
+func (x *SpamToken) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SpamToken) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *SpamToken) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListSpamTokensRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSpamTokensRequest) Reset() {
+	*x = ListSpamTokensRequest{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSpamTokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSpamTokensRequest) ProtoMessage() {}
+
+func (x *ListSpamTokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSpamTokensRequest.ProtoReflect.Descriptor instead.
+func (*ListSpamTokensRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{15}
+}
+
+type ListSpamTokensResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tokens        []*SpamToken           `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSpamTokensResponse) Reset() {
+	*x = ListSpamTokensResponse{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSpamTokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSpamTokensResponse) ProtoMessage() {}
+
+func (x *ListSpamTokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSpamTokensResponse.ProtoReflect.Descriptor instead.
+func (*ListSpamTokensResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListSpamTokensResponse) GetTokens() []*SpamToken {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+type SetSpamTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         *SpamToken             `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSpamTokenRequest) Reset() {
+	*x = SetSpamTokenRequest{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSpamTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSpamTokenRequest) ProtoMessage() {}
+
+func (x *SetSpamTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSpamTokenRequest.ProtoReflect.Descriptor instead.
+func (*SetSpamTokenRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SetSpamTokenRequest) GetToken() *SpamToken {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+type SetSpamTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         *SpamToken             `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSpamTokenResponse) Reset() {
+	*x = SetSpamTokenResponse{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSpamTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSpamTokenResponse) ProtoMessage() {}
+
+func (x *SetSpamTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSpamTokenResponse.ProtoReflect.Descriptor instead.
+func (*SetSpamTokenResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SetSpamTokenResponse) GetToken() *SpamToken {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+type DeleteSpamTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mint          string                 `protobuf:"bytes,1,opt,name=mint,proto3" json:"mint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSpamTokenRequest) Reset() {
+	*x = DeleteSpamTokenRequest{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSpamTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSpamTokenRequest) ProtoMessage() {}
+
+func (x *DeleteSpamTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSpamTokenRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSpamTokenRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DeleteSpamTokenRequest) GetMint() string {
+	if x != nil {
+		return x.Mint
+	}
+	return ""
+}
+
+type DeleteSpamTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSpamTokenResponse) Reset() {
+	*x = DeleteSpamTokenResponse{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSpamTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSpamTokenResponse) ProtoMessage() {}
+
+func (x *DeleteSpamTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSpamTokenResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSpamTokenResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{20}
+}
+
+type BlockedMint struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Mint  string                 `protobuf:"bytes,1,opt,name=mint,proto3" json:"mint,omitempty"`
+	// Feed the entry came from, or "admin" for overrides.
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Admin override allowing the mint despite feed entries.
+	Allow         bool                   `protobuf:"varint,4,opt,name=allow,proto3" json:"allow,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,5,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockedMint) Reset() {
+	*x = BlockedMint{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockedMint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockedMint) ProtoMessage() {}
+
+func (x *BlockedMint) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockedMint.ProtoReflect.Descriptor instead.
+func (*BlockedMint) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *BlockedMint) GetMint() string {
+	if x != nil {
+		return x.Mint
+	}
+	return ""
+}
+
+func (x *BlockedMint) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *BlockedMint) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BlockedMint) GetAllow() bool {
+	if x != nil {
+		return x.Allow
+	}
+	return false
+}
+
+func (x *BlockedMint) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *BlockedMint) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListBlockedMintsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mint          string                 `protobuf:"bytes,1,opt,name=mint,proto3" json:"mint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBlockedMintsRequest) Reset() {
+	*x = ListBlockedMintsRequest{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlockedMintsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlockedMintsRequest) ProtoMessage() {}
+
+func (x *ListBlockedMintsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlockedMintsRequest.ProtoReflect.Descriptor instead.
+func (*ListBlockedMintsRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListBlockedMintsRequest) GetMint() string {
+	if x != nil {
+		return x.Mint
+	}
+	return ""
+}
+
+type ListBlockedMintsResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Entries []*BlockedMint         `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	// Whether the mint is currently blocked; only set when a mint was requested.
+	Blocked       bool `protobuf:"varint,2,opt,name=blocked,proto3" json:"blocked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBlockedMintsResponse) Reset() {
+	*x = ListBlockedMintsResponse{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlockedMintsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlockedMintsResponse) ProtoMessage() {}
+
+func (x *ListBlockedMintsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlockedMintsResponse.ProtoReflect.Descriptor instead.
+func (*ListBlockedMintsResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListBlockedMintsResponse) GetEntries() []*BlockedMint {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListBlockedMintsResponse) GetBlocked() bool {
+	if x != nil {
+		return x.Blocked
+	}
+	return false
+}
+
+type SetBlocklistOverrideRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mint          string                 `protobuf:"bytes,1,opt,name=mint,proto3" json:"mint,omitempty"`
+	Allow         bool                   `protobuf:"varint,2,opt,name=allow,proto3" json:"allow,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,4,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetBlocklistOverrideRequest) Reset() {
+	*x = SetBlocklistOverrideRequest{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetBlocklistOverrideRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBlocklistOverrideRequest) ProtoMessage() {}
+
+func (x *SetBlocklistOverrideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBlocklistOverrideRequest.ProtoReflect.Descriptor instead.
+func (*SetBlocklistOverrideRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SetBlocklistOverrideRequest) GetMint() string {
+	if x != nil {
+		return x.Mint
+	}
+	return ""
+}
+
+func (x *SetBlocklistOverrideRequest) GetAllow() bool {
+	if x != nil {
+		return x.Allow
+	}
+	return false
+}
+
+func (x *SetBlocklistOverrideRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SetBlocklistOverrideRequest) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+type SetBlocklistOverrideResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *BlockedMint           `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetBlocklistOverrideResponse) Reset() {
+	*x = SetBlocklistOverrideResponse{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetBlocklistOverrideResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBlocklistOverrideResponse) ProtoMessage() {}
+
+func (x *SetBlocklistOverrideResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBlocklistOverrideResponse.ProtoReflect.Descriptor instead.
+func (*SetBlocklistOverrideResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SetBlocklistOverrideResponse) GetEntry() *BlockedMint {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type DeleteBlocklistOverrideRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mint          string                 `protobuf:"bytes,1,opt,name=mint,proto3" json:"mint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBlocklistOverrideRequest) Reset() {
+	*x = DeleteBlocklistOverrideRequest{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBlocklistOverrideRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBlocklistOverrideRequest) ProtoMessage() {}
+
+func (x *DeleteBlocklistOverrideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBlocklistOverrideRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBlocklistOverrideRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DeleteBlocklistOverrideRequest) GetMint() string {
+	if x != nil {
+		return x.Mint
+	}
+	return ""
+}
+
+type DeleteBlocklistOverrideResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBlocklistOverrideResponse) Reset() {
+	*x = DeleteBlocklistOverrideResponse{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBlocklistOverrideResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBlocklistOverrideResponse) ProtoMessage() {}
+
+func (x *DeleteBlocklistOverrideResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBlocklistOverrideResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBlocklistOverrideResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{27}
+}
+
+type SyncBlocklistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncBlocklistRequest) Reset() {
+	*x = SyncBlocklistRequest{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncBlocklistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncBlocklistRequest) ProtoMessage() {}
+
+func (x *SyncBlocklistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncBlocklistRequest.ProtoReflect.Descriptor instead.
+func (*SyncBlocklistRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{28}
+}
+
+type BlocklistSyncResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Feed          string                 `protobuf:"bytes,1,opt,name=feed,proto3" json:"feed,omitempty"`
+	Entries       int32                  `protobuf:"varint,2,opt,name=entries,proto3" json:"entries,omitempty"`
+	Removed       int32                  `protobuf:"varint,3,opt,name=removed,proto3" json:"removed,omitempty"`
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlocklistSyncResult) Reset() {
+	*x = BlocklistSyncResult{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlocklistSyncResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlocklistSyncResult) ProtoMessage() {}
+
+func (x *BlocklistSyncResult) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlocklistSyncResult.ProtoReflect.Descriptor instead.
+func (*BlocklistSyncResult) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *BlocklistSyncResult) GetFeed() string {
+	if x != nil {
+		return x.Feed
+	}
+	return ""
+}
+
+func (x *BlocklistSyncResult) GetEntries() int32 {
+	if x != nil {
+		return x.Entries
+	}
+	return 0
+}
+
+func (x *BlocklistSyncResult) GetRemoved() int32 {
+	if x != nil {
+		return x.Removed
+	}
+	return 0
+}
+
+func (x *BlocklistSyncResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type SyncBlocklistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*BlocklistSyncResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncBlocklistResponse) Reset() {
+	*x = SyncBlocklistResponse{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncBlocklistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncBlocklistResponse) ProtoMessage() {}
+
+func (x *SyncBlocklistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncBlocklistResponse.ProtoReflect.Descriptor instead.
+func (*SyncBlocklistResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SyncBlocklistResponse) GetResults() []*BlocklistSyncResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type CoinDescription struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	CoinAddress string                 `protobuf:"bytes,1,opt,name=coin_address,json=coinAddress,proto3" json:"coin_address,omitempty"`
+	// Lowercase BCP 47 tag, e.g. "en" or "pt-br".
+	Locale      string `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// "metadata" or "admin".
+	Source        string                 `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,5,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CoinDescription) Reset() {
+	*x = CoinDescription{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CoinDescription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoinDescription) ProtoMessage() {}
+
+func (x *CoinDescription) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoinDescription.ProtoReflect.Descriptor instead.
+func (*CoinDescription) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CoinDescription) GetCoinAddress() string {
+	if x != nil {
+		return x.CoinAddress
+	}
+	return ""
+}
+
+func (x *CoinDescription) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *CoinDescription) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CoinDescription) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *CoinDescription) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *CoinDescription) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListCoinDescriptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CoinAddress   string                 `protobuf:"bytes,1,opt,name=coin_address,json=coinAddress,proto3" json:"coin_address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCoinDescriptionsRequest) Reset() {
+	*x = ListCoinDescriptionsRequest{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCoinDescriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCoinDescriptionsRequest) ProtoMessage() {}
+
+func (x *ListCoinDescriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCoinDescriptionsRequest.ProtoReflect.Descriptor instead.
+func (*ListCoinDescriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListCoinDescriptionsRequest) GetCoinAddress() string {
+	if x != nil {
+		return x.CoinAddress
+	}
+	return ""
+}
+
+type ListCoinDescriptionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Descriptions  []*CoinDescription     `protobuf:"bytes,1,rep,name=descriptions,proto3" json:"descriptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCoinDescriptionsResponse) Reset() {
+	*x = ListCoinDescriptionsResponse{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCoinDescriptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCoinDescriptionsResponse) ProtoMessage() {}
+
+func (x *ListCoinDescriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCoinDescriptionsResponse.ProtoReflect.Descriptor instead.
+func (*ListCoinDescriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListCoinDescriptionsResponse) GetDescriptions() []*CoinDescription {
+	if x != nil {
+		return x.Descriptions
+	}
+	return nil
+}
+
+type SetCoinDescriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CoinAddress   string                 `protobuf:"bytes,1,opt,name=coin_address,json=coinAddress,proto3" json:"coin_address,omitempty"`
+	Locale        string                 `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,4,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCoinDescriptionRequest) Reset() {
+	*x = SetCoinDescriptionRequest{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCoinDescriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCoinDescriptionRequest) ProtoMessage() {}
+
+func (x *SetCoinDescriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCoinDescriptionRequest.ProtoReflect.Descriptor instead.
+func (*SetCoinDescriptionRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SetCoinDescriptionRequest) GetCoinAddress() string {
+	if x != nil {
+		return x.CoinAddress
+	}
+	return ""
+}
+
+func (x *SetCoinDescriptionRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *SetCoinDescriptionRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SetCoinDescriptionRequest) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+type SetCoinDescriptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Description   *CoinDescription       `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCoinDescriptionResponse) Reset() {
+	*x = SetCoinDescriptionResponse{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCoinDescriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCoinDescriptionResponse) ProtoMessage() {}
+
+func (x *SetCoinDescriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCoinDescriptionResponse.ProtoReflect.Descriptor instead.
+func (*SetCoinDescriptionResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SetCoinDescriptionResponse) GetDescription() *CoinDescription {
+	if x != nil {
+		return x.Description
+	}
+	return nil
+}
+
+type DeleteCoinDescriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CoinAddress   string                 `protobuf:"bytes,1,opt,name=coin_address,json=coinAddress,proto3" json:"coin_address,omitempty"`
+	Locale        string                 `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCoinDescriptionRequest) Reset() {
+	*x = DeleteCoinDescriptionRequest{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCoinDescriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCoinDescriptionRequest) ProtoMessage() {}
+
+func (x *DeleteCoinDescriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCoinDescriptionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCoinDescriptionRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DeleteCoinDescriptionRequest) GetCoinAddress() string {
+	if x != nil {
+		return x.CoinAddress
+	}
+	return ""
+}
+
+func (x *DeleteCoinDescriptionRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type DeleteCoinDescriptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCoinDescriptionResponse) Reset() {
+	*x = DeleteCoinDescriptionResponse{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCoinDescriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCoinDescriptionResponse) ProtoMessage() {}
+
+func (x *DeleteCoinDescriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCoinDescriptionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCoinDescriptionResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{37}
+}
+
+type SlowQuery struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Statement with literals and placeholders replaced by ?.
+	Sql   string `protobuf:"bytes,1,opt,name=sql,proto3" json:"sql,omitempty"`
+	Table string `protobuf:"bytes,2,opt,name=table,proto3" json:"table,omitempty"`
+	// Executions over the threshold.
+	Count         int64                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	TotalMs       float64                `protobuf:"fixed64,4,opt,name=total_ms,json=totalMs,proto3" json:"total_ms,omitempty"`
+	AvgMs         float64                `protobuf:"fixed64,5,opt,name=avg_ms,json=avgMs,proto3" json:"avg_ms,omitempty"`
+	MaxMs         float64                `protobuf:"fixed64,6,opt,name=max_ms,json=maxMs,proto3" json:"max_ms,omitempty"`
+	LastSeen      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlowQuery) Reset() {
+	*x = SlowQuery{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlowQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlowQuery) ProtoMessage() {}
+
+func (x *SlowQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlowQuery.ProtoReflect.Descriptor instead.
+func (*SlowQuery) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SlowQuery) GetSql() string {
+	if x != nil {
+		return x.Sql
+	}
+	return ""
+}
+
+func (x *SlowQuery) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *SlowQuery) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *SlowQuery) GetTotalMs() float64 {
+	if x != nil {
+		return x.TotalMs
+	}
+	return 0
+}
+
+func (x *SlowQuery) GetAvgMs() float64 {
+	if x != nil {
+		return x.AvgMs
+	}
+	return 0
+}
+
+func (x *SlowQuery) GetMaxMs() float64 {
+	if x != nil {
+		return x.MaxMs
+	}
+	return 0
+}
+
+func (x *SlowQuery) GetLastSeen() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeen
+	}
+	return nil
+}
+
+type ListSlowQueriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Defaults to 20.
+	Limit int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Clear the slow query table after reading it.
+	Reset_        bool `protobuf:"varint,2,opt,name=reset,proto3" json:"reset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSlowQueriesRequest) Reset() {
+	*x = ListSlowQueriesRequest{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSlowQueriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSlowQueriesRequest) ProtoMessage() {}
+
+func (x *ListSlowQueriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSlowQueriesRequest.ProtoReflect.Descriptor instead.
+func (*ListSlowQueriesRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListSlowQueriesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListSlowQueriesRequest) GetReset_() bool {
+	if x != nil {
+		return x.Reset_
+	}
+	return false
+}
+
+type ListSlowQueriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Queries       []*SlowQuery           `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries,omitempty"`
+	ThresholdMs   float64                `protobuf:"fixed64,2,opt,name=threshold_ms,json=thresholdMs,proto3" json:"threshold_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSlowQueriesResponse) Reset() {
+	*x = ListSlowQueriesResponse{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSlowQueriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSlowQueriesResponse) ProtoMessage() {}
+
+func (x *ListSlowQueriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSlowQueriesResponse.ProtoReflect.Descriptor instead.
+func (*ListSlowQueriesResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListSlowQueriesResponse) GetQueries() []*SlowQuery {
+	if x != nil {
+		return x.Queries
+	}
+	return nil
+}
+
+func (x *ListSlowQueriesResponse) GetThresholdMs() float64 {
+	if x != nil {
+		return x.ThresholdMs
+	}
+	return 0
+}
+
+type BroadcastNotificationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Title string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Body  string                 `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	// Delivered to the app alongside the message, e.g. a deep link.
+	Data          map[string]string `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BroadcastNotificationRequest) Reset() {
+	*x = BroadcastNotificationRequest{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BroadcastNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BroadcastNotificationRequest) ProtoMessage() {}
+
+func (x *BroadcastNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BroadcastNotificationRequest.ProtoReflect.Descriptor instead.
+func (*BroadcastNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *BroadcastNotificationRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *BroadcastNotificationRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *BroadcastNotificationRequest) GetData() map[string]string {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type BroadcastNotificationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Wallets with a registered device.
+	Wallets int32 `protobuf:"varint,1,opt,name=wallets,proto3" json:"wallets,omitempty"`
+	Sent    int32 `protobuf:"varint,2,opt,name=sent,proto3" json:"sent,omitempty"`
+	// Held back by the wallet's preferences, e.g. quiet hours.
+	Suppressed    int32 `protobuf:"varint,3,opt,name=suppressed,proto3" json:"suppressed,omitempty"`
+	Failed        int32 `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BroadcastNotificationResponse) Reset() {
+	*x = BroadcastNotificationResponse{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BroadcastNotificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BroadcastNotificationResponse) ProtoMessage() {}
+
+func (x *BroadcastNotificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BroadcastNotificationResponse.ProtoReflect.Descriptor instead.
+func (*BroadcastNotificationResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *BroadcastNotificationResponse) GetWallets() int32 {
+	if x != nil {
+		return x.Wallets
+	}
+	return 0
+}
+
+func (x *BroadcastNotificationResponse) GetSent() int32 {
+	if x != nil {
+		return x.Sent
+	}
+	return 0
+}
+
+func (x *BroadcastNotificationResponse) GetSuppressed() int32 {
+	if x != nil {
+		return x.Suppressed
+	}
+	return 0
+}
+
+func (x *BroadcastNotificationResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+type NotificationDelivery struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	WalletAddress string                 `protobuf:"bytes,2,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Body          string                 `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	// "sent", "failed" or "suppressed".
+	Status string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	// Why the notification failed or was suppressed.
+	Detail        string                 `protobuf:"bytes,7,opt,name=detail,proto3" json:"detail,omitempty"`
+	Devices       int32                  `protobuf:"varint,8,opt,name=devices,proto3" json:"devices,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationDelivery) Reset() {
+	*x = NotificationDelivery{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationDelivery) ProtoMessage() {}
+
+func (x *NotificationDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationDelivery.ProtoReflect.Descriptor instead.
+func (*NotificationDelivery) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *NotificationDelivery) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *NotificationDelivery) GetWalletAddress() string {
+	if x != nil {
+		return x.WalletAddress
+	}
+	return ""
+}
+
+func (x *NotificationDelivery) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *NotificationDelivery) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *NotificationDelivery) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *NotificationDelivery) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *NotificationDelivery) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *NotificationDelivery) GetDevices() int32 {
+	if x != nil {
+		return x.Devices
+	}
+	return 0
+}
+
+func (x *NotificationDelivery) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListNotificationDeliveriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Filters; all optional.
+	WalletAddress string `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
+	Type          string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Status        string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	// Defaults to 50, at most 500.
+	Limit         int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationDeliveriesRequest) Reset() {
+	*x = ListNotificationDeliveriesRequest{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListNotificationDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListNotificationDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListNotificationDeliveriesRequest) GetWalletAddress() string {
+	if x != nil {
+		return x.WalletAddress
+	}
+	return ""
+}
+
+func (x *ListNotificationDeliveriesRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ListNotificationDeliveriesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListNotificationDeliveriesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListNotificationDeliveriesResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Deliveries    []*NotificationDelivery `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationDeliveriesResponse) Reset() {
+	*x = ListNotificationDeliveriesResponse{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListNotificationDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListNotificationDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListNotificationDeliveriesResponse) GetDeliveries() []*NotificationDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+type AnnouncementContent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Title string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Body  string                 `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	// "info", "warning" or "critical"; defaults to "info".
+	Severity string `protobuf:"bytes,3,opt,name=severity,proto3" json:"severity,omitempty"`
+	// Optional app version range, e.g. "1.4.0".
+	MinAppVersion string `protobuf:"bytes,4,opt,name=min_app_version,json=minAppVersion,proto3" json:"min_app_version,omitempty"`
+	MaxAppVersion string `protobuf:"bytes,5,opt,name=max_app_version,json=maxAppVersion,proto3" json:"max_app_version,omitempty"`
+	// Defaults to now.
+	StartsAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=starts_at,json=startsAt,proto3,oneof" json:"starts_at,omitempty"`
+	// Unset for no expiry.
+	EndsAt        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=ends_at,json=endsAt,proto3,oneof" json:"ends_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnnouncementContent) Reset() {
+	*x = AnnouncementContent{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnnouncementContent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnnouncementContent) ProtoMessage() {}
+
+func (x *AnnouncementContent) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnnouncementContent.ProtoReflect.Descriptor instead.
+func (*AnnouncementContent) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *AnnouncementContent) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *AnnouncementContent) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *AnnouncementContent) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *AnnouncementContent) GetMinAppVersion() string {
+	if x != nil {
+		return x.MinAppVersion
+	}
+	return ""
+}
+
+func (x *AnnouncementContent) GetMaxAppVersion() string {
+	if x != nil {
+		return x.MaxAppVersion
+	}
+	return ""
+}
+
+func (x *AnnouncementContent) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *AnnouncementContent) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+type ListAllAnnouncementsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAllAnnouncementsRequest) Reset() {
+	*x = ListAllAnnouncementsRequest{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAllAnnouncementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllAnnouncementsRequest) ProtoMessage() {}
+
+func (x *ListAllAnnouncementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllAnnouncementsRequest.ProtoReflect.Descriptor instead.
+func (*ListAllAnnouncementsRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{47}
+}
+
+type ListAllAnnouncementsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Announcements []*Announcement        `protobuf:"bytes,1,rep,name=announcements,proto3" json:"announcements,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAllAnnouncementsResponse) Reset() {
+	*x = ListAllAnnouncementsResponse{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAllAnnouncementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllAnnouncementsResponse) ProtoMessage() {}
+
+func (x *ListAllAnnouncementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllAnnouncementsResponse.ProtoReflect.Descriptor instead.
+func (*ListAllAnnouncementsResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListAllAnnouncementsResponse) GetAnnouncements() []*Announcement {
+	if x != nil {
+		return x.Announcements
+	}
+	return nil
+}
+
+type CreateAnnouncementRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Content *AnnouncementContent   `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	// Who wrote it, for the record.
+	CreatedBy     string `protobuf:"bytes,2,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAnnouncementRequest) Reset() {
+	*x = CreateAnnouncementRequest{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAnnouncementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAnnouncementRequest) ProtoMessage() {}
+
+func (x *CreateAnnouncementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAnnouncementRequest.ProtoReflect.Descriptor instead.
+func (*CreateAnnouncementRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CreateAnnouncementRequest) GetContent() *AnnouncementContent {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *CreateAnnouncementRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+type CreateAnnouncementResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Announcement  *Announcement          `protobuf:"bytes,1,opt,name=announcement,proto3" json:"announcement,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAnnouncementResponse) Reset() {
+	*x = CreateAnnouncementResponse{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAnnouncementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAnnouncementResponse) ProtoMessage() {}
+
+func (x *CreateAnnouncementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAnnouncementResponse.ProtoReflect.Descriptor instead.
+func (*CreateAnnouncementResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CreateAnnouncementResponse) GetAnnouncement() *Announcement {
+	if x != nil {
+		return x.Announcement
+	}
+	return nil
+}
+
+type UpdateAnnouncementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Content       *AnnouncementContent   `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAnnouncementRequest) Reset() {
+	*x = UpdateAnnouncementRequest{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAnnouncementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAnnouncementRequest) ProtoMessage() {}
+
+func (x *UpdateAnnouncementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAnnouncementRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAnnouncementRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *UpdateAnnouncementRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateAnnouncementRequest) GetContent() *AnnouncementContent {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type UpdateAnnouncementResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Announcement  *Announcement          `protobuf:"bytes,1,opt,name=announcement,proto3" json:"announcement,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAnnouncementResponse) Reset() {
+	*x = UpdateAnnouncementResponse{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAnnouncementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAnnouncementResponse) ProtoMessage() {}
+
+func (x *UpdateAnnouncementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAnnouncementResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAnnouncementResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *UpdateAnnouncementResponse) GetAnnouncement() *Announcement {
+	if x != nil {
+		return x.Announcement
+	}
+	return nil
+}
+
+type DeleteAnnouncementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAnnouncementRequest) Reset() {
+	*x = DeleteAnnouncementRequest{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAnnouncementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAnnouncementRequest) ProtoMessage() {}
+
+func (x *DeleteAnnouncementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAnnouncementRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAnnouncementRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *DeleteAnnouncementRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteAnnouncementResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAnnouncementResponse) Reset() {
+	*x = DeleteAnnouncementResponse{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAnnouncementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAnnouncementResponse) ProtoMessage() {}
+
+func (x *DeleteAnnouncementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAnnouncementResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAnnouncementResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{54}
+}
+
+type JobRun struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Job   string                 `protobuf:"bytes,2,opt,name=job,proto3" json:"job,omitempty"`
+	// 1 for the scheduled run, then each retry.
+	Attempt int32 `protobuf:"varint,3,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	// "running", "succeeded", "failed" or "panicked".
+	Status    string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Error     string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	StartedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	// Unset while running.
+	FinishedAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=finished_at,json=finishedAt,proto3,oneof" json:"finished_at,omitempty"`
+	DurationMs    float64                `protobuf:"fixed64,8,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobRun) Reset() {
+	*x = JobRun{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobRun) ProtoMessage() {}
+
+func (x *JobRun) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobRun.ProtoReflect.Descriptor instead.
+func (*JobRun) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *JobRun) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *JobRun) GetJob() string {
+	if x != nil {
+		return x.Job
+	}
+	return ""
+}
+
+func (x *JobRun) GetAttempt() int32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+func (x *JobRun) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *JobRun) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *JobRun) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *JobRun) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+func (x *JobRun) GetDurationMs() float64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+type ListJobRunsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Filters; all optional.
+	Job    string `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// Defaults to 50, at most 500.
+	Limit         int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobRunsRequest) Reset() {
+	*x = ListJobRunsRequest{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobRunsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobRunsRequest) ProtoMessage() {}
+
+func (x *ListJobRunsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobRunsRequest.ProtoReflect.Descriptor instead.
+func (*ListJobRunsRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListJobRunsRequest) GetJob() string {
+	if x != nil {
+		return x.Job
+	}
+	return ""
+}
+
+func (x *ListJobRunsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListJobRunsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListJobRunsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Runs          []*JobRun              `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobRunsResponse) Reset() {
+	*x = ListJobRunsResponse{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobRunsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobRunsResponse) ProtoMessage() {}
+
+func (x *ListJobRunsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobRunsResponse.ProtoReflect.Descriptor instead.
+func (*ListJobRunsResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListJobRunsResponse) GetRuns() []*JobRun {
+	if x != nil {
+		return x.Runs
+	}
+	return nil
+}
+
+type GetOperationsOverviewRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Recent job runs to include; defaults to 20, at most 500.
+	JobRunLimit   int32 `protobuf:"varint,1,opt,name=job_run_limit,json=jobRunLimit,proto3" json:"job_run_limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOperationsOverviewRequest) Reset() {
+	*x = GetOperationsOverviewRequest{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOperationsOverviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOperationsOverviewRequest) ProtoMessage() {}
+
+func (x *GetOperationsOverviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOperationsOverviewRequest.ProtoReflect.Descriptor instead.
+func (*GetOperationsOverviewRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetOperationsOverviewRequest) GetJobRunLimit() int32 {
+	if x != nil {
+		return x.JobRunLimit
+	}
+	return 0
+}
+
+// Calls to an upstream service since the server started.
+type UpstreamServiceStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Service       string                 `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	Calls         int64                  `protobuf:"varint,2,opt,name=calls,proto3" json:"calls,omitempty"`
+	Errors        int64                  `protobuf:"varint,3,opt,name=errors,proto3" json:"errors,omitempty"`
+	AvgDurationMs float64                `protobuf:"fixed64,4,opt,name=avg_duration_ms,json=avgDurationMs,proto3" json:"avg_duration_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpstreamServiceStats) Reset() {
+	*x = UpstreamServiceStats{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpstreamServiceStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpstreamServiceStats) ProtoMessage() {}
+
+func (x *UpstreamServiceStats) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpstreamServiceStats.ProtoReflect.Descriptor instead.
+func (*UpstreamServiceStats) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *UpstreamServiceStats) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *UpstreamServiceStats) GetCalls() int64 {
+	if x != nil {
+		return x.Calls
+	}
+	return 0
+}
+
+func (x *UpstreamServiceStats) GetErrors() int64 {
+	if x != nil {
+		return x.Errors
+	}
+	return 0
+}
+
+func (x *UpstreamServiceStats) GetAvgDurationMs() float64 {
+	if x != nil {
+		return x.AvgDurationMs
+	}
+	return 0
+}
+
+type CacheStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Hits          uint64                 `protobuf:"varint,2,opt,name=hits,proto3" json:"hits,omitempty"`
+	Misses        uint64                 `protobuf:"varint,3,opt,name=misses,proto3" json:"misses,omitempty"`
+	HitRatio      float64                `protobuf:"fixed64,4,opt,name=hit_ratio,json=hitRatio,proto3" json:"hit_ratio,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CacheStats) Reset() {
+	*x = CacheStats{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CacheStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CacheStats) ProtoMessage() {}
+
+func (x *CacheStats) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CacheStats.ProtoReflect.Descriptor instead.
+func (*CacheStats) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *CacheStats) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CacheStats) GetHits() uint64 {
+	if x != nil {
+		return x.Hits
+	}
+	return 0
+}
+
+func (x *CacheStats) GetMisses() uint64 {
+	if x != nil {
+		return x.Misses
+	}
+	return 0
+}
+
+func (x *CacheStats) GetHitRatio() float64 {
+	if x != nil {
+		return x.HitRatio
+	}
+	return 0
+}
+
+type RPCEndpointStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// False while the endpoint is out of rotation.
+	Healthy             bool    `protobuf:"varint,2,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	ConsecutiveFailures int32   `protobuf:"varint,3,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	AvgLatencyMs        float64 `protobuf:"fixed64,4,opt,name=avg_latency_ms,json=avgLatencyMs,proto3" json:"avg_latency_ms,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *RPCEndpointStatus) Reset() {
+	*x = RPCEndpointStatus{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RPCEndpointStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RPCEndpointStatus) ProtoMessage() {}
+
+func (x *RPCEndpointStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RPCEndpointStatus.ProtoReflect.Descriptor instead.
+func (*RPCEndpointStatus) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *RPCEndpointStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RPCEndpointStatus) GetHealthy() bool {
+	if x != nil {
+		return x.Healthy
+	}
+	return false
+}
+
+func (x *RPCEndpointStatus) GetConsecutiveFailures() int32 {
+	if x != nil {
+		return x.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (x *RPCEndpointStatus) GetAvgLatencyMs() float64 {
+	if x != nil {
+		return x.AvgLatencyMs
+	}
+	return 0
+}
+
+type FeeBalance struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Token mint; the native SOL mint for SOL.
+	Mint          string  `protobuf:"bytes,1,opt,name=mint,proto3" json:"mint,omitempty"`
+	Amount        float64 `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	RawAmount     string  `protobuf:"bytes,3,opt,name=raw_amount,json=rawAmount,proto3" json:"raw_amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeeBalance) Reset() {
+	*x = FeeBalance{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeeBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeBalance) ProtoMessage() {}
+
+func (x *FeeBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeeBalance.ProtoReflect.Descriptor instead.
+func (*FeeBalance) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *FeeBalance) GetMint() string {
+	if x != nil {
+		return x.Mint
+	}
+	return ""
+}
+
+func (x *FeeBalance) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *FeeBalance) GetRawAmount() string {
+	if x != nil {
+		return x.RawAmount
+	}
+	return ""
+}
+
+type GetOperationsOverviewResponse struct {
+	state            protoimpl.MessageState  `protogen:"open.v1"`
+	UpstreamServices []*UpstreamServiceStats `protobuf:"bytes,1,rep,name=upstream_services,json=upstreamServices,proto3" json:"upstream_services,omitempty"`
+	Caches           []*CacheStats           `protobuf:"bytes,2,rep,name=caches,proto3" json:"caches,omitempty"`
+	RecentJobRuns    []*JobRun               `protobuf:"bytes,3,rep,name=recent_job_runs,json=recentJobRuns,proto3" json:"recent_job_runs,omitempty"`
+	RpcEndpoints     []*RPCEndpointStatus    `protobuf:"bytes,4,rep,name=rpc_endpoints,json=rpcEndpoints,proto3" json:"rpc_endpoints,omitempty"`
+	// Coins waiting for an enrichment worker, and being enriched.
+	EnrichmentQueued  int64         `protobuf:"varint,5,opt,name=enrichment_queued,json=enrichmentQueued,proto3" json:"enrichment_queued,omitempty"`
+	EnrichmentRunning int64         `protobuf:"varint,6,opt,name=enrichment_running,json=enrichmentRunning,proto3" json:"enrichment_running,omitempty"`
+	FeeAccount        string        `protobuf:"bytes,7,opt,name=fee_account,json=feeAccount,proto3" json:"fee_account,omitempty"`
+	FeeBalances       []*FeeBalance `protobuf:"bytes,8,rep,name=fee_balances,json=feeBalances,proto3" json:"fee_balances,omitempty"`
+	// Sections that failed to load, each as "<section>: <error>". Sections
+	// whose source is not configured are empty instead.
+	Errors        []string `protobuf:"bytes,9,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOperationsOverviewResponse) Reset() {
+	*x = GetOperationsOverviewResponse{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOperationsOverviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOperationsOverviewResponse) ProtoMessage() {}
+
+func (x *GetOperationsOverviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOperationsOverviewResponse.ProtoReflect.Descriptor instead.
+func (*GetOperationsOverviewResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetOperationsOverviewResponse) GetUpstreamServices() []*UpstreamServiceStats {
+	if x != nil {
+		return x.UpstreamServices
+	}
+	return nil
+}
+
+func (x *GetOperationsOverviewResponse) GetCaches() []*CacheStats {
+	if x != nil {
+		return x.Caches
+	}
+	return nil
+}
+
+func (x *GetOperationsOverviewResponse) GetRecentJobRuns() []*JobRun {
+	if x != nil {
+		return x.RecentJobRuns
+	}
+	return nil
+}
+
+func (x *GetOperationsOverviewResponse) GetRpcEndpoints() []*RPCEndpointStatus {
+	if x != nil {
+		return x.RpcEndpoints
+	}
+	return nil
+}
+
+func (x *GetOperationsOverviewResponse) GetEnrichmentQueued() int64 {
+	if x != nil {
+		return x.EnrichmentQueued
+	}
+	return 0
+}
+
+func (x *GetOperationsOverviewResponse) GetEnrichmentRunning() int64 {
+	if x != nil {
+		return x.EnrichmentRunning
+	}
+	return 0
+}
+
+func (x *GetOperationsOverviewResponse) GetFeeAccount() string {
+	if x != nil {
+		return x.FeeAccount
+	}
+	return ""
+}
+
+func (x *GetOperationsOverviewResponse) GetFeeBalances() []*FeeBalance {
+	if x != nil {
+		return x.FeeBalances
+	}
+	return nil
+}
+
+func (x *GetOperationsOverviewResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type CoinRevision struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CoinAddress string                 `protobuf:"bytes,2,opt,name=coin_address,json=coinAddress,proto3" json:"coin_address,omitempty"`
+	// "name", "symbol", "decimals", "logo_uri", "description", "website",
+	// "twitter", "telegram", "discord", or "description:<locale>" for a
+	// localized description.
+	Field string `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`
+	// Empty when the field was unset.
+	Previous string `protobuf:"bytes,4,opt,name=previous,proto3" json:"previous,omitempty"`
+	// Empty when the field was cleared.
+	Current string `protobuf:"bytes,5,opt,name=current,proto3" json:"current,omitempty"`
+	// "birdeye", "jupiter", "metaplex" or "admin".
+	Source        string                 `protobuf:"bytes,6,opt,name=source,proto3" json:"source,omitempty"`
+	ChangedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CoinRevision) Reset() {
+	*x = CoinRevision{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CoinRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoinRevision) ProtoMessage() {}
+
+func (x *CoinRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoinRevision.ProtoReflect.Descriptor instead.
+func (*CoinRevision) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *CoinRevision) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CoinRevision) GetCoinAddress() string {
+	if x != nil {
+		return x.CoinAddress
+	}
+	return ""
+}
+
+func (x *CoinRevision) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *CoinRevision) GetPrevious() string {
+	if x != nil {
+		return x.Previous
+	}
+	return ""
+}
+
+func (x *CoinRevision) GetCurrent() string {
+	if x != nil {
+		return x.Current
+	}
+	return ""
+}
+
+func (x *CoinRevision) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *CoinRevision) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+type GetCoinHistoryRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	CoinAddress string                 `protobuf:"bytes,1,opt,name=coin_address,json=coinAddress,proto3" json:"coin_address,omitempty"`
+	// Only the changes to this field; optional.
+	Field string `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	// Defaults to 100, at most 500.
+	Limit         int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCoinHistoryRequest) Reset() {
+	*x = GetCoinHistoryRequest{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCoinHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoinHistoryRequest) ProtoMessage() {}
+
+func (x *GetCoinHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoinHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetCoinHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetCoinHistoryRequest) GetCoinAddress() string {
+	if x != nil {
+		return x.CoinAddress
+	}
+	return ""
+}
+
+func (x *GetCoinHistoryRequest) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *GetCoinHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetCoinHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revisions     []*CoinRevision        `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCoinHistoryResponse) Reset() {
+	*x = GetCoinHistoryResponse{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCoinHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoinHistoryResponse) ProtoMessage() {}
+
+func (x *GetCoinHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoinHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetCoinHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetCoinHistoryResponse) GetRevisions() []*CoinRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+type CoinOverride struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	CoinAddress string                 `protobuf:"bytes,1,opt,name=coin_address,json=coinAddress,proto3" json:"coin_address,omitempty"`
+	// One of the fields listed on CoinRevision, other than localized descriptions.
+	Field         string                 `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,4,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CoinOverride) Reset() {
+	*x = CoinOverride{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CoinOverride) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoinOverride) ProtoMessage() {}
+
+func (x *CoinOverride) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoinOverride.ProtoReflect.Descriptor instead.
+func (*CoinOverride) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *CoinOverride) GetCoinAddress() string {
+	if x != nil {
+		return x.CoinAddress
+	}
+	return ""
+}
+
+func (x *CoinOverride) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *CoinOverride) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *CoinOverride) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *CoinOverride) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListCoinOverridesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CoinAddress   string                 `protobuf:"bytes,1,opt,name=coin_address,json=coinAddress,proto3" json:"coin_address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCoinOverridesRequest) Reset() {
+	*x = ListCoinOverridesRequest{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCoinOverridesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCoinOverridesRequest) ProtoMessage() {}
+
+func (x *ListCoinOverridesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCoinOverridesRequest.ProtoReflect.Descriptor instead.
+func (*ListCoinOverridesRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ListCoinOverridesRequest) GetCoinAddress() string {
+	if x != nil {
+		return x.CoinAddress
+	}
+	return ""
+}
+
+type ListCoinOverridesResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Overrides []*CoinOverride        `protobuf:"bytes,1,rep,name=overrides,proto3" json:"overrides,omitempty"`
+	// Source of each set metadata field as decided by the merge policy, e.g. "metaplex".
+	FieldSources  map[string]string `protobuf:"bytes,2,rep,name=field_sources,json=fieldSources,proto3" json:"field_sources,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCoinOverridesResponse) Reset() {
+	*x = ListCoinOverridesResponse{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCoinOverridesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCoinOverridesResponse) ProtoMessage() {}
+
+func (x *ListCoinOverridesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCoinOverridesResponse.ProtoReflect.Descriptor instead.
+func (*ListCoinOverridesResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ListCoinOverridesResponse) GetOverrides() []*CoinOverride {
+	if x != nil {
+		return x.Overrides
+	}
+	return nil
+}
+
+func (x *ListCoinOverridesResponse) GetFieldSources() map[string]string {
+	if x != nil {
+		return x.FieldSources
+	}
+	return nil
+}
+
+type SetCoinOverrideRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CoinAddress   string                 `protobuf:"bytes,1,opt,name=coin_address,json=coinAddress,proto3" json:"coin_address,omitempty"`
+	Field         string                 `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,4,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCoinOverrideRequest) Reset() {
+	*x = SetCoinOverrideRequest{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCoinOverrideRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCoinOverrideRequest) ProtoMessage() {}
+
+func (x *SetCoinOverrideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCoinOverrideRequest.ProtoReflect.Descriptor instead.
+func (*SetCoinOverrideRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *SetCoinOverrideRequest) GetCoinAddress() string {
+	if x != nil {
+		return x.CoinAddress
+	}
+	return ""
+}
+
+func (x *SetCoinOverrideRequest) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *SetCoinOverrideRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *SetCoinOverrideRequest) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+type SetCoinOverrideResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Override      *CoinOverride          `protobuf:"bytes,1,opt,name=override,proto3" json:"override,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCoinOverrideResponse) Reset() {
+	*x = SetCoinOverrideResponse{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCoinOverrideResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCoinOverrideResponse) ProtoMessage() {}
+
+func (x *SetCoinOverrideResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCoinOverrideResponse.ProtoReflect.Descriptor instead.
+func (*SetCoinOverrideResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *SetCoinOverrideResponse) GetOverride() *CoinOverride {
+	if x != nil {
+		return x.Override
+	}
+	return nil
+}
+
+type DeleteCoinOverrideRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CoinAddress   string                 `protobuf:"bytes,1,opt,name=coin_address,json=coinAddress,proto3" json:"coin_address,omitempty"`
+	Field         string                 `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCoinOverrideRequest) Reset() {
+	*x = DeleteCoinOverrideRequest{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCoinOverrideRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCoinOverrideRequest) ProtoMessage() {}
+
+func (x *DeleteCoinOverrideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCoinOverrideRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCoinOverrideRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *DeleteCoinOverrideRequest) GetCoinAddress() string {
+	if x != nil {
+		return x.CoinAddress
+	}
+	return ""
+}
+
+func (x *DeleteCoinOverrideRequest) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+type DeleteCoinOverrideResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCoinOverrideResponse) Reset() {
+	*x = DeleteCoinOverrideResponse{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCoinOverrideResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCoinOverrideResponse) ProtoMessage() {}
+
+func (x *DeleteCoinOverrideResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCoinOverrideResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCoinOverrideResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{73}
+}
+
+type SearchSynonym struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Lowercased, with whitespace collapsed.
+	Term string `protobuf:"bytes,1,opt,name=term,proto3" json:"term,omitempty"`
+	// Query searched instead: text, a mint address or a "$SYMBOL" cashtag.
+	Target        string                 `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,3,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchSynonym) Reset() {
+	*x = SearchSynonym{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchSynonym) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchSynonym) ProtoMessage() {}
+
+func (x *SearchSynonym) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchSynonym.ProtoReflect.Descriptor instead.
+func (*SearchSynonym) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *SearchSynonym) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+func (x *SearchSynonym) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *SearchSynonym) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *SearchSynonym) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListSearchSynonymsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSearchSynonymsRequest) Reset() {
+	*x = ListSearchSynonymsRequest{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSearchSynonymsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSearchSynonymsRequest) ProtoMessage() {}
+
+func (x *ListSearchSynonymsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSearchSynonymsRequest.ProtoReflect.Descriptor instead.
+func (*ListSearchSynonymsRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{75}
+}
+
+type ListSearchSynonymsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Synonyms      []*SearchSynonym       `protobuf:"bytes,1,rep,name=synonyms,proto3" json:"synonyms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSearchSynonymsResponse) Reset() {
+	*x = ListSearchSynonymsResponse{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSearchSynonymsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSearchSynonymsResponse) ProtoMessage() {}
+
+func (x *ListSearchSynonymsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSearchSynonymsResponse.ProtoReflect.Descriptor instead.
+func (*ListSearchSynonymsResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ListSearchSynonymsResponse) GetSynonyms() []*SearchSynonym {
+	if x != nil {
+		return x.Synonyms
+	}
+	return nil
+}
+
+type SetSearchSynonymRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Term          string                 `protobuf:"bytes,1,opt,name=term,proto3" json:"term,omitempty"`
+	Target        string                 `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,3,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSearchSynonymRequest) Reset() {
+	*x = SetSearchSynonymRequest{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSearchSynonymRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSearchSynonymRequest) ProtoMessage() {}
+
+func (x *SetSearchSynonymRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSearchSynonymRequest.ProtoReflect.Descriptor instead.
+func (*SetSearchSynonymRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *SetSearchSynonymRequest) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+func (x *SetSearchSynonymRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *SetSearchSynonymRequest) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+type SetSearchSynonymResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Synonym       *SearchSynonym         `protobuf:"bytes,1,opt,name=synonym,proto3" json:"synonym,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSearchSynonymResponse) Reset() {
+	*x = SetSearchSynonymResponse{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSearchSynonymResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSearchSynonymResponse) ProtoMessage() {}
+
+func (x *SetSearchSynonymResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSearchSynonymResponse.ProtoReflect.Descriptor instead.
+func (*SetSearchSynonymResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *SetSearchSynonymResponse) GetSynonym() *SearchSynonym {
+	if x != nil {
+		return x.Synonym
+	}
+	return nil
+}
+
+type DeleteSearchSynonymRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Term          string                 `protobuf:"bytes,1,opt,name=term,proto3" json:"term,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSearchSynonymRequest) Reset() {
+	*x = DeleteSearchSynonymRequest{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSearchSynonymRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSearchSynonymRequest) ProtoMessage() {}
+
+func (x *DeleteSearchSynonymRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSearchSynonymRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSearchSynonymRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *DeleteSearchSynonymRequest) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+type DeleteSearchSynonymResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSearchSynonymResponse) Reset() {
+	*x = DeleteSearchSynonymResponse{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSearchSynonymResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSearchSynonymResponse) ProtoMessage() {}
+
+func (x *DeleteSearchSynonymResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSearchSynonymResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSearchSynonymResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{80}
+}
+
+type NaughtyWord struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Lowercased, with whitespace collapsed.
+	Word string `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`
+	// LDNOOBW language code, e.g. "en".
+	Language      string `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NaughtyWord) Reset() {
+	*x = NaughtyWord{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NaughtyWord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NaughtyWord) ProtoMessage() {}
+
+func (x *NaughtyWord) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NaughtyWord.ProtoReflect.Descriptor instead.
+func (*NaughtyWord) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *NaughtyWord) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+func (x *NaughtyWord) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+type ListNaughtyWordsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only words in this language; empty for all.
+	Language string `protobuf:"bytes,1,opt,name=language,proto3" json:"language,omitempty"`
+	// Only words containing this text.
+	Query string `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	// Defaults to 100, at most 1000.
+	Limit         int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32 `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNaughtyWordsRequest) Reset() {
+	*x = ListNaughtyWordsRequest{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNaughtyWordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNaughtyWordsRequest) ProtoMessage() {}
+
+func (x *ListNaughtyWordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNaughtyWordsRequest.ProtoReflect.Descriptor instead.
+func (*ListNaughtyWordsRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ListNaughtyWordsRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *ListNaughtyWordsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ListNaughtyWordsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListNaughtyWordsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListNaughtyWordsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Words []*NaughtyWord         `protobuf:"bytes,1,rep,name=words,proto3" json:"words,omitempty"`
+	// Words matching the language and query.
+	Total         int32 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNaughtyWordsResponse) Reset() {
+	*x = ListNaughtyWordsResponse{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNaughtyWordsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNaughtyWordsResponse) ProtoMessage() {}
+
+func (x *ListNaughtyWordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNaughtyWordsResponse.ProtoReflect.Descriptor instead.
+func (*ListNaughtyWordsResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ListNaughtyWordsResponse) GetWords() []*NaughtyWord {
+	if x != nil {
+		return x.Words
+	}
+	return nil
+}
+
+func (x *ListNaughtyWordsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type AddNaughtyWordsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Words []string               `protobuf:"bytes,1,rep,name=words,proto3" json:"words,omitempty"`
+	// Defaults to "en".
+	Language      string `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddNaughtyWordsRequest) Reset() {
+	*x = AddNaughtyWordsRequest{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddNaughtyWordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddNaughtyWordsRequest) ProtoMessage() {}
+
+func (x *AddNaughtyWordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddNaughtyWordsRequest.ProtoReflect.Descriptor instead.
+func (*AddNaughtyWordsRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *AddNaughtyWordsRequest) GetWords() []string {
+	if x != nil {
+		return x.Words
+	}
+	return nil
+}
+
+func (x *AddNaughtyWordsRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+type AddNaughtyWordsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Words that weren't listed before.
+	Added         int32 `protobuf:"varint,1,opt,name=added,proto3" json:"added,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddNaughtyWordsResponse) Reset() {
+	*x = AddNaughtyWordsResponse{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddNaughtyWordsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddNaughtyWordsResponse) ProtoMessage() {}
+
+func (x *AddNaughtyWordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddNaughtyWordsResponse.ProtoReflect.Descriptor instead.
+func (*AddNaughtyWordsResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *AddNaughtyWordsResponse) GetAdded() int32 {
+	if x != nil {
+		return x.Added
+	}
+	return 0
+}
+
+type RemoveNaughtyWordsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Words         []string               `protobuf:"bytes,1,rep,name=words,proto3" json:"words,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveNaughtyWordsRequest) Reset() {
+	*x = RemoveNaughtyWordsRequest{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveNaughtyWordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveNaughtyWordsRequest) ProtoMessage() {}
+
+func (x *RemoveNaughtyWordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveNaughtyWordsRequest.ProtoReflect.Descriptor instead.
+func (*RemoveNaughtyWordsRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *RemoveNaughtyWordsRequest) GetWords() []string {
+	if x != nil {
+		return x.Words
+	}
+	return nil
+}
+
+type RemoveNaughtyWordsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Words that were listed.
+	Removed       int32 `protobuf:"varint,1,opt,name=removed,proto3" json:"removed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveNaughtyWordsResponse) Reset() {
+	*x = RemoveNaughtyWordsResponse{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveNaughtyWordsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveNaughtyWordsResponse) ProtoMessage() {}
+
+func (x *RemoveNaughtyWordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveNaughtyWordsResponse.ProtoReflect.Descriptor instead.
+func (*RemoveNaughtyWordsResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *RemoveNaughtyWordsResponse) GetRemoved() int32 {
+	if x != nil {
+		return x.Removed
+	}
+	return 0
+}
+
+type ImportNaughtyWordsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// LDNOOBW language codes; empty for every language.
+	Languages     []string `protobuf:"bytes,1,rep,name=languages,proto3" json:"languages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportNaughtyWordsRequest) Reset() {
+	*x = ImportNaughtyWordsRequest{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportNaughtyWordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportNaughtyWordsRequest) ProtoMessage() {}
+
+func (x *ImportNaughtyWordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportNaughtyWordsRequest.ProtoReflect.Descriptor instead.
+func (*ImportNaughtyWordsRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ImportNaughtyWordsRequest) GetLanguages() []string {
+	if x != nil {
+		return x.Languages
+	}
+	return nil
+}
+
+type NaughtyWordImport struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Language string                 `protobuf:"bytes,1,opt,name=language,proto3" json:"language,omitempty"`
+	// Words in the downloaded list.
+	Fetched int32 `protobuf:"varint,2,opt,name=fetched,proto3" json:"fetched,omitempty"`
+	// Words that weren't listed before.
+	Added int32 `protobuf:"varint,3,opt,name=added,proto3" json:"added,omitempty"`
+	// Set when the list couldn't be downloaded.
+	Error         string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NaughtyWordImport) Reset() {
+	*x = NaughtyWordImport{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NaughtyWordImport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NaughtyWordImport) ProtoMessage() {}
+
+func (x *NaughtyWordImport) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NaughtyWordImport.ProtoReflect.Descriptor instead.
+func (*NaughtyWordImport) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *NaughtyWordImport) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *NaughtyWordImport) GetFetched() int32 {
+	if x != nil {
+		return x.Fetched
+	}
+	return 0
+}
+
+func (x *NaughtyWordImport) GetAdded() int32 {
+	if x != nil {
+		return x.Added
+	}
+	return 0
+}
+
+func (x *NaughtyWordImport) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ImportNaughtyWordsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Imports       []*NaughtyWordImport   `protobuf:"bytes,1,rep,name=imports,proto3" json:"imports,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportNaughtyWordsResponse) Reset() {
+	*x = ImportNaughtyWordsResponse{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportNaughtyWordsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportNaughtyWordsResponse) ProtoMessage() {}
+
+func (x *ImportNaughtyWordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportNaughtyWordsResponse.ProtoReflect.Descriptor instead.
+func (*ImportNaughtyWordsResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ImportNaughtyWordsResponse) GetImports() []*NaughtyWordImport {
+	if x != nil {
+		return x.Imports
+	}
+	return nil
+}
+
+type ExportNaughtyWordsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only words in this language; empty for all.
+	Language      string `protobuf:"bytes,1,opt,name=language,proto3" json:"language,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportNaughtyWordsRequest) Reset() {
+	*x = ExportNaughtyWordsRequest{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportNaughtyWordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportNaughtyWordsRequest) ProtoMessage() {}
+
+func (x *ExportNaughtyWordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportNaughtyWordsRequest.ProtoReflect.Descriptor instead.
+func (*ExportNaughtyWordsRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ExportNaughtyWordsRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+type ExportNaughtyWordsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportNaughtyWordsResponse) Reset() {
+	*x = ExportNaughtyWordsResponse{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportNaughtyWordsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportNaughtyWordsResponse) ProtoMessage() {}
+
+func (x *ExportNaughtyWordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportNaughtyWordsResponse.ProtoReflect.Descriptor instead.
+func (*ExportNaughtyWordsResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ExportNaughtyWordsResponse) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ExportNaughtyWordsResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ReloadNaughtyWordsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReloadNaughtyWordsRequest) Reset() {
+	*x = ReloadNaughtyWordsRequest{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReloadNaughtyWordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadNaughtyWordsRequest) ProtoMessage() {}
+
+func (x *ReloadNaughtyWordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadNaughtyWordsRequest.ProtoReflect.Descriptor instead.
+func (*ReloadNaughtyWordsRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{93}
+}
+
+type ReloadNaughtyWordsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReloadNaughtyWordsResponse) Reset() {
+	*x = ReloadNaughtyWordsResponse{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReloadNaughtyWordsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadNaughtyWordsResponse) ProtoMessage() {}
+
+func (x *ReloadNaughtyWordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadNaughtyWordsResponse.ProtoReflect.Descriptor instead.
+func (*ReloadNaughtyWordsResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{94}
+}
+
+type TradeLimit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WalletAddress string                 `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
+	// USD notional the wallet can swap in a UTC day; 0 uses the configured limit.
+	DailyLimitUsd float64 `protobuf:"fixed64,2,opt,name=daily_limit_usd,json=dailyLimitUsd,proto3" json:"daily_limit_usd,omitempty"`
+	// USD notional the wallet can swap in seven UTC days; 0 uses the configured limit.
+	WeeklyLimitUsd float64 `protobuf:"fixed64,3,opt,name=weekly_limit_usd,json=weeklyLimitUsd,proto3" json:"weekly_limit_usd,omitempty"`
+	// No limits apply to the wallet.
+	Exempt        bool                   `protobuf:"varint,4,opt,name=exempt,proto3" json:"exempt,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,6,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TradeLimit) Reset() {
+	*x = TradeLimit{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TradeLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TradeLimit) ProtoMessage() {}
+
+func (x *TradeLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TradeLimit.ProtoReflect.Descriptor instead.
+func (*TradeLimit) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *TradeLimit) GetWalletAddress() string {
+	if x != nil {
+		return x.WalletAddress
+	}
+	return ""
+}
+
+func (x *TradeLimit) GetDailyLimitUsd() float64 {
+	if x != nil {
+		return x.DailyLimitUsd
+	}
+	return 0
+}
+
+func (x *TradeLimit) GetWeeklyLimitUsd() float64 {
+	if x != nil {
+		return x.WeeklyLimitUsd
+	}
+	return 0
+}
+
+func (x *TradeLimit) GetExempt() bool {
+	if x != nil {
+		return x.Exempt
+	}
+	return false
+}
+
+func (x *TradeLimit) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *TradeLimit) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *TradeLimit) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type TradeLimitStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WalletAddress string                 `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
+	// Admin override, unset when the configured limits apply.
+	Override *TradeLimit `protobuf:"bytes,2,opt,name=override,proto3,oneof" json:"override,omitempty"`
+	// Limits are enabled and the wallet isn't exempt.
+	Enforced       bool    `protobuf:"varint,3,opt,name=enforced,proto3" json:"enforced,omitempty"`
+	DailyLimitUsd  float64 `protobuf:"fixed64,4,opt,name=daily_limit_usd,json=dailyLimitUsd,proto3" json:"daily_limit_usd,omitempty"`
+	WeeklyLimitUsd float64 `protobuf:"fixed64,5,opt,name=weekly_limit_usd,json=weeklyLimitUsd,proto3" json:"weekly_limit_usd,omitempty"`
+	DailyUsedUsd   float64 `protobuf:"fixed64,6,opt,name=daily_used_usd,json=dailyUsedUsd,proto3" json:"daily_used_usd,omitempty"`
+	WeeklyUsedUsd  float64 `protobuf:"fixed64,7,opt,name=weekly_used_usd,json=weeklyUsedUsd,proto3" json:"weekly_used_usd,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TradeLimitStatus) Reset() {
+	*x = TradeLimitStatus{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TradeLimitStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TradeLimitStatus) ProtoMessage() {}
+
+func (x *TradeLimitStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TradeLimitStatus.ProtoReflect.Descriptor instead.
+func (*TradeLimitStatus) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *TradeLimitStatus) GetWalletAddress() string {
+	if x != nil {
+		return x.WalletAddress
+	}
+	return ""
+}
+
+func (x *TradeLimitStatus) GetOverride() *TradeLimit {
+	if x != nil {
+		return x.Override
+	}
+	return nil
+}
+
+func (x *TradeLimitStatus) GetEnforced() bool {
+	if x != nil {
+		return x.Enforced
+	}
+	return false
+}
+
+func (x *TradeLimitStatus) GetDailyLimitUsd() float64 {
+	if x != nil {
+		return x.DailyLimitUsd
+	}
+	return 0
+}
+
+func (x *TradeLimitStatus) GetWeeklyLimitUsd() float64 {
+	if x != nil {
+		return x.WeeklyLimitUsd
+	}
+	return 0
+}
+
+func (x *TradeLimitStatus) GetDailyUsedUsd() float64 {
+	if x != nil {
+		return x.DailyUsedUsd
+	}
+	return 0
+}
+
+func (x *TradeLimitStatus) GetWeeklyUsedUsd() float64 {
+	if x != nil {
+		return x.WeeklyUsedUsd
+	}
+	return 0
+}
+
+type GetTradeLimitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WalletAddress string                 `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTradeLimitRequest) Reset() {
+	*x = GetTradeLimitRequest{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTradeLimitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTradeLimitRequest) ProtoMessage() {}
+
+func (x *GetTradeLimitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTradeLimitRequest.ProtoReflect.Descriptor instead.
+func (*GetTradeLimitRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *GetTradeLimitRequest) GetWalletAddress() string {
+	if x != nil {
+		return x.WalletAddress
+	}
+	return ""
+}
+
+type GetTradeLimitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *TradeLimitStatus      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTradeLimitResponse) Reset() {
+	*x = GetTradeLimitResponse{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTradeLimitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTradeLimitResponse) ProtoMessage() {}
+
+func (x *GetTradeLimitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTradeLimitResponse.ProtoReflect.Descriptor instead.
+func (*GetTradeLimitResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *GetTradeLimitResponse) GetStatus() *TradeLimitStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type SetTradeLimitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         *TradeLimit            `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTradeLimitRequest) Reset() {
+	*x = SetTradeLimitRequest{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTradeLimitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTradeLimitRequest) ProtoMessage() {}
+
+func (x *SetTradeLimitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTradeLimitRequest.ProtoReflect.Descriptor instead.
+func (*SetTradeLimitRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *SetTradeLimitRequest) GetLimit() *TradeLimit {
+	if x != nil {
+		return x.Limit
+	}
+	return nil
+}
+
+type SetTradeLimitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *TradeLimitStatus      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTradeLimitResponse) Reset() {
+	*x = SetTradeLimitResponse{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTradeLimitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTradeLimitResponse) ProtoMessage() {}
+
+func (x *SetTradeLimitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTradeLimitResponse.ProtoReflect.Descriptor instead.
+func (*SetTradeLimitResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *SetTradeLimitResponse) GetStatus() *TradeLimitStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type DeleteTradeLimitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WalletAddress string                 `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTradeLimitRequest) Reset() {
+	*x = DeleteTradeLimitRequest{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTradeLimitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTradeLimitRequest) ProtoMessage() {}
+
+func (x *DeleteTradeLimitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTradeLimitRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTradeLimitRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *DeleteTradeLimitRequest) GetWalletAddress() string {
+	if x != nil {
+		return x.WalletAddress
+	}
+	return ""
+}
+
+type DeleteTradeLimitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTradeLimitResponse) Reset() {
+	*x = DeleteTradeLimitResponse{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTradeLimitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTradeLimitResponse) ProtoMessage() {}
+
+func (x *DeleteTradeLimitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTradeLimitResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTradeLimitResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{102}
+}
+
+type IconRevalidation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Filter the re-validation was started with.
+	Status  string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Gateway string                 `protobuf:"bytes,3,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	Since   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=since,proto3,oneof" json:"since,omitempty"`
+	Until   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=until,proto3,oneof" json:"until,omitempty"`
+	Limit   int32                  `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Coins read, and of those the ones matching the filter.
+	Scanned int32 `protobuf:"varint,7,opt,name=scanned,proto3" json:"scanned,omitempty"`
+	Matched int32 `protobuf:"varint,8,opt,name=matched,proto3" json:"matched,omitempty"`
+	// Matched coins done so far, by outcome.
+	Processed    int32                  `protobuf:"varint,9,opt,name=processed,proto3" json:"processed,omitempty"`
+	Valid        int32                  `protobuf:"varint,10,opt,name=valid,proto3" json:"valid,omitempty"`
+	Replaced     int32                  `protobuf:"varint,11,opt,name=replaced,proto3" json:"replaced,omitempty"`
+	Placeholders int32                  `protobuf:"varint,12,opt,name=placeholders,proto3" json:"placeholders,omitempty"`
+	Skipped      int32                  `protobuf:"varint,13,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	Failed       int32                  `protobuf:"varint,14,opt,name=failed,proto3" json:"failed,omitempty"`
+	StartedAt    *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	// Unset while running.
+	FinishedAt *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=finished_at,json=finishedAt,proto3,oneof" json:"finished_at,omitempty"`
+	// Why the re-validation stopped early.
+	Error         string `protobuf:"bytes,17,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IconRevalidation) Reset() {
+	*x = IconRevalidation{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IconRevalidation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IconRevalidation) ProtoMessage() {}
+
+func (x *IconRevalidation) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IconRevalidation.ProtoReflect.Descriptor instead.
+func (*IconRevalidation) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *IconRevalidation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *IconRevalidation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *IconRevalidation) GetGateway() string {
+	if x != nil {
+		return x.Gateway
+	}
+	return ""
+}
+
+func (x *IconRevalidation) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *IconRevalidation) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+func (x *IconRevalidation) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *IconRevalidation) GetScanned() int32 {
+	if x != nil {
+		return x.Scanned
+	}
+	return 0
+}
+
+func (x *IconRevalidation) GetMatched() int32 {
+	if x != nil {
+		return x.Matched
+	}
+	return 0
+}
+
+func (x *IconRevalidation) GetProcessed() int32 {
+	if x != nil {
+		return x.Processed
+	}
+	return 0
+}
+
+func (x *IconRevalidation) GetValid() int32 {
+	if x != nil {
+		return x.Valid
+	}
+	return 0
+}
+
+func (x *IconRevalidation) GetReplaced() int32 {
+	if x != nil {
+		return x.Replaced
+	}
+	return 0
+}
+
+func (x *IconRevalidation) GetPlaceholders() int32 {
+	if x != nil {
+		return x.Placeholders
+	}
+	return 0
+}
+
+func (x *IconRevalidation) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *IconRevalidation) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *IconRevalidation) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *IconRevalidation) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+func (x *IconRevalidation) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type RevalidateIconsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "broken" (default): no icon, a placeholder or an icon not stored by the image proxy;
+	// "placeholder": on a generated placeholder; "all": every coin, checking stored icons in place.
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// Only icons whose source URL contains this, e.g. "gateway.pinata.cloud".
+	Gateway string `protobuf:"bytes,2,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	// Only coins created in [since, until).
+	Since *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=since,proto3,oneof" json:"since,omitempty"`
+	Until *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=until,proto3,oneof" json:"until,omitempty"`
+	// At most this many coins; 0 for every matching coin.
+	Limit         int32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevalidateIconsRequest) Reset() {
+	*x = RevalidateIconsRequest{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevalidateIconsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevalidateIconsRequest) ProtoMessage() {}
+
+func (x *RevalidateIconsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevalidateIconsRequest.ProtoReflect.Descriptor instead.
+func (*RevalidateIconsRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *RevalidateIconsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RevalidateIconsRequest) GetGateway() string {
+	if x != nil {
+		return x.Gateway
+	}
+	return ""
+}
+
+func (x *RevalidateIconsRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *RevalidateIconsRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+func (x *RevalidateIconsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type RevalidateIconsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revalidation  *IconRevalidation      `protobuf:"bytes,1,opt,name=revalidation,proto3" json:"revalidation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevalidateIconsResponse) Reset() {
+	*x = RevalidateIconsResponse{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevalidateIconsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevalidateIconsResponse) ProtoMessage() {}
+
+func (x *RevalidateIconsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevalidateIconsResponse.ProtoReflect.Descriptor instead.
+func (*RevalidateIconsResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *RevalidateIconsResponse) GetRevalidation() *IconRevalidation {
+	if x != nil {
+		return x.Revalidation
+	}
+	return nil
+}
+
+type GetIconRevalidationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIconRevalidationRequest) Reset() {
+	*x = GetIconRevalidationRequest{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIconRevalidationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIconRevalidationRequest) ProtoMessage() {}
+
+func (x *GetIconRevalidationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIconRevalidationRequest.ProtoReflect.Descriptor instead.
+func (*GetIconRevalidationRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *GetIconRevalidationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetIconRevalidationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revalidation  *IconRevalidation      `protobuf:"bytes,1,opt,name=revalidation,proto3" json:"revalidation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIconRevalidationResponse) Reset() {
+	*x = GetIconRevalidationResponse{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIconRevalidationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIconRevalidationResponse) ProtoMessage() {}
+
+func (x *GetIconRevalidationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIconRevalidationResponse.ProtoReflect.Descriptor instead.
+func (*GetIconRevalidationResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *GetIconRevalidationResponse) GetRevalidation() *IconRevalidation {
+	if x != nil {
+		return x.Revalidation
+	}
+	return nil
+}
+
+type DeleteRecordRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "coin", "burn_watch", "trade", "wallet" or "webhook_subscription".
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// Coin address, burn watch mint, trade ID, wallet public key or webhook subscription ID.
+	Key           string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	DeletedBy     string `protobuf:"bytes,3,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	Reason        string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRecordRequest) Reset() {
+	*x = DeleteRecordRequest{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRecordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRecordRequest) ProtoMessage() {}
+
+func (x *DeleteRecordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRecordRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRecordRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *DeleteRecordRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *DeleteRecordRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *DeleteRecordRequest) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+func (x *DeleteRecordRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type DeleteRecordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRecordResponse) Reset() {
+	*x = DeleteRecordResponse{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRecordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRecordResponse) ProtoMessage() {}
+
+func (x *DeleteRecordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRecordResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRecordResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{109}
+}
+
+type RestoreRecordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreRecordRequest) Reset() {
+	*x = RestoreRecordRequest{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreRecordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreRecordRequest) ProtoMessage() {}
+
+func (x *RestoreRecordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreRecordRequest.ProtoReflect.Descriptor instead.
+func (*RestoreRecordRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *RestoreRecordRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *RestoreRecordRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type RestoreRecordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreRecordResponse) Reset() {
+	*x = RestoreRecordResponse{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreRecordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreRecordResponse) ProtoMessage() {}
+
+func (x *RestoreRecordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreRecordResponse.ProtoReflect.Descriptor instead.
+func (*RestoreRecordResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{111}
+}
+
+type DeletedRecord struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Kind  string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Key   string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// Human-readable summary, e.g. a coin's symbol.
+	Label     string                 `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	DeletedBy string                 `protobuf:"bytes,5,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	Reason    string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	// When the record is purged for good.
+	PurgeAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=purge_at,json=purgeAt,proto3" json:"purge_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletedRecord) Reset() {
+	*x = DeletedRecord{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletedRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletedRecord) ProtoMessage() {}
+
+func (x *DeletedRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletedRecord.ProtoReflect.Descriptor instead.
+func (*DeletedRecord) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *DeletedRecord) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *DeletedRecord) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *DeletedRecord) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *DeletedRecord) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *DeletedRecord) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+func (x *DeletedRecord) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *DeletedRecord) GetPurgeAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PurgeAt
+	}
+	return nil
+}
+
+type ListDeletedRecordsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Kind  string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// Defaults to 50, at most 500.
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedRecordsRequest) Reset() {
+	*x = ListDeletedRecordsRequest{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedRecordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedRecordsRequest) ProtoMessage() {}
+
+func (x *ListDeletedRecordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedRecordsRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedRecordsRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *ListDeletedRecordsRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ListDeletedRecordsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListDeletedRecordsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListDeletedRecordsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Records       []*DeletedRecord       `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedRecordsResponse) Reset() {
+	*x = ListDeletedRecordsResponse{}
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedRecordsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedRecordsResponse) ProtoMessage() {}
+
+func (x *ListDeletedRecordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_admin_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedRecordsResponse.ProtoReflect.Descriptor instead.
+func (*ListDeletedRecordsResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_admin_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *ListDeletedRecordsResponse) GetRecords() []*DeletedRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+func (x *ListDeletedRecordsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+var File_dankfolio_v1_admin_proto protoreflect.FileDescriptor
+
+const file_dankfolio_v1_admin_proto_rawDesc = "" +
+	"\n" +
+	"\x18dankfolio/v1/admin.proto\x12\fdankfolio.v1\x1a\x1fdankfolio/v1/announcement.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb0\x02\n" +
+	"\aSetting\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05value\x18\x04 \x01(\tR\x05value\x12#\n" +
+	"\rdefault_value\x18\x05 \x01(\tR\fdefaultValue\x12\x1e\n" +
+	"\n" +
+	"overridden\x18\x06 \x01(\bR\n" +
+	"overridden\x12>\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x00R\tupdatedAt\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"updated_by\x18\b \x01(\tH\x01R\tupdatedBy\x88\x01\x01B\r\n" +
+	"\v_updated_atB\r\n" +
+	"\v_updated_by\"\x15\n" +
+	"\x13ListSettingsRequest\"I\n" +
+	"\x14ListSettingsResponse\x121\n" +
+	"\bsettings\x18\x01 \x03(\v2\x15.dankfolio.v1.SettingR\bsettings\"_\n" +
+	"\x14UpdateSettingRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x03 \x01(\tR\tupdatedBy\"H\n" +
+	"\x15UpdateSettingResponse\x12/\n" +
+	"\asetting\x18\x01 \x01(\v2\x15.dankfolio.v1.SettingR\asetting\")\n" +
+	"\x13ResetSettingRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"G\n" +
+	"\x14ResetSettingResponse\x12/\n" +
+	"\asetting\x18\x01 \x01(\v2\x15.dankfolio.v1.SettingR\asetting\"\xde\x01\n" +
+	"\vFeatureFlag\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x18\n" +
+	"\aenabled\x18\x03 \x01(\bR\aenabled\x12'\n" +
+	"\x0frollout_percent\x18\x04 \x01(\x05R\x0erolloutPercent\x12\x1d\n" +
+	"\n" +
+	"allow_list\x18\x05 \x03(\tR\tallowList\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x19\n" +
+	"\x17ListFeatureFlagsRequest\"K\n" +
+	"\x18ListFeatureFlagsResponse\x12/\n" +
+	"\x05flags\x18\x01 \x03(\v2\x19.dankfolio.v1.FeatureFlagR\x05flags\"F\n" +
+	"\x15SetFeatureFlagRequest\x12-\n" +
+	"\x04flag\x18\x01 \x01(\v2\x19.dankfolio.v1.FeatureFlagR\x04flag\"G\n" +
+	"\x16SetFeatureFlagResponse\x12-\n" +
+	"\x04flag\x18\x01 \x01(\v2\x19.dankfolio.v1.FeatureFlagR\x04flag\",\n" +
+	"\x18DeleteFeatureFlagRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"\x1b\n" +
+	"\x19DeleteFeatureFlagResponse\"\xab\x01\n" +
+	"\tSpamToken\x12\x12\n" +
+	"\x04mint\x18\x01 \x01(\tR\x04mint\x12\x18\n" +
+	"\averdict\x18\x02 \x01(\tR\averdict\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x04 \x01(\tR\tupdatedBy\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x17\n" +
+	"\x15ListSpamTokensRequest\"I\n" +
+	"\x16ListSpamTokensResponse\x12/\n" +
+	"\x06tokens\x18\x01 \x03(\v2\x17.dankfolio.v1.SpamTokenR\x06tokens\"D\n" +
+	"\x13SetSpamTokenRequest\x12-\n" +
+	"\x05token\x18\x01 \x01(\v2\x17.dankfolio.v1.SpamTokenR\x05token\"E\n" +
+	"\x14SetSpamTokenResponse\x12-\n" +
+	"\x05token\x18\x01 \x01(\v2\x17.dankfolio.v1.SpamTokenR\x05token\",\n" +
+	"\x16DeleteSpamTokenRequest\x12\x12\n" +
+	"\x04mint\x18\x01 \x01(\tR\x04mint\"\x19\n" +
+	"\x17DeleteSpamTokenResponse\"\xc1\x01\n" +
+	"\vBlockedMint\x12\x12\n" +
+	"\x04mint\x18\x01 \x01(\tR\x04mint\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x14\n" +
+	"\x05allow\x18\x04 \x01(\bR\x05allow\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x05 \x01(\tR\tupdatedBy\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"-\n" +
+	"\x17ListBlockedMintsRequest\x12\x12\n" +
+	"\x04mint\x18\x01 \x01(\tR\x04mint\"i\n" +
+	"\x18ListBlockedMintsResponse\x123\n" +
+	"\aentries\x18\x01 \x03(\v2\x19.dankfolio.v1.BlockedMintR\aentries\x12\x18\n" +
+	"\ablocked\x18\x02 \x01(\bR\ablocked\"~\n" +
+	"\x1bSetBlocklistOverrideRequest\x12\x12\n" +
+	"\x04mint\x18\x01 \x01(\tR\x04mint\x12\x14\n" +
+	"\x05allow\x18\x02 \x01(\bR\x05allow\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x04 \x01(\tR\tupdatedBy\"O\n" +
+	"\x1cSetBlocklistOverrideResponse\x12/\n" +
+	"\x05entry\x18\x01 \x01(\v2\x19.dankfolio.v1.BlockedMintR\x05entry\"4\n" +
+	"\x1eDeleteBlocklistOverrideRequest\x12\x12\n" +
+	"\x04mint\x18\x01 \x01(\tR\x04mint\"!\n" +
+	"\x1fDeleteBlocklistOverrideResponse\"\x16\n" +
+	"\x14SyncBlocklistRequest\"s\n" +
+	"\x13BlocklistSyncResult\x12\x12\n" +
+	"\x04feed\x18\x01 \x01(\tR\x04feed\x12\x18\n" +
+	"\aentries\x18\x02 \x01(\x05R\aentries\x12\x18\n" +
+	"\aremoved\x18\x03 \x01(\x05R\aremoved\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"T\n" +
+	"\x15SyncBlocklistResponse\x12;\n" +
+	"\aresults\x18\x01 \x03(\v2!.dankfolio.v1.BlocklistSyncResultR\aresults\"\xe0\x01\n" +
+	"\x0fCoinDescription\x12!\n" +
+	"\fcoin_address\x18\x01 \x01(\tR\vcoinAddress\x12\x16\n" +
+	"\x06locale\x18\x02 \x01(\tR\x06locale\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x05 \x01(\tR\tupdatedBy\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"@\n" +
+	"\x1bListCoinDescriptionsRequest\x12!\n" +
+	"\fcoin_address\x18\x01 \x01(\tR\vcoinAddress\"a\n" +
+	"\x1cListCoinDescriptionsResponse\x12A\n" +
+	"\fdescriptions\x18\x01 \x03(\v2\x1d.dankfolio.v1.CoinDescriptionR\fdescriptions\"\x97\x01\n" +
+	"\x19SetCoinDescriptionRequest\x12!\n" +
+	"\fcoin_address\x18\x01 \x01(\tR\vcoinAddress\x12\x16\n" +
+	"\x06locale\x18\x02 \x01(\tR\x06locale\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x04 \x01(\tR\tupdatedBy\"]\n" +
+	"\x1aSetCoinDescriptionResponse\x12?\n" +
+	"\vdescription\x18\x01 \x01(\v2\x1d.dankfolio.v1.CoinDescriptionR\vdescription\"Y\n" +
+	"\x1cDeleteCoinDescriptionRequest\x12!\n" +
+	"\fcoin_address\x18\x01 \x01(\tR\vcoinAddress\x12\x16\n" +
+	"\x06locale\x18\x02 \x01(\tR\x06locale\"\x1f\n" +
+	"\x1dDeleteCoinDescriptionResponse\"\xcb\x01\n" +
+	"\tSlowQuery\x12\x10\n" +
+	"\x03sql\x18\x01 \x01(\tR\x03sql\x12\x14\n" +
+	"\x05table\x18\x02 \x01(\tR\x05table\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x03R\x05count\x12\x19\n" +
+	"\btotal_ms\x18\x04 \x01(\x01R\atotalMs\x12\x15\n" +
+	"\x06avg_ms\x18\x05 \x01(\x01R\x05avgMs\x12\x15\n" +
+	"\x06max_ms\x18\x06 \x01(\x01R\x05maxMs\x127\n" +
+	"\tlast_seen\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\blastSeen\"D\n" +
+	"\x16ListSlowQueriesRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x14\n" +
+	"\x05reset\x18\x02 \x01(\bR\x05reset\"o\n" +
+	"\x17ListSlowQueriesResponse\x121\n" +
+	"\aqueries\x18\x01 \x03(\v2\x17.dankfolio.v1.SlowQueryR\aqueries\x12!\n" +
+	"\fthreshold_ms\x18\x02 \x01(\x01R\vthresholdMs\"\xcb\x01\n" +
+	"\x1cBroadcastNotificationRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x02 \x01(\tR\x04body\x12H\n" +
+	"\x04data\x18\x03 \x03(\v24.dankfolio.v1.BroadcastNotificationRequest.DataEntryR\x04data\x1a7\n" +
+	"\tDataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x85\x01\n" +
+	"\x1dBroadcastNotificationResponse\x12\x18\n" +
+	"\awallets\x18\x01 \x01(\x05R\awallets\x12\x12\n" +
+	"\x04sent\x18\x02 \x01(\x05R\x04sent\x12\x1e\n" +
+	"\n" +
+	"suppressed\x18\x03 \x01(\x05R\n" +
+	"suppressed\x12\x16\n" +
+	"\x06failed\x18\x04 \x01(\x05R\x06failed\"\x90\x02\n" +
+	"\x14NotificationDelivery\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12%\n" +
+	"\x0ewallet_address\x18\x02 \x01(\tR\rwalletAddress\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x05 \x01(\tR\x04body\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x16\n" +
+	"\x06detail\x18\a \x01(\tR\x06detail\x12\x18\n" +
+	"\adevices\x18\b \x01(\x05R\adevices\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x8c\x01\n" +
+	"!ListNotificationDeliveriesRequest\x12%\n" +
+	"\x0ewallet_address\x18\x01 \x01(\tR\rwalletAddress\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"h\n" +
+	"\"ListNotificationDeliveriesResponse\x12B\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2\".dankfolio.v1.NotificationDeliveryR\n" +
+	"deliveries\"\xbd\x02\n" +
+	"\x13AnnouncementContent\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x02 \x01(\tR\x04body\x12\x1a\n" +
+	"\bseverity\x18\x03 \x01(\tR\bseverity\x12&\n" +
+	"\x0fmin_app_version\x18\x04 \x01(\tR\rminAppVersion\x12&\n" +
+	"\x0fmax_app_version\x18\x05 \x01(\tR\rmaxAppVersion\x12<\n" +
+	"\tstarts_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\bstartsAt\x88\x01\x01\x128\n" +
+	"\aends_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x06endsAt\x88\x01\x01B\f\n" +
+	"\n" +
+	"_starts_atB\n" +
+	"\n" +
+	"\b_ends_at\"\x1d\n" +
+	"\x1bListAllAnnouncementsRequest\"`\n" +
+	"\x1cListAllAnnouncementsResponse\x12@\n" +
+	"\rannouncements\x18\x01 \x03(\v2\x1a.dankfolio.v1.AnnouncementR\rannouncements\"w\n" +
+	"\x19CreateAnnouncementRequest\x12;\n" +
+	"\acontent\x18\x01 \x01(\v2!.dankfolio.v1.AnnouncementContentR\acontent\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x02 \x01(\tR\tcreatedBy\"\\\n" +
+	"\x1aCreateAnnouncementResponse\x12>\n" +
+	"\fannouncement\x18\x01 \x01(\v2\x1a.dankfolio.v1.AnnouncementR\fannouncement\"h\n" +
+	"\x19UpdateAnnouncementRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12;\n" +
+	"\acontent\x18\x02 \x01(\v2!.dankfolio.v1.AnnouncementContentR\acontent\"\\\n" +
+	"\x1aUpdateAnnouncementResponse\x12>\n" +
+	"\fannouncement\x18\x01 \x01(\v2\x1a.dankfolio.v1.AnnouncementR\fannouncement\"+\n" +
+	"\x19DeleteAnnouncementRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x1c\n" +
+	"\x1aDeleteAnnouncementResponse\"\xa0\x02\n" +
+	"\x06JobRun\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03job\x18\x02 \x01(\tR\x03job\x12\x18\n" +
+	"\aattempt\x18\x03 \x01(\x05R\aattempt\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\x129\n" +
+	"\n" +
+	"started_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12@\n" +
+	"\vfinished_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
+	"finishedAt\x88\x01\x01\x12\x1f\n" +
+	"\vduration_ms\x18\b \x01(\x01R\n" +
+	"durationMsB\x0e\n" +
+	"\f_finished_at\"T\n" +
+	"\x12ListJobRunsRequest\x12\x10\n" +
+	"\x03job\x18\x01 \x01(\tR\x03job\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"?\n" +
+	"\x13ListJobRunsResponse\x12(\n" +
+	"\x04runs\x18\x01 \x03(\v2\x14.dankfolio.v1.JobRunR\x04runs\"B\n" +
+	"\x1cGetOperationsOverviewRequest\x12\"\n" +
+	"\rjob_run_limit\x18\x01 \x01(\x05R\vjobRunLimit\"\x86\x01\n" +
+	"\x14UpstreamServiceStats\x12\x18\n" +
+	"\aservice\x18\x01 \x01(\tR\aservice\x12\x14\n" +
+	"\x05calls\x18\x02 \x01(\x03R\x05calls\x12\x16\n" +
+	"\x06errors\x18\x03 \x01(\x03R\x06errors\x12&\n" +
+	"\x0favg_duration_ms\x18\x04 \x01(\x01R\ravgDurationMs\"i\n" +
+	"\n" +
+	"CacheStats\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04hits\x18\x02 \x01(\x04R\x04hits\x12\x16\n" +
+	"\x06misses\x18\x03 \x01(\x04R\x06misses\x12\x1b\n" +
+	"\thit_ratio\x18\x04 \x01(\x01R\bhitRatio\"\x9a\x01\n" +
+	"\x11RPCEndpointStatus\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\ahealthy\x18\x02 \x01(\bR\ahealthy\x121\n" +
+	"\x14consecutive_failures\x18\x03 \x01(\x05R\x13consecutiveFailures\x12$\n" +
+	"\x0eavg_latency_ms\x18\x04 \x01(\x01R\favgLatencyMs\"W\n" +
+	"\n" +
+	"FeeBalance\x12\x12\n" +
+	"\x04mint\x18\x01 \x01(\tR\x04mint\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\x1d\n" +
+	"\n" +
+	"raw_amount\x18\x03 \x01(\tR\trawAmount\"\xf8\x03\n" +
+	"\x1dGetOperationsOverviewResponse\x12O\n" +
+	"\x11upstream_services\x18\x01 \x03(\v2\".dankfolio.v1.UpstreamServiceStatsR\x10upstreamServices\x120\n" +
+	"\x06caches\x18\x02 \x03(\v2\x18.dankfolio.v1.CacheStatsR\x06caches\x12<\n" +
+	"\x0frecent_job_runs\x18\x03 \x03(\v2\x14.dankfolio.v1.JobRunR\rrecentJobRuns\x12D\n" +
+	"\rrpc_endpoints\x18\x04 \x03(\v2\x1f.dankfolio.v1.RPCEndpointStatusR\frpcEndpoints\x12+\n" +
+	"\x11enrichment_queued\x18\x05 \x01(\x03R\x10enrichmentQueued\x12-\n" +
+	"\x12enrichment_running\x18\x06 \x01(\x03R\x11enrichmentRunning\x12\x1f\n" +
+	"\vfee_account\x18\a \x01(\tR\n" +
+	"feeAccount\x12;\n" +
+	"\ffee_balances\x18\b \x03(\v2\x18.dankfolio.v1.FeeBalanceR\vfeeBalances\x12\x16\n" +
+	"\x06errors\x18\t \x03(\tR\x06errors\"\xe0\x01\n" +
+	"\fCoinRevision\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fcoin_address\x18\x02 \x01(\tR\vcoinAddress\x12\x14\n" +
+	"\x05field\x18\x03 \x01(\tR\x05field\x12\x1a\n" +
+	"\bprevious\x18\x04 \x01(\tR\bprevious\x12\x18\n" +
+	"\acurrent\x18\x05 \x01(\tR\acurrent\x12\x16\n" +
+	"\x06source\x18\x06 \x01(\tR\x06source\x129\n" +
+	"\n" +
+	"changed_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tchangedAt\"f\n" +
+	"\x15GetCoinHistoryRequest\x12!\n" +
+	"\fcoin_address\x18\x01 \x01(\tR\vcoinAddress\x12\x14\n" +
+	"\x05field\x18\x02 \x01(\tR\x05field\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"R\n" +
+	"\x16GetCoinHistoryResponse\x128\n" +
+	"\trevisions\x18\x01 \x03(\v2\x1a.dankfolio.v1.CoinRevisionR\trevisions\"\xb7\x01\n" +
+	"\fCoinOverride\x12!\n" +
+	"\fcoin_address\x18\x01 \x01(\tR\vcoinAddress\x12\x14\n" +
+	"\x05field\x18\x02 \x01(\tR\x05field\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x04 \x01(\tR\tupdatedBy\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"=\n" +
+	"\x18ListCoinOverridesRequest\x12!\n" +
+	"\fcoin_address\x18\x01 \x01(\tR\vcoinAddress\"\xf6\x01\n" +
+	"\x19ListCoinOverridesResponse\x128\n" +
+	"\toverrides\x18\x01 \x03(\v2\x1a.dankfolio.v1.CoinOverrideR\toverrides\x12^\n" +
+	"\rfield_sources\x18\x02 \x03(\v29.dankfolio.v1.ListCoinOverridesResponse.FieldSourcesEntryR\ffieldSources\x1a?\n" +
+	"\x11FieldSourcesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x86\x01\n" +
+	"\x16SetCoinOverrideRequest\x12!\n" +
+	"\fcoin_address\x18\x01 \x01(\tR\vcoinAddress\x12\x14\n" +
+	"\x05field\x18\x02 \x01(\tR\x05field\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x04 \x01(\tR\tupdatedBy\"Q\n" +
+	"\x17SetCoinOverrideResponse\x126\n" +
+	"\boverride\x18\x01 \x01(\v2\x1a.dankfolio.v1.CoinOverrideR\boverride\"T\n" +
+	"\x19DeleteCoinOverrideRequest\x12!\n" +
+	"\fcoin_address\x18\x01 \x01(\tR\vcoinAddress\x12\x14\n" +
+	"\x05field\x18\x02 \x01(\tR\x05field\"\x1c\n" +
+	"\x1aDeleteCoinOverrideResponse\"\x95\x01\n" +
+	"\rSearchSynonym\x12\x12\n" +
+	"\x04term\x18\x01 \x01(\tR\x04term\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x03 \x01(\tR\tupdatedBy\x129\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x1b\n" +
+	"\x19ListSearchSynonymsRequest\"U\n" +
+	"\x1aListSearchSynonymsResponse\x127\n" +
+	"\bsynonyms\x18\x01 \x03(\v2\x1b.dankfolio.v1.SearchSynonymR\bsynonyms\"d\n" +
+	"\x17SetSearchSynonymRequest\x12\x12\n" +
+	"\x04term\x18\x01 \x01(\tR\x04term\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x03 \x01(\tR\tupdatedBy\"Q\n" +
+	"\x18SetSearchSynonymResponse\x125\n" +
+	"\asynonym\x18\x01 \x01(\v2\x1b.dankfolio.v1.SearchSynonymR\asynonym\"0\n" +
+	"\x1aDeleteSearchSynonymRequest\x12\x12\n" +
+	"\x04term\x18\x01 \x01(\tR\x04term\"\x1d\n" +
+	"\x1bDeleteSearchSynonymResponse\"=\n" +
+	"\vNaughtyWord\x12\x12\n" +
+	"\x04word\x18\x01 \x01(\tR\x04word\x12\x1a\n" +
+	"\blanguage\x18\x02 \x01(\tR\blanguage\"y\n" +
+	"\x17ListNaughtyWordsRequest\x12\x1a\n" +
+	"\blanguage\x18\x01 \x01(\tR\blanguage\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\"a\n" +
+	"\x18ListNaughtyWordsResponse\x12/\n" +
+	"\x05words\x18\x01 \x03(\v2\x19.dankfolio.v1.NaughtyWordR\x05words\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"J\n" +
+	"\x16AddNaughtyWordsRequest\x12\x14\n" +
+	"\x05words\x18\x01 \x03(\tR\x05words\x12\x1a\n" +
+	"\blanguage\x18\x02 \x01(\tR\blanguage\"/\n" +
+	"\x17AddNaughtyWordsResponse\x12\x14\n" +
+	"\x05added\x18\x01 \x01(\x05R\x05added\"1\n" +
+	"\x19RemoveNaughtyWordsRequest\x12\x14\n" +
+	"\x05words\x18\x01 \x03(\tR\x05words\"6\n" +
+	"\x1aRemoveNaughtyWordsResponse\x12\x18\n" +
+	"\aremoved\x18\x01 \x01(\x05R\aremoved\"9\n" +
+	"\x19ImportNaughtyWordsRequest\x12\x1c\n" +
+	"\tlanguages\x18\x01 \x03(\tR\tlanguages\"u\n" +
+	"\x11NaughtyWordImport\x12\x1a\n" +
+	"\blanguage\x18\x01 \x01(\tR\blanguage\x12\x18\n" +
+	"\afetched\x18\x02 \x01(\x05R\afetched\x12\x14\n" +
+	"\x05added\x18\x03 \x01(\x05R\x05added\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"W\n" +
+	"\x1aImportNaughtyWordsResponse\x129\n" +
+	"\aimports\x18\x01 \x03(\v2\x1f.dankfolio.v1.NaughtyWordImportR\aimports\"7\n" +
+	"\x19ExportNaughtyWordsRequest\x12\x1a\n" +
+	"\blanguage\x18\x01 \x01(\tR\blanguage\"F\n" +
+	"\x1aExportNaughtyWordsResponse\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"\x1b\n" +
+	"\x19ReloadNaughtyWordsRequest\"\x1c\n" +
+	"\x1aReloadNaughtyWordsResponse\"\x8f\x02\n" +
+	"\n" +
+	"TradeLimit\x12%\n" +
+	"\x0ewallet_address\x18\x01 \x01(\tR\rwalletAddress\x12&\n" +
+	"\x0fdaily_limit_usd\x18\x02 \x01(\x01R\rdailyLimitUsd\x12(\n" +
+	"\x10weekly_limit_usd\x18\x03 \x01(\x01R\x0eweeklyLimitUsd\x12\x16\n" +
+	"\x06exempt\x18\x04 \x01(\bR\x06exempt\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x06 \x01(\tR\tupdatedBy\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xbd\x02\n" +
+	"\x10TradeLimitStatus\x12%\n" +
+	"\x0ewallet_address\x18\x01 \x01(\tR\rwalletAddress\x129\n" +
+	"\boverride\x18\x02 \x01(\v2\x18.dankfolio.v1.TradeLimitH\x00R\boverride\x88\x01\x01\x12\x1a\n" +
+	"\benforced\x18\x03 \x01(\bR\benforced\x12&\n" +
+	"\x0fdaily_limit_usd\x18\x04 \x01(\x01R\rdailyLimitUsd\x12(\n" +
+	"\x10weekly_limit_usd\x18\x05 \x01(\x01R\x0eweeklyLimitUsd\x12$\n" +
+	"\x0edaily_used_usd\x18\x06 \x01(\x01R\fdailyUsedUsd\x12&\n" +
+	"\x0fweekly_used_usd\x18\a \x01(\x01R\rweeklyUsedUsdB\v\n" +
+	"\t_override\"=\n" +
+	"\x14GetTradeLimitRequest\x12%\n" +
+	"\x0ewallet_address\x18\x01 \x01(\tR\rwalletAddress\"O\n" +
+	"\x15GetTradeLimitResponse\x126\n" +
+	"\x06status\x18\x01 \x01(\v2\x1e.dankfolio.v1.TradeLimitStatusR\x06status\"F\n" +
+	"\x14SetTradeLimitRequest\x12.\n" +
+	"\x05limit\x18\x01 \x01(\v2\x18.dankfolio.v1.TradeLimitR\x05limit\"O\n" +
+	"\x15SetTradeLimitResponse\x126\n" +
+	"\x06status\x18\x01 \x01(\v2\x1e.dankfolio.v1.TradeLimitStatusR\x06status\"@\n" +
+	"\x17DeleteTradeLimitRequest\x12%\n" +
+	"\x0ewallet_address\x18\x01 \x01(\tR\rwalletAddress\"\x1a\n" +
+	"\x18DeleteTradeLimitResponse\"\xe9\x04\n" +
+	"\x10IconRevalidation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x18\n" +
+	"\agateway\x18\x03 \x01(\tR\agateway\x125\n" +
+	"\x05since\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x05since\x88\x01\x01\x125\n" +
+	"\x05until\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x05until\x88\x01\x01\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x05R\x05limit\x12\x18\n" +
+	"\ascanned\x18\a \x01(\x05R\ascanned\x12\x18\n" +
+	"\amatched\x18\b \x01(\x05R\amatched\x12\x1c\n" +
+	"\tprocessed\x18\t \x01(\x05R\tprocessed\x12\x14\n" +
+	"\x05valid\x18\n" +
+	" \x01(\x05R\x05valid\x12\x1a\n" +
+	"\breplaced\x18\v \x01(\x05R\breplaced\x12\"\n" +
+	"\fplaceholders\x18\f \x01(\x05R\fplaceholders\x12\x18\n" +
+	"\askipped\x18\r \x01(\x05R\askipped\x12\x16\n" +
+	"\x06failed\x18\x0e \x01(\x05R\x06failed\x129\n" +
+	"\n" +
+	"started_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12@\n" +
+	"\vfinished_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\n" +
+	"finishedAt\x88\x01\x01\x12\x14\n" +
+	"\x05error\x18\x11 \x01(\tR\x05errorB\b\n" +
+	"\x06_sinceB\b\n" +
+	"\x06_untilB\x0e\n" +
+	"\f_finished_at\"\xe2\x01\n" +
+	"\x16RevalidateIconsRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x18\n" +
+	"\agateway\x18\x02 \x01(\tR\agateway\x125\n" +
+	"\x05since\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x05since\x88\x01\x01\x125\n" +
+	"\x05until\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x05until\x88\x01\x01\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limitB\b\n" +
+	"\x06_sinceB\b\n" +
+	"\x06_until\"]\n" +
+	"\x17RevalidateIconsResponse\x12B\n" +
+	"\frevalidation\x18\x01 \x01(\v2\x1e.dankfolio.v1.IconRevalidationR\frevalidation\",\n" +
+	"\x1aGetIconRevalidationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"a\n" +
+	"\x1bGetIconRevalidationResponse\x12B\n" +
+	"\frevalidation\x18\x01 \x01(\v2\x1e.dankfolio.v1.IconRevalidationR\frevalidation\"r\n" +
+	"\x13DeleteRecordRequest\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\x03 \x01(\tR\tdeletedBy\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\x16\n" +
+	"\x14DeleteRecordResponse\"<\n" +
+	"\x14RestoreRecordRequest\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\"\x17\n" +
+	"\x15RestoreRecordResponse\"\xf4\x01\n" +
+	"\rDeletedRecord\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\x129\n" +
+	"\n" +
+	"deleted_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\x05 \x01(\tR\tdeletedBy\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x125\n" +
+	"\bpurge_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\apurgeAt\"]\n" +
+	"\x19ListDeletedRecordsRequest\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\"i\n" +
+	"\x1aListDeletedRecordsResponse\x125\n" +
+	"\arecords\x18\x01 \x03(\v2\x1b.dankfolio.v1.DeletedRecordR\arecords\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total2\xa9$\n" +
+	"\fAdminService\x12U\n" +
+	"\fListSettings\x12!.dankfolio.v1.ListSettingsRequest\x1a\".dankfolio.v1.ListSettingsResponse\x12X\n" +
+	"\rUpdateSetting\x12\".dankfolio.v1.UpdateSettingRequest\x1a#.dankfolio.v1.UpdateSettingResponse\x12U\n" +
+	"\fResetSetting\x12!.dankfolio.v1.ResetSettingRequest\x1a\".dankfolio.v1.ResetSettingResponse\x12a\n" +
+	"\x10ListFeatureFlags\x12%.dankfolio.v1.ListFeatureFlagsRequest\x1a&.dankfolio.v1.ListFeatureFlagsResponse\x12[\n" +
+	"\x0eSetFeatureFlag\x12#.dankfolio.v1.SetFeatureFlagRequest\x1a$.dankfolio.v1.SetFeatureFlagResponse\x12d\n" +
+	"\x11DeleteFeatureFlag\x12&.dankfolio.v1.DeleteFeatureFlagRequest\x1a'.dankfolio.v1.DeleteFeatureFlagResponse\x12[\n" +
+	"\x0eListSpamTokens\x12#.dankfolio.v1.ListSpamTokensRequest\x1a$.dankfolio.v1.ListSpamTokensResponse\x12U\n" +
+	"\fSetSpamToken\x12!.dankfolio.v1.SetSpamTokenRequest\x1a\".dankfolio.v1.SetSpamTokenResponse\x12^\n" +
+	"\x0fDeleteSpamToken\x12$.dankfolio.v1.DeleteSpamTokenRequest\x1a%.dankfolio.v1.DeleteSpamTokenResponse\x12a\n" +
+	"\x10ListBlockedMints\x12%.dankfolio.v1.ListBlockedMintsRequest\x1a&.dankfolio.v1.ListBlockedMintsResponse\x12m\n" +
+	"\x14SetBlocklistOverride\x12).dankfolio.v1.SetBlocklistOverrideRequest\x1a*.dankfolio.v1.SetBlocklistOverrideResponse\x12v\n" +
+	"\x17DeleteBlocklistOverride\x12,.dankfolio.v1.DeleteBlocklistOverrideRequest\x1a-.dankfolio.v1.DeleteBlocklistOverrideResponse\x12X\n" +
+	"\rSyncBlocklist\x12\".dankfolio.v1.SyncBlocklistRequest\x1a#.dankfolio.v1.SyncBlocklistResponse\x12m\n" +
+	"\x14ListCoinDescriptions\x12).dankfolio.v1.ListCoinDescriptionsRequest\x1a*.dankfolio.v1.ListCoinDescriptionsResponse\x12g\n" +
+	"\x12SetCoinDescription\x12'.dankfolio.v1.SetCoinDescriptionRequest\x1a(.dankfolio.v1.SetCoinDescriptionResponse\x12p\n" +
+	"\x15DeleteCoinDescription\x12*.dankfolio.v1.DeleteCoinDescriptionRequest\x1a+.dankfolio.v1.DeleteCoinDescriptionResponse\x12^\n" +
+	"\x0fListSlowQueries\x12$.dankfolio.v1.ListSlowQueriesRequest\x1a%.dankfolio.v1.ListSlowQueriesResponse\x12p\n" +
+	"\x15BroadcastNotification\x12*.dankfolio.v1.BroadcastNotificationRequest\x1a+.dankfolio.v1.BroadcastNotificationResponse\x12\x7f\n" +
+	"\x1aListNotificationDeliveries\x12/.dankfolio.v1.ListNotificationDeliveriesRequest\x1a0.dankfolio.v1.ListNotificationDeliveriesResponse\x12m\n" +
+	"\x14ListAllAnnouncements\x12).dankfolio.v1.ListAllAnnouncementsRequest\x1a*.dankfolio.v1.ListAllAnnouncementsResponse\x12g\n" +
+	"\x12CreateAnnouncement\x12'.dankfolio.v1.CreateAnnouncementRequest\x1a(.dankfolio.v1.CreateAnnouncementResponse\x12g\n" +
+	"\x12UpdateAnnouncement\x12'.dankfolio.v1.UpdateAnnouncementRequest\x1a(.dankfolio.v1.UpdateAnnouncementResponse\x12g\n" +
+	"\x12DeleteAnnouncement\x12'.dankfolio.v1.DeleteAnnouncementRequest\x1a(.dankfolio.v1.DeleteAnnouncementResponse\x12R\n" +
+	"\vListJobRuns\x12 .dankfolio.v1.ListJobRunsRequest\x1a!.dankfolio.v1.ListJobRunsResponse\x12p\n" +
+	"\x15GetOperationsOverview\x12*.dankfolio.v1.GetOperationsOverviewRequest\x1a+.dankfolio.v1.GetOperationsOverviewResponse\x12[\n" +
+	"\x0eGetCoinHistory\x12#.dankfolio.v1.GetCoinHistoryRequest\x1a$.dankfolio.v1.GetCoinHistoryResponse\x12d\n" +
+	"\x11ListCoinOverrides\x12&.dankfolio.v1.ListCoinOverridesRequest\x1a'.dankfolio.v1.ListCoinOverridesResponse\x12^\n" +
+	"\x0fSetCoinOverride\x12$.dankfolio.v1.SetCoinOverrideRequest\x1a%.dankfolio.v1.SetCoinOverrideResponse\x12g\n" +
+	"\x12DeleteCoinOverride\x12'.dankfolio.v1.DeleteCoinOverrideRequest\x1a(.dankfolio.v1.DeleteCoinOverrideResponse\x12g\n" +
+	"\x12ListSearchSynonyms\x12'.dankfolio.v1.ListSearchSynonymsRequest\x1a(.dankfolio.v1.ListSearchSynonymsResponse\x12a\n" +
+	"\x10SetSearchSynonym\x12%.dankfolio.v1.SetSearchSynonymRequest\x1a&.dankfolio.v1.SetSearchSynonymResponse\x12j\n" +
+	"\x13DeleteSearchSynonym\x12(.dankfolio.v1.DeleteSearchSynonymRequest\x1a).dankfolio.v1.DeleteSearchSynonymResponse\x12a\n" +
+	"\x10ListNaughtyWords\x12%.dankfolio.v1.ListNaughtyWordsRequest\x1a&.dankfolio.v1.ListNaughtyWordsResponse\x12^\n" +
+	"\x0fAddNaughtyWords\x12$.dankfolio.v1.AddNaughtyWordsRequest\x1a%.dankfolio.v1.AddNaughtyWordsResponse\x12g\n" +
+	"\x12RemoveNaughtyWords\x12'.dankfolio.v1.RemoveNaughtyWordsRequest\x1a(.dankfolio.v1.RemoveNaughtyWordsResponse\x12g\n" +
+	"\x12ImportNaughtyWords\x12'.dankfolio.v1.ImportNaughtyWordsRequest\x1a(.dankfolio.v1.ImportNaughtyWordsResponse\x12g\n" +
+	"\x12ExportNaughtyWords\x12'.dankfolio.v1.ExportNaughtyWordsRequest\x1a(.dankfolio.v1.ExportNaughtyWordsResponse\x12g\n" +
+	"\x12ReloadNaughtyWords\x12'.dankfolio.v1.ReloadNaughtyWordsRequest\x1a(.dankfolio.v1.ReloadNaughtyWordsResponse\x12X\n" +
+	"\rGetTradeLimit\x12\".dankfolio.v1.GetTradeLimitRequest\x1a#.dankfolio.v1.GetTradeLimitResponse\x12X\n" +
+	"\rSetTradeLimit\x12\".dankfolio.v1.SetTradeLimitRequest\x1a#.dankfolio.v1.SetTradeLimitResponse\x12a\n" +
+	"\x10DeleteTradeLimit\x12%.dankfolio.v1.DeleteTradeLimitRequest\x1a&.dankfolio.v1.DeleteTradeLimitResponse\x12^\n" +
+	"\x0fRevalidateIcons\x12$.dankfolio.v1.RevalidateIconsRequest\x1a%.dankfolio.v1.RevalidateIconsResponse\x12j\n" +
+	"\x13GetIconRevalidation\x12(.dankfolio.v1.GetIconRevalidationRequest\x1a).dankfolio.v1.GetIconRevalidationResponse\x12U\n" +
+	"\fDeleteRecord\x12!.dankfolio.v1.DeleteRecordRequest\x1a\".dankfolio.v1.DeleteRecordResponse\x12X\n" +
+	"\rRestoreRecord\x12\".dankfolio.v1.RestoreRecordRequest\x1a#.dankfolio.v1.RestoreRecordResponse\x12g\n" +
+	"\x12ListDeletedRecords\x12'.dankfolio.v1.ListDeletedRecordsRequest\x1a(.dankfolio.v1.ListDeletedRecordsResponseB\xb6\x01\n" +
+	"\x10com.dankfolio.v1B\n" +
+	"AdminProtoP\x01ZEgithub.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1\xa2\x02\x03DXX\xaa\x02\fDankfolio.V1\xca\x02\fDankfolio\\V1\xe2\x02\x18Dankfolio\\V1\\GPBMetadata\xea\x02\rDankfolio::V1b\x06proto3"
+
+var (
+	file_dankfolio_v1_admin_proto_rawDescOnce sync.Once
+	file_dankfolio_v1_admin_proto_rawDescData []byte
+)
+
+func file_dankfolio_v1_admin_proto_rawDescGZIP() []byte {
+	file_dankfolio_v1_admin_proto_rawDescOnce.Do(func() {
+		file_dankfolio_v1_admin_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_dankfolio_v1_admin_proto_rawDesc), len(file_dankfolio_v1_admin_proto_rawDesc)))
+	})
+	return file_dankfolio_v1_admin_proto_rawDescData
+}
+
+var file_dankfolio_v1_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 117)
+var file_dankfolio_v1_admin_proto_goTypes = []any{
+	(*Setting)(nil),                            // 0: dankfolio.v1.Setting
+	(*ListSettingsRequest)(nil),                // 1: dankfolio.v1.ListSettingsRequest
+	(*ListSettingsResponse)(nil),               // 2: dankfolio.v1.ListSettingsResponse
+	(*UpdateSettingRequest)(nil),               // 3: dankfolio.v1.UpdateSettingRequest
+	(*UpdateSettingResponse)(nil),              // 4: dankfolio.v1.UpdateSettingResponse
+	(*ResetSettingRequest)(nil),                // 5: dankfolio.v1.ResetSettingRequest
+	(*ResetSettingResponse)(nil),               // 6: dankfolio.v1.ResetSettingResponse
+	(*FeatureFlag)(nil),                        // 7: dankfolio.v1.FeatureFlag
+	(*ListFeatureFlagsRequest)(nil),            // 8: dankfolio.v1.ListFeatureFlagsRequest
+	(*ListFeatureFlagsResponse)(nil),           // 9: dankfolio.v1.ListFeatureFlagsResponse
+	(*SetFeatureFlagRequest)(nil),              // 10: dankfolio.v1.SetFeatureFlagRequest
+	(*SetFeatureFlagResponse)(nil),             // 11: dankfolio.v1.SetFeatureFlagResponse
+	(*DeleteFeatureFlagRequest)(nil),           // 12: dankfolio.v1.DeleteFeatureFlagRequest
+	(*DeleteFeatureFlagResponse)(nil),          // 13: dankfolio.v1.DeleteFeatureFlagResponse
+	(*SpamToken)(nil),                          // 14: dankfolio.v1.SpamToken
+	(*ListSpamTokensRequest)(nil),              // 15: dankfolio.v1.ListSpamTokensRequest
+	(*ListSpamTokensResponse)(nil),             // 16: dankfolio.v1.ListSpamTokensResponse
+	(*SetSpamTokenRequest)(nil),                // 17: dankfolio.v1.SetSpamTokenRequest
+	(*SetSpamTokenResponse)(nil),               // 18: dankfolio.v1.SetSpamTokenResponse
+	(*DeleteSpamTokenRequest)(nil),             // 19: dankfolio.v1.DeleteSpamTokenRequest
+	(*DeleteSpamTokenResponse)(nil),            // 20: dankfolio.v1.DeleteSpamTokenResponse
+	(*BlockedMint)(nil),                        // 21: dankfolio.v1.BlockedMint
+	(*ListBlockedMintsRequest)(nil),            // 22: dankfolio.v1.ListBlockedMintsRequest
+	(*ListBlockedMintsResponse)(nil),           // 23: dankfolio.v1.ListBlockedMintsResponse
+	(*SetBlocklistOverrideRequest)(nil),        // 24: dankfolio.v1.SetBlocklistOverrideRequest
+	(*SetBlocklistOverrideResponse)(nil),       // 25: dankfolio.v1.SetBlocklistOverrideResponse
+	(*DeleteBlocklistOverrideRequest)(nil),     // 26: dankfolio.v1.DeleteBlocklistOverrideRequest
+	(*DeleteBlocklistOverrideResponse)(nil),    // 27: dankfolio.v1.DeleteBlocklistOverrideResponse
+	(*SyncBlocklistRequest)(nil),               // 28: dankfolio.v1.SyncBlocklistRequest
+	(*BlocklistSyncResult)(nil),                // 29: dankfolio.v1.BlocklistSyncResult
+	(*SyncBlocklistResponse)(nil),              // 30: dankfolio.v1.SyncBlocklistResponse
+	(*CoinDescription)(nil),                    // 31: dankfolio.v1.CoinDescription
+	(*ListCoinDescriptionsRequest)(nil),        // 32: dankfolio.v1.ListCoinDescriptionsRequest
+	(*ListCoinDescriptionsResponse)(nil),       // 33: dankfolio.v1.ListCoinDescriptionsResponse
+	(*SetCoinDescriptionRequest)(nil),          // 34: dankfolio.v1.SetCoinDescriptionRequest
+	(*SetCoinDescriptionResponse)(nil),         // 35: dankfolio.v1.SetCoinDescriptionResponse
+	(*DeleteCoinDescriptionRequest)(nil),       // 36: dankfolio.v1.DeleteCoinDescriptionRequest
+	(*DeleteCoinDescriptionResponse)(nil),      // 37: dankfolio.v1.DeleteCoinDescriptionResponse
+	(*SlowQuery)(nil),                          // 38: dankfolio.v1.SlowQuery
+	(*ListSlowQueriesRequest)(nil),             // 39: dankfolio.v1.ListSlowQueriesRequest
+	(*ListSlowQueriesResponse)(nil),            // 40: dankfolio.v1.ListSlowQueriesResponse
+	(*BroadcastNotificationRequest)(nil),       // 41: dankfolio.v1.BroadcastNotificationRequest
+	(*BroadcastNotificationResponse)(nil),      // 42: dankfolio.v1.BroadcastNotificationResponse
+	(*NotificationDelivery)(nil),               // 43: dankfolio.v1.NotificationDelivery
+	(*ListNotificationDeliveriesRequest)(nil),  // 44: dankfolio.v1.ListNotificationDeliveriesRequest
+	(*ListNotificationDeliveriesResponse)(nil), // 45: dankfolio.v1.ListNotificationDeliveriesResponse
+	(*AnnouncementContent)(nil),                // 46: dankfolio.v1.AnnouncementContent
+	(*ListAllAnnouncementsRequest)(nil),        // 47: dankfolio.v1.ListAllAnnouncementsRequest
+	(*ListAllAnnouncementsResponse)(nil),       // 48: dankfolio.v1.ListAllAnnouncementsResponse
+	(*CreateAnnouncementRequest)(nil),          // 49: dankfolio.v1.CreateAnnouncementRequest
+	(*CreateAnnouncementResponse)(nil),         // 50: dankfolio.v1.CreateAnnouncementResponse
+	(*UpdateAnnouncementRequest)(nil),          // 51: dankfolio.v1.UpdateAnnouncementRequest
+	(*UpdateAnnouncementResponse)(nil),         // 52: dankfolio.v1.UpdateAnnouncementResponse
+	(*DeleteAnnouncementRequest)(nil),          // 53: dankfolio.v1.DeleteAnnouncementRequest
+	(*DeleteAnnouncementResponse)(nil),         // 54: dankfolio.v1.DeleteAnnouncementResponse
+	(*JobRun)(nil),                             // 55: dankfolio.v1.JobRun
+	(*ListJobRunsRequest)(nil),                 // 56: dankfolio.v1.ListJobRunsRequest
+	(*ListJobRunsResponse)(nil),                // 57: dankfolio.v1.ListJobRunsResponse
+	(*GetOperationsOverviewRequest)(nil),       // 58: dankfolio.v1.GetOperationsOverviewRequest
+	(*UpstreamServiceStats)(nil),               // 59: dankfolio.v1.UpstreamServiceStats
+	(*CacheStats)(nil),                         // 60: dankfolio.v1.CacheStats
+	(*RPCEndpointStatus)(nil),                  // 61: dankfolio.v1.RPCEndpointStatus
+	(*FeeBalance)(nil),                         // 62: dankfolio.v1.FeeBalance
+	(*GetOperationsOverviewResponse)(nil),      // 63: dankfolio.v1.GetOperationsOverviewResponse
+	(*CoinRevision)(nil),                       // 64: dankfolio.v1.CoinRevision
+	(*GetCoinHistoryRequest)(nil),              // 65: dankfolio.v1.GetCoinHistoryRequest
+	(*GetCoinHistoryResponse)(nil),             // 66: dankfolio.v1.GetCoinHistoryResponse
+	(*CoinOverride)(nil),                       // 67: dankfolio.v1.CoinOverride
+	(*ListCoinOverridesRequest)(nil),           // 68: dankfolio.v1.ListCoinOverridesRequest
+	(*ListCoinOverridesResponse)(nil),          // 69: dankfolio.v1.ListCoinOverridesResponse
+	(*SetCoinOverrideRequest)(nil),             // 70: dankfolio.v1.SetCoinOverrideRequest
+	(*SetCoinOverrideResponse)(nil),            // 71: dankfolio.v1.SetCoinOverrideResponse
+	(*DeleteCoinOverrideRequest)(nil),          // 72: dankfolio.v1.DeleteCoinOverrideRequest
+	(*DeleteCoinOverrideResponse)(nil),         // 73: dankfolio.v1.DeleteCoinOverrideResponse
+	(*SearchSynonym)(nil),                      // 74: dankfolio.v1.SearchSynonym
+	(*ListSearchSynonymsRequest)(nil),          // 75: dankfolio.v1.ListSearchSynonymsRequest
+	(*ListSearchSynonymsResponse)(nil),         // 76: dankfolio.v1.ListSearchSynonymsResponse
+	(*SetSearchSynonymRequest)(nil),            // 77: dankfolio.v1.SetSearchSynonymRequest
+	(*SetSearchSynonymResponse)(nil),           // 78: dankfolio.v1.SetSearchSynonymResponse
+	(*DeleteSearchSynonymRequest)(nil),         // 79: dankfolio.v1.DeleteSearchSynonymRequest
+	(*DeleteSearchSynonymResponse)(nil),        // 80: dankfolio.v1.DeleteSearchSynonymResponse
+	(*NaughtyWord)(nil),                        // 81: dankfolio.v1.NaughtyWord
+	(*ListNaughtyWordsRequest)(nil),            // 82: dankfolio.v1.ListNaughtyWordsRequest
+	(*ListNaughtyWordsResponse)(nil),           // 83: dankfolio.v1.ListNaughtyWordsResponse
+	(*AddNaughtyWordsRequest)(nil),             // 84: dankfolio.v1.AddNaughtyWordsRequest
+	(*AddNaughtyWordsResponse)(nil),            // 85: dankfolio.v1.AddNaughtyWordsResponse
+	(*RemoveNaughtyWordsRequest)(nil),          // 86: dankfolio.v1.RemoveNaughtyWordsRequest
+	(*RemoveNaughtyWordsResponse)(nil),         // 87: dankfolio.v1.RemoveNaughtyWordsResponse
+	(*ImportNaughtyWordsRequest)(nil),          // 88: dankfolio.v1.ImportNaughtyWordsRequest
+	(*NaughtyWordImport)(nil),                  // 89: dankfolio.v1.NaughtyWordImport
+	(*ImportNaughtyWordsResponse)(nil),         // 90: dankfolio.v1.ImportNaughtyWordsResponse
+	(*ExportNaughtyWordsRequest)(nil),          // 91: dankfolio.v1.ExportNaughtyWordsRequest
+	(*ExportNaughtyWordsResponse)(nil),         // 92: dankfolio.v1.ExportNaughtyWordsResponse
+	(*ReloadNaughtyWordsRequest)(nil),          // 93: dankfolio.v1.ReloadNaughtyWordsRequest
+	(*ReloadNaughtyWordsResponse)(nil),         // 94: dankfolio.v1.ReloadNaughtyWordsResponse
+	(*TradeLimit)(nil),                         // 95: dankfolio.v1.TradeLimit
+	(*TradeLimitStatus)(nil),                   // 96: dankfolio.v1.TradeLimitStatus
+	(*GetTradeLimitRequest)(nil),               // 97: dankfolio.v1.GetTradeLimitRequest
+	(*GetTradeLimitResponse)(nil),              // 98: dankfolio.v1.GetTradeLimitResponse
+	(*SetTradeLimitRequest)(nil),               // 99: dankfolio.v1.SetTradeLimitRequest
+	(*SetTradeLimitResponse)(nil),              // 100: dankfolio.v1.SetTradeLimitResponse
+	(*DeleteTradeLimitRequest)(nil),            // 101: dankfolio.v1.DeleteTradeLimitRequest
+	(*DeleteTradeLimitResponse)(nil),           // 102: dankfolio.v1.DeleteTradeLimitResponse
+	(*IconRevalidation)(nil),                   // 103: dankfolio.v1.IconRevalidation
+	(*RevalidateIconsRequest)(nil),             // 104: dankfolio.v1.RevalidateIconsRequest
+	(*RevalidateIconsResponse)(nil),            // 105: dankfolio.v1.RevalidateIconsResponse
+	(*GetIconRevalidationRequest)(nil),         // 106: dankfolio.v1.GetIconRevalidationRequest
+	(*GetIconRevalidationResponse)(nil),        // 107: dankfolio.v1.GetIconRevalidationResponse
+	(*DeleteRecordRequest)(nil),                // 108: dankfolio.v1.DeleteRecordRequest
+	(*DeleteRecordResponse)(nil),               // 109: dankfolio.v1.DeleteRecordResponse
+	(*RestoreRecordRequest)(nil),               // 110: dankfolio.v1.RestoreRecordRequest
+	(*RestoreRecordResponse)(nil),              // 111: dankfolio.v1.RestoreRecordResponse
+	(*DeletedRecord)(nil),                      // 112: dankfolio.v1.DeletedRecord
+	(*ListDeletedRecordsRequest)(nil),          // 113: dankfolio.v1.ListDeletedRecordsRequest
+	(*ListDeletedRecordsResponse)(nil),         // 114: dankfolio.v1.ListDeletedRecordsResponse
+	nil,                                        // 115: dankfolio.v1.BroadcastNotificationRequest.DataEntry
+	nil,                                        // 116: dankfolio.v1.ListCoinOverridesResponse.FieldSourcesEntry
+	(*timestamppb.Timestamp)(nil),              // 117: google.protobuf.Timestamp
+	(*Announcement)(nil),                       // 118: dankfolio.v1.Announcement
+}
+var file_dankfolio_v1_admin_proto_depIdxs = []int32{
+	117, // 0: dankfolio.v1.Setting.updated_at:type_name -> google.protobuf.Timestamp
+	0,   // 1: dankfolio.v1.ListSettingsResponse.settings:type_name -> dankfolio.v1.Setting
+	0,   // 2: dankfolio.v1.UpdateSettingResponse.setting:type_name -> dankfolio.v1.Setting
+	0,   // 3: dankfolio.v1.ResetSettingResponse.setting:type_name -> dankfolio.v1.Setting
+	117, // 4: dankfolio.v1.FeatureFlag.updated_at:type_name -> google.protobuf.Timestamp
+	7,   // 5: dankfolio.v1.ListFeatureFlagsResponse.flags:type_name -> dankfolio.v1.FeatureFlag
+	7,   // 6: dankfolio.v1.SetFeatureFlagRequest.flag:type_name -> dankfolio.v1.FeatureFlag
+	7,   // 7: dankfolio.v1.SetFeatureFlagResponse.flag:type_name -> dankfolio.v1.FeatureFlag
+	117, // 8: dankfolio.v1.SpamToken.updated_at:type_name -> google.protobuf.Timestamp
+	14,  // 9: dankfolio.v1.ListSpamTokensResponse.tokens:type_name -> dankfolio.v1.SpamToken
+	14,  // 10: dankfolio.v1.SetSpamTokenRequest.token:type_name -> dankfolio.v1.SpamToken
+	14,  // 11: dankfolio.v1.SetSpamTokenResponse.token:type_name -> dankfolio.v1.SpamToken
+	117, // 12: dankfolio.v1.BlockedMint.updated_at:type_name -> google.protobuf.Timestamp
+	21,  // 13: dankfolio.v1.ListBlockedMintsResponse.entries:type_name -> dankfolio.v1.BlockedMint
+	21,  // 14: dankfolio.v1.SetBlocklistOverrideResponse.entry:type_name -> dankfolio.v1.BlockedMint
+	29,  // 15: dankfolio.v1.SyncBlocklistResponse.results:type_name -> dankfolio.v1.BlocklistSyncResult
+	117, // 16: dankfolio.v1.CoinDescription.updated_at:type_name -> google.protobuf.Timestamp
+	31,  // 17: dankfolio.v1.ListCoinDescriptionsResponse.descriptions:type_name -> dankfolio.v1.CoinDescription
+	31,  // 18: dankfolio.v1.SetCoinDescriptionResponse.description:type_name -> dankfolio.v1.CoinDescription
+	117, // 19: dankfolio.v1.SlowQuery.last_seen:type_name -> google.protobuf.Timestamp
+	38,  // 20: dankfolio.v1.ListSlowQueriesResponse.queries:type_name -> dankfolio.v1.SlowQuery
+	115, // 21: dankfolio.v1.BroadcastNotificationRequest.data:type_name -> dankfolio.v1.BroadcastNotificationRequest.DataEntry
+	117, // 22: dankfolio.v1.NotificationDelivery.created_at:type_name -> google.protobuf.Timestamp
+	43,  // 23: dankfolio.v1.ListNotificationDeliveriesResponse.deliveries:type_name -> dankfolio.v1.NotificationDelivery
+	117, // 24: dankfolio.v1.AnnouncementContent.starts_at:type_name -> google.protobuf.Timestamp
+	117, // 25: dankfolio.v1.AnnouncementContent.ends_at:type_name -> google.protobuf.Timestamp
+	118, // 26: dankfolio.v1.ListAllAnnouncementsResponse.announcements:type_name -> dankfolio.v1.Announcement
+	46,  // 27: dankfolio.v1.CreateAnnouncementRequest.content:type_name -> dankfolio.v1.AnnouncementContent
+	118, // 28: dankfolio.v1.CreateAnnouncementResponse.announcement:type_name -> dankfolio.v1.Announcement
+	46,  // 29: dankfolio.v1.UpdateAnnouncementRequest.content:type_name -> dankfolio.v1.AnnouncementContent
+	118, // 30: dankfolio.v1.UpdateAnnouncementResponse.announcement:type_name -> dankfolio.v1.Announcement
+	117, // 31: dankfolio.v1.JobRun.started_at:type_name -> google.protobuf.Timestamp
+	117, // 32: dankfolio.v1.JobRun.finished_at:type_name -> google.protobuf.Timestamp
+	55,  // 33: dankfolio.v1.ListJobRunsResponse.runs:type_name -> dankfolio.v1.JobRun
+	59,  // 34: dankfolio.v1.GetOperationsOverviewResponse.upstream_services:type_name -> dankfolio.v1.UpstreamServiceStats
+	60,  // 35: dankfolio.v1.GetOperationsOverviewResponse.caches:type_name -> dankfolio.v1.CacheStats
+	55,  // 36: dankfolio.v1.GetOperationsOverviewResponse.recent_job_runs:type_name -> dankfolio.v1.JobRun
+	61,  // 37: dankfolio.v1.GetOperationsOverviewResponse.rpc_endpoints:type_name -> dankfolio.v1.RPCEndpointStatus
+	62,  // 38: dankfolio.v1.GetOperationsOverviewResponse.fee_balances:type_name -> dankfolio.v1.FeeBalance
+	117, // 39: dankfolio.v1.CoinRevision.changed_at:type_name -> google.protobuf.Timestamp
+	64,  // 40: dankfolio.v1.GetCoinHistoryResponse.revisions:type_name -> dankfolio.v1.CoinRevision
+	117, // 41: dankfolio.v1.CoinOverride.updated_at:type_name -> google.protobuf.Timestamp
+	67,  // 42: dankfolio.v1.ListCoinOverridesResponse.overrides:type_name -> dankfolio.v1.CoinOverride
+	116, // 43: dankfolio.v1.ListCoinOverridesResponse.field_sources:type_name -> dankfolio.v1.ListCoinOverridesResponse.FieldSourcesEntry
+	67,  // 44: dankfolio.v1.SetCoinOverrideResponse.override:type_name -> dankfolio.v1.CoinOverride
+	117, // 45: dankfolio.v1.SearchSynonym.updated_at:type_name -> google.protobuf.Timestamp
+	74,  // 46: dankfolio.v1.ListSearchSynonymsResponse.synonyms:type_name -> dankfolio.v1.SearchSynonym
+	74,  // 47: dankfolio.v1.SetSearchSynonymResponse.synonym:type_name -> dankfolio.v1.SearchSynonym
+	81,  // 48: dankfolio.v1.ListNaughtyWordsResponse.words:type_name -> dankfolio.v1.NaughtyWord
+	89,  // 49: dankfolio.v1.ImportNaughtyWordsResponse.imports:type_name -> dankfolio.v1.NaughtyWordImport
+	117, // 50: dankfolio.v1.TradeLimit.updated_at:type_name -> google.protobuf.Timestamp
+	95,  // 51: dankfolio.v1.TradeLimitStatus.override:type_name -> dankfolio.v1.TradeLimit
+	96,  // 52: dankfolio.v1.GetTradeLimitResponse.status:type_name -> dankfolio.v1.TradeLimitStatus
+	95,  // 53: dankfolio.v1.SetTradeLimitRequest.limit:type_name -> dankfolio.v1.TradeLimit
+	96,  // 54: dankfolio.v1.SetTradeLimitResponse.status:type_name -> dankfolio.v1.TradeLimitStatus
+	117, // 55: dankfolio.v1.IconRevalidation.since:type_name -> google.protobuf.Timestamp
+	117, // 56: dankfolio.v1.IconRevalidation.until:type_name -> google.protobuf.Timestamp
+	117, // 57: dankfolio.v1.IconRevalidation.started_at:type_name -> google.protobuf.Timestamp
+	117, // 58: dankfolio.v1.IconRevalidation.finished_at:type_name -> google.protobuf.Timestamp
+	117, // 59: dankfolio.v1.RevalidateIconsRequest.since:type_name -> google.protobuf.Timestamp
+	117, // 60: dankfolio.v1.RevalidateIconsRequest.until:type_name -> google.protobuf.Timestamp
+	103, // 61: dankfolio.v1.RevalidateIconsResponse.revalidation:type_name -> dankfolio.v1.IconRevalidation
+	103, // 62: dankfolio.v1.GetIconRevalidationResponse.revalidation:type_name -> dankfolio.v1.IconRevalidation
+	117, // 63: dankfolio.v1.DeletedRecord.deleted_at:type_name -> google.protobuf.Timestamp
+	117, // 64: dankfolio.v1.DeletedRecord.purge_at:type_name -> google.protobuf.Timestamp
+	112, // 65: dankfolio.v1.ListDeletedRecordsResponse.records:type_name -> dankfolio.v1.DeletedRecord
+	1,   // 66: dankfolio.v1.AdminService.ListSettings:input_type -> dankfolio.v1.ListSettingsRequest
+	3,   // 67: dankfolio.v1.AdminService.UpdateSetting:input_type -> dankfolio.v1.UpdateSettingRequest
+	5,   // 68: dankfolio.v1.AdminService.ResetSetting:input_type -> dankfolio.v1.ResetSettingRequest
+	8,   // 69: dankfolio.v1.AdminService.ListFeatureFlags:input_type -> dankfolio.v1.ListFeatureFlagsRequest
+	10,  // 70: dankfolio.v1.AdminService.SetFeatureFlag:input_type -> dankfolio.v1.SetFeatureFlagRequest
+	12,  // 71: dankfolio.v1.AdminService.DeleteFeatureFlag:input_type -> dankfolio.v1.DeleteFeatureFlagRequest
+	15,  // 72: dankfolio.v1.AdminService.ListSpamTokens:input_type -> dankfolio.v1.ListSpamTokensRequest
+	17,  // 73: dankfolio.v1.AdminService.SetSpamToken:input_type -> dankfolio.v1.SetSpamTokenRequest
+	19,  // 74: dankfolio.v1.AdminService.DeleteSpamToken:input_type -> dankfolio.v1.DeleteSpamTokenRequest
+	22,  // 75: dankfolio.v1.AdminService.ListBlockedMints:input_type -> dankfolio.v1.ListBlockedMintsRequest
+	24,  // 76: dankfolio.v1.AdminService.SetBlocklistOverride:input_type -> dankfolio.v1.SetBlocklistOverrideRequest
+	26,  // 77: dankfolio.v1.AdminService.DeleteBlocklistOverride:input_type -> dankfolio.v1.DeleteBlocklistOverrideRequest
+	28,  // 78: dankfolio.v1.AdminService.SyncBlocklist:input_type -> dankfolio.v1.SyncBlocklistRequest
+	32,  // 79: dankfolio.v1.AdminService.ListCoinDescriptions:input_type -> dankfolio.v1.ListCoinDescriptionsRequest
+	34,  // 80: dankfolio.v1.AdminService.SetCoinDescription:input_type -> dankfolio.v1.SetCoinDescriptionRequest
+	36,  // 81: dankfolio.v1.AdminService.DeleteCoinDescription:input_type -> dankfolio.v1.DeleteCoinDescriptionRequest
+	39,  // 82: dankfolio.v1.AdminService.ListSlowQueries:input_type -> dankfolio.v1.ListSlowQueriesRequest
+	41,  // 83: dankfolio.v1.AdminService.BroadcastNotification:input_type -> dankfolio.v1.BroadcastNotificationRequest
+	44,  // 84: dankfolio.v1.AdminService.ListNotificationDeliveries:input_type -> dankfolio.v1.ListNotificationDeliveriesRequest
+	47,  // 85: dankfolio.v1.AdminService.ListAllAnnouncements:input_type -> dankfolio.v1.ListAllAnnouncementsRequest
+	49,  // 86: dankfolio.v1.AdminService.CreateAnnouncement:input_type -> dankfolio.v1.CreateAnnouncementRequest
+	51,  // 87: dankfolio.v1.AdminService.UpdateAnnouncement:input_type -> dankfolio.v1.UpdateAnnouncementRequest
+	53,  // 88: dankfolio.v1.AdminService.DeleteAnnouncement:input_type -> dankfolio.v1.DeleteAnnouncementRequest
+	56,  // 89: dankfolio.v1.AdminService.ListJobRuns:input_type -> dankfolio.v1.ListJobRunsRequest
+	58,  // 90: dankfolio.v1.AdminService.GetOperationsOverview:input_type -> dankfolio.v1.GetOperationsOverviewRequest
+	65,  // 91: dankfolio.v1.AdminService.GetCoinHistory:input_type -> dankfolio.v1.GetCoinHistoryRequest
+	68,  // 92: dankfolio.v1.AdminService.ListCoinOverrides:input_type -> dankfolio.v1.ListCoinOverridesRequest
+	70,  // 93: dankfolio.v1.AdminService.SetCoinOverride:input_type -> dankfolio.v1.SetCoinOverrideRequest
+	72,  // 94: dankfolio.v1.AdminService.DeleteCoinOverride:input_type -> dankfolio.v1.DeleteCoinOverrideRequest
+	75,  // 95: dankfolio.v1.AdminService.ListSearchSynonyms:input_type -> dankfolio.v1.ListSearchSynonymsRequest
+	77,  // 96: dankfolio.v1.AdminService.SetSearchSynonym:input_type -> dankfolio.v1.SetSearchSynonymRequest
+	79,  // 97: dankfolio.v1.AdminService.DeleteSearchSynonym:input_type -> dankfolio.v1.DeleteSearchSynonymRequest
+	82,  // 98: dankfolio.v1.AdminService.ListNaughtyWords:input_type -> dankfolio.v1.ListNaughtyWordsRequest
+	84,  // 99: dankfolio.v1.AdminService.AddNaughtyWords:input_type -> dankfolio.v1.AddNaughtyWordsRequest
+	86,  // 100: dankfolio.v1.AdminService.RemoveNaughtyWords:input_type -> dankfolio.v1.RemoveNaughtyWordsRequest
+	88,  // 101: dankfolio.v1.AdminService.ImportNaughtyWords:input_type -> dankfolio.v1.ImportNaughtyWordsRequest
+	91,  // 102: dankfolio.v1.AdminService.ExportNaughtyWords:input_type -> dankfolio.v1.ExportNaughtyWordsRequest
+	93,  // 103: dankfolio.v1.AdminService.ReloadNaughtyWords:input_type -> dankfolio.v1.ReloadNaughtyWordsRequest
+	97,  // 104: dankfolio.v1.AdminService.GetTradeLimit:input_type -> dankfolio.v1.GetTradeLimitRequest
+	99,  // 105: dankfolio.v1.AdminService.SetTradeLimit:input_type -> dankfolio.v1.SetTradeLimitRequest
+	101, // 106: dankfolio.v1.AdminService.DeleteTradeLimit:input_type -> dankfolio.v1.DeleteTradeLimitRequest
+	104, // 107: dankfolio.v1.AdminService.RevalidateIcons:input_type -> dankfolio.v1.RevalidateIconsRequest
+	106, // 108: dankfolio.v1.AdminService.GetIconRevalidation:input_type -> dankfolio.v1.GetIconRevalidationRequest
+	108, // 109: dankfolio.v1.AdminService.DeleteRecord:input_type -> dankfolio.v1.DeleteRecordRequest
+	110, // 110: dankfolio.v1.AdminService.RestoreRecord:input_type -> dankfolio.v1.RestoreRecordRequest
+	113, // 111: dankfolio.v1.AdminService.ListDeletedRecords:input_type -> dankfolio.v1.ListDeletedRecordsRequest
+	2,   // 112: dankfolio.v1.AdminService.ListSettings:output_type -> dankfolio.v1.ListSettingsResponse
+	4,   // 113: dankfolio.v1.AdminService.UpdateSetting:output_type -> dankfolio.v1.UpdateSettingResponse
+	6,   // 114: dankfolio.v1.AdminService.ResetSetting:output_type -> dankfolio.v1.ResetSettingResponse
+	9,   // 115: dankfolio.v1.AdminService.ListFeatureFlags:output_type -> dankfolio.v1.ListFeatureFlagsResponse
+	11,  // 116: dankfolio.v1.AdminService.SetFeatureFlag:output_type -> dankfolio.v1.SetFeatureFlagResponse
+	13,  // 117: dankfolio.v1.AdminService.DeleteFeatureFlag:output_type -> dankfolio.v1.DeleteFeatureFlagResponse
+	16,  // 118: dankfolio.v1.AdminService.ListSpamTokens:output_type -> dankfolio.v1.ListSpamTokensResponse
+	18,  // 119: dankfolio.v1.AdminService.SetSpamToken:output_type -> dankfolio.v1.SetSpamTokenResponse
+	20,  // 120: dankfolio.v1.AdminService.DeleteSpamToken:output_type -> dankfolio.v1.DeleteSpamTokenResponse
+	23,  // 121: dankfolio.v1.AdminService.ListBlockedMints:output_type -> dankfolio.v1.ListBlockedMintsResponse
+	25,  // 122: dankfolio.v1.AdminService.SetBlocklistOverride:output_type -> dankfolio.v1.SetBlocklistOverrideResponse
+	27,  // 123: dankfolio.v1.AdminService.DeleteBlocklistOverride:output_type -> dankfolio.v1.DeleteBlocklistOverrideResponse
+	30,  // 124: dankfolio.v1.AdminService.SyncBlocklist:output_type -> dankfolio.v1.SyncBlocklistResponse
+	33,  // 125: dankfolio.v1.AdminService.ListCoinDescriptions:output_type -> dankfolio.v1.ListCoinDescriptionsResponse
+	35,  // 126: dankfolio.v1.AdminService.SetCoinDescription:output_type -> dankfolio.v1.SetCoinDescriptionResponse
+	37,  // 127: dankfolio.v1.AdminService.DeleteCoinDescription:output_type -> dankfolio.v1.DeleteCoinDescriptionResponse
+	40,  // 128: dankfolio.v1.AdminService.ListSlowQueries:output_type -> dankfolio.v1.ListSlowQueriesResponse
+	42,  // 129: dankfolio.v1.AdminService.BroadcastNotification:output_type -> dankfolio.v1.BroadcastNotificationResponse
+	45,  // 130: dankfolio.v1.AdminService.ListNotificationDeliveries:output_type -> dankfolio.v1.ListNotificationDeliveriesResponse
+	48,  // 131: dankfolio.v1.AdminService.ListAllAnnouncements:output_type -> dankfolio.v1.ListAllAnnouncementsResponse
+	50,  // 132: dankfolio.v1.AdminService.CreateAnnouncement:output_type -> dankfolio.v1.CreateAnnouncementResponse
+	52,  // 133: dankfolio.v1.AdminService.UpdateAnnouncement:output_type -> dankfolio.v1.UpdateAnnouncementResponse
+	54,  // 134: dankfolio.v1.AdminService.DeleteAnnouncement:output_type -> dankfolio.v1.DeleteAnnouncementResponse
+	57,  // 135: dankfolio.v1.AdminService.ListJobRuns:output_type -> dankfolio.v1.ListJobRunsResponse
+	63,  // 136: dankfolio.v1.AdminService.GetOperationsOverview:output_type -> dankfolio.v1.GetOperationsOverviewResponse
+	66,  // 137: dankfolio.v1.AdminService.GetCoinHistory:output_type -> dankfolio.v1.GetCoinHistoryResponse
+	69,  // 138: dankfolio.v1.AdminService.ListCoinOverrides:output_type -> dankfolio.v1.ListCoinOverridesResponse
+	71,  // 139: dankfolio.v1.AdminService.SetCoinOverride:output_type -> dankfolio.v1.SetCoinOverrideResponse
+	73,  // 140: dankfolio.v1.AdminService.DeleteCoinOverride:output_type -> dankfolio.v1.DeleteCoinOverrideResponse
+	76,  // 141: dankfolio.v1.AdminService.ListSearchSynonyms:output_type -> dankfolio.v1.ListSearchSynonymsResponse
+	78,  // 142: dankfolio.v1.AdminService.SetSearchSynonym:output_type -> dankfolio.v1.SetSearchSynonymResponse
+	80,  // 143: dankfolio.v1.AdminService.DeleteSearchSynonym:output_type -> dankfolio.v1.DeleteSearchSynonymResponse
+	83,  // 144: dankfolio.v1.AdminService.ListNaughtyWords:output_type -> dankfolio.v1.ListNaughtyWordsResponse
+	85,  // 145: dankfolio.v1.AdminService.AddNaughtyWords:output_type -> dankfolio.v1.AddNaughtyWordsResponse
+	87,  // 146: dankfolio.v1.AdminService.RemoveNaughtyWords:output_type -> dankfolio.v1.RemoveNaughtyWordsResponse
+	90,  // 147: dankfolio.v1.AdminService.ImportNaughtyWords:output_type -> dankfolio.v1.ImportNaughtyWordsResponse
+	92,  // 148: dankfolio.v1.AdminService.ExportNaughtyWords:output_type -> dankfolio.v1.ExportNaughtyWordsResponse
+	94,  // 149: dankfolio.v1.AdminService.ReloadNaughtyWords:output_type -> dankfolio.v1.ReloadNaughtyWordsResponse
+	98,  // 150: dankfolio.v1.AdminService.GetTradeLimit:output_type -> dankfolio.v1.GetTradeLimitResponse
+	100, // 151: dankfolio.v1.AdminService.SetTradeLimit:output_type -> dankfolio.v1.SetTradeLimitResponse
+	102, // 152: dankfolio.v1.AdminService.DeleteTradeLimit:output_type -> dankfolio.v1.DeleteTradeLimitResponse
+	105, // 153: dankfolio.v1.AdminService.RevalidateIcons:output_type -> dankfolio.v1.RevalidateIconsResponse
+	107, // 154: dankfolio.v1.AdminService.GetIconRevalidation:output_type -> dankfolio.v1.GetIconRevalidationResponse
+	109, // 155: dankfolio.v1.AdminService.DeleteRecord:output_type -> dankfolio.v1.DeleteRecordResponse
+	111, // 156: dankfolio.v1.AdminService.RestoreRecord:output_type -> dankfolio.v1.RestoreRecordResponse
+	114, // 157: dankfolio.v1.AdminService.ListDeletedRecords:output_type -> dankfolio.v1.ListDeletedRecordsResponse
+	112, // [112:158] is the sub-list for method output_type
+	66,  // [66:112] is the sub-list for method input_type
+	66,  // [66:66] is the sub-list for extension type_name
+	66,  // [66:66] is the sub-list for extension extendee
+	0,   // [0:66] is the sub-list for field type_name
+}
+
+func init() { file_dankfolio_v1_admin_proto_init() }
+func file_dankfolio_v1_admin_proto_init() {
+	if File_dankfolio_v1_admin_proto != nil {
+		return
+	}
+	file_dankfolio_v1_announcement_proto_init()
+	file_dankfolio_v1_admin_proto_msgTypes[0].OneofWrappers = []any{}
+	file_dankfolio_v1_admin_proto_msgTypes[46].OneofWrappers = []any{}
+	file_dankfolio_v1_admin_proto_msgTypes[55].OneofWrappers = []any{}
+	file_dankfolio_v1_admin_proto_msgTypes[96].OneofWrappers = []any{}
+	file_dankfolio_v1_admin_proto_msgTypes[103].OneofWrappers = []any{}
+	file_dankfolio_v1_admin_proto_msgTypes[104].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_dankfolio_v1_admin_proto_rawDesc), len(file_dankfolio_v1_admin_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   117,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_dankfolio_v1_admin_proto_goTypes,
+		DependencyIndexes: file_dankfolio_v1_admin_proto_depIdxs,
+		MessageInfos:      file_dankfolio_v1_admin_proto_msgTypes,
+	}.Build()
+	File_dankfolio_v1_admin_proto = out.File
+	file_dankfolio_v1_admin_proto_goTypes = nil
+	file_dankfolio_v1_admin_proto_depIdxs = nil
+}
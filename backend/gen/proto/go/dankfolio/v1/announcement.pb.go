@@ -0,0 +1,447 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: dankfolio/v1/announcement.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Announcement struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Body  string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	// "info", "warning" or "critical".
+	Severity string `protobuf:"bytes,4,opt,name=severity,proto3" json:"severity,omitempty"`
+	// Only app versions in [min_app_version, max_app_version] see the announcement. Empty bounds are open.
+	MinAppVersion string                 `protobuf:"bytes,5,opt,name=min_app_version,json=minAppVersion,proto3" json:"min_app_version,omitempty"`
+	MaxAppVersion string                 `protobuf:"bytes,6,opt,name=max_app_version,json=maxAppVersion,proto3" json:"max_app_version,omitempty"`
+	StartsAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	// Unset when the announcement does not expire.
+	EndsAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=ends_at,json=endsAt,proto3,oneof" json:"ends_at,omitempty"`
+	// Whether the wallet has read it. Always false in admin listings.
+	Read          bool                   `protobuf:"varint,9,opt,name=read,proto3" json:"read,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Announcement) Reset() {
+	*x = Announcement{}
+	mi := &file_dankfolio_v1_announcement_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Announcement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Announcement) ProtoMessage() {}
+
+func (x *Announcement) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_announcement_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Announcement.ProtoReflect.Descriptor instead.
+func (*Announcement) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_announcement_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Announcement) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Announcement) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Announcement) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Announcement) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *Announcement) GetMinAppVersion() string {
+	if x != nil {
+		return x.MinAppVersion
+	}
+	return ""
+}
+
+func (x *Announcement) GetMaxAppVersion() string {
+	if x != nil {
+		return x.MaxAppVersion
+	}
+	return ""
+}
+
+func (x *Announcement) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *Announcement) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *Announcement) GetRead() bool {
+	if x != nil {
+		return x.Read
+	}
+	return false
+}
+
+func (x *Announcement) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Announcement) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListAnnouncementsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional; without it no announcement is read.
+	WalletAddress string `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
+	// The app's version, e.g. "1.4.2". Without it only announcements that do not target versions are returned.
+	AppVersion    string `protobuf:"bytes,2,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAnnouncementsRequest) Reset() {
+	*x = ListAnnouncementsRequest{}
+	mi := &file_dankfolio_v1_announcement_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAnnouncementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAnnouncementsRequest) ProtoMessage() {}
+
+func (x *ListAnnouncementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_announcement_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAnnouncementsRequest.ProtoReflect.Descriptor instead.
+func (*ListAnnouncementsRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_announcement_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListAnnouncementsRequest) GetWalletAddress() string {
+	if x != nil {
+		return x.WalletAddress
+	}
+	return ""
+}
+
+func (x *ListAnnouncementsRequest) GetAppVersion() string {
+	if x != nil {
+		return x.AppVersion
+	}
+	return ""
+}
+
+type ListAnnouncementsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Announcements []*Announcement        `protobuf:"bytes,1,rep,name=announcements,proto3" json:"announcements,omitempty"`
+	UnreadCount   int32                  `protobuf:"varint,2,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAnnouncementsResponse) Reset() {
+	*x = ListAnnouncementsResponse{}
+	mi := &file_dankfolio_v1_announcement_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAnnouncementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAnnouncementsResponse) ProtoMessage() {}
+
+func (x *ListAnnouncementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_announcement_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAnnouncementsResponse.ProtoReflect.Descriptor instead.
+func (*ListAnnouncementsResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_announcement_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListAnnouncementsResponse) GetAnnouncements() []*Announcement {
+	if x != nil {
+		return x.Announcements
+	}
+	return nil
+}
+
+func (x *ListAnnouncementsResponse) GetUnreadCount() int32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+type MarkAnnouncementsReadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WalletAddress string                 `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
+	// At most 100.
+	AnnouncementIds []string `protobuf:"bytes,2,rep,name=announcement_ids,json=announcementIds,proto3" json:"announcement_ids,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MarkAnnouncementsReadRequest) Reset() {
+	*x = MarkAnnouncementsReadRequest{}
+	mi := &file_dankfolio_v1_announcement_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkAnnouncementsReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkAnnouncementsReadRequest) ProtoMessage() {}
+
+func (x *MarkAnnouncementsReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_announcement_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkAnnouncementsReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkAnnouncementsReadRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_announcement_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MarkAnnouncementsReadRequest) GetWalletAddress() string {
+	if x != nil {
+		return x.WalletAddress
+	}
+	return ""
+}
+
+func (x *MarkAnnouncementsReadRequest) GetAnnouncementIds() []string {
+	if x != nil {
+		return x.AnnouncementIds
+	}
+	return nil
+}
+
+type MarkAnnouncementsReadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkAnnouncementsReadResponse) Reset() {
+	*x = MarkAnnouncementsReadResponse{}
+	mi := &file_dankfolio_v1_announcement_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkAnnouncementsReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkAnnouncementsReadResponse) ProtoMessage() {}
+
+func (x *MarkAnnouncementsReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_announcement_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkAnnouncementsReadResponse.ProtoReflect.Descriptor instead.
+func (*MarkAnnouncementsReadResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_announcement_proto_rawDescGZIP(), []int{4}
+}
+
+var File_dankfolio_v1_announcement_proto protoreflect.FileDescriptor
+
+const file_dankfolio_v1_announcement_proto_rawDesc = "" +
+	"\n" +
+	"\x1fdankfolio/v1/announcement.proto\x12\fdankfolio.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xbd\x03\n" +
+	"\fAnnouncement\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\tR\x04body\x12\x1a\n" +
+	"\bseverity\x18\x04 \x01(\tR\bseverity\x12&\n" +
+	"\x0fmin_app_version\x18\x05 \x01(\tR\rminAppVersion\x12&\n" +
+	"\x0fmax_app_version\x18\x06 \x01(\tR\rmaxAppVersion\x127\n" +
+	"\tstarts_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x128\n" +
+	"\aends_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x06endsAt\x88\x01\x01\x12\x12\n" +
+	"\x04read\x18\t \x01(\bR\x04read\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\n" +
+	"\n" +
+	"\b_ends_at\"b\n" +
+	"\x18ListAnnouncementsRequest\x12%\n" +
+	"\x0ewallet_address\x18\x01 \x01(\tR\rwalletAddress\x12\x1f\n" +
+	"\vapp_version\x18\x02 \x01(\tR\n" +
+	"appVersion\"\x80\x01\n" +
+	"\x19ListAnnouncementsResponse\x12@\n" +
+	"\rannouncements\x18\x01 \x03(\v2\x1a.dankfolio.v1.AnnouncementR\rannouncements\x12!\n" +
+	"\funread_count\x18\x02 \x01(\x05R\vunreadCount\"p\n" +
+	"\x1cMarkAnnouncementsReadRequest\x12%\n" +
+	"\x0ewallet_address\x18\x01 \x01(\tR\rwalletAddress\x12)\n" +
+	"\x10announcement_ids\x18\x02 \x03(\tR\x0fannouncementIds\"\x1f\n" +
+	"\x1dMarkAnnouncementsReadResponse2\xed\x01\n" +
+	"\x13AnnouncementService\x12d\n" +
+	"\x11ListAnnouncements\x12&.dankfolio.v1.ListAnnouncementsRequest\x1a'.dankfolio.v1.ListAnnouncementsResponse\x12p\n" +
+	"\x15MarkAnnouncementsRead\x12*.dankfolio.v1.MarkAnnouncementsReadRequest\x1a+.dankfolio.v1.MarkAnnouncementsReadResponseB\xbd\x01\n" +
+	"\x10com.dankfolio.v1B\x11AnnouncementProtoP\x01ZEgithub.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1\xa2\x02\x03DXX\xaa\x02\fDankfolio.V1\xca\x02\fDankfolio\\V1\xe2\x02\x18Dankfolio\\V1\\GPBMetadata\xea\x02\rDankfolio::V1b\x06proto3"
+
+var (
+	file_dankfolio_v1_announcement_proto_rawDescOnce sync.Once
+	file_dankfolio_v1_announcement_proto_rawDescData []byte
+)
+
+func file_dankfolio_v1_announcement_proto_rawDescGZIP() []byte {
+	file_dankfolio_v1_announcement_proto_rawDescOnce.Do(func() {
+		file_dankfolio_v1_announcement_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_dankfolio_v1_announcement_proto_rawDesc), len(file_dankfolio_v1_announcement_proto_rawDesc)))
+	})
+	return file_dankfolio_v1_announcement_proto_rawDescData
+}
+
+var file_dankfolio_v1_announcement_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_dankfolio_v1_announcement_proto_goTypes = []any{
+	(*Announcement)(nil),                  // 0: dankfolio.v1.Announcement
+	(*ListAnnouncementsRequest)(nil),      // 1: dankfolio.v1.ListAnnouncementsRequest
+	(*ListAnnouncementsResponse)(nil),     // 2: dankfolio.v1.ListAnnouncementsResponse
+	(*MarkAnnouncementsReadRequest)(nil),  // 3: dankfolio.v1.MarkAnnouncementsReadRequest
+	(*MarkAnnouncementsReadResponse)(nil), // 4: dankfolio.v1.MarkAnnouncementsReadResponse
+	(*timestamppb.Timestamp)(nil),         // 5: google.protobuf.Timestamp
+}
+var file_dankfolio_v1_announcement_proto_depIdxs = []int32{
+	5, // 0: dankfolio.v1.Announcement.starts_at:type_name -> google.protobuf.Timestamp
+	5, // 1: dankfolio.v1.Announcement.ends_at:type_name -> google.protobuf.Timestamp
+	5, // 2: dankfolio.v1.Announcement.created_at:type_name -> google.protobuf.Timestamp
+	5, // 3: dankfolio.v1.Announcement.updated_at:type_name -> google.protobuf.Timestamp
+	0, // 4: dankfolio.v1.ListAnnouncementsResponse.announcements:type_name -> dankfolio.v1.Announcement
+	1, // 5: dankfolio.v1.AnnouncementService.ListAnnouncements:input_type -> dankfolio.v1.ListAnnouncementsRequest
+	3, // 6: dankfolio.v1.AnnouncementService.MarkAnnouncementsRead:input_type -> dankfolio.v1.MarkAnnouncementsReadRequest
+	2, // 7: dankfolio.v1.AnnouncementService.ListAnnouncements:output_type -> dankfolio.v1.ListAnnouncementsResponse
+	4, // 8: dankfolio.v1.AnnouncementService.MarkAnnouncementsRead:output_type -> dankfolio.v1.MarkAnnouncementsReadResponse
+	7, // [7:9] is the sub-list for method output_type
+	5, // [5:7] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_dankfolio_v1_announcement_proto_init() }
+func file_dankfolio_v1_announcement_proto_init() {
+	if File_dankfolio_v1_announcement_proto != nil {
+		return
+	}
+	file_dankfolio_v1_announcement_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_dankfolio_v1_announcement_proto_rawDesc), len(file_dankfolio_v1_announcement_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_dankfolio_v1_announcement_proto_goTypes,
+		DependencyIndexes: file_dankfolio_v1_announcement_proto_depIdxs,
+		MessageInfos:      file_dankfolio_v1_announcement_proto_msgTypes,
+	}.Build()
+	File_dankfolio_v1_announcement_proto = out.File
+	file_dankfolio_v1_announcement_proto_goTypes = nil
+	file_dankfolio_v1_announcement_proto_depIdxs = nil
+}
@@ -0,0 +1,247 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: dankfolio/v1/app_config.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetAppConfigRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The app's version, e.g. "1.4.2". Optional; used to compute update_required.
+	AppVersion    string `protobuf:"bytes,1,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAppConfigRequest) Reset() {
+	*x = GetAppConfigRequest{}
+	mi := &file_dankfolio_v1_app_config_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAppConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAppConfigRequest) ProtoMessage() {}
+
+func (x *GetAppConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_app_config_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAppConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetAppConfigRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_app_config_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetAppConfigRequest) GetAppVersion() string {
+	if x != nil {
+		return x.AppVersion
+	}
+	return ""
+}
+
+type GetAppConfigResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Older app versions are no longer supported. Empty when every version is.
+	MinimumAppVersion string `protobuf:"bytes,1,opt,name=minimum_app_version,json=minimumAppVersion,proto3" json:"minimum_app_version,omitempty"`
+	// True when app_version is older than minimum_app_version.
+	UpdateRequired bool `protobuf:"varint,2,opt,name=update_required,json=updateRequired,proto3" json:"update_required,omitempty"`
+	// Features switched off by ops: "trading", "transfers", "payments", "search".
+	DisabledFeatures []string `protobuf:"bytes,3,rep,name=disabled_features,json=disabledFeatures,proto3" json:"disabled_features,omitempty"`
+	// Banner text shown while any feature is disabled.
+	DisabledMessage string `protobuf:"bytes,4,opt,name=disabled_message,json=disabledMessage,proto3" json:"disabled_message,omitempty"`
+	// Solana RPC endpoint the app should use. Empty to keep its built-in endpoint.
+	RpcEndpoint string `protobuf:"bytes,5,opt,name=rpc_endpoint,json=rpcEndpoint,proto3" json:"rpc_endpoint,omitempty"`
+	// Slippage preselected for swaps, in basis points.
+	DefaultSlippageBps int32 `protobuf:"varint,6,opt,name=default_slippage_bps,json=defaultSlippageBps,proto3" json:"default_slippage_bps,omitempty"`
+	// Trades and transfers are rejected with a MAINTENANCE error while true; read-only endpoints keep serving.
+	Maintenance bool `protobuf:"varint,7,opt,name=maintenance,proto3" json:"maintenance,omitempty"`
+	// Shown to users while in maintenance. Empty for the app's generic message.
+	MaintenanceMessage string `protobuf:"bytes,8,opt,name=maintenance_message,json=maintenanceMessage,proto3" json:"maintenance_message,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetAppConfigResponse) Reset() {
+	*x = GetAppConfigResponse{}
+	mi := &file_dankfolio_v1_app_config_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAppConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAppConfigResponse) ProtoMessage() {}
+
+func (x *GetAppConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_app_config_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAppConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetAppConfigResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_app_config_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetAppConfigResponse) GetMinimumAppVersion() string {
+	if x != nil {
+		return x.MinimumAppVersion
+	}
+	return ""
+}
+
+func (x *GetAppConfigResponse) GetUpdateRequired() bool {
+	if x != nil {
+		return x.UpdateRequired
+	}
+	return false
+}
+
+func (x *GetAppConfigResponse) GetDisabledFeatures() []string {
+	if x != nil {
+		return x.DisabledFeatures
+	}
+	return nil
+}
+
+func (x *GetAppConfigResponse) GetDisabledMessage() string {
+	if x != nil {
+		return x.DisabledMessage
+	}
+	return ""
+}
+
+func (x *GetAppConfigResponse) GetRpcEndpoint() string {
+	if x != nil {
+		return x.RpcEndpoint
+	}
+	return ""
+}
+
+func (x *GetAppConfigResponse) GetDefaultSlippageBps() int32 {
+	if x != nil {
+		return x.DefaultSlippageBps
+	}
+	return 0
+}
+
+func (x *GetAppConfigResponse) GetMaintenance() bool {
+	if x != nil {
+		return x.Maintenance
+	}
+	return false
+}
+
+func (x *GetAppConfigResponse) GetMaintenanceMessage() string {
+	if x != nil {
+		return x.MaintenanceMessage
+	}
+	return ""
+}
+
+var File_dankfolio_v1_app_config_proto protoreflect.FileDescriptor
+
+const file_dankfolio_v1_app_config_proto_rawDesc = "" +
+	"\n" +
+	"\x1ddankfolio/v1/app_config.proto\x12\fdankfolio.v1\"6\n" +
+	"\x13GetAppConfigRequest\x12\x1f\n" +
+	"\vapp_version\x18\x01 \x01(\tR\n" +
+	"appVersion\"\xef\x02\n" +
+	"\x14GetAppConfigResponse\x12.\n" +
+	"\x13minimum_app_version\x18\x01 \x01(\tR\x11minimumAppVersion\x12'\n" +
+	"\x0fupdate_required\x18\x02 \x01(\bR\x0eupdateRequired\x12+\n" +
+	"\x11disabled_features\x18\x03 \x03(\tR\x10disabledFeatures\x12)\n" +
+	"\x10disabled_message\x18\x04 \x01(\tR\x0fdisabledMessage\x12!\n" +
+	"\frpc_endpoint\x18\x05 \x01(\tR\vrpcEndpoint\x120\n" +
+	"\x14default_slippage_bps\x18\x06 \x01(\x05R\x12defaultSlippageBps\x12 \n" +
+	"\vmaintenance\x18\a \x01(\bR\vmaintenance\x12/\n" +
+	"\x13maintenance_message\x18\b \x01(\tR\x12maintenanceMessage2i\n" +
+	"\x10AppConfigService\x12U\n" +
+	"\fGetAppConfig\x12!.dankfolio.v1.GetAppConfigRequest\x1a\".dankfolio.v1.GetAppConfigResponseB\xba\x01\n" +
+	"\x10com.dankfolio.v1B\x0eAppConfigProtoP\x01ZEgithub.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1\xa2\x02\x03DXX\xaa\x02\fDankfolio.V1\xca\x02\fDankfolio\\V1\xe2\x02\x18Dankfolio\\V1\\GPBMetadata\xea\x02\rDankfolio::V1b\x06proto3"
+
+var (
+	file_dankfolio_v1_app_config_proto_rawDescOnce sync.Once
+	file_dankfolio_v1_app_config_proto_rawDescData []byte
+)
+
+func file_dankfolio_v1_app_config_proto_rawDescGZIP() []byte {
+	file_dankfolio_v1_app_config_proto_rawDescOnce.Do(func() {
+		file_dankfolio_v1_app_config_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_dankfolio_v1_app_config_proto_rawDesc), len(file_dankfolio_v1_app_config_proto_rawDesc)))
+	})
+	return file_dankfolio_v1_app_config_proto_rawDescData
+}
+
+var file_dankfolio_v1_app_config_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_dankfolio_v1_app_config_proto_goTypes = []any{
+	(*GetAppConfigRequest)(nil),  // 0: dankfolio.v1.GetAppConfigRequest
+	(*GetAppConfigResponse)(nil), // 1: dankfolio.v1.GetAppConfigResponse
+}
+var file_dankfolio_v1_app_config_proto_depIdxs = []int32{
+	0, // 0: dankfolio.v1.AppConfigService.GetAppConfig:input_type -> dankfolio.v1.GetAppConfigRequest
+	1, // 1: dankfolio.v1.AppConfigService.GetAppConfig:output_type -> dankfolio.v1.GetAppConfigResponse
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_dankfolio_v1_app_config_proto_init() }
+func file_dankfolio_v1_app_config_proto_init() {
+	if File_dankfolio_v1_app_config_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_dankfolio_v1_app_config_proto_rawDesc), len(file_dankfolio_v1_app_config_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_dankfolio_v1_app_config_proto_goTypes,
+		DependencyIndexes: file_dankfolio_v1_app_config_proto_depIdxs,
+		MessageInfos:      file_dankfolio_v1_app_config_proto_msgTypes,
+	}.Build()
+	File_dankfolio_v1_app_config_proto = out.File
+	file_dankfolio_v1_app_config_proto_goTypes = nil
+	file_dankfolio_v1_app_config_proto_depIdxs = nil
+}
@@ -0,0 +1,156 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: dankfolio/v1/cache.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// CacheInfo tells the client how fresh a response is and how long it may reuse it.
+// Send version back as if_version_not_changed to skip an unchanged payload.
+type CacheInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`                                     // Opaque content hash, like an ETag
+	LastUpdated   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`          // When the newest data in the response changed
+	MaxAgeSeconds int32                  `protobuf:"varint,3,opt,name=max_age_seconds,json=maxAgeSeconds,proto3" json:"max_age_seconds,omitempty"` // How long the client may reuse the response without asking
+	NotModified   bool                   `protobuf:"varint,4,opt,name=not_modified,json=notModified,proto3" json:"not_modified,omitempty"`         // Payload omitted; it matches if_version_not_changed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CacheInfo) Reset() {
+	*x = CacheInfo{}
+	mi := &file_dankfolio_v1_cache_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CacheInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CacheInfo) ProtoMessage() {}
+
+func (x *CacheInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_cache_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CacheInfo.ProtoReflect.Descriptor instead.
+func (*CacheInfo) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_cache_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CacheInfo) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *CacheInfo) GetLastUpdated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUpdated
+	}
+	return nil
+}
+
+func (x *CacheInfo) GetMaxAgeSeconds() int32 {
+	if x != nil {
+		return x.MaxAgeSeconds
+	}
+	return 0
+}
+
+func (x *CacheInfo) GetNotModified() bool {
+	if x != nil {
+		return x.NotModified
+	}
+	return false
+}
+
+var File_dankfolio_v1_cache_proto protoreflect.FileDescriptor
+
+const file_dankfolio_v1_cache_proto_rawDesc = "" +
+	"\n" +
+	"\x18dankfolio/v1/cache.proto\x12\fdankfolio.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xaf\x01\n" +
+	"\tCacheInfo\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\x12=\n" +
+	"\flast_updated\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vlastUpdated\x12&\n" +
+	"\x0fmax_age_seconds\x18\x03 \x01(\x05R\rmaxAgeSeconds\x12!\n" +
+	"\fnot_modified\x18\x04 \x01(\bR\vnotModifiedB\xb6\x01\n" +
+	"\x10com.dankfolio.v1B\n" +
+	"CacheProtoP\x01ZEgithub.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1\xa2\x02\x03DXX\xaa\x02\fDankfolio.V1\xca\x02\fDankfolio\\V1\xe2\x02\x18Dankfolio\\V1\\GPBMetadata\xea\x02\rDankfolio::V1b\x06proto3"
+
+var (
+	file_dankfolio_v1_cache_proto_rawDescOnce sync.Once
+	file_dankfolio_v1_cache_proto_rawDescData []byte
+)
+
+func file_dankfolio_v1_cache_proto_rawDescGZIP() []byte {
+	file_dankfolio_v1_cache_proto_rawDescOnce.Do(func() {
+		file_dankfolio_v1_cache_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_dankfolio_v1_cache_proto_rawDesc), len(file_dankfolio_v1_cache_proto_rawDesc)))
+	})
+	return file_dankfolio_v1_cache_proto_rawDescData
+}
+
+var file_dankfolio_v1_cache_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_dankfolio_v1_cache_proto_goTypes = []any{
+	(*CacheInfo)(nil),             // 0: dankfolio.v1.CacheInfo
+	(*timestamppb.Timestamp)(nil), // 1: google.protobuf.Timestamp
+}
+var file_dankfolio_v1_cache_proto_depIdxs = []int32{
+	1, // 0: dankfolio.v1.CacheInfo.last_updated:type_name -> google.protobuf.Timestamp
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_dankfolio_v1_cache_proto_init() }
+func file_dankfolio_v1_cache_proto_init() {
+	if File_dankfolio_v1_cache_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_dankfolio_v1_cache_proto_rawDesc), len(file_dankfolio_v1_cache_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_dankfolio_v1_cache_proto_goTypes,
+		DependencyIndexes: file_dankfolio_v1_cache_proto_depIdxs,
+		MessageInfos:      file_dankfolio_v1_cache_proto_msgTypes,
+	}.Build()
+	File_dankfolio_v1_cache_proto = out.File
+	file_dankfolio_v1_cache_proto_goTypes = nil
+	file_dankfolio_v1_cache_proto_depIdxs = nil
+}
@@ -9,6 +9,7 @@ package v1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -48,6 +49,14 @@ type Coin struct {
 	CreatedAt              *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	LastUpdated            *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=last_updated,json=lastUpdated,proto3,oneof" json:"last_updated,omitempty"`
 	JupiterListedAt        *timestamppb.Timestamp `protobuf:"bytes,22,opt,name=jupiter_listed_at,json=jupiterListedAt,proto3,oneof" json:"jupiter_listed_at,omitempty"`
+	CacheInfo              *CacheInfo             `protobuf:"bytes,23,opt,name=cache_info,json=cacheInfo,proto3" json:"cache_info,omitempty"`                                         // Only set on GetCoinByID
+	Archived               bool                   `protobuf:"varint,24,opt,name=archived,proto3" json:"archived,omitempty"`                                                           // Inactive coin served from the archive
+	SupplyBurnedPercent    *float64               `protobuf:"fixed64,25,opt,name=supply_burned_percent,json=supplyBurnedPercent,proto3,oneof" json:"supply_burned_percent,omitempty"` // Share of the supply burned since we started watching; only set on GetCoinByID
+	LpBurnedPercent        *float64               `protobuf:"fixed64,26,opt,name=lp_burned_percent,json=lpBurnedPercent,proto3,oneof" json:"lp_burned_percent,omitempty"`             // Smallest share of LP tokens burned across the coin's pools; only set on GetCoinByID
+	LastBurnAt             *timestamppb.Timestamp `protobuf:"bytes,27,opt,name=last_burn_at,json=lastBurnAt,proto3,oneof" json:"last_burn_at,omitempty"`                              // Most recent supply or LP burn we detected
+	SafetyScore            *int32                 `protobuf:"varint,28,opt,name=safety_score,json=safetyScore,proto3,oneof" json:"safety_score,omitempty"`                            // 0-100 from the mint's authorities; only set on GetCoinByID once checked
+	MintAuthority          *string                `protobuf:"bytes,29,opt,name=mint_authority,json=mintAuthority,proto3,oneof" json:"mint_authority,omitempty"`                       // Empty when revoked; unset until checked
+	FreezeAuthority        *string                `protobuf:"bytes,30,opt,name=freeze_authority,json=freezeAuthority,proto3,oneof" json:"freeze_authority,omitempty"`                 // Empty when revoked; unset until checked
 	unknownFields          protoimpl.UnknownFields
 	sizeCache              protoimpl.SizeCache
 }
@@ -236,12 +245,71 @@ func (x *Coin) GetJupiterListedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Coin) GetCacheInfo() *CacheInfo {
+	if x != nil {
+		return x.CacheInfo
+	}
+	return nil
+}
+
+func (x *Coin) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+func (x *Coin) GetSupplyBurnedPercent() float64 {
+	if x != nil && x.SupplyBurnedPercent != nil {
+		return *x.SupplyBurnedPercent
+	}
+	return 0
+}
+
+func (x *Coin) GetLpBurnedPercent() float64 {
+	if x != nil && x.LpBurnedPercent != nil {
+		return *x.LpBurnedPercent
+	}
+	return 0
+}
+
+func (x *Coin) GetLastBurnAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastBurnAt
+	}
+	return nil
+}
+
+func (x *Coin) GetSafetyScore() int32 {
+	if x != nil && x.SafetyScore != nil {
+		return *x.SafetyScore
+	}
+	return 0
+}
+
+func (x *Coin) GetMintAuthority() string {
+	if x != nil && x.MintAuthority != nil {
+		return *x.MintAuthority
+	}
+	return ""
+}
+
+func (x *Coin) GetFreezeAuthority() string {
+	if x != nil && x.FreezeAuthority != nil {
+		return *x.FreezeAuthority
+	}
+	return ""
+}
+
 type GetAvailableCoinsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Limit               int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset              int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Locale              string                 `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"`                                                          // Preferred description language, e.g. "es" or "pt-BR"; falls back to the default description
+	IfVersionNotChanged string                 `protobuf:"bytes,4,opt,name=if_version_not_changed,json=ifVersionNotChanged,proto3" json:"if_version_not_changed,omitempty"` // CacheInfo.version from a previous response; unchanged data comes back as not_modified
+	FieldMask           *fieldmaskpb.FieldMask `protobuf:"bytes,5,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`                                   // Coin fields to return, e.g. ["address", "symbol", "price"]; all fields when empty
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *GetAvailableCoinsRequest) Reset() {
@@ -288,10 +356,32 @@ func (x *GetAvailableCoinsRequest) GetOffset() int32 {
 	return 0
 }
 
+func (x *GetAvailableCoinsRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *GetAvailableCoinsRequest) GetIfVersionNotChanged() string {
+	if x != nil {
+		return x.IfVersionNotChanged
+	}
+	return ""
+}
+
+func (x *GetAvailableCoinsRequest) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
 type GetAvailableCoinsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Coins         []*Coin                `protobuf:"bytes,1,rep,name=coins,proto3" json:"coins,omitempty"`
 	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	CacheInfo     *CacheInfo             `protobuf:"bytes,3,opt,name=cache_info,json=cacheInfo,proto3" json:"cache_info,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -340,11 +430,20 @@ func (x *GetAvailableCoinsResponse) GetTotalCount() int32 {
 	return 0
 }
 
+func (x *GetAvailableCoinsResponse) GetCacheInfo() *CacheInfo {
+	if x != nil {
+		return x.CacheInfo
+	}
+	return nil
+}
+
 type GetCoinByIDRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Address             string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Locale              string                 `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`                                                          // Description language
+	IfVersionNotChanged string                 `protobuf:"bytes,3,opt,name=if_version_not_changed,json=ifVersionNotChanged,proto3" json:"if_version_not_changed,omitempty"` // CacheInfo.version from a previous response
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *GetCoinByIDRequest) Reset() {
@@ -384,10 +483,26 @@ func (x *GetCoinByIDRequest) GetAddress() string {
 	return ""
 }
 
+func (x *GetCoinByIDRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *GetCoinByIDRequest) GetIfVersionNotChanged() string {
+	if x != nil {
+		return x.IfVersionNotChanged
+	}
+	return ""
+}
+
 type GetCoinsByIDsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Addresses     []string               `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
 	ForceRefresh  bool                   `protobuf:"varint,2,opt,name=force_refresh,json=forceRefresh,proto3" json:"force_refresh,omitempty"` // Force fetching fresh data from external APIs
+	Locale        string                 `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"`                                  // Description language
+	FieldMask     *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`           // Coin fields to return
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -436,6 +551,20 @@ func (x *GetCoinsByIDsRequest) GetForceRefresh() bool {
 	return false
 }
 
+func (x *GetCoinsByIDsRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *GetCoinsByIDsRequest) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
 type GetCoinsByIDsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Coins         []*Coin                `protobuf:"bytes,1,rep,name=coins,proto3" json:"coins,omitempty"`
@@ -483,6 +612,7 @@ func (x *GetCoinsByIDsResponse) GetCoins() []*Coin {
 type SearchCoinByAddressRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Locale        string                 `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"` // Description language
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -524,6 +654,13 @@ func (x *SearchCoinByAddressRequest) GetAddress() string {
 	return ""
 }
 
+func (x *SearchCoinByAddressRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
 type SearchCoinByAddressResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Coin          *Coin                  `protobuf:"bytes,1,opt,name=coin,proto3" json:"coin,omitempty"`
@@ -649,12 +786,15 @@ func (x *GetAllCoinsResponse) GetCoins() []*Coin {
 }
 
 type SearchRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`    // Text to search in name, symbol, or mint address
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`   // Maximum number of results (default: 20)
-	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"` // Offset for pagination
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Query           string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`                                             // Text to search in name, symbol, or mint address; also a full mint address, a solscan/birdeye/dexscreener token URL, or a "$SYMBOL" cashtag
+	Limit           int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                                            // Maximum number of results (default: 20)
+	Offset          int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`                                          // Offset for pagination
+	Locale          string                 `protobuf:"bytes,4,opt,name=locale,proto3" json:"locale,omitempty"`                                           // Description language
+	FieldMask       *fieldmaskpb.FieldMask `protobuf:"bytes,5,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`                    // Coin fields to return
+	IncludeArchived bool                   `protobuf:"varint,6,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"` // Fill remaining results from coins archived for inactivity
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *SearchRequest) Reset() {
@@ -708,6 +848,27 @@ func (x *SearchRequest) GetOffset() int32 {
 	return 0
 }
 
+func (x *SearchRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
+func (x *SearchRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
 type SearchResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Coins         []*Coin                `protobuf:"bytes,1,rep,name=coins,proto3" json:"coins,omitempty"`                              // Search results
@@ -761,11 +922,14 @@ func (x *SearchResponse) GetTotalCount() int32 {
 }
 
 type GetNewCoinsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         *int32                 `protobuf:"varint,1,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
-	Offset        *int32                 `protobuf:"varint,2,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Limit               *int32                 `protobuf:"varint,1,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Offset              *int32                 `protobuf:"varint,2,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
+	Locale              string                 `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"`                                                          // Description language
+	IfVersionNotChanged string                 `protobuf:"bytes,4,opt,name=if_version_not_changed,json=ifVersionNotChanged,proto3" json:"if_version_not_changed,omitempty"` // CacheInfo.version from a previous response
+	FieldMask           *fieldmaskpb.FieldMask `protobuf:"bytes,5,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`                                   // Coin fields to return
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *GetNewCoinsRequest) Reset() {
@@ -812,12 +976,36 @@ func (x *GetNewCoinsRequest) GetOffset() int32 {
 	return 0
 }
 
+func (x *GetNewCoinsRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *GetNewCoinsRequest) GetIfVersionNotChanged() string {
+	if x != nil {
+		return x.IfVersionNotChanged
+	}
+	return ""
+}
+
+func (x *GetNewCoinsRequest) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
 type GetTrendingCoinsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         *int32                 `protobuf:"varint,1,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
-	Offset        *int32                 `protobuf:"varint,2,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Limit               *int32                 `protobuf:"varint,1,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Offset              *int32                 `protobuf:"varint,2,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
+	Locale              string                 `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"`                                                          // Description language
+	IfVersionNotChanged string                 `protobuf:"bytes,4,opt,name=if_version_not_changed,json=ifVersionNotChanged,proto3" json:"if_version_not_changed,omitempty"` // CacheInfo.version from a previous response
+	FieldMask           *fieldmaskpb.FieldMask `protobuf:"bytes,5,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`                                   // Coin fields to return
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *GetTrendingCoinsRequest) Reset() {
@@ -864,12 +1052,36 @@ func (x *GetTrendingCoinsRequest) GetOffset() int32 {
 	return 0
 }
 
+func (x *GetTrendingCoinsRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *GetTrendingCoinsRequest) GetIfVersionNotChanged() string {
+	if x != nil {
+		return x.IfVersionNotChanged
+	}
+	return ""
+}
+
+func (x *GetTrendingCoinsRequest) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
 type GetTopGainersCoinsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         *int32                 `protobuf:"varint,1,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
-	Offset        *int32                 `protobuf:"varint,2,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Limit               *int32                 `protobuf:"varint,1,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Offset              *int32                 `protobuf:"varint,2,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
+	Locale              string                 `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"`                                                          // Description language
+	IfVersionNotChanged string                 `protobuf:"bytes,4,opt,name=if_version_not_changed,json=ifVersionNotChanged,proto3" json:"if_version_not_changed,omitempty"` // CacheInfo.version from a previous response
+	FieldMask           *fieldmaskpb.FieldMask `protobuf:"bytes,5,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`                                   // Coin fields to return
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *GetTopGainersCoinsRequest) Reset() {
@@ -916,12 +1128,36 @@ func (x *GetTopGainersCoinsRequest) GetOffset() int32 {
 	return 0
 }
 
+func (x *GetTopGainersCoinsRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *GetTopGainersCoinsRequest) GetIfVersionNotChanged() string {
+	if x != nil {
+		return x.IfVersionNotChanged
+	}
+	return ""
+}
+
+func (x *GetTopGainersCoinsRequest) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
 type GetXStocksCoinsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         *int32                 `protobuf:"varint,1,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
-	Offset        *int32                 `protobuf:"varint,2,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Limit               *int32                 `protobuf:"varint,1,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Offset              *int32                 `protobuf:"varint,2,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
+	Locale              string                 `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"`                                                          // Description language
+	IfVersionNotChanged string                 `protobuf:"bytes,4,opt,name=if_version_not_changed,json=ifVersionNotChanged,proto3" json:"if_version_not_changed,omitempty"` // CacheInfo.version from a previous response
+	FieldMask           *fieldmaskpb.FieldMask `protobuf:"bytes,5,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`                                   // Coin fields to return
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *GetXStocksCoinsRequest) Reset() {
@@ -968,104 +1204,952 @@ func (x *GetXStocksCoinsRequest) GetOffset() int32 {
 	return 0
 }
 
-var File_dankfolio_v1_coin_proto protoreflect.FileDescriptor
+func (x *GetXStocksCoinsRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
 
-const file_dankfolio_v1_coin_proto_rawDesc = "" +
-	"\n" +
-	"\x17dankfolio/v1/coin.proto\x12\fdankfolio.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x85\b\n" +
-	"\x04Coin\x12\x18\n" +
-	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
-	"\x06symbol\x18\x03 \x01(\tR\x06symbol\x12\x1a\n" +
-	"\bdecimals\x18\x04 \x01(\x05R\bdecimals\x12 \n" +
-	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x19\n" +
-	"\blogo_uri\x18\x06 \x01(\tR\alogoUri\x12\x12\n" +
-	"\x04tags\x18\a \x03(\tR\x04tags\x12\x14\n" +
-	"\x05price\x18\b \x01(\x01R\x05price\x12;\n" +
-	"\x17price24h_change_percent\x18\t \x01(\x01H\x00R\x15price24hChangePercent\x88\x01\x01\x12!\n" +
-	"\tmarketcap\x18\n" +
-	" \x01(\x01H\x01R\tmarketcap\x88\x01\x01\x12(\n" +
-	"\rvolume24h_usd\x18\v \x01(\x01H\x02R\fvolume24hUsd\x88\x01\x01\x12=\n" +
-	"\x18volume24h_change_percent\x18\f \x01(\x01H\x03R\x16volume24hChangePercent\x88\x01\x01\x12!\n" +
-	"\tliquidity\x18\r \x01(\x01H\x04R\tliquidity\x88\x01\x01\x12\x15\n" +
-	"\x03fdv\x18\x0e \x01(\x01H\x05R\x03fdv\x88\x01\x01\x12\x17\n" +
-	"\x04rank\x18\x0f \x01(\x05H\x06R\x04rank\x88\x01\x01\x12\x1d\n" +
-	"\awebsite\x18\x10 \x01(\tH\aR\awebsite\x88\x01\x01\x12\x1d\n" +
-	"\atwitter\x18\x11 \x01(\tH\bR\atwitter\x88\x01\x01\x12\x1f\n" +
-	"\btelegram\x18\x12 \x01(\tH\tR\btelegram\x88\x01\x01\x12\x1d\n" +
-	"\adiscord\x18\x13 \x01(\tH\n" +
-	"R\adiscord\x88\x01\x01\x129\n" +
-	"\n" +
-	"created_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12B\n" +
-	"\flast_updated\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampH\vR\vlastUpdated\x88\x01\x01\x12K\n" +
-	"\x11jupiter_listed_at\x18\x16 \x01(\v2\x1a.google.protobuf.TimestampH\fR\x0fjupiterListedAt\x88\x01\x01B\x1a\n" +
-	"\x18_price24h_change_percentB\f\n" +
-	"\n" +
-	"_marketcapB\x10\n" +
-	"\x0e_volume24h_usdB\x1b\n" +
-	"\x19_volume24h_change_percentB\f\n" +
-	"\n" +
-	"_liquidityB\x06\n" +
-	"\x04_fdvB\a\n" +
-	"\x05_rankB\n" +
-	"\n" +
-	"\b_websiteB\n" +
-	"\n" +
-	"\b_twitterB\v\n" +
-	"\t_telegramB\n" +
-	"\n" +
-	"\b_discordB\x0f\n" +
-	"\r_last_updatedB\x14\n" +
-	"\x12_jupiter_listed_at\"H\n" +
-	"\x18GetAvailableCoinsRequest\x12\x14\n" +
-	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x02 \x01(\x05R\x06offset\"f\n" +
-	"\x19GetAvailableCoinsResponse\x12(\n" +
-	"\x05coins\x18\x01 \x03(\v2\x12.dankfolio.v1.CoinR\x05coins\x12\x1f\n" +
-	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\".\n" +
-	"\x12GetCoinByIDRequest\x12\x18\n" +
-	"\aaddress\x18\x01 \x01(\tR\aaddress\"Y\n" +
-	"\x14GetCoinsByIDsRequest\x12\x1c\n" +
-	"\taddresses\x18\x01 \x03(\tR\taddresses\x12#\n" +
-	"\rforce_refresh\x18\x02 \x01(\bR\fforceRefresh\"A\n" +
-	"\x15GetCoinsByIDsResponse\x12(\n" +
-	"\x05coins\x18\x01 \x03(\v2\x12.dankfolio.v1.CoinR\x05coins\"6\n" +
-	"\x1aSearchCoinByAddressRequest\x12\x18\n" +
-	"\aaddress\x18\x01 \x01(\tR\aaddress\"E\n" +
-	"\x1bSearchCoinByAddressResponse\x12&\n" +
-	"\x04coin\x18\x01 \x01(\v2\x12.dankfolio.v1.CoinR\x04coin\"\x14\n" +
-	"\x12GetAllCoinsRequest\"?\n" +
-	"\x13GetAllCoinsResponse\x12(\n" +
-	"\x05coins\x18\x01 \x03(\v2\x12.dankfolio.v1.CoinR\x05coins\"S\n" +
-	"\rSearchRequest\x12\x14\n" +
-	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
-	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x03 \x01(\x05R\x06offset\"[\n" +
-	"\x0eSearchResponse\x12(\n" +
-	"\x05coins\x18\x01 \x03(\v2\x12.dankfolio.v1.CoinR\x05coins\x12\x1f\n" +
-	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\"a\n" +
-	"\x12GetNewCoinsRequest\x12\x19\n" +
-	"\x05limit\x18\x01 \x01(\x05H\x00R\x05limit\x88\x01\x01\x12\x1b\n" +
-	"\x06offset\x18\x02 \x01(\x05H\x01R\x06offset\x88\x01\x01B\b\n" +
-	"\x06_limitB\t\n" +
-	"\a_offset\"f\n" +
-	"\x17GetTrendingCoinsRequest\x12\x19\n" +
-	"\x05limit\x18\x01 \x01(\x05H\x00R\x05limit\x88\x01\x01\x12\x1b\n" +
-	"\x06offset\x18\x02 \x01(\x05H\x01R\x06offset\x88\x01\x01B\b\n" +
-	"\x06_limitB\t\n" +
-	"\a_offset\"h\n" +
-	"\x19GetTopGainersCoinsRequest\x12\x19\n" +
-	"\x05limit\x18\x01 \x01(\x05H\x00R\x05limit\x88\x01\x01\x12\x1b\n" +
-	"\x06offset\x18\x02 \x01(\x05H\x01R\x06offset\x88\x01\x01B\b\n" +
-	"\x06_limitB\t\n" +
-	"\a_offset\"e\n" +
-	"\x16GetXStocksCoinsRequest\x12\x19\n" +
-	"\x05limit\x18\x01 \x01(\x05H\x00R\x05limit\x88\x01\x01\x12\x1b\n" +
-	"\x06offset\x18\x02 \x01(\x05H\x01R\x06offset\x88\x01\x01B\b\n" +
-	"\x06_limitB\t\n" +
-	"\a_offset2\x9f\a\n" +
+func (x *GetXStocksCoinsRequest) GetIfVersionNotChanged() string {
+	if x != nil {
+		return x.IfVersionNotChanged
+	}
+	return ""
+}
+
+func (x *GetXStocksCoinsRequest) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
+type GetCoinTradeStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCoinTradeStatsRequest) Reset() {
+	*x = GetCoinTradeStatsRequest{}
+	mi := &file_dankfolio_v1_coin_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCoinTradeStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoinTradeStatsRequest) ProtoMessage() {}
+
+func (x *GetCoinTradeStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_coin_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoinTradeStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetCoinTradeStatsRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_coin_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetCoinTradeStatsRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+// TradeWindowStats is trade activity over one rolling window
+type TradeWindowStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Window        string                 `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"` // "5m", "1h" or "24h"
+	Trades        int32                  `protobuf:"varint,2,opt,name=trades,proto3" json:"trades,omitempty"`
+	Buys          int32                  `protobuf:"varint,3,opt,name=buys,proto3" json:"buys,omitempty"`
+	Sells         int32                  `protobuf:"varint,4,opt,name=sells,proto3" json:"sells,omitempty"`
+	UniqueWallets int32                  `protobuf:"varint,5,opt,name=unique_wallets,json=uniqueWallets,proto3" json:"unique_wallets,omitempty"`
+	VolumeUsd     float64                `protobuf:"fixed64,6,opt,name=volume_usd,json=volumeUsd,proto3" json:"volume_usd,omitempty"`
+	BuyVolumeUsd  float64                `protobuf:"fixed64,7,opt,name=buy_volume_usd,json=buyVolumeUsd,proto3" json:"buy_volume_usd,omitempty"`
+	SellVolumeUsd float64                `protobuf:"fixed64,8,opt,name=sell_volume_usd,json=sellVolumeUsd,proto3" json:"sell_volume_usd,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TradeWindowStats) Reset() {
+	*x = TradeWindowStats{}
+	mi := &file_dankfolio_v1_coin_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TradeWindowStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TradeWindowStats) ProtoMessage() {}
+
+func (x *TradeWindowStats) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_coin_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TradeWindowStats.ProtoReflect.Descriptor instead.
+func (*TradeWindowStats) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_coin_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TradeWindowStats) GetWindow() string {
+	if x != nil {
+		return x.Window
+	}
+	return ""
+}
+
+func (x *TradeWindowStats) GetTrades() int32 {
+	if x != nil {
+		return x.Trades
+	}
+	return 0
+}
+
+func (x *TradeWindowStats) GetBuys() int32 {
+	if x != nil {
+		return x.Buys
+	}
+	return 0
+}
+
+func (x *TradeWindowStats) GetSells() int32 {
+	if x != nil {
+		return x.Sells
+	}
+	return 0
+}
+
+func (x *TradeWindowStats) GetUniqueWallets() int32 {
+	if x != nil {
+		return x.UniqueWallets
+	}
+	return 0
+}
+
+func (x *TradeWindowStats) GetVolumeUsd() float64 {
+	if x != nil {
+		return x.VolumeUsd
+	}
+	return 0
+}
+
+func (x *TradeWindowStats) GetBuyVolumeUsd() float64 {
+	if x != nil {
+		return x.BuyVolumeUsd
+	}
+	return 0
+}
+
+func (x *TradeWindowStats) GetSellVolumeUsd() float64 {
+	if x != nil {
+		return x.SellVolumeUsd
+	}
+	return 0
+}
+
+type GetCoinTradeStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Windows       []*TradeWindowStats    `protobuf:"bytes,2,rep,name=windows,proto3" json:"windows,omitempty"` // Shortest window first
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCoinTradeStatsResponse) Reset() {
+	*x = GetCoinTradeStatsResponse{}
+	mi := &file_dankfolio_v1_coin_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCoinTradeStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoinTradeStatsResponse) ProtoMessage() {}
+
+func (x *GetCoinTradeStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_coin_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoinTradeStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetCoinTradeStatsResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_coin_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetCoinTradeStatsResponse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *GetCoinTradeStatsResponse) GetWindows() []*TradeWindowStats {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
+func (x *GetCoinTradeStatsResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GetCoinTopTradersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // Entries per list (default: 10, max: 50)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCoinTopTradersRequest) Reset() {
+	*x = GetCoinTopTradersRequest{}
+	mi := &file_dankfolio_v1_coin_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCoinTopTradersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoinTopTradersRequest) ProtoMessage() {}
+
+func (x *GetCoinTopTradersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_coin_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoinTopTradersRequest.ProtoReflect.Descriptor instead.
+func (*GetCoinTopTradersRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_coin_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetCoinTopTradersRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *GetCoinTopTradersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type TopHolder struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Wallet        string                 `protobuf:"bytes,1,opt,name=wallet,proto3" json:"wallet,omitempty"`
+	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	ValueUsd      float64                `protobuf:"fixed64,3,opt,name=value_usd,json=valueUsd,proto3" json:"value_usd,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopHolder) Reset() {
+	*x = TopHolder{}
+	mi := &file_dankfolio_v1_coin_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopHolder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopHolder) ProtoMessage() {}
+
+func (x *TopHolder) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_coin_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopHolder.ProtoReflect.Descriptor instead.
+func (*TopHolder) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_coin_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *TopHolder) GetWallet() string {
+	if x != nil {
+		return x.Wallet
+	}
+	return ""
+}
+
+func (x *TopHolder) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TopHolder) GetValueUsd() float64 {
+	if x != nil {
+		return x.ValueUsd
+	}
+	return 0
+}
+
+type TopTrader struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Wallet           string                 `protobuf:"bytes,1,opt,name=wallet,proto3" json:"wallet,omitempty"`
+	RealizedPnlUsd   float64                `protobuf:"fixed64,2,opt,name=realized_pnl_usd,json=realizedPnlUsd,proto3" json:"realized_pnl_usd,omitempty"`
+	UnrealizedPnlUsd float64                `protobuf:"fixed64,3,opt,name=unrealized_pnl_usd,json=unrealizedPnlUsd,proto3" json:"unrealized_pnl_usd,omitempty"`
+	TotalPnlUsd      float64                `protobuf:"fixed64,4,opt,name=total_pnl_usd,json=totalPnlUsd,proto3" json:"total_pnl_usd,omitempty"`
+	VolumeUsd        float64                `protobuf:"fixed64,5,opt,name=volume_usd,json=volumeUsd,proto3" json:"volume_usd,omitempty"`
+	Trades           int32                  `protobuf:"varint,6,opt,name=trades,proto3" json:"trades,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TopTrader) Reset() {
+	*x = TopTrader{}
+	mi := &file_dankfolio_v1_coin_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopTrader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopTrader) ProtoMessage() {}
+
+func (x *TopTrader) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_coin_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopTrader.ProtoReflect.Descriptor instead.
+func (*TopTrader) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_coin_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TopTrader) GetWallet() string {
+	if x != nil {
+		return x.Wallet
+	}
+	return ""
+}
+
+func (x *TopTrader) GetRealizedPnlUsd() float64 {
+	if x != nil {
+		return x.RealizedPnlUsd
+	}
+	return 0
+}
+
+func (x *TopTrader) GetUnrealizedPnlUsd() float64 {
+	if x != nil {
+		return x.UnrealizedPnlUsd
+	}
+	return 0
+}
+
+func (x *TopTrader) GetTotalPnlUsd() float64 {
+	if x != nil {
+		return x.TotalPnlUsd
+	}
+	return 0
+}
+
+func (x *TopTrader) GetVolumeUsd() float64 {
+	if x != nil {
+		return x.VolumeUsd
+	}
+	return 0
+}
+
+func (x *TopTrader) GetTrades() int32 {
+	if x != nil {
+		return x.Trades
+	}
+	return 0
+}
+
+type GetCoinTopTradersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	TopHolders    []*TopHolder           `protobuf:"bytes,2,rep,name=top_holders,json=topHolders,proto3" json:"top_holders,omitempty"`
+	TopTraders    []*TopTrader           `protobuf:"bytes,3,rep,name=top_traders,json=topTraders,proto3" json:"top_traders,omitempty"`
+	WindowHours   int32                  `protobuf:"varint,4,opt,name=window_hours,json=windowHours,proto3" json:"window_hours,omitempty"` // Lookback for top_traders
+	ComputedAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=computed_at,json=computedAt,proto3" json:"computed_at,omitempty"`     // Boards are cached and refreshed in the background
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCoinTopTradersResponse) Reset() {
+	*x = GetCoinTopTradersResponse{}
+	mi := &file_dankfolio_v1_coin_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCoinTopTradersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoinTopTradersResponse) ProtoMessage() {}
+
+func (x *GetCoinTopTradersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_coin_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoinTopTradersResponse.ProtoReflect.Descriptor instead.
+func (*GetCoinTopTradersResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_coin_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetCoinTopTradersResponse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *GetCoinTopTradersResponse) GetTopHolders() []*TopHolder {
+	if x != nil {
+		return x.TopHolders
+	}
+	return nil
+}
+
+func (x *GetCoinTopTradersResponse) GetTopTraders() []*TopTrader {
+	if x != nil {
+		return x.TopTraders
+	}
+	return nil
+}
+
+func (x *GetCoinTopTradersResponse) GetWindowHours() int32 {
+	if x != nil {
+		return x.WindowHours
+	}
+	return 0
+}
+
+func (x *GetCoinTopTradersResponse) GetComputedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ComputedAt
+	}
+	return nil
+}
+
+type GetMarketOverviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Locale        string                 `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"` // Description language
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMarketOverviewRequest) Reset() {
+	*x = GetMarketOverviewRequest{}
+	mi := &file_dankfolio_v1_coin_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMarketOverviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarketOverviewRequest) ProtoMessage() {}
+
+func (x *GetMarketOverviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_coin_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarketOverviewRequest.ProtoReflect.Descriptor instead.
+func (*GetMarketOverviewRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_coin_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetMarketOverviewRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type GetMarketOverviewResponse struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	SolPrice                 float64                `protobuf:"fixed64,1,opt,name=sol_price,json=solPrice,proto3" json:"sol_price,omitempty"`
+	SolPrice24HChangePercent float64                `protobuf:"fixed64,2,opt,name=sol_price24h_change_percent,json=solPrice24hChangePercent,proto3" json:"sol_price24h_change_percent,omitempty"`
+	TotalVolume24HUsd        float64                `protobuf:"fixed64,3,opt,name=total_volume24h_usd,json=totalVolume24hUsd,proto3" json:"total_volume24h_usd,omitempty"` // Excludes SOL and xStocks
+	NewListings24H           int32                  `protobuf:"varint,4,opt,name=new_listings24h,json=newListings24h,proto3" json:"new_listings24h,omitempty"`
+	TopGainers               []*Coin                `protobuf:"bytes,5,rep,name=top_gainers,json=topGainers,proto3" json:"top_gainers,omitempty"`
+	TopLosers                []*Coin                `protobuf:"bytes,6,rep,name=top_losers,json=topLosers,proto3" json:"top_losers,omitempty"`
+	ComputedAt               *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=computed_at,json=computedAt,proto3" json:"computed_at,omitempty"` // Recomputed on a schedule
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *GetMarketOverviewResponse) Reset() {
+	*x = GetMarketOverviewResponse{}
+	mi := &file_dankfolio_v1_coin_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMarketOverviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarketOverviewResponse) ProtoMessage() {}
+
+func (x *GetMarketOverviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_coin_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarketOverviewResponse.ProtoReflect.Descriptor instead.
+func (*GetMarketOverviewResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_coin_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetMarketOverviewResponse) GetSolPrice() float64 {
+	if x != nil {
+		return x.SolPrice
+	}
+	return 0
+}
+
+func (x *GetMarketOverviewResponse) GetSolPrice24HChangePercent() float64 {
+	if x != nil {
+		return x.SolPrice24HChangePercent
+	}
+	return 0
+}
+
+func (x *GetMarketOverviewResponse) GetTotalVolume24HUsd() float64 {
+	if x != nil {
+		return x.TotalVolume24HUsd
+	}
+	return 0
+}
+
+func (x *GetMarketOverviewResponse) GetNewListings24H() int32 {
+	if x != nil {
+		return x.NewListings24H
+	}
+	return 0
+}
+
+func (x *GetMarketOverviewResponse) GetTopGainers() []*Coin {
+	if x != nil {
+		return x.TopGainers
+	}
+	return nil
+}
+
+func (x *GetMarketOverviewResponse) GetTopLosers() []*Coin {
+	if x != nil {
+		return x.TopLosers
+	}
+	return nil
+}
+
+func (x *GetMarketOverviewResponse) GetComputedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ComputedAt
+	}
+	return nil
+}
+
+type GetRecentlyViewedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`                         // Maximum number of coins (default: 20, max: 50)
+	Locale        string                 `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`                        // Description language
+	FieldMask     *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"` // Coin fields to return
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecentlyViewedRequest) Reset() {
+	*x = GetRecentlyViewedRequest{}
+	mi := &file_dankfolio_v1_coin_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecentlyViewedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecentlyViewedRequest) ProtoMessage() {}
+
+func (x *GetRecentlyViewedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_coin_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecentlyViewedRequest.ProtoReflect.Descriptor instead.
+func (*GetRecentlyViewedRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_coin_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetRecentlyViewedRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetRecentlyViewedRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *GetRecentlyViewedRequest) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
+type GetRecentlyViewedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Coins         []*Coin                `protobuf:"bytes,1,rep,name=coins,proto3" json:"coins,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecentlyViewedResponse) Reset() {
+	*x = GetRecentlyViewedResponse{}
+	mi := &file_dankfolio_v1_coin_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecentlyViewedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecentlyViewedResponse) ProtoMessage() {}
+
+func (x *GetRecentlyViewedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_coin_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecentlyViewedResponse.ProtoReflect.Descriptor instead.
+func (*GetRecentlyViewedResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_coin_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetRecentlyViewedResponse) GetCoins() []*Coin {
+	if x != nil {
+		return x.Coins
+	}
+	return nil
+}
+
+var File_dankfolio_v1_coin_proto protoreflect.FileDescriptor
+
+const file_dankfolio_v1_coin_proto_rawDesc = "" +
+	"\n" +
+	"\x17dankfolio/v1/coin.proto\x12\fdankfolio.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x18dankfolio/v1/cache.proto\x1a google/protobuf/field_mask.proto\"\x84\f\n" +
+	"\x04Coin\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06symbol\x18\x03 \x01(\tR\x06symbol\x12\x1a\n" +
+	"\bdecimals\x18\x04 \x01(\x05R\bdecimals\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x19\n" +
+	"\blogo_uri\x18\x06 \x01(\tR\alogoUri\x12\x12\n" +
+	"\x04tags\x18\a \x03(\tR\x04tags\x12\x14\n" +
+	"\x05price\x18\b \x01(\x01R\x05price\x12;\n" +
+	"\x17price24h_change_percent\x18\t \x01(\x01H\x00R\x15price24hChangePercent\x88\x01\x01\x12!\n" +
+	"\tmarketcap\x18\n" +
+	" \x01(\x01H\x01R\tmarketcap\x88\x01\x01\x12(\n" +
+	"\rvolume24h_usd\x18\v \x01(\x01H\x02R\fvolume24hUsd\x88\x01\x01\x12=\n" +
+	"\x18volume24h_change_percent\x18\f \x01(\x01H\x03R\x16volume24hChangePercent\x88\x01\x01\x12!\n" +
+	"\tliquidity\x18\r \x01(\x01H\x04R\tliquidity\x88\x01\x01\x12\x15\n" +
+	"\x03fdv\x18\x0e \x01(\x01H\x05R\x03fdv\x88\x01\x01\x12\x17\n" +
+	"\x04rank\x18\x0f \x01(\x05H\x06R\x04rank\x88\x01\x01\x12\x1d\n" +
+	"\awebsite\x18\x10 \x01(\tH\aR\awebsite\x88\x01\x01\x12\x1d\n" +
+	"\atwitter\x18\x11 \x01(\tH\bR\atwitter\x88\x01\x01\x12\x1f\n" +
+	"\btelegram\x18\x12 \x01(\tH\tR\btelegram\x88\x01\x01\x12\x1d\n" +
+	"\adiscord\x18\x13 \x01(\tH\n" +
+	"R\adiscord\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12B\n" +
+	"\flast_updated\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampH\vR\vlastUpdated\x88\x01\x01\x12K\n" +
+	"\x11jupiter_listed_at\x18\x16 \x01(\v2\x1a.google.protobuf.TimestampH\fR\x0fjupiterListedAt\x88\x01\x01\x126\n" +
+	"\n" +
+	"cache_info\x18\x17 \x01(\v2\x17.dankfolio.v1.CacheInfoR\tcacheInfo\x12\x1a\n" +
+	"\barchived\x18\x18 \x01(\bR\barchived\x127\n" +
+	"\x15supply_burned_percent\x18\x19 \x01(\x01H\rR\x13supplyBurnedPercent\x88\x01\x01\x12/\n" +
+	"\x11lp_burned_percent\x18\x1a \x01(\x01H\x0eR\x0flpBurnedPercent\x88\x01\x01\x12A\n" +
+	"\flast_burn_at\x18\x1b \x01(\v2\x1a.google.protobuf.TimestampH\x0fR\n" +
+	"lastBurnAt\x88\x01\x01\x12&\n" +
+	"\fsafety_score\x18\x1c \x01(\x05H\x10R\vsafetyScore\x88\x01\x01\x12*\n" +
+	"\x0emint_authority\x18\x1d \x01(\tH\x11R\rmintAuthority\x88\x01\x01\x12.\n" +
+	"\x10freeze_authority\x18\x1e \x01(\tH\x12R\x0ffreezeAuthority\x88\x01\x01B\x1a\n" +
+	"\x18_price24h_change_percentB\f\n" +
+	"\n" +
+	"_marketcapB\x10\n" +
+	"\x0e_volume24h_usdB\x1b\n" +
+	"\x19_volume24h_change_percentB\f\n" +
+	"\n" +
+	"_liquidityB\x06\n" +
+	"\x04_fdvB\a\n" +
+	"\x05_rankB\n" +
+	"\n" +
+	"\b_websiteB\n" +
+	"\n" +
+	"\b_twitterB\v\n" +
+	"\t_telegramB\n" +
+	"\n" +
+	"\b_discordB\x0f\n" +
+	"\r_last_updatedB\x14\n" +
+	"\x12_jupiter_listed_atB\x18\n" +
+	"\x16_supply_burned_percentB\x14\n" +
+	"\x12_lp_burned_percentB\x0f\n" +
+	"\r_last_burn_atB\x0f\n" +
+	"\r_safety_scoreB\x11\n" +
+	"\x0f_mint_authorityB\x13\n" +
+	"\x11_freeze_authority\"\xd0\x01\n" +
+	"\x18GetAvailableCoinsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x16\n" +
+	"\x06locale\x18\x03 \x01(\tR\x06locale\x123\n" +
+	"\x16if_version_not_changed\x18\x04 \x01(\tR\x13ifVersionNotChanged\x129\n" +
+	"\n" +
+	"field_mask\x18\x05 \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMask\"\x9e\x01\n" +
+	"\x19GetAvailableCoinsResponse\x12(\n" +
+	"\x05coins\x18\x01 \x03(\v2\x12.dankfolio.v1.CoinR\x05coins\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x126\n" +
+	"\n" +
+	"cache_info\x18\x03 \x01(\v2\x17.dankfolio.v1.CacheInfoR\tcacheInfo\"{\n" +
+	"\x12GetCoinByIDRequest\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x16\n" +
+	"\x06locale\x18\x02 \x01(\tR\x06locale\x123\n" +
+	"\x16if_version_not_changed\x18\x03 \x01(\tR\x13ifVersionNotChanged\"\xac\x01\n" +
+	"\x14GetCoinsByIDsRequest\x12\x1c\n" +
+	"\taddresses\x18\x01 \x03(\tR\taddresses\x12#\n" +
+	"\rforce_refresh\x18\x02 \x01(\bR\fforceRefresh\x12\x16\n" +
+	"\x06locale\x18\x03 \x01(\tR\x06locale\x129\n" +
+	"\n" +
+	"field_mask\x18\x04 \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMask\"A\n" +
+	"\x15GetCoinsByIDsResponse\x12(\n" +
+	"\x05coins\x18\x01 \x03(\v2\x12.dankfolio.v1.CoinR\x05coins\"N\n" +
+	"\x1aSearchCoinByAddressRequest\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x16\n" +
+	"\x06locale\x18\x02 \x01(\tR\x06locale\"E\n" +
+	"\x1bSearchCoinByAddressResponse\x12&\n" +
+	"\x04coin\x18\x01 \x01(\v2\x12.dankfolio.v1.CoinR\x04coin\"\x14\n" +
+	"\x12GetAllCoinsRequest\"?\n" +
+	"\x13GetAllCoinsResponse\x12(\n" +
+	"\x05coins\x18\x01 \x03(\v2\x12.dankfolio.v1.CoinR\x05coins\"\xd1\x01\n" +
+	"\rSearchRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\x12\x16\n" +
+	"\x06locale\x18\x04 \x01(\tR\x06locale\x129\n" +
+	"\n" +
+	"field_mask\x18\x05 \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMask\x12)\n" +
+	"\x10include_archived\x18\x06 \x01(\bR\x0fincludeArchived\"[\n" +
+	"\x0eSearchResponse\x12(\n" +
+	"\x05coins\x18\x01 \x03(\v2\x12.dankfolio.v1.CoinR\x05coins\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\"\xe9\x01\n" +
+	"\x12GetNewCoinsRequest\x12\x19\n" +
+	"\x05limit\x18\x01 \x01(\x05H\x00R\x05limit\x88\x01\x01\x12\x1b\n" +
+	"\x06offset\x18\x02 \x01(\x05H\x01R\x06offset\x88\x01\x01\x12\x16\n" +
+	"\x06locale\x18\x03 \x01(\tR\x06locale\x123\n" +
+	"\x16if_version_not_changed\x18\x04 \x01(\tR\x13ifVersionNotChanged\x129\n" +
+	"\n" +
+	"field_mask\x18\x05 \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMaskB\b\n" +
+	"\x06_limitB\t\n" +
+	"\a_offset\"\xee\x01\n" +
+	"\x17GetTrendingCoinsRequest\x12\x19\n" +
+	"\x05limit\x18\x01 \x01(\x05H\x00R\x05limit\x88\x01\x01\x12\x1b\n" +
+	"\x06offset\x18\x02 \x01(\x05H\x01R\x06offset\x88\x01\x01\x12\x16\n" +
+	"\x06locale\x18\x03 \x01(\tR\x06locale\x123\n" +
+	"\x16if_version_not_changed\x18\x04 \x01(\tR\x13ifVersionNotChanged\x129\n" +
+	"\n" +
+	"field_mask\x18\x05 \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMaskB\b\n" +
+	"\x06_limitB\t\n" +
+	"\a_offset\"\xf0\x01\n" +
+	"\x19GetTopGainersCoinsRequest\x12\x19\n" +
+	"\x05limit\x18\x01 \x01(\x05H\x00R\x05limit\x88\x01\x01\x12\x1b\n" +
+	"\x06offset\x18\x02 \x01(\x05H\x01R\x06offset\x88\x01\x01\x12\x16\n" +
+	"\x06locale\x18\x03 \x01(\tR\x06locale\x123\n" +
+	"\x16if_version_not_changed\x18\x04 \x01(\tR\x13ifVersionNotChanged\x129\n" +
+	"\n" +
+	"field_mask\x18\x05 \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMaskB\b\n" +
+	"\x06_limitB\t\n" +
+	"\a_offset\"\xed\x01\n" +
+	"\x16GetXStocksCoinsRequest\x12\x19\n" +
+	"\x05limit\x18\x01 \x01(\x05H\x00R\x05limit\x88\x01\x01\x12\x1b\n" +
+	"\x06offset\x18\x02 \x01(\x05H\x01R\x06offset\x88\x01\x01\x12\x16\n" +
+	"\x06locale\x18\x03 \x01(\tR\x06locale\x123\n" +
+	"\x16if_version_not_changed\x18\x04 \x01(\tR\x13ifVersionNotChanged\x129\n" +
+	"\n" +
+	"field_mask\x18\x05 \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMaskB\b\n" +
+	"\x06_limitB\t\n" +
+	"\a_offset\"4\n" +
+	"\x18GetCoinTradeStatsRequest\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\"\x80\x02\n" +
+	"\x10TradeWindowStats\x12\x16\n" +
+	"\x06window\x18\x01 \x01(\tR\x06window\x12\x16\n" +
+	"\x06trades\x18\x02 \x01(\x05R\x06trades\x12\x12\n" +
+	"\x04buys\x18\x03 \x01(\x05R\x04buys\x12\x14\n" +
+	"\x05sells\x18\x04 \x01(\x05R\x05sells\x12%\n" +
+	"\x0eunique_wallets\x18\x05 \x01(\x05R\runiqueWallets\x12\x1d\n" +
+	"\n" +
+	"volume_usd\x18\x06 \x01(\x01R\tvolumeUsd\x12$\n" +
+	"\x0ebuy_volume_usd\x18\a \x01(\x01R\fbuyVolumeUsd\x12&\n" +
+	"\x0fsell_volume_usd\x18\b \x01(\x01R\rsellVolumeUsd\"\xaa\x01\n" +
+	"\x19GetCoinTradeStatsResponse\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x128\n" +
+	"\awindows\x18\x02 \x03(\v2\x1e.dankfolio.v1.TradeWindowStatsR\awindows\x129\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"J\n" +
+	"\x18GetCoinTopTradersRequest\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"X\n" +
+	"\tTopHolder\x12\x16\n" +
+	"\x06wallet\x18\x01 \x01(\tR\x06wallet\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\x1b\n" +
+	"\tvalue_usd\x18\x03 \x01(\x01R\bvalueUsd\"\xd6\x01\n" +
+	"\tTopTrader\x12\x16\n" +
+	"\x06wallet\x18\x01 \x01(\tR\x06wallet\x12(\n" +
+	"\x10realized_pnl_usd\x18\x02 \x01(\x01R\x0erealizedPnlUsd\x12,\n" +
+	"\x12unrealized_pnl_usd\x18\x03 \x01(\x01R\x10unrealizedPnlUsd\x12\"\n" +
+	"\rtotal_pnl_usd\x18\x04 \x01(\x01R\vtotalPnlUsd\x12\x1d\n" +
+	"\n" +
+	"volume_usd\x18\x05 \x01(\x01R\tvolumeUsd\x12\x16\n" +
+	"\x06trades\x18\x06 \x01(\x05R\x06trades\"\x89\x02\n" +
+	"\x19GetCoinTopTradersResponse\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x128\n" +
+	"\vtop_holders\x18\x02 \x03(\v2\x17.dankfolio.v1.TopHolderR\n" +
+	"topHolders\x128\n" +
+	"\vtop_traders\x18\x03 \x03(\v2\x17.dankfolio.v1.TopTraderR\n" +
+	"topTraders\x12!\n" +
+	"\fwindow_hours\x18\x04 \x01(\x05R\vwindowHours\x12;\n" +
+	"\vcomputed_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"computedAt\"2\n" +
+	"\x18GetMarketOverviewRequest\x12\x16\n" +
+	"\x06locale\x18\x01 \x01(\tR\x06locale\"\xf5\x02\n" +
+	"\x19GetMarketOverviewResponse\x12\x1b\n" +
+	"\tsol_price\x18\x01 \x01(\x01R\bsolPrice\x12=\n" +
+	"\x1bsol_price24h_change_percent\x18\x02 \x01(\x01R\x18solPrice24hChangePercent\x12.\n" +
+	"\x13total_volume24h_usd\x18\x03 \x01(\x01R\x11totalVolume24hUsd\x12'\n" +
+	"\x0fnew_listings24h\x18\x04 \x01(\x05R\x0enewListings24h\x123\n" +
+	"\vtop_gainers\x18\x05 \x03(\v2\x12.dankfolio.v1.CoinR\n" +
+	"topGainers\x121\n" +
+	"\n" +
+	"top_losers\x18\x06 \x03(\v2\x12.dankfolio.v1.CoinR\ttopLosers\x12;\n" +
+	"\vcomputed_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"computedAt\"\x83\x01\n" +
+	"\x18GetRecentlyViewedRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06locale\x18\x02 \x01(\tR\x06locale\x129\n" +
+	"\n" +
+	"field_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMask\"E\n" +
+	"\x19GetRecentlyViewedResponse\x12(\n" +
+	"\x05coins\x18\x01 \x03(\v2\x12.dankfolio.v1.CoinR\x05coins2\xb7\n" +
+	"\n" +
 	"\vCoinService\x12d\n" +
 	"\x11GetAvailableCoins\x12&.dankfolio.v1.GetAvailableCoinsRequest\x1a'.dankfolio.v1.GetAvailableCoinsResponse\x12C\n" +
 	"\vGetCoinByID\x12 .dankfolio.v1.GetCoinByIDRequest\x1a\x12.dankfolio.v1.Coin\x12X\n" +
@@ -1076,7 +2160,11 @@ const file_dankfolio_v1_coin_proto_rawDesc = "" +
 	"\vGetNewCoins\x12 .dankfolio.v1.GetNewCoinsRequest\x1a'.dankfolio.v1.GetAvailableCoinsResponse\x12b\n" +
 	"\x10GetTrendingCoins\x12%.dankfolio.v1.GetTrendingCoinsRequest\x1a'.dankfolio.v1.GetAvailableCoinsResponse\x12f\n" +
 	"\x12GetTopGainersCoins\x12'.dankfolio.v1.GetTopGainersCoinsRequest\x1a'.dankfolio.v1.GetAvailableCoinsResponse\x12`\n" +
-	"\x0fGetXStocksCoins\x12$.dankfolio.v1.GetXStocksCoinsRequest\x1a'.dankfolio.v1.GetAvailableCoinsResponseB\xb5\x01\n" +
+	"\x0fGetXStocksCoins\x12$.dankfolio.v1.GetXStocksCoinsRequest\x1a'.dankfolio.v1.GetAvailableCoinsResponse\x12d\n" +
+	"\x11GetCoinTradeStats\x12&.dankfolio.v1.GetCoinTradeStatsRequest\x1a'.dankfolio.v1.GetCoinTradeStatsResponse\x12d\n" +
+	"\x11GetCoinTopTraders\x12&.dankfolio.v1.GetCoinTopTradersRequest\x1a'.dankfolio.v1.GetCoinTopTradersResponse\x12d\n" +
+	"\x11GetMarketOverview\x12&.dankfolio.v1.GetMarketOverviewRequest\x1a'.dankfolio.v1.GetMarketOverviewResponse\x12d\n" +
+	"\x11GetRecentlyViewed\x12&.dankfolio.v1.GetRecentlyViewedRequest\x1a'.dankfolio.v1.GetRecentlyViewedResponseB\xb5\x01\n" +
 	"\x10com.dankfolio.v1B\tCoinProtoP\x01ZEgithub.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1\xa2\x02\x03DXX\xaa\x02\fDankfolio.V1\xca\x02\fDankfolio\\V1\xe2\x02\x18Dankfolio\\V1\\GPBMetadata\xea\x02\rDankfolio::V1b\x06proto3"
 
 var (
@@ -1091,7 +2179,7 @@ func file_dankfolio_v1_coin_proto_rawDescGZIP() []byte {
 	return file_dankfolio_v1_coin_proto_rawDescData
 }
 
-var file_dankfolio_v1_coin_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_dankfolio_v1_coin_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_dankfolio_v1_coin_proto_goTypes = []any{
 	(*Coin)(nil),                        // 0: dankfolio.v1.Coin
 	(*GetAvailableCoinsRequest)(nil),    // 1: dankfolio.v1.GetAvailableCoinsRequest
@@ -1109,42 +2197,83 @@ var file_dankfolio_v1_coin_proto_goTypes = []any{
 	(*GetTrendingCoinsRequest)(nil),     // 13: dankfolio.v1.GetTrendingCoinsRequest
 	(*GetTopGainersCoinsRequest)(nil),   // 14: dankfolio.v1.GetTopGainersCoinsRequest
 	(*GetXStocksCoinsRequest)(nil),      // 15: dankfolio.v1.GetXStocksCoinsRequest
-	(*timestamppb.Timestamp)(nil),       // 16: google.protobuf.Timestamp
+	(*GetCoinTradeStatsRequest)(nil),    // 16: dankfolio.v1.GetCoinTradeStatsRequest
+	(*TradeWindowStats)(nil),            // 17: dankfolio.v1.TradeWindowStats
+	(*GetCoinTradeStatsResponse)(nil),   // 18: dankfolio.v1.GetCoinTradeStatsResponse
+	(*GetCoinTopTradersRequest)(nil),    // 19: dankfolio.v1.GetCoinTopTradersRequest
+	(*TopHolder)(nil),                   // 20: dankfolio.v1.TopHolder
+	(*TopTrader)(nil),                   // 21: dankfolio.v1.TopTrader
+	(*GetCoinTopTradersResponse)(nil),   // 22: dankfolio.v1.GetCoinTopTradersResponse
+	(*GetMarketOverviewRequest)(nil),    // 23: dankfolio.v1.GetMarketOverviewRequest
+	(*GetMarketOverviewResponse)(nil),   // 24: dankfolio.v1.GetMarketOverviewResponse
+	(*GetRecentlyViewedRequest)(nil),    // 25: dankfolio.v1.GetRecentlyViewedRequest
+	(*GetRecentlyViewedResponse)(nil),   // 26: dankfolio.v1.GetRecentlyViewedResponse
+	(*timestamppb.Timestamp)(nil),       // 27: google.protobuf.Timestamp
+	(*CacheInfo)(nil),                   // 28: dankfolio.v1.CacheInfo
+	(*fieldmaskpb.FieldMask)(nil),       // 29: google.protobuf.FieldMask
 }
 var file_dankfolio_v1_coin_proto_depIdxs = []int32{
-	16, // 0: dankfolio.v1.Coin.created_at:type_name -> google.protobuf.Timestamp
-	16, // 1: dankfolio.v1.Coin.last_updated:type_name -> google.protobuf.Timestamp
-	16, // 2: dankfolio.v1.Coin.jupiter_listed_at:type_name -> google.protobuf.Timestamp
-	0,  // 3: dankfolio.v1.GetAvailableCoinsResponse.coins:type_name -> dankfolio.v1.Coin
-	0,  // 4: dankfolio.v1.GetCoinsByIDsResponse.coins:type_name -> dankfolio.v1.Coin
-	0,  // 5: dankfolio.v1.SearchCoinByAddressResponse.coin:type_name -> dankfolio.v1.Coin
-	0,  // 6: dankfolio.v1.GetAllCoinsResponse.coins:type_name -> dankfolio.v1.Coin
-	0,  // 7: dankfolio.v1.SearchResponse.coins:type_name -> dankfolio.v1.Coin
-	1,  // 8: dankfolio.v1.CoinService.GetAvailableCoins:input_type -> dankfolio.v1.GetAvailableCoinsRequest
-	3,  // 9: dankfolio.v1.CoinService.GetCoinByID:input_type -> dankfolio.v1.GetCoinByIDRequest
-	4,  // 10: dankfolio.v1.CoinService.GetCoinsByIDs:input_type -> dankfolio.v1.GetCoinsByIDsRequest
-	6,  // 11: dankfolio.v1.CoinService.SearchCoinByAddress:input_type -> dankfolio.v1.SearchCoinByAddressRequest
-	8,  // 12: dankfolio.v1.CoinService.GetAllCoins:input_type -> dankfolio.v1.GetAllCoinsRequest
-	10, // 13: dankfolio.v1.CoinService.Search:input_type -> dankfolio.v1.SearchRequest
-	12, // 14: dankfolio.v1.CoinService.GetNewCoins:input_type -> dankfolio.v1.GetNewCoinsRequest
-	13, // 15: dankfolio.v1.CoinService.GetTrendingCoins:input_type -> dankfolio.v1.GetTrendingCoinsRequest
-	14, // 16: dankfolio.v1.CoinService.GetTopGainersCoins:input_type -> dankfolio.v1.GetTopGainersCoinsRequest
-	15, // 17: dankfolio.v1.CoinService.GetXStocksCoins:input_type -> dankfolio.v1.GetXStocksCoinsRequest
-	2,  // 18: dankfolio.v1.CoinService.GetAvailableCoins:output_type -> dankfolio.v1.GetAvailableCoinsResponse
-	0,  // 19: dankfolio.v1.CoinService.GetCoinByID:output_type -> dankfolio.v1.Coin
-	5,  // 20: dankfolio.v1.CoinService.GetCoinsByIDs:output_type -> dankfolio.v1.GetCoinsByIDsResponse
-	7,  // 21: dankfolio.v1.CoinService.SearchCoinByAddress:output_type -> dankfolio.v1.SearchCoinByAddressResponse
-	9,  // 22: dankfolio.v1.CoinService.GetAllCoins:output_type -> dankfolio.v1.GetAllCoinsResponse
-	11, // 23: dankfolio.v1.CoinService.Search:output_type -> dankfolio.v1.SearchResponse
-	2,  // 24: dankfolio.v1.CoinService.GetNewCoins:output_type -> dankfolio.v1.GetAvailableCoinsResponse
-	2,  // 25: dankfolio.v1.CoinService.GetTrendingCoins:output_type -> dankfolio.v1.GetAvailableCoinsResponse
-	2,  // 26: dankfolio.v1.CoinService.GetTopGainersCoins:output_type -> dankfolio.v1.GetAvailableCoinsResponse
-	2,  // 27: dankfolio.v1.CoinService.GetXStocksCoins:output_type -> dankfolio.v1.GetAvailableCoinsResponse
-	18, // [18:28] is the sub-list for method output_type
-	8,  // [8:18] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	27, // 0: dankfolio.v1.Coin.created_at:type_name -> google.protobuf.Timestamp
+	27, // 1: dankfolio.v1.Coin.last_updated:type_name -> google.protobuf.Timestamp
+	27, // 2: dankfolio.v1.Coin.jupiter_listed_at:type_name -> google.protobuf.Timestamp
+	28, // 3: dankfolio.v1.Coin.cache_info:type_name -> dankfolio.v1.CacheInfo
+	27, // 4: dankfolio.v1.Coin.last_burn_at:type_name -> google.protobuf.Timestamp
+	29, // 5: dankfolio.v1.GetAvailableCoinsRequest.field_mask:type_name -> google.protobuf.FieldMask
+	0,  // 6: dankfolio.v1.GetAvailableCoinsResponse.coins:type_name -> dankfolio.v1.Coin
+	28, // 7: dankfolio.v1.GetAvailableCoinsResponse.cache_info:type_name -> dankfolio.v1.CacheInfo
+	29, // 8: dankfolio.v1.GetCoinsByIDsRequest.field_mask:type_name -> google.protobuf.FieldMask
+	0,  // 9: dankfolio.v1.GetCoinsByIDsResponse.coins:type_name -> dankfolio.v1.Coin
+	0,  // 10: dankfolio.v1.SearchCoinByAddressResponse.coin:type_name -> dankfolio.v1.Coin
+	0,  // 11: dankfolio.v1.GetAllCoinsResponse.coins:type_name -> dankfolio.v1.Coin
+	29, // 12: dankfolio.v1.SearchRequest.field_mask:type_name -> google.protobuf.FieldMask
+	0,  // 13: dankfolio.v1.SearchResponse.coins:type_name -> dankfolio.v1.Coin
+	29, // 14: dankfolio.v1.GetNewCoinsRequest.field_mask:type_name -> google.protobuf.FieldMask
+	29, // 15: dankfolio.v1.GetTrendingCoinsRequest.field_mask:type_name -> google.protobuf.FieldMask
+	29, // 16: dankfolio.v1.GetTopGainersCoinsRequest.field_mask:type_name -> google.protobuf.FieldMask
+	29, // 17: dankfolio.v1.GetXStocksCoinsRequest.field_mask:type_name -> google.protobuf.FieldMask
+	17, // 18: dankfolio.v1.GetCoinTradeStatsResponse.windows:type_name -> dankfolio.v1.TradeWindowStats
+	27, // 19: dankfolio.v1.GetCoinTradeStatsResponse.updated_at:type_name -> google.protobuf.Timestamp
+	20, // 20: dankfolio.v1.GetCoinTopTradersResponse.top_holders:type_name -> dankfolio.v1.TopHolder
+	21, // 21: dankfolio.v1.GetCoinTopTradersResponse.top_traders:type_name -> dankfolio.v1.TopTrader
+	27, // 22: dankfolio.v1.GetCoinTopTradersResponse.computed_at:type_name -> google.protobuf.Timestamp
+	0,  // 23: dankfolio.v1.GetMarketOverviewResponse.top_gainers:type_name -> dankfolio.v1.Coin
+	0,  // 24: dankfolio.v1.GetMarketOverviewResponse.top_losers:type_name -> dankfolio.v1.Coin
+	27, // 25: dankfolio.v1.GetMarketOverviewResponse.computed_at:type_name -> google.protobuf.Timestamp
+	29, // 26: dankfolio.v1.GetRecentlyViewedRequest.field_mask:type_name -> google.protobuf.FieldMask
+	0,  // 27: dankfolio.v1.GetRecentlyViewedResponse.coins:type_name -> dankfolio.v1.Coin
+	1,  // 28: dankfolio.v1.CoinService.GetAvailableCoins:input_type -> dankfolio.v1.GetAvailableCoinsRequest
+	3,  // 29: dankfolio.v1.CoinService.GetCoinByID:input_type -> dankfolio.v1.GetCoinByIDRequest
+	4,  // 30: dankfolio.v1.CoinService.GetCoinsByIDs:input_type -> dankfolio.v1.GetCoinsByIDsRequest
+	6,  // 31: dankfolio.v1.CoinService.SearchCoinByAddress:input_type -> dankfolio.v1.SearchCoinByAddressRequest
+	8,  // 32: dankfolio.v1.CoinService.GetAllCoins:input_type -> dankfolio.v1.GetAllCoinsRequest
+	10, // 33: dankfolio.v1.CoinService.Search:input_type -> dankfolio.v1.SearchRequest
+	12, // 34: dankfolio.v1.CoinService.GetNewCoins:input_type -> dankfolio.v1.GetNewCoinsRequest
+	13, // 35: dankfolio.v1.CoinService.GetTrendingCoins:input_type -> dankfolio.v1.GetTrendingCoinsRequest
+	14, // 36: dankfolio.v1.CoinService.GetTopGainersCoins:input_type -> dankfolio.v1.GetTopGainersCoinsRequest
+	15, // 37: dankfolio.v1.CoinService.GetXStocksCoins:input_type -> dankfolio.v1.GetXStocksCoinsRequest
+	16, // 38: dankfolio.v1.CoinService.GetCoinTradeStats:input_type -> dankfolio.v1.GetCoinTradeStatsRequest
+	19, // 39: dankfolio.v1.CoinService.GetCoinTopTraders:input_type -> dankfolio.v1.GetCoinTopTradersRequest
+	23, // 40: dankfolio.v1.CoinService.GetMarketOverview:input_type -> dankfolio.v1.GetMarketOverviewRequest
+	25, // 41: dankfolio.v1.CoinService.GetRecentlyViewed:input_type -> dankfolio.v1.GetRecentlyViewedRequest
+	2,  // 42: dankfolio.v1.CoinService.GetAvailableCoins:output_type -> dankfolio.v1.GetAvailableCoinsResponse
+	0,  // 43: dankfolio.v1.CoinService.GetCoinByID:output_type -> dankfolio.v1.Coin
+	5,  // 44: dankfolio.v1.CoinService.GetCoinsByIDs:output_type -> dankfolio.v1.GetCoinsByIDsResponse
+	7,  // 45: dankfolio.v1.CoinService.SearchCoinByAddress:output_type -> dankfolio.v1.SearchCoinByAddressResponse
+	9,  // 46: dankfolio.v1.CoinService.GetAllCoins:output_type -> dankfolio.v1.GetAllCoinsResponse
+	11, // 47: dankfolio.v1.CoinService.Search:output_type -> dankfolio.v1.SearchResponse
+	2,  // 48: dankfolio.v1.CoinService.GetNewCoins:output_type -> dankfolio.v1.GetAvailableCoinsResponse
+	2,  // 49: dankfolio.v1.CoinService.GetTrendingCoins:output_type -> dankfolio.v1.GetAvailableCoinsResponse
+	2,  // 50: dankfolio.v1.CoinService.GetTopGainersCoins:output_type -> dankfolio.v1.GetAvailableCoinsResponse
+	2,  // 51: dankfolio.v1.CoinService.GetXStocksCoins:output_type -> dankfolio.v1.GetAvailableCoinsResponse
+	18, // 52: dankfolio.v1.CoinService.GetCoinTradeStats:output_type -> dankfolio.v1.GetCoinTradeStatsResponse
+	22, // 53: dankfolio.v1.CoinService.GetCoinTopTraders:output_type -> dankfolio.v1.GetCoinTopTradersResponse
+	24, // 54: dankfolio.v1.CoinService.GetMarketOverview:output_type -> dankfolio.v1.GetMarketOverviewResponse
+	26, // 55: dankfolio.v1.CoinService.GetRecentlyViewed:output_type -> dankfolio.v1.GetRecentlyViewedResponse
+	42, // [42:56] is the sub-list for method output_type
+	28, // [28:42] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_dankfolio_v1_coin_proto_init() }
@@ -1152,6 +2281,7 @@ func file_dankfolio_v1_coin_proto_init() {
 	if File_dankfolio_v1_coin_proto != nil {
 		return
 	}
+	file_dankfolio_v1_cache_proto_init()
 	file_dankfolio_v1_coin_proto_msgTypes[0].OneofWrappers = []any{}
 	file_dankfolio_v1_coin_proto_msgTypes[12].OneofWrappers = []any{}
 	file_dankfolio_v1_coin_proto_msgTypes[13].OneofWrappers = []any{}
@@ -1163,7 +2293,7 @@ func file_dankfolio_v1_coin_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_dankfolio_v1_coin_proto_rawDesc), len(file_dankfolio_v1_coin_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,665 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: dankfolio/v1/notification.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type NotificationPreferences struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WalletAddress string                 `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
+	// Notification types the wallet does not want: "transfer_received", "trade_confirmed", "token_dump",
+	// "price_alert", "announcement".
+	DisabledTypes []string `protobuf:"bytes,2,rep,name=disabled_types,json=disabledTypes,proto3" json:"disabled_types,omitempty"`
+	// Incoming transfers worth less than this are not notified.
+	TransferThresholdUsd float64 `protobuf:"fixed64,3,opt,name=transfer_threshold_usd,json=transferThresholdUsd,proto3" json:"transfer_threshold_usd,omitempty"`
+	// A held token falling by at least this much within an hour is notified as a dump.
+	DumpThresholdPercent float64 `protobuf:"fixed64,4,opt,name=dump_threshold_percent,json=dumpThresholdPercent,proto3" json:"dump_threshold_percent,omitempty"`
+	DeviceCount          int32   `protobuf:"varint,5,opt,name=device_count,json=deviceCount,proto3" json:"device_count,omitempty"`
+	// "push", or "none" to send nothing.
+	Channel string `protobuf:"bytes,6,opt,name=channel,proto3" json:"channel,omitempty"`
+	// Nothing is sent from quiet_hours_start until quiet_hours_end, as "15:04" in timezone. Empty when not set.
+	QuietHoursStart string `protobuf:"bytes,7,opt,name=quiet_hours_start,json=quietHoursStart,proto3" json:"quiet_hours_start,omitempty"`
+	QuietHoursEnd   string `protobuf:"bytes,8,opt,name=quiet_hours_end,json=quietHoursEnd,proto3" json:"quiet_hours_end,omitempty"`
+	// IANA time zone name, e.g. "America/Toronto". UTC when empty.
+	Timezone      string `protobuf:"bytes,9,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationPreferences) Reset() {
+	*x = NotificationPreferences{}
+	mi := &file_dankfolio_v1_notification_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationPreferences) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationPreferences) ProtoMessage() {}
+
+func (x *NotificationPreferences) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_notification_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationPreferences.ProtoReflect.Descriptor instead.
+func (*NotificationPreferences) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_notification_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *NotificationPreferences) GetWalletAddress() string {
+	if x != nil {
+		return x.WalletAddress
+	}
+	return ""
+}
+
+func (x *NotificationPreferences) GetDisabledTypes() []string {
+	if x != nil {
+		return x.DisabledTypes
+	}
+	return nil
+}
+
+func (x *NotificationPreferences) GetTransferThresholdUsd() float64 {
+	if x != nil {
+		return x.TransferThresholdUsd
+	}
+	return 0
+}
+
+func (x *NotificationPreferences) GetDumpThresholdPercent() float64 {
+	if x != nil {
+		return x.DumpThresholdPercent
+	}
+	return 0
+}
+
+func (x *NotificationPreferences) GetDeviceCount() int32 {
+	if x != nil {
+		return x.DeviceCount
+	}
+	return 0
+}
+
+func (x *NotificationPreferences) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *NotificationPreferences) GetQuietHoursStart() string {
+	if x != nil {
+		return x.QuietHoursStart
+	}
+	return ""
+}
+
+func (x *NotificationPreferences) GetQuietHoursEnd() string {
+	if x != nil {
+		return x.QuietHoursEnd
+	}
+	return ""
+}
+
+func (x *NotificationPreferences) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+type RegisterDeviceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WalletAddress string                 `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
+	DeviceToken   string                 `protobuf:"bytes,2,opt,name=device_token,json=deviceToken,proto3" json:"device_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterDeviceRequest) Reset() {
+	*x = RegisterDeviceRequest{}
+	mi := &file_dankfolio_v1_notification_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterDeviceRequest) ProtoMessage() {}
+
+func (x *RegisterDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_notification_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterDeviceRequest.ProtoReflect.Descriptor instead.
+func (*RegisterDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_notification_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RegisterDeviceRequest) GetWalletAddress() string {
+	if x != nil {
+		return x.WalletAddress
+	}
+	return ""
+}
+
+func (x *RegisterDeviceRequest) GetDeviceToken() string {
+	if x != nil {
+		return x.DeviceToken
+	}
+	return ""
+}
+
+type RegisterDeviceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterDeviceResponse) Reset() {
+	*x = RegisterDeviceResponse{}
+	mi := &file_dankfolio_v1_notification_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterDeviceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterDeviceResponse) ProtoMessage() {}
+
+func (x *RegisterDeviceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_notification_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterDeviceResponse.ProtoReflect.Descriptor instead.
+func (*RegisterDeviceResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_notification_proto_rawDescGZIP(), []int{2}
+}
+
+type UnregisterDeviceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WalletAddress string                 `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
+	DeviceToken   string                 `protobuf:"bytes,2,opt,name=device_token,json=deviceToken,proto3" json:"device_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnregisterDeviceRequest) Reset() {
+	*x = UnregisterDeviceRequest{}
+	mi := &file_dankfolio_v1_notification_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnregisterDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnregisterDeviceRequest) ProtoMessage() {}
+
+func (x *UnregisterDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_notification_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnregisterDeviceRequest.ProtoReflect.Descriptor instead.
+func (*UnregisterDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_notification_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UnregisterDeviceRequest) GetWalletAddress() string {
+	if x != nil {
+		return x.WalletAddress
+	}
+	return ""
+}
+
+func (x *UnregisterDeviceRequest) GetDeviceToken() string {
+	if x != nil {
+		return x.DeviceToken
+	}
+	return ""
+}
+
+type UnregisterDeviceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnregisterDeviceResponse) Reset() {
+	*x = UnregisterDeviceResponse{}
+	mi := &file_dankfolio_v1_notification_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnregisterDeviceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnregisterDeviceResponse) ProtoMessage() {}
+
+func (x *UnregisterDeviceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_notification_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnregisterDeviceResponse.ProtoReflect.Descriptor instead.
+func (*UnregisterDeviceResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_notification_proto_rawDescGZIP(), []int{4}
+}
+
+type GetNotificationPreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WalletAddress string                 `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationPreferencesRequest) Reset() {
+	*x = GetNotificationPreferencesRequest{}
+	mi := &file_dankfolio_v1_notification_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationPreferencesRequest) ProtoMessage() {}
+
+func (x *GetNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_notification_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*GetNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_notification_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetNotificationPreferencesRequest) GetWalletAddress() string {
+	if x != nil {
+		return x.WalletAddress
+	}
+	return ""
+}
+
+type GetNotificationPreferencesResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Preferences   *NotificationPreferences `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationPreferencesResponse) Reset() {
+	*x = GetNotificationPreferencesResponse{}
+	mi := &file_dankfolio_v1_notification_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationPreferencesResponse) ProtoMessage() {}
+
+func (x *GetNotificationPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_notification_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*GetNotificationPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_notification_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetNotificationPreferencesResponse) GetPreferences() *NotificationPreferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+type UpdateNotificationPreferencesRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	WalletAddress        string                 `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
+	DisabledTypes        []string               `protobuf:"bytes,2,rep,name=disabled_types,json=disabledTypes,proto3" json:"disabled_types,omitempty"`
+	TransferThresholdUsd *float64               `protobuf:"fixed64,3,opt,name=transfer_threshold_usd,json=transferThresholdUsd,proto3,oneof" json:"transfer_threshold_usd,omitempty"` // Unchanged when unset
+	DumpThresholdPercent *float64               `protobuf:"fixed64,4,opt,name=dump_threshold_percent,json=dumpThresholdPercent,proto3,oneof" json:"dump_threshold_percent,omitempty"` // Unchanged when unset
+	Channel              *string                `protobuf:"bytes,5,opt,name=channel,proto3,oneof" json:"channel,omitempty"`                                                           // Unchanged when unset
+	// Set both, or both to "" to clear quiet hours. Unchanged when unset.
+	QuietHoursStart *string `protobuf:"bytes,6,opt,name=quiet_hours_start,json=quietHoursStart,proto3,oneof" json:"quiet_hours_start,omitempty"`
+	QuietHoursEnd   *string `protobuf:"bytes,7,opt,name=quiet_hours_end,json=quietHoursEnd,proto3,oneof" json:"quiet_hours_end,omitempty"`
+	Timezone        *string `protobuf:"bytes,8,opt,name=timezone,proto3,oneof" json:"timezone,omitempty"` // Unchanged when unset
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateNotificationPreferencesRequest) Reset() {
+	*x = UpdateNotificationPreferencesRequest{}
+	mi := &file_dankfolio_v1_notification_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNotificationPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotificationPreferencesRequest) ProtoMessage() {}
+
+func (x *UpdateNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_notification_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_notification_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateNotificationPreferencesRequest) GetWalletAddress() string {
+	if x != nil {
+		return x.WalletAddress
+	}
+	return ""
+}
+
+func (x *UpdateNotificationPreferencesRequest) GetDisabledTypes() []string {
+	if x != nil {
+		return x.DisabledTypes
+	}
+	return nil
+}
+
+func (x *UpdateNotificationPreferencesRequest) GetTransferThresholdUsd() float64 {
+	if x != nil && x.TransferThresholdUsd != nil {
+		return *x.TransferThresholdUsd
+	}
+	return 0
+}
+
+func (x *UpdateNotificationPreferencesRequest) GetDumpThresholdPercent() float64 {
+	if x != nil && x.DumpThresholdPercent != nil {
+		return *x.DumpThresholdPercent
+	}
+	return 0
+}
+
+func (x *UpdateNotificationPreferencesRequest) GetChannel() string {
+	if x != nil && x.Channel != nil {
+		return *x.Channel
+	}
+	return ""
+}
+
+func (x *UpdateNotificationPreferencesRequest) GetQuietHoursStart() string {
+	if x != nil && x.QuietHoursStart != nil {
+		return *x.QuietHoursStart
+	}
+	return ""
+}
+
+func (x *UpdateNotificationPreferencesRequest) GetQuietHoursEnd() string {
+	if x != nil && x.QuietHoursEnd != nil {
+		return *x.QuietHoursEnd
+	}
+	return ""
+}
+
+func (x *UpdateNotificationPreferencesRequest) GetTimezone() string {
+	if x != nil && x.Timezone != nil {
+		return *x.Timezone
+	}
+	return ""
+}
+
+type UpdateNotificationPreferencesResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Preferences   *NotificationPreferences `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNotificationPreferencesResponse) Reset() {
+	*x = UpdateNotificationPreferencesResponse{}
+	mi := &file_dankfolio_v1_notification_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNotificationPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotificationPreferencesResponse) ProtoMessage() {}
+
+func (x *UpdateNotificationPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_notification_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotificationPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_notification_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateNotificationPreferencesResponse) GetPreferences() *NotificationPreferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+var File_dankfolio_v1_notification_proto protoreflect.FileDescriptor
+
+const file_dankfolio_v1_notification_proto_rawDesc = "" +
+	"\n" +
+	"\x1fdankfolio/v1/notification.proto\x12\fdankfolio.v1\"\x80\x03\n" +
+	"\x17NotificationPreferences\x12%\n" +
+	"\x0ewallet_address\x18\x01 \x01(\tR\rwalletAddress\x12%\n" +
+	"\x0edisabled_types\x18\x02 \x03(\tR\rdisabledTypes\x124\n" +
+	"\x16transfer_threshold_usd\x18\x03 \x01(\x01R\x14transferThresholdUsd\x124\n" +
+	"\x16dump_threshold_percent\x18\x04 \x01(\x01R\x14dumpThresholdPercent\x12!\n" +
+	"\fdevice_count\x18\x05 \x01(\x05R\vdeviceCount\x12\x18\n" +
+	"\achannel\x18\x06 \x01(\tR\achannel\x12*\n" +
+	"\x11quiet_hours_start\x18\a \x01(\tR\x0fquietHoursStart\x12&\n" +
+	"\x0fquiet_hours_end\x18\b \x01(\tR\rquietHoursEnd\x12\x1a\n" +
+	"\btimezone\x18\t \x01(\tR\btimezone\"a\n" +
+	"\x15RegisterDeviceRequest\x12%\n" +
+	"\x0ewallet_address\x18\x01 \x01(\tR\rwalletAddress\x12!\n" +
+	"\fdevice_token\x18\x02 \x01(\tR\vdeviceToken\"\x18\n" +
+	"\x16RegisterDeviceResponse\"c\n" +
+	"\x17UnregisterDeviceRequest\x12%\n" +
+	"\x0ewallet_address\x18\x01 \x01(\tR\rwalletAddress\x12!\n" +
+	"\fdevice_token\x18\x02 \x01(\tR\vdeviceToken\"\x1a\n" +
+	"\x18UnregisterDeviceResponse\"J\n" +
+	"!GetNotificationPreferencesRequest\x12%\n" +
+	"\x0ewallet_address\x18\x01 \x01(\tR\rwalletAddress\"m\n" +
+	"\"GetNotificationPreferencesResponse\x12G\n" +
+	"\vpreferences\x18\x01 \x01(\v2%.dankfolio.v1.NotificationPreferencesR\vpreferences\"\x81\x04\n" +
+	"$UpdateNotificationPreferencesRequest\x12%\n" +
+	"\x0ewallet_address\x18\x01 \x01(\tR\rwalletAddress\x12%\n" +
+	"\x0edisabled_types\x18\x02 \x03(\tR\rdisabledTypes\x129\n" +
+	"\x16transfer_threshold_usd\x18\x03 \x01(\x01H\x00R\x14transferThresholdUsd\x88\x01\x01\x129\n" +
+	"\x16dump_threshold_percent\x18\x04 \x01(\x01H\x01R\x14dumpThresholdPercent\x88\x01\x01\x12\x1d\n" +
+	"\achannel\x18\x05 \x01(\tH\x02R\achannel\x88\x01\x01\x12/\n" +
+	"\x11quiet_hours_start\x18\x06 \x01(\tH\x03R\x0fquietHoursStart\x88\x01\x01\x12+\n" +
+	"\x0fquiet_hours_end\x18\a \x01(\tH\x04R\rquietHoursEnd\x88\x01\x01\x12\x1f\n" +
+	"\btimezone\x18\b \x01(\tH\x05R\btimezone\x88\x01\x01B\x19\n" +
+	"\x17_transfer_threshold_usdB\x19\n" +
+	"\x17_dump_threshold_percentB\n" +
+	"\n" +
+	"\b_channelB\x14\n" +
+	"\x12_quiet_hours_startB\x12\n" +
+	"\x10_quiet_hours_endB\v\n" +
+	"\t_timezone\"p\n" +
+	"%UpdateNotificationPreferencesResponse\x12G\n" +
+	"\vpreferences\x18\x01 \x01(\v2%.dankfolio.v1.NotificationPreferencesR\vpreferences2\xe1\x03\n" +
+	"\x13NotificationService\x12[\n" +
+	"\x0eRegisterDevice\x12#.dankfolio.v1.RegisterDeviceRequest\x1a$.dankfolio.v1.RegisterDeviceResponse\x12a\n" +
+	"\x10UnregisterDevice\x12%.dankfolio.v1.UnregisterDeviceRequest\x1a&.dankfolio.v1.UnregisterDeviceResponse\x12\x7f\n" +
+	"\x1aGetNotificationPreferences\x12/.dankfolio.v1.GetNotificationPreferencesRequest\x1a0.dankfolio.v1.GetNotificationPreferencesResponse\x12\x88\x01\n" +
+	"\x1dUpdateNotificationPreferences\x122.dankfolio.v1.UpdateNotificationPreferencesRequest\x1a3.dankfolio.v1.UpdateNotificationPreferencesResponseB\xbd\x01\n" +
+	"\x10com.dankfolio.v1B\x11NotificationProtoP\x01ZEgithub.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1\xa2\x02\x03DXX\xaa\x02\fDankfolio.V1\xca\x02\fDankfolio\\V1\xe2\x02\x18Dankfolio\\V1\\GPBMetadata\xea\x02\rDankfolio::V1b\x06proto3"
+
+var (
+	file_dankfolio_v1_notification_proto_rawDescOnce sync.Once
+	file_dankfolio_v1_notification_proto_rawDescData []byte
+)
+
+func file_dankfolio_v1_notification_proto_rawDescGZIP() []byte {
+	file_dankfolio_v1_notification_proto_rawDescOnce.Do(func() {
+		file_dankfolio_v1_notification_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_dankfolio_v1_notification_proto_rawDesc), len(file_dankfolio_v1_notification_proto_rawDesc)))
+	})
+	return file_dankfolio_v1_notification_proto_rawDescData
+}
+
+var file_dankfolio_v1_notification_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_dankfolio_v1_notification_proto_goTypes = []any{
+	(*NotificationPreferences)(nil),               // 0: dankfolio.v1.NotificationPreferences
+	(*RegisterDeviceRequest)(nil),                 // 1: dankfolio.v1.RegisterDeviceRequest
+	(*RegisterDeviceResponse)(nil),                // 2: dankfolio.v1.RegisterDeviceResponse
+	(*UnregisterDeviceRequest)(nil),               // 3: dankfolio.v1.UnregisterDeviceRequest
+	(*UnregisterDeviceResponse)(nil),              // 4: dankfolio.v1.UnregisterDeviceResponse
+	(*GetNotificationPreferencesRequest)(nil),     // 5: dankfolio.v1.GetNotificationPreferencesRequest
+	(*GetNotificationPreferencesResponse)(nil),    // 6: dankfolio.v1.GetNotificationPreferencesResponse
+	(*UpdateNotificationPreferencesRequest)(nil),  // 7: dankfolio.v1.UpdateNotificationPreferencesRequest
+	(*UpdateNotificationPreferencesResponse)(nil), // 8: dankfolio.v1.UpdateNotificationPreferencesResponse
+}
+var file_dankfolio_v1_notification_proto_depIdxs = []int32{
+	0, // 0: dankfolio.v1.GetNotificationPreferencesResponse.preferences:type_name -> dankfolio.v1.NotificationPreferences
+	0, // 1: dankfolio.v1.UpdateNotificationPreferencesResponse.preferences:type_name -> dankfolio.v1.NotificationPreferences
+	1, // 2: dankfolio.v1.NotificationService.RegisterDevice:input_type -> dankfolio.v1.RegisterDeviceRequest
+	3, // 3: dankfolio.v1.NotificationService.UnregisterDevice:input_type -> dankfolio.v1.UnregisterDeviceRequest
+	5, // 4: dankfolio.v1.NotificationService.GetNotificationPreferences:input_type -> dankfolio.v1.GetNotificationPreferencesRequest
+	7, // 5: dankfolio.v1.NotificationService.UpdateNotificationPreferences:input_type -> dankfolio.v1.UpdateNotificationPreferencesRequest
+	2, // 6: dankfolio.v1.NotificationService.RegisterDevice:output_type -> dankfolio.v1.RegisterDeviceResponse
+	4, // 7: dankfolio.v1.NotificationService.UnregisterDevice:output_type -> dankfolio.v1.UnregisterDeviceResponse
+	6, // 8: dankfolio.v1.NotificationService.GetNotificationPreferences:output_type -> dankfolio.v1.GetNotificationPreferencesResponse
+	8, // 9: dankfolio.v1.NotificationService.UpdateNotificationPreferences:output_type -> dankfolio.v1.UpdateNotificationPreferencesResponse
+	6, // [6:10] is the sub-list for method output_type
+	2, // [2:6] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_dankfolio_v1_notification_proto_init() }
+func file_dankfolio_v1_notification_proto_init() {
+	if File_dankfolio_v1_notification_proto != nil {
+		return
+	}
+	file_dankfolio_v1_notification_proto_msgTypes[7].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_dankfolio_v1_notification_proto_rawDesc), len(file_dankfolio_v1_notification_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_dankfolio_v1_notification_proto_goTypes,
+		DependencyIndexes: file_dankfolio_v1_notification_proto_depIdxs,
+		MessageInfos:      file_dankfolio_v1_notification_proto_msgTypes,
+	}.Build()
+	File_dankfolio_v1_notification_proto = out.File
+	file_dankfolio_v1_notification_proto_goTypes = nil
+	file_dankfolio_v1_notification_proto_depIdxs = nil
+}
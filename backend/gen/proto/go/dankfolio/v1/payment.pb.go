@@ -0,0 +1,526 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: dankfolio/v1/payment.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PaymentRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Recipient string                 `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// SPL token mint the payment is in; empty for SOL.
+	Mint string `protobuf:"bytes,3,opt,name=mint,proto3" json:"mint,omitempty"`
+	// Decimal amount in token units, e.g. "1.5".
+	Amount string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Reference key the payment transaction carries.
+	Reference string `protobuf:"bytes,5,opt,name=reference,proto3" json:"reference,omitempty"`
+	Label     string `protobuf:"bytes,6,opt,name=label,proto3" json:"label,omitempty"`
+	Message   string `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	Memo      string `protobuf:"bytes,8,opt,name=memo,proto3" json:"memo,omitempty"`
+	// "pending", "paid" or "expired".
+	Status string `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	// Transaction that paid the request.
+	Signature *string                `protobuf:"bytes,10,opt,name=signature,proto3,oneof" json:"signature,omitempty"`
+	Payer     *string                `protobuf:"bytes,11,opt,name=payer,proto3,oneof" json:"payer,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	PaidAt    *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=paid_at,json=paidAt,proto3,oneof" json:"paid_at,omitempty"`
+	// solana: transfer request URL any Solana Pay wallet can pay.
+	TransferRequestUrl string `protobuf:"bytes,15,opt,name=transfer_request_url,json=transferRequestUrl,proto3" json:"transfer_request_url,omitempty"`
+	// solana: transaction request URL, when the server has a public link base.
+	TransactionRequestUrl *string `protobuf:"bytes,16,opt,name=transaction_request_url,json=transactionRequestUrl,proto3,oneof" json:"transaction_request_url,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *PaymentRequest) Reset() {
+	*x = PaymentRequest{}
+	mi := &file_dankfolio_v1_payment_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentRequest) ProtoMessage() {}
+
+func (x *PaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_payment_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentRequest.ProtoReflect.Descriptor instead.
+func (*PaymentRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_payment_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PaymentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PaymentRequest) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *PaymentRequest) GetMint() string {
+	if x != nil {
+		return x.Mint
+	}
+	return ""
+}
+
+func (x *PaymentRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *PaymentRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *PaymentRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *PaymentRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PaymentRequest) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *PaymentRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PaymentRequest) GetSignature() string {
+	if x != nil && x.Signature != nil {
+		return *x.Signature
+	}
+	return ""
+}
+
+func (x *PaymentRequest) GetPayer() string {
+	if x != nil && x.Payer != nil {
+		return *x.Payer
+	}
+	return ""
+}
+
+func (x *PaymentRequest) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PaymentRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *PaymentRequest) GetPaidAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PaidAt
+	}
+	return nil
+}
+
+func (x *PaymentRequest) GetTransferRequestUrl() string {
+	if x != nil {
+		return x.TransferRequestUrl
+	}
+	return ""
+}
+
+func (x *PaymentRequest) GetTransactionRequestUrl() string {
+	if x != nil && x.TransactionRequestUrl != nil {
+		return *x.TransactionRequestUrl
+	}
+	return ""
+}
+
+type CreatePaymentRequestRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Recipient string                 `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// SPL token mint to be paid in; empty for SOL.
+	Mint   string `protobuf:"bytes,2,opt,name=mint,proto3" json:"mint,omitempty"`
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Merchant name shown by the wallet.
+	Label string `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
+	// Shown by the wallet, e.g. the order description.
+	Message string `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	// Recorded on chain with the payment.
+	Memo          string `protobuf:"bytes,6,opt,name=memo,proto3" json:"memo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePaymentRequestRequest) Reset() {
+	*x = CreatePaymentRequestRequest{}
+	mi := &file_dankfolio_v1_payment_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePaymentRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePaymentRequestRequest) ProtoMessage() {}
+
+func (x *CreatePaymentRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_payment_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePaymentRequestRequest.ProtoReflect.Descriptor instead.
+func (*CreatePaymentRequestRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_payment_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreatePaymentRequestRequest) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *CreatePaymentRequestRequest) GetMint() string {
+	if x != nil {
+		return x.Mint
+	}
+	return ""
+}
+
+func (x *CreatePaymentRequestRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *CreatePaymentRequestRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *CreatePaymentRequestRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreatePaymentRequestRequest) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+type CreatePaymentRequestResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PaymentRequest *PaymentRequest        `protobuf:"bytes,1,opt,name=payment_request,json=paymentRequest,proto3" json:"payment_request,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreatePaymentRequestResponse) Reset() {
+	*x = CreatePaymentRequestResponse{}
+	mi := &file_dankfolio_v1_payment_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePaymentRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePaymentRequestResponse) ProtoMessage() {}
+
+func (x *CreatePaymentRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_payment_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePaymentRequestResponse.ProtoReflect.Descriptor instead.
+func (*CreatePaymentRequestResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_payment_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreatePaymentRequestResponse) GetPaymentRequest() *PaymentRequest {
+	if x != nil {
+		return x.PaymentRequest
+	}
+	return nil
+}
+
+type GetPaymentStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPaymentStatusRequest) Reset() {
+	*x = GetPaymentStatusRequest{}
+	mi := &file_dankfolio_v1_payment_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPaymentStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPaymentStatusRequest) ProtoMessage() {}
+
+func (x *GetPaymentStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_payment_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPaymentStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetPaymentStatusRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_payment_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetPaymentStatusRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetPaymentStatusResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PaymentRequest *PaymentRequest        `protobuf:"bytes,1,opt,name=payment_request,json=paymentRequest,proto3" json:"payment_request,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetPaymentStatusResponse) Reset() {
+	*x = GetPaymentStatusResponse{}
+	mi := &file_dankfolio_v1_payment_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPaymentStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPaymentStatusResponse) ProtoMessage() {}
+
+func (x *GetPaymentStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_payment_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPaymentStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetPaymentStatusResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_payment_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetPaymentStatusResponse) GetPaymentRequest() *PaymentRequest {
+	if x != nil {
+		return x.PaymentRequest
+	}
+	return nil
+}
+
+var File_dankfolio_v1_payment_proto protoreflect.FileDescriptor
+
+const file_dankfolio_v1_payment_proto_rawDesc = "" +
+	"\n" +
+	"\x1adankfolio/v1/payment.proto\x12\fdankfolio.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x81\x05\n" +
+	"\x0ePaymentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\trecipient\x18\x02 \x01(\tR\trecipient\x12\x12\n" +
+	"\x04mint\x18\x03 \x01(\tR\x04mint\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\tR\x06amount\x12\x1c\n" +
+	"\treference\x18\x05 \x01(\tR\treference\x12\x14\n" +
+	"\x05label\x18\x06 \x01(\tR\x05label\x12\x18\n" +
+	"\amessage\x18\a \x01(\tR\amessage\x12\x12\n" +
+	"\x04memo\x18\b \x01(\tR\x04memo\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\x12!\n" +
+	"\tsignature\x18\n" +
+	" \x01(\tH\x00R\tsignature\x88\x01\x01\x12\x19\n" +
+	"\x05payer\x18\v \x01(\tH\x01R\x05payer\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x128\n" +
+	"\apaid_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampH\x02R\x06paidAt\x88\x01\x01\x120\n" +
+	"\x14transfer_request_url\x18\x0f \x01(\tR\x12transferRequestUrl\x12;\n" +
+	"\x17transaction_request_url\x18\x10 \x01(\tH\x03R\x15transactionRequestUrl\x88\x01\x01B\f\n" +
+	"\n" +
+	"_signatureB\b\n" +
+	"\x06_payerB\n" +
+	"\n" +
+	"\b_paid_atB\x1a\n" +
+	"\x18_transaction_request_url\"\xab\x01\n" +
+	"\x1bCreatePaymentRequestRequest\x12\x1c\n" +
+	"\trecipient\x18\x01 \x01(\tR\trecipient\x12\x12\n" +
+	"\x04mint\x18\x02 \x01(\tR\x04mint\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\x12\x14\n" +
+	"\x05label\x18\x04 \x01(\tR\x05label\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\x12\x12\n" +
+	"\x04memo\x18\x06 \x01(\tR\x04memo\"e\n" +
+	"\x1cCreatePaymentRequestResponse\x12E\n" +
+	"\x0fpayment_request\x18\x01 \x01(\v2\x1c.dankfolio.v1.PaymentRequestR\x0epaymentRequest\")\n" +
+	"\x17GetPaymentStatusRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"a\n" +
+	"\x18GetPaymentStatusResponse\x12E\n" +
+	"\x0fpayment_request\x18\x01 \x01(\v2\x1c.dankfolio.v1.PaymentRequestR\x0epaymentRequest2\xe2\x01\n" +
+	"\x0ePaymentService\x12m\n" +
+	"\x14CreatePaymentRequest\x12).dankfolio.v1.CreatePaymentRequestRequest\x1a*.dankfolio.v1.CreatePaymentRequestResponse\x12a\n" +
+	"\x10GetPaymentStatus\x12%.dankfolio.v1.GetPaymentStatusRequest\x1a&.dankfolio.v1.GetPaymentStatusResponseB\xb8\x01\n" +
+	"\x10com.dankfolio.v1B\fPaymentProtoP\x01ZEgithub.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1\xa2\x02\x03DXX\xaa\x02\fDankfolio.V1\xca\x02\fDankfolio\\V1\xe2\x02\x18Dankfolio\\V1\\GPBMetadata\xea\x02\rDankfolio::V1b\x06proto3"
+
+var (
+	file_dankfolio_v1_payment_proto_rawDescOnce sync.Once
+	file_dankfolio_v1_payment_proto_rawDescData []byte
+)
+
+func file_dankfolio_v1_payment_proto_rawDescGZIP() []byte {
+	file_dankfolio_v1_payment_proto_rawDescOnce.Do(func() {
+		file_dankfolio_v1_payment_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_dankfolio_v1_payment_proto_rawDesc), len(file_dankfolio_v1_payment_proto_rawDesc)))
+	})
+	return file_dankfolio_v1_payment_proto_rawDescData
+}
+
+var file_dankfolio_v1_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_dankfolio_v1_payment_proto_goTypes = []any{
+	(*PaymentRequest)(nil),               // 0: dankfolio.v1.PaymentRequest
+	(*CreatePaymentRequestRequest)(nil),  // 1: dankfolio.v1.CreatePaymentRequestRequest
+	(*CreatePaymentRequestResponse)(nil), // 2: dankfolio.v1.CreatePaymentRequestResponse
+	(*GetPaymentStatusRequest)(nil),      // 3: dankfolio.v1.GetPaymentStatusRequest
+	(*GetPaymentStatusResponse)(nil),     // 4: dankfolio.v1.GetPaymentStatusResponse
+	(*timestamppb.Timestamp)(nil),        // 5: google.protobuf.Timestamp
+}
+var file_dankfolio_v1_payment_proto_depIdxs = []int32{
+	5, // 0: dankfolio.v1.PaymentRequest.created_at:type_name -> google.protobuf.Timestamp
+	5, // 1: dankfolio.v1.PaymentRequest.expires_at:type_name -> google.protobuf.Timestamp
+	5, // 2: dankfolio.v1.PaymentRequest.paid_at:type_name -> google.protobuf.Timestamp
+	0, // 3: dankfolio.v1.CreatePaymentRequestResponse.payment_request:type_name -> dankfolio.v1.PaymentRequest
+	0, // 4: dankfolio.v1.GetPaymentStatusResponse.payment_request:type_name -> dankfolio.v1.PaymentRequest
+	1, // 5: dankfolio.v1.PaymentService.CreatePaymentRequest:input_type -> dankfolio.v1.CreatePaymentRequestRequest
+	3, // 6: dankfolio.v1.PaymentService.GetPaymentStatus:input_type -> dankfolio.v1.GetPaymentStatusRequest
+	2, // 7: dankfolio.v1.PaymentService.CreatePaymentRequest:output_type -> dankfolio.v1.CreatePaymentRequestResponse
+	4, // 8: dankfolio.v1.PaymentService.GetPaymentStatus:output_type -> dankfolio.v1.GetPaymentStatusResponse
+	7, // [7:9] is the sub-list for method output_type
+	5, // [5:7] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_dankfolio_v1_payment_proto_init() }
+func file_dankfolio_v1_payment_proto_init() {
+	if File_dankfolio_v1_payment_proto != nil {
+		return
+	}
+	file_dankfolio_v1_payment_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_dankfolio_v1_payment_proto_rawDesc), len(file_dankfolio_v1_payment_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_dankfolio_v1_payment_proto_goTypes,
+		DependencyIndexes: file_dankfolio_v1_payment_proto_depIdxs,
+		MessageInfos:      file_dankfolio_v1_payment_proto_msgTypes,
+	}.Build()
+	File_dankfolio_v1_payment_proto = out.File
+	file_dankfolio_v1_payment_proto_goTypes = nil
+	file_dankfolio_v1_payment_proto_depIdxs = nil
+}
@@ -9,7 +9,7 @@ package v1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
-	_ "google.golang.org/protobuf/types/known/timestamppb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -110,13 +110,74 @@ func (GetPriceHistoryRequest_PriceHistoryType) EnumDescriptor() ([]byte, []int)
 	return file_dankfolio_v1_price_proto_rawDescGZIP(), []int{0, 0}
 }
 
+type IndicatorRequest_Kind int32
+
+const (
+	IndicatorRequest_KIND_UNSPECIFIED IndicatorRequest_Kind = 0
+	IndicatorRequest_SMA              IndicatorRequest_Kind = 1 // Simple moving average of closes
+	IndicatorRequest_EMA              IndicatorRequest_Kind = 2 // Exponential moving average of closes
+	IndicatorRequest_RSI              IndicatorRequest_Kind = 3 // Relative strength index
+	IndicatorRequest_VWAP             IndicatorRequest_Kind = 4 // Volume-weighted average price over the window; takes no period
+)
+
+// Enum value maps for IndicatorRequest_Kind.
+var (
+	IndicatorRequest_Kind_name = map[int32]string{
+		0: "KIND_UNSPECIFIED",
+		1: "SMA",
+		2: "EMA",
+		3: "RSI",
+		4: "VWAP",
+	}
+	IndicatorRequest_Kind_value = map[string]int32{
+		"KIND_UNSPECIFIED": 0,
+		"SMA":              1,
+		"EMA":              2,
+		"RSI":              3,
+		"VWAP":             4,
+	}
+)
+
+func (x IndicatorRequest_Kind) Enum() *IndicatorRequest_Kind {
+	p := new(IndicatorRequest_Kind)
+	*p = x
+	return p
+}
+
+func (x IndicatorRequest_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IndicatorRequest_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_dankfolio_v1_price_proto_enumTypes[1].Descriptor()
+}
+
+func (IndicatorRequest_Kind) Type() protoreflect.EnumType {
+	return &file_dankfolio_v1_price_proto_enumTypes[1]
+}
+
+func (x IndicatorRequest_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IndicatorRequest_Kind.Descriptor instead.
+func (IndicatorRequest_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_dankfolio_v1_price_proto_rawDescGZIP(), []int{7, 0}
+}
+
 // GetPriceHistoryRequest represents a request for price history data
 type GetPriceHistoryRequest struct {
-	state         protoimpl.MessageState                  `protogen:"open.v1"`
-	Address       string                                  `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Type          GetPriceHistoryRequest_PriceHistoryType `protobuf:"varint,2,opt,name=type,proto3,enum=dankfolio.v1.GetPriceHistoryRequest_PriceHistoryType" json:"type,omitempty"`
-	Time          string                                  `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
-	AddressType   string                                  `protobuf:"bytes,4,opt,name=address_type,json=addressType,proto3" json:"address_type,omitempty"`
+	state               protoimpl.MessageState                  `protogen:"open.v1"`
+	Address             string                                  `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Type                GetPriceHistoryRequest_PriceHistoryType `protobuf:"varint,2,opt,name=type,proto3,enum=dankfolio.v1.GetPriceHistoryRequest_PriceHistoryType" json:"type,omitempty"`
+	Time                string                                  `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	AddressType         string                                  `protobuf:"bytes,4,opt,name=address_type,json=addressType,proto3" json:"address_type,omitempty"`
+	IfVersionNotChanged string                                  `protobuf:"bytes,5,opt,name=if_version_not_changed,json=ifVersionNotChanged,proto3" json:"if_version_not_changed,omitempty"` // CacheInfo.version from a previous response
+	DisplayCurrency     string                                  `protobuf:"bytes,6,opt,name=display_currency,json=displayCurrency,proto3" json:"display_currency,omitempty"`                 // ISO 4217 code to quote values in; USD when empty
+	// A custom range, as RFC3339 times, replaces the type's window; time is then ignored
+	From          string `protobuf:"bytes,7,opt,name=from,proto3" json:"from,omitempty"`
+	To            string `protobuf:"bytes,8,opt,name=to,proto3" json:"to,omitempty"`
+	Granularity   string `protobuf:"bytes,9,opt,name=granularity,proto3" json:"granularity,omitempty"` // Spacing of custom range points, e.g. "5m" or "1H"; the finest allowed when empty
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -179,11 +240,48 @@ func (x *GetPriceHistoryRequest) GetAddressType() string {
 	return ""
 }
 
+func (x *GetPriceHistoryRequest) GetIfVersionNotChanged() string {
+	if x != nil {
+		return x.IfVersionNotChanged
+	}
+	return ""
+}
+
+func (x *GetPriceHistoryRequest) GetDisplayCurrency() string {
+	if x != nil {
+		return x.DisplayCurrency
+	}
+	return ""
+}
+
+func (x *GetPriceHistoryRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetPriceHistoryRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *GetPriceHistoryRequest) GetGranularity() string {
+	if x != nil {
+		return x.Granularity
+	}
+	return ""
+}
+
 // GetPriceHistoryResponse represents the response containing price history data
 type GetPriceHistoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Data          *PriceHistoryData      `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	CacheInfo     *CacheInfo             `protobuf:"bytes,3,opt,name=cache_info,json=cacheInfo,proto3" json:"cache_info,omitempty"`
+	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"` // Currency the values are quoted in
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -232,6 +330,20 @@ func (x *GetPriceHistoryResponse) GetSuccess() bool {
 	return false
 }
 
+func (x *GetPriceHistoryResponse) GetCacheInfo() *CacheInfo {
+	if x != nil {
+		return x.CacheInfo
+	}
+	return nil
+}
+
+func (x *GetPriceHistoryResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
 // PriceHistoryData contains a list of price history items
 type PriceHistoryData struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -332,10 +444,11 @@ func (x *PriceHistoryItem) GetValue() float64 {
 
 // GetCoinPricesRequest is the request for getting coin prices
 type GetCoinPricesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CoinIds       []string               `protobuf:"bytes,1,rep,name=coin_ids,json=coinIds,proto3" json:"coin_ids,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CoinIds         []string               `protobuf:"bytes,1,rep,name=coin_ids,json=coinIds,proto3" json:"coin_ids,omitempty"`
+	DisplayCurrency string                 `protobuf:"bytes,2,opt,name=display_currency,json=displayCurrency,proto3" json:"display_currency,omitempty"` // ISO 4217 code to quote prices in; USD when empty
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *GetCoinPricesRequest) Reset() {
@@ -375,10 +488,18 @@ func (x *GetCoinPricesRequest) GetCoinIds() []string {
 	return nil
 }
 
+func (x *GetCoinPricesRequest) GetDisplayCurrency() string {
+	if x != nil {
+		return x.DisplayCurrency
+	}
+	return ""
+}
+
 // GetCoinPricesResponse is the response containing coin prices
 type GetCoinPricesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Prices        map[string]float64     `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"` // Currency the prices are quoted in
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -420,28 +541,41 @@ func (x *GetCoinPricesResponse) GetPrices() map[string]float64 {
 	return nil
 }
 
-// GetPriceHistoriesByIDsRequest represents a batched request for multiple price histories
-type GetPriceHistoriesByIDsRequest struct {
-	state         protoimpl.MessageState     `protogen:"open.v1"`
-	Items         []*PriceHistoryRequestItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+func (x *GetCoinPricesResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// GetPriceCandlesRequest selects a chart's window the same way GetPriceHistoryRequest does
+type GetPriceCandlesRequest struct {
+	state         protoimpl.MessageState                  `protogen:"open.v1"`
+	Address       string                                  `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Type          GetPriceHistoryRequest_PriceHistoryType `protobuf:"varint,2,opt,name=type,proto3,enum=dankfolio.v1.GetPriceHistoryRequest_PriceHistoryType" json:"type,omitempty"`
+	Time          string                                  `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	From          string                                  `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                                  `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	Granularity   string                                  `protobuf:"bytes,6,opt,name=granularity,proto3" json:"granularity,omitempty"`
+	Indicators    []*IndicatorRequest                     `protobuf:"bytes,7,rep,name=indicators,proto3" json:"indicators,omitempty"` // At most 8
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPriceHistoriesByIDsRequest) Reset() {
-	*x = GetPriceHistoriesByIDsRequest{}
+func (x *GetPriceCandlesRequest) Reset() {
+	*x = GetPriceCandlesRequest{}
 	mi := &file_dankfolio_v1_price_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPriceHistoriesByIDsRequest) String() string {
+func (x *GetPriceCandlesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPriceHistoriesByIDsRequest) ProtoMessage() {}
+func (*GetPriceCandlesRequest) ProtoMessage() {}
 
-func (x *GetPriceHistoriesByIDsRequest) ProtoReflect() protoreflect.Message {
+func (x *GetPriceCandlesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_dankfolio_v1_price_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -453,43 +587,83 @@ func (x *GetPriceHistoriesByIDsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPriceHistoriesByIDsRequest.ProtoReflect.Descriptor instead.
-func (*GetPriceHistoriesByIDsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPriceCandlesRequest.ProtoReflect.Descriptor instead.
+func (*GetPriceCandlesRequest) Descriptor() ([]byte, []int) {
 	return file_dankfolio_v1_price_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetPriceHistoriesByIDsRequest) GetItems() []*PriceHistoryRequestItem {
+func (x *GetPriceCandlesRequest) GetAddress() string {
 	if x != nil {
-		return x.Items
+		return x.Address
+	}
+	return ""
+}
+
+func (x *GetPriceCandlesRequest) GetType() GetPriceHistoryRequest_PriceHistoryType {
+	if x != nil {
+		return x.Type
+	}
+	return GetPriceHistoryRequest_PRICE_HISTORY_TYPE_UNSPECIFIED
+}
+
+func (x *GetPriceCandlesRequest) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *GetPriceCandlesRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetPriceCandlesRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *GetPriceCandlesRequest) GetGranularity() string {
+	if x != nil {
+		return x.Granularity
+	}
+	return ""
+}
+
+func (x *GetPriceCandlesRequest) GetIndicators() []*IndicatorRequest {
+	if x != nil {
+		return x.Indicators
 	}
 	return nil
 }
 
-// PriceHistoryRequestItem represents a single price history request within a batch
-type PriceHistoryRequestItem struct {
-	state         protoimpl.MessageState                  `protogen:"open.v1"`
-	Address       string                                  `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Type          GetPriceHistoryRequest_PriceHistoryType `protobuf:"varint,2,opt,name=type,proto3,enum=dankfolio.v1.GetPriceHistoryRequest_PriceHistoryType" json:"type,omitempty"`
-	Time          string                                  `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
-	AddressType   string                                  `protobuf:"bytes,4,opt,name=address_type,json=addressType,proto3" json:"address_type,omitempty"`
+// IndicatorRequest asks for a technical indicator over the candles
+type IndicatorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          IndicatorRequest_Kind  `protobuf:"varint,1,opt,name=kind,proto3,enum=dankfolio.v1.IndicatorRequest_Kind" json:"kind,omitempty"`
+	Period        int32                  `protobuf:"varint,2,opt,name=period,proto3" json:"period,omitempty"` // Candles per value, up to 200
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PriceHistoryRequestItem) Reset() {
-	*x = PriceHistoryRequestItem{}
+func (x *IndicatorRequest) Reset() {
+	*x = IndicatorRequest{}
 	mi := &file_dankfolio_v1_price_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PriceHistoryRequestItem) String() string {
+func (x *IndicatorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PriceHistoryRequestItem) ProtoMessage() {}
+func (*IndicatorRequest) ProtoMessage() {}
 
-func (x *PriceHistoryRequestItem) ProtoReflect() protoreflect.Message {
+func (x *IndicatorRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_dankfolio_v1_price_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -501,62 +675,52 @@ func (x *PriceHistoryRequestItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PriceHistoryRequestItem.ProtoReflect.Descriptor instead.
-func (*PriceHistoryRequestItem) Descriptor() ([]byte, []int) {
+// Deprecated: Use IndicatorRequest.ProtoReflect.Descriptor instead.
+func (*IndicatorRequest) Descriptor() ([]byte, []int) {
 	return file_dankfolio_v1_price_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *PriceHistoryRequestItem) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
-}
-
-func (x *PriceHistoryRequestItem) GetType() GetPriceHistoryRequest_PriceHistoryType {
-	if x != nil {
-		return x.Type
-	}
-	return GetPriceHistoryRequest_PRICE_HISTORY_TYPE_UNSPECIFIED
-}
-
-func (x *PriceHistoryRequestItem) GetTime() string {
+func (x *IndicatorRequest) GetKind() IndicatorRequest_Kind {
 	if x != nil {
-		return x.Time
+		return x.Kind
 	}
-	return ""
+	return IndicatorRequest_KIND_UNSPECIFIED
 }
 
-func (x *PriceHistoryRequestItem) GetAddressType() string {
+func (x *IndicatorRequest) GetPeriod() int32 {
 	if x != nil {
-		return x.AddressType
+		return x.Period
 	}
-	return ""
+	return 0
 }
 
-// GetPriceHistoriesByIDsResponse represents the batched response containing multiple price histories
-type GetPriceHistoriesByIDsResponse struct {
-	state           protoimpl.MessageState         `protogen:"open.v1"`
-	Results         map[string]*PriceHistoryResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	FailedAddresses []string                       `protobuf:"bytes,2,rep,name=failed_addresses,json=failedAddresses,proto3" json:"failed_addresses,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// Candle is one OHLCV candle in USD
+type Candle struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UnixTime      int64                  `protobuf:"varint,1,opt,name=unix_time,json=unixTime,proto3" json:"unix_time,omitempty"` // Start of the candle
+	Open          float64                `protobuf:"fixed64,2,opt,name=open,proto3" json:"open,omitempty"`
+	High          float64                `protobuf:"fixed64,3,opt,name=high,proto3" json:"high,omitempty"`
+	Low           float64                `protobuf:"fixed64,4,opt,name=low,proto3" json:"low,omitempty"`
+	Close         float64                `protobuf:"fixed64,5,opt,name=close,proto3" json:"close,omitempty"`
+	Volume        float64                `protobuf:"fixed64,6,opt,name=volume,proto3" json:"volume,omitempty"` // In tokens
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPriceHistoriesByIDsResponse) Reset() {
-	*x = GetPriceHistoriesByIDsResponse{}
+func (x *Candle) Reset() {
+	*x = Candle{}
 	mi := &file_dankfolio_v1_price_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPriceHistoriesByIDsResponse) String() string {
+func (x *Candle) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPriceHistoriesByIDsResponse) ProtoMessage() {}
+func (*Candle) ProtoMessage() {}
 
-func (x *GetPriceHistoriesByIDsResponse) ProtoReflect() protoreflect.Message {
+func (x *Candle) ProtoReflect() protoreflect.Message {
 	mi := &file_dankfolio_v1_price_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -568,49 +732,78 @@ func (x *GetPriceHistoriesByIDsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPriceHistoriesByIDsResponse.ProtoReflect.Descriptor instead.
-func (*GetPriceHistoriesByIDsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Candle.ProtoReflect.Descriptor instead.
+func (*Candle) Descriptor() ([]byte, []int) {
 	return file_dankfolio_v1_price_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetPriceHistoriesByIDsResponse) GetResults() map[string]*PriceHistoryResult {
+func (x *Candle) GetUnixTime() int64 {
 	if x != nil {
-		return x.Results
+		return x.UnixTime
 	}
-	return nil
+	return 0
 }
 
-func (x *GetPriceHistoriesByIDsResponse) GetFailedAddresses() []string {
+func (x *Candle) GetOpen() float64 {
 	if x != nil {
-		return x.FailedAddresses
+		return x.Open
 	}
-	return nil
+	return 0
 }
 
-// PriceHistoryResult contains the price history data and success status for a single address
-type PriceHistoryResult struct {
+func (x *Candle) GetHigh() float64 {
+	if x != nil {
+		return x.High
+	}
+	return 0
+}
+
+func (x *Candle) GetLow() float64 {
+	if x != nil {
+		return x.Low
+	}
+	return 0
+}
+
+func (x *Candle) GetClose() float64 {
+	if x != nil {
+		return x.Close
+	}
+	return 0
+}
+
+func (x *Candle) GetVolume() float64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+// IndicatorSeries holds an indicator's values; values[i] belongs to candles[start + i]
+type IndicatorSeries struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          *PriceHistoryData      `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
-	ErrorMessage  string                 `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	Kind          IndicatorRequest_Kind  `protobuf:"varint,1,opt,name=kind,proto3,enum=dankfolio.v1.IndicatorRequest_Kind" json:"kind,omitempty"`
+	Period        int32                  `protobuf:"varint,2,opt,name=period,proto3" json:"period,omitempty"`
+	Start         int32                  `protobuf:"varint,3,opt,name=start,proto3" json:"start,omitempty"`
+	Values        []float64              `protobuf:"fixed64,4,rep,packed,name=values,proto3" json:"values,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PriceHistoryResult) Reset() {
-	*x = PriceHistoryResult{}
+func (x *IndicatorSeries) Reset() {
+	*x = IndicatorSeries{}
 	mi := &file_dankfolio_v1_price_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PriceHistoryResult) String() string {
+func (x *IndicatorSeries) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PriceHistoryResult) ProtoMessage() {}
+func (*IndicatorSeries) ProtoMessage() {}
 
-func (x *PriceHistoryResult) ProtoReflect() protoreflect.Message {
+func (x *IndicatorSeries) ProtoReflect() protoreflect.Message {
 	mi := &file_dankfolio_v1_price_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -622,54 +815,783 @@ func (x *PriceHistoryResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PriceHistoryResult.ProtoReflect.Descriptor instead.
-func (*PriceHistoryResult) Descriptor() ([]byte, []int) {
+// Deprecated: Use IndicatorSeries.ProtoReflect.Descriptor instead.
+func (*IndicatorSeries) Descriptor() ([]byte, []int) {
 	return file_dankfolio_v1_price_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *PriceHistoryResult) GetData() *PriceHistoryData {
+func (x *IndicatorSeries) GetKind() IndicatorRequest_Kind {
 	if x != nil {
-		return x.Data
+		return x.Kind
 	}
-	return nil
+	return IndicatorRequest_KIND_UNSPECIFIED
 }
 
-func (x *PriceHistoryResult) GetSuccess() bool {
+func (x *IndicatorSeries) GetPeriod() int32 {
 	if x != nil {
-		return x.Success
+		return x.Period
 	}
-	return false
+	return 0
 }
 
-func (x *PriceHistoryResult) GetErrorMessage() string {
+func (x *IndicatorSeries) GetStart() int32 {
 	if x != nil {
-		return x.ErrorMessage
+		return x.Start
 	}
-	return ""
+	return 0
 }
 
-var File_dankfolio_v1_price_proto protoreflect.FileDescriptor
+func (x *IndicatorSeries) GetValues() []float64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
 
-const file_dankfolio_v1_price_proto_rawDesc = "" +
-	"\n" +
-	"\x18dankfolio/v1/price.proto\x12\fdankfolio.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xda\x03\n" +
-	"\x16GetPriceHistoryRequest\x12\x18\n" +
-	"\aaddress\x18\x01 \x01(\tR\aaddress\x12I\n" +
-	"\x04type\x18\x02 \x01(\x0e25.dankfolio.v1.GetPriceHistoryRequest.PriceHistoryTypeR\x04type\x12\x12\n" +
-	"\x04time\x18\x03 \x01(\tR\x04time\x12!\n" +
-	"\faddress_type\x18\x04 \x01(\tR\vaddressType\"\xa3\x02\n" +
-	"\x10PriceHistoryType\x12\"\n" +
-	"\x1ePRICE_HISTORY_TYPE_UNSPECIFIED\x10\x00\x12\x0e\n" +
-	"\n" +
-	"ONE_MINUTE\x10\x01\x12\x10\n" +
-	"\fTHREE_MINUTE\x10\x02\x12\x0f\n" +
-	"\vFIVE_MINUTE\x10\x03\x12\x12\n" +
-	"\x0eFIFTEEN_MINUTE\x10\x04\x12\x11\n" +
-	"\rTHIRTY_MINUTE\x10\x05\x12\f\n" +
-	"\bONE_HOUR\x10\x06\x12\f\n" +
-	"\bTWO_HOUR\x10\a\x12\r\n" +
-	"\tFOUR_HOUR\x10\b\x12\f\n" +
-	"\bSIX_HOUR\x10\t\x12\x0e\n" +
+// GetPriceCandlesResponse holds the candles, oldest first, and the requested indicators in request order
+type GetPriceCandlesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Candles       []*Candle              `protobuf:"bytes,1,rep,name=candles,proto3" json:"candles,omitempty"`
+	Indicators    []*IndicatorSeries     `protobuf:"bytes,2,rep,name=indicators,proto3" json:"indicators,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriceCandlesResponse) Reset() {
+	*x = GetPriceCandlesResponse{}
+	mi := &file_dankfolio_v1_price_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceCandlesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceCandlesResponse) ProtoMessage() {}
+
+func (x *GetPriceCandlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_price_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceCandlesResponse.ProtoReflect.Descriptor instead.
+func (*GetPriceCandlesResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_price_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetPriceCandlesResponse) GetCandles() []*Candle {
+	if x != nil {
+		return x.Candles
+	}
+	return nil
+}
+
+func (x *GetPriceCandlesResponse) GetIndicators() []*IndicatorSeries {
+	if x != nil {
+		return x.Indicators
+	}
+	return nil
+}
+
+// GetPriceHistoriesByIDsRequest represents a batched request for multiple price histories
+type GetPriceHistoriesByIDsRequest struct {
+	state           protoimpl.MessageState     `protogen:"open.v1"`
+	Items           []*PriceHistoryRequestItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	DisplayCurrency string                     `protobuf:"bytes,2,opt,name=display_currency,json=displayCurrency,proto3" json:"display_currency,omitempty"` // ISO 4217 code to quote values in; USD when empty
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetPriceHistoriesByIDsRequest) Reset() {
+	*x = GetPriceHistoriesByIDsRequest{}
+	mi := &file_dankfolio_v1_price_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceHistoriesByIDsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceHistoriesByIDsRequest) ProtoMessage() {}
+
+func (x *GetPriceHistoriesByIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_price_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceHistoriesByIDsRequest.ProtoReflect.Descriptor instead.
+func (*GetPriceHistoriesByIDsRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_price_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetPriceHistoriesByIDsRequest) GetItems() []*PriceHistoryRequestItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *GetPriceHistoriesByIDsRequest) GetDisplayCurrency() string {
+	if x != nil {
+		return x.DisplayCurrency
+	}
+	return ""
+}
+
+// PriceHistoryRequestItem represents a single price history request within a batch
+type PriceHistoryRequestItem struct {
+	state         protoimpl.MessageState                  `protogen:"open.v1"`
+	Address       string                                  `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Type          GetPriceHistoryRequest_PriceHistoryType `protobuf:"varint,2,opt,name=type,proto3,enum=dankfolio.v1.GetPriceHistoryRequest_PriceHistoryType" json:"type,omitempty"`
+	Time          string                                  `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	AddressType   string                                  `protobuf:"bytes,4,opt,name=address_type,json=addressType,proto3" json:"address_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceHistoryRequestItem) Reset() {
+	*x = PriceHistoryRequestItem{}
+	mi := &file_dankfolio_v1_price_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceHistoryRequestItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceHistoryRequestItem) ProtoMessage() {}
+
+func (x *PriceHistoryRequestItem) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_price_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceHistoryRequestItem.ProtoReflect.Descriptor instead.
+func (*PriceHistoryRequestItem) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_price_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PriceHistoryRequestItem) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PriceHistoryRequestItem) GetType() GetPriceHistoryRequest_PriceHistoryType {
+	if x != nil {
+		return x.Type
+	}
+	return GetPriceHistoryRequest_PRICE_HISTORY_TYPE_UNSPECIFIED
+}
+
+func (x *PriceHistoryRequestItem) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *PriceHistoryRequestItem) GetAddressType() string {
+	if x != nil {
+		return x.AddressType
+	}
+	return ""
+}
+
+// GetPriceHistoriesByIDsResponse represents the batched response containing multiple price histories
+type GetPriceHistoriesByIDsResponse struct {
+	state           protoimpl.MessageState         `protogen:"open.v1"`
+	Results         map[string]*PriceHistoryResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	FailedAddresses []string                       `protobuf:"bytes,2,rep,name=failed_addresses,json=failedAddresses,proto3" json:"failed_addresses,omitempty"`
+	Currency        string                         `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"` // Currency the values are quoted in
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetPriceHistoriesByIDsResponse) Reset() {
+	*x = GetPriceHistoriesByIDsResponse{}
+	mi := &file_dankfolio_v1_price_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceHistoriesByIDsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceHistoriesByIDsResponse) ProtoMessage() {}
+
+func (x *GetPriceHistoriesByIDsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_price_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceHistoriesByIDsResponse.ProtoReflect.Descriptor instead.
+func (*GetPriceHistoriesByIDsResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_price_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetPriceHistoriesByIDsResponse) GetResults() map[string]*PriceHistoryResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *GetPriceHistoriesByIDsResponse) GetFailedAddresses() []string {
+	if x != nil {
+		return x.FailedAddresses
+	}
+	return nil
+}
+
+func (x *GetPriceHistoriesByIDsResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// PriceHistoryResult contains the price history data and success status for a single address
+type PriceHistoryResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *PriceHistoryData      `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceHistoryResult) Reset() {
+	*x = PriceHistoryResult{}
+	mi := &file_dankfolio_v1_price_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceHistoryResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceHistoryResult) ProtoMessage() {}
+
+func (x *PriceHistoryResult) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_price_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceHistoryResult.ProtoReflect.Descriptor instead.
+func (*PriceHistoryResult) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_price_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PriceHistoryResult) GetData() *PriceHistoryData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *PriceHistoryResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *PriceHistoryResult) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+// GetPricesBatchRequest is the request for getting current prices for many addresses at once
+type GetPricesBatchRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Addresses       []string               `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	DisplayCurrency string                 `protobuf:"bytes,2,opt,name=display_currency,json=displayCurrency,proto3" json:"display_currency,omitempty"` // ISO 4217 code to quote prices in; USD when empty
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetPricesBatchRequest) Reset() {
+	*x = GetPricesBatchRequest{}
+	mi := &file_dankfolio_v1_price_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPricesBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPricesBatchRequest) ProtoMessage() {}
+
+func (x *GetPricesBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_price_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPricesBatchRequest.ProtoReflect.Descriptor instead.
+func (*GetPricesBatchRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_price_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetPricesBatchRequest) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *GetPricesBatchRequest) GetDisplayCurrency() string {
+	if x != nil {
+		return x.DisplayCurrency
+	}
+	return ""
+}
+
+// GetPricesBatchResponse contains current prices keyed by address
+type GetPricesBatchResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Prices           map[string]float64     `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	MissingAddresses []string               `protobuf:"bytes,2,rep,name=missing_addresses,json=missingAddresses,proto3" json:"missing_addresses,omitempty"`
+	Currency         string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"` // Currency the prices are quoted in
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetPricesBatchResponse) Reset() {
+	*x = GetPricesBatchResponse{}
+	mi := &file_dankfolio_v1_price_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPricesBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPricesBatchResponse) ProtoMessage() {}
+
+func (x *GetPricesBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_price_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPricesBatchResponse.ProtoReflect.Descriptor instead.
+func (*GetPricesBatchResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_price_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetPricesBatchResponse) GetPrices() map[string]float64 {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+func (x *GetPricesBatchResponse) GetMissingAddresses() []string {
+	if x != nil {
+		return x.MissingAddresses
+	}
+	return nil
+}
+
+func (x *GetPricesBatchResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// StreamPricesRequest names the tokens to stream
+type StreamPricesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Addresses       []string               `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`                                    // At most 100
+	DisplayCurrency string                 `protobuf:"bytes,2,opt,name=display_currency,json=displayCurrency,proto3" json:"display_currency,omitempty"` // ISO 4217 code to quote prices in; USD when empty
+	IncludeTrades   bool                   `protobuf:"varint,3,opt,name=include_trades,json=includeTrades,proto3" json:"include_trades,omitempty"`      // Also stream swaps of the tokens
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *StreamPricesRequest) Reset() {
+	*x = StreamPricesRequest{}
+	mi := &file_dankfolio_v1_price_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamPricesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamPricesRequest) ProtoMessage() {}
+
+func (x *StreamPricesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_price_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamPricesRequest.ProtoReflect.Descriptor instead.
+func (*StreamPricesRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_price_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *StreamPricesRequest) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *StreamPricesRequest) GetDisplayCurrency() string {
+	if x != nil {
+		return x.DisplayCurrency
+	}
+	return ""
+}
+
+func (x *StreamPricesRequest) GetIncludeTrades() bool {
+	if x != nil {
+		return x.IncludeTrades
+	}
+	return false
+}
+
+// StreamPricesResponse is one live update
+type StreamPricesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Update:
+	//
+	//	*StreamPricesResponse_Price
+	//	*StreamPricesResponse_Trade
+	Update        isStreamPricesResponse_Update `protobuf_oneof:"update"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamPricesResponse) Reset() {
+	*x = StreamPricesResponse{}
+	mi := &file_dankfolio_v1_price_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamPricesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamPricesResponse) ProtoMessage() {}
+
+func (x *StreamPricesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_price_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamPricesResponse.ProtoReflect.Descriptor instead.
+func (*StreamPricesResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_price_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *StreamPricesResponse) GetUpdate() isStreamPricesResponse_Update {
+	if x != nil {
+		return x.Update
+	}
+	return nil
+}
+
+func (x *StreamPricesResponse) GetPrice() *PriceTick {
+	if x != nil {
+		if x, ok := x.Update.(*StreamPricesResponse_Price); ok {
+			return x.Price
+		}
+	}
+	return nil
+}
+
+func (x *StreamPricesResponse) GetTrade() *TokenTrade {
+	if x != nil {
+		if x, ok := x.Update.(*StreamPricesResponse_Trade); ok {
+			return x.Trade
+		}
+	}
+	return nil
+}
+
+type isStreamPricesResponse_Update interface {
+	isStreamPricesResponse_Update()
+}
+
+type StreamPricesResponse_Price struct {
+	Price *PriceTick `protobuf:"bytes,1,opt,name=price,proto3,oneof"`
+}
+
+type StreamPricesResponse_Trade struct {
+	Trade *TokenTrade `protobuf:"bytes,2,opt,name=trade,proto3,oneof"`
+}
+
+func (*StreamPricesResponse_Price) isStreamPricesResponse_Update() {}
+
+func (*StreamPricesResponse_Trade) isStreamPricesResponse_Update() {}
+
+// PriceTick is a token's latest price
+type PriceTick struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Price         float64                `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"` // Currency the price is quoted in
+	Time          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceTick) Reset() {
+	*x = PriceTick{}
+	mi := &file_dankfolio_v1_price_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceTick) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceTick) ProtoMessage() {}
+
+func (x *PriceTick) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_price_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceTick.ProtoReflect.Descriptor instead.
+func (*PriceTick) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_price_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PriceTick) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PriceTick) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *PriceTick) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *PriceTick) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+// TokenTrade is a swap of a streamed token
+type TokenTrade struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	TxHash        string                 `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	Owner         string                 `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Side          string                 `protobuf:"bytes,4,opt,name=side,proto3" json:"side,omitempty"`     // "buy" or "sell"
+	Source        string                 `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"` // DEX the swap went through, e.g. "raydium"
+	VolumeUsd     float64                `protobuf:"fixed64,6,opt,name=volume_usd,json=volumeUsd,proto3" json:"volume_usd,omitempty"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=time,proto3" json:"time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TokenTrade) Reset() {
+	*x = TokenTrade{}
+	mi := &file_dankfolio_v1_price_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenTrade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenTrade) ProtoMessage() {}
+
+func (x *TokenTrade) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_price_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenTrade.ProtoReflect.Descriptor instead.
+func (*TokenTrade) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_price_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *TokenTrade) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *TokenTrade) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *TokenTrade) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *TokenTrade) GetSide() string {
+	if x != nil {
+		return x.Side
+	}
+	return ""
+}
+
+func (x *TokenTrade) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *TokenTrade) GetVolumeUsd() float64 {
+	if x != nil {
+		return x.VolumeUsd
+	}
+	return 0
+}
+
+func (x *TokenTrade) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+var File_dankfolio_v1_price_proto protoreflect.FileDescriptor
+
+const file_dankfolio_v1_price_proto_rawDesc = "" +
+	"\n" +
+	"\x18dankfolio/v1/price.proto\x12\fdankfolio.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x18dankfolio/v1/cache.proto\"\x80\x05\n" +
+	"\x16GetPriceHistoryRequest\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12I\n" +
+	"\x04type\x18\x02 \x01(\x0e25.dankfolio.v1.GetPriceHistoryRequest.PriceHistoryTypeR\x04type\x12\x12\n" +
+	"\x04time\x18\x03 \x01(\tR\x04time\x12!\n" +
+	"\faddress_type\x18\x04 \x01(\tR\vaddressType\x123\n" +
+	"\x16if_version_not_changed\x18\x05 \x01(\tR\x13ifVersionNotChanged\x12)\n" +
+	"\x10display_currency\x18\x06 \x01(\tR\x0fdisplayCurrency\x12\x12\n" +
+	"\x04from\x18\a \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\b \x01(\tR\x02to\x12 \n" +
+	"\vgranularity\x18\t \x01(\tR\vgranularity\"\xa3\x02\n" +
+	"\x10PriceHistoryType\x12\"\n" +
+	"\x1ePRICE_HISTORY_TYPE_UNSPECIFIED\x10\x00\x12\x0e\n" +
+	"\n" +
+	"ONE_MINUTE\x10\x01\x12\x10\n" +
+	"\fTHREE_MINUTE\x10\x02\x12\x0f\n" +
+	"\vFIVE_MINUTE\x10\x03\x12\x12\n" +
+	"\x0eFIFTEEN_MINUTE\x10\x04\x12\x11\n" +
+	"\rTHIRTY_MINUTE\x10\x05\x12\f\n" +
+	"\bONE_HOUR\x10\x06\x12\f\n" +
+	"\bTWO_HOUR\x10\a\x12\r\n" +
+	"\tFOUR_HOUR\x10\b\x12\f\n" +
+	"\bSIX_HOUR\x10\t\x12\x0e\n" +
 	"\n" +
 	"EIGHT_HOUR\x10\n" +
 	"\x12\x0f\n" +
@@ -677,43 +1599,122 @@ const file_dankfolio_v1_price_proto_rawDesc = "" +
 	"\aONE_DAY\x10\f\x12\r\n" +
 	"\tTHREE_DAY\x10\r\x12\f\n" +
 	"\bONE_WEEK\x10\x0e\x12\r\n" +
-	"\tONE_MONTH\x10\x0f\"g\n" +
+	"\tONE_MONTH\x10\x0f\"\xbb\x01\n" +
 	"\x17GetPriceHistoryResponse\x122\n" +
 	"\x04data\x18\x01 \x01(\v2\x1e.dankfolio.v1.PriceHistoryDataR\x04data\x12\x18\n" +
-	"\asuccess\x18\x02 \x01(\bR\asuccess\"H\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x126\n" +
+	"\n" +
+	"cache_info\x18\x03 \x01(\v2\x17.dankfolio.v1.CacheInfoR\tcacheInfo\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\"H\n" +
 	"\x10PriceHistoryData\x124\n" +
 	"\x05items\x18\x01 \x03(\v2\x1e.dankfolio.v1.PriceHistoryItemR\x05items\"E\n" +
 	"\x10PriceHistoryItem\x12\x1b\n" +
 	"\tunix_time\x18\x01 \x01(\tR\bunixTime\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\x01R\x05value\"1\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value\"\\\n" +
 	"\x14GetCoinPricesRequest\x12\x19\n" +
-	"\bcoin_ids\x18\x01 \x03(\tR\acoinIds\"\x9b\x01\n" +
+	"\bcoin_ids\x18\x01 \x03(\tR\acoinIds\x12)\n" +
+	"\x10display_currency\x18\x02 \x01(\tR\x0fdisplayCurrency\"\xb7\x01\n" +
 	"\x15GetCoinPricesResponse\x12G\n" +
-	"\x06prices\x18\x01 \x03(\v2/.dankfolio.v1.GetCoinPricesResponse.PricesEntryR\x06prices\x1a9\n" +
+	"\x06prices\x18\x01 \x03(\v2/.dankfolio.v1.GetCoinPricesResponse.PricesEntryR\x06prices\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x1a9\n" +
 	"\vPricesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"\\\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"\x97\x02\n" +
+	"\x16GetPriceCandlesRequest\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12I\n" +
+	"\x04type\x18\x02 \x01(\x0e25.dankfolio.v1.GetPriceHistoryRequest.PriceHistoryTypeR\x04type\x12\x12\n" +
+	"\x04time\x18\x03 \x01(\tR\x04time\x12\x12\n" +
+	"\x04from\x18\x04 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x05 \x01(\tR\x02to\x12 \n" +
+	"\vgranularity\x18\x06 \x01(\tR\vgranularity\x12>\n" +
+	"\n" +
+	"indicators\x18\a \x03(\v2\x1e.dankfolio.v1.IndicatorRequestR\n" +
+	"indicators\"\xa6\x01\n" +
+	"\x10IndicatorRequest\x127\n" +
+	"\x04kind\x18\x01 \x01(\x0e2#.dankfolio.v1.IndicatorRequest.KindR\x04kind\x12\x16\n" +
+	"\x06period\x18\x02 \x01(\x05R\x06period\"A\n" +
+	"\x04Kind\x12\x14\n" +
+	"\x10KIND_UNSPECIFIED\x10\x00\x12\a\n" +
+	"\x03SMA\x10\x01\x12\a\n" +
+	"\x03EMA\x10\x02\x12\a\n" +
+	"\x03RSI\x10\x03\x12\b\n" +
+	"\x04VWAP\x10\x04\"\x8d\x01\n" +
+	"\x06Candle\x12\x1b\n" +
+	"\tunix_time\x18\x01 \x01(\x03R\bunixTime\x12\x12\n" +
+	"\x04open\x18\x02 \x01(\x01R\x04open\x12\x12\n" +
+	"\x04high\x18\x03 \x01(\x01R\x04high\x12\x10\n" +
+	"\x03low\x18\x04 \x01(\x01R\x03low\x12\x14\n" +
+	"\x05close\x18\x05 \x01(\x01R\x05close\x12\x16\n" +
+	"\x06volume\x18\x06 \x01(\x01R\x06volume\"\x90\x01\n" +
+	"\x0fIndicatorSeries\x127\n" +
+	"\x04kind\x18\x01 \x01(\x0e2#.dankfolio.v1.IndicatorRequest.KindR\x04kind\x12\x16\n" +
+	"\x06period\x18\x02 \x01(\x05R\x06period\x12\x14\n" +
+	"\x05start\x18\x03 \x01(\x05R\x05start\x12\x16\n" +
+	"\x06values\x18\x04 \x03(\x01R\x06values\"\x88\x01\n" +
+	"\x17GetPriceCandlesResponse\x12.\n" +
+	"\acandles\x18\x01 \x03(\v2\x14.dankfolio.v1.CandleR\acandles\x12=\n" +
+	"\n" +
+	"indicators\x18\x02 \x03(\v2\x1d.dankfolio.v1.IndicatorSeriesR\n" +
+	"indicators\"\x87\x01\n" +
 	"\x1dGetPriceHistoriesByIDsRequest\x12;\n" +
-	"\x05items\x18\x01 \x03(\v2%.dankfolio.v1.PriceHistoryRequestItemR\x05items\"\xb5\x01\n" +
+	"\x05items\x18\x01 \x03(\v2%.dankfolio.v1.PriceHistoryRequestItemR\x05items\x12)\n" +
+	"\x10display_currency\x18\x02 \x01(\tR\x0fdisplayCurrency\"\xb5\x01\n" +
 	"\x17PriceHistoryRequestItem\x12\x18\n" +
 	"\aaddress\x18\x01 \x01(\tR\aaddress\x12I\n" +
 	"\x04type\x18\x02 \x01(\x0e25.dankfolio.v1.GetPriceHistoryRequest.PriceHistoryTypeR\x04type\x12\x12\n" +
 	"\x04time\x18\x03 \x01(\tR\x04time\x12!\n" +
-	"\faddress_type\x18\x04 \x01(\tR\vaddressType\"\xfe\x01\n" +
+	"\faddress_type\x18\x04 \x01(\tR\vaddressType\"\x9a\x02\n" +
 	"\x1eGetPriceHistoriesByIDsResponse\x12S\n" +
 	"\aresults\x18\x01 \x03(\v29.dankfolio.v1.GetPriceHistoriesByIDsResponse.ResultsEntryR\aresults\x12)\n" +
-	"\x10failed_addresses\x18\x02 \x03(\tR\x0ffailedAddresses\x1a\\\n" +
+	"\x10failed_addresses\x18\x02 \x03(\tR\x0ffailedAddresses\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x1a\\\n" +
 	"\fResultsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x126\n" +
 	"\x05value\x18\x02 \x01(\v2 .dankfolio.v1.PriceHistoryResultR\x05value:\x028\x01\"\x87\x01\n" +
 	"\x12PriceHistoryResult\x122\n" +
 	"\x04data\x18\x01 \x01(\v2\x1e.dankfolio.v1.PriceHistoryDataR\x04data\x12\x18\n" +
 	"\asuccess\x18\x02 \x01(\bR\asuccess\x12#\n" +
-	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage2\xc3\x02\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\"`\n" +
+	"\x15GetPricesBatchRequest\x12\x1c\n" +
+	"\taddresses\x18\x01 \x03(\tR\taddresses\x12)\n" +
+	"\x10display_currency\x18\x02 \x01(\tR\x0fdisplayCurrency\"\xe6\x01\n" +
+	"\x16GetPricesBatchResponse\x12H\n" +
+	"\x06prices\x18\x01 \x03(\v20.dankfolio.v1.GetPricesBatchResponse.PricesEntryR\x06prices\x12+\n" +
+	"\x11missing_addresses\x18\x02 \x03(\tR\x10missingAddresses\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x1a9\n" +
+	"\vPricesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"\x85\x01\n" +
+	"\x13StreamPricesRequest\x12\x1c\n" +
+	"\taddresses\x18\x01 \x03(\tR\taddresses\x12)\n" +
+	"\x10display_currency\x18\x02 \x01(\tR\x0fdisplayCurrency\x12%\n" +
+	"\x0einclude_trades\x18\x03 \x01(\bR\rincludeTrades\"\x83\x01\n" +
+	"\x14StreamPricesResponse\x12/\n" +
+	"\x05price\x18\x01 \x01(\v2\x17.dankfolio.v1.PriceTickH\x00R\x05price\x120\n" +
+	"\x05trade\x18\x02 \x01(\v2\x18.dankfolio.v1.TokenTradeH\x00R\x05tradeB\b\n" +
+	"\x06update\"\x87\x01\n" +
+	"\tPriceTick\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x14\n" +
+	"\x05price\x18\x02 \x01(\x01R\x05price\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12.\n" +
+	"\x04time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\"\xd0\x01\n" +
+	"\n" +
+	"TokenTrade\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x17\n" +
+	"\atx_hash\x18\x02 \x01(\tR\x06txHash\x12\x14\n" +
+	"\x05owner\x18\x03 \x01(\tR\x05owner\x12\x12\n" +
+	"\x04side\x18\x04 \x01(\tR\x04side\x12\x16\n" +
+	"\x06source\x18\x05 \x01(\tR\x06source\x12\x1d\n" +
+	"\n" +
+	"volume_usd\x18\x06 \x01(\x01R\tvolumeUsd\x12.\n" +
+	"\x04time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x04time2\xdf\x04\n" +
 	"\fPriceService\x12`\n" +
-	"\x0fGetPriceHistory\x12$.dankfolio.v1.GetPriceHistoryRequest\x1a%.dankfolio.v1.GetPriceHistoryResponse\"\x00\x12Z\n" +
+	"\x0fGetPriceHistory\x12$.dankfolio.v1.GetPriceHistoryRequest\x1a%.dankfolio.v1.GetPriceHistoryResponse\"\x00\x12`\n" +
+	"\x0fGetPriceCandles\x12$.dankfolio.v1.GetPriceCandlesRequest\x1a%.dankfolio.v1.GetPriceCandlesResponse\"\x00\x12Z\n" +
 	"\rGetCoinPrices\x12\".dankfolio.v1.GetCoinPricesRequest\x1a#.dankfolio.v1.GetCoinPricesResponse\"\x00\x12u\n" +
-	"\x16GetPriceHistoriesByIDs\x12+.dankfolio.v1.GetPriceHistoriesByIDsRequest\x1a,.dankfolio.v1.GetPriceHistoriesByIDsResponse\"\x00B\xb6\x01\n" +
+	"\x16GetPriceHistoriesByIDs\x12+.dankfolio.v1.GetPriceHistoriesByIDsRequest\x1a,.dankfolio.v1.GetPriceHistoriesByIDsResponse\"\x00\x12]\n" +
+	"\x0eGetPricesBatch\x12#.dankfolio.v1.GetPricesBatchRequest\x1a$.dankfolio.v1.GetPricesBatchResponse\"\x00\x12Y\n" +
+	"\fStreamPrices\x12!.dankfolio.v1.StreamPricesRequest\x1a\".dankfolio.v1.StreamPricesResponse\"\x000\x01B\xb6\x01\n" +
 	"\x10com.dankfolio.v1B\n" +
 	"PriceProtoP\x01ZEgithub.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1\xa2\x02\x03DXX\xaa\x02\fDankfolio.V1\xca\x02\fDankfolio\\V1\xe2\x02\x18Dankfolio\\V1\\GPBMetadata\xea\x02\rDankfolio::V1b\x06proto3"
 
@@ -729,44 +1730,77 @@ func file_dankfolio_v1_price_proto_rawDescGZIP() []byte {
 	return file_dankfolio_v1_price_proto_rawDescData
 }
 
-var file_dankfolio_v1_price_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_dankfolio_v1_price_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_dankfolio_v1_price_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_dankfolio_v1_price_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_dankfolio_v1_price_proto_goTypes = []any{
 	(GetPriceHistoryRequest_PriceHistoryType)(0), // 0: dankfolio.v1.GetPriceHistoryRequest.PriceHistoryType
-	(*GetPriceHistoryRequest)(nil),               // 1: dankfolio.v1.GetPriceHistoryRequest
-	(*GetPriceHistoryResponse)(nil),              // 2: dankfolio.v1.GetPriceHistoryResponse
-	(*PriceHistoryData)(nil),                     // 3: dankfolio.v1.PriceHistoryData
-	(*PriceHistoryItem)(nil),                     // 4: dankfolio.v1.PriceHistoryItem
-	(*GetCoinPricesRequest)(nil),                 // 5: dankfolio.v1.GetCoinPricesRequest
-	(*GetCoinPricesResponse)(nil),                // 6: dankfolio.v1.GetCoinPricesResponse
-	(*GetPriceHistoriesByIDsRequest)(nil),        // 7: dankfolio.v1.GetPriceHistoriesByIDsRequest
-	(*PriceHistoryRequestItem)(nil),              // 8: dankfolio.v1.PriceHistoryRequestItem
-	(*GetPriceHistoriesByIDsResponse)(nil),       // 9: dankfolio.v1.GetPriceHistoriesByIDsResponse
-	(*PriceHistoryResult)(nil),                   // 10: dankfolio.v1.PriceHistoryResult
-	nil,                                          // 11: dankfolio.v1.GetCoinPricesResponse.PricesEntry
-	nil,                                          // 12: dankfolio.v1.GetPriceHistoriesByIDsResponse.ResultsEntry
+	(IndicatorRequest_Kind)(0),                   // 1: dankfolio.v1.IndicatorRequest.Kind
+	(*GetPriceHistoryRequest)(nil),               // 2: dankfolio.v1.GetPriceHistoryRequest
+	(*GetPriceHistoryResponse)(nil),              // 3: dankfolio.v1.GetPriceHistoryResponse
+	(*PriceHistoryData)(nil),                     // 4: dankfolio.v1.PriceHistoryData
+	(*PriceHistoryItem)(nil),                     // 5: dankfolio.v1.PriceHistoryItem
+	(*GetCoinPricesRequest)(nil),                 // 6: dankfolio.v1.GetCoinPricesRequest
+	(*GetCoinPricesResponse)(nil),                // 7: dankfolio.v1.GetCoinPricesResponse
+	(*GetPriceCandlesRequest)(nil),               // 8: dankfolio.v1.GetPriceCandlesRequest
+	(*IndicatorRequest)(nil),                     // 9: dankfolio.v1.IndicatorRequest
+	(*Candle)(nil),                               // 10: dankfolio.v1.Candle
+	(*IndicatorSeries)(nil),                      // 11: dankfolio.v1.IndicatorSeries
+	(*GetPriceCandlesResponse)(nil),              // 12: dankfolio.v1.GetPriceCandlesResponse
+	(*GetPriceHistoriesByIDsRequest)(nil),        // 13: dankfolio.v1.GetPriceHistoriesByIDsRequest
+	(*PriceHistoryRequestItem)(nil),              // 14: dankfolio.v1.PriceHistoryRequestItem
+	(*GetPriceHistoriesByIDsResponse)(nil),       // 15: dankfolio.v1.GetPriceHistoriesByIDsResponse
+	(*PriceHistoryResult)(nil),                   // 16: dankfolio.v1.PriceHistoryResult
+	(*GetPricesBatchRequest)(nil),                // 17: dankfolio.v1.GetPricesBatchRequest
+	(*GetPricesBatchResponse)(nil),               // 18: dankfolio.v1.GetPricesBatchResponse
+	(*StreamPricesRequest)(nil),                  // 19: dankfolio.v1.StreamPricesRequest
+	(*StreamPricesResponse)(nil),                 // 20: dankfolio.v1.StreamPricesResponse
+	(*PriceTick)(nil),                            // 21: dankfolio.v1.PriceTick
+	(*TokenTrade)(nil),                           // 22: dankfolio.v1.TokenTrade
+	nil,                                          // 23: dankfolio.v1.GetCoinPricesResponse.PricesEntry
+	nil,                                          // 24: dankfolio.v1.GetPriceHistoriesByIDsResponse.ResultsEntry
+	nil,                                          // 25: dankfolio.v1.GetPricesBatchResponse.PricesEntry
+	(*CacheInfo)(nil),                            // 26: dankfolio.v1.CacheInfo
+	(*timestamppb.Timestamp)(nil),                // 27: google.protobuf.Timestamp
 }
 var file_dankfolio_v1_price_proto_depIdxs = []int32{
 	0,  // 0: dankfolio.v1.GetPriceHistoryRequest.type:type_name -> dankfolio.v1.GetPriceHistoryRequest.PriceHistoryType
-	3,  // 1: dankfolio.v1.GetPriceHistoryResponse.data:type_name -> dankfolio.v1.PriceHistoryData
-	4,  // 2: dankfolio.v1.PriceHistoryData.items:type_name -> dankfolio.v1.PriceHistoryItem
-	11, // 3: dankfolio.v1.GetCoinPricesResponse.prices:type_name -> dankfolio.v1.GetCoinPricesResponse.PricesEntry
-	8,  // 4: dankfolio.v1.GetPriceHistoriesByIDsRequest.items:type_name -> dankfolio.v1.PriceHistoryRequestItem
-	0,  // 5: dankfolio.v1.PriceHistoryRequestItem.type:type_name -> dankfolio.v1.GetPriceHistoryRequest.PriceHistoryType
-	12, // 6: dankfolio.v1.GetPriceHistoriesByIDsResponse.results:type_name -> dankfolio.v1.GetPriceHistoriesByIDsResponse.ResultsEntry
-	3,  // 7: dankfolio.v1.PriceHistoryResult.data:type_name -> dankfolio.v1.PriceHistoryData
-	10, // 8: dankfolio.v1.GetPriceHistoriesByIDsResponse.ResultsEntry.value:type_name -> dankfolio.v1.PriceHistoryResult
-	1,  // 9: dankfolio.v1.PriceService.GetPriceHistory:input_type -> dankfolio.v1.GetPriceHistoryRequest
-	5,  // 10: dankfolio.v1.PriceService.GetCoinPrices:input_type -> dankfolio.v1.GetCoinPricesRequest
-	7,  // 11: dankfolio.v1.PriceService.GetPriceHistoriesByIDs:input_type -> dankfolio.v1.GetPriceHistoriesByIDsRequest
-	2,  // 12: dankfolio.v1.PriceService.GetPriceHistory:output_type -> dankfolio.v1.GetPriceHistoryResponse
-	6,  // 13: dankfolio.v1.PriceService.GetCoinPrices:output_type -> dankfolio.v1.GetCoinPricesResponse
-	9,  // 14: dankfolio.v1.PriceService.GetPriceHistoriesByIDs:output_type -> dankfolio.v1.GetPriceHistoriesByIDsResponse
-	12, // [12:15] is the sub-list for method output_type
-	9,  // [9:12] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	4,  // 1: dankfolio.v1.GetPriceHistoryResponse.data:type_name -> dankfolio.v1.PriceHistoryData
+	26, // 2: dankfolio.v1.GetPriceHistoryResponse.cache_info:type_name -> dankfolio.v1.CacheInfo
+	5,  // 3: dankfolio.v1.PriceHistoryData.items:type_name -> dankfolio.v1.PriceHistoryItem
+	23, // 4: dankfolio.v1.GetCoinPricesResponse.prices:type_name -> dankfolio.v1.GetCoinPricesResponse.PricesEntry
+	0,  // 5: dankfolio.v1.GetPriceCandlesRequest.type:type_name -> dankfolio.v1.GetPriceHistoryRequest.PriceHistoryType
+	9,  // 6: dankfolio.v1.GetPriceCandlesRequest.indicators:type_name -> dankfolio.v1.IndicatorRequest
+	1,  // 7: dankfolio.v1.IndicatorRequest.kind:type_name -> dankfolio.v1.IndicatorRequest.Kind
+	1,  // 8: dankfolio.v1.IndicatorSeries.kind:type_name -> dankfolio.v1.IndicatorRequest.Kind
+	10, // 9: dankfolio.v1.GetPriceCandlesResponse.candles:type_name -> dankfolio.v1.Candle
+	11, // 10: dankfolio.v1.GetPriceCandlesResponse.indicators:type_name -> dankfolio.v1.IndicatorSeries
+	14, // 11: dankfolio.v1.GetPriceHistoriesByIDsRequest.items:type_name -> dankfolio.v1.PriceHistoryRequestItem
+	0,  // 12: dankfolio.v1.PriceHistoryRequestItem.type:type_name -> dankfolio.v1.GetPriceHistoryRequest.PriceHistoryType
+	24, // 13: dankfolio.v1.GetPriceHistoriesByIDsResponse.results:type_name -> dankfolio.v1.GetPriceHistoriesByIDsResponse.ResultsEntry
+	4,  // 14: dankfolio.v1.PriceHistoryResult.data:type_name -> dankfolio.v1.PriceHistoryData
+	25, // 15: dankfolio.v1.GetPricesBatchResponse.prices:type_name -> dankfolio.v1.GetPricesBatchResponse.PricesEntry
+	21, // 16: dankfolio.v1.StreamPricesResponse.price:type_name -> dankfolio.v1.PriceTick
+	22, // 17: dankfolio.v1.StreamPricesResponse.trade:type_name -> dankfolio.v1.TokenTrade
+	27, // 18: dankfolio.v1.PriceTick.time:type_name -> google.protobuf.Timestamp
+	27, // 19: dankfolio.v1.TokenTrade.time:type_name -> google.protobuf.Timestamp
+	16, // 20: dankfolio.v1.GetPriceHistoriesByIDsResponse.ResultsEntry.value:type_name -> dankfolio.v1.PriceHistoryResult
+	2,  // 21: dankfolio.v1.PriceService.GetPriceHistory:input_type -> dankfolio.v1.GetPriceHistoryRequest
+	8,  // 22: dankfolio.v1.PriceService.GetPriceCandles:input_type -> dankfolio.v1.GetPriceCandlesRequest
+	6,  // 23: dankfolio.v1.PriceService.GetCoinPrices:input_type -> dankfolio.v1.GetCoinPricesRequest
+	13, // 24: dankfolio.v1.PriceService.GetPriceHistoriesByIDs:input_type -> dankfolio.v1.GetPriceHistoriesByIDsRequest
+	17, // 25: dankfolio.v1.PriceService.GetPricesBatch:input_type -> dankfolio.v1.GetPricesBatchRequest
+	19, // 26: dankfolio.v1.PriceService.StreamPrices:input_type -> dankfolio.v1.StreamPricesRequest
+	3,  // 27: dankfolio.v1.PriceService.GetPriceHistory:output_type -> dankfolio.v1.GetPriceHistoryResponse
+	12, // 28: dankfolio.v1.PriceService.GetPriceCandles:output_type -> dankfolio.v1.GetPriceCandlesResponse
+	7,  // 29: dankfolio.v1.PriceService.GetCoinPrices:output_type -> dankfolio.v1.GetCoinPricesResponse
+	15, // 30: dankfolio.v1.PriceService.GetPriceHistoriesByIDs:output_type -> dankfolio.v1.GetPriceHistoriesByIDsResponse
+	18, // 31: dankfolio.v1.PriceService.GetPricesBatch:output_type -> dankfolio.v1.GetPricesBatchResponse
+	20, // 32: dankfolio.v1.PriceService.StreamPrices:output_type -> dankfolio.v1.StreamPricesResponse
+	27, // [27:33] is the sub-list for method output_type
+	21, // [21:27] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
 }
 
 func init() { file_dankfolio_v1_price_proto_init() }
@@ -774,13 +1808,18 @@ func file_dankfolio_v1_price_proto_init() {
 	if File_dankfolio_v1_price_proto != nil {
 		return
 	}
+	file_dankfolio_v1_cache_proto_init()
+	file_dankfolio_v1_price_proto_msgTypes[18].OneofWrappers = []any{
+		(*StreamPricesResponse_Price)(nil),
+		(*StreamPricesResponse_Trade)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_dankfolio_v1_price_proto_rawDesc), len(file_dankfolio_v1_price_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   12,
+			NumEnums:      2,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
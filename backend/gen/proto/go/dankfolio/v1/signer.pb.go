@@ -0,0 +1,266 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: dankfolio/v1/signer.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetPublicKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicKeyRequest) Reset() {
+	*x = GetPublicKeyRequest{}
+	mi := &file_dankfolio_v1_signer_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicKeyRequest) ProtoMessage() {}
+
+func (x *GetPublicKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_signer_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicKeyRequest.ProtoReflect.Descriptor instead.
+func (*GetPublicKeyRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_signer_proto_rawDescGZIP(), []int{0}
+}
+
+type GetPublicKeyResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Base58 encoded public key.
+	PublicKey     string `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicKeyResponse) Reset() {
+	*x = GetPublicKeyResponse{}
+	mi := &file_dankfolio_v1_signer_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicKeyResponse) ProtoMessage() {}
+
+func (x *GetPublicKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_signer_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicKeyResponse.ProtoReflect.Descriptor instead.
+func (*GetPublicKeyResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_signer_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetPublicKeyResponse) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+type SignMessageRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Serialized transaction message (legacy or v0).
+	Message       []byte `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignMessageRequest) Reset() {
+	*x = SignMessageRequest{}
+	mi := &file_dankfolio_v1_signer_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignMessageRequest) ProtoMessage() {}
+
+func (x *SignMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_signer_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignMessageRequest.ProtoReflect.Descriptor instead.
+func (*SignMessageRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_signer_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SignMessageRequest) GetMessage() []byte {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type SignMessageResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 64-byte ed25519 signature.
+	Signature     []byte `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignMessageResponse) Reset() {
+	*x = SignMessageResponse{}
+	mi := &file_dankfolio_v1_signer_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignMessageResponse) ProtoMessage() {}
+
+func (x *SignMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_signer_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignMessageResponse.ProtoReflect.Descriptor instead.
+func (*SignMessageResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_signer_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SignMessageResponse) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+var File_dankfolio_v1_signer_proto protoreflect.FileDescriptor
+
+const file_dankfolio_v1_signer_proto_rawDesc = "" +
+	"\n" +
+	"\x19dankfolio/v1/signer.proto\x12\fdankfolio.v1\"\x15\n" +
+	"\x13GetPublicKeyRequest\"5\n" +
+	"\x14GetPublicKeyResponse\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x01 \x01(\tR\tpublicKey\".\n" +
+	"\x12SignMessageRequest\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\fR\amessage\"3\n" +
+	"\x13SignMessageResponse\x12\x1c\n" +
+	"\tsignature\x18\x01 \x01(\fR\tsignature2\xba\x01\n" +
+	"\rSignerService\x12U\n" +
+	"\fGetPublicKey\x12!.dankfolio.v1.GetPublicKeyRequest\x1a\".dankfolio.v1.GetPublicKeyResponse\x12R\n" +
+	"\vSignMessage\x12 .dankfolio.v1.SignMessageRequest\x1a!.dankfolio.v1.SignMessageResponseB\xb7\x01\n" +
+	"\x10com.dankfolio.v1B\vSignerProtoP\x01ZEgithub.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1\xa2\x02\x03DXX\xaa\x02\fDankfolio.V1\xca\x02\fDankfolio\\V1\xe2\x02\x18Dankfolio\\V1\\GPBMetadata\xea\x02\rDankfolio::V1b\x06proto3"
+
+var (
+	file_dankfolio_v1_signer_proto_rawDescOnce sync.Once
+	file_dankfolio_v1_signer_proto_rawDescData []byte
+)
+
+func file_dankfolio_v1_signer_proto_rawDescGZIP() []byte {
+	file_dankfolio_v1_signer_proto_rawDescOnce.Do(func() {
+		file_dankfolio_v1_signer_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_dankfolio_v1_signer_proto_rawDesc), len(file_dankfolio_v1_signer_proto_rawDesc)))
+	})
+	return file_dankfolio_v1_signer_proto_rawDescData
+}
+
+var file_dankfolio_v1_signer_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_dankfolio_v1_signer_proto_goTypes = []any{
+	(*GetPublicKeyRequest)(nil),  // 0: dankfolio.v1.GetPublicKeyRequest
+	(*GetPublicKeyResponse)(nil), // 1: dankfolio.v1.GetPublicKeyResponse
+	(*SignMessageRequest)(nil),   // 2: dankfolio.v1.SignMessageRequest
+	(*SignMessageResponse)(nil),  // 3: dankfolio.v1.SignMessageResponse
+}
+var file_dankfolio_v1_signer_proto_depIdxs = []int32{
+	0, // 0: dankfolio.v1.SignerService.GetPublicKey:input_type -> dankfolio.v1.GetPublicKeyRequest
+	2, // 1: dankfolio.v1.SignerService.SignMessage:input_type -> dankfolio.v1.SignMessageRequest
+	1, // 2: dankfolio.v1.SignerService.GetPublicKey:output_type -> dankfolio.v1.GetPublicKeyResponse
+	3, // 3: dankfolio.v1.SignerService.SignMessage:output_type -> dankfolio.v1.SignMessageResponse
+	2, // [2:4] is the sub-list for method output_type
+	0, // [0:2] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_dankfolio_v1_signer_proto_init() }
+func file_dankfolio_v1_signer_proto_init() {
+	if File_dankfolio_v1_signer_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_dankfolio_v1_signer_proto_rawDesc), len(file_dankfolio_v1_signer_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_dankfolio_v1_signer_proto_goTypes,
+		DependencyIndexes: file_dankfolio_v1_signer_proto_depIdxs,
+		MessageInfos:      file_dankfolio_v1_signer_proto_msgTypes,
+	}.Build()
+	File_dankfolio_v1_signer_proto = out.File
+	file_dankfolio_v1_signer_proto_goTypes = nil
+	file_dankfolio_v1_signer_proto_depIdxs = nil
+}
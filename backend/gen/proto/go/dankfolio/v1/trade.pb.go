@@ -43,6 +43,12 @@ type Trade struct {
 	OutputAmount      *float64               `protobuf:"fixed64,21,opt,name=output_amount,json=outputAmount,proto3,oneof" json:"output_amount,omitempty"` // Amount of output token received in swaps
 	FromAddress       string                 `protobuf:"bytes,22,opt,name=fromAddress,proto3" json:"fromAddress,omitempty"`
 	ToAddress         string                 `protobuf:"bytes,23,opt,name=toAddress,proto3" json:"toAddress,omitempty"`
+	RawAmount         string                 `protobuf:"bytes,24,opt,name=raw_amount,json=rawAmount,proto3" json:"raw_amount,omitempty"`                     // Exact input amount in base units; amount is for display only
+	RawOutputAmount   string                 `protobuf:"bytes,25,opt,name=raw_output_amount,json=rawOutputAmount,proto3" json:"raw_output_amount,omitempty"` // Exact quoted output amount in base units
+	Memo              string                 `protobuf:"bytes,26,opt,name=memo,proto3" json:"memo,omitempty"`                                                // Transfer memo, if one was attached
+	References        []string               `protobuf:"bytes,27,rep,name=references,proto3" json:"references,omitempty"`                                    // Solana Pay reference keys attached to a transfer
+	Paper             bool                   `protobuf:"varint,28,opt,name=paper,proto3" json:"paper,omitempty"`                                             // Simulated against paper balances; nothing was sent on chain
+	Sponsored         bool                   `protobuf:"varint,29,opt,name=sponsored,proto3" json:"sponsored,omitempty"`                                     // The platform paid the network fees, recovered through a higher platform fee
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -203,6 +209,48 @@ func (x *Trade) GetToAddress() string {
 	return ""
 }
 
+func (x *Trade) GetRawAmount() string {
+	if x != nil {
+		return x.RawAmount
+	}
+	return ""
+}
+
+func (x *Trade) GetRawOutputAmount() string {
+	if x != nil {
+		return x.RawOutputAmount
+	}
+	return ""
+}
+
+func (x *Trade) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *Trade) GetReferences() []string {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+func (x *Trade) GetPaper() bool {
+	if x != nil {
+		return x.Paper
+	}
+	return false
+}
+
+func (x *Trade) GetSponsored() bool {
+	if x != nil {
+		return x.Sponsored
+	}
+	return false
+}
+
 // GetSwapQuoteRequest is the request for getting a trade quote
 type GetSwapQuoteRequest struct {
 	state               protoimpl.MessageState `protogen:"open.v1"`
@@ -383,18 +431,21 @@ func (x *SolFeeBreakdown) GetAccountsToCreate() int32 {
 
 // GetSwapQuoteResponse is the response containing trade quote details
 type GetSwapQuoteResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	EstimatedAmount  string                 `protobuf:"bytes,1,opt,name=estimated_amount,json=estimatedAmount,proto3" json:"estimated_amount,omitempty"`
-	ExchangeRate     string                 `protobuf:"bytes,2,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`
-	PriceImpact      string                 `protobuf:"bytes,3,opt,name=price_impact,json=priceImpact,proto3" json:"price_impact,omitempty"`
-	RoutePlan        []string               `protobuf:"bytes,4,rep,name=route_plan,json=routePlan,proto3" json:"route_plan,omitempty"`
-	InputMint        string                 `protobuf:"bytes,5,opt,name=input_mint,json=inputMint,proto3" json:"input_mint,omitempty"`
-	OutputMint       string                 `protobuf:"bytes,6,opt,name=output_mint,json=outputMint,proto3" json:"output_mint,omitempty"`
-	SolFeeBreakdown  *SolFeeBreakdown       `protobuf:"bytes,7,opt,name=sol_fee_breakdown,json=solFeeBreakdown,proto3,oneof" json:"sol_fee_breakdown,omitempty"` // Enhanced SOL fee breakdown
-	TotalSolRequired string                 `protobuf:"bytes,8,opt,name=total_sol_required,json=totalSolRequired,proto3" json:"total_sol_required,omitempty"`    // Total SOL needed for transaction
-	TradingFeeSol    string                 `protobuf:"bytes,9,opt,name=trading_fee_sol,json=tradingFeeSol,proto3" json:"trading_fee_sol,omitempty"`             // Trading fees in SOL
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	EstimatedAmount    string                 `protobuf:"bytes,1,opt,name=estimated_amount,json=estimatedAmount,proto3" json:"estimated_amount,omitempty"`
+	ExchangeRate       string                 `protobuf:"bytes,2,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`
+	PriceImpact        string                 `protobuf:"bytes,3,opt,name=price_impact,json=priceImpact,proto3" json:"price_impact,omitempty"`
+	RoutePlan          []string               `protobuf:"bytes,4,rep,name=route_plan,json=routePlan,proto3" json:"route_plan,omitempty"`
+	InputMint          string                 `protobuf:"bytes,5,opt,name=input_mint,json=inputMint,proto3" json:"input_mint,omitempty"`
+	OutputMint         string                 `protobuf:"bytes,6,opt,name=output_mint,json=outputMint,proto3" json:"output_mint,omitempty"`
+	SolFeeBreakdown    *SolFeeBreakdown       `protobuf:"bytes,7,opt,name=sol_fee_breakdown,json=solFeeBreakdown,proto3,oneof" json:"sol_fee_breakdown,omitempty"`     // Enhanced SOL fee breakdown
+	TotalSolRequired   string                 `protobuf:"bytes,8,opt,name=total_sol_required,json=totalSolRequired,proto3" json:"total_sol_required,omitempty"`        // Total SOL needed for transaction
+	TradingFeeSol      string                 `protobuf:"bytes,9,opt,name=trading_fee_sol,json=tradingFeeSol,proto3" json:"trading_fee_sol,omitempty"`                 // Trading fees in SOL
+	InputRawAmount     string                 `protobuf:"bytes,10,opt,name=input_raw_amount,json=inputRawAmount,proto3" json:"input_raw_amount,omitempty"`             // Exact input amount in base units
+	EstimatedRawAmount string                 `protobuf:"bytes,11,opt,name=estimated_raw_amount,json=estimatedRawAmount,proto3" json:"estimated_raw_amount,omitempty"` // Exact quoted output in base units; estimated_amount is rounded
+	PriceDivergences   []*PriceDivergence     `protobuf:"bytes,12,rep,name=price_divergences,json=priceDivergences,proto3" json:"price_divergences,omitempty"`         // Set when BirdEye and Jupiter disagree on a token's price; USD values may be off
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *GetSwapQuoteResponse) Reset() {
@@ -490,6 +541,96 @@ func (x *GetSwapQuoteResponse) GetTradingFeeSol() string {
 	return ""
 }
 
+func (x *GetSwapQuoteResponse) GetInputRawAmount() string {
+	if x != nil {
+		return x.InputRawAmount
+	}
+	return ""
+}
+
+func (x *GetSwapQuoteResponse) GetEstimatedRawAmount() string {
+	if x != nil {
+		return x.EstimatedRawAmount
+	}
+	return ""
+}
+
+func (x *GetSwapQuoteResponse) GetPriceDivergences() []*PriceDivergence {
+	if x != nil {
+		return x.PriceDivergences
+	}
+	return nil
+}
+
+// PriceDivergence is a token whose BirdEye price differs from Jupiter's beyond the cross-check threshold
+type PriceDivergence struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mint          string                 `protobuf:"bytes,1,opt,name=mint,proto3" json:"mint,omitempty"`
+	BirdeyePrice  float64                `protobuf:"fixed64,2,opt,name=birdeye_price,json=birdeyePrice,proto3" json:"birdeye_price,omitempty"`
+	JupiterPrice  float64                `protobuf:"fixed64,3,opt,name=jupiter_price,json=jupiterPrice,proto3" json:"jupiter_price,omitempty"`
+	Divergence    float64                `protobuf:"fixed64,4,opt,name=divergence,proto3" json:"divergence,omitempty"` // Relative to the Jupiter price, e.g. 0.1 for 10%
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceDivergence) Reset() {
+	*x = PriceDivergence{}
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceDivergence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceDivergence) ProtoMessage() {}
+
+func (x *PriceDivergence) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceDivergence.ProtoReflect.Descriptor instead.
+func (*PriceDivergence) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_trade_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PriceDivergence) GetMint() string {
+	if x != nil {
+		return x.Mint
+	}
+	return ""
+}
+
+func (x *PriceDivergence) GetBirdeyePrice() float64 {
+	if x != nil {
+		return x.BirdeyePrice
+	}
+	return 0
+}
+
+func (x *PriceDivergence) GetJupiterPrice() float64 {
+	if x != nil {
+		return x.JupiterPrice
+	}
+	return 0
+}
+
+func (x *PriceDivergence) GetDivergence() float64 {
+	if x != nil {
+		return x.Divergence
+	}
+	return 0
+}
+
 // PrepareSwapRequest is the request for preparing a swap transaction
 type PrepareSwapRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -499,14 +640,17 @@ type PrepareSwapRequest struct {
 	SlippageBps   string                 `protobuf:"bytes,4,opt,name=slippage_bps,json=slippageBps,proto3" json:"slippage_bps,omitempty"`
 	UserPublicKey string                 `protobuf:"bytes,5,opt,name=user_public_key,json=userPublicKey,proto3" json:"user_public_key,omitempty"` // This is the wallet address of the user initiating the swap
 	// from_address field was removed as user_public_key serves this purpose.
-	AllowMultiHop bool `protobuf:"varint,6,opt,name=allow_multi_hop,json=allowMultiHop,proto3" json:"allow_multi_hop,omitempty"` // Allow routing through multiple pools for better rates
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	AllowMultiHop     bool     `protobuf:"varint,6,opt,name=allow_multi_hop,json=allowMultiHop,proto3" json:"allow_multi_hop,omitempty"`          // Allow routing through multiple pools for better rates
+	Paper             bool     `protobuf:"varint,7,opt,name=paper,proto3" json:"paper,omitempty"`                                                 // Quote a paper trade against the wallet's virtual balances; no transaction is built
+	Sponsored         bool     `protobuf:"varint,8,opt,name=sponsored,proto3" json:"sponsored,omitempty"`                                         // Have the platform pay the network fees and rent, for wallets without SOL
+	AcknowledgedRisks []string `protobuf:"bytes,9,rep,name=acknowledged_risks,json=acknowledgedRisks,proto3" json:"acknowledged_risks,omitempty"` // Risk checks the user accepts, from RiskWarning.check
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *PrepareSwapRequest) Reset() {
 	*x = PrepareSwapRequest{}
-	mi := &file_dankfolio_v1_trade_proto_msgTypes[4]
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -518,7 +662,7 @@ func (x *PrepareSwapRequest) String() string {
 func (*PrepareSwapRequest) ProtoMessage() {}
 
 func (x *PrepareSwapRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_dankfolio_v1_trade_proto_msgTypes[4]
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -531,7 +675,7 @@ func (x *PrepareSwapRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PrepareSwapRequest.ProtoReflect.Descriptor instead.
 func (*PrepareSwapRequest) Descriptor() ([]byte, []int) {
-	return file_dankfolio_v1_trade_proto_rawDescGZIP(), []int{4}
+	return file_dankfolio_v1_trade_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *PrepareSwapRequest) GetFromCoinId() string {
@@ -576,20 +720,47 @@ func (x *PrepareSwapRequest) GetAllowMultiHop() bool {
 	return false
 }
 
+func (x *PrepareSwapRequest) GetPaper() bool {
+	if x != nil {
+		return x.Paper
+	}
+	return false
+}
+
+func (x *PrepareSwapRequest) GetSponsored() bool {
+	if x != nil {
+		return x.Sponsored
+	}
+	return false
+}
+
+func (x *PrepareSwapRequest) GetAcknowledgedRisks() []string {
+	if x != nil {
+		return x.AcknowledgedRisks
+	}
+	return nil
+}
+
 // PrepareSwapResponse is the response with the unsigned transaction
 type PrepareSwapResponse struct {
 	state               protoimpl.MessageState `protogen:"open.v1"`
 	UnsignedTransaction string                 `protobuf:"bytes,1,opt,name=unsigned_transaction,json=unsignedTransaction,proto3" json:"unsigned_transaction,omitempty"`
-	SolFeeBreakdown     *SolFeeBreakdown       `protobuf:"bytes,2,opt,name=sol_fee_breakdown,json=solFeeBreakdown,proto3,oneof" json:"sol_fee_breakdown,omitempty"` // Enhanced SOL fee breakdown
-	TotalSolRequired    string                 `protobuf:"bytes,3,opt,name=total_sol_required,json=totalSolRequired,proto3" json:"total_sol_required,omitempty"`    // Total SOL needed for transaction
-	TradingFeeSol       string                 `protobuf:"bytes,4,opt,name=trading_fee_sol,json=tradingFeeSol,proto3" json:"trading_fee_sol,omitempty"`             // Trading fees in SOL
+	SolFeeBreakdown     *SolFeeBreakdown       `protobuf:"bytes,2,opt,name=sol_fee_breakdown,json=solFeeBreakdown,proto3,oneof" json:"sol_fee_breakdown,omitempty"`        // Enhanced SOL fee breakdown
+	TotalSolRequired    string                 `protobuf:"bytes,3,opt,name=total_sol_required,json=totalSolRequired,proto3" json:"total_sol_required,omitempty"`           // Total SOL needed for transaction
+	TradingFeeSol       string                 `protobuf:"bytes,4,opt,name=trading_fee_sol,json=tradingFeeSol,proto3" json:"trading_fee_sol,omitempty"`                    // Trading fees in SOL
+	Deduplicated        bool                   `protobuf:"varint,5,opt,name=deduplicated,proto3" json:"deduplicated,omitempty"`                                            // Same result as an identical request made moments earlier
+	QuoteId             string                 `protobuf:"bytes,6,opt,name=quote_id,json=quoteId,proto3" json:"quote_id,omitempty"`                                        // Pass to SubmitSwap or RefreshQuote
+	ExpiresAt           *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`                                  // SubmitSwap rejects the transaction after this
+	SponsorFeeBps       int32                  `protobuf:"varint,8,opt,name=sponsor_fee_bps,json=sponsorFeeBps,proto3" json:"sponsor_fee_bps,omitempty"`                   // Sponsored swaps: added to the platform fee to recover the cost
+	SponsorCostLamports uint64                 `protobuf:"varint,9,opt,name=sponsor_cost_lamports,json=sponsorCostLamports,proto3" json:"sponsor_cost_lamports,omitempty"` // Sponsored swaps: most the platform pays in fees and rent
+	RiskWarnings        []*RiskWarning         `protobuf:"bytes,10,rep,name=risk_warnings,json=riskWarnings,proto3" json:"risk_warnings,omitempty"`                        // Risk checks the swap failed; show before signing
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
 
 func (x *PrepareSwapResponse) Reset() {
 	*x = PrepareSwapResponse{}
-	mi := &file_dankfolio_v1_trade_proto_msgTypes[5]
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -601,7 +772,7 @@ func (x *PrepareSwapResponse) String() string {
 func (*PrepareSwapResponse) ProtoMessage() {}
 
 func (x *PrepareSwapResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_dankfolio_v1_trade_proto_msgTypes[5]
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -614,7 +785,7 @@ func (x *PrepareSwapResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PrepareSwapResponse.ProtoReflect.Descriptor instead.
 func (*PrepareSwapResponse) Descriptor() ([]byte, []int) {
-	return file_dankfolio_v1_trade_proto_rawDescGZIP(), []int{5}
+	return file_dankfolio_v1_trade_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *PrepareSwapResponse) GetUnsignedTransaction() string {
@@ -645,106 +816,73 @@ func (x *PrepareSwapResponse) GetTradingFeeSol() string {
 	return ""
 }
 
-// SubmitSwapRequest is the request for submitting a trade
-type SubmitSwapRequest struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	FromCoinId          string                 `protobuf:"bytes,1,opt,name=from_coin_id,json=fromCoinId,proto3" json:"from_coin_id,omitempty"` // Typically mint address
-	ToCoinId            string                 `protobuf:"bytes,2,opt,name=to_coin_id,json=toCoinId,proto3" json:"to_coin_id,omitempty"`       // Typically mint address
-	Amount              float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	SignedTransaction   string                 `protobuf:"bytes,4,opt,name=signed_transaction,json=signedTransaction,proto3" json:"signed_transaction,omitempty"`
-	UnsignedTransaction string                 `protobuf:"bytes,5,opt,name=unsigned_transaction,json=unsignedTransaction,proto3" json:"unsigned_transaction,omitempty"` // used to retrieve the record in the backend
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
-}
-
-func (x *SubmitSwapRequest) Reset() {
-	*x = SubmitSwapRequest{}
-	mi := &file_dankfolio_v1_trade_proto_msgTypes[6]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *SubmitSwapRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*SubmitSwapRequest) ProtoMessage() {}
-
-func (x *SubmitSwapRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_dankfolio_v1_trade_proto_msgTypes[6]
+func (x *PrepareSwapResponse) GetDeduplicated() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Deduplicated
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use SubmitSwapRequest.ProtoReflect.Descriptor instead.
-func (*SubmitSwapRequest) Descriptor() ([]byte, []int) {
-	return file_dankfolio_v1_trade_proto_rawDescGZIP(), []int{6}
+	return false
 }
 
-func (x *SubmitSwapRequest) GetFromCoinId() string {
+func (x *PrepareSwapResponse) GetQuoteId() string {
 	if x != nil {
-		return x.FromCoinId
+		return x.QuoteId
 	}
 	return ""
 }
 
-func (x *SubmitSwapRequest) GetToCoinId() string {
+func (x *PrepareSwapResponse) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ToCoinId
+		return x.ExpiresAt
 	}
-	return ""
+	return nil
 }
 
-func (x *SubmitSwapRequest) GetAmount() float64 {
+func (x *PrepareSwapResponse) GetSponsorFeeBps() int32 {
 	if x != nil {
-		return x.Amount
+		return x.SponsorFeeBps
 	}
 	return 0
 }
 
-func (x *SubmitSwapRequest) GetSignedTransaction() string {
+func (x *PrepareSwapResponse) GetSponsorCostLamports() uint64 {
 	if x != nil {
-		return x.SignedTransaction
+		return x.SponsorCostLamports
 	}
-	return ""
+	return 0
 }
 
-func (x *SubmitSwapRequest) GetUnsignedTransaction() string {
+func (x *PrepareSwapResponse) GetRiskWarnings() []*RiskWarning {
 	if x != nil {
-		return x.UnsignedTransaction
+		return x.RiskWarnings
 	}
-	return ""
+	return nil
 }
 
-// SubmitSwapResponse is the response after submitting a trade
-type SubmitSwapResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	TradeId         string                 `protobuf:"bytes,1,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
-	TransactionHash string                 `protobuf:"bytes,2,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// RiskWarning is a risk check a prepared swap failed
+type RiskWarning struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Check         string                 `protobuf:"bytes,1,opt,name=check,proto3" json:"check,omitempty"`                // "position_size", "price_impact" or "new_coin"
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`            // Safe to show users
+	Blocking      bool                   `protobuf:"varint,3,opt,name=blocking,proto3" json:"blocking,omitempty"`         // PrepareSwap refuses the swap unless the check is in acknowledged_risks
+	Acknowledged  bool                   `protobuf:"varint,4,opt,name=acknowledged,proto3" json:"acknowledged,omitempty"` // The check was in acknowledged_risks
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubmitSwapResponse) Reset() {
-	*x = SubmitSwapResponse{}
+func (x *RiskWarning) Reset() {
+	*x = RiskWarning{}
 	mi := &file_dankfolio_v1_trade_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitSwapResponse) String() string {
+func (x *RiskWarning) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitSwapResponse) ProtoMessage() {}
+func (*RiskWarning) ProtoMessage() {}
 
-func (x *SubmitSwapResponse) ProtoReflect() protoreflect.Message {
+func (x *RiskWarning) ProtoReflect() protoreflect.Message {
 	mi := &file_dankfolio_v1_trade_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -756,51 +894,61 @@ func (x *SubmitSwapResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitSwapResponse.ProtoReflect.Descriptor instead.
-func (*SubmitSwapResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RiskWarning.ProtoReflect.Descriptor instead.
+func (*RiskWarning) Descriptor() ([]byte, []int) {
 	return file_dankfolio_v1_trade_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *SubmitSwapResponse) GetTradeId() string {
+func (x *RiskWarning) GetCheck() string {
 	if x != nil {
-		return x.TradeId
+		return x.Check
 	}
 	return ""
 }
 
-func (x *SubmitSwapResponse) GetTransactionHash() string {
+func (x *RiskWarning) GetMessage() string {
 	if x != nil {
-		return x.TransactionHash
+		return x.Message
 	}
 	return ""
 }
 
-// GetTradeRequest is the request for getting trade details and status
-type GetTradeRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Identifier:
-	//
-	//	*GetTradeRequest_Id
-	//	*GetTradeRequest_TransactionHash
-	Identifier    isGetTradeRequest_Identifier `protobuf_oneof:"identifier"`
+func (x *RiskWarning) GetBlocking() bool {
+	if x != nil {
+		return x.Blocking
+	}
+	return false
+}
+
+func (x *RiskWarning) GetAcknowledged() bool {
+	if x != nil {
+		return x.Acknowledged
+	}
+	return false
+}
+
+// RefreshQuoteRequest is the request for re-quoting a prepared swap
+type RefreshQuoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuoteId       string                 `protobuf:"bytes,1,opt,name=quote_id,json=quoteId,proto3" json:"quote_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTradeRequest) Reset() {
-	*x = GetTradeRequest{}
+func (x *RefreshQuoteRequest) Reset() {
+	*x = RefreshQuoteRequest{}
 	mi := &file_dankfolio_v1_trade_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTradeRequest) String() string {
+func (x *RefreshQuoteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTradeRequest) ProtoMessage() {}
+func (*RefreshQuoteRequest) ProtoMessage() {}
 
-func (x *GetTradeRequest) ProtoReflect() protoreflect.Message {
+func (x *RefreshQuoteRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_dankfolio_v1_trade_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -812,83 +960,1640 @@ func (x *GetTradeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTradeRequest.ProtoReflect.Descriptor instead.
-func (*GetTradeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RefreshQuoteRequest.ProtoReflect.Descriptor instead.
+func (*RefreshQuoteRequest) Descriptor() ([]byte, []int) {
 	return file_dankfolio_v1_trade_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetTradeRequest) GetIdentifier() isGetTradeRequest_Identifier {
+func (x *RefreshQuoteRequest) GetQuoteId() string {
 	if x != nil {
-		return x.Identifier
+		return x.QuoteId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetTradeRequest) GetId() string {
-	if x != nil {
-		if x, ok := x.Identifier.(*GetTradeRequest_Id); ok {
-			return x.Id
-		}
-	}
-	return ""
+// SubmitSwapRequest is the request for submitting a trade
+type SubmitSwapRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	FromCoinId          string                 `protobuf:"bytes,1,opt,name=from_coin_id,json=fromCoinId,proto3" json:"from_coin_id,omitempty"` // Typically mint address
+	ToCoinId            string                 `protobuf:"bytes,2,opt,name=to_coin_id,json=toCoinId,proto3" json:"to_coin_id,omitempty"`       // Typically mint address
+	Amount              float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	SignedTransaction   string                 `protobuf:"bytes,4,opt,name=signed_transaction,json=signedTransaction,proto3" json:"signed_transaction,omitempty"`
+	UnsignedTransaction string                 `protobuf:"bytes,5,opt,name=unsigned_transaction,json=unsignedTransaction,proto3" json:"unsigned_transaction,omitempty"` // used to retrieve the record in the backend
+	QuoteId             string                 `protobuf:"bytes,6,opt,name=quote_id,json=quoteId,proto3" json:"quote_id,omitempty"`                                     // Optional; from PrepareSwapResponse, checked for expiry
+	Paper               bool                   `protobuf:"varint,7,opt,name=paper,proto3" json:"paper,omitempty"`                                                       // Fill a paper trade prepared with paper set; only quote_id is needed
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *GetTradeRequest) GetTransactionHash() string {
-	if x != nil {
-		if x, ok := x.Identifier.(*GetTradeRequest_TransactionHash); ok {
+func (x *SubmitSwapRequest) Reset() {
+	*x = SubmitSwapRequest{}
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitSwapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitSwapRequest) ProtoMessage() {}
+
+func (x *SubmitSwapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitSwapRequest.ProtoReflect.Descriptor instead.
+func (*SubmitSwapRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_trade_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SubmitSwapRequest) GetFromCoinId() string {
+	if x != nil {
+		return x.FromCoinId
+	}
+	return ""
+}
+
+func (x *SubmitSwapRequest) GetToCoinId() string {
+	if x != nil {
+		return x.ToCoinId
+	}
+	return ""
+}
+
+func (x *SubmitSwapRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *SubmitSwapRequest) GetSignedTransaction() string {
+	if x != nil {
+		return x.SignedTransaction
+	}
+	return ""
+}
+
+func (x *SubmitSwapRequest) GetUnsignedTransaction() string {
+	if x != nil {
+		return x.UnsignedTransaction
+	}
+	return ""
+}
+
+func (x *SubmitSwapRequest) GetQuoteId() string {
+	if x != nil {
+		return x.QuoteId
+	}
+	return ""
+}
+
+func (x *SubmitSwapRequest) GetPaper() bool {
+	if x != nil {
+		return x.Paper
+	}
+	return false
+}
+
+// SubmitSwapResponse is the response after submitting a trade
+type SubmitSwapResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TradeId         string                 `protobuf:"bytes,1,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
+	TransactionHash string                 `protobuf:"bytes,2,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SubmitSwapResponse) Reset() {
+	*x = SubmitSwapResponse{}
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitSwapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitSwapResponse) ProtoMessage() {}
+
+func (x *SubmitSwapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitSwapResponse.ProtoReflect.Descriptor instead.
+func (*SubmitSwapResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_trade_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SubmitSwapResponse) GetTradeId() string {
+	if x != nil {
+		return x.TradeId
+	}
+	return ""
+}
+
+func (x *SubmitSwapResponse) GetTransactionHash() string {
+	if x != nil {
+		return x.TransactionHash
+	}
+	return ""
+}
+
+// GetTradeRequest is the request for getting trade details and status
+type GetTradeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Identifier:
+	//
+	//	*GetTradeRequest_Id
+	//	*GetTradeRequest_TransactionHash
+	Identifier    isGetTradeRequest_Identifier `protobuf_oneof:"identifier"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTradeRequest) Reset() {
+	*x = GetTradeRequest{}
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTradeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTradeRequest) ProtoMessage() {}
+
+func (x *GetTradeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTradeRequest.ProtoReflect.Descriptor instead.
+func (*GetTradeRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_trade_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetTradeRequest) GetIdentifier() isGetTradeRequest_Identifier {
+	if x != nil {
+		return x.Identifier
+	}
+	return nil
+}
+
+func (x *GetTradeRequest) GetId() string {
+	if x != nil {
+		if x, ok := x.Identifier.(*GetTradeRequest_Id); ok {
+			return x.Id
+		}
+	}
+	return ""
+}
+
+func (x *GetTradeRequest) GetTransactionHash() string {
+	if x != nil {
+		if x, ok := x.Identifier.(*GetTradeRequest_TransactionHash); ok {
 			return x.TransactionHash
 		}
 	}
 	return ""
 }
 
-type isGetTradeRequest_Identifier interface {
-	isGetTradeRequest_Identifier()
+type isGetTradeRequest_Identifier interface {
+	isGetTradeRequest_Identifier()
+}
+
+type GetTradeRequest_Id struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3,oneof"`
+}
+
+type GetTradeRequest_TransactionHash struct {
+	TransactionHash string `protobuf:"bytes,2,opt,name=transaction_hash,json=transactionHash,proto3,oneof"`
+}
+
+func (*GetTradeRequest_Id) isGetTradeRequest_Identifier() {}
+
+func (*GetTradeRequest_TransactionHash) isGetTradeRequest_Identifier() {}
+
+// ListTradesRequest is the request for listing trades
+type ListTradesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Limit           int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset          int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	SortBy          string                 `protobuf:"bytes,3,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"` // e.g., "created_at", "amount"
+	SortDesc        bool                   `protobuf:"varint,4,opt,name=sort_desc,json=sortDesc,proto3" json:"sort_desc,omitempty"`
+	UserId          *string                `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`                              // Filter by user ID (wallet public key)
+	Status          *string                `protobuf:"bytes,6,opt,name=status,proto3,oneof" json:"status,omitempty"`                                            // Filter by trade status (e.g., "completed", "pending")
+	Type            *string                `protobuf:"bytes,7,opt,name=type,proto3,oneof" json:"type,omitempty"`                                                // Filter by trade type (e.g., "swap", "transfer")
+	FromCoinAddress *string                `protobuf:"bytes,8,opt,name=from_coin_address,json=fromCoinAddress,proto3,oneof" json:"from_coin_address,omitempty"` // Filter by source coin mint address
+	ToCoinAddress   *string                `protobuf:"bytes,9,opt,name=to_coin_address,json=toCoinAddress,proto3,oneof" json:"to_coin_address,omitempty"`       // Filter by destination coin mint address
+	Paper           bool                   `protobuf:"varint,10,opt,name=paper,proto3" json:"paper,omitempty"`                                                  // List paper trades instead; requires user_id
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListTradesRequest) Reset() {
+	*x = ListTradesRequest{}
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTradesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTradesRequest) ProtoMessage() {}
+
+func (x *ListTradesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTradesRequest.ProtoReflect.Descriptor instead.
+func (*ListTradesRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_trade_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListTradesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListTradesRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListTradesRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *ListTradesRequest) GetSortDesc() bool {
+	if x != nil {
+		return x.SortDesc
+	}
+	return false
+}
+
+func (x *ListTradesRequest) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+func (x *ListTradesRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *ListTradesRequest) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *ListTradesRequest) GetFromCoinAddress() string {
+	if x != nil && x.FromCoinAddress != nil {
+		return *x.FromCoinAddress
+	}
+	return ""
+}
+
+func (x *ListTradesRequest) GetToCoinAddress() string {
+	if x != nil && x.ToCoinAddress != nil {
+		return *x.ToCoinAddress
+	}
+	return ""
+}
+
+func (x *ListTradesRequest) GetPaper() bool {
+	if x != nil {
+		return x.Paper
+	}
+	return false
+}
+
+// ListTradesResponse is the response containing a list of trades
+type ListTradesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Trades        []*Trade               `protobuf:"bytes,1,rep,name=trades,proto3" json:"trades,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"` // Total number of trades matching the filter criteria (before pagination)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTradesResponse) Reset() {
+	*x = ListTradesResponse{}
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTradesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTradesResponse) ProtoMessage() {}
+
+func (x *ListTradesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTradesResponse.ProtoReflect.Descriptor instead.
+func (*ListTradesResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_trade_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListTradesResponse) GetTrades() []*Trade {
+	if x != nil {
+		return x.Trades
+	}
+	return nil
+}
+
+func (x *ListTradesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// GetTradeReceiptRequest is the request for a completed swap's receipt
+type GetTradeReceiptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TradeId       string                 `protobuf:"bytes,1,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTradeReceiptRequest) Reset() {
+	*x = GetTradeReceiptRequest{}
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTradeReceiptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTradeReceiptRequest) ProtoMessage() {}
+
+func (x *GetTradeReceiptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTradeReceiptRequest.ProtoReflect.Descriptor instead.
+func (*GetTradeReceiptRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_trade_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetTradeReceiptRequest) GetTradeId() string {
+	if x != nil {
+		return x.TradeId
+	}
+	return ""
+}
+
+// GetTradeReceiptResponse is the response containing a trade receipt
+type GetTradeReceiptResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Receipt       *TradeReceipt          `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTradeReceiptResponse) Reset() {
+	*x = GetTradeReceiptResponse{}
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTradeReceiptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTradeReceiptResponse) ProtoMessage() {}
+
+func (x *GetTradeReceiptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTradeReceiptResponse.ProtoReflect.Descriptor instead.
+func (*GetTradeReceiptResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_trade_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetTradeReceiptResponse) GetReceipt() *TradeReceipt {
+	if x != nil {
+		return x.Receipt
+	}
+	return nil
+}
+
+// TradeReceipt is a swap's fill as read from its confirmed transaction.
+// Amounts are in UI units and prices are output per unit of input.
+type TradeReceipt struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	TradeId              string                 `protobuf:"bytes,1,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
+	TransactionHash      string                 `protobuf:"bytes,2,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	FromCoinMintAddress  string                 `protobuf:"bytes,3,opt,name=from_coin_mint_address,json=fromCoinMintAddress,proto3" json:"from_coin_mint_address,omitempty"`
+	ToCoinMintAddress    string                 `protobuf:"bytes,4,opt,name=to_coin_mint_address,json=toCoinMintAddress,proto3" json:"to_coin_mint_address,omitempty"`
+	QuotedAmount         float64                `protobuf:"fixed64,5,opt,name=quoted_amount,json=quotedAmount,proto3" json:"quoted_amount,omitempty"`
+	QuotedOutputAmount   float64                `protobuf:"fixed64,6,opt,name=quoted_output_amount,json=quotedOutputAmount,proto3" json:"quoted_output_amount,omitempty"`
+	QuotedPrice          float64                `protobuf:"fixed64,7,opt,name=quoted_price,json=quotedPrice,proto3" json:"quoted_price,omitempty"`
+	ExecutedAmount       float64                `protobuf:"fixed64,8,opt,name=executed_amount,json=executedAmount,proto3" json:"executed_amount,omitempty"`
+	ExecutedOutputAmount float64                `protobuf:"fixed64,9,opt,name=executed_output_amount,json=executedOutputAmount,proto3" json:"executed_output_amount,omitempty"`
+	ExecutedPrice        float64                `protobuf:"fixed64,10,opt,name=executed_price,json=executedPrice,proto3" json:"executed_price,omitempty"`
+	PlatformFee          float64                `protobuf:"fixed64,11,opt,name=platform_fee,json=platformFee,proto3" json:"platform_fee,omitempty"` // Fee the platform fee account actually received
+	PlatformFeeMint      string                 `protobuf:"bytes,12,opt,name=platform_fee_mint,json=platformFeeMint,proto3" json:"platform_fee_mint,omitempty"`
+	NetworkFeeSol        float64                `protobuf:"fixed64,13,opt,name=network_fee_sol,json=networkFeeSol,proto3" json:"network_fee_sol,omitempty"`
+	SlippageBps          float64                `protobuf:"fixed64,14,opt,name=slippage_bps,json=slippageBps,proto3" json:"slippage_bps,omitempty"` // Executed price shortfall against the quote; negative when it beat the quote
+	AnalyzedAt           *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=analyzed_at,json=analyzedAt,proto3" json:"analyzed_at,omitempty"`
+	MevIncident          *MevIncident           `protobuf:"bytes,16,opt,name=mev_incident,json=mevIncident,proto3,oneof" json:"mev_incident,omitempty"` // Set when the swap was likely sandwiched
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *TradeReceipt) Reset() {
+	*x = TradeReceipt{}
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TradeReceipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TradeReceipt) ProtoMessage() {}
+
+func (x *TradeReceipt) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TradeReceipt.ProtoReflect.Descriptor instead.
+func (*TradeReceipt) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_trade_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TradeReceipt) GetTradeId() string {
+	if x != nil {
+		return x.TradeId
+	}
+	return ""
+}
+
+func (x *TradeReceipt) GetTransactionHash() string {
+	if x != nil {
+		return x.TransactionHash
+	}
+	return ""
+}
+
+func (x *TradeReceipt) GetFromCoinMintAddress() string {
+	if x != nil {
+		return x.FromCoinMintAddress
+	}
+	return ""
+}
+
+func (x *TradeReceipt) GetToCoinMintAddress() string {
+	if x != nil {
+		return x.ToCoinMintAddress
+	}
+	return ""
+}
+
+func (x *TradeReceipt) GetQuotedAmount() float64 {
+	if x != nil {
+		return x.QuotedAmount
+	}
+	return 0
+}
+
+func (x *TradeReceipt) GetQuotedOutputAmount() float64 {
+	if x != nil {
+		return x.QuotedOutputAmount
+	}
+	return 0
+}
+
+func (x *TradeReceipt) GetQuotedPrice() float64 {
+	if x != nil {
+		return x.QuotedPrice
+	}
+	return 0
+}
+
+func (x *TradeReceipt) GetExecutedAmount() float64 {
+	if x != nil {
+		return x.ExecutedAmount
+	}
+	return 0
+}
+
+func (x *TradeReceipt) GetExecutedOutputAmount() float64 {
+	if x != nil {
+		return x.ExecutedOutputAmount
+	}
+	return 0
+}
+
+func (x *TradeReceipt) GetExecutedPrice() float64 {
+	if x != nil {
+		return x.ExecutedPrice
+	}
+	return 0
+}
+
+func (x *TradeReceipt) GetPlatformFee() float64 {
+	if x != nil {
+		return x.PlatformFee
+	}
+	return 0
+}
+
+func (x *TradeReceipt) GetPlatformFeeMint() string {
+	if x != nil {
+		return x.PlatformFeeMint
+	}
+	return ""
+}
+
+func (x *TradeReceipt) GetNetworkFeeSol() float64 {
+	if x != nil {
+		return x.NetworkFeeSol
+	}
+	return 0
+}
+
+func (x *TradeReceipt) GetSlippageBps() float64 {
+	if x != nil {
+		return x.SlippageBps
+	}
+	return 0
+}
+
+func (x *TradeReceipt) GetAnalyzedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AnalyzedAt
+	}
+	return nil
+}
+
+func (x *TradeReceipt) GetMevIncident() *MevIncident {
+	if x != nil {
+		return x.MevIncident
+	}
+	return nil
+}
+
+// MevIncident is a suspected sandwich: one signer traded the swap's pair
+// against the same pool right before and right after it
+type MevIncident struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Attacker          string                 `protobuf:"bytes,1,opt,name=attacker,proto3" json:"attacker,omitempty"`
+	FrontRunSignature string                 `protobuf:"bytes,2,opt,name=front_run_signature,json=frontRunSignature,proto3" json:"front_run_signature,omitempty"`
+	BackRunSignature  string                 `protobuf:"bytes,3,opt,name=back_run_signature,json=backRunSignature,proto3" json:"back_run_signature,omitempty"`
+	Pool              string                 `protobuf:"bytes,4,opt,name=pool,proto3" json:"pool,omitempty"`
+	LossMint          string                 `protobuf:"bytes,5,opt,name=loss_mint,json=lossMint,proto3" json:"loss_mint,omitempty"`
+	EstimatedLoss     float64                `protobuf:"fixed64,6,opt,name=estimated_loss,json=estimatedLoss,proto3" json:"estimated_loss,omitempty"` // In loss_mint units
+	EstimatedLossUsd  float64                `protobuf:"fixed64,7,opt,name=estimated_loss_usd,json=estimatedLossUsd,proto3" json:"estimated_loss_usd,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *MevIncident) Reset() {
+	*x = MevIncident{}
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MevIncident) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MevIncident) ProtoMessage() {}
+
+func (x *MevIncident) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MevIncident.ProtoReflect.Descriptor instead.
+func (*MevIncident) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_trade_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *MevIncident) GetAttacker() string {
+	if x != nil {
+		return x.Attacker
+	}
+	return ""
+}
+
+func (x *MevIncident) GetFrontRunSignature() string {
+	if x != nil {
+		return x.FrontRunSignature
+	}
+	return ""
+}
+
+func (x *MevIncident) GetBackRunSignature() string {
+	if x != nil {
+		return x.BackRunSignature
+	}
+	return ""
+}
+
+func (x *MevIncident) GetPool() string {
+	if x != nil {
+		return x.Pool
+	}
+	return ""
+}
+
+func (x *MevIncident) GetLossMint() string {
+	if x != nil {
+		return x.LossMint
+	}
+	return ""
+}
+
+func (x *MevIncident) GetEstimatedLoss() float64 {
+	if x != nil {
+		return x.EstimatedLoss
+	}
+	return 0
+}
+
+func (x *MevIncident) GetEstimatedLossUsd() float64 {
+	if x != nil {
+		return x.EstimatedLossUsd
+	}
+	return 0
+}
+
+type GetPaperPortfolioRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserPublicKey string                 `protobuf:"bytes,1,opt,name=user_public_key,json=userPublicKey,proto3" json:"user_public_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPaperPortfolioRequest) Reset() {
+	*x = GetPaperPortfolioRequest{}
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPaperPortfolioRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPaperPortfolioRequest) ProtoMessage() {}
+
+func (x *GetPaperPortfolioRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPaperPortfolioRequest.ProtoReflect.Descriptor instead.
+func (*GetPaperPortfolioRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_trade_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetPaperPortfolioRequest) GetUserPublicKey() string {
+	if x != nil {
+		return x.UserPublicKey
+	}
+	return ""
+}
+
+type ResetPaperPortfolioRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserPublicKey string                 `protobuf:"bytes,1,opt,name=user_public_key,json=userPublicKey,proto3" json:"user_public_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPaperPortfolioRequest) Reset() {
+	*x = ResetPaperPortfolioRequest{}
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPaperPortfolioRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPaperPortfolioRequest) ProtoMessage() {}
+
+func (x *ResetPaperPortfolioRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPaperPortfolioRequest.ProtoReflect.Descriptor instead.
+func (*ResetPaperPortfolioRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_trade_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ResetPaperPortfolioRequest) GetUserPublicKey() string {
+	if x != nil {
+		return x.UserPublicKey
+	}
+	return ""
+}
+
+// PaperHolding is a virtual balance of one coin, valued at its current price
+type PaperHolding struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Mint             string                 `protobuf:"bytes,1,opt,name=mint,proto3" json:"mint,omitempty"`
+	Symbol           string                 `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Amount           float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"` // UI units
+	Price            float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`   // USD
+	ValueUsd         float64                `protobuf:"fixed64,5,opt,name=value_usd,json=valueUsd,proto3" json:"value_usd,omitempty"`
+	CostBasisUsd     float64                `protobuf:"fixed64,6,opt,name=cost_basis_usd,json=costBasisUsd,proto3" json:"cost_basis_usd,omitempty"` // USD paid for the amount still held
+	UnrealizedPnlUsd float64                `protobuf:"fixed64,7,opt,name=unrealized_pnl_usd,json=unrealizedPnlUsd,proto3" json:"unrealized_pnl_usd,omitempty"`
+	RealizedPnlUsd   float64                `protobuf:"fixed64,8,opt,name=realized_pnl_usd,json=realizedPnlUsd,proto3" json:"realized_pnl_usd,omitempty"` // Profit taken selling this coin
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PaperHolding) Reset() {
+	*x = PaperHolding{}
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaperHolding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaperHolding) ProtoMessage() {}
+
+func (x *PaperHolding) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaperHolding.ProtoReflect.Descriptor instead.
+func (*PaperHolding) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_trade_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PaperHolding) GetMint() string {
+	if x != nil {
+		return x.Mint
+	}
+	return ""
+}
+
+func (x *PaperHolding) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *PaperHolding) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PaperHolding) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *PaperHolding) GetValueUsd() float64 {
+	if x != nil {
+		return x.ValueUsd
+	}
+	return 0
+}
+
+func (x *PaperHolding) GetCostBasisUsd() float64 {
+	if x != nil {
+		return x.CostBasisUsd
+	}
+	return 0
+}
+
+func (x *PaperHolding) GetUnrealizedPnlUsd() float64 {
+	if x != nil {
+		return x.UnrealizedPnlUsd
+	}
+	return 0
+}
+
+func (x *PaperHolding) GetRealizedPnlUsd() float64 {
+	if x != nil {
+		return x.RealizedPnlUsd
+	}
+	return 0
+}
+
+type GetPaperPortfolioResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Holdings         []*PaperHolding        `protobuf:"bytes,1,rep,name=holdings,proto3" json:"holdings,omitempty"`
+	TotalValueUsd    float64                `protobuf:"fixed64,2,opt,name=total_value_usd,json=totalValueUsd,proto3" json:"total_value_usd,omitempty"`
+	UnrealizedPnlUsd float64                `protobuf:"fixed64,3,opt,name=unrealized_pnl_usd,json=unrealizedPnlUsd,proto3" json:"unrealized_pnl_usd,omitempty"`
+	RealizedPnlUsd   float64                `protobuf:"fixed64,4,opt,name=realized_pnl_usd,json=realizedPnlUsd,proto3" json:"realized_pnl_usd,omitempty"`
+	StartingSol      float64                `protobuf:"fixed64,5,opt,name=starting_sol,json=startingSol,proto3" json:"starting_sol,omitempty"` // SOL the account was funded with
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetPaperPortfolioResponse) Reset() {
+	*x = GetPaperPortfolioResponse{}
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPaperPortfolioResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPaperPortfolioResponse) ProtoMessage() {}
+
+func (x *GetPaperPortfolioResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPaperPortfolioResponse.ProtoReflect.Descriptor instead.
+func (*GetPaperPortfolioResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_trade_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetPaperPortfolioResponse) GetHoldings() []*PaperHolding {
+	if x != nil {
+		return x.Holdings
+	}
+	return nil
+}
+
+func (x *GetPaperPortfolioResponse) GetTotalValueUsd() float64 {
+	if x != nil {
+		return x.TotalValueUsd
+	}
+	return 0
+}
+
+func (x *GetPaperPortfolioResponse) GetUnrealizedPnlUsd() float64 {
+	if x != nil {
+		return x.UnrealizedPnlUsd
+	}
+	return 0
+}
+
+func (x *GetPaperPortfolioResponse) GetRealizedPnlUsd() float64 {
+	if x != nil {
+		return x.RealizedPnlUsd
+	}
+	return 0
+}
+
+func (x *GetPaperPortfolioResponse) GetStartingSol() float64 {
+	if x != nil {
+		return x.StartingSol
+	}
+	return 0
+}
+
+type GetMaxSpendableRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserPublicKey string                 `protobuf:"bytes,1,opt,name=user_public_key,json=userPublicKey,proto3" json:"user_public_key,omitempty"`
+	FromCoinId    string                 `protobuf:"bytes,2,opt,name=from_coin_id,json=fromCoinId,proto3" json:"from_coin_id,omitempty"`
+	ToCoinId      string                 `protobuf:"bytes,3,opt,name=to_coin_id,json=toCoinId,proto3" json:"to_coin_id,omitempty"`
+	Paper         bool                   `protobuf:"varint,4,opt,name=paper,proto3" json:"paper,omitempty"` // Spend from the paper trading balances
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMaxSpendableRequest) Reset() {
+	*x = GetMaxSpendableRequest{}
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMaxSpendableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMaxSpendableRequest) ProtoMessage() {}
+
+func (x *GetMaxSpendableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMaxSpendableRequest.ProtoReflect.Descriptor instead.
+func (*GetMaxSpendableRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_trade_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetMaxSpendableRequest) GetUserPublicKey() string {
+	if x != nil {
+		return x.UserPublicKey
+	}
+	return ""
+}
+
+func (x *GetMaxSpendableRequest) GetFromCoinId() string {
+	if x != nil {
+		return x.FromCoinId
+	}
+	return ""
+}
+
+func (x *GetMaxSpendableRequest) GetToCoinId() string {
+	if x != nil {
+		return x.ToCoinId
+	}
+	return ""
+}
+
+func (x *GetMaxSpendableRequest) GetPaper() bool {
+	if x != nil {
+		return x.Paper
+	}
+	return false
+}
+
+type GetMaxSpendableResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	RawAmount            string                 `protobuf:"bytes,1,opt,name=raw_amount,json=rawAmount,proto3" json:"raw_amount,omitempty"` // Base units of the from coin
+	Amount               float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`                      // UI units
+	Decimals             int32                  `protobuf:"varint,3,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	ReservedLamports     uint64                 `protobuf:"varint,4,opt,name=reserved_lamports,json=reservedLamports,proto3" json:"reserved_lamports,omitempty"`               // SOL kept back for fees and rent
+	AccountsToCreate     int32                  `protobuf:"varint,5,opt,name=accounts_to_create,json=accountsToCreate,proto3" json:"accounts_to_create,omitempty"`             // Token accounts the swap creates
+	PlatformFeeBps       int32                  `protobuf:"varint,6,opt,name=platform_fee_bps,json=platformFeeBps,proto3" json:"platform_fee_bps,omitempty"`                   // Deducted from the swap, not on top of it
+	PresetRawAmounts     []string               `protobuf:"bytes,7,rep,name=preset_raw_amounts,json=presetRawAmounts,proto3" json:"preset_raw_amounts,omitempty"`              // 25, 50, 75 and 100% of raw_amount
+	SolShortfallLamports uint64                 `protobuf:"varint,8,opt,name=sol_shortfall_lamports,json=solShortfallLamports,proto3" json:"sol_shortfall_lamports,omitempty"` // SOL missing for the fees of a token swap
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GetMaxSpendableResponse) Reset() {
+	*x = GetMaxSpendableResponse{}
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMaxSpendableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMaxSpendableResponse) ProtoMessage() {}
+
+func (x *GetMaxSpendableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMaxSpendableResponse.ProtoReflect.Descriptor instead.
+func (*GetMaxSpendableResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_trade_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetMaxSpendableResponse) GetRawAmount() string {
+	if x != nil {
+		return x.RawAmount
+	}
+	return ""
+}
+
+func (x *GetMaxSpendableResponse) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *GetMaxSpendableResponse) GetDecimals() int32 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+func (x *GetMaxSpendableResponse) GetReservedLamports() uint64 {
+	if x != nil {
+		return x.ReservedLamports
+	}
+	return 0
+}
+
+func (x *GetMaxSpendableResponse) GetAccountsToCreate() int32 {
+	if x != nil {
+		return x.AccountsToCreate
+	}
+	return 0
+}
+
+func (x *GetMaxSpendableResponse) GetPlatformFeeBps() int32 {
+	if x != nil {
+		return x.PlatformFeeBps
+	}
+	return 0
+}
+
+func (x *GetMaxSpendableResponse) GetPresetRawAmounts() []string {
+	if x != nil {
+		return x.PresetRawAmounts
+	}
+	return nil
+}
+
+func (x *GetMaxSpendableResponse) GetSolShortfallLamports() uint64 {
+	if x != nil {
+		return x.SolShortfallLamports
+	}
+	return 0
+}
+
+type ValidateTradeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserPublicKey string                 `protobuf:"bytes,1,opt,name=user_public_key,json=userPublicKey,proto3" json:"user_public_key,omitempty"`
+	FromCoinId    string                 `protobuf:"bytes,2,opt,name=from_coin_id,json=fromCoinId,proto3" json:"from_coin_id,omitempty"`
+	ToCoinId      string                 `protobuf:"bytes,3,opt,name=to_coin_id,json=toCoinId,proto3" json:"to_coin_id,omitempty"`
+	Amount        string                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"` // Base units of the from coin
+	Paper         bool                   `protobuf:"varint,5,opt,name=paper,proto3" json:"paper,omitempty"`  // Validate against the paper trading balances
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateTradeRequest) Reset() {
+	*x = ValidateTradeRequest{}
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateTradeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateTradeRequest) ProtoMessage() {}
+
+func (x *ValidateTradeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateTradeRequest.ProtoReflect.Descriptor instead.
+func (*ValidateTradeRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_trade_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ValidateTradeRequest) GetUserPublicKey() string {
+	if x != nil {
+		return x.UserPublicKey
+	}
+	return ""
+}
+
+func (x *ValidateTradeRequest) GetFromCoinId() string {
+	if x != nil {
+		return x.FromCoinId
+	}
+	return ""
+}
+
+func (x *ValidateTradeRequest) GetToCoinId() string {
+	if x != nil {
+		return x.ToCoinId
+	}
+	return ""
+}
+
+func (x *ValidateTradeRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *ValidateTradeRequest) GetPaper() bool {
+	if x != nil {
+		return x.Paper
+	}
+	return false
+}
+
+// TradeProblem is a reason the swap would fail
+type TradeProblem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reason        string                 `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`   // ErrorInfo reason the swap would fail with, e.g. INSUFFICIENT_BALANCE
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // Safe to show users
+	Mint          string                 `protobuf:"bytes,3,opt,name=mint,proto3" json:"mint,omitempty"`       // The coin the problem is with, if any
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TradeProblem) Reset() {
+	*x = TradeProblem{}
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TradeProblem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TradeProblem) ProtoMessage() {}
+
+func (x *TradeProblem) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TradeProblem.ProtoReflect.Descriptor instead.
+func (*TradeProblem) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_trade_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *TradeProblem) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *TradeProblem) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *TradeProblem) GetMint() string {
+	if x != nil {
+		return x.Mint
+	}
+	return ""
+}
+
+type ValidateTradeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"` // No problems were found
+	Problems      []*TradeProblem        `protobuf:"bytes,2,rep,name=problems,proto3" json:"problems,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateTradeResponse) Reset() {
+	*x = ValidateTradeResponse{}
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateTradeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateTradeResponse) ProtoMessage() {}
+
+func (x *ValidateTradeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateTradeResponse.ProtoReflect.Descriptor instead.
+func (*ValidateTradeResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_trade_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ValidateTradeResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateTradeResponse) GetProblems() []*TradeProblem {
+	if x != nil {
+		return x.Problems
+	}
+	return nil
+}
+
+type CreateTwapOrderRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserPublicKey   string                 `protobuf:"bytes,1,opt,name=user_public_key,json=userPublicKey,proto3" json:"user_public_key,omitempty"`
+	FromCoinId      string                 `protobuf:"bytes,2,opt,name=from_coin_id,json=fromCoinId,proto3" json:"from_coin_id,omitempty"`
+	ToCoinId        string                 `protobuf:"bytes,3,opt,name=to_coin_id,json=toCoinId,proto3" json:"to_coin_id,omitempty"`
+	Amount          string                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`                                           // Base units of the from coin, in total
+	Slices          int32                  `protobuf:"varint,5,opt,name=slices,proto3" json:"slices,omitempty"`                                          // Between 2 and 50
+	DurationMinutes int32                  `protobuf:"varint,6,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"` // From the first slice to the last, at most a day
+	SlippageBps     string                 `protobuf:"bytes,7,opt,name=slippage_bps,json=slippageBps,proto3" json:"slippage_bps,omitempty"`
+	AllowMultiHop   bool                   `protobuf:"varint,8,opt,name=allow_multi_hop,json=allowMultiHop,proto3" json:"allow_multi_hop,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateTwapOrderRequest) Reset() {
+	*x = CreateTwapOrderRequest{}
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTwapOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTwapOrderRequest) ProtoMessage() {}
+
+func (x *CreateTwapOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTwapOrderRequest.ProtoReflect.Descriptor instead.
+func (*CreateTwapOrderRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_trade_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CreateTwapOrderRequest) GetUserPublicKey() string {
+	if x != nil {
+		return x.UserPublicKey
+	}
+	return ""
+}
+
+func (x *CreateTwapOrderRequest) GetFromCoinId() string {
+	if x != nil {
+		return x.FromCoinId
+	}
+	return ""
+}
+
+func (x *CreateTwapOrderRequest) GetToCoinId() string {
+	if x != nil {
+		return x.ToCoinId
+	}
+	return ""
+}
+
+func (x *CreateTwapOrderRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *CreateTwapOrderRequest) GetSlices() int32 {
+	if x != nil {
+		return x.Slices
+	}
+	return 0
+}
+
+func (x *CreateTwapOrderRequest) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+func (x *CreateTwapOrderRequest) GetSlippageBps() string {
+	if x != nil {
+		return x.SlippageBps
+	}
+	return ""
+}
+
+func (x *CreateTwapOrderRequest) GetAllowMultiHop() bool {
+	if x != nil {
+		return x.AllowMultiHop
+	}
+	return false
+}
+
+// TwapSlice is one swap of a TWAP order
+type TwapSlice struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Index               int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	RawAmount           string                 `protobuf:"bytes,2,opt,name=raw_amount,json=rawAmount,proto3" json:"raw_amount,omitempty"` // Base units of the from coin
+	Status              string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`                        // pending, prepared, submitted, filled, failed, expired or cancelled
+	DueAt               *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	QuoteId             string                 `protobuf:"bytes,5,opt,name=quote_id,json=quoteId,proto3" json:"quote_id,omitempty"`                                     // Set once prepared; pass to SubmitSwap
+	UnsignedTransaction string                 `protobuf:"bytes,6,opt,name=unsigned_transaction,json=unsignedTransaction,proto3" json:"unsigned_transaction,omitempty"` // Set while prepared
+	QuoteExpiresAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=quote_expires_at,json=quoteExpiresAt,proto3" json:"quote_expires_at,omitempty"`
+	TransactionHash     string                 `protobuf:"bytes,8,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	OutputAmount        float64                `protobuf:"fixed64,9,opt,name=output_amount,json=outputAmount,proto3" json:"output_amount,omitempty"` // UI units of the to coin, once filled
+	Error               string                 `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`                                    // Why the slice failed or expired
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *TwapSlice) Reset() {
+	*x = TwapSlice{}
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TwapSlice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TwapSlice) ProtoMessage() {}
+
+func (x *TwapSlice) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TwapSlice.ProtoReflect.Descriptor instead.
+func (*TwapSlice) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_trade_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *TwapSlice) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *TwapSlice) GetRawAmount() string {
+	if x != nil {
+		return x.RawAmount
+	}
+	return ""
+}
+
+func (x *TwapSlice) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
 }
 
-type GetTradeRequest_Id struct {
-	Id string `protobuf:"bytes,1,opt,name=id,proto3,oneof"`
+func (x *TwapSlice) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
 }
 
-type GetTradeRequest_TransactionHash struct {
-	TransactionHash string `protobuf:"bytes,2,opt,name=transaction_hash,json=transactionHash,proto3,oneof"`
+func (x *TwapSlice) GetQuoteId() string {
+	if x != nil {
+		return x.QuoteId
+	}
+	return ""
 }
 
-func (*GetTradeRequest_Id) isGetTradeRequest_Identifier() {}
+func (x *TwapSlice) GetUnsignedTransaction() string {
+	if x != nil {
+		return x.UnsignedTransaction
+	}
+	return ""
+}
 
-func (*GetTradeRequest_TransactionHash) isGetTradeRequest_Identifier() {}
+func (x *TwapSlice) GetQuoteExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.QuoteExpiresAt
+	}
+	return nil
+}
 
-// ListTradesRequest is the request for listing trades
-type ListTradesRequest struct {
+func (x *TwapSlice) GetTransactionHash() string {
+	if x != nil {
+		return x.TransactionHash
+	}
+	return ""
+}
+
+func (x *TwapSlice) GetOutputAmount() float64 {
+	if x != nil {
+		return x.OutputAmount
+	}
+	return 0
+}
+
+func (x *TwapSlice) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type TwapOrder struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
-	Limit           int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset          int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	SortBy          string                 `protobuf:"bytes,3,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"` // e.g., "created_at", "amount"
-	SortDesc        bool                   `protobuf:"varint,4,opt,name=sort_desc,json=sortDesc,proto3" json:"sort_desc,omitempty"`
-	UserId          *string                `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`                              // Filter by user ID (wallet public key)
-	Status          *string                `protobuf:"bytes,6,opt,name=status,proto3,oneof" json:"status,omitempty"`                                            // Filter by trade status (e.g., "completed", "pending")
-	Type            *string                `protobuf:"bytes,7,opt,name=type,proto3,oneof" json:"type,omitempty"`                                                // Filter by trade type (e.g., "swap", "transfer")
-	FromCoinAddress *string                `protobuf:"bytes,8,opt,name=from_coin_address,json=fromCoinAddress,proto3,oneof" json:"from_coin_address,omitempty"` // Filter by source coin mint address
-	ToCoinAddress   *string                `protobuf:"bytes,9,opt,name=to_coin_address,json=toCoinAddress,proto3,oneof" json:"to_coin_address,omitempty"`       // Filter by destination coin mint address
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FromCoinId      string                 `protobuf:"bytes,2,opt,name=from_coin_id,json=fromCoinId,proto3" json:"from_coin_id,omitempty"`
+	ToCoinId        string                 `protobuf:"bytes,3,opt,name=to_coin_id,json=toCoinId,proto3" json:"to_coin_id,omitempty"`
+	RawAmount       string                 `protobuf:"bytes,4,opt,name=raw_amount,json=rawAmount,proto3" json:"raw_amount,omitempty"`
+	SliceCount      int32                  `protobuf:"varint,5,opt,name=slice_count,json=sliceCount,proto3" json:"slice_count,omitempty"`
+	IntervalSeconds int32                  `protobuf:"varint,6,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	Status          string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"` // active, completed or cancelled
+	FilledSlices    int32                  `protobuf:"varint,8,opt,name=filled_slices,json=filledSlices,proto3" json:"filled_slices,omitempty"`
+	FilledRawAmount string                 `protobuf:"bytes,9,opt,name=filled_raw_amount,json=filledRawAmount,proto3" json:"filled_raw_amount,omitempty"` // Base units of the from coin swapped so far
+	OutputAmount    float64                `protobuf:"fixed64,10,opt,name=output_amount,json=outputAmount,proto3" json:"output_amount,omitempty"`         // UI units of the to coin received so far
+	NextSliceAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=next_slice_at,json=nextSliceAt,proto3,oneof" json:"next_slice_at,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Slices          []*TwapSlice           `protobuf:"bytes,13,rep,name=slices,proto3" json:"slices,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ListTradesRequest) Reset() {
-	*x = ListTradesRequest{}
-	mi := &file_dankfolio_v1_trade_proto_msgTypes[9]
+func (x *TwapOrder) Reset() {
+	*x = TwapOrder{}
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTradesRequest) String() string {
+func (x *TwapOrder) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTradesRequest) ProtoMessage() {}
+func (*TwapOrder) ProtoMessage() {}
 
-func (x *ListTradesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_dankfolio_v1_trade_proto_msgTypes[9]
+func (x *TwapOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -899,98 +2604,168 @@ func (x *ListTradesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTradesRequest.ProtoReflect.Descriptor instead.
-func (*ListTradesRequest) Descriptor() ([]byte, []int) {
-	return file_dankfolio_v1_trade_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use TwapOrder.ProtoReflect.Descriptor instead.
+func (*TwapOrder) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_trade_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *ListTradesRequest) GetLimit() int32 {
+func (x *TwapOrder) GetId() string {
 	if x != nil {
-		return x.Limit
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TwapOrder) GetFromCoinId() string {
+	if x != nil {
+		return x.FromCoinId
+	}
+	return ""
+}
+
+func (x *TwapOrder) GetToCoinId() string {
+	if x != nil {
+		return x.ToCoinId
+	}
+	return ""
+}
+
+func (x *TwapOrder) GetRawAmount() string {
+	if x != nil {
+		return x.RawAmount
+	}
+	return ""
+}
+
+func (x *TwapOrder) GetSliceCount() int32 {
+	if x != nil {
+		return x.SliceCount
 	}
 	return 0
 }
 
-func (x *ListTradesRequest) GetOffset() int32 {
+func (x *TwapOrder) GetIntervalSeconds() int32 {
 	if x != nil {
-		return x.Offset
+		return x.IntervalSeconds
 	}
 	return 0
 }
 
-func (x *ListTradesRequest) GetSortBy() string {
+func (x *TwapOrder) GetStatus() string {
 	if x != nil {
-		return x.SortBy
+		return x.Status
 	}
 	return ""
 }
 
-func (x *ListTradesRequest) GetSortDesc() bool {
+func (x *TwapOrder) GetFilledSlices() int32 {
 	if x != nil {
-		return x.SortDesc
+		return x.FilledSlices
 	}
-	return false
+	return 0
 }
 
-func (x *ListTradesRequest) GetUserId() string {
-	if x != nil && x.UserId != nil {
-		return *x.UserId
+func (x *TwapOrder) GetFilledRawAmount() string {
+	if x != nil {
+		return x.FilledRawAmount
 	}
 	return ""
 }
 
-func (x *ListTradesRequest) GetStatus() string {
-	if x != nil && x.Status != nil {
-		return *x.Status
+func (x *TwapOrder) GetOutputAmount() float64 {
+	if x != nil {
+		return x.OutputAmount
 	}
-	return ""
+	return 0
 }
 
-func (x *ListTradesRequest) GetType() string {
-	if x != nil && x.Type != nil {
-		return *x.Type
+func (x *TwapOrder) GetNextSliceAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextSliceAt
 	}
-	return ""
+	return nil
 }
 
-func (x *ListTradesRequest) GetFromCoinAddress() string {
-	if x != nil && x.FromCoinAddress != nil {
-		return *x.FromCoinAddress
+func (x *TwapOrder) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *ListTradesRequest) GetToCoinAddress() string {
-	if x != nil && x.ToCoinAddress != nil {
-		return *x.ToCoinAddress
+func (x *TwapOrder) GetSlices() []*TwapSlice {
+	if x != nil {
+		return x.Slices
+	}
+	return nil
+}
+
+type ListTwapOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserPublicKey string                 `protobuf:"bytes,1,opt,name=user_public_key,json=userPublicKey,proto3" json:"user_public_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTwapOrdersRequest) Reset() {
+	*x = ListTwapOrdersRequest{}
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTwapOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTwapOrdersRequest) ProtoMessage() {}
+
+func (x *ListTwapOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTwapOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ListTwapOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_trade_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListTwapOrdersRequest) GetUserPublicKey() string {
+	if x != nil {
+		return x.UserPublicKey
 	}
 	return ""
 }
 
-// ListTradesResponse is the response containing a list of trades
-type ListTradesResponse struct {
+type ListTwapOrdersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Trades        []*Trade               `protobuf:"bytes,1,rep,name=trades,proto3" json:"trades,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"` // Total number of trades matching the filter criteria (before pagination)
+	Orders        []*TwapOrder           `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTradesResponse) Reset() {
-	*x = ListTradesResponse{}
-	mi := &file_dankfolio_v1_trade_proto_msgTypes[10]
+func (x *ListTwapOrdersResponse) Reset() {
+	*x = ListTwapOrdersResponse{}
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTradesResponse) String() string {
+func (x *ListTwapOrdersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTradesResponse) ProtoMessage() {}
+func (*ListTwapOrdersResponse) ProtoMessage() {}
 
-func (x *ListTradesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_dankfolio_v1_trade_proto_msgTypes[10]
+func (x *ListTwapOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1001,30 +2776,75 @@ func (x *ListTradesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTradesResponse.ProtoReflect.Descriptor instead.
-func (*ListTradesResponse) Descriptor() ([]byte, []int) {
-	return file_dankfolio_v1_trade_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ListTwapOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ListTwapOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_trade_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *ListTradesResponse) GetTrades() []*Trade {
+func (x *ListTwapOrdersResponse) GetOrders() []*TwapOrder {
 	if x != nil {
-		return x.Trades
+		return x.Orders
 	}
 	return nil
 }
 
-func (x *ListTradesResponse) GetTotalCount() int32 {
+type CancelTwapOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserPublicKey string                 `protobuf:"bytes,1,opt,name=user_public_key,json=userPublicKey,proto3" json:"user_public_key,omitempty"`
+	OrderId       string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelTwapOrderRequest) Reset() {
+	*x = CancelTwapOrderRequest{}
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelTwapOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelTwapOrderRequest) ProtoMessage() {}
+
+func (x *CancelTwapOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_trade_proto_msgTypes[32]
 	if x != nil {
-		return x.TotalCount
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelTwapOrderRequest.ProtoReflect.Descriptor instead.
+func (*CancelTwapOrderRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_trade_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CancelTwapOrderRequest) GetUserPublicKey() string {
+	if x != nil {
+		return x.UserPublicKey
+	}
+	return ""
+}
+
+func (x *CancelTwapOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
 }
 
 var File_dankfolio_v1_trade_proto protoreflect.FileDescriptor
 
 const file_dankfolio_v1_trade_proto_rawDesc = "" +
 	"\n" +
-	"\x18dankfolio/v1/trade.proto\x12\fdankfolio.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd1\x05\n" +
+	"\x18dankfolio/v1/trade.proto\x12\fdankfolio.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x84\a\n" +
 	"\x05Trade\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12 \n" +
@@ -1047,7 +2867,16 @@ const file_dankfolio_v1_trade_proto_rawDesc = "" +
 	"\x13platform_fee_amount\x18\x12 \x01(\x01H\x02R\x11platformFeeAmount\x88\x01\x01\x12(\n" +
 	"\routput_amount\x18\x15 \x01(\x01H\x03R\foutputAmount\x88\x01\x01\x12 \n" +
 	"\vfromAddress\x18\x16 \x01(\tR\vfromAddress\x12\x1c\n" +
-	"\ttoAddress\x18\x17 \x01(\tR\ttoAddressB\x0f\n" +
+	"\ttoAddress\x18\x17 \x01(\tR\ttoAddress\x12\x1d\n" +
+	"\n" +
+	"raw_amount\x18\x18 \x01(\tR\trawAmount\x12*\n" +
+	"\x11raw_output_amount\x18\x19 \x01(\tR\x0frawOutputAmount\x12\x12\n" +
+	"\x04memo\x18\x1a \x01(\tR\x04memo\x12\x1e\n" +
+	"\n" +
+	"references\x18\x1b \x03(\tR\n" +
+	"references\x12\x14\n" +
+	"\x05paper\x18\x1c \x01(\bR\x05paper\x12\x1c\n" +
+	"\tsponsored\x18\x1d \x01(\bR\tsponsoredB\x0f\n" +
 	"\r_completed_atB\b\n" +
 	"\x06_errorB\x16\n" +
 	"\x14_platform_fee_amountB\x10\n" +
@@ -1070,7 +2899,7 @@ const file_dankfolio_v1_trade_proto_rawDesc = "" +
 	"\x14account_creation_fee\x18\x03 \x01(\tR\x12accountCreationFee\x12!\n" +
 	"\fpriority_fee\x18\x04 \x01(\tR\vpriorityFee\x12\x14\n" +
 	"\x05total\x18\x05 \x01(\tR\x05total\x12,\n" +
-	"\x12accounts_to_create\x18\x06 \x01(\x05R\x10accountsToCreate\"\xa4\x03\n" +
+	"\x12accounts_to_create\x18\x06 \x01(\x05R\x10accountsToCreate\"\xcc\x04\n" +
 	"\x14GetSwapQuoteResponse\x12)\n" +
 	"\x10estimated_amount\x18\x01 \x01(\tR\x0festimatedAmount\x12#\n" +
 	"\rexchange_rate\x18\x02 \x01(\tR\fexchangeRate\x12!\n" +
@@ -1083,8 +2912,19 @@ const file_dankfolio_v1_trade_proto_rawDesc = "" +
 	"outputMint\x12N\n" +
 	"\x11sol_fee_breakdown\x18\a \x01(\v2\x1d.dankfolio.v1.SolFeeBreakdownH\x00R\x0fsolFeeBreakdown\x88\x01\x01\x12,\n" +
 	"\x12total_sol_required\x18\b \x01(\tR\x10totalSolRequired\x12&\n" +
-	"\x0ftrading_fee_sol\x18\t \x01(\tR\rtradingFeeSolB\x14\n" +
-	"\x12_sol_fee_breakdown\"\xdf\x01\n" +
+	"\x0ftrading_fee_sol\x18\t \x01(\tR\rtradingFeeSol\x12(\n" +
+	"\x10input_raw_amount\x18\n" +
+	" \x01(\tR\x0einputRawAmount\x120\n" +
+	"\x14estimated_raw_amount\x18\v \x01(\tR\x12estimatedRawAmount\x12J\n" +
+	"\x11price_divergences\x18\f \x03(\v2\x1d.dankfolio.v1.PriceDivergenceR\x10priceDivergencesB\x14\n" +
+	"\x12_sol_fee_breakdown\"\x8f\x01\n" +
+	"\x0fPriceDivergence\x12\x12\n" +
+	"\x04mint\x18\x01 \x01(\tR\x04mint\x12#\n" +
+	"\rbirdeye_price\x18\x02 \x01(\x01R\fbirdeyePrice\x12#\n" +
+	"\rjupiter_price\x18\x03 \x01(\x01R\fjupiterPrice\x12\x1e\n" +
+	"\n" +
+	"divergence\x18\x04 \x01(\x01R\n" +
+	"divergence\"\xc2\x02\n" +
 	"\x12PrepareSwapRequest\x12 \n" +
 	"\ffrom_coin_id\x18\x01 \x01(\tR\n" +
 	"fromCoinId\x12\x1c\n" +
@@ -1093,13 +2933,31 @@ const file_dankfolio_v1_trade_proto_rawDesc = "" +
 	"\x06amount\x18\x03 \x01(\tR\x06amount\x12!\n" +
 	"\fslippage_bps\x18\x04 \x01(\tR\vslippageBps\x12&\n" +
 	"\x0fuser_public_key\x18\x05 \x01(\tR\ruserPublicKey\x12&\n" +
-	"\x0fallow_multi_hop\x18\x06 \x01(\bR\rallowMultiHop\"\x84\x02\n" +
+	"\x0fallow_multi_hop\x18\x06 \x01(\bR\rallowMultiHop\x12\x14\n" +
+	"\x05paper\x18\a \x01(\bR\x05paper\x12\x1c\n" +
+	"\tsponsored\x18\b \x01(\bR\tsponsored\x12-\n" +
+	"\x12acknowledged_risks\x18\t \x03(\tR\x11acknowledgedRisks\"\x9a\x04\n" +
 	"\x13PrepareSwapResponse\x121\n" +
 	"\x14unsigned_transaction\x18\x01 \x01(\tR\x13unsignedTransaction\x12N\n" +
 	"\x11sol_fee_breakdown\x18\x02 \x01(\v2\x1d.dankfolio.v1.SolFeeBreakdownH\x00R\x0fsolFeeBreakdown\x88\x01\x01\x12,\n" +
 	"\x12total_sol_required\x18\x03 \x01(\tR\x10totalSolRequired\x12&\n" +
-	"\x0ftrading_fee_sol\x18\x04 \x01(\tR\rtradingFeeSolB\x14\n" +
-	"\x12_sol_fee_breakdown\"\xcd\x01\n" +
+	"\x0ftrading_fee_sol\x18\x04 \x01(\tR\rtradingFeeSol\x12\"\n" +
+	"\fdeduplicated\x18\x05 \x01(\bR\fdeduplicated\x12\x19\n" +
+	"\bquote_id\x18\x06 \x01(\tR\aquoteId\x129\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12&\n" +
+	"\x0fsponsor_fee_bps\x18\b \x01(\x05R\rsponsorFeeBps\x122\n" +
+	"\x15sponsor_cost_lamports\x18\t \x01(\x04R\x13sponsorCostLamports\x12>\n" +
+	"\rrisk_warnings\x18\n" +
+	" \x03(\v2\x19.dankfolio.v1.RiskWarningR\friskWarningsB\x14\n" +
+	"\x12_sol_fee_breakdown\"}\n" +
+	"\vRiskWarning\x12\x14\n" +
+	"\x05check\x18\x01 \x01(\tR\x05check\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1a\n" +
+	"\bblocking\x18\x03 \x01(\bR\bblocking\x12\"\n" +
+	"\facknowledged\x18\x04 \x01(\bR\facknowledged\"0\n" +
+	"\x13RefreshQuoteRequest\x12\x19\n" +
+	"\bquote_id\x18\x01 \x01(\tR\aquoteId\"\xfe\x01\n" +
 	"\x11SubmitSwapRequest\x12 \n" +
 	"\ffrom_coin_id\x18\x01 \x01(\tR\n" +
 	"fromCoinId\x12\x1c\n" +
@@ -1107,7 +2965,9 @@ const file_dankfolio_v1_trade_proto_rawDesc = "" +
 	"to_coin_id\x18\x02 \x01(\tR\btoCoinId\x12\x16\n" +
 	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12-\n" +
 	"\x12signed_transaction\x18\x04 \x01(\tR\x11signedTransaction\x121\n" +
-	"\x14unsigned_transaction\x18\x05 \x01(\tR\x13unsignedTransaction\"Z\n" +
+	"\x14unsigned_transaction\x18\x05 \x01(\tR\x13unsignedTransaction\x12\x19\n" +
+	"\bquote_id\x18\x06 \x01(\tR\aquoteId\x12\x14\n" +
+	"\x05paper\x18\a \x01(\bR\x05paper\"Z\n" +
 	"\x12SubmitSwapResponse\x12\x19\n" +
 	"\btrade_id\x18\x01 \x01(\tR\atradeId\x12)\n" +
 	"\x10transaction_hash\x18\x02 \x01(\tR\x0ftransactionHash\"^\n" +
@@ -1115,7 +2975,7 @@ const file_dankfolio_v1_trade_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\tH\x00R\x02id\x12+\n" +
 	"\x10transaction_hash\x18\x02 \x01(\tH\x00R\x0ftransactionHashB\f\n" +
 	"\n" +
-	"identifier\"\xf3\x02\n" +
+	"identifier\"\x89\x03\n" +
 	"\x11ListTradesRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
 	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x17\n" +
@@ -1125,7 +2985,9 @@ const file_dankfolio_v1_trade_proto_rawDesc = "" +
 	"\x06status\x18\x06 \x01(\tH\x01R\x06status\x88\x01\x01\x12\x17\n" +
 	"\x04type\x18\a \x01(\tH\x02R\x04type\x88\x01\x01\x12/\n" +
 	"\x11from_coin_address\x18\b \x01(\tH\x03R\x0ffromCoinAddress\x88\x01\x01\x12+\n" +
-	"\x0fto_coin_address\x18\t \x01(\tH\x04R\rtoCoinAddress\x88\x01\x01B\n" +
+	"\x0fto_coin_address\x18\t \x01(\tH\x04R\rtoCoinAddress\x88\x01\x01\x12\x14\n" +
+	"\x05paper\x18\n" +
+	" \x01(\bR\x05paperB\n" +
 	"\n" +
 	"\b_user_idB\t\n" +
 	"\a_statusB\a\n" +
@@ -1135,15 +2997,159 @@ const file_dankfolio_v1_trade_proto_rawDesc = "" +
 	"\x12ListTradesResponse\x12+\n" +
 	"\x06trades\x18\x01 \x03(\v2\x13.dankfolio.v1.TradeR\x06trades\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount2\x9b\x03\n" +
+	"totalCount\"3\n" +
+	"\x16GetTradeReceiptRequest\x12\x19\n" +
+	"\btrade_id\x18\x01 \x01(\tR\atradeId\"O\n" +
+	"\x17GetTradeReceiptResponse\x124\n" +
+	"\areceipt\x18\x01 \x01(\v2\x1a.dankfolio.v1.TradeReceiptR\areceipt\"\xe5\x05\n" +
+	"\fTradeReceipt\x12\x19\n" +
+	"\btrade_id\x18\x01 \x01(\tR\atradeId\x12)\n" +
+	"\x10transaction_hash\x18\x02 \x01(\tR\x0ftransactionHash\x123\n" +
+	"\x16from_coin_mint_address\x18\x03 \x01(\tR\x13fromCoinMintAddress\x12/\n" +
+	"\x14to_coin_mint_address\x18\x04 \x01(\tR\x11toCoinMintAddress\x12#\n" +
+	"\rquoted_amount\x18\x05 \x01(\x01R\fquotedAmount\x120\n" +
+	"\x14quoted_output_amount\x18\x06 \x01(\x01R\x12quotedOutputAmount\x12!\n" +
+	"\fquoted_price\x18\a \x01(\x01R\vquotedPrice\x12'\n" +
+	"\x0fexecuted_amount\x18\b \x01(\x01R\x0eexecutedAmount\x124\n" +
+	"\x16executed_output_amount\x18\t \x01(\x01R\x14executedOutputAmount\x12%\n" +
+	"\x0eexecuted_price\x18\n" +
+	" \x01(\x01R\rexecutedPrice\x12!\n" +
+	"\fplatform_fee\x18\v \x01(\x01R\vplatformFee\x12*\n" +
+	"\x11platform_fee_mint\x18\f \x01(\tR\x0fplatformFeeMint\x12&\n" +
+	"\x0fnetwork_fee_sol\x18\r \x01(\x01R\rnetworkFeeSol\x12!\n" +
+	"\fslippage_bps\x18\x0e \x01(\x01R\vslippageBps\x12;\n" +
+	"\vanalyzed_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"analyzedAt\x12A\n" +
+	"\fmev_incident\x18\x10 \x01(\v2\x19.dankfolio.v1.MevIncidentH\x00R\vmevIncident\x88\x01\x01B\x0f\n" +
+	"\r_mev_incident\"\x8d\x02\n" +
+	"\vMevIncident\x12\x1a\n" +
+	"\battacker\x18\x01 \x01(\tR\battacker\x12.\n" +
+	"\x13front_run_signature\x18\x02 \x01(\tR\x11frontRunSignature\x12,\n" +
+	"\x12back_run_signature\x18\x03 \x01(\tR\x10backRunSignature\x12\x12\n" +
+	"\x04pool\x18\x04 \x01(\tR\x04pool\x12\x1b\n" +
+	"\tloss_mint\x18\x05 \x01(\tR\blossMint\x12%\n" +
+	"\x0eestimated_loss\x18\x06 \x01(\x01R\restimatedLoss\x12,\n" +
+	"\x12estimated_loss_usd\x18\a \x01(\x01R\x10estimatedLossUsd\"B\n" +
+	"\x18GetPaperPortfolioRequest\x12&\n" +
+	"\x0fuser_public_key\x18\x01 \x01(\tR\ruserPublicKey\"D\n" +
+	"\x1aResetPaperPortfolioRequest\x12&\n" +
+	"\x0fuser_public_key\x18\x01 \x01(\tR\ruserPublicKey\"\x83\x02\n" +
+	"\fPaperHolding\x12\x12\n" +
+	"\x04mint\x18\x01 \x01(\tR\x04mint\x12\x16\n" +
+	"\x06symbol\x18\x02 \x01(\tR\x06symbol\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\x01R\x05price\x12\x1b\n" +
+	"\tvalue_usd\x18\x05 \x01(\x01R\bvalueUsd\x12$\n" +
+	"\x0ecost_basis_usd\x18\x06 \x01(\x01R\fcostBasisUsd\x12,\n" +
+	"\x12unrealized_pnl_usd\x18\a \x01(\x01R\x10unrealizedPnlUsd\x12(\n" +
+	"\x10realized_pnl_usd\x18\b \x01(\x01R\x0erealizedPnlUsd\"\xf6\x01\n" +
+	"\x19GetPaperPortfolioResponse\x126\n" +
+	"\bholdings\x18\x01 \x03(\v2\x1a.dankfolio.v1.PaperHoldingR\bholdings\x12&\n" +
+	"\x0ftotal_value_usd\x18\x02 \x01(\x01R\rtotalValueUsd\x12,\n" +
+	"\x12unrealized_pnl_usd\x18\x03 \x01(\x01R\x10unrealizedPnlUsd\x12(\n" +
+	"\x10realized_pnl_usd\x18\x04 \x01(\x01R\x0erealizedPnlUsd\x12!\n" +
+	"\fstarting_sol\x18\x05 \x01(\x01R\vstartingSol\"\x96\x01\n" +
+	"\x16GetMaxSpendableRequest\x12&\n" +
+	"\x0fuser_public_key\x18\x01 \x01(\tR\ruserPublicKey\x12 \n" +
+	"\ffrom_coin_id\x18\x02 \x01(\tR\n" +
+	"fromCoinId\x12\x1c\n" +
+	"\n" +
+	"to_coin_id\x18\x03 \x01(\tR\btoCoinId\x12\x14\n" +
+	"\x05paper\x18\x04 \x01(\bR\x05paper\"\xd5\x02\n" +
+	"\x17GetMaxSpendableResponse\x12\x1d\n" +
+	"\n" +
+	"raw_amount\x18\x01 \x01(\tR\trawAmount\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\x1a\n" +
+	"\bdecimals\x18\x03 \x01(\x05R\bdecimals\x12+\n" +
+	"\x11reserved_lamports\x18\x04 \x01(\x04R\x10reservedLamports\x12,\n" +
+	"\x12accounts_to_create\x18\x05 \x01(\x05R\x10accountsToCreate\x12(\n" +
+	"\x10platform_fee_bps\x18\x06 \x01(\x05R\x0eplatformFeeBps\x12,\n" +
+	"\x12preset_raw_amounts\x18\a \x03(\tR\x10presetRawAmounts\x124\n" +
+	"\x16sol_shortfall_lamports\x18\b \x01(\x04R\x14solShortfallLamports\"\xac\x01\n" +
+	"\x14ValidateTradeRequest\x12&\n" +
+	"\x0fuser_public_key\x18\x01 \x01(\tR\ruserPublicKey\x12 \n" +
+	"\ffrom_coin_id\x18\x02 \x01(\tR\n" +
+	"fromCoinId\x12\x1c\n" +
+	"\n" +
+	"to_coin_id\x18\x03 \x01(\tR\btoCoinId\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\tR\x06amount\x12\x14\n" +
+	"\x05paper\x18\x05 \x01(\bR\x05paper\"T\n" +
+	"\fTradeProblem\x12\x16\n" +
+	"\x06reason\x18\x01 \x01(\tR\x06reason\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x12\n" +
+	"\x04mint\x18\x03 \x01(\tR\x04mint\"e\n" +
+	"\x15ValidateTradeResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x126\n" +
+	"\bproblems\x18\x02 \x03(\v2\x1a.dankfolio.v1.TradeProblemR\bproblems\"\xa6\x02\n" +
+	"\x16CreateTwapOrderRequest\x12&\n" +
+	"\x0fuser_public_key\x18\x01 \x01(\tR\ruserPublicKey\x12 \n" +
+	"\ffrom_coin_id\x18\x02 \x01(\tR\n" +
+	"fromCoinId\x12\x1c\n" +
+	"\n" +
+	"to_coin_id\x18\x03 \x01(\tR\btoCoinId\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\tR\x06amount\x12\x16\n" +
+	"\x06slices\x18\x05 \x01(\x05R\x06slices\x12)\n" +
+	"\x10duration_minutes\x18\x06 \x01(\x05R\x0fdurationMinutes\x12!\n" +
+	"\fslippage_bps\x18\a \x01(\tR\vslippageBps\x12&\n" +
+	"\x0fallow_multi_hop\x18\b \x01(\bR\rallowMultiHop\"\x85\x03\n" +
+	"\tTwapSlice\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x1d\n" +
+	"\n" +
+	"raw_amount\x18\x02 \x01(\tR\trawAmount\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x121\n" +
+	"\x06due_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x05dueAt\x12\x19\n" +
+	"\bquote_id\x18\x05 \x01(\tR\aquoteId\x121\n" +
+	"\x14unsigned_transaction\x18\x06 \x01(\tR\x13unsignedTransaction\x12D\n" +
+	"\x10quote_expires_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x0equoteExpiresAt\x12)\n" +
+	"\x10transaction_hash\x18\b \x01(\tR\x0ftransactionHash\x12#\n" +
+	"\routput_amount\x18\t \x01(\x01R\foutputAmount\x12\x14\n" +
+	"\x05error\x18\n" +
+	" \x01(\tR\x05error\"\x97\x04\n" +
+	"\tTwapOrder\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12 \n" +
+	"\ffrom_coin_id\x18\x02 \x01(\tR\n" +
+	"fromCoinId\x12\x1c\n" +
+	"\n" +
+	"to_coin_id\x18\x03 \x01(\tR\btoCoinId\x12\x1d\n" +
+	"\n" +
+	"raw_amount\x18\x04 \x01(\tR\trawAmount\x12\x1f\n" +
+	"\vslice_count\x18\x05 \x01(\x05R\n" +
+	"sliceCount\x12)\n" +
+	"\x10interval_seconds\x18\x06 \x01(\x05R\x0fintervalSeconds\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12#\n" +
+	"\rfilled_slices\x18\b \x01(\x05R\ffilledSlices\x12*\n" +
+	"\x11filled_raw_amount\x18\t \x01(\tR\x0ffilledRawAmount\x12#\n" +
+	"\routput_amount\x18\n" +
+	" \x01(\x01R\foutputAmount\x12C\n" +
+	"\rnext_slice_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampH\x00R\vnextSliceAt\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12/\n" +
+	"\x06slices\x18\r \x03(\v2\x17.dankfolio.v1.TwapSliceR\x06slicesB\x10\n" +
+	"\x0e_next_slice_at\"?\n" +
+	"\x15ListTwapOrdersRequest\x12&\n" +
+	"\x0fuser_public_key\x18\x01 \x01(\tR\ruserPublicKey\"I\n" +
+	"\x16ListTwapOrdersResponse\x12/\n" +
+	"\x06orders\x18\x01 \x03(\v2\x17.dankfolio.v1.TwapOrderR\x06orders\"[\n" +
+	"\x16CancelTwapOrderRequest\x12&\n" +
+	"\x0fuser_public_key\x18\x01 \x01(\tR\ruserPublicKey\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId2\xdc\t\n" +
 	"\fTradeService\x12U\n" +
 	"\fGetSwapQuote\x12!.dankfolio.v1.GetSwapQuoteRequest\x1a\".dankfolio.v1.GetSwapQuoteResponse\x12R\n" +
-	"\vPrepareSwap\x12 .dankfolio.v1.PrepareSwapRequest\x1a!.dankfolio.v1.PrepareSwapResponse\x12O\n" +
+	"\vPrepareSwap\x12 .dankfolio.v1.PrepareSwapRequest\x1a!.dankfolio.v1.PrepareSwapResponse\x12T\n" +
+	"\fRefreshQuote\x12!.dankfolio.v1.RefreshQuoteRequest\x1a!.dankfolio.v1.PrepareSwapResponse\x12O\n" +
 	"\n" +
 	"SubmitSwap\x12\x1f.dankfolio.v1.SubmitSwapRequest\x1a .dankfolio.v1.SubmitSwapResponse\x12>\n" +
 	"\bGetTrade\x12\x1d.dankfolio.v1.GetTradeRequest\x1a\x13.dankfolio.v1.Trade\x12O\n" +
 	"\n" +
-	"ListTrades\x12\x1f.dankfolio.v1.ListTradesRequest\x1a .dankfolio.v1.ListTradesResponseB\xb6\x01\n" +
+	"ListTrades\x12\x1f.dankfolio.v1.ListTradesRequest\x1a .dankfolio.v1.ListTradesResponse\x12^\n" +
+	"\x0fGetTradeReceipt\x12$.dankfolio.v1.GetTradeReceiptRequest\x1a%.dankfolio.v1.GetTradeReceiptResponse\x12d\n" +
+	"\x11GetPaperPortfolio\x12&.dankfolio.v1.GetPaperPortfolioRequest\x1a'.dankfolio.v1.GetPaperPortfolioResponse\x12h\n" +
+	"\x13ResetPaperPortfolio\x12(.dankfolio.v1.ResetPaperPortfolioRequest\x1a'.dankfolio.v1.GetPaperPortfolioResponse\x12^\n" +
+	"\x0fGetMaxSpendable\x12$.dankfolio.v1.GetMaxSpendableRequest\x1a%.dankfolio.v1.GetMaxSpendableResponse\x12X\n" +
+	"\rValidateTrade\x12\".dankfolio.v1.ValidateTradeRequest\x1a#.dankfolio.v1.ValidateTradeResponse\x12P\n" +
+	"\x0fCreateTwapOrder\x12$.dankfolio.v1.CreateTwapOrderRequest\x1a\x17.dankfolio.v1.TwapOrder\x12[\n" +
+	"\x0eListTwapOrders\x12#.dankfolio.v1.ListTwapOrdersRequest\x1a$.dankfolio.v1.ListTwapOrdersResponse\x12P\n" +
+	"\x0fCancelTwapOrder\x12$.dankfolio.v1.CancelTwapOrderRequest\x1a\x17.dankfolio.v1.TwapOrderB\xb6\x01\n" +
 	"\x10com.dankfolio.v1B\n" +
 	"TradeProtoP\x01ZEgithub.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1\xa2\x02\x03DXX\xaa\x02\fDankfolio.V1\xca\x02\fDankfolio\\V1\xe2\x02\x18Dankfolio\\V1\\GPBMetadata\xea\x02\rDankfolio::V1b\x06proto3"
 
@@ -1159,42 +3165,96 @@ func file_dankfolio_v1_trade_proto_rawDescGZIP() []byte {
 	return file_dankfolio_v1_trade_proto_rawDescData
 }
 
-var file_dankfolio_v1_trade_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_dankfolio_v1_trade_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
 var file_dankfolio_v1_trade_proto_goTypes = []any{
-	(*Trade)(nil),                 // 0: dankfolio.v1.Trade
-	(*GetSwapQuoteRequest)(nil),   // 1: dankfolio.v1.GetSwapQuoteRequest
-	(*SolFeeBreakdown)(nil),       // 2: dankfolio.v1.SolFeeBreakdown
-	(*GetSwapQuoteResponse)(nil),  // 3: dankfolio.v1.GetSwapQuoteResponse
-	(*PrepareSwapRequest)(nil),    // 4: dankfolio.v1.PrepareSwapRequest
-	(*PrepareSwapResponse)(nil),   // 5: dankfolio.v1.PrepareSwapResponse
-	(*SubmitSwapRequest)(nil),     // 6: dankfolio.v1.SubmitSwapRequest
-	(*SubmitSwapResponse)(nil),    // 7: dankfolio.v1.SubmitSwapResponse
-	(*GetTradeRequest)(nil),       // 8: dankfolio.v1.GetTradeRequest
-	(*ListTradesRequest)(nil),     // 9: dankfolio.v1.ListTradesRequest
-	(*ListTradesResponse)(nil),    // 10: dankfolio.v1.ListTradesResponse
-	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+	(*Trade)(nil),                      // 0: dankfolio.v1.Trade
+	(*GetSwapQuoteRequest)(nil),        // 1: dankfolio.v1.GetSwapQuoteRequest
+	(*SolFeeBreakdown)(nil),            // 2: dankfolio.v1.SolFeeBreakdown
+	(*GetSwapQuoteResponse)(nil),       // 3: dankfolio.v1.GetSwapQuoteResponse
+	(*PriceDivergence)(nil),            // 4: dankfolio.v1.PriceDivergence
+	(*PrepareSwapRequest)(nil),         // 5: dankfolio.v1.PrepareSwapRequest
+	(*PrepareSwapResponse)(nil),        // 6: dankfolio.v1.PrepareSwapResponse
+	(*RiskWarning)(nil),                // 7: dankfolio.v1.RiskWarning
+	(*RefreshQuoteRequest)(nil),        // 8: dankfolio.v1.RefreshQuoteRequest
+	(*SubmitSwapRequest)(nil),          // 9: dankfolio.v1.SubmitSwapRequest
+	(*SubmitSwapResponse)(nil),         // 10: dankfolio.v1.SubmitSwapResponse
+	(*GetTradeRequest)(nil),            // 11: dankfolio.v1.GetTradeRequest
+	(*ListTradesRequest)(nil),          // 12: dankfolio.v1.ListTradesRequest
+	(*ListTradesResponse)(nil),         // 13: dankfolio.v1.ListTradesResponse
+	(*GetTradeReceiptRequest)(nil),     // 14: dankfolio.v1.GetTradeReceiptRequest
+	(*GetTradeReceiptResponse)(nil),    // 15: dankfolio.v1.GetTradeReceiptResponse
+	(*TradeReceipt)(nil),               // 16: dankfolio.v1.TradeReceipt
+	(*MevIncident)(nil),                // 17: dankfolio.v1.MevIncident
+	(*GetPaperPortfolioRequest)(nil),   // 18: dankfolio.v1.GetPaperPortfolioRequest
+	(*ResetPaperPortfolioRequest)(nil), // 19: dankfolio.v1.ResetPaperPortfolioRequest
+	(*PaperHolding)(nil),               // 20: dankfolio.v1.PaperHolding
+	(*GetPaperPortfolioResponse)(nil),  // 21: dankfolio.v1.GetPaperPortfolioResponse
+	(*GetMaxSpendableRequest)(nil),     // 22: dankfolio.v1.GetMaxSpendableRequest
+	(*GetMaxSpendableResponse)(nil),    // 23: dankfolio.v1.GetMaxSpendableResponse
+	(*ValidateTradeRequest)(nil),       // 24: dankfolio.v1.ValidateTradeRequest
+	(*TradeProblem)(nil),               // 25: dankfolio.v1.TradeProblem
+	(*ValidateTradeResponse)(nil),      // 26: dankfolio.v1.ValidateTradeResponse
+	(*CreateTwapOrderRequest)(nil),     // 27: dankfolio.v1.CreateTwapOrderRequest
+	(*TwapSlice)(nil),                  // 28: dankfolio.v1.TwapSlice
+	(*TwapOrder)(nil),                  // 29: dankfolio.v1.TwapOrder
+	(*ListTwapOrdersRequest)(nil),      // 30: dankfolio.v1.ListTwapOrdersRequest
+	(*ListTwapOrdersResponse)(nil),     // 31: dankfolio.v1.ListTwapOrdersResponse
+	(*CancelTwapOrderRequest)(nil),     // 32: dankfolio.v1.CancelTwapOrderRequest
+	(*timestamppb.Timestamp)(nil),      // 33: google.protobuf.Timestamp
 }
 var file_dankfolio_v1_trade_proto_depIdxs = []int32{
-	11, // 0: dankfolio.v1.Trade.created_at:type_name -> google.protobuf.Timestamp
-	11, // 1: dankfolio.v1.Trade.completed_at:type_name -> google.protobuf.Timestamp
+	33, // 0: dankfolio.v1.Trade.created_at:type_name -> google.protobuf.Timestamp
+	33, // 1: dankfolio.v1.Trade.completed_at:type_name -> google.protobuf.Timestamp
 	2,  // 2: dankfolio.v1.GetSwapQuoteResponse.sol_fee_breakdown:type_name -> dankfolio.v1.SolFeeBreakdown
-	2,  // 3: dankfolio.v1.PrepareSwapResponse.sol_fee_breakdown:type_name -> dankfolio.v1.SolFeeBreakdown
-	0,  // 4: dankfolio.v1.ListTradesResponse.trades:type_name -> dankfolio.v1.Trade
-	1,  // 5: dankfolio.v1.TradeService.GetSwapQuote:input_type -> dankfolio.v1.GetSwapQuoteRequest
-	4,  // 6: dankfolio.v1.TradeService.PrepareSwap:input_type -> dankfolio.v1.PrepareSwapRequest
-	6,  // 7: dankfolio.v1.TradeService.SubmitSwap:input_type -> dankfolio.v1.SubmitSwapRequest
-	8,  // 8: dankfolio.v1.TradeService.GetTrade:input_type -> dankfolio.v1.GetTradeRequest
-	9,  // 9: dankfolio.v1.TradeService.ListTrades:input_type -> dankfolio.v1.ListTradesRequest
-	3,  // 10: dankfolio.v1.TradeService.GetSwapQuote:output_type -> dankfolio.v1.GetSwapQuoteResponse
-	5,  // 11: dankfolio.v1.TradeService.PrepareSwap:output_type -> dankfolio.v1.PrepareSwapResponse
-	7,  // 12: dankfolio.v1.TradeService.SubmitSwap:output_type -> dankfolio.v1.SubmitSwapResponse
-	0,  // 13: dankfolio.v1.TradeService.GetTrade:output_type -> dankfolio.v1.Trade
-	10, // 14: dankfolio.v1.TradeService.ListTrades:output_type -> dankfolio.v1.ListTradesResponse
-	10, // [10:15] is the sub-list for method output_type
-	5,  // [5:10] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	4,  // 3: dankfolio.v1.GetSwapQuoteResponse.price_divergences:type_name -> dankfolio.v1.PriceDivergence
+	2,  // 4: dankfolio.v1.PrepareSwapResponse.sol_fee_breakdown:type_name -> dankfolio.v1.SolFeeBreakdown
+	33, // 5: dankfolio.v1.PrepareSwapResponse.expires_at:type_name -> google.protobuf.Timestamp
+	7,  // 6: dankfolio.v1.PrepareSwapResponse.risk_warnings:type_name -> dankfolio.v1.RiskWarning
+	0,  // 7: dankfolio.v1.ListTradesResponse.trades:type_name -> dankfolio.v1.Trade
+	16, // 8: dankfolio.v1.GetTradeReceiptResponse.receipt:type_name -> dankfolio.v1.TradeReceipt
+	33, // 9: dankfolio.v1.TradeReceipt.analyzed_at:type_name -> google.protobuf.Timestamp
+	17, // 10: dankfolio.v1.TradeReceipt.mev_incident:type_name -> dankfolio.v1.MevIncident
+	20, // 11: dankfolio.v1.GetPaperPortfolioResponse.holdings:type_name -> dankfolio.v1.PaperHolding
+	25, // 12: dankfolio.v1.ValidateTradeResponse.problems:type_name -> dankfolio.v1.TradeProblem
+	33, // 13: dankfolio.v1.TwapSlice.due_at:type_name -> google.protobuf.Timestamp
+	33, // 14: dankfolio.v1.TwapSlice.quote_expires_at:type_name -> google.protobuf.Timestamp
+	33, // 15: dankfolio.v1.TwapOrder.next_slice_at:type_name -> google.protobuf.Timestamp
+	33, // 16: dankfolio.v1.TwapOrder.created_at:type_name -> google.protobuf.Timestamp
+	28, // 17: dankfolio.v1.TwapOrder.slices:type_name -> dankfolio.v1.TwapSlice
+	29, // 18: dankfolio.v1.ListTwapOrdersResponse.orders:type_name -> dankfolio.v1.TwapOrder
+	1,  // 19: dankfolio.v1.TradeService.GetSwapQuote:input_type -> dankfolio.v1.GetSwapQuoteRequest
+	5,  // 20: dankfolio.v1.TradeService.PrepareSwap:input_type -> dankfolio.v1.PrepareSwapRequest
+	8,  // 21: dankfolio.v1.TradeService.RefreshQuote:input_type -> dankfolio.v1.RefreshQuoteRequest
+	9,  // 22: dankfolio.v1.TradeService.SubmitSwap:input_type -> dankfolio.v1.SubmitSwapRequest
+	11, // 23: dankfolio.v1.TradeService.GetTrade:input_type -> dankfolio.v1.GetTradeRequest
+	12, // 24: dankfolio.v1.TradeService.ListTrades:input_type -> dankfolio.v1.ListTradesRequest
+	14, // 25: dankfolio.v1.TradeService.GetTradeReceipt:input_type -> dankfolio.v1.GetTradeReceiptRequest
+	18, // 26: dankfolio.v1.TradeService.GetPaperPortfolio:input_type -> dankfolio.v1.GetPaperPortfolioRequest
+	19, // 27: dankfolio.v1.TradeService.ResetPaperPortfolio:input_type -> dankfolio.v1.ResetPaperPortfolioRequest
+	22, // 28: dankfolio.v1.TradeService.GetMaxSpendable:input_type -> dankfolio.v1.GetMaxSpendableRequest
+	24, // 29: dankfolio.v1.TradeService.ValidateTrade:input_type -> dankfolio.v1.ValidateTradeRequest
+	27, // 30: dankfolio.v1.TradeService.CreateTwapOrder:input_type -> dankfolio.v1.CreateTwapOrderRequest
+	30, // 31: dankfolio.v1.TradeService.ListTwapOrders:input_type -> dankfolio.v1.ListTwapOrdersRequest
+	32, // 32: dankfolio.v1.TradeService.CancelTwapOrder:input_type -> dankfolio.v1.CancelTwapOrderRequest
+	3,  // 33: dankfolio.v1.TradeService.GetSwapQuote:output_type -> dankfolio.v1.GetSwapQuoteResponse
+	6,  // 34: dankfolio.v1.TradeService.PrepareSwap:output_type -> dankfolio.v1.PrepareSwapResponse
+	6,  // 35: dankfolio.v1.TradeService.RefreshQuote:output_type -> dankfolio.v1.PrepareSwapResponse
+	10, // 36: dankfolio.v1.TradeService.SubmitSwap:output_type -> dankfolio.v1.SubmitSwapResponse
+	0,  // 37: dankfolio.v1.TradeService.GetTrade:output_type -> dankfolio.v1.Trade
+	13, // 38: dankfolio.v1.TradeService.ListTrades:output_type -> dankfolio.v1.ListTradesResponse
+	15, // 39: dankfolio.v1.TradeService.GetTradeReceipt:output_type -> dankfolio.v1.GetTradeReceiptResponse
+	21, // 40: dankfolio.v1.TradeService.GetPaperPortfolio:output_type -> dankfolio.v1.GetPaperPortfolioResponse
+	21, // 41: dankfolio.v1.TradeService.ResetPaperPortfolio:output_type -> dankfolio.v1.GetPaperPortfolioResponse
+	23, // 42: dankfolio.v1.TradeService.GetMaxSpendable:output_type -> dankfolio.v1.GetMaxSpendableResponse
+	26, // 43: dankfolio.v1.TradeService.ValidateTrade:output_type -> dankfolio.v1.ValidateTradeResponse
+	29, // 44: dankfolio.v1.TradeService.CreateTwapOrder:output_type -> dankfolio.v1.TwapOrder
+	31, // 45: dankfolio.v1.TradeService.ListTwapOrders:output_type -> dankfolio.v1.ListTwapOrdersResponse
+	29, // 46: dankfolio.v1.TradeService.CancelTwapOrder:output_type -> dankfolio.v1.TwapOrder
+	33, // [33:47] is the sub-list for method output_type
+	19, // [19:33] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_dankfolio_v1_trade_proto_init() }
@@ -1205,19 +3265,21 @@ func file_dankfolio_v1_trade_proto_init() {
 	file_dankfolio_v1_trade_proto_msgTypes[0].OneofWrappers = []any{}
 	file_dankfolio_v1_trade_proto_msgTypes[1].OneofWrappers = []any{}
 	file_dankfolio_v1_trade_proto_msgTypes[3].OneofWrappers = []any{}
-	file_dankfolio_v1_trade_proto_msgTypes[5].OneofWrappers = []any{}
-	file_dankfolio_v1_trade_proto_msgTypes[8].OneofWrappers = []any{
+	file_dankfolio_v1_trade_proto_msgTypes[6].OneofWrappers = []any{}
+	file_dankfolio_v1_trade_proto_msgTypes[11].OneofWrappers = []any{
 		(*GetTradeRequest_Id)(nil),
 		(*GetTradeRequest_TransactionHash)(nil),
 	}
-	file_dankfolio_v1_trade_proto_msgTypes[9].OneofWrappers = []any{}
+	file_dankfolio_v1_trade_proto_msgTypes[12].OneofWrappers = []any{}
+	file_dankfolio_v1_trade_proto_msgTypes[16].OneofWrappers = []any{}
+	file_dankfolio_v1_trade_proto_msgTypes[29].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_dankfolio_v1_trade_proto_rawDesc), len(file_dankfolio_v1_trade_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   33,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -9,6 +9,7 @@ package v1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -230,11 +231,323 @@ func (x *DeleteAccountResponse) GetMessage() string {
 	return ""
 }
 
+type DeleteMyDataRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The wallet public key whose data is erased.
+	// This should match the authenticated user's wallet.
+	WalletPublicKey string `protobuf:"bytes,1,opt,name=wallet_public_key,json=walletPublicKey,proto3" json:"wallet_public_key,omitempty"`
+	// Confirmation string that must be "DELETE" to proceed.
+	Confirmation  string `protobuf:"bytes,2,opt,name=confirmation,proto3" json:"confirmation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMyDataRequest) Reset() {
+	*x = DeleteMyDataRequest{}
+	mi := &file_dankfolio_v1_utility_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMyDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMyDataRequest) ProtoMessage() {}
+
+func (x *DeleteMyDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_utility_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMyDataRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMyDataRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_utility_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteMyDataRequest) GetWalletPublicKey() string {
+	if x != nil {
+		return x.WalletPublicKey
+	}
+	return ""
+}
+
+func (x *DeleteMyDataRequest) GetConfirmation() string {
+	if x != nil {
+		return x.Confirmation
+	}
+	return ""
+}
+
+type DeleteMyDataResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Identifies the request for GetDataDeletion.
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// "queued", "completed" or "failed".
+	Status        string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMyDataResponse) Reset() {
+	*x = DeleteMyDataResponse{}
+	mi := &file_dankfolio_v1_utility_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMyDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMyDataResponse) ProtoMessage() {}
+
+func (x *DeleteMyDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_utility_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMyDataResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMyDataResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_utility_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteMyDataResponse) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *DeleteMyDataResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type GetDataDeletionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDataDeletionRequest) Reset() {
+	*x = GetDataDeletionRequest{}
+	mi := &file_dankfolio_v1_utility_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDataDeletionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataDeletionRequest) ProtoMessage() {}
+
+func (x *GetDataDeletionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_utility_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataDeletionRequest.ProtoReflect.Descriptor instead.
+func (*GetDataDeletionRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_utility_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetDataDeletionRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+// DeletionCertificate attests that a user's personal data was erased and
+// verified gone. It names the user only by a hash of their wallet.
+type DeletionCertificate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Hex SHA-256 of the wallet public key.
+	SubjectHash string `protobuf:"bytes,2,opt,name=subject_hash,json=subjectHash,proto3" json:"subject_hash,omitempty"`
+	// Rows erased per table.
+	Erased        map[string]int64       `protobuf:"bytes,3,rep,name=erased,proto3" json:"erased,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	RequestedAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletionCertificate) Reset() {
+	*x = DeletionCertificate{}
+	mi := &file_dankfolio_v1_utility_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletionCertificate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletionCertificate) ProtoMessage() {}
+
+func (x *DeletionCertificate) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_utility_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletionCertificate.ProtoReflect.Descriptor instead.
+func (*DeletionCertificate) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_utility_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeletionCertificate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeletionCertificate) GetSubjectHash() string {
+	if x != nil {
+		return x.SubjectHash
+	}
+	return ""
+}
+
+func (x *DeletionCertificate) GetErased() map[string]int64 {
+	if x != nil {
+		return x.Erased
+	}
+	return nil
+}
+
+func (x *DeletionCertificate) GetRequestedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return nil
+}
+
+func (x *DeletionCertificate) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+type GetDataDeletionResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	RequestId string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// "queued", "completed" or "failed".
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// Why the last attempt failed, while the request is retried or failed.
+	Error       string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	RequestedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	// Set once the request is completed.
+	Certificate   *DeletionCertificate `protobuf:"bytes,5,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDataDeletionResponse) Reset() {
+	*x = GetDataDeletionResponse{}
+	mi := &file_dankfolio_v1_utility_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDataDeletionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataDeletionResponse) ProtoMessage() {}
+
+func (x *GetDataDeletionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_utility_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataDeletionResponse.ProtoReflect.Descriptor instead.
+func (*GetDataDeletionResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_utility_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetDataDeletionResponse) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *GetDataDeletionResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetDataDeletionResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *GetDataDeletionResponse) GetRequestedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return nil
+}
+
+func (x *GetDataDeletionResponse) GetCertificate() *DeletionCertificate {
+	if x != nil {
+		return x.Certificate
+	}
+	return nil
+}
+
 var File_dankfolio_v1_utility_proto protoreflect.FileDescriptor
 
 const file_dankfolio_v1_utility_proto_rawDesc = "" +
 	"\n" +
-	"\x1adankfolio/v1/utility.proto\x12\fdankfolio.v1\"5\n" +
+	"\x1adankfolio/v1/utility.proto\x12\fdankfolio.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"5\n" +
 	"\x16GetProxiedImageRequest\x12\x1b\n" +
 	"\timage_url\x18\x01 \x01(\tR\bimageUrl\"[\n" +
 	"\x17GetProxiedImageResponse\x12\x1d\n" +
@@ -246,10 +559,38 @@ const file_dankfolio_v1_utility_proto_rawDesc = "" +
 	"\fconfirmation\x18\x02 \x01(\tR\fconfirmation\"K\n" +
 	"\x15DeleteAccountResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage2\xca\x01\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"e\n" +
+	"\x13DeleteMyDataRequest\x12*\n" +
+	"\x11wallet_public_key\x18\x01 \x01(\tR\x0fwalletPublicKey\x12\"\n" +
+	"\fconfirmation\x18\x02 \x01(\tR\fconfirmation\"M\n" +
+	"\x14DeleteMyDataResponse\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"7\n" +
+	"\x16GetDataDeletionRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\"\xc8\x02\n" +
+	"\x13DeletionCertificate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fsubject_hash\x18\x02 \x01(\tR\vsubjectHash\x12E\n" +
+	"\x06erased\x18\x03 \x03(\v2-.dankfolio.v1.DeletionCertificate.ErasedEntryR\x06erased\x12=\n" +
+	"\frequested_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vrequestedAt\x12=\n" +
+	"\fcompleted_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x1a9\n" +
+	"\vErasedEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"\xea\x01\n" +
+	"\x17GetDataDeletionResponse\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12=\n" +
+	"\frequested_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vrequestedAt\x12C\n" +
+	"\vcertificate\x18\x05 \x01(\v2!.dankfolio.v1.DeletionCertificateR\vcertificate2\x81\x03\n" +
 	"\x0eUtilityService\x12^\n" +
 	"\x0fGetProxiedImage\x12$.dankfolio.v1.GetProxiedImageRequest\x1a%.dankfolio.v1.GetProxiedImageResponse\x12X\n" +
-	"\rDeleteAccount\x12\".dankfolio.v1.DeleteAccountRequest\x1a#.dankfolio.v1.DeleteAccountResponseB\xb8\x01\n" +
+	"\rDeleteAccount\x12\".dankfolio.v1.DeleteAccountRequest\x1a#.dankfolio.v1.DeleteAccountResponse\x12U\n" +
+	"\fDeleteMyData\x12!.dankfolio.v1.DeleteMyDataRequest\x1a\".dankfolio.v1.DeleteMyDataResponse\x12^\n" +
+	"\x0fGetDataDeletion\x12$.dankfolio.v1.GetDataDeletionRequest\x1a%.dankfolio.v1.GetDataDeletionResponseB\xb8\x01\n" +
 	"\x10com.dankfolio.v1B\fUtilityProtoP\x01ZEgithub.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1\xa2\x02\x03DXX\xaa\x02\fDankfolio.V1\xca\x02\fDankfolio\\V1\xe2\x02\x18Dankfolio\\V1\\GPBMetadata\xea\x02\rDankfolio::V1b\x06proto3"
 
 var (
@@ -264,23 +605,39 @@ func file_dankfolio_v1_utility_proto_rawDescGZIP() []byte {
 	return file_dankfolio_v1_utility_proto_rawDescData
 }
 
-var file_dankfolio_v1_utility_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_dankfolio_v1_utility_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_dankfolio_v1_utility_proto_goTypes = []any{
 	(*GetProxiedImageRequest)(nil),  // 0: dankfolio.v1.GetProxiedImageRequest
 	(*GetProxiedImageResponse)(nil), // 1: dankfolio.v1.GetProxiedImageResponse
 	(*DeleteAccountRequest)(nil),    // 2: dankfolio.v1.DeleteAccountRequest
 	(*DeleteAccountResponse)(nil),   // 3: dankfolio.v1.DeleteAccountResponse
+	(*DeleteMyDataRequest)(nil),     // 4: dankfolio.v1.DeleteMyDataRequest
+	(*DeleteMyDataResponse)(nil),    // 5: dankfolio.v1.DeleteMyDataResponse
+	(*GetDataDeletionRequest)(nil),  // 6: dankfolio.v1.GetDataDeletionRequest
+	(*DeletionCertificate)(nil),     // 7: dankfolio.v1.DeletionCertificate
+	(*GetDataDeletionResponse)(nil), // 8: dankfolio.v1.GetDataDeletionResponse
+	nil,                             // 9: dankfolio.v1.DeletionCertificate.ErasedEntry
+	(*timestamppb.Timestamp)(nil),   // 10: google.protobuf.Timestamp
 }
 var file_dankfolio_v1_utility_proto_depIdxs = []int32{
-	0, // 0: dankfolio.v1.UtilityService.GetProxiedImage:input_type -> dankfolio.v1.GetProxiedImageRequest
-	2, // 1: dankfolio.v1.UtilityService.DeleteAccount:input_type -> dankfolio.v1.DeleteAccountRequest
-	1, // 2: dankfolio.v1.UtilityService.GetProxiedImage:output_type -> dankfolio.v1.GetProxiedImageResponse
-	3, // 3: dankfolio.v1.UtilityService.DeleteAccount:output_type -> dankfolio.v1.DeleteAccountResponse
-	2, // [2:4] is the sub-list for method output_type
-	0, // [0:2] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	9,  // 0: dankfolio.v1.DeletionCertificate.erased:type_name -> dankfolio.v1.DeletionCertificate.ErasedEntry
+	10, // 1: dankfolio.v1.DeletionCertificate.requested_at:type_name -> google.protobuf.Timestamp
+	10, // 2: dankfolio.v1.DeletionCertificate.completed_at:type_name -> google.protobuf.Timestamp
+	10, // 3: dankfolio.v1.GetDataDeletionResponse.requested_at:type_name -> google.protobuf.Timestamp
+	7,  // 4: dankfolio.v1.GetDataDeletionResponse.certificate:type_name -> dankfolio.v1.DeletionCertificate
+	0,  // 5: dankfolio.v1.UtilityService.GetProxiedImage:input_type -> dankfolio.v1.GetProxiedImageRequest
+	2,  // 6: dankfolio.v1.UtilityService.DeleteAccount:input_type -> dankfolio.v1.DeleteAccountRequest
+	4,  // 7: dankfolio.v1.UtilityService.DeleteMyData:input_type -> dankfolio.v1.DeleteMyDataRequest
+	6,  // 8: dankfolio.v1.UtilityService.GetDataDeletion:input_type -> dankfolio.v1.GetDataDeletionRequest
+	1,  // 9: dankfolio.v1.UtilityService.GetProxiedImage:output_type -> dankfolio.v1.GetProxiedImageResponse
+	3,  // 10: dankfolio.v1.UtilityService.DeleteAccount:output_type -> dankfolio.v1.DeleteAccountResponse
+	5,  // 11: dankfolio.v1.UtilityService.DeleteMyData:output_type -> dankfolio.v1.DeleteMyDataResponse
+	8,  // 12: dankfolio.v1.UtilityService.GetDataDeletion:output_type -> dankfolio.v1.GetDataDeletionResponse
+	9,  // [9:13] is the sub-list for method output_type
+	5,  // [5:9] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_dankfolio_v1_utility_proto_init() }
@@ -294,7 +651,7 @@ func file_dankfolio_v1_utility_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_dankfolio_v1_utility_proto_rawDesc), len(file_dankfolio_v1_utility_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,1516 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: dankfolio/v1/admin.proto
+
+package v1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// AdminServiceName is the fully-qualified name of the AdminService service.
+	AdminServiceName = "dankfolio.v1.AdminService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AdminServiceListSettingsProcedure is the fully-qualified name of the AdminService's ListSettings
+	// RPC.
+	AdminServiceListSettingsProcedure = "/dankfolio.v1.AdminService/ListSettings"
+	// AdminServiceUpdateSettingProcedure is the fully-qualified name of the AdminService's
+	// UpdateSetting RPC.
+	AdminServiceUpdateSettingProcedure = "/dankfolio.v1.AdminService/UpdateSetting"
+	// AdminServiceResetSettingProcedure is the fully-qualified name of the AdminService's ResetSetting
+	// RPC.
+	AdminServiceResetSettingProcedure = "/dankfolio.v1.AdminService/ResetSetting"
+	// AdminServiceListFeatureFlagsProcedure is the fully-qualified name of the AdminService's
+	// ListFeatureFlags RPC.
+	AdminServiceListFeatureFlagsProcedure = "/dankfolio.v1.AdminService/ListFeatureFlags"
+	// AdminServiceSetFeatureFlagProcedure is the fully-qualified name of the AdminService's
+	// SetFeatureFlag RPC.
+	AdminServiceSetFeatureFlagProcedure = "/dankfolio.v1.AdminService/SetFeatureFlag"
+	// AdminServiceDeleteFeatureFlagProcedure is the fully-qualified name of the AdminService's
+	// DeleteFeatureFlag RPC.
+	AdminServiceDeleteFeatureFlagProcedure = "/dankfolio.v1.AdminService/DeleteFeatureFlag"
+	// AdminServiceListSpamTokensProcedure is the fully-qualified name of the AdminService's
+	// ListSpamTokens RPC.
+	AdminServiceListSpamTokensProcedure = "/dankfolio.v1.AdminService/ListSpamTokens"
+	// AdminServiceSetSpamTokenProcedure is the fully-qualified name of the AdminService's SetSpamToken
+	// RPC.
+	AdminServiceSetSpamTokenProcedure = "/dankfolio.v1.AdminService/SetSpamToken"
+	// AdminServiceDeleteSpamTokenProcedure is the fully-qualified name of the AdminService's
+	// DeleteSpamToken RPC.
+	AdminServiceDeleteSpamTokenProcedure = "/dankfolio.v1.AdminService/DeleteSpamToken"
+	// AdminServiceListBlockedMintsProcedure is the fully-qualified name of the AdminService's
+	// ListBlockedMints RPC.
+	AdminServiceListBlockedMintsProcedure = "/dankfolio.v1.AdminService/ListBlockedMints"
+	// AdminServiceSetBlocklistOverrideProcedure is the fully-qualified name of the AdminService's
+	// SetBlocklistOverride RPC.
+	AdminServiceSetBlocklistOverrideProcedure = "/dankfolio.v1.AdminService/SetBlocklistOverride"
+	// AdminServiceDeleteBlocklistOverrideProcedure is the fully-qualified name of the AdminService's
+	// DeleteBlocklistOverride RPC.
+	AdminServiceDeleteBlocklistOverrideProcedure = "/dankfolio.v1.AdminService/DeleteBlocklistOverride"
+	// AdminServiceSyncBlocklistProcedure is the fully-qualified name of the AdminService's
+	// SyncBlocklist RPC.
+	AdminServiceSyncBlocklistProcedure = "/dankfolio.v1.AdminService/SyncBlocklist"
+	// AdminServiceListCoinDescriptionsProcedure is the fully-qualified name of the AdminService's
+	// ListCoinDescriptions RPC.
+	AdminServiceListCoinDescriptionsProcedure = "/dankfolio.v1.AdminService/ListCoinDescriptions"
+	// AdminServiceSetCoinDescriptionProcedure is the fully-qualified name of the AdminService's
+	// SetCoinDescription RPC.
+	AdminServiceSetCoinDescriptionProcedure = "/dankfolio.v1.AdminService/SetCoinDescription"
+	// AdminServiceDeleteCoinDescriptionProcedure is the fully-qualified name of the AdminService's
+	// DeleteCoinDescription RPC.
+	AdminServiceDeleteCoinDescriptionProcedure = "/dankfolio.v1.AdminService/DeleteCoinDescription"
+	// AdminServiceListSlowQueriesProcedure is the fully-qualified name of the AdminService's
+	// ListSlowQueries RPC.
+	AdminServiceListSlowQueriesProcedure = "/dankfolio.v1.AdminService/ListSlowQueries"
+	// AdminServiceBroadcastNotificationProcedure is the fully-qualified name of the AdminService's
+	// BroadcastNotification RPC.
+	AdminServiceBroadcastNotificationProcedure = "/dankfolio.v1.AdminService/BroadcastNotification"
+	// AdminServiceListNotificationDeliveriesProcedure is the fully-qualified name of the AdminService's
+	// ListNotificationDeliveries RPC.
+	AdminServiceListNotificationDeliveriesProcedure = "/dankfolio.v1.AdminService/ListNotificationDeliveries"
+	// AdminServiceListAllAnnouncementsProcedure is the fully-qualified name of the AdminService's
+	// ListAllAnnouncements RPC.
+	AdminServiceListAllAnnouncementsProcedure = "/dankfolio.v1.AdminService/ListAllAnnouncements"
+	// AdminServiceCreateAnnouncementProcedure is the fully-qualified name of the AdminService's
+	// CreateAnnouncement RPC.
+	AdminServiceCreateAnnouncementProcedure = "/dankfolio.v1.AdminService/CreateAnnouncement"
+	// AdminServiceUpdateAnnouncementProcedure is the fully-qualified name of the AdminService's
+	// UpdateAnnouncement RPC.
+	AdminServiceUpdateAnnouncementProcedure = "/dankfolio.v1.AdminService/UpdateAnnouncement"
+	// AdminServiceDeleteAnnouncementProcedure is the fully-qualified name of the AdminService's
+	// DeleteAnnouncement RPC.
+	AdminServiceDeleteAnnouncementProcedure = "/dankfolio.v1.AdminService/DeleteAnnouncement"
+	// AdminServiceListJobRunsProcedure is the fully-qualified name of the AdminService's ListJobRuns
+	// RPC.
+	AdminServiceListJobRunsProcedure = "/dankfolio.v1.AdminService/ListJobRuns"
+	// AdminServiceGetOperationsOverviewProcedure is the fully-qualified name of the AdminService's
+	// GetOperationsOverview RPC.
+	AdminServiceGetOperationsOverviewProcedure = "/dankfolio.v1.AdminService/GetOperationsOverview"
+	// AdminServiceGetCoinHistoryProcedure is the fully-qualified name of the AdminService's
+	// GetCoinHistory RPC.
+	AdminServiceGetCoinHistoryProcedure = "/dankfolio.v1.AdminService/GetCoinHistory"
+	// AdminServiceListCoinOverridesProcedure is the fully-qualified name of the AdminService's
+	// ListCoinOverrides RPC.
+	AdminServiceListCoinOverridesProcedure = "/dankfolio.v1.AdminService/ListCoinOverrides"
+	// AdminServiceSetCoinOverrideProcedure is the fully-qualified name of the AdminService's
+	// SetCoinOverride RPC.
+	AdminServiceSetCoinOverrideProcedure = "/dankfolio.v1.AdminService/SetCoinOverride"
+	// AdminServiceDeleteCoinOverrideProcedure is the fully-qualified name of the AdminService's
+	// DeleteCoinOverride RPC.
+	AdminServiceDeleteCoinOverrideProcedure = "/dankfolio.v1.AdminService/DeleteCoinOverride"
+	// AdminServiceListSearchSynonymsProcedure is the fully-qualified name of the AdminService's
+	// ListSearchSynonyms RPC.
+	AdminServiceListSearchSynonymsProcedure = "/dankfolio.v1.AdminService/ListSearchSynonyms"
+	// AdminServiceSetSearchSynonymProcedure is the fully-qualified name of the AdminService's
+	// SetSearchSynonym RPC.
+	AdminServiceSetSearchSynonymProcedure = "/dankfolio.v1.AdminService/SetSearchSynonym"
+	// AdminServiceDeleteSearchSynonymProcedure is the fully-qualified name of the AdminService's
+	// DeleteSearchSynonym RPC.
+	AdminServiceDeleteSearchSynonymProcedure = "/dankfolio.v1.AdminService/DeleteSearchSynonym"
+	// AdminServiceListNaughtyWordsProcedure is the fully-qualified name of the AdminService's
+	// ListNaughtyWords RPC.
+	AdminServiceListNaughtyWordsProcedure = "/dankfolio.v1.AdminService/ListNaughtyWords"
+	// AdminServiceAddNaughtyWordsProcedure is the fully-qualified name of the AdminService's
+	// AddNaughtyWords RPC.
+	AdminServiceAddNaughtyWordsProcedure = "/dankfolio.v1.AdminService/AddNaughtyWords"
+	// AdminServiceRemoveNaughtyWordsProcedure is the fully-qualified name of the AdminService's
+	// RemoveNaughtyWords RPC.
+	AdminServiceRemoveNaughtyWordsProcedure = "/dankfolio.v1.AdminService/RemoveNaughtyWords"
+	// AdminServiceImportNaughtyWordsProcedure is the fully-qualified name of the AdminService's
+	// ImportNaughtyWords RPC.
+	AdminServiceImportNaughtyWordsProcedure = "/dankfolio.v1.AdminService/ImportNaughtyWords"
+	// AdminServiceExportNaughtyWordsProcedure is the fully-qualified name of the AdminService's
+	// ExportNaughtyWords RPC.
+	AdminServiceExportNaughtyWordsProcedure = "/dankfolio.v1.AdminService/ExportNaughtyWords"
+	// AdminServiceReloadNaughtyWordsProcedure is the fully-qualified name of the AdminService's
+	// ReloadNaughtyWords RPC.
+	AdminServiceReloadNaughtyWordsProcedure = "/dankfolio.v1.AdminService/ReloadNaughtyWords"
+	// AdminServiceGetTradeLimitProcedure is the fully-qualified name of the AdminService's
+	// GetTradeLimit RPC.
+	AdminServiceGetTradeLimitProcedure = "/dankfolio.v1.AdminService/GetTradeLimit"
+	// AdminServiceSetTradeLimitProcedure is the fully-qualified name of the AdminService's
+	// SetTradeLimit RPC.
+	AdminServiceSetTradeLimitProcedure = "/dankfolio.v1.AdminService/SetTradeLimit"
+	// AdminServiceDeleteTradeLimitProcedure is the fully-qualified name of the AdminService's
+	// DeleteTradeLimit RPC.
+	AdminServiceDeleteTradeLimitProcedure = "/dankfolio.v1.AdminService/DeleteTradeLimit"
+	// AdminServiceRevalidateIconsProcedure is the fully-qualified name of the AdminService's
+	// RevalidateIcons RPC.
+	AdminServiceRevalidateIconsProcedure = "/dankfolio.v1.AdminService/RevalidateIcons"
+	// AdminServiceGetIconRevalidationProcedure is the fully-qualified name of the AdminService's
+	// GetIconRevalidation RPC.
+	AdminServiceGetIconRevalidationProcedure = "/dankfolio.v1.AdminService/GetIconRevalidation"
+	// AdminServiceDeleteRecordProcedure is the fully-qualified name of the AdminService's DeleteRecord
+	// RPC.
+	AdminServiceDeleteRecordProcedure = "/dankfolio.v1.AdminService/DeleteRecord"
+	// AdminServiceRestoreRecordProcedure is the fully-qualified name of the AdminService's
+	// RestoreRecord RPC.
+	AdminServiceRestoreRecordProcedure = "/dankfolio.v1.AdminService/RestoreRecord"
+	// AdminServiceListDeletedRecordsProcedure is the fully-qualified name of the AdminService's
+	// ListDeletedRecords RPC.
+	AdminServiceListDeletedRecordsProcedure = "/dankfolio.v1.AdminService/ListDeletedRecords"
+)
+
+// AdminServiceClient is a client for the dankfolio.v1.AdminService service.
+type AdminServiceClient interface {
+	// ListSettings returns every runtime setting with its effective and default value.
+	ListSettings(context.Context, *connect.Request[v1.ListSettingsRequest]) (*connect.Response[v1.ListSettingsResponse], error)
+	// UpdateSetting overrides a setting. The change is applied without a restart.
+	UpdateSetting(context.Context, *connect.Request[v1.UpdateSettingRequest]) (*connect.Response[v1.UpdateSettingResponse], error)
+	// ResetSetting removes an override so the setting falls back to its env/default value.
+	ResetSetting(context.Context, *connect.Request[v1.ResetSettingRequest]) (*connect.Response[v1.ResetSettingResponse], error)
+	// ListFeatureFlags returns every feature flag definition.
+	ListFeatureFlags(context.Context, *connect.Request[v1.ListFeatureFlagsRequest]) (*connect.Response[v1.ListFeatureFlagsResponse], error)
+	// SetFeatureFlag creates or replaces a feature flag.
+	SetFeatureFlag(context.Context, *connect.Request[v1.SetFeatureFlagRequest]) (*connect.Response[v1.SetFeatureFlagResponse], error)
+	// DeleteFeatureFlag removes a feature flag; it evaluates as off afterwards.
+	DeleteFeatureFlag(context.Context, *connect.Request[v1.DeleteFeatureFlagRequest]) (*connect.Response[v1.DeleteFeatureFlagResponse], error)
+	// ListSpamTokens returns the wallet spam deny/allow list.
+	ListSpamTokens(context.Context, *connect.Request[v1.ListSpamTokensRequest]) (*connect.Response[v1.ListSpamTokensResponse], error)
+	// SetSpamToken adds or replaces a deny/allow entry for a mint.
+	SetSpamToken(context.Context, *connect.Request[v1.SetSpamTokenRequest]) (*connect.Response[v1.SetSpamTokenResponse], error)
+	// DeleteSpamToken removes a mint from the deny/allow list.
+	DeleteSpamToken(context.Context, *connect.Request[v1.DeleteSpamTokenRequest]) (*connect.Response[v1.DeleteSpamTokenResponse], error)
+	// ListBlockedMints returns every blocklist entry for a mint, or all admin overrides when no mint is given.
+	ListBlockedMints(context.Context, *connect.Request[v1.ListBlockedMintsRequest]) (*connect.Response[v1.ListBlockedMintsResponse], error)
+	// SetBlocklistOverride blocks a mint, or allows it despite feed entries.
+	SetBlocklistOverride(context.Context, *connect.Request[v1.SetBlocklistOverrideRequest]) (*connect.Response[v1.SetBlocklistOverrideResponse], error)
+	// DeleteBlocklistOverride removes an admin block or allow, leaving only feed entries.
+	DeleteBlocklistOverride(context.Context, *connect.Request[v1.DeleteBlocklistOverrideRequest]) (*connect.Response[v1.DeleteBlocklistOverrideResponse], error)
+	// SyncBlocklist downloads every blocklist feed now instead of waiting for the next scheduled sync.
+	SyncBlocklist(context.Context, *connect.Request[v1.SyncBlocklistRequest]) (*connect.Response[v1.SyncBlocklistResponse], error)
+	// ListCoinDescriptions returns every localized description stored for a coin.
+	ListCoinDescriptions(context.Context, *connect.Request[v1.ListCoinDescriptionsRequest]) (*connect.Response[v1.ListCoinDescriptionsResponse], error)
+	// SetCoinDescription writes a coin description for a locale. Admin edits are kept across metadata refreshes.
+	SetCoinDescription(context.Context, *connect.Request[v1.SetCoinDescriptionRequest]) (*connect.Response[v1.SetCoinDescriptionResponse], error)
+	// DeleteCoinDescription removes a coin description for a locale.
+	DeleteCoinDescription(context.Context, *connect.Request[v1.DeleteCoinDescriptionRequest]) (*connect.Response[v1.DeleteCoinDescriptionResponse], error)
+	// ListSlowQueries returns the database queries over the slow query threshold, most total time first.
+	ListSlowQueries(context.Context, *connect.Request[v1.ListSlowQueriesRequest]) (*connect.Response[v1.ListSlowQueriesResponse], error)
+	// BroadcastNotification sends a system announcement to every wallet with a registered device,
+	// subject to each wallet's notification preferences.
+	BroadcastNotification(context.Context, *connect.Request[v1.BroadcastNotificationRequest]) (*connect.Response[v1.BroadcastNotificationResponse], error)
+	// ListNotificationDeliveries returns the notification delivery log, newest first.
+	ListNotificationDeliveries(context.Context, *connect.Request[v1.ListNotificationDeliveriesRequest]) (*connect.Response[v1.ListNotificationDeliveriesResponse], error)
+	// ListAllAnnouncements returns every announcement, including scheduled and expired ones.
+	ListAllAnnouncements(context.Context, *connect.Request[v1.ListAllAnnouncementsRequest]) (*connect.Response[v1.ListAllAnnouncementsResponse], error)
+	// CreateAnnouncement publishes an in-app announcement.
+	CreateAnnouncement(context.Context, *connect.Request[v1.CreateAnnouncementRequest]) (*connect.Response[v1.CreateAnnouncementResponse], error)
+	// UpdateAnnouncement replaces an announcement's content, schedule and targeting.
+	UpdateAnnouncement(context.Context, *connect.Request[v1.UpdateAnnouncementRequest]) (*connect.Response[v1.UpdateAnnouncementResponse], error)
+	// DeleteAnnouncement removes an announcement.
+	DeleteAnnouncement(context.Context, *connect.Request[v1.DeleteAnnouncementRequest]) (*connect.Response[v1.DeleteAnnouncementResponse], error)
+	// ListJobRuns returns the background job run log, newest first.
+	ListJobRuns(context.Context, *connect.Request[v1.ListJobRunsRequest]) (*connect.Response[v1.ListJobRunsResponse], error)
+	// GetOperationsOverview returns the operational state for the admin
+	// dashboard: upstream call totals, cache hit rates, recent job runs, RPC
+	// endpoint health, the enrichment queue and the platform fee balances.
+	GetOperationsOverview(context.Context, *connect.Request[v1.GetOperationsOverviewRequest]) (*connect.Response[v1.GetOperationsOverviewResponse], error)
+	// GetCoinHistory returns the recorded changes to a coin's metadata, newest first.
+	GetCoinHistory(context.Context, *connect.Request[v1.GetCoinHistoryRequest]) (*connect.Response[v1.GetCoinHistoryResponse], error)
+	// ListCoinOverrides returns the admin overrides of a coin, along with the source each metadata field was taken from.
+	ListCoinOverrides(context.Context, *connect.Request[v1.ListCoinOverridesRequest]) (*connect.Response[v1.ListCoinOverridesResponse], error)
+	// SetCoinOverride pins a coin metadata field to a value, winning over every provider until deleted.
+	SetCoinOverride(context.Context, *connect.Request[v1.SetCoinOverrideRequest]) (*connect.Response[v1.SetCoinOverrideResponse], error)
+	// DeleteCoinOverride removes an override; the next provider refresh replaces the value.
+	DeleteCoinOverride(context.Context, *connect.Request[v1.DeleteCoinOverrideRequest]) (*connect.Response[v1.DeleteCoinOverrideResponse], error)
+	// ListSearchSynonyms returns every coin search synonym.
+	ListSearchSynonyms(context.Context, *connect.Request[v1.ListSearchSynonymsRequest]) (*connect.Response[v1.ListSearchSynonymsResponse], error)
+	// SetSearchSynonym makes coin searches for a term run as another query, e.g. "dogwifhat" as "$WIF".
+	SetSearchSynonym(context.Context, *connect.Request[v1.SetSearchSynonymRequest]) (*connect.Response[v1.SetSearchSynonymResponse], error)
+	// DeleteSearchSynonym removes a coin search synonym.
+	DeleteSearchSynonym(context.Context, *connect.Request[v1.DeleteSearchSynonymRequest]) (*connect.Response[v1.DeleteSearchSynonymResponse], error)
+	// ListNaughtyWords returns a page of the words coin names and symbols are filtered on.
+	ListNaughtyWords(context.Context, *connect.Request[v1.ListNaughtyWordsRequest]) (*connect.Response[v1.ListNaughtyWordsResponse], error)
+	// AddNaughtyWords adds words to the filter, moving any already listed to the given language.
+	AddNaughtyWords(context.Context, *connect.Request[v1.AddNaughtyWordsRequest]) (*connect.Response[v1.AddNaughtyWordsResponse], error)
+	// RemoveNaughtyWords removes words from the filter.
+	RemoveNaughtyWords(context.Context, *connect.Request[v1.RemoveNaughtyWordsRequest]) (*connect.Response[v1.RemoveNaughtyWordsResponse], error)
+	// ImportNaughtyWords adds the LDNOOBW word lists of the given languages, keeping words already listed.
+	ImportNaughtyWords(context.Context, *connect.Request[v1.ImportNaughtyWordsRequest]) (*connect.Response[v1.ImportNaughtyWordsResponse], error)
+	// ExportNaughtyWords returns the filtered words a word per line, like the LDNOOBW lists.
+	ExportNaughtyWords(context.Context, *connect.Request[v1.ExportNaughtyWordsRequest]) (*connect.Response[v1.ExportNaughtyWordsResponse], error)
+	// ReloadNaughtyWords has every replica read the filtered words from the database again.
+	ReloadNaughtyWords(context.Context, *connect.Request[v1.ReloadNaughtyWordsRequest]) (*connect.Response[v1.ReloadNaughtyWordsResponse], error)
+	// GetTradeLimit returns a wallet's effective daily and weekly trade limits and what it has used of them.
+	GetTradeLimit(context.Context, *connect.Request[v1.GetTradeLimitRequest]) (*connect.Response[v1.GetTradeLimitResponse], error)
+	// SetTradeLimit overrides a wallet's trade limits, or exempts it from them.
+	SetTradeLimit(context.Context, *connect.Request[v1.SetTradeLimitRequest]) (*connect.Response[v1.SetTradeLimitResponse], error)
+	// DeleteTradeLimit removes a wallet's override, returning it to the configured limits.
+	DeleteTradeLimit(context.Context, *connect.Request[v1.DeleteTradeLimitRequest]) (*connect.Response[v1.DeleteTradeLimitResponse], error)
+	// RevalidateIcons starts re-validating the icons of a slice of the coins in the background.
+	// Broken icons are fetched again and replaced, or served placeholders when they still fail.
+	RevalidateIcons(context.Context, *connect.Request[v1.RevalidateIconsRequest]) (*connect.Response[v1.RevalidateIconsResponse], error)
+	// GetIconRevalidation returns the progress of an icon re-validation.
+	GetIconRevalidation(context.Context, *connect.Request[v1.GetIconRevalidationRequest]) (*connect.Response[v1.GetIconRevalidationResponse], error)
+	// DeleteRecord soft-deletes a coin, burn watch or user record, hiding it until it is
+	// restored or purged once the retention period has passed.
+	DeleteRecord(context.Context, *connect.Request[v1.DeleteRecordRequest]) (*connect.Response[v1.DeleteRecordResponse], error)
+	// RestoreRecord undoes the deletion of a record that hasn't been purged yet.
+	RestoreRecord(context.Context, *connect.Request[v1.RestoreRecordRequest]) (*connect.Response[v1.RestoreRecordResponse], error)
+	// ListDeletedRecords returns a page of the deleted records of a kind, most recently deleted first.
+	ListDeletedRecords(context.Context, *connect.Request[v1.ListDeletedRecordsRequest]) (*connect.Response[v1.ListDeletedRecordsResponse], error)
+}
+
+// NewAdminServiceClient constructs a client for the dankfolio.v1.AdminService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAdminServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AdminServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	adminServiceMethods := v1.File_dankfolio_v1_admin_proto.Services().ByName("AdminService").Methods()
+	return &adminServiceClient{
+		listSettings: connect.NewClient[v1.ListSettingsRequest, v1.ListSettingsResponse](
+			httpClient,
+			baseURL+AdminServiceListSettingsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ListSettings")),
+			connect.WithClientOptions(opts...),
+		),
+		updateSetting: connect.NewClient[v1.UpdateSettingRequest, v1.UpdateSettingResponse](
+			httpClient,
+			baseURL+AdminServiceUpdateSettingProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("UpdateSetting")),
+			connect.WithClientOptions(opts...),
+		),
+		resetSetting: connect.NewClient[v1.ResetSettingRequest, v1.ResetSettingResponse](
+			httpClient,
+			baseURL+AdminServiceResetSettingProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ResetSetting")),
+			connect.WithClientOptions(opts...),
+		),
+		listFeatureFlags: connect.NewClient[v1.ListFeatureFlagsRequest, v1.ListFeatureFlagsResponse](
+			httpClient,
+			baseURL+AdminServiceListFeatureFlagsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ListFeatureFlags")),
+			connect.WithClientOptions(opts...),
+		),
+		setFeatureFlag: connect.NewClient[v1.SetFeatureFlagRequest, v1.SetFeatureFlagResponse](
+			httpClient,
+			baseURL+AdminServiceSetFeatureFlagProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("SetFeatureFlag")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteFeatureFlag: connect.NewClient[v1.DeleteFeatureFlagRequest, v1.DeleteFeatureFlagResponse](
+			httpClient,
+			baseURL+AdminServiceDeleteFeatureFlagProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("DeleteFeatureFlag")),
+			connect.WithClientOptions(opts...),
+		),
+		listSpamTokens: connect.NewClient[v1.ListSpamTokensRequest, v1.ListSpamTokensResponse](
+			httpClient,
+			baseURL+AdminServiceListSpamTokensProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ListSpamTokens")),
+			connect.WithClientOptions(opts...),
+		),
+		setSpamToken: connect.NewClient[v1.SetSpamTokenRequest, v1.SetSpamTokenResponse](
+			httpClient,
+			baseURL+AdminServiceSetSpamTokenProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("SetSpamToken")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteSpamToken: connect.NewClient[v1.DeleteSpamTokenRequest, v1.DeleteSpamTokenResponse](
+			httpClient,
+			baseURL+AdminServiceDeleteSpamTokenProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("DeleteSpamToken")),
+			connect.WithClientOptions(opts...),
+		),
+		listBlockedMints: connect.NewClient[v1.ListBlockedMintsRequest, v1.ListBlockedMintsResponse](
+			httpClient,
+			baseURL+AdminServiceListBlockedMintsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ListBlockedMints")),
+			connect.WithClientOptions(opts...),
+		),
+		setBlocklistOverride: connect.NewClient[v1.SetBlocklistOverrideRequest, v1.SetBlocklistOverrideResponse](
+			httpClient,
+			baseURL+AdminServiceSetBlocklistOverrideProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("SetBlocklistOverride")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteBlocklistOverride: connect.NewClient[v1.DeleteBlocklistOverrideRequest, v1.DeleteBlocklistOverrideResponse](
+			httpClient,
+			baseURL+AdminServiceDeleteBlocklistOverrideProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("DeleteBlocklistOverride")),
+			connect.WithClientOptions(opts...),
+		),
+		syncBlocklist: connect.NewClient[v1.SyncBlocklistRequest, v1.SyncBlocklistResponse](
+			httpClient,
+			baseURL+AdminServiceSyncBlocklistProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("SyncBlocklist")),
+			connect.WithClientOptions(opts...),
+		),
+		listCoinDescriptions: connect.NewClient[v1.ListCoinDescriptionsRequest, v1.ListCoinDescriptionsResponse](
+			httpClient,
+			baseURL+AdminServiceListCoinDescriptionsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ListCoinDescriptions")),
+			connect.WithClientOptions(opts...),
+		),
+		setCoinDescription: connect.NewClient[v1.SetCoinDescriptionRequest, v1.SetCoinDescriptionResponse](
+			httpClient,
+			baseURL+AdminServiceSetCoinDescriptionProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("SetCoinDescription")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteCoinDescription: connect.NewClient[v1.DeleteCoinDescriptionRequest, v1.DeleteCoinDescriptionResponse](
+			httpClient,
+			baseURL+AdminServiceDeleteCoinDescriptionProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("DeleteCoinDescription")),
+			connect.WithClientOptions(opts...),
+		),
+		listSlowQueries: connect.NewClient[v1.ListSlowQueriesRequest, v1.ListSlowQueriesResponse](
+			httpClient,
+			baseURL+AdminServiceListSlowQueriesProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ListSlowQueries")),
+			connect.WithClientOptions(opts...),
+		),
+		broadcastNotification: connect.NewClient[v1.BroadcastNotificationRequest, v1.BroadcastNotificationResponse](
+			httpClient,
+			baseURL+AdminServiceBroadcastNotificationProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("BroadcastNotification")),
+			connect.WithClientOptions(opts...),
+		),
+		listNotificationDeliveries: connect.NewClient[v1.ListNotificationDeliveriesRequest, v1.ListNotificationDeliveriesResponse](
+			httpClient,
+			baseURL+AdminServiceListNotificationDeliveriesProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ListNotificationDeliveries")),
+			connect.WithClientOptions(opts...),
+		),
+		listAllAnnouncements: connect.NewClient[v1.ListAllAnnouncementsRequest, v1.ListAllAnnouncementsResponse](
+			httpClient,
+			baseURL+AdminServiceListAllAnnouncementsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ListAllAnnouncements")),
+			connect.WithClientOptions(opts...),
+		),
+		createAnnouncement: connect.NewClient[v1.CreateAnnouncementRequest, v1.CreateAnnouncementResponse](
+			httpClient,
+			baseURL+AdminServiceCreateAnnouncementProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("CreateAnnouncement")),
+			connect.WithClientOptions(opts...),
+		),
+		updateAnnouncement: connect.NewClient[v1.UpdateAnnouncementRequest, v1.UpdateAnnouncementResponse](
+			httpClient,
+			baseURL+AdminServiceUpdateAnnouncementProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("UpdateAnnouncement")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteAnnouncement: connect.NewClient[v1.DeleteAnnouncementRequest, v1.DeleteAnnouncementResponse](
+			httpClient,
+			baseURL+AdminServiceDeleteAnnouncementProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("DeleteAnnouncement")),
+			connect.WithClientOptions(opts...),
+		),
+		listJobRuns: connect.NewClient[v1.ListJobRunsRequest, v1.ListJobRunsResponse](
+			httpClient,
+			baseURL+AdminServiceListJobRunsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ListJobRuns")),
+			connect.WithClientOptions(opts...),
+		),
+		getOperationsOverview: connect.NewClient[v1.GetOperationsOverviewRequest, v1.GetOperationsOverviewResponse](
+			httpClient,
+			baseURL+AdminServiceGetOperationsOverviewProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("GetOperationsOverview")),
+			connect.WithClientOptions(opts...),
+		),
+		getCoinHistory: connect.NewClient[v1.GetCoinHistoryRequest, v1.GetCoinHistoryResponse](
+			httpClient,
+			baseURL+AdminServiceGetCoinHistoryProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("GetCoinHistory")),
+			connect.WithClientOptions(opts...),
+		),
+		listCoinOverrides: connect.NewClient[v1.ListCoinOverridesRequest, v1.ListCoinOverridesResponse](
+			httpClient,
+			baseURL+AdminServiceListCoinOverridesProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ListCoinOverrides")),
+			connect.WithClientOptions(opts...),
+		),
+		setCoinOverride: connect.NewClient[v1.SetCoinOverrideRequest, v1.SetCoinOverrideResponse](
+			httpClient,
+			baseURL+AdminServiceSetCoinOverrideProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("SetCoinOverride")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteCoinOverride: connect.NewClient[v1.DeleteCoinOverrideRequest, v1.DeleteCoinOverrideResponse](
+			httpClient,
+			baseURL+AdminServiceDeleteCoinOverrideProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("DeleteCoinOverride")),
+			connect.WithClientOptions(opts...),
+		),
+		listSearchSynonyms: connect.NewClient[v1.ListSearchSynonymsRequest, v1.ListSearchSynonymsResponse](
+			httpClient,
+			baseURL+AdminServiceListSearchSynonymsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ListSearchSynonyms")),
+			connect.WithClientOptions(opts...),
+		),
+		setSearchSynonym: connect.NewClient[v1.SetSearchSynonymRequest, v1.SetSearchSynonymResponse](
+			httpClient,
+			baseURL+AdminServiceSetSearchSynonymProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("SetSearchSynonym")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteSearchSynonym: connect.NewClient[v1.DeleteSearchSynonymRequest, v1.DeleteSearchSynonymResponse](
+			httpClient,
+			baseURL+AdminServiceDeleteSearchSynonymProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("DeleteSearchSynonym")),
+			connect.WithClientOptions(opts...),
+		),
+		listNaughtyWords: connect.NewClient[v1.ListNaughtyWordsRequest, v1.ListNaughtyWordsResponse](
+			httpClient,
+			baseURL+AdminServiceListNaughtyWordsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ListNaughtyWords")),
+			connect.WithClientOptions(opts...),
+		),
+		addNaughtyWords: connect.NewClient[v1.AddNaughtyWordsRequest, v1.AddNaughtyWordsResponse](
+			httpClient,
+			baseURL+AdminServiceAddNaughtyWordsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("AddNaughtyWords")),
+			connect.WithClientOptions(opts...),
+		),
+		removeNaughtyWords: connect.NewClient[v1.RemoveNaughtyWordsRequest, v1.RemoveNaughtyWordsResponse](
+			httpClient,
+			baseURL+AdminServiceRemoveNaughtyWordsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("RemoveNaughtyWords")),
+			connect.WithClientOptions(opts...),
+		),
+		importNaughtyWords: connect.NewClient[v1.ImportNaughtyWordsRequest, v1.ImportNaughtyWordsResponse](
+			httpClient,
+			baseURL+AdminServiceImportNaughtyWordsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ImportNaughtyWords")),
+			connect.WithClientOptions(opts...),
+		),
+		exportNaughtyWords: connect.NewClient[v1.ExportNaughtyWordsRequest, v1.ExportNaughtyWordsResponse](
+			httpClient,
+			baseURL+AdminServiceExportNaughtyWordsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ExportNaughtyWords")),
+			connect.WithClientOptions(opts...),
+		),
+		reloadNaughtyWords: connect.NewClient[v1.ReloadNaughtyWordsRequest, v1.ReloadNaughtyWordsResponse](
+			httpClient,
+			baseURL+AdminServiceReloadNaughtyWordsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ReloadNaughtyWords")),
+			connect.WithClientOptions(opts...),
+		),
+		getTradeLimit: connect.NewClient[v1.GetTradeLimitRequest, v1.GetTradeLimitResponse](
+			httpClient,
+			baseURL+AdminServiceGetTradeLimitProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("GetTradeLimit")),
+			connect.WithClientOptions(opts...),
+		),
+		setTradeLimit: connect.NewClient[v1.SetTradeLimitRequest, v1.SetTradeLimitResponse](
+			httpClient,
+			baseURL+AdminServiceSetTradeLimitProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("SetTradeLimit")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteTradeLimit: connect.NewClient[v1.DeleteTradeLimitRequest, v1.DeleteTradeLimitResponse](
+			httpClient,
+			baseURL+AdminServiceDeleteTradeLimitProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("DeleteTradeLimit")),
+			connect.WithClientOptions(opts...),
+		),
+		revalidateIcons: connect.NewClient[v1.RevalidateIconsRequest, v1.RevalidateIconsResponse](
+			httpClient,
+			baseURL+AdminServiceRevalidateIconsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("RevalidateIcons")),
+			connect.WithClientOptions(opts...),
+		),
+		getIconRevalidation: connect.NewClient[v1.GetIconRevalidationRequest, v1.GetIconRevalidationResponse](
+			httpClient,
+			baseURL+AdminServiceGetIconRevalidationProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("GetIconRevalidation")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteRecord: connect.NewClient[v1.DeleteRecordRequest, v1.DeleteRecordResponse](
+			httpClient,
+			baseURL+AdminServiceDeleteRecordProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("DeleteRecord")),
+			connect.WithClientOptions(opts...),
+		),
+		restoreRecord: connect.NewClient[v1.RestoreRecordRequest, v1.RestoreRecordResponse](
+			httpClient,
+			baseURL+AdminServiceRestoreRecordProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("RestoreRecord")),
+			connect.WithClientOptions(opts...),
+		),
+		listDeletedRecords: connect.NewClient[v1.ListDeletedRecordsRequest, v1.ListDeletedRecordsResponse](
+			httpClient,
+			baseURL+AdminServiceListDeletedRecordsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ListDeletedRecords")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// adminServiceClient implements AdminServiceClient.
+type adminServiceClient struct {
+	listSettings               *connect.Client[v1.ListSettingsRequest, v1.ListSettingsResponse]
+	updateSetting              *connect.Client[v1.UpdateSettingRequest, v1.UpdateSettingResponse]
+	resetSetting               *connect.Client[v1.ResetSettingRequest, v1.ResetSettingResponse]
+	listFeatureFlags           *connect.Client[v1.ListFeatureFlagsRequest, v1.ListFeatureFlagsResponse]
+	setFeatureFlag             *connect.Client[v1.SetFeatureFlagRequest, v1.SetFeatureFlagResponse]
+	deleteFeatureFlag          *connect.Client[v1.DeleteFeatureFlagRequest, v1.DeleteFeatureFlagResponse]
+	listSpamTokens             *connect.Client[v1.ListSpamTokensRequest, v1.ListSpamTokensResponse]
+	setSpamToken               *connect.Client[v1.SetSpamTokenRequest, v1.SetSpamTokenResponse]
+	deleteSpamToken            *connect.Client[v1.DeleteSpamTokenRequest, v1.DeleteSpamTokenResponse]
+	listBlockedMints           *connect.Client[v1.ListBlockedMintsRequest, v1.ListBlockedMintsResponse]
+	setBlocklistOverride       *connect.Client[v1.SetBlocklistOverrideRequest, v1.SetBlocklistOverrideResponse]
+	deleteBlocklistOverride    *connect.Client[v1.DeleteBlocklistOverrideRequest, v1.DeleteBlocklistOverrideResponse]
+	syncBlocklist              *connect.Client[v1.SyncBlocklistRequest, v1.SyncBlocklistResponse]
+	listCoinDescriptions       *connect.Client[v1.ListCoinDescriptionsRequest, v1.ListCoinDescriptionsResponse]
+	setCoinDescription         *connect.Client[v1.SetCoinDescriptionRequest, v1.SetCoinDescriptionResponse]
+	deleteCoinDescription      *connect.Client[v1.DeleteCoinDescriptionRequest, v1.DeleteCoinDescriptionResponse]
+	listSlowQueries            *connect.Client[v1.ListSlowQueriesRequest, v1.ListSlowQueriesResponse]
+	broadcastNotification      *connect.Client[v1.BroadcastNotificationRequest, v1.BroadcastNotificationResponse]
+	listNotificationDeliveries *connect.Client[v1.ListNotificationDeliveriesRequest, v1.ListNotificationDeliveriesResponse]
+	listAllAnnouncements       *connect.Client[v1.ListAllAnnouncementsRequest, v1.ListAllAnnouncementsResponse]
+	createAnnouncement         *connect.Client[v1.CreateAnnouncementRequest, v1.CreateAnnouncementResponse]
+	updateAnnouncement         *connect.Client[v1.UpdateAnnouncementRequest, v1.UpdateAnnouncementResponse]
+	deleteAnnouncement         *connect.Client[v1.DeleteAnnouncementRequest, v1.DeleteAnnouncementResponse]
+	listJobRuns                *connect.Client[v1.ListJobRunsRequest, v1.ListJobRunsResponse]
+	getOperationsOverview      *connect.Client[v1.GetOperationsOverviewRequest, v1.GetOperationsOverviewResponse]
+	getCoinHistory             *connect.Client[v1.GetCoinHistoryRequest, v1.GetCoinHistoryResponse]
+	listCoinOverrides          *connect.Client[v1.ListCoinOverridesRequest, v1.ListCoinOverridesResponse]
+	setCoinOverride            *connect.Client[v1.SetCoinOverrideRequest, v1.SetCoinOverrideResponse]
+	deleteCoinOverride         *connect.Client[v1.DeleteCoinOverrideRequest, v1.DeleteCoinOverrideResponse]
+	listSearchSynonyms         *connect.Client[v1.ListSearchSynonymsRequest, v1.ListSearchSynonymsResponse]
+	setSearchSynonym           *connect.Client[v1.SetSearchSynonymRequest, v1.SetSearchSynonymResponse]
+	deleteSearchSynonym        *connect.Client[v1.DeleteSearchSynonymRequest, v1.DeleteSearchSynonymResponse]
+	listNaughtyWords           *connect.Client[v1.ListNaughtyWordsRequest, v1.ListNaughtyWordsResponse]
+	addNaughtyWords            *connect.Client[v1.AddNaughtyWordsRequest, v1.AddNaughtyWordsResponse]
+	removeNaughtyWords         *connect.Client[v1.RemoveNaughtyWordsRequest, v1.RemoveNaughtyWordsResponse]
+	importNaughtyWords         *connect.Client[v1.ImportNaughtyWordsRequest, v1.ImportNaughtyWordsResponse]
+	exportNaughtyWords         *connect.Client[v1.ExportNaughtyWordsRequest, v1.ExportNaughtyWordsResponse]
+	reloadNaughtyWords         *connect.Client[v1.ReloadNaughtyWordsRequest, v1.ReloadNaughtyWordsResponse]
+	getTradeLimit              *connect.Client[v1.GetTradeLimitRequest, v1.GetTradeLimitResponse]
+	setTradeLimit              *connect.Client[v1.SetTradeLimitRequest, v1.SetTradeLimitResponse]
+	deleteTradeLimit           *connect.Client[v1.DeleteTradeLimitRequest, v1.DeleteTradeLimitResponse]
+	revalidateIcons            *connect.Client[v1.RevalidateIconsRequest, v1.RevalidateIconsResponse]
+	getIconRevalidation        *connect.Client[v1.GetIconRevalidationRequest, v1.GetIconRevalidationResponse]
+	deleteRecord               *connect.Client[v1.DeleteRecordRequest, v1.DeleteRecordResponse]
+	restoreRecord              *connect.Client[v1.RestoreRecordRequest, v1.RestoreRecordResponse]
+	listDeletedRecords         *connect.Client[v1.ListDeletedRecordsRequest, v1.ListDeletedRecordsResponse]
+}
+
+// ListSettings calls dankfolio.v1.AdminService.ListSettings.
+func (c *adminServiceClient) ListSettings(ctx context.Context, req *connect.Request[v1.ListSettingsRequest]) (*connect.Response[v1.ListSettingsResponse], error) {
+	return c.listSettings.CallUnary(ctx, req)
+}
+
+// UpdateSetting calls dankfolio.v1.AdminService.UpdateSetting.
+func (c *adminServiceClient) UpdateSetting(ctx context.Context, req *connect.Request[v1.UpdateSettingRequest]) (*connect.Response[v1.UpdateSettingResponse], error) {
+	return c.updateSetting.CallUnary(ctx, req)
+}
+
+// ResetSetting calls dankfolio.v1.AdminService.ResetSetting.
+func (c *adminServiceClient) ResetSetting(ctx context.Context, req *connect.Request[v1.ResetSettingRequest]) (*connect.Response[v1.ResetSettingResponse], error) {
+	return c.resetSetting.CallUnary(ctx, req)
+}
+
+// ListFeatureFlags calls dankfolio.v1.AdminService.ListFeatureFlags.
+func (c *adminServiceClient) ListFeatureFlags(ctx context.Context, req *connect.Request[v1.ListFeatureFlagsRequest]) (*connect.Response[v1.ListFeatureFlagsResponse], error) {
+	return c.listFeatureFlags.CallUnary(ctx, req)
+}
+
+// SetFeatureFlag calls dankfolio.v1.AdminService.SetFeatureFlag.
+func (c *adminServiceClient) SetFeatureFlag(ctx context.Context, req *connect.Request[v1.SetFeatureFlagRequest]) (*connect.Response[v1.SetFeatureFlagResponse], error) {
+	return c.setFeatureFlag.CallUnary(ctx, req)
+}
+
+// DeleteFeatureFlag calls dankfolio.v1.AdminService.DeleteFeatureFlag.
+func (c *adminServiceClient) DeleteFeatureFlag(ctx context.Context, req *connect.Request[v1.DeleteFeatureFlagRequest]) (*connect.Response[v1.DeleteFeatureFlagResponse], error) {
+	return c.deleteFeatureFlag.CallUnary(ctx, req)
+}
+
+// ListSpamTokens calls dankfolio.v1.AdminService.ListSpamTokens.
+func (c *adminServiceClient) ListSpamTokens(ctx context.Context, req *connect.Request[v1.ListSpamTokensRequest]) (*connect.Response[v1.ListSpamTokensResponse], error) {
+	return c.listSpamTokens.CallUnary(ctx, req)
+}
+
+// SetSpamToken calls dankfolio.v1.AdminService.SetSpamToken.
+func (c *adminServiceClient) SetSpamToken(ctx context.Context, req *connect.Request[v1.SetSpamTokenRequest]) (*connect.Response[v1.SetSpamTokenResponse], error) {
+	return c.setSpamToken.CallUnary(ctx, req)
+}
+
+// DeleteSpamToken calls dankfolio.v1.AdminService.DeleteSpamToken.
+func (c *adminServiceClient) DeleteSpamToken(ctx context.Context, req *connect.Request[v1.DeleteSpamTokenRequest]) (*connect.Response[v1.DeleteSpamTokenResponse], error) {
+	return c.deleteSpamToken.CallUnary(ctx, req)
+}
+
+// ListBlockedMints calls dankfolio.v1.AdminService.ListBlockedMints.
+func (c *adminServiceClient) ListBlockedMints(ctx context.Context, req *connect.Request[v1.ListBlockedMintsRequest]) (*connect.Response[v1.ListBlockedMintsResponse], error) {
+	return c.listBlockedMints.CallUnary(ctx, req)
+}
+
+// SetBlocklistOverride calls dankfolio.v1.AdminService.SetBlocklistOverride.
+func (c *adminServiceClient) SetBlocklistOverride(ctx context.Context, req *connect.Request[v1.SetBlocklistOverrideRequest]) (*connect.Response[v1.SetBlocklistOverrideResponse], error) {
+	return c.setBlocklistOverride.CallUnary(ctx, req)
+}
+
+// DeleteBlocklistOverride calls dankfolio.v1.AdminService.DeleteBlocklistOverride.
+func (c *adminServiceClient) DeleteBlocklistOverride(ctx context.Context, req *connect.Request[v1.DeleteBlocklistOverrideRequest]) (*connect.Response[v1.DeleteBlocklistOverrideResponse], error) {
+	return c.deleteBlocklistOverride.CallUnary(ctx, req)
+}
+
+// SyncBlocklist calls dankfolio.v1.AdminService.SyncBlocklist.
+func (c *adminServiceClient) SyncBlocklist(ctx context.Context, req *connect.Request[v1.SyncBlocklistRequest]) (*connect.Response[v1.SyncBlocklistResponse], error) {
+	return c.syncBlocklist.CallUnary(ctx, req)
+}
+
+// ListCoinDescriptions calls dankfolio.v1.AdminService.ListCoinDescriptions.
+func (c *adminServiceClient) ListCoinDescriptions(ctx context.Context, req *connect.Request[v1.ListCoinDescriptionsRequest]) (*connect.Response[v1.ListCoinDescriptionsResponse], error) {
+	return c.listCoinDescriptions.CallUnary(ctx, req)
+}
+
+// SetCoinDescription calls dankfolio.v1.AdminService.SetCoinDescription.
+func (c *adminServiceClient) SetCoinDescription(ctx context.Context, req *connect.Request[v1.SetCoinDescriptionRequest]) (*connect.Response[v1.SetCoinDescriptionResponse], error) {
+	return c.setCoinDescription.CallUnary(ctx, req)
+}
+
+// DeleteCoinDescription calls dankfolio.v1.AdminService.DeleteCoinDescription.
+func (c *adminServiceClient) DeleteCoinDescription(ctx context.Context, req *connect.Request[v1.DeleteCoinDescriptionRequest]) (*connect.Response[v1.DeleteCoinDescriptionResponse], error) {
+	return c.deleteCoinDescription.CallUnary(ctx, req)
+}
+
+// ListSlowQueries calls dankfolio.v1.AdminService.ListSlowQueries.
+func (c *adminServiceClient) ListSlowQueries(ctx context.Context, req *connect.Request[v1.ListSlowQueriesRequest]) (*connect.Response[v1.ListSlowQueriesResponse], error) {
+	return c.listSlowQueries.CallUnary(ctx, req)
+}
+
+// BroadcastNotification calls dankfolio.v1.AdminService.BroadcastNotification.
+func (c *adminServiceClient) BroadcastNotification(ctx context.Context, req *connect.Request[v1.BroadcastNotificationRequest]) (*connect.Response[v1.BroadcastNotificationResponse], error) {
+	return c.broadcastNotification.CallUnary(ctx, req)
+}
+
+// ListNotificationDeliveries calls dankfolio.v1.AdminService.ListNotificationDeliveries.
+func (c *adminServiceClient) ListNotificationDeliveries(ctx context.Context, req *connect.Request[v1.ListNotificationDeliveriesRequest]) (*connect.Response[v1.ListNotificationDeliveriesResponse], error) {
+	return c.listNotificationDeliveries.CallUnary(ctx, req)
+}
+
+// ListAllAnnouncements calls dankfolio.v1.AdminService.ListAllAnnouncements.
+func (c *adminServiceClient) ListAllAnnouncements(ctx context.Context, req *connect.Request[v1.ListAllAnnouncementsRequest]) (*connect.Response[v1.ListAllAnnouncementsResponse], error) {
+	return c.listAllAnnouncements.CallUnary(ctx, req)
+}
+
+// CreateAnnouncement calls dankfolio.v1.AdminService.CreateAnnouncement.
+func (c *adminServiceClient) CreateAnnouncement(ctx context.Context, req *connect.Request[v1.CreateAnnouncementRequest]) (*connect.Response[v1.CreateAnnouncementResponse], error) {
+	return c.createAnnouncement.CallUnary(ctx, req)
+}
+
+// UpdateAnnouncement calls dankfolio.v1.AdminService.UpdateAnnouncement.
+func (c *adminServiceClient) UpdateAnnouncement(ctx context.Context, req *connect.Request[v1.UpdateAnnouncementRequest]) (*connect.Response[v1.UpdateAnnouncementResponse], error) {
+	return c.updateAnnouncement.CallUnary(ctx, req)
+}
+
+// DeleteAnnouncement calls dankfolio.v1.AdminService.DeleteAnnouncement.
+func (c *adminServiceClient) DeleteAnnouncement(ctx context.Context, req *connect.Request[v1.DeleteAnnouncementRequest]) (*connect.Response[v1.DeleteAnnouncementResponse], error) {
+	return c.deleteAnnouncement.CallUnary(ctx, req)
+}
+
+// ListJobRuns calls dankfolio.v1.AdminService.ListJobRuns.
+func (c *adminServiceClient) ListJobRuns(ctx context.Context, req *connect.Request[v1.ListJobRunsRequest]) (*connect.Response[v1.ListJobRunsResponse], error) {
+	return c.listJobRuns.CallUnary(ctx, req)
+}
+
+// GetOperationsOverview calls dankfolio.v1.AdminService.GetOperationsOverview.
+func (c *adminServiceClient) GetOperationsOverview(ctx context.Context, req *connect.Request[v1.GetOperationsOverviewRequest]) (*connect.Response[v1.GetOperationsOverviewResponse], error) {
+	return c.getOperationsOverview.CallUnary(ctx, req)
+}
+
+// GetCoinHistory calls dankfolio.v1.AdminService.GetCoinHistory.
+func (c *adminServiceClient) GetCoinHistory(ctx context.Context, req *connect.Request[v1.GetCoinHistoryRequest]) (*connect.Response[v1.GetCoinHistoryResponse], error) {
+	return c.getCoinHistory.CallUnary(ctx, req)
+}
+
+// ListCoinOverrides calls dankfolio.v1.AdminService.ListCoinOverrides.
+func (c *adminServiceClient) ListCoinOverrides(ctx context.Context, req *connect.Request[v1.ListCoinOverridesRequest]) (*connect.Response[v1.ListCoinOverridesResponse], error) {
+	return c.listCoinOverrides.CallUnary(ctx, req)
+}
+
+// SetCoinOverride calls dankfolio.v1.AdminService.SetCoinOverride.
+func (c *adminServiceClient) SetCoinOverride(ctx context.Context, req *connect.Request[v1.SetCoinOverrideRequest]) (*connect.Response[v1.SetCoinOverrideResponse], error) {
+	return c.setCoinOverride.CallUnary(ctx, req)
+}
+
+// DeleteCoinOverride calls dankfolio.v1.AdminService.DeleteCoinOverride.
+func (c *adminServiceClient) DeleteCoinOverride(ctx context.Context, req *connect.Request[v1.DeleteCoinOverrideRequest]) (*connect.Response[v1.DeleteCoinOverrideResponse], error) {
+	return c.deleteCoinOverride.CallUnary(ctx, req)
+}
+
+// ListSearchSynonyms calls dankfolio.v1.AdminService.ListSearchSynonyms.
+func (c *adminServiceClient) ListSearchSynonyms(ctx context.Context, req *connect.Request[v1.ListSearchSynonymsRequest]) (*connect.Response[v1.ListSearchSynonymsResponse], error) {
+	return c.listSearchSynonyms.CallUnary(ctx, req)
+}
+
+// SetSearchSynonym calls dankfolio.v1.AdminService.SetSearchSynonym.
+func (c *adminServiceClient) SetSearchSynonym(ctx context.Context, req *connect.Request[v1.SetSearchSynonymRequest]) (*connect.Response[v1.SetSearchSynonymResponse], error) {
+	return c.setSearchSynonym.CallUnary(ctx, req)
+}
+
+// DeleteSearchSynonym calls dankfolio.v1.AdminService.DeleteSearchSynonym.
+func (c *adminServiceClient) DeleteSearchSynonym(ctx context.Context, req *connect.Request[v1.DeleteSearchSynonymRequest]) (*connect.Response[v1.DeleteSearchSynonymResponse], error) {
+	return c.deleteSearchSynonym.CallUnary(ctx, req)
+}
+
+// ListNaughtyWords calls dankfolio.v1.AdminService.ListNaughtyWords.
+func (c *adminServiceClient) ListNaughtyWords(ctx context.Context, req *connect.Request[v1.ListNaughtyWordsRequest]) (*connect.Response[v1.ListNaughtyWordsResponse], error) {
+	return c.listNaughtyWords.CallUnary(ctx, req)
+}
+
+// AddNaughtyWords calls dankfolio.v1.AdminService.AddNaughtyWords.
+func (c *adminServiceClient) AddNaughtyWords(ctx context.Context, req *connect.Request[v1.AddNaughtyWordsRequest]) (*connect.Response[v1.AddNaughtyWordsResponse], error) {
+	return c.addNaughtyWords.CallUnary(ctx, req)
+}
+
+// RemoveNaughtyWords calls dankfolio.v1.AdminService.RemoveNaughtyWords.
+func (c *adminServiceClient) RemoveNaughtyWords(ctx context.Context, req *connect.Request[v1.RemoveNaughtyWordsRequest]) (*connect.Response[v1.RemoveNaughtyWordsResponse], error) {
+	return c.removeNaughtyWords.CallUnary(ctx, req)
+}
+
+// ImportNaughtyWords calls dankfolio.v1.AdminService.ImportNaughtyWords.
+func (c *adminServiceClient) ImportNaughtyWords(ctx context.Context, req *connect.Request[v1.ImportNaughtyWordsRequest]) (*connect.Response[v1.ImportNaughtyWordsResponse], error) {
+	return c.importNaughtyWords.CallUnary(ctx, req)
+}
+
+// ExportNaughtyWords calls dankfolio.v1.AdminService.ExportNaughtyWords.
+func (c *adminServiceClient) ExportNaughtyWords(ctx context.Context, req *connect.Request[v1.ExportNaughtyWordsRequest]) (*connect.Response[v1.ExportNaughtyWordsResponse], error) {
+	return c.exportNaughtyWords.CallUnary(ctx, req)
+}
+
+// ReloadNaughtyWords calls dankfolio.v1.AdminService.ReloadNaughtyWords.
+func (c *adminServiceClient) ReloadNaughtyWords(ctx context.Context, req *connect.Request[v1.ReloadNaughtyWordsRequest]) (*connect.Response[v1.ReloadNaughtyWordsResponse], error) {
+	return c.reloadNaughtyWords.CallUnary(ctx, req)
+}
+
+// GetTradeLimit calls dankfolio.v1.AdminService.GetTradeLimit.
+func (c *adminServiceClient) GetTradeLimit(ctx context.Context, req *connect.Request[v1.GetTradeLimitRequest]) (*connect.Response[v1.GetTradeLimitResponse], error) {
+	return c.getTradeLimit.CallUnary(ctx, req)
+}
+
+// SetTradeLimit calls dankfolio.v1.AdminService.SetTradeLimit.
+func (c *adminServiceClient) SetTradeLimit(ctx context.Context, req *connect.Request[v1.SetTradeLimitRequest]) (*connect.Response[v1.SetTradeLimitResponse], error) {
+	return c.setTradeLimit.CallUnary(ctx, req)
+}
+
+// DeleteTradeLimit calls dankfolio.v1.AdminService.DeleteTradeLimit.
+func (c *adminServiceClient) DeleteTradeLimit(ctx context.Context, req *connect.Request[v1.DeleteTradeLimitRequest]) (*connect.Response[v1.DeleteTradeLimitResponse], error) {
+	return c.deleteTradeLimit.CallUnary(ctx, req)
+}
+
+// RevalidateIcons calls dankfolio.v1.AdminService.RevalidateIcons.
+func (c *adminServiceClient) RevalidateIcons(ctx context.Context, req *connect.Request[v1.RevalidateIconsRequest]) (*connect.Response[v1.RevalidateIconsResponse], error) {
+	return c.revalidateIcons.CallUnary(ctx, req)
+}
+
+// GetIconRevalidation calls dankfolio.v1.AdminService.GetIconRevalidation.
+func (c *adminServiceClient) GetIconRevalidation(ctx context.Context, req *connect.Request[v1.GetIconRevalidationRequest]) (*connect.Response[v1.GetIconRevalidationResponse], error) {
+	return c.getIconRevalidation.CallUnary(ctx, req)
+}
+
+// DeleteRecord calls dankfolio.v1.AdminService.DeleteRecord.
+func (c *adminServiceClient) DeleteRecord(ctx context.Context, req *connect.Request[v1.DeleteRecordRequest]) (*connect.Response[v1.DeleteRecordResponse], error) {
+	return c.deleteRecord.CallUnary(ctx, req)
+}
+
+// RestoreRecord calls dankfolio.v1.AdminService.RestoreRecord.
+func (c *adminServiceClient) RestoreRecord(ctx context.Context, req *connect.Request[v1.RestoreRecordRequest]) (*connect.Response[v1.RestoreRecordResponse], error) {
+	return c.restoreRecord.CallUnary(ctx, req)
+}
+
+// ListDeletedRecords calls dankfolio.v1.AdminService.ListDeletedRecords.
+func (c *adminServiceClient) ListDeletedRecords(ctx context.Context, req *connect.Request[v1.ListDeletedRecordsRequest]) (*connect.Response[v1.ListDeletedRecordsResponse], error) {
+	return c.listDeletedRecords.CallUnary(ctx, req)
+}
+
+// AdminServiceHandler is an implementation of the dankfolio.v1.AdminService service.
+type AdminServiceHandler interface {
+	// ListSettings returns every runtime setting with its effective and default value.
+	ListSettings(context.Context, *connect.Request[v1.ListSettingsRequest]) (*connect.Response[v1.ListSettingsResponse], error)
+	// UpdateSetting overrides a setting. The change is applied without a restart.
+	UpdateSetting(context.Context, *connect.Request[v1.UpdateSettingRequest]) (*connect.Response[v1.UpdateSettingResponse], error)
+	// ResetSetting removes an override so the setting falls back to its env/default value.
+	ResetSetting(context.Context, *connect.Request[v1.ResetSettingRequest]) (*connect.Response[v1.ResetSettingResponse], error)
+	// ListFeatureFlags returns every feature flag definition.
+	ListFeatureFlags(context.Context, *connect.Request[v1.ListFeatureFlagsRequest]) (*connect.Response[v1.ListFeatureFlagsResponse], error)
+	// SetFeatureFlag creates or replaces a feature flag.
+	SetFeatureFlag(context.Context, *connect.Request[v1.SetFeatureFlagRequest]) (*connect.Response[v1.SetFeatureFlagResponse], error)
+	// DeleteFeatureFlag removes a feature flag; it evaluates as off afterwards.
+	DeleteFeatureFlag(context.Context, *connect.Request[v1.DeleteFeatureFlagRequest]) (*connect.Response[v1.DeleteFeatureFlagResponse], error)
+	// ListSpamTokens returns the wallet spam deny/allow list.
+	ListSpamTokens(context.Context, *connect.Request[v1.ListSpamTokensRequest]) (*connect.Response[v1.ListSpamTokensResponse], error)
+	// SetSpamToken adds or replaces a deny/allow entry for a mint.
+	SetSpamToken(context.Context, *connect.Request[v1.SetSpamTokenRequest]) (*connect.Response[v1.SetSpamTokenResponse], error)
+	// DeleteSpamToken removes a mint from the deny/allow list.
+	DeleteSpamToken(context.Context, *connect.Request[v1.DeleteSpamTokenRequest]) (*connect.Response[v1.DeleteSpamTokenResponse], error)
+	// ListBlockedMints returns every blocklist entry for a mint, or all admin overrides when no mint is given.
+	ListBlockedMints(context.Context, *connect.Request[v1.ListBlockedMintsRequest]) (*connect.Response[v1.ListBlockedMintsResponse], error)
+	// SetBlocklistOverride blocks a mint, or allows it despite feed entries.
+	SetBlocklistOverride(context.Context, *connect.Request[v1.SetBlocklistOverrideRequest]) (*connect.Response[v1.SetBlocklistOverrideResponse], error)
+	// DeleteBlocklistOverride removes an admin block or allow, leaving only feed entries.
+	DeleteBlocklistOverride(context.Context, *connect.Request[v1.DeleteBlocklistOverrideRequest]) (*connect.Response[v1.DeleteBlocklistOverrideResponse], error)
+	// SyncBlocklist downloads every blocklist feed now instead of waiting for the next scheduled sync.
+	SyncBlocklist(context.Context, *connect.Request[v1.SyncBlocklistRequest]) (*connect.Response[v1.SyncBlocklistResponse], error)
+	// ListCoinDescriptions returns every localized description stored for a coin.
+	ListCoinDescriptions(context.Context, *connect.Request[v1.ListCoinDescriptionsRequest]) (*connect.Response[v1.ListCoinDescriptionsResponse], error)
+	// SetCoinDescription writes a coin description for a locale. Admin edits are kept across metadata refreshes.
+	SetCoinDescription(context.Context, *connect.Request[v1.SetCoinDescriptionRequest]) (*connect.Response[v1.SetCoinDescriptionResponse], error)
+	// DeleteCoinDescription removes a coin description for a locale.
+	DeleteCoinDescription(context.Context, *connect.Request[v1.DeleteCoinDescriptionRequest]) (*connect.Response[v1.DeleteCoinDescriptionResponse], error)
+	// ListSlowQueries returns the database queries over the slow query threshold, most total time first.
+	ListSlowQueries(context.Context, *connect.Request[v1.ListSlowQueriesRequest]) (*connect.Response[v1.ListSlowQueriesResponse], error)
+	// BroadcastNotification sends a system announcement to every wallet with a registered device,
+	// subject to each wallet's notification preferences.
+	BroadcastNotification(context.Context, *connect.Request[v1.BroadcastNotificationRequest]) (*connect.Response[v1.BroadcastNotificationResponse], error)
+	// ListNotificationDeliveries returns the notification delivery log, newest first.
+	ListNotificationDeliveries(context.Context, *connect.Request[v1.ListNotificationDeliveriesRequest]) (*connect.Response[v1.ListNotificationDeliveriesResponse], error)
+	// ListAllAnnouncements returns every announcement, including scheduled and expired ones.
+	ListAllAnnouncements(context.Context, *connect.Request[v1.ListAllAnnouncementsRequest]) (*connect.Response[v1.ListAllAnnouncementsResponse], error)
+	// CreateAnnouncement publishes an in-app announcement.
+	CreateAnnouncement(context.Context, *connect.Request[v1.CreateAnnouncementRequest]) (*connect.Response[v1.CreateAnnouncementResponse], error)
+	// UpdateAnnouncement replaces an announcement's content, schedule and targeting.
+	UpdateAnnouncement(context.Context, *connect.Request[v1.UpdateAnnouncementRequest]) (*connect.Response[v1.UpdateAnnouncementResponse], error)
+	// DeleteAnnouncement removes an announcement.
+	DeleteAnnouncement(context.Context, *connect.Request[v1.DeleteAnnouncementRequest]) (*connect.Response[v1.DeleteAnnouncementResponse], error)
+	// ListJobRuns returns the background job run log, newest first.
+	ListJobRuns(context.Context, *connect.Request[v1.ListJobRunsRequest]) (*connect.Response[v1.ListJobRunsResponse], error)
+	// GetOperationsOverview returns the operational state for the admin
+	// dashboard: upstream call totals, cache hit rates, recent job runs, RPC
+	// endpoint health, the enrichment queue and the platform fee balances.
+	GetOperationsOverview(context.Context, *connect.Request[v1.GetOperationsOverviewRequest]) (*connect.Response[v1.GetOperationsOverviewResponse], error)
+	// GetCoinHistory returns the recorded changes to a coin's metadata, newest first.
+	GetCoinHistory(context.Context, *connect.Request[v1.GetCoinHistoryRequest]) (*connect.Response[v1.GetCoinHistoryResponse], error)
+	// ListCoinOverrides returns the admin overrides of a coin, along with the source each metadata field was taken from.
+	ListCoinOverrides(context.Context, *connect.Request[v1.ListCoinOverridesRequest]) (*connect.Response[v1.ListCoinOverridesResponse], error)
+	// SetCoinOverride pins a coin metadata field to a value, winning over every provider until deleted.
+	SetCoinOverride(context.Context, *connect.Request[v1.SetCoinOverrideRequest]) (*connect.Response[v1.SetCoinOverrideResponse], error)
+	// DeleteCoinOverride removes an override; the next provider refresh replaces the value.
+	DeleteCoinOverride(context.Context, *connect.Request[v1.DeleteCoinOverrideRequest]) (*connect.Response[v1.DeleteCoinOverrideResponse], error)
+	// ListSearchSynonyms returns every coin search synonym.
+	ListSearchSynonyms(context.Context, *connect.Request[v1.ListSearchSynonymsRequest]) (*connect.Response[v1.ListSearchSynonymsResponse], error)
+	// SetSearchSynonym makes coin searches for a term run as another query, e.g. "dogwifhat" as "$WIF".
+	SetSearchSynonym(context.Context, *connect.Request[v1.SetSearchSynonymRequest]) (*connect.Response[v1.SetSearchSynonymResponse], error)
+	// DeleteSearchSynonym removes a coin search synonym.
+	DeleteSearchSynonym(context.Context, *connect.Request[v1.DeleteSearchSynonymRequest]) (*connect.Response[v1.DeleteSearchSynonymResponse], error)
+	// ListNaughtyWords returns a page of the words coin names and symbols are filtered on.
+	ListNaughtyWords(context.Context, *connect.Request[v1.ListNaughtyWordsRequest]) (*connect.Response[v1.ListNaughtyWordsResponse], error)
+	// AddNaughtyWords adds words to the filter, moving any already listed to the given language.
+	AddNaughtyWords(context.Context, *connect.Request[v1.AddNaughtyWordsRequest]) (*connect.Response[v1.AddNaughtyWordsResponse], error)
+	// RemoveNaughtyWords removes words from the filter.
+	RemoveNaughtyWords(context.Context, *connect.Request[v1.RemoveNaughtyWordsRequest]) (*connect.Response[v1.RemoveNaughtyWordsResponse], error)
+	// ImportNaughtyWords adds the LDNOOBW word lists of the given languages, keeping words already listed.
+	ImportNaughtyWords(context.Context, *connect.Request[v1.ImportNaughtyWordsRequest]) (*connect.Response[v1.ImportNaughtyWordsResponse], error)
+	// ExportNaughtyWords returns the filtered words a word per line, like the LDNOOBW lists.
+	ExportNaughtyWords(context.Context, *connect.Request[v1.ExportNaughtyWordsRequest]) (*connect.Response[v1.ExportNaughtyWordsResponse], error)
+	// ReloadNaughtyWords has every replica read the filtered words from the database again.
+	ReloadNaughtyWords(context.Context, *connect.Request[v1.ReloadNaughtyWordsRequest]) (*connect.Response[v1.ReloadNaughtyWordsResponse], error)
+	// GetTradeLimit returns a wallet's effective daily and weekly trade limits and what it has used of them.
+	GetTradeLimit(context.Context, *connect.Request[v1.GetTradeLimitRequest]) (*connect.Response[v1.GetTradeLimitResponse], error)
+	// SetTradeLimit overrides a wallet's trade limits, or exempts it from them.
+	SetTradeLimit(context.Context, *connect.Request[v1.SetTradeLimitRequest]) (*connect.Response[v1.SetTradeLimitResponse], error)
+	// DeleteTradeLimit removes a wallet's override, returning it to the configured limits.
+	DeleteTradeLimit(context.Context, *connect.Request[v1.DeleteTradeLimitRequest]) (*connect.Response[v1.DeleteTradeLimitResponse], error)
+	// RevalidateIcons starts re-validating the icons of a slice of the coins in the background.
+	// Broken icons are fetched again and replaced, or served placeholders when they still fail.
+	RevalidateIcons(context.Context, *connect.Request[v1.RevalidateIconsRequest]) (*connect.Response[v1.RevalidateIconsResponse], error)
+	// GetIconRevalidation returns the progress of an icon re-validation.
+	GetIconRevalidation(context.Context, *connect.Request[v1.GetIconRevalidationRequest]) (*connect.Response[v1.GetIconRevalidationResponse], error)
+	// DeleteRecord soft-deletes a coin, burn watch or user record, hiding it until it is
+	// restored or purged once the retention period has passed.
+	DeleteRecord(context.Context, *connect.Request[v1.DeleteRecordRequest]) (*connect.Response[v1.DeleteRecordResponse], error)
+	// RestoreRecord undoes the deletion of a record that hasn't been purged yet.
+	RestoreRecord(context.Context, *connect.Request[v1.RestoreRecordRequest]) (*connect.Response[v1.RestoreRecordResponse], error)
+	// ListDeletedRecords returns a page of the deleted records of a kind, most recently deleted first.
+	ListDeletedRecords(context.Context, *connect.Request[v1.ListDeletedRecordsRequest]) (*connect.Response[v1.ListDeletedRecordsResponse], error)
+}
+
+// NewAdminServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAdminServiceHandler(svc AdminServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	adminServiceMethods := v1.File_dankfolio_v1_admin_proto.Services().ByName("AdminService").Methods()
+	adminServiceListSettingsHandler := connect.NewUnaryHandler(
+		AdminServiceListSettingsProcedure,
+		svc.ListSettings,
+		connect.WithSchema(adminServiceMethods.ByName("ListSettings")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceUpdateSettingHandler := connect.NewUnaryHandler(
+		AdminServiceUpdateSettingProcedure,
+		svc.UpdateSetting,
+		connect.WithSchema(adminServiceMethods.ByName("UpdateSetting")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceResetSettingHandler := connect.NewUnaryHandler(
+		AdminServiceResetSettingProcedure,
+		svc.ResetSetting,
+		connect.WithSchema(adminServiceMethods.ByName("ResetSetting")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListFeatureFlagsHandler := connect.NewUnaryHandler(
+		AdminServiceListFeatureFlagsProcedure,
+		svc.ListFeatureFlags,
+		connect.WithSchema(adminServiceMethods.ByName("ListFeatureFlags")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceSetFeatureFlagHandler := connect.NewUnaryHandler(
+		AdminServiceSetFeatureFlagProcedure,
+		svc.SetFeatureFlag,
+		connect.WithSchema(adminServiceMethods.ByName("SetFeatureFlag")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceDeleteFeatureFlagHandler := connect.NewUnaryHandler(
+		AdminServiceDeleteFeatureFlagProcedure,
+		svc.DeleteFeatureFlag,
+		connect.WithSchema(adminServiceMethods.ByName("DeleteFeatureFlag")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListSpamTokensHandler := connect.NewUnaryHandler(
+		AdminServiceListSpamTokensProcedure,
+		svc.ListSpamTokens,
+		connect.WithSchema(adminServiceMethods.ByName("ListSpamTokens")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceSetSpamTokenHandler := connect.NewUnaryHandler(
+		AdminServiceSetSpamTokenProcedure,
+		svc.SetSpamToken,
+		connect.WithSchema(adminServiceMethods.ByName("SetSpamToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceDeleteSpamTokenHandler := connect.NewUnaryHandler(
+		AdminServiceDeleteSpamTokenProcedure,
+		svc.DeleteSpamToken,
+		connect.WithSchema(adminServiceMethods.ByName("DeleteSpamToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListBlockedMintsHandler := connect.NewUnaryHandler(
+		AdminServiceListBlockedMintsProcedure,
+		svc.ListBlockedMints,
+		connect.WithSchema(adminServiceMethods.ByName("ListBlockedMints")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceSetBlocklistOverrideHandler := connect.NewUnaryHandler(
+		AdminServiceSetBlocklistOverrideProcedure,
+		svc.SetBlocklistOverride,
+		connect.WithSchema(adminServiceMethods.ByName("SetBlocklistOverride")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceDeleteBlocklistOverrideHandler := connect.NewUnaryHandler(
+		AdminServiceDeleteBlocklistOverrideProcedure,
+		svc.DeleteBlocklistOverride,
+		connect.WithSchema(adminServiceMethods.ByName("DeleteBlocklistOverride")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceSyncBlocklistHandler := connect.NewUnaryHandler(
+		AdminServiceSyncBlocklistProcedure,
+		svc.SyncBlocklist,
+		connect.WithSchema(adminServiceMethods.ByName("SyncBlocklist")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListCoinDescriptionsHandler := connect.NewUnaryHandler(
+		AdminServiceListCoinDescriptionsProcedure,
+		svc.ListCoinDescriptions,
+		connect.WithSchema(adminServiceMethods.ByName("ListCoinDescriptions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceSetCoinDescriptionHandler := connect.NewUnaryHandler(
+		AdminServiceSetCoinDescriptionProcedure,
+		svc.SetCoinDescription,
+		connect.WithSchema(adminServiceMethods.ByName("SetCoinDescription")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceDeleteCoinDescriptionHandler := connect.NewUnaryHandler(
+		AdminServiceDeleteCoinDescriptionProcedure,
+		svc.DeleteCoinDescription,
+		connect.WithSchema(adminServiceMethods.ByName("DeleteCoinDescription")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListSlowQueriesHandler := connect.NewUnaryHandler(
+		AdminServiceListSlowQueriesProcedure,
+		svc.ListSlowQueries,
+		connect.WithSchema(adminServiceMethods.ByName("ListSlowQueries")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceBroadcastNotificationHandler := connect.NewUnaryHandler(
+		AdminServiceBroadcastNotificationProcedure,
+		svc.BroadcastNotification,
+		connect.WithSchema(adminServiceMethods.ByName("BroadcastNotification")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListNotificationDeliveriesHandler := connect.NewUnaryHandler(
+		AdminServiceListNotificationDeliveriesProcedure,
+		svc.ListNotificationDeliveries,
+		connect.WithSchema(adminServiceMethods.ByName("ListNotificationDeliveries")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListAllAnnouncementsHandler := connect.NewUnaryHandler(
+		AdminServiceListAllAnnouncementsProcedure,
+		svc.ListAllAnnouncements,
+		connect.WithSchema(adminServiceMethods.ByName("ListAllAnnouncements")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceCreateAnnouncementHandler := connect.NewUnaryHandler(
+		AdminServiceCreateAnnouncementProcedure,
+		svc.CreateAnnouncement,
+		connect.WithSchema(adminServiceMethods.ByName("CreateAnnouncement")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceUpdateAnnouncementHandler := connect.NewUnaryHandler(
+		AdminServiceUpdateAnnouncementProcedure,
+		svc.UpdateAnnouncement,
+		connect.WithSchema(adminServiceMethods.ByName("UpdateAnnouncement")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceDeleteAnnouncementHandler := connect.NewUnaryHandler(
+		AdminServiceDeleteAnnouncementProcedure,
+		svc.DeleteAnnouncement,
+		connect.WithSchema(adminServiceMethods.ByName("DeleteAnnouncement")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListJobRunsHandler := connect.NewUnaryHandler(
+		AdminServiceListJobRunsProcedure,
+		svc.ListJobRuns,
+		connect.WithSchema(adminServiceMethods.ByName("ListJobRuns")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceGetOperationsOverviewHandler := connect.NewUnaryHandler(
+		AdminServiceGetOperationsOverviewProcedure,
+		svc.GetOperationsOverview,
+		connect.WithSchema(adminServiceMethods.ByName("GetOperationsOverview")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceGetCoinHistoryHandler := connect.NewUnaryHandler(
+		AdminServiceGetCoinHistoryProcedure,
+		svc.GetCoinHistory,
+		connect.WithSchema(adminServiceMethods.ByName("GetCoinHistory")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListCoinOverridesHandler := connect.NewUnaryHandler(
+		AdminServiceListCoinOverridesProcedure,
+		svc.ListCoinOverrides,
+		connect.WithSchema(adminServiceMethods.ByName("ListCoinOverrides")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceSetCoinOverrideHandler := connect.NewUnaryHandler(
+		AdminServiceSetCoinOverrideProcedure,
+		svc.SetCoinOverride,
+		connect.WithSchema(adminServiceMethods.ByName("SetCoinOverride")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceDeleteCoinOverrideHandler := connect.NewUnaryHandler(
+		AdminServiceDeleteCoinOverrideProcedure,
+		svc.DeleteCoinOverride,
+		connect.WithSchema(adminServiceMethods.ByName("DeleteCoinOverride")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListSearchSynonymsHandler := connect.NewUnaryHandler(
+		AdminServiceListSearchSynonymsProcedure,
+		svc.ListSearchSynonyms,
+		connect.WithSchema(adminServiceMethods.ByName("ListSearchSynonyms")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceSetSearchSynonymHandler := connect.NewUnaryHandler(
+		AdminServiceSetSearchSynonymProcedure,
+		svc.SetSearchSynonym,
+		connect.WithSchema(adminServiceMethods.ByName("SetSearchSynonym")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceDeleteSearchSynonymHandler := connect.NewUnaryHandler(
+		AdminServiceDeleteSearchSynonymProcedure,
+		svc.DeleteSearchSynonym,
+		connect.WithSchema(adminServiceMethods.ByName("DeleteSearchSynonym")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListNaughtyWordsHandler := connect.NewUnaryHandler(
+		AdminServiceListNaughtyWordsProcedure,
+		svc.ListNaughtyWords,
+		connect.WithSchema(adminServiceMethods.ByName("ListNaughtyWords")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceAddNaughtyWordsHandler := connect.NewUnaryHandler(
+		AdminServiceAddNaughtyWordsProcedure,
+		svc.AddNaughtyWords,
+		connect.WithSchema(adminServiceMethods.ByName("AddNaughtyWords")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceRemoveNaughtyWordsHandler := connect.NewUnaryHandler(
+		AdminServiceRemoveNaughtyWordsProcedure,
+		svc.RemoveNaughtyWords,
+		connect.WithSchema(adminServiceMethods.ByName("RemoveNaughtyWords")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceImportNaughtyWordsHandler := connect.NewUnaryHandler(
+		AdminServiceImportNaughtyWordsProcedure,
+		svc.ImportNaughtyWords,
+		connect.WithSchema(adminServiceMethods.ByName("ImportNaughtyWords")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceExportNaughtyWordsHandler := connect.NewUnaryHandler(
+		AdminServiceExportNaughtyWordsProcedure,
+		svc.ExportNaughtyWords,
+		connect.WithSchema(adminServiceMethods.ByName("ExportNaughtyWords")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceReloadNaughtyWordsHandler := connect.NewUnaryHandler(
+		AdminServiceReloadNaughtyWordsProcedure,
+		svc.ReloadNaughtyWords,
+		connect.WithSchema(adminServiceMethods.ByName("ReloadNaughtyWords")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceGetTradeLimitHandler := connect.NewUnaryHandler(
+		AdminServiceGetTradeLimitProcedure,
+		svc.GetTradeLimit,
+		connect.WithSchema(adminServiceMethods.ByName("GetTradeLimit")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceSetTradeLimitHandler := connect.NewUnaryHandler(
+		AdminServiceSetTradeLimitProcedure,
+		svc.SetTradeLimit,
+		connect.WithSchema(adminServiceMethods.ByName("SetTradeLimit")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceDeleteTradeLimitHandler := connect.NewUnaryHandler(
+		AdminServiceDeleteTradeLimitProcedure,
+		svc.DeleteTradeLimit,
+		connect.WithSchema(adminServiceMethods.ByName("DeleteTradeLimit")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceRevalidateIconsHandler := connect.NewUnaryHandler(
+		AdminServiceRevalidateIconsProcedure,
+		svc.RevalidateIcons,
+		connect.WithSchema(adminServiceMethods.ByName("RevalidateIcons")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceGetIconRevalidationHandler := connect.NewUnaryHandler(
+		AdminServiceGetIconRevalidationProcedure,
+		svc.GetIconRevalidation,
+		connect.WithSchema(adminServiceMethods.ByName("GetIconRevalidation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceDeleteRecordHandler := connect.NewUnaryHandler(
+		AdminServiceDeleteRecordProcedure,
+		svc.DeleteRecord,
+		connect.WithSchema(adminServiceMethods.ByName("DeleteRecord")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceRestoreRecordHandler := connect.NewUnaryHandler(
+		AdminServiceRestoreRecordProcedure,
+		svc.RestoreRecord,
+		connect.WithSchema(adminServiceMethods.ByName("RestoreRecord")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListDeletedRecordsHandler := connect.NewUnaryHandler(
+		AdminServiceListDeletedRecordsProcedure,
+		svc.ListDeletedRecords,
+		connect.WithSchema(adminServiceMethods.ByName("ListDeletedRecords")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/dankfolio.v1.AdminService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AdminServiceListSettingsProcedure:
+			adminServiceListSettingsHandler.ServeHTTP(w, r)
+		case AdminServiceUpdateSettingProcedure:
+			adminServiceUpdateSettingHandler.ServeHTTP(w, r)
+		case AdminServiceResetSettingProcedure:
+			adminServiceResetSettingHandler.ServeHTTP(w, r)
+		case AdminServiceListFeatureFlagsProcedure:
+			adminServiceListFeatureFlagsHandler.ServeHTTP(w, r)
+		case AdminServiceSetFeatureFlagProcedure:
+			adminServiceSetFeatureFlagHandler.ServeHTTP(w, r)
+		case AdminServiceDeleteFeatureFlagProcedure:
+			adminServiceDeleteFeatureFlagHandler.ServeHTTP(w, r)
+		case AdminServiceListSpamTokensProcedure:
+			adminServiceListSpamTokensHandler.ServeHTTP(w, r)
+		case AdminServiceSetSpamTokenProcedure:
+			adminServiceSetSpamTokenHandler.ServeHTTP(w, r)
+		case AdminServiceDeleteSpamTokenProcedure:
+			adminServiceDeleteSpamTokenHandler.ServeHTTP(w, r)
+		case AdminServiceListBlockedMintsProcedure:
+			adminServiceListBlockedMintsHandler.ServeHTTP(w, r)
+		case AdminServiceSetBlocklistOverrideProcedure:
+			adminServiceSetBlocklistOverrideHandler.ServeHTTP(w, r)
+		case AdminServiceDeleteBlocklistOverrideProcedure:
+			adminServiceDeleteBlocklistOverrideHandler.ServeHTTP(w, r)
+		case AdminServiceSyncBlocklistProcedure:
+			adminServiceSyncBlocklistHandler.ServeHTTP(w, r)
+		case AdminServiceListCoinDescriptionsProcedure:
+			adminServiceListCoinDescriptionsHandler.ServeHTTP(w, r)
+		case AdminServiceSetCoinDescriptionProcedure:
+			adminServiceSetCoinDescriptionHandler.ServeHTTP(w, r)
+		case AdminServiceDeleteCoinDescriptionProcedure:
+			adminServiceDeleteCoinDescriptionHandler.ServeHTTP(w, r)
+		case AdminServiceListSlowQueriesProcedure:
+			adminServiceListSlowQueriesHandler.ServeHTTP(w, r)
+		case AdminServiceBroadcastNotificationProcedure:
+			adminServiceBroadcastNotificationHandler.ServeHTTP(w, r)
+		case AdminServiceListNotificationDeliveriesProcedure:
+			adminServiceListNotificationDeliveriesHandler.ServeHTTP(w, r)
+		case AdminServiceListAllAnnouncementsProcedure:
+			adminServiceListAllAnnouncementsHandler.ServeHTTP(w, r)
+		case AdminServiceCreateAnnouncementProcedure:
+			adminServiceCreateAnnouncementHandler.ServeHTTP(w, r)
+		case AdminServiceUpdateAnnouncementProcedure:
+			adminServiceUpdateAnnouncementHandler.ServeHTTP(w, r)
+		case AdminServiceDeleteAnnouncementProcedure:
+			adminServiceDeleteAnnouncementHandler.ServeHTTP(w, r)
+		case AdminServiceListJobRunsProcedure:
+			adminServiceListJobRunsHandler.ServeHTTP(w, r)
+		case AdminServiceGetOperationsOverviewProcedure:
+			adminServiceGetOperationsOverviewHandler.ServeHTTP(w, r)
+		case AdminServiceGetCoinHistoryProcedure:
+			adminServiceGetCoinHistoryHandler.ServeHTTP(w, r)
+		case AdminServiceListCoinOverridesProcedure:
+			adminServiceListCoinOverridesHandler.ServeHTTP(w, r)
+		case AdminServiceSetCoinOverrideProcedure:
+			adminServiceSetCoinOverrideHandler.ServeHTTP(w, r)
+		case AdminServiceDeleteCoinOverrideProcedure:
+			adminServiceDeleteCoinOverrideHandler.ServeHTTP(w, r)
+		case AdminServiceListSearchSynonymsProcedure:
+			adminServiceListSearchSynonymsHandler.ServeHTTP(w, r)
+		case AdminServiceSetSearchSynonymProcedure:
+			adminServiceSetSearchSynonymHandler.ServeHTTP(w, r)
+		case AdminServiceDeleteSearchSynonymProcedure:
+			adminServiceDeleteSearchSynonymHandler.ServeHTTP(w, r)
+		case AdminServiceListNaughtyWordsProcedure:
+			adminServiceListNaughtyWordsHandler.ServeHTTP(w, r)
+		case AdminServiceAddNaughtyWordsProcedure:
+			adminServiceAddNaughtyWordsHandler.ServeHTTP(w, r)
+		case AdminServiceRemoveNaughtyWordsProcedure:
+			adminServiceRemoveNaughtyWordsHandler.ServeHTTP(w, r)
+		case AdminServiceImportNaughtyWordsProcedure:
+			adminServiceImportNaughtyWordsHandler.ServeHTTP(w, r)
+		case AdminServiceExportNaughtyWordsProcedure:
+			adminServiceExportNaughtyWordsHandler.ServeHTTP(w, r)
+		case AdminServiceReloadNaughtyWordsProcedure:
+			adminServiceReloadNaughtyWordsHandler.ServeHTTP(w, r)
+		case AdminServiceGetTradeLimitProcedure:
+			adminServiceGetTradeLimitHandler.ServeHTTP(w, r)
+		case AdminServiceSetTradeLimitProcedure:
+			adminServiceSetTradeLimitHandler.ServeHTTP(w, r)
+		case AdminServiceDeleteTradeLimitProcedure:
+			adminServiceDeleteTradeLimitHandler.ServeHTTP(w, r)
+		case AdminServiceRevalidateIconsProcedure:
+			adminServiceRevalidateIconsHandler.ServeHTTP(w, r)
+		case AdminServiceGetIconRevalidationProcedure:
+			adminServiceGetIconRevalidationHandler.ServeHTTP(w, r)
+		case AdminServiceDeleteRecordProcedure:
+			adminServiceDeleteRecordHandler.ServeHTTP(w, r)
+		case AdminServiceRestoreRecordProcedure:
+			adminServiceRestoreRecordHandler.ServeHTTP(w, r)
+		case AdminServiceListDeletedRecordsProcedure:
+			adminServiceListDeletedRecordsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAdminServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAdminServiceHandler struct{}
+
+func (UnimplementedAdminServiceHandler) ListSettings(context.Context, *connect.Request[v1.ListSettingsRequest]) (*connect.Response[v1.ListSettingsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.AdminService.ListSettings is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) UpdateSetting(context.Context, *connect.Request[v1.UpdateSettingRequest]) (*connect.Response[v1.UpdateSettingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.AdminService.UpdateSetting is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ResetSetting(context.Context, *connect.Request[v1.ResetSettingRequest]) (*connect.Response[v1.ResetSettingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.AdminService.ResetSetting is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListFeatureFlags(context.Context, *connect.Request[v1.ListFeatureFlagsRequest]) (*connect.Response[v1.ListFeatureFlagsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.AdminService.ListFeatureFlags is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) SetFeatureFlag(context.Context, *connect.Request[v1.SetFeatureFlagRequest]) (*connect.Response[v1.SetFeatureFlagResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.AdminService.SetFeatureFlag is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) DeleteFeatureFlag(context.Context, *connect.Request[v1.DeleteFeatureFlagRequest]) (*connect.Response[v1.DeleteFeatureFlagResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.AdminService.DeleteFeatureFlag is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListSpamTokens(context.Context, *connect.Request[v1.ListSpamTokensRequest]) (*connect.Response[v1.ListSpamTokensResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.AdminService.ListSpamTokens is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) SetSpamToken(context.Context, *connect.Request[v1.SetSpamTokenRequest]) (*connect.Response[v1.SetSpamTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.AdminService.SetSpamToken is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) DeleteSpamToken(context.Context, *connect.Request[v1.DeleteSpamTokenRequest]) (*connect.Response[v1.DeleteSpamTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.AdminService.DeleteSpamToken is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListBlockedMints(context.Context, *connect.Request[v1.ListBlockedMintsRequest]) (*connect.Response[v1.ListBlockedMintsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.AdminService.ListBlockedMints is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) SetBlocklistOverride(context.Context, *connect.Request[v1.SetBlocklistOverrideRequest]) (*connect.Response[v1.SetBlocklistOverrideResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.AdminService.SetBlocklistOverride is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) DeleteBlocklistOverride(context.Context, *connect.Request[v1.DeleteBlocklistOverrideRequest]) (*connect.Response[v1.DeleteBlocklistOverrideResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.AdminService.DeleteBlocklistOverride is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) SyncBlocklist(context.Context, *connect.Request[v1.SyncBlocklistRequest]) (*connect.Response[v1.SyncBlocklistResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.AdminService.SyncBlocklist is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListCoinDescriptions(context.Context, *connect.Request[v1.ListCoinDescriptionsRequest]) (*connect.Response[v1.ListCoinDescriptionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.AdminService.ListCoinDescriptions is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) SetCoinDescription(context.Context, *connect.Request[v1.SetCoinDescriptionRequest]) (*connect.Response[v1.SetCoinDescriptionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.AdminService.SetCoinDescription is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) DeleteCoinDescription(context.Context, *connect.Request[v1.DeleteCoinDescriptionRequest]) (*connect.Response[v1.DeleteCoinDescriptionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.AdminService.DeleteCoinDescription is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListSlowQueries(context.Context, *connect.Request[v1.ListSlowQueriesRequest]) (*connect.Response[v1.ListSlowQueriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.AdminService.ListSlowQueries is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) BroadcastNotification(context.Context, *connect.Request[v1.BroadcastNotificationRequest]) (*connect.Response[v1.BroadcastNotificationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.AdminService.BroadcastNotification is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListNotificationDeliveries(context.Context, *connect.Request[v1.ListNotificationDeliveriesRequest]) (*connect.Response[v1.ListNotificationDeliveriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.AdminService.ListNotificationDeliveries is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListAllAnnouncements(context.Context, *connect.Request[v1.ListAllAnnouncementsRequest]) (*connect.Response[v1.ListAllAnnouncementsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.AdminService.ListAllAnnouncements is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) CreateAnnouncement(context.Context, *connect.Request[v1.CreateAnnouncementRequest]) (*connect.Response[v1.CreateAnnouncementResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.AdminService.CreateAnnouncement is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) UpdateAnnouncement(context.Context, *connect.Request[v1.UpdateAnnouncementRequest]) (*connect.Response[v1.UpdateAnnouncementResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.AdminService.UpdateAnnouncement is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) DeleteAnnouncement(context.Context, *connect.Request[v1.DeleteAnnouncementRequest]) (*connect.Response[v1.DeleteAnnouncementResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.AdminService.DeleteAnnouncement is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListJobRuns(context.Context, *connect.Request[v1.ListJobRunsRequest]) (*connect.Response[v1.ListJobRunsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.AdminService.ListJobRuns is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) GetOperationsOverview(context.Context, *connect.Request[v1.GetOperationsOverviewRequest]) (*connect.Response[v1.GetOperationsOverviewResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.AdminService.GetOperationsOverview is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) GetCoinHistory(context.Context, *connect.Request[v1.GetCoinHistoryRequest]) (*connect.Response[v1.GetCoinHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.AdminService.GetCoinHistory is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListCoinOverrides(context.Context, *connect.Request[v1.ListCoinOverridesRequest]) (*connect.Response[v1.ListCoinOverridesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.AdminService.ListCoinOverrides is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) SetCoinOverride(context.Context, *connect.Request[v1.SetCoinOverrideRequest]) (*connect.Response[v1.SetCoinOverrideResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.AdminService.SetCoinOverride is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) DeleteCoinOverride(context.Context, *connect.Request[v1.DeleteCoinOverrideRequest]) (*connect.Response[v1.DeleteCoinOverrideResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.AdminService.DeleteCoinOverride is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListSearchSynonyms(context.Context, *connect.Request[v1.ListSearchSynonymsRequest]) (*connect.Response[v1.ListSearchSynonymsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.AdminService.ListSearchSynonyms is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) SetSearchSynonym(context.Context, *connect.Request[v1.SetSearchSynonymRequest]) (*connect.Response[v1.SetSearchSynonymResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.AdminService.SetSearchSynonym is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) DeleteSearchSynonym(context.Context, *connect.Request[v1.DeleteSearchSynonymRequest]) (*connect.Response[v1.DeleteSearchSynonymResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.AdminService.DeleteSearchSynonym is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListNaughtyWords(context.Context, *connect.Request[v1.ListNaughtyWordsRequest]) (*connect.Response[v1.ListNaughtyWordsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.AdminService.ListNaughtyWords is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) AddNaughtyWords(context.Context, *connect.Request[v1.AddNaughtyWordsRequest]) (*connect.Response[v1.AddNaughtyWordsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.AdminService.AddNaughtyWords is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) RemoveNaughtyWords(context.Context, *connect.Request[v1.RemoveNaughtyWordsRequest]) (*connect.Response[v1.RemoveNaughtyWordsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.AdminService.RemoveNaughtyWords is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ImportNaughtyWords(context.Context, *connect.Request[v1.ImportNaughtyWordsRequest]) (*connect.Response[v1.ImportNaughtyWordsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.AdminService.ImportNaughtyWords is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ExportNaughtyWords(context.Context, *connect.Request[v1.ExportNaughtyWordsRequest]) (*connect.Response[v1.ExportNaughtyWordsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.AdminService.ExportNaughtyWords is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ReloadNaughtyWords(context.Context, *connect.Request[v1.ReloadNaughtyWordsRequest]) (*connect.Response[v1.ReloadNaughtyWordsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.AdminService.ReloadNaughtyWords is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) GetTradeLimit(context.Context, *connect.Request[v1.GetTradeLimitRequest]) (*connect.Response[v1.GetTradeLimitResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.AdminService.GetTradeLimit is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) SetTradeLimit(context.Context, *connect.Request[v1.SetTradeLimitRequest]) (*connect.Response[v1.SetTradeLimitResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.AdminService.SetTradeLimit is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) DeleteTradeLimit(context.Context, *connect.Request[v1.DeleteTradeLimitRequest]) (*connect.Response[v1.DeleteTradeLimitResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.AdminService.DeleteTradeLimit is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) RevalidateIcons(context.Context, *connect.Request[v1.RevalidateIconsRequest]) (*connect.Response[v1.RevalidateIconsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.AdminService.RevalidateIcons is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) GetIconRevalidation(context.Context, *connect.Request[v1.GetIconRevalidationRequest]) (*connect.Response[v1.GetIconRevalidationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.AdminService.GetIconRevalidation is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) DeleteRecord(context.Context, *connect.Request[v1.DeleteRecordRequest]) (*connect.Response[v1.DeleteRecordResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.AdminService.DeleteRecord is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) RestoreRecord(context.Context, *connect.Request[v1.RestoreRecordRequest]) (*connect.Response[v1.RestoreRecordResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.AdminService.RestoreRecord is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListDeletedRecords(context.Context, *connect.Request[v1.ListDeletedRecordsRequest]) (*connect.Response[v1.ListDeletedRecordsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.AdminService.ListDeletedRecords is not implemented"))
+}
@@ -0,0 +1,144 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: dankfolio/v1/announcement.proto
+
+package v1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// AnnouncementServiceName is the fully-qualified name of the AnnouncementService service.
+	AnnouncementServiceName = "dankfolio.v1.AnnouncementService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AnnouncementServiceListAnnouncementsProcedure is the fully-qualified name of the
+	// AnnouncementService's ListAnnouncements RPC.
+	AnnouncementServiceListAnnouncementsProcedure = "/dankfolio.v1.AnnouncementService/ListAnnouncements"
+	// AnnouncementServiceMarkAnnouncementsReadProcedure is the fully-qualified name of the
+	// AnnouncementService's MarkAnnouncementsRead RPC.
+	AnnouncementServiceMarkAnnouncementsReadProcedure = "/dankfolio.v1.AnnouncementService/MarkAnnouncementsRead"
+)
+
+// AnnouncementServiceClient is a client for the dankfolio.v1.AnnouncementService service.
+type AnnouncementServiceClient interface {
+	// ListAnnouncements returns the live announcements for the app version, newest first,
+	// with the wallet's read state.
+	ListAnnouncements(context.Context, *connect.Request[v1.ListAnnouncementsRequest]) (*connect.Response[v1.ListAnnouncementsResponse], error)
+	// MarkAnnouncementsRead marks announcements read for a wallet.
+	MarkAnnouncementsRead(context.Context, *connect.Request[v1.MarkAnnouncementsReadRequest]) (*connect.Response[v1.MarkAnnouncementsReadResponse], error)
+}
+
+// NewAnnouncementServiceClient constructs a client for the dankfolio.v1.AnnouncementService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAnnouncementServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AnnouncementServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	announcementServiceMethods := v1.File_dankfolio_v1_announcement_proto.Services().ByName("AnnouncementService").Methods()
+	return &announcementServiceClient{
+		listAnnouncements: connect.NewClient[v1.ListAnnouncementsRequest, v1.ListAnnouncementsResponse](
+			httpClient,
+			baseURL+AnnouncementServiceListAnnouncementsProcedure,
+			connect.WithSchema(announcementServiceMethods.ByName("ListAnnouncements")),
+			connect.WithClientOptions(opts...),
+		),
+		markAnnouncementsRead: connect.NewClient[v1.MarkAnnouncementsReadRequest, v1.MarkAnnouncementsReadResponse](
+			httpClient,
+			baseURL+AnnouncementServiceMarkAnnouncementsReadProcedure,
+			connect.WithSchema(announcementServiceMethods.ByName("MarkAnnouncementsRead")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// announcementServiceClient implements AnnouncementServiceClient.
+type announcementServiceClient struct {
+	listAnnouncements     *connect.Client[v1.ListAnnouncementsRequest, v1.ListAnnouncementsResponse]
+	markAnnouncementsRead *connect.Client[v1.MarkAnnouncementsReadRequest, v1.MarkAnnouncementsReadResponse]
+}
+
+// ListAnnouncements calls dankfolio.v1.AnnouncementService.ListAnnouncements.
+func (c *announcementServiceClient) ListAnnouncements(ctx context.Context, req *connect.Request[v1.ListAnnouncementsRequest]) (*connect.Response[v1.ListAnnouncementsResponse], error) {
+	return c.listAnnouncements.CallUnary(ctx, req)
+}
+
+// MarkAnnouncementsRead calls dankfolio.v1.AnnouncementService.MarkAnnouncementsRead.
+func (c *announcementServiceClient) MarkAnnouncementsRead(ctx context.Context, req *connect.Request[v1.MarkAnnouncementsReadRequest]) (*connect.Response[v1.MarkAnnouncementsReadResponse], error) {
+	return c.markAnnouncementsRead.CallUnary(ctx, req)
+}
+
+// AnnouncementServiceHandler is an implementation of the dankfolio.v1.AnnouncementService service.
+type AnnouncementServiceHandler interface {
+	// ListAnnouncements returns the live announcements for the app version, newest first,
+	// with the wallet's read state.
+	ListAnnouncements(context.Context, *connect.Request[v1.ListAnnouncementsRequest]) (*connect.Response[v1.ListAnnouncementsResponse], error)
+	// MarkAnnouncementsRead marks announcements read for a wallet.
+	MarkAnnouncementsRead(context.Context, *connect.Request[v1.MarkAnnouncementsReadRequest]) (*connect.Response[v1.MarkAnnouncementsReadResponse], error)
+}
+
+// NewAnnouncementServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAnnouncementServiceHandler(svc AnnouncementServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	announcementServiceMethods := v1.File_dankfolio_v1_announcement_proto.Services().ByName("AnnouncementService").Methods()
+	announcementServiceListAnnouncementsHandler := connect.NewUnaryHandler(
+		AnnouncementServiceListAnnouncementsProcedure,
+		svc.ListAnnouncements,
+		connect.WithSchema(announcementServiceMethods.ByName("ListAnnouncements")),
+		connect.WithHandlerOptions(opts...),
+	)
+	announcementServiceMarkAnnouncementsReadHandler := connect.NewUnaryHandler(
+		AnnouncementServiceMarkAnnouncementsReadProcedure,
+		svc.MarkAnnouncementsRead,
+		connect.WithSchema(announcementServiceMethods.ByName("MarkAnnouncementsRead")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/dankfolio.v1.AnnouncementService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AnnouncementServiceListAnnouncementsProcedure:
+			announcementServiceListAnnouncementsHandler.ServeHTTP(w, r)
+		case AnnouncementServiceMarkAnnouncementsReadProcedure:
+			announcementServiceMarkAnnouncementsReadHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAnnouncementServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAnnouncementServiceHandler struct{}
+
+func (UnimplementedAnnouncementServiceHandler) ListAnnouncements(context.Context, *connect.Request[v1.ListAnnouncementsRequest]) (*connect.Response[v1.ListAnnouncementsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.AnnouncementService.ListAnnouncements is not implemented"))
+}
+
+func (UnimplementedAnnouncementServiceHandler) MarkAnnouncementsRead(context.Context, *connect.Request[v1.MarkAnnouncementsReadRequest]) (*connect.Response[v1.MarkAnnouncementsReadResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.AnnouncementService.MarkAnnouncementsRead is not implemented"))
+}
@@ -0,0 +1,111 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: dankfolio/v1/app_config.proto
+
+package v1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// AppConfigServiceName is the fully-qualified name of the AppConfigService service.
+	AppConfigServiceName = "dankfolio.v1.AppConfigService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AppConfigServiceGetAppConfigProcedure is the fully-qualified name of the AppConfigService's
+	// GetAppConfig RPC.
+	AppConfigServiceGetAppConfigProcedure = "/dankfolio.v1.AppConfigService/GetAppConfig"
+)
+
+// AppConfigServiceClient is a client for the dankfolio.v1.AppConfigService service.
+type AppConfigServiceClient interface {
+	// GetAppConfig returns the minimum supported app version, feature kill switches and client defaults.
+	GetAppConfig(context.Context, *connect.Request[v1.GetAppConfigRequest]) (*connect.Response[v1.GetAppConfigResponse], error)
+}
+
+// NewAppConfigServiceClient constructs a client for the dankfolio.v1.AppConfigService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAppConfigServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AppConfigServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	appConfigServiceMethods := v1.File_dankfolio_v1_app_config_proto.Services().ByName("AppConfigService").Methods()
+	return &appConfigServiceClient{
+		getAppConfig: connect.NewClient[v1.GetAppConfigRequest, v1.GetAppConfigResponse](
+			httpClient,
+			baseURL+AppConfigServiceGetAppConfigProcedure,
+			connect.WithSchema(appConfigServiceMethods.ByName("GetAppConfig")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// appConfigServiceClient implements AppConfigServiceClient.
+type appConfigServiceClient struct {
+	getAppConfig *connect.Client[v1.GetAppConfigRequest, v1.GetAppConfigResponse]
+}
+
+// GetAppConfig calls dankfolio.v1.AppConfigService.GetAppConfig.
+func (c *appConfigServiceClient) GetAppConfig(ctx context.Context, req *connect.Request[v1.GetAppConfigRequest]) (*connect.Response[v1.GetAppConfigResponse], error) {
+	return c.getAppConfig.CallUnary(ctx, req)
+}
+
+// AppConfigServiceHandler is an implementation of the dankfolio.v1.AppConfigService service.
+type AppConfigServiceHandler interface {
+	// GetAppConfig returns the minimum supported app version, feature kill switches and client defaults.
+	GetAppConfig(context.Context, *connect.Request[v1.GetAppConfigRequest]) (*connect.Response[v1.GetAppConfigResponse], error)
+}
+
+// NewAppConfigServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAppConfigServiceHandler(svc AppConfigServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	appConfigServiceMethods := v1.File_dankfolio_v1_app_config_proto.Services().ByName("AppConfigService").Methods()
+	appConfigServiceGetAppConfigHandler := connect.NewUnaryHandler(
+		AppConfigServiceGetAppConfigProcedure,
+		svc.GetAppConfig,
+		connect.WithSchema(appConfigServiceMethods.ByName("GetAppConfig")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/dankfolio.v1.AppConfigService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AppConfigServiceGetAppConfigProcedure:
+			appConfigServiceGetAppConfigHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAppConfigServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAppConfigServiceHandler struct{}
+
+func (UnimplementedAppConfigServiceHandler) GetAppConfig(context.Context, *connect.Request[v1.GetAppConfigRequest]) (*connect.Response[v1.GetAppConfigResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.AppConfigService.GetAppConfig is not implemented"))
+}
@@ -59,6 +59,18 @@ const (
 	// CoinServiceGetXStocksCoinsProcedure is the fully-qualified name of the CoinService's
 	// GetXStocksCoins RPC.
 	CoinServiceGetXStocksCoinsProcedure = "/dankfolio.v1.CoinService/GetXStocksCoins"
+	// CoinServiceGetCoinTradeStatsProcedure is the fully-qualified name of the CoinService's
+	// GetCoinTradeStats RPC.
+	CoinServiceGetCoinTradeStatsProcedure = "/dankfolio.v1.CoinService/GetCoinTradeStats"
+	// CoinServiceGetCoinTopTradersProcedure is the fully-qualified name of the CoinService's
+	// GetCoinTopTraders RPC.
+	CoinServiceGetCoinTopTradersProcedure = "/dankfolio.v1.CoinService/GetCoinTopTraders"
+	// CoinServiceGetMarketOverviewProcedure is the fully-qualified name of the CoinService's
+	// GetMarketOverview RPC.
+	CoinServiceGetMarketOverviewProcedure = "/dankfolio.v1.CoinService/GetMarketOverview"
+	// CoinServiceGetRecentlyViewedProcedure is the fully-qualified name of the CoinService's
+	// GetRecentlyViewed RPC.
+	CoinServiceGetRecentlyViewedProcedure = "/dankfolio.v1.CoinService/GetRecentlyViewed"
 )
 
 // CoinServiceClient is a client for the dankfolio.v1.CoinService service.
@@ -79,6 +91,17 @@ type CoinServiceClient interface {
 	GetTrendingCoins(context.Context, *connect.Request[v1.GetTrendingCoinsRequest]) (*connect.Response[v1.GetAvailableCoinsResponse], error)
 	GetTopGainersCoins(context.Context, *connect.Request[v1.GetTopGainersCoinsRequest]) (*connect.Response[v1.GetAvailableCoinsResponse], error)
 	GetXStocksCoins(context.Context, *connect.Request[v1.GetXStocksCoinsRequest]) (*connect.Response[v1.GetAvailableCoinsResponse], error)
+	// GetCoinTradeStats returns buy/sell activity for a coin over 5m, 1h and 24h windows
+	GetCoinTradeStats(context.Context, *connect.Request[v1.GetCoinTradeStatsRequest]) (*connect.Response[v1.GetCoinTradeStatsResponse], error)
+	// GetCoinTopTraders returns the largest holders and most profitable recent traders of a coin
+	// among wallets trading through dankfolio
+	GetCoinTopTraders(context.Context, *connect.Request[v1.GetCoinTopTradersRequest]) (*connect.Response[v1.GetCoinTopTradersResponse], error)
+	// GetMarketOverview returns the home screen summary: SOL price, total memecoin volume,
+	// top gainers and losers, and new listings in the last 24h
+	GetMarketOverview(context.Context, *connect.Request[v1.GetMarketOverviewRequest]) (*connect.Response[v1.GetMarketOverviewResponse], error)
+	// GetRecentlyViewed returns the coins the caller opened most recently, newest first.
+	// Views are tracked for callers identified by a wallet; others get an empty list
+	GetRecentlyViewed(context.Context, *connect.Request[v1.GetRecentlyViewedRequest]) (*connect.Response[v1.GetRecentlyViewedResponse], error)
 }
 
 // NewCoinServiceClient constructs a client for the dankfolio.v1.CoinService service. By default, it
@@ -152,6 +175,30 @@ func NewCoinServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(coinServiceMethods.ByName("GetXStocksCoins")),
 			connect.WithClientOptions(opts...),
 		),
+		getCoinTradeStats: connect.NewClient[v1.GetCoinTradeStatsRequest, v1.GetCoinTradeStatsResponse](
+			httpClient,
+			baseURL+CoinServiceGetCoinTradeStatsProcedure,
+			connect.WithSchema(coinServiceMethods.ByName("GetCoinTradeStats")),
+			connect.WithClientOptions(opts...),
+		),
+		getCoinTopTraders: connect.NewClient[v1.GetCoinTopTradersRequest, v1.GetCoinTopTradersResponse](
+			httpClient,
+			baseURL+CoinServiceGetCoinTopTradersProcedure,
+			connect.WithSchema(coinServiceMethods.ByName("GetCoinTopTraders")),
+			connect.WithClientOptions(opts...),
+		),
+		getMarketOverview: connect.NewClient[v1.GetMarketOverviewRequest, v1.GetMarketOverviewResponse](
+			httpClient,
+			baseURL+CoinServiceGetMarketOverviewProcedure,
+			connect.WithSchema(coinServiceMethods.ByName("GetMarketOverview")),
+			connect.WithClientOptions(opts...),
+		),
+		getRecentlyViewed: connect.NewClient[v1.GetRecentlyViewedRequest, v1.GetRecentlyViewedResponse](
+			httpClient,
+			baseURL+CoinServiceGetRecentlyViewedProcedure,
+			connect.WithSchema(coinServiceMethods.ByName("GetRecentlyViewed")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -167,6 +214,10 @@ type coinServiceClient struct {
 	getTrendingCoins    *connect.Client[v1.GetTrendingCoinsRequest, v1.GetAvailableCoinsResponse]
 	getTopGainersCoins  *connect.Client[v1.GetTopGainersCoinsRequest, v1.GetAvailableCoinsResponse]
 	getXStocksCoins     *connect.Client[v1.GetXStocksCoinsRequest, v1.GetAvailableCoinsResponse]
+	getCoinTradeStats   *connect.Client[v1.GetCoinTradeStatsRequest, v1.GetCoinTradeStatsResponse]
+	getCoinTopTraders   *connect.Client[v1.GetCoinTopTradersRequest, v1.GetCoinTopTradersResponse]
+	getMarketOverview   *connect.Client[v1.GetMarketOverviewRequest, v1.GetMarketOverviewResponse]
+	getRecentlyViewed   *connect.Client[v1.GetRecentlyViewedRequest, v1.GetRecentlyViewedResponse]
 }
 
 // GetAvailableCoins calls dankfolio.v1.CoinService.GetAvailableCoins.
@@ -219,6 +270,26 @@ func (c *coinServiceClient) GetXStocksCoins(ctx context.Context, req *connect.Re
 	return c.getXStocksCoins.CallUnary(ctx, req)
 }
 
+// GetCoinTradeStats calls dankfolio.v1.CoinService.GetCoinTradeStats.
+func (c *coinServiceClient) GetCoinTradeStats(ctx context.Context, req *connect.Request[v1.GetCoinTradeStatsRequest]) (*connect.Response[v1.GetCoinTradeStatsResponse], error) {
+	return c.getCoinTradeStats.CallUnary(ctx, req)
+}
+
+// GetCoinTopTraders calls dankfolio.v1.CoinService.GetCoinTopTraders.
+func (c *coinServiceClient) GetCoinTopTraders(ctx context.Context, req *connect.Request[v1.GetCoinTopTradersRequest]) (*connect.Response[v1.GetCoinTopTradersResponse], error) {
+	return c.getCoinTopTraders.CallUnary(ctx, req)
+}
+
+// GetMarketOverview calls dankfolio.v1.CoinService.GetMarketOverview.
+func (c *coinServiceClient) GetMarketOverview(ctx context.Context, req *connect.Request[v1.GetMarketOverviewRequest]) (*connect.Response[v1.GetMarketOverviewResponse], error) {
+	return c.getMarketOverview.CallUnary(ctx, req)
+}
+
+// GetRecentlyViewed calls dankfolio.v1.CoinService.GetRecentlyViewed.
+func (c *coinServiceClient) GetRecentlyViewed(ctx context.Context, req *connect.Request[v1.GetRecentlyViewedRequest]) (*connect.Response[v1.GetRecentlyViewedResponse], error) {
+	return c.getRecentlyViewed.CallUnary(ctx, req)
+}
+
 // CoinServiceHandler is an implementation of the dankfolio.v1.CoinService service.
 type CoinServiceHandler interface {
 	// GetAvailableCoins returns a list of available coins
@@ -237,6 +308,17 @@ type CoinServiceHandler interface {
 	GetTrendingCoins(context.Context, *connect.Request[v1.GetTrendingCoinsRequest]) (*connect.Response[v1.GetAvailableCoinsResponse], error)
 	GetTopGainersCoins(context.Context, *connect.Request[v1.GetTopGainersCoinsRequest]) (*connect.Response[v1.GetAvailableCoinsResponse], error)
 	GetXStocksCoins(context.Context, *connect.Request[v1.GetXStocksCoinsRequest]) (*connect.Response[v1.GetAvailableCoinsResponse], error)
+	// GetCoinTradeStats returns buy/sell activity for a coin over 5m, 1h and 24h windows
+	GetCoinTradeStats(context.Context, *connect.Request[v1.GetCoinTradeStatsRequest]) (*connect.Response[v1.GetCoinTradeStatsResponse], error)
+	// GetCoinTopTraders returns the largest holders and most profitable recent traders of a coin
+	// among wallets trading through dankfolio
+	GetCoinTopTraders(context.Context, *connect.Request[v1.GetCoinTopTradersRequest]) (*connect.Response[v1.GetCoinTopTradersResponse], error)
+	// GetMarketOverview returns the home screen summary: SOL price, total memecoin volume,
+	// top gainers and losers, and new listings in the last 24h
+	GetMarketOverview(context.Context, *connect.Request[v1.GetMarketOverviewRequest]) (*connect.Response[v1.GetMarketOverviewResponse], error)
+	// GetRecentlyViewed returns the coins the caller opened most recently, newest first.
+	// Views are tracked for callers identified by a wallet; others get an empty list
+	GetRecentlyViewed(context.Context, *connect.Request[v1.GetRecentlyViewedRequest]) (*connect.Response[v1.GetRecentlyViewedResponse], error)
 }
 
 // NewCoinServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -306,6 +388,30 @@ func NewCoinServiceHandler(svc CoinServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(coinServiceMethods.ByName("GetXStocksCoins")),
 		connect.WithHandlerOptions(opts...),
 	)
+	coinServiceGetCoinTradeStatsHandler := connect.NewUnaryHandler(
+		CoinServiceGetCoinTradeStatsProcedure,
+		svc.GetCoinTradeStats,
+		connect.WithSchema(coinServiceMethods.ByName("GetCoinTradeStats")),
+		connect.WithHandlerOptions(opts...),
+	)
+	coinServiceGetCoinTopTradersHandler := connect.NewUnaryHandler(
+		CoinServiceGetCoinTopTradersProcedure,
+		svc.GetCoinTopTraders,
+		connect.WithSchema(coinServiceMethods.ByName("GetCoinTopTraders")),
+		connect.WithHandlerOptions(opts...),
+	)
+	coinServiceGetMarketOverviewHandler := connect.NewUnaryHandler(
+		CoinServiceGetMarketOverviewProcedure,
+		svc.GetMarketOverview,
+		connect.WithSchema(coinServiceMethods.ByName("GetMarketOverview")),
+		connect.WithHandlerOptions(opts...),
+	)
+	coinServiceGetRecentlyViewedHandler := connect.NewUnaryHandler(
+		CoinServiceGetRecentlyViewedProcedure,
+		svc.GetRecentlyViewed,
+		connect.WithSchema(coinServiceMethods.ByName("GetRecentlyViewed")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/dankfolio.v1.CoinService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case CoinServiceGetAvailableCoinsProcedure:
@@ -328,6 +434,14 @@ func NewCoinServiceHandler(svc CoinServiceHandler, opts ...connect.HandlerOption
 			coinServiceGetTopGainersCoinsHandler.ServeHTTP(w, r)
 		case CoinServiceGetXStocksCoinsProcedure:
 			coinServiceGetXStocksCoinsHandler.ServeHTTP(w, r)
+		case CoinServiceGetCoinTradeStatsProcedure:
+			coinServiceGetCoinTradeStatsHandler.ServeHTTP(w, r)
+		case CoinServiceGetCoinTopTradersProcedure:
+			coinServiceGetCoinTopTradersHandler.ServeHTTP(w, r)
+		case CoinServiceGetMarketOverviewProcedure:
+			coinServiceGetMarketOverviewHandler.ServeHTTP(w, r)
+		case CoinServiceGetRecentlyViewedProcedure:
+			coinServiceGetRecentlyViewedHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -376,3 +490,19 @@ func (UnimplementedCoinServiceHandler) GetTopGainersCoins(context.Context, *conn
 func (UnimplementedCoinServiceHandler) GetXStocksCoins(context.Context, *connect.Request[v1.GetXStocksCoinsRequest]) (*connect.Response[v1.GetAvailableCoinsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.CoinService.GetXStocksCoins is not implemented"))
 }
+
+func (UnimplementedCoinServiceHandler) GetCoinTradeStats(context.Context, *connect.Request[v1.GetCoinTradeStatsRequest]) (*connect.Response[v1.GetCoinTradeStatsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.CoinService.GetCoinTradeStats is not implemented"))
+}
+
+func (UnimplementedCoinServiceHandler) GetCoinTopTraders(context.Context, *connect.Request[v1.GetCoinTopTradersRequest]) (*connect.Response[v1.GetCoinTopTradersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.CoinService.GetCoinTopTraders is not implemented"))
+}
+
+func (UnimplementedCoinServiceHandler) GetMarketOverview(context.Context, *connect.Request[v1.GetMarketOverviewRequest]) (*connect.Response[v1.GetMarketOverviewResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.CoinService.GetMarketOverview is not implemented"))
+}
+
+func (UnimplementedCoinServiceHandler) GetRecentlyViewed(context.Context, *connect.Request[v1.GetRecentlyViewedRequest]) (*connect.Response[v1.GetRecentlyViewedResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.CoinService.GetRecentlyViewed is not implemented"))
+}
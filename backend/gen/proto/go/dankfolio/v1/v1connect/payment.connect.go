@@ -0,0 +1,146 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: dankfolio/v1/payment.proto
+
+package v1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// PaymentServiceName is the fully-qualified name of the PaymentService service.
+	PaymentServiceName = "dankfolio.v1.PaymentService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// PaymentServiceCreatePaymentRequestProcedure is the fully-qualified name of the PaymentService's
+	// CreatePaymentRequest RPC.
+	PaymentServiceCreatePaymentRequestProcedure = "/dankfolio.v1.PaymentService/CreatePaymentRequest"
+	// PaymentServiceGetPaymentStatusProcedure is the fully-qualified name of the PaymentService's
+	// GetPaymentStatus RPC.
+	PaymentServiceGetPaymentStatusProcedure = "/dankfolio.v1.PaymentService/GetPaymentStatus"
+)
+
+// PaymentServiceClient is a client for the dankfolio.v1.PaymentService service.
+type PaymentServiceClient interface {
+	// CreatePaymentRequest creates a payment request with a fresh reference key
+	// and returns the Solana Pay URLs to show the payer, e.g. as a QR code.
+	CreatePaymentRequest(context.Context, *connect.Request[v1.CreatePaymentRequestRequest]) (*connect.Response[v1.CreatePaymentRequestResponse], error)
+	// GetPaymentStatus reports whether a payment request has been paid, looking
+	// for the payment on chain while it is pending.
+	GetPaymentStatus(context.Context, *connect.Request[v1.GetPaymentStatusRequest]) (*connect.Response[v1.GetPaymentStatusResponse], error)
+}
+
+// NewPaymentServiceClient constructs a client for the dankfolio.v1.PaymentService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewPaymentServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) PaymentServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	paymentServiceMethods := v1.File_dankfolio_v1_payment_proto.Services().ByName("PaymentService").Methods()
+	return &paymentServiceClient{
+		createPaymentRequest: connect.NewClient[v1.CreatePaymentRequestRequest, v1.CreatePaymentRequestResponse](
+			httpClient,
+			baseURL+PaymentServiceCreatePaymentRequestProcedure,
+			connect.WithSchema(paymentServiceMethods.ByName("CreatePaymentRequest")),
+			connect.WithClientOptions(opts...),
+		),
+		getPaymentStatus: connect.NewClient[v1.GetPaymentStatusRequest, v1.GetPaymentStatusResponse](
+			httpClient,
+			baseURL+PaymentServiceGetPaymentStatusProcedure,
+			connect.WithSchema(paymentServiceMethods.ByName("GetPaymentStatus")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// paymentServiceClient implements PaymentServiceClient.
+type paymentServiceClient struct {
+	createPaymentRequest *connect.Client[v1.CreatePaymentRequestRequest, v1.CreatePaymentRequestResponse]
+	getPaymentStatus     *connect.Client[v1.GetPaymentStatusRequest, v1.GetPaymentStatusResponse]
+}
+
+// CreatePaymentRequest calls dankfolio.v1.PaymentService.CreatePaymentRequest.
+func (c *paymentServiceClient) CreatePaymentRequest(ctx context.Context, req *connect.Request[v1.CreatePaymentRequestRequest]) (*connect.Response[v1.CreatePaymentRequestResponse], error) {
+	return c.createPaymentRequest.CallUnary(ctx, req)
+}
+
+// GetPaymentStatus calls dankfolio.v1.PaymentService.GetPaymentStatus.
+func (c *paymentServiceClient) GetPaymentStatus(ctx context.Context, req *connect.Request[v1.GetPaymentStatusRequest]) (*connect.Response[v1.GetPaymentStatusResponse], error) {
+	return c.getPaymentStatus.CallUnary(ctx, req)
+}
+
+// PaymentServiceHandler is an implementation of the dankfolio.v1.PaymentService service.
+type PaymentServiceHandler interface {
+	// CreatePaymentRequest creates a payment request with a fresh reference key
+	// and returns the Solana Pay URLs to show the payer, e.g. as a QR code.
+	CreatePaymentRequest(context.Context, *connect.Request[v1.CreatePaymentRequestRequest]) (*connect.Response[v1.CreatePaymentRequestResponse], error)
+	// GetPaymentStatus reports whether a payment request has been paid, looking
+	// for the payment on chain while it is pending.
+	GetPaymentStatus(context.Context, *connect.Request[v1.GetPaymentStatusRequest]) (*connect.Response[v1.GetPaymentStatusResponse], error)
+}
+
+// NewPaymentServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewPaymentServiceHandler(svc PaymentServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	paymentServiceMethods := v1.File_dankfolio_v1_payment_proto.Services().ByName("PaymentService").Methods()
+	paymentServiceCreatePaymentRequestHandler := connect.NewUnaryHandler(
+		PaymentServiceCreatePaymentRequestProcedure,
+		svc.CreatePaymentRequest,
+		connect.WithSchema(paymentServiceMethods.ByName("CreatePaymentRequest")),
+		connect.WithHandlerOptions(opts...),
+	)
+	paymentServiceGetPaymentStatusHandler := connect.NewUnaryHandler(
+		PaymentServiceGetPaymentStatusProcedure,
+		svc.GetPaymentStatus,
+		connect.WithSchema(paymentServiceMethods.ByName("GetPaymentStatus")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/dankfolio.v1.PaymentService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case PaymentServiceCreatePaymentRequestProcedure:
+			paymentServiceCreatePaymentRequestHandler.ServeHTTP(w, r)
+		case PaymentServiceGetPaymentStatusProcedure:
+			paymentServiceGetPaymentStatusHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedPaymentServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedPaymentServiceHandler struct{}
+
+func (UnimplementedPaymentServiceHandler) CreatePaymentRequest(context.Context, *connect.Request[v1.CreatePaymentRequestRequest]) (*connect.Response[v1.CreatePaymentRequestResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.PaymentService.CreatePaymentRequest is not implemented"))
+}
+
+func (UnimplementedPaymentServiceHandler) GetPaymentStatus(context.Context, *connect.Request[v1.GetPaymentStatusRequest]) (*connect.Response[v1.GetPaymentStatusResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.PaymentService.GetPaymentStatus is not implemented"))
+}
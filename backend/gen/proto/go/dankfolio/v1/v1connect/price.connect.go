@@ -36,22 +36,38 @@ const (
 	// PriceServiceGetPriceHistoryProcedure is the fully-qualified name of the PriceService's
 	// GetPriceHistory RPC.
 	PriceServiceGetPriceHistoryProcedure = "/dankfolio.v1.PriceService/GetPriceHistory"
+	// PriceServiceGetPriceCandlesProcedure is the fully-qualified name of the PriceService's
+	// GetPriceCandles RPC.
+	PriceServiceGetPriceCandlesProcedure = "/dankfolio.v1.PriceService/GetPriceCandles"
 	// PriceServiceGetCoinPricesProcedure is the fully-qualified name of the PriceService's
 	// GetCoinPrices RPC.
 	PriceServiceGetCoinPricesProcedure = "/dankfolio.v1.PriceService/GetCoinPrices"
 	// PriceServiceGetPriceHistoriesByIDsProcedure is the fully-qualified name of the PriceService's
 	// GetPriceHistoriesByIDs RPC.
 	PriceServiceGetPriceHistoriesByIDsProcedure = "/dankfolio.v1.PriceService/GetPriceHistoriesByIDs"
+	// PriceServiceGetPricesBatchProcedure is the fully-qualified name of the PriceService's
+	// GetPricesBatch RPC.
+	PriceServiceGetPricesBatchProcedure = "/dankfolio.v1.PriceService/GetPricesBatch"
+	// PriceServiceStreamPricesProcedure is the fully-qualified name of the PriceService's StreamPrices
+	// RPC.
+	PriceServiceStreamPricesProcedure = "/dankfolio.v1.PriceService/StreamPrices"
 )
 
 // PriceServiceClient is a client for the dankfolio.v1.PriceService service.
 type PriceServiceClient interface {
 	// GetPriceHistory returns historical price data for a given address
 	GetPriceHistory(context.Context, *connect.Request[v1.GetPriceHistoryRequest]) (*connect.Response[v1.GetPriceHistoryResponse], error)
+	// GetPriceCandles returns OHLCV candles for a chart with technical indicators computed over them
+	GetPriceCandles(context.Context, *connect.Request[v1.GetPriceCandlesRequest]) (*connect.Response[v1.GetPriceCandlesResponse], error)
 	// GetCoinPrices returns current prices for multiple coins
 	GetCoinPrices(context.Context, *connect.Request[v1.GetCoinPricesRequest]) (*connect.Response[v1.GetCoinPricesResponse], error)
 	// GetPriceHistoriesByIDs returns historical price data for multiple addresses in a single request
 	GetPriceHistoriesByIDs(context.Context, *connect.Request[v1.GetPriceHistoriesByIDsRequest]) (*connect.Response[v1.GetPriceHistoriesByIDsResponse], error)
+	// GetPricesBatch returns current USD prices for up to 100 addresses in a single round trip
+	GetPricesBatch(context.Context, *connect.Request[v1.GetPricesBatchRequest]) (*connect.Response[v1.GetPricesBatchResponse], error)
+	// StreamPrices streams live prices for the tokens on screen, starting with their current prices,
+	// and optionally their swaps
+	StreamPrices(context.Context, *connect.Request[v1.StreamPricesRequest]) (*connect.ServerStreamForClient[v1.StreamPricesResponse], error)
 }
 
 // NewPriceServiceClient constructs a client for the dankfolio.v1.PriceService service. By default,
@@ -71,6 +87,12 @@ func NewPriceServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(priceServiceMethods.ByName("GetPriceHistory")),
 			connect.WithClientOptions(opts...),
 		),
+		getPriceCandles: connect.NewClient[v1.GetPriceCandlesRequest, v1.GetPriceCandlesResponse](
+			httpClient,
+			baseURL+PriceServiceGetPriceCandlesProcedure,
+			connect.WithSchema(priceServiceMethods.ByName("GetPriceCandles")),
+			connect.WithClientOptions(opts...),
+		),
 		getCoinPrices: connect.NewClient[v1.GetCoinPricesRequest, v1.GetCoinPricesResponse](
 			httpClient,
 			baseURL+PriceServiceGetCoinPricesProcedure,
@@ -83,14 +105,29 @@ func NewPriceServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(priceServiceMethods.ByName("GetPriceHistoriesByIDs")),
 			connect.WithClientOptions(opts...),
 		),
+		getPricesBatch: connect.NewClient[v1.GetPricesBatchRequest, v1.GetPricesBatchResponse](
+			httpClient,
+			baseURL+PriceServiceGetPricesBatchProcedure,
+			connect.WithSchema(priceServiceMethods.ByName("GetPricesBatch")),
+			connect.WithClientOptions(opts...),
+		),
+		streamPrices: connect.NewClient[v1.StreamPricesRequest, v1.StreamPricesResponse](
+			httpClient,
+			baseURL+PriceServiceStreamPricesProcedure,
+			connect.WithSchema(priceServiceMethods.ByName("StreamPrices")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // priceServiceClient implements PriceServiceClient.
 type priceServiceClient struct {
 	getPriceHistory        *connect.Client[v1.GetPriceHistoryRequest, v1.GetPriceHistoryResponse]
+	getPriceCandles        *connect.Client[v1.GetPriceCandlesRequest, v1.GetPriceCandlesResponse]
 	getCoinPrices          *connect.Client[v1.GetCoinPricesRequest, v1.GetCoinPricesResponse]
 	getPriceHistoriesByIDs *connect.Client[v1.GetPriceHistoriesByIDsRequest, v1.GetPriceHistoriesByIDsResponse]
+	getPricesBatch         *connect.Client[v1.GetPricesBatchRequest, v1.GetPricesBatchResponse]
+	streamPrices           *connect.Client[v1.StreamPricesRequest, v1.StreamPricesResponse]
 }
 
 // GetPriceHistory calls dankfolio.v1.PriceService.GetPriceHistory.
@@ -98,6 +135,11 @@ func (c *priceServiceClient) GetPriceHistory(ctx context.Context, req *connect.R
 	return c.getPriceHistory.CallUnary(ctx, req)
 }
 
+// GetPriceCandles calls dankfolio.v1.PriceService.GetPriceCandles.
+func (c *priceServiceClient) GetPriceCandles(ctx context.Context, req *connect.Request[v1.GetPriceCandlesRequest]) (*connect.Response[v1.GetPriceCandlesResponse], error) {
+	return c.getPriceCandles.CallUnary(ctx, req)
+}
+
 // GetCoinPrices calls dankfolio.v1.PriceService.GetCoinPrices.
 func (c *priceServiceClient) GetCoinPrices(ctx context.Context, req *connect.Request[v1.GetCoinPricesRequest]) (*connect.Response[v1.GetCoinPricesResponse], error) {
 	return c.getCoinPrices.CallUnary(ctx, req)
@@ -108,14 +150,31 @@ func (c *priceServiceClient) GetPriceHistoriesByIDs(ctx context.Context, req *co
 	return c.getPriceHistoriesByIDs.CallUnary(ctx, req)
 }
 
+// GetPricesBatch calls dankfolio.v1.PriceService.GetPricesBatch.
+func (c *priceServiceClient) GetPricesBatch(ctx context.Context, req *connect.Request[v1.GetPricesBatchRequest]) (*connect.Response[v1.GetPricesBatchResponse], error) {
+	return c.getPricesBatch.CallUnary(ctx, req)
+}
+
+// StreamPrices calls dankfolio.v1.PriceService.StreamPrices.
+func (c *priceServiceClient) StreamPrices(ctx context.Context, req *connect.Request[v1.StreamPricesRequest]) (*connect.ServerStreamForClient[v1.StreamPricesResponse], error) {
+	return c.streamPrices.CallServerStream(ctx, req)
+}
+
 // PriceServiceHandler is an implementation of the dankfolio.v1.PriceService service.
 type PriceServiceHandler interface {
 	// GetPriceHistory returns historical price data for a given address
 	GetPriceHistory(context.Context, *connect.Request[v1.GetPriceHistoryRequest]) (*connect.Response[v1.GetPriceHistoryResponse], error)
+	// GetPriceCandles returns OHLCV candles for a chart with technical indicators computed over them
+	GetPriceCandles(context.Context, *connect.Request[v1.GetPriceCandlesRequest]) (*connect.Response[v1.GetPriceCandlesResponse], error)
 	// GetCoinPrices returns current prices for multiple coins
 	GetCoinPrices(context.Context, *connect.Request[v1.GetCoinPricesRequest]) (*connect.Response[v1.GetCoinPricesResponse], error)
 	// GetPriceHistoriesByIDs returns historical price data for multiple addresses in a single request
 	GetPriceHistoriesByIDs(context.Context, *connect.Request[v1.GetPriceHistoriesByIDsRequest]) (*connect.Response[v1.GetPriceHistoriesByIDsResponse], error)
+	// GetPricesBatch returns current USD prices for up to 100 addresses in a single round trip
+	GetPricesBatch(context.Context, *connect.Request[v1.GetPricesBatchRequest]) (*connect.Response[v1.GetPricesBatchResponse], error)
+	// StreamPrices streams live prices for the tokens on screen, starting with their current prices,
+	// and optionally their swaps
+	StreamPrices(context.Context, *connect.Request[v1.StreamPricesRequest], *connect.ServerStream[v1.StreamPricesResponse]) error
 }
 
 // NewPriceServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -131,6 +190,12 @@ func NewPriceServiceHandler(svc PriceServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(priceServiceMethods.ByName("GetPriceHistory")),
 		connect.WithHandlerOptions(opts...),
 	)
+	priceServiceGetPriceCandlesHandler := connect.NewUnaryHandler(
+		PriceServiceGetPriceCandlesProcedure,
+		svc.GetPriceCandles,
+		connect.WithSchema(priceServiceMethods.ByName("GetPriceCandles")),
+		connect.WithHandlerOptions(opts...),
+	)
 	priceServiceGetCoinPricesHandler := connect.NewUnaryHandler(
 		PriceServiceGetCoinPricesProcedure,
 		svc.GetCoinPrices,
@@ -143,14 +208,32 @@ func NewPriceServiceHandler(svc PriceServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(priceServiceMethods.ByName("GetPriceHistoriesByIDs")),
 		connect.WithHandlerOptions(opts...),
 	)
+	priceServiceGetPricesBatchHandler := connect.NewUnaryHandler(
+		PriceServiceGetPricesBatchProcedure,
+		svc.GetPricesBatch,
+		connect.WithSchema(priceServiceMethods.ByName("GetPricesBatch")),
+		connect.WithHandlerOptions(opts...),
+	)
+	priceServiceStreamPricesHandler := connect.NewServerStreamHandler(
+		PriceServiceStreamPricesProcedure,
+		svc.StreamPrices,
+		connect.WithSchema(priceServiceMethods.ByName("StreamPrices")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/dankfolio.v1.PriceService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case PriceServiceGetPriceHistoryProcedure:
 			priceServiceGetPriceHistoryHandler.ServeHTTP(w, r)
+		case PriceServiceGetPriceCandlesProcedure:
+			priceServiceGetPriceCandlesHandler.ServeHTTP(w, r)
 		case PriceServiceGetCoinPricesProcedure:
 			priceServiceGetCoinPricesHandler.ServeHTTP(w, r)
 		case PriceServiceGetPriceHistoriesByIDsProcedure:
 			priceServiceGetPriceHistoriesByIDsHandler.ServeHTTP(w, r)
+		case PriceServiceGetPricesBatchProcedure:
+			priceServiceGetPricesBatchHandler.ServeHTTP(w, r)
+		case PriceServiceStreamPricesProcedure:
+			priceServiceStreamPricesHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -164,6 +247,10 @@ func (UnimplementedPriceServiceHandler) GetPriceHistory(context.Context, *connec
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.PriceService.GetPriceHistory is not implemented"))
 }
 
+func (UnimplementedPriceServiceHandler) GetPriceCandles(context.Context, *connect.Request[v1.GetPriceCandlesRequest]) (*connect.Response[v1.GetPriceCandlesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.PriceService.GetPriceCandles is not implemented"))
+}
+
 func (UnimplementedPriceServiceHandler) GetCoinPrices(context.Context, *connect.Request[v1.GetCoinPricesRequest]) (*connect.Response[v1.GetCoinPricesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.PriceService.GetCoinPrices is not implemented"))
 }
@@ -171,3 +258,11 @@ func (UnimplementedPriceServiceHandler) GetCoinPrices(context.Context, *connect.
 func (UnimplementedPriceServiceHandler) GetPriceHistoriesByIDs(context.Context, *connect.Request[v1.GetPriceHistoriesByIDsRequest]) (*connect.Response[v1.GetPriceHistoriesByIDsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.PriceService.GetPriceHistoriesByIDs is not implemented"))
 }
+
+func (UnimplementedPriceServiceHandler) GetPricesBatch(context.Context, *connect.Request[v1.GetPricesBatchRequest]) (*connect.Response[v1.GetPricesBatchResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.PriceService.GetPricesBatch is not implemented"))
+}
+
+func (UnimplementedPriceServiceHandler) StreamPrices(context.Context, *connect.Request[v1.StreamPricesRequest], *connect.ServerStream[v1.StreamPricesResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.PriceService.StreamPrices is not implemented"))
+}
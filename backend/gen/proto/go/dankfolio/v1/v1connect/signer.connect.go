@@ -0,0 +1,144 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: dankfolio/v1/signer.proto
+
+package v1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// SignerServiceName is the fully-qualified name of the SignerService service.
+	SignerServiceName = "dankfolio.v1.SignerService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// SignerServiceGetPublicKeyProcedure is the fully-qualified name of the SignerService's
+	// GetPublicKey RPC.
+	SignerServiceGetPublicKeyProcedure = "/dankfolio.v1.SignerService/GetPublicKey"
+	// SignerServiceSignMessageProcedure is the fully-qualified name of the SignerService's SignMessage
+	// RPC.
+	SignerServiceSignMessageProcedure = "/dankfolio.v1.SignerService/SignMessage"
+)
+
+// SignerServiceClient is a client for the dankfolio.v1.SignerService service.
+type SignerServiceClient interface {
+	// GetPublicKey returns the platform signer's public key.
+	GetPublicKey(context.Context, *connect.Request[v1.GetPublicKeyRequest]) (*connect.Response[v1.GetPublicKeyResponse], error)
+	// SignMessage signs a serialized Solana transaction message. The signer
+	// rejects messages that call programs outside its allow list.
+	SignMessage(context.Context, *connect.Request[v1.SignMessageRequest]) (*connect.Response[v1.SignMessageResponse], error)
+}
+
+// NewSignerServiceClient constructs a client for the dankfolio.v1.SignerService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewSignerServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) SignerServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	signerServiceMethods := v1.File_dankfolio_v1_signer_proto.Services().ByName("SignerService").Methods()
+	return &signerServiceClient{
+		getPublicKey: connect.NewClient[v1.GetPublicKeyRequest, v1.GetPublicKeyResponse](
+			httpClient,
+			baseURL+SignerServiceGetPublicKeyProcedure,
+			connect.WithSchema(signerServiceMethods.ByName("GetPublicKey")),
+			connect.WithClientOptions(opts...),
+		),
+		signMessage: connect.NewClient[v1.SignMessageRequest, v1.SignMessageResponse](
+			httpClient,
+			baseURL+SignerServiceSignMessageProcedure,
+			connect.WithSchema(signerServiceMethods.ByName("SignMessage")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// signerServiceClient implements SignerServiceClient.
+type signerServiceClient struct {
+	getPublicKey *connect.Client[v1.GetPublicKeyRequest, v1.GetPublicKeyResponse]
+	signMessage  *connect.Client[v1.SignMessageRequest, v1.SignMessageResponse]
+}
+
+// GetPublicKey calls dankfolio.v1.SignerService.GetPublicKey.
+func (c *signerServiceClient) GetPublicKey(ctx context.Context, req *connect.Request[v1.GetPublicKeyRequest]) (*connect.Response[v1.GetPublicKeyResponse], error) {
+	return c.getPublicKey.CallUnary(ctx, req)
+}
+
+// SignMessage calls dankfolio.v1.SignerService.SignMessage.
+func (c *signerServiceClient) SignMessage(ctx context.Context, req *connect.Request[v1.SignMessageRequest]) (*connect.Response[v1.SignMessageResponse], error) {
+	return c.signMessage.CallUnary(ctx, req)
+}
+
+// SignerServiceHandler is an implementation of the dankfolio.v1.SignerService service.
+type SignerServiceHandler interface {
+	// GetPublicKey returns the platform signer's public key.
+	GetPublicKey(context.Context, *connect.Request[v1.GetPublicKeyRequest]) (*connect.Response[v1.GetPublicKeyResponse], error)
+	// SignMessage signs a serialized Solana transaction message. The signer
+	// rejects messages that call programs outside its allow list.
+	SignMessage(context.Context, *connect.Request[v1.SignMessageRequest]) (*connect.Response[v1.SignMessageResponse], error)
+}
+
+// NewSignerServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewSignerServiceHandler(svc SignerServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	signerServiceMethods := v1.File_dankfolio_v1_signer_proto.Services().ByName("SignerService").Methods()
+	signerServiceGetPublicKeyHandler := connect.NewUnaryHandler(
+		SignerServiceGetPublicKeyProcedure,
+		svc.GetPublicKey,
+		connect.WithSchema(signerServiceMethods.ByName("GetPublicKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	signerServiceSignMessageHandler := connect.NewUnaryHandler(
+		SignerServiceSignMessageProcedure,
+		svc.SignMessage,
+		connect.WithSchema(signerServiceMethods.ByName("SignMessage")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/dankfolio.v1.SignerService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case SignerServiceGetPublicKeyProcedure:
+			signerServiceGetPublicKeyHandler.ServeHTTP(w, r)
+		case SignerServiceSignMessageProcedure:
+			signerServiceSignMessageHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedSignerServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedSignerServiceHandler struct{}
+
+func (UnimplementedSignerServiceHandler) GetPublicKey(context.Context, *connect.Request[v1.GetPublicKeyRequest]) (*connect.Response[v1.GetPublicKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.SignerService.GetPublicKey is not implemented"))
+}
+
+func (UnimplementedSignerServiceHandler) SignMessage(context.Context, *connect.Request[v1.SignMessageRequest]) (*connect.Response[v1.SignMessageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.SignerService.SignMessage is not implemented"))
+}
@@ -0,0 +1,111 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: dankfolio/v1/status.proto
+
+package v1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// StatusServiceName is the fully-qualified name of the StatusService service.
+	StatusServiceName = "dankfolio.v1.StatusService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// StatusServiceGetServiceStatusProcedure is the fully-qualified name of the StatusService's
+	// GetServiceStatus RPC.
+	StatusServiceGetServiceStatusProcedure = "/dankfolio.v1.StatusService/GetServiceStatus"
+)
+
+// StatusServiceClient is a client for the dankfolio.v1.StatusService service.
+type StatusServiceClient interface {
+	// GetServiceStatus returns the health of each dependency over the last few minutes. Unauthenticated.
+	GetServiceStatus(context.Context, *connect.Request[v1.GetServiceStatusRequest]) (*connect.Response[v1.GetServiceStatusResponse], error)
+}
+
+// NewStatusServiceClient constructs a client for the dankfolio.v1.StatusService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewStatusServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) StatusServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	statusServiceMethods := v1.File_dankfolio_v1_status_proto.Services().ByName("StatusService").Methods()
+	return &statusServiceClient{
+		getServiceStatus: connect.NewClient[v1.GetServiceStatusRequest, v1.GetServiceStatusResponse](
+			httpClient,
+			baseURL+StatusServiceGetServiceStatusProcedure,
+			connect.WithSchema(statusServiceMethods.ByName("GetServiceStatus")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// statusServiceClient implements StatusServiceClient.
+type statusServiceClient struct {
+	getServiceStatus *connect.Client[v1.GetServiceStatusRequest, v1.GetServiceStatusResponse]
+}
+
+// GetServiceStatus calls dankfolio.v1.StatusService.GetServiceStatus.
+func (c *statusServiceClient) GetServiceStatus(ctx context.Context, req *connect.Request[v1.GetServiceStatusRequest]) (*connect.Response[v1.GetServiceStatusResponse], error) {
+	return c.getServiceStatus.CallUnary(ctx, req)
+}
+
+// StatusServiceHandler is an implementation of the dankfolio.v1.StatusService service.
+type StatusServiceHandler interface {
+	// GetServiceStatus returns the health of each dependency over the last few minutes. Unauthenticated.
+	GetServiceStatus(context.Context, *connect.Request[v1.GetServiceStatusRequest]) (*connect.Response[v1.GetServiceStatusResponse], error)
+}
+
+// NewStatusServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewStatusServiceHandler(svc StatusServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	statusServiceMethods := v1.File_dankfolio_v1_status_proto.Services().ByName("StatusService").Methods()
+	statusServiceGetServiceStatusHandler := connect.NewUnaryHandler(
+		StatusServiceGetServiceStatusProcedure,
+		svc.GetServiceStatus,
+		connect.WithSchema(statusServiceMethods.ByName("GetServiceStatus")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/dankfolio.v1.StatusService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case StatusServiceGetServiceStatusProcedure:
+			statusServiceGetServiceStatusHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedStatusServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedStatusServiceHandler struct{}
+
+func (UnimplementedStatusServiceHandler) GetServiceStatus(context.Context, *connect.Request[v1.GetServiceStatusRequest]) (*connect.Response[v1.GetServiceStatusResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.StatusService.GetServiceStatus is not implemented"))
+}
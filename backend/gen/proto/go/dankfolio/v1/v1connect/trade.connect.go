@@ -39,12 +39,39 @@ const (
 	// TradeServicePrepareSwapProcedure is the fully-qualified name of the TradeService's PrepareSwap
 	// RPC.
 	TradeServicePrepareSwapProcedure = "/dankfolio.v1.TradeService/PrepareSwap"
+	// TradeServiceRefreshQuoteProcedure is the fully-qualified name of the TradeService's RefreshQuote
+	// RPC.
+	TradeServiceRefreshQuoteProcedure = "/dankfolio.v1.TradeService/RefreshQuote"
 	// TradeServiceSubmitSwapProcedure is the fully-qualified name of the TradeService's SubmitSwap RPC.
 	TradeServiceSubmitSwapProcedure = "/dankfolio.v1.TradeService/SubmitSwap"
 	// TradeServiceGetTradeProcedure is the fully-qualified name of the TradeService's GetTrade RPC.
 	TradeServiceGetTradeProcedure = "/dankfolio.v1.TradeService/GetTrade"
 	// TradeServiceListTradesProcedure is the fully-qualified name of the TradeService's ListTrades RPC.
 	TradeServiceListTradesProcedure = "/dankfolio.v1.TradeService/ListTrades"
+	// TradeServiceGetTradeReceiptProcedure is the fully-qualified name of the TradeService's
+	// GetTradeReceipt RPC.
+	TradeServiceGetTradeReceiptProcedure = "/dankfolio.v1.TradeService/GetTradeReceipt"
+	// TradeServiceGetPaperPortfolioProcedure is the fully-qualified name of the TradeService's
+	// GetPaperPortfolio RPC.
+	TradeServiceGetPaperPortfolioProcedure = "/dankfolio.v1.TradeService/GetPaperPortfolio"
+	// TradeServiceResetPaperPortfolioProcedure is the fully-qualified name of the TradeService's
+	// ResetPaperPortfolio RPC.
+	TradeServiceResetPaperPortfolioProcedure = "/dankfolio.v1.TradeService/ResetPaperPortfolio"
+	// TradeServiceGetMaxSpendableProcedure is the fully-qualified name of the TradeService's
+	// GetMaxSpendable RPC.
+	TradeServiceGetMaxSpendableProcedure = "/dankfolio.v1.TradeService/GetMaxSpendable"
+	// TradeServiceValidateTradeProcedure is the fully-qualified name of the TradeService's
+	// ValidateTrade RPC.
+	TradeServiceValidateTradeProcedure = "/dankfolio.v1.TradeService/ValidateTrade"
+	// TradeServiceCreateTwapOrderProcedure is the fully-qualified name of the TradeService's
+	// CreateTwapOrder RPC.
+	TradeServiceCreateTwapOrderProcedure = "/dankfolio.v1.TradeService/CreateTwapOrder"
+	// TradeServiceListTwapOrdersProcedure is the fully-qualified name of the TradeService's
+	// ListTwapOrders RPC.
+	TradeServiceListTwapOrdersProcedure = "/dankfolio.v1.TradeService/ListTwapOrders"
+	// TradeServiceCancelTwapOrderProcedure is the fully-qualified name of the TradeService's
+	// CancelTwapOrder RPC.
+	TradeServiceCancelTwapOrderProcedure = "/dankfolio.v1.TradeService/CancelTwapOrder"
 )
 
 // TradeServiceClient is a client for the dankfolio.v1.TradeService service.
@@ -53,12 +80,37 @@ type TradeServiceClient interface {
 	GetSwapQuote(context.Context, *connect.Request[v1.GetSwapQuoteRequest]) (*connect.Response[v1.GetSwapQuoteResponse], error)
 	// PrepareSwap prepares an unsigned swap transaction
 	PrepareSwap(context.Context, *connect.Request[v1.PrepareSwapRequest]) (*connect.Response[v1.PrepareSwapResponse], error)
+	// RefreshQuote re-quotes a prepared swap with the same parameters, replacing
+	// its unsigned transaction and quote_id
+	RefreshQuote(context.Context, *connect.Request[v1.RefreshQuoteRequest]) (*connect.Response[v1.PrepareSwapResponse], error)
 	// SubmitSwap submits a trade for execution
 	SubmitSwap(context.Context, *connect.Request[v1.SubmitSwapRequest]) (*connect.Response[v1.SubmitSwapResponse], error)
 	// GetTrade returns details and status of a specific trade
 	GetTrade(context.Context, *connect.Request[v1.GetTradeRequest]) (*connect.Response[v1.Trade], error)
 	// ListTrades returns all trades
 	ListTrades(context.Context, *connect.Request[v1.ListTradesRequest]) (*connect.Response[v1.ListTradesResponse], error)
+	// GetTradeReceipt compares how a completed swap filled with its quote
+	GetTradeReceipt(context.Context, *connect.Request[v1.GetTradeReceiptRequest]) (*connect.Response[v1.GetTradeReceiptResponse], error)
+	// GetPaperPortfolio returns a wallet's paper trading balances valued at current
+	// prices, funding the account with its starting SOL on first use
+	GetPaperPortfolio(context.Context, *connect.Request[v1.GetPaperPortfolioRequest]) (*connect.Response[v1.GetPaperPortfolioResponse], error)
+	// ResetPaperPortfolio discards a wallet's paper balances and trades and starts
+	// it over with the starting SOL
+	ResetPaperPortfolio(context.Context, *connect.Request[v1.ResetPaperPortfolioRequest]) (*connect.Response[v1.GetPaperPortfolioResponse], error)
+	// GetMaxSpendable returns the most of a coin a wallet can swap into another,
+	// after the SOL for network fees and new token account rent is set aside
+	GetMaxSpendable(context.Context, *connect.Request[v1.GetMaxSpendableRequest]) (*connect.Response[v1.GetMaxSpendableResponse], error)
+	// ValidateTrade checks a swap's balance, fees, route and tradability without
+	// quoting it in full, so the app can disable the swap button with a reason
+	ValidateTrade(context.Context, *connect.Request[v1.ValidateTradeRequest]) (*connect.Response[v1.ValidateTradeResponse], error)
+	// CreateTwapOrder splits a large swap into slices prepared on a schedule.
+	// Each prepared slice is signed and submitted with SubmitSwap by its quote_id.
+	CreateTwapOrder(context.Context, *connect.Request[v1.CreateTwapOrderRequest]) (*connect.Response[v1.TwapOrder], error)
+	// ListTwapOrders returns a wallet's TWAP orders, newest first, with their slices
+	ListTwapOrders(context.Context, *connect.Request[v1.ListTwapOrdersRequest]) (*connect.Response[v1.ListTwapOrdersResponse], error)
+	// CancelTwapOrder stops preparing an order's slices and withdraws any
+	// prepared slice; submitted slices still settle
+	CancelTwapOrder(context.Context, *connect.Request[v1.CancelTwapOrderRequest]) (*connect.Response[v1.TwapOrder], error)
 }
 
 // NewTradeServiceClient constructs a client for the dankfolio.v1.TradeService service. By default,
@@ -84,6 +136,12 @@ func NewTradeServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(tradeServiceMethods.ByName("PrepareSwap")),
 			connect.WithClientOptions(opts...),
 		),
+		refreshQuote: connect.NewClient[v1.RefreshQuoteRequest, v1.PrepareSwapResponse](
+			httpClient,
+			baseURL+TradeServiceRefreshQuoteProcedure,
+			connect.WithSchema(tradeServiceMethods.ByName("RefreshQuote")),
+			connect.WithClientOptions(opts...),
+		),
 		submitSwap: connect.NewClient[v1.SubmitSwapRequest, v1.SubmitSwapResponse](
 			httpClient,
 			baseURL+TradeServiceSubmitSwapProcedure,
@@ -102,16 +160,73 @@ func NewTradeServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(tradeServiceMethods.ByName("ListTrades")),
 			connect.WithClientOptions(opts...),
 		),
+		getTradeReceipt: connect.NewClient[v1.GetTradeReceiptRequest, v1.GetTradeReceiptResponse](
+			httpClient,
+			baseURL+TradeServiceGetTradeReceiptProcedure,
+			connect.WithSchema(tradeServiceMethods.ByName("GetTradeReceipt")),
+			connect.WithClientOptions(opts...),
+		),
+		getPaperPortfolio: connect.NewClient[v1.GetPaperPortfolioRequest, v1.GetPaperPortfolioResponse](
+			httpClient,
+			baseURL+TradeServiceGetPaperPortfolioProcedure,
+			connect.WithSchema(tradeServiceMethods.ByName("GetPaperPortfolio")),
+			connect.WithClientOptions(opts...),
+		),
+		resetPaperPortfolio: connect.NewClient[v1.ResetPaperPortfolioRequest, v1.GetPaperPortfolioResponse](
+			httpClient,
+			baseURL+TradeServiceResetPaperPortfolioProcedure,
+			connect.WithSchema(tradeServiceMethods.ByName("ResetPaperPortfolio")),
+			connect.WithClientOptions(opts...),
+		),
+		getMaxSpendable: connect.NewClient[v1.GetMaxSpendableRequest, v1.GetMaxSpendableResponse](
+			httpClient,
+			baseURL+TradeServiceGetMaxSpendableProcedure,
+			connect.WithSchema(tradeServiceMethods.ByName("GetMaxSpendable")),
+			connect.WithClientOptions(opts...),
+		),
+		validateTrade: connect.NewClient[v1.ValidateTradeRequest, v1.ValidateTradeResponse](
+			httpClient,
+			baseURL+TradeServiceValidateTradeProcedure,
+			connect.WithSchema(tradeServiceMethods.ByName("ValidateTrade")),
+			connect.WithClientOptions(opts...),
+		),
+		createTwapOrder: connect.NewClient[v1.CreateTwapOrderRequest, v1.TwapOrder](
+			httpClient,
+			baseURL+TradeServiceCreateTwapOrderProcedure,
+			connect.WithSchema(tradeServiceMethods.ByName("CreateTwapOrder")),
+			connect.WithClientOptions(opts...),
+		),
+		listTwapOrders: connect.NewClient[v1.ListTwapOrdersRequest, v1.ListTwapOrdersResponse](
+			httpClient,
+			baseURL+TradeServiceListTwapOrdersProcedure,
+			connect.WithSchema(tradeServiceMethods.ByName("ListTwapOrders")),
+			connect.WithClientOptions(opts...),
+		),
+		cancelTwapOrder: connect.NewClient[v1.CancelTwapOrderRequest, v1.TwapOrder](
+			httpClient,
+			baseURL+TradeServiceCancelTwapOrderProcedure,
+			connect.WithSchema(tradeServiceMethods.ByName("CancelTwapOrder")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // tradeServiceClient implements TradeServiceClient.
 type tradeServiceClient struct {
-	getSwapQuote *connect.Client[v1.GetSwapQuoteRequest, v1.GetSwapQuoteResponse]
-	prepareSwap  *connect.Client[v1.PrepareSwapRequest, v1.PrepareSwapResponse]
-	submitSwap   *connect.Client[v1.SubmitSwapRequest, v1.SubmitSwapResponse]
-	getTrade     *connect.Client[v1.GetTradeRequest, v1.Trade]
-	listTrades   *connect.Client[v1.ListTradesRequest, v1.ListTradesResponse]
+	getSwapQuote        *connect.Client[v1.GetSwapQuoteRequest, v1.GetSwapQuoteResponse]
+	prepareSwap         *connect.Client[v1.PrepareSwapRequest, v1.PrepareSwapResponse]
+	refreshQuote        *connect.Client[v1.RefreshQuoteRequest, v1.PrepareSwapResponse]
+	submitSwap          *connect.Client[v1.SubmitSwapRequest, v1.SubmitSwapResponse]
+	getTrade            *connect.Client[v1.GetTradeRequest, v1.Trade]
+	listTrades          *connect.Client[v1.ListTradesRequest, v1.ListTradesResponse]
+	getTradeReceipt     *connect.Client[v1.GetTradeReceiptRequest, v1.GetTradeReceiptResponse]
+	getPaperPortfolio   *connect.Client[v1.GetPaperPortfolioRequest, v1.GetPaperPortfolioResponse]
+	resetPaperPortfolio *connect.Client[v1.ResetPaperPortfolioRequest, v1.GetPaperPortfolioResponse]
+	getMaxSpendable     *connect.Client[v1.GetMaxSpendableRequest, v1.GetMaxSpendableResponse]
+	validateTrade       *connect.Client[v1.ValidateTradeRequest, v1.ValidateTradeResponse]
+	createTwapOrder     *connect.Client[v1.CreateTwapOrderRequest, v1.TwapOrder]
+	listTwapOrders      *connect.Client[v1.ListTwapOrdersRequest, v1.ListTwapOrdersResponse]
+	cancelTwapOrder     *connect.Client[v1.CancelTwapOrderRequest, v1.TwapOrder]
 }
 
 // GetSwapQuote calls dankfolio.v1.TradeService.GetSwapQuote.
@@ -124,6 +239,11 @@ func (c *tradeServiceClient) PrepareSwap(ctx context.Context, req *connect.Reque
 	return c.prepareSwap.CallUnary(ctx, req)
 }
 
+// RefreshQuote calls dankfolio.v1.TradeService.RefreshQuote.
+func (c *tradeServiceClient) RefreshQuote(ctx context.Context, req *connect.Request[v1.RefreshQuoteRequest]) (*connect.Response[v1.PrepareSwapResponse], error) {
+	return c.refreshQuote.CallUnary(ctx, req)
+}
+
 // SubmitSwap calls dankfolio.v1.TradeService.SubmitSwap.
 func (c *tradeServiceClient) SubmitSwap(ctx context.Context, req *connect.Request[v1.SubmitSwapRequest]) (*connect.Response[v1.SubmitSwapResponse], error) {
 	return c.submitSwap.CallUnary(ctx, req)
@@ -139,18 +259,83 @@ func (c *tradeServiceClient) ListTrades(ctx context.Context, req *connect.Reques
 	return c.listTrades.CallUnary(ctx, req)
 }
 
+// GetTradeReceipt calls dankfolio.v1.TradeService.GetTradeReceipt.
+func (c *tradeServiceClient) GetTradeReceipt(ctx context.Context, req *connect.Request[v1.GetTradeReceiptRequest]) (*connect.Response[v1.GetTradeReceiptResponse], error) {
+	return c.getTradeReceipt.CallUnary(ctx, req)
+}
+
+// GetPaperPortfolio calls dankfolio.v1.TradeService.GetPaperPortfolio.
+func (c *tradeServiceClient) GetPaperPortfolio(ctx context.Context, req *connect.Request[v1.GetPaperPortfolioRequest]) (*connect.Response[v1.GetPaperPortfolioResponse], error) {
+	return c.getPaperPortfolio.CallUnary(ctx, req)
+}
+
+// ResetPaperPortfolio calls dankfolio.v1.TradeService.ResetPaperPortfolio.
+func (c *tradeServiceClient) ResetPaperPortfolio(ctx context.Context, req *connect.Request[v1.ResetPaperPortfolioRequest]) (*connect.Response[v1.GetPaperPortfolioResponse], error) {
+	return c.resetPaperPortfolio.CallUnary(ctx, req)
+}
+
+// GetMaxSpendable calls dankfolio.v1.TradeService.GetMaxSpendable.
+func (c *tradeServiceClient) GetMaxSpendable(ctx context.Context, req *connect.Request[v1.GetMaxSpendableRequest]) (*connect.Response[v1.GetMaxSpendableResponse], error) {
+	return c.getMaxSpendable.CallUnary(ctx, req)
+}
+
+// ValidateTrade calls dankfolio.v1.TradeService.ValidateTrade.
+func (c *tradeServiceClient) ValidateTrade(ctx context.Context, req *connect.Request[v1.ValidateTradeRequest]) (*connect.Response[v1.ValidateTradeResponse], error) {
+	return c.validateTrade.CallUnary(ctx, req)
+}
+
+// CreateTwapOrder calls dankfolio.v1.TradeService.CreateTwapOrder.
+func (c *tradeServiceClient) CreateTwapOrder(ctx context.Context, req *connect.Request[v1.CreateTwapOrderRequest]) (*connect.Response[v1.TwapOrder], error) {
+	return c.createTwapOrder.CallUnary(ctx, req)
+}
+
+// ListTwapOrders calls dankfolio.v1.TradeService.ListTwapOrders.
+func (c *tradeServiceClient) ListTwapOrders(ctx context.Context, req *connect.Request[v1.ListTwapOrdersRequest]) (*connect.Response[v1.ListTwapOrdersResponse], error) {
+	return c.listTwapOrders.CallUnary(ctx, req)
+}
+
+// CancelTwapOrder calls dankfolio.v1.TradeService.CancelTwapOrder.
+func (c *tradeServiceClient) CancelTwapOrder(ctx context.Context, req *connect.Request[v1.CancelTwapOrderRequest]) (*connect.Response[v1.TwapOrder], error) {
+	return c.cancelTwapOrder.CallUnary(ctx, req)
+}
+
 // TradeServiceHandler is an implementation of the dankfolio.v1.TradeService service.
 type TradeServiceHandler interface {
 	// GetSwapQuote returns a quote for a potential trade
 	GetSwapQuote(context.Context, *connect.Request[v1.GetSwapQuoteRequest]) (*connect.Response[v1.GetSwapQuoteResponse], error)
 	// PrepareSwap prepares an unsigned swap transaction
 	PrepareSwap(context.Context, *connect.Request[v1.PrepareSwapRequest]) (*connect.Response[v1.PrepareSwapResponse], error)
+	// RefreshQuote re-quotes a prepared swap with the same parameters, replacing
+	// its unsigned transaction and quote_id
+	RefreshQuote(context.Context, *connect.Request[v1.RefreshQuoteRequest]) (*connect.Response[v1.PrepareSwapResponse], error)
 	// SubmitSwap submits a trade for execution
 	SubmitSwap(context.Context, *connect.Request[v1.SubmitSwapRequest]) (*connect.Response[v1.SubmitSwapResponse], error)
 	// GetTrade returns details and status of a specific trade
 	GetTrade(context.Context, *connect.Request[v1.GetTradeRequest]) (*connect.Response[v1.Trade], error)
 	// ListTrades returns all trades
 	ListTrades(context.Context, *connect.Request[v1.ListTradesRequest]) (*connect.Response[v1.ListTradesResponse], error)
+	// GetTradeReceipt compares how a completed swap filled with its quote
+	GetTradeReceipt(context.Context, *connect.Request[v1.GetTradeReceiptRequest]) (*connect.Response[v1.GetTradeReceiptResponse], error)
+	// GetPaperPortfolio returns a wallet's paper trading balances valued at current
+	// prices, funding the account with its starting SOL on first use
+	GetPaperPortfolio(context.Context, *connect.Request[v1.GetPaperPortfolioRequest]) (*connect.Response[v1.GetPaperPortfolioResponse], error)
+	// ResetPaperPortfolio discards a wallet's paper balances and trades and starts
+	// it over with the starting SOL
+	ResetPaperPortfolio(context.Context, *connect.Request[v1.ResetPaperPortfolioRequest]) (*connect.Response[v1.GetPaperPortfolioResponse], error)
+	// GetMaxSpendable returns the most of a coin a wallet can swap into another,
+	// after the SOL for network fees and new token account rent is set aside
+	GetMaxSpendable(context.Context, *connect.Request[v1.GetMaxSpendableRequest]) (*connect.Response[v1.GetMaxSpendableResponse], error)
+	// ValidateTrade checks a swap's balance, fees, route and tradability without
+	// quoting it in full, so the app can disable the swap button with a reason
+	ValidateTrade(context.Context, *connect.Request[v1.ValidateTradeRequest]) (*connect.Response[v1.ValidateTradeResponse], error)
+	// CreateTwapOrder splits a large swap into slices prepared on a schedule.
+	// Each prepared slice is signed and submitted with SubmitSwap by its quote_id.
+	CreateTwapOrder(context.Context, *connect.Request[v1.CreateTwapOrderRequest]) (*connect.Response[v1.TwapOrder], error)
+	// ListTwapOrders returns a wallet's TWAP orders, newest first, with their slices
+	ListTwapOrders(context.Context, *connect.Request[v1.ListTwapOrdersRequest]) (*connect.Response[v1.ListTwapOrdersResponse], error)
+	// CancelTwapOrder stops preparing an order's slices and withdraws any
+	// prepared slice; submitted slices still settle
+	CancelTwapOrder(context.Context, *connect.Request[v1.CancelTwapOrderRequest]) (*connect.Response[v1.TwapOrder], error)
 }
 
 // NewTradeServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -172,6 +357,12 @@ func NewTradeServiceHandler(svc TradeServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(tradeServiceMethods.ByName("PrepareSwap")),
 		connect.WithHandlerOptions(opts...),
 	)
+	tradeServiceRefreshQuoteHandler := connect.NewUnaryHandler(
+		TradeServiceRefreshQuoteProcedure,
+		svc.RefreshQuote,
+		connect.WithSchema(tradeServiceMethods.ByName("RefreshQuote")),
+		connect.WithHandlerOptions(opts...),
+	)
 	tradeServiceSubmitSwapHandler := connect.NewUnaryHandler(
 		TradeServiceSubmitSwapProcedure,
 		svc.SubmitSwap,
@@ -190,18 +381,84 @@ func NewTradeServiceHandler(svc TradeServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(tradeServiceMethods.ByName("ListTrades")),
 		connect.WithHandlerOptions(opts...),
 	)
+	tradeServiceGetTradeReceiptHandler := connect.NewUnaryHandler(
+		TradeServiceGetTradeReceiptProcedure,
+		svc.GetTradeReceipt,
+		connect.WithSchema(tradeServiceMethods.ByName("GetTradeReceipt")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tradeServiceGetPaperPortfolioHandler := connect.NewUnaryHandler(
+		TradeServiceGetPaperPortfolioProcedure,
+		svc.GetPaperPortfolio,
+		connect.WithSchema(tradeServiceMethods.ByName("GetPaperPortfolio")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tradeServiceResetPaperPortfolioHandler := connect.NewUnaryHandler(
+		TradeServiceResetPaperPortfolioProcedure,
+		svc.ResetPaperPortfolio,
+		connect.WithSchema(tradeServiceMethods.ByName("ResetPaperPortfolio")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tradeServiceGetMaxSpendableHandler := connect.NewUnaryHandler(
+		TradeServiceGetMaxSpendableProcedure,
+		svc.GetMaxSpendable,
+		connect.WithSchema(tradeServiceMethods.ByName("GetMaxSpendable")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tradeServiceValidateTradeHandler := connect.NewUnaryHandler(
+		TradeServiceValidateTradeProcedure,
+		svc.ValidateTrade,
+		connect.WithSchema(tradeServiceMethods.ByName("ValidateTrade")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tradeServiceCreateTwapOrderHandler := connect.NewUnaryHandler(
+		TradeServiceCreateTwapOrderProcedure,
+		svc.CreateTwapOrder,
+		connect.WithSchema(tradeServiceMethods.ByName("CreateTwapOrder")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tradeServiceListTwapOrdersHandler := connect.NewUnaryHandler(
+		TradeServiceListTwapOrdersProcedure,
+		svc.ListTwapOrders,
+		connect.WithSchema(tradeServiceMethods.ByName("ListTwapOrders")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tradeServiceCancelTwapOrderHandler := connect.NewUnaryHandler(
+		TradeServiceCancelTwapOrderProcedure,
+		svc.CancelTwapOrder,
+		connect.WithSchema(tradeServiceMethods.ByName("CancelTwapOrder")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/dankfolio.v1.TradeService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case TradeServiceGetSwapQuoteProcedure:
 			tradeServiceGetSwapQuoteHandler.ServeHTTP(w, r)
 		case TradeServicePrepareSwapProcedure:
 			tradeServicePrepareSwapHandler.ServeHTTP(w, r)
+		case TradeServiceRefreshQuoteProcedure:
+			tradeServiceRefreshQuoteHandler.ServeHTTP(w, r)
 		case TradeServiceSubmitSwapProcedure:
 			tradeServiceSubmitSwapHandler.ServeHTTP(w, r)
 		case TradeServiceGetTradeProcedure:
 			tradeServiceGetTradeHandler.ServeHTTP(w, r)
 		case TradeServiceListTradesProcedure:
 			tradeServiceListTradesHandler.ServeHTTP(w, r)
+		case TradeServiceGetTradeReceiptProcedure:
+			tradeServiceGetTradeReceiptHandler.ServeHTTP(w, r)
+		case TradeServiceGetPaperPortfolioProcedure:
+			tradeServiceGetPaperPortfolioHandler.ServeHTTP(w, r)
+		case TradeServiceResetPaperPortfolioProcedure:
+			tradeServiceResetPaperPortfolioHandler.ServeHTTP(w, r)
+		case TradeServiceGetMaxSpendableProcedure:
+			tradeServiceGetMaxSpendableHandler.ServeHTTP(w, r)
+		case TradeServiceValidateTradeProcedure:
+			tradeServiceValidateTradeHandler.ServeHTTP(w, r)
+		case TradeServiceCreateTwapOrderProcedure:
+			tradeServiceCreateTwapOrderHandler.ServeHTTP(w, r)
+		case TradeServiceListTwapOrdersProcedure:
+			tradeServiceListTwapOrdersHandler.ServeHTTP(w, r)
+		case TradeServiceCancelTwapOrderProcedure:
+			tradeServiceCancelTwapOrderHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -219,6 +476,10 @@ func (UnimplementedTradeServiceHandler) PrepareSwap(context.Context, *connect.Re
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.TradeService.PrepareSwap is not implemented"))
 }
 
+func (UnimplementedTradeServiceHandler) RefreshQuote(context.Context, *connect.Request[v1.RefreshQuoteRequest]) (*connect.Response[v1.PrepareSwapResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.TradeService.RefreshQuote is not implemented"))
+}
+
 func (UnimplementedTradeServiceHandler) SubmitSwap(context.Context, *connect.Request[v1.SubmitSwapRequest]) (*connect.Response[v1.SubmitSwapResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.TradeService.SubmitSwap is not implemented"))
 }
@@ -230,3 +491,35 @@ func (UnimplementedTradeServiceHandler) GetTrade(context.Context, *connect.Reque
 func (UnimplementedTradeServiceHandler) ListTrades(context.Context, *connect.Request[v1.ListTradesRequest]) (*connect.Response[v1.ListTradesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.TradeService.ListTrades is not implemented"))
 }
+
+func (UnimplementedTradeServiceHandler) GetTradeReceipt(context.Context, *connect.Request[v1.GetTradeReceiptRequest]) (*connect.Response[v1.GetTradeReceiptResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.TradeService.GetTradeReceipt is not implemented"))
+}
+
+func (UnimplementedTradeServiceHandler) GetPaperPortfolio(context.Context, *connect.Request[v1.GetPaperPortfolioRequest]) (*connect.Response[v1.GetPaperPortfolioResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.TradeService.GetPaperPortfolio is not implemented"))
+}
+
+func (UnimplementedTradeServiceHandler) ResetPaperPortfolio(context.Context, *connect.Request[v1.ResetPaperPortfolioRequest]) (*connect.Response[v1.GetPaperPortfolioResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.TradeService.ResetPaperPortfolio is not implemented"))
+}
+
+func (UnimplementedTradeServiceHandler) GetMaxSpendable(context.Context, *connect.Request[v1.GetMaxSpendableRequest]) (*connect.Response[v1.GetMaxSpendableResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.TradeService.GetMaxSpendable is not implemented"))
+}
+
+func (UnimplementedTradeServiceHandler) ValidateTrade(context.Context, *connect.Request[v1.ValidateTradeRequest]) (*connect.Response[v1.ValidateTradeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.TradeService.ValidateTrade is not implemented"))
+}
+
+func (UnimplementedTradeServiceHandler) CreateTwapOrder(context.Context, *connect.Request[v1.CreateTwapOrderRequest]) (*connect.Response[v1.TwapOrder], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.TradeService.CreateTwapOrder is not implemented"))
+}
+
+func (UnimplementedTradeServiceHandler) ListTwapOrders(context.Context, *connect.Request[v1.ListTwapOrdersRequest]) (*connect.Response[v1.ListTwapOrdersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.TradeService.ListTwapOrders is not implemented"))
+}
+
+func (UnimplementedTradeServiceHandler) CancelTwapOrder(context.Context, *connect.Request[v1.CancelTwapOrderRequest]) (*connect.Response[v1.TwapOrder], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.TradeService.CancelTwapOrder is not implemented"))
+}
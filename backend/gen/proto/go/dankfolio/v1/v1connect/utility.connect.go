@@ -39,6 +39,12 @@ const (
 	// UtilityServiceDeleteAccountProcedure is the fully-qualified name of the UtilityService's
 	// DeleteAccount RPC.
 	UtilityServiceDeleteAccountProcedure = "/dankfolio.v1.UtilityService/DeleteAccount"
+	// UtilityServiceDeleteMyDataProcedure is the fully-qualified name of the UtilityService's
+	// DeleteMyData RPC.
+	UtilityServiceDeleteMyDataProcedure = "/dankfolio.v1.UtilityService/DeleteMyData"
+	// UtilityServiceGetDataDeletionProcedure is the fully-qualified name of the UtilityService's
+	// GetDataDeletion RPC.
+	UtilityServiceGetDataDeletionProcedure = "/dankfolio.v1.UtilityService/GetDataDeletion"
 )
 
 // UtilityServiceClient is a client for the dankfolio.v1.UtilityService service.
@@ -49,6 +55,15 @@ type UtilityServiceClient interface {
 	// DeleteAccount deletes all user data associated with the authenticated user.
 	// This is required for App Store compliance (Guideline 5.1.1(v)).
 	DeleteAccount(context.Context, *connect.Request[v1.DeleteAccountRequest]) (*connect.Response[v1.DeleteAccountResponse], error)
+	// DeleteMyData queues the erasure of the user's personal data: linked wallets,
+	// trades, alerts, notification tokens and view history. The erasure runs in
+	// the background and is certified once verified; poll GetDataDeletion for it.
+	// Watchlists are only kept on the device, which clears them itself.
+	// This is required for App Store privacy compliance.
+	DeleteMyData(context.Context, *connect.Request[v1.DeleteMyDataRequest]) (*connect.Response[v1.DeleteMyDataResponse], error)
+	// GetDataDeletion returns the status of a DeleteMyData request, with its
+	// deletion certificate once completed.
+	GetDataDeletion(context.Context, *connect.Request[v1.GetDataDeletionRequest]) (*connect.Response[v1.GetDataDeletionResponse], error)
 }
 
 // NewUtilityServiceClient constructs a client for the dankfolio.v1.UtilityService service. By
@@ -74,6 +89,18 @@ func NewUtilityServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(utilityServiceMethods.ByName("DeleteAccount")),
 			connect.WithClientOptions(opts...),
 		),
+		deleteMyData: connect.NewClient[v1.DeleteMyDataRequest, v1.DeleteMyDataResponse](
+			httpClient,
+			baseURL+UtilityServiceDeleteMyDataProcedure,
+			connect.WithSchema(utilityServiceMethods.ByName("DeleteMyData")),
+			connect.WithClientOptions(opts...),
+		),
+		getDataDeletion: connect.NewClient[v1.GetDataDeletionRequest, v1.GetDataDeletionResponse](
+			httpClient,
+			baseURL+UtilityServiceGetDataDeletionProcedure,
+			connect.WithSchema(utilityServiceMethods.ByName("GetDataDeletion")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -81,6 +108,8 @@ func NewUtilityServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 type utilityServiceClient struct {
 	getProxiedImage *connect.Client[v1.GetProxiedImageRequest, v1.GetProxiedImageResponse]
 	deleteAccount   *connect.Client[v1.DeleteAccountRequest, v1.DeleteAccountResponse]
+	deleteMyData    *connect.Client[v1.DeleteMyDataRequest, v1.DeleteMyDataResponse]
+	getDataDeletion *connect.Client[v1.GetDataDeletionRequest, v1.GetDataDeletionResponse]
 }
 
 // GetProxiedImage calls dankfolio.v1.UtilityService.GetProxiedImage.
@@ -93,6 +122,16 @@ func (c *utilityServiceClient) DeleteAccount(ctx context.Context, req *connect.R
 	return c.deleteAccount.CallUnary(ctx, req)
 }
 
+// DeleteMyData calls dankfolio.v1.UtilityService.DeleteMyData.
+func (c *utilityServiceClient) DeleteMyData(ctx context.Context, req *connect.Request[v1.DeleteMyDataRequest]) (*connect.Response[v1.DeleteMyDataResponse], error) {
+	return c.deleteMyData.CallUnary(ctx, req)
+}
+
+// GetDataDeletion calls dankfolio.v1.UtilityService.GetDataDeletion.
+func (c *utilityServiceClient) GetDataDeletion(ctx context.Context, req *connect.Request[v1.GetDataDeletionRequest]) (*connect.Response[v1.GetDataDeletionResponse], error) {
+	return c.getDataDeletion.CallUnary(ctx, req)
+}
+
 // UtilityServiceHandler is an implementation of the dankfolio.v1.UtilityService service.
 type UtilityServiceHandler interface {
 	// GetProxiedImage fetches an image from an external URL via the backend proxy.
@@ -101,6 +140,15 @@ type UtilityServiceHandler interface {
 	// DeleteAccount deletes all user data associated with the authenticated user.
 	// This is required for App Store compliance (Guideline 5.1.1(v)).
 	DeleteAccount(context.Context, *connect.Request[v1.DeleteAccountRequest]) (*connect.Response[v1.DeleteAccountResponse], error)
+	// DeleteMyData queues the erasure of the user's personal data: linked wallets,
+	// trades, alerts, notification tokens and view history. The erasure runs in
+	// the background and is certified once verified; poll GetDataDeletion for it.
+	// Watchlists are only kept on the device, which clears them itself.
+	// This is required for App Store privacy compliance.
+	DeleteMyData(context.Context, *connect.Request[v1.DeleteMyDataRequest]) (*connect.Response[v1.DeleteMyDataResponse], error)
+	// GetDataDeletion returns the status of a DeleteMyData request, with its
+	// deletion certificate once completed.
+	GetDataDeletion(context.Context, *connect.Request[v1.GetDataDeletionRequest]) (*connect.Response[v1.GetDataDeletionResponse], error)
 }
 
 // NewUtilityServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -122,12 +170,28 @@ func NewUtilityServiceHandler(svc UtilityServiceHandler, opts ...connect.Handler
 		connect.WithSchema(utilityServiceMethods.ByName("DeleteAccount")),
 		connect.WithHandlerOptions(opts...),
 	)
+	utilityServiceDeleteMyDataHandler := connect.NewUnaryHandler(
+		UtilityServiceDeleteMyDataProcedure,
+		svc.DeleteMyData,
+		connect.WithSchema(utilityServiceMethods.ByName("DeleteMyData")),
+		connect.WithHandlerOptions(opts...),
+	)
+	utilityServiceGetDataDeletionHandler := connect.NewUnaryHandler(
+		UtilityServiceGetDataDeletionProcedure,
+		svc.GetDataDeletion,
+		connect.WithSchema(utilityServiceMethods.ByName("GetDataDeletion")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/dankfolio.v1.UtilityService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case UtilityServiceGetProxiedImageProcedure:
 			utilityServiceGetProxiedImageHandler.ServeHTTP(w, r)
 		case UtilityServiceDeleteAccountProcedure:
 			utilityServiceDeleteAccountHandler.ServeHTTP(w, r)
+		case UtilityServiceDeleteMyDataProcedure:
+			utilityServiceDeleteMyDataHandler.ServeHTTP(w, r)
+		case UtilityServiceGetDataDeletionProcedure:
+			utilityServiceGetDataDeletionHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -144,3 +208,11 @@ func (UnimplementedUtilityServiceHandler) GetProxiedImage(context.Context, *conn
 func (UnimplementedUtilityServiceHandler) DeleteAccount(context.Context, *connect.Request[v1.DeleteAccountRequest]) (*connect.Response[v1.DeleteAccountResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.UtilityService.DeleteAccount is not implemented"))
 }
+
+func (UnimplementedUtilityServiceHandler) DeleteMyData(context.Context, *connect.Request[v1.DeleteMyDataRequest]) (*connect.Response[v1.DeleteMyDataResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.UtilityService.DeleteMyData is not implemented"))
+}
+
+func (UnimplementedUtilityServiceHandler) GetDataDeletion(context.Context, *connect.Request[v1.GetDataDeletionRequest]) (*connect.Response[v1.GetDataDeletionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.UtilityService.GetDataDeletion is not implemented"))
+}
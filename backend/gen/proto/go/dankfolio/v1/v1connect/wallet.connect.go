@@ -48,6 +48,12 @@ const (
 	// WalletServiceGetPortfolioPnLProcedure is the fully-qualified name of the WalletService's
 	// GetPortfolioPnL RPC.
 	WalletServiceGetPortfolioPnLProcedure = "/dankfolio.v1.WalletService/GetPortfolioPnL"
+	// WalletServiceGetTokenApprovalsProcedure is the fully-qualified name of the WalletService's
+	// GetTokenApprovals RPC.
+	WalletServiceGetTokenApprovalsProcedure = "/dankfolio.v1.WalletService/GetTokenApprovals"
+	// WalletServicePrepareRevokeApprovalsProcedure is the fully-qualified name of the WalletService's
+	// PrepareRevokeApprovals RPC.
+	WalletServicePrepareRevokeApprovalsProcedure = "/dankfolio.v1.WalletService/PrepareRevokeApprovals"
 )
 
 // WalletServiceClient is a client for the dankfolio.v1.WalletService service.
@@ -62,6 +68,10 @@ type WalletServiceClient interface {
 	SubmitTransfer(context.Context, *connect.Request[v1.SubmitTransferRequest]) (*connect.Response[v1.SubmitTransferResponse], error)
 	// GetPortfolioPnL returns the overall profit and loss for a wallet
 	GetPortfolioPnL(context.Context, *connect.Request[v1.GetPortfolioPnLRequest]) (*connect.Response[v1.GetPortfolioPnLResponse], error)
+	// GetTokenApprovals lists token accounts in a wallet that have an active delegate
+	GetTokenApprovals(context.Context, *connect.Request[v1.GetTokenApprovalsRequest]) (*connect.Response[v1.GetTokenApprovalsResponse], error)
+	// PrepareRevokeApprovals prepares an unsigned transaction revoking token delegates
+	PrepareRevokeApprovals(context.Context, *connect.Request[v1.PrepareRevokeApprovalsRequest]) (*connect.Response[v1.PrepareRevokeApprovalsResponse], error)
 }
 
 // NewWalletServiceClient constructs a client for the dankfolio.v1.WalletService service. By
@@ -105,16 +115,30 @@ func NewWalletServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(walletServiceMethods.ByName("GetPortfolioPnL")),
 			connect.WithClientOptions(opts...),
 		),
+		getTokenApprovals: connect.NewClient[v1.GetTokenApprovalsRequest, v1.GetTokenApprovalsResponse](
+			httpClient,
+			baseURL+WalletServiceGetTokenApprovalsProcedure,
+			connect.WithSchema(walletServiceMethods.ByName("GetTokenApprovals")),
+			connect.WithClientOptions(opts...),
+		),
+		prepareRevokeApprovals: connect.NewClient[v1.PrepareRevokeApprovalsRequest, v1.PrepareRevokeApprovalsResponse](
+			httpClient,
+			baseURL+WalletServicePrepareRevokeApprovalsProcedure,
+			connect.WithSchema(walletServiceMethods.ByName("PrepareRevokeApprovals")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // walletServiceClient implements WalletServiceClient.
 type walletServiceClient struct {
-	getWalletBalances *connect.Client[v1.GetWalletBalancesRequest, v1.GetWalletBalancesResponse]
-	registerWallet    *connect.Client[v1.RegisterWalletRequest, v1.RegisterWalletResponse]
-	prepareTransfer   *connect.Client[v1.PrepareTransferRequest, v1.PrepareTransferResponse]
-	submitTransfer    *connect.Client[v1.SubmitTransferRequest, v1.SubmitTransferResponse]
-	getPortfolioPnL   *connect.Client[v1.GetPortfolioPnLRequest, v1.GetPortfolioPnLResponse]
+	getWalletBalances      *connect.Client[v1.GetWalletBalancesRequest, v1.GetWalletBalancesResponse]
+	registerWallet         *connect.Client[v1.RegisterWalletRequest, v1.RegisterWalletResponse]
+	prepareTransfer        *connect.Client[v1.PrepareTransferRequest, v1.PrepareTransferResponse]
+	submitTransfer         *connect.Client[v1.SubmitTransferRequest, v1.SubmitTransferResponse]
+	getPortfolioPnL        *connect.Client[v1.GetPortfolioPnLRequest, v1.GetPortfolioPnLResponse]
+	getTokenApprovals      *connect.Client[v1.GetTokenApprovalsRequest, v1.GetTokenApprovalsResponse]
+	prepareRevokeApprovals *connect.Client[v1.PrepareRevokeApprovalsRequest, v1.PrepareRevokeApprovalsResponse]
 }
 
 // GetWalletBalances calls dankfolio.v1.WalletService.GetWalletBalances.
@@ -142,6 +166,16 @@ func (c *walletServiceClient) GetPortfolioPnL(ctx context.Context, req *connect.
 	return c.getPortfolioPnL.CallUnary(ctx, req)
 }
 
+// GetTokenApprovals calls dankfolio.v1.WalletService.GetTokenApprovals.
+func (c *walletServiceClient) GetTokenApprovals(ctx context.Context, req *connect.Request[v1.GetTokenApprovalsRequest]) (*connect.Response[v1.GetTokenApprovalsResponse], error) {
+	return c.getTokenApprovals.CallUnary(ctx, req)
+}
+
+// PrepareRevokeApprovals calls dankfolio.v1.WalletService.PrepareRevokeApprovals.
+func (c *walletServiceClient) PrepareRevokeApprovals(ctx context.Context, req *connect.Request[v1.PrepareRevokeApprovalsRequest]) (*connect.Response[v1.PrepareRevokeApprovalsResponse], error) {
+	return c.prepareRevokeApprovals.CallUnary(ctx, req)
+}
+
 // WalletServiceHandler is an implementation of the dankfolio.v1.WalletService service.
 type WalletServiceHandler interface {
 	// GetWalletBalances returns the balances for all coins in a wallet
@@ -154,6 +188,10 @@ type WalletServiceHandler interface {
 	SubmitTransfer(context.Context, *connect.Request[v1.SubmitTransferRequest]) (*connect.Response[v1.SubmitTransferResponse], error)
 	// GetPortfolioPnL returns the overall profit and loss for a wallet
 	GetPortfolioPnL(context.Context, *connect.Request[v1.GetPortfolioPnLRequest]) (*connect.Response[v1.GetPortfolioPnLResponse], error)
+	// GetTokenApprovals lists token accounts in a wallet that have an active delegate
+	GetTokenApprovals(context.Context, *connect.Request[v1.GetTokenApprovalsRequest]) (*connect.Response[v1.GetTokenApprovalsResponse], error)
+	// PrepareRevokeApprovals prepares an unsigned transaction revoking token delegates
+	PrepareRevokeApprovals(context.Context, *connect.Request[v1.PrepareRevokeApprovalsRequest]) (*connect.Response[v1.PrepareRevokeApprovalsResponse], error)
 }
 
 // NewWalletServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -193,6 +231,18 @@ func NewWalletServiceHandler(svc WalletServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(walletServiceMethods.ByName("GetPortfolioPnL")),
 		connect.WithHandlerOptions(opts...),
 	)
+	walletServiceGetTokenApprovalsHandler := connect.NewUnaryHandler(
+		WalletServiceGetTokenApprovalsProcedure,
+		svc.GetTokenApprovals,
+		connect.WithSchema(walletServiceMethods.ByName("GetTokenApprovals")),
+		connect.WithHandlerOptions(opts...),
+	)
+	walletServicePrepareRevokeApprovalsHandler := connect.NewUnaryHandler(
+		WalletServicePrepareRevokeApprovalsProcedure,
+		svc.PrepareRevokeApprovals,
+		connect.WithSchema(walletServiceMethods.ByName("PrepareRevokeApprovals")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/dankfolio.v1.WalletService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case WalletServiceGetWalletBalancesProcedure:
@@ -205,6 +255,10 @@ func NewWalletServiceHandler(svc WalletServiceHandler, opts ...connect.HandlerOp
 			walletServiceSubmitTransferHandler.ServeHTTP(w, r)
 		case WalletServiceGetPortfolioPnLProcedure:
 			walletServiceGetPortfolioPnLHandler.ServeHTTP(w, r)
+		case WalletServiceGetTokenApprovalsProcedure:
+			walletServiceGetTokenApprovalsHandler.ServeHTTP(w, r)
+		case WalletServicePrepareRevokeApprovalsProcedure:
+			walletServicePrepareRevokeApprovalsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -233,3 +287,11 @@ func (UnimplementedWalletServiceHandler) SubmitTransfer(context.Context, *connec
 func (UnimplementedWalletServiceHandler) GetPortfolioPnL(context.Context, *connect.Request[v1.GetPortfolioPnLRequest]) (*connect.Response[v1.GetPortfolioPnLResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.WalletService.GetPortfolioPnL is not implemented"))
 }
+
+func (UnimplementedWalletServiceHandler) GetTokenApprovals(context.Context, *connect.Request[v1.GetTokenApprovalsRequest]) (*connect.Response[v1.GetTokenApprovalsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.WalletService.GetTokenApprovals is not implemented"))
+}
+
+func (UnimplementedWalletServiceHandler) PrepareRevokeApprovals(context.Context, *connect.Request[v1.PrepareRevokeApprovalsRequest]) (*connect.Response[v1.PrepareRevokeApprovalsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.WalletService.PrepareRevokeApprovals is not implemented"))
+}
@@ -0,0 +1,204 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: dankfolio/v1/webhook.proto
+
+package v1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// WebhookServiceName is the fully-qualified name of the WebhookService service.
+	WebhookServiceName = "dankfolio.v1.WebhookService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// WebhookServiceCreateWebhookSubscriptionProcedure is the fully-qualified name of the
+	// WebhookService's CreateWebhookSubscription RPC.
+	WebhookServiceCreateWebhookSubscriptionProcedure = "/dankfolio.v1.WebhookService/CreateWebhookSubscription"
+	// WebhookServiceListWebhookSubscriptionsProcedure is the fully-qualified name of the
+	// WebhookService's ListWebhookSubscriptions RPC.
+	WebhookServiceListWebhookSubscriptionsProcedure = "/dankfolio.v1.WebhookService/ListWebhookSubscriptions"
+	// WebhookServiceDeleteWebhookSubscriptionProcedure is the fully-qualified name of the
+	// WebhookService's DeleteWebhookSubscription RPC.
+	WebhookServiceDeleteWebhookSubscriptionProcedure = "/dankfolio.v1.WebhookService/DeleteWebhookSubscription"
+	// WebhookServiceListWebhookDeadLettersProcedure is the fully-qualified name of the WebhookService's
+	// ListWebhookDeadLetters RPC.
+	WebhookServiceListWebhookDeadLettersProcedure = "/dankfolio.v1.WebhookService/ListWebhookDeadLetters"
+)
+
+// WebhookServiceClient is a client for the dankfolio.v1.WebhookService service.
+type WebhookServiceClient interface {
+	// CreateWebhookSubscription registers a partner URL for one or more event types.
+	CreateWebhookSubscription(context.Context, *connect.Request[v1.CreateWebhookSubscriptionRequest]) (*connect.Response[v1.CreateWebhookSubscriptionResponse], error)
+	// ListWebhookSubscriptions lists registered subscriptions, optionally for a single partner.
+	ListWebhookSubscriptions(context.Context, *connect.Request[v1.ListWebhookSubscriptionsRequest]) (*connect.Response[v1.ListWebhookSubscriptionsResponse], error)
+	// DeleteWebhookSubscription removes a subscription; no further deliveries are made to it.
+	DeleteWebhookSubscription(context.Context, *connect.Request[v1.DeleteWebhookSubscriptionRequest]) (*connect.Response[v1.DeleteWebhookSubscriptionResponse], error)
+	// ListWebhookDeadLetters lists deliveries that failed after all retries.
+	ListWebhookDeadLetters(context.Context, *connect.Request[v1.ListWebhookDeadLettersRequest]) (*connect.Response[v1.ListWebhookDeadLettersResponse], error)
+}
+
+// NewWebhookServiceClient constructs a client for the dankfolio.v1.WebhookService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewWebhookServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) WebhookServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	webhookServiceMethods := v1.File_dankfolio_v1_webhook_proto.Services().ByName("WebhookService").Methods()
+	return &webhookServiceClient{
+		createWebhookSubscription: connect.NewClient[v1.CreateWebhookSubscriptionRequest, v1.CreateWebhookSubscriptionResponse](
+			httpClient,
+			baseURL+WebhookServiceCreateWebhookSubscriptionProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("CreateWebhookSubscription")),
+			connect.WithClientOptions(opts...),
+		),
+		listWebhookSubscriptions: connect.NewClient[v1.ListWebhookSubscriptionsRequest, v1.ListWebhookSubscriptionsResponse](
+			httpClient,
+			baseURL+WebhookServiceListWebhookSubscriptionsProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("ListWebhookSubscriptions")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteWebhookSubscription: connect.NewClient[v1.DeleteWebhookSubscriptionRequest, v1.DeleteWebhookSubscriptionResponse](
+			httpClient,
+			baseURL+WebhookServiceDeleteWebhookSubscriptionProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("DeleteWebhookSubscription")),
+			connect.WithClientOptions(opts...),
+		),
+		listWebhookDeadLetters: connect.NewClient[v1.ListWebhookDeadLettersRequest, v1.ListWebhookDeadLettersResponse](
+			httpClient,
+			baseURL+WebhookServiceListWebhookDeadLettersProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("ListWebhookDeadLetters")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// webhookServiceClient implements WebhookServiceClient.
+type webhookServiceClient struct {
+	createWebhookSubscription *connect.Client[v1.CreateWebhookSubscriptionRequest, v1.CreateWebhookSubscriptionResponse]
+	listWebhookSubscriptions  *connect.Client[v1.ListWebhookSubscriptionsRequest, v1.ListWebhookSubscriptionsResponse]
+	deleteWebhookSubscription *connect.Client[v1.DeleteWebhookSubscriptionRequest, v1.DeleteWebhookSubscriptionResponse]
+	listWebhookDeadLetters    *connect.Client[v1.ListWebhookDeadLettersRequest, v1.ListWebhookDeadLettersResponse]
+}
+
+// CreateWebhookSubscription calls dankfolio.v1.WebhookService.CreateWebhookSubscription.
+func (c *webhookServiceClient) CreateWebhookSubscription(ctx context.Context, req *connect.Request[v1.CreateWebhookSubscriptionRequest]) (*connect.Response[v1.CreateWebhookSubscriptionResponse], error) {
+	return c.createWebhookSubscription.CallUnary(ctx, req)
+}
+
+// ListWebhookSubscriptions calls dankfolio.v1.WebhookService.ListWebhookSubscriptions.
+func (c *webhookServiceClient) ListWebhookSubscriptions(ctx context.Context, req *connect.Request[v1.ListWebhookSubscriptionsRequest]) (*connect.Response[v1.ListWebhookSubscriptionsResponse], error) {
+	return c.listWebhookSubscriptions.CallUnary(ctx, req)
+}
+
+// DeleteWebhookSubscription calls dankfolio.v1.WebhookService.DeleteWebhookSubscription.
+func (c *webhookServiceClient) DeleteWebhookSubscription(ctx context.Context, req *connect.Request[v1.DeleteWebhookSubscriptionRequest]) (*connect.Response[v1.DeleteWebhookSubscriptionResponse], error) {
+	return c.deleteWebhookSubscription.CallUnary(ctx, req)
+}
+
+// ListWebhookDeadLetters calls dankfolio.v1.WebhookService.ListWebhookDeadLetters.
+func (c *webhookServiceClient) ListWebhookDeadLetters(ctx context.Context, req *connect.Request[v1.ListWebhookDeadLettersRequest]) (*connect.Response[v1.ListWebhookDeadLettersResponse], error) {
+	return c.listWebhookDeadLetters.CallUnary(ctx, req)
+}
+
+// WebhookServiceHandler is an implementation of the dankfolio.v1.WebhookService service.
+type WebhookServiceHandler interface {
+	// CreateWebhookSubscription registers a partner URL for one or more event types.
+	CreateWebhookSubscription(context.Context, *connect.Request[v1.CreateWebhookSubscriptionRequest]) (*connect.Response[v1.CreateWebhookSubscriptionResponse], error)
+	// ListWebhookSubscriptions lists registered subscriptions, optionally for a single partner.
+	ListWebhookSubscriptions(context.Context, *connect.Request[v1.ListWebhookSubscriptionsRequest]) (*connect.Response[v1.ListWebhookSubscriptionsResponse], error)
+	// DeleteWebhookSubscription removes a subscription; no further deliveries are made to it.
+	DeleteWebhookSubscription(context.Context, *connect.Request[v1.DeleteWebhookSubscriptionRequest]) (*connect.Response[v1.DeleteWebhookSubscriptionResponse], error)
+	// ListWebhookDeadLetters lists deliveries that failed after all retries.
+	ListWebhookDeadLetters(context.Context, *connect.Request[v1.ListWebhookDeadLettersRequest]) (*connect.Response[v1.ListWebhookDeadLettersResponse], error)
+}
+
+// NewWebhookServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewWebhookServiceHandler(svc WebhookServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	webhookServiceMethods := v1.File_dankfolio_v1_webhook_proto.Services().ByName("WebhookService").Methods()
+	webhookServiceCreateWebhookSubscriptionHandler := connect.NewUnaryHandler(
+		WebhookServiceCreateWebhookSubscriptionProcedure,
+		svc.CreateWebhookSubscription,
+		connect.WithSchema(webhookServiceMethods.ByName("CreateWebhookSubscription")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceListWebhookSubscriptionsHandler := connect.NewUnaryHandler(
+		WebhookServiceListWebhookSubscriptionsProcedure,
+		svc.ListWebhookSubscriptions,
+		connect.WithSchema(webhookServiceMethods.ByName("ListWebhookSubscriptions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceDeleteWebhookSubscriptionHandler := connect.NewUnaryHandler(
+		WebhookServiceDeleteWebhookSubscriptionProcedure,
+		svc.DeleteWebhookSubscription,
+		connect.WithSchema(webhookServiceMethods.ByName("DeleteWebhookSubscription")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceListWebhookDeadLettersHandler := connect.NewUnaryHandler(
+		WebhookServiceListWebhookDeadLettersProcedure,
+		svc.ListWebhookDeadLetters,
+		connect.WithSchema(webhookServiceMethods.ByName("ListWebhookDeadLetters")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/dankfolio.v1.WebhookService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case WebhookServiceCreateWebhookSubscriptionProcedure:
+			webhookServiceCreateWebhookSubscriptionHandler.ServeHTTP(w, r)
+		case WebhookServiceListWebhookSubscriptionsProcedure:
+			webhookServiceListWebhookSubscriptionsHandler.ServeHTTP(w, r)
+		case WebhookServiceDeleteWebhookSubscriptionProcedure:
+			webhookServiceDeleteWebhookSubscriptionHandler.ServeHTTP(w, r)
+		case WebhookServiceListWebhookDeadLettersProcedure:
+			webhookServiceListWebhookDeadLettersHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedWebhookServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedWebhookServiceHandler struct{}
+
+func (UnimplementedWebhookServiceHandler) CreateWebhookSubscription(context.Context, *connect.Request[v1.CreateWebhookSubscriptionRequest]) (*connect.Response[v1.CreateWebhookSubscriptionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.WebhookService.CreateWebhookSubscription is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) ListWebhookSubscriptions(context.Context, *connect.Request[v1.ListWebhookSubscriptionsRequest]) (*connect.Response[v1.ListWebhookSubscriptionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.WebhookService.ListWebhookSubscriptions is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) DeleteWebhookSubscription(context.Context, *connect.Request[v1.DeleteWebhookSubscriptionRequest]) (*connect.Response[v1.DeleteWebhookSubscriptionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.WebhookService.DeleteWebhookSubscription is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) ListWebhookDeadLetters(context.Context, *connect.Request[v1.ListWebhookDeadLettersRequest]) (*connect.Response[v1.ListWebhookDeadLettersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dankfolio.v1.WebhookService.ListWebhookDeadLetters is not implemented"))
+}
@@ -9,6 +9,7 @@ package v1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -23,11 +24,19 @@ const (
 
 // Balance represents information about a coin balance
 type Balance struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`           // Coin mint address or identifier
-	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"` // Coin amount
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                                     // Coin mint address or identifier
+	Amount           float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`                                           // Coin amount
+	IsSpam           bool                   `protobuf:"varint,3,opt,name=is_spam,json=isSpam,proto3" json:"is_spam,omitempty"`                              // Flagged by the spam filter
+	SpamReasons      []string               `protobuf:"bytes,4,rep,name=spam_reasons,json=spamReasons,proto3" json:"spam_reasons,omitempty"`                // Why the balance was flagged, e.g. "deny_list", "zero_liquidity"
+	RawAmount        string                 `protobuf:"bytes,5,opt,name=raw_amount,json=rawAmount,proto3" json:"raw_amount,omitempty"`                      // Exact amount in base units (lamports for SOL); amount is for display only
+	Decimals         uint32                 `protobuf:"varint,6,opt,name=decimals,proto3" json:"decimals,omitempty"`                                        // Scale of raw_amount: amount = raw_amount / 10^decimals
+	SolBreakdown     *SolBreakdown          `protobuf:"bytes,7,opt,name=sol_breakdown,json=solBreakdown,proto3" json:"sol_breakdown,omitempty"`             // Native and wrapped parts of the SOL balance; only set on SOL
+	SuggestedActions []string               `protobuf:"bytes,8,rep,name=suggested_actions,json=suggestedActions,proto3" json:"suggested_actions,omitempty"` // Actions worth offering for this balance, e.g. "unwrap" when wrapped SOL is held
+	Category         string                 `protobuf:"bytes,9,opt,name=category,proto3" json:"category,omitempty"`                                         // Portfolio category: "sol", "liquid_staking" or "token"
+	LiquidStaking    *LiquidStaking         `protobuf:"bytes,10,opt,name=liquid_staking,json=liquidStaking,proto3" json:"liquid_staking,omitempty"`         // SOL value of a liquid staking token; unset when its exchange rate is unknown
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *Balance) Reset() {
@@ -74,17 +83,213 @@ func (x *Balance) GetAmount() float64 {
 	return 0
 }
 
+func (x *Balance) GetIsSpam() bool {
+	if x != nil {
+		return x.IsSpam
+	}
+	return false
+}
+
+func (x *Balance) GetSpamReasons() []string {
+	if x != nil {
+		return x.SpamReasons
+	}
+	return nil
+}
+
+func (x *Balance) GetRawAmount() string {
+	if x != nil {
+		return x.RawAmount
+	}
+	return ""
+}
+
+func (x *Balance) GetDecimals() uint32 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+func (x *Balance) GetSolBreakdown() *SolBreakdown {
+	if x != nil {
+		return x.SolBreakdown
+	}
+	return nil
+}
+
+func (x *Balance) GetSuggestedActions() []string {
+	if x != nil {
+		return x.SuggestedActions
+	}
+	return nil
+}
+
+func (x *Balance) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Balance) GetLiquidStaking() *LiquidStaking {
+	if x != nil {
+		return x.LiquidStaking
+	}
+	return nil
+}
+
+// LiquidStaking values a liquid staking token (mSOL, jitoSOL, bSOL) in the SOL it is redeemable for
+type LiquidStaking struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ExchangeRate       float64                `protobuf:"fixed64,1,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`                     // SOL per token
+	StakedSol          float64                `protobuf:"fixed64,2,opt,name=staked_sol,json=stakedSol,proto3" json:"staked_sol,omitempty"`                              // Effective staked SOL: amount * exchange_rate
+	EstimatedApy       float64                `protobuf:"fixed64,3,opt,name=estimated_apy,json=estimatedApy,proto3" json:"estimated_apy,omitempty"`                     // Typical staking yield of the pool, e.g. 0.07 for 7%
+	EstimatedYearlySol float64                `protobuf:"fixed64,4,opt,name=estimated_yearly_sol,json=estimatedYearlySol,proto3" json:"estimated_yearly_sol,omitempty"` // SOL the stake earns in a year at estimated_apy
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *LiquidStaking) Reset() {
+	*x = LiquidStaking{}
+	mi := &file_dankfolio_v1_wallet_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LiquidStaking) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiquidStaking) ProtoMessage() {}
+
+func (x *LiquidStaking) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_wallet_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiquidStaking.ProtoReflect.Descriptor instead.
+func (*LiquidStaking) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_wallet_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LiquidStaking) GetExchangeRate() float64 {
+	if x != nil {
+		return x.ExchangeRate
+	}
+	return 0
+}
+
+func (x *LiquidStaking) GetStakedSol() float64 {
+	if x != nil {
+		return x.StakedSol
+	}
+	return 0
+}
+
+func (x *LiquidStaking) GetEstimatedApy() float64 {
+	if x != nil {
+		return x.EstimatedApy
+	}
+	return 0
+}
+
+func (x *LiquidStaking) GetEstimatedYearlySol() float64 {
+	if x != nil {
+		return x.EstimatedYearlySol
+	}
+	return 0
+}
+
+// SolBreakdown splits the SOL balance into native SOL and wrapped SOL (wSOL) held in token accounts
+type SolBreakdown struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	NativeAmount     float64                `protobuf:"fixed64,1,opt,name=native_amount,json=nativeAmount,proto3" json:"native_amount,omitempty"`
+	NativeRawAmount  string                 `protobuf:"bytes,2,opt,name=native_raw_amount,json=nativeRawAmount,proto3" json:"native_raw_amount,omitempty"` // Lamports
+	WrappedAmount    float64                `protobuf:"fixed64,3,opt,name=wrapped_amount,json=wrappedAmount,proto3" json:"wrapped_amount,omitempty"`
+	WrappedRawAmount string                 `protobuf:"bytes,4,opt,name=wrapped_raw_amount,json=wrappedRawAmount,proto3" json:"wrapped_raw_amount,omitempty"` // Lamports
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SolBreakdown) Reset() {
+	*x = SolBreakdown{}
+	mi := &file_dankfolio_v1_wallet_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SolBreakdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SolBreakdown) ProtoMessage() {}
+
+func (x *SolBreakdown) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_wallet_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SolBreakdown.ProtoReflect.Descriptor instead.
+func (*SolBreakdown) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_wallet_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SolBreakdown) GetNativeAmount() float64 {
+	if x != nil {
+		return x.NativeAmount
+	}
+	return 0
+}
+
+func (x *SolBreakdown) GetNativeRawAmount() string {
+	if x != nil {
+		return x.NativeRawAmount
+	}
+	return ""
+}
+
+func (x *SolBreakdown) GetWrappedAmount() float64 {
+	if x != nil {
+		return x.WrappedAmount
+	}
+	return 0
+}
+
+func (x *SolBreakdown) GetWrappedRawAmount() string {
+	if x != nil {
+		return x.WrappedRawAmount
+	}
+	return ""
+}
+
 // WalletBalance represents a wallet's complete balance
 type WalletBalance struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Balances      []*Balance             `protobuf:"bytes,1,rep,name=balances,proto3" json:"balances,omitempty"` // List of coin balances
+	Balances      []*Balance             `protobuf:"bytes,1,rep,name=balances,proto3" json:"balances,omitempty"`                          // List of coin balances
+	StakedSol     float64                `protobuf:"fixed64,2,opt,name=staked_sol,json=stakedSol,proto3" json:"staked_sol,omitempty"`     // Effective SOL staked through liquid staking tokens
+	NativeStake   *NativeStake           `protobuf:"bytes,3,opt,name=native_stake,json=nativeStake,proto3" json:"native_stake,omitempty"` // Native stake accounts; unset when the wallet has none
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *WalletBalance) Reset() {
 	*x = WalletBalance{}
-	mi := &file_dankfolio_v1_wallet_proto_msgTypes[1]
+	mi := &file_dankfolio_v1_wallet_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -96,7 +301,7 @@ func (x *WalletBalance) String() string {
 func (*WalletBalance) ProtoMessage() {}
 
 func (x *WalletBalance) ProtoReflect() protoreflect.Message {
-	mi := &file_dankfolio_v1_wallet_proto_msgTypes[1]
+	mi := &file_dankfolio_v1_wallet_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -109,7 +314,7 @@ func (x *WalletBalance) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WalletBalance.ProtoReflect.Descriptor instead.
 func (*WalletBalance) Descriptor() ([]byte, []int) {
-	return file_dankfolio_v1_wallet_proto_rawDescGZIP(), []int{1}
+	return file_dankfolio_v1_wallet_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *WalletBalance) GetBalances() []*Balance {
@@ -119,17 +324,195 @@ func (x *WalletBalance) GetBalances() []*Balance {
 	return nil
 }
 
+func (x *WalletBalance) GetStakedSol() float64 {
+	if x != nil {
+		return x.StakedSol
+	}
+	return 0
+}
+
+func (x *WalletBalance) GetNativeStake() *NativeStake {
+	if x != nil {
+		return x.NativeStake
+	}
+	return nil
+}
+
+// NativeStake is the wallet's native stake accounts and their totals in SOL
+type NativeStake struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Accounts            []*StakeAccount        `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	TotalSol            float64                `protobuf:"fixed64,2,opt,name=total_sol,json=totalSol,proto3" json:"total_sol,omitempty"`                                      // Everything held in stake accounts, whatever their status
+	ActiveSol           float64                `protobuf:"fixed64,3,opt,name=active_sol,json=activeSol,proto3" json:"active_sol,omitempty"`                                   // Delegated stake earning rewards
+	ActivatingSol       float64                `protobuf:"fixed64,4,opt,name=activating_sol,json=activatingSol,proto3" json:"activating_sol,omitempty"`                       // Delegated stake that starts earning next epoch
+	LastEpochRewardsSol float64                `protobuf:"fixed64,5,opt,name=last_epoch_rewards_sol,json=lastEpochRewardsSol,proto3" json:"last_epoch_rewards_sol,omitempty"` // Rewards earned across accounts in the previous epoch
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *NativeStake) Reset() {
+	*x = NativeStake{}
+	mi := &file_dankfolio_v1_wallet_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NativeStake) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NativeStake) ProtoMessage() {}
+
+func (x *NativeStake) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_wallet_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NativeStake.ProtoReflect.Descriptor instead.
+func (*NativeStake) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_wallet_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *NativeStake) GetAccounts() []*StakeAccount {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *NativeStake) GetTotalSol() float64 {
+	if x != nil {
+		return x.TotalSol
+	}
+	return 0
+}
+
+func (x *NativeStake) GetActiveSol() float64 {
+	if x != nil {
+		return x.ActiveSol
+	}
+	return 0
+}
+
+func (x *NativeStake) GetActivatingSol() float64 {
+	if x != nil {
+		return x.ActivatingSol
+	}
+	return 0
+}
+
+func (x *NativeStake) GetLastEpochRewardsSol() float64 {
+	if x != nil {
+		return x.LastEpochRewardsSol
+	}
+	return 0
+}
+
+// StakeAccount is a native stake account the wallet can withdraw from
+type StakeAccount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`                                      // SOL in the account, including its rent-exempt reserve
+	DelegatedSol  float64                `protobuf:"fixed64,3,opt,name=delegated_sol,json=delegatedSol,proto3" json:"delegated_sol,omitempty"`      // SOL delegated to the validator
+	Validator     string                 `protobuf:"bytes,4,opt,name=validator,proto3" json:"validator,omitempty"`                                  // Vote account, empty when undelegated
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`                                        // "inactive", "activating", "active" or "deactivating"
+	LastRewardSol float64                `protobuf:"fixed64,6,opt,name=last_reward_sol,json=lastRewardSol,proto3" json:"last_reward_sol,omitempty"` // SOL earned in the previous epoch
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StakeAccount) Reset() {
+	*x = StakeAccount{}
+	mi := &file_dankfolio_v1_wallet_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StakeAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StakeAccount) ProtoMessage() {}
+
+func (x *StakeAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_wallet_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StakeAccount.ProtoReflect.Descriptor instead.
+func (*StakeAccount) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_wallet_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *StakeAccount) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *StakeAccount) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *StakeAccount) GetDelegatedSol() float64 {
+	if x != nil {
+		return x.DelegatedSol
+	}
+	return 0
+}
+
+func (x *StakeAccount) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+func (x *StakeAccount) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *StakeAccount) GetLastRewardSol() float64 {
+	if x != nil {
+		return x.LastRewardSol
+	}
+	return 0
+}
+
 // GetWalletBalancesRequest is the request for GetWalletBalances
 type GetWalletBalancesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"` // Solana wallet address
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`                             // Solana wallet address
+	IncludeSpam   bool                   `protobuf:"varint,2,opt,name=include_spam,json=includeSpam,proto3" json:"include_spam,omitempty"` // Return balances flagged as spam, which are hidden by default
+	FieldMask     *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`        // Balance fields to return, e.g. ["id", "amount"]; all fields when empty
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetWalletBalancesRequest) Reset() {
 	*x = GetWalletBalancesRequest{}
-	mi := &file_dankfolio_v1_wallet_proto_msgTypes[2]
+	mi := &file_dankfolio_v1_wallet_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -141,7 +524,7 @@ func (x *GetWalletBalancesRequest) String() string {
 func (*GetWalletBalancesRequest) ProtoMessage() {}
 
 func (x *GetWalletBalancesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_dankfolio_v1_wallet_proto_msgTypes[2]
+	mi := &file_dankfolio_v1_wallet_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -154,7 +537,7 @@ func (x *GetWalletBalancesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetWalletBalancesRequest.ProtoReflect.Descriptor instead.
 func (*GetWalletBalancesRequest) Descriptor() ([]byte, []int) {
-	return file_dankfolio_v1_wallet_proto_rawDescGZIP(), []int{2}
+	return file_dankfolio_v1_wallet_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetWalletBalancesRequest) GetAddress() string {
@@ -164,6 +547,20 @@ func (x *GetWalletBalancesRequest) GetAddress() string {
 	return ""
 }
 
+func (x *GetWalletBalancesRequest) GetIncludeSpam() bool {
+	if x != nil {
+		return x.IncludeSpam
+	}
+	return false
+}
+
+func (x *GetWalletBalancesRequest) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
 // GetWalletBalancesResponse is the response for GetWalletBalances
 type GetWalletBalancesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -174,7 +571,7 @@ type GetWalletBalancesResponse struct {
 
 func (x *GetWalletBalancesResponse) Reset() {
 	*x = GetWalletBalancesResponse{}
-	mi := &file_dankfolio_v1_wallet_proto_msgTypes[3]
+	mi := &file_dankfolio_v1_wallet_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -186,7 +583,7 @@ func (x *GetWalletBalancesResponse) String() string {
 func (*GetWalletBalancesResponse) ProtoMessage() {}
 
 func (x *GetWalletBalancesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_dankfolio_v1_wallet_proto_msgTypes[3]
+	mi := &file_dankfolio_v1_wallet_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -199,7 +596,7 @@ func (x *GetWalletBalancesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetWalletBalancesResponse.ProtoReflect.Descriptor instead.
 func (*GetWalletBalancesResponse) Descriptor() ([]byte, []int) {
-	return file_dankfolio_v1_wallet_proto_rawDescGZIP(), []int{3}
+	return file_dankfolio_v1_wallet_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetWalletBalancesResponse) GetWalletBalance() *WalletBalance {
@@ -219,7 +616,7 @@ type RegisterWalletRequest struct {
 
 func (x *RegisterWalletRequest) Reset() {
 	*x = RegisterWalletRequest{}
-	mi := &file_dankfolio_v1_wallet_proto_msgTypes[4]
+	mi := &file_dankfolio_v1_wallet_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -231,7 +628,7 @@ func (x *RegisterWalletRequest) String() string {
 func (*RegisterWalletRequest) ProtoMessage() {}
 
 func (x *RegisterWalletRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_dankfolio_v1_wallet_proto_msgTypes[4]
+	mi := &file_dankfolio_v1_wallet_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -244,7 +641,7 @@ func (x *RegisterWalletRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterWalletRequest.ProtoReflect.Descriptor instead.
 func (*RegisterWalletRequest) Descriptor() ([]byte, []int) {
-	return file_dankfolio_v1_wallet_proto_rawDescGZIP(), []int{4}
+	return file_dankfolio_v1_wallet_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *RegisterWalletRequest) GetPublicKey() string {
@@ -265,7 +662,7 @@ type RegisterWalletResponse struct {
 
 func (x *RegisterWalletResponse) Reset() {
 	*x = RegisterWalletResponse{}
-	mi := &file_dankfolio_v1_wallet_proto_msgTypes[5]
+	mi := &file_dankfolio_v1_wallet_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -277,7 +674,7 @@ func (x *RegisterWalletResponse) String() string {
 func (*RegisterWalletResponse) ProtoMessage() {}
 
 func (x *RegisterWalletResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_dankfolio_v1_wallet_proto_msgTypes[5]
+	mi := &file_dankfolio_v1_wallet_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -290,7 +687,7 @@ func (x *RegisterWalletResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterWalletResponse.ProtoReflect.Descriptor instead.
 func (*RegisterWalletResponse) Descriptor() ([]byte, []int) {
-	return file_dankfolio_v1_wallet_proto_rawDescGZIP(), []int{5}
+	return file_dankfolio_v1_wallet_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *RegisterWalletResponse) GetSuccess() bool {
@@ -314,13 +711,15 @@ type PrepareTransferRequest struct {
 	ToAddress     string                 `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
 	CoinMint      string                 `protobuf:"bytes,3,opt,name=coin_mint,json=coinMint,proto3" json:"coin_mint,omitempty"` // Optional, empty for SOL
 	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Memo          string                 `protobuf:"bytes,5,opt,name=memo,proto3" json:"memo,omitempty"`             // Optional memo program note, e.g. an exchange deposit tag
+	References    []string               `protobuf:"bytes,6,rep,name=references,proto3" json:"references,omitempty"` // Optional Solana Pay reference keys for payment reconciliation
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PrepareTransferRequest) Reset() {
 	*x = PrepareTransferRequest{}
-	mi := &file_dankfolio_v1_wallet_proto_msgTypes[6]
+	mi := &file_dankfolio_v1_wallet_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -332,7 +731,7 @@ func (x *PrepareTransferRequest) String() string {
 func (*PrepareTransferRequest) ProtoMessage() {}
 
 func (x *PrepareTransferRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_dankfolio_v1_wallet_proto_msgTypes[6]
+	mi := &file_dankfolio_v1_wallet_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -345,7 +744,7 @@ func (x *PrepareTransferRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PrepareTransferRequest.ProtoReflect.Descriptor instead.
 func (*PrepareTransferRequest) Descriptor() ([]byte, []int) {
-	return file_dankfolio_v1_wallet_proto_rawDescGZIP(), []int{6}
+	return file_dankfolio_v1_wallet_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *PrepareTransferRequest) GetFromAddress() string {
@@ -376,6 +775,20 @@ func (x *PrepareTransferRequest) GetAmount() float64 {
 	return 0
 }
 
+func (x *PrepareTransferRequest) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *PrepareTransferRequest) GetReferences() []string {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
 // PrepareTransferResponse is the response with the unsigned transaction
 type PrepareTransferResponse struct {
 	state               protoimpl.MessageState `protogen:"open.v1"`
@@ -386,7 +799,7 @@ type PrepareTransferResponse struct {
 
 func (x *PrepareTransferResponse) Reset() {
 	*x = PrepareTransferResponse{}
-	mi := &file_dankfolio_v1_wallet_proto_msgTypes[7]
+	mi := &file_dankfolio_v1_wallet_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -398,7 +811,7 @@ func (x *PrepareTransferResponse) String() string {
 func (*PrepareTransferResponse) ProtoMessage() {}
 
 func (x *PrepareTransferResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_dankfolio_v1_wallet_proto_msgTypes[7]
+	mi := &file_dankfolio_v1_wallet_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -411,7 +824,7 @@ func (x *PrepareTransferResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PrepareTransferResponse.ProtoReflect.Descriptor instead.
 func (*PrepareTransferResponse) Descriptor() ([]byte, []int) {
-	return file_dankfolio_v1_wallet_proto_rawDescGZIP(), []int{7}
+	return file_dankfolio_v1_wallet_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *PrepareTransferResponse) GetUnsignedTransaction() string {
@@ -432,7 +845,7 @@ type SubmitTransferRequest struct {
 
 func (x *SubmitTransferRequest) Reset() {
 	*x = SubmitTransferRequest{}
-	mi := &file_dankfolio_v1_wallet_proto_msgTypes[8]
+	mi := &file_dankfolio_v1_wallet_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -444,7 +857,7 @@ func (x *SubmitTransferRequest) String() string {
 func (*SubmitTransferRequest) ProtoMessage() {}
 
 func (x *SubmitTransferRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_dankfolio_v1_wallet_proto_msgTypes[8]
+	mi := &file_dankfolio_v1_wallet_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -457,7 +870,7 @@ func (x *SubmitTransferRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubmitTransferRequest.ProtoReflect.Descriptor instead.
 func (*SubmitTransferRequest) Descriptor() ([]byte, []int) {
-	return file_dankfolio_v1_wallet_proto_rawDescGZIP(), []int{8}
+	return file_dankfolio_v1_wallet_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *SubmitTransferRequest) GetSignedTransaction() string {
@@ -484,7 +897,7 @@ type SubmitTransferResponse struct {
 
 func (x *SubmitTransferResponse) Reset() {
 	*x = SubmitTransferResponse{}
-	mi := &file_dankfolio_v1_wallet_proto_msgTypes[9]
+	mi := &file_dankfolio_v1_wallet_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -496,7 +909,7 @@ func (x *SubmitTransferResponse) String() string {
 func (*SubmitTransferResponse) ProtoMessage() {}
 
 func (x *SubmitTransferResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_dankfolio_v1_wallet_proto_msgTypes[9]
+	mi := &file_dankfolio_v1_wallet_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -509,7 +922,7 @@ func (x *SubmitTransferResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubmitTransferResponse.ProtoReflect.Descriptor instead.
 func (*SubmitTransferResponse) Descriptor() ([]byte, []int) {
-	return file_dankfolio_v1_wallet_proto_rawDescGZIP(), []int{9}
+	return file_dankfolio_v1_wallet_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *SubmitTransferResponse) GetTransactionHash() string {
@@ -521,15 +934,17 @@ func (x *SubmitTransferResponse) GetTransactionHash() string {
 
 // GetPortfolioPnLRequest is the request for GetPortfolioPnL
 type GetPortfolioPnLRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	WalletAddress string                 `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"` // Solana wallet address
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	WalletAddress   string                 `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`       // Solana wallet address
+	FieldMask       *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`                   // TokenPnL fields to return; all fields when empty
+	DisplayCurrency string                 `protobuf:"bytes,3,opt,name=display_currency,json=displayCurrency,proto3" json:"display_currency,omitempty"` // ISO 4217 code to quote values in; USD when empty
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *GetPortfolioPnLRequest) Reset() {
 	*x = GetPortfolioPnLRequest{}
-	mi := &file_dankfolio_v1_wallet_proto_msgTypes[10]
+	mi := &file_dankfolio_v1_wallet_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -541,7 +956,7 @@ func (x *GetPortfolioPnLRequest) String() string {
 func (*GetPortfolioPnLRequest) ProtoMessage() {}
 
 func (x *GetPortfolioPnLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_dankfolio_v1_wallet_proto_msgTypes[10]
+	mi := &file_dankfolio_v1_wallet_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -554,7 +969,7 @@ func (x *GetPortfolioPnLRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPortfolioPnLRequest.ProtoReflect.Descriptor instead.
 func (*GetPortfolioPnLRequest) Descriptor() ([]byte, []int) {
-	return file_dankfolio_v1_wallet_proto_rawDescGZIP(), []int{10}
+	return file_dankfolio_v1_wallet_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetPortfolioPnLRequest) GetWalletAddress() string {
@@ -564,6 +979,20 @@ func (x *GetPortfolioPnLRequest) GetWalletAddress() string {
 	return ""
 }
 
+func (x *GetPortfolioPnLRequest) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
+func (x *GetPortfolioPnLRequest) GetDisplayCurrency() string {
+	if x != nil {
+		return x.DisplayCurrency
+	}
+	return ""
+}
+
 // TokenPnL represents profit and loss data for a single token
 type TokenPnL struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
@@ -577,13 +1006,14 @@ type TokenPnL struct {
 	UnrealizedPnl   float64                `protobuf:"fixed64,8,opt,name=unrealized_pnl,json=unrealizedPnl,proto3" json:"unrealized_pnl,omitempty"`         // Unrealized profit/loss
 	PnlPercentage   float64                `protobuf:"fixed64,9,opt,name=pnl_percentage,json=pnlPercentage,proto3" json:"pnl_percentage,omitempty"`         // Percentage gain/loss
 	HasPurchaseData bool                   `protobuf:"varint,10,opt,name=has_purchase_data,json=hasPurchaseData,proto3" json:"has_purchase_data,omitempty"` // Whether we have transaction history
+	Category        string                 `protobuf:"bytes,11,opt,name=category,proto3" json:"category,omitempty"`                                         // Portfolio category: "sol", "liquid_staking" or "token"
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
 func (x *TokenPnL) Reset() {
 	*x = TokenPnL{}
-	mi := &file_dankfolio_v1_wallet_proto_msgTypes[11]
+	mi := &file_dankfolio_v1_wallet_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -595,7 +1025,7 @@ func (x *TokenPnL) String() string {
 func (*TokenPnL) ProtoMessage() {}
 
 func (x *TokenPnL) ProtoReflect() protoreflect.Message {
-	mi := &file_dankfolio_v1_wallet_proto_msgTypes[11]
+	mi := &file_dankfolio_v1_wallet_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -608,7 +1038,7 @@ func (x *TokenPnL) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TokenPnL.ProtoReflect.Descriptor instead.
 func (*TokenPnL) Descriptor() ([]byte, []int) {
-	return file_dankfolio_v1_wallet_proto_rawDescGZIP(), []int{11}
+	return file_dankfolio_v1_wallet_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *TokenPnL) GetCoinId() string {
@@ -681,6 +1111,13 @@ func (x *TokenPnL) GetHasPurchaseData() bool {
 	return false
 }
 
+func (x *TokenPnL) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
 // GetPortfolioPnLResponse contains overall portfolio PnL and per-token breakdown
 type GetPortfolioPnLResponse struct {
 	state               protoimpl.MessageState `protogen:"open.v1"`
@@ -690,13 +1127,14 @@ type GetPortfolioPnLResponse struct {
 	TotalPnlPercentage  float64                `protobuf:"fixed64,4,opt,name=total_pnl_percentage,json=totalPnlPercentage,proto3" json:"total_pnl_percentage,omitempty"`    // Overall percentage gain/loss
 	TotalHoldings       int32                  `protobuf:"varint,5,opt,name=total_holdings,json=totalHoldings,proto3" json:"total_holdings,omitempty"`                      // Number of tokens held
 	TokenPnls           []*TokenPnL            `protobuf:"bytes,6,rep,name=token_pnls,json=tokenPnls,proto3" json:"token_pnls,omitempty"`                                   // PnL data for each token
+	Currency            string                 `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`                                                      // Currency the values are quoted in
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
 
 func (x *GetPortfolioPnLResponse) Reset() {
 	*x = GetPortfolioPnLResponse{}
-	mi := &file_dankfolio_v1_wallet_proto_msgTypes[12]
+	mi := &file_dankfolio_v1_wallet_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -708,7 +1146,7 @@ func (x *GetPortfolioPnLResponse) String() string {
 func (*GetPortfolioPnLResponse) ProtoMessage() {}
 
 func (x *GetPortfolioPnLResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_dankfolio_v1_wallet_proto_msgTypes[12]
+	mi := &file_dankfolio_v1_wallet_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -721,7 +1159,7 @@ func (x *GetPortfolioPnLResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPortfolioPnLResponse.ProtoReflect.Descriptor instead.
 func (*GetPortfolioPnLResponse) Descriptor() ([]byte, []int) {
-	return file_dankfolio_v1_wallet_proto_rawDescGZIP(), []int{12}
+	return file_dankfolio_v1_wallet_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetPortfolioPnLResponse) GetTotalPortfolioValue() float64 {
@@ -766,18 +1204,347 @@ func (x *GetPortfolioPnLResponse) GetTokenPnls() []*TokenPnL {
 	return nil
 }
 
+func (x *GetPortfolioPnLResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// TokenApproval is a token account whose balance a delegate is allowed to transfer
+type TokenApproval struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TokenAccount    string                 `protobuf:"bytes,1,opt,name=token_account,json=tokenAccount,proto3" json:"token_account,omitempty"`            // Token account address
+	Mint            string                 `protobuf:"bytes,2,opt,name=mint,proto3" json:"mint,omitempty"`                                                // Mint of the delegated token
+	Delegate        string                 `protobuf:"bytes,3,opt,name=delegate,proto3" json:"delegate,omitempty"`                                        // Address allowed to transfer from the token account
+	DelegatedAmount float64                `protobuf:"fixed64,4,opt,name=delegated_amount,json=delegatedAmount,proto3" json:"delegated_amount,omitempty"` // Amount the delegate may still transfer
+	ProgramId       string                 `protobuf:"bytes,5,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`                     // Token program owning the account (SPL Token or Token-2022)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TokenApproval) Reset() {
+	*x = TokenApproval{}
+	mi := &file_dankfolio_v1_wallet_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenApproval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenApproval) ProtoMessage() {}
+
+func (x *TokenApproval) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_wallet_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenApproval.ProtoReflect.Descriptor instead.
+func (*TokenApproval) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_wallet_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TokenApproval) GetTokenAccount() string {
+	if x != nil {
+		return x.TokenAccount
+	}
+	return ""
+}
+
+func (x *TokenApproval) GetMint() string {
+	if x != nil {
+		return x.Mint
+	}
+	return ""
+}
+
+func (x *TokenApproval) GetDelegate() string {
+	if x != nil {
+		return x.Delegate
+	}
+	return ""
+}
+
+func (x *TokenApproval) GetDelegatedAmount() float64 {
+	if x != nil {
+		return x.DelegatedAmount
+	}
+	return 0
+}
+
+func (x *TokenApproval) GetProgramId() string {
+	if x != nil {
+		return x.ProgramId
+	}
+	return ""
+}
+
+// GetTokenApprovalsRequest is the request for GetTokenApprovals
+type GetTokenApprovalsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WalletAddress string                 `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"` // Solana wallet address
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTokenApprovalsRequest) Reset() {
+	*x = GetTokenApprovalsRequest{}
+	mi := &file_dankfolio_v1_wallet_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTokenApprovalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTokenApprovalsRequest) ProtoMessage() {}
+
+func (x *GetTokenApprovalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_wallet_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTokenApprovalsRequest.ProtoReflect.Descriptor instead.
+func (*GetTokenApprovalsRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_wallet_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetTokenApprovalsRequest) GetWalletAddress() string {
+	if x != nil {
+		return x.WalletAddress
+	}
+	return ""
+}
+
+// GetTokenApprovalsResponse is the response for GetTokenApprovals
+type GetTokenApprovalsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Approvals     []*TokenApproval       `protobuf:"bytes,1,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTokenApprovalsResponse) Reset() {
+	*x = GetTokenApprovalsResponse{}
+	mi := &file_dankfolio_v1_wallet_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTokenApprovalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTokenApprovalsResponse) ProtoMessage() {}
+
+func (x *GetTokenApprovalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_wallet_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTokenApprovalsResponse.ProtoReflect.Descriptor instead.
+func (*GetTokenApprovalsResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_wallet_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetTokenApprovalsResponse) GetApprovals() []*TokenApproval {
+	if x != nil {
+		return x.Approvals
+	}
+	return nil
+}
+
+// PrepareRevokeApprovalsRequest is the request for PrepareRevokeApprovals
+type PrepareRevokeApprovalsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WalletAddress string                 `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
+	TokenAccounts []string               `protobuf:"bytes,2,rep,name=token_accounts,json=tokenAccounts,proto3" json:"token_accounts,omitempty"` // Token accounts to revoke; empty revokes every active approval
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrepareRevokeApprovalsRequest) Reset() {
+	*x = PrepareRevokeApprovalsRequest{}
+	mi := &file_dankfolio_v1_wallet_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrepareRevokeApprovalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrepareRevokeApprovalsRequest) ProtoMessage() {}
+
+func (x *PrepareRevokeApprovalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_wallet_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrepareRevokeApprovalsRequest.ProtoReflect.Descriptor instead.
+func (*PrepareRevokeApprovalsRequest) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_wallet_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PrepareRevokeApprovalsRequest) GetWalletAddress() string {
+	if x != nil {
+		return x.WalletAddress
+	}
+	return ""
+}
+
+func (x *PrepareRevokeApprovalsRequest) GetTokenAccounts() []string {
+	if x != nil {
+		return x.TokenAccounts
+	}
+	return nil
+}
+
+// PrepareRevokeApprovalsResponse is the response with the unsigned revoke transaction
+type PrepareRevokeApprovalsResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	UnsignedTransaction  string                 `protobuf:"bytes,1,opt,name=unsigned_transaction,json=unsignedTransaction,proto3" json:"unsigned_transaction,omitempty"`      // Submit with SubmitTransfer once signed
+	RevokedTokenAccounts []string               `protobuf:"bytes,2,rep,name=revoked_token_accounts,json=revokedTokenAccounts,proto3" json:"revoked_token_accounts,omitempty"` // Token accounts covered by the transaction
+	Remaining            int32                  `protobuf:"varint,3,opt,name=remaining,proto3" json:"remaining,omitempty"`                                                    // Approvals left over because they did not fit in one transaction
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *PrepareRevokeApprovalsResponse) Reset() {
+	*x = PrepareRevokeApprovalsResponse{}
+	mi := &file_dankfolio_v1_wallet_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrepareRevokeApprovalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrepareRevokeApprovalsResponse) ProtoMessage() {}
+
+func (x *PrepareRevokeApprovalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dankfolio_v1_wallet_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrepareRevokeApprovalsResponse.ProtoReflect.Descriptor instead.
+func (*PrepareRevokeApprovalsResponse) Descriptor() ([]byte, []int) {
+	return file_dankfolio_v1_wallet_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PrepareRevokeApprovalsResponse) GetUnsignedTransaction() string {
+	if x != nil {
+		return x.UnsignedTransaction
+	}
+	return ""
+}
+
+func (x *PrepareRevokeApprovalsResponse) GetRevokedTokenAccounts() []string {
+	if x != nil {
+		return x.RevokedTokenAccounts
+	}
+	return nil
+}
+
+func (x *PrepareRevokeApprovalsResponse) GetRemaining() int32 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
 var File_dankfolio_v1_wallet_proto protoreflect.FileDescriptor
 
 const file_dankfolio_v1_wallet_proto_rawDesc = "" +
 	"\n" +
-	"\x19dankfolio/v1/wallet.proto\x12\fdankfolio.v1\"1\n" +
+	"\x19dankfolio/v1/wallet.proto\x12\fdankfolio.v1\x1a google/protobuf/field_mask.proto\"\xf6\x02\n" +
 	"\aBalance\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
-	"\x06amount\x18\x02 \x01(\x01R\x06amount\"B\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\x17\n" +
+	"\ais_spam\x18\x03 \x01(\bR\x06isSpam\x12!\n" +
+	"\fspam_reasons\x18\x04 \x03(\tR\vspamReasons\x12\x1d\n" +
+	"\n" +
+	"raw_amount\x18\x05 \x01(\tR\trawAmount\x12\x1a\n" +
+	"\bdecimals\x18\x06 \x01(\rR\bdecimals\x12?\n" +
+	"\rsol_breakdown\x18\a \x01(\v2\x1a.dankfolio.v1.SolBreakdownR\fsolBreakdown\x12+\n" +
+	"\x11suggested_actions\x18\b \x03(\tR\x10suggestedActions\x12\x1a\n" +
+	"\bcategory\x18\t \x01(\tR\bcategory\x12B\n" +
+	"\x0eliquid_staking\x18\n" +
+	" \x01(\v2\x1b.dankfolio.v1.LiquidStakingR\rliquidStaking\"\xaa\x01\n" +
+	"\rLiquidStaking\x12#\n" +
+	"\rexchange_rate\x18\x01 \x01(\x01R\fexchangeRate\x12\x1d\n" +
+	"\n" +
+	"staked_sol\x18\x02 \x01(\x01R\tstakedSol\x12#\n" +
+	"\restimated_apy\x18\x03 \x01(\x01R\festimatedApy\x120\n" +
+	"\x14estimated_yearly_sol\x18\x04 \x01(\x01R\x12estimatedYearlySol\"\xb4\x01\n" +
+	"\fSolBreakdown\x12#\n" +
+	"\rnative_amount\x18\x01 \x01(\x01R\fnativeAmount\x12*\n" +
+	"\x11native_raw_amount\x18\x02 \x01(\tR\x0fnativeRawAmount\x12%\n" +
+	"\x0ewrapped_amount\x18\x03 \x01(\x01R\rwrappedAmount\x12,\n" +
+	"\x12wrapped_raw_amount\x18\x04 \x01(\tR\x10wrappedRawAmount\"\x9f\x01\n" +
 	"\rWalletBalance\x121\n" +
-	"\bbalances\x18\x01 \x03(\v2\x15.dankfolio.v1.BalanceR\bbalances\"4\n" +
+	"\bbalances\x18\x01 \x03(\v2\x15.dankfolio.v1.BalanceR\bbalances\x12\x1d\n" +
+	"\n" +
+	"staked_sol\x18\x02 \x01(\x01R\tstakedSol\x12<\n" +
+	"\fnative_stake\x18\x03 \x01(\v2\x19.dankfolio.v1.NativeStakeR\vnativeStake\"\xdd\x01\n" +
+	"\vNativeStake\x126\n" +
+	"\baccounts\x18\x01 \x03(\v2\x1a.dankfolio.v1.StakeAccountR\baccounts\x12\x1b\n" +
+	"\ttotal_sol\x18\x02 \x01(\x01R\btotalSol\x12\x1d\n" +
+	"\n" +
+	"active_sol\x18\x03 \x01(\x01R\tactiveSol\x12%\n" +
+	"\x0eactivating_sol\x18\x04 \x01(\x01R\ractivatingSol\x123\n" +
+	"\x16last_epoch_rewards_sol\x18\x05 \x01(\x01R\x13lastEpochRewardsSol\"\xc3\x01\n" +
+	"\fStakeAccount\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12#\n" +
+	"\rdelegated_sol\x18\x03 \x01(\x01R\fdelegatedSol\x12\x1c\n" +
+	"\tvalidator\x18\x04 \x01(\tR\tvalidator\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12&\n" +
+	"\x0flast_reward_sol\x18\x06 \x01(\x01R\rlastRewardSol\"\x92\x01\n" +
 	"\x18GetWalletBalancesRequest\x12\x18\n" +
-	"\aaddress\x18\x01 \x01(\tR\aaddress\"_\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12!\n" +
+	"\finclude_spam\x18\x02 \x01(\bR\vincludeSpam\x129\n" +
+	"\n" +
+	"field_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMask\"_\n" +
 	"\x19GetWalletBalancesResponse\x12B\n" +
 	"\x0ewallet_balance\x18\x01 \x01(\v2\x1b.dankfolio.v1.WalletBalanceR\rwalletBalance\"6\n" +
 	"\x15RegisterWalletRequest\x12\x1d\n" +
@@ -785,22 +1552,29 @@ const file_dankfolio_v1_wallet_proto_rawDesc = "" +
 	"public_key\x18\x01 \x01(\tR\tpublicKey\"L\n" +
 	"\x16RegisterWalletResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\x8f\x01\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xc3\x01\n" +
 	"\x16PrepareTransferRequest\x12!\n" +
 	"\ffrom_address\x18\x01 \x01(\tR\vfromAddress\x12\x1d\n" +
 	"\n" +
 	"to_address\x18\x02 \x01(\tR\ttoAddress\x12\x1b\n" +
 	"\tcoin_mint\x18\x03 \x01(\tR\bcoinMint\x12\x16\n" +
-	"\x06amount\x18\x04 \x01(\x01R\x06amount\"L\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12\x12\n" +
+	"\x04memo\x18\x05 \x01(\tR\x04memo\x12\x1e\n" +
+	"\n" +
+	"references\x18\x06 \x03(\tR\n" +
+	"references\"L\n" +
 	"\x17PrepareTransferResponse\x121\n" +
 	"\x14unsigned_transaction\x18\x01 \x01(\tR\x13unsignedTransaction\"y\n" +
 	"\x15SubmitTransferRequest\x12-\n" +
 	"\x12signed_transaction\x18\x01 \x01(\tR\x11signedTransaction\x121\n" +
 	"\x14unsigned_transaction\x18\x02 \x01(\tR\x13unsignedTransaction\"C\n" +
 	"\x16SubmitTransferResponse\x12)\n" +
-	"\x10transaction_hash\x18\x01 \x01(\tR\x0ftransactionHash\"?\n" +
+	"\x10transaction_hash\x18\x01 \x01(\tR\x0ftransactionHash\"\xa5\x01\n" +
 	"\x16GetPortfolioPnLRequest\x12%\n" +
-	"\x0ewallet_address\x18\x01 \x01(\tR\rwalletAddress\"\xd3\x02\n" +
+	"\x0ewallet_address\x18\x01 \x01(\tR\rwalletAddress\x129\n" +
+	"\n" +
+	"field_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMask\x12)\n" +
+	"\x10display_currency\x18\x03 \x01(\tR\x0fdisplayCurrency\"\xef\x02\n" +
 	"\bTokenPnL\x12\x17\n" +
 	"\acoin_id\x18\x01 \x01(\tR\x06coinId\x12\x16\n" +
 	"\x06symbol\x18\x02 \x01(\tR\x06symbol\x12\x12\n" +
@@ -814,7 +1588,8 @@ const file_dankfolio_v1_wallet_proto_rawDesc = "" +
 	"\x0eunrealized_pnl\x18\b \x01(\x01R\runrealizedPnl\x12%\n" +
 	"\x0epnl_percentage\x18\t \x01(\x01R\rpnlPercentage\x12*\n" +
 	"\x11has_purchase_data\x18\n" +
-	" \x01(\bR\x0fhasPurchaseData\"\xb9\x02\n" +
+	" \x01(\bR\x0fhasPurchaseData\x12\x1a\n" +
+	"\bcategory\x18\v \x01(\tR\bcategory\"\xd5\x02\n" +
 	"\x17GetPortfolioPnLResponse\x122\n" +
 	"\x15total_portfolio_value\x18\x01 \x01(\x01R\x13totalPortfolioValue\x12(\n" +
 	"\x10total_cost_basis\x18\x02 \x01(\x01R\x0etotalCostBasis\x120\n" +
@@ -822,13 +1597,34 @@ const file_dankfolio_v1_wallet_proto_rawDesc = "" +
 	"\x14total_pnl_percentage\x18\x04 \x01(\x01R\x12totalPnlPercentage\x12%\n" +
 	"\x0etotal_holdings\x18\x05 \x01(\x05R\rtotalHoldings\x125\n" +
 	"\n" +
-	"token_pnls\x18\x06 \x03(\v2\x16.dankfolio.v1.TokenPnLR\ttokenPnls2\xef\x03\n" +
+	"token_pnls\x18\x06 \x03(\v2\x16.dankfolio.v1.TokenPnLR\ttokenPnls\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\tR\bcurrency\"\xae\x01\n" +
+	"\rTokenApproval\x12#\n" +
+	"\rtoken_account\x18\x01 \x01(\tR\ftokenAccount\x12\x12\n" +
+	"\x04mint\x18\x02 \x01(\tR\x04mint\x12\x1a\n" +
+	"\bdelegate\x18\x03 \x01(\tR\bdelegate\x12)\n" +
+	"\x10delegated_amount\x18\x04 \x01(\x01R\x0fdelegatedAmount\x12\x1d\n" +
+	"\n" +
+	"program_id\x18\x05 \x01(\tR\tprogramId\"A\n" +
+	"\x18GetTokenApprovalsRequest\x12%\n" +
+	"\x0ewallet_address\x18\x01 \x01(\tR\rwalletAddress\"V\n" +
+	"\x19GetTokenApprovalsResponse\x129\n" +
+	"\tapprovals\x18\x01 \x03(\v2\x1b.dankfolio.v1.TokenApprovalR\tapprovals\"m\n" +
+	"\x1dPrepareRevokeApprovalsRequest\x12%\n" +
+	"\x0ewallet_address\x18\x01 \x01(\tR\rwalletAddress\x12%\n" +
+	"\x0etoken_accounts\x18\x02 \x03(\tR\rtokenAccounts\"\xa7\x01\n" +
+	"\x1ePrepareRevokeApprovalsResponse\x121\n" +
+	"\x14unsigned_transaction\x18\x01 \x01(\tR\x13unsignedTransaction\x124\n" +
+	"\x16revoked_token_accounts\x18\x02 \x03(\tR\x14revokedTokenAccounts\x12\x1c\n" +
+	"\tremaining\x18\x03 \x01(\x05R\tremaining2\xca\x05\n" +
 	"\rWalletService\x12d\n" +
 	"\x11GetWalletBalances\x12&.dankfolio.v1.GetWalletBalancesRequest\x1a'.dankfolio.v1.GetWalletBalancesResponse\x12[\n" +
 	"\x0eRegisterWallet\x12#.dankfolio.v1.RegisterWalletRequest\x1a$.dankfolio.v1.RegisterWalletResponse\x12^\n" +
 	"\x0fPrepareTransfer\x12$.dankfolio.v1.PrepareTransferRequest\x1a%.dankfolio.v1.PrepareTransferResponse\x12[\n" +
 	"\x0eSubmitTransfer\x12#.dankfolio.v1.SubmitTransferRequest\x1a$.dankfolio.v1.SubmitTransferResponse\x12^\n" +
-	"\x0fGetPortfolioPnL\x12$.dankfolio.v1.GetPortfolioPnLRequest\x1a%.dankfolio.v1.GetPortfolioPnLResponseB\xb7\x01\n" +
+	"\x0fGetPortfolioPnL\x12$.dankfolio.v1.GetPortfolioPnLRequest\x1a%.dankfolio.v1.GetPortfolioPnLResponse\x12d\n" +
+	"\x11GetTokenApprovals\x12&.dankfolio.v1.GetTokenApprovalsRequest\x1a'.dankfolio.v1.GetTokenApprovalsResponse\x12s\n" +
+	"\x16PrepareRevokeApprovals\x12+.dankfolio.v1.PrepareRevokeApprovalsRequest\x1a,.dankfolio.v1.PrepareRevokeApprovalsResponseB\xb7\x01\n" +
 	"\x10com.dankfolio.v1B\vWalletProtoP\x01ZEgithub.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1\xa2\x02\x03DXX\xaa\x02\fDankfolio.V1\xca\x02\fDankfolio\\V1\xe2\x02\x18Dankfolio\\V1\\GPBMetadata\xea\x02\rDankfolio::V1b\x06proto3"
 
 var (
@@ -843,41 +1639,62 @@ func file_dankfolio_v1_wallet_proto_rawDescGZIP() []byte {
 	return file_dankfolio_v1_wallet_proto_rawDescData
 }
 
-var file_dankfolio_v1_wallet_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_dankfolio_v1_wallet_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_dankfolio_v1_wallet_proto_goTypes = []any{
-	(*Balance)(nil),                   // 0: dankfolio.v1.Balance
-	(*WalletBalance)(nil),             // 1: dankfolio.v1.WalletBalance
-	(*GetWalletBalancesRequest)(nil),  // 2: dankfolio.v1.GetWalletBalancesRequest
-	(*GetWalletBalancesResponse)(nil), // 3: dankfolio.v1.GetWalletBalancesResponse
-	(*RegisterWalletRequest)(nil),     // 4: dankfolio.v1.RegisterWalletRequest
-	(*RegisterWalletResponse)(nil),    // 5: dankfolio.v1.RegisterWalletResponse
-	(*PrepareTransferRequest)(nil),    // 6: dankfolio.v1.PrepareTransferRequest
-	(*PrepareTransferResponse)(nil),   // 7: dankfolio.v1.PrepareTransferResponse
-	(*SubmitTransferRequest)(nil),     // 8: dankfolio.v1.SubmitTransferRequest
-	(*SubmitTransferResponse)(nil),    // 9: dankfolio.v1.SubmitTransferResponse
-	(*GetPortfolioPnLRequest)(nil),    // 10: dankfolio.v1.GetPortfolioPnLRequest
-	(*TokenPnL)(nil),                  // 11: dankfolio.v1.TokenPnL
-	(*GetPortfolioPnLResponse)(nil),   // 12: dankfolio.v1.GetPortfolioPnLResponse
+	(*Balance)(nil),                        // 0: dankfolio.v1.Balance
+	(*LiquidStaking)(nil),                  // 1: dankfolio.v1.LiquidStaking
+	(*SolBreakdown)(nil),                   // 2: dankfolio.v1.SolBreakdown
+	(*WalletBalance)(nil),                  // 3: dankfolio.v1.WalletBalance
+	(*NativeStake)(nil),                    // 4: dankfolio.v1.NativeStake
+	(*StakeAccount)(nil),                   // 5: dankfolio.v1.StakeAccount
+	(*GetWalletBalancesRequest)(nil),       // 6: dankfolio.v1.GetWalletBalancesRequest
+	(*GetWalletBalancesResponse)(nil),      // 7: dankfolio.v1.GetWalletBalancesResponse
+	(*RegisterWalletRequest)(nil),          // 8: dankfolio.v1.RegisterWalletRequest
+	(*RegisterWalletResponse)(nil),         // 9: dankfolio.v1.RegisterWalletResponse
+	(*PrepareTransferRequest)(nil),         // 10: dankfolio.v1.PrepareTransferRequest
+	(*PrepareTransferResponse)(nil),        // 11: dankfolio.v1.PrepareTransferResponse
+	(*SubmitTransferRequest)(nil),          // 12: dankfolio.v1.SubmitTransferRequest
+	(*SubmitTransferResponse)(nil),         // 13: dankfolio.v1.SubmitTransferResponse
+	(*GetPortfolioPnLRequest)(nil),         // 14: dankfolio.v1.GetPortfolioPnLRequest
+	(*TokenPnL)(nil),                       // 15: dankfolio.v1.TokenPnL
+	(*GetPortfolioPnLResponse)(nil),        // 16: dankfolio.v1.GetPortfolioPnLResponse
+	(*TokenApproval)(nil),                  // 17: dankfolio.v1.TokenApproval
+	(*GetTokenApprovalsRequest)(nil),       // 18: dankfolio.v1.GetTokenApprovalsRequest
+	(*GetTokenApprovalsResponse)(nil),      // 19: dankfolio.v1.GetTokenApprovalsResponse
+	(*PrepareRevokeApprovalsRequest)(nil),  // 20: dankfolio.v1.PrepareRevokeApprovalsRequest
+	(*PrepareRevokeApprovalsResponse)(nil), // 21: dankfolio.v1.PrepareRevokeApprovalsResponse
+	(*fieldmaskpb.FieldMask)(nil),          // 22: google.protobuf.FieldMask
 }
 var file_dankfolio_v1_wallet_proto_depIdxs = []int32{
-	0,  // 0: dankfolio.v1.WalletBalance.balances:type_name -> dankfolio.v1.Balance
-	1,  // 1: dankfolio.v1.GetWalletBalancesResponse.wallet_balance:type_name -> dankfolio.v1.WalletBalance
-	11, // 2: dankfolio.v1.GetPortfolioPnLResponse.token_pnls:type_name -> dankfolio.v1.TokenPnL
-	2,  // 3: dankfolio.v1.WalletService.GetWalletBalances:input_type -> dankfolio.v1.GetWalletBalancesRequest
-	4,  // 4: dankfolio.v1.WalletService.RegisterWallet:input_type -> dankfolio.v1.RegisterWalletRequest
-	6,  // 5: dankfolio.v1.WalletService.PrepareTransfer:input_type -> dankfolio.v1.PrepareTransferRequest
-	8,  // 6: dankfolio.v1.WalletService.SubmitTransfer:input_type -> dankfolio.v1.SubmitTransferRequest
-	10, // 7: dankfolio.v1.WalletService.GetPortfolioPnL:input_type -> dankfolio.v1.GetPortfolioPnLRequest
-	3,  // 8: dankfolio.v1.WalletService.GetWalletBalances:output_type -> dankfolio.v1.GetWalletBalancesResponse
-	5,  // 9: dankfolio.v1.WalletService.RegisterWallet:output_type -> dankfolio.v1.RegisterWalletResponse
-	7,  // 10: dankfolio.v1.WalletService.PrepareTransfer:output_type -> dankfolio.v1.PrepareTransferResponse
-	9,  // 11: dankfolio.v1.WalletService.SubmitTransfer:output_type -> dankfolio.v1.SubmitTransferResponse
-	12, // 12: dankfolio.v1.WalletService.GetPortfolioPnL:output_type -> dankfolio.v1.GetPortfolioPnLResponse
-	8,  // [8:13] is the sub-list for method output_type
-	3,  // [3:8] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	2,  // 0: dankfolio.v1.Balance.sol_breakdown:type_name -> dankfolio.v1.SolBreakdown
+	1,  // 1: dankfolio.v1.Balance.liquid_staking:type_name -> dankfolio.v1.LiquidStaking
+	0,  // 2: dankfolio.v1.WalletBalance.balances:type_name -> dankfolio.v1.Balance
+	4,  // 3: dankfolio.v1.WalletBalance.native_stake:type_name -> dankfolio.v1.NativeStake
+	5,  // 4: dankfolio.v1.NativeStake.accounts:type_name -> dankfolio.v1.StakeAccount
+	22, // 5: dankfolio.v1.GetWalletBalancesRequest.field_mask:type_name -> google.protobuf.FieldMask
+	3,  // 6: dankfolio.v1.GetWalletBalancesResponse.wallet_balance:type_name -> dankfolio.v1.WalletBalance
+	22, // 7: dankfolio.v1.GetPortfolioPnLRequest.field_mask:type_name -> google.protobuf.FieldMask
+	15, // 8: dankfolio.v1.GetPortfolioPnLResponse.token_pnls:type_name -> dankfolio.v1.TokenPnL
+	17, // 9: dankfolio.v1.GetTokenApprovalsResponse.approvals:type_name -> dankfolio.v1.TokenApproval
+	6,  // 10: dankfolio.v1.WalletService.GetWalletBalances:input_type -> dankfolio.v1.GetWalletBalancesRequest
+	8,  // 11: dankfolio.v1.WalletService.RegisterWallet:input_type -> dankfolio.v1.RegisterWalletRequest
+	10, // 12: dankfolio.v1.WalletService.PrepareTransfer:input_type -> dankfolio.v1.PrepareTransferRequest
+	12, // 13: dankfolio.v1.WalletService.SubmitTransfer:input_type -> dankfolio.v1.SubmitTransferRequest
+	14, // 14: dankfolio.v1.WalletService.GetPortfolioPnL:input_type -> dankfolio.v1.GetPortfolioPnLRequest
+	18, // 15: dankfolio.v1.WalletService.GetTokenApprovals:input_type -> dankfolio.v1.GetTokenApprovalsRequest
+	20, // 16: dankfolio.v1.WalletService.PrepareRevokeApprovals:input_type -> dankfolio.v1.PrepareRevokeApprovalsRequest
+	7,  // 17: dankfolio.v1.WalletService.GetWalletBalances:output_type -> dankfolio.v1.GetWalletBalancesResponse
+	9,  // 18: dankfolio.v1.WalletService.RegisterWallet:output_type -> dankfolio.v1.RegisterWalletResponse
+	11, // 19: dankfolio.v1.WalletService.PrepareTransfer:output_type -> dankfolio.v1.PrepareTransferResponse
+	13, // 20: dankfolio.v1.WalletService.SubmitTransfer:output_type -> dankfolio.v1.SubmitTransferResponse
+	16, // 21: dankfolio.v1.WalletService.GetPortfolioPnL:output_type -> dankfolio.v1.GetPortfolioPnLResponse
+	19, // 22: dankfolio.v1.WalletService.GetTokenApprovals:output_type -> dankfolio.v1.GetTokenApprovalsResponse
+	21, // 23: dankfolio.v1.WalletService.PrepareRevokeApprovals:output_type -> dankfolio.v1.PrepareRevokeApprovalsResponse
+	17, // [17:24] is the sub-list for method output_type
+	10, // [10:17] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_dankfolio_v1_wallet_proto_init() }
@@ -891,7 +1708,7 @@ func file_dankfolio_v1_wallet_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_dankfolio_v1_wallet_proto_rawDesc), len(file_dankfolio_v1_wallet_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
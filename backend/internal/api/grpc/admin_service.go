@@ -0,0 +1,1175 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1"
+	dankfoliov1connect "github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1/v1connect"
+	"github.com/nicolas-martin/dankfolio/backend/internal/blocklist"
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/db/postgres"
+	"github.com/nicolas-martin/dankfolio/backend/internal/featureflags"
+	"github.com/nicolas-martin/dankfolio/backend/internal/jobs"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/announcement"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/coin"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/notification"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/retention"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/trade"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/wallet"
+	"github.com/nicolas-martin/dankfolio/backend/internal/settings"
+)
+
+// adminServiceHandler implements the operator-facing AdminService API
+type adminServiceHandler struct {
+	dankfoliov1connect.UnimplementedAdminServiceHandler
+	settings      *settings.Manager
+	featureFlags  *featureflags.Evaluator // Optional; flag RPCs are unavailable when nil
+	spamTokens    *wallet.SpamClassifier  // Optional; spam list RPCs are unavailable when nil
+	blocklist     *blocklist.Blocklist    // Optional; blocklist RPCs are unavailable when nil
+	queryStats    *postgres.QueryStats    // Optional; ListSlowQueries is unavailable when nil
+	jobs          *jobs.Scheduler         // Optional; ListJobRuns is unavailable when nil
+	operations    Operations
+	coinService   *coin.Service
+	wallets       *wallet.Service
+	trades        *trade.Service
+	notifications *notification.Service // Optional; notification RPCs are unavailable when nil
+	announcements *announcement.Service // Optional; announcement RPCs are unavailable when nil
+	retention     *retention.Service    // Optional; deleted record RPCs are unavailable when nil
+}
+
+// newAdminServiceHandler creates a new adminServiceHandler
+func newAdminServiceHandler(settingsManager *settings.Manager, featureFlags *featureflags.Evaluator, spamTokens *wallet.SpamClassifier, scamBlocklist *blocklist.Blocklist, queryStats *postgres.QueryStats, jobScheduler *jobs.Scheduler, operations Operations, coinService *coin.Service, wallets *wallet.Service, trades *trade.Service, notifications *notification.Service, announcements *announcement.Service, retentionService *retention.Service) *adminServiceHandler {
+	return &adminServiceHandler{settings: settingsManager, featureFlags: featureFlags, spamTokens: spamTokens, blocklist: scamBlocklist, queryStats: queryStats, jobs: jobScheduler, operations: operations, coinService: coinService, wallets: wallets, trades: trades, notifications: notifications, announcements: announcements, retention: retentionService}
+}
+
+// ListSettings returns all runtime settings
+func (h *adminServiceHandler) ListSettings(
+	ctx context.Context,
+	req *connect.Request[pb.ListSettingsRequest],
+) (*connect.Response[pb.ListSettingsResponse], error) {
+	entries := h.settings.List()
+	pbSettings := make([]*pb.Setting, 0, len(entries))
+	for i := range entries {
+		pbSettings = append(pbSettings, convertSettingToPb(&entries[i]))
+	}
+	return connect.NewResponse(&pb.ListSettingsResponse{Settings: pbSettings}), nil
+}
+
+// UpdateSetting stores and applies a setting override
+func (h *adminServiceHandler) UpdateSetting(
+	ctx context.Context,
+	req *connect.Request[pb.UpdateSettingRequest],
+) (*connect.Response[pb.UpdateSettingResponse], error) {
+	if err := h.settings.Set(ctx, req.Msg.Name, req.Msg.Value, req.Msg.UpdatedBy); err != nil {
+		return nil, settingError("update", req.Msg.Name, err)
+	}
+	setting, err := h.findSetting(req.Msg.Name)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&pb.UpdateSettingResponse{Setting: setting}), nil
+}
+
+// ResetSetting removes a setting override
+func (h *adminServiceHandler) ResetSetting(
+	ctx context.Context,
+	req *connect.Request[pb.ResetSettingRequest],
+) (*connect.Response[pb.ResetSettingResponse], error) {
+	if err := h.settings.Reset(ctx, req.Msg.Name); err != nil {
+		return nil, settingError("reset", req.Msg.Name, err)
+	}
+	setting, err := h.findSetting(req.Msg.Name)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&pb.ResetSettingResponse{Setting: setting}), nil
+}
+
+// ListFeatureFlags returns all feature flag definitions
+func (h *adminServiceHandler) ListFeatureFlags(
+	ctx context.Context,
+	req *connect.Request[pb.ListFeatureFlagsRequest],
+) (*connect.Response[pb.ListFeatureFlagsResponse], error) {
+	if h.featureFlags == nil {
+		return nil, errFeatureFlagsDisabled
+	}
+	flags := h.featureFlags.List()
+	pbFlags := make([]*pb.FeatureFlag, 0, len(flags))
+	for i := range flags {
+		pbFlags = append(pbFlags, convertFeatureFlagToPb(&flags[i]))
+	}
+	return connect.NewResponse(&pb.ListFeatureFlagsResponse{Flags: pbFlags}), nil
+}
+
+// SetFeatureFlag creates or replaces a feature flag
+func (h *adminServiceHandler) SetFeatureFlag(
+	ctx context.Context,
+	req *connect.Request[pb.SetFeatureFlagRequest],
+) (*connect.Response[pb.SetFeatureFlagResponse], error) {
+	if h.featureFlags == nil {
+		return nil, errFeatureFlagsDisabled
+	}
+	if req.Msg.Flag == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("flag is required"))
+	}
+	saved, err := h.featureFlags.Save(ctx, model.FeatureFlag{
+		ID:             req.Msg.Flag.Key,
+		Description:    req.Msg.Flag.Description,
+		Enabled:        req.Msg.Flag.Enabled,
+		RolloutPercent: int(req.Msg.Flag.RolloutPercent),
+		AllowList:      req.Msg.Flag.AllowList,
+	})
+	if err != nil {
+		if errors.Is(err, featureflags.ErrInvalidFlag) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to save feature flag: %w", err))
+	}
+	return connect.NewResponse(&pb.SetFeatureFlagResponse{Flag: convertFeatureFlagToPb(saved)}), nil
+}
+
+// DeleteFeatureFlag removes a feature flag
+func (h *adminServiceHandler) DeleteFeatureFlag(
+	ctx context.Context,
+	req *connect.Request[pb.DeleteFeatureFlagRequest],
+) (*connect.Response[pb.DeleteFeatureFlagResponse], error) {
+	if h.featureFlags == nil {
+		return nil, errFeatureFlagsDisabled
+	}
+	if err := h.featureFlags.Delete(ctx, req.Msg.Key); err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&pb.DeleteFeatureFlagResponse{}), nil
+}
+
+// ListSpamTokens returns the wallet spam deny/allow list
+func (h *adminServiceHandler) ListSpamTokens(
+	ctx context.Context,
+	req *connect.Request[pb.ListSpamTokensRequest],
+) (*connect.Response[pb.ListSpamTokensResponse], error) {
+	if h.spamTokens == nil {
+		return nil, errSpamFilterDisabled
+	}
+	tokens := h.spamTokens.List()
+	pbTokens := make([]*pb.SpamToken, 0, len(tokens))
+	for i := range tokens {
+		pbTokens = append(pbTokens, convertSpamTokenToPb(&tokens[i]))
+	}
+	return connect.NewResponse(&pb.ListSpamTokensResponse{Tokens: pbTokens}), nil
+}
+
+// SetSpamToken adds or replaces a deny/allow entry
+func (h *adminServiceHandler) SetSpamToken(
+	ctx context.Context,
+	req *connect.Request[pb.SetSpamTokenRequest],
+) (*connect.Response[pb.SetSpamTokenResponse], error) {
+	if h.spamTokens == nil {
+		return nil, errSpamFilterDisabled
+	}
+	if req.Msg.Token == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("token is required"))
+	}
+	saved, err := h.spamTokens.Save(ctx, model.SpamToken{
+		ID:        req.Msg.Token.Mint,
+		Verdict:   req.Msg.Token.Verdict,
+		Reason:    req.Msg.Token.Reason,
+		UpdatedBy: req.Msg.Token.UpdatedBy,
+	})
+	if err != nil {
+		if errors.Is(err, wallet.ErrInvalidSpamToken) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to save spam token: %w", err))
+	}
+	return connect.NewResponse(&pb.SetSpamTokenResponse{Token: convertSpamTokenToPb(saved)}), nil
+}
+
+// DeleteSpamToken removes a deny/allow entry
+func (h *adminServiceHandler) DeleteSpamToken(
+	ctx context.Context,
+	req *connect.Request[pb.DeleteSpamTokenRequest],
+) (*connect.Response[pb.DeleteSpamTokenResponse], error) {
+	if h.spamTokens == nil {
+		return nil, errSpamFilterDisabled
+	}
+	if err := h.spamTokens.Delete(ctx, req.Msg.Mint); err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&pb.DeleteSpamTokenResponse{}), nil
+}
+
+// ListBlockedMints returns the blocklist entries for a mint, or every admin override
+func (h *adminServiceHandler) ListBlockedMints(
+	ctx context.Context,
+	req *connect.Request[pb.ListBlockedMintsRequest],
+) (*connect.Response[pb.ListBlockedMintsResponse], error) {
+	if h.blocklist == nil {
+		return nil, errBlocklistDisabled
+	}
+	res := &pb.ListBlockedMintsResponse{}
+	var entries []model.BlockedMint
+	if req.Msg.Mint != "" {
+		entries = h.blocklist.Entries(req.Msg.Mint)
+		res.Blocked = h.blocklist.IsBlocked(req.Msg.Mint)
+	} else {
+		entries = h.blocklist.Overrides()
+	}
+	res.Entries = make([]*pb.BlockedMint, 0, len(entries))
+	for i := range entries {
+		res.Entries = append(res.Entries, convertBlockedMintToPb(&entries[i]))
+	}
+	return connect.NewResponse(res), nil
+}
+
+// SetBlocklistOverride blocks or allows a mint regardless of feed entries
+func (h *adminServiceHandler) SetBlocklistOverride(
+	ctx context.Context,
+	req *connect.Request[pb.SetBlocklistOverrideRequest],
+) (*connect.Response[pb.SetBlocklistOverrideResponse], error) {
+	if h.blocklist == nil {
+		return nil, errBlocklistDisabled
+	}
+	entry, err := h.blocklist.SetOverride(ctx, req.Msg.Mint, req.Msg.Allow, req.Msg.Reason, req.Msg.UpdatedBy)
+	if err != nil {
+		if errors.Is(err, blocklist.ErrInvalidEntry) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to save blocklist override: %w", err))
+	}
+	return connect.NewResponse(&pb.SetBlocklistOverrideResponse{Entry: convertBlockedMintToPb(entry)}), nil
+}
+
+// DeleteBlocklistOverride removes an admin block or allow
+func (h *adminServiceHandler) DeleteBlocklistOverride(
+	ctx context.Context,
+	req *connect.Request[pb.DeleteBlocklistOverrideRequest],
+) (*connect.Response[pb.DeleteBlocklistOverrideResponse], error) {
+	if h.blocklist == nil {
+		return nil, errBlocklistDisabled
+	}
+	if err := h.blocklist.DeleteOverride(ctx, req.Msg.Mint); err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&pb.DeleteBlocklistOverrideResponse{}), nil
+}
+
+// SyncBlocklist downloads every blocklist feed immediately
+func (h *adminServiceHandler) SyncBlocklist(
+	ctx context.Context,
+	req *connect.Request[pb.SyncBlocklistRequest],
+) (*connect.Response[pb.SyncBlocklistResponse], error) {
+	if h.blocklist == nil {
+		return nil, errBlocklistDisabled
+	}
+	results := h.blocklist.Sync(ctx)
+	pbResults := make([]*pb.BlocklistSyncResult, 0, len(results))
+	for _, result := range results {
+		pbResult := &pb.BlocklistSyncResult{
+			Feed:    result.Feed,
+			Entries: int32(result.Entries),
+			Removed: int32(result.Removed),
+		}
+		if result.Err != nil {
+			pbResult.Error = result.Err.Error()
+		}
+		pbResults = append(pbResults, pbResult)
+	}
+	return connect.NewResponse(&pb.SyncBlocklistResponse{Results: pbResults}), nil
+}
+
+// ListCoinDescriptions returns every localized description stored for a coin
+func (h *adminServiceHandler) ListCoinDescriptions(
+	ctx context.Context,
+	req *connect.Request[pb.ListCoinDescriptionsRequest],
+) (*connect.Response[pb.ListCoinDescriptionsResponse], error) {
+	descriptions, err := h.coinService.ListCoinDescriptions(ctx, req.Msg.CoinAddress)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	res := &pb.ListCoinDescriptionsResponse{Descriptions: make([]*pb.CoinDescription, 0, len(descriptions))}
+	for i := range descriptions {
+		res.Descriptions = append(res.Descriptions, convertCoinDescriptionToPb(&descriptions[i]))
+	}
+	return connect.NewResponse(res), nil
+}
+
+// SetCoinDescription writes an admin description for a coin and locale
+func (h *adminServiceHandler) SetCoinDescription(
+	ctx context.Context,
+	req *connect.Request[pb.SetCoinDescriptionRequest],
+) (*connect.Response[pb.SetCoinDescriptionResponse], error) {
+	description, err := h.coinService.SetCoinDescription(ctx, req.Msg.CoinAddress, req.Msg.Locale, req.Msg.Description, req.Msg.UpdatedBy)
+	if err != nil {
+		if errors.Is(err, coin.ErrInvalidDescription) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&pb.SetCoinDescriptionResponse{Description: convertCoinDescriptionToPb(description)}), nil
+}
+
+// DeleteCoinDescription removes a coin description for a locale
+func (h *adminServiceHandler) DeleteCoinDescription(
+	ctx context.Context,
+	req *connect.Request[pb.DeleteCoinDescriptionRequest],
+) (*connect.Response[pb.DeleteCoinDescriptionResponse], error) {
+	if err := h.coinService.DeleteCoinDescription(ctx, req.Msg.CoinAddress, req.Msg.Locale); err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&pb.DeleteCoinDescriptionResponse{}), nil
+}
+
+// GetCoinHistory returns the recorded changes to a coin's metadata, newest first
+func (h *adminServiceHandler) GetCoinHistory(
+	ctx context.Context,
+	req *connect.Request[pb.GetCoinHistoryRequest],
+) (*connect.Response[pb.GetCoinHistoryResponse], error) {
+	if req.Msg.CoinAddress == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("coin_address is required"))
+	}
+	revisions, err := h.coinService.CoinHistory(ctx, req.Msg.CoinAddress, req.Msg.Field, int(req.Msg.Limit))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	res := &pb.GetCoinHistoryResponse{Revisions: make([]*pb.CoinRevision, 0, len(revisions))}
+	for _, r := range revisions {
+		res.Revisions = append(res.Revisions, &pb.CoinRevision{
+			Id:          r.ID,
+			CoinAddress: r.CoinAddress,
+			Field:       r.Field,
+			Previous:    r.Previous,
+			Current:     r.Current,
+			Source:      r.Source,
+			ChangedAt:   timestamppb.New(r.ChangedAt),
+		})
+	}
+	return connect.NewResponse(res), nil
+}
+
+// ListCoinOverrides returns the admin overrides of a coin and where its other fields came from
+func (h *adminServiceHandler) ListCoinOverrides(
+	ctx context.Context,
+	req *connect.Request[pb.ListCoinOverridesRequest],
+) (*connect.Response[pb.ListCoinOverridesResponse], error) {
+	overrides, err := h.coinService.ListCoinOverrides(ctx, req.Msg.CoinAddress)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	// A coin that isn't stored yet has no sources to report
+	sources, err := h.coinService.CoinMetadataSources(ctx, req.Msg.CoinAddress)
+	if err != nil && !errors.Is(err, db.ErrNotFound) {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	res := &pb.ListCoinOverridesResponse{
+		Overrides:    make([]*pb.CoinOverride, 0, len(overrides)),
+		FieldSources: sources,
+	}
+	for i := range overrides {
+		res.Overrides = append(res.Overrides, convertCoinOverrideToPb(&overrides[i]))
+	}
+	return connect.NewResponse(res), nil
+}
+
+// SetCoinOverride pins a coin metadata field to an admin value
+func (h *adminServiceHandler) SetCoinOverride(
+	ctx context.Context,
+	req *connect.Request[pb.SetCoinOverrideRequest],
+) (*connect.Response[pb.SetCoinOverrideResponse], error) {
+	override, err := h.coinService.SetCoinOverride(ctx, req.Msg.CoinAddress, req.Msg.Field, req.Msg.Value, req.Msg.UpdatedBy)
+	if err != nil {
+		switch {
+		case errors.Is(err, coin.ErrInvalidOverride):
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		case errors.Is(err, db.ErrNotFound):
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&pb.SetCoinOverrideResponse{Override: convertCoinOverrideToPb(override)}), nil
+}
+
+// DeleteCoinOverride removes an admin override of a coin metadata field
+func (h *adminServiceHandler) DeleteCoinOverride(
+	ctx context.Context,
+	req *connect.Request[pb.DeleteCoinOverrideRequest],
+) (*connect.Response[pb.DeleteCoinOverrideResponse], error) {
+	if err := h.coinService.DeleteCoinOverride(ctx, req.Msg.CoinAddress, req.Msg.Field); err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&pb.DeleteCoinOverrideResponse{}), nil
+}
+
+// ListSearchSynonyms returns every coin search synonym
+func (h *adminServiceHandler) ListSearchSynonyms(
+	ctx context.Context,
+	req *connect.Request[pb.ListSearchSynonymsRequest],
+) (*connect.Response[pb.ListSearchSynonymsResponse], error) {
+	synonyms, err := h.coinService.ListSearchSynonyms(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	res := &pb.ListSearchSynonymsResponse{Synonyms: make([]*pb.SearchSynonym, 0, len(synonyms))}
+	for i := range synonyms {
+		res.Synonyms = append(res.Synonyms, convertSearchSynonymToPb(&synonyms[i]))
+	}
+	return connect.NewResponse(res), nil
+}
+
+// SetSearchSynonym maps a search term to another query
+func (h *adminServiceHandler) SetSearchSynonym(
+	ctx context.Context,
+	req *connect.Request[pb.SetSearchSynonymRequest],
+) (*connect.Response[pb.SetSearchSynonymResponse], error) {
+	synonym, err := h.coinService.SetSearchSynonym(ctx, req.Msg.Term, req.Msg.Target, req.Msg.UpdatedBy)
+	if err != nil {
+		if errors.Is(err, coin.ErrInvalidSynonym) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&pb.SetSearchSynonymResponse{Synonym: convertSearchSynonymToPb(synonym)}), nil
+}
+
+// DeleteSearchSynonym removes a search synonym
+func (h *adminServiceHandler) DeleteSearchSynonym(
+	ctx context.Context,
+	req *connect.Request[pb.DeleteSearchSynonymRequest],
+) (*connect.Response[pb.DeleteSearchSynonymResponse], error) {
+	if err := h.coinService.DeleteSearchSynonym(ctx, req.Msg.Term); err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&pb.DeleteSearchSynonymResponse{}), nil
+}
+
+// ListNaughtyWords returns a page of the naughty words
+func (h *adminServiceHandler) ListNaughtyWords(
+	ctx context.Context,
+	req *connect.Request[pb.ListNaughtyWordsRequest],
+) (*connect.Response[pb.ListNaughtyWordsResponse], error) {
+	words, total, err := h.coinService.ListNaughtyWords(ctx, req.Msg.Language, req.Msg.Query, int(req.Msg.Limit), int(req.Msg.Offset))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	res := &pb.ListNaughtyWordsResponse{Words: make([]*pb.NaughtyWord, 0, len(words)), Total: int32(total)}
+	for _, word := range words {
+		res.Words = append(res.Words, &pb.NaughtyWord{Word: word.Word, Language: word.Language})
+	}
+	return connect.NewResponse(res), nil
+}
+
+// AddNaughtyWords adds words to the naughty word filter
+func (h *adminServiceHandler) AddNaughtyWords(
+	ctx context.Context,
+	req *connect.Request[pb.AddNaughtyWordsRequest],
+) (*connect.Response[pb.AddNaughtyWordsResponse], error) {
+	added, err := h.coinService.AddNaughtyWords(ctx, req.Msg.Words, req.Msg.Language)
+	if err != nil {
+		if errors.Is(err, coin.ErrInvalidNaughtyWord) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&pb.AddNaughtyWordsResponse{Added: int32(added)}), nil
+}
+
+// RemoveNaughtyWords removes words from the naughty word filter
+func (h *adminServiceHandler) RemoveNaughtyWords(
+	ctx context.Context,
+	req *connect.Request[pb.RemoveNaughtyWordsRequest],
+) (*connect.Response[pb.RemoveNaughtyWordsResponse], error) {
+	removed, err := h.coinService.RemoveNaughtyWords(ctx, req.Msg.Words)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&pb.RemoveNaughtyWordsResponse{Removed: int32(removed)}), nil
+}
+
+// ImportNaughtyWords adds LDNOOBW word lists to the naughty word filter
+func (h *adminServiceHandler) ImportNaughtyWords(
+	ctx context.Context,
+	req *connect.Request[pb.ImportNaughtyWordsRequest],
+) (*connect.Response[pb.ImportNaughtyWordsResponse], error) {
+	imports, err := h.coinService.ImportNaughtyWords(ctx, req.Msg.Languages)
+	if err != nil {
+		if errors.Is(err, coin.ErrInvalidNaughtyWord) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	res := &pb.ImportNaughtyWordsResponse{Imports: make([]*pb.NaughtyWordImport, 0, len(imports))}
+	for _, imp := range imports {
+		pbImport := &pb.NaughtyWordImport{Language: imp.Language, Fetched: int32(imp.Fetched), Added: int32(imp.Added)}
+		if imp.Err != nil {
+			pbImport.Error = imp.Err.Error()
+		}
+		res.Imports = append(res.Imports, pbImport)
+	}
+	return connect.NewResponse(res), nil
+}
+
+// ExportNaughtyWords returns the naughty words a word per line
+func (h *adminServiceHandler) ExportNaughtyWords(
+	ctx context.Context,
+	req *connect.Request[pb.ExportNaughtyWordsRequest],
+) (*connect.Response[pb.ExportNaughtyWordsResponse], error) {
+	text, count, err := h.coinService.ExportNaughtyWords(ctx, req.Msg.Language)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&pb.ExportNaughtyWordsResponse{Text: text, Count: int32(count)}), nil
+}
+
+// ReloadNaughtyWords has every replica reload the naughty word filter
+func (h *adminServiceHandler) ReloadNaughtyWords(
+	ctx context.Context,
+	req *connect.Request[pb.ReloadNaughtyWordsRequest],
+) (*connect.Response[pb.ReloadNaughtyWordsResponse], error) {
+	h.coinService.ReloadNaughtyWords(ctx)
+	return connect.NewResponse(&pb.ReloadNaughtyWordsResponse{}), nil
+}
+
+// GetTradeLimit returns a wallet's trade limits and usage
+func (h *adminServiceHandler) GetTradeLimit(
+	ctx context.Context,
+	req *connect.Request[pb.GetTradeLimitRequest],
+) (*connect.Response[pb.GetTradeLimitResponse], error) {
+	status, err := h.trades.GetTradeLimit(ctx, req.Msg.WalletAddress)
+	if err != nil {
+		if errors.Is(err, trade.ErrInvalidTradeLimit) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&pb.GetTradeLimitResponse{Status: convertTradeLimitStatusToPb(status)}), nil
+}
+
+// SetTradeLimit overrides a wallet's trade limits
+func (h *adminServiceHandler) SetTradeLimit(
+	ctx context.Context,
+	req *connect.Request[pb.SetTradeLimitRequest],
+) (*connect.Response[pb.SetTradeLimitResponse], error) {
+	if req.Msg.Limit == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("limit is required"))
+	}
+	status, err := h.trades.SetTradeLimit(ctx, model.TradeLimit{
+		ID:             req.Msg.Limit.WalletAddress,
+		DailyLimitUSD:  req.Msg.Limit.DailyLimitUsd,
+		WeeklyLimitUSD: req.Msg.Limit.WeeklyLimitUsd,
+		Exempt:         req.Msg.Limit.Exempt,
+		Reason:         req.Msg.Limit.Reason,
+		UpdatedBy:      req.Msg.Limit.UpdatedBy,
+	})
+	if err != nil {
+		if errors.Is(err, trade.ErrInvalidTradeLimit) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to save trade limit: %w", err))
+	}
+	return connect.NewResponse(&pb.SetTradeLimitResponse{Status: convertTradeLimitStatusToPb(status)}), nil
+}
+
+// DeleteTradeLimit removes a wallet's trade limit override
+func (h *adminServiceHandler) DeleteTradeLimit(
+	ctx context.Context,
+	req *connect.Request[pb.DeleteTradeLimitRequest],
+) (*connect.Response[pb.DeleteTradeLimitResponse], error) {
+	if err := h.trades.DeleteTradeLimit(ctx, req.Msg.WalletAddress); err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&pb.DeleteTradeLimitResponse{}), nil
+}
+
+// ListSlowQueries returns the database queries over the slow query threshold
+func (h *adminServiceHandler) ListSlowQueries(
+	ctx context.Context,
+	req *connect.Request[pb.ListSlowQueriesRequest],
+) (*connect.Response[pb.ListSlowQueriesResponse], error) {
+	if h.queryStats == nil {
+		return nil, errQueryStatsDisabled
+	}
+	limit := int(req.Msg.Limit)
+	if limit <= 0 {
+		limit = 20
+	}
+	queries := h.queryStats.Top(limit)
+	if req.Msg.Reset_ {
+		h.queryStats.Reset()
+	}
+
+	pbQueries := make([]*pb.SlowQuery, 0, len(queries))
+	for _, q := range queries {
+		pbQueries = append(pbQueries, &pb.SlowQuery{
+			Sql:      q.SQL,
+			Table:    q.Table,
+			Count:    q.Count,
+			TotalMs:  durationMillis(q.Total),
+			AvgMs:    durationMillis(q.Avg()),
+			MaxMs:    durationMillis(q.Max),
+			LastSeen: timestamppb.New(q.LastSeen),
+		})
+	}
+	return connect.NewResponse(&pb.ListSlowQueriesResponse{
+		Queries:     pbQueries,
+		ThresholdMs: durationMillis(h.queryStats.Threshold()),
+	}), nil
+}
+
+// BroadcastNotification sends a system announcement to every wallet with a device
+func (h *adminServiceHandler) BroadcastNotification(
+	ctx context.Context,
+	req *connect.Request[pb.BroadcastNotificationRequest],
+) (*connect.Response[pb.BroadcastNotificationResponse], error) {
+	if h.notifications == nil {
+		return nil, errNotificationsDisabled
+	}
+	result, err := h.notifications.Broadcast(ctx, req.Msg.Title, req.Msg.Body, req.Msg.Data)
+	if err != nil {
+		return nil, notificationError("failed to broadcast notification", err)
+	}
+	return connect.NewResponse(&pb.BroadcastNotificationResponse{
+		Wallets:    int32(result.Wallets),
+		Sent:       int32(result.Sent),
+		Suppressed: int32(result.Suppressed),
+		Failed:     int32(result.Failed),
+	}), nil
+}
+
+// ListNotificationDeliveries returns the notification delivery log
+func (h *adminServiceHandler) ListNotificationDeliveries(
+	ctx context.Context,
+	req *connect.Request[pb.ListNotificationDeliveriesRequest],
+) (*connect.Response[pb.ListNotificationDeliveriesResponse], error) {
+	if h.notifications == nil {
+		return nil, errNotificationsDisabled
+	}
+	deliveries, err := h.notifications.ListDeliveries(ctx, notification.DeliveryFilter{
+		WalletAddress: req.Msg.WalletAddress,
+		Type:          req.Msg.Type,
+		Status:        req.Msg.Status,
+		Limit:         int(req.Msg.Limit),
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	pbDeliveries := make([]*pb.NotificationDelivery, 0, len(deliveries))
+	for _, d := range deliveries {
+		pbDeliveries = append(pbDeliveries, &pb.NotificationDelivery{
+			Id:            d.ID,
+			WalletAddress: d.WalletAddress,
+			Type:          d.Type,
+			Title:         d.Title,
+			Body:          d.Body,
+			Status:        d.Status,
+			Detail:        d.Detail,
+			Devices:       int32(d.Devices),
+			CreatedAt:     timestamppb.New(d.CreatedAt),
+		})
+	}
+	return connect.NewResponse(&pb.ListNotificationDeliveriesResponse{Deliveries: pbDeliveries}), nil
+}
+
+// ListJobRuns returns the background job run log, newest first
+func (h *adminServiceHandler) ListJobRuns(
+	ctx context.Context,
+	req *connect.Request[pb.ListJobRunsRequest],
+) (*connect.Response[pb.ListJobRunsResponse], error) {
+	if h.jobs == nil {
+		return nil, errJobsDisabled
+	}
+	runs, err := h.jobs.ListRuns(ctx, jobs.RunFilter{
+		Job:    req.Msg.Job,
+		Status: req.Msg.Status,
+		Limit:  int(req.Msg.Limit),
+	})
+	if err != nil {
+		if errors.Is(err, jobs.ErrRunsNotRecorded) {
+			return nil, errJobsDisabled
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&pb.ListJobRunsResponse{Runs: jobRunsToPB(runs)}), nil
+}
+
+func jobRunsToPB(runs []model.JobRun) []*pb.JobRun {
+	pbRuns := make([]*pb.JobRun, 0, len(runs))
+	for _, r := range runs {
+		pbRun := &pb.JobRun{
+			Id:         r.ID,
+			Job:        r.Job,
+			Attempt:    int32(r.Attempt),
+			Status:     r.Status,
+			Error:      r.Error,
+			StartedAt:  timestamppb.New(r.StartedAt),
+			DurationMs: durationMillis(r.Duration),
+		}
+		if r.FinishedAt != nil {
+			pbRun.FinishedAt = timestamppb.New(*r.FinishedAt)
+		}
+		pbRuns = append(pbRuns, pbRun)
+	}
+	return pbRuns
+}
+
+// defaultOverviewJobRuns is how many recent job runs the operations overview
+// includes unless asked for more
+const defaultOverviewJobRuns = 20
+
+// GetOperationsOverview returns the operational state for the admin dashboard.
+// A section that fails to load is reported in the response's errors rather
+// than failing the whole overview.
+func (h *adminServiceHandler) GetOperationsOverview(
+	ctx context.Context,
+	req *connect.Request[pb.GetOperationsOverviewRequest],
+) (*connect.Response[pb.GetOperationsOverviewResponse], error) {
+	resp := &pb.GetOperationsOverviewResponse{FeeAccount: h.operations.FeeAccount}
+
+	for _, s := range h.operations.Tracker.Stats() {
+		resp.UpstreamServices = append(resp.UpstreamServices, &pb.UpstreamServiceStats{
+			Service:       s.Service,
+			Calls:         s.Calls,
+			Errors:        s.Errors,
+			AvgDurationMs: durationMillis(s.AverageDuration()),
+		})
+	}
+
+	for _, c := range h.operations.Caches {
+		stats := c.Stats()
+		resp.Caches = append(resp.Caches, &pb.CacheStats{
+			Name:     stats.Name,
+			Hits:     stats.Hits,
+			Misses:   stats.Misses,
+			HitRatio: stats.HitRatio,
+		})
+	}
+
+	if h.jobs != nil {
+		limit := int(req.Msg.JobRunLimit)
+		if limit <= 0 {
+			limit = defaultOverviewJobRuns
+		}
+		runs, err := h.jobs.ListRuns(ctx, jobs.RunFilter{Limit: limit})
+		switch {
+		case errors.Is(err, jobs.ErrRunsNotRecorded):
+		case err != nil:
+			resp.Errors = append(resp.Errors, "job_runs: "+err.Error())
+		default:
+			resp.RecentJobRuns = jobRunsToPB(runs)
+		}
+	}
+
+	if h.operations.RPCPool != nil {
+		for _, e := range h.operations.RPCPool.Status() {
+			resp.RpcEndpoints = append(resp.RpcEndpoints, &pb.RPCEndpointStatus{
+				Name:                e.Name,
+				Healthy:             e.Healthy,
+				ConsecutiveFailures: int32(e.ConsecutiveFailures),
+				AvgLatencyMs:        durationMillis(e.Latency),
+			})
+		}
+	}
+
+	if h.coinService != nil {
+		resp.EnrichmentQueued, resp.EnrichmentRunning = h.coinService.EnrichmentQueue()
+	}
+
+	if h.operations.FeeAccount != "" && h.wallets != nil {
+		balances, err := h.wallets.GetWalletBalances(ctx, h.operations.FeeAccount)
+		if err != nil {
+			resp.Errors = append(resp.Errors, "fee_balances: "+err.Error())
+		} else {
+			for _, b := range balances.Balances {
+				resp.FeeBalances = append(resp.FeeBalances, &pb.FeeBalance{
+					Mint:      b.ID,
+					Amount:    b.Amount,
+					RawAmount: b.RawAmount,
+				})
+			}
+		}
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// ListAllAnnouncements returns every announcement, including scheduled and expired ones
+func (h *adminServiceHandler) ListAllAnnouncements(
+	ctx context.Context,
+	_ *connect.Request[pb.ListAllAnnouncementsRequest],
+) (*connect.Response[pb.ListAllAnnouncementsResponse], error) {
+	if h.announcements == nil {
+		return nil, errAnnouncementsDisabled
+	}
+	announcements, err := h.announcements.ListAll(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	pbAnnouncements := make([]*pb.Announcement, 0, len(announcements))
+	for i := range announcements {
+		pbAnnouncements = append(pbAnnouncements, convertAnnouncementToPb(&announcements[i]))
+	}
+	return connect.NewResponse(&pb.ListAllAnnouncementsResponse{Announcements: pbAnnouncements}), nil
+}
+
+// CreateAnnouncement publishes an in-app announcement
+func (h *adminServiceHandler) CreateAnnouncement(
+	ctx context.Context,
+	req *connect.Request[pb.CreateAnnouncementRequest],
+) (*connect.Response[pb.CreateAnnouncementResponse], error) {
+	if h.announcements == nil {
+		return nil, errAnnouncementsDisabled
+	}
+	created, err := h.announcements.Create(ctx, announcementParamsFromPb(req.Msg.Content), req.Msg.CreatedBy)
+	if err != nil {
+		return nil, announcementError("failed to create announcement", err)
+	}
+	return connect.NewResponse(&pb.CreateAnnouncementResponse{Announcement: convertAnnouncementToPb(created)}), nil
+}
+
+// UpdateAnnouncement replaces an announcement's content, schedule and targeting
+func (h *adminServiceHandler) UpdateAnnouncement(
+	ctx context.Context,
+	req *connect.Request[pb.UpdateAnnouncementRequest],
+) (*connect.Response[pb.UpdateAnnouncementResponse], error) {
+	if h.announcements == nil {
+		return nil, errAnnouncementsDisabled
+	}
+	updated, err := h.announcements.Update(ctx, req.Msg.Id, announcementParamsFromPb(req.Msg.Content))
+	if err != nil {
+		return nil, announcementError("failed to update announcement", err)
+	}
+	return connect.NewResponse(&pb.UpdateAnnouncementResponse{Announcement: convertAnnouncementToPb(updated)}), nil
+}
+
+// DeleteAnnouncement removes an announcement
+func (h *adminServiceHandler) DeleteAnnouncement(
+	ctx context.Context,
+	req *connect.Request[pb.DeleteAnnouncementRequest],
+) (*connect.Response[pb.DeleteAnnouncementResponse], error) {
+	if h.announcements == nil {
+		return nil, errAnnouncementsDisabled
+	}
+	if err := h.announcements.Delete(ctx, req.Msg.Id); err != nil {
+		return nil, announcementError("failed to delete announcement", err)
+	}
+	return connect.NewResponse(&pb.DeleteAnnouncementResponse{}), nil
+}
+
+// RevalidateIcons starts re-validating the icons of a slice of the coins
+func (h *adminServiceHandler) RevalidateIcons(
+	ctx context.Context,
+	req *connect.Request[pb.RevalidateIconsRequest],
+) (*connect.Response[pb.RevalidateIconsResponse], error) {
+	filter := coin.IconFilter{
+		Status:  req.Msg.Status,
+		Gateway: req.Msg.Gateway,
+		Limit:   int(req.Msg.Limit),
+	}
+	if req.Msg.Since != nil {
+		filter.Since = req.Msg.Since.AsTime()
+	}
+	if req.Msg.Until != nil {
+		filter.Until = req.Msg.Until.AsTime()
+	}
+	run, err := h.coinService.RevalidateIcons(filter)
+	if err != nil {
+		switch {
+		case errors.Is(err, coin.ErrInvalidIconFilter):
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		case errors.Is(err, coin.ErrImageProxyDisabled):
+			return nil, connect.NewError(connect.CodeUnimplemented, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&pb.RevalidateIconsResponse{Revalidation: convertIconRevalidationToPb(run)}), nil
+}
+
+// GetIconRevalidation returns the progress of an icon re-validation
+func (h *adminServiceHandler) GetIconRevalidation(
+	ctx context.Context,
+	req *connect.Request[pb.GetIconRevalidationRequest],
+) (*connect.Response[pb.GetIconRevalidationResponse], error) {
+	run, err := h.coinService.IconRevalidationProgress(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	return connect.NewResponse(&pb.GetIconRevalidationResponse{Revalidation: convertIconRevalidationToPb(run)}), nil
+}
+
+func convertIconRevalidationToPb(run coin.IconRevalidation) *pb.IconRevalidation {
+	pbRun := &pb.IconRevalidation{
+		Id:           run.ID,
+		Status:       run.Filter.Status,
+		Gateway:      run.Filter.Gateway,
+		Limit:        int32(run.Filter.Limit),
+		Scanned:      int32(run.Scanned),
+		Matched:      int32(run.Matched),
+		Processed:    int32(run.Processed),
+		Valid:        int32(run.Valid),
+		Replaced:     int32(run.Replaced),
+		Placeholders: int32(run.Placeholders),
+		Skipped:      int32(run.Skipped),
+		Failed:       int32(run.Failed),
+		StartedAt:    timestamppb.New(run.StartedAt),
+		Error:        run.Err,
+	}
+	if !run.Filter.Since.IsZero() {
+		pbRun.Since = timestamppb.New(run.Filter.Since)
+	}
+	if !run.Filter.Until.IsZero() {
+		pbRun.Until = timestamppb.New(run.Filter.Until)
+	}
+	if run.Done() {
+		pbRun.FinishedAt = timestamppb.New(run.FinishedAt)
+	}
+	return pbRun
+}
+
+// DeleteRecord soft-deletes a coin, burn watch or user record
+func (h *adminServiceHandler) DeleteRecord(
+	ctx context.Context,
+	req *connect.Request[pb.DeleteRecordRequest],
+) (*connect.Response[pb.DeleteRecordResponse], error) {
+	if h.retention == nil {
+		return nil, errRetentionDisabled
+	}
+	if err := h.retention.Delete(ctx, req.Msg.Kind, req.Msg.Key, req.Msg.DeletedBy, req.Msg.Reason); err != nil {
+		return nil, retentionError(err)
+	}
+	return connect.NewResponse(&pb.DeleteRecordResponse{}), nil
+}
+
+// RestoreRecord undoes the deletion of a record
+func (h *adminServiceHandler) RestoreRecord(
+	ctx context.Context,
+	req *connect.Request[pb.RestoreRecordRequest],
+) (*connect.Response[pb.RestoreRecordResponse], error) {
+	if h.retention == nil {
+		return nil, errRetentionDisabled
+	}
+	if err := h.retention.Restore(ctx, req.Msg.Kind, req.Msg.Key); err != nil {
+		return nil, retentionError(err)
+	}
+	return connect.NewResponse(&pb.RestoreRecordResponse{}), nil
+}
+
+// ListDeletedRecords returns a page of the deleted records of a kind
+func (h *adminServiceHandler) ListDeletedRecords(
+	ctx context.Context,
+	req *connect.Request[pb.ListDeletedRecordsRequest],
+) (*connect.Response[pb.ListDeletedRecordsResponse], error) {
+	if h.retention == nil {
+		return nil, errRetentionDisabled
+	}
+	records, total, err := h.retention.ListDeleted(ctx, req.Msg.Kind, int(req.Msg.Limit), int(req.Msg.Offset))
+	if err != nil {
+		return nil, retentionError(err)
+	}
+	pbRecords := make([]*pb.DeletedRecord, len(records))
+	for i, record := range records {
+		pbRecords[i] = &pb.DeletedRecord{
+			Kind:      record.Kind,
+			Key:       record.Key,
+			Label:     record.Label,
+			DeletedAt: timestamppb.New(record.Deletion.At),
+			DeletedBy: record.Deletion.By,
+			Reason:    record.Deletion.Reason,
+			PurgeAt:   timestamppb.New(record.Deletion.At.Add(h.retention.Retention())),
+		}
+	}
+	return connect.NewResponse(&pb.ListDeletedRecordsResponse{Records: pbRecords, Total: int32(total)}), nil
+}
+
+func retentionError(err error) error {
+	switch {
+	case errors.Is(err, retention.ErrUnknownKind):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, db.ErrNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
+
+func durationMillis(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+var (
+	errFeatureFlagsDisabled  = connect.NewError(connect.CodeUnimplemented, errors.New("feature flags are not enabled"))
+	errSpamFilterDisabled    = connect.NewError(connect.CodeUnimplemented, errors.New("spam filter is not enabled"))
+	errBlocklistDisabled     = connect.NewError(connect.CodeUnimplemented, errors.New("scam blocklist is not enabled"))
+	errQueryStatsDisabled    = connect.NewError(connect.CodeUnimplemented, errors.New("slow query tracking is not enabled"))
+	errJobsDisabled          = connect.NewError(connect.CodeUnimplemented, errors.New("job run recording is not enabled"))
+	errNotificationsDisabled = connect.NewError(connect.CodeUnimplemented, errors.New("notifications are not enabled"))
+	errAnnouncementsDisabled = connect.NewError(connect.CodeUnimplemented, errors.New("announcements are not enabled"))
+	errRetentionDisabled     = connect.NewError(connect.CodeUnimplemented, errors.New("soft deletes are not enabled"))
+)
+
+func (h *adminServiceHandler) findSetting(name string) (*pb.Setting, error) {
+	for _, entry := range h.settings.List() {
+		if entry.Name == name {
+			return convertSettingToPb(&entry), nil
+		}
+	}
+	return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("%w: %s", settings.ErrUnknownSetting, name))
+}
+
+func settingError(op, name string, err error) error {
+	if errors.Is(err, settings.ErrUnknownSetting) {
+		return connect.NewError(connect.CodeNotFound, err)
+	}
+	if errors.Is(err, settings.ErrInvalidValue) {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to %s setting %s: %w", op, name, err))
+}
+
+func convertFeatureFlagToPb(flag *model.FeatureFlag) *pb.FeatureFlag {
+	return &pb.FeatureFlag{
+		Key:            flag.ID,
+		Description:    flag.Description,
+		Enabled:        flag.Enabled,
+		RolloutPercent: int32(flag.RolloutPercent),
+		AllowList:      flag.AllowList,
+		UpdatedAt:      timestamppb.New(flag.UpdatedAt),
+	}
+}
+
+func convertCoinDescriptionToPb(description *model.CoinDescription) *pb.CoinDescription {
+	return &pb.CoinDescription{
+		CoinAddress: description.CoinAddress,
+		Locale:      description.Locale,
+		Description: description.Description,
+		Source:      description.Source,
+		UpdatedBy:   description.UpdatedBy,
+		UpdatedAt:   timestamppb.New(description.UpdatedAt),
+	}
+}
+
+func convertCoinOverrideToPb(override *model.CoinOverride) *pb.CoinOverride {
+	return &pb.CoinOverride{
+		CoinAddress: override.CoinAddress,
+		Field:       override.Field,
+		Value:       override.Value,
+		UpdatedBy:   override.UpdatedBy,
+		UpdatedAt:   timestamppb.New(override.UpdatedAt),
+	}
+}
+
+func convertSearchSynonymToPb(synonym *model.SearchSynonym) *pb.SearchSynonym {
+	return &pb.SearchSynonym{
+		Term:      synonym.ID,
+		Target:    synonym.Target,
+		UpdatedBy: synonym.UpdatedBy,
+		UpdatedAt: timestamppb.New(synonym.UpdatedAt),
+	}
+}
+
+func convertTradeLimitStatusToPb(status *trade.TradeLimitStatus) *pb.TradeLimitStatus {
+	res := &pb.TradeLimitStatus{
+		WalletAddress:  status.WalletAddress,
+		Enforced:       status.Enforced,
+		DailyLimitUsd:  status.DailyLimitUSD,
+		WeeklyLimitUsd: status.WeeklyLimitUSD,
+		DailyUsedUsd:   status.DailyUsedUSD,
+		WeeklyUsedUsd:  status.WeeklyUsedUSD,
+	}
+	if o := status.Override; o != nil {
+		res.Override = &pb.TradeLimit{
+			WalletAddress:  o.ID,
+			DailyLimitUsd:  o.DailyLimitUSD,
+			WeeklyLimitUsd: o.WeeklyLimitUSD,
+			Exempt:         o.Exempt,
+			Reason:         o.Reason,
+			UpdatedBy:      o.UpdatedBy,
+			UpdatedAt:      timestamppb.New(o.UpdatedAt),
+		}
+	}
+	return res
+}
+
+func convertBlockedMintToPb(entry *model.BlockedMint) *pb.BlockedMint {
+	return &pb.BlockedMint{
+		Mint:      entry.Mint,
+		Source:    entry.Source,
+		Reason:    entry.Reason,
+		Allow:     entry.Allow,
+		UpdatedBy: entry.UpdatedBy,
+		UpdatedAt: timestamppb.New(entry.UpdatedAt),
+	}
+}
+
+func convertSpamTokenToPb(token *model.SpamToken) *pb.SpamToken {
+	return &pb.SpamToken{
+		Mint:      token.ID,
+		Verdict:   token.Verdict,
+		Reason:    token.Reason,
+		UpdatedBy: token.UpdatedBy,
+		UpdatedAt: timestamppb.New(token.UpdatedAt),
+	}
+}
+
+func convertSettingToPb(entry *settings.Entry) *pb.Setting {
+	setting := &pb.Setting{
+		Name:         entry.Name,
+		Kind:         string(entry.Kind),
+		Description:  entry.Description,
+		Value:        entry.Value,
+		DefaultValue: entry.Default,
+		Overridden:   entry.Overridden,
+	}
+	if entry.Overridden {
+		setting.UpdatedAt = timestamppb.New(entry.UpdatedAt)
+		if entry.UpdatedBy != "" {
+			setting.UpdatedBy = &entry.UpdatedBy
+		}
+	}
+	return setting
+}
+
+func announcementParamsFromPb(content *pb.AnnouncementContent) announcement.Params {
+	if content == nil {
+		return announcement.Params{}
+	}
+	params := announcement.Params{
+		Title:         content.Title,
+		Body:          content.Body,
+		Severity:      content.Severity,
+		MinAppVersion: content.MinAppVersion,
+		MaxAppVersion: content.MaxAppVersion,
+	}
+	if content.StartsAt != nil {
+		params.StartsAt = content.StartsAt.AsTime()
+	}
+	if content.EndsAt != nil {
+		params.EndsAt = content.EndsAt.AsTime()
+	}
+	return params
+}
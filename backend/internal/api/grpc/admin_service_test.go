@@ -0,0 +1,48 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1"
+	"github.com/nicolas-martin/dankfolio/backend/internal/cache"
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/tracker"
+	"github.com/nicolas-martin/dankfolio/backend/internal/jobs"
+)
+
+func TestGetOperationsOverview(t *testing.T) {
+	apiTracker, err := tracker.NewAPITracker(nil)
+	require.NoError(t, err)
+	apiTracker.RecordEndpointCall(context.Background(), "birdeye", "/defi/price", 10*time.Millisecond, errors.New("rate limited"))
+
+	coinCache, err := cache.NewCoinCache()
+	require.NoError(t, err)
+	coinCache.Get("coin:missing")
+
+	// Without a database the scheduler records no runs, which leaves the
+	// section empty rather than reporting an error
+	h := newAdminServiceHandler(nil, nil, nil, nil, nil, jobs.NewScheduler(nil), Operations{
+		Tracker: apiTracker,
+		Caches:  []cache.StatsReporter{coinCache},
+	}, nil, nil, nil, nil, nil, nil)
+
+	resp, err := h.GetOperationsOverview(context.Background(), connect.NewRequest(&pb.GetOperationsOverviewRequest{}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.UpstreamServices, 1)
+	assert.Equal(t, "birdeye", resp.Msg.UpstreamServices[0].Service)
+	assert.Equal(t, int64(1), resp.Msg.UpstreamServices[0].Errors)
+	assert.Equal(t, 10.0, resp.Msg.UpstreamServices[0].AvgDurationMs)
+	require.Len(t, resp.Msg.Caches, 1)
+	assert.Equal(t, "coin", resp.Msg.Caches[0].Name)
+	assert.Equal(t, uint64(1), resp.Msg.Caches[0].Misses)
+	assert.Empty(t, resp.Msg.RecentJobRuns)
+	assert.Empty(t, resp.Msg.RpcEndpoints)
+	assert.Empty(t, resp.Msg.FeeBalances)
+	assert.Empty(t, resp.Msg.Errors)
+}
@@ -0,0 +1,94 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1"
+	dankfoliov1connect "github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1/v1connect"
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/announcement"
+	"github.com/nicolas-martin/dankfolio/backend/internal/util"
+)
+
+// announcementServiceHandler implements the AnnouncementService API
+type announcementServiceHandler struct {
+	dankfoliov1connect.UnimplementedAnnouncementServiceHandler
+	announcementService *announcement.Service
+}
+
+// newAnnouncementServiceHandler creates a new announcementServiceHandler
+func newAnnouncementServiceHandler(announcementService *announcement.Service) *announcementServiceHandler {
+	return &announcementServiceHandler{announcementService: announcementService}
+}
+
+// ListAnnouncements returns the live announcements for the app with the wallet's read state
+func (h *announcementServiceHandler) ListAnnouncements(
+	ctx context.Context,
+	req *connect.Request[pb.ListAnnouncementsRequest],
+) (*connect.Response[pb.ListAnnouncementsResponse], error) {
+	if req.Msg.WalletAddress != "" && !util.IsValidSolanaAddress(req.Msg.WalletAddress) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid wallet address"))
+	}
+	items, err := h.announcementService.List(ctx, req.Msg.WalletAddress, req.Msg.AppVersion)
+	if err != nil {
+		return nil, announcementError("failed to list announcements", err)
+	}
+	resp := &pb.ListAnnouncementsResponse{Announcements: make([]*pb.Announcement, 0, len(items))}
+	for i := range items {
+		pbAnnouncement := convertAnnouncementToPb(&items[i].Announcement)
+		pbAnnouncement.Read = items[i].Read
+		if !items[i].Read {
+			resp.UnreadCount++
+		}
+		resp.Announcements = append(resp.Announcements, pbAnnouncement)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// MarkAnnouncementsRead marks announcements read for a wallet
+func (h *announcementServiceHandler) MarkAnnouncementsRead(
+	ctx context.Context,
+	req *connect.Request[pb.MarkAnnouncementsReadRequest],
+) (*connect.Response[pb.MarkAnnouncementsReadResponse], error) {
+	if !util.IsValidSolanaAddress(req.Msg.WalletAddress) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid wallet address"))
+	}
+	if err := h.announcementService.MarkRead(ctx, req.Msg.WalletAddress, req.Msg.AnnouncementIds); err != nil {
+		return nil, announcementError("failed to mark announcements read", err)
+	}
+	return connect.NewResponse(&pb.MarkAnnouncementsReadResponse{}), nil
+}
+
+func announcementError(message string, err error) error {
+	if errors.Is(err, announcement.ErrInvalidAnnouncement) {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if errors.Is(err, db.ErrNotFound) {
+		return connect.NewError(connect.CodeNotFound, err)
+	}
+	return connect.NewError(connect.CodeInternal, fmt.Errorf("%s: %w", message, err))
+}
+
+func convertAnnouncementToPb(a *model.Announcement) *pb.Announcement {
+	pbAnnouncement := &pb.Announcement{
+		Id:            a.ID,
+		Title:         a.Title,
+		Body:          a.Body,
+		Severity:      a.Severity,
+		MinAppVersion: a.MinAppVersion,
+		MaxAppVersion: a.MaxAppVersion,
+		StartsAt:      timestamppb.New(a.StartsAt),
+		CreatedAt:     timestamppb.New(a.CreatedAt),
+		UpdatedAt:     timestamppb.New(a.UpdatedAt),
+	}
+	if !a.EndsAt.IsZero() {
+		pbAnnouncement.EndsAt = timestamppb.New(a.EndsAt)
+	}
+	return pbAnnouncement
+}
@@ -0,0 +1,40 @@
+package grpc
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+
+	pb "github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1"
+	dankfoliov1connect "github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1/v1connect"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/appconfig"
+)
+
+// appConfigServiceHandler implements the AppConfigService API
+type appConfigServiceHandler struct {
+	dankfoliov1connect.UnimplementedAppConfigServiceHandler
+	appConfig *appconfig.Service
+}
+
+// newAppConfigServiceHandler creates a new appConfigServiceHandler
+func newAppConfigServiceHandler(appConfig *appconfig.Service) *appConfigServiceHandler {
+	return &appConfigServiceHandler{appConfig: appConfig}
+}
+
+// GetAppConfig returns the remote configuration for the app
+func (h *appConfigServiceHandler) GetAppConfig(
+	_ context.Context,
+	req *connect.Request[pb.GetAppConfigRequest],
+) (*connect.Response[pb.GetAppConfigResponse], error) {
+	config := h.appConfig.Get()
+	return connect.NewResponse(&pb.GetAppConfigResponse{
+		MinimumAppVersion:  config.MinimumAppVersion,
+		UpdateRequired:     h.appConfig.UpdateRequired(req.Msg.AppVersion),
+		DisabledFeatures:   config.DisabledFeatures,
+		DisabledMessage:    config.DisabledMessage,
+		Maintenance:        config.Maintenance,
+		MaintenanceMessage: config.MaintenanceMessage,
+		RpcEndpoint:        config.ClientRPCEndpoint,
+		DefaultSlippageBps: int32(config.DefaultSlippageBps),
+	}), nil
+}
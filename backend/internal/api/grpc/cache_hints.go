@@ -0,0 +1,80 @@
+package grpc
+
+import (
+	"fmt"
+	"hash/fnv"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+// How long clients may reuse a response before asking again. Lists only change
+// when the background fetchers run; coin prices are refreshed on read once stale.
+const (
+	coinListMaxAge   = time.Minute
+	coinDetailMaxAge = 30 * time.Second
+)
+
+// newCacheInfo versions msg by hashing its deterministic encoding and reports
+// whether that matches ifVersion, the version the client already holds. msg must
+// not carry CacheInfo yet or the version would never match.
+func newCacheInfo(msg proto.Message, ifVersion string, lastUpdated time.Time, maxAge time.Duration) (*pb.CacheInfo, bool) {
+	info := &pb.CacheInfo{MaxAgeSeconds: int32(maxAge / time.Second)}
+	if !lastUpdated.IsZero() {
+		info.LastUpdated = timestamppb.New(lastUpdated)
+	}
+	encoded, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		// Without a version the client simply always gets the full payload
+		slog.Warn("Failed to version response for caching", "error", err)
+		return info, false
+	}
+	hash := fnv.New64a()
+	_, _ = hash.Write(encoded)
+	info.Version = fmt.Sprintf("%016x", hash.Sum64())
+	info.NotModified = ifVersion != "" && ifVersion == info.Version
+	return info, info.NotModified
+}
+
+// setCacheHeaders mirrors CacheInfo in HTTP headers for clients and proxies that
+// cache at the transport level, such as Connect GET requests.
+func setCacheHeaders(header http.Header, info *pb.CacheInfo) {
+	if info.Version != "" {
+		header.Set("ETag", `"`+info.Version+`"`)
+	}
+	header.Set("Cache-Control", fmt.Sprintf("private, max-age=%d", info.MaxAgeSeconds))
+}
+
+// cachedCoinList stamps a coin list response with cache metadata, dropping the
+// coins when the client's copy is current.
+func cachedCoinList(resp *pb.GetAvailableCoinsResponse, coins []model.Coin, ifVersion string) *connect.Response[pb.GetAvailableCoinsResponse] {
+	info, notModified := newCacheInfo(resp, ifVersion, coinsLastUpdated(coins...), coinListMaxAge)
+	if notModified {
+		resp = &pb.GetAvailableCoinsResponse{}
+	}
+	resp.CacheInfo = info
+	res := connect.NewResponse(resp)
+	setCacheHeaders(res.Header(), info)
+	return res
+}
+
+// coinsLastUpdated returns the most recent LastUpdated across coins
+func coinsLastUpdated(coins ...model.Coin) time.Time {
+	var latest time.Time
+	for _, c := range coins {
+		if c.LastUpdated == "" {
+			continue
+		}
+		if t, err := time.Parse(time.RFC3339, c.LastUpdated); err == nil && t.After(latest) {
+			latest = t
+		}
+	}
+	return latest
+}
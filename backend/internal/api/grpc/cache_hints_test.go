@@ -0,0 +1,44 @@
+package grpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+func TestCachedCoinList_NotModified(t *testing.T) {
+	coins := []model.Coin{
+		{Address: "a", LastUpdated: "2025-01-01T00:00:00Z"},
+		{Address: "b", LastUpdated: "2025-01-02T00:00:00Z"},
+	}
+	newResp := func() *pb.GetAvailableCoinsResponse {
+		return &pb.GetAvailableCoinsResponse{
+			Coins:      []*pb.Coin{{Address: "a"}, {Address: "b"}},
+			TotalCount: 2,
+		}
+	}
+
+	first := cachedCoinList(newResp(), coins, "")
+	info := first.Msg.CacheInfo
+	require.NotEmpty(t, info.Version)
+	assert.False(t, info.NotModified)
+	assert.Len(t, first.Msg.Coins, 2)
+	assert.Equal(t, time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC), info.LastUpdated.AsTime())
+	assert.Equal(t, `"`+info.Version+`"`, first.Header().Get("ETag"))
+
+	second := cachedCoinList(newResp(), coins, info.Version)
+	assert.True(t, second.Msg.CacheInfo.NotModified)
+	assert.Equal(t, info.Version, second.Msg.CacheInfo.Version)
+	assert.Empty(t, second.Msg.Coins)
+
+	changed := newResp()
+	changed.TotalCount = 3
+	third := cachedCoinList(changed, coins, info.Version)
+	assert.False(t, third.Msg.CacheInfo.NotModified)
+	assert.NotEqual(t, info.Version, third.Msg.CacheInfo.Version)
+}
@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"time"
@@ -14,7 +15,11 @@ import (
 	dankfoliov1connect "github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1/v1connect"
 	"github.com/nicolas-martin/dankfolio/backend/internal/db"
 	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/authority"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/burn"
 	"github.com/nicolas-martin/dankfolio/backend/internal/service/coin"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/imageproxy"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/leaderboard"
 )
 
 // #region test
@@ -22,12 +27,20 @@ import (
 type coinServiceHandler struct {
 	dankfoliov1connect.UnimplementedCoinServiceHandler
 	coinService *coin.Service
+	leaderboard *leaderboard.Service // Optional; GetCoinTopTraders is unavailable when nil
+	burns       *burn.Checker        // Optional; coin detail has no burn fields when nil
+	authorities *authority.Watcher   // Optional; coin detail has no authority fields when nil
+	images      *imageproxy.Service  // Optional; logos are served as stored when nil
 }
 
 // newCoinServiceHandler creates a new coinServiceHandler
-func newCoinServiceHandler(coinService *coin.Service) *coinServiceHandler {
+func newCoinServiceHandler(coinService *coin.Service, leaderboardService *leaderboard.Service, burnChecker *burn.Checker, authorityWatcher *authority.Watcher, images *imageproxy.Service) *coinServiceHandler {
 	return &coinServiceHandler{
 		coinService: coinService,
+		leaderboard: leaderboardService,
+		burns:       burnChecker,
+		authorities: authorityWatcher,
+		images:      images,
 	}
 }
 
@@ -39,8 +52,6 @@ func (s *coinServiceHandler) GetAvailableCoins(ctx context.Context, req *connect
 	var err error
 	var totalCount int32
 
-	slog.Debug("GetAvailableCoins request received", "limit", req.Msg.GetLimit(), "offset", req.Msg.GetOffset())
-
 	// Use default ListOptions since GetAvailableCoinsRequest doesn't have pagination/sorting fields
 	listOptions := db.ListOptions{}
 	// Apply default pagination and sorting
@@ -68,31 +79,90 @@ func (s *coinServiceHandler) GetAvailableCoins(ctx context.Context, req *connect
 
 	pbCoins := make([]*pb.Coin, len(coins))
 	for i, coinModel := range coins { // Iterate over model.Coin directly
-		pbCoins[i] = convertModelCoinToPbCoin(&coinModel) // Pass address of coinModel
+		pbCoins[i] = s.convertCoin(&coinModel) // Pass address of coinModel
+	}
+	s.localizeCoins(ctx, req.Msg.Locale, pbCoins...)
+	if err := applyFieldMask(req.Msg.FieldMask, pbCoins...); err != nil {
+		return nil, err
 	}
 
-	res := connect.NewResponse(&pb.GetAvailableCoinsResponse{
+	resp := &pb.GetAvailableCoinsResponse{
 		Coins:      pbCoins,
 		TotalCount: totalCount,
-	})
-	return res, nil
+	}
+	return cachedCoinList(resp, coins, req.Msg.IfVersionNotChanged), nil
 }
 
 // GetCoinByID returns a specific coin by ID
 func (s *coinServiceHandler) GetCoinByID(ctx context.Context, req *connect.Request[pb.GetCoinByIDRequest]) (*connect.Response[pb.Coin], error) {
+	if err := s.coinService.CheckBlocked(req.Msg.Address); err != nil {
+		return nil, toConnectError(err, connect.CodeFailedPrecondition)
+	}
 	coin, err := s.coinService.GetCoinByAddress(ctx, req.Msg.Address)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("failed to get coin: %w", err))
+		return nil, toConnectError(fmt.Errorf("failed to get coin: %w", err), connect.CodeNotFound)
+	}
+
+	// Revalidations of a coin the client already shows aren't new views
+	if req.Msg.IfVersionNotChanged == "" {
+		if err := s.coinService.RecordView(ctx, coin.Address); err != nil {
+			slog.WarnContext(ctx, "Failed to record coin view", "address", coin.Address, "error", err)
+		}
 	}
 
-	res := connect.NewResponse(convertModelCoinToPbCoin(coin))
+	pbCoin := s.convertCoin(coin)
+	s.localizeCoins(ctx, req.Msg.Locale, pbCoin)
+	s.addBurnSummary(ctx, pbCoin)
+	s.addAuthorities(ctx, pbCoin)
+	info, notModified := newCacheInfo(pbCoin, req.Msg.IfVersionNotChanged, coinsLastUpdated(*coin), coinDetailMaxAge)
+	if notModified {
+		pbCoin = &pb.Coin{Address: coin.Address}
+	}
+	pbCoin.CacheInfo = info
+	res := connect.NewResponse(pbCoin)
+	setCacheHeaders(res.Header(), info)
 	return res, nil
 }
 
+// addBurnSummary sets the burn fields on a coin detail. They are left unset
+// when the summary cannot be loaded rather than failing the request.
+func (s *coinServiceHandler) addBurnSummary(ctx context.Context, pbCoin *pb.Coin) {
+	if s.burns == nil {
+		return
+	}
+	summary, err := s.burns.Summary(ctx, pbCoin.Address)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to load burn summary", "address", pbCoin.Address, "error", err)
+		return
+	}
+	pbCoin.SupplyBurnedPercent = summary.SupplyBurnedPercent
+	pbCoin.LpBurnedPercent = summary.LPBurnedPercent
+	if !summary.LastBurnAt.IsZero() {
+		pbCoin.LastBurnAt = timestamppb.New(summary.LastBurnAt)
+	}
+}
+
+// addAuthorities sets the mint authority fields and safety score on a coin
+// detail once the coin has been checked
+func (s *coinServiceHandler) addAuthorities(ctx context.Context, pbCoin *pb.Coin) {
+	if s.authorities == nil {
+		return
+	}
+	authorities, err := s.authorities.Get(ctx, pbCoin.Address)
+	if err != nil {
+		if !errors.Is(err, db.ErrNotFound) {
+			slog.WarnContext(ctx, "Failed to load mint authorities", "address", pbCoin.Address, "error", err)
+		}
+		return
+	}
+	score := int32(authorities.SafetyScore)
+	pbCoin.SafetyScore = &score
+	pbCoin.MintAuthority = &authorities.MintAuthority
+	pbCoin.FreezeAuthority = &authorities.FreezeAuthority
+}
+
 // GetCoinsByIDs returns multiple coins by their addresses in a single request
 func (s *coinServiceHandler) GetCoinsByIDs(ctx context.Context, req *connect.Request[pb.GetCoinsByIDsRequest]) (*connect.Response[pb.GetCoinsByIDsResponse], error) {
-	slog.DebugContext(ctx, "gRPC GetCoinsByIDs request received", "addresses_count", len(req.Msg.Addresses))
-	
 	if len(req.Msg.Addresses) == 0 {
 		return connect.NewResponse(&pb.GetCoinsByIDsResponse{
 			Coins: []*pb.Coin{},
@@ -109,19 +179,18 @@ func (s *coinServiceHandler) GetCoinsByIDs(ctx context.Context, req *connect.Req
 	forceRefresh := req.Msg.ForceRefresh
 	coins, err := s.coinService.GetCoinsByAddresses(ctx, req.Msg.Addresses, forceRefresh)
 	if err != nil {
-		slog.ErrorContext(ctx, "GetCoinsByIDs service call failed", "error", err, "addresses_count", len(req.Msg.Addresses))
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get coins by addresses: %w", err))
 	}
 
 	// Convert model coins to protobuf coins
 	pbCoins := make([]*pb.Coin, len(coins))
 	for i, coinModel := range coins {
-		pbCoins[i] = convertModelCoinToPbCoin(&coinModel)
+		pbCoins[i] = s.convertCoin(&coinModel)
+	}
+	s.localizeCoins(ctx, req.Msg.Locale, pbCoins...)
+	if err := applyFieldMask(req.Msg.FieldMask, pbCoins...); err != nil {
+		return nil, err
 	}
-
-	slog.InfoContext(ctx, "Successfully processed batch coin request", 
-		"requested_count", len(req.Msg.Addresses), 
-		"returned_count", len(pbCoins))
 
 	res := connect.NewResponse(&pb.GetCoinsByIDsResponse{
 		Coins: pbCoins,
@@ -134,14 +203,19 @@ func (s *coinServiceHandler) SearchCoinByAddress(
 	ctx context.Context,
 	req *connect.Request[pb.SearchCoinByAddressRequest],
 ) (*connect.Response[pb.SearchCoinByAddressResponse], error) {
+	if err := s.coinService.CheckBlocked(req.Msg.Address); err != nil {
+		return nil, toConnectError(err, connect.CodeFailedPrecondition)
+	}
 	coin, err := s.coinService.GetCoinByAddress(ctx, req.Msg.Address)
 	if err != nil {
 		// Return user-friendly error message instead of technical details
-		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("%v", err))
+		return nil, toConnectError(err, connect.CodeNotFound)
 	}
 
+	pbCoin := s.convertCoin(coin)
+	s.localizeCoins(ctx, req.Msg.Locale, pbCoin)
 	res := connect.NewResponse(&pb.SearchCoinByAddressResponse{
-		Coin: convertModelCoinToPbCoin(coin),
+		Coin: pbCoin,
 	})
 	return res, nil
 }
@@ -160,12 +234,20 @@ func (s *coinServiceHandler) Search(ctx context.Context, req *connect.Request[pb
 	// validate if the req.Msg.Query is a valid mint address
 	solanaAddress, err := solana.PublicKeyFromBase58(req.Msg.Query)
 	if err == nil {
-		coin, err := s.coinService.GetCoinByAddress(ctx, solanaAddress.String())
+		if err := s.coinService.CheckBlocked(solanaAddress.String()); err != nil {
+			return nil, toConnectError(err, connect.CodeFailedPrecondition)
+		}
+		coin, err := s.coinService.LookupMint(ctx, solanaAddress.String())
 		if err != nil {
 			// Return user-friendly error message instead of technical details
-			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("%v", err))
+			return nil, toConnectError(err, connect.CodeNotFound)
+		}
+		pbCoin := s.convertCoin(coin)
+		s.localizeCoins(ctx, req.Msg.Locale, pbCoin)
+		if err := applyFieldMask(req.Msg.FieldMask, pbCoin); err != nil {
+			return nil, err
 		}
-		return connect.NewResponse(&pb.SearchResponse{Coins: []*pb.Coin{convertModelCoinToPbCoin(coin)}}), nil
+		return connect.NewResponse(&pb.SearchResponse{Coins: []*pb.Coin{pbCoin}}), nil
 	}
 
 	// Convert protobuf request to internal types
@@ -198,9 +280,28 @@ func (s *coinServiceHandler) Search(ctx context.Context, req *connect.Request[pb
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to search coins: %w", err))
 	}
 
+	// Archived coins only fill the page once live results run out
+	if limit := int(req.Msg.GetLimit()); req.Msg.GetIncludeArchived() && limit > 0 && len(coins) < limit {
+		archiveOffset := int32(0)
+		if len(coins) == 0 {
+			archiveOffset = req.Msg.GetOffset()
+		}
+		archived, err := s.coinService.SearchArchivedCoins(ctx, query, int32(limit-len(coins)), archiveOffset)
+		if err != nil {
+			slog.WarnContext(ctx, "Failed to search archived coins", "query", query, "error", err)
+		} else {
+			coins = append(coins, archived...)
+			total += int32(len(archived))
+		}
+	}
+
 	pbCoins := make([]*pb.Coin, len(coins))
 	for i, c := range coins { // Iterate over model.Coin directly
-		pbCoins[i] = convertModelCoinToPbCoin(&c) // Pass address of c
+		pbCoins[i] = s.convertCoin(&c) // Pass address of c
+	}
+	s.localizeCoins(ctx, req.Msg.Locale, pbCoins...)
+	if err := applyFieldMask(req.Msg.FieldMask, pbCoins...); err != nil {
+		return nil, err
 	}
 
 	res := connect.NewResponse(&pb.SearchResponse{
@@ -215,8 +316,6 @@ func (s *coinServiceHandler) GetNewCoins(
 	ctx context.Context,
 	req *connect.Request[pb.GetNewCoinsRequest],
 ) (*connect.Response[pb.GetAvailableCoinsResponse], error) {
-	slog.DebugContext(ctx, "gRPC GetNewCoins request received", "limit", req.Msg.GetLimit(), "offset", req.Msg.GetOffset())
-
 	// Parse and validate protobuf request
 	var limit, offset int32
 	if req.Msg.Limit != nil {
@@ -229,30 +328,26 @@ func (s *coinServiceHandler) GetNewCoins(
 	// Call service with domain types
 	modelCoins, totalCount, err := s.coinService.GetNewCoins(ctx, limit, offset)
 	if err != nil {
-		slog.ErrorContext(ctx, "GetNewCoins service call failed", "error", err)
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get new coins: %w", err))
 	}
 
-	// Convert domain models to protobuf and collect symbols for logging
+	// Convert domain models to protobuf
 	pbCoins := make([]*pb.Coin, len(modelCoins))
-	symbols := make([]string, len(modelCoins))
 	for i, coinModel := range modelCoins {
-		pbCoins[i] = convertModelCoinToPbCoin(&coinModel)
-		symbols[i] = coinModel.Symbol
+		pbCoins[i] = s.convertCoin(&coinModel)
+	}
+	s.localizeCoins(ctx, req.Msg.Locale, pbCoins...)
+	if err := applyFieldMask(req.Msg.FieldMask, pbCoins...); err != nil {
+		return nil, err
 	}
 	
-	// Log the symbols being returned for debugging
-	slog.InfoContext(ctx, "🆕 GetNewCoins returning coins", 
-		"count", len(modelCoins),
-		"symbols", symbols,
-		"totalCount", totalCount)
 
 	resp := &pb.GetAvailableCoinsResponse{
 		Coins:      pbCoins,
 		TotalCount: totalCount,
 	}
 
-	return connect.NewResponse(resp), nil
+	return cachedCoinList(resp, modelCoins, req.Msg.IfVersionNotChanged), nil
 }
 
 // GetTrendingCoins handles the GetTrendingCoins RPC call.
@@ -260,8 +355,6 @@ func (s *coinServiceHandler) GetTrendingCoins(
 	ctx context.Context,
 	req *connect.Request[pb.GetTrendingCoinsRequest],
 ) (*connect.Response[pb.GetAvailableCoinsResponse], error) {
-	slog.DebugContext(ctx, "gRPC GetTrendingCoins request received", "limit", req.Msg.GetLimit(), "offset", req.Msg.GetOffset())
-
 	// Parse and validate protobuf request
 	var limit, offset int32
 	if req.Msg.Limit != nil {
@@ -274,30 +367,26 @@ func (s *coinServiceHandler) GetTrendingCoins(
 	// Call service with domain types
 	modelCoins, totalCount, err := s.coinService.GetTrendingCoinsRPC(ctx, limit, offset)
 	if err != nil {
-		slog.ErrorContext(ctx, "GetTrendingCoins service call failed", "error", err)
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get trending coins: %w", err))
 	}
 
-	// Convert domain models to protobuf and collect symbols for logging
+	// Convert domain models to protobuf
 	pbCoins := make([]*pb.Coin, len(modelCoins))
-	symbols := make([]string, len(modelCoins))
 	for i, coinModel := range modelCoins {
-		pbCoins[i] = convertModelCoinToPbCoin(&coinModel)
-		symbols[i] = coinModel.Symbol
+		pbCoins[i] = s.convertCoin(&coinModel)
+	}
+	s.localizeCoins(ctx, req.Msg.Locale, pbCoins...)
+	if err := applyFieldMask(req.Msg.FieldMask, pbCoins...); err != nil {
+		return nil, err
 	}
 	
-	// Log the symbols being returned for debugging
-	slog.InfoContext(ctx, "📈 GetTrendingCoins returning coins", 
-		"count", len(modelCoins),
-		"symbols", symbols,
-		"totalCount", totalCount)
 
 	resp := &pb.GetAvailableCoinsResponse{
 		Coins:      pbCoins,
 		TotalCount: totalCount,
 	}
 
-	return connect.NewResponse(resp), nil
+	return cachedCoinList(resp, modelCoins, req.Msg.IfVersionNotChanged), nil
 }
 
 // GetTopGainersCoins handles the GetTopGainersCoins RPC call.
@@ -305,8 +394,6 @@ func (s *coinServiceHandler) GetTopGainersCoins(
 	ctx context.Context,
 	req *connect.Request[pb.GetTopGainersCoinsRequest],
 ) (*connect.Response[pb.GetAvailableCoinsResponse], error) {
-	slog.DebugContext(ctx, "gRPC GetTopGainersCoins request received", "limit", req.Msg.GetLimit(), "offset", req.Msg.GetOffset())
-
 	// Parse and validate protobuf request
 	var limit, offset int32
 	if req.Msg.Limit != nil {
@@ -319,30 +406,26 @@ func (s *coinServiceHandler) GetTopGainersCoins(
 	// Call service with domain types
 	modelCoins, totalCount, err := s.coinService.GetTopGainersCoins(ctx, limit, offset)
 	if err != nil {
-		slog.ErrorContext(ctx, "GetTopGainersCoins service call failed", "error", err)
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get top gainer coins: %w", err))
 	}
 
-	// Convert domain models to protobuf and collect symbols for logging
+	// Convert domain models to protobuf
 	pbCoins := make([]*pb.Coin, len(modelCoins))
-	symbols := make([]string, len(modelCoins))
 	for i, coinModel := range modelCoins {
-		pbCoins[i] = convertModelCoinToPbCoin(&coinModel)
-		symbols[i] = coinModel.Symbol
+		pbCoins[i] = s.convertCoin(&coinModel)
+	}
+	s.localizeCoins(ctx, req.Msg.Locale, pbCoins...)
+	if err := applyFieldMask(req.Msg.FieldMask, pbCoins...); err != nil {
+		return nil, err
 	}
 	
-	// Log the symbols being returned for debugging
-	slog.InfoContext(ctx, "🚀 GetTopGainersCoins returning coins", 
-		"count", len(modelCoins),
-		"symbols", symbols,
-		"totalCount", totalCount)
 
 	resp := &pb.GetAvailableCoinsResponse{
 		Coins:      pbCoins,
 		TotalCount: totalCount,
 	}
 
-	return connect.NewResponse(resp), nil
+	return cachedCoinList(resp, modelCoins, req.Msg.IfVersionNotChanged), nil
 }
 
 // GetXStocksCoins returns xStocks tokens
@@ -362,14 +445,17 @@ func (s *coinServiceHandler) GetXStocksCoins(ctx context.Context, req *connect.R
 	// Call service with domain types
 	modelCoins, totalCount, err := s.coinService.GetXStocksCoins(ctx, limit, offset)
 	if err != nil {
-		slog.ErrorContext(ctx, "GetXStocksCoins service call failed", "error", err)
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get xStocks coins: %w", err))
 	}
 
 	// Convert domain models to protobuf
 	pbCoins := make([]*pb.Coin, len(modelCoins))
 	for i, coinModel := range modelCoins {
-		pbCoins[i] = convertModelCoinToPbCoin(&coinModel)
+		pbCoins[i] = s.convertCoin(&coinModel)
+	}
+	s.localizeCoins(ctx, req.Msg.Locale, pbCoins...)
+	if err := applyFieldMask(req.Msg.FieldMask, pbCoins...); err != nil {
+		return nil, err
 	}
 
 	resp := &pb.GetAvailableCoinsResponse{
@@ -377,7 +463,114 @@ func (s *coinServiceHandler) GetXStocksCoins(ctx context.Context, req *connect.R
 		TotalCount: totalCount,
 	}
 
-	return connect.NewResponse(resp), nil
+	return cachedCoinList(resp, modelCoins, req.Msg.IfVersionNotChanged), nil
+}
+
+// GetCoinTradeStats returns buy/sell activity for a coin over 5m, 1h and 24h windows
+func (s *coinServiceHandler) GetCoinTradeStats(ctx context.Context, req *connect.Request[pb.GetCoinTradeStatsRequest]) (*connect.Response[pb.GetCoinTradeStatsResponse], error) {
+	if _, err := solana.PublicKeyFromBase58(req.Msg.Address); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid coin address: %w", err))
+	}
+	if err := s.coinService.CheckBlocked(req.Msg.Address); err != nil {
+		return nil, toConnectError(err, connect.CodeFailedPrecondition)
+	}
+
+	stats, err := s.coinService.GetCoinTradeStats(ctx, req.Msg.Address)
+	if err != nil {
+		slog.ErrorContext(ctx, "GetCoinTradeStats service call failed", "address", req.Msg.Address, "error", err)
+		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("trade stats are temporarily unavailable"))
+	}
+
+	windows := make([]*pb.TradeWindowStats, len(stats.Windows))
+	for i, w := range stats.Windows {
+		windows[i] = &pb.TradeWindowStats{
+			Window:        w.Window,
+			Trades:        int32(w.Trades),
+			Buys:          int32(w.Buys),
+			Sells:         int32(w.Sells),
+			UniqueWallets: int32(w.UniqueWallets),
+			VolumeUsd:     w.VolumeUSD,
+			BuyVolumeUsd:  w.BuyVolumeUSD,
+			SellVolumeUsd: w.SellVolumeUSD,
+		}
+	}
+
+	return connect.NewResponse(&pb.GetCoinTradeStatsResponse{
+		Address:   stats.Address,
+		Windows:   windows,
+		UpdatedAt: timestamppb.New(stats.UpdatedAt),
+	}), nil
+}
+
+// GetCoinTopTraders returns the largest holders and most profitable recent traders of a coin
+func (s *coinServiceHandler) GetCoinTopTraders(ctx context.Context, req *connect.Request[pb.GetCoinTopTradersRequest]) (*connect.Response[pb.GetCoinTopTradersResponse], error) {
+	if s.leaderboard == nil {
+		return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("top traders are not enabled"))
+	}
+	if _, err := solana.PublicKeyFromBase58(req.Msg.Address); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid coin address: %w", err))
+	}
+	if err := s.coinService.CheckBlocked(req.Msg.Address); err != nil {
+		return nil, toConnectError(err, connect.CodeFailedPrecondition)
+	}
+
+	limit := int(req.Msg.Limit)
+	if limit <= 0 {
+		limit = 10
+	}
+	limit = min(limit, leaderboard.MaxEntries)
+
+	board, err := s.leaderboard.GetCoinLeaderboard(ctx, req.Msg.Address)
+	if err != nil {
+		slog.ErrorContext(ctx, "GetCoinTopTraders service call failed", "address", req.Msg.Address, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get top traders"))
+	}
+
+	holders := board.Holders[:min(limit, len(board.Holders))]
+	traders := board.Traders[:min(limit, len(board.Traders))]
+	res := &pb.GetCoinTopTradersResponse{
+		Address:     board.Address,
+		TopHolders:  make([]*pb.TopHolder, len(holders)),
+		TopTraders:  make([]*pb.TopTrader, len(traders)),
+		WindowHours: int32(board.Window.Hours()),
+		ComputedAt:  timestamppb.New(board.ComputedAt),
+	}
+	for i, h := range holders {
+		res.TopHolders[i] = &pb.TopHolder{Wallet: h.Wallet, Amount: h.Amount, ValueUsd: h.ValueUSD}
+	}
+	for i, t := range traders {
+		res.TopTraders[i] = &pb.TopTrader{
+			Wallet:           t.Wallet,
+			RealizedPnlUsd:   t.RealizedPnLUSD,
+			UnrealizedPnlUsd: t.UnrealizedPnLUSD,
+			TotalPnlUsd:      t.TotalPnLUSD(),
+			VolumeUsd:        t.VolumeUSD,
+			Trades:           int32(t.Trades),
+		}
+	}
+	return connect.NewResponse(res), nil
+}
+
+// localizeCoins swaps in each coin's description for locale where one is stored.
+// Lookup failures are logged and leave the default descriptions in place.
+func (s *coinServiceHandler) localizeCoins(ctx context.Context, locale string, coins ...*pb.Coin) {
+	if locale == "" || len(coins) == 0 {
+		return
+	}
+	addresses := make([]string, len(coins))
+	for i, c := range coins {
+		addresses[i] = c.Address
+	}
+	descriptions, err := s.coinService.LocalizedDescriptions(ctx, locale, addresses)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to localize coin descriptions", "locale", locale, "error", err)
+		return
+	}
+	for _, c := range coins {
+		if description, ok := descriptions[c.Address]; ok {
+			c.Description = description
+		}
+	}
 }
 
 // pint is a helper function to get a pointer to an int.
@@ -433,7 +626,70 @@ func convertModelCoinToPbCoin(coin *model.Coin) *pb.Coin {
 		Fdv:                    &coin.FDV,
 		Marketcap:              &coin.Marketcap,
 		Rank:                   &r, // Mapped from coin.Rank (int) to *int32
+		Archived:               coin.Archived,
 	}
 	
 	return pbCoin
 }
+
+// convertCoin converts a coin for a response, pointing its logo at the URL
+// clients should fetch stored icons from
+func (s *coinServiceHandler) convertCoin(coin *model.Coin) *pb.Coin {
+	pbCoin := convertModelCoinToPbCoin(coin)
+	if s.images != nil {
+		pbCoin.LogoUri = s.images.ServedURL(pbCoin.LogoUri)
+	}
+	return pbCoin
+}
+
+// GetMarketOverview returns the home screen market summary in one call
+func (s *coinServiceHandler) GetMarketOverview(ctx context.Context, req *connect.Request[pb.GetMarketOverviewRequest]) (*connect.Response[pb.GetMarketOverviewResponse], error) {
+	overview, err := s.coinService.GetMarketOverview(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "GetMarketOverview service call failed", "error", err)
+		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("market overview is temporarily unavailable"))
+	}
+
+	toPb := func(coins []model.Coin) []*pb.Coin {
+		pbCoins := make([]*pb.Coin, len(coins))
+		for i := range coins {
+			pbCoins[i] = s.convertCoin(&coins[i])
+		}
+		return pbCoins
+	}
+	gainers := toPb(overview.TopGainers)
+	losers := toPb(overview.TopLosers)
+	s.localizeCoins(ctx, req.Msg.Locale, append(gainers, losers...)...)
+
+	return connect.NewResponse(&pb.GetMarketOverviewResponse{
+		SolPrice:                 overview.SolPrice,
+		SolPrice24HChangePercent: overview.SolPrice24hChangePercent,
+		TotalVolume24HUsd:        overview.Totals.Volume24hUSD,
+		NewListings24H:           int32(overview.Totals.NewListings24h),
+		TopGainers:               gainers,
+		TopLosers:                losers,
+		ComputedAt:               timestamppb.New(overview.ComputedAt),
+	}), nil
+}
+
+// GetRecentlyViewed returns the coins the caller opened most recently
+func (s *coinServiceHandler) GetRecentlyViewed(ctx context.Context, req *connect.Request[pb.GetRecentlyViewedRequest]) (*connect.Response[pb.GetRecentlyViewedResponse], error) {
+	limit := int(req.Msg.GetLimit())
+	if limit <= 0 {
+		limit = 20
+	}
+	limit = min(limit, coin.MaxRecentViews)
+	coins, err := s.coinService.RecentlyViewed(ctx, limit)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get recently viewed coins: %w", err))
+	}
+	pbCoins := make([]*pb.Coin, len(coins))
+	for i := range coins {
+		pbCoins[i] = s.convertCoin(&coins[i])
+	}
+	s.localizeCoins(ctx, req.Msg.Locale, pbCoins...)
+	if err := applyFieldMask(req.Msg.FieldMask, pbCoins...); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&pb.GetRecentlyViewedResponse{Coins: pbCoins}), nil
+}
@@ -0,0 +1,48 @@
+package grpc
+
+import (
+	"connectrpc.com/connect"
+	"github.com/klauspost/compress/zstd"
+)
+
+// compressMinBytes skips compressing responses too small to benefit
+const compressMinBytes = 1024
+
+// compressionOptions negotiates response compression with clients. Connect
+// already speaks gzip; zstd is added for clients that support it since it is
+// both smaller and cheaper to decode on phones.
+func compressionOptions() connect.HandlerOption {
+	return connect.WithHandlerOptions(
+		connect.WithCompression("zstd", newZstdDecompressor, newZstdCompressor),
+		connect.WithCompressMinBytes(compressMinBytes),
+	)
+}
+
+// zstdDecoder adapts zstd.Decoder, whose Close returns nothing, to connect.Decompressor.
+// Concurrency is pinned to one so pooled coders don't each hold goroutines.
+type zstdDecoder struct {
+	*zstd.Decoder
+}
+
+func (d *zstdDecoder) Close() error {
+	// Closing a pooled decoder would release it for good; Reset(nil) frees the reader instead
+	return d.Decoder.Reset(nil)
+}
+
+func newZstdDecompressor() connect.Decompressor {
+	decoder, err := zstd.NewReader(nil, zstd.WithDecoderConcurrency(1))
+	if err != nil {
+		// Only fails on invalid options
+		panic(err)
+	}
+	return &zstdDecoder{Decoder: decoder}
+}
+
+func newZstdCompressor() connect.Compressor {
+	encoder, err := zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+	if err != nil {
+		// Only fails on invalid options
+		panic(err)
+	}
+	return encoder
+}
@@ -0,0 +1,16 @@
+package grpc
+
+import (
+	"connectrpc.com/connect"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/apperrors"
+)
+
+// toConnectError reports err with its apperrors code and details, or with
+// fallback when err is unclassified
+func toConnectError(err error, fallback connect.Code) *connect.Error {
+	if connectErr, ok := apperrors.ToConnect(err); ok {
+		return connectErr
+	}
+	return connect.NewError(fallback, err)
+}
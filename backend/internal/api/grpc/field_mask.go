@@ -0,0 +1,63 @@
+package grpc
+
+import (
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// maskTree is a parsed field mask. A field with no children is kept whole.
+type maskTree map[string]maskTree
+
+func newMaskTree(paths []string) maskTree {
+	tree := maskTree{}
+	for _, path := range paths {
+		node := tree
+		for name := range strings.SplitSeq(path, ".") {
+			child, ok := node[name]
+			if !ok {
+				child = maskTree{}
+				node[name] = child
+			}
+			node = child
+		}
+	}
+	return tree
+}
+
+// prune clears every populated field of m that the tree does not select
+func (t maskTree) prune(m protoreflect.Message) {
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		child, ok := t[string(fd.Name())]
+		switch {
+		case !ok:
+			m.Clear(fd)
+		case len(child) > 0 && fd.Message() != nil && !fd.IsList() && !fd.IsMap():
+			child.prune(v.Message())
+		}
+		return true
+	})
+}
+
+// applyFieldMask trims each item to the fields named in mask, so list views only
+// pay for what they render. Paths name fields of the item message, e.g. "symbol"
+// or "cache_info.version". A nil or empty mask leaves items untouched.
+func applyFieldMask[T proto.Message](mask *fieldmaskpb.FieldMask, items ...T) error {
+	if len(mask.GetPaths()) == 0 {
+		return nil
+	}
+	// A nil message still carries its descriptor, so the mask is checked even for empty lists
+	var zero T
+	if !mask.IsValid(zero) {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid field mask %v", mask.GetPaths()))
+	}
+	tree := newMaskTree(mask.GetPaths())
+	for _, item := range items {
+		tree.prune(item.ProtoReflect())
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package grpc
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	pb "github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1"
+)
+
+func TestApplyFieldMask(t *testing.T) {
+	price24h := 12.5
+	coins := []*pb.Coin{
+		{
+			Address:               "a",
+			Symbol:                "AAA",
+			Description:           "long text",
+			Price:                 1,
+			Price24HChangePercent: &price24h,
+			CacheInfo:             &pb.CacheInfo{Version: "v1", MaxAgeSeconds: 30},
+		},
+	}
+
+	err := applyFieldMask(&fieldmaskpb.FieldMask{Paths: []string{"address", "price", "cache_info.version"}}, coins...)
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(&pb.Coin{
+		Address:   "a",
+		Price:     1,
+		CacheInfo: &pb.CacheInfo{Version: "v1"},
+	}, coins[0]))
+}
+
+func TestApplyFieldMask_RejectsUnknownField(t *testing.T) {
+	err := applyFieldMask[*pb.Coin](&fieldmaskpb.FieldMask{Paths: []string{"nope"}})
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	assert.NoError(t, applyFieldMask[*pb.Coin](nil))
+}
+
+func TestZstdRoundTrip(t *testing.T) {
+	payload := bytes.Repeat([]byte("dankfolio "), 500)
+
+	var compressed bytes.Buffer
+	compressor := newZstdCompressor()
+	compressor.Reset(&compressed)
+	_, err := compressor.Write(payload)
+	require.NoError(t, err)
+	require.NoError(t, compressor.Close())
+	assert.Less(t, compressed.Len(), len(payload))
+
+	decompressor := newZstdDecompressor()
+	require.NoError(t, decompressor.Reset(&compressed))
+	got, err := io.ReadAll(decompressor)
+	require.NoError(t, err)
+	require.NoError(t, decompressor.Close())
+	assert.Equal(t, payload, got)
+}
@@ -0,0 +1,117 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+
+	pb "github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1"
+	dankfoliov1connect "github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1/v1connect"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/notification"
+	"github.com/nicolas-martin/dankfolio/backend/internal/util"
+)
+
+// notificationServiceHandler implements the NotificationService API
+type notificationServiceHandler struct {
+	dankfoliov1connect.UnimplementedNotificationServiceHandler
+	notificationService *notification.Service
+}
+
+// newNotificationServiceHandler creates a new notificationServiceHandler
+func newNotificationServiceHandler(notificationService *notification.Service) *notificationServiceHandler {
+	return &notificationServiceHandler{notificationService: notificationService}
+}
+
+// RegisterDevice adds a device token for a wallet's push notifications
+func (h *notificationServiceHandler) RegisterDevice(
+	ctx context.Context,
+	req *connect.Request[pb.RegisterDeviceRequest],
+) (*connect.Response[pb.RegisterDeviceResponse], error) {
+	if !util.IsValidSolanaAddress(req.Msg.WalletAddress) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid wallet address"))
+	}
+	if err := h.notificationService.RegisterDevice(ctx, req.Msg.WalletAddress, req.Msg.DeviceToken); err != nil {
+		return nil, notificationError("failed to register device", err)
+	}
+	return connect.NewResponse(&pb.RegisterDeviceResponse{}), nil
+}
+
+// UnregisterDevice removes a device token from a wallet
+func (h *notificationServiceHandler) UnregisterDevice(
+	ctx context.Context,
+	req *connect.Request[pb.UnregisterDeviceRequest],
+) (*connect.Response[pb.UnregisterDeviceResponse], error) {
+	if !util.IsValidSolanaAddress(req.Msg.WalletAddress) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid wallet address"))
+	}
+	if err := h.notificationService.UnregisterDevice(ctx, req.Msg.WalletAddress, req.Msg.DeviceToken); err != nil {
+		return nil, notificationError("failed to unregister device", err)
+	}
+	return connect.NewResponse(&pb.UnregisterDeviceResponse{}), nil
+}
+
+// GetNotificationPreferences returns a wallet's notification preferences
+func (h *notificationServiceHandler) GetNotificationPreferences(
+	ctx context.Context,
+	req *connect.Request[pb.GetNotificationPreferencesRequest],
+) (*connect.Response[pb.GetNotificationPreferencesResponse], error) {
+	if !util.IsValidSolanaAddress(req.Msg.WalletAddress) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid wallet address"))
+	}
+	prefs, err := h.notificationService.GetPreferences(ctx, req.Msg.WalletAddress)
+	if err != nil {
+		return nil, notificationError("failed to get notification preferences", err)
+	}
+	return connect.NewResponse(&pb.GetNotificationPreferencesResponse{
+		Preferences: convertNotificationPreferencesToPb(prefs),
+	}), nil
+}
+
+// UpdateNotificationPreferences replaces a wallet's notification preferences
+func (h *notificationServiceHandler) UpdateNotificationPreferences(
+	ctx context.Context,
+	req *connect.Request[pb.UpdateNotificationPreferencesRequest],
+) (*connect.Response[pb.UpdateNotificationPreferencesResponse], error) {
+	if !util.IsValidSolanaAddress(req.Msg.WalletAddress) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid wallet address"))
+	}
+	prefs, err := h.notificationService.UpdatePreferences(ctx, req.Msg.WalletAddress, notification.UpdatePreferencesParams{
+		DisabledTypes:        req.Msg.DisabledTypes,
+		TransferThresholdUSD: req.Msg.TransferThresholdUsd,
+		DumpThresholdPercent: req.Msg.DumpThresholdPercent,
+		Channel:              req.Msg.Channel,
+		QuietHoursStart:      req.Msg.QuietHoursStart,
+		QuietHoursEnd:        req.Msg.QuietHoursEnd,
+		Timezone:             req.Msg.Timezone,
+	})
+	if err != nil {
+		return nil, notificationError("failed to update notification preferences", err)
+	}
+	return connect.NewResponse(&pb.UpdateNotificationPreferencesResponse{
+		Preferences: convertNotificationPreferencesToPb(prefs),
+	}), nil
+}
+
+func notificationError(message string, err error) error {
+	if errors.Is(err, notification.ErrInvalidPreferences) {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return connect.NewError(connect.CodeInternal, fmt.Errorf("%s: %w", message, err))
+}
+
+func convertNotificationPreferencesToPb(prefs *model.NotificationPreferences) *pb.NotificationPreferences {
+	return &pb.NotificationPreferences{
+		WalletAddress:        prefs.WalletAddress,
+		DisabledTypes:        prefs.DisabledTypes,
+		TransferThresholdUsd: prefs.TransferThresholdUSD,
+		DumpThresholdPercent: prefs.DumpThresholdPercent,
+		DeviceCount:          int32(len(prefs.DeviceTokens)),
+		Channel:              prefs.Channel,
+		QuietHoursStart:      prefs.QuietHoursStart,
+		QuietHoursEnd:        prefs.QuietHoursEnd,
+		Timezone:             prefs.Timezone,
+	}
+}
@@ -0,0 +1,99 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1"
+	dankfoliov1connect "github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1/v1connect"
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/payment"
+)
+
+// paymentServiceHandler implements the merchant-facing PaymentService API
+type paymentServiceHandler struct {
+	dankfoliov1connect.UnimplementedPaymentServiceHandler
+	paymentService *payment.Service
+}
+
+// newPaymentServiceHandler creates a new paymentServiceHandler
+func newPaymentServiceHandler(paymentService *payment.Service) *paymentServiceHandler {
+	return &paymentServiceHandler{paymentService: paymentService}
+}
+
+// CreatePaymentRequest creates a Solana Pay payment request
+func (h *paymentServiceHandler) CreatePaymentRequest(
+	ctx context.Context,
+	req *connect.Request[pb.CreatePaymentRequestRequest],
+) (*connect.Response[pb.CreatePaymentRequestResponse], error) {
+	request, err := h.paymentService.CreatePaymentRequest(ctx, payment.CreatePaymentRequestParams{
+		Recipient: req.Msg.Recipient,
+		Mint:      req.Msg.Mint,
+		Amount:    req.Msg.Amount,
+		Label:     req.Msg.Label,
+		Message:   req.Msg.Message,
+		Memo:      req.Msg.Memo,
+	})
+	if err != nil {
+		if errors.Is(err, payment.ErrInvalidPaymentRequest) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create payment request: %w", err))
+	}
+	return connect.NewResponse(&pb.CreatePaymentRequestResponse{
+		PaymentRequest: h.convertPaymentRequestToPb(request),
+	}), nil
+}
+
+// GetPaymentStatus reports whether a payment request has been paid
+func (h *paymentServiceHandler) GetPaymentStatus(
+	ctx context.Context,
+	req *connect.Request[pb.GetPaymentStatusRequest],
+) (*connect.Response[pb.GetPaymentStatusResponse], error) {
+	if req.Msg.Id == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("id is required"))
+	}
+	request, err := h.paymentService.GetPaymentStatus(ctx, req.Msg.Id)
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("payment request not found"))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get payment status: %w", err))
+	}
+	return connect.NewResponse(&pb.GetPaymentStatusResponse{
+		PaymentRequest: h.convertPaymentRequestToPb(request),
+	}), nil
+}
+
+func (h *paymentServiceHandler) convertPaymentRequestToPb(request *model.PaymentRequest) *pb.PaymentRequest {
+	pbRequest := &pb.PaymentRequest{
+		Id:                 request.ID,
+		Recipient:          request.Recipient,
+		Mint:               request.Mint,
+		Amount:             request.Amount,
+		Reference:          request.Reference,
+		Label:              request.Label,
+		Message:            request.Message,
+		Memo:               request.Memo,
+		Status:             request.Status,
+		CreatedAt:          timestamppb.New(request.CreatedAt),
+		ExpiresAt:          timestamppb.New(request.ExpiresAt),
+		TransferRequestUrl: payment.TransferRequestURL(request),
+	}
+	if request.Signature != "" {
+		pbRequest.Signature = &request.Signature
+		pbRequest.Payer = &request.Payer
+	}
+	if !request.PaidAt.IsZero() {
+		pbRequest.PaidAt = timestamppb.New(request.PaidAt)
+	}
+	if link := h.paymentService.TransactionRequestURL(request); link != "" {
+		pbRequest.TransactionRequestUrl = &link
+	}
+	return pbRequest
+}
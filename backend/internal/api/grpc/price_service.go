@@ -2,13 +2,18 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"log/slog"
 	"maps"
+	"time"
 
 	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
 	pb "github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1"
 	dankfoliov1connect "github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1/v1connect"
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/birdeye"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/fx"
 	"github.com/nicolas-martin/dankfolio/backend/internal/service/price"
 )
 
@@ -45,36 +50,27 @@ func (s *priceServiceHandler) GetPriceHistory(
 		historyType = pb.GetPriceHistoryRequest_FOUR_HOUR // Defaulting in handler
 	}
 
-	config, ok := price.TimeframeConfigMap[historyType]
-	if !ok {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unsupported history type: %s", historyType.String()))
-	}
-
-	if req.Msg.Time == "" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("time is required"))
+	config, endTime, err := s.resolveTimeframe(historyType, req.Msg.Time, req.Msg.From, req.Msg.To, req.Msg.Granularity)
+	if err != nil {
+		return nil, err
 	}
 
-	slog.Debug("Fetching price history",
-		"address", req.Msg.Address,
-		"config", config,
-		"time", req.Msg.Time,
-		"address_type", addressType)
-
 	// Get price history from service
 	// Pass the historyType enum (req.Msg.Type or its default) directly to the service method.
 	priceHistory, err := s.priceService.GetPriceHistory(
 		ctx,
 		req.Msg.Address,
 		config,
-		req.Msg.Time, // Now it's already a string
+		endTime,
 		addressType,
 	)
 	if err != nil {
-		slog.Error("Failed to get price history",
-			"address", req.Msg.Address,
-			"error", err)
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get price history: %w", err))
 	}
+	priceHistory, currency, err := s.priceService.ConvertPriceHistory(ctx, priceHistory, req.Msg.DisplayCurrency)
+	if err != nil {
+		return nil, toConnectError(err, connect.CodeUnavailable)
+	}
 
 	// Convert to protobuf response
 	pbItems := make([]*pb.PriceHistoryItem, len(priceHistory.Data.Items))
@@ -85,19 +81,126 @@ func (s *priceServiceHandler) GetPriceHistory(
 		}
 	}
 
-	slog.Debug("Price history fetched successfully",
-		"address", req.Msg.Address,
-		"items_count", len(pbItems))
-
-	res := connect.NewResponse(&pb.GetPriceHistoryResponse{
+	resp := &pb.GetPriceHistoryResponse{
 		Data: &pb.PriceHistoryData{
 			Items: pbItems,
 		},
-		Success: priceHistory.Success,
-	})
+		Success:  priceHistory.Success,
+		Currency: currency,
+	}
+
+	// History is cached server-side per rounding bucket, so the client can reuse it that long
+	var lastUpdated time.Time
+	if n := len(priceHistory.Data.Items); n > 0 {
+		lastUpdated = time.Unix(priceHistory.Data.Items[n-1].UnixTime, 0)
+	}
+	info, notModified := newCacheInfo(resp, req.Msg.IfVersionNotChanged, lastUpdated, config.Rounding)
+	if notModified {
+		resp = &pb.GetPriceHistoryResponse{Success: priceHistory.Success, Currency: currency}
+	}
+	resp.CacheInfo = info
+	res := connect.NewResponse(resp)
+	setCacheHeaders(res.Header(), info)
 	return res, nil
 }
 
+// resolveTimeframe returns the chart configuration and window end for a
+// timeframe, or for a custom range when from or to is set
+func (s *priceServiceHandler) resolveTimeframe(historyType pb.GetPriceHistoryRequest_PriceHistoryType, endTime, fromStr, toStr, granularity string) (price.BackendTimeframeConfig, string, error) {
+	if fromStr == "" && toStr == "" {
+		config, ok := s.priceService.Timeframe(historyType)
+		if !ok {
+			return config, "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unsupported history type: %s", historyType.String()))
+		}
+		if endTime == "" {
+			return config, "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("time is required"))
+		}
+		return config, endTime, nil
+	}
+
+	from, err := time.Parse(time.RFC3339, fromStr)
+	if err != nil {
+		return price.BackendTimeframeConfig{}, "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("from must be an RFC3339 time: %w", err))
+	}
+	to, err := time.Parse(time.RFC3339, toStr)
+	if err != nil {
+		return price.BackendTimeframeConfig{}, "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("to must be an RFC3339 time: %w", err))
+	}
+	config, err := price.CustomTimeframe(from, to, granularity, time.Now())
+	if err != nil {
+		return config, "", connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return config, to.UTC().Format(time.RFC3339), nil
+}
+
+// GetPriceCandles returns OHLCV candles for a chart with the requested
+// technical indicators computed over them
+func (s *priceServiceHandler) GetPriceCandles(
+	ctx context.Context,
+	req *connect.Request[pb.GetPriceCandlesRequest],
+) (*connect.Response[pb.GetPriceCandlesResponse], error) {
+	if req.Msg.Address == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("address is required"))
+	}
+	historyType := req.Msg.Type
+	if historyType == pb.GetPriceHistoryRequest_PRICE_HISTORY_TYPE_UNSPECIFIED {
+		historyType = pb.GetPriceHistoryRequest_FOUR_HOUR
+	}
+	config, endTime, err := s.resolveTimeframe(historyType, req.Msg.Time, req.Msg.From, req.Msg.To, req.Msg.Granularity)
+	if err != nil {
+		return nil, err
+	}
+
+	requests := make([]price.IndicatorRequest, len(req.Msg.Indicators))
+	for i, indicator := range req.Msg.Indicators {
+		kind, ok := indicatorKinds[indicator.Kind]
+		if !ok {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unsupported indicator: %s", indicator.Kind.String()))
+		}
+		requests[i] = price.IndicatorRequest{Kind: kind, Period: int(indicator.Period)}
+	}
+
+	candles, err := s.priceService.GetPriceCandles(ctx, req.Msg.Address, config, endTime)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get price candles: %w", err))
+	}
+	series, err := price.ComputeIndicators(candles.Data.Items, requests)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	resp := &pb.GetPriceCandlesResponse{
+		Candles:    make([]*pb.Candle, len(candles.Data.Items)),
+		Indicators: make([]*pb.IndicatorSeries, len(series)),
+	}
+	for i, candle := range candles.Data.Items {
+		resp.Candles[i] = &pb.Candle{
+			UnixTime: candle.UnixTime,
+			Open:     candle.Open,
+			High:     candle.High,
+			Low:      candle.Low,
+			Close:    candle.Close,
+			Volume:   candle.Volume,
+		}
+	}
+	for i, indicator := range series {
+		resp.Indicators[i] = &pb.IndicatorSeries{
+			Kind:   req.Msg.Indicators[i].Kind,
+			Period: int32(indicator.Period),
+			Start:  int32(indicator.Start),
+			Values: indicator.Values,
+		}
+	}
+	return connect.NewResponse(resp), nil
+}
+
+var indicatorKinds = map[pb.IndicatorRequest_Kind]string{
+	pb.IndicatorRequest_SMA:  price.IndicatorSMA,
+	pb.IndicatorRequest_EMA:  price.IndicatorEMA,
+	pb.IndicatorRequest_RSI:  price.IndicatorRSI,
+	pb.IndicatorRequest_VWAP: price.IndicatorVWAP,
+}
+
 // GetCoinPrices returns current prices for multiple coins
 func (s *priceServiceHandler) GetCoinPrices(
 	ctx context.Context,
@@ -108,25 +211,143 @@ func (s *priceServiceHandler) GetCoinPrices(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("no coin IDs provided"))
 	}
 
-	slog.Debug("Getting prices for multiple coins", "count", len(coinIDs))
 	prices, err := s.priceService.GetCoinPrices(ctx, coinIDs)
 	if err != nil {
-		slog.Error("Failed to get coin prices", "error", err)
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get coin prices: %w", err))
 	}
-
-	slog.Debug("Retrieved prices for coins", "count", len(prices))
+	prices, currency, err := s.priceService.ConvertPrices(ctx, prices, req.Msg.DisplayCurrency)
+	if err != nil {
+		return nil, toConnectError(err, connect.CodeUnavailable)
+	}
 
 	// Convert map to proto response
 	priceMap := make(map[string]float64)
 	maps.Copy(priceMap, prices)
 
 	res := connect.NewResponse(&pb.GetCoinPricesResponse{
-		Prices: priceMap,
+		Prices:   priceMap,
+		Currency: currency,
+	})
+	return res, nil
+}
+
+// GetPricesBatch returns current prices for up to 100 addresses in a single upstream call
+func (s *priceServiceHandler) GetPricesBatch(
+	ctx context.Context,
+	req *connect.Request[pb.GetPricesBatchRequest],
+) (*connect.Response[pb.GetPricesBatchResponse], error) {
+	addresses := req.Msg.Addresses
+	if len(addresses) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("no addresses provided"))
+	}
+
+	if len(addresses) > birdeye.MaxMultiPriceAddresses {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("batch size %d exceeds maximum allowed %d", len(addresses), birdeye.MaxMultiPriceAddresses))
+	}
+
+	prices, err := s.priceService.GetPricesBatch(ctx, addresses)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get batch prices: %w", err))
+	}
+	prices, currency, err := s.priceService.ConvertPrices(ctx, prices, req.Msg.DisplayCurrency)
+	if err != nil {
+		return nil, toConnectError(err, connect.CodeUnavailable)
+	}
+
+	var missingAddresses []string
+	for _, address := range addresses {
+		if _, found := prices[address]; !found {
+			missingAddresses = append(missingAddresses, address)
+		}
+	}
+
+	res := connect.NewResponse(&pb.GetPricesBatchResponse{
+		Prices:           prices,
+		MissingAddresses: missingAddresses,
+		Currency:         currency,
 	})
 	return res, nil
 }
 
+// StreamPrices sends the current prices of the requested tokens, then live
+// updates until the client disconnects
+func (s *priceServiceHandler) StreamPrices(
+	ctx context.Context,
+	req *connect.Request[pb.StreamPricesRequest],
+	stream *connect.ServerStream[pb.StreamPricesResponse],
+) error {
+	addresses := req.Msg.Addresses
+	if len(addresses) == 0 {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("no addresses provided"))
+	}
+	if len(addresses) > birdeye.MaxMultiPriceAddresses {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%d addresses exceeds maximum allowed %d", len(addresses), birdeye.MaxMultiPriceAddresses))
+	}
+
+	// Subscribe before the snapshot so no update between the two is missed
+	updates, cancel, err := s.priceService.SubscribePrices(addresses, req.Msg.IncludeTrades)
+	if errors.Is(err, price.ErrLivePricesDisabled) {
+		return connect.NewError(connect.CodeUnimplemented, err)
+	}
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	defer cancel()
+
+	prices, err := s.priceService.GetPricesBatch(ctx, addresses)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get prices: %w", err))
+	}
+	now := time.Now()
+	for address, usd := range prices {
+		if err := s.sendPriceTick(ctx, stream, req.Msg.DisplayCurrency, &price.PriceUpdate{Address: address, Price: usd, Time: now}); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case update := <-updates:
+			var err error
+			if update.Price != nil {
+				err = s.sendPriceTick(ctx, stream, req.Msg.DisplayCurrency, update.Price)
+			} else if update.Trade != nil {
+				err = stream.Send(&pb.StreamPricesResponse{Update: &pb.StreamPricesResponse_Trade{Trade: convertTokenTradeToPb(update.Trade)}})
+			}
+			if err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (s *priceServiceHandler) sendPriceTick(ctx context.Context, stream *connect.ServerStream[pb.StreamPricesResponse], displayCurrency string, update *price.PriceUpdate) error {
+	converted, currency, err := s.priceService.ConvertPrices(ctx, map[string]float64{update.Address: update.Price}, displayCurrency)
+	if err != nil {
+		return toConnectError(err, connect.CodeUnavailable)
+	}
+	return stream.Send(&pb.StreamPricesResponse{Update: &pb.StreamPricesResponse_Price{Price: &pb.PriceTick{
+		Address:  update.Address,
+		Price:    converted[update.Address],
+		Currency: currency,
+		Time:     timestamppb.New(update.Time),
+	}}})
+}
+
+func convertTokenTradeToPb(trade *birdeye.TradeEvent) *pb.TokenTrade {
+	return &pb.TokenTrade{
+		Address:   trade.Address,
+		TxHash:    trade.TxHash,
+		Owner:     trade.Owner,
+		Side:      trade.Side,
+		Source:    trade.Source,
+		VolumeUsd: trade.VolumeUSD,
+		Time:      timestamppb.New(trade.Time),
+	}
+}
+
 // GetPriceHistoriesByIDs returns price histories for multiple addresses in a single request
 func (s *priceServiceHandler) GetPriceHistoriesByIDs(
 	ctx context.Context,
@@ -143,8 +364,6 @@ func (s *priceServiceHandler) GetPriceHistoriesByIDs(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("batch size %d exceeds maximum allowed %d", len(items), maxBatchSize))
 	}
 
-	slog.DebugContext(ctx, "gRPC GetPriceHistoriesByIDs request received", "requests_count", len(items))
-
 	// Convert protobuf requests to service requests
 	var serviceRequests []price.PriceHistoryBatchRequest
 	for _, item := range items {
@@ -163,7 +382,7 @@ func (s *priceServiceHandler) GetPriceHistoriesByIDs(
 			historyType = pb.GetPriceHistoryRequest_FOUR_HOUR // Default
 		}
 
-		config, ok := price.TimeframeConfigMap[historyType]
+		config, ok := s.priceService.Timeframe(historyType)
 		if !ok {
 			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unsupported history type: %s", historyType.String()))
 		}
@@ -180,13 +399,9 @@ func (s *priceServiceHandler) GetPriceHistoriesByIDs(
 		})
 	}
 
-	slog.DebugContext(ctx, "Fetching price histories using batch service",
-		"requests_count", len(serviceRequests))
-
 	// Call the batch service method
 	results, err := s.priceService.GetPriceHistoriesByAddresses(ctx, serviceRequests)
 	if err != nil {
-		slog.ErrorContext(ctx, "GetPriceHistoriesByIDs service call failed", "error", err, "requests_count", len(serviceRequests))
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get price histories: %w", err))
 	}
 
@@ -194,7 +409,14 @@ func (s *priceServiceHandler) GetPriceHistoriesByIDs(
 	pbResults := make(map[string]*pb.PriceHistoryResult)
 	var failedAddresses []string
 
+	currency := fx.NormalizeCurrency(req.Msg.DisplayCurrency)
 	for address, result := range results {
+		if result.Success && result.Data != nil {
+			result.Data, currency, err = s.priceService.ConvertPriceHistory(ctx, result.Data, req.Msg.DisplayCurrency)
+			if err != nil {
+				return nil, toConnectError(err, connect.CodeUnavailable)
+			}
+		}
 		if !result.Success || result.Data == nil {
 			failedAddresses = append(failedAddresses, address)
 			pbResults[address] = &pb.PriceHistoryResult{
@@ -222,14 +444,10 @@ func (s *priceServiceHandler) GetPriceHistoriesByIDs(
 		}
 	}
 
-	slog.InfoContext(ctx, "Successfully processed batch price history request",
-		"requested_count", len(serviceRequests),
-		"successful_count", len(results)-len(failedAddresses),
-		"failed_count", len(failedAddresses))
-
 	res := connect.NewResponse(&pb.GetPriceHistoriesByIDsResponse{
 		Results:         pbResults,
 		FailedAddresses: failedAddresses,
+		Currency:        currency,
 	})
 	return res, nil
 }
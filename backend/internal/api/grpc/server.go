@@ -1,6 +1,8 @@
 package grpc
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
@@ -8,31 +10,104 @@ import (
 	"connectrpc.com/connect"
 	"firebase.google.com/go/v4/appcheck"
 	dankfoliov1connect "github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1/v1connect"
+	"github.com/nicolas-martin/dankfolio/backend/internal/blocklist"
+	"github.com/nicolas-martin/dankfolio/backend/internal/cache"
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/solana"
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/tracker"
+	"github.com/nicolas-martin/dankfolio/backend/internal/db/postgres"
+	"github.com/nicolas-martin/dankfolio/backend/internal/featureflags"
+	"github.com/nicolas-martin/dankfolio/backend/internal/geo"
+	"github.com/nicolas-martin/dankfolio/backend/internal/jobs"
 	"github.com/nicolas-martin/dankfolio/backend/internal/middleware"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/announcement"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/appconfig"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/authority"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/burn"
 	"github.com/nicolas-martin/dankfolio/backend/internal/service/coin"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/imageproxy"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/leaderboard"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/notification"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/payment"
 	"github.com/nicolas-martin/dankfolio/backend/internal/service/price"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/retention"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/status"
 	"github.com/nicolas-martin/dankfolio/backend/internal/service/trade"
 	"github.com/nicolas-martin/dankfolio/backend/internal/service/wallet"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/webhook"
+	"github.com/nicolas-martin/dankfolio/backend/internal/settings"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 )
 
+// maintenanceProcedures are rejected in maintenance mode: everything that
+// builds or submits a transaction. Balances, prices and search keep serving.
+var maintenanceProcedures = []string{
+	dankfoliov1connect.TradeServicePrepareSwapProcedure,
+	dankfoliov1connect.TradeServiceRefreshQuoteProcedure,
+	dankfoliov1connect.TradeServiceSubmitSwapProcedure,
+	dankfoliov1connect.WalletServicePrepareTransferProcedure,
+	dankfoliov1connect.WalletServiceSubmitTransferProcedure,
+	dankfoliov1connect.WalletServicePrepareRevokeApprovalsProcedure,
+}
+
+// RegionRestrictedProcedures are refused to callers from regions the access
+// policy makes read-only: everything that trades. Transfers stay available so
+// users can always move their funds out.
+var RegionRestrictedProcedures = []string{
+	dankfoliov1connect.TradeServicePrepareSwapProcedure,
+	dankfoliov1connect.TradeServiceRefreshQuoteProcedure,
+	dankfoliov1connect.TradeServiceSubmitSwapProcedure,
+	dankfoliov1connect.TradeServiceCreateTwapOrderProcedure,
+}
+
+// RegionExemptProcedures are served to callers from every region, so the app
+// can explain a block and operators are never locked out
+var RegionExemptProcedures = []string{
+	dankfoliov1connect.AppConfigServiceGetAppConfigProcedure,
+	dankfoliov1connect.StatusServiceGetServiceStatusProcedure,
+	"/" + dankfoliov1connect.AdminServiceName + "/",
+}
+
 // Server represents the API server
 type Server struct {
-	mux              *http.ServeMux
-	coinService      *coin.Service
-	walletService    *wallet.Service
-	tradeService     *trade.Service
-	priceService     *price.Service
-	utilityService   *Service
-	appCheckClient   *appcheck.Client
-	env              string
-	devAppCheckToken string
-	tracer           trace.Tracer
-	meter            metric.Meter
-	rateLimiter      *middleware.RateLimiter
+	mux                 *http.ServeMux
+	coinService         *coin.Service
+	walletService       *wallet.Service
+	tradeService        *trade.Service
+	priceService        *price.Service
+	utilityService      *Service
+	appCheckClient      *appcheck.Client
+	env                 string
+	devAppCheckToken    string
+	tracer              trace.Tracer
+	meter               metric.Meter
+	rateLimiter         *middleware.RateLimiter
+	webhookService      *webhook.Service
+	adminAPIKey         string
+	settingsManager     *settings.Manager
+	featureFlags        *featureflags.Evaluator
+	spamClassifier      *wallet.SpamClassifier
+	blocklist           *blocklist.Blocklist
+	queryStats          *postgres.QueryStats
+	jobScheduler        *jobs.Scheduler
+	operations          Operations
+	leaderboard         *leaderboard.Service
+	burnChecker         *burn.Checker
+	authorityWatcher    *authority.Watcher
+	paymentService      *payment.Service
+	notificationService *notification.Service
+	announcementService *announcement.Service
+	retentionService    *retention.Service
+	appConfig           *appconfig.Service
+	geoPolicy           *geo.Policy
+	statusMonitor       *status.Monitor
+	imageProxy          *imageproxy.Service
+	requestLogger       *middleware.RequestLogger
+	httpServer          *http.Server
+
+	reportUpstreamCalls bool
 }
 
 // NewServer creates a new Server instance
@@ -61,6 +136,7 @@ func NewServer(
 		env:              env,
 		devAppCheckToken: devAppCheckToken,
 		rateLimiter:      rateLimiter,
+		httpServer:       &http.Server{},
 	}
 }
 
@@ -75,17 +151,156 @@ func (s *Server) SetOtel(tracer trace.Tracer, meter metric.Meter) {
 	s.meter = meter
 }
 
+// SetWebhookService enables the partner WebhookService API
+func (s *Server) SetWebhookService(webhookService *webhook.Service) {
+	s.webhookService = webhookService
+}
+
+// SetSettingsManager enables the AdminService settings API
+func (s *Server) SetSettingsManager(settingsManager *settings.Manager) {
+	s.settingsManager = settingsManager
+}
+
+// SetFeatureFlags enables per-request feature flag evaluation and the flag admin API
+func (s *Server) SetFeatureFlags(evaluator *featureflags.Evaluator) {
+	s.featureFlags = evaluator
+}
+
+// SetSpamClassifier enables the spam deny/allow list admin API
+func (s *Server) SetSpamClassifier(classifier *wallet.SpamClassifier) {
+	s.spamClassifier = classifier
+}
+
+// SetBlocklist enables the scam blocklist admin API
+func (s *Server) SetBlocklist(list *blocklist.Blocklist) {
+	s.blocklist = list
+}
+
+// SetQueryStats enables the slow query admin API
+func (s *Server) SetQueryStats(queryStats *postgres.QueryStats) {
+	s.queryStats = queryStats
+}
+
+// SetJobScheduler enables the job run admin API
+func (s *Server) SetJobScheduler(scheduler *jobs.Scheduler) {
+	s.jobScheduler = scheduler
+}
+
+// Operations are the sources of the admin operations overview. Sections
+// without a source are left empty.
+type Operations struct {
+	Tracker    *tracker.APITracker
+	Caches     []cache.StatsReporter
+	RPCPool    *solana.Pool
+	FeeAccount string // Platform fee account whose balances are reported
+}
+
+// SetOperations sets the sources of the admin operations overview
+func (s *Server) SetOperations(operations Operations) {
+	s.operations = operations
+}
+
+// SetLeaderboard enables GetCoinTopTraders
+func (s *Server) SetLeaderboard(leaderboardService *leaderboard.Service) {
+	s.leaderboard = leaderboardService
+}
+
+// SetBurnChecker adds burn fields to coin detail
+func (s *Server) SetBurnChecker(checker *burn.Checker) {
+	s.burnChecker = checker
+}
+
+// SetAuthorityWatcher adds mint authority fields and the safety score to coin detail
+func (s *Server) SetAuthorityWatcher(watcher *authority.Watcher) {
+	s.authorityWatcher = watcher
+}
+
+// SetPaymentService enables the merchant PaymentService API and the public
+// Solana Pay transaction request endpoint
+func (s *Server) SetPaymentService(paymentService *payment.Service) {
+	s.paymentService = paymentService
+}
+
+// SetNotificationService enables the NotificationService API for managing
+// push notification devices and preferences
+func (s *Server) SetNotificationService(notificationService *notification.Service) {
+	s.notificationService = notificationService
+}
+
+// SetAnnouncementService enables the AnnouncementService API for the in-app
+// announcement feed
+func (s *Server) SetAnnouncementService(announcementService *announcement.Service) {
+	s.announcementService = announcementService
+}
+
+// SetRetentionService enables the AdminService RPCs that soft-delete and
+// restore records, and the UtilityService RPCs that erase users' personal data
+func (s *Server) SetRetentionService(retentionService *retention.Service) {
+	s.retentionService = retentionService
+	s.utilityService.SetRetentionService(retentionService)
+}
+
+// SetAppConfig enables the AppConfigService API serving remote app
+// configuration, and rejects trades and transfers while it is in maintenance
+func (s *Server) SetAppConfig(appConfig *appconfig.Service) {
+	s.appConfig = appConfig
+}
+
+// SetStatusMonitor enables the public StatusService API reporting the health
+// of the service's dependencies
+func (s *Server) SetStatusMonitor(monitor *status.Monitor) {
+	s.statusMonitor = monitor
+}
+
+// SetImageProxy serves coin logos stored by the image proxy from the URLs it
+// hands out, on its CDN and signed when one is configured
+func (s *Server) SetImageProxy(imageProxy *imageproxy.Service) {
+	s.imageProxy = imageProxy
+}
+
+// SetGeoPolicy refuses calls the region access policy doesn't allow from the
+// caller's region
+func (s *Server) SetGeoPolicy(policy *geo.Policy) {
+	s.geoPolicy = policy
+}
+
+// SetRequestLogger sets the request logger; without one every request is logged
+func (s *Server) SetRequestLogger(logger *middleware.RequestLogger) {
+	s.requestLogger = logger
+}
+
+// SetReportUpstreamCalls lets clients ask for the upstream API calls each
+// request made, for load testing
+func (s *Server) SetReportUpstreamCalls(enabled bool) {
+	s.reportUpstreamCalls = enabled
+}
+
+// SetAdminAPIKey sets the bearer token required by admin/partner routes
+func (s *Server) SetAdminAPIKey(apiKey string) {
+	s.adminAPIKey = apiKey
+}
+
 // Start starts the Connect RPC server
 func (s *Server) Start(port int) error {
 	// Create interceptors
 	panicRecoveryInterceptor := middleware.PanicRecoveryInterceptor()
-	logInterceptor := middleware.GRPCLoggerInterceptor()
+	if s.requestLogger == nil {
+		s.requestLogger = middleware.NewRequestLogger(1, 0)
+	}
+	logInterceptor := s.requestLogger.Interceptor()
 	debugModeInterceptor := middleware.GRPCDebugModeInterceptor()
 
 	// Create OpenTelemetry interceptor if tracer and meter are set
 	var interceptors []connect.Interceptor
-	// Panic recovery should be first to catch panics from all other interceptors
-	interceptors = append(interceptors, panicRecoveryInterceptor, debugModeInterceptor, logInterceptor)
+	// Panic recovery should be first to catch panics from all other interceptors,
+	// then the request ID so every later log line and span carries it
+	interceptors = append(interceptors, panicRecoveryInterceptor, middleware.RequestIDInterceptor(), debugModeInterceptor, logInterceptor)
+	if s.featureFlags != nil {
+		interceptors = append(interceptors, middleware.FeatureFlagInterceptor(s.featureFlags))
+	}
+	if s.reportUpstreamCalls {
+		interceptors = append(interceptors, middleware.UpstreamCallsInterceptor())
+	}
 
 	if s.tracer != nil && s.meter != nil {
 		otelInterceptor, err := middleware.NewOtelConnectInterceptor(s.tracer, s.meter)
@@ -94,19 +309,27 @@ func (s *Server) Start(port int) error {
 		}
 		interceptors = append(interceptors, otelInterceptor)
 	}
+	// Innermost, so the interceptors above see the classified error
+	interceptors = append(interceptors, middleware.AppErrorsInterceptor())
+	if s.appConfig != nil {
+		interceptors = append(interceptors, middleware.MaintenanceInterceptor(s.appConfig.CheckMaintenance, maintenanceProcedures...))
+	}
+	if s.geoPolicy != nil {
+		interceptors = append(interceptors, middleware.GeoPolicyInterceptor(s.geoPolicy))
+	}
 
 	// Create App Check authentication middleware
 	appCheckMiddleware := middleware.AppCheckMiddleware(s.appCheckClient, s.env, s.devAppCheckToken)
 
-	// Default interceptors for all handlers
-	defaultInterceptors := connect.WithInterceptors(interceptors...)
+	// Default interceptors and response compression for all handlers
+	defaultInterceptors := connect.WithHandlerOptions(connect.WithInterceptors(interceptors...), compressionOptions())
 
 	// Create a sub-mux for protected routes
 	protectedMux := http.NewServeMux()
 
 	// Register protected Connect RPC handlers
 	path, handler := dankfoliov1connect.NewCoinServiceHandler(
-		newCoinServiceHandler(s.coinService),
+		newCoinServiceHandler(s.coinService, s.leaderboard, s.burnChecker, s.authorityWatcher, s.imageProxy),
 		defaultInterceptors,
 	)
 	protectedMux.Handle(path, handler)
@@ -137,9 +360,67 @@ func (s *Server) Start(port int) error {
 	)
 	protectedMux.Handle(path, handler)
 
+	if s.notificationService != nil {
+		path, handler = dankfoliov1connect.NewNotificationServiceHandler(
+			newNotificationServiceHandler(s.notificationService),
+			defaultInterceptors,
+		)
+		protectedMux.Handle(path, handler)
+	}
+	if s.announcementService != nil {
+		path, handler = dankfoliov1connect.NewAnnouncementServiceHandler(
+			newAnnouncementServiceHandler(s.announcementService),
+			defaultInterceptors,
+		)
+		protectedMux.Handle(path, handler)
+	}
+	if s.appConfig != nil {
+		path, handler = dankfoliov1connect.NewAppConfigServiceHandler(
+			newAppConfigServiceHandler(s.appConfig),
+			defaultInterceptors,
+		)
+		protectedMux.Handle(path, handler)
+	}
+
 	// Wrap protected routes with App Check authentication middleware
 	s.mux.Handle("/", appCheckMiddleware.Wrap(protectedMux))
 
+	// The status page and the app's degraded service banner check status
+	// without App Check, e.g. when App Check itself is failing
+	if s.statusMonitor != nil {
+		path, handler = dankfoliov1connect.NewStatusServiceHandler(
+			newStatusServiceHandler(s.statusMonitor),
+			defaultInterceptors,
+		)
+		s.mux.Handle(path, handler)
+	}
+
+	// Partner/admin routes authenticate with the admin API key instead of App Check
+	adminMiddleware := middleware.AdminKeyMiddleware(s.adminAPIKey)
+	if s.webhookService != nil {
+		path, handler = dankfoliov1connect.NewWebhookServiceHandler(
+			newWebhookServiceHandler(s.webhookService),
+			defaultInterceptors,
+		)
+		s.mux.Handle(path, adminMiddleware.Wrap(handler))
+	}
+	if s.settingsManager != nil {
+		path, handler = dankfoliov1connect.NewAdminServiceHandler(
+			newAdminServiceHandler(s.settingsManager, s.featureFlags, s.spamClassifier, s.blocklist, s.queryStats, s.jobScheduler, s.operations, s.coinService, s.walletService, s.tradeService, s.notificationService, s.announcementService, s.retentionService),
+			defaultInterceptors,
+		)
+		s.mux.Handle(path, adminMiddleware.Wrap(handler))
+	}
+	if s.paymentService != nil {
+		path, handler = dankfoliov1connect.NewPaymentServiceHandler(
+			newPaymentServiceHandler(s.paymentService),
+			defaultInterceptors,
+		)
+		s.mux.Handle(path, adminMiddleware.Wrap(handler))
+		// Payers' wallets call the transaction request endpoint directly
+		s.mux.Handle(payment.TransactionRequestPath, s.paymentService.TransactionRequestHandler())
+	}
+
 	// Start HTTP server with CORS middleware and HTTP/2 support
 	addr := fmt.Sprintf(":%d", port)
 	log.Printf("Starting Connect RPC server on %s", addr)
@@ -155,10 +436,16 @@ func (s *Server) Start(port int) error {
 	}
 
 	// Use h2c for HTTP/2 without TLS
-	return http.ListenAndServe(addr, h2c.NewHandler(finalHandler, &http2.Server{}))
+	s.httpServer.Addr = addr
+	s.httpServer.Handler = h2c.NewHandler(finalHandler, &http2.Server{})
+	if err := s.httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
 }
 
-// Stop gracefully stops the server
-func (s *Server) Stop() {
-	// Nothing to clean up for now
+// Shutdown stops accepting new connections and waits for in-flight requests
+// to complete, or until ctx expires.
+func (s *Server) Shutdown(ctx context.Context) error {
+	return s.httpServer.Shutdown(ctx)
 }
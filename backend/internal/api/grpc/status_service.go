@@ -0,0 +1,50 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1"
+	dankfoliov1connect "github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1/v1connect"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/status"
+)
+
+// statusServiceHandler implements the StatusService API
+type statusServiceHandler struct {
+	dankfoliov1connect.UnimplementedStatusServiceHandler
+	monitor *status.Monitor
+}
+
+// newStatusServiceHandler creates a new statusServiceHandler
+func newStatusServiceHandler(monitor *status.Monitor) *statusServiceHandler {
+	return &statusServiceHandler{monitor: monitor}
+}
+
+// GetServiceStatus returns the health of each dependency
+func (h *statusServiceHandler) GetServiceStatus(
+	_ context.Context,
+	_ *connect.Request[pb.GetServiceStatusRequest],
+) (*connect.Response[pb.GetServiceStatusResponse], error) {
+	current := h.monitor.Status()
+	resp := &pb.GetServiceStatusResponse{
+		Status:        string(current.Level),
+		WindowSeconds: int32(current.Window / time.Second),
+		CheckedAt:     timestamppb.New(current.CheckedAt),
+	}
+	for _, dep := range current.Dependencies {
+		resp.Dependencies = append(resp.Dependencies, &pb.DependencyStatus{
+			Name:      dep.Name,
+			Status:    string(dep.Level),
+			Calls:     dep.Calls,
+			Errors:    dep.Errors,
+			ErrorRate: dep.ErrorRate(),
+			P50Ms:     durationMillis(dep.P50),
+			P95Ms:     durationMillis(dep.P95),
+			P99Ms:     durationMillis(dep.P99),
+		})
+	}
+	return connect.NewResponse(resp), nil
+}
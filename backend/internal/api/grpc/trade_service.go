@@ -2,17 +2,20 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
-	"strings"
+	"strconv"
 	"time"
 
 	"connectrpc.com/connect"
 	pb "github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1"
 	dankfoliov1connect "github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1/v1connect"
+	"github.com/nicolas-martin/dankfolio/backend/internal/apperrors"
 	"github.com/nicolas-martin/dankfolio/backend/internal/db" // Added for db.ListOptions
 	"github.com/nicolas-martin/dankfolio/backend/internal/model"
 	"github.com/nicolas-martin/dankfolio/backend/internal/model/blockchain"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/price"
 	"github.com/nicolas-martin/dankfolio/backend/internal/service/trade"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -42,19 +45,12 @@ func pstring(s string) *string {
 
 // GetSwapQuote fetches a trade quote
 func (s *tradeServiceHandler) GetSwapQuote(ctx context.Context, req *connect.Request[pb.GetSwapQuoteRequest]) (*connect.Response[pb.GetSwapQuoteResponse], error) {
-	slog.Debug("Received GetSwapQuote request",
-		"from_coin_id", req.Msg.FromCoinId,
-		"to_coin_id", req.Msg.ToCoinId,
-		"amount", req.Msg.Amount)
-
 	if req.Msg.FromCoinId == "" || req.Msg.ToCoinId == "" || req.Msg.Amount == "" {
-		slog.Warn("Invalid GetSwapQuote request", "error", "missing required fields", "from_coin_id", req.Msg.FromCoinId, "to_coin_id", req.Msg.ToCoinId, "amount", req.Msg.Amount)
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("from_coin_id, to_coin_id, and amount are required"))
 	}
 
 	requestCtx := ctx
 	if req.Header().Get("x-debug-mode") == "true" {
-		slog.Debug("Debug mode enabled for GetSwapQuote")
 		requestCtx = context.WithValue(ctx, model.DebugModeKey, true)
 	}
 
@@ -75,22 +71,12 @@ func (s *tradeServiceHandler) GetSwapQuote(ctx context.Context, req *connect.Req
 
 	quote, err := s.tradeService.GetSwapQuote(requestCtx, req.Msg.FromCoinId, req.Msg.ToCoinId, req.Msg.Amount, slippageBps, req.Msg.IncludeFeeBreakdown, userPublicKey, req.Msg.AllowMultiHop)
 	if err != nil {
-		slog.Error("Failed to fetch trade quote", "error", err)
-		// Check for Jupiter TOKEN_NOT_TRADABLE error and provide friendly message
-		if isTokenNotTradableError(err) {
-			return nil, connect.NewError(connect.CodeInvalidArgument,
-				fmt.Errorf("this token is not available for trading. It may be a new token that hasn't been approved for trading yet, or it might have trading restrictions"))
+		if connectErr, ok := apperrors.ToConnect(err); ok {
+			return nil, connectErr
 		}
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get trade quote: %w", err))
 	}
 
-	slog.Debug("Trade quote generated successfully",
-		"estimated_amount", quote.EstimatedAmount,
-		"exchange_rate", quote.ExchangeRate,
-		"price_impact", quote.PriceImpact,
-		"total_sol_required", quote.TotalSolRequired,
-		"trading_fee_sol", quote.TradingFeeSol)
-
 	// Convert SolFeeBreakdown to protobuf if available
 	var solFeeBreakdown *pb.SolFeeBreakdown
 	if quote.SolFeeBreakdown != nil {
@@ -114,10 +100,27 @@ func (s *tradeServiceHandler) GetSwapQuote(ctx context.Context, req *connect.Req
 		SolFeeBreakdown:  solFeeBreakdown,
 		TotalSolRequired: quote.TotalSolRequired,
 		TradingFeeSol:    quote.TradingFeeSol,
+
+		InputRawAmount:     quote.InputRawAmount,
+		EstimatedRawAmount: quote.EstimatedRawAmount,
+		PriceDivergences:   convertPriceDivergencesToPb(quote.PriceDivergences),
 	})
 	return res, nil
 }
 
+func convertPriceDivergencesToPb(divergences []price.PriceDivergence) []*pb.PriceDivergence {
+	pbDivergences := make([]*pb.PriceDivergence, 0, len(divergences))
+	for _, d := range divergences {
+		pbDivergences = append(pbDivergences, &pb.PriceDivergence{
+			Mint:         d.Address,
+			BirdeyePrice: d.BirdEyePrice,
+			JupiterPrice: d.JupiterPrice,
+			Divergence:   d.Divergence,
+		})
+	}
+	return pbDivergences
+}
+
 // PrepareSwap prepares an unsigned swap transaction
 func (s *tradeServiceHandler) PrepareSwap(ctx context.Context, req *connect.Request[pb.PrepareSwapRequest]) (*connect.Response[pb.PrepareSwapResponse], error) {
 	if req.Msg.FromCoinId == "" || req.Msg.ToCoinId == "" || req.Msg.Amount == "" {
@@ -131,18 +134,40 @@ func (s *tradeServiceHandler) PrepareSwap(ctx context.Context, req *connect.Requ
 		SlippageBps:         req.Msg.SlippageBps,
 		UserWalletAddress:   req.Msg.UserPublicKey,
 		AllowMultiHop:       req.Msg.AllowMultiHop,
+		Paper:               req.Msg.Paper,
+		Sponsored:           req.Msg.Sponsored,
+		AcknowledgedRisks:   req.Msg.AcknowledgedRisks,
 	}
 
 	prepareResponse, err := s.tradeService.PrepareSwap(ctx, params)
 	if err != nil {
-		// Check for Jupiter TOKEN_NOT_TRADABLE error and provide friendly message
-		if isTokenNotTradableError(err) {
-			return nil, connect.NewError(connect.CodeInvalidArgument,
-				fmt.Errorf("this token is not available for trading. It may be a new token that hasn't been approved for trading yet, or it might have trading restrictions"))
+		if connectErr, ok := apperrors.ToConnect(err); ok {
+			return nil, connectErr
 		}
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to prepare swap: %w", err))
 	}
 
+	return connect.NewResponse(prepareSwapResponseToProto(prepareResponse)), nil
+}
+
+// RefreshQuote re-quotes a prepared swap whose quote expired or is about to
+func (s *tradeServiceHandler) RefreshQuote(ctx context.Context, req *connect.Request[pb.RefreshQuoteRequest]) (*connect.Response[pb.PrepareSwapResponse], error) {
+	if req.Msg.QuoteId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("quote_id is required"))
+	}
+
+	prepareResponse, err := s.tradeService.RefreshQuote(ctx, req.Msg.QuoteId)
+	if err != nil {
+		if connectErr, ok := apperrors.ToConnect(err); ok {
+			return nil, connectErr
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to refresh quote: %w", err))
+	}
+
+	return connect.NewResponse(prepareSwapResponseToProto(prepareResponse)), nil
+}
+
+func prepareSwapResponseToProto(prepareResponse *trade.PrepareSwapResponse) *pb.PrepareSwapResponse {
 	// Convert SolFeeBreakdown to protobuf format
 	var solFeeBreakdown *pb.SolFeeBreakdown
 	if prepareResponse.SolFeeBreakdown != nil {
@@ -156,18 +181,35 @@ func (s *tradeServiceHandler) PrepareSwap(ctx context.Context, req *connect.Requ
 		}
 	}
 
-	res := connect.NewResponse(&pb.PrepareSwapResponse{
+	resp := &pb.PrepareSwapResponse{
 		UnsignedTransaction: prepareResponse.UnsignedTransaction,
 		SolFeeBreakdown:     solFeeBreakdown,
 		TotalSolRequired:    prepareResponse.TotalSolRequired,
 		TradingFeeSol:       prepareResponse.TradingFeeSol,
-	})
-
-	return res, nil
+		Deduplicated:        prepareResponse.Deduplicated,
+		QuoteId:             prepareResponse.QuoteID,
+		SponsorFeeBps:       int32(prepareResponse.SponsorFeeBps),
+		SponsorCostLamports: prepareResponse.SponsorCostLamports,
+	}
+	if !prepareResponse.ExpiresAt.IsZero() {
+		resp.ExpiresAt = timestamppb.New(prepareResponse.ExpiresAt)
+	}
+	for _, w := range prepareResponse.RiskWarnings {
+		resp.RiskWarnings = append(resp.RiskWarnings, &pb.RiskWarning{
+			Check:        w.Check,
+			Message:      w.Message,
+			Blocking:     w.Blocking,
+			Acknowledged: w.Acknowledged,
+		})
+	}
+	return resp
 }
 
 // SubmitSwap submits a trade for execution
 func (s *tradeServiceHandler) SubmitSwap(ctx context.Context, req *connect.Request[pb.SubmitSwapRequest]) (*connect.Response[pb.SubmitSwapResponse], error) {
+	if req.Msg.Paper {
+		return s.submitPaperSwap(ctx, req.Msg.QuoteId)
+	}
 	if req.Msg.FromCoinId == "" || req.Msg.ToCoinId == "" || req.Msg.SignedTransaction == "" || req.Msg.UnsignedTransaction == "" {
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("from_coin_id, to_coin_id, signed_transaction and unsigned_transaction are required"))
 	}
@@ -178,6 +220,7 @@ func (s *tradeServiceHandler) SubmitSwap(ctx context.Context, req *connect.Reque
 		Amount:              req.Msg.Amount,
 		SignedTransaction:   req.Msg.SignedTransaction,
 		UnsignedTransaction: req.Msg.UnsignedTransaction,
+		QuoteID:             req.Msg.QuoteId,
 	}
 
 	requestCtx := ctx
@@ -185,18 +228,36 @@ func (s *tradeServiceHandler) SubmitSwap(ctx context.Context, req *connect.Reque
 		requestCtx = context.WithValue(ctx, model.DebugModeKey, true)
 	}
 
-	trade, err := s.tradeService.ExecuteTrade(requestCtx, tradeReq)
+	executed, err := s.tradeService.ExecuteTrade(requestCtx, tradeReq)
 	if err != nil {
+		if connectErr, ok := apperrors.ToConnect(err); ok {
+			return nil, connectErr
+		}
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to execute trade: %w", err))
 	}
 
 	res := connect.NewResponse(&pb.SubmitSwapResponse{
-		TradeId:         fmt.Sprintf("%d", trade.ID),
-		TransactionHash: trade.TransactionHash,
+		TradeId:         fmt.Sprintf("%d", executed.ID),
+		TransactionHash: executed.TransactionHash,
 	})
 	return res, nil
 }
 
+// submitPaperSwap fills a paper trade; there is no transaction to report
+func (s *tradeServiceHandler) submitPaperSwap(ctx context.Context, quoteID string) (*connect.Response[pb.SubmitSwapResponse], error) {
+	if quoteID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("quote_id is required for paper trades"))
+	}
+	filled, err := s.tradeService.FillPaperSwap(ctx, quoteID)
+	if err != nil {
+		if connectErr, ok := apperrors.ToConnect(err); ok {
+			return nil, connectErr
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to fill paper trade: %w", err))
+	}
+	return connect.NewResponse(&pb.SubmitSwapResponse{TradeId: filled.ID}), nil
+}
+
 // GetTrade returns details and status of a specific trade
 func (s *tradeServiceHandler) GetTrade(ctx context.Context, req *connect.Request[pb.GetTradeRequest]) (*connect.Response[pb.Trade], error) {
 	var trade *model.Trade
@@ -204,13 +265,11 @@ func (s *tradeServiceHandler) GetTrade(ctx context.Context, req *connect.Request
 
 	switch identifier := req.Msg.Identifier.(type) {
 	case *pb.GetTradeRequest_Id:
-		slog.Debug("Received GetTrade request with ID", "id", identifier.Id)
 		if identifier.Id == "" {
 			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("trade ID is required"))
 		}
 		trade, err = s.tradeService.GetTrade(ctx, identifier.Id)
 	case *pb.GetTradeRequest_TransactionHash:
-		slog.Debug("Received GetTrade request with TransactionHash", "tx_hash", identifier.TransactionHash)
 		if identifier.TransactionHash == "" {
 			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("transaction hash is required"))
 		}
@@ -347,6 +406,9 @@ func (s *tradeServiceHandler) GetTrade(ctx context.Context, req *connect.Request
 
 // ListTrades returns all trades based on provided options
 func (s *tradeServiceHandler) ListTrades(ctx context.Context, req *connect.Request[pb.ListTradesRequest]) (*connect.Response[pb.ListTradesResponse], error) {
+	if req.Msg.Paper {
+		return s.listPaperTrades(ctx, req.Msg)
+	}
 	opts := db.ListOptions{
 		Limit:    pint32(req.Msg.GetLimit()),
 		Offset:   pint32(req.Msg.GetOffset()),
@@ -395,6 +457,137 @@ func (s *tradeServiceHandler) ListTrades(ctx context.Context, req *connect.Reque
 	return res, nil
 }
 
+// listPaperTrades lists a wallet's filled paper trades, newest first
+func (s *tradeServiceHandler) listPaperTrades(ctx context.Context, req *pb.ListTradesRequest) (*connect.Response[pb.ListTradesResponse], error) {
+	if req.GetUserId() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("user_id is required for paper trades"))
+	}
+	trades, total, err := s.tradeService.ListPaperTrades(ctx, req.GetUserId(), int(req.GetLimit()), int(req.GetOffset()))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list paper trades: %w", err))
+	}
+	pbTrades := make([]*pb.Trade, len(trades))
+	for i := range trades {
+		pbTrades[i] = convertPaperTradeToPb(&trades[i])
+	}
+	return connect.NewResponse(&pb.ListTradesResponse{Trades: pbTrades, TotalCount: total}), nil
+}
+
+func convertPaperTradeToPb(t *model.PaperTrade) *pb.Trade {
+	outputAmount := t.OutputAmount
+	return &pb.Trade{
+		Id:              t.ID,
+		UserId:          t.WalletAddress,
+		FromCoinId:      t.FromCoinMintAddress,
+		ToCoinId:        t.ToCoinMintAddress,
+		Type:            "swap",
+		Amount:          t.Amount,
+		Status:          t.Status,
+		CreatedAt:       timestamppb.New(t.CreatedAt),
+		CompletedAt:     timestamppb.New(t.FilledAt),
+		Finalized:       true,
+		OutputAmount:    &outputAmount,
+		FromAddress:     t.WalletAddress,
+		ToAddress:       t.WalletAddress,
+		RawAmount:       t.RawAmount,
+		RawOutputAmount: t.RawOutputAmount,
+		Paper:           true,
+	}
+}
+
+// GetPaperPortfolio returns a wallet's paper balances valued at current prices
+func (s *tradeServiceHandler) GetPaperPortfolio(ctx context.Context, req *connect.Request[pb.GetPaperPortfolioRequest]) (*connect.Response[pb.GetPaperPortfolioResponse], error) {
+	portfolio, err := s.tradeService.GetPaperPortfolio(ctx, req.Msg.UserPublicKey)
+	if err != nil {
+		if connectErr, ok := apperrors.ToConnect(err); ok {
+			return nil, connectErr
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get paper portfolio: %w", err))
+	}
+	return connect.NewResponse(convertPaperPortfolioToPb(portfolio)), nil
+}
+
+// ResetPaperPortfolio starts a wallet's paper trading over
+func (s *tradeServiceHandler) ResetPaperPortfolio(ctx context.Context, req *connect.Request[pb.ResetPaperPortfolioRequest]) (*connect.Response[pb.GetPaperPortfolioResponse], error) {
+	portfolio, err := s.tradeService.ResetPaperPortfolio(ctx, req.Msg.UserPublicKey)
+	if err != nil {
+		if connectErr, ok := apperrors.ToConnect(err); ok {
+			return nil, connectErr
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to reset paper portfolio: %w", err))
+	}
+	return connect.NewResponse(convertPaperPortfolioToPb(portfolio)), nil
+}
+
+func convertPaperPortfolioToPb(portfolio *trade.PaperPortfolio) *pb.GetPaperPortfolioResponse {
+	resp := &pb.GetPaperPortfolioResponse{
+		Holdings:         make([]*pb.PaperHolding, 0, len(portfolio.Holdings)),
+		TotalValueUsd:    portfolio.TotalValueUSD,
+		UnrealizedPnlUsd: portfolio.UnrealizedPnLUSD,
+		RealizedPnlUsd:   portfolio.RealizedPnLUSD,
+		StartingSol:      portfolio.StartingSOL,
+	}
+	for _, h := range portfolio.Holdings {
+		resp.Holdings = append(resp.Holdings, &pb.PaperHolding{
+			Mint:             h.Mint,
+			Symbol:           h.Symbol,
+			Amount:           h.Amount,
+			Price:            h.Price,
+			ValueUsd:         h.ValueUSD,
+			CostBasisUsd:     h.CostBasisUSD,
+			UnrealizedPnlUsd: h.UnrealizedPnLUSD,
+			RealizedPnlUsd:   h.RealizedPnLUSD,
+		})
+	}
+	return resp
+}
+
+// GetTradeReceipt returns how a completed swap filled compared with its quote
+func (s *tradeServiceHandler) GetTradeReceipt(ctx context.Context, req *connect.Request[pb.GetTradeReceiptRequest]) (*connect.Response[pb.GetTradeReceiptResponse], error) {
+	if req.Msg.TradeId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("trade ID is required"))
+	}
+
+	receipt, err := s.tradeService.GetTradeReceipt(ctx, req.Msg.TradeId)
+	if err != nil {
+		if connectErr, ok := apperrors.ToConnect(err); ok {
+			return nil, connectErr
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get trade receipt: %w", err))
+	}
+
+	pbReceipt := &pb.TradeReceipt{
+		TradeId:              fmt.Sprintf("%d", receipt.TradeID),
+		TransactionHash:      receipt.TransactionHash,
+		FromCoinMintAddress:  receipt.FromMint,
+		ToCoinMintAddress:    receipt.ToMint,
+		QuotedAmount:         receipt.QuotedAmount,
+		QuotedOutputAmount:   receipt.QuotedOutputAmount,
+		QuotedPrice:          receipt.QuotedPrice,
+		ExecutedAmount:       receipt.ExecutedAmount,
+		ExecutedOutputAmount: receipt.ExecutedOutputAmount,
+		ExecutedPrice:        receipt.ExecutedPrice,
+		PlatformFee:          receipt.PlatformFee,
+		PlatformFeeMint:      receipt.PlatformFeeMint,
+		NetworkFeeSol:        receipt.NetworkFeeSOL,
+		SlippageBps:          receipt.SlippageBps,
+		AnalyzedAt:           timestamppb.New(receipt.AnalyzedAt),
+	}
+	if incident := receipt.MEVIncident; incident != nil {
+		pbReceipt.MevIncident = &pb.MevIncident{
+			Attacker:          incident.Attacker,
+			FrontRunSignature: incident.FrontRunSignature,
+			BackRunSignature:  incident.BackRunSignature,
+			Pool:              incident.Pool,
+			LossMint:          incident.LossMint,
+			EstimatedLoss:     incident.EstimatedLoss,
+			EstimatedLossUsd:  incident.EstimatedLossUSD,
+		}
+	}
+
+	return connect.NewResponse(&pb.GetTradeReceiptResponse{Receipt: pbReceipt}), nil
+}
+
 // Helper function to convert model.Trade to pb.Trade
 func convertModelToProtoTrade(trade *model.Trade) *pb.Trade {
 	if trade == nil {
@@ -414,6 +607,11 @@ func convertModelToProtoTrade(trade *model.Trade) *pb.Trade {
 		Finalized:       trade.Finalized,
 		FromAddress:     trade.FromAddress,
 		ToAddress:       trade.ToAddress,
+		RawAmount:       trade.RawAmount,
+		RawOutputAmount: trade.RawOutputAmount,
+		Memo:            trade.Memo,
+		References:      trade.References,
+		Sponsored:       trade.Sponsored,
 	}
 
 	if trade.Error != "" {
@@ -424,11 +622,6 @@ func convertModelToProtoTrade(trade *model.Trade) *pb.Trade {
 	// This ensures the frontend can display the received amount correctly
 	if trade.Type == "swap" {
 		pbTrade.OutputAmount = &trade.OutputAmount
-		slog.Debug("Setting OutputAmount for trade",
-			"trade_id", trade.ID,
-			"output_amount", trade.OutputAmount,
-			"from_coin", trade.FromCoinMintAddress,
-			"to_coin", trade.ToCoinMintAddress)
 	} else if trade.OutputAmount > 0 {
 		pbTrade.OutputAmount = &trade.OutputAmount
 	}
@@ -444,11 +637,141 @@ func convertModelToProtoTrade(trade *model.Trade) *pb.Trade {
 	return pbTrade
 }
 
-// isTokenNotTradableError checks if the error is a Jupiter TOKEN_NOT_TRADABLE error
-func isTokenNotTradableError(err error) bool {
-	if err == nil {
-		return false
+// GetMaxSpendable returns the most of a coin a wallet can swap once fees and rent are set aside
+func (s *tradeServiceHandler) GetMaxSpendable(ctx context.Context, req *connect.Request[pb.GetMaxSpendableRequest]) (*connect.Response[pb.GetMaxSpendableResponse], error) {
+	spendable, err := s.tradeService.GetMaxSpendable(ctx, req.Msg.UserPublicKey, req.Msg.FromCoinId, req.Msg.ToCoinId, req.Msg.Paper)
+	if err != nil {
+		if connectErr, ok := apperrors.ToConnect(err); ok {
+			return nil, connectErr
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get max spendable amount: %w", err))
+	}
+	return connect.NewResponse(&pb.GetMaxSpendableResponse{
+		RawAmount:            spendable.RawAmount,
+		Amount:               spendable.Amount,
+		Decimals:             int32(spendable.Decimals),
+		ReservedLamports:     spendable.ReservedLamports,
+		AccountsToCreate:     int32(spendable.AccountsToCreate),
+		PlatformFeeBps:       int32(spendable.PlatformFeeBps),
+		PresetRawAmounts:     spendable.Presets,
+		SolShortfallLamports: spendable.SOLShortfallLamports,
+	}), nil
+}
+
+// ValidateTrade reports why a swap would fail before the app asks for a quote
+func (s *tradeServiceHandler) ValidateTrade(ctx context.Context, req *connect.Request[pb.ValidateTradeRequest]) (*connect.Response[pb.ValidateTradeResponse], error) {
+	if req.Msg.FromCoinId == "" || req.Msg.ToCoinId == "" || req.Msg.Amount == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("from_coin_id, to_coin_id, and amount are required"))
+	}
+	if amount, err := strconv.ParseUint(req.Msg.Amount, 10, 64); err != nil || amount == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("amount must be a positive integer in raw units"))
+	}
+	problems, err := s.tradeService.ValidateTrade(ctx, req.Msg.UserPublicKey, req.Msg.FromCoinId, req.Msg.ToCoinId, req.Msg.Amount, req.Msg.Paper)
+	if err != nil {
+		if connectErr, ok := apperrors.ToConnect(err); ok {
+			return nil, connectErr
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to validate trade: %w", err))
+	}
+	resp := &pb.ValidateTradeResponse{Valid: len(problems) == 0}
+	for _, p := range problems {
+		resp.Problems = append(resp.Problems, &pb.TradeProblem{Reason: string(p.Kind), Message: p.Message, Mint: p.Mint})
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// CreateTwapOrder splits a swap into slices prepared over time
+func (s *tradeServiceHandler) CreateTwapOrder(ctx context.Context, req *connect.Request[pb.CreateTwapOrderRequest]) (*connect.Response[pb.TwapOrder], error) {
+	if req.Msg.UserPublicKey == "" || req.Msg.FromCoinId == "" || req.Msg.ToCoinId == "" || req.Msg.Amount == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("user_public_key, from_coin_id, to_coin_id, and amount are required"))
+	}
+	order, err := s.tradeService.CreateTwapOrder(ctx, trade.TwapOrderRequest{
+		WalletAddress:       req.Msg.UserPublicKey,
+		FromCoinMintAddress: req.Msg.FromCoinId,
+		ToCoinMintAddress:   req.Msg.ToCoinId,
+		RawAmount:           req.Msg.Amount,
+		Slices:              int(req.Msg.Slices),
+		Duration:            time.Duration(req.Msg.DurationMinutes) * time.Minute,
+		SlippageBps:         req.Msg.SlippageBps,
+		AllowMultiHop:       req.Msg.AllowMultiHop,
+	})
+	if err != nil {
+		if errors.Is(err, trade.ErrInvalidTwapOrder) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		if connectErr, ok := apperrors.ToConnect(err); ok {
+			return nil, connectErr
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create TWAP order: %w", err))
+	}
+	return connect.NewResponse(convertTwapOrderToPb(order)), nil
+}
+
+// ListTwapOrders returns a wallet's TWAP orders and their slices
+func (s *tradeServiceHandler) ListTwapOrders(ctx context.Context, req *connect.Request[pb.ListTwapOrdersRequest]) (*connect.Response[pb.ListTwapOrdersResponse], error) {
+	if req.Msg.UserPublicKey == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("user_public_key is required"))
+	}
+	orders, err := s.tradeService.ListTwapOrders(ctx, req.Msg.UserPublicKey)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list TWAP orders: %w", err))
+	}
+	resp := &pb.ListTwapOrdersResponse{Orders: make([]*pb.TwapOrder, 0, len(orders))}
+	for i := range orders {
+		resp.Orders = append(resp.Orders, convertTwapOrderToPb(&orders[i]))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// CancelTwapOrder stops a TWAP order
+func (s *tradeServiceHandler) CancelTwapOrder(ctx context.Context, req *connect.Request[pb.CancelTwapOrderRequest]) (*connect.Response[pb.TwapOrder], error) {
+	if req.Msg.UserPublicKey == "" || req.Msg.OrderId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("user_public_key and order_id are required"))
+	}
+	order, err := s.tradeService.CancelTwapOrder(ctx, req.Msg.UserPublicKey, req.Msg.OrderId)
+	if err != nil {
+		if connectErr, ok := apperrors.ToConnect(err); ok {
+			return nil, connectErr
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to cancel TWAP order: %w", err))
+	}
+	return connect.NewResponse(convertTwapOrderToPb(order)), nil
+}
+
+func convertTwapOrderToPb(o *trade.TwapOrderWithSlices) *pb.TwapOrder {
+	order := &pb.TwapOrder{
+		Id:              o.Order.ID,
+		FromCoinId:      o.Order.FromCoinMintAddress,
+		ToCoinId:        o.Order.ToCoinMintAddress,
+		RawAmount:       o.Order.RawAmount,
+		SliceCount:      int32(o.Order.Slices),
+		IntervalSeconds: int32(o.Order.Interval / time.Second),
+		Status:          o.Order.Status,
+		FilledSlices:    int32(o.Order.FilledSlices),
+		FilledRawAmount: o.Order.FilledRawAmount,
+		OutputAmount:    o.Order.OutputAmount,
+		CreatedAt:       timestamppb.New(o.Order.CreatedAt),
+		Slices:          make([]*pb.TwapSlice, 0, len(o.Slices)),
+	}
+	if !o.Order.NextSliceAt.IsZero() {
+		order.NextSliceAt = timestamppb.New(o.Order.NextSliceAt)
+	}
+	for _, slice := range o.Slices {
+		pbSlice := &pb.TwapSlice{
+			Index:               int32(slice.Index),
+			RawAmount:           slice.RawAmount,
+			Status:              slice.Status,
+			DueAt:               timestamppb.New(slice.DueAt),
+			QuoteId:             slice.QuoteID,
+			UnsignedTransaction: slice.UnsignedTransaction,
+			TransactionHash:     slice.TransactionHash,
+			OutputAmount:        slice.OutputAmount,
+			Error:               slice.Error,
+		}
+		if !slice.QuoteExpiresAt.IsZero() {
+			pbSlice.QuoteExpiresAt = timestamppb.New(slice.QuoteExpiresAt)
+		}
+		order.Slices = append(order.Slices, pbSlice)
 	}
-	errStr := err.Error()
-	return strings.Contains(errStr, "TOKEN_NOT_TRADABLE") || strings.Contains(errStr, "token is not tradable")
+	return order
 }
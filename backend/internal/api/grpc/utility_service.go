@@ -2,12 +2,14 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"time"
 
 	"connectrpc.com/connect"
 	"github.com/patrickmn/go-cache"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	// Corrected import path for base proto definitions
 	dankfoliov1 "github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1"
@@ -15,6 +17,7 @@ import (
 	dankfoliov1connect "github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1/v1connect"
 	// Import db for store interface
 	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/retention"
 	// Import the image service package for the interface
 	imageservice "github.com/nicolas-martin/dankfolio/backend/internal/service/image"
 )
@@ -35,6 +38,7 @@ type Service struct {
 	fetcher                                               imageservice.RawDataFetcher // Use interface from image service package
 	cache                                                 *cache.Cache                // In-memory cache for proxied images
 	store                                                 db.Store                    // Store for database operations
+	retention                                             *retention.Service          // Erases users' personal data; nil when disabled
 }
 
 // NewService creates a new instance of the image proxy Service.
@@ -59,12 +63,9 @@ func (s *Service) GetProxiedImage(ctx context.Context, req *connect.Request[dank
 	imageURL := req.Msg.GetImageUrl()
 
 	if imageURL == "" {
-		slog.Error("GetProxiedImage received empty image_url")
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("image_url cannot be empty"))
 	}
 
-	slog.Debug("Processing GetProxiedImage request", "url", imageURL)
-
 	// 1. Check cache
 	if cached, found := s.cache.Get(imageURL); found {
 		if cachedData, ok := cached.(*CachedImageData); ok {
@@ -84,15 +85,9 @@ func (s *Service) GetProxiedImage(ctx context.Context, req *connect.Request[dank
 	// 2. Fetch from source using the injected fetcher
 	data, contentType, err := s.fetcher.FetchRawData(ctx, imageURL)
 	if err != nil {
-		slog.Error("Failed to fetch image data", "url", imageURL, "error", err)
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to fetch image from %s: %w", imageURL, err))
 	}
 
-	slog.Debug("Successfully fetched image data",
-		"url", imageURL,
-		"bytes", len(data),
-		"content_type", contentType)
-
 	// 3. Store in cache
 	cacheItem := &CachedImageData{
 		Data:        data,
@@ -116,20 +111,15 @@ func (s *Service) DeleteAccount(ctx context.Context, req *connect.Request[dankfo
 
 	// Validate inputs
 	if walletPublicKey == "" {
-		slog.Error("DeleteAccount received empty wallet_public_key")
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("wallet_public_key cannot be empty"))
 	}
 
 	if confirmation != "DELETE" {
-		slog.Error("DeleteAccount received invalid confirmation", "confirmation", confirmation)
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("confirmation must be 'DELETE' to proceed"))
 	}
 
-	slog.Info("Processing DeleteAccount request", "wallet", walletPublicKey)
-
 	// Use the store's DeleteAccount method which handles the transaction
 	if err := s.store.DeleteAccount(ctx, walletPublicKey); err != nil {
-		slog.Error("Failed to delete account", "wallet", walletPublicKey, "error", err)
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to delete account: %w", err))
 	}
 
@@ -140,3 +130,68 @@ func (s *Service) DeleteAccount(ctx context.Context, req *connect.Request[dankfo
 
 	return connect.NewResponse(resp), nil
 }
+
+// SetRetentionService enables DeleteMyData and GetDataDeletion
+func (s *Service) SetRetentionService(retentionService *retention.Service) {
+	s.retention = retentionService
+}
+
+// DeleteMyData queues the erasure of the user's personal data.
+// This satisfies App Store privacy requirements for data deletion.
+func (s *Service) DeleteMyData(ctx context.Context, req *connect.Request[dankfoliov1.DeleteMyDataRequest]) (*connect.Response[dankfoliov1.DeleteMyDataResponse], error) {
+	if s.retention == nil {
+		return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("data deletion is not enabled"))
+	}
+	if req.Msg.GetConfirmation() != "DELETE" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("confirmation must be 'DELETE' to proceed"))
+	}
+
+	request, err := s.retention.RequestErasure(ctx, req.Msg.GetWalletPublicKey())
+	if err != nil {
+		if errors.Is(err, retention.ErrInvalidWallet) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to queue data deletion: %w", err))
+	}
+
+	return connect.NewResponse(&dankfoliov1.DeleteMyDataResponse{
+		RequestId: request.ID,
+		Status:    request.Status,
+	}), nil
+}
+
+// GetDataDeletion returns the status of a DeleteMyData request, with its
+// deletion certificate once completed.
+func (s *Service) GetDataDeletion(ctx context.Context, req *connect.Request[dankfoliov1.GetDataDeletionRequest]) (*connect.Response[dankfoliov1.GetDataDeletionResponse], error) {
+	if s.retention == nil {
+		return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("data deletion is not enabled"))
+	}
+	if req.Msg.GetRequestId() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("request_id cannot be empty"))
+	}
+
+	request, certificate, err := s.retention.ErasureStatus(ctx, req.Msg.GetRequestId())
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("data deletion %s not found", req.Msg.GetRequestId()))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get data deletion: %w", err))
+	}
+
+	resp := &dankfoliov1.GetDataDeletionResponse{
+		RequestId:   request.ID,
+		Status:      request.Status,
+		Error:       request.Error,
+		RequestedAt: timestamppb.New(request.RequestedAt),
+	}
+	if certificate != nil {
+		resp.Certificate = &dankfoliov1.DeletionCertificate{
+			Id:          certificate.ID,
+			SubjectHash: certificate.SubjectHash,
+			Erased:      certificate.Erased,
+			RequestedAt: timestamppb.New(certificate.RequestedAt),
+			CompletedAt: timestamppb.New(certificate.CompletedAt),
+		}
+	}
+	return connect.NewResponse(resp), nil
+}
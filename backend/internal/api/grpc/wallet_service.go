@@ -2,13 +2,15 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
-	"strings"
+	"slices"
 
 	"connectrpc.com/connect"
 	pb "github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1"
 	dankfoliov1connect "github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1/v1connect"
+	"github.com/nicolas-martin/dankfolio/backend/internal/apperrors"
 	"github.com/nicolas-martin/dankfolio/backend/internal/service/wallet"
 )
 
@@ -34,26 +36,27 @@ func (s *walletServiceHandler) GetWalletBalances(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("wallet address is required"))
 	}
 
-	slog.Debug("Getting wallet balances", "address", req.Msg.Address)
 	balances, err := s.walletService.GetWalletBalances(ctx, req.Msg.Address)
 	if err != nil {
-		slog.Error("Failed to get wallet balances", "address", req.Msg.Address, "error", err)
-
-		// Check for specific error types and return appropriate GRPC codes
-		errorMsg := err.Error()
-		if strings.Contains(errorMsg, "INVALID_ADDRESS:") {
-			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid wallet address: %s", strings.TrimPrefix(errorMsg, "INVALID_ADDRESS: ")))
-		}
-		if strings.Contains(errorMsg, "NETWORK_ERROR:") {
-			return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("network error: %s", strings.TrimPrefix(errorMsg, "NETWORK_ERROR: ")))
+		if connectErr, ok := apperrors.ToConnect(err); ok {
+			return nil, connectErr
 		}
 
 		// Default to internal error for unknown error types
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get wallet balance: %w", err))
 	}
 
+	if !req.Msg.IncludeSpam {
+		balances.Balances = slices.DeleteFunc(balances.Balances, func(b wallet.Balance) bool { return b.IsSpam })
+	}
+
+	pbBalance := convertModelBalanceToPb(balances)
+	if err := applyFieldMask(req.Msg.FieldMask, pbBalance.Balances...); err != nil {
+		return nil, err
+	}
+
 	return connect.NewResponse(&pb.GetWalletBalancesResponse{
-		WalletBalance: convertModelBalanceToPb(balances),
+		WalletBalance: pbBalance,
 	}), nil
 }
 
@@ -68,22 +71,18 @@ func (s *walletServiceHandler) RegisterWallet(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("public key is required"))
 	}
 
-	slog.Info("Registering client-generated wallet", "public_key", req.Msg.PublicKey)
-	
 	// Validate the public key format
 	if err := s.walletService.ValidatePublicKey(ctx, req.Msg.PublicKey); err != nil {
-		slog.Error("Invalid public key provided", "public_key", req.Msg.PublicKey, "error", err)
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid public key format"))
 	}
 	
 	// Store the wallet public key (e.g., in database for tracking)
 	// This could associate the wallet with a user account, etc.
 	if err := s.walletService.RegisterWallet(ctx, req.Msg.PublicKey); err != nil {
-		slog.Error("Failed to register wallet", "public_key", req.Msg.PublicKey, "error", err)
+		slog.ErrorContext(ctx, "Failed to register wallet", "error", err)
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to register wallet"))
 	}
 	
-	slog.Info("Wallet registered successfully", "public_key", req.Msg.PublicKey)
 	return connect.NewResponse(&pb.RegisterWalletResponse{
 		Success: true,
 		Message: "Wallet registered successfully",
@@ -101,28 +100,26 @@ func (s *walletServiceHandler) PrepareTransfer(
 	if req.Msg.Amount <= 0 {
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("amount must be greater than 0"))
 	}
+	if len(req.Msg.Memo) > wallet.MaxMemoBytes {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("memo must be at most %d bytes", wallet.MaxMemoBytes))
+	}
+	if len(req.Msg.References) > wallet.MaxReferences {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("at most %d references are allowed", wallet.MaxReferences))
+	}
 
-	slog.Debug("Preparing transfer transaction",
-		"from", req.Msg.FromAddress,
-		"to", req.Msg.ToAddress,
-		"coin_mint", req.Msg.CoinMint,
-		"amount", req.Msg.Amount)
-
-	unsignedTx, err := s.walletService.PrepareTransfer(ctx, req.Msg.FromAddress, req.Msg.ToAddress, req.Msg.CoinMint, req.Msg.Amount)
+	unsignedTx, err := s.walletService.PrepareTransfer(ctx, req.Msg.FromAddress, req.Msg.ToAddress, req.Msg.CoinMint, req.Msg.Amount, wallet.TransferOptions{
+		Memo:       req.Msg.Memo,
+		References: req.Msg.References,
+	})
 	if err != nil {
-		slog.Error("Failed to prepare transfer",
-			"from", req.Msg.FromAddress,
-			"to", req.Msg.ToAddress,
-			"error", err)
-		// SECURITY: Don't expose internal error details
-		// Check for specific user-facing errors
-		if strings.Contains(err.Error(), "insufficient") {
-			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("insufficient funds for transfer"))
+		slog.ErrorContext(ctx, "Failed to prepare transfer", "error", err)
+		// SECURITY: Don't expose internal error details beyond the typed ones
+		if connectErr, ok := apperrors.ToConnect(err); ok {
+			return nil, connectErr
 		}
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to prepare transfer"))
 	}
 
-	slog.Debug("Transfer transaction prepared successfully")
 	res := connect.NewResponse(&pb.PrepareTransferResponse{
 		UnsignedTransaction: unsignedTx,
 	})
@@ -143,23 +140,16 @@ func (s *walletServiceHandler) SubmitTransfer(
 		UnsignedTransaction: req.Msg.UnsignedTransaction,
 	}
 
-	slog.Debug("Submitting transfer transaction")
 	txHash, err := s.walletService.SubmitTransfer(ctx, transferRequest)
 	if err != nil {
-		slog.Error("Failed to submit transfer", "error", err)
-		// SECURITY: Don't expose internal error details
-		// Check for specific user-facing errors
-		errMsg := err.Error()
-		if strings.Contains(errMsg, "invalid signature") {
-			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid transaction signature"))
-		}
-		if strings.Contains(errMsg, "expired") {
-			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("transaction expired, please retry"))
+		slog.ErrorContext(ctx, "Failed to submit transfer", "error", err)
+		// SECURITY: Don't expose internal error details beyond the typed ones
+		if connectErr, ok := apperrors.ToConnect(err); ok {
+			return nil, connectErr
 		}
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to submit transfer"))
 	}
 
-	slog.Info("Transfer submitted successfully", "tx_hash", txHash)
 	res := connect.NewResponse(&pb.SubmitTransferResponse{
 		TransactionHash: txHash,
 	})
@@ -175,11 +165,12 @@ func (s *walletServiceHandler) GetPortfolioPnL(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("wallet address is required"))
 	}
 
-	slog.Debug("Getting portfolio PnL", "wallet_address", req.Msg.WalletAddress)
-	
-	totalValue, totalCostBasis, totalUnrealizedPnL, totalPnLPercentage, totalHoldings, tokenPnLs, err := s.walletService.GetPortfolioPnL(ctx, req.Msg.WalletAddress)
+	totalValue, totalCostBasis, totalUnrealizedPnL, totalPnLPercentage, totalHoldings, tokenPnLs, currency, err := s.walletService.GetPortfolioPnLIn(ctx, req.Msg.WalletAddress, req.Msg.DisplayCurrency)
+	if errors.Is(err, apperrors.ErrUnsupportedCurrency) {
+		return nil, toConnectError(err, connect.CodeInvalidArgument)
+	}
 	if err != nil {
-		slog.Error("Failed to get portfolio PnL", "wallet_address", req.Msg.WalletAddress, "error", err)
+		slog.ErrorContext(ctx, "Failed to get portfolio PnL", "error", err)
 		// SECURITY: Don't expose internal error details
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get portfolio data"))
 	}
@@ -198,14 +189,13 @@ func (s *walletServiceHandler) GetPortfolioPnL(
 			UnrealizedPnl:   tokenPnL.UnrealizedPnL,
 			PnlPercentage:   tokenPnL.PnLPercentage,
 			HasPurchaseData: tokenPnL.HasPurchaseData,
+			Category:        tokenPnL.Category,
 		})
 	}
 
-	slog.Info("Portfolio PnL calculated successfully", 
-		"wallet_address", req.Msg.WalletAddress,
-		"total_value", totalValue,
-		"total_pnl", totalUnrealizedPnL,
-		"token_count", len(pbTokenPnLs))
+	if err := applyFieldMask(req.Msg.FieldMask, pbTokenPnLs...); err != nil {
+		return nil, err
+	}
 
 	return connect.NewResponse(&pb.GetPortfolioPnLResponse{
 		TotalPortfolioValue: totalValue,
@@ -214,13 +204,96 @@ func (s *walletServiceHandler) GetPortfolioPnL(
 		TotalPnlPercentage:  totalPnLPercentage,
 		TotalHoldings:       totalHoldings,
 		TokenPnls:           pbTokenPnLs,
+		Currency:            currency,
+	}), nil
+}
+
+// GetTokenApprovals lists token accounts in the wallet with an active delegate
+func (s *walletServiceHandler) GetTokenApprovals(
+	ctx context.Context,
+	req *connect.Request[pb.GetTokenApprovalsRequest],
+) (*connect.Response[pb.GetTokenApprovalsResponse], error) {
+	if err := s.walletService.ValidatePublicKey(ctx, req.Msg.WalletAddress); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid wallet address"))
+	}
+
+	approvals, err := s.walletService.GetTokenApprovals(ctx, req.Msg.WalletAddress)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get token approvals", "error", err)
+		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("failed to scan token approvals"))
+	}
+
+	pbApprovals := make([]*pb.TokenApproval, 0, len(approvals))
+	for _, approval := range approvals {
+		pbApprovals = append(pbApprovals, &pb.TokenApproval{
+			TokenAccount:    approval.TokenAccount,
+			Mint:            approval.Mint,
+			Delegate:        approval.Delegate,
+			DelegatedAmount: approval.DelegatedAmount,
+			ProgramId:       approval.ProgramID,
+		})
+	}
+
+	return connect.NewResponse(&pb.GetTokenApprovalsResponse{
+		Approvals: pbApprovals,
+	}), nil
+}
+
+// PrepareRevokeApprovals prepares an unsigned transaction revoking token delegates
+func (s *walletServiceHandler) PrepareRevokeApprovals(
+	ctx context.Context,
+	req *connect.Request[pb.PrepareRevokeApprovalsRequest],
+) (*connect.Response[pb.PrepareRevokeApprovalsResponse], error) {
+	if err := s.walletService.ValidatePublicKey(ctx, req.Msg.WalletAddress); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid wallet address"))
+	}
+
+	revoke, err := s.walletService.PrepareRevokeApprovals(ctx, req.Msg.WalletAddress, req.Msg.TokenAccounts)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to prepare revoke approvals", "error", err)
+		if errors.Is(err, wallet.ErrNoApproval) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to prepare revoke transaction"))
+	}
+
+	return connect.NewResponse(&pb.PrepareRevokeApprovalsResponse{
+		UnsignedTransaction:  revoke.UnsignedTransaction,
+		RevokedTokenAccounts: revoke.RevokedTokenAccounts,
+		Remaining:            int32(revoke.Remaining),
 	}), nil
 }
 
 // Helper function to convert model.WalletBalance to pb.WalletBalance
 func convertModelBalanceToPb(balance *wallet.WalletBalance) *pb.WalletBalance {
 	return &pb.WalletBalance{
-		Balances: convertModelCoinBalancesToPb(balance.Balances),
+		Balances:    convertModelCoinBalancesToPb(balance.Balances),
+		StakedSol:   balance.StakedSOL,
+		NativeStake: convertNativeStakeToPb(balance.NativeStake),
+	}
+}
+
+func convertNativeStakeToPb(stake *wallet.NativeStake) *pb.NativeStake {
+	if stake == nil {
+		return nil
+	}
+	accounts := make([]*pb.StakeAccount, len(stake.Accounts))
+	for i, account := range stake.Accounts {
+		accounts[i] = &pb.StakeAccount{
+			Address:       account.Address,
+			Amount:        account.Amount,
+			DelegatedSol:  account.DelegatedSOL,
+			Validator:     account.Validator,
+			Status:        account.Status,
+			LastRewardSol: account.LastRewardSOL,
+		}
+	}
+	return &pb.NativeStake{
+		Accounts:            accounts,
+		TotalSol:            stake.TotalSOL,
+		ActiveSol:           stake.ActiveSOL,
+		ActivatingSol:       stake.ActivatingSOL,
+		LastEpochRewardsSol: stake.LastEpochRewardsSOL,
 	}
 }
 
@@ -229,9 +302,41 @@ func convertModelCoinBalancesToPb(coins []wallet.Balance) []*pb.Balance {
 	pbCoins := make([]*pb.Balance, len(coins))
 	for i, coin := range coins {
 		pbCoins[i] = &pb.Balance{
-			Id:     coin.ID,
-			Amount: coin.Amount,
+			Id:               coin.ID,
+			Amount:           coin.Amount,
+			IsSpam:           coin.IsSpam,
+			SpamReasons:      coin.SpamReasons,
+			RawAmount:        coin.RawAmount,
+			Decimals:         uint32(coin.Decimals),
+			SolBreakdown:     convertSOLBreakdownToPb(coin.SOLBreakdown),
+			SuggestedActions: coin.SuggestedActions,
+			Category:         coin.Category,
+			LiquidStaking:    convertLiquidStakingToPb(coin.LiquidStaking),
 		}
 	}
 	return pbCoins
 }
+
+func convertSOLBreakdownToPb(breakdown *wallet.SOLBreakdown) *pb.SolBreakdown {
+	if breakdown == nil {
+		return nil
+	}
+	return &pb.SolBreakdown{
+		NativeAmount:     breakdown.NativeAmount,
+		NativeRawAmount:  breakdown.NativeRawAmount,
+		WrappedAmount:    breakdown.WrappedAmount,
+		WrappedRawAmount: breakdown.WrappedRawAmount,
+	}
+}
+
+func convertLiquidStakingToPb(staking *wallet.LiquidStaking) *pb.LiquidStaking {
+	if staking == nil {
+		return nil
+	}
+	return &pb.LiquidStaking{
+		ExchangeRate:       staking.ExchangeRate,
+		StakedSol:          staking.StakedSOL,
+		EstimatedApy:       staking.EstimatedAPY,
+		EstimatedYearlySol: staking.EstimatedYearlySOL,
+	}
+}
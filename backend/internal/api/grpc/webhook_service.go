@@ -0,0 +1,155 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1"
+	dankfoliov1connect "github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1/v1connect"
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/webhook"
+)
+
+const (
+	defaultWebhookPageSize = 50
+	maxWebhookPageSize     = 200
+)
+
+// webhookServiceHandler implements the partner-facing WebhookService API
+type webhookServiceHandler struct {
+	dankfoliov1connect.UnimplementedWebhookServiceHandler
+	webhookService *webhook.Service
+}
+
+// newWebhookServiceHandler creates a new webhookServiceHandler
+func newWebhookServiceHandler(webhookService *webhook.Service) *webhookServiceHandler {
+	return &webhookServiceHandler{webhookService: webhookService}
+}
+
+// CreateWebhookSubscription registers a new partner subscription
+func (h *webhookServiceHandler) CreateWebhookSubscription(
+	ctx context.Context,
+	req *connect.Request[pb.CreateWebhookSubscriptionRequest],
+) (*connect.Response[pb.CreateWebhookSubscriptionResponse], error) {
+	sub, err := h.webhookService.CreateSubscription(ctx, webhook.CreateSubscriptionParams{
+		PartnerName:   req.Msg.PartnerName,
+		URL:           req.Msg.Url,
+		EventTypes:    req.Msg.EventTypes,
+		Secret:        req.Msg.GetSecret(),
+		WalletAddress: req.Msg.GetWalletAddress(),
+		CoinAddress:   req.Msg.GetCoinAddress(),
+	})
+	if err != nil {
+		if errors.Is(err, webhook.ErrInvalidSubscription) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create webhook subscription: %w", err))
+	}
+
+	return connect.NewResponse(&pb.CreateWebhookSubscriptionResponse{
+		Subscription: convertWebhookSubscriptionToPb(sub),
+		Secret:       sub.Secret,
+	}), nil
+}
+
+// ListWebhookSubscriptions lists registered subscriptions
+func (h *webhookServiceHandler) ListWebhookSubscriptions(
+	ctx context.Context,
+	req *connect.Request[pb.ListWebhookSubscriptionsRequest],
+) (*connect.Response[pb.ListWebhookSubscriptionsResponse], error) {
+	limit, offset := webhookPage(req.Msg.Limit, req.Msg.Offset)
+	subs, total, err := h.webhookService.ListSubscriptions(ctx, req.Msg.GetPartnerName(), limit, offset)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list webhook subscriptions: %w", err))
+	}
+
+	pbSubs := make([]*pb.WebhookSubscription, 0, len(subs))
+	for i := range subs {
+		pbSubs = append(pbSubs, convertWebhookSubscriptionToPb(&subs[i]))
+	}
+	return connect.NewResponse(&pb.ListWebhookSubscriptionsResponse{
+		Subscriptions: pbSubs,
+		TotalCount:    total,
+	}), nil
+}
+
+// DeleteWebhookSubscription removes a subscription
+func (h *webhookServiceHandler) DeleteWebhookSubscription(
+	ctx context.Context,
+	req *connect.Request[pb.DeleteWebhookSubscriptionRequest],
+) (*connect.Response[pb.DeleteWebhookSubscriptionResponse], error) {
+	if req.Msg.Id == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("id is required"))
+	}
+	if err := h.webhookService.DeleteSubscription(ctx, req.Msg.Id); err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&pb.DeleteWebhookSubscriptionResponse{}), nil
+}
+
+// ListWebhookDeadLetters lists deliveries that exhausted their retries
+func (h *webhookServiceHandler) ListWebhookDeadLetters(
+	ctx context.Context,
+	req *connect.Request[pb.ListWebhookDeadLettersRequest],
+) (*connect.Response[pb.ListWebhookDeadLettersResponse], error) {
+	limit, offset := webhookPage(req.Msg.Limit, req.Msg.Offset)
+	letters, total, err := h.webhookService.ListDeadLetters(ctx, req.Msg.GetSubscriptionId(), limit, offset)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list webhook dead letters: %w", err))
+	}
+
+	pbLetters := make([]*pb.WebhookDeadLetter, 0, len(letters))
+	for _, l := range letters {
+		pbLetters = append(pbLetters, &pb.WebhookDeadLetter{
+			Id:             uint64(l.ID),
+			SubscriptionId: l.SubscriptionID,
+			EventId:        l.EventID,
+			EventType:      l.EventType,
+			Payload:        l.Payload,
+			Attempts:       int32(l.Attempts),
+			LastError:      l.LastError,
+			CreatedAt:      timestamppb.New(l.CreatedAt),
+		})
+	}
+	return connect.NewResponse(&pb.ListWebhookDeadLettersResponse{
+		DeadLetters: pbLetters,
+		TotalCount:  total,
+	}), nil
+}
+
+func convertWebhookSubscriptionToPb(sub *model.WebhookSubscription) *pb.WebhookSubscription {
+	pbSub := &pb.WebhookSubscription{
+		Id:          sub.ID,
+		PartnerName: sub.PartnerName,
+		Url:         sub.URL,
+		EventTypes:  sub.EventTypes,
+		Active:      sub.Active,
+		CreatedAt:   timestamppb.New(sub.CreatedAt),
+	}
+	if sub.WalletAddress != "" {
+		pbSub.WalletAddress = &sub.WalletAddress
+	}
+	if sub.CoinAddress != "" {
+		pbSub.CoinAddress = &sub.CoinAddress
+	}
+	return pbSub
+}
+
+func webhookPage(limit, offset *int32) (int, int) {
+	l, o := defaultWebhookPageSize, 0
+	if limit != nil && *limit > 0 {
+		l = min(int(*limit), maxWebhookPageSize)
+	}
+	if offset != nil && *offset > 0 {
+		o = int(*offset)
+	}
+	return l, o
+}
@@ -0,0 +1,334 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/joho/godotenv"
+	"github.com/kelseyhightower/envconfig"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients"
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/offchain"
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/solana"
+	"github.com/nicolas-martin/dankfolio/backend/internal/secrets"
+)
+
+// Config is the API server's configuration, read from the environment
+type Config struct {
+	SolanaRPCEndpoint          string        `envconfig:"SOLANA_RPC_ENDPOINT" default:"https://api.mainnet-beta.solana.com"`
+	SolanaRPCAPIKey            string        `envconfig:"SOLANA_RPC_API_KEY" required:"true"`
+	SolanaRPCFallbackEndpoints string        `envconfig:"SOLANA_RPC_FALLBACK_ENDPOINTS"`            // Comma-separated url or name=url, tried in order when the primary fails
+	SolanaRPCFailureThreshold  int           `envconfig:"SOLANA_RPC_FAILURE_THRESHOLD" default:"3"` // Consecutive errors before an endpoint leaves rotation
+	SolanaRPCHealthInterval    time.Duration `envconfig:"SOLANA_RPC_HEALTH_CHECK_INTERVAL" default:"30s"`
+	SolanaRPCLatencyRouting    bool          `envconfig:"SOLANA_RPC_LATENCY_ROUTING" default:"false"` // Prefer the fastest healthy endpoint over the primary
+	SolanaCommitment           string        `envconfig:"SOLANA_COMMITMENT" default:"confirmed"`      // processed, confirmed or finalized; trades complete at this level
+	BirdEyeEndpoint            string        `envconfig:"BIRDEYE_ENDPOINT" required:"true"`
+	BirdEyeAPIKey              string        `envconfig:"BIRDEYE_API_KEY" required:"true"`
+	BirdEyeWSEndpoint          string        `envconfig:"BIRDEYE_WS_ENDPOINT"`                       // Streams live prices of viewed tokens when set; polled otherwise
+	LivePricePollInterval      time.Duration `envconfig:"LIVE_PRICE_POLL_INTERVAL" default:"15s"`    // Polls viewed tokens the stream has not priced recently
+	PriceCrossCheckThreshold   float64       `envconfig:"PRICE_CROSSCHECK_THRESHOLD" default:"0.05"` // Quotes are flagged when BirdEye and Jupiter prices differ by more; 0 disables
+	PriceTimeframes            []string      `envconfig:"PRICE_TIMEFRAMES"`                          // Chart timeframe overrides, e.g. ONE_HOUR=1m/1h/2m; see the price.timeframes setting
+	WalletBalanceCacheTTL      time.Duration `envconfig:"WALLET_BALANCE_CACHE_TTL" default:"30s"`    // Balances are reused this long unless a transaction invalidates them; 0 disables
+	GRPCPort                   int           `envconfig:"GRPC_PORT" default:"9000"`
+	DBURL                      string        `envconfig:"DB_URL" required:"true"`
+	JupiterAPIKey              string        `envconfig:"JUPITER_API_KEY"`
+	JupiterAPIUrl              string        `envconfig:"JUPITER_API_URL" required:"true"`
+	Env                        string        `envconfig:"APP_ENV" required:"true"`
+	NewCoinsFetchInterval      time.Duration `envconfig:"NEW_COINS_FETCH_INTERVAL" required:"true"`
+	TrendingCoinsFetchInterval time.Duration `envconfig:"TRENDING_COINS_FETCH_INTERVAL" required:"true"`
+	TopGainersFetchInterval    time.Duration `envconfig:"TOP_GAINERS_FETCH_INTERVAL" required:"true"`
+	MarketOverviewInterval     time.Duration `envconfig:"MARKET_OVERVIEW_INTERVAL" default:"5m"`
+	CoinArchiveInterval        time.Duration `envconfig:"COIN_ARCHIVE_INTERVAL" default:"6h"`
+	CoinArchiveInactiveFor     time.Duration `envconfig:"COIN_ARCHIVE_INACTIVE_FOR" default:"336h"`
+	PlaceholderRetryInterval   time.Duration `envconfig:"PLACEHOLDER_RETRY_INTERVAL" default:"1h"`
+	ImageMaxRedirects          int           `envconfig:"IMAGE_MAX_REDIRECTS" default:"3"`        // Same-site redirects followed by logo downloads; 0 rejects any
+	OffchainMaxPerHost         int           `envconfig:"OFFCHAIN_MAX_PER_HOST" default:"4"`      // Metadata and image fetches in flight per host
+	OffchainMaxCrawlDelay      time.Duration `envconfig:"OFFCHAIN_MAX_CRAWL_DELAY" default:"10s"` // Longest robots.txt Crawl-delay honoured
+	CoinIndexBudget            time.Duration `envconfig:"COIN_INDEX_BUDGET" default:"2s"`         // How long a search waits on indexing an unknown mint
+	PriceHistoryPersist        bool          `envconfig:"PRICE_HISTORY_PERSIST" default:"true"`
+	HistoryDownsampleInterval  time.Duration `envconfig:"PRICE_HISTORY_DOWNSAMPLE_INTERVAL" default:"15m"`
+	FXProvider                 string        `envconfig:"FX_PROVIDER" default:"ecb"` // ecb, openexchangerates or none
+	OpenExchangeRatesAppID     string        `envconfig:"OPENEXCHANGERATES_APP_ID"`
+	FXRatesTTL                 time.Duration `envconfig:"FX_RATES_TTL" default:"24h"`
+	PrefetchTopN               int           `envconfig:"PREFETCH_TOP_N" default:"10"`                  // Trending coins whose detail data is prefetched; 0 disables
+	PlatformFeeBps             int           `envconfig:"PLATFORM_FEE_BPS" required:"true"`             // Basis points for platform fee, e.g., 100 = 1%
+	PlatformFeeAccountAddress  string        `envconfig:"PLATFORM_FEE_ACCOUNT_ADDRESS" required:"true"` // Conditionally required, handled in validation
+	PlatformPrivateKey         string        `ignored:"true"`                                           // Base64 encoded private key for platform account, loaded by loadSecrets
+	// Secret holding PlatformPrivateKey; with the env secrets backend this is the variable name
+	PlatformPrivateKeySecret   string        `envconfig:"PLATFORM_PRIVATE_KEY_SECRET" default:"PLATFORM_PRIVATE_KEY"`
+	QuoteTTL                   time.Duration `envconfig:"QUOTE_TTL" default:"45s"`                          // How long a prepared swap can be submitted
+	PaperStartingSOL           float64       `envconfig:"PAPER_STARTING_SOL" default:"10"`                  // Virtual SOL a new paper trading account is funded with
+	SwapComputeUnitMargin      float64       `envconfig:"SWAP_COMPUTE_UNIT_MARGIN" default:"0.15"`          // Fraction added to simulated compute units
+	SwapPriorityFeePercentile  int           `envconfig:"SWAP_PRIORITY_FEE_PERCENTILE" default:"75"`        // Percentile of recent prioritization fees paid
+	SwapMinPriorityFee         uint64        `envconfig:"SWAP_MIN_PRIORITY_FEE_MICROLAMPORTS" default:"0"`  // Floor on the compute unit price
+	SwapMaxPriorityFeeLamports uint64        `envconfig:"SWAP_MAX_PRIORITY_FEE_LAMPORTS" default:"1000000"` // Cap on a swap's total priority fee
+	MEVDetectionEnabled        bool          `envconfig:"MEV_DETECTION_ENABLED" default:"true"`             // Read confirmed swaps' blocks for sandwiches
+	SponsoredSwapsEnabled      bool          `envconfig:"SPONSORED_SWAPS_ENABLED" default:"false"`          // Let the platform signer pay fees for wallets without SOL
+	SponsoredSwapsPerDay       int           `envconfig:"SPONSORED_SWAPS_PER_DAY" default:"3"`              // Sponsored swaps per wallet in 24 hours
+	SponsoredLamportsPerDay    uint64        `envconfig:"SPONSORED_LAMPORTS_PER_DAY" default:"20000000"`    // Platform SOL spent per wallet in 24 hours
+	SponsoredMaxFeeBps         int           `envconfig:"SPONSORED_MAX_FEE_BPS" default:"300"`              // Most the fee recovering a sponsorship can be
+	RiskChecksEnabled          bool          `envconfig:"RISK_CHECKS_ENABLED" default:"true"`               // Flag risky swaps in PrepareSwap
+	RiskMaxPositionPercent     float64       `envconfig:"RISK_MAX_POSITION_PERCENT" default:"50"`           // Share of the wallet's value one swap can spend unflagged
+	RiskMaxPriceImpactPercent  float64       `envconfig:"RISK_MAX_PRICE_IMPACT_PERCENT" default:"5"`        // Price impact a swap can have unflagged
+	RiskNewCoinAge             time.Duration `envconfig:"RISK_NEW_COIN_AGE" default:"24h"`                  // Coins listed more recently than this are new
+	RiskNewCoinMinSafetyScore  int           `envconfig:"RISK_NEW_COIN_MIN_SAFETY_SCORE" default:"50"`      // New coins scoring below this are flagged
+	RiskBlockingChecks         []string      `envconfig:"RISK_BLOCKING_CHECKS"`                             // Checks refused until acknowledged; the rest only warn
+	TradeLimitsEnabled         bool          `envconfig:"TRADE_LIMITS_ENABLED" default:"false"`             // Enforce per-wallet notional limits in SubmitSwap
+	TradeLimitDailyUSD         float64       `envconfig:"TRADE_LIMIT_DAILY_USD" default:"10000"`            // Notional a wallet can swap in a UTC day
+	TradeLimitWeeklyUSD        float64       `envconfig:"TRADE_LIMIT_WEEKLY_USD" default:"50000"`           // Notional a wallet can swap in seven UTC days
+	GeoPolicyEnabled           bool          `envconfig:"GEO_POLICY_ENABLED" default:"false"`               // Apply the region access rules to API calls
+	GeoRegionHeader            string        `envconfig:"GEO_REGION_HEADER" default:"CF-IPCountry"`         // Header the edge sets to the caller's country
+	GeoRegionRules             []string      `envconfig:"GEO_REGION_RULES"`                                 // Default REGION=action rules, e.g. US=read_only
+	MaxMindAccountID           string        `envconfig:"MAXMIND_ACCOUNT_ID"`                               // Looks up callers' countries when the header is missing
+	MaxMindLicenseKey          string        `envconfig:"MAXMIND_LICENSE_KEY"`
+	DevAppCheckToken           string        `envconfig:"DEV_APP_CHECK_TOKEN"`
+	InitializeXStocksOnStartup bool          `envconfig:"INITIALIZE_XSTOCKS_ON_STARTUP" default:"false"`
+	PopulateNaughtyWords       bool          `envconfig:"POPULATE_NAUGHTY_WORDS" default:"false"`
+	OTLPEndpoint               string        `envconfig:"OTLP_ENDPOINT"`
+	AdminAPIKey                string        `envconfig:"ADMIN_API_KEY"`
+	ShutdownDrainTimeout       time.Duration `envconfig:"SHUTDOWN_DRAIN_TIMEOUT" default:"25s"`
+	WebhooksEnabled            bool          `envconfig:"WEBHOOKS_ENABLED" default:"false"`
+	SettingsPollInterval       time.Duration `envconfig:"SETTINGS_POLL_INTERVAL" default:"30s"`
+	SlowQueryThreshold         time.Duration `envconfig:"SLOW_QUERY_THRESHOLD" default:"200ms"` // Queries over this are logged and kept for ListSlowQueries
+	RequestLogSampleRate       float64       `envconfig:"REQUEST_LOG_SAMPLE_RATE" default:"1"`  // Share of successful API requests logged; failures are always logged
+	SlowRequestThreshold       time.Duration `envconfig:"SLOW_REQUEST_THRESHOLD" default:"1s"`  // API requests over this are always logged
+	CacheSummaryInterval       time.Duration `envconfig:"CACHE_SUMMARY_INTERVAL" default:"10m"` // How often cache hit ratios are logged; 0 disables
+	CacheHitRatioTargets       string        `envconfig:"CACHE_HIT_RATIO_TARGETS" default:"coin=0.8,price=0.8"`
+	RepositoryCacheTTL         time.Duration `envconfig:"REPOSITORY_CACHE_TTL" default:"30s"`      // Point reads of coins, settings and naughty words are cached this long; 0 disables
+	SoftDeleteRetention        time.Duration `envconfig:"SOFT_DELETE_RETENTION" default:"720h"`    // Soft-deleted records can be restored this long before they are purged
+	SoftDeletePurgeInterval    time.Duration `envconfig:"SOFT_DELETE_PURGE_INTERVAL" default:"6h"` // 0 disables purging
+	DataErasureInterval        time.Duration `envconfig:"DATA_ERASURE_INTERVAL" default:"1m"`      // Time between runs of queued DeleteMyData erasures; 0 disables
+	FeatureFlagRefreshInterval time.Duration `envconfig:"FEATURE_FLAG_REFRESH_INTERVAL" default:"30s"`
+	SpamListRefreshInterval    time.Duration `envconfig:"SPAM_LIST_REFRESH_INTERVAL" default:"1m"`
+	BlocklistFeeds             string        `envconfig:"BLOCKLIST_FEEDS"` // Comma-separated name=url scam list feeds
+	BlocklistSyncInterval      time.Duration `envconfig:"BLOCKLIST_SYNC_INTERVAL" default:"6h"`
+	BlocklistReloadInterval    time.Duration `envconfig:"BLOCKLIST_RELOAD_INTERVAL" default:"1m"`
+	LeaderboardWindow          time.Duration `envconfig:"LEADERBOARD_WINDOW" default:"168h"`     // Lookback for top traders
+	LeaderboardFreshFor        time.Duration `envconfig:"LEADERBOARD_FRESH_FOR" default:"10m"`   // Age before a cached board refreshes in the background
+	BurnCheckInterval          time.Duration `envconfig:"BURN_CHECK_INTERVAL" default:"15m"`     // 0 disables supply and LP burn detection
+	BurnCheckMaxCoins          int           `envconfig:"BURN_CHECK_MAX_COINS" default:"500"`    // Coins watched for burns, by 24h volume
+	AuthorityCheckInterval     time.Duration `envconfig:"AUTHORITY_CHECK_INTERVAL" default:"5m"` // 0 disables mint/freeze authority monitoring
+	AuthorityCheckMaxCoins     int           `envconfig:"AUTHORITY_CHECK_MAX_COINS" default:"500"`
+	SolanaPayEnabled           bool          `envconfig:"SOLANA_PAY_ENABLED" default:"false"`
+	SolanaPayLinkBaseURL       string        `envconfig:"SOLANA_PAY_LINK_BASE_URL"` // Public base for transaction requests; empty serves transfer requests only
+	SolanaPayIconURL           string        `envconfig:"SOLANA_PAY_ICON_URL"`
+	SolanaPayRequestTTL        time.Duration `envconfig:"SOLANA_PAY_REQUEST_TTL" default:"30m"`
+	NotificationsEnabled       bool          `envconfig:"NOTIFICATIONS_ENABLED" default:"false"`
+	SolanaWSEndpoint           string        `envconfig:"SOLANA_WS_ENDPOINT"` // Derived from SOLANA_RPC_ENDPOINT when empty
+	NotificationDumpInterval   time.Duration `envconfig:"NOTIFICATION_DUMP_INTERVAL" default:"5m"`
+	SecretsBackend             string        `envconfig:"SECRETS_BACKEND" default:"env"` // env, gcp or aws
+	SecretsGCPProjectID        string        `envconfig:"SECRETS_GCP_PROJECT_ID" default:"dankfolio"`
+	SecretsAWSRegion           string        `envconfig:"SECRETS_AWS_REGION"`
+	SecretsRefreshInterval     time.Duration `envconfig:"SECRETS_REFRESH_INTERVAL" default:"5m"`
+	PlatformSigner             string        `envconfig:"PLATFORM_SIGNER" default:"local"` // local or remote
+	PlatformSignerURL          string        `envconfig:"PLATFORM_SIGNER_URL"`             // Signing sidecar base URL when remote
+	PlatformSignerToken        string        `ignored:"true"`                              // Bearer token for the sidecar, loaded by loadSecrets
+	PlatformSignerTokenSecret  string        `envconfig:"PLATFORM_SIGNER_TOKEN_SECRET" default:"PLATFORM_SIGNER_TOKEN"`
+	RateLimitRPS               float64       `envconfig:"RATE_LIMIT_RPS" default:"10"` // Per-client request rate
+	RateLimitBurst             int           `envconfig:"RATE_LIMIT_BURST" default:"20"`
+	ReportUpstreamCalls        bool          `envconfig:"REPORT_UPSTREAM_CALLS" default:"false"`              // Honour X-Report-Upstream-Calls, for dankctl loadtest
+	HTTPReplayMode             string        `envconfig:"HTTP_REPLAY_MODE"`                                   // off, record or replay; APP_ENV=offline defaults to replay
+	HTTPFixturesDir            string        `envconfig:"HTTP_FIXTURES_DIR" default:"testdata/http-fixtures"` // Recorded BirdEye/Jupiter/offchain responses
+	MinimumAppVersion          string        `envconfig:"MINIMUM_APP_VERSION"`                                // Older apps are asked to update
+	ClientRPCEndpoint          string        `envconfig:"CLIENT_RPC_ENDPOINT"`                                // Solana RPC endpoint handed to the app; must not carry our API key
+	MaintenanceMode            bool          `envconfig:"MAINTENANCE_MODE" default:"false"`                   // Start with trades and transfers paused, e.g. during migrations
+
+	// HTTP_* connection settings shared by the API clients, each upstream's
+	// own such as BIRDEYE_HTTP_*, and the DNS_CACHE_* settings, loaded by LoadConfig
+	HTTPTransport clients.TransportConfig            `ignored:"true"`
+	HTTPUpstreams map[string]clients.TransportConfig `ignored:"true"`
+	DNSCache      clients.DNSConfig                  `ignored:"true"`
+}
+
+const envOffline = "offline"
+
+// offlineDefaults stand in for credentials when APP_ENV=offline. Replayed
+// responses never reach the real APIs, so the values are never used.
+var offlineDefaults = map[string]string{
+	"SOLANA_RPC_API_KEY":           "offline",
+	"BIRDEYE_ENDPOINT":             "https://public-api.birdeye.so",
+	"BIRDEYE_API_KEY":              "offline",
+	"JUPITER_API_URL":              "https://lite-api.jup.ag",
+	"PLATFORM_FEE_BPS":             "0",
+	"PLATFORM_FEE_ACCOUNT_ADDRESS": "11111111111111111111111111111111",
+}
+
+// httpReplay returns the record/replay settings for external HTTP clients
+func (c *Config) httpReplay() clients.ReplayConfig {
+	mode, err := clients.ParseReplayMode(c.HTTPReplayMode)
+	if err != nil {
+		log.Fatalf("Invalid HTTP_REPLAY_MODE: %v", err)
+	}
+	return clients.ReplayConfig{Mode: mode, Dir: c.HTTPFixturesDir}
+}
+
+// httpUpstreams are the API clients whose connections can be tuned on their own
+var httpUpstreams = []string{"birdeye", "jupiter", "offchain", "solana"}
+
+// loadHTTPTransports reads the shared HTTP_* connection settings and each
+// upstream's own, which fall back to the shared ones when unset
+func loadHTTPTransports() (clients.TransportConfig, map[string]clients.TransportConfig, error) {
+	var shared clients.TransportConfig
+	if err := envconfig.Process("", &shared); err != nil {
+		return clients.TransportConfig{}, nil, err
+	}
+	upstreams := make(map[string]clients.TransportConfig, len(httpUpstreams))
+	for _, name := range httpUpstreams {
+		var upstream clients.TransportConfig
+		if err := envconfig.Process(name, &upstream); err != nil {
+			return clients.TransportConfig{}, nil, fmt.Errorf("%s: %w", name, err)
+		}
+		upstreams[name] = upstream
+	}
+	return shared, upstreams, nil
+}
+
+// LoadConfig reads the configuration from the environment, and from .env in
+// development and offline runs, then loads secrets. It exits on invalid
+// configuration, since nothing can start without it.
+func LoadConfig() (*Config, *secrets.CachingProvider) {
+	// Load environment variables from .env file in development
+	if os.Getenv("APP_ENV") == "development" {
+		if err := godotenv.Load(); err != nil {
+			log.Fatalf("Error loading .env file: %v", err)
+		}
+	}
+
+	// Offline runs serve external APIs from fixtures, so keys are optional
+	if os.Getenv("APP_ENV") == envOffline {
+		_ = godotenv.Load()
+		for key, value := range offlineDefaults {
+			if os.Getenv(key) == "" {
+				os.Setenv(key, value)
+			}
+		}
+		if os.Getenv("HTTP_REPLAY_MODE") == "" {
+			os.Setenv("HTTP_REPLAY_MODE", string(clients.ReplayReplay))
+		}
+	}
+
+	var cfg Config
+	err := envconfig.Process("", &cfg)
+	if err != nil {
+		log.Fatalf("Error processing environment variables: %v", err)
+	}
+	cfg.HTTPTransport, cfg.HTTPUpstreams, err = loadHTTPTransports()
+	if err != nil {
+		log.Fatalf("Error processing HTTP client settings: %v", err)
+	}
+	if err := envconfig.Process("", &cfg.DNSCache); err != nil {
+		log.Fatalf("Error processing DNS cache settings: %v", err)
+	}
+
+	secretProvider := loadSecrets(&cfg)
+	return &cfg, secretProvider
+}
+
+// loadSecrets fills secret config fields from the configured secrets backend.
+// The returned provider caches values and drives rotation hooks.
+func loadSecrets(cfg *Config) *secrets.CachingProvider {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	provider, err := secrets.NewProvider(ctx, secrets.Config{
+		Backend:      cfg.SecretsBackend,
+		GCPProjectID: cfg.SecretsGCPProjectID,
+		AWSRegion:    cfg.SecretsAWSRegion,
+	})
+	if err != nil {
+		log.Fatalf("Error creating secrets provider: %v", err)
+	}
+	cached := secrets.NewCachingProvider(provider)
+
+	// With a remote signer the key never enters this process; only the sidecar token is needed
+	if cfg.PlatformSigner == platformSignerRemote {
+		cfg.PlatformSignerToken, err = cached.GetSecret(ctx, cfg.PlatformSignerTokenSecret)
+		if err != nil {
+			log.Fatalf("Error loading platform signer token from %s secrets: %v", cfg.SecretsBackend, err)
+		}
+		return cached
+	}
+
+	// The platform key is optional; without it platform ATAs are not created
+	cfg.PlatformPrivateKey, err = cached.GetSecret(ctx, cfg.PlatformPrivateKeySecret)
+	if err != nil && !errors.Is(err, secrets.ErrNotFound) {
+		log.Fatalf("Error loading platform private key from %s secrets: %v", cfg.SecretsBackend, err)
+	}
+
+	return cached
+}
+
+// MaskSensitiveURL masks sensitive parts of a URL while preserving useful information
+func MaskSensitiveURL(url string) string {
+	if url == "" {
+		return ""
+	}
+
+	// Simple approach: mask everything after the first @ and before the last /
+	// This will show the protocol and database name while hiding credentials and host details
+	parts := strings.Split(url, "@")
+	if len(parts) < 2 {
+		return url // No credentials to mask
+	}
+
+	// Get the part after @
+	afterAt := parts[len(parts)-1]
+	hostAndPath := strings.Split(afterAt, "/")
+	if len(hostAndPath) < 2 {
+		return parts[0] + "@***"
+	}
+
+	// Return protocol + masked credentials + database name
+	return parts[0] + "@***/***/" + hostAndPath[len(hostAndPath)-1]
+}
+
+// Tooling returns the tooling profile the server is assembled on
+func (c *Config) Tooling() (ToolingConfig, error) {
+	header := map[string]string{
+		"Authorization": "Bearer " + c.SolanaRPCAPIKey,
+	}
+	fallbackEndpoints, err := solana.ParseEndpoints(c.SolanaRPCFallbackEndpoints)
+	if err != nil {
+		return ToolingConfig{}, fmt.Errorf("invalid SOLANA_RPC_FALLBACK_ENDPOINTS: %w", err)
+	}
+
+	// Telemetry is skipped in development unless explicitly configured
+	otlpEndpoint := c.OTLPEndpoint
+	if otlpEndpoint == "" && c.Env != "development" {
+		otlpEndpoint = "localhost:4317" // Default OTLP gRPC endpoint
+	}
+
+	return ToolingConfig{
+		ServiceName:  "dankfolio-backend",
+		Env:          c.Env,
+		DBURL:        c.DBURL,
+		AutoMigrate:  true,
+		OTLPEndpoint: otlpEndpoint,
+		DrainTimeout: c.ShutdownDrainTimeout,
+		// The primary endpoint fails over to the fallbacks, which carry their own keys
+		SolanaRPCEndpoints: append([]solana.Endpoint{{Name: "primary", URL: c.SolanaRPCEndpoint, Headers: header}}, fallbackEndpoints...),
+		SolanaRPCPool: solana.PoolConfig{
+			FailureThreshold:    c.SolanaRPCFailureThreshold,
+			HealthCheckInterval: c.SolanaRPCHealthInterval,
+			LatencyRouting:      c.SolanaRPCLatencyRouting,
+		},
+		BirdEyeEndpoint: c.BirdEyeEndpoint,
+		BirdEyeAPIKey:   c.BirdEyeAPIKey,
+		JupiterAPIURL:   c.JupiterAPIUrl,
+		JupiterAPIKey:   c.JupiterAPIKey,
+		Offchain:        true,
+		OffchainHosts: offchain.Config{
+			MaxPerHost:    c.OffchainMaxPerHost,
+			MaxCrawlDelay: c.OffchainMaxCrawlDelay,
+		},
+		Replay:        c.httpReplay(),
+		HTTPTransport: c.HTTPTransport,
+		HTTPUpstreams: c.HTTPUpstreams,
+		DNSCache:      c.DNSCache,
+	}, nil
+}
@@ -0,0 +1,69 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients"
+)
+
+func TestConfigTooling(t *testing.T) {
+	config := &Config{
+		Env:                        "development",
+		SolanaRPCEndpoint:          "https://rpc.example.com",
+		SolanaRPCAPIKey:            "key",
+		SolanaRPCFallbackEndpoints: "backup=https://backup.example.com",
+	}
+
+	tooling, err := config.Tooling()
+	require.NoError(t, err)
+	assert.Empty(t, tooling.OTLPEndpoint, "telemetry is off in development unless configured")
+	require.Len(t, tooling.SolanaRPCEndpoints, 2)
+	assert.Equal(t, "Bearer key", tooling.SolanaRPCEndpoints[0].Headers["Authorization"])
+	assert.Equal(t, "backup", tooling.SolanaRPCEndpoints[1].Name)
+
+	config.Env = "production"
+	tooling, err = config.Tooling()
+	require.NoError(t, err)
+	assert.Equal(t, "localhost:4317", tooling.OTLPEndpoint)
+
+	config.SolanaRPCFallbackEndpoints = "ftp://backup.example.com"
+	_, err = config.Tooling()
+	assert.Error(t, err)
+}
+
+func TestLoadHTTPTransports(t *testing.T) {
+	t.Setenv("HTTP_TIMEOUT", "15s")
+	t.Setenv("HTTP_MAX_IDLE_CONNS_PER_HOST", "8")
+	t.Setenv("BIRDEYE_HTTP_MAX_IDLE_CONNS_PER_HOST", "128")
+	t.Setenv("SOLANA_HTTP_DISABLE_HTTP2", "true")
+
+	shared, upstreams, err := loadHTTPTransports()
+	require.NoError(t, err)
+	assert.Equal(t, 15*time.Second, shared.Timeout)
+	assert.Equal(t, 8, shared.MaxIdleConnsPerHost)
+	assert.Equal(t, 128, upstreams["birdeye"].MaxIdleConnsPerHost)
+	assert.Equal(t, 8, upstreams["jupiter"].MaxIdleConnsPerHost, "upstreams fall back to the shared settings")
+	assert.True(t, upstreams["solana"].DisableHTTP2)
+	assert.False(t, upstreams["birdeye"].DisableHTTP2)
+
+	tooling := upstreamTransports(ToolingConfig{HTTPUpstreams: map[string]clients.TransportConfig{"solana": upstreams["solana"]}})
+	assert.Equal(t, 15*time.Second, tooling["solana"].Timeout, "configured settings replace the built-in tuning")
+	assert.Equal(t, 64, tooling["birdeye"].MaxIdleConnsPerHost)
+}
+
+func TestNewToolingWithoutEndpoints(t *testing.T) {
+	tooling, err := NewTooling(ToolingConfig{ServiceName: "test", Env: EnvCLI})
+	require.NoError(t, err)
+	assert.Nil(t, tooling.Store)
+	assert.Nil(t, tooling.Solana)
+	assert.Nil(t, tooling.BirdEye)
+	assert.NotNil(t, tooling.Tracker)
+	assert.NoError(t, tooling.Close())
+
+	_, err = NewReadOnly(ToolingConfig{ServiceName: "test", Env: EnvCLI})
+	assert.Error(t, err, "the read services need a database")
+}
@@ -0,0 +1,36 @@
+package app
+
+import (
+	"log/slog"
+	"os"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/logger"
+)
+
+// SetupLogging installs the default logger: colored text in development and
+// JSON elsewhere, both carrying trace context
+func SetupLogging(env string) {
+	level := logLevel(env)
+	var handler slog.Handler
+	if env != "development" {
+		handler = slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: level})
+	} else {
+		handler = logger.NewColorHandler(level, os.Stdout, os.Stderr)
+	}
+	slog.SetDefault(slog.New(logger.NewOtelHandler(handler)))
+}
+
+// EnvCLI is the environment of command-line tools, which only log warnings
+// so their output stays readable
+const EnvCLI = "cli"
+
+func logLevel(env string) slog.Level {
+	switch env {
+	case "development":
+		return slog.LevelDebug
+	case EnvCLI:
+		return slog.LevelWarn
+	default:
+		return slog.LevelInfo
+	}
+}
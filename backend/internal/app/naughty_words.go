@@ -0,0 +1,135 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/lifecycle"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/coin"
+)
+
+// naughtyWordsPopulationJob is the checkpoint name for the naughty words table population.
+const naughtyWordsPopulationJob = "naughty_words.populate"
+
+// populateNaughtyWords fills an empty naughty words table from the LDNOOBW
+// lists, resuming an interrupted run, and reloads the coin service's words
+func populateNaughtyWords(ctx context.Context, coinService *coin.Service) error {
+	// A checkpoint that is not "done" means a previous run was interrupted; resume it
+	checkpoints := lifecycle.NewCheckpoints(coinService.GetStore().JobCheckpoints())
+	checkpoint, cpErr := checkpoints.Load(ctx, naughtyWordsPopulationJob)
+	if cpErr != nil {
+		slog.WarnContext(ctx, "Failed to load naughty words population checkpoint", slog.Any("error", cpErr))
+	}
+	resuming := checkpoint != nil && checkpoint.Cursor != lifecycle.CursorDone
+	resumeAfter := ""
+	if resuming && checkpoint.Cursor != lifecycle.CursorRunning {
+		resumeAfter = checkpoint.Cursor
+	}
+
+	// Check if the table is empty first
+	var count int64
+	// Simplified check: Attempt to list one item. If error or empty, assume we can populate.
+	_, total, listErr := coinService.GetStore().NaughtyWords().List(ctx, db.ListOptions{Limit: pint(1)})
+	if listErr != nil {
+		slog.ErrorContext(ctx, "Failed to check existing naughty words (error on list)", slog.Any("error", listErr))
+		if !errors.Is(listErr, db.ErrNotFound) {
+			return fmt.Errorf("failed to check existing naughty words: %w", listErr)
+		}
+	}
+	count = int64(total)
+
+	if count == 0 || resuming {
+		if resuming {
+			slog.InfoContext(ctx, "Resuming interrupted naughty words population", slog.String("after_language", resumeAfter))
+		} else {
+			slog.InfoContext(ctx, "Naughty words table is empty (or was not found), proceeding with population from all languages.")
+			if err := checkpoints.Save(ctx, naughtyWordsPopulationJob, lifecycle.CursorRunning); err != nil {
+				slog.WarnContext(ctx, "Failed to save naughty words population checkpoint", slog.Any("error", err))
+			}
+		}
+
+		// Words already listed, e.g. by an interrupted run, are skipped
+		naughtyWords := coinService.GetStore().NaughtyWords()
+		listed, listedErr := coin.ListedNaughtyWords(ctx, naughtyWords)
+		if listedErr != nil {
+			return listedErr
+		}
+
+		totalWordsAdded := 0
+		totalWordsFetched := 0
+
+		skipping := resumeAfter != ""
+		for _, lang := range coin.NaughtyWordLanguages {
+			if skipping {
+				skipping = lang.Code != resumeAfter
+				continue
+			}
+			if ctx.Err() != nil {
+				slog.InfoContext(ctx, "Naughty words population interrupted; will resume on next start", slog.String("next_language", lang.Code))
+				return ctx.Err()
+			}
+
+			slog.InfoContext(ctx, "Downloading banned words", slog.String("language", lang.Name), slog.String("code", lang.Code))
+
+			words, fetchErr := coin.FetchNaughtyWordList(ctx, lang.Code)
+			if fetchErr != nil {
+				slog.WarnContext(ctx, "Failed to fetch word list for language", slog.String("language", lang.Name), slog.Any("error", fetchErr))
+				continue
+			}
+
+			// One multi-row upsert per language. A failed language isn't
+			// checkpointed, so it is retried in full on the next start.
+			languageWordsAdded, saveErr := coin.SaveNewNaughtyWords(ctx, naughtyWords, words, lang.Code, listed)
+			if saveErr != nil {
+				if ctx.Err() != nil {
+					slog.InfoContext(ctx, "Naughty words population interrupted mid-language; will resume on next start", slog.String("language", lang.Code))
+					return ctx.Err()
+				}
+				slog.WarnContext(ctx, "Failed to save word list for language", slog.String("language", lang.Name), slog.Any("error", saveErr))
+				continue
+			}
+
+			totalWordsFetched += len(words)
+			totalWordsAdded += languageWordsAdded
+
+			if err := checkpoints.Save(ctx, naughtyWordsPopulationJob, lang.Code); err != nil {
+				slog.WarnContext(ctx, "Failed to save naughty words population checkpoint", slog.String("language", lang.Code), slog.Any("error", err))
+			}
+
+			slog.InfoContext(ctx, "Completed language",
+				slog.String("language", lang.Name),
+				slog.Int("words_fetched", len(words)),
+				slog.Int("words_added", languageWordsAdded))
+
+			// Small delay between requests to be respectful
+			time.Sleep(100 * time.Millisecond)
+		}
+
+		if err := checkpoints.Save(ctx, naughtyWordsPopulationJob, lifecycle.CursorDone); err != nil {
+			slog.WarnContext(ctx, "Failed to save naughty words population checkpoint", slog.Any("error", err))
+		}
+
+		slog.InfoContext(ctx, "Finished populating naughty words table from all languages.",
+			slog.Int("total_words_added", totalWordsAdded),
+			slog.Int("total_words_fetched", totalWordsFetched),
+			slog.Int("languages_processed", len(coin.NaughtyWordLanguages)))
+
+		if reloadErr := coinService.LoadNaughtyWords(ctx); reloadErr != nil {
+			slog.ErrorContext(ctx, "Failed to reload naughty words in CoinService after populating table", slog.Any("error", reloadErr))
+		} else {
+			slog.InfoContext(ctx, "CoinService naughty words reloaded successfully after table population.")
+		}
+	} else {
+		slog.InfoContext(ctx, "Naughty words table is not empty, skipping population.", slog.Int64("existing_word_count", count))
+	}
+	return nil
+}
+
+// Helper function to get a pointer to an int.
+func pint(i int) *int {
+	return &i
+}
@@ -0,0 +1,104 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/cache"
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/coin"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/imageproxy"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/price"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/wallet"
+)
+
+// ReadOnly is Tooling plus the coin, price and wallet services. Coins are not
+// fetched on a schedule and prices are neither streamed nor persisted; the
+// server adds those on top.
+type ReadOnly struct {
+	*Tooling
+	Coins     *coin.Service
+	Prices    *price.Service
+	Wallets   *wallet.Service
+	CoinCache coin.CoinCache
+	// Caches are the services' caches, for reporting their hit rates
+	Caches []cache.StatsReporter
+}
+
+// NewReadOnly connects to what cfg selects and builds the read services on
+// it. It needs a database.
+func NewReadOnly(cfg ToolingConfig) (*ReadOnly, error) {
+	t, err := NewTooling(cfg)
+	if err != nil {
+		return nil, err
+	}
+	r, err := t.readOnly(readOnlyOptions{})
+	if err != nil {
+		_ = t.Close()
+		return nil, err
+	}
+	return r, nil
+}
+
+// readOnlyOptions are what the server adds to the read services. Zero values
+// build them as NewReadOnly does.
+type readOnlyOptions struct {
+	coinConfig *coin.Config // Schedules the coin jobs; nil runs none
+	store      db.Store     // Store the services use; the Tooling's when nil
+	imageProxy *imageproxy.Service
+	// wrapCoinCache and wrapPriceCache wrap the caches the services read
+	// through, e.g. to instrument them
+	wrapCoinCache  func(coin.CoinCache) coin.CoinCache
+	wrapPriceCache func(price.PriceHistoryCache) price.PriceHistoryCache
+}
+
+func (t *Tooling) readOnly(opts readOnlyOptions) (*ReadOnly, error) {
+	if t.Store == nil {
+		return nil, errors.New("the read services need a database")
+	}
+	r := &ReadOnly{Tooling: t}
+	var store db.Store = t.Store
+	if opts.store != nil {
+		store = opts.store
+	}
+
+	var err error
+	r.CoinCache, err = coin.NewCoinCache()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create coin cache: %w", err)
+	}
+	coinDetailCache := r.CoinCache
+	if opts.wrapCoinCache != nil {
+		coinDetailCache = opts.wrapCoinCache(r.CoinCache)
+	}
+	r.Coins = coin.NewService(
+		opts.coinConfig,
+		t.Jupiter,
+		store,
+		t.Solana,
+		t.BirdEye,
+		t.Tracker,
+		t.Offchain,
+		coinDetailCache,
+		opts.imageProxy, // Can be nil
+	)
+	t.Lifecycle.OnShutdown("coin-service", r.Coins.Shutdown)
+
+	priceCache, err := price.NewPriceHistoryCache()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create price cache: %w", err)
+	}
+	if opts.wrapPriceCache != nil {
+		priceCache = opts.wrapPriceCache(priceCache)
+	}
+	r.Prices = price.NewService(t.BirdEye, t.Jupiter, store, priceCache)
+	candleCache, err := price.NewCandleCache()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create candle cache: %w", err)
+	}
+	r.Prices.SetCandleCache(candleCache)
+	r.Caches = []cache.StatsReporter{r.CoinCache, priceCache, candleCache}
+
+	r.Wallets = wallet.New(t.Solana, store, r.Coins, r.Prices, r.CoinCache)
+	return r, nil
+}
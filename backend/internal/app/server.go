@@ -0,0 +1,698 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+
+	firebase "firebase.google.com/go/v4"
+
+	grpcapi "github.com/nicolas-martin/dankfolio/backend/internal/api/grpc"
+	"github.com/nicolas-martin/dankfolio/backend/internal/blocklist"
+	"github.com/nicolas-martin/dankfolio/backend/internal/cache"
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients"
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/birdeye"
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/cdn"
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/fxrates"
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/maxmind"
+	s3client "github.com/nicolas-martin/dankfolio/backend/internal/clients/s3"
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/solana"
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/db/cached"
+	"github.com/nicolas-martin/dankfolio/backend/internal/events"
+	"github.com/nicolas-martin/dankfolio/backend/internal/featureflags"
+	"github.com/nicolas-martin/dankfolio/backend/internal/geo"
+	"github.com/nicolas-martin/dankfolio/backend/internal/jobs"
+	"github.com/nicolas-martin/dankfolio/backend/internal/lifecycle"
+	"github.com/nicolas-martin/dankfolio/backend/internal/middleware"
+	bmodel "github.com/nicolas-martin/dankfolio/backend/internal/model/blockchain"
+	"github.com/nicolas-martin/dankfolio/backend/internal/secrets"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/announcement"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/appconfig"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/authority"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/burn"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/coin"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/fx"
+	imageservice "github.com/nicolas-martin/dankfolio/backend/internal/service/image"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/imageproxy"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/leaderboard"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/notification"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/payment"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/prefetch"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/price"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/retention"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/status"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/trade"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/wallet"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/webhook"
+	"github.com/nicolas-martin/dankfolio/backend/internal/signer"
+	"github.com/nicolas-martin/dankfolio/backend/internal/telemetry/trademetrics"
+)
+
+// Server is everything the API server runs: the read services with their
+// background jobs, trading, and the Connect RPC server in front of them
+type Server struct {
+	*ReadOnly
+	Trades *trade.Service
+	Jobs   *jobs.Scheduler
+	RPC    *grpcapi.Server
+
+	port int
+}
+
+// NewServer assembles the API server. Background work starts right away;
+// the RPC server starts on Run.
+func NewServer(config *Config, secretProvider *secrets.CachingProvider) (_ *Server, err error) {
+	toolingConfig, err := config.Tooling()
+	if err != nil {
+		return nil, err
+	}
+	t, err := NewTooling(toolingConfig)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			_ = t.Close()
+		}
+	}()
+	lc := t.Lifecycle
+	ctx := lc.Context()
+	var store db.Store = t.Store
+
+	// Initialize Firebase with explicit project ID to match App Check token audience
+	// The token has audiences: ["projects/7513481592181", "projects/dankfolio"]
+	// We need to configure Firebase to accept either format
+	firebaseConfig := &firebase.Config{
+		ProjectID: "dankfolio", // Use string project ID as seen in one of the token's audiences
+	}
+	firebaseApp, err := firebase.NewApp(ctx, firebaseConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize Firebase Admin SDK: %w", err)
+	}
+	slog.Info("🔥 Firebase Admin SDK initialized successfully", "projectID", firebaseConfig.ProjectID)
+
+	appCheckClient, err := firebaseApp.AppCheck(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize Firebase App Check client: %w", err)
+	}
+	slog.Info("🔒 Firebase App Check client initialized successfully")
+
+	if (config.Env == "development" || config.Env == "local" || config.Env == "production-simulator") && config.DevAppCheckToken == "" {
+		return nil, errors.New("DEV_APP_CHECK_TOKEN is not set. This is required for development/local/production-simulator app check bypass")
+	} else if config.DevAppCheckToken != "" {
+		slog.Info("DEV_APP_CHECK_TOKEN is set.", "env", config.Env)
+	}
+
+	// Warm the detail caches of the top trending coins after each refresh.
+	// Detail cache lookups are counted by prefetched or not to measure the gain.
+	var prefetcher *prefetch.Service
+	readOnlyOpts := readOnlyOptions{
+		coinConfig: &coin.Config{
+			BirdEyeBaseURL:             config.BirdEyeEndpoint,
+			BirdEyeAPIKey:              config.BirdEyeAPIKey,
+			SolanaRPCEndpoint:          config.SolanaRPCEndpoint,
+			NewCoinsFetchInterval:      config.NewCoinsFetchInterval,
+			TrendingFetchInterval:      config.TrendingCoinsFetchInterval,
+			TopGainersFetchInterval:    config.TopGainersFetchInterval,
+			MarketOverviewInterval:     config.MarketOverviewInterval,
+			ArchiveInterval:            config.CoinArchiveInterval,
+			ArchiveInactiveFor:         config.CoinArchiveInactiveFor,
+			PlaceholderRetryInterval:   config.PlaceholderRetryInterval,
+			IndexBudget:                config.CoinIndexBudget,
+			InitializeXStocksOnStartup: config.InitializeXStocksOnStartup,
+		},
+	}
+	if config.PrefetchTopN > 0 {
+		prefetcher = prefetch.NewService(prefetch.Config{TopN: config.PrefetchTopN})
+		readOnlyOpts.wrapCoinCache = func(coinCache coin.CoinCache) coin.CoinCache {
+			return prefetch.InstrumentCache(coinCache, "coin", prefetch.PrefixedKey("coin:"), prefetcher)
+		}
+		readOnlyOpts.wrapPriceCache = func(priceCache price.PriceHistoryCache) price.PriceHistoryCache {
+			return prefetch.InstrumentCache(priceCache, "price_history", prefetch.PriceHistoryKey, prefetcher)
+		}
+	}
+
+	// Initialize S3 client for image proxy (optional)
+	var s3Probe status.Probe
+	if os.Getenv("S3_ACCESS_KEY_ID") != "" {
+		s3Client, err := s3client.NewClientFromEnv()
+		if err != nil {
+			slog.Warn("Failed to initialize S3 client for image proxy", "error", err)
+		} else {
+			imageProxy := imageproxy.NewService(s3Client)
+			imageProxy.SetMaxRedirects(config.ImageMaxRedirects)
+			imageProxy.SetHashStore(store.ImageHashes())
+			imageProxy.SetPlaceholderStore(store.PlaceholderIcons())
+			if err := imageProxy.LoadHashes(ctx); err != nil {
+				// Icons are still served; only deduplication against unloaded hashes is lost
+				slog.Warn("Failed to load image hashes", "error", err)
+			}
+			if os.Getenv("CDN_PROVIDER") != "" {
+				cdnClient, err := cdn.NewClientFromEnv(t.HTTPClient("cdn"))
+				if err != nil {
+					slog.Warn("Failed to initialize CDN client, serving icons from S3", "error", err)
+				} else {
+					imageProxy.SetCDN(cdnClient)
+					slog.Info("Serving proxied icons through CDN", "provider", os.Getenv("CDN_PROVIDER"))
+				}
+			}
+			readOnlyOpts.imageProxy = imageProxy
+			s3Probe = func(ctx context.Context) error {
+				_, err := s3Client.ImageExists(ctx, "healthcheck")
+				return err
+			}
+			slog.Info("Image proxy service initialized with S3 backend")
+		}
+	} else {
+		slog.Info("S3 not configured, image proxy service disabled")
+	}
+
+	// In-process domain event bus shared by the coin and trade services.
+	// Registered before the services so they stop before it drains.
+	eventBus := events.NewMemoryBus(0)
+	lc.OnShutdown("event-bus", func(context.Context) error {
+		eventBus.Close()
+		return nil
+	})
+
+	// Point reads of the coins, settings and naughty words are cached; the
+	// bus carries invalidations for writes made outside this store
+	var repositoryCaches []cache.StatsReporter
+	if config.RepositoryCacheTTL > 0 {
+		cachedStore, err := cached.NewStore(t.Store, config.RepositoryCacheTTL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create repository caches: %w", err)
+		}
+		cachedStore.WatchInvalidations(eventBus)
+		store = cachedStore
+		readOnlyOpts.store = cachedStore
+		repositoryCaches = cachedStore.Caches()
+	}
+
+	r, err := t.readOnly(readOnlyOpts)
+	if err != nil {
+		return nil, err
+	}
+	coinService, priceService := r.Coins, r.Prices
+	slog.Info("Coin service initialized.")
+	// Runs the server's own jobs; the coin service schedules its fetchers itself
+	scheduler := jobs.NewScheduler(store)
+
+	tradeStatsCache, err := coin.NewTradeStatsCache()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create trade stats cache: %w", err)
+	}
+	if prefetcher != nil {
+		tradeStatsCache = prefetch.InstrumentCache(tradeStatsCache, "trade_stats", prefetch.PrefixedKey("trade_stats:"), prefetcher)
+	}
+	coinService.SetTradeStatsCache(tradeStatsCache)
+	coinService.SetEventBus(eventBus)
+	coinService.WatchNaughtyWordChanges(eventBus)
+
+	if config.PopulateNaughtyWords {
+		slog.Info("The POPULATE_NAUGHTY_WORDS environment variable is set. Attempting to populate naughty words table...")
+		// Kept off the startup path, since it downloads the word lists
+		goJob(lc, scheduler, jobs.Job{
+			Name: naughtyWordsPopulationJob,
+			Run: func(ctx context.Context) error {
+				return populateNaughtyWords(ctx, coinService)
+			},
+		})
+	} else {
+		slog.Info("The POPULATE_NAUGHTY_WORDS environment variable is not set. Skipping DB population of naughty words.")
+	}
+
+	if config.PriceHistoryPersist {
+		priceService.SetPersistHistory(true)
+		goJob(lc, scheduler, priceService.HistoryDownsampleJob(config.HistoryDownsampleInterval))
+	}
+	priceTimeframes, err := price.ParseTimeframes(config.PriceTimeframes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid PRICE_TIMEFRAMES: %w", err)
+	}
+	priceService.SetTimeframes(priceTimeframes)
+	if config.PriceCrossCheckThreshold > 0 {
+		priceService.SetCrossCheckThreshold(config.PriceCrossCheckThreshold)
+	}
+	livePrices := price.NewLivePrices(price.LiveConfig{PollInterval: config.LivePricePollInterval}, priceService.GetPricesBatch)
+	priceService.SetLivePrices(livePrices)
+	lc.Go("live-prices", livePrices.Run)
+	if config.BirdEyeWSEndpoint != "" {
+		birdeyeStream := birdeye.NewStream(config.BirdEyeWSEndpoint, config.BirdEyeAPIKey)
+		livePrices.SetFeed(birdeyeStream)
+		lc.Go("birdeye-stream", func(ctx context.Context) {
+			birdeyeStream.Run(ctx, livePrices)
+		})
+		slog.Info("BirdEye websocket price stream enabled.", slog.String("endpoint", config.BirdEyeWSEndpoint))
+	}
+	if prefetcher != nil {
+		prefetcher.SetSources(coinService, priceService)
+		lc.Go("trending-prefetch", func(ctx context.Context) {
+			prefetcher.Run(ctx, eventBus)
+		})
+	}
+
+	tradeMetrics, err := trademetrics.New(t.Telemetry.Meter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create trade metrics: %w", err)
+	}
+
+	platformSigner, err := newPlatformSigner(ctx, config, secretProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	tradeService := trade.NewService(
+		t.Solana,
+		coinService,
+		priceService,
+		t.Jupiter,
+		store,
+		config.PlatformFeeBps,
+		config.PlatformFeeAccountAddress,
+		platformSigner,
+		tradeMetrics,
+		false, // showDetailedBreakdown - disabled by default
+	)
+	tradeService.SetEventBus(eventBus)
+	tradeService.SetPaperStartingSOL(config.PaperStartingSOL)
+	solanaCommitment, err := bmodel.ParseCommitment(config.SolanaCommitment)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SOLANA_COMMITMENT: %w", err)
+	}
+	tradeService.SetCommitment(solanaCommitment)
+	// Receipts read confirmed swaps, and their blocks for sandwiches, off the
+	// concrete client
+	if reader, ok := t.Solana.(trade.TransactionReader); ok {
+		tradeService.SetTransactionReader(reader)
+	}
+	if config.MEVDetectionEnabled {
+		if reader, ok := t.Solana.(trade.BlockReader); ok {
+			tradeService.SetBlockReader(reader)
+		}
+	}
+	tradeService.SetComputeBudget(trade.ComputeBudgetConfig{
+		Margin:                 config.SwapComputeUnitMargin,
+		FeePercentile:          config.SwapPriorityFeePercentile,
+		MinMicroLamports:       config.SwapMinPriorityFee,
+		MaxPriorityFeeLamports: config.SwapMaxPriorityFeeLamports,
+	})
+	tradeService.SetSponsorship(trade.SponsorshipConfig{
+		Enabled:           config.SponsoredSwapsEnabled,
+		MaxSwapsPerDay:    config.SponsoredSwapsPerDay,
+		MaxLamportsPerDay: config.SponsoredLamportsPerDay,
+		MaxFeeBps:         config.SponsoredMaxFeeBps,
+	})
+	tradeService.SetTradeLimits(trade.TradeLimitsConfig{
+		Enabled:   config.TradeLimitsEnabled,
+		DailyUSD:  config.TradeLimitDailyUSD,
+		WeeklyUSD: config.TradeLimitWeeklyUSD,
+	})
+	goJob(lc, scheduler, tradeService.TwapJob())
+	if config.SecretsBackend != secrets.BackendEnv {
+		lc.Go("secrets-refresh", func(ctx context.Context) {
+			secretProvider.Watch(ctx, config.SecretsRefreshInterval)
+		})
+	}
+
+	blocklistFeeds, err := blocklist.ParseFeeds(config.BlocklistFeeds)
+	if err != nil {
+		return nil, fmt.Errorf("invalid BLOCKLIST_FEEDS: %w", err)
+	}
+	scamBlocklist := blocklist.New(store.BlockedMints(), blocklistFeeds, nil)
+	if err := scamBlocklist.Load(ctx); err != nil {
+		// Nothing is blocked until the first successful reload
+		slog.Warn("Failed to load scam blocklist", slog.Any("error", err))
+	}
+	lc.Go("blocklist-sync", func(ctx context.Context) {
+		scamBlocklist.Run(ctx, config.BlocklistSyncInterval, config.BlocklistReloadInterval)
+	})
+	coinService.SetBlocklist(scamBlocklist)
+	tradeService.SetBlocklist(scamBlocklist)
+
+	walletService := r.Wallets
+	tradeService.SetRiskChecks(trade.RiskConfig{
+		Enabled:               config.RiskChecksEnabled,
+		MaxPositionPercent:    config.RiskMaxPositionPercent,
+		MaxPriceImpactPercent: config.RiskMaxPriceImpactPercent,
+		NewCoinAge:            config.RiskNewCoinAge,
+		NewCoinMinSafetyScore: config.RiskNewCoinMinSafetyScore,
+		Blocking:              config.RiskBlockingChecks,
+	}, func(ctx context.Context, wallet string) (float64, error) {
+		totalValue, _, _, _, _, _, err := walletService.GetPortfolioPnL(ctx, wallet)
+		return totalValue, err
+	})
+	spamClassifier := wallet.NewSpamClassifier(store, coinService.ContainsNaughtyWord, config.SpamListRefreshInterval)
+	if err := spamClassifier.Load(ctx); err != nil {
+		// Heuristics still apply; admin overrides take effect on the next refresh
+		slog.Warn("Failed to load spam token list", slog.Any("error", err))
+	}
+	lc.Go("spam-list-watcher", spamClassifier.Watch)
+	if imageProxy := readOnlyOpts.imageProxy; imageProxy != nil {
+		spamClassifier.SetSimilarIcons(func(mint string) []string {
+			var mints []string
+			for _, match := range imageProxy.SimilarIcons(mint, imageproxy.ImpersonationDistance) {
+				mints = append(mints, match.Mint)
+			}
+			return mints
+		})
+	}
+	walletService.SetSpamClassifier(spamClassifier)
+	walletService.SetCommitment(solanaCommitment)
+	walletService.SetBalanceCacheTTL(config.WalletBalanceCacheTTL)
+	walletService.WatchBalanceChanges(eventBus)
+
+	fxService, err := newFXService(config, t.HTTPClient("fx"))
+	if err != nil {
+		return nil, err
+	}
+	priceService.SetFX(fxService)
+	walletService.SetFX(fxService)
+
+	imageFetcher := imageservice.NewOffchainFetcher(t.Offchain)
+	utilitySvc := grpcapi.NewService(imageFetcher, store)
+
+	grpcServer := grpcapi.NewServer(
+		coinService,
+		walletService,
+		tradeService,
+		priceService,
+		utilitySvc,
+		appCheckClient,
+		config.Env,
+		config.DevAppCheckToken,
+	)
+	// Set OpenTelemetry tracer and meter
+	grpcServer.SetOtel(t.Telemetry.Tracer, t.Telemetry.Meter)
+	grpcServer.SetAdminAPIKey(config.AdminAPIKey)
+	grpcServer.SetRateLimiter(middleware.NewRateLimiter(config.RateLimitRPS, config.RateLimitBurst))
+	grpcServer.SetReportUpstreamCalls(config.ReportUpstreamCalls)
+	requestLogger := middleware.NewRequestLogger(config.RequestLogSampleRate, config.SlowRequestThreshold)
+	grpcServer.SetRequestLogger(requestLogger)
+	appConfig := appconfig.NewService()
+	var geoPolicy *geo.Policy
+	if config.GeoPolicyEnabled {
+		var lookup geo.CountryLookup
+		if config.MaxMindAccountID != "" {
+			lookup = maxmind.NewClient(t.HTTPClient("maxmind"), "", config.MaxMindAccountID, config.MaxMindLicenseKey)
+		}
+		geoPolicy = geo.NewPolicy(geo.NewResolver(config.GeoRegionHeader, lookup),
+			grpcapi.RegionRestrictedProcedures, grpcapi.RegionExemptProcedures, store.AuditLog())
+		grpcServer.SetGeoPolicy(geoPolicy)
+	}
+	grpcServer.SetSettingsManager(setupSettings(ctx, lc, store, config, coinService, tradeService, t.Store.QueryStats(), appConfig, priceService, geoPolicy, requestLogger))
+	grpcServer.SetAppConfig(appConfig)
+	grpcServer.SetQueryStats(t.Store.QueryStats())
+	grpcServer.SetJobScheduler(scheduler)
+
+	flagEvaluator := featureflags.NewEvaluator(store.FeatureFlags(), config.FeatureFlagRefreshInterval)
+	if err := flagEvaluator.Load(ctx); err != nil {
+		// Flags evaluate as off until the watcher's next successful refresh
+		slog.Warn("Failed to load feature flags", slog.Any("error", err))
+	}
+	lc.Go("feature-flag-watcher", flagEvaluator.Watch)
+	grpcServer.SetFeatureFlags(flagEvaluator)
+	grpcServer.SetSpamClassifier(spamClassifier)
+	grpcServer.SetBlocklist(scamBlocklist)
+
+	leaderboardCache, err := leaderboard.NewCache()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create leaderboard cache: %w", err)
+	}
+	grpcServer.SetLeaderboard(leaderboard.NewService(store, coinService, leaderboardCache, leaderboard.Config{
+		Window:   config.LeaderboardWindow,
+		FreshFor: config.LeaderboardFreshFor,
+	}))
+
+	caches := append(r.Caches, tradeStatsCache, leaderboardCache)
+	caches = append(caches, repositoryCaches...)
+	if config.CacheSummaryInterval > 0 {
+		hitRatioTargets, err := cache.ParseHitRatioTargets(config.CacheHitRatioTargets)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CACHE_HIT_RATIO_TARGETS: %w", err)
+		}
+		summarizer := cache.NewSummarizer(caches, hitRatioTargets)
+		lc.Go("cache-summary", func(ctx context.Context) {
+			summarizer.Run(ctx, config.CacheSummaryInterval)
+		})
+	}
+	grpcServer.SetOperations(grpcapi.Operations{
+		Tracker:    t.Tracker,
+		Caches:     caches,
+		RPCPool:    t.RPCPool,
+		FeeAccount: config.PlatformFeeAccountAddress,
+	})
+
+	// Postgres and S3 aren't otherwise tracked, so they are judged by probes
+	dependencies := status.DefaultDependencies
+	if s3Probe == nil {
+		dependencies = slices.DeleteFunc(slices.Clone(dependencies), func(d status.Dependency) bool { return d.Name == "s3" })
+	}
+	statusMonitor := status.NewMonitor(t.Tracker, status.Config{Dependencies: dependencies})
+	statusMonitor.SetProbe("postgres", func(ctx context.Context) error {
+		db, err := t.Store.DB().DB()
+		if err != nil {
+			return err
+		}
+		return db.PingContext(ctx)
+	})
+	if s3Probe != nil {
+		statusMonitor.SetProbe("s3", s3Probe)
+	}
+	lc.Go("service-status", statusMonitor.Run)
+	grpcServer.SetStatusMonitor(statusMonitor)
+	if readOnlyOpts.imageProxy != nil {
+		grpcServer.SetImageProxy(readOnlyOpts.imageProxy)
+	}
+
+	if config.WebhooksEnabled {
+		webhookConfig := webhook.DefaultConfig()
+		webhookConfig.AllowInsecureURLs = config.Env == "development"
+		webhookService := webhook.NewService(webhookConfig, store, nil)
+		webhookService.Start(eventBus)
+		grpcServer.SetWebhookService(webhookService)
+		// Registered after the event bus so deliveries stop before the bus drains
+		lc.OnShutdown("webhooks", func(context.Context) error {
+			webhookService.Close()
+			return nil
+		})
+		slog.Info("Partner webhooks enabled.")
+	}
+
+	if config.BurnCheckInterval > 0 {
+		chain, ok := t.Solana.(burn.Chain)
+		if !ok {
+			return nil, errors.New("solana client cannot look up pools for burn checks")
+		}
+		burnChecker := burn.NewChecker(burn.Config{
+			Interval:   config.BurnCheckInterval,
+			MaxCoins:   config.BurnCheckMaxCoins,
+			Commitment: solanaCommitment,
+		}, store, chain, eventBus)
+		goJob(lc, scheduler, burnChecker.Job())
+		grpcServer.SetBurnChecker(burnChecker)
+	}
+
+	if config.AuthorityCheckInterval > 0 {
+		chain, ok := t.Solana.(authority.Chain)
+		if !ok {
+			return nil, errors.New("solana client cannot read mints for authority checks")
+		}
+		authorityWatcher := authority.NewWatcher(authority.Config{
+			Interval:   config.AuthorityCheckInterval,
+			MaxCoins:   config.AuthorityCheckMaxCoins,
+			Commitment: solanaCommitment,
+		}, store, chain, eventBus)
+		goJob(lc, scheduler, authorityWatcher.Job())
+		grpcServer.SetAuthorityWatcher(authorityWatcher)
+	}
+
+	if config.SolanaPayEnabled {
+		// Payment lookups need the concrete client's transaction history reads
+		chain, ok := t.Solana.(payment.Chain)
+		if !ok {
+			return nil, errors.New("solana client cannot look up payments")
+		}
+		grpcServer.SetPaymentService(payment.NewService(payment.Config{
+			LinkBaseURL: config.SolanaPayLinkBaseURL,
+			IconURL:     config.SolanaPayIconURL,
+			RequestTTL:  config.SolanaPayRequestTTL,
+			Commitment:  solanaCommitment,
+		}, store, chain, walletService))
+		slog.Info("Solana Pay payment requests enabled.", slog.Bool("transactionRequests", config.SolanaPayLinkBaseURL != ""))
+	}
+
+	if config.NotificationsEnabled {
+		// Transfer notifications need the concrete client's transaction reads
+		chain, ok := t.Solana.(notification.Chain)
+		if !ok {
+			return nil, errors.New("solana client cannot read transactions for notifications")
+		}
+		messagingClient, err := firebaseApp.Messaging(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize Firebase Cloud Messaging client: %w", err)
+		}
+		notificationService := notification.NewService(store, notification.NewFCMSender(messagingClient))
+		grpcServer.SetNotificationService(notificationService)
+
+		wsEndpoint := config.SolanaWSEndpoint
+		if wsEndpoint == "" {
+			wsEndpoint = websocketEndpoint(config.SolanaRPCEndpoint)
+		}
+		wsHeader := http.Header{}
+		wsHeader.Set("Authorization", "Bearer "+config.SolanaRPCAPIKey)
+		subscriber := solana.NewSubscriber(wsEndpoint, wsHeader, solanaCommitment)
+		activityStream := notification.NewActivityStream(notification.ActivityConfig{
+			Commitment: solanaCommitment,
+		}, notificationService, subscriber, chain, priceService)
+		lc.Go("notification-activity", func(ctx context.Context) {
+			activityStream.Run(ctx, eventBus)
+		})
+		dumpWatcher := notification.NewDumpWatcher(notification.DumpConfig{
+			Interval: config.NotificationDumpInterval,
+		}, notificationService, walletService, priceService)
+		goJob(lc, scheduler, dumpWatcher.Job())
+		slog.Info("Push notifications enabled.", slog.String("wsEndpoint", wsEndpoint))
+	}
+
+	grpcServer.SetAnnouncementService(announcement.NewService(store))
+
+	retentionService := retention.NewService(store, config.SoftDeleteRetention)
+	grpcServer.SetRetentionService(retentionService)
+	if config.SoftDeletePurgeInterval > 0 {
+		goJob(lc, scheduler, retentionService.PurgeJob(config.SoftDeletePurgeInterval))
+	}
+	if config.DataErasureInterval > 0 {
+		goJob(lc, scheduler, retentionService.ErasureJob(config.DataErasureInterval))
+	}
+
+	// Registered last so in-flight requests drain before the services they call shut down
+	lc.OnShutdown("grpc-server", grpcServer.Shutdown)
+
+	return &Server{ReadOnly: r, Trades: tradeService, Jobs: scheduler, RPC: grpcServer, port: config.GRPCPort}, nil
+}
+
+// Run serves RPCs until the process is signalled to stop or the server
+// fails, then shuts everything down
+func (s *Server) Run() error {
+	serveErr := make(chan error, 1)
+	go func() {
+		slog.Info("Starting gRPC server", slog.Int("port", s.port))
+		serveErr <- s.RPC.Start(s.port)
+	}()
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	var err error
+	select {
+	case sig := <-quit:
+		slog.Info("Received shutdown signal", slog.String("signal", sig.String()))
+	case err = <-serveErr:
+		slog.Error("gRPC server error", slog.Any("error", err))
+	}
+
+	if shutdownErr := s.Close(); shutdownErr != nil {
+		slog.Error("Shutdown completed with errors", slog.Any("error", shutdownErr))
+		err = errors.Join(err, shutdownErr)
+	}
+	slog.Info("Server shutdown completed.")
+	return err
+}
+
+// goJob runs job on the scheduler in a goroutine tracked by lc
+func goJob(lc *lifecycle.Manager, scheduler *jobs.Scheduler, job jobs.Job) {
+	lc.Go(job.Name, func(ctx context.Context) {
+		scheduler.Run(ctx, job)
+	})
+}
+
+const (
+	fxProviderECB               = "ecb"
+	fxProviderOpenExchangeRates = "openexchangerates"
+	fxProviderNone              = "none"
+)
+
+// newFXService returns the exchange rate service for display currencies, or
+// nil when conversion is disabled and only USD can be displayed
+func newFXService(config *Config, httpClient clients.HTTPDoer) (*fx.Service, error) {
+	switch config.FXProvider {
+	case fxProviderECB:
+		return fx.NewService(fxrates.NewECBClient(httpClient, ""), config.FXRatesTTL), nil
+	case fxProviderOpenExchangeRates:
+		if config.OpenExchangeRatesAppID == "" {
+			return nil, errors.New("OPENEXCHANGERATES_APP_ID is required with FX_PROVIDER=openexchangerates")
+		}
+		return fx.NewService(fxrates.NewOpenExchangeRatesClient(httpClient, "", config.OpenExchangeRatesAppID), config.FXRatesTTL), nil
+	case fxProviderNone:
+		slog.Info("Display currency conversion disabled; prices are shown in USD only")
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unknown FX provider %q", config.FXProvider)
+	}
+}
+
+const (
+	platformSignerLocal  = "local"
+	platformSignerRemote = "remote"
+)
+
+// newPlatformSigner returns the signer for platform transactions, or nil when
+// no key is configured. The local signer follows key rotations.
+func newPlatformSigner(ctx context.Context, config *Config, secretProvider *secrets.CachingProvider) (signer.Signer, error) {
+	switch config.PlatformSigner {
+	case platformSignerRemote:
+		remote, err := signer.NewRemoteSigner(ctx, &http.Client{Timeout: 10 * time.Second}, config.PlatformSignerURL, config.PlatformSignerToken)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to platform signer at %s: %w", config.PlatformSignerURL, err)
+		}
+		slog.Info("Using remote platform signer", slog.String("publicKey", remote.PublicKey().String()))
+		return remote, nil
+	case platformSignerLocal:
+		if config.PlatformPrivateKey == "" {
+			slog.Warn("No platform private key configured; platform ATA creation is disabled")
+			return nil, nil
+		}
+		key, err := signer.ParsePrivateKey(config.PlatformPrivateKey)
+		if err != nil {
+			slog.Error("Failed to parse platform private key", slog.Any("error", err))
+			return nil, nil
+		}
+		if config.Env == "production" {
+			slog.Warn("Platform private key is loaded in the API process; use PLATFORM_SIGNER=remote in production")
+		}
+		local := signer.NewLocalSigner(key)
+		secretProvider.OnRotate(config.PlatformPrivateKeySecret, func(value string) {
+			rotated, err := signer.ParsePrivateKey(value)
+			if err != nil {
+				slog.Error("Rotated platform private key is invalid; keeping the previous key", slog.Any("error", err))
+				return
+			}
+			local.SetPrivateKey(rotated)
+			slog.Info("Platform private key rotated", slog.String("publicKey", rotated.PublicKey().String()))
+		})
+		return local, nil
+	default:
+		return nil, fmt.Errorf("unknown platform signer %q", config.PlatformSigner)
+	}
+}
+
+// websocketEndpoint returns the websocket URL of an RPC endpoint, which
+// providers serve on the same host and path
+func websocketEndpoint(rpcEndpoint string) string {
+	if rest, ok := strings.CutPrefix(rpcEndpoint, "https://"); ok {
+		return "wss://" + rest
+	}
+	if rest, ok := strings.CutPrefix(rpcEndpoint, "http://"); ok {
+		return "ws://" + rest
+	}
+	return rpcEndpoint
+}
@@ -0,0 +1,196 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/db/postgres"
+	"github.com/nicolas-martin/dankfolio/backend/internal/geo"
+	"github.com/nicolas-martin/dankfolio/backend/internal/lifecycle"
+	"github.com/nicolas-martin/dankfolio/backend/internal/middleware"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/appconfig"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/coin"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/price"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/trade"
+	"github.com/nicolas-martin/dankfolio/backend/internal/settings"
+	"github.com/nicolas-martin/dankfolio/backend/internal/util"
+)
+
+// Runtime settings. Defaults come from env config; overrides live in the settings table.
+var (
+	settingNewCoinsFetchInterval = settings.DurationKey("coin.fetch_interval.new",
+		"How often newly listed coins are fetched").WithValidation(minDuration(time.Minute))
+	settingTrendingFetchInterval = settings.DurationKey("coin.fetch_interval.trending",
+		"How often trending coins are fetched").WithValidation(minDuration(time.Minute))
+	settingTopGainersFetchInterval = settings.DurationKey("coin.fetch_interval.top_gainers",
+		"How often top gainers are fetched").WithValidation(minDuration(time.Minute))
+	settingMarketOverviewInterval = settings.DurationKey("coin.market_overview_interval",
+		"How often the home screen market overview is recomputed").WithValidation(minDuration(time.Minute))
+	settingIPFSFallbackGateways = settings.StringListKey("coin.ipfs_fallback_gateways",
+		"IPFS gateways tried, in order, when a logo download fails").WithValidation(validGateways)
+	settingPlatformFeeBps = settings.IntKey("trade.platform_fee_bps",
+		"Platform fee in basis points, e.g. 100 = 1%").WithValidation(bpsRange)
+	settingShowDetailedBreakdown = settings.BoolKey("trade.show_detailed_breakdown",
+		"Include the detailed fee breakdown in trade quotes")
+	settingQuoteTTL = settings.DurationKey("trade.quote_ttl",
+		"How long a prepared swap can be submitted before it must be re-quoted").WithValidation(minDuration(10 * time.Second))
+	settingSlowQueryThreshold = settings.DurationKey("db.slow_query_threshold",
+		"Queries running longer than this are logged and listed by ListSlowQueries; 0 disables").WithValidation(minDuration(0))
+	settingMinimumAppVersion = settings.StringKey("app.minimum_version",
+		"Oldest app version still supported; older apps are asked to update. Empty supports every version").WithValidation(validAppVersion)
+	settingDisabledFeatures = settings.StringListKey("app.disabled_features",
+		"Features switched off in the app: trading, transfers, payments, search").WithValidation(knownFeatures)
+	settingDisabledMessage = settings.StringKey("app.disabled_message",
+		"Banner shown in the app while any feature is disabled")
+	settingMaintenance = settings.BoolKey("app.maintenance",
+		"Reject trades and transfers with a MAINTENANCE error while read-only endpoints keep serving")
+	settingMaintenanceMessage = settings.StringKey("app.maintenance_message",
+		"Message shown to users in maintenance mode; empty for a generic message")
+	settingClientRPCEndpoint = settings.StringKey("app.rpc_endpoint",
+		"Solana RPC endpoint the app uses; empty keeps the app's built-in endpoint").WithValidation(httpsURL)
+	settingDefaultSlippageBps = settings.IntKey("app.default_slippage_bps",
+		"Slippage preselected for swaps in the app, in basis points").WithValidation(bpsRange)
+	settingPriceTimeframes = settings.StringListKey("price.timeframes",
+		"Chart timeframe overrides as TYPE=granularity/view/rounding, e.g. ONE_HOUR=1m/1h/2m").WithValidation(validTimeframes)
+	settingRequestLogSampleRates = settings.StringListKey("log.request_sample_rates",
+		"Share of successful API requests logged as PROCEDURE=rate, e.g. /dankfolio.v1.PriceService/=0.1; * sets the default").WithValidation(validSampleRates)
+	settingGeoRegionRules = settings.StringListKey("geo.region_rules",
+		"Region access rules as REGION=allow|read_only|block, e.g. US=read_only; \"unknown\" applies to unresolved regions").WithValidation(validRegionRules)
+)
+
+// setupSettings defines the runtime settings, loads overrides, subscribes the
+// services to changes and starts watching the settings table.
+func setupSettings(ctx context.Context, lc *lifecycle.Manager, store db.Store, config *Config, coinService *coin.Service, tradeService *trade.Service, queryStats *postgres.QueryStats, appConfig *appconfig.Service, priceService *price.Service, geoPolicy *geo.Policy, requestLogger *middleware.RequestLogger) *settings.Manager {
+	manager := settings.NewManager(store.Settings(), config.SettingsPollInterval)
+
+	settings.Define(manager, settingNewCoinsFetchInterval, config.NewCoinsFetchInterval)
+	settings.Define(manager, settingTrendingFetchInterval, config.TrendingCoinsFetchInterval)
+	settings.Define(manager, settingTopGainersFetchInterval, config.TopGainersFetchInterval)
+	settings.Define(manager, settingMarketOverviewInterval, config.MarketOverviewInterval)
+	settings.Define(manager, settingIPFSFallbackGateways, coin.DefaultIPFSFallbackGateways)
+	settings.Define(manager, settingPlatformFeeBps, config.PlatformFeeBps)
+	settings.Define(manager, settingShowDetailedBreakdown, false)
+	settings.Define(manager, settingQuoteTTL, config.QuoteTTL)
+	settings.Define(manager, settingSlowQueryThreshold, config.SlowQueryThreshold)
+	settings.Define(manager, settingMinimumAppVersion, config.MinimumAppVersion)
+	settings.Define(manager, settingDisabledFeatures, nil)
+	settings.Define(manager, settingDisabledMessage, "")
+	settings.Define(manager, settingMaintenance, config.MaintenanceMode)
+	settings.Define(manager, settingMaintenanceMessage, "")
+	settings.Define(manager, settingClientRPCEndpoint, config.ClientRPCEndpoint)
+	settings.Define(manager, settingDefaultSlippageBps, appconfig.DefaultSlippageBps)
+	settings.Define(manager, settingPriceTimeframes, config.PriceTimeframes)
+	settings.Define(manager, settingGeoRegionRules, config.GeoRegionRules)
+	settings.Define(manager, settingRequestLogSampleRates, nil)
+
+	// A failed initial load is not fatal; services keep their env defaults until the watcher succeeds
+	if err := manager.Load(ctx); err != nil {
+		slog.Warn("Failed to load settings overrides, using defaults", slog.Any("error", err))
+	}
+
+	settings.Subscribe(manager, settingNewCoinsFetchInterval, func(d time.Duration) {
+		coinService.SetFetchInterval(coin.JobNewCoinsFetch, d)
+	})
+	settings.Subscribe(manager, settingTrendingFetchInterval, func(d time.Duration) {
+		coinService.SetFetchInterval(coin.JobTrendingFetch, d)
+	})
+	settings.Subscribe(manager, settingTopGainersFetchInterval, func(d time.Duration) {
+		coinService.SetFetchInterval(coin.JobTopGainersFetch, d)
+	})
+	settings.Subscribe(manager, settingMarketOverviewInterval, func(d time.Duration) {
+		coinService.SetFetchInterval(coin.JobMarketOverview, d)
+	})
+	settings.Subscribe(manager, settingIPFSFallbackGateways, coinService.SetIPFSFallbackGateways)
+	settings.Subscribe(manager, settingPlatformFeeBps, tradeService.SetPlatformFeeBps)
+	settings.Subscribe(manager, settingShowDetailedBreakdown, tradeService.SetShowDetailedBreakdown)
+	settings.Subscribe(manager, settingQuoteTTL, tradeService.SetQuoteTTL)
+	settings.Subscribe(manager, settingSlowQueryThreshold, queryStats.SetThreshold)
+	settings.Subscribe(manager, settingMinimumAppVersion, appConfig.SetMinimumAppVersion)
+	settings.Subscribe(manager, settingDisabledFeatures, appConfig.SetDisabledFeatures)
+	settings.Subscribe(manager, settingDisabledMessage, appConfig.SetDisabledMessage)
+	settings.Subscribe(manager, settingMaintenance, appConfig.SetMaintenance)
+	settings.Subscribe(manager, settingMaintenanceMessage, appConfig.SetMaintenanceMessage)
+	settings.Subscribe(manager, settingClientRPCEndpoint, appConfig.SetClientRPCEndpoint)
+	settings.Subscribe(manager, settingDefaultSlippageBps, appConfig.SetDefaultSlippageBps)
+	settings.Subscribe(manager, settingPriceTimeframes, func(entries []string) {
+		// Validated when set, so parsing cannot fail here
+		timeframes, _ := price.ParseTimeframes(entries)
+		priceService.SetTimeframes(timeframes)
+	})
+	settings.Subscribe(manager, settingRequestLogSampleRates, requestLogger.SetSampleRates)
+	if geoPolicy != nil {
+		settings.Subscribe(manager, settingGeoRegionRules, geoPolicy.SetRules)
+	}
+
+	lc.Go("settings-watcher", manager.Watch)
+	return manager
+}
+
+func minDuration(minimum time.Duration) func(time.Duration) error {
+	return func(d time.Duration) error {
+		if d < minimum {
+			return fmt.Errorf("must be at least %s", minimum)
+		}
+		return nil
+	}
+}
+
+func bpsRange(bps int) error {
+	if bps < 0 || bps > 10000 {
+		return errors.New("must be between 0 and 10000")
+	}
+	return nil
+}
+
+func validGateways(gateways []string) error {
+	for _, gateway := range gateways {
+		if !strings.HasPrefix(gateway, "https://") || !strings.HasSuffix(gateway, "/") {
+			return fmt.Errorf("gateway %q must be an https URL ending in /", gateway)
+		}
+	}
+	return nil
+}
+
+func validAppVersion(version string) error {
+	if version != "" && !util.IsValidVersion(version) {
+		return fmt.Errorf("%q is not a version like 1.4.2", version)
+	}
+	return nil
+}
+
+func knownFeatures(features []string) error {
+	for _, feature := range features {
+		if !slices.Contains(appconfig.Features, feature) {
+			return fmt.Errorf("unknown feature %q, expected one of %s", feature, strings.Join(appconfig.Features, ", "))
+		}
+	}
+	return nil
+}
+
+func httpsURL(endpoint string) error {
+	if endpoint != "" && !strings.HasPrefix(endpoint, "https://") {
+		return fmt.Errorf("%q must be an https URL", endpoint)
+	}
+	return nil
+}
+
+func validTimeframes(entries []string) error {
+	_, err := price.ParseTimeframes(entries)
+	return err
+}
+
+func validRegionRules(entries []string) error {
+	_, err := geo.ParseRules(entries)
+	return err
+}
+
+func validSampleRates(entries []string) error {
+	_, err := middleware.ParseSampleRates(entries)
+	return err
+}
@@ -0,0 +1,187 @@
+// Package app assembles the backend for its binaries. Each profile builds on
+// the previous one:
+//
+//   - Tooling: the store, telemetry and external clients, for command-line tools
+//   - ReadOnly: Tooling plus the coin, price and wallet services, without
+//     background jobs or anything that signs or submits transactions
+//   - Server: everything the API server runs
+package app
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/gagliardetto/solana-go/rpc"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients"
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/birdeye"
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/jupiter"
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/offchain"
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/solana"
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/tracker"
+	"github.com/nicolas-martin/dankfolio/backend/internal/db/postgres"
+	"github.com/nicolas-martin/dankfolio/backend/internal/lifecycle"
+	"github.com/nicolas-martin/dankfolio/backend/internal/telemetry/otel"
+)
+
+// ToolingConfig selects what NewTooling connects to. A client whose endpoint
+// is empty is left nil, so a tool only configures what it uses.
+type ToolingConfig struct {
+	ServiceName string // Names the telemetry the tool reports
+	Env         string
+
+	DBURL       string // Empty leaves Store nil
+	AutoMigrate bool
+
+	OTLPEndpoint string        // Empty uses no-op telemetry
+	DrainTimeout time.Duration // Bounds Close; 0 uses lifecycle.DefaultDrainTimeout
+
+	// SolanaRPCEndpoints are the RPC nodes, the first being the primary and
+	// the rest failovers. None leaves Solana nil.
+	SolanaRPCEndpoints []solana.Endpoint
+	SolanaRPCPool      solana.PoolConfig
+	BirdEyeEndpoint    string
+	BirdEyeAPIKey      string
+	JupiterAPIURL      string
+	JupiterAPIKey      string
+	Offchain           bool // Whether to create the offchain metadata client
+	OffchainHosts      offchain.Config
+	Replay             clients.ReplayConfig
+	// HTTPTransport is every API client's connection settings, and
+	// HTTPUpstreams an upstream's own by client name, e.g. "birdeye"
+	HTTPTransport clients.TransportConfig
+	HTTPUpstreams map[string]clients.TransportConfig
+	DNSCache      clients.DNSConfig // Shared by the API clients' connections
+}
+
+// Tooling is the store, telemetry and external clients every profile shares.
+// Its lifecycle manager owns the root context and closes everything on Close.
+type Tooling struct {
+	Lifecycle *lifecycle.Manager
+	Store     *postgres.Store
+	Telemetry *otel.Telemetry
+	Tracker   *tracker.APITracker
+	Solana    clients.GenericClientAPI
+	RPCPool   *solana.Pool // The endpoints behind Solana
+	BirdEye   birdeye.ClientAPI
+	Jupiter   jupiter.ClientAPI
+	Offchain  offchain.ClientAPI
+
+	HTTPClients *clients.ClientFactory // Builds each upstream's client on its own connections
+
+	replay clients.WrapOption
+}
+
+// NewTooling connects to what cfg selects
+func NewTooling(cfg ToolingConfig) (_ *Tooling, err error) {
+	lc := lifecycle.NewManager(cfg.DrainTimeout)
+	t := &Tooling{Lifecycle: lc}
+	defer func() {
+		if err != nil {
+			_ = t.Close()
+		}
+	}()
+
+	if cfg.DBURL != "" {
+		t.Store, err = postgres.NewStore(cfg.DBURL, cfg.AutoMigrate, logLevel(cfg.Env), cfg.Env)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to database: %w", err)
+		}
+		slog.Info("Database store initialized successfully.")
+		lc.OnShutdown("database", func(context.Context) error { return t.Store.Close() })
+	}
+
+	if cfg.OTLPEndpoint == "" {
+		t.Telemetry = otel.NewNoOpTelemetry(cfg.ServiceName)
+	} else {
+		t.Telemetry, err = otel.InitTelemetry(lc.Context(), otel.Config{
+			ServiceName:    cfg.ServiceName,
+			ServiceVersion: "1.0.0",
+			Environment:    cfg.Env,
+			OTLPEndpoint:   cfg.OTLPEndpoint,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize OpenTelemetry: %w", err)
+		}
+		slog.Info("OpenTelemetry initialized successfully", slog.String("endpoint", cfg.OTLPEndpoint))
+	}
+	// Telemetry is flushed after everything registered later has stopped
+	lc.OnShutdown("opentelemetry", t.Telemetry.Shutdown)
+
+	t.Tracker, err = tracker.NewAPITracker(t.Telemetry)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create API tracker: %w", err)
+	}
+
+	t.HTTPClients = clients.NewClientFactory(cfg.HTTPTransport, upstreamTransports(cfg))
+	if !cfg.DNSCache.Disabled {
+		t.HTTPClients.SetResolver(clients.NewCachingResolver(cfg.DNSCache))
+	}
+	lc.OnShutdown("http clients", func(context.Context) error {
+		t.HTTPClients.CloseIdleConnections()
+		return nil
+	})
+	t.replay = clients.WithReplay(cfg.Replay)
+	if cfg.Replay.Mode != clients.ReplayOff {
+		slog.Info("HTTP record/replay enabled", slog.String("mode", string(cfg.Replay.Mode)), slog.String("dir", cfg.Replay.Dir))
+	}
+
+	if cfg.JupiterAPIURL != "" {
+		t.Jupiter = jupiter.NewClient(t.HTTPClient("jupiter"), cfg.JupiterAPIURL, cfg.JupiterAPIKey)
+	}
+	if cfg.BirdEyeEndpoint != "" {
+		t.BirdEye = birdeye.NewClient(t.HTTPClient("birdeye"), cfg.BirdEyeEndpoint, cfg.BirdEyeAPIKey)
+	}
+	if cfg.Offchain {
+		t.Offchain = offchain.NewClient(t.HTTPClient("offchain"), cfg.OffchainHosts)
+	}
+
+	if len(cfg.SolanaRPCEndpoints) > 0 {
+		t.RPCPool, err = solana.NewPool(cfg.SolanaRPCEndpoints, t.HTTPClients.Client("solana"), t.Tracker, cfg.SolanaRPCPool)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create Solana RPC pool: %w", err)
+		}
+		if len(cfg.SolanaRPCEndpoints) > 1 {
+			slog.Info("Solana RPC failover enabled", slog.Int("fallbacks", len(cfg.SolanaRPCEndpoints)-1), slog.Bool("latencyRouting", cfg.SolanaRPCPool.LatencyRouting))
+			lc.Go("solana-rpc-health", t.RPCPool.Run)
+		}
+		t.Solana = solana.NewClient(rpc.NewWithCustomRPCClient(t.RPCPool), t.Tracker)
+	}
+
+	return t, nil
+}
+
+// HTTPClient returns an HTTP client for calls to the named external service,
+// tracked and subject to record/replay like the built-in clients
+func (t *Tooling) HTTPClient(service string) clients.HTTPDoer {
+	return clients.WrapHTTPClient(t.HTTPClients.Client(service), service, t.Tracker, t.replay)
+}
+
+// upstreamTransports returns each upstream's connection settings: the built-in
+// tuning below, replaced by whatever cfg sets for the upstream
+func upstreamTransports(cfg ToolingConfig) map[string]clients.TransportConfig {
+	offchainPerHost := cmp.Or(cfg.OffchainHosts.MaxPerHost, offchain.DefaultMaxPerHost)
+	upstreams := map[string]clients.TransportConfig{
+		// Operations like GetTokenAccountsByOwner can be resource-intensive,
+		// so RPC calls get a longer timeout
+		"solana": {Timeout: 30 * time.Second},
+		// Price and coin fan-outs burst at BirdEye; idle connections are kept
+		// for the whole burst rather than redialed
+		"birdeye": {MaxIdleConnsPerHost: 64},
+		"jupiter": {MaxIdleConnsPerHost: 32},
+		// Offchain fetches hit arbitrary hosts, each limited by the client
+		"offchain": {MaxConnsPerHost: offchainPerHost, MaxIdleConnsPerHost: offchainPerHost},
+	}
+	for name, override := range cfg.HTTPUpstreams {
+		upstreams[name] = upstreams[name].Merge(override)
+	}
+	return upstreams
+}
+
+// Close stops background work and closes the store and telemetry
+func (t *Tooling) Close() error {
+	return t.Lifecycle.Shutdown()
+}
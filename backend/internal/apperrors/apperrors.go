@@ -0,0 +1,210 @@
+// Package apperrors defines the errors clients can branch on. Each Error has
+// a Kind that maps to a gRPC code and is sent to clients as the Reason of an
+// errdetails.ErrorInfo, so the app can tell "no route" from "slippage
+// exceeded" without parsing messages.
+//
+// Services return the sentinels below, usually with a cause attached:
+//
+//	return nil, apperrors.ErrNoRoute.Wrap(err)
+//
+// and callers test for them with errors.Is.
+package apperrors
+
+import (
+	"errors"
+	"maps"
+	"net/http"
+	"strconv"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+// Kind identifies a class of failure
+type Kind string
+
+const (
+	KindInvalidAddress      Kind = "INVALID_ADDRESS"
+	KindInsufficientBalance Kind = "INSUFFICIENT_BALANCE"
+	KindNoRoute             Kind = "NO_ROUTE"
+	KindTokenNotTradable    Kind = "TOKEN_NOT_TRADABLE"
+	KindSlippageExceeded    Kind = "SLIPPAGE_EXCEEDED"
+	KindTokenBlocked        Kind = "TOKEN_BLOCKED"
+	KindQuoteExpired        Kind = "QUOTE_EXPIRED"
+	KindQuoteNotFound       Kind = "QUOTE_NOT_FOUND"
+	KindQuoteNotRefreshable Kind = "QUOTE_NOT_REFRESHABLE"
+	KindTransactionExpired  Kind = "TRANSACTION_EXPIRED"
+	KindInvalidSignature    Kind = "INVALID_SIGNATURE"
+	KindCoinNotFound        Kind = "COIN_NOT_FOUND"
+	KindTradeNotFound       Kind = "TRADE_NOT_FOUND"
+	KindTradeNotComplete    Kind = "TRADE_NOT_COMPLETE"
+	KindUpstreamRateLimited Kind = "UPSTREAM_RATE_LIMITED"
+	KindUpstreamUnavailable Kind = "UPSTREAM_UNAVAILABLE"
+	KindUnsupportedCurrency Kind = "UNSUPPORTED_CURRENCY"
+	KindMaintenance         Kind = "MAINTENANCE"
+	KindSponsorshipDenied   Kind = "SPONSORSHIP_DENIED"
+	KindRiskNotAcknowledged Kind = "RISK_NOT_ACKNOWLEDGED"
+	KindTradeLimitExceeded  Kind = "TRADE_LIMIT_EXCEEDED"
+	KindRegionRestricted    Kind = "REGION_RESTRICTED"
+)
+
+// Domain is the ErrorInfo domain for every error in this package
+const Domain = "dankfolio.app"
+
+var codes = map[Kind]connect.Code{
+	KindInvalidAddress:      connect.CodeInvalidArgument,
+	KindInsufficientBalance: connect.CodeFailedPrecondition,
+	KindNoRoute:             connect.CodeFailedPrecondition,
+	KindTokenNotTradable:    connect.CodeInvalidArgument,
+	KindSlippageExceeded:    connect.CodeAborted,
+	KindTokenBlocked:        connect.CodeFailedPrecondition,
+	KindQuoteExpired:        connect.CodeFailedPrecondition,
+	KindQuoteNotFound:       connect.CodeNotFound,
+	KindQuoteNotRefreshable: connect.CodeFailedPrecondition,
+	KindTransactionExpired:  connect.CodeFailedPrecondition,
+	KindInvalidSignature:    connect.CodeInvalidArgument,
+	KindCoinNotFound:        connect.CodeNotFound,
+	KindTradeNotFound:       connect.CodeNotFound,
+	KindTradeNotComplete:    connect.CodeFailedPrecondition,
+	KindUpstreamRateLimited: connect.CodeUnavailable,
+	KindUpstreamUnavailable: connect.CodeUnavailable,
+	KindUnsupportedCurrency: connect.CodeInvalidArgument,
+	KindMaintenance:         connect.CodeUnavailable,
+	KindSponsorshipDenied:   connect.CodeFailedPrecondition,
+	KindRiskNotAcknowledged: connect.CodeFailedPrecondition,
+	KindTradeLimitExceeded:  connect.CodeResourceExhausted,
+	KindRegionRestricted:    connect.CodePermissionDenied,
+}
+
+// Code returns the gRPC code errors of kind are reported with
+func (k Kind) Code() connect.Code {
+	if code, ok := codes[k]; ok {
+		return code
+	}
+	return connect.CodeInternal
+}
+
+// Sentinel errors. Use Wrap and With to attach a cause and metadata; the
+// results still match the sentinel under errors.Is.
+var (
+	ErrInvalidAddress      = New(KindInvalidAddress, "invalid address")
+	ErrInsufficientBalance = New(KindInsufficientBalance, "insufficient balance")
+	ErrNoRoute             = New(KindNoRoute, "no swap route found for this pair")
+	ErrTokenNotTradable    = New(KindTokenNotTradable, "this token is not available for trading. It may be a new token that hasn't been approved for trading yet, or it might have trading restrictions")
+	ErrSlippageExceeded    = New(KindSlippageExceeded, "price moved beyond the slippage tolerance")
+	ErrTokenBlocked        = New(KindTokenBlocked, "token is on the scam blocklist")
+	ErrQuoteExpired        = New(KindQuoteExpired, "quote expired")
+	ErrQuoteNotFound       = New(KindQuoteNotFound, "quote not found")
+	ErrQuoteNotRefreshable = New(KindQuoteNotRefreshable, "quote can no longer be refreshed")
+	ErrTransactionExpired  = New(KindTransactionExpired, "transaction expired, please retry")
+	ErrInvalidSignature    = New(KindInvalidSignature, "invalid transaction signature")
+	ErrCoinNotFound        = New(KindCoinNotFound, "coin not found")
+	ErrTradeNotFound       = New(KindTradeNotFound, "trade not found")
+	ErrTradeNotComplete    = New(KindTradeNotComplete, "trade has not completed yet")
+	ErrUpstreamRateLimited = New(KindUpstreamRateLimited, "an upstream provider is rate limiting requests, please retry shortly")
+	ErrUpstreamUnavailable = New(KindUpstreamUnavailable, "an upstream provider is temporarily unavailable, please retry")
+	ErrUnsupportedCurrency = New(KindUnsupportedCurrency, "display currency is not supported")
+	ErrMaintenance         = New(KindMaintenance, "trading and transfers are paused for maintenance, please try again shortly")
+	ErrSponsorshipDenied   = New(KindSponsorshipDenied, "this swap can't be sponsored, add SOL to pay its network fees")
+	ErrRiskNotAcknowledged = New(KindRiskNotAcknowledged, "this swap is risky and needs your confirmation")
+	ErrTradeLimitExceeded  = New(KindTradeLimitExceeded, "this swap would exceed your trading limit")
+	ErrRegionRestricted    = New(KindRegionRestricted, "this feature isn't available in your region")
+)
+
+// Error is a classified error. Message is safe to show users; Cause is only
+// logged.
+type Error struct {
+	Kind     Kind
+	Message  string
+	Metadata map[string]string
+	// RetryAfter, when set, is sent to clients as errdetails.RetryInfo
+	RetryAfter time.Duration
+	Cause      error
+}
+
+// New returns an error of kind with a user-facing message
+func New(kind Kind, message string) *Error {
+	return &Error{Kind: kind, Message: message}
+}
+
+func (e *Error) Error() string {
+	if e.Cause != nil {
+		return e.Message + ": " + e.Cause.Error()
+	}
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Cause
+}
+
+// Is matches any Error of the same kind, so wrapped copies of a sentinel
+// still satisfy errors.Is(err, sentinel)
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Kind == e.Kind
+}
+
+// Wrap returns a copy of e caused by cause
+func (e *Error) Wrap(cause error) *Error {
+	c := e.clone()
+	c.Cause = cause
+	return c
+}
+
+// WithMessage returns a copy of e with a more specific user-facing message
+func (e *Error) WithMessage(message string) *Error {
+	c := e.clone()
+	c.Message = message
+	return c
+}
+
+// With returns a copy of e with metadata key set, e.g. the mint that was blocked
+func (e *Error) With(key, value string) *Error {
+	c := e.clone()
+	c.Metadata = maps.Clone(c.Metadata)
+	if c.Metadata == nil {
+		c.Metadata = make(map[string]string)
+	}
+	c.Metadata[key] = value
+	return c
+}
+
+// WithRetryAfter returns a copy of e telling clients when to retry
+func (e *Error) WithRetryAfter(d time.Duration) *Error {
+	c := e.clone()
+	c.RetryAfter = d
+	return c
+}
+
+func (e *Error) clone() *Error {
+	c := *e
+	return &c
+}
+
+// KindOf returns the kind of the first Error in err's chain
+func KindOf(err error) (Kind, bool) {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Kind, true
+	}
+	return "", false
+}
+
+// FromHTTPResponse classifies a failed upstream response as rate limited or
+// unavailable. Other statuses say nothing the app can act on, so cause is
+// returned as is.
+func FromHTTPResponse(resp *http.Response, cause error) error {
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		rateLimited := ErrUpstreamRateLimited.Wrap(cause)
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds > 0 {
+			rateLimited.RetryAfter = time.Duration(seconds) * time.Second
+		}
+		return rateLimited
+	case resp.StatusCode >= http.StatusInternalServerError:
+		return ErrUpstreamUnavailable.Wrap(cause)
+	default:
+		return cause
+	}
+}
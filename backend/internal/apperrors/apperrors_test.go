@@ -0,0 +1,66 @@
+package apperrors
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func TestWrappedSentinelsMatch(t *testing.T) {
+	cause := errors.New("simulation failed: custom program error: 0x1771")
+	err := fmt.Errorf("failed to execute trade: %w", ErrSlippageExceeded.With("mint", "abc").Wrap(cause))
+
+	assert.ErrorIs(t, err, ErrSlippageExceeded)
+	assert.ErrorIs(t, err, cause)
+	assert.NotErrorIs(t, err, ErrNoRoute)
+
+	kind, ok := KindOf(err)
+	require.True(t, ok)
+	assert.Equal(t, KindSlippageExceeded, kind)
+
+	// Copies must not share metadata with the sentinel
+	assert.Empty(t, ErrSlippageExceeded.Metadata)
+}
+
+func TestToConnect(t *testing.T) {
+	err := fmt.Errorf("prepare swap: %w", ErrTokenBlocked.With("mint", "scam").Wrap(errors.New("internal detail")))
+
+	connectErr, ok := ToConnect(err)
+	require.True(t, ok)
+	assert.Equal(t, connect.CodeFailedPrecondition, connectErr.Code())
+	assert.Equal(t, ErrTokenBlocked.Message, connectErr.Message(), "cause must not reach the client")
+
+	require.Len(t, connectErr.Details(), 1)
+	value, err := connectErr.Details()[0].Value()
+	require.NoError(t, err)
+	info, ok := value.(*errdetails.ErrorInfo)
+	require.True(t, ok)
+	assert.Equal(t, string(KindTokenBlocked), info.Reason)
+	assert.Equal(t, Domain, info.Domain)
+	assert.Equal(t, map[string]string{"mint": "scam"}, info.Metadata)
+
+	_, ok = ToConnect(errors.New("plain"))
+	assert.False(t, ok)
+}
+
+func TestFromHTTPResponse(t *testing.T) {
+	cause := errors.New("request failed")
+
+	limited := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": []string{"3"}}}
+	err := FromHTTPResponse(limited, cause)
+	assert.ErrorIs(t, err, ErrUpstreamRateLimited)
+	connectErr, _ := ToConnect(err)
+	require.Len(t, connectErr.Details(), 2)
+	value, _ := connectErr.Details()[1].Value()
+	assert.Equal(t, 3*time.Second, value.(*errdetails.RetryInfo).RetryDelay.AsDuration())
+
+	assert.ErrorIs(t, FromHTTPResponse(&http.Response{StatusCode: http.StatusBadGateway}, cause), ErrUpstreamUnavailable)
+	assert.Same(t, cause, FromHTTPResponse(&http.Response{StatusCode: http.StatusBadRequest}, cause))
+}
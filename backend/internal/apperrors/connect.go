@@ -0,0 +1,34 @@
+package apperrors
+
+import (
+	"errors"
+	"log/slog"
+
+	"connectrpc.com/connect"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// ToConnect converts the first Error in err's chain to a connect error with
+// the kind's code, the user-facing message and an ErrorInfo detail. The cause
+// is not sent. ok is false when err carries no Error.
+func ToConnect(err error) (connectErr *connect.Error, ok bool) {
+	var e *Error
+	if !errors.As(err, &e) {
+		return nil, false
+	}
+
+	connectErr = connect.NewError(e.Kind.Code(), errors.New(e.Message))
+	info := &errdetails.ErrorInfo{Reason: string(e.Kind), Domain: Domain, Metadata: e.Metadata}
+	if detail, detailErr := connect.NewErrorDetail(info); detailErr == nil {
+		connectErr.AddDetail(detail)
+	} else {
+		slog.Warn("Failed to attach error info", "kind", e.Kind, "error", detailErr)
+	}
+	if e.RetryAfter > 0 {
+		if detail, detailErr := connect.NewErrorDetail(&errdetails.RetryInfo{RetryDelay: durationpb.New(e.RetryAfter)}); detailErr == nil {
+			connectErr.AddDetail(detail)
+		}
+	}
+	return connectErr, true
+}
@@ -0,0 +1,286 @@
+// Package blocklist keeps a list of known scam mints synced from external feeds.
+//
+// Each feed's entries are stored with the feed name as their source so it is
+// always clear who flagged a mint. Admins can block mints directly or allow a
+// mint that a feed flagged by mistake; an admin allow wins over every feed.
+package blocklist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/apperrors"
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+const (
+	// DefaultSyncInterval is how often feeds are downloaded.
+	DefaultSyncInterval = 6 * time.Hour
+	// DefaultReloadInterval is how often the in-memory list is reloaded from the
+	// database, which picks up syncs and overrides made by other instances.
+	DefaultReloadInterval = time.Minute
+)
+
+// upsertBatchSize keeps each feed upsert well under the postgres parameter limit
+const upsertBatchSize = 1000
+
+var (
+	// ErrBlocked is returned when an operation involves a blocked mint.
+	ErrBlocked = errors.New("token is on the scam blocklist")
+	// ErrInvalidEntry is returned when an admin entry fails validation.
+	ErrInvalidEntry = errors.New("invalid blocklist entry")
+)
+
+// Blocklist answers whether a mint is a known scam and keeps the list in sync with its feeds.
+type Blocklist struct {
+	repo       db.Repository[model.BlockedMint]
+	feeds      []Feed
+	httpClient *http.Client
+
+	mu      sync.RWMutex
+	blocked map[string][]model.BlockedMint // Blocking entries by mint
+	allowed map[string]model.BlockedMint   // Admin allow overrides by mint
+}
+
+// New creates a Blocklist. A nil httpClient uses a client with a 30 second timeout.
+func New(repo db.Repository[model.BlockedMint], feeds []Feed, httpClient *http.Client) *Blocklist {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 30 * time.Second}
+	}
+	return &Blocklist{
+		repo:       repo,
+		feeds:      feeds,
+		httpClient: httpClient,
+		blocked:    make(map[string][]model.BlockedMint),
+		allowed:    make(map[string]model.BlockedMint),
+	}
+}
+
+// Load replaces the in-memory list with the current database contents.
+func (b *Blocklist) Load(ctx context.Context) error {
+	rows, _, err := b.repo.List(ctx, db.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to load blocklist: %w", err)
+	}
+	blocked := make(map[string][]model.BlockedMint)
+	allowed := make(map[string]model.BlockedMint)
+	for _, row := range rows {
+		if row.Allow {
+			if row.Source == model.BlocklistSourceAdmin {
+				allowed[row.Mint] = row
+			}
+			continue
+		}
+		blocked[row.Mint] = append(blocked[row.Mint], row)
+	}
+	b.mu.Lock()
+	b.blocked = blocked
+	b.allowed = allowed
+	b.mu.Unlock()
+	return nil
+}
+
+// IsBlocked reports whether mint is blocked and not overridden by an admin.
+func (b *Blocklist) IsBlocked(mint string) bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if _, ok := b.allowed[mint]; ok {
+		return false
+	}
+	return len(b.blocked[mint]) > 0
+}
+
+// Check returns an error wrapping ErrBlocked for the first blocked mint,
+// classified as apperrors.ErrTokenBlocked.
+func (b *Blocklist) Check(mints ...string) error {
+	for _, mint := range mints {
+		if b.IsBlocked(mint) {
+			return apperrors.ErrTokenBlocked.With("mint", mint).Wrap(fmt.Errorf("%w: %s", ErrBlocked, mint))
+		}
+	}
+	return nil
+}
+
+// Entries returns every entry for mint, including admin allow overrides.
+func (b *Blocklist) Entries(mint string) []model.BlockedMint {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	entries := append([]model.BlockedMint(nil), b.blocked[mint]...)
+	if allow, ok := b.allowed[mint]; ok {
+		entries = append(entries, allow)
+	}
+	return entries
+}
+
+// Overrides returns the admin-made entries, both blocks and allows, sorted by mint.
+func (b *Blocklist) Overrides() []model.BlockedMint {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	var entries []model.BlockedMint
+	for _, allow := range b.allowed {
+		entries = append(entries, allow)
+	}
+	for _, rows := range b.blocked {
+		for _, row := range rows {
+			if row.Source == model.BlocklistSourceAdmin {
+				entries = append(entries, row)
+			}
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Mint < entries[j].Mint })
+	return entries
+}
+
+// SetOverride records an admin decision for mint: allow overrides every feed, otherwise the mint is blocked.
+func (b *Blocklist) SetOverride(ctx context.Context, mint string, allow bool, reason, updatedBy string) (*model.BlockedMint, error) {
+	if !validMint(mint) {
+		return nil, fmt.Errorf("%w: invalid mint address %q", ErrInvalidEntry, mint)
+	}
+	entry := model.BlockedMint{
+		ID:        entryID(model.BlocklistSourceAdmin, mint),
+		Mint:      mint,
+		Source:    model.BlocklistSourceAdmin,
+		Reason:    reason,
+		Allow:     allow,
+		UpdatedBy: updatedBy,
+	}
+	if _, err := b.repo.Upsert(ctx, &entry); err != nil {
+		return nil, fmt.Errorf("failed to save blocklist override for %s: %w", mint, err)
+	}
+	if err := b.Load(ctx); err != nil {
+		return nil, err
+	}
+	entry.UpdatedAt = time.Now()
+	return &entry, nil
+}
+
+// DeleteOverride removes the admin decision for mint, leaving only feed entries.
+func (b *Blocklist) DeleteOverride(ctx context.Context, mint string) error {
+	if err := b.repo.HardDelete(ctx, entryID(model.BlocklistSourceAdmin, mint)); err != nil {
+		return fmt.Errorf("failed to delete blocklist override for %s: %w", mint, err)
+	}
+	return b.Load(ctx)
+}
+
+// SyncResult is the outcome of syncing one feed.
+type SyncResult struct {
+	Feed    string
+	Entries int
+	Removed int
+	Err     error
+}
+
+// Sync downloads every feed, stores its entries and removes entries the feed no
+// longer lists. A feed that fails keeps its previous entries.
+func (b *Blocklist) Sync(ctx context.Context) []SyncResult {
+	results := make([]SyncResult, 0, len(b.feeds))
+	for _, feed := range b.feeds {
+		result := b.syncFeed(ctx, feed)
+		if result.Err != nil {
+			slog.WarnContext(ctx, "Failed to sync blocklist feed", "feed", feed.Name, "error", result.Err)
+		} else {
+			slog.InfoContext(ctx, "Synced blocklist feed", "feed", feed.Name, "entries", result.Entries, "removed", result.Removed)
+		}
+		results = append(results, result)
+	}
+	if err := b.Load(ctx); err != nil {
+		slog.WarnContext(ctx, "Failed to reload blocklist after sync", "error", err)
+	}
+	return results
+}
+
+func (b *Blocklist) syncFeed(ctx context.Context, feed Feed) SyncResult {
+	result := SyncResult{Feed: feed.Name}
+	fetched, err := fetchFeed(ctx, b.httpClient, feed)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	if len(fetched) == 0 {
+		// An empty list is far more likely a broken feed than a clean one
+		result.Err = fmt.Errorf("feed listed no valid mints")
+		return result
+	}
+
+	rows := make([]model.BlockedMint, 0, len(fetched))
+	seen := make(map[string]struct{}, len(fetched))
+	for _, entry := range fetched {
+		id := entryID(feed.Name, entry.Mint)
+		if _, dup := seen[id]; dup {
+			continue
+		}
+		seen[id] = struct{}{}
+		rows = append(rows, model.BlockedMint{ID: id, Mint: entry.Mint, Source: feed.Name, Reason: entry.Reason})
+	}
+	for start := 0; start < len(rows); start += upsertBatchSize {
+		batch := rows[start:min(start+upsertBatchSize, len(rows))]
+		if _, err := b.repo.BulkUpsert(ctx, &batch); err != nil {
+			result.Err = fmt.Errorf("failed to store feed entries: %w", err)
+			return result
+		}
+	}
+	result.Entries = len(rows)
+
+	existing, _, err := b.repo.List(ctx, db.ListOptions{Filters: []db.FilterOption{
+		{Field: "source", Operator: db.FilterOpEqual, Value: feed.Name},
+	}})
+	if err != nil {
+		result.Err = fmt.Errorf("failed to list stale feed entries: %w", err)
+		return result
+	}
+	for _, row := range existing {
+		if _, ok := seen[row.ID]; ok {
+			continue
+		}
+		if err := b.repo.HardDelete(ctx, row.ID); err != nil && !errors.Is(err, db.ErrNotFound) {
+			result.Err = fmt.Errorf("failed to remove stale entry %s: %w", row.ID, err)
+			return result
+		}
+		result.Removed++
+	}
+	return result
+}
+
+// Run syncs feeds every syncInterval and reloads the list every reloadInterval
+// until ctx is cancelled. Intervals <= 0 use the defaults.
+func (b *Blocklist) Run(ctx context.Context, syncInterval, reloadInterval time.Duration) {
+	if syncInterval <= 0 {
+		syncInterval = DefaultSyncInterval
+	}
+	if reloadInterval <= 0 {
+		reloadInterval = DefaultReloadInterval
+	}
+	if len(b.feeds) > 0 {
+		b.Sync(ctx)
+	}
+
+	syncTicker := time.NewTicker(syncInterval)
+	defer syncTicker.Stop()
+	reloadTicker := time.NewTicker(reloadInterval)
+	defer reloadTicker.Stop()
+	for {
+		select {
+		case <-syncTicker.C:
+			if len(b.feeds) > 0 {
+				b.Sync(ctx)
+			}
+		case <-reloadTicker.C:
+			if err := b.Load(ctx); err != nil && ctx.Err() == nil {
+				slog.WarnContext(ctx, "Failed to reload blocklist", slog.Any("error", err))
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func entryID(source, mint string) string {
+	return source + ":" + mint
+}
@@ -0,0 +1,123 @@
+package blocklist
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	dbmocks "github.com/nicolas-martin/dankfolio/backend/internal/db/mocks"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+const (
+	scamMint  = "ScamaMint1111111111111111111111111111111111"
+	otherMint = "So11111111111111111111111111111111111111112"
+)
+
+func TestParseFeed(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want []feedEntry
+	}{
+		{
+			name: "string array",
+			body: `["` + scamMint + `", "not-a-mint"]`,
+			want: []feedEntry{{Mint: scamMint}},
+		},
+		{
+			name: "object array",
+			body: `[{"address": "` + scamMint + `", "reason": "rug"}, {"mint": "` + otherMint + `"}]`,
+			want: []feedEntry{{Mint: scamMint, Reason: "rug"}, {Mint: otherMint}},
+		},
+		{
+			name: "wrapped tokens",
+			body: `{"tokens": ["` + scamMint + `"]}`,
+			want: []feedEntry{{Mint: scamMint}},
+		},
+		{
+			name: "text lines",
+			body: "# header\n" + scamMint + " # honeypot\n\n",
+			want: []feedEntry{{Mint: scamMint, Reason: "honeypot"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseFeed([]byte(tt.body))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseFeeds(t *testing.T) {
+	feeds, err := ParseFeeds(" community=https://example.com/a.json , other=http://example.org/b.txt")
+	require.NoError(t, err)
+	assert.Equal(t, []Feed{
+		{Name: "community", URL: "https://example.com/a.json"},
+		{Name: "other", URL: "http://example.org/b.txt"},
+	}, feeds)
+
+	for _, spec := range []string{"missing-url", "admin=https://example.com", "bad:name=https://example.com", "ftp=ftp://example.com"} {
+		_, err := ParseFeeds(spec)
+		assert.Error(t, err, spec)
+	}
+}
+
+func TestBlocklist_AdminAllowWins(t *testing.T) {
+	repo := dbmocks.NewMockRepository[model.BlockedMint](t)
+	repo.EXPECT().List(mock.Anything, db.ListOptions{}).Return([]model.BlockedMint{
+		{ID: "community:" + scamMint, Mint: scamMint, Source: "community"},
+		{ID: "community:" + otherMint, Mint: otherMint, Source: "community"},
+		{ID: "admin:" + otherMint, Mint: otherMint, Source: model.BlocklistSourceAdmin, Allow: true},
+	}, int32(3), nil)
+
+	list := New(repo, nil, nil)
+	require.NoError(t, list.Load(context.Background()))
+
+	assert.True(t, list.IsBlocked(scamMint))
+	assert.False(t, list.IsBlocked(otherMint))
+	assert.Len(t, list.Entries(otherMint), 2)
+
+	err := list.Check(otherMint, scamMint)
+	assert.True(t, errors.Is(err, ErrBlocked))
+	assert.NoError(t, list.Check(otherMint))
+}
+
+func TestBlocklist_SyncRemovesStaleEntries(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(scamMint + "\n"))
+	}))
+	defer server.Close()
+
+	repo := dbmocks.NewMockRepository[model.BlockedMint](t)
+	repo.EXPECT().BulkUpsert(mock.Anything, mock.MatchedBy(func(rows *[]model.BlockedMint) bool {
+		return len(*rows) == 1 && (*rows)[0].ID == "community:"+scamMint && (*rows)[0].Source == "community"
+	})).Return(int64(1), nil)
+	repo.EXPECT().List(mock.Anything, db.ListOptions{Filters: []db.FilterOption{
+		{Field: "source", Operator: db.FilterOpEqual, Value: "community"},
+	}}).Return([]model.BlockedMint{
+		{ID: "community:" + scamMint},
+		{ID: "community:" + otherMint},
+	}, int32(2), nil)
+	repo.EXPECT().HardDelete(mock.Anything, "community:"+otherMint).Return(nil)
+	repo.EXPECT().List(mock.Anything, db.ListOptions{}).Return([]model.BlockedMint{
+		{ID: "community:" + scamMint, Mint: scamMint, Source: "community"},
+	}, int32(1), nil)
+
+	list := New(repo, []Feed{{Name: "community", URL: server.URL}}, server.Client())
+	results := list.Sync(context.Background())
+
+	require.Len(t, results, 1)
+	require.NoError(t, results[0].Err)
+	assert.Equal(t, 1, results[0].Entries)
+	assert.Equal(t, 1, results[0].Removed)
+	assert.True(t, list.IsBlocked(scamMint))
+}
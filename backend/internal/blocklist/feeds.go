@@ -0,0 +1,151 @@
+package blocklist
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gagliardetto/solana-go"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+// maxFeedBytes caps how much of a feed response is read
+const maxFeedBytes = 16 << 20
+
+// Feed is an external scam token list.
+type Feed struct {
+	Name string
+	URL  string
+}
+
+// ParseFeeds parses a comma-separated list of name=url pairs, e.g.
+// "community=https://example.com/blocklist.json,other=https://example.org/list.txt".
+func ParseFeeds(spec string) ([]Feed, error) {
+	var feeds []Feed
+	for pair := range strings.SplitSeq(spec, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		name, url, ok := strings.Cut(pair, "=")
+		name, url = strings.TrimSpace(name), strings.TrimSpace(url)
+		if !ok || name == "" || url == "" {
+			return nil, fmt.Errorf("invalid blocklist feed %q: expected name=url", pair)
+		}
+		if !strings.HasPrefix(url, "https://") && !strings.HasPrefix(url, "http://") {
+			return nil, fmt.Errorf("invalid blocklist feed %q: url must be http(s)", pair)
+		}
+		if strings.Contains(name, ":") || name == model.BlocklistSourceAdmin {
+			return nil, fmt.Errorf("invalid blocklist feed name %q", name)
+		}
+		feeds = append(feeds, Feed{Name: name, URL: url})
+	}
+	return feeds, nil
+}
+
+// feedEntry is a single mint listed by a feed
+type feedEntry struct {
+	Mint   string
+	Reason string
+}
+
+// fetchFeed downloads and parses a feed
+func fetchFeed(ctx context.Context, client *http.Client, feed Feed) ([]feedEntry, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, feed.URL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch feed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("feed returned status %d", resp.StatusCode)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxFeedBytes))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read feed: %w", err)
+	}
+	return parseFeed(body)
+}
+
+// parseFeed accepts the shapes community lists are commonly published in: a JSON
+// array of mint strings, a JSON array of objects with an "address" or "mint" field,
+// either of those wrapped in an object under "tokens", or one mint per line with
+// "#" comments. Entries that are not valid public keys are skipped.
+func parseFeed(body []byte) ([]feedEntry, error) {
+	body = bytes.TrimSpace(body)
+	if len(body) == 0 {
+		return nil, fmt.Errorf("feed is empty")
+	}
+
+	var raw []json.RawMessage
+	switch body[0] {
+	case '[':
+		if err := json.Unmarshal(body, &raw); err != nil {
+			return nil, fmt.Errorf("failed to parse feed: %w", err)
+		}
+	case '{':
+		var wrapped struct {
+			Tokens []json.RawMessage `json:"tokens"`
+		}
+		if err := json.Unmarshal(body, &wrapped); err != nil {
+			return nil, fmt.Errorf("failed to parse feed: %w", err)
+		}
+		raw = wrapped.Tokens
+	default:
+		return parseTextFeed(body), nil
+	}
+
+	entries := make([]feedEntry, 0, len(raw))
+	for _, item := range raw {
+		var entry feedEntry
+		var mint string
+		if err := json.Unmarshal(item, &mint); err == nil {
+			entry.Mint = mint
+		} else {
+			var obj struct {
+				Address string `json:"address"`
+				Mint    string `json:"mint"`
+				Reason  string `json:"reason"`
+			}
+			if err := json.Unmarshal(item, &obj); err != nil {
+				continue
+			}
+			entry = feedEntry{Mint: obj.Address, Reason: obj.Reason}
+			if entry.Mint == "" {
+				entry.Mint = obj.Mint
+			}
+		}
+		if validMint(entry.Mint) {
+			entries = append(entries, entry)
+		}
+	}
+	return entries, nil
+}
+
+func parseTextFeed(body []byte) []feedEntry {
+	var entries []feedEntry
+	scanner := bufio.NewScanner(bytes.NewReader(body))
+	for scanner.Scan() {
+		line, comment, _ := strings.Cut(scanner.Text(), "#")
+		fields := strings.Fields(line)
+		if len(fields) == 0 || !validMint(fields[0]) {
+			continue
+		}
+		entries = append(entries, feedEntry{Mint: fields[0], Reason: strings.TrimSpace(comment)})
+	}
+	return entries
+}
+
+func validMint(mint string) bool {
+	_, err := solana.PublicKeyFromBase58(mint)
+	return err == nil
+}
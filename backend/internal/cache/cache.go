@@ -3,11 +3,15 @@ package cache
 import (
 	"context"
 	"log/slog"
+	"sync/atomic"
 	"time"
 
 	"github.com/dgraph-io/ristretto"
 	"github.com/eko/gocache/v3/cache"
 	"github.com/eko/gocache/v3/store"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
 
 	"github.com/nicolas-martin/dankfolio/backend/internal/clients/birdeye"
 	"github.com/nicolas-martin/dankfolio/backend/internal/model"
@@ -17,13 +21,40 @@ import (
 type (
 	CoinCache         = GenericCache[[]model.Coin]
 	PriceHistoryCache = GenericCache[*birdeye.PriceHistory]
+	CandleCache       = GenericCache[*birdeye.OHLCV]
+	TradeStatsCache   = GenericCache[*model.CoinTradeStats]
+	LeaderboardCache  = GenericCache[*model.CoinLeaderboard]
 )
 
+// maxStaleWindow caps how long an expired entry is kept to tell a lookup that
+// just missed it, a stale one, from a cold miss
+const maxStaleWindow = 5 * time.Minute
+
+// Lookup outcomes, as the outcome attribute of dankfolio.cache.lookups_total
+const (
+	outcomeHit   = "hit"
+	outcomeMiss  = "miss"
+	outcomeStale = "stale" // The entry had expired; a longer TTL would have made it a hit
+)
+
+// entry is a cached value with the time it expires. The store keeps it a
+// while longer, see maxStaleWindow.
+type entry[T any] struct {
+	value     T
+	expiresAt time.Time
+}
+
 // GoGenericCacheAdapter provides a generic cache implementation using Ristretto
 type GoGenericCacheAdapter[T any] struct {
-	cacheManager   cache.CacheInterface[T]
+	cacheManager   cache.CacheInterface[entry[T]]
 	ristrettoCache *ristretto.Cache
 	logPrefix      string
+
+	hits    atomic.Uint64
+	misses  atomic.Uint64 // Includes stale lookups
+	stale   atomic.Uint64
+	lookups metric.Int64Counter
+	attrs   attribute.Set
 }
 
 // NewGoGenericCacheAdapter creates a new generic cache adapter
@@ -32,19 +63,74 @@ func NewGoGenericCacheAdapter[T any](logPrefix string) (*GoGenericCacheAdapter[T
 		NumCounters: 1e5,     // Num keys to track frequency of (100k).
 		MaxCost:     1 << 25, // Maximum cost of cache (32MB).
 		BufferItems: 64,      // Number of keys per Get buffer.
+		Metrics:     true,    // Counts keys added and evicted, for the entries gauge.
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	cacheStore := store.NewRistretto(ristrettoCache)
-	cacheManager := cache.New[T](cacheStore)
+	cacheManager := cache.New[entry[T]](cacheStore)
 
-	return &GoGenericCacheAdapter[T]{
+	a := &GoGenericCacheAdapter[T]{
 		cacheManager:   cacheManager,
 		ristrettoCache: ristrettoCache,
 		logPrefix:      logPrefix,
-	}, nil
+		attrs:          attribute.NewSet(attribute.String("cache", logPrefix)),
+	}
+	a.instrument()
+	return a, nil
+}
+
+// instrument registers the cache's lookup counter and entries gauge with the
+// global meter provider. Caches still work, uninstrumented, if it fails.
+func (a *GoGenericCacheAdapter[T]) instrument() {
+	meter := otel.Meter("github.com/nicolas-martin/dankfolio/backend/internal/cache")
+	var err error
+	a.lookups, err = meter.Int64Counter(
+		"dankfolio.cache.lookups_total",
+		metric.WithDescription("Cache lookups by cache and outcome: hit, miss, or stale for an entry that had just expired"),
+		metric.WithUnit("{lookup}"),
+	)
+	if err != nil {
+		slog.Warn("Failed to create cache lookup counter", slog.String("cache", a.logPrefix), slog.Any("error", err))
+	}
+	_, err = meter.Int64ObservableGauge(
+		"dankfolio.cache.entries",
+		metric.WithDescription("Entries held by each cache, including expired ones not yet dropped"),
+		metric.WithUnit("{entry}"),
+		metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+			o.Observe(a.entries(), metric.WithAttributeSet(a.attrs))
+			return nil
+		}),
+	)
+	if err != nil {
+		slog.Warn("Failed to create cache entries gauge", slog.String("cache", a.logPrefix), slog.Any("error", err))
+	}
+}
+
+// entries is how many keys the cache holds
+func (a *GoGenericCacheAdapter[T]) entries() int64 {
+	metrics := a.ristrettoCache.Metrics
+	return int64(metrics.KeysAdded()) - int64(metrics.KeysEvicted())
+}
+
+func (a *GoGenericCacheAdapter[T]) record(outcome string) {
+	switch outcome {
+	case outcomeHit:
+		a.hits.Add(1)
+	case outcomeStale:
+		a.stale.Add(1)
+		a.misses.Add(1)
+	default:
+		a.misses.Add(1)
+	}
+	if a.lookups != nil {
+		a.lookups.Add(context.Background(), 1, metric.WithAttributes(
+			attribute.String("cache", a.logPrefix),
+			attribute.String("outcome", outcome),
+		))
+	}
 }
 
 func NewCoinCache() (CoinCache, error) {
@@ -55,37 +141,56 @@ func NewPriceHistoryCache() (PriceHistoryCache, error) {
 	return NewGoGenericCacheAdapter[*birdeye.PriceHistory]("price")
 }
 
+func NewCandleCache() (CandleCache, error) {
+	return NewGoGenericCacheAdapter[*birdeye.OHLCV]("candles")
+}
+
+func NewTradeStatsCache() (TradeStatsCache, error) {
+	return NewGoGenericCacheAdapter[*model.CoinTradeStats]("trade_stats")
+}
+
+func NewLeaderboardCache() (LeaderboardCache, error) {
+	return NewGoGenericCacheAdapter[*model.CoinLeaderboard]("leaderboard")
+}
+
 // Get retrieves an item from the cache
 func (a *GoGenericCacheAdapter[T]) Get(key string) (T, bool) {
 	var zero T
 	startTime := time.Now()
 
-	cachedValue, err := a.cacheManager.Get(context.Background(), key)
+	cached, err := a.cacheManager.Get(context.Background(), key)
 
 	duration := time.Since(startTime)
-	metrics := a.ristrettoCache.Metrics
 
 	if err != nil {
+		a.record(outcomeMiss)
+		stats := a.Stats()
 		slog.Info("🔍 Cache access - MISS (error)",
 			slog.String("service", a.logPrefix),
 			slog.String("key", key),
-			slog.String("outcome", "miss"),
+			slog.String("outcome", outcomeMiss),
 			slog.Duration("accessDuration", duration),
-			slog.Uint64("hits", metrics.Hits()),
-			slog.Uint64("misses", metrics.Misses()),
-			slog.Float64("hitRatio", metrics.Ratio()),
+			slog.Uint64("hits", stats.Hits),
+			slog.Uint64("misses", stats.Misses),
+			slog.Float64("hitRatio", stats.HitRatio),
 			slog.String("error", err.Error()),
 		)
 		return zero, false
 	}
+	if !time.Now().Before(cached.expiresAt) {
+		a.record(outcomeStale)
+		return zero, false
+	}
 
-	return cachedValue, true
+	a.record(outcomeHit)
+	return cached.value, true
 }
 
 // Set adds an item to the cache with an expiration duration
 func (a *GoGenericCacheAdapter[T]) Set(key string, data T, expiration time.Duration) {
 	startTime := time.Now()
-	err := a.cacheManager.Set(context.Background(), key, data, store.WithExpiration(expiration))
+	cached := entry[T]{value: data, expiresAt: startTime.Add(expiration)}
+	err := a.cacheManager.Set(context.Background(), key, cached, store.WithExpiration(expiration+min(expiration, maxStaleWindow)))
 	duration := time.Since(startTime)
 
 	if err != nil {
@@ -114,3 +219,18 @@ func (a *GoGenericCacheAdapter[T]) Delete(key string) {
 		)
 	}
 }
+
+// Stats returns the cache's lookup counts and size
+func (a *GoGenericCacheAdapter[T]) Stats() Stats {
+	stats := Stats{
+		Name:    a.logPrefix,
+		Hits:    a.hits.Load(),
+		Misses:  a.misses.Load(),
+		Stale:   a.stale.Load(),
+		Entries: a.entries(),
+	}
+	if lookups := stats.Hits + stats.Misses; lookups > 0 {
+		stats.HitRatio = float64(stats.Hits) / float64(lookups)
+	}
+	return stats
+}
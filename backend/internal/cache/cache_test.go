@@ -45,4 +45,43 @@ func TestCacheDelete(t *testing.T) {
 	if found {
 		t.Error("Expected data to be deleted from cache after Delete")
 	}
-}
\ No newline at end of file
+}
+func TestCacheStats(t *testing.T) {
+	cache, err := NewCoinCache()
+	if err != nil {
+		t.Fatalf("Failed to create cache: %v", err)
+	}
+
+	cache.Get("missing")
+
+	stats := cache.Stats()
+	if stats.Name != "coin" {
+		t.Errorf("Expected stats for the coin cache, got %q", stats.Name)
+	}
+	if stats.Misses != 1 || stats.Hits != 0 || stats.HitRatio != 0 {
+		t.Errorf("Expected one miss, got %+v", stats)
+	}
+}
+
+func TestCacheStats_Stale(t *testing.T) {
+	cache, err := NewGoGenericCacheAdapter[string]("test")
+	if err != nil {
+		t.Fatalf("Failed to create cache: %v", err)
+	}
+
+	cache.Set("key", "value", 50*time.Millisecond)
+	cache.ristrettoCache.Wait()
+	if _, found := cache.Get("key"); !found {
+		t.Fatal("Expected a hit before the entry expires")
+	}
+	time.Sleep(60 * time.Millisecond)
+	if _, found := cache.Get("key"); found {
+		t.Fatal("Expected a miss once the entry expired")
+	}
+	cache.Get("missing")
+
+	stats := cache.Stats()
+	if stats.Hits != 1 || stats.Misses != 2 || stats.Stale != 1 || stats.Entries != 1 {
+		t.Errorf("Expected one hit and two misses, one stale, on one entry, got %+v", stats)
+	}
+}
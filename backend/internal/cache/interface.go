@@ -6,4 +6,20 @@ type GenericCache[T any] interface {
 	Get(key string) (T, bool)
 	Set(key string, data T, expiration time.Duration)
 	Delete(key string)
+	Stats() Stats
+}
+
+// StatsReporter is any cache, whatever it holds
+type StatsReporter interface {
+	Stats() Stats
+}
+
+// Stats are a cache's lookup counts since it was created
+type Stats struct {
+	Name     string
+	Hits     uint64
+	Misses   uint64
+	Stale    uint64  // Misses on an entry that had just expired
+	HitRatio float64 // Hits over lookups, 0 before the first lookup
+	Entries  int64   // Keys held, including expired ones not yet dropped
 }
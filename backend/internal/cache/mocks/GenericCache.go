@@ -7,6 +7,7 @@ package cachemocks
 import (
 	"time"
 
+	"github.com/nicolas-martin/dankfolio/backend/internal/cache"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -190,3 +191,47 @@ func (_c *MockGenericCache_Set_Call[T]) RunAndReturn(run func(key string, data T
 	_c.Run(run)
 	return _c
 }
+
+// Stats provides a mock function for the type MockGenericCache
+func (_mock *MockGenericCache[T]) Stats() cache.Stats {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stats")
+	}
+
+	var r0 cache.Stats
+	if returnFunc, ok := ret.Get(0).(func() cache.Stats); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(cache.Stats)
+	}
+	return r0
+}
+
+// MockGenericCache_Stats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stats'
+type MockGenericCache_Stats_Call[T any] struct {
+	*mock.Call
+}
+
+// Stats is a helper method to define mock.On call
+func (_e *MockGenericCache_Expecter[T]) Stats() *MockGenericCache_Stats_Call[T] {
+	return &MockGenericCache_Stats_Call[T]{Call: _e.mock.On("Stats")}
+}
+
+func (_c *MockGenericCache_Stats_Call[T]) Run(run func()) *MockGenericCache_Stats_Call[T] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockGenericCache_Stats_Call[T]) Return(stats cache.Stats) *MockGenericCache_Stats_Call[T] {
+	_c.Call.Return(stats)
+	return _c
+}
+
+func (_c *MockGenericCache_Stats_Call[T]) RunAndReturn(run func() cache.Stats) *MockGenericCache_Stats_Call[T] {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,100 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// minSummaryLookups is how many lookups an interval needs before its hit
+// ratio is held to the cache's target; a quiet cache says little
+const minSummaryLookups = 100
+
+// ParseHitRatioTargets parses comma-separated CACHE=ratio hit ratio targets,
+// e.g. "coin=0.8,price=0.7"
+func ParseHitRatioTargets(s string) (map[string]float64, error) {
+	targets := make(map[string]float64)
+	for _, field := range strings.Split(s, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(field, "=")
+		if !ok || strings.TrimSpace(name) == "" {
+			return nil, fmt.Errorf("invalid hit ratio target %q, want CACHE=ratio", field)
+		}
+		ratio, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil || ratio < 0 || ratio > 1 {
+			return nil, fmt.Errorf("invalid hit ratio target %q, want a ratio from 0 to 1", field)
+		}
+		targets[strings.TrimSpace(name)] = ratio
+	}
+	return targets, nil
+}
+
+// Summarizer periodically logs each cache's lookups since its last summary,
+// warning about caches whose hit ratio is under their target
+type Summarizer struct {
+	caches  []StatsReporter
+	targets map[string]float64 // Hit ratio targets by cache name
+	last    map[string]Stats
+}
+
+// NewSummarizer creates a summarizer of caches, holding those named in
+// targets to their hit ratio
+func NewSummarizer(caches []StatsReporter, targets map[string]float64) *Summarizer {
+	return &Summarizer{caches: caches, targets: targets, last: make(map[string]Stats)}
+}
+
+// Run logs a summary every interval until ctx is done
+func (s *Summarizer) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.summarize(ctx)
+		}
+	}
+}
+
+// summarize logs one line per cache and returns the names of those that
+// missed their target
+func (s *Summarizer) summarize(ctx context.Context) []string {
+	var missed []string
+	for _, c := range s.caches {
+		stats := c.Stats()
+		prev := s.last[stats.Name]
+		s.last[stats.Name] = stats
+
+		hits, misses := stats.Hits-prev.Hits, stats.Misses-prev.Misses
+		lookups := hits + misses
+		var ratio float64
+		if lookups > 0 {
+			ratio = float64(hits) / float64(lookups)
+		}
+		attrs := []slog.Attr{
+			slog.String("cache", stats.Name),
+			slog.Uint64("lookups", lookups),
+			slog.Uint64("hits", hits),
+			slog.Uint64("misses", misses),
+			slog.Uint64("stale", stats.Stale-prev.Stale),
+			slog.Float64("hit_ratio", ratio),
+			slog.Int64("entries", stats.Entries),
+		}
+
+		target, ok := s.targets[stats.Name]
+		if ok && lookups >= minSummaryLookups && ratio < target {
+			missed = append(missed, stats.Name)
+			slog.LogAttrs(ctx, slog.LevelWarn, "Cache hit ratio under target", append(attrs, slog.Float64("target", target))...)
+			continue
+		}
+		slog.LogAttrs(ctx, slog.LevelInfo, "Cache summary", attrs...)
+	}
+	return missed
+}
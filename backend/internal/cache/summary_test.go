@@ -0,0 +1,37 @@
+package cache
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fixedStats struct{ stats Stats }
+
+func (f *fixedStats) Stats() Stats { return f.stats }
+
+func TestParseHitRatioTargets(t *testing.T) {
+	targets, err := ParseHitRatioTargets("coin=0.8, price = 0.7,")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]float64{"coin": 0.8, "price": 0.7}, targets)
+
+	for _, invalid := range []string{"coin", "=0.5", "coin=high", "coin=1.5"} {
+		_, err := ParseHitRatioTargets(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestSummarizer_HitRatioPerInterval(t *testing.T) {
+	coin := &fixedStats{Stats{Name: "coin", Hits: 950, Misses: 50}}
+	price := &fixedStats{Stats{Name: "price", Hits: 10, Misses: 40}}
+	s := NewSummarizer([]StatsReporter{coin, price}, map[string]float64{"coin": 0.8, "price": 0.8})
+
+	// price misses its target but on too few lookups to tell
+	assert.Empty(t, s.summarize(context.Background()))
+
+	// Since the last summary coin only hit 100 of 300 lookups
+	coin.stats.Hits, coin.stats.Misses = 1050, 250
+	assert.Equal(t, []string{"coin"}, s.summarize(context.Background()))
+}
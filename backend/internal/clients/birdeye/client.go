@@ -10,20 +10,28 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 
+	"github.com/nicolas-martin/dankfolio/backend/internal/apperrors"
 	"github.com/nicolas-martin/dankfolio/backend/internal/clients"
 	"github.com/nicolas-martin/dankfolio/backend/internal/util"
 )
 
 const (
 	priceHistoryEndpoint            = "defi/history_price"
+	ohlcvEndpoint                   = "defi/ohlcv"
 	trendingTokensEndpoint          = "defi/token_trending"
 	tokenOverviewEndpoint           = "defi/token_overview"
 	tokenMetadataMultipleEndpoint   = "defi/v3/token/meta-data/multiple"
 	tokenMarketDataMultipleEndpoint = "defi/v3/token/market-data/multiple"
 	newListingTokensEndpoint        = "defi/v2/tokens/new_listing"
+	multiPriceEndpoint              = "defi/multi_price"
+	tokenTradeDataSingleEndpoint    = "defi/v3/token/trade-data/single"
 )
 
+// MaxMultiPriceAddresses is the maximum number of addresses accepted by the multi price endpoint
+const MaxMultiPriceAddresses = 100
+
 // Client handles interactions with the BirdEye API
 type Client struct {
 	httpClient clients.HTTPDoer
@@ -61,6 +69,24 @@ func (c *Client) GetPriceHistory(ctx context.Context, params PriceHistoryParams)
 	return priceHistory, nil
 }
 
+// GetOHLCV retrieves candles for a token. HistoryType is the candle interval, e.g. "15m".
+func (c *Client) GetOHLCV(ctx context.Context, params PriceHistoryParams) (*OHLCV, error) {
+	queryParams := url.Values{}
+	queryParams.Add("address", params.Address)
+	queryParams.Add("type", params.HistoryType)
+	queryParams.Add("time_from", strconv.FormatInt(params.TimeFrom.Unix(), 10))
+	queryParams.Add("time_to", strconv.FormatInt(params.TimeTo.Unix(), 10))
+
+	fullURL := fmt.Sprintf("%s/%s?%s", c.baseURL, ohlcvEndpoint, queryParams.Encode())
+
+	ohlcv, err := getRequest[OHLCV](c, ctx, fullURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ohlcv: %w", err)
+	}
+
+	return ohlcv, nil
+}
+
 // GetTrendingTokens retrieves the list of trending tokens from the BirdEye API.
 func (c *Client) GetTrendingTokens(ctx context.Context, params TrendingTokensParams) (*TokenTrendingResponse, error) {
 	queryParams := url.Values{}
@@ -307,6 +333,82 @@ func (c *Client) GetTokensTradeDataBatch(ctx context.Context, addresses []string
 	return results, nil
 }
 
+// GetTokenTradeStats retrieves buy/sell counts, unique wallets and volume for a token
+// over rolling 5m, 1h and 24h windows.
+func (c *Client) GetTokenTradeStats(ctx context.Context, address string) (*TokenTradeStats, error) {
+	// Native SOL doesn't exist on Birdeye; its trades are wSOL trades
+	requestAddress := address
+	if address == "11111111111111111111111111111111" {
+		requestAddress = "So11111111111111111111111111111111111111112"
+	}
+
+	queryParams := url.Values{}
+	queryParams.Add("address", requestAddress)
+	fullURL := fmt.Sprintf("%s/%s?%s", c.baseURL, tokenTradeDataSingleEndpoint, queryParams.Encode())
+
+	slog.Debug("Fetching token trade stats from BirdEye", "url", fullURL, "address", address)
+
+	response, err := getRequest[TokenTradeStatsResponse](c, ctx, fullURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get token trade stats for %s: %w", address, err)
+	}
+	if !response.Success {
+		return nil, fmt.Errorf("BirdEye returned unsuccessful trade stats response for %s", address)
+	}
+
+	stats := response.Data
+	stats.Address = address
+	return &stats, nil
+}
+
+// GetMultiPrice retrieves current USD prices for up to 100 tokens in a single request.
+// Tokens without a price in the response are omitted from the returned map.
+func (c *Client) GetMultiPrice(ctx context.Context, addresses []string) (map[string]float64, error) {
+	if len(addresses) == 0 {
+		return map[string]float64{}, nil
+	}
+
+	if len(addresses) > MaxMultiPriceAddresses {
+		return nil, fmt.Errorf("batch size %d exceeds maximum allowed %d", len(addresses), MaxMultiPriceAddresses)
+	}
+
+	// Convert native SOL to wSOL for Birdeye API
+	normalizedAddresses := make([]string, len(addresses))
+	for i, address := range addresses {
+		if address == "11111111111111111111111111111111" {
+			normalizedAddresses[i] = "So11111111111111111111111111111111111111112"
+			slog.Debug("Converting native SOL to wSOL for Birdeye multi price API", "original", address)
+		} else {
+			normalizedAddresses[i] = address
+		}
+	}
+
+	queryParams := url.Values{}
+	queryParams.Add("list_address", strings.Join(normalizedAddresses, ","))
+
+	fullURL := fmt.Sprintf("%s/%s?%s", c.baseURL, multiPriceEndpoint, queryParams.Encode())
+	slog.Debug("Fetching multi price from BirdEye", "url", fullURL, "count", len(addresses))
+
+	multiPriceResponse, err := getRequest[MultiPriceResponse](c, ctx, fullURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get multi price: %w", err)
+	}
+
+	// Map prices back to the requested addresses (restoring native SOL)
+	prices := make(map[string]float64, len(addresses))
+	for i, address := range addresses {
+		priceData, exists := multiPriceResponse.Data[normalizedAddresses[i]]
+		if !exists || priceData == nil {
+			slog.Debug("Price not found in multi price response", "address", address)
+			continue
+		}
+		prices[address] = priceData.Value
+	}
+
+	slog.Debug("Successfully fetched multi price", "requested", len(addresses), "found", len(prices))
+	return prices, nil
+}
+
 // GetNewListingTokens retrieves newly listed tokens from the BirdEye API
 func (c *Client) GetNewListingTokens(ctx context.Context, params NewListingTokensParams) (*NewListingTokensResponse, error) {
 	queryParams := url.Values{}
@@ -463,14 +565,14 @@ func getRequest[T any](c *Client, ctx context.Context, requestURL string) (*T, e
 				"url", requestURL,
 				"status_code", resp.StatusCode,
 				"content_type", resp.Header.Get("Content-Type"))
-			return nil, fmt.Errorf("GET request to %s failed with status code: %d (received HTML error page instead of JSON)", requestURL, resp.StatusCode)
+			return nil, apperrors.FromHTTPResponse(resp, fmt.Errorf("GET request to %s failed with status code: %d (received HTML error page instead of JSON)", requestURL, resp.StatusCode))
 		}
 
 		slog.Error("BirdEye GET request failed",
 			"url", requestURL,
 			"status_code", resp.StatusCode,
 			"body", string(respBody))
-		return nil, fmt.Errorf("GET request to %s failed with status code: %d, body: %s", requestURL, resp.StatusCode, string(respBody))
+		return nil, apperrors.FromHTTPResponse(resp, fmt.Errorf("GET request to %s failed with status code: %d, body: %s", requestURL, resp.StatusCode, string(respBody)))
 	}
 
 	// Check if we received HTML when expecting JSON
@@ -560,14 +662,14 @@ func postRequest[T any](c *Client, ctx context.Context, requestURL string, reque
 				"url", requestURL,
 				"status_code", resp.StatusCode,
 				"content_type", resp.Header.Get("Content-Type"))
-			return nil, fmt.Errorf("POST request to %s failed with status code: %d (received HTML error page instead of JSON)", requestURL, resp.StatusCode)
+			return nil, apperrors.FromHTTPResponse(resp, fmt.Errorf("POST request to %s failed with status code: %d (received HTML error page instead of JSON)", requestURL, resp.StatusCode))
 		}
 
 		slog.Error("BirdEye POST request failed",
 			"url", requestURL,
 			"status_code", resp.StatusCode,
 			"body", string(respBody))
-		return nil, fmt.Errorf("POST request to %s failed with status code: %d, body: %s", requestURL, resp.StatusCode, string(respBody))
+		return nil, apperrors.FromHTTPResponse(resp, fmt.Errorf("POST request to %s failed with status code: %d, body: %s", requestURL, resp.StatusCode, string(respBody)))
 	}
 
 	// Check if we received HTML when expecting JSON
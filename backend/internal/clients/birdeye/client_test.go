@@ -351,3 +351,86 @@ func TestGetPriceHistory_HttpError(t *testing.T) {
 	assert.Contains(t, err.Error(), errorBody)
 
 }
+
+func TestGetMultiPrice_Success(t *testing.T) {
+	expectedPath := "/defi/multi_price"
+	nativeSol := "11111111111111111111111111111111"
+	wrappedSol := "So11111111111111111111111111111111111111112"
+
+	mockResponse := birdeyeclient.MultiPriceResponse{
+		Success: true,
+		Data: map[string]*birdeyeclient.MultiPriceData{
+			wrappedSol: {Value: 150.25},
+			"TOKEN_1":  {Value: 0.0042},
+		},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, expectedPath, r.URL.Path)
+		assert.Equal(t, wrappedSol+",TOKEN_1,TOKEN_2", r.URL.Query().Get("list_address"))
+
+		w.WriteHeader(http.StatusOK)
+		err := json.NewEncoder(w).Encode(mockResponse)
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+
+	client := birdeyeclient.NewClient(server.Client(), server.URL, testAPIKey)
+
+	prices, err := client.GetMultiPrice(context.Background(), []string{nativeSol, "TOKEN_1", "TOKEN_2"})
+
+	assert.NoError(t, err)
+	assert.Len(t, prices, 2)
+	assert.Equal(t, 150.25, prices[nativeSol]) // Native SOL restored as the key
+	assert.Equal(t, 0.0042, prices["TOKEN_1"])
+	assert.NotContains(t, prices, "TOKEN_2")
+}
+
+func TestGetMultiPrice_TooManyAddresses(t *testing.T) {
+	client := birdeyeclient.NewClient(http.DefaultClient, "http://unused", testAPIKey)
+
+	addresses := make([]string, birdeyeclient.MaxMultiPriceAddresses+1)
+	for i := range addresses {
+		addresses[i] = fmt.Sprintf("TOKEN_%d", i)
+	}
+
+	prices, err := client.GetMultiPrice(context.Background(), addresses)
+
+	assert.Error(t, err)
+	assert.Nil(t, prices)
+	assert.Contains(t, err.Error(), "exceeds maximum allowed")
+}
+
+func TestGetTokenTradeStats_Success(t *testing.T) {
+	expectedPath := "/defi/v3/token/trade-data/single"
+	nativeSol := "11111111111111111111111111111111"
+	wrappedSol := "So11111111111111111111111111111111111111112"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, expectedPath, r.URL.Path)
+		assert.Equal(t, wrappedSol, r.URL.Query().Get("address"))
+
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(`{"success": true, "data": {
+			"address": "` + wrappedSol + `",
+			"trade_5m": 12, "buy_5m": 8, "sell_5m": 4, "unique_wallet_5m": 9,
+			"volume_5m_usd": 1500.5, "volume_buy_5m_usd": 1000.25, "volume_sell_5m_usd": 500.25,
+			"trade_24h": 4200, "buy_24h": 2000, "sell_24h": 2200, "unique_wallet_24h": 1300
+		}}`))
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+
+	client := birdeyeclient.NewClient(server.Client(), server.URL, testAPIKey)
+
+	stats, err := client.GetTokenTradeStats(context.Background(), nativeSol)
+
+	assert.NoError(t, err)
+	assert.Equal(t, nativeSol, stats.Address) // Native SOL restored
+	assert.Equal(t, 12, stats.Trade5m)
+	assert.Equal(t, 8, stats.Buy5m)
+	assert.Equal(t, 9, stats.UniqueWallet5m)
+	assert.Equal(t, 1000.25, stats.VolumeBuy5mUSD)
+	assert.Equal(t, 2200, stats.Sell24h)
+	assert.Equal(t, 1300, stats.UniqueWallet24h)
+}
@@ -5,10 +5,13 @@ import "context"
 // ClientAPI defines the interface for the BirdEye client.
 type ClientAPI interface {
 	GetPriceHistory(ctx context.Context, params PriceHistoryParams) (*PriceHistory, error)
+	GetOHLCV(ctx context.Context, params PriceHistoryParams) (*OHLCV, error)
 	GetTrendingTokens(ctx context.Context, params TrendingTokensParams) (*TokenTrendingResponse, error)
 	GetTokenOverview(ctx context.Context, address string) (*TokenOverview, error)
 	GetTokensOverviewBatch(ctx context.Context, addresses []string) ([]TokenOverviewData, error)
 	GetTokensTradeDataBatch(ctx context.Context, addresses []string) ([]TokenTradeData, error)
+	GetTokenTradeStats(ctx context.Context, address string) (*TokenTradeStats, error)
+	GetMultiPrice(ctx context.Context, addresses []string) (map[string]float64, error)
 	GetNewListingTokens(ctx context.Context, params NewListingTokensParams) (*NewListingTokensResponse, error)
 	Search(ctx context.Context, params SearchParams) (*SearchResponse, error)
 	GetMaxWorkers() int
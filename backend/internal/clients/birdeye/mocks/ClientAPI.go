@@ -82,6 +82,74 @@ func (_c *MockClientAPI_GetMaxWorkers_Call) RunAndReturn(run func() int) *MockCl
 	return _c
 }
 
+// GetMultiPrice provides a mock function for the type MockClientAPI
+func (_mock *MockClientAPI) GetMultiPrice(ctx context.Context, addresses []string) (map[string]float64, error) {
+	ret := _mock.Called(ctx, addresses)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMultiPrice")
+	}
+
+	var r0 map[string]float64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) (map[string]float64, error)); ok {
+		return returnFunc(ctx, addresses)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) map[string]float64); ok {
+		r0 = returnFunc(ctx, addresses)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]float64)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = returnFunc(ctx, addresses)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClientAPI_GetMultiPrice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMultiPrice'
+type MockClientAPI_GetMultiPrice_Call struct {
+	*mock.Call
+}
+
+// GetMultiPrice is a helper method to define mock.On call
+//   - ctx context.Context
+//   - addresses []string
+func (_e *MockClientAPI_Expecter) GetMultiPrice(ctx interface{}, addresses interface{}) *MockClientAPI_GetMultiPrice_Call {
+	return &MockClientAPI_GetMultiPrice_Call{Call: _e.mock.On("GetMultiPrice", ctx, addresses)}
+}
+
+func (_c *MockClientAPI_GetMultiPrice_Call) Run(run func(ctx context.Context, addresses []string)) *MockClientAPI_GetMultiPrice_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClientAPI_GetMultiPrice_Call) Return(stringToFloat64 map[string]float64, err error) *MockClientAPI_GetMultiPrice_Call {
+	_c.Call.Return(stringToFloat64, err)
+	return _c
+}
+
+func (_c *MockClientAPI_GetMultiPrice_Call) RunAndReturn(run func(ctx context.Context, addresses []string) (map[string]float64, error)) *MockClientAPI_GetMultiPrice_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetNewListingTokens provides a mock function for the type MockClientAPI
 func (_mock *MockClientAPI) GetNewListingTokens(ctx context.Context, params birdeye.NewListingTokensParams) (*birdeye.NewListingTokensResponse, error) {
 	ret := _mock.Called(ctx, params)
@@ -150,6 +218,74 @@ func (_c *MockClientAPI_GetNewListingTokens_Call) RunAndReturn(run func(ctx cont
 	return _c
 }
 
+// GetOHLCV provides a mock function for the type MockClientAPI
+func (_mock *MockClientAPI) GetOHLCV(ctx context.Context, params birdeye.PriceHistoryParams) (*birdeye.OHLCV, error) {
+	ret := _mock.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOHLCV")
+	}
+
+	var r0 *birdeye.OHLCV
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, birdeye.PriceHistoryParams) (*birdeye.OHLCV, error)); ok {
+		return returnFunc(ctx, params)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, birdeye.PriceHistoryParams) *birdeye.OHLCV); ok {
+		r0 = returnFunc(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*birdeye.OHLCV)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, birdeye.PriceHistoryParams) error); ok {
+		r1 = returnFunc(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClientAPI_GetOHLCV_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOHLCV'
+type MockClientAPI_GetOHLCV_Call struct {
+	*mock.Call
+}
+
+// GetOHLCV is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params birdeye.PriceHistoryParams
+func (_e *MockClientAPI_Expecter) GetOHLCV(ctx interface{}, params interface{}) *MockClientAPI_GetOHLCV_Call {
+	return &MockClientAPI_GetOHLCV_Call{Call: _e.mock.On("GetOHLCV", ctx, params)}
+}
+
+func (_c *MockClientAPI_GetOHLCV_Call) Run(run func(ctx context.Context, params birdeye.PriceHistoryParams)) *MockClientAPI_GetOHLCV_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 birdeye.PriceHistoryParams
+		if args[1] != nil {
+			arg1 = args[1].(birdeye.PriceHistoryParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClientAPI_GetOHLCV_Call) Return(oHLCV *birdeye.OHLCV, err error) *MockClientAPI_GetOHLCV_Call {
+	_c.Call.Return(oHLCV, err)
+	return _c
+}
+
+func (_c *MockClientAPI_GetOHLCV_Call) RunAndReturn(run func(ctx context.Context, params birdeye.PriceHistoryParams) (*birdeye.OHLCV, error)) *MockClientAPI_GetOHLCV_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPriceHistory provides a mock function for the type MockClientAPI
 func (_mock *MockClientAPI) GetPriceHistory(ctx context.Context, params birdeye.PriceHistoryParams) (*birdeye.PriceHistory, error) {
 	ret := _mock.Called(ctx, params)
@@ -286,6 +422,74 @@ func (_c *MockClientAPI_GetTokenOverview_Call) RunAndReturn(run func(ctx context
 	return _c
 }
 
+// GetTokenTradeStats provides a mock function for the type MockClientAPI
+func (_mock *MockClientAPI) GetTokenTradeStats(ctx context.Context, address string) (*birdeye.TokenTradeStats, error) {
+	ret := _mock.Called(ctx, address)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTokenTradeStats")
+	}
+
+	var r0 *birdeye.TokenTradeStats
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*birdeye.TokenTradeStats, error)); ok {
+		return returnFunc(ctx, address)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *birdeye.TokenTradeStats); ok {
+		r0 = returnFunc(ctx, address)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*birdeye.TokenTradeStats)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, address)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClientAPI_GetTokenTradeStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTokenTradeStats'
+type MockClientAPI_GetTokenTradeStats_Call struct {
+	*mock.Call
+}
+
+// GetTokenTradeStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - address string
+func (_e *MockClientAPI_Expecter) GetTokenTradeStats(ctx interface{}, address interface{}) *MockClientAPI_GetTokenTradeStats_Call {
+	return &MockClientAPI_GetTokenTradeStats_Call{Call: _e.mock.On("GetTokenTradeStats", ctx, address)}
+}
+
+func (_c *MockClientAPI_GetTokenTradeStats_Call) Run(run func(ctx context.Context, address string)) *MockClientAPI_GetTokenTradeStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClientAPI_GetTokenTradeStats_Call) Return(tokenTradeStats *birdeye.TokenTradeStats, err error) *MockClientAPI_GetTokenTradeStats_Call {
+	_c.Call.Return(tokenTradeStats, err)
+	return _c
+}
+
+func (_c *MockClientAPI_GetTokenTradeStats_Call) RunAndReturn(run func(ctx context.Context, address string) (*birdeye.TokenTradeStats, error)) *MockClientAPI_GetTokenTradeStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetTokensOverviewBatch provides a mock function for the type MockClientAPI
 func (_mock *MockClientAPI) GetTokensOverviewBatch(ctx context.Context, addresses []string) ([]birdeye.TokenOverviewData, error) {
 	ret := _mock.Called(ctx, addresses)
@@ -48,6 +48,27 @@ type PriceHistoryItem struct {
 	Value    float64 `json:"value"`
 }
 
+// OHLCV represents the response from the OHLCV API
+type OHLCV struct {
+	Data    OHLCVData `json:"data"`
+	Success bool      `json:"success"`
+}
+
+// OHLCVData contains the candles
+type OHLCVData struct {
+	Items []OHLCVItem `json:"items"`
+}
+
+// OHLCVItem is one candle, in USD
+type OHLCVItem struct {
+	UnixTime int64   `json:"unixTime"` // Start of the candle
+	Open     float64 `json:"o"`
+	High     float64 `json:"h"`
+	Low      float64 `json:"l"`
+	Close    float64 `json:"c"`
+	Volume   float64 `json:"v"` // In tokens
+}
+
 // PriceHistoryParams contains parameters for the GetPriceHistory request
 type PriceHistoryParams struct {
 	Address     string    // Token address
@@ -199,6 +220,54 @@ type TokenTradeData struct {
 	Rank                   int     `json:"rank"`
 }
 
+// TokenTradeStatsResponse represents the response from the single token trade data API
+type TokenTradeStatsResponse struct {
+	Data    TokenTradeStats `json:"data"`
+	Success bool            `json:"success"`
+}
+
+// TokenTradeStats contains trade activity for a token over rolling 5m, 1h and 24h windows
+type TokenTradeStats struct {
+	Address string `json:"address"`
+
+	Trade5m          int     `json:"trade_5m"`
+	Buy5m            int     `json:"buy_5m"`
+	Sell5m           int     `json:"sell_5m"`
+	UniqueWallet5m   int     `json:"unique_wallet_5m"`
+	Volume5mUSD      float64 `json:"volume_5m_usd"`
+	VolumeBuy5mUSD   float64 `json:"volume_buy_5m_usd"`
+	VolumeSell5mUSD  float64 `json:"volume_sell_5m_usd"`
+	Trade1h          int     `json:"trade_1h"`
+	Buy1h            int     `json:"buy_1h"`
+	Sell1h           int     `json:"sell_1h"`
+	UniqueWallet1h   int     `json:"unique_wallet_1h"`
+	Volume1hUSD      float64 `json:"volume_1h_usd"`
+	VolumeBuy1hUSD   float64 `json:"volume_buy_1h_usd"`
+	VolumeSell1hUSD  float64 `json:"volume_sell_1h_usd"`
+	Trade24h         int     `json:"trade_24h"`
+	Buy24h           int     `json:"buy_24h"`
+	Sell24h          int     `json:"sell_24h"`
+	UniqueWallet24h  int     `json:"unique_wallet_24h"`
+	Volume24hUSD     float64 `json:"volume_24h_usd"`
+	VolumeBuy24hUSD  float64 `json:"volume_buy_24h_usd"`
+	VolumeSell24hUSD float64 `json:"volume_sell_24h_usd"`
+}
+
+// MultiPriceResponse represents the response from the multi price API
+type MultiPriceResponse struct {
+	Data    map[string]*MultiPriceData `json:"data"`
+	Success bool                       `json:"success"`
+}
+
+// MultiPriceData contains the current price information for a token
+type MultiPriceData struct {
+	Value           float64 `json:"value"`
+	UpdateUnixTime  int64   `json:"updateUnixTime"`
+	UpdateHumanTime string  `json:"updateHumanTime"`
+	PriceChange24h  float64 `json:"priceChange24h"`
+	Liquidity       float64 `json:"liquidity"`
+}
+
 // NewListingTokensResponse represents the response from the new listing tokens API
 type NewListingTokensResponse struct {
 	Data    NewListingTokensData `json:"data"`
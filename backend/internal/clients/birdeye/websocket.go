@@ -0,0 +1,348 @@
+package birdeye
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// MaxStreamAddresses is the most addresses one websocket subscription accepts
+const MaxStreamAddresses = 100
+
+const (
+	minStreamReconnectDelay = time.Second
+	maxStreamReconnectDelay = 30 * time.Second
+	streamPingInterval      = 30 * time.Second
+	// streamReadTimeout drops connections that have gone quiet, pongs included
+	streamReadTimeout  = 90 * time.Second
+	streamWriteTimeout = 10 * time.Second
+	// streamChartType is the candle the price subscription streams; its close is the price
+	streamChartType = "1m"
+)
+
+// PriceEvent is a real-time price update from the websocket.
+type PriceEvent struct {
+	Address string
+	Price   float64 // Close of the current candle, in USD
+	Open    float64
+	High    float64
+	Low     float64
+	Volume  float64
+	Time    time.Time
+}
+
+// TradeEvent is a swap of a subscribed token seen by the websocket.
+type TradeEvent struct {
+	Address   string // The subscribed token
+	TxHash    string
+	Owner     string
+	Side      string // "buy" or "sell"
+	Source    string // DEX, e.g. "raydium"
+	VolumeUSD float64
+	Time      time.Time
+}
+
+// StreamHandler receives websocket events. Handlers are called from the
+// stream's read loop and should return quickly.
+type StreamHandler interface {
+	HandlePrice(PriceEvent)
+	HandleTrade(TradeEvent)
+}
+
+// Stream subscribes to BirdEye's websocket price and trade feeds for a set of
+// tokens. Dropped connections are redialed and resubscribed; changing the
+// set resubscribes on the open connection.
+type Stream struct {
+	endpoint string
+	apiKey   string
+	dialer   *websocket.Dialer
+
+	mu      sync.Mutex
+	prices  []string
+	trades  []string
+	changed chan struct{}
+}
+
+// NewStream creates a stream for a BirdEye websocket endpoint, e.g.
+// wss://public-api.birdeye.so/socket/solana
+func NewStream(endpoint, apiKey string) *Stream {
+	return &Stream{
+		endpoint: endpoint,
+		apiKey:   apiKey,
+		dialer:   &websocket.Dialer{HandshakeTimeout: 10 * time.Second, Subprotocols: []string{"echo-protocol"}},
+		changed:  make(chan struct{}, 1),
+	}
+}
+
+// SetAddresses replaces the tokens streamed for prices and for trades. Only
+// the first MaxStreamAddresses of each are subscribed.
+func (s *Stream) SetAddresses(prices, trades []string) {
+	s.mu.Lock()
+	s.prices = capAddresses(prices)
+	s.trades = capAddresses(trades)
+	s.mu.Unlock()
+	select {
+	case s.changed <- struct{}{}:
+	default:
+	}
+}
+
+func capAddresses(addresses []string) []string {
+	if len(addresses) > MaxStreamAddresses {
+		slog.Warn("Too many addresses for the BirdEye stream, the rest are polled",
+			slog.Int("addresses", len(addresses)), slog.Int("max", MaxStreamAddresses))
+		addresses = addresses[:MaxStreamAddresses]
+	}
+	return append([]string(nil), addresses...)
+}
+
+func (s *Stream) snapshot() (prices, trades []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.prices, s.trades
+}
+
+// Run streams events to handler until ctx is cancelled.
+func (s *Stream) Run(ctx context.Context, handler StreamHandler) {
+	delay := minStreamReconnectDelay
+	for {
+		started := time.Now()
+		err := s.session(ctx, handler)
+		if ctx.Err() != nil {
+			return
+		}
+		if time.Since(started) > maxStreamReconnectDelay {
+			delay = minStreamReconnectDelay
+		}
+		slog.WarnContext(ctx, "BirdEye websocket session ended, reconnecting",
+			slog.Any("error", err), slog.Duration("delay", delay))
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return
+		}
+		delay = min(delay*2, maxStreamReconnectDelay)
+	}
+}
+
+// session streams over one connection until it fails or ctx is cancelled.
+// Only this goroutine writes to the connection; a second one reads.
+func (s *Stream) session(ctx context.Context, handler StreamHandler) error {
+	// Wait for something to watch rather than holding an idle connection
+	for {
+		prices, trades := s.snapshot()
+		if len(prices) > 0 || len(trades) > 0 {
+			break
+		}
+		select {
+		case <-s.changed:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+
+	conn, err := s.dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	readErr := make(chan error, 1)
+	go func() {
+		readErr <- s.readLoop(conn, handler)
+	}()
+
+	var subscribed bool
+	for {
+		// Drain before reading the set so a change made meanwhile is not lost
+		select {
+		case <-s.changed:
+		default:
+		}
+		prices, trades := s.snapshot()
+		if err := s.subscribe(conn, prices, trades, subscribed); err != nil {
+			return err
+		}
+		subscribed = true
+
+	wait:
+		for {
+			ping := time.NewTimer(streamPingInterval)
+			select {
+			case <-s.changed:
+				ping.Stop()
+				break wait
+			case err := <-readErr:
+				ping.Stop()
+				return err
+			case <-ping.C:
+				if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(streamWriteTimeout)); err != nil {
+					return fmt.Errorf("failed to ping BirdEye websocket: %w", err)
+				}
+			case <-ctx.Done():
+				ping.Stop()
+				_ = conn.WriteControl(websocket.CloseMessage,
+					websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(time.Second))
+				return nil
+			}
+		}
+	}
+}
+
+func (s *Stream) dial(ctx context.Context) (*websocket.Conn, error) {
+	u, err := url.Parse(s.endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid BirdEye websocket endpoint: %w", err)
+	}
+	query := u.Query()
+	query.Set("x-api-key", s.apiKey)
+	u.RawQuery = query.Encode()
+
+	header := http.Header{}
+	header.Set("Origin", "ws://public-api.birdeye.so")
+	conn, resp, err := s.dialer.DialContext(ctx, u.String(), header)
+	if err != nil {
+		if resp != nil {
+			return nil, fmt.Errorf("failed to connect to BirdEye websocket: status %d: %w", resp.StatusCode, err)
+		}
+		return nil, fmt.Errorf("failed to connect to BirdEye websocket: %w", err)
+	}
+	return conn, nil
+}
+
+// subscribe replaces the connection's subscriptions. BirdEye keeps one price
+// and one trade subscription per connection, each a query over addresses.
+func (s *Stream) subscribe(conn *websocket.Conn, prices, trades []string, resubscribe bool) error {
+	var messages []streamMessage
+	if resubscribe {
+		messages = append(messages, streamMessage{Type: "UNSUBSCRIBE_PRICE"}, streamMessage{Type: "UNSUBSCRIBE_TXS"})
+	}
+	if len(prices) > 0 {
+		clauses := make([]string, len(prices))
+		for i, address := range prices {
+			clauses[i] = fmt.Sprintf("(address = %s AND chartType = %s AND currency = usd)", address, streamChartType)
+		}
+		messages = append(messages, streamMessage{Type: "SUBSCRIBE_PRICE", Data: &subscribeData{
+			QueryType: "complex",
+			Query:     strings.Join(clauses, " OR "),
+		}})
+	}
+	if len(trades) > 0 {
+		clauses := make([]string, len(trades))
+		for i, address := range trades {
+			clauses[i] = "address = " + address
+		}
+		messages = append(messages, streamMessage{Type: "SUBSCRIBE_TXS", Data: &subscribeData{
+			QueryType: "complex",
+			Query:     strings.Join(clauses, " OR "),
+		}})
+	}
+	for _, message := range messages {
+		_ = conn.SetWriteDeadline(time.Now().Add(streamWriteTimeout))
+		if err := conn.WriteJSON(message); err != nil {
+			return fmt.Errorf("failed to send %s: %w", message.Type, err)
+		}
+	}
+	return nil
+}
+
+func (s *Stream) readLoop(conn *websocket.Conn, handler StreamHandler) error {
+	_ = conn.SetReadDeadline(time.Now().Add(streamReadTimeout))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(streamReadTimeout))
+	})
+	for {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			return fmt.Errorf("failed to read BirdEye websocket: %w", err)
+		}
+		_ = conn.SetReadDeadline(time.Now().Add(streamReadTimeout))
+		dispatchStreamMessage(data, handler)
+	}
+}
+
+// dispatchStreamMessage decodes one message and hands its event to handler.
+// Acknowledgements, welcome messages and unknown types are ignored.
+func dispatchStreamMessage(data []byte, handler StreamHandler) {
+	var envelope struct {
+		Type string          `json:"type"`
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		slog.Debug("Ignoring undecodable BirdEye websocket message", slog.Any("error", err))
+		return
+	}
+	switch envelope.Type {
+	case "PRICE_DATA":
+		var price streamPriceData
+		if err := json.Unmarshal(envelope.Data, &price); err != nil || price.Address == "" {
+			slog.Debug("Ignoring malformed BirdEye price event", slog.Any("error", err))
+			return
+		}
+		handler.HandlePrice(PriceEvent{
+			Address: price.Address,
+			Price:   price.Close,
+			Open:    price.Open,
+			High:    price.High,
+			Low:     price.Low,
+			Volume:  price.Volume,
+			Time:    time.Unix(price.UnixTime, 0),
+		})
+	case "TXS_DATA":
+		var trade streamTradeData
+		if err := json.Unmarshal(envelope.Data, &trade); err != nil || trade.TokenAddress == "" {
+			slog.Debug("Ignoring malformed BirdEye trade event", slog.Any("error", err))
+			return
+		}
+		handler.HandleTrade(TradeEvent{
+			Address:   trade.TokenAddress,
+			TxHash:    trade.TxHash,
+			Owner:     trade.Owner,
+			Side:      trade.Side,
+			Source:    trade.Source,
+			VolumeUSD: trade.VolumeUSD,
+			Time:      time.Unix(trade.BlockUnixTime, 0),
+		})
+	}
+}
+
+// streamMessage is a subscription request sent to the websocket
+type streamMessage struct {
+	Type string         `json:"type"`
+	Data *subscribeData `json:"data,omitempty"`
+}
+
+type subscribeData struct {
+	QueryType string `json:"queryType"`
+	Query     string `json:"query"`
+}
+
+// streamPriceData is the data of a PRICE_DATA message, an OHLCV candle
+type streamPriceData struct {
+	Address  string  `json:"address"`
+	Open     float64 `json:"o"`
+	High     float64 `json:"h"`
+	Low      float64 `json:"l"`
+	Close    float64 `json:"c"`
+	Volume   float64 `json:"v"`
+	UnixTime int64   `json:"unixTime"`
+}
+
+// streamTradeData is the data of a TXS_DATA message
+type streamTradeData struct {
+	TokenAddress  string  `json:"tokenAddress"`
+	TxHash        string  `json:"txHash"`
+	Owner         string  `json:"owner"`
+	Side          string  `json:"side"`
+	Source        string  `json:"source"`
+	VolumeUSD     float64 `json:"volumeUSD"`
+	BlockUnixTime int64   `json:"blockUnixTime"`
+}
@@ -0,0 +1,99 @@
+package birdeye_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	birdeyeclient "github.com/nicolas-martin/dankfolio/backend/internal/clients/birdeye"
+)
+
+type recordingStreamHandler struct {
+	mu     sync.Mutex
+	prices []birdeyeclient.PriceEvent
+	trades []birdeyeclient.TradeEvent
+}
+
+func (h *recordingStreamHandler) HandlePrice(event birdeyeclient.PriceEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.prices = append(h.prices, event)
+}
+
+func (h *recordingStreamHandler) HandleTrade(event birdeyeclient.TradeEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.trades = append(h.trades, event)
+}
+
+func (h *recordingStreamHandler) counts() (int, int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return len(h.prices), len(h.trades)
+}
+
+func TestStream(t *testing.T) {
+	const mint = "So11111111111111111111111111111111111111112"
+	subscriptions := make(chan map[string]any, 4)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, testAPIKey, r.URL.Query().Get("x-api-key"))
+		// The client sends BirdEye's origin, not the test server's
+		upgrader := websocket.Upgrader{Subprotocols: []string{"echo-protocol"}, CheckOrigin: func(*http.Request) bool { return true }}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if !assert.NoError(t, err) {
+			return
+		}
+		defer conn.Close()
+		for range 2 {
+			var message map[string]any
+			if err := conn.ReadJSON(&message); err != nil {
+				return
+			}
+			subscriptions <- message
+		}
+		_ = conn.WriteMessage(websocket.TextMessage, []byte(`{"type":"WELCOME"}`))
+		_ = conn.WriteMessage(websocket.TextMessage, []byte(`{"type":"PRICE_DATA","data":{"o":150,"h":152,"l":149,"c":151.5,"v":1200,"eventType":"ohlcv","type":"1m","unixTime":1735689600,"symbol":"SOL","address":"`+mint+`"}}`))
+		_ = conn.WriteMessage(websocket.TextMessage, []byte(`{"type":"TXS_DATA","data":{"blockUnixTime":1735689601,"owner":"owner","source":"raydium","txHash":"hash","side":"buy","tokenAddress":"`+mint+`","volumeUSD":42.5}}`))
+		// Hold the connection until the client goes away
+		_, _, _ = conn.ReadMessage()
+	}))
+	defer server.Close()
+
+	stream := birdeyeclient.NewStream("ws"+strings.TrimPrefix(server.URL, "http"), testAPIKey)
+	stream.SetAddresses([]string{mint}, []string{mint})
+	handler := &recordingStreamHandler{}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		stream.Run(ctx, handler)
+		close(done)
+	}()
+
+	price := <-subscriptions
+	assert.Equal(t, "SUBSCRIBE_PRICE", price["type"])
+	assert.Equal(t, "(address = "+mint+" AND chartType = 1m AND currency = usd)", price["data"].(map[string]any)["query"])
+	trades := <-subscriptions
+	assert.Equal(t, "SUBSCRIBE_TXS", trades["type"])
+	assert.Equal(t, "address = "+mint, trades["data"].(map[string]any)["query"])
+
+	require.Eventually(t, func() bool {
+		prices, trades := handler.counts()
+		return prices == 1 && trades == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	cancel()
+	<-done
+
+	assert.Equal(t, mint, handler.prices[0].Address)
+	assert.Equal(t, 151.5, handler.prices[0].Price)
+	assert.Equal(t, int64(1735689600), handler.prices[0].Time.Unix())
+	assert.Equal(t, "buy", handler.trades[0].Side)
+	assert.Equal(t, 42.5, handler.trades[0].VolumeUSD)
+}
@@ -0,0 +1,147 @@
+// Package cdn serves proxied images through CloudFront or Cloudflare: it maps
+// object keys to CDN URLs, signs them for private buckets and invalidates the
+// cached copy of replaced objects.
+package cdn
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients"
+)
+
+const (
+	ProviderCloudFront = "cloudfront"
+	ProviderCloudflare = "cloudflare"
+)
+
+// Config selects the CDN in front of the bucket and how to sign and
+// invalidate its URLs. Only the provider's own fields are used.
+type Config struct {
+	Provider     string        // ProviderCloudFront or ProviderCloudflare
+	URLPrefix    string        // Origin of the CDN, e.g. https://icons.dankfolio.app
+	SignedURLTTL time.Duration // Served URLs are signed to expire after this long; 0 serves them unsigned
+
+	// CloudFront
+	KeyPairID       string // Public key in the distribution's trusted key group
+	PrivateKeyPEM   string // RSA private key of KeyPairID
+	DistributionID  string
+	AccessKeyID     string // Credentials allowed to create invalidations
+	SecretAccessKey string
+
+	// Cloudflare
+	SigningSecret string // HMAC secret the edge verifies signed URLs with
+	ZoneID        string
+	APIToken      string // Token allowed to purge the zone's cache
+}
+
+// provider signs and invalidates URLs for one CDN
+type provider interface {
+	sign(rawURL string, expires time.Time) (string, error)
+	invalidate(ctx context.Context, urls []string) error
+}
+
+// Client hands out CDN URLs for object keys
+type Client struct {
+	urlPrefix    string
+	signedURLTTL time.Duration
+	provider     provider
+	now          func() time.Time
+}
+
+// NewClient creates a client for the configured CDN. Invalidations are sent
+// with httpClient.
+func NewClient(httpClient clients.HTTPDoer, config Config) (*Client, error) {
+	if config.URLPrefix == "" {
+		return nil, fmt.Errorf("CDN URL prefix is required")
+	}
+	c := &Client{
+		urlPrefix:    strings.TrimSuffix(config.URLPrefix, "/"),
+		signedURLTTL: config.SignedURLTTL,
+		now:          time.Now,
+	}
+	var err error
+	switch config.Provider {
+	case ProviderCloudFront:
+		c.provider, err = newCloudFront(httpClient, config)
+	case ProviderCloudflare:
+		c.provider = newCloudflare(httpClient, config)
+	default:
+		return nil, fmt.Errorf("unknown CDN provider %q, want %s or %s", config.Provider, ProviderCloudFront, ProviderCloudflare)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// NewClientFromEnv creates a client for the CDN configured by environment
+// variables
+func NewClientFromEnv(httpClient clients.HTTPDoer) (*Client, error) {
+	cfg := Config{
+		Provider:        os.Getenv("CDN_PROVIDER"),
+		URLPrefix:       os.Getenv("CDN_URL_PREFIX"),
+		KeyPairID:       os.Getenv("CLOUDFRONT_KEY_PAIR_ID"),
+		PrivateKeyPEM:   os.Getenv("CLOUDFRONT_PRIVATE_KEY"),
+		DistributionID:  os.Getenv("CLOUDFRONT_DISTRIBUTION_ID"),
+		AccessKeyID:     os.Getenv("CLOUDFRONT_ACCESS_KEY_ID"),
+		SecretAccessKey: os.Getenv("CLOUDFRONT_SECRET_ACCESS_KEY"),
+		SigningSecret:   os.Getenv("CLOUDFLARE_SIGNING_SECRET"),
+		ZoneID:          os.Getenv("CLOUDFLARE_ZONE_ID"),
+		APIToken:        os.Getenv("CLOUDFLARE_API_TOKEN"),
+	}
+	if v := os.Getenv("CDN_SIGNED_URL_TTL"); v != "" {
+		ttl, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CDN_SIGNED_URL_TTL %q: %w", v, err)
+		}
+		cfg.SignedURLTTL = ttl
+	}
+	return NewClient(httpClient, cfg)
+}
+
+// URL returns the CDN URL of the object at key
+func (c *Client) URL(key string) string {
+	return c.urlPrefix + "/" + strings.TrimPrefix(key, "/")
+}
+
+// Owns reports whether rawURL is served by the CDN
+func (c *Client) Owns(rawURL string) bool {
+	return strings.HasPrefix(rawURL, c.urlPrefix+"/")
+}
+
+// Sign returns rawURL signed to expire after the configured TTL, or rawURL
+// itself when signing is off or the URL isn't the CDN's. A URL that fails to
+// sign is logged and returned unsigned.
+func (c *Client) Sign(rawURL string) string {
+	if c == nil || c.signedURLTTL <= 0 || !c.Owns(rawURL) {
+		return rawURL
+	}
+	signed, err := c.provider.sign(rawURL, c.now().Add(c.signedURLTTL))
+	if err != nil {
+		slog.Warn("Failed to sign CDN URL", slog.String("url", rawURL), slog.Any("error", err))
+		return rawURL
+	}
+	return signed
+}
+
+// Invalidate drops the CDN's cached copies of the objects at keys, so the
+// next request fetches them from the bucket again
+func (c *Client) Invalidate(ctx context.Context, keys ...string) error {
+	if c == nil || len(keys) == 0 {
+		return nil
+	}
+	urls := make([]string, len(keys))
+	for i, key := range keys {
+		urls[i] = c.URL(key)
+	}
+	if err := c.provider.invalidate(ctx, urls); err != nil {
+		return fmt.Errorf("failed to invalidate %d CDN objects: %w", len(keys), err)
+	}
+	slog.InfoContext(ctx, "Invalidated CDN objects", slog.Any("keys", keys))
+	return nil
+}
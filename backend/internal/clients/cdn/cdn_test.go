@@ -0,0 +1,147 @@
+package cdn
+
+import (
+	"context"
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/apperrors"
+)
+
+var testNow = time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC)
+
+func TestCloudFrontSign(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+	client, err := NewClient(http.DefaultClient, Config{
+		Provider:      ProviderCloudFront,
+		URLPrefix:     "https://icons.example.com/",
+		SignedURLTTL:  time.Hour,
+		KeyPairID:     "K2JCJMDEHXQW5F",
+		PrivateKeyPEM: string(keyPEM),
+	})
+	require.NoError(t, err)
+	client.now = func() time.Time { return testNow }
+
+	signed := client.Sign(client.URL("tokens/mint.png"))
+	parsed, err := url.Parse(signed)
+	require.NoError(t, err)
+	query := parsed.Query()
+	assert.Equal(t, "K2JCJMDEHXQW5F", query.Get("Key-Pair-Id"))
+	assert.Equal(t, "1792069200", query.Get("Expires"))
+
+	// The signature verifies against the canned policy of the unsigned URL
+	policy := `{"Statement":[{"Resource":"https://icons.example.com/tokens/mint.png","Condition":{"DateLessThan":{"AWS:EpochTime":1792069200}}}]}`
+	signature := strings.NewReplacer("-", "+", "_", "=", "~", "/").Replace(query.Get("Signature"))
+	rawSignature, err := base64.StdEncoding.DecodeString(signature)
+	require.NoError(t, err)
+	digest := sha1.Sum([]byte(policy))
+	assert.NoError(t, rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA1, digest[:], rawSignature))
+
+	// Other hosts' URLs are served as is
+	assert.Equal(t, "https://ipfs.io/ipfs/cid", client.Sign("https://ipfs.io/ipfs/cid"))
+}
+
+func TestCloudflareSign(t *testing.T) {
+	client, err := NewClient(http.DefaultClient, Config{
+		Provider:      ProviderCloudflare,
+		URLPrefix:     "https://icons.example.com",
+		SignedURLTTL:  time.Hour,
+		SigningSecret: "secret",
+	})
+	require.NoError(t, err)
+	client.now = func() time.Time { return testNow }
+
+	signed, err := url.Parse(client.Sign("https://icons.example.com/tokens/mint.png"))
+	require.NoError(t, err)
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write([]byte("/tokens/mint.png1792069200"))
+	assert.Equal(t, "1792069200-"+base64.StdEncoding.EncodeToString(mac.Sum(nil)), signed.Query().Get("verify"))
+
+	// Unsigned without a TTL
+	client.signedURLTTL = 0
+	assert.Equal(t, "https://icons.example.com/tokens/mint.png", client.Sign("https://icons.example.com/tokens/mint.png"))
+}
+
+func TestInvalidate(t *testing.T) {
+	t.Run("cloudflare purges the URLs", func(t *testing.T) {
+		var files map[string][]string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/zones/zone/purge_cache", r.URL.Path)
+			assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&files))
+			_, _ = w.Write([]byte(`{"success":true,"errors":[]}`))
+		}))
+		defer server.Close()
+
+		client, err := NewClient(server.Client(), Config{Provider: ProviderCloudflare, URLPrefix: "https://icons.example.com", ZoneID: "zone", APIToken: "token"})
+		require.NoError(t, err)
+		client.provider.(*cloudflare).apiURL = server.URL
+
+		require.NoError(t, client.Invalidate(context.Background(), "tokens/mint.png"))
+		assert.Equal(t, []string{"https://icons.example.com/tokens/mint.png"}, files["files"])
+	})
+
+	t.Run("cloudfront creates a signed invalidation of the paths", func(t *testing.T) {
+		var body string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/distribution/E1ABC/invalidation", r.URL.Path)
+			assert.Contains(t, r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=AKID/")
+			data, _ := io.ReadAll(r.Body)
+			body = string(data)
+			w.WriteHeader(http.StatusCreated)
+		}))
+		defer server.Close()
+
+		client, err := NewClient(server.Client(), Config{
+			Provider:        ProviderCloudFront,
+			URLPrefix:       "https://icons.example.com",
+			DistributionID:  "E1ABC",
+			AccessKeyID:     "AKID",
+			SecretAccessKey: "secret",
+		})
+		require.NoError(t, err)
+		client.provider.(*cloudFront).apiURL = server.URL
+
+		require.NoError(t, client.Invalidate(context.Background(), "tokens/a.png", "tokens/b.png"))
+		assert.Contains(t, body, "<Quantity>2</Quantity><Items><Path>/tokens/a.png</Path><Path>/tokens/b.png</Path></Items>")
+	})
+
+	t.Run("failures are classified", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"success":false,"errors":[{"message":"rate limited"}]}`))
+		}))
+		defer server.Close()
+
+		client, err := NewClient(server.Client(), Config{Provider: ProviderCloudflare, URLPrefix: "https://icons.example.com", ZoneID: "zone", APIToken: "token"})
+		require.NoError(t, err)
+		client.provider.(*cloudflare).apiURL = server.URL
+		err = client.Invalidate(context.Background(), "tokens/mint.png")
+		assert.ErrorIs(t, err, apperrors.ErrUpstreamRateLimited)
+		assert.ErrorContains(t, err, "rate limited")
+	})
+
+	var none *Client
+	assert.NoError(t, none.Invalidate(context.Background(), "tokens/mint.png"))
+}
@@ -0,0 +1,107 @@
+package cdn
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/apperrors"
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients"
+)
+
+// cloudflareAPI is the Cloudflare API root
+const cloudflareAPI = "https://api.cloudflare.com/client/v4"
+
+type cloudflare struct {
+	httpClient    clients.HTTPDoer
+	apiURL        string
+	signingSecret []byte
+	zoneID        string
+	apiToken      string
+}
+
+func newCloudflare(httpClient clients.HTTPDoer, config Config) *cloudflare {
+	return &cloudflare{
+		httpClient:    httpClient,
+		apiURL:        cloudflareAPI,
+		signingSecret: []byte(config.SigningSecret),
+		zoneID:        config.ZoneID,
+		apiToken:      config.APIToken,
+	}
+}
+
+// sign adds the verify parameter the edge worker checks: the expiry and an
+// HMAC-SHA256 of the path followed by the expiry, see
+// https://developers.cloudflare.com/workers/examples/signing-requests/
+func (cf *cloudflare) sign(rawURL string, expires time.Time) (string, error) {
+	if len(cf.signingSecret) == 0 {
+		return "", fmt.Errorf("no Cloudflare signing secret configured")
+	}
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return "", err
+	}
+	expiry := strconv.FormatInt(expires.Unix(), 10)
+	mac := hmac.New(sha256.New, cf.signingSecret)
+	mac.Write([]byte(parsed.Path + expiry))
+
+	query := parsed.Query()
+	query.Set("verify", expiry+"-"+base64.StdEncoding.EncodeToString(mac.Sum(nil)))
+	parsed.RawQuery = query.Encode()
+	return parsed.String(), nil
+}
+
+type purgeResponse struct {
+	Success bool `json:"success"`
+	Errors  []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// invalidate purges the URLs from the zone's cache, see
+// https://developers.cloudflare.com/api/resources/cache/methods/purge/
+func (cf *cloudflare) invalidate(ctx context.Context, urls []string) error {
+	if cf.zoneID == "" || cf.apiToken == "" {
+		return fmt.Errorf("no Cloudflare zone or API token configured")
+	}
+	body, err := json.Marshal(map[string][]string{"files": urls})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		fmt.Sprintf("%s/zones/%s/purge_cache", cf.apiURL, url.PathEscape(cf.zoneID)), bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+cf.apiToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := cf.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+	var purge purgeResponse
+	decodeErr := json.Unmarshal(respBody, &purge)
+	if resp.StatusCode != http.StatusOK || decodeErr != nil || !purge.Success {
+		cause := fmt.Errorf("unexpected status %d", resp.StatusCode)
+		if len(purge.Errors) > 0 {
+			cause = fmt.Errorf("unexpected status %d: %s", resp.StatusCode, purge.Errors[0].Message)
+		}
+		return apperrors.FromHTTPResponse(resp, cause)
+	}
+	return nil
+}
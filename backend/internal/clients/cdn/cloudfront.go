@@ -0,0 +1,178 @@
+package cdn
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/apperrors"
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients"
+)
+
+// cloudFrontAPI is the CloudFront API root; its region is always us-east-1
+const cloudFrontAPI = "https://cloudfront.amazonaws.com/2020-05-31"
+
+// cloudFrontBase64 is the URL-safe base64 alphabet CloudFront signatures use
+var cloudFrontBase64 = strings.NewReplacer("+", "-", "=", "_", "/", "~")
+
+type cloudFront struct {
+	httpClient     clients.HTTPDoer
+	apiURL         string
+	keyPairID      string
+	privateKey     *rsa.PrivateKey // Nil leaves URLs unsigned
+	distributionID string
+	credentials    aws.Credentials
+	signer         *v4.Signer
+}
+
+func newCloudFront(httpClient clients.HTTPDoer, config Config) (*cloudFront, error) {
+	cf := &cloudFront{
+		httpClient:     httpClient,
+		apiURL:         cloudFrontAPI,
+		keyPairID:      config.KeyPairID,
+		distributionID: config.DistributionID,
+		credentials:    aws.Credentials{AccessKeyID: config.AccessKeyID, SecretAccessKey: config.SecretAccessKey},
+		signer:         v4.NewSigner(),
+	}
+	if config.PrivateKeyPEM != "" {
+		key, err := parseRSAPrivateKey(config.PrivateKeyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CloudFront private key: %w", err)
+		}
+		cf.privateKey = key
+	}
+	return cf, nil
+}
+
+func parseRSAPrivateKey(keyPEM string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(keyPEM))
+	if block == nil {
+		return nil, fmt.Errorf("no PEM block found")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("key is %T, want an RSA key", key)
+	}
+	return rsaKey, nil
+}
+
+// cannedPolicy allows fetching one URL until it expires
+type cannedPolicy struct {
+	Statement []cannedStatement `json:"Statement"`
+}
+
+type cannedStatement struct {
+	Resource  string `json:"Resource"`
+	Condition struct {
+		DateLessThan struct {
+			EpochTime int64 `json:"AWS:EpochTime"`
+		} `json:"DateLessThan"`
+	} `json:"Condition"`
+}
+
+// sign signs rawURL with a canned policy, see
+// https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/private-content-creating-signed-url-canned-policy.html
+func (cf *cloudFront) sign(rawURL string, expires time.Time) (string, error) {
+	if cf.privateKey == nil {
+		return "", fmt.Errorf("no CloudFront private key configured")
+	}
+	statement := cannedStatement{Resource: rawURL}
+	statement.Condition.DateLessThan.EpochTime = expires.Unix()
+	policy, err := json.Marshal(cannedPolicy{Statement: []cannedStatement{statement}})
+	if err != nil {
+		return "", err
+	}
+	digest := sha1.Sum(policy)
+	signature, err := rsa.SignPKCS1v15(rand.Reader, cf.privateKey, crypto.SHA1, digest[:])
+	if err != nil {
+		return "", err
+	}
+
+	params := url.Values{}
+	params.Set("Expires", strconv.FormatInt(expires.Unix(), 10))
+	params.Set("Signature", cloudFrontBase64.Replace(base64.StdEncoding.EncodeToString(signature)))
+	params.Set("Key-Pair-Id", cf.keyPairID)
+	separator := "?"
+	if strings.Contains(rawURL, "?") {
+		separator = "&"
+	}
+	return rawURL + separator + params.Encode(), nil
+}
+
+type invalidationBatch struct {
+	XMLName         xml.Name `xml:"http://cloudfront.amazonaws.com/doc/2020-05-31/ InvalidationBatch"`
+	Quantity        int      `xml:"Paths>Quantity"`
+	Paths           []string `xml:"Paths>Items>Path"`
+	CallerReference string   `xml:"CallerReference"`
+}
+
+// invalidate creates an invalidation of the URLs' paths, see
+// https://docs.aws.amazon.com/cloudfront/latest/APIReference/API_CreateInvalidation.html
+func (cf *cloudFront) invalidate(ctx context.Context, urls []string) error {
+	if cf.distributionID == "" {
+		return fmt.Errorf("no CloudFront distribution configured")
+	}
+	batch := invalidationBatch{CallerReference: strconv.FormatInt(time.Now().UnixNano(), 10)}
+	for _, rawURL := range urls {
+		parsed, err := url.Parse(rawURL)
+		if err != nil {
+			return fmt.Errorf("invalid URL %q: %w", rawURL, err)
+		}
+		batch.Paths = append(batch.Paths, parsed.EscapedPath())
+	}
+	batch.Quantity = len(batch.Paths)
+	body, err := xml.Marshal(batch)
+	if err != nil {
+		return err
+	}
+	body = append([]byte(xml.Header), body...)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		fmt.Sprintf("%s/distribution/%s/invalidation", cf.apiURL, url.PathEscape(cf.distributionID)), bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/xml")
+	payloadHash := sha256.Sum256(body)
+	if err := cf.signer.SignHTTP(ctx, cf.credentials, req, hex.EncodeToString(payloadHash[:]), "cloudfront", "us-east-1", time.Now()); err != nil {
+		return fmt.Errorf("failed to sign request: %w", err)
+	}
+
+	resp, err := cf.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return apperrors.FromHTTPResponse(resp, fmt.Errorf("unexpected status %d: %s", resp.StatusCode, respBody))
+	}
+	return nil
+}
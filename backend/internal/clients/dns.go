@@ -0,0 +1,344 @@
+package clients
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"log/slog"
+	"net"
+	"slices"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"golang.org/x/net/dns/dnsmessage"
+	"golang.org/x/sync/singleflight"
+)
+
+// DNS cache defaults
+const (
+	DefaultDNSMinTTL      = 5 * time.Second
+	DefaultDNSMaxTTL      = 5 * time.Minute
+	DefaultDNSNegativeTTL = 30 * time.Second
+	DefaultDNSStaleFor    = 10 * time.Minute
+
+	// unknownTTL is used for answers whose TTL wasn't seen, e.g. from /etc/hosts or over TCP
+	unknownTTL = 30 * time.Second
+	// dnsLookupTimeout bounds a lookup shared by concurrent callers
+	dnsLookupTimeout = 10 * time.Second
+	maxDNSEntries    = 4096
+)
+
+// DNSConfig tunes the caching resolver. Zero values use the defaults.
+type DNSConfig struct {
+	Disabled    bool          `envconfig:"DNS_CACHE_DISABLED"` // Resolve on every dial
+	MinTTL      time.Duration `envconfig:"DNS_CACHE_MIN_TTL"`  // Floor on answers' TTLs, so bursts share a lookup even for zero-TTL records
+	MaxTTL      time.Duration `envconfig:"DNS_CACHE_MAX_TTL"`
+	NegativeTTL time.Duration `envconfig:"DNS_CACHE_NEGATIVE_TTL"` // Longest a host that doesn't exist is remembered
+	StaleFor    time.Duration `envconfig:"DNS_CACHE_STALE_FOR"`    // How long past its TTL an answer is served when lookups fail
+}
+
+func (c DNSConfig) withDefaults() DNSConfig {
+	c.MinTTL = cmp.Or(c.MinTTL, DefaultDNSMinTTL)
+	c.MaxTTL = max(cmp.Or(c.MaxTTL, DefaultDNSMaxTTL), c.MinTTL)
+	c.NegativeTTL = cmp.Or(c.NegativeTTL, DefaultDNSNegativeTTL)
+	c.StaleFor = cmp.Or(c.StaleFor, DefaultDNSStaleFor)
+	return c
+}
+
+// CachingResolver caches host lookups for the TTL of their records, between
+// MinTTL and MaxTTL. Hosts that don't exist are cached for their zone's
+// negative TTL, up to NegativeTTL. When a lookup fails for any other reason,
+// the expired answer is served for up to StaleFor rather than failing the
+// dial. Concurrent lookups of a host share one query.
+type CachingResolver struct {
+	config   DNSConfig
+	resolver *net.Resolver
+	dialer   *net.Dialer // Reaches the nameservers
+	group    singleflight.Group
+
+	mu      sync.Mutex
+	entries map[string]dnsEntry
+
+	lookups metric.Int64Counter
+}
+
+type dnsEntry struct {
+	addrs   []net.IPAddr
+	err     error // Set for hosts that don't exist
+	expires time.Time
+}
+
+// NewCachingResolver returns a resolver using the system's nameservers
+func NewCachingResolver(config DNSConfig) *CachingResolver {
+	r := &CachingResolver{
+		config:  config.withDefaults(),
+		dialer:  &net.Dialer{Timeout: DefaultDialTimeout},
+		entries: make(map[string]dnsEntry),
+	}
+	// The Go resolver dials the nameservers through r.dial, which reads the
+	// TTLs off the responses
+	r.resolver = &net.Resolver{PreferGo: true, Dial: r.dial}
+
+	meter := otel.Meter("github.com/nicolas-martin/dankfolio/backend/internal/clients")
+	var err error
+	r.lookups, err = meter.Int64Counter(
+		"dankfolio.dns.lookups_total",
+		metric.WithDescription("Host lookups by outbound HTTP clients, by whether the cache answered"),
+		metric.WithUnit("{lookup}"),
+	)
+	if err != nil {
+		slog.Warn("Failed to create DNS lookup counter", slog.Any("error", err))
+	}
+	return r
+}
+
+// LookupIPAddr returns the host's addresses, from the cache while they're fresh
+func (r *CachingResolver) LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error) {
+	r.mu.Lock()
+	entry, ok := r.entries[host]
+	r.mu.Unlock()
+	if ok && time.Now().Before(entry.expires) {
+		if entry.err != nil {
+			r.count(ctx, "negative")
+			return nil, entry.err
+		}
+		r.count(ctx, "hit")
+		return entry.addrs, nil
+	}
+
+	// One caller giving up doesn't fail the others sharing the lookup
+	result := r.group.DoChan(host, func() (any, error) {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), dnsLookupTimeout)
+		defer cancel()
+		return r.refresh(ctx, host)
+	})
+	select {
+	case res := <-result:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return res.Val.([]net.IPAddr), nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (r *CachingResolver) refresh(ctx context.Context, host string) ([]net.IPAddr, error) {
+	ttl := &lookupTTL{}
+	addrs, err := r.resolver.LookupIPAddr(context.WithValue(ctx, lookupTTLKey{}, ttl), host)
+	now := time.Now()
+	if err == nil {
+		r.count(ctx, "miss")
+		// IPv4 first, since IPv6 routes are the likelier to be broken in containers
+		slices.SortStableFunc(addrs, func(a, b net.IPAddr) int {
+			return cmp.Compare(ipFamily(a), ipFamily(b))
+		})
+		expires := now.Add(min(max(ttl.answerTTL(), r.config.MinTTL), r.config.MaxTTL))
+		r.store(host, dnsEntry{addrs: addrs, expires: expires})
+		return addrs, nil
+	}
+
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) && dnsErr.IsNotFound {
+		r.count(ctx, "miss")
+		expires := now.Add(min(ttl.negativeTTL(r.config.NegativeTTL), r.config.NegativeTTL))
+		r.store(host, dnsEntry{err: err, expires: expires})
+		return nil, err
+	}
+
+	r.mu.Lock()
+	entry, ok := r.entries[host]
+	r.mu.Unlock()
+	if ok && entry.err == nil && now.Before(entry.expires.Add(r.config.StaleFor)) {
+		r.count(ctx, "stale")
+		slog.WarnContext(ctx, "DNS lookup failed, using the expired answer", slog.String("host", host), slog.Any("error", err))
+		return entry.addrs, nil
+	}
+	r.count(ctx, "error")
+	return nil, err
+}
+
+// ipFamily orders IPv4 addresses before IPv6 ones
+func ipFamily(addr net.IPAddr) int {
+	if addr.IP.To4() != nil {
+		return 4
+	}
+	return 6
+}
+
+func (r *CachingResolver) store(host string, entry dnsEntry) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.entries[host]; !ok && len(r.entries) >= maxDNSEntries {
+		now := time.Now()
+		for h, e := range r.entries {
+			if now.After(e.expires.Add(r.config.StaleFor)) {
+				delete(r.entries, h)
+			}
+		}
+		// Still full of live entries: make room with any of them
+		for h := range r.entries {
+			if len(r.entries) < maxDNSEntries {
+				break
+			}
+			delete(r.entries, h)
+		}
+	}
+	r.entries[host] = entry
+}
+
+func (r *CachingResolver) count(ctx context.Context, result string) {
+	if r.lookups != nil {
+		r.lookups.Add(ctx, 1, metric.WithAttributes(attribute.String("result", result)))
+	}
+}
+
+// DialContext returns a dial function for transports that resolves hosts
+// through the cache, trying each address in turn
+func (r *CachingResolver) DialContext(dialer *net.Dialer) func(ctx context.Context, network, address string) (net.Conn, error) {
+	return func(ctx context.Context, network, address string) (net.Conn, error) {
+		host, port, err := net.SplitHostPort(address)
+		if err != nil {
+			return nil, err
+		}
+		if net.ParseIP(host) != nil {
+			return dialer.DialContext(ctx, network, address)
+		}
+		addrs, err := r.LookupIPAddr(ctx, host)
+		if err != nil {
+			return nil, err
+		}
+
+		var errs []error
+		for _, addr := range addrs {
+			ipv4 := addr.IP.To4() != nil
+			if (network == "tcp4" && !ipv4) || (network == "tcp6" && ipv4) {
+				continue
+			}
+			conn, err := dialer.DialContext(ctx, network, net.JoinHostPort(addr.String(), port))
+			if err == nil {
+				return conn, nil
+			}
+			errs = append(errs, err)
+			if ctx.Err() != nil {
+				break
+			}
+		}
+		if len(errs) == 0 {
+			return nil, &net.DNSError{Err: "no " + network + " address", Name: host, IsNotFound: true}
+		}
+		return nil, errors.Join(errs...)
+	}
+}
+
+// dial connects the Go resolver to a nameserver, reading TTLs off UDP responses
+func (r *CachingResolver) dial(ctx context.Context, network, address string) (net.Conn, error) {
+	conn, err := r.dialer.DialContext(ctx, network, address)
+	if err != nil {
+		return nil, err
+	}
+	if ttl, ok := ctx.Value(lookupTTLKey{}).(*lookupTTL); ok {
+		if _, packets := conn.(net.PacketConn); packets {
+			return &ttlConn{Conn: conn, ttl: ttl}, nil
+		}
+	}
+	return conn, nil
+}
+
+type lookupTTLKey struct{}
+
+// lookupTTL collects the TTLs of the responses to one lookup's queries
+type lookupTTL struct {
+	mu       sync.Mutex
+	answer   time.Duration // Lowest answer TTL; 0 until one is seen
+	negative time.Duration // From the SOA of a response without answers
+	answered bool
+	denied   bool
+}
+
+func (t *lookupTTL) answerTTL() time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if !t.answered {
+		return unknownTTL
+	}
+	return t.answer
+}
+
+func (t *lookupTTL) negativeTTL(fallback time.Duration) time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if !t.denied {
+		return fallback
+	}
+	return t.negative
+}
+
+// observe reads the TTLs of a DNS response
+func (t *lookupTTL) observe(msg []byte) {
+	var p dnsmessage.Parser
+	if _, err := p.Start(msg); err != nil {
+		return
+	}
+	if err := p.SkipAllQuestions(); err != nil {
+		return
+	}
+	answers, err := p.AllAnswers()
+	if err != nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, answer := range answers {
+		switch answer.Header.Type {
+		case dnsmessage.TypeA, dnsmessage.TypeAAAA, dnsmessage.TypeCNAME:
+			ttl := time.Duration(answer.Header.TTL) * time.Second
+			if !t.answered || ttl < t.answer {
+				t.answer, t.answered = ttl, true
+			}
+		}
+	}
+	if len(answers) > 0 {
+		return
+	}
+	// RFC 2308: negative answers are cached for the lower of the SOA's TTL and its MINIMUM
+	for {
+		header, err := p.AuthorityHeader()
+		if err != nil {
+			return
+		}
+		if header.Type != dnsmessage.TypeSOA {
+			if err := p.SkipAuthority(); err != nil {
+				return
+			}
+			continue
+		}
+		soa, err := p.SOAResource()
+		if err != nil {
+			return
+		}
+		ttl := time.Duration(min(header.TTL, soa.MinTTL)) * time.Second
+		if !t.denied || ttl < t.negative {
+			t.negative, t.denied = ttl, true
+		}
+		return
+	}
+}
+
+// ttlConn passes a nameserver's responses to the lookup's TTL collector
+type ttlConn struct {
+	net.Conn
+	ttl *lookupTTL
+}
+
+func (c *ttlConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	if n > 0 {
+		c.ttl.observe(b[:n])
+	}
+	return n, err
+}
@@ -0,0 +1,84 @@
+package clients
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+func dnsResponse(t *testing.T, rcode dnsmessage.RCode, build func(b *dnsmessage.Builder)) []byte {
+	t.Helper()
+	b := dnsmessage.NewBuilder(nil, dnsmessage.Header{Response: true, RCode: rcode})
+	require.NoError(t, b.StartQuestions())
+	name := dnsmessage.MustNewName("gateway.example.com.")
+	require.NoError(t, b.Question(dnsmessage.Question{Name: name, Type: dnsmessage.TypeA, Class: dnsmessage.ClassINET}))
+	build(&b)
+	msg, err := b.Finish()
+	require.NoError(t, err)
+	return msg
+}
+
+func TestLookupTTL_Observe(t *testing.T) {
+	name := dnsmessage.MustNewName("gateway.example.com.")
+
+	ttl := &lookupTTL{}
+	assert.Equal(t, unknownTTL, ttl.answerTTL())
+	ttl.observe(dnsResponse(t, dnsmessage.RCodeSuccess, func(b *dnsmessage.Builder) {
+		require.NoError(t, b.StartAnswers())
+		require.NoError(t, b.AResource(dnsmessage.ResourceHeader{Name: name, Class: dnsmessage.ClassINET, TTL: 120}, dnsmessage.AResource{A: [4]byte{10, 0, 0, 1}}))
+		require.NoError(t, b.AResource(dnsmessage.ResourceHeader{Name: name, Class: dnsmessage.ClassINET, TTL: 60}, dnsmessage.AResource{A: [4]byte{10, 0, 0, 2}}))
+	}))
+	assert.Equal(t, time.Minute, ttl.answerTTL(), "the lowest answer TTL")
+
+	negative := &lookupTTL{}
+	assert.Equal(t, time.Second, negative.negativeTTL(time.Second))
+	negative.observe(dnsResponse(t, dnsmessage.RCodeNameError, func(b *dnsmessage.Builder) {
+		require.NoError(t, b.StartAuthorities())
+		require.NoError(t, b.SOAResource(
+			dnsmessage.ResourceHeader{Name: dnsmessage.MustNewName("example.com."), Class: dnsmessage.ClassINET, TTL: 900},
+			dnsmessage.SOAResource{NS: name, MBox: name, MinTTL: 300},
+		))
+	}))
+	assert.Equal(t, 5*time.Minute, negative.negativeTTL(time.Second), "the lower of the SOA's TTL and minimum")
+}
+
+func TestCachingResolver(t *testing.T) {
+	r := NewCachingResolver(DNSConfig{})
+	var lookups atomic.Int32
+	// Every query fails, as if the nameserver were unreachable
+	r.resolver = &net.Resolver{PreferGo: true, Dial: func(context.Context, string, string) (net.Conn, error) {
+		lookups.Add(1)
+		return nil, errors.New("unreachable")
+	}}
+	ctx := context.Background()
+	addrs := []net.IPAddr{{IP: net.ParseIP("10.0.0.1")}}
+
+	r.store("fresh.example.com", dnsEntry{addrs: addrs, expires: time.Now().Add(time.Minute)})
+	got, err := r.LookupIPAddr(ctx, "fresh.example.com")
+	require.NoError(t, err)
+	assert.Equal(t, addrs, got)
+	assert.Zero(t, lookups.Load(), "fresh answers come from the cache")
+
+	notFound := &net.DNSError{Err: "no such host", Name: "gone.example.com", IsNotFound: true}
+	r.store("gone.example.com", dnsEntry{err: notFound, expires: time.Now().Add(time.Minute)})
+	_, err = r.LookupIPAddr(ctx, "gone.example.com")
+	assert.ErrorIs(t, err, notFound)
+	assert.Zero(t, lookups.Load(), "missing hosts are cached too")
+
+	r.store("stale.example.com", dnsEntry{addrs: addrs, expires: time.Now().Add(-time.Minute)})
+	got, err = r.LookupIPAddr(ctx, "stale.example.com")
+	require.NoError(t, err, "expired answers stand in for failed lookups")
+	assert.Equal(t, addrs, got)
+	assert.NotZero(t, lookups.Load())
+
+	r.store("expired.example.com", dnsEntry{addrs: addrs, expires: time.Now().Add(-time.Hour)})
+	_, err = r.LookupIPAddr(ctx, "expired.example.com")
+	assert.Error(t, err, "answers past StaleFor aren't served")
+}
@@ -0,0 +1,156 @@
+package fxrates
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/apperrors"
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients"
+)
+
+const (
+	// DefaultECBURL is the ECB euro foreign exchange reference rates feed,
+	// published once per working day around 16:00 CET
+	DefaultECBURL = "https://www.ecb.europa.eu/stats/eurofxref/eurofxref-daily.xml"
+	// DefaultOpenExchangeRatesURL is the openexchangerates.org API root
+	DefaultOpenExchangeRatesURL = "https://openexchangerates.org/api"
+
+	latestEndpoint = "/latest.json"
+)
+
+// ECBClient reads the European Central Bank daily reference rates. It needs
+// no key but only quotes about thirty currencies, against EUR.
+type ECBClient struct {
+	httpClient clients.HTTPDoer
+	url        string
+}
+
+var _ ClientAPI = (*ECBClient)(nil)
+
+// NewECBClient creates a client for the ECB feed at url, or DefaultECBURL when empty
+func NewECBClient(httpClient clients.HTTPDoer, url string) *ECBClient {
+	if url == "" {
+		url = DefaultECBURL
+	}
+	return &ECBClient{httpClient: httpClient, url: url}
+}
+
+// GetLatestRates returns the latest EUR reference rates
+func (c *ECBClient) GetLatestRates(ctx context.Context) (*Rates, error) {
+	body, err := get(ctx, c.httpClient, c.url, "application/xml")
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch ECB rates: %w", err)
+	}
+
+	var envelope ecbEnvelope
+	if err := xml.Unmarshal(body, &envelope); err != nil {
+		return nil, fmt.Errorf("failed to decode ECB rates: %w", err)
+	}
+	if len(envelope.Cube.Days) == 0 {
+		return nil, fmt.Errorf("ECB rates document has no rates")
+	}
+
+	// The daily feed has one day; the historical feeds list the newest first
+	day := envelope.Cube.Days[0]
+	date, err := time.Parse(time.DateOnly, day.Time)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ECB rates date %q: %w", day.Time, err)
+	}
+	rates := &Rates{Base: "EUR", Date: date, Rates: make(map[string]float64, len(day.Rates))}
+	for _, rate := range day.Rates {
+		if rate.Rate > 0 {
+			rates.Rates[strings.ToUpper(rate.Currency)] = rate.Rate
+		}
+	}
+	return rates, nil
+}
+
+// OpenExchangeRatesClient reads the openexchangerates.org latest rates. The
+// free plan refreshes hourly, quotes against USD only and is limited to 1000
+// requests a month, which a daily cache stays well under.
+type OpenExchangeRatesClient struct {
+	httpClient clients.HTTPDoer
+	baseURL    string
+	appID      string
+}
+
+var _ ClientAPI = (*OpenExchangeRatesClient)(nil)
+
+// NewOpenExchangeRatesClient creates a client for the API at baseURL, or
+// DefaultOpenExchangeRatesURL when empty
+func NewOpenExchangeRatesClient(httpClient clients.HTTPDoer, baseURL, appID string) *OpenExchangeRatesClient {
+	if baseURL == "" {
+		baseURL = DefaultOpenExchangeRatesURL
+	}
+	return &OpenExchangeRatesClient{httpClient: httpClient, baseURL: strings.TrimSuffix(baseURL, "/"), appID: appID}
+}
+
+// GetLatestRates returns the latest USD rates
+func (c *OpenExchangeRatesClient) GetLatestRates(ctx context.Context) (*Rates, error) {
+	if c.appID == "" {
+		return nil, fmt.Errorf("openexchangerates app ID is not configured")
+	}
+	requestURL := fmt.Sprintf("%s%s?app_id=%s", c.baseURL, latestEndpoint, url.QueryEscape(c.appID))
+
+	body, err := get(ctx, c.httpClient, requestURL, "application/json")
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch openexchangerates rates: %w", err)
+	}
+
+	var latest oxrLatestResponse
+	if err := json.Unmarshal(body, &latest); err != nil {
+		return nil, fmt.Errorf("failed to decode openexchangerates rates: %w", err)
+	}
+	if latest.Base == "" || len(latest.Rates) == 0 {
+		return nil, fmt.Errorf("openexchangerates response has no rates")
+	}
+
+	rates := &Rates{
+		Base:  strings.ToUpper(latest.Base),
+		Date:  time.Unix(latest.Timestamp, 0).UTC(),
+		Rates: make(map[string]float64, len(latest.Rates)),
+	}
+	for code, rate := range latest.Rates {
+		code = strings.ToUpper(code)
+		if rate > 0 && code != rates.Base {
+			rates.Rates[code] = rate
+		}
+	}
+	return rates, nil
+}
+
+// get fetches requestURL and returns the body of a 200 response
+func get(ctx context.Context, httpClient clients.HTTPDoer, requestURL, accept string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", accept)
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		cause := fmt.Errorf("unexpected status %d", resp.StatusCode)
+		var oxrErr oxrErrorResponse
+		if json.Unmarshal(body, &oxrErr) == nil && oxrErr.Message != "" {
+			cause = fmt.Errorf("unexpected status %d: %s: %s", resp.StatusCode, oxrErr.Message, oxrErr.Description)
+		}
+		return nil, apperrors.FromHTTPResponse(resp, cause)
+	}
+	return body, nil
+}
@@ -0,0 +1,85 @@
+package fxrates_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/apperrors"
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/fxrates"
+)
+
+const ecbDaily = `<?xml version="1.0" encoding="UTF-8"?>
+<gesmes:Envelope xmlns:gesmes="http://www.gesmes.org/xml/2002-08-01" xmlns="http://www.ecb.int/vocabulary/2002-08-01/eurofxref">
+	<gesmes:subject>Reference rates</gesmes:subject>
+	<Cube>
+		<Cube time="2026-10-14">
+			<Cube currency="USD" rate="1.25"/>
+			<Cube currency="JPY" rate="150.0"/>
+			<Cube currency="GBP" rate="0.85"/>
+		</Cube>
+	</Cube>
+</gesmes:Envelope>`
+
+func TestECBClient_GetLatestRates(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/xml")
+		_, _ = w.Write([]byte(ecbDaily))
+	}))
+	defer server.Close()
+
+	rates, err := fxrates.NewECBClient(server.Client(), server.URL).GetLatestRates(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "EUR", rates.Base)
+	assert.Equal(t, time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC), rates.Date)
+	assert.Equal(t, map[string]float64{"USD": 1.25, "JPY": 150, "GBP": 0.85}, rates.Rates)
+
+	usd, err := rates.Rebase("USD")
+	require.NoError(t, err)
+	assert.Equal(t, "USD", usd.Base)
+	assert.InDelta(t, 0.8, usd.Rates["EUR"], 1e-9)
+	assert.InDelta(t, 120, usd.Rates["JPY"], 1e-9)
+	assert.InDelta(t, 0.68, usd.Rates["GBP"], 1e-9)
+	assert.NotContains(t, usd.Rates, "USD")
+}
+
+func TestOpenExchangeRatesClient_GetLatestRates(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/latest.json", r.URL.Path)
+		if r.URL.Query().Get("app_id") != "test-app" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":true,"status":401,"message":"invalid_app_id","description":"Invalid App ID provided"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"timestamp":1792000000,"base":"USD","rates":{"USD":1,"EUR":0.8,"JPY":120}}`))
+	}))
+	defer server.Close()
+
+	rates, err := fxrates.NewOpenExchangeRatesClient(server.Client(), server.URL, "test-app").GetLatestRates(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "USD", rates.Base)
+	assert.Equal(t, time.Unix(1792000000, 0).UTC(), rates.Date)
+	assert.Equal(t, map[string]float64{"EUR": 0.8, "JPY": 120}, rates.Rates)
+
+	_, err = fxrates.NewOpenExchangeRatesClient(server.Client(), server.URL, "wrong").GetLatestRates(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid_app_id")
+}
+
+func TestGetLatestRates_RateLimited(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	_, err := fxrates.NewECBClient(server.Client(), server.URL).GetLatestRates(context.Background())
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, apperrors.ErrUpstreamRateLimited))
+}
@@ -0,0 +1,9 @@
+package fxrates
+
+import "context"
+
+// ClientAPI fetches fiat exchange rates
+type ClientAPI interface {
+	// GetLatestRates returns the most recent published rates
+	GetLatestRates(ctx context.Context) (*Rates, error)
+}
@@ -0,0 +1,101 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package fxratesmocks
+
+import (
+	"context"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/fxrates"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockClientAPI creates a new instance of MockClientAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockClientAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockClientAPI {
+	mock := &MockClientAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockClientAPI is an autogenerated mock type for the ClientAPI type
+type MockClientAPI struct {
+	mock.Mock
+}
+
+type MockClientAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockClientAPI) EXPECT() *MockClientAPI_Expecter {
+	return &MockClientAPI_Expecter{mock: &_m.Mock}
+}
+
+// GetLatestRates provides a mock function for the type MockClientAPI
+func (_mock *MockClientAPI) GetLatestRates(ctx context.Context) (*fxrates.Rates, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestRates")
+	}
+
+	var r0 *fxrates.Rates
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (*fxrates.Rates, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) *fxrates.Rates); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*fxrates.Rates)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClientAPI_GetLatestRates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestRates'
+type MockClientAPI_GetLatestRates_Call struct {
+	*mock.Call
+}
+
+// GetLatestRates is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockClientAPI_Expecter) GetLatestRates(ctx interface{}) *MockClientAPI_GetLatestRates_Call {
+	return &MockClientAPI_GetLatestRates_Call{Call: _e.mock.On("GetLatestRates", ctx)}
+}
+
+func (_c *MockClientAPI_GetLatestRates_Call) Run(run func(ctx context.Context)) *MockClientAPI_GetLatestRates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClientAPI_GetLatestRates_Call) Return(rates *fxrates.Rates, err error) *MockClientAPI_GetLatestRates_Call {
+	_c.Call.Return(rates, err)
+	return _c
+}
+
+func (_c *MockClientAPI_GetLatestRates_Call) RunAndReturn(run func(ctx context.Context) (*fxrates.Rates, error)) *MockClientAPI_GetLatestRates_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,66 @@
+package fxrates
+
+import (
+	"fmt"
+	"time"
+)
+
+// Rates are fiat exchange rates quoted against Base: one unit of Base buys
+// Rates[code] units of code. Base itself is not in Rates.
+type Rates struct {
+	Base  string
+	Date  time.Time // Day or moment the rates were published
+	Rates map[string]float64
+}
+
+// Rebase returns the same rates quoted against base, which must be Base or
+// one of the quoted currencies
+func (r *Rates) Rebase(base string) (*Rates, error) {
+	if base == r.Base {
+		return r, nil
+	}
+	pivot, ok := r.Rates[base]
+	if !ok || pivot <= 0 {
+		return nil, fmt.Errorf("no %s rate to rebase %s rates on", base, r.Base)
+	}
+
+	rebased := &Rates{
+		Base:  base,
+		Date:  r.Date,
+		Rates: make(map[string]float64, len(r.Rates)),
+	}
+	rebased.Rates[r.Base] = 1 / pivot
+	for code, rate := range r.Rates {
+		if code == base {
+			continue
+		}
+		rebased.Rates[code] = rate / pivot
+	}
+	return rebased, nil
+}
+
+// ecbEnvelope is the ECB eurofxref daily reference rates document
+type ecbEnvelope struct {
+	Cube struct {
+		Days []struct {
+			Time  string `xml:"time,attr"`
+			Rates []struct {
+				Currency string  `xml:"currency,attr"`
+				Rate     float64 `xml:"rate,attr"`
+			} `xml:"Cube"`
+		} `xml:"Cube"`
+	} `xml:"Cube"`
+}
+
+// oxrLatestResponse is the openexchangerates.org /latest.json response
+type oxrLatestResponse struct {
+	Timestamp int64              `json:"timestamp"`
+	Base      string             `json:"base"`
+	Rates     map[string]float64 `json:"rates"`
+}
+
+// oxrErrorResponse is returned by openexchangerates.org on failure
+type oxrErrorResponse struct {
+	Message     string `json:"message"`
+	Description string `json:"description"`
+}
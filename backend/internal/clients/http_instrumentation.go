@@ -5,13 +5,12 @@ import (
 	"fmt"
 	"io"
 	"net/http"
-	"strings"
 	"time"
 
 	"github.com/nicolas-martin/dankfolio/backend/internal/clients/tracker"
+	"github.com/nicolas-martin/dankfolio/backend/internal/requestid"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
-	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/propagation"
 )
 
@@ -36,7 +35,9 @@ func NewInstrumentedHTTPClient(client HTTPDoer, serviceName string, tracker *tra
 	}
 }
 
-// Do executes an HTTP request with OpenTelemetry instrumentation
+// Do executes an HTTP request in a client span named after its endpoint
+// template. The span ends, and the call's duration and payload sizes are
+// recorded, once the response body has been read or closed.
 func (c *InstrumentedHTTPClient) Do(req *http.Request) (*http.Response, error) {
 	if c.tracker == nil {
 		// Fallback to regular HTTP call if no tracker
@@ -44,79 +45,51 @@ func (c *InstrumentedHTTPClient) Do(req *http.Request) (*http.Response, error) {
 	}
 
 	ctx := req.Context()
-	endpointName := req.URL.Path
-	if endpointName == "" {
-		endpointName = "/"
-	}
-	
-	// Normalize endpoint names for better metrics grouping
-	// This prevents query parameters from creating unique metric labels
-	if c.serviceName == "birdeye" {
-		// Remove query parameters and normalize Birdeye endpoints
-		if idx := strings.Index(endpointName, "?"); idx != -1 {
-			endpointName = endpointName[:idx]
-		}
-	} else if c.serviceName == "jupiter" && strings.Contains(endpointName, "/tokens/v1/token/") {
-		// Normalize Jupiter token endpoints
-		endpointName = "/tokens/v1/token/{address}"
-	}
-
-	// Start span
-	spanName := fmt.Sprintf("%s %s", req.Method, endpointName)
-	ctx, span := c.tracker.StartSpan(ctx, c.serviceName, spanName)
-	defer func() {
-		c.tracker.EndSpan(span, nil, c.serviceName)
-	}()
-
-	// Set span attributes
+	endpointName := endpointTemplate(req.URL.Path)
+	ctx, span := c.tracker.StartSpan(ctx, c.serviceName, fmt.Sprintf("%s %s", req.Method, endpointName))
 	span.SetAttributes(
 		attribute.String("http.method", req.Method),
-		attribute.String("http.url", req.URL.String()),
+		attribute.String("http.url", redactURL(req.URL)),
 		attribute.String("http.host", req.URL.Host),
 		attribute.String("http.scheme", req.URL.Scheme),
 		attribute.String("http.target", req.URL.Path),
+		attribute.String("url.template", endpointName),
 		attribute.String("service.name", c.serviceName),
+		tracker.RetryCountKey.Int(retryCount(ctx)),
 	)
+	if id := requestid.FromContext(ctx); id != "" {
+		span.SetAttributes(attribute.String("request.id", id))
+	}
+	if req.ContentLength >= 0 {
+		span.SetAttributes(attribute.Int64("http.request.body.size", req.ContentLength))
+	}
 
-	// Track the call
 	c.tracker.TrackCallWithContext(ctx, c.serviceName, endpointName)
 
-	// Update request context
 	req = req.WithContext(ctx)
-	
 	// Inject trace context into HTTP headers for distributed tracing
 	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
 
-	// Record timing
-	start := time.Now()
-
-	// Execute request
+	call := &tracedCall{
+		tracker:      c.tracker,
+		service:      c.serviceName,
+		endpoint:     endpointName,
+		ctx:          ctx,
+		span:         span,
+		start:        time.Now(),
+		requestBytes: req.ContentLength,
+	}
 	resp, err := c.client.Do(req)
-
-	// Record duration
-	duration := time.Since(start)
-	c.tracker.RecordDuration(ctx, c.serviceName, endpointName, duration)
-
 	if err != nil {
-		span.RecordError(err)
-		span.SetStatus(codes.Error, err.Error())
-		c.tracker.EndSpan(span, err, c.serviceName)
+		call.finish(err)
 		return nil, err
 	}
 
-	// Set response attributes
-	span.SetAttributes(
-		attribute.Int("http.status_code", resp.StatusCode),
-		attribute.Int64("http.response_content_length", resp.ContentLength),
-	)
-
-	// Set span status based on HTTP status code
+	span.SetAttributes(attribute.Int("http.status_code", resp.StatusCode))
 	if resp.StatusCode >= 400 {
-		span.SetStatus(codes.Error, fmt.Sprintf("HTTP %d", resp.StatusCode))
-	} else {
-		span.SetStatus(codes.Ok, "")
+		call.statusErr = &httpStatusError{StatusCode: resp.StatusCode}
 	}
-
+	resp.Body = &tracedBody{ReadCloser: resp.Body, call: call}
 	return resp, nil
 }
 
@@ -175,8 +148,35 @@ func ExtractEndpointName(urlStr string) string {
 	return urlStr
 }
 
-// WrapHTTPClient wraps a standard http.Client with instrumentation
-func WrapHTTPClient(client *http.Client, serviceName string, tracker *tracker.APITracker) HTTPDoer {
+// WrapOption customizes WrapHTTPClient
+type WrapOption func(*wrapOptions)
+
+type wrapOptions struct {
+	replay ReplayConfig
+}
+
+// WithReplay records responses to, or replays them from, fixtures under
+// cfg.Dir. Replay sits below instrumentation so replayed calls are still traced.
+func WithReplay(cfg ReplayConfig) WrapOption {
+	return func(o *wrapOptions) {
+		o.replay = cfg
+	}
+}
+
+// WrapHTTPClient wraps a standard http.Client with instrumentation. Requests
+// made within an API request carry its correlation ID in X-Request-ID.
+func WrapHTTPClient(client *http.Client, serviceName string, tracker *tracker.APITracker, opts ...WrapOption) HTTPDoer {
+	var options wrapOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+	wrapped := *client
+	if options.replay.Mode != ReplayOff {
+		wrapped.Transport = NewReplayTransport(wrapped.Transport, serviceName, options.replay)
+	}
+	wrapped.Transport = &requestid.Transport{Base: wrapped.Transport}
+	client = &wrapped
+
 	if tracker == nil {
 		return client
 	}
@@ -0,0 +1,133 @@
+package clients
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/netip"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/tracker"
+	"github.com/nicolas-martin/dankfolio/backend/internal/util"
+)
+
+type retryCountKey struct{}
+
+// WithRetryCount marks the calls made with ctx as the retry-th retry of an
+// earlier call, e.g. the next gateway after one failed. It is recorded on
+// their spans so slow calls can be told apart from slow fallbacks.
+func WithRetryCount(ctx context.Context, retry int) context.Context {
+	return context.WithValue(ctx, retryCountKey{}, retry)
+}
+
+func retryCount(ctx context.Context) int {
+	retry, _ := ctx.Value(retryCountKey{}).(int)
+	return retry
+}
+
+// minOpaqueSegmentLen is the length from which a path segment mixing letters
+// and digits is taken for an ID, e.g. a CID or a transaction signature
+const minOpaqueSegmentLen = 24
+
+// endpointTemplate replaces the IDs in a URL path with placeholders, e.g.
+// /tokens/v1/token/So11…112 becomes /tokens/v1/token/{address}, so calls to
+// one endpoint share metric labels and span names
+func endpointTemplate(path string) string {
+	if path == "" {
+		return "/"
+	}
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		switch {
+		case segment == "":
+		case util.IsValidSolanaAddress(segment):
+			segments[i] = "{address}"
+		case isDigits(segment):
+			segments[i] = "{id}"
+		case isIP(segment):
+			segments[i] = "{ip}"
+		case len(segment) >= minOpaqueSegmentLen && strings.ContainsFunc(segment, unicode.IsDigit):
+			segments[i] = "{id}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+func isDigits(s string) bool {
+	return !strings.ContainsFunc(s, func(r rune) bool { return !unicode.IsDigit(r) })
+}
+
+func isIP(s string) bool {
+	_, err := netip.ParseAddr(s)
+	return err == nil
+}
+
+// httpStatusError fails the span of a call answered with an error status
+type httpStatusError struct {
+	StatusCode int
+}
+
+func (e *httpStatusError) Error() string {
+	return fmt.Sprintf("HTTP %d", e.StatusCode)
+}
+
+// tracedCall is an HTTP call in flight. It finishes when the response body
+// has been read or closed, so its span and duration cover the download too.
+type tracedCall struct {
+	tracker      *tracker.APITracker
+	service      string
+	endpoint     string
+	ctx          context.Context
+	span         trace.Span
+	start        time.Time
+	requestBytes int64
+	statusErr    error
+
+	once          sync.Once
+	responseBytes int64
+}
+
+// finish records the call's duration and sizes and ends its span, once
+func (c *tracedCall) finish(err error) {
+	c.once.Do(func() {
+		if err == nil {
+			err = c.statusErr
+		}
+		c.tracker.RecordDuration(c.ctx, c.service, c.endpoint, time.Since(c.start))
+		c.tracker.RecordPayloadSizes(c.ctx, c.service, c.endpoint, c.requestBytes, c.responseBytes)
+		c.span.SetAttributes(attribute.Int64("http.response.body.size", c.responseBytes))
+		c.tracker.EndSpan(c.span, err, c.service)
+	})
+}
+
+// tracedBody counts the bytes read from a response body and finishes its
+// call at the end of the body
+type tracedBody struct {
+	io.ReadCloser
+	call *tracedCall
+}
+
+func (b *tracedBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.call.responseBytes += int64(n)
+	switch {
+	case errors.Is(err, io.EOF):
+		b.call.finish(nil)
+	case err != nil:
+		b.call.finish(err)
+	}
+	return n, err
+}
+
+func (b *tracedBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.call.finish(nil)
+	return err
+}
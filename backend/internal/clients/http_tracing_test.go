@@ -0,0 +1,108 @@
+package clients
+
+import (
+	"context"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/tracker"
+	"github.com/nicolas-martin/dankfolio/backend/internal/telemetry/otel"
+)
+
+func TestEndpointTemplate(t *testing.T) {
+	for path, want := range map[string]string{
+		"":            "/",
+		"/defi/price": "/defi/price",
+		"/tokens/v1/token/So11111111111111111111111111111111111111112": "/tokens/v1/token/{address}",
+		"/geoip/v2.1/country/203.0.113.7":                              "/geoip/v2.1/country/{ip}",
+		"/ipfs/QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG":         "/ipfs/{id}",
+		"/v1/items/42": "/v1/items/{id}",
+	} {
+		assert.Equal(t, want, endpointTemplate(path), path)
+	}
+}
+
+func TestInstrumentedHTTPClient_Spans(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("fail") != "" {
+			w.WriteHeader(http.StatusBadGateway)
+		}
+		_, _ = io.WriteString(w, `{"data":{"value":1.5}}`)
+	}))
+	defer server.Close()
+
+	spans := tracetest.NewSpanRecorder()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))
+	reader := sdkmetric.NewManualReader()
+	meterProvider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	tr, err := tracker.NewAPITracker(&otel.Telemetry{
+		Tracer: tracerProvider.Tracer("test"),
+		Meter:  meterProvider.Meter("test"),
+	})
+	require.NoError(t, err)
+	client := WrapHTTPClient(&http.Client{}, "birdeye", tr)
+
+	get := func(ctx context.Context, query string) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/defi/token_overview/So11111111111111111111111111111111111111112?"+query, nil)
+		require.NoError(t, err)
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		before := len(spans.Ended())
+		_, _ = io.ReadAll(resp.Body)
+		assert.Len(t, spans.Ended(), before+1, "the span ends once the body is read")
+		require.NoError(t, resp.Body.Close())
+	}
+
+	ctx, parent := tracerProvider.Tracer("test").Start(context.Background(), "PrepareSwap")
+	get(ctx, "api_key=secret")
+	get(WithRetryCount(ctx, 1), "fail=1")
+	parent.End()
+
+	ended := spans.Ended()
+	require.Len(t, ended, 3)
+	ok, failed := ended[0], ended[1]
+	assert.Equal(t, "birdeye.GET /defi/token_overview/{address}", ok.Name())
+	assert.Equal(t, parent.SpanContext().SpanID(), ok.Parent().SpanID())
+	assert.Equal(t, codes.Ok, ok.Status().Code)
+	attrs := attribute.NewSet(ok.Attributes()...)
+	size, _ := attrs.Value("http.response.body.size")
+	assert.Equal(t, int64(22), size.AsInt64())
+	url, _ := attrs.Value("http.url")
+	assert.NotContains(t, url.AsString(), "secret")
+	retry, _ := attrs.Value(tracker.RetryCountKey)
+	assert.Equal(t, int64(0), retry.AsInt64())
+
+	assert.Equal(t, codes.Error, failed.Status().Code)
+	failedAttrs := attribute.NewSet(failed.Attributes()...)
+	retry, _ = failedAttrs.Value(tracker.RetryCountKey)
+	assert.Equal(t, int64(1), retry.AsInt64())
+	assert.Equal(t, int64(1), tr.Stats()[0].Errors)
+
+	// Durations carry exemplars pointing at the spans of the calls
+	var metrics metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &metrics))
+	var exemplars []metricdata.Exemplar[float64]
+	for _, m := range metrics.ScopeMetrics[0].Metrics {
+		if m.Name == "dankfolio.api_call_duration_seconds" {
+			for _, point := range m.Data.(metricdata.Histogram[float64]).DataPoints {
+				exemplars = append(exemplars, point.Exemplars...)
+			}
+		}
+	}
+	require.NotEmpty(t, exemplars)
+	for _, exemplar := range exemplars {
+		assert.Equal(t, parent.SpanContext().TraceID().String(), hex.EncodeToString(exemplar.TraceID))
+	}
+}
@@ -13,7 +13,8 @@ var ErrAccountNotFound = errors.New("account not found")
 // GenericClientAPI defines a chain-agnostic interface for blockchain interactions.
 type GenericClientAPI interface {
 	// GetAccountInfo retrieves information about a specific account.
-	GetAccountInfo(ctx context.Context, address blockchain.Address) (*blockchain.AccountInfo, error)
+	// 'commitment' is "processed", "confirmed" or "finalized"; empty means blockchain.DefaultCommitment.
+	GetAccountInfo(ctx context.Context, address blockchain.Address, commitment string) (*blockchain.AccountInfo, error)
 
 	// GetBalance retrieves the native asset balance for a specific account.
 	// 'commitment' string can be "processed", "confirmed", "finalized", or chain-specific values.
@@ -28,8 +29,8 @@ type GenericClientAPI interface {
 	// For now, let's assume it takes the owner and the token's mint address.
 	GetTokenBalance(ctx context.Context, ownerAddress blockchain.Address, tokenMintAddress blockchain.Address, commitment string) (*blockchain.Balance, error)
 
-	// GetLatestBlockhash retrieves the most recent processed block hash.
-	GetLatestBlockhash(ctx context.Context) (blockchain.Blockhash, error)
+	// GetLatestBlockhash retrieves the most recent block hash at commitment.
+	GetLatestBlockhash(ctx context.Context, commitment string) (blockchain.Blockhash, error)
 
 	// SendTransaction submits a signed transaction to the blockchain.
 	// The 'tx' parameter might be a fully signed raw transaction []byte in some generic designs,
@@ -57,6 +58,19 @@ type GenericClientAPI interface {
 	// SendRawTransaction submits an already serialized (and likely signed) transaction to the blockchain.
 	SendRawTransaction(ctx context.Context, rawTx []byte, opts blockchain.TransactionOptions) (blockchain.Signature, error)
 
+	// SimulateTransaction runs a serialized transaction at commitment without
+	// submitting it. Signatures are not verified and the blockhash is replaced,
+	// so unsigned transactions can be simulated.
+	SimulateTransaction(ctx context.Context, rawTx []byte, commitment string) (*blockchain.SimulationResult, error)
+
+	// GetRecentPrioritizationFees returns the per-compute-unit prices paid in
+	// recent slots by transactions that write to any of accounts.
+	GetRecentPrioritizationFees(ctx context.Context, accounts []blockchain.Address) ([]uint64, error)
+
 	// GetTokenMetadata retrieves metadata for a given token mint address.
 	GetTokenMetadata(ctx context.Context, mintAddress blockchain.Address) (*blockchain.TokenMetadata, error)
+
+	// GetStakeAccounts lists the native stake accounts withdrawable by owner,
+	// with their status and last epoch's reward.
+	GetStakeAccounts(ctx context.Context, owner blockchain.Address, commitment string) ([]*blockchain.StakeAccount, error)
 }
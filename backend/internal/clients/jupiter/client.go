@@ -15,6 +15,7 @@ import (
 
 	solanago "github.com/gagliardetto/solana-go"
 
+	"github.com/nicolas-martin/dankfolio/backend/internal/apperrors"
 	"github.com/nicolas-martin/dankfolio/backend/internal/clients"
 	"github.com/nicolas-martin/dankfolio/backend/internal/clients/tracker"
 	"github.com/nicolas-martin/dankfolio/backend/internal/util"
@@ -30,6 +31,9 @@ const (
 	newTokensEndpoint = "/tokens/v1/new"
 )
 
+// MaxPriceIDs is the maximum number of mints accepted by one Price API V2 request
+const MaxPriceIDs = 100
+
 // Client handles interactions with the Jupiter API
 type Client struct {
 	httpClient clients.HTTPDoer
@@ -66,6 +70,9 @@ func (c *Client) GetCoinPrices(ctx context.Context, tokenAddresses []string) (ma
 	if len(tokenAddresses) == 0 {
 		return nil, fmt.Errorf("no token addresses provided")
 	}
+	if len(tokenAddresses) > MaxPriceIDs {
+		return nil, fmt.Errorf("batch size %d exceeds maximum allowed %d", len(tokenAddresses), MaxPriceIDs)
+	}
 	addressList := strings.Join(tokenAddresses, ",")
 	url := fmt.Sprintf("%s%s?ids=%s", c.baseURL, priceEndpoint, url.QueryEscape(addressList)) // Inline URL formatting
 
@@ -241,6 +248,16 @@ func (c *Client) GetNewCoins(ctx context.Context, params *NewCoinsParams) ([]*Ne
 
 // CreateSwapTransaction requests an unsigned swap transaction from Jupiter
 func (c *Client) CreateSwapTransaction(ctx context.Context, quoteResp []byte, userPublicKey solanago.PublicKey, feeAccount string) (*SwapResponse, error) {
+	return c.createSwapTransaction(ctx, quoteResp, userPublicKey, feeAccount, nil)
+}
+
+// CreateSponsoredSwapTransaction requests an unsigned swap transaction whose
+// network fees and new account rent are paid by payer instead of the user
+func (c *Client) CreateSponsoredSwapTransaction(ctx context.Context, quoteResp []byte, userPublicKey solanago.PublicKey, feeAccount string, payer solanago.PublicKey) (*SwapResponse, error) {
+	return c.createSwapTransaction(ctx, quoteResp, userPublicKey, feeAccount, &payer)
+}
+
+func (c *Client) createSwapTransaction(ctx context.Context, quoteResp []byte, userPublicKey solanago.PublicKey, feeAccount string, payer *solanago.PublicKey) (*SwapResponse, error) {
 	// Log the raw quoteResp for debugging
 	slog.Debug("Jupiter quote response (raw)", "payload", string(quoteResp))
 
@@ -267,6 +284,9 @@ func (c *Client) CreateSwapTransaction(ctx context.Context, quoteResp []byte, us
 	if feeAccount != "" {
 		swapReqBody["feeAccount"] = feeAccount
 	}
+	if payer != nil {
+		swapReqBody["payer"] = payer.String()
+	}
 
 	url := fmt.Sprintf("%s%s", c.baseURL, swapEndpoint) // Inline URL formatting
 
@@ -346,11 +366,11 @@ func doGetRequest[T any](ctx context.Context, c *Client, requestURL string) (T,
 				"url", requestURL,
 				"status_code", resp.StatusCode,
 				"content_type", resp.Header.Get("Content-Type"))
-			return zeroT, respBody, fmt.Errorf("GET request to %s failed with status code: %d (received HTML error page instead of JSON)", requestURL, resp.StatusCode)
+			return zeroT, respBody, classifyError(resp, nil, fmt.Errorf("GET request to %s failed with status code: %d (received HTML error page instead of JSON)", requestURL, resp.StatusCode))
 		}
 
 		// Return respBody as it might contain useful error info from the API
-		return zeroT, respBody, fmt.Errorf("GET request to %s failed with status code: %d, body: %s", requestURL, resp.StatusCode, string(respBody))
+		return zeroT, respBody, classifyError(resp, respBody, fmt.Errorf("GET request to %s failed with status code: %d, body: %s", requestURL, resp.StatusCode, string(respBody)))
 	}
 
 	// Check if we received HTML when expecting JSON
@@ -446,10 +466,10 @@ func doPostRequest[T any](ctx context.Context, c *Client, requestURL string, req
 				"url", requestURL,
 				"status_code", resp.StatusCode,
 				"content_type", resp.Header.Get("Content-Type"))
-			return zeroT, fmt.Errorf("POST request to %s failed with status code: %d (received HTML error page instead of JSON)", requestURL, resp.StatusCode)
+			return zeroT, classifyError(resp, nil, fmt.Errorf("POST request to %s failed with status code: %d (received HTML error page instead of JSON)", requestURL, resp.StatusCode))
 		}
 
-		return zeroT, fmt.Errorf("POST request to %s failed with status code: %d, body: %s", requestURL, resp.StatusCode, string(respBody))
+		return zeroT, classifyError(resp, respBody, fmt.Errorf("POST request to %s failed with status code: %d, body: %s", requestURL, resp.StatusCode, string(respBody)))
 	}
 
 	// Check if we received HTML when expecting JSON
@@ -474,3 +494,16 @@ func doPostRequest[T any](ctx context.Context, c *Client, requestURL string, req
 
 	return responseObject, nil
 }
+
+// classifyError tags failed responses the app can act on: Jupiter's error
+// codes for untradable tokens and missing routes, and rate limiting or
+// outages by status
+func classifyError(resp *http.Response, body []byte, err error) error {
+	switch {
+	case bytes.Contains(body, []byte("TOKEN_NOT_TRADABLE")):
+		return apperrors.ErrTokenNotTradable.Wrap(err)
+	case bytes.Contains(body, []byte("COULD_NOT_FIND_ANY_ROUTE")), bytes.Contains(body, []byte("NO_ROUTES_FOUND")):
+		return apperrors.ErrNoRoute.Wrap(err)
+	}
+	return apperrors.FromHTTPResponse(resp, err)
+}
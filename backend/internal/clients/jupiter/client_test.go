@@ -10,6 +10,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/apperrors"
 )
 
 // mockTelemetry is a mock implementation of TelemetryAPI for testing.
@@ -357,3 +359,30 @@ func TestTrackCall_EndpointTruncation(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_GetQuote_ClassifiesErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		want   error
+	}{
+		{"no route", http.StatusBadRequest, `{"error":"Could not find any route","errorCode":"COULD_NOT_FIND_ANY_ROUTE"}`, apperrors.ErrNoRoute},
+		{"not tradable", http.StatusBadRequest, `{"error":"The token is not tradable","errorCode":"TOKEN_NOT_TRADABLE"}`, apperrors.ErrTokenNotTradable},
+		{"rate limited", http.StatusTooManyRequests, `{"message":"Too many requests"}`, apperrors.ErrUpstreamRateLimited},
+		{"outage", http.StatusServiceUnavailable, `{"message":"unavailable"}`, apperrors.ErrUpstreamUnavailable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			client := NewClient(server.Client(), server.URL, "")
+			_, err := client.GetQuote(context.Background(), QuoteParams{InputMint: "a", OutputMint: "b", Amount: "1"})
+			assert.ErrorIs(t, err, tt.want)
+		})
+	}
+}
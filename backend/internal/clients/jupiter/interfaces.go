@@ -25,4 +25,6 @@ type ClientAPI interface {
 
 	// CreateSwapTransaction requests an unsigned swap transaction from Jupiter
 	CreateSwapTransaction(ctx context.Context, quoteResp []byte, userPublicKey solanago.PublicKey, feeAccount string) (*SwapResponse, error)
+	// CreateSponsoredSwapTransaction requests an unsigned swap transaction with payer as its fee payer
+	CreateSponsoredSwapTransaction(ctx context.Context, quoteResp []byte, userPublicKey solanago.PublicKey, feeAccount string, payer solanago.PublicKey) (*SwapResponse, error)
 }
@@ -39,6 +39,92 @@ func (_m *MockClientAPI) EXPECT() *MockClientAPI_Expecter {
 	return &MockClientAPI_Expecter{mock: &_m.Mock}
 }
 
+// CreateSponsoredSwapTransaction provides a mock function for the type MockClientAPI
+func (_mock *MockClientAPI) CreateSponsoredSwapTransaction(ctx context.Context, quoteResp []byte, userPublicKey solana.PublicKey, feeAccount string, payer solana.PublicKey) (*jupiter.SwapResponse, error) {
+	ret := _mock.Called(ctx, quoteResp, userPublicKey, feeAccount, payer)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSponsoredSwapTransaction")
+	}
+
+	var r0 *jupiter.SwapResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []byte, solana.PublicKey, string, solana.PublicKey) (*jupiter.SwapResponse, error)); ok {
+		return returnFunc(ctx, quoteResp, userPublicKey, feeAccount, payer)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []byte, solana.PublicKey, string, solana.PublicKey) *jupiter.SwapResponse); ok {
+		r0 = returnFunc(ctx, quoteResp, userPublicKey, feeAccount, payer)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*jupiter.SwapResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []byte, solana.PublicKey, string, solana.PublicKey) error); ok {
+		r1 = returnFunc(ctx, quoteResp, userPublicKey, feeAccount, payer)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClientAPI_CreateSponsoredSwapTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSponsoredSwapTransaction'
+type MockClientAPI_CreateSponsoredSwapTransaction_Call struct {
+	*mock.Call
+}
+
+// CreateSponsoredSwapTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - quoteResp []byte
+//   - userPublicKey solana.PublicKey
+//   - feeAccount string
+//   - payer solana.PublicKey
+func (_e *MockClientAPI_Expecter) CreateSponsoredSwapTransaction(ctx interface{}, quoteResp interface{}, userPublicKey interface{}, feeAccount interface{}, payer interface{}) *MockClientAPI_CreateSponsoredSwapTransaction_Call {
+	return &MockClientAPI_CreateSponsoredSwapTransaction_Call{Call: _e.mock.On("CreateSponsoredSwapTransaction", ctx, quoteResp, userPublicKey, feeAccount, payer)}
+}
+
+func (_c *MockClientAPI_CreateSponsoredSwapTransaction_Call) Run(run func(ctx context.Context, quoteResp []byte, userPublicKey solana.PublicKey, feeAccount string, payer solana.PublicKey)) *MockClientAPI_CreateSponsoredSwapTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []byte
+		if args[1] != nil {
+			arg1 = args[1].([]byte)
+		}
+		var arg2 solana.PublicKey
+		if args[2] != nil {
+			arg2 = args[2].(solana.PublicKey)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 solana.PublicKey
+		if args[4] != nil {
+			arg4 = args[4].(solana.PublicKey)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClientAPI_CreateSponsoredSwapTransaction_Call) Return(swapResponse *jupiter.SwapResponse, err error) *MockClientAPI_CreateSponsoredSwapTransaction_Call {
+	_c.Call.Return(swapResponse, err)
+	return _c
+}
+
+func (_c *MockClientAPI_CreateSponsoredSwapTransaction_Call) RunAndReturn(run func(ctx context.Context, quoteResp []byte, userPublicKey solana.PublicKey, feeAccount string, payer solana.PublicKey) (*jupiter.SwapResponse, error)) *MockClientAPI_CreateSponsoredSwapTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateSwapTransaction provides a mock function for the type MockClientAPI
 func (_mock *MockClientAPI) CreateSwapTransaction(ctx context.Context, quoteResp []byte, userPublicKey solana.PublicKey, feeAccount string) (*jupiter.SwapResponse, error) {
 	ret := _mock.Called(ctx, quoteResp, userPublicKey, feeAccount)
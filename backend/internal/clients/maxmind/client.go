@@ -0,0 +1,96 @@
+// Package maxmind looks up the country of IP addresses with the MaxMind
+// GeoIP2 Country web service.
+package maxmind
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/netip"
+	"strings"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/apperrors"
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients"
+)
+
+// DefaultURL is the GeoIP2 web service API root
+const DefaultURL = "https://geoip.maxmind.com/geoip/v2.1"
+
+// Client looks up countries with an account's license key. Lookups are
+// billed per query, so callers should cache them.
+type Client struct {
+	httpClient clients.HTTPDoer
+	baseURL    string
+	accountID  string
+	licenseKey string
+}
+
+// NewClient creates a client for the web service at baseURL, or DefaultURL when empty
+func NewClient(httpClient clients.HTTPDoer, baseURL, accountID, licenseKey string) *Client {
+	if baseURL == "" {
+		baseURL = DefaultURL
+	}
+	return &Client{httpClient: httpClient, baseURL: strings.TrimSuffix(baseURL, "/"), accountID: accountID, licenseKey: licenseKey}
+}
+
+// countryResponse is the part of the Country endpoint's response we read
+type countryResponse struct {
+	Country struct {
+		ISOCode string `json:"iso_code"`
+	} `json:"country"`
+	RegisteredCountry struct {
+		ISOCode string `json:"iso_code"`
+	} `json:"registered_country"`
+}
+
+// errorResponse is the body of a failed request
+type errorResponse struct {
+	Code  string `json:"code"`
+	Error string `json:"error"`
+}
+
+// Country returns the ISO 3166-1 alpha-2 code of the country ip is located
+// in, falling back to the country its network is registered in. It is empty
+// when MaxMind doesn't know either.
+func (c *Client) Country(ctx context.Context, ip netip.Addr) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/country/%s", c.baseURL, ip), nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	req.SetBasicAuth(c.accountID, c.licenseKey)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read response body: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		var errResp errorResponse
+		if json.Unmarshal(body, &errResp) == nil && errResp.Code == "IP_ADDRESS_RESERVED" {
+			// Private and reserved addresses are in no country
+			return "", nil
+		}
+		cause := fmt.Errorf("unexpected status %d", resp.StatusCode)
+		if errResp.Code != "" {
+			cause = fmt.Errorf("unexpected status %d: %s: %s", resp.StatusCode, errResp.Code, errResp.Error)
+		}
+		return "", apperrors.FromHTTPResponse(resp, cause)
+	}
+
+	var country countryResponse
+	if err := json.Unmarshal(body, &country); err != nil {
+		return "", fmt.Errorf("failed to decode MaxMind country: %w", err)
+	}
+	if country.Country.ISOCode != "" {
+		return strings.ToUpper(country.Country.ISOCode), nil
+	}
+	return strings.ToUpper(country.RegisteredCountry.ISOCode), nil
+}
@@ -0,0 +1,51 @@
+package maxmind_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/maxmind"
+)
+
+func TestClient_Country(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, key, _ := r.BasicAuth()
+		assert.Equal(t, "42", user)
+		assert.Equal(t, "secret", key)
+		switch r.URL.Path {
+		case "/country/203.0.113.7":
+			_, _ = w.Write([]byte(`{"country":{"iso_code":"de"},"registered_country":{"iso_code":"FR"}}`))
+		case "/country/198.51.100.1":
+			_, _ = w.Write([]byte(`{"registered_country":{"iso_code":"US"}}`))
+		case "/country/10.0.0.1":
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"code":"IP_ADDRESS_RESERVED","error":"The value 10.0.0.1 belongs to a reserved or private range"}`))
+		default:
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"code":"AUTHORIZATION_INVALID","error":"invalid license key"}`))
+		}
+	}))
+	defer server.Close()
+	client := maxmind.NewClient(server.Client(), server.URL, "42", "secret")
+
+	country, err := client.Country(context.Background(), netip.MustParseAddr("203.0.113.7"))
+	require.NoError(t, err)
+	assert.Equal(t, "DE", country)
+
+	country, err = client.Country(context.Background(), netip.MustParseAddr("198.51.100.1"))
+	require.NoError(t, err)
+	assert.Equal(t, "US", country, "falls back to the registered country")
+
+	country, err = client.Country(context.Background(), netip.MustParseAddr("10.0.0.1"))
+	require.NoError(t, err)
+	assert.Empty(t, country)
+
+	_, err = client.Country(context.Background(), netip.MustParseAddr("192.0.2.1"))
+	assert.ErrorContains(t, err, "AUTHORIZATION_INVALID")
+}
@@ -117,8 +117,8 @@ func (_c *MockGenericClientAPI_ExecuteSwap_Call) RunAndReturn(run func(ctx conte
 }
 
 // GetAccountInfo provides a mock function for the type MockGenericClientAPI
-func (_mock *MockGenericClientAPI) GetAccountInfo(ctx context.Context, address blockchain.Address) (*blockchain.AccountInfo, error) {
-	ret := _mock.Called(ctx, address)
+func (_mock *MockGenericClientAPI) GetAccountInfo(ctx context.Context, address blockchain.Address, commitment string) (*blockchain.AccountInfo, error) {
+	ret := _mock.Called(ctx, address, commitment)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetAccountInfo")
@@ -126,18 +126,18 @@ func (_mock *MockGenericClientAPI) GetAccountInfo(ctx context.Context, address b
 
 	var r0 *blockchain.AccountInfo
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, blockchain.Address) (*blockchain.AccountInfo, error)); ok {
-		return returnFunc(ctx, address)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, blockchain.Address, string) (*blockchain.AccountInfo, error)); ok {
+		return returnFunc(ctx, address, commitment)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, blockchain.Address) *blockchain.AccountInfo); ok {
-		r0 = returnFunc(ctx, address)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, blockchain.Address, string) *blockchain.AccountInfo); ok {
+		r0 = returnFunc(ctx, address, commitment)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*blockchain.AccountInfo)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, blockchain.Address) error); ok {
-		r1 = returnFunc(ctx, address)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, blockchain.Address, string) error); ok {
+		r1 = returnFunc(ctx, address, commitment)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -152,11 +152,12 @@ type MockGenericClientAPI_GetAccountInfo_Call struct {
 // GetAccountInfo is a helper method to define mock.On call
 //   - ctx context.Context
 //   - address blockchain.Address
-func (_e *MockGenericClientAPI_Expecter) GetAccountInfo(ctx interface{}, address interface{}) *MockGenericClientAPI_GetAccountInfo_Call {
-	return &MockGenericClientAPI_GetAccountInfo_Call{Call: _e.mock.On("GetAccountInfo", ctx, address)}
+//   - commitment string
+func (_e *MockGenericClientAPI_Expecter) GetAccountInfo(ctx interface{}, address interface{}, commitment interface{}) *MockGenericClientAPI_GetAccountInfo_Call {
+	return &MockGenericClientAPI_GetAccountInfo_Call{Call: _e.mock.On("GetAccountInfo", ctx, address, commitment)}
 }
 
-func (_c *MockGenericClientAPI_GetAccountInfo_Call) Run(run func(ctx context.Context, address blockchain.Address)) *MockGenericClientAPI_GetAccountInfo_Call {
+func (_c *MockGenericClientAPI_GetAccountInfo_Call) Run(run func(ctx context.Context, address blockchain.Address, commitment string)) *MockGenericClientAPI_GetAccountInfo_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -166,9 +167,14 @@ func (_c *MockGenericClientAPI_GetAccountInfo_Call) Run(run func(ctx context.Con
 		if args[1] != nil {
 			arg1 = args[1].(blockchain.Address)
 		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -179,7 +185,7 @@ func (_c *MockGenericClientAPI_GetAccountInfo_Call) Return(accountInfo *blockcha
 	return _c
 }
 
-func (_c *MockGenericClientAPI_GetAccountInfo_Call) RunAndReturn(run func(ctx context.Context, address blockchain.Address) (*blockchain.AccountInfo, error)) *MockGenericClientAPI_GetAccountInfo_Call {
+func (_c *MockGenericClientAPI_GetAccountInfo_Call) RunAndReturn(run func(ctx context.Context, address blockchain.Address, commitment string) (*blockchain.AccountInfo, error)) *MockGenericClientAPI_GetAccountInfo_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -259,8 +265,8 @@ func (_c *MockGenericClientAPI_GetBalance_Call) RunAndReturn(run func(ctx contex
 }
 
 // GetLatestBlockhash provides a mock function for the type MockGenericClientAPI
-func (_mock *MockGenericClientAPI) GetLatestBlockhash(ctx context.Context) (blockchain.Blockhash, error) {
-	ret := _mock.Called(ctx)
+func (_mock *MockGenericClientAPI) GetLatestBlockhash(ctx context.Context, commitment string) (blockchain.Blockhash, error) {
+	ret := _mock.Called(ctx, commitment)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetLatestBlockhash")
@@ -268,16 +274,16 @@ func (_mock *MockGenericClientAPI) GetLatestBlockhash(ctx context.Context) (bloc
 
 	var r0 blockchain.Blockhash
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) (blockchain.Blockhash, error)); ok {
-		return returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (blockchain.Blockhash, error)); ok {
+		return returnFunc(ctx, commitment)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) blockchain.Blockhash); ok {
-		r0 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) blockchain.Blockhash); ok {
+		r0 = returnFunc(ctx, commitment)
 	} else {
 		r0 = ret.Get(0).(blockchain.Blockhash)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, commitment)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -291,18 +297,24 @@ type MockGenericClientAPI_GetLatestBlockhash_Call struct {
 
 // GetLatestBlockhash is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockGenericClientAPI_Expecter) GetLatestBlockhash(ctx interface{}) *MockGenericClientAPI_GetLatestBlockhash_Call {
-	return &MockGenericClientAPI_GetLatestBlockhash_Call{Call: _e.mock.On("GetLatestBlockhash", ctx)}
+//   - commitment string
+func (_e *MockGenericClientAPI_Expecter) GetLatestBlockhash(ctx interface{}, commitment interface{}) *MockGenericClientAPI_GetLatestBlockhash_Call {
+	return &MockGenericClientAPI_GetLatestBlockhash_Call{Call: _e.mock.On("GetLatestBlockhash", ctx, commitment)}
 }
 
-func (_c *MockGenericClientAPI_GetLatestBlockhash_Call) Run(run func(ctx context.Context)) *MockGenericClientAPI_GetLatestBlockhash_Call {
+func (_c *MockGenericClientAPI_GetLatestBlockhash_Call) Run(run func(ctx context.Context, commitment string)) *MockGenericClientAPI_GetLatestBlockhash_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -313,7 +325,149 @@ func (_c *MockGenericClientAPI_GetLatestBlockhash_Call) Return(blockhash blockch
 	return _c
 }
 
-func (_c *MockGenericClientAPI_GetLatestBlockhash_Call) RunAndReturn(run func(ctx context.Context) (blockchain.Blockhash, error)) *MockGenericClientAPI_GetLatestBlockhash_Call {
+func (_c *MockGenericClientAPI_GetLatestBlockhash_Call) RunAndReturn(run func(ctx context.Context, commitment string) (blockchain.Blockhash, error)) *MockGenericClientAPI_GetLatestBlockhash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRecentPrioritizationFees provides a mock function for the type MockGenericClientAPI
+func (_mock *MockGenericClientAPI) GetRecentPrioritizationFees(ctx context.Context, accounts []blockchain.Address) ([]uint64, error) {
+	ret := _mock.Called(ctx, accounts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRecentPrioritizationFees")
+	}
+
+	var r0 []uint64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []blockchain.Address) ([]uint64, error)); ok {
+		return returnFunc(ctx, accounts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []blockchain.Address) []uint64); ok {
+		r0 = returnFunc(ctx, accounts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uint64)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []blockchain.Address) error); ok {
+		r1 = returnFunc(ctx, accounts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGenericClientAPI_GetRecentPrioritizationFees_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecentPrioritizationFees'
+type MockGenericClientAPI_GetRecentPrioritizationFees_Call struct {
+	*mock.Call
+}
+
+// GetRecentPrioritizationFees is a helper method to define mock.On call
+//   - ctx context.Context
+//   - accounts []blockchain.Address
+func (_e *MockGenericClientAPI_Expecter) GetRecentPrioritizationFees(ctx interface{}, accounts interface{}) *MockGenericClientAPI_GetRecentPrioritizationFees_Call {
+	return &MockGenericClientAPI_GetRecentPrioritizationFees_Call{Call: _e.mock.On("GetRecentPrioritizationFees", ctx, accounts)}
+}
+
+func (_c *MockGenericClientAPI_GetRecentPrioritizationFees_Call) Run(run func(ctx context.Context, accounts []blockchain.Address)) *MockGenericClientAPI_GetRecentPrioritizationFees_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []blockchain.Address
+		if args[1] != nil {
+			arg1 = args[1].([]blockchain.Address)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGenericClientAPI_GetRecentPrioritizationFees_Call) Return(uint64s []uint64, err error) *MockGenericClientAPI_GetRecentPrioritizationFees_Call {
+	_c.Call.Return(uint64s, err)
+	return _c
+}
+
+func (_c *MockGenericClientAPI_GetRecentPrioritizationFees_Call) RunAndReturn(run func(ctx context.Context, accounts []blockchain.Address) ([]uint64, error)) *MockGenericClientAPI_GetRecentPrioritizationFees_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStakeAccounts provides a mock function for the type MockGenericClientAPI
+func (_mock *MockGenericClientAPI) GetStakeAccounts(ctx context.Context, owner blockchain.Address, commitment string) ([]*blockchain.StakeAccount, error) {
+	ret := _mock.Called(ctx, owner, commitment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStakeAccounts")
+	}
+
+	var r0 []*blockchain.StakeAccount
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, blockchain.Address, string) ([]*blockchain.StakeAccount, error)); ok {
+		return returnFunc(ctx, owner, commitment)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, blockchain.Address, string) []*blockchain.StakeAccount); ok {
+		r0 = returnFunc(ctx, owner, commitment)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*blockchain.StakeAccount)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, blockchain.Address, string) error); ok {
+		r1 = returnFunc(ctx, owner, commitment)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGenericClientAPI_GetStakeAccounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStakeAccounts'
+type MockGenericClientAPI_GetStakeAccounts_Call struct {
+	*mock.Call
+}
+
+// GetStakeAccounts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - owner blockchain.Address
+//   - commitment string
+func (_e *MockGenericClientAPI_Expecter) GetStakeAccounts(ctx interface{}, owner interface{}, commitment interface{}) *MockGenericClientAPI_GetStakeAccounts_Call {
+	return &MockGenericClientAPI_GetStakeAccounts_Call{Call: _e.mock.On("GetStakeAccounts", ctx, owner, commitment)}
+}
+
+func (_c *MockGenericClientAPI_GetStakeAccounts_Call) Run(run func(ctx context.Context, owner blockchain.Address, commitment string)) *MockGenericClientAPI_GetStakeAccounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 blockchain.Address
+		if args[1] != nil {
+			arg1 = args[1].(blockchain.Address)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGenericClientAPI_GetStakeAccounts_Call) Return(stakeAccounts []*blockchain.StakeAccount, err error) *MockGenericClientAPI_GetStakeAccounts_Call {
+	_c.Call.Return(stakeAccounts, err)
+	return _c
+}
+
+func (_c *MockGenericClientAPI_GetStakeAccounts_Call) RunAndReturn(run func(ctx context.Context, owner blockchain.Address, commitment string) ([]*blockchain.StakeAccount, error)) *MockGenericClientAPI_GetStakeAccounts_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -849,3 +1003,77 @@ func (_c *MockGenericClientAPI_SendTransaction_Call) RunAndReturn(run func(ctx c
 	_c.Call.Return(run)
 	return _c
 }
+
+// SimulateTransaction provides a mock function for the type MockGenericClientAPI
+func (_mock *MockGenericClientAPI) SimulateTransaction(ctx context.Context, rawTx []byte, commitment string) (*blockchain.SimulationResult, error) {
+	ret := _mock.Called(ctx, rawTx, commitment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SimulateTransaction")
+	}
+
+	var r0 *blockchain.SimulationResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []byte, string) (*blockchain.SimulationResult, error)); ok {
+		return returnFunc(ctx, rawTx, commitment)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []byte, string) *blockchain.SimulationResult); ok {
+		r0 = returnFunc(ctx, rawTx, commitment)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*blockchain.SimulationResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []byte, string) error); ok {
+		r1 = returnFunc(ctx, rawTx, commitment)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGenericClientAPI_SimulateTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SimulateTransaction'
+type MockGenericClientAPI_SimulateTransaction_Call struct {
+	*mock.Call
+}
+
+// SimulateTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rawTx []byte
+//   - commitment string
+func (_e *MockGenericClientAPI_Expecter) SimulateTransaction(ctx interface{}, rawTx interface{}, commitment interface{}) *MockGenericClientAPI_SimulateTransaction_Call {
+	return &MockGenericClientAPI_SimulateTransaction_Call{Call: _e.mock.On("SimulateTransaction", ctx, rawTx, commitment)}
+}
+
+func (_c *MockGenericClientAPI_SimulateTransaction_Call) Run(run func(ctx context.Context, rawTx []byte, commitment string)) *MockGenericClientAPI_SimulateTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []byte
+		if args[1] != nil {
+			arg1 = args[1].([]byte)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGenericClientAPI_SimulateTransaction_Call) Return(simulationResult *blockchain.SimulationResult, err error) *MockGenericClientAPI_SimulateTransaction_Call {
+	_c.Call.Return(simulationResult, err)
+	return _c
+}
+
+func (_c *MockGenericClientAPI_SimulateTransaction_Call) RunAndReturn(run func(ctx context.Context, rawTx []byte, commitment string) (*blockchain.SimulationResult, error)) *MockGenericClientAPI_SimulateTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -7,6 +7,7 @@ import (
 	"io"
 	"log/slog"
 	"net/http"
+	"slices"
 	"strings"
 
 	"github.com/nicolas-martin/dankfolio/backend/internal/clients"
@@ -15,16 +16,22 @@ import (
 
 // Client handles interactions with external metadata sources
 type Client struct {
-	httpClient clients.HTTPDoer // HTTP client for making requests
+	httpClient clients.HTTPDoer // HTTP client for making requests, limited per host
+	responses  *responseCache   // Bodies kept for conditional refetches; nil when disabled
 }
 
 var _ ClientAPI = (*Client)(nil) // Ensure Client implements ClientAPI
 
-// NewClient creates a new instance of Client
-func NewClient(httpClient clients.HTTPDoer) ClientAPI {
-	return &Client{
-		httpClient: httpClient,
+// NewClient creates a new instance of Client whose requests to each host are
+// limited by config
+func NewClient(httpClient clients.HTTPDoer, config Config) ClientAPI {
+	c := &Client{
+		httpClient: newHostLimiter(httpClient, config),
 	}
+	if cacheBytes := config.withDefaults().ResponseCacheBytes; cacheBytes > 0 {
+		c.responses = newResponseCache(cacheBytes)
+	}
+	return c
 }
 
 // FetchMetadata fetches JSON metadata from a URI with fallback support for IPFS and Arweave
@@ -171,41 +178,14 @@ func (c *Client) fetchArweaveMetadata(uri string) (map[string]any, error) {
 
 // fetchHTTPMetadata fetches JSON metadata from an HTTP(S) URL
 func (c *Client) fetchHTTPMetadata(requestURL string) (map[string]any, error) {
-	slog.Debug("🌐 HTTP: Creating request", "url", requestURL)
-	slog.Debug("🔄 HTTP: Setting up request headers...")
-
-	req, err := http.NewRequest("GET", requestURL, nil)
-	if err != nil {
-		slog.Error("❌ HTTP: Failed to create request", "url", requestURL, "error", err)
-		return nil, fmt.Errorf("failed to create request for %s: %w", requestURL, err)
-	}
-
-	slog.Debug("🌐 HTTP: Sending GET request", "url", requestURL)
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		slog.Error("❌ HTTP: Request failed", "url", requestURL, "error", err)
-		return nil, fmt.Errorf("http get failed for %s: %w", requestURL, err)
-	}
-	defer resp.Body.Close()
-
-	slog.Debug("📥 HTTP: Received response", "status_code", resp.StatusCode, "url", requestURL)
-	if resp.StatusCode != http.StatusOK {
-		slog.Error("❌ HTTP: Failed with status code", "status_code", resp.StatusCode, "url", requestURL)
-		return nil, fmt.Errorf("http status %d for %s", resp.StatusCode, requestURL)
-	}
-
-	slog.Debug("🔄 HTTP: Decoding JSON response", "url", requestURL)
-
-	// Read the response body first
-	respBody, err := io.ReadAll(resp.Body)
+	respBody, contentType, err := c.get(context.Background(), requestURL)
 	if err != nil {
-		slog.Error("❌ HTTP: Failed to read response body", "url", requestURL, "error", err)
-		return nil, fmt.Errorf("failed to read response body from %s: %w", requestURL, err)
+		return nil, err
 	}
 
 	// Check if we received HTML when expecting JSON
 	if util.IsHTMLResponse(respBody) {
-		slog.Error("❌ HTTP: Received HTML instead of JSON metadata", "url", requestURL, "content_type", resp.Header.Get("Content-Type"))
+		slog.Error("❌ HTTP: Received HTML instead of JSON metadata", "url", requestURL, "content_type", contentType)
 		return nil, fmt.Errorf("received HTML page instead of JSON metadata from %s - this may indicate an invalid metadata URL", requestURL)
 	}
 
@@ -229,43 +209,60 @@ func (c *Client) fetchHTTPMetadata(requestURL string) (map[string]any, error) {
 
 // fetchHTTPRaw fetches raw data and content type from an HTTP(S) URL
 func (c *Client) fetchHTTPRaw(ctx context.Context, requestURL string) (data []byte, contentType string, err error) {
-	slog.Debug("🌐 HTTP Raw: Requesting", "url", requestURL)
+	data, contentType, err = c.get(ctx, requestURL)
+	if err != nil {
+		return nil, "", err
+	}
+	if contentType == "" {
+		contentType = "application/octet-stream"
+		slog.Warn("⚠️ HTTP Raw: Content-Type missing, using fallback", "url", requestURL, "fallback_type", contentType)
+	}
+	if len(data) == 0 {
+		slog.Error("❌ HTTP Raw: Empty response body received", "url", requestURL)
+		return nil, "", fmt.Errorf("empty response body received from %s", requestURL)
+	}
+	slog.Debug("✅ HTTP Raw: Success fetching", "bytes", len(data), "content_type", contentType, "url", requestURL)
+	return data, contentType, nil
+}
+
+// get fetches the body and content type of an HTTP(S) URL. URLs fetched
+// before with an ETag or Last-Modified are fetched conditionally, and a 304
+// is answered with the body kept from then.
+func (c *Client) get(ctx context.Context, requestURL string) (body []byte, contentType string, err error) {
+	slog.Debug("🌐 HTTP: Requesting", "url", requestURL)
 	req, err := http.NewRequestWithContext(ctx, "GET", requestURL, nil)
 	if err != nil {
-		slog.Error("❌ HTTP Raw: Failed to create request", "url", requestURL, "error", err)
+		slog.Error("❌ HTTP: Failed to create request", "url", requestURL, "error", err)
 		return nil, "", fmt.Errorf("failed to create request for %s: %w", requestURL, err)
 	}
-	req.Header.Set("User-Agent", "DankfolioImageProxy/1.0")
+	req.Header.Set("User-Agent", userAgent)
+	cached := c.responses.prepare(req)
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		slog.Error("❌ HTTP Raw: Request failed", "url", requestURL, "error", err)
+		slog.Error("❌ HTTP: Request failed", "url", requestURL, "error", err)
 		return nil, "", fmt.Errorf("http get failed for %s: %w", requestURL, err)
 	}
 	defer resp.Body.Close()
 
+	slog.Debug("📥 HTTP: Received response", "status_code", resp.StatusCode, "url", requestURL)
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		slog.Debug("✅ HTTP: Not modified, using the stored body", "bytes", len(cached.body), "url", requestURL)
+		return slices.Clone(cached.body), cached.contentType, nil
+	}
 	if resp.StatusCode != http.StatusOK {
-		slog.Error("❌ HTTP Raw: Failed with status code", "status_code", resp.StatusCode, "url", requestURL)
+		slog.Error("❌ HTTP: Failed with status code", "status_code", resp.StatusCode, "url", requestURL)
 		return nil, "", fmt.Errorf("http status %d for %s", resp.StatusCode, requestURL)
 	}
 
-	contentType = resp.Header.Get("Content-Type")
-	if contentType == "" {
-		contentType = "application/octet-stream"
-		slog.Warn("⚠️ HTTP Raw: Content-Type missing, using fallback", "url", requestURL, "fallback_type", contentType)
-	}
-
-	data, err = io.ReadAll(resp.Body)
+	body, err = io.ReadAll(resp.Body)
 	if err != nil {
-		slog.Error("❌ HTTP Raw: Failed to read response body", "url", requestURL, "error", err)
+		slog.Error("❌ HTTP: Failed to read response body", "url", requestURL, "error", err)
 		return nil, "", fmt.Errorf("failed to read response body from %s: %w", requestURL, err)
 	}
-	if len(data) == 0 {
-		slog.Error("❌ HTTP Raw: Empty response body received", "url", requestURL)
-		return nil, "", fmt.Errorf("empty response body received from %s", requestURL)
-	}
-	slog.Debug("✅ HTTP Raw: Success fetching", "bytes", len(data), "content_type", contentType, "url", requestURL)
-	return data, contentType, nil
+	contentType = resp.Header.Get("Content-Type")
+	c.responses.store(requestURL, resp, contentType, body)
+	return body, contentType, nil
 }
 
 // fetchIPFSRaw fetches raw data from IPFS
@@ -282,7 +279,7 @@ func (c *Client) fetchIPFSRaw(ctx context.Context, uri string) ([]byte, string,
 	for i, gw := range gateways {
 		fullURL := gw + cid
 		slog.Debug("📦 IPFS Raw: Attempt", "index", fmt.Sprintf("%d/%d", i+1, len(gateways)), "gateway", gw, "url", fullURL)
-		data, contentType, err := c.fetchHTTPRaw(ctx, fullURL)
+		data, contentType, err := c.fetchHTTPRaw(clients.WithRetryCount(ctx, i), fullURL)
 		if err == nil {
 			slog.Debug("✅ IPFS Raw: Success from gateway", "index", fmt.Sprintf("%d/%d", i+1, len(gateways)), "gateway", gw)
 			return data, contentType, nil
@@ -306,7 +303,7 @@ func (c *Client) fetchArweaveRaw(ctx context.Context, uri string) ([]byte, strin
 	for i, gw := range gateways {
 		fullURL := gw + txID
 		slog.Debug("📜 Arweave Raw: Attempt", "index", fmt.Sprintf("%d/%d", i+1, len(gateways)), "gateway", gw, "url", fullURL)
-		data, contentType, err := c.fetchHTTPRaw(ctx, fullURL)
+		data, contentType, err := c.fetchHTTPRaw(clients.WithRetryCount(ctx, i), fullURL)
 		if err == nil {
 			slog.Debug("✅ Arweave Raw: Success from gateway", "index", fmt.Sprintf("%d/%d", i+1, len(gateways)), "gateway", gw)
 			return data, contentType, nil
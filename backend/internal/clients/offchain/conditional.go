@@ -0,0 +1,97 @@
+package offchain
+
+import (
+	"container/list"
+	"net/http"
+	"slices"
+	"sync"
+)
+
+// DefaultResponseCacheBytes bounds the bodies kept for conditional refetches
+const DefaultResponseCacheBytes = 32 << 20
+
+// cachedResponse is a fetched body with the validators its host sent
+type cachedResponse struct {
+	url          string
+	etag         string
+	lastModified string
+	contentType  string
+	body         []byte
+}
+
+// responseCache keeps the most recently fetched bodies that came with an ETag
+// or Last-Modified, so refetching them can be conditional and a 304 answered
+// from memory rather than downloading the unchanged bytes again
+type responseCache struct {
+	maxBytes int
+
+	mu      sync.Mutex
+	size    int
+	entries map[string]*list.Element
+	order   *list.List // Of *cachedResponse, most recently used first
+}
+
+func newResponseCache(maxBytes int) *responseCache {
+	return &responseCache{
+		maxBytes: maxBytes,
+		entries:  make(map[string]*list.Element),
+		order:    list.New(),
+	}
+}
+
+// prepare makes req conditional on the cached response for its URL, if any,
+// and returns that response
+func (c *responseCache) prepare(req *http.Request) *cachedResponse {
+	if c == nil {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.entries[req.URL.String()]
+	if !ok {
+		return nil
+	}
+	c.order.MoveToFront(elem)
+	cached := elem.Value.(*cachedResponse)
+	if cached.etag != "" {
+		req.Header.Set("If-None-Match", cached.etag)
+	}
+	if cached.lastModified != "" {
+		req.Header.Set("If-Modified-Since", cached.lastModified)
+	}
+	return cached
+}
+
+// store keeps body if resp carries validators, evicting the least recently
+// used bodies to make room. Bodies over an eighth of the cache aren't kept.
+func (c *responseCache) store(url string, resp *http.Response, contentType string, body []byte) {
+	if c == nil {
+		return
+	}
+	etag, lastModified := resp.Header.Get("ETag"), resp.Header.Get("Last-Modified")
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if elem, ok := c.entries[url]; ok {
+		c.remove(elem)
+	}
+	if (etag == "" && lastModified == "") || len(body) > c.maxBytes/8 {
+		return
+	}
+	for c.size+len(body) > c.maxBytes && c.order.Len() > 0 {
+		c.remove(c.order.Back())
+	}
+	c.entries[url] = c.order.PushFront(&cachedResponse{
+		url:          url,
+		etag:         etag,
+		lastModified: lastModified,
+		contentType:  contentType,
+		body:         slices.Clone(body),
+	})
+	c.size += len(body)
+}
+
+func (c *responseCache) remove(elem *list.Element) {
+	cached := c.order.Remove(elem).(*cachedResponse)
+	delete(c.entries, cached.url)
+	c.size -= len(cached.body)
+}
@@ -0,0 +1,82 @@
+package offchain
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetchRawData_ConditionalRefetch(t *testing.T) {
+	const etag = `"v1"`
+	var downloads, notModified atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/robots.txt":
+			http.NotFound(w, r)
+		case "/logo.png":
+			if r.Header.Get("If-None-Match") == etag {
+				notModified.Add(1)
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			downloads.Add(1)
+			w.Header().Set("ETag", etag)
+			w.Header().Set("Content-Type", "image/png")
+			_, _ = w.Write([]byte("png bytes"))
+		default:
+			_, _ = w.Write([]byte("no validators"))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.Client(), Config{})
+	ctx := context.Background()
+	for range 3 {
+		data, contentType, err := client.FetchRawData(ctx, server.URL+"/logo.png")
+		require.NoError(t, err)
+		assert.Equal(t, "png bytes", string(data))
+		assert.Equal(t, "image/png", contentType)
+	}
+	assert.Equal(t, int32(1), downloads.Load())
+	assert.Equal(t, int32(2), notModified.Load())
+
+	disabled := NewClient(server.Client(), Config{ResponseCacheBytes: -1})
+	for range 2 {
+		_, _, err := disabled.FetchRawData(ctx, server.URL+"/logo.png")
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int32(3), downloads.Load(), "disabled caches always download")
+}
+
+func TestResponseCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	cache := newResponseCache(80)
+	resp := &http.Response{Header: http.Header{"Etag": {`"x"`}}}
+	body := []byte(strings.Repeat("a", 10))
+	for _, url := range []string{"https://a/1", "https://a/2", "https://a/3", "https://a/4", "https://a/5", "https://a/6", "https://a/7", "https://a/8"} {
+		cache.store(url, resp, "", body)
+	}
+	req, err := http.NewRequest(http.MethodGet, "https://a/1", nil)
+	require.NoError(t, err)
+	require.NotNil(t, cache.prepare(req), "a/1 is now the most recently used")
+
+	cache.store("https://a/9", resp, "", body)
+	assert.Equal(t, 80, cache.size)
+	_, kept := cache.entries["https://a/1"]
+	assert.True(t, kept)
+	_, kept = cache.entries["https://a/2"]
+	assert.False(t, kept, "the least recently used body makes room")
+
+	cache.store("https://a/big", resp, "", []byte(strings.Repeat("b", 11)))
+	_, kept = cache.entries["https://a/big"]
+	assert.False(t, kept, "bodies over an eighth of the cache aren't kept")
+
+	cache.store("https://a/1", &http.Response{Header: http.Header{}}, "", body)
+	_, kept = cache.entries["https://a/1"]
+	assert.False(t, kept, "a response without validators drops the stored body")
+}
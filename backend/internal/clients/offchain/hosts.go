@@ -0,0 +1,380 @@
+package offchain
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/apperrors"
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients"
+)
+
+// userAgent identifies the client to the hosts it fetches from and to their robots.txt
+const userAgent = "DankfolioImageProxy/1.0"
+
+// Host politeness defaults
+const (
+	DefaultMaxPerHost    = 4
+	DefaultMinBackoff    = time.Second
+	DefaultMaxBackoff    = 2 * time.Minute
+	DefaultMaxCrawlDelay = 10 * time.Second
+	DefaultRobotsTTL     = 6 * time.Hour
+
+	// robotsTimeout bounds fetching a host's robots.txt
+	robotsTimeout = 5 * time.Second
+	// maxRobotsSize is how much of a robots.txt is read
+	maxRobotsSize = 64 << 10
+	// hostIdleTTL is how long an idle host's state is kept once many hosts are tracked
+	hostIdleTTL     = time.Hour
+	maxTrackedHosts = 1024
+)
+
+// Config bounds how hard the client hits any one host. Zero values use the defaults.
+type Config struct {
+	MaxPerHost    int           // Requests in flight per host while it isn't rate limiting
+	MinBackoff    time.Duration // First pause after a host answers 429 or 503
+	MaxBackoff    time.Duration // Longest pause, including a host's Retry-After
+	MaxCrawlDelay time.Duration // Longest robots.txt Crawl-delay honoured
+	RobotsTTL     time.Duration // How long a host's robots.txt is cached
+
+	// ResponseCacheBytes bounds the bodies kept so unchanged URLs can be
+	// refetched conditionally; negative disables conditional requests
+	ResponseCacheBytes int
+}
+
+func (c Config) withDefaults() Config {
+	if c.MaxPerHost <= 0 {
+		c.MaxPerHost = DefaultMaxPerHost
+	}
+	if c.MinBackoff <= 0 {
+		c.MinBackoff = DefaultMinBackoff
+	}
+	if c.MaxBackoff <= 0 {
+		c.MaxBackoff = DefaultMaxBackoff
+	}
+	c.MaxBackoff = max(c.MaxBackoff, c.MinBackoff)
+	if c.MaxCrawlDelay <= 0 {
+		c.MaxCrawlDelay = DefaultMaxCrawlDelay
+	}
+	if c.RobotsTTL <= 0 {
+		c.RobotsTTL = DefaultRobotsTTL
+	}
+	if c.ResponseCacheBytes == 0 {
+		c.ResponseCacheBytes = DefaultResponseCacheBytes
+	}
+	return c
+}
+
+// hostLimiter keeps each host to its share of requests: at most MaxPerHost in
+// flight, halved when the host rate limits and grown back one at a time as
+// requests succeed, no faster than its robots.txt Crawl-delay, and none at all
+// while it is backing off. Requests to a host that is backing off fail at once
+// so gateway fallbacks move on to the next host.
+type hostLimiter struct {
+	config Config
+	doer   clients.HTTPDoer // Fetches robots.txt
+
+	mu    sync.Mutex
+	hosts map[string]*hostState
+
+	backoffs metric.Int64Counter
+}
+
+type hostState struct {
+	mu           sync.Mutex
+	limit        int // Current cap on requests in flight, between 1 and MaxPerHost
+	inFlight     int
+	successes    int           // Since limit last changed
+	released     chan struct{} // Closed and replaced when a request finishes
+	backoff      time.Duration // Last pause; zero once the host answers normally
+	blockedUntil time.Time
+	nextAt       time.Time // Earliest start of the next request under the crawl delay
+	lastUsed     time.Time
+
+	robotsMu   sync.Mutex // Held while fetching robots.txt
+	crawlDelay time.Duration
+	robotsAt   time.Time // When robots.txt was last fetched; zero for never
+}
+
+func newHostLimiter(doer clients.HTTPDoer, config Config) *hostLimiter {
+	l := &hostLimiter{
+		config: config.withDefaults(),
+		doer:   doer,
+		hosts:  make(map[string]*hostState),
+	}
+	meter := otel.Meter("github.com/nicolas-martin/dankfolio/backend/internal/clients/offchain")
+	var err error
+	l.backoffs, err = meter.Int64Counter(
+		"dankfolio.offchain.host_backoffs_total",
+		metric.WithDescription("Times the offchain client backed off a host that rate limited it"),
+		metric.WithUnit("{backoff}"),
+	)
+	if err != nil {
+		slog.Warn("Failed to create offchain host backoff counter", slog.Any("error", err))
+	}
+	return l
+}
+
+// Do sends req once its host has room, and adapts the host's limits to the response
+func (l *hostLimiter) Do(req *http.Request) (*http.Response, error) {
+	host := req.URL.Host
+	state := l.host(host)
+	l.loadRobots(req.Context(), req.URL, state)
+	if err := l.acquire(req.Context(), host, state); err != nil {
+		return nil, err
+	}
+	resp, err := l.doer.Do(req)
+	if err != nil {
+		l.release(host, state, 0, nil)
+		return nil, err
+	}
+	l.release(host, state, resp.StatusCode, resp.Header)
+	return resp, nil
+}
+
+func (l *hostLimiter) host(host string) *hostState {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := time.Now()
+	state, ok := l.hosts[host]
+	if !ok {
+		if len(l.hosts) >= maxTrackedHosts {
+			l.evictIdle(now)
+		}
+		state = &hostState{limit: l.config.MaxPerHost, released: make(chan struct{})}
+		l.hosts[host] = state
+	}
+	state.mu.Lock()
+	state.lastUsed = now
+	state.mu.Unlock()
+	return state
+}
+
+// evictIdle forgets hosts unused for hostIdleTTL that aren't backing off
+func (l *hostLimiter) evictIdle(now time.Time) {
+	for host, state := range l.hosts {
+		state.mu.Lock()
+		idle := state.inFlight == 0 && now.Sub(state.lastUsed) > hostIdleTTL && now.After(state.blockedUntil)
+		state.mu.Unlock()
+		if idle {
+			delete(l.hosts, host)
+		}
+	}
+}
+
+// acquire waits for room on the host and its crawl delay
+func (l *hostLimiter) acquire(ctx context.Context, host string, state *hostState) error {
+	for {
+		state.mu.Lock()
+		now := time.Now()
+		if now.Before(state.blockedUntil) {
+			remaining := state.blockedUntil.Sub(now)
+			state.mu.Unlock()
+			return fmt.Errorf("%w: %s is backing off for %s", apperrors.ErrUpstreamRateLimited, host, remaining.Round(time.Millisecond))
+		}
+		var wait time.Duration
+		switch {
+		case state.inFlight >= state.limit:
+			wait = -1
+		case now.Before(state.nextAt):
+			wait = state.nextAt.Sub(now)
+		default:
+			state.inFlight++
+			state.nextAt = now.Add(state.crawlDelay)
+			state.mu.Unlock()
+			return nil
+		}
+		released := state.released
+		state.mu.Unlock()
+
+		if wait < 0 {
+			select {
+			case <-released:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			continue
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-released:
+			timer.Stop()
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+	}
+}
+
+// release frees the request's slot. A 429 or 503 halves the host's limit and
+// pauses it, doubling the pause each time unless Retry-After asks for longer;
+// status 0 is a transport error, which leaves the limits alone.
+func (l *hostLimiter) release(host string, state *hostState, status int, header http.Header) {
+	state.mu.Lock()
+	defer state.mu.Unlock()
+	state.inFlight--
+	close(state.released)
+	state.released = make(chan struct{})
+
+	switch {
+	case status == http.StatusTooManyRequests || status == http.StatusServiceUnavailable:
+		state.limit = max(1, state.limit/2)
+		state.successes = 0
+		state.backoff = min(max(2*state.backoff, l.config.MinBackoff), l.config.MaxBackoff)
+		if retryAfter := parseRetryAfter(header, time.Now()); retryAfter > state.backoff {
+			state.backoff = min(retryAfter, l.config.MaxBackoff)
+		}
+		state.blockedUntil = time.Now().Add(state.backoff)
+		if l.backoffs != nil {
+			l.backoffs.Add(context.Background(), 1, metric.WithAttributes(attribute.String("host", host)))
+		}
+		slog.Warn("Offchain host rate limited, backing off",
+			slog.String("host", host),
+			slog.Int("status", status),
+			slog.Int("limit", state.limit),
+			slog.Duration("backoff", state.backoff))
+	case status != 0:
+		state.backoff = 0
+		if state.limit < l.config.MaxPerHost {
+			state.successes++
+			if state.successes >= state.limit {
+				state.limit++
+				state.successes = 0
+			}
+		}
+	}
+}
+
+// loadRobots fetches the host's robots.txt when it isn't cached. Hosts whose
+// robots.txt can't be fetched get no crawl delay until it is next due.
+func (l *hostLimiter) loadRobots(ctx context.Context, u *url.URL, state *hostState) {
+	state.robotsMu.Lock()
+	defer state.robotsMu.Unlock()
+	if !state.robotsAt.IsZero() && time.Since(state.robotsAt) < l.config.RobotsTTL {
+		return
+	}
+
+	delay, err := l.fetchCrawlDelay(ctx, u)
+	if err != nil {
+		slog.Debug("Failed to fetch robots.txt", slog.String("host", u.Host), slog.Any("error", err))
+	}
+	delay = min(delay, l.config.MaxCrawlDelay)
+	state.mu.Lock()
+	state.crawlDelay = delay
+	state.mu.Unlock()
+	state.robotsAt = time.Now()
+}
+
+func (l *hostLimiter) fetchCrawlDelay(ctx context.Context, u *url.URL) (time.Duration, error) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), robotsTimeout)
+	defer cancel()
+	robotsURL := (&url.URL{Scheme: u.Scheme, Host: u.Host, Path: "/robots.txt"}).String()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, robotsURL, nil)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("User-Agent", userAgent)
+	resp, err := l.doer.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		// Missing robots.txt means no rules
+		return 0, nil
+	}
+	return parseCrawlDelay(io.LimitReader(resp.Body, maxRobotsSize), userAgent), nil
+}
+
+// parseCrawlDelay returns the Crawl-delay of the robots.txt group that names
+// agent, falling back to the "*" group
+func parseCrawlDelay(r io.Reader, agent string) time.Duration {
+	token := strings.ToLower(agent)
+	if i := strings.IndexByte(token, '/'); i >= 0 {
+		token = token[:i]
+	}
+
+	var (
+		matched, wildcard       bool
+		inAgents, ownGroup      bool
+		ownDelay, wildcardDelay time.Duration
+		haveOwn, haveWildcard   bool
+	)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		field, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		field = strings.ToLower(strings.TrimSpace(field))
+		value = strings.TrimSpace(value)
+
+		if field == "user-agent" {
+			// Consecutive User-agent lines share a group
+			if !inAgents {
+				matched, wildcard = false, false
+			}
+			inAgents = true
+			name := strings.ToLower(value)
+			switch {
+			case name == "*":
+				wildcard = true
+			case name != "" && strings.Contains(token, name):
+				matched, ownGroup = true, true
+			}
+			continue
+		}
+		inAgents = false
+		if field != "crawl-delay" {
+			continue
+		}
+		seconds, err := strconv.ParseFloat(value, 64)
+		if err != nil || seconds < 0 {
+			continue
+		}
+		delay := time.Duration(seconds * float64(time.Second))
+		if matched && !haveOwn {
+			ownDelay, haveOwn = delay, true
+		}
+		if wildcard && !haveWildcard {
+			wildcardDelay, haveWildcard = delay, true
+		}
+	}
+	// A group naming the agent replaces the wildcard group, delay or not
+	if ownGroup {
+		return ownDelay
+	}
+	return wildcardDelay
+}
+
+// parseRetryAfter reads a Retry-After header in seconds or as an HTTP date
+func parseRetryAfter(header http.Header, now time.Time) time.Duration {
+	value := header.Get("Retry-After")
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if at, err := http.ParseTime(value); err == nil && at.After(now) {
+		return at.Sub(now)
+	}
+	return 0
+}
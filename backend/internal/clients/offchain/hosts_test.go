@@ -0,0 +1,133 @@
+package offchain
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/apperrors"
+)
+
+func TestParseCrawlDelay(t *testing.T) {
+	tests := []struct {
+		name   string
+		robots string
+		want   time.Duration
+	}{
+		{"none", "User-agent: *\nDisallow: /private", 0},
+		{"wildcard", "User-agent: *\nCrawl-delay: 2", 2 * time.Second},
+		{"fractional", "User-agent: *\nCrawl-delay: 0.5 # seconds", 500 * time.Millisecond},
+		{"own group wins", "User-agent: *\nCrawl-delay: 5\n\nUser-agent: DankfolioImageProxy\nCrawl-delay: 1", time.Second},
+		{"own group without a delay", "User-agent: *\nCrawl-delay: 5\n\nUser-agent: dankfolioimageproxy\nDisallow:", 0},
+		{"shared group", "User-agent: googlebot\nUser-agent: *\nCrawl-delay: 3", 3 * time.Second},
+		{"other agents", "User-agent: googlebot\nCrawl-delay: 3", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, parseCrawlDelay(strings.NewReader(tt.robots), userAgent))
+		})
+	}
+}
+
+func TestHostLimiter_BacksOffRateLimitedHosts(t *testing.T) {
+	var limited atomic.Bool
+	limited.Store(true)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			http.NotFound(w, r)
+			return
+		}
+		if limited.Load() {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	l := newHostLimiter(server.Client(), Config{MaxPerHost: 4, MinBackoff: 100 * time.Millisecond})
+	get := func() (*http.Response, error) {
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL+"/token.json", nil)
+		require.NoError(t, err)
+		return l.Do(req)
+	}
+
+	resp, err := get()
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+
+	state := l.host(resp.Request.URL.Host)
+	state.mu.Lock()
+	assert.Equal(t, 2, state.limit, "limit halves")
+	assert.Equal(t, time.Second, state.backoff, "Retry-After beats the minimum backoff")
+	state.mu.Unlock()
+
+	// The host fails fast while it is backing off
+	_, err = get()
+	assert.ErrorIs(t, err, apperrors.ErrUpstreamRateLimited)
+
+	// Successes after the backoff grow the limit back
+	limited.Store(false)
+	state.mu.Lock()
+	state.blockedUntil = time.Time{}
+	state.mu.Unlock()
+	for range 2 {
+		resp, err := get()
+		require.NoError(t, err)
+		resp.Body.Close()
+	}
+	state.mu.Lock()
+	defer state.mu.Unlock()
+	assert.Equal(t, 3, state.limit)
+	assert.Zero(t, state.backoff)
+}
+
+func TestHostLimiter_CapsRequestsPerHost(t *testing.T) {
+	var inFlight, peak atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			http.NotFound(w, r)
+			return
+		}
+		n := inFlight.Add(1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		inFlight.Add(-1)
+	}))
+	defer server.Close()
+
+	l := newHostLimiter(server.Client(), Config{MaxPerHost: 2})
+	done := make(chan error)
+	for range 6 {
+		go func() {
+			req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL+"/logo.png", nil)
+			if err != nil {
+				done <- err
+				return
+			}
+			resp, err := l.Do(req)
+			if err == nil {
+				resp.Body.Close()
+			}
+			done <- err
+		}()
+	}
+	for range 6 {
+		require.NoError(t, <-done)
+	}
+	assert.LessOrEqual(t, peak.Load(), int32(2))
+}
@@ -0,0 +1,233 @@
+package clients
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// ReplayMode selects whether outgoing HTTP calls are recorded to fixtures or
+// served from them
+type ReplayMode string
+
+const (
+	// ReplayOff sends requests to the network untouched
+	ReplayOff ReplayMode = ""
+	// ReplayRecord sends requests to the network and saves every response as a fixture
+	ReplayRecord ReplayMode = "record"
+	// ReplayReplay serves responses from fixtures and never touches the network
+	ReplayReplay ReplayMode = "replay"
+)
+
+// ErrNoFixture is returned in replay mode when no fixture matches a request
+var ErrNoFixture = errors.New("no recorded fixture for request")
+
+// ParseReplayMode parses HTTP_REPLAY_MODE; "off" and "" disable replay
+func ParseReplayMode(s string) (ReplayMode, error) {
+	switch mode := ReplayMode(strings.ToLower(strings.TrimSpace(s))); mode {
+	case ReplayOff, "off":
+		return ReplayOff, nil
+	case ReplayRecord, ReplayReplay:
+		return mode, nil
+	default:
+		return ReplayOff, fmt.Errorf("unknown replay mode %q (want off, record or replay)", s)
+	}
+}
+
+// ReplayConfig configures record-and-replay for wrapped HTTP clients
+type ReplayConfig struct {
+	Mode ReplayMode
+	// Dir holds one sub-directory of fixtures per service
+	Dir string
+}
+
+// secretQueryParams are dropped from fixture keys and recorded URLs so
+// fixtures can be committed and replayed without keys
+var secretQueryParams = map[string]bool{
+	"api_key": true,
+	"api-key": true,
+	"apikey":  true,
+	"key":     true,
+	"token":   true,
+}
+
+// fixture is a recorded response as stored on disk. Request headers are
+// never recorded since they carry API keys.
+type fixture struct {
+	Method       string      `json:"method"`
+	URL          string      `json:"url"`
+	RequestBody  string      `json:"request_body,omitempty"`
+	Status       int         `json:"status"`
+	Header       http.Header `json:"header,omitempty"`
+	Body         string      `json:"body"`
+	BodyEncoding string      `json:"body_encoding,omitempty"` // "base64" for binary bodies such as images
+}
+
+// replayTransport records or replays responses for one service
+type replayTransport struct {
+	base http.RoundTripper
+	mode ReplayMode
+	dir  string
+}
+
+// NewReplayTransport wraps base so responses for serviceName are recorded to,
+// or replayed from, cfg.Dir/serviceName. With replay off base is returned.
+func NewReplayTransport(base http.RoundTripper, serviceName string, cfg ReplayConfig) http.RoundTripper {
+	if cfg.Mode == ReplayOff {
+		return base
+	}
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &replayTransport{
+		base: base,
+		mode: cfg.Mode,
+		dir:  filepath.Join(cfg.Dir, serviceName),
+	}
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *replayTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var reqBody []byte
+	if req.Body != nil {
+		var err error
+		reqBody, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read request body: %w", err)
+		}
+		req.Body = io.NopCloser(bytes.NewReader(reqBody))
+	}
+	path := filepath.Join(t.dir, fixtureName(req, reqBody))
+
+	if t.mode == ReplayReplay {
+		return t.replay(req, path)
+	}
+	return t.record(req, reqBody, path)
+}
+
+func (t *replayTransport) replay(req *http.Request, path string) (*http.Response, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s %s (expected %s)", ErrNoFixture, req.Method, redactURL(req.URL), path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read fixture %s: %w", path, err)
+	}
+
+	var f fixture
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("failed to decode fixture %s: %w", path, err)
+	}
+	body := []byte(f.Body)
+	if f.BodyEncoding == "base64" {
+		if body, err = base64.StdEncoding.DecodeString(f.Body); err != nil {
+			return nil, fmt.Errorf("failed to decode fixture body %s: %w", path, err)
+		}
+	}
+
+	header := f.Header
+	if header == nil {
+		header = make(http.Header)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", f.Status, http.StatusText(f.Status)),
+		StatusCode:    f.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+func (t *replayTransport) record(req *http.Request, reqBody []byte, path string) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	f := fixture{
+		Method:      req.Method,
+		URL:         redactURL(req.URL),
+		RequestBody: string(reqBody),
+		Status:      resp.StatusCode,
+		Body:        string(body),
+	}
+	if contentType := resp.Header.Get("Content-Type"); contentType != "" {
+		f.Header = http.Header{"Content-Type": {contentType}}
+	}
+	if !utf8.Valid(body) {
+		f.Body = base64.StdEncoding.EncodeToString(body)
+		f.BodyEncoding = "base64"
+	}
+
+	// A failed write only costs the fixture; the live response is still returned
+	if err := writeFixture(path, f); err != nil {
+		slog.Warn("Failed to record HTTP fixture", slog.String("path", path), slog.Any("error", err))
+	}
+	return resp, nil
+}
+
+func writeFixture(path string, f fixture) error {
+	data, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+var unsafeFixtureChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// fixtureName derives a stable file name from the method, host, path, query
+// without secret parameters, and request body
+func fixtureName(req *http.Request, body []byte) string {
+	key := req.Method + " " + redactURL(req.URL) + "\n" + string(body)
+	sum := sha256.Sum256([]byte(key))
+
+	readable := unsafeFixtureChars.ReplaceAllString(req.URL.Host+req.URL.Path, "_")
+	if len(readable) > 80 {
+		readable = readable[:80]
+	}
+	return fmt.Sprintf("%s_%s_%s.json", req.Method, strings.Trim(readable, "_"), hex.EncodeToString(sum[:6]))
+}
+
+// redactURL returns u with secret query parameters removed and the rest in
+// sorted order
+func redactURL(u *url.URL) string {
+	query := u.Query()
+	for k := range query {
+		if secretQueryParams[strings.ToLower(k)] {
+			query.Del(k)
+		}
+	}
+	redacted := *u
+	redacted.User = nil
+	redacted.RawQuery = query.Encode()
+	redacted.Fragment = ""
+	return redacted.String()
+}
@@ -0,0 +1,107 @@
+package clients
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplay_RecordThenReplay(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, `{"symbol":"`+r.URL.Query().Get("symbol")+`"}`)
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	get := func(client HTTPDoer, query string) (string, error) {
+		req, err := http.NewRequest(http.MethodGet, server.URL+"/defi/price?"+query, nil)
+		require.NoError(t, err)
+		resp, err := client.Do(req)
+		if err != nil {
+			return "", err
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+		return string(body), nil
+	}
+
+	recorder := WrapHTTPClient(&http.Client{}, "birdeye", nil, WithReplay(ReplayConfig{Mode: ReplayRecord, Dir: dir}))
+	body, err := get(recorder, "symbol=BONK&api_key=secret")
+	require.NoError(t, err)
+	assert.Equal(t, `{"symbol":"BONK"}`, body)
+
+	// Keys never reach the fixtures
+	files, err := filepath.Glob(filepath.Join(dir, "birdeye", "*.json"))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	data, err := os.ReadFile(files[0])
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "secret")
+
+	// Replays match regardless of the key and never hit the server
+	replayer := WrapHTTPClient(&http.Client{}, "birdeye", nil, WithReplay(ReplayConfig{Mode: ReplayReplay, Dir: dir}))
+	body, err = get(replayer, "api_key=other&symbol=BONK")
+	require.NoError(t, err)
+	assert.Equal(t, `{"symbol":"BONK"}`, body)
+	assert.Equal(t, 1, calls)
+
+	_, err = get(replayer, "symbol=WIF")
+	assert.ErrorIs(t, err, ErrNoFixture)
+}
+
+func TestReplay_BinaryBodiesAndRequestBodies(t *testing.T) {
+	image := []byte{0x89, 'P', 'N', 'G', 0xff, 0x00}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if len(body) > 0 {
+			_, _ = w.Write(body)
+			return
+		}
+		_, _ = w.Write(image)
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	do := func(client HTTPDoer, method, body string) []byte {
+		req, err := http.NewRequest(method, server.URL+"/x", strings.NewReader(body))
+		require.NoError(t, err)
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		got, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return got
+	}
+
+	recorder := WrapHTTPClient(&http.Client{}, "offchain", nil, WithReplay(ReplayConfig{Mode: ReplayRecord, Dir: dir}))
+	do(recorder, http.MethodGet, "")
+	do(recorder, http.MethodPost, "a")
+	do(recorder, http.MethodPost, "b")
+
+	replayer := WrapHTTPClient(&http.Client{}, "offchain", nil, WithReplay(ReplayConfig{Mode: ReplayReplay, Dir: dir}))
+	assert.Equal(t, image, do(replayer, http.MethodGet, ""))
+	assert.Equal(t, []byte("a"), do(replayer, http.MethodPost, "a"))
+	assert.Equal(t, []byte("b"), do(replayer, http.MethodPost, "b"))
+}
+
+func TestParseReplayMode(t *testing.T) {
+	for in, want := range map[string]ReplayMode{"": ReplayOff, "off": ReplayOff, "Record": ReplayRecord, "replay": ReplayReplay} {
+		got, err := ParseReplayMode(in)
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+	}
+	_, err := ParseReplayMode("sometimes")
+	assert.Error(t, err)
+}
@@ -1,19 +1,17 @@
 package s3
 
 import (
-	"bytes"
 	"context"
 	"fmt"
 	"io"
-	"log/slog"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
-	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/aws/smithy-go/middleware"
 	smithyhttp "github.com/aws/smithy-go/transport/http"
 )
@@ -31,9 +29,12 @@ func withContentMD5(o *s3.Options) {
 }
 
 type Client struct {
-	s3Client       *s3.Client
+	s3Client       objectAPI
 	bucketName     string
 	publicURLPrefix string
+	partSize       int64
+	maxUploadSize  int64
+	cacheControl   string
 }
 
 type Config struct {
@@ -43,10 +44,30 @@ type Config struct {
 	BucketName      string
 	Region          string
 	PublicURLPrefix string
+	PartSize        int64 // Bytes buffered per multipart upload part; DefaultPartSize when zero
+	MaxUploadSize   int64 // Largest object accepted; DefaultMaxUploadSize when zero
+	CacheControl    string // Cache-Control stored with uploads, served by the bucket and CDN; DefaultCacheControl when empty
+}
+
+func (c Config) withDefaults() Config {
+	if c.PartSize <= 0 {
+		c.PartSize = DefaultPartSize
+	}
+	// S3 rejects parts other than the last under 5 MiB
+	c.PartSize = max(c.PartSize, minPartSize)
+	if c.MaxUploadSize <= 0 {
+		c.MaxUploadSize = DefaultMaxUploadSize
+	}
+	if c.CacheControl == "" {
+		c.CacheControl = DefaultCacheControl
+	}
+	return c
 }
 
 // NewClient creates a new S3 client configured for Linode Object Storage
 func NewClient(cfg Config) (*Client, error) {
+	cfg = cfg.withDefaults()
+
 	// Load AWS config with credentials
 	awsCfg, err := config.LoadDefaultConfig(context.TODO(),
 		config.WithRegion(cfg.Region),
@@ -71,45 +92,16 @@ func NewClient(cfg Config) (*Client, error) {
 		s3Client:        s3Client,
 		bucketName:      cfg.BucketName,
 		publicURLPrefix: strings.TrimSuffix(cfg.PublicURLPrefix, "/"),
+		partSize:        cfg.PartSize,
+		maxUploadSize:   cfg.MaxUploadSize,
+		cacheControl:    cfg.CacheControl,
 	}, nil
 }
 
-// UploadImage uploads an image to S3 and returns the public URL
+// UploadImage uploads an image to S3 and returns the public URL. The image is
+// streamed, see UploadStream.
 func (c *Client) UploadImage(ctx context.Context, key string, data io.Reader, contentType string) (string, error) {
-	// Buffer the entire content to ensure proper content length
-	buf := new(bytes.Buffer)
-	_, err := io.Copy(buf, data)
-	if err != nil {
-		return "", fmt.Errorf("failed to buffer image data: %w", err)
-	}
-	
-	input := &s3.PutObjectInput{
-		Bucket:       aws.String(c.bucketName),
-		Key:          aws.String(key),
-		Body:         bytes.NewReader(buf.Bytes()),
-		ContentType:  aws.String(contentType),
-		ContentLength: aws.Int64(int64(buf.Len())),
-		ACL:          types.ObjectCannedACLPublicRead, // Make object publicly readable
-	}
-	
-	slog.Debug("Uploading to S3", 
-		"bucket", c.bucketName,
-		"key", key,
-		"contentType", contentType,
-		"size", buf.Len())
-	
-	// Use withContentMD5 to disable new checksum behavior for Linode compatibility
-	_, err = c.s3Client.PutObject(ctx, input, withContentMD5)
-	if err != nil {
-		return "", fmt.Errorf("failed to upload to S3: %w", err)
-	}
-
-	publicURL := fmt.Sprintf("%s/%s", c.publicURLPrefix, key)
-	slog.Info("Successfully uploaded image to S3", 
-		"key", key,
-		"url", publicURL)
-	
-	return publicURL, nil
+	return c.UploadStream(ctx, key, data, -1, contentType)
 }
 
 // ImageExists checks if an image already exists in S3
@@ -142,6 +134,14 @@ func NewClientFromEnv() (*Client, error) {
 		BucketName:      os.Getenv("S3_BUCKET_NAME"),
 		Region:          os.Getenv("S3_REGION"),
 		PublicURLPrefix: os.Getenv("S3_PUBLIC_URL_PREFIX"),
+		CacheControl:    os.Getenv("S3_CACHE_CONTROL"),
+	}
+	if v := os.Getenv("S3_MAX_UPLOAD_BYTES"); v != "" {
+		maxUploadSize, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid S3_MAX_UPLOAD_BYTES %q: %w", v, err)
+		}
+		cfg.MaxUploadSize = maxUploadSize
 	}
 
 	// Validate required fields
@@ -0,0 +1,237 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+const (
+	DefaultPartSize      = 8 << 20   // 8 MiB
+	DefaultMaxUploadSize = 100 << 20 // 100 MiB
+
+	// DefaultCacheControl lets the CDN and apps keep objects a day; replaced
+	// icons are invalidated at the CDN
+	DefaultCacheControl = "public, max-age=86400"
+
+	// minPartSize is the smallest part S3 accepts other than the last
+	minPartSize = 5 << 20
+
+	// abortTimeout bounds cleaning up a failed multipart upload
+	abortTimeout = 30 * time.Second
+)
+
+var (
+	// ErrTooLarge is returned for uploads over the client's MaxUploadSize
+	ErrTooLarge = errors.New("object exceeds the upload size limit")
+	// ErrNotFound is returned when downloading an object that doesn't exist
+	ErrNotFound = errors.New("object not found")
+)
+
+// objectAPI is the part of the S3 API the client uses
+type objectAPI interface {
+	PutObject(ctx context.Context, params *s3.PutObjectInput, optFns ...func(*s3.Options)) (*s3.PutObjectOutput, error)
+	HeadObject(ctx context.Context, params *s3.HeadObjectInput, optFns ...func(*s3.Options)) (*s3.HeadObjectOutput, error)
+	GetObject(ctx context.Context, params *s3.GetObjectInput, optFns ...func(*s3.Options)) (*s3.GetObjectOutput, error)
+	CreateMultipartUpload(ctx context.Context, params *s3.CreateMultipartUploadInput, optFns ...func(*s3.Options)) (*s3.CreateMultipartUploadOutput, error)
+	UploadPart(ctx context.Context, params *s3.UploadPartInput, optFns ...func(*s3.Options)) (*s3.UploadPartOutput, error)
+	CompleteMultipartUpload(ctx context.Context, params *s3.CompleteMultipartUploadInput, optFns ...func(*s3.Options)) (*s3.CompleteMultipartUploadOutput, error)
+	AbortMultipartUpload(ctx context.Context, params *s3.AbortMultipartUploadInput, optFns ...func(*s3.Options)) (*s3.AbortMultipartUploadOutput, error)
+}
+
+// UploadStream uploads body to S3 and returns the public URL, buffering at
+// most one part in memory. Bodies that fit in one part are put as is; larger
+// ones are uploaded in parts. size is body's length, or -1 if unknown; a
+// known size over the limit is rejected before anything is read, an unknown
+// one as soon as the body runs past it.
+func (c *Client) UploadStream(ctx context.Context, key string, body io.Reader, size int64, contentType string) (string, error) {
+	if size > c.maxUploadSize {
+		return "", fmt.Errorf("%w: %d bytes, limit %d", ErrTooLarge, size, c.maxUploadSize)
+	}
+	// One byte past the limit is read to tell a body at the limit from a larger one
+	body = io.LimitReader(body, c.maxUploadSize+1)
+
+	part := make([]byte, min(c.partSize, c.maxUploadSize+1))
+	n, err := io.ReadFull(body, part)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return "", fmt.Errorf("failed to read upload body: %w", err)
+	}
+	if int64(n) > c.maxUploadSize {
+		return "", fmt.Errorf("%w: over %d bytes", ErrTooLarge, c.maxUploadSize)
+	}
+	if err != nil {
+		// The whole body fits in one part
+		err = c.putObject(ctx, key, part[:n], contentType)
+	} else {
+		err = c.uploadMultipart(ctx, key, body, part, contentType)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	publicURL := c.GetImageURL(key)
+	slog.Info("Successfully uploaded object to S3", "key", key, "url", publicURL)
+	return publicURL, nil
+}
+
+func (c *Client) putObject(ctx context.Context, key string, data []byte, contentType string) error {
+	slog.Debug("Uploading to S3",
+		"bucket", c.bucketName,
+		"key", key,
+		"contentType", contentType,
+		"size", len(data))
+
+	// Use withContentMD5 to disable new checksum behavior for Linode compatibility
+	_, err := c.s3Client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:        aws.String(c.bucketName),
+		Key:           aws.String(key),
+		Body:          bytes.NewReader(data),
+		ContentType:   aws.String(contentType),
+		ContentLength: aws.Int64(int64(len(data))),
+		CacheControl:  aws.String(c.cacheControl),
+		ACL:           types.ObjectCannedACLPublicRead, // Make object publicly readable
+	}, withContentMD5)
+	if err != nil {
+		return fmt.Errorf("failed to upload to S3: %w", err)
+	}
+	return nil
+}
+
+// uploadMultipart uploads the full first part and the rest of body in parts,
+// aborting the upload if any of them fails so S3 drops the parts stored
+func (c *Client) uploadMultipart(ctx context.Context, key string, body io.Reader, part []byte, contentType string) (err error) {
+	created, err := c.s3Client.CreateMultipartUpload(ctx, &s3.CreateMultipartUploadInput{
+		Bucket:       aws.String(c.bucketName),
+		Key:          aws.String(key),
+		ContentType:  aws.String(contentType),
+		CacheControl: aws.String(c.cacheControl),
+		ACL:          types.ObjectCannedACLPublicRead,
+	}, withContentMD5)
+	if err != nil {
+		return fmt.Errorf("failed to start multipart upload: %w", err)
+	}
+	uploadID := created.UploadId
+	defer func() {
+		if err != nil {
+			c.abortMultipart(ctx, key, uploadID)
+		}
+	}()
+
+	var parts []types.CompletedPart
+	var total int64
+	n := len(part)
+	for partNumber := int32(1); n > 0; partNumber++ {
+		total += int64(n)
+		if total > c.maxUploadSize {
+			return fmt.Errorf("%w: over %d bytes", ErrTooLarge, c.maxUploadSize)
+		}
+		uploaded, err := c.s3Client.UploadPart(ctx, &s3.UploadPartInput{
+			Bucket:        aws.String(c.bucketName),
+			Key:           aws.String(key),
+			UploadId:      uploadID,
+			PartNumber:    aws.Int32(partNumber),
+			Body:          bytes.NewReader(part[:n]),
+			ContentLength: aws.Int64(int64(n)),
+		}, withContentMD5)
+		if err != nil {
+			return fmt.Errorf("failed to upload part %d: %w", partNumber, err)
+		}
+		parts = append(parts, types.CompletedPart{ETag: uploaded.ETag, PartNumber: aws.Int32(partNumber)})
+
+		n, err = io.ReadFull(body, part)
+		if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+			return fmt.Errorf("failed to read upload body: %w", err)
+		}
+	}
+
+	_, err = c.s3Client.CompleteMultipartUpload(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(c.bucketName),
+		Key:             aws.String(key),
+		UploadId:        uploadID,
+		MultipartUpload: &types.CompletedMultipartUpload{Parts: parts},
+	}, withContentMD5)
+	if err != nil {
+		return fmt.Errorf("failed to complete multipart upload: %w", err)
+	}
+	slog.Debug("Completed multipart upload to S3", "key", key, "parts", len(parts), "size", total)
+	return nil
+}
+
+func (c *Client) abortMultipart(ctx context.Context, key string, uploadID *string) {
+	// Aborted even when ctx was what cancelled the upload
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), abortTimeout)
+	defer cancel()
+	_, err := c.s3Client.AbortMultipartUpload(ctx, &s3.AbortMultipartUploadInput{
+		Bucket:   aws.String(c.bucketName),
+		Key:      aws.String(key),
+		UploadId: uploadID,
+	})
+	if err != nil {
+		// The bucket's lifecycle rules drop the parts eventually
+		slog.Warn("Failed to abort multipart upload", "key", key, "error", err)
+	}
+}
+
+// Object is a downloaded object, or a range of it
+type Object struct {
+	Body          io.ReadCloser // Closed by the caller
+	ContentType   string
+	ContentLength int64 // Bytes in Body
+	Size          int64 // Bytes in the whole object
+}
+
+// Download streams the object at key, or with length > 0 the length bytes
+// from offset. A range running past the end of the object is cut short.
+func (c *Client) Download(ctx context.Context, key string, offset, length int64) (*Object, error) {
+	input := &s3.GetObjectInput{
+		Bucket: aws.String(c.bucketName),
+		Key:    aws.String(key),
+	}
+	switch {
+	case length > 0:
+		input.Range = aws.String(fmt.Sprintf("bytes=%d-%d", offset, offset+length-1))
+	case offset > 0:
+		input.Range = aws.String(fmt.Sprintf("bytes=%d-", offset))
+	}
+
+	out, err := c.s3Client.GetObject(ctx, input)
+	if err != nil {
+		var noSuchKey *types.NoSuchKey
+		if errors.As(err, &noSuchKey) {
+			return nil, fmt.Errorf("%w: %s", ErrNotFound, key)
+		}
+		return nil, fmt.Errorf("failed to download from S3: %w", err)
+	}
+
+	object := &Object{
+		Body:          out.Body,
+		ContentType:   aws.ToString(out.ContentType),
+		ContentLength: aws.ToInt64(out.ContentLength),
+	}
+	object.Size = object.ContentLength
+	if size, ok := rangeTotal(aws.ToString(out.ContentRange)); ok {
+		object.Size = size
+	}
+	return object, nil
+}
+
+// rangeTotal returns the object size from a Content-Range such as
+// "bytes 0-99/1234"
+func rangeTotal(contentRange string) (int64, bool) {
+	_, total, ok := strings.Cut(contentRange, "/")
+	if !ok {
+		return 0, false
+	}
+	size, err := strconv.ParseInt(total, 10, 64)
+	return size, err == nil
+}
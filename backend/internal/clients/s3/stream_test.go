@@ -0,0 +1,154 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeObjectAPI stores objects in memory, putting multipart uploads together
+// on completion
+type fakeObjectAPI struct {
+	objectAPI
+	objects  map[string][]byte
+	parts    map[int32][]byte
+	puts     int
+	aborted  bool
+	failPart int32
+}
+
+func (f *fakeObjectAPI) PutObject(_ context.Context, in *s3.PutObjectInput, _ ...func(*s3.Options)) (*s3.PutObjectOutput, error) {
+	data, _ := io.ReadAll(in.Body)
+	f.objects[*in.Key] = data
+	f.puts++
+	return &s3.PutObjectOutput{}, nil
+}
+
+func (f *fakeObjectAPI) CreateMultipartUpload(context.Context, *s3.CreateMultipartUploadInput, ...func(*s3.Options)) (*s3.CreateMultipartUploadOutput, error) {
+	f.parts = make(map[int32][]byte)
+	return &s3.CreateMultipartUploadOutput{UploadId: aws.String("upload")}, nil
+}
+
+func (f *fakeObjectAPI) UploadPart(_ context.Context, in *s3.UploadPartInput, _ ...func(*s3.Options)) (*s3.UploadPartOutput, error) {
+	if *in.PartNumber == f.failPart {
+		return nil, errors.New("connection reset")
+	}
+	data, _ := io.ReadAll(in.Body)
+	f.parts[*in.PartNumber] = data
+	return &s3.UploadPartOutput{ETag: aws.String(fmt.Sprintf("etag-%d", *in.PartNumber))}, nil
+}
+
+func (f *fakeObjectAPI) CompleteMultipartUpload(_ context.Context, in *s3.CompleteMultipartUploadInput, _ ...func(*s3.Options)) (*s3.CompleteMultipartUploadOutput, error) {
+	var data []byte
+	for _, part := range in.MultipartUpload.Parts {
+		data = append(data, f.parts[*part.PartNumber]...)
+	}
+	f.objects[*in.Key] = data
+	return &s3.CompleteMultipartUploadOutput{}, nil
+}
+
+func (f *fakeObjectAPI) AbortMultipartUpload(context.Context, *s3.AbortMultipartUploadInput, ...func(*s3.Options)) (*s3.AbortMultipartUploadOutput, error) {
+	f.aborted = true
+	return &s3.AbortMultipartUploadOutput{}, nil
+}
+
+func (f *fakeObjectAPI) GetObject(_ context.Context, in *s3.GetObjectInput, _ ...func(*s3.Options)) (*s3.GetObjectOutput, error) {
+	data, ok := f.objects[*in.Key]
+	if !ok {
+		return nil, &types.NoSuchKey{}
+	}
+	out := &s3.GetObjectOutput{ContentType: aws.String("image/gif")}
+	if in.Range != nil {
+		var start, end int
+		_, _ = fmt.Sscanf(*in.Range, "bytes=%d-%d", &start, &end)
+		out.ContentRange = aws.String(fmt.Sprintf("bytes %d-%d/%d", start, end, len(data)))
+		data = data[start : end+1]
+	}
+	out.Body = io.NopCloser(bytes.NewReader(data))
+	out.ContentLength = aws.Int64(int64(len(data)))
+	return out, nil
+}
+
+func newTestClient(maxUploadSize int64) (*Client, *fakeObjectAPI) {
+	api := &fakeObjectAPI{objects: make(map[string][]byte)}
+	return &Client{
+		s3Client:        api,
+		bucketName:      "bucket",
+		publicURLPrefix: "https://cdn.example.com",
+		partSize:        minPartSize,
+		maxUploadSize:   maxUploadSize,
+	}, api
+}
+
+func TestUploadStream(t *testing.T) {
+	ctx := context.Background()
+	large := bytes.Repeat([]byte("gif"), minPartSize) // Three parts
+
+	t.Run("small bodies are put in one request", func(t *testing.T) {
+		client, api := newTestClient(DefaultMaxUploadSize)
+		url, err := client.UploadStream(ctx, "tokens/a.png", strings.NewReader("png"), -1, "image/png")
+		require.NoError(t, err)
+		assert.Equal(t, "https://cdn.example.com/tokens/a.png", url)
+		assert.Equal(t, 1, api.puts)
+		assert.Equal(t, []byte("png"), api.objects["tokens/a.png"])
+	})
+
+	t.Run("large bodies are uploaded in parts", func(t *testing.T) {
+		client, api := newTestClient(DefaultMaxUploadSize)
+		_, err := client.UploadStream(ctx, "media/a.gif", bytes.NewReader(large), -1, "image/gif")
+		require.NoError(t, err)
+		assert.Zero(t, api.puts)
+		assert.Len(t, api.parts, 3)
+		assert.Equal(t, large, api.objects["media/a.gif"])
+	})
+
+	t.Run("known sizes over the limit are rejected up front", func(t *testing.T) {
+		client, api := newTestClient(minPartSize)
+		_, err := client.UploadStream(ctx, "media/a.gif", bytes.NewReader(large), int64(len(large)), "image/gif")
+		require.ErrorIs(t, err, ErrTooLarge)
+		assert.Nil(t, api.parts)
+	})
+
+	t.Run("unknown sizes over the limit are aborted", func(t *testing.T) {
+		client, api := newTestClient(2 * minPartSize)
+		_, err := client.UploadStream(ctx, "media/a.gif", bytes.NewReader(large), -1, "image/gif")
+		require.ErrorIs(t, err, ErrTooLarge)
+		assert.True(t, api.aborted)
+		assert.NotContains(t, api.objects, "media/a.gif")
+	})
+
+	t.Run("failed parts abort the upload", func(t *testing.T) {
+		client, api := newTestClient(DefaultMaxUploadSize)
+		api.failPart = 2
+		_, err := client.UploadStream(ctx, "media/a.gif", bytes.NewReader(large), -1, "image/gif")
+		require.Error(t, err)
+		assert.True(t, api.aborted)
+	})
+}
+
+func TestDownload(t *testing.T) {
+	client, api := newTestClient(DefaultMaxUploadSize)
+	api.objects["media/a.gif"] = []byte("0123456789")
+
+	object, err := client.Download(context.Background(), "media/a.gif", 2, 4)
+	require.NoError(t, err)
+	defer object.Body.Close()
+	data, err := io.ReadAll(object.Body)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("2345"), data)
+	assert.Equal(t, int64(4), object.ContentLength)
+	assert.Equal(t, int64(10), object.Size)
+
+	_, err = client.Download(context.Background(), "media/missing.gif", 0, 0)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
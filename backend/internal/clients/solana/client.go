@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -28,8 +29,12 @@ import (
 	"github.com/nicolas-martin/dankfolio/backend/internal/clients/tracker"
 	"github.com/nicolas-martin/dankfolio/backend/internal/model"
 	bmodel "github.com/nicolas-martin/dankfolio/backend/internal/model/blockchain"
+	"github.com/nicolas-martin/dankfolio/backend/internal/util/ata"
 )
 
+// MaxAccountsPerRequest is the most accounts one getMultipleAccounts call accepts
+const MaxAccountsPerRequest = 100
+
 type Client struct {
 	rpcConn *rpc.Client
 	tracker *tracker.APITracker
@@ -37,8 +42,9 @@ type Client struct {
 
 // Comment out the interface implementation check for now until we can fix all issues
 var (
-	_ ClientAPI                = (*Client)(nil)
-	_ clients.GenericClientAPI = (*Client)(nil)
+	_ ClientAPI                  = (*Client)(nil)
+	_ clients.GenericClientAPI   = (*Client)(nil)
+	_ ata.MultipleAccountsGetter = (*Client)(nil)
 )
 
 func NewClient(solClient *rpc.Client, tracker *tracker.APITracker) clients.GenericClientAPI {
@@ -162,7 +168,9 @@ func (c *Client) ExecuteSignedTransaction(ctx context.Context, signedTx string)
 	err = c.tracker.InstrumentCall(ctx, "solana", "simulateTransaction", func(ctx context.Context) error {
 		slog.Debug("Simulating transaction...")
 		var simErr error
-		simResult, simErr = c.rpcConn.SimulateTransaction(ctx, tx)
+		simResult, simErr = c.rpcConn.SimulateTransactionWithOpts(ctx, tx, &rpc.SimulateTransactionOpts{
+			Commitment: model.ToRPCCommitment(bmodel.DefaultCommitment),
+		})
 		if simErr != nil {
 			slog.Error("Transaction simulation failed", "error", simErr)
 			return fmt.Errorf("transaction simulation failed: %w", simErr)
@@ -189,7 +197,7 @@ func (c *Client) ExecuteSignedTransaction(ctx context.Context, signedTx string)
 		var sendErr error
 		sig, sendErr = c.rpcConn.SendTransactionWithOpts(ctx, tx, rpc.TransactionOpts{
 			SkipPreflight:       false,
-			PreflightCommitment: rpc.CommitmentFinalized,
+			PreflightCommitment: model.ToRPCCommitment(bmodel.DefaultCommitment),
 		})
 		if sendErr != nil {
 			slog.Error("Failed to submit transaction", "error", sendErr)
@@ -298,7 +306,7 @@ func (c *Client) GetTransactionStatus(ctx context.Context, signature bmodel.Sign
 }
 
 // GetAccountInfo implements clients.GenericClientAPI
-func (c *Client) GetAccountInfo(ctx context.Context, address bmodel.Address) (*bmodel.AccountInfo, error) {
+func (c *Client) GetAccountInfo(ctx context.Context, address bmodel.Address, commitment string) (*bmodel.AccountInfo, error) {
 	var result *bmodel.AccountInfo
 	err := c.tracker.InstrumentCall(ctx, "solana", "GetAccountInfo", func(ctx context.Context) error {
 		solAddress, err := solana.PublicKeyFromBase58(string(address))
@@ -306,7 +314,9 @@ func (c *Client) GetAccountInfo(ctx context.Context, address bmodel.Address) (*b
 			return fmt.Errorf("invalid address '%s': %w", address, err)
 		}
 
-		rpcAccountInfo, err := c.rpcConn.GetAccountInfo(ctx, solAddress)
+		rpcAccountInfo, err := c.rpcConn.GetAccountInfoWithOpts(ctx, solAddress, &rpc.GetAccountInfoOpts{
+			Commitment: model.ToRPCCommitment(commitment),
+		})
 		if err != nil {
 			if errors.Is(err, rpc.ErrNotFound) {
 				return clients.ErrAccountNotFound
@@ -317,19 +327,7 @@ func (c *Client) GetAccountInfo(ctx context.Context, address bmodel.Address) (*b
 			return clients.ErrAccountNotFound
 		}
 
-		ownerPk, ownerErr := solana.PublicKeyFromBase58(rpcAccountInfo.Value.Owner.String()) // Use ownerErr here
-		if ownerErr != nil {
-			return fmt.Errorf("invalid owner address for %s: %w", address, ownerErr) // Wrap ownerErr
-		}
-
-		result = &bmodel.AccountInfo{
-			Address:    address,
-			Lamports:   rpcAccountInfo.Value.Lamports,
-			Owner:      bmodel.Address(ownerPk.String()),
-			Executable: rpcAccountInfo.Value.Executable,
-			RentEpoch:  rpcAccountInfo.Value.RentEpoch.Uint64(),
-			Data:       rpcAccountInfo.Value.Data.GetBinary(),
-		}
+		result = toAccountInfo(address, rpcAccountInfo.Value)
 		return nil
 	})
 
@@ -339,12 +337,62 @@ func (c *Client) GetAccountInfo(ctx context.Context, address bmodel.Address) (*b
 	return result, nil
 }
 
+// GetMultipleAccounts reads many accounts with as few getMultipleAccounts calls
+// as the RPC limit allows. Results are in request order, nil where the account
+// does not exist.
+func (c *Client) GetMultipleAccounts(ctx context.Context, addresses []bmodel.Address, commitment string) ([]*bmodel.AccountInfo, error) {
+	keys := make([]solana.PublicKey, len(addresses))
+	for i, address := range addresses {
+		key, err := solana.PublicKeyFromBase58(string(address))
+		if err != nil {
+			return nil, fmt.Errorf("invalid address '%s': %w", address, err)
+		}
+		keys[i] = key
+	}
+
+	results := make([]*bmodel.AccountInfo, len(addresses))
+	for start := 0; start < len(keys); start += MaxAccountsPerRequest {
+		end := min(start+MaxAccountsPerRequest, len(keys))
+		err := c.tracker.InstrumentCall(ctx, "solana", "GetMultipleAccounts", func(ctx context.Context) error {
+			rpcResult, err := c.rpcConn.GetMultipleAccountsWithOpts(ctx, keys[start:end], &rpc.GetMultipleAccountsOpts{
+				Commitment: model.ToRPCCommitment(commitment),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to get %d accounts: %w", end-start, err)
+			}
+			if len(rpcResult.Value) != end-start {
+				return fmt.Errorf("getMultipleAccounts returned %d accounts for %d addresses", len(rpcResult.Value), end-start)
+			}
+			for i, account := range rpcResult.Value {
+				if account != nil {
+					results[start+i] = toAccountInfo(addresses[start+i], account)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return results, nil
+}
+
+func toAccountInfo(address bmodel.Address, account *rpc.Account) *bmodel.AccountInfo {
+	return &bmodel.AccountInfo{
+		Address:    address,
+		Lamports:   account.Lamports,
+		Owner:      bmodel.Address(account.Owner.String()),
+		Executable: account.Executable,
+		RentEpoch:  account.RentEpoch.Uint64(),
+		Data:       account.Data.GetBinary(),
+	}
+}
+
 // GetLatestBlockhash implements clients.GenericClientAPI
-func (c *Client) GetLatestBlockhash(ctx context.Context) (bmodel.Blockhash, error) {
+func (c *Client) GetLatestBlockhash(ctx context.Context, commitment string) (bmodel.Blockhash, error) {
 	var blockhash bmodel.Blockhash
 	err := c.tracker.InstrumentCall(ctx, "solana", "GetLatestBlockhash", func(ctx context.Context) error {
-		// Using CommitmentConfirmed as a default for generic API, can be made configurable if needed
-		result, err := c.rpcConn.GetLatestBlockhash(ctx, rpc.CommitmentConfirmed)
+		result, err := c.rpcConn.GetLatestBlockhash(ctx, model.ToRPCCommitment(commitment))
 		if err != nil {
 			return fmt.Errorf("failed to get latest blockhash: %w", err)
 		}
@@ -632,6 +680,228 @@ func (c *Client) SendRawTransaction(ctx context.Context, rawTx []byte, opts bmod
 	return sig, nil
 }
 
+// SimulateTransaction implements clients.GenericClientAPI
+func (c *Client) SimulateTransaction(ctx context.Context, rawTx []byte, commitment string) (*bmodel.SimulationResult, error) {
+	var result *bmodel.SimulationResult
+	err := c.tracker.InstrumentCall(ctx, "solana", "SimulateTransaction", func(ctx context.Context) error {
+		simulation, err := c.rpcConn.SimulateRawTransactionWithOpts(ctx, rawTx, &rpc.SimulateTransactionOpts{
+			Commitment:             model.ToRPCCommitment(commitment),
+			ReplaceRecentBlockhash: true,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to simulate transaction: %w", err)
+		}
+		if simulation == nil || simulation.Value == nil {
+			return fmt.Errorf("received nil simulation result")
+		}
+		result = &bmodel.SimulationResult{Err: simulation.Value.Err, Logs: simulation.Value.Logs}
+		if simulation.Value.UnitsConsumed != nil {
+			result.UnitsConsumed = *simulation.Value.UnitsConsumed
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetRecentPrioritizationFees implements clients.GenericClientAPI
+func (c *Client) GetRecentPrioritizationFees(ctx context.Context, accounts []bmodel.Address) ([]uint64, error) {
+	keys := make(solana.PublicKeySlice, len(accounts))
+	for i, account := range accounts {
+		key, err := solana.PublicKeyFromBase58(string(account))
+		if err != nil {
+			return nil, fmt.Errorf("invalid address '%s': %w", account, err)
+		}
+		keys[i] = key
+	}
+
+	var fees []uint64
+	err := c.tracker.InstrumentCall(ctx, "solana", "GetRecentPrioritizationFees", func(ctx context.Context) error {
+		results, err := c.rpcConn.GetRecentPrioritizationFees(ctx, keys)
+		if err != nil {
+			return fmt.Errorf("failed to get recent prioritization fees: %w", err)
+		}
+		fees = make([]uint64, len(results))
+		for i, result := range results {
+			fees[i] = result.PrioritizationFee
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return fees, nil
+}
+
+// GetSignaturesForAddress lists up to limit of the newest transactions that
+// referenced address, newest first.
+func (c *Client) GetSignaturesForAddress(ctx context.Context, address bmodel.Address, limit int, commitment string) ([]bmodel.SignatureInfo, error) {
+	key, err := solana.PublicKeyFromBase58(string(address))
+	if err != nil {
+		return nil, fmt.Errorf("invalid address '%s': %w", address, err)
+	}
+
+	var infos []bmodel.SignatureInfo
+	err = c.tracker.InstrumentCall(ctx, "solana", "GetSignaturesForAddress", func(ctx context.Context) error {
+		signatures, err := c.rpcConn.GetSignaturesForAddressWithOpts(ctx, key, &rpc.GetSignaturesForAddressOpts{
+			Limit:      &limit,
+			Commitment: model.ToRPCCommitment(commitment),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get signatures for %s: %w", address, err)
+		}
+		infos = make([]bmodel.SignatureInfo, len(signatures))
+		for i, signature := range signatures {
+			infos[i] = bmodel.SignatureInfo{
+				Signature: bmodel.Signature(signature.Signature.String()),
+				Slot:      signature.Slot,
+				Err:       signature.Err,
+				Status:    string(signature.ConfirmationStatus),
+			}
+			if signature.BlockTime != nil {
+				infos[i].BlockTime = signature.BlockTime.Time()
+			}
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return infos, nil
+}
+
+// GetTransaction reads a processed transaction with its balance changes. It
+// returns nil and no error when the node does not have the transaction at
+// commitment yet.
+func (c *Client) GetTransaction(ctx context.Context, signature bmodel.Signature, commitment string) (*bmodel.TransactionDetail, error) {
+	sig, err := solana.SignatureFromBase58(string(signature))
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature '%s': %w", signature, err)
+	}
+
+	var detail *bmodel.TransactionDetail
+	err = c.tracker.InstrumentCall(ctx, "solana", "GetTransaction", func(ctx context.Context) error {
+		maxVersion := uint64(0)
+		result, err := c.rpcConn.GetTransaction(ctx, sig, &rpc.GetTransactionOpts{
+			Encoding:                       solana.EncodingBase64,
+			Commitment:                     model.ToRPCCommitment(commitment),
+			MaxSupportedTransactionVersion: &maxVersion,
+		})
+		if errors.Is(err, rpc.ErrNotFound) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get transaction %s: %w", signature, err)
+		}
+		if result == nil || result.Transaction == nil || result.Meta == nil {
+			return nil
+		}
+		tx, err := result.Transaction.GetTransaction()
+		if err != nil {
+			return fmt.Errorf("failed to decode transaction %s: %w", signature, err)
+		}
+		detail = toTransactionDetail(signature, result.Slot, result.BlockTime, result.Meta, tx)
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return detail, nil
+}
+
+// GetBlockTransactions returns the transactions of the block at slot in
+// execution order, or nil if the node has no such block.
+func (c *Client) GetBlockTransactions(ctx context.Context, slot uint64, commitment string) ([]*bmodel.TransactionDetail, error) {
+	var details []*bmodel.TransactionDetail
+	err := c.tracker.InstrumentCall(ctx, "solana", "GetBlock", func(ctx context.Context) error {
+		rewards := false
+		result, err := c.rpcConn.GetBlockWithOpts(ctx, slot, &rpc.GetBlockOpts{
+			Encoding:                       solana.EncodingBase64,
+			TransactionDetails:             rpc.TransactionDetailsFull,
+			Rewards:                        &rewards,
+			Commitment:                     model.ToRPCCommitment(commitment),
+			MaxSupportedTransactionVersion: &rpc.MaxSupportedTransactionVersion0,
+		})
+		if errors.Is(err, rpc.ErrNotFound) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get block %d: %w", slot, err)
+		}
+
+		details = make([]*bmodel.TransactionDetail, 0, len(result.Transactions))
+		for _, txWithMeta := range result.Transactions {
+			if txWithMeta.Meta == nil {
+				continue
+			}
+			tx, err := txWithMeta.GetTransaction()
+			if err != nil || len(tx.Signatures) == 0 {
+				slog.WarnContext(ctx, "Skipping undecodable block transaction", "slot", slot, "error", err)
+				continue
+			}
+			details = append(details, toTransactionDetail(bmodel.Signature(tx.Signatures[0].String()), slot, result.BlockTime, txWithMeta.Meta, tx))
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return details, nil
+}
+
+func toTransactionDetail(signature bmodel.Signature, slot uint64, blockTime *solana.UnixTimeSeconds, meta *rpc.TransactionMeta, tx *solana.Transaction) *bmodel.TransactionDetail {
+	detail := &bmodel.TransactionDetail{
+		Signature:         signature,
+		Slot:              slot,
+		Err:               meta.Err,
+		Fee:               meta.Fee,
+		PreBalances:       meta.PreBalances,
+		PostBalances:      meta.PostBalances,
+		PreTokenBalances:  toTokenBalances(meta.PreTokenBalances),
+		PostTokenBalances: toTokenBalances(meta.PostTokenBalances),
+	}
+	if blockTime != nil {
+		detail.BlockTime = blockTime.Time()
+	}
+	keys := slices.Concat(tx.Message.AccountKeys, meta.LoadedAddresses.Writable, meta.LoadedAddresses.ReadOnly)
+	detail.Accounts = make([]bmodel.Address, len(keys))
+	for i, key := range keys {
+		detail.Accounts[i] = bmodel.Address(key.String())
+	}
+	for _, ix := range tx.Message.Instructions {
+		if int(ix.ProgramIDIndex) < len(keys) && keys[ix.ProgramIDIndex].Equals(solana.MemoProgramID) {
+			detail.Memos = append(detail.Memos, string(ix.Data))
+		}
+	}
+	return detail
+}
+
+func toTokenBalances(balances []rpc.TokenBalance) []bmodel.TokenBalance {
+	result := make([]bmodel.TokenBalance, 0, len(balances))
+	for _, balance := range balances {
+		tokenBalance := bmodel.TokenBalance{
+			AccountIndex: int(balance.AccountIndex),
+			Mint:         bmodel.Address(balance.Mint.String()),
+		}
+		if balance.Owner != nil {
+			tokenBalance.Owner = bmodel.Address(balance.Owner.String())
+		}
+		if balance.UiTokenAmount != nil {
+			tokenBalance.Amount = balance.UiTokenAmount.Amount
+			tokenBalance.Decimals = balance.UiTokenAmount.Decimals
+		}
+		result = append(result, tokenBalance)
+	}
+	return result
+}
+
 func (c *Client) GetTokenMetadata(ctx context.Context, mintAddress bmodel.Address) (*bmodel.TokenMetadata, error) {
 	var metadata *bmodel.TokenMetadata
 	err := c.tracker.InstrumentCall(ctx, "solana", "GetTokenMetadata", func(ctx context.Context) error {
@@ -640,38 +910,39 @@ func (c *Client) GetTokenMetadata(ctx context.Context, mintAddress bmodel.Addres
 			return fmt.Errorf("invalid mint address '%s': %w", mintAddress, err)
 		}
 
-		// 1. Get Metaplex metadata (name, symbol, URI)
-		var name, symbol, uri string
+		// The Metaplex metadata (name, symbol, URI) and the mint account
+		// (decimals, supply) are read in one call
 		metadataPDA, _, err := solana.FindTokenMetadataAddress(solMintAddress)
 		if err != nil {
-			slog.WarnContext(ctx, "failed to find metadata PDA", "mint", mintAddress, "error", err)
-		} else {
-			accInfo, err := c.rpcConn.GetAccountInfo(ctx, metadataPDA)
-			if err == nil && accInfo != nil && accInfo.Value != nil && len(accInfo.Value.Data.GetBinary()) > 0 {
-				// Ensure tm "github.com/blocto/solana-go-sdk/program/metaplex/token_metadata" is imported
-				meta, errDeserialize := tm.MetadataDeserialize(accInfo.Value.Data.GetBinary())
-				if errDeserialize == nil {
-					name = meta.Data.Name
-					symbol = meta.Data.Symbol
-					uri = meta.Data.Uri
-				} else {
-					slog.WarnContext(ctx, "failed to deserialize Metaplex metadata", "mint", mintAddress, "pda", metadataPDA.String(), "error", errDeserialize)
-				}
+			return fmt.Errorf("failed to find metadata PDA for %s: %w", mintAddress, err)
+		}
+		accounts, err := c.rpcConn.GetMultipleAccounts(ctx, metadataPDA, solMintAddress)
+		if err != nil || len(accounts.Value) != 2 {
+			slog.WarnContext(ctx, "failed to get metadata and mint accounts", "mint", mintAddress, "error", err)
+			accounts = &rpc.GetMultipleAccountsResult{Value: make([]*rpc.Account, 2)}
+		}
+		metadataAcc, mintAcc := accounts.Value[0], accounts.Value[1]
+
+		var name, symbol, uri string
+		if metadataAcc != nil && len(metadataAcc.Data.GetBinary()) > 0 {
+			// Ensure tm "github.com/blocto/solana-go-sdk/program/metaplex/token_metadata" is imported
+			meta, errDeserialize := tm.MetadataDeserialize(metadataAcc.Data.GetBinary())
+			if errDeserialize == nil {
+				name = meta.Data.Name
+				symbol = meta.Data.Symbol
+				uri = meta.Data.Uri
 			} else {
-				slog.DebugContext(ctx, "Metaplex metadata account not found or empty", "mint", mintAddress, "pda", metadataPDA.String(), "rpc_error", err)
+				slog.WarnContext(ctx, "failed to deserialize Metaplex metadata", "mint", mintAddress, "pda", metadataPDA.String(), "error", errDeserialize)
 			}
+		} else {
+			slog.DebugContext(ctx, "Metaplex metadata account not found or empty", "mint", mintAddress, "pda", metadataPDA.String())
 		}
 
-		// 2. Get Mint account info for decimals and supply (from SPL Token program)
 		var decimals uint8
 		var supply string
-		mintAccInfo, err := c.rpcConn.GetAccountInfo(ctx, solMintAddress)
-		if err != nil {
-			slog.WarnContext(ctx, "failed to get mint account info", "mint", mintAddress, "error", err)
-			// Potentially return error here if decimals/supply are critical and not found
-		} else if mintAccInfo != nil && mintAccInfo.Value != nil && len(mintAccInfo.Value.Data.GetBinary()) > 0 {
+		if mintAcc != nil && len(mintAcc.Data.GetBinary()) > 0 {
 			var splMint spltoken.Mint
-			if errSpl := splMint.UnmarshalWithDecoder(bin.NewBinDecoder(mintAccInfo.Value.Data.GetBinary())); errSpl == nil {
+			if errSpl := splMint.UnmarshalWithDecoder(bin.NewBinDecoder(mintAcc.Data.GetBinary())); errSpl == nil {
 				decimals = splMint.Decimals
 				supply = strconv.FormatUint(splMint.Supply, 10)
 			} else {
@@ -719,6 +990,47 @@ func (c *Client) GetProgramAccounts(ctx context.Context, pubkey solana.PublicKey
 	return result, nil
 }
 
+// FindProgramAccounts lists accounts owned by program whose data holds value
+// at offset. A dataSize of 0 matches accounts of any size.
+func (c *Client) FindProgramAccounts(ctx context.Context, program bmodel.Address, dataSize, offset uint64, value bmodel.Address, commitment string) ([]*bmodel.AccountInfo, error) {
+	programKey, err := solana.PublicKeyFromBase58(string(program))
+	if err != nil {
+		return nil, fmt.Errorf("invalid program '%s': %w", program, err)
+	}
+	valueKey, err := solana.PublicKeyFromBase58(string(value))
+	if err != nil {
+		return nil, fmt.Errorf("invalid address '%s': %w", value, err)
+	}
+	filters := []rpc.RPCFilter{{Memcmp: &rpc.RPCFilterMemcmp{Offset: offset, Bytes: solana.Base58(valueKey.Bytes())}}}
+	if dataSize > 0 {
+		filters = append(filters, rpc.RPCFilter{DataSize: dataSize})
+	}
+
+	var accounts []*bmodel.AccountInfo
+	err = c.tracker.InstrumentCall(ctx, "solana", "GetProgramAccounts", func(ctx context.Context) error {
+		result, err := c.rpcConn.GetProgramAccountsWithOpts(ctx, programKey, &rpc.GetProgramAccountsOpts{
+			Commitment: model.ToRPCCommitment(commitment),
+			Encoding:   solana.EncodingBase64,
+			Filters:    filters,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get accounts of program %s: %w", program, err)
+		}
+		accounts = make([]*bmodel.AccountInfo, 0, len(result))
+		for _, keyed := range result {
+			if keyed.Account != nil {
+				accounts = append(accounts, toAccountInfo(bmodel.Address(keyed.Pubkey.String()), keyed.Account))
+			}
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return accounts, nil
+}
+
 // GetLargestAccounts retrieves the largest accounts
 func (c *Client) GetLargestAccounts(ctx context.Context, commitment rpc.CommitmentType, filter rpc.LargestAccountsFilterType) (*rpc.GetLargestAccountsResult, error) {
 	var result *rpc.GetLargestAccountsResult
@@ -749,7 +1061,8 @@ func (c *Client) GetSupply(ctx context.Context, commitment rpc.CommitmentType) (
 	return result, nil
 }
 
-// GetTokenAccountsByOwner implements the GenericClientAPI interface
+// GetTokenAccountsByOwner implements the GenericClientAPI interface. It lists
+// the owner's accounts under opts.ProgramID, the SPL Token program when empty.
 func (c *Client) GetTokenAccountsByOwner(ctx context.Context, ownerAddress bmodel.Address, opts bmodel.TokenAccountsOptions) ([]*bmodel.TokenAccountInfo, error) {
 	var accounts []*bmodel.TokenAccountInfo
 	err := c.tracker.InstrumentCall(ctx, "solana", "GetTokenAccountsByOwner", func(ctx context.Context) error {
@@ -758,9 +1071,17 @@ func (c *Client) GetTokenAccountsByOwner(ctx context.Context, ownerAddress bmode
 			return fmt.Errorf("invalid owner address '%s': %w", ownerAddress, err)
 		}
 
+		programID := solana.TokenProgramID
+		if opts.ProgramID != "" {
+			programID, err = solana.PublicKeyFromBase58(string(opts.ProgramID))
+			if err != nil {
+				return fmt.Errorf("invalid token program '%s': %w", opts.ProgramID, err)
+			}
+		}
+
 		// Prepare RPC options
 		rpcOpts := &rpc.GetTokenAccountsOpts{
-			Commitment: model.ToRPCCommitment("confirmed"), // Defaulting to confirmed
+			Commitment: model.ToRPCCommitment(opts.Commitment),
 		}
 
 		if opts.Encoding != "" {
@@ -771,121 +1092,26 @@ func (c *Client) GetTokenAccountsByOwner(ctx context.Context, ownerAddress bmode
 			rpcOpts.Encoding = solana.EncodingJSONParsed // Default
 		}
 
-		// Token2022 program ID: TokenzQdBNbLqP5VEhdkAS6EPFLC1PHnBqCXEpPxuEb
-		token2022ProgramID := solana.MustPublicKeyFromBase58("TokenzQdBNbLqP5VEhdkAS6EPFLC1PHnBqCXEpPxuEb")
-
-		// Get regular SPL token accounts
-		tokenProgramID := solana.TokenProgramID
-		rpcConf := &rpc.GetTokenAccountsConfig{
-			ProgramId: &tokenProgramID,
-		}
-
-		result, err := c.rpcConn.GetTokenAccountsByOwner(ctx, solOwner, rpcConf, rpcOpts)
-		if err != nil {
-			return fmt.Errorf("failed to get SPL token accounts for %s: %w", ownerAddress, err)
-		}
-
-		// Get Token2022 accounts
-		rpcConf2022 := &rpc.GetTokenAccountsConfig{
-			ProgramId: &token2022ProgramID,
-		}
-
-		result2022, err := c.rpcConn.GetTokenAccountsByOwner(ctx, solOwner, rpcConf2022, rpcOpts)
+		result, err := c.rpcConn.GetTokenAccountsByOwner(ctx, solOwner, &rpc.GetTokenAccountsConfig{ProgramId: &programID}, rpcOpts)
 		if err != nil {
-			// Log but don't fail - not all wallets have Token2022 accounts
-			slog.DebugContext(ctx, "No Token2022 accounts found (this is normal)", "owner", ownerAddress, "error", err)
+			return fmt.Errorf("failed to get token accounts of program %s for %s: %w", programID, ownerAddress, err)
 		}
 
 		for _, rpcAcc := range result.Value {
-			var parsedAccount struct {
-				Parsed struct {
-					Info struct {
-						Mint        string `json:"mint"`
-						Owner       string `json:"owner"`
-						TokenAmount struct {
-							Amount         string `json:"amount"`
-							Decimals       uint8  `json:"decimals"`
-							UIAmountString string `json:"uiAmountString"`
-						} `json:"tokenAmount"`
-					} `json:"info"`
-				} `json:"parsed"`
-			}
-
-			if rpcOpts.Encoding == solana.EncodingJSONParsed {
-				rawJSONData := rpcAcc.Account.Data.GetRawJSON()
-				if err := json.Unmarshal(rawJSONData, &parsedAccount); err != nil {
-					slog.WarnContext(ctx, "failed to parse token account data (json)", "address", rpcAcc.Pubkey.String(), "error", err)
-					continue
-				}
-
-				uiAmount, parseErr := strconv.ParseFloat(parsedAccount.Parsed.Info.TokenAmount.UIAmountString, 64)
-				if parseErr != nil {
-					slog.WarnContext(ctx, "failed to parse UIAmountString to float", "address", rpcAcc.Pubkey.String(), "uiAmountString", parsedAccount.Parsed.Info.TokenAmount.UIAmountString, "error", parseErr)
-					// Set uiAmount to 0 or handle as appropriate if parsing fails
-					uiAmount = 0
-				}
-
-				accounts = append(accounts, &bmodel.TokenAccountInfo{
-					Address:     bmodel.Address(rpcAcc.Pubkey.String()),
-					MintAddress: bmodel.Address(parsedAccount.Parsed.Info.Mint),
-					Owner:       bmodel.Address(parsedAccount.Parsed.Info.Owner),
-					Amount:      parsedAccount.Parsed.Info.TokenAmount.Amount,
-					Decimals:    parsedAccount.Parsed.Info.TokenAmount.Decimals,
-					UIAmount:    uiAmount,
-				})
-			} else {
+			if rpcOpts.Encoding != solana.EncodingJSONParsed {
 				slog.WarnContext(ctx, "GetTokenAccountsByOwner non-JSONParsed encoding not fully handled for generic model mapping yet", "encoding", rpcOpts.Encoding)
 				accounts = append(accounts, &bmodel.TokenAccountInfo{
-					Address: bmodel.Address(rpcAcc.Pubkey.String()),
+					Address:   bmodel.Address(rpcAcc.Pubkey.String()),
+					ProgramID: bmodel.Address(programID.String()),
 				})
+				continue
 			}
-		}
-
-		// Process Token2022 accounts if found
-		if result2022 != nil {
-			for _, rpcAcc := range result2022.Value {
-				var parsedAccount struct {
-					Parsed struct {
-						Info struct {
-							Mint        string `json:"mint"`
-							Owner       string `json:"owner"`
-							TokenAmount struct {
-								Amount         string `json:"amount"`
-								Decimals       uint8  `json:"decimals"`
-								UIAmountString string `json:"uiAmountString"`
-							} `json:"tokenAmount"`
-						} `json:"info"`
-					} `json:"parsed"`
-				}
-
-				if rpcOpts.Encoding == solana.EncodingJSONParsed {
-					rawJSONData := rpcAcc.Account.Data.GetRawJSON()
-					if err := json.Unmarshal(rawJSONData, &parsedAccount); err != nil {
-						slog.WarnContext(ctx, "failed to parse Token2022 account data (json)", "address", rpcAcc.Pubkey.String(), "error", err)
-						continue
-					}
-
-					uiAmount, parseErr := strconv.ParseFloat(parsedAccount.Parsed.Info.TokenAmount.UIAmountString, 64)
-					if parseErr != nil {
-						slog.WarnContext(ctx, "failed to parse UIAmountString to float for Token2022", "address", rpcAcc.Pubkey.String(), "uiAmountString", parsedAccount.Parsed.Info.TokenAmount.UIAmountString, "error", parseErr)
-						uiAmount = 0
-					}
-
-					slog.InfoContext(ctx, "Found Token2022 token account",
-						"mint", parsedAccount.Parsed.Info.Mint,
-						"amount", uiAmount,
-						"owner", parsedAccount.Parsed.Info.Owner)
-
-					accounts = append(accounts, &bmodel.TokenAccountInfo{
-						Address:     bmodel.Address(rpcAcc.Pubkey.String()),
-						MintAddress: bmodel.Address(parsedAccount.Parsed.Info.Mint),
-						Owner:       bmodel.Address(parsedAccount.Parsed.Info.Owner),
-						Amount:      parsedAccount.Parsed.Info.TokenAmount.Amount,
-						Decimals:    parsedAccount.Parsed.Info.TokenAmount.Decimals,
-						UIAmount:    uiAmount,
-					})
-				}
+			account, err := parseTokenAccount(rpcAcc, programID)
+			if err != nil {
+				slog.WarnContext(ctx, "failed to parse token account data (json)", "address", rpcAcc.Pubkey.String(), "program", programID.String(), "error", err)
+				continue
 			}
+			accounts = append(accounts, account)
 		}
 
 		return nil
@@ -896,3 +1122,52 @@ func (c *Client) GetTokenAccountsByOwner(ctx context.Context, ownerAddress bmode
 	}
 	return accounts, nil
 }
+
+// parseTokenAccount maps a jsonParsed token account. SPL Token and Token-2022
+// accounts share the parsed layout; Token-2022 only adds extensions.
+func parseTokenAccount(rpcAcc *rpc.TokenAccount, programID solana.PublicKey) (*bmodel.TokenAccountInfo, error) {
+	var parsedAccount struct {
+		Parsed struct {
+			Info struct {
+				Mint        string `json:"mint"`
+				Owner       string `json:"owner"`
+				TokenAmount struct {
+					Amount         string `json:"amount"`
+					Decimals       uint8  `json:"decimals"`
+					UIAmountString string `json:"uiAmountString"`
+				} `json:"tokenAmount"`
+				Delegate        string `json:"delegate"`
+				DelegatedAmount struct {
+					Amount         string `json:"amount"`
+					UIAmountString string `json:"uiAmountString"`
+				} `json:"delegatedAmount"`
+			} `json:"info"`
+		} `json:"parsed"`
+	}
+	if err := json.Unmarshal(rpcAcc.Account.Data.GetRawJSON(), &parsedAccount); err != nil {
+		return nil, err
+	}
+	info := parsedAccount.Parsed.Info
+
+	return &bmodel.TokenAccountInfo{
+		Address:           bmodel.Address(rpcAcc.Pubkey.String()),
+		MintAddress:       bmodel.Address(info.Mint),
+		Owner:             bmodel.Address(info.Owner),
+		Amount:            info.TokenAmount.Amount,
+		Decimals:          info.TokenAmount.Decimals,
+		UIAmount:          parseUIAmount(info.TokenAmount.UIAmountString),
+		ProgramID:         bmodel.Address(programID.String()),
+		Delegate:          bmodel.Address(info.Delegate),
+		DelegatedAmount:   info.DelegatedAmount.Amount,
+		DelegatedUIAmount: parseUIAmount(info.DelegatedAmount.UIAmountString),
+	}, nil
+}
+
+// parseUIAmount parses a uiAmountString, treating missing or malformed values as zero
+func parseUIAmount(value string) float64 {
+	amount, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0
+	}
+	return amount
+}
@@ -0,0 +1,91 @@
+package solana
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/tracker"
+	bmodel "github.com/nicolas-martin/dankfolio/backend/internal/model/blockchain"
+	"github.com/nicolas-martin/dankfolio/backend/internal/telemetry/otel"
+)
+
+// multipleAccountsServer answers getMultipleAccounts, reporting every address
+// in exists as a token account, and records the size of each request
+func multipleAccountsServer(t *testing.T, exists map[string]bool, batches *[]int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     any               `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		require.Equal(t, "getMultipleAccounts", req.Method)
+		var addresses []string
+		require.NoError(t, json.Unmarshal(req.Params[0], &addresses))
+		*batches = append(*batches, len(addresses))
+
+		value := make([]any, len(addresses))
+		for i, address := range addresses {
+			if exists[address] {
+				value[i] = map[string]any{
+					"lamports":   2039280,
+					"owner":      solana.TokenProgramID.String(),
+					"data":       []string{"", "base64"},
+					"executable": false,
+					"rentEpoch":  0,
+				}
+			}
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{
+			"jsonrpc": "2.0",
+			"id":      req.ID,
+			"result":  map[string]any{"context": map[string]any{"slot": 1}, "value": value},
+		}))
+	}))
+}
+
+func TestGetMultipleAccountsChunks(t *testing.T) {
+	addresses := make([]bmodel.Address, MaxAccountsPerRequest+1)
+	exists := make(map[string]bool)
+	for i := range addresses {
+		addresses[i] = bmodel.Address(solana.NewWallet().PublicKey().String())
+		if i%2 == 0 {
+			exists[string(addresses[i])] = true
+		}
+	}
+	var batches []int
+	server := multipleAccountsServer(t, exists, &batches)
+	defer server.Close()
+
+	apiTracker, err := tracker.NewAPITracker(otel.NewNoOpTelemetry("dankfolio-test"))
+	require.NoError(t, err)
+	client := &Client{rpcConn: rpc.New(server.URL), tracker: apiTracker}
+
+	infos, err := client.GetMultipleAccounts(t.Context(), addresses, bmodel.CommitmentConfirmed)
+	require.NoError(t, err)
+	assert.Equal(t, []int{MaxAccountsPerRequest, 1}, batches)
+	require.Len(t, infos, len(addresses))
+	for i, info := range infos {
+		if i%2 != 0 {
+			assert.Nil(t, info, "account %d does not exist", i)
+			continue
+		}
+		require.NotNil(t, info, "account %d exists", i)
+		assert.Equal(t, addresses[i], info.Address)
+		assert.Equal(t, bmodel.Address(solana.TokenProgramID.String()), info.Owner)
+		assert.Equal(t, uint64(2039280), info.Lamports)
+	}
+}
+
+func TestGetMultipleAccountsInvalidAddress(t *testing.T) {
+	client := &Client{}
+	_, err := client.GetMultipleAccounts(t.Context(), []bmodel.Address{"not-an-address"}, "")
+	assert.ErrorContains(t, err, "invalid address")
+}
@@ -0,0 +1,331 @@
+package solana
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/tracker"
+)
+
+const (
+	// DefaultFailureThreshold is how many consecutive errors take an endpoint out of rotation.
+	DefaultFailureThreshold = 3
+	// DefaultHealthCheckInterval is how often every endpoint is checked with getHealth.
+	DefaultHealthCheckInterval = 30 * time.Second
+
+	// poolServiceName is the tracker service per-endpoint attempts are recorded under
+	poolServiceName = "solana_rpc"
+	// healthCheckTimeout bounds one getHealth call
+	healthCheckTimeout = 5 * time.Second
+	// latencyWeight is how much the latest call moves an endpoint's average latency
+	latencyWeight = 0.2
+)
+
+// Endpoint is one Solana RPC node. Name labels its metrics and logs, so it
+// must not contain credentials.
+type Endpoint struct {
+	Name    string
+	URL     string
+	Headers map[string]string
+}
+
+// PoolConfig tunes the endpoint pool. Zero values use the defaults.
+type PoolConfig struct {
+	FailureThreshold    int
+	HealthCheckInterval time.Duration
+	// LatencyRouting sends calls to the healthy endpoint with the lowest
+	// average latency instead of the first healthy one in priority order
+	LatencyRouting bool
+}
+
+// Pool spreads JSON-RPC calls over several Solana RPC endpoints. Calls go to
+// the primary (the first endpoint) while it is healthy and fail over to the
+// fallbacks in order. An endpoint that fails FailureThreshold calls in a row
+// is skipped until a health check passes. It implements rpc.JSONRPCClient,
+// so it plugs into rpc.NewWithCustomRPCClient.
+type Pool struct {
+	endpoints []*poolEndpoint
+	config    PoolConfig
+	tracker   *tracker.APITracker
+}
+
+type poolEndpoint struct {
+	Endpoint
+	client rpc.JSONRPCClient
+
+	mu       sync.Mutex
+	failures int           // Consecutive failed calls
+	healthy  bool          // False once failures reaches the threshold, until a health check passes
+	latency  time.Duration // Moving average of successful calls
+}
+
+var _ rpc.JSONRPCClient = (*Pool)(nil)
+
+// NewPool creates a pool over endpoints, the first being the primary.
+func NewPool(endpoints []Endpoint, httpClient *http.Client, apiTracker *tracker.APITracker, config PoolConfig) (*Pool, error) {
+	if len(endpoints) == 0 {
+		return nil, errors.New("at least one Solana RPC endpoint is required")
+	}
+	if config.FailureThreshold <= 0 {
+		config.FailureThreshold = DefaultFailureThreshold
+	}
+	if config.HealthCheckInterval <= 0 {
+		config.HealthCheckInterval = DefaultHealthCheckInterval
+	}
+
+	p := &Pool{config: config, tracker: apiTracker}
+	for _, endpoint := range endpoints {
+		if endpoint.Name == "" {
+			endpoint.Name = endpointName(endpoint.URL)
+		}
+		p.endpoints = append(p.endpoints, &poolEndpoint{
+			Endpoint: endpoint,
+			client: jsonrpc.NewClientWithOpts(endpoint.URL, &jsonrpc.RPCClientOpts{
+				HTTPClient:    httpClient,
+				CustomHeaders: endpoint.Headers,
+			}),
+			healthy: true,
+		})
+	}
+	return p, nil
+}
+
+// ParseEndpoints parses comma-separated fallback endpoints, each a url or a
+// name=url pair.
+func ParseEndpoints(spec string) ([]Endpoint, error) {
+	var endpoints []Endpoint
+	for entry := range strings.SplitSeq(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		var name string
+		if before, after, ok := strings.Cut(entry, "="); ok && !strings.Contains(before, "/") {
+			name, entry = strings.TrimSpace(before), strings.TrimSpace(after)
+		}
+		if !strings.HasPrefix(entry, "https://") && !strings.HasPrefix(entry, "http://") {
+			return nil, fmt.Errorf("invalid Solana RPC endpoint %q: url must be http(s)", entry)
+		}
+		endpoints = append(endpoints, Endpoint{Name: name, URL: entry})
+	}
+	return endpoints, nil
+}
+
+// endpointName labels an endpoint by its host, leaving out any key in the path or query
+func endpointName(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return "unknown"
+	}
+	return u.Host
+}
+
+// CallForInto implements rpc.JSONRPCClient
+func (p *Pool) CallForInto(ctx context.Context, out any, method string, params []any) error {
+	return p.call(ctx, method, func(ctx context.Context, client rpc.JSONRPCClient) error {
+		return client.CallForInto(ctx, out, method, params)
+	})
+}
+
+// CallWithCallback implements rpc.JSONRPCClient
+func (p *Pool) CallWithCallback(ctx context.Context, method string, params []any, callback func(*http.Request, *http.Response) error) error {
+	return p.call(ctx, method, func(ctx context.Context, client rpc.JSONRPCClient) error {
+		return client.CallWithCallback(ctx, method, params, callback)
+	})
+}
+
+// CallBatch implements rpc.JSONRPCClient
+func (p *Pool) CallBatch(ctx context.Context, requests jsonrpc.RPCRequests) (jsonrpc.RPCResponses, error) {
+	var responses jsonrpc.RPCResponses
+	err := p.call(ctx, "batch", func(ctx context.Context, client rpc.JSONRPCClient) error {
+		var err error
+		responses, err = client.CallBatch(ctx, requests)
+		return err
+	})
+	return responses, err
+}
+
+// call tries the endpoints in order until one succeeds or fails with an error
+// another endpoint would return too. Each try is traced in its own span.
+func (p *Pool) call(ctx context.Context, method string, attempt func(context.Context, rpc.JSONRPCClient) error) error {
+	var err error
+	for retry, endpoint := range p.order() {
+		start := time.Now()
+		err = p.tracker.TraceEndpointCall(ctx, poolServiceName, endpoint.Name, method, retry, func(ctx context.Context) error {
+			return attempt(ctx, endpoint.client)
+		})
+		elapsed := time.Since(start)
+
+		if err == nil || !failover(ctx, err) {
+			p.succeeded(endpoint, elapsed)
+			return err
+		}
+		p.failed(endpoint, err)
+		slog.WarnContext(ctx, "Solana RPC call failed, trying next endpoint",
+			"endpoint", endpoint.Name, "method", method, "error", err)
+	}
+	return err
+}
+
+// order returns the healthy endpoints, by priority or latency, followed by the
+// unhealthy ones as a last resort
+func (p *Pool) order() []*poolEndpoint {
+	type candidate struct {
+		endpoint *poolEndpoint
+		healthy  bool
+		latency  time.Duration
+	}
+	candidates := make([]candidate, len(p.endpoints))
+	for i, endpoint := range p.endpoints {
+		endpoint.mu.Lock()
+		candidates[i] = candidate{endpoint: endpoint, healthy: endpoint.healthy, latency: endpoint.latency}
+		endpoint.mu.Unlock()
+	}
+	slices.SortStableFunc(candidates, func(a, b candidate) int {
+		if a.healthy != b.healthy {
+			if a.healthy {
+				return -1
+			}
+			return 1
+		}
+		// Endpoints not measured yet sort first, so they get measured
+		if p.config.LatencyRouting {
+			return cmp.Compare(a.latency, b.latency)
+		}
+		return 0
+	})
+
+	ordered := make([]*poolEndpoint, len(candidates))
+	for i, c := range candidates {
+		ordered[i] = c.endpoint
+	}
+	return ordered
+}
+
+// failover reports whether err is the endpoint's fault, so the call may
+// succeed elsewhere. Errors the node returns about the request itself would
+// come back from every endpoint.
+func failover(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	var rpcErr *jsonrpc.RPCError
+	if errors.As(err, &rpcErr) {
+		// Node is unhealthy or behind, or has not got the slot yet
+		return rpcErr.Code == -32005 || rpcErr.Code == -32004
+	}
+	return true
+}
+
+func (p *Pool) succeeded(endpoint *poolEndpoint, elapsed time.Duration) {
+	endpoint.mu.Lock()
+	defer endpoint.mu.Unlock()
+	endpoint.failures = 0
+	if endpoint.latency == 0 {
+		endpoint.latency = elapsed
+	} else {
+		endpoint.latency += time.Duration(latencyWeight * float64(elapsed-endpoint.latency))
+	}
+}
+
+func (p *Pool) failed(endpoint *poolEndpoint, err error) {
+	endpoint.mu.Lock()
+	defer endpoint.mu.Unlock()
+	endpoint.failures++
+	if endpoint.healthy && endpoint.failures >= p.config.FailureThreshold {
+		endpoint.healthy = false
+		slog.Error("Solana RPC endpoint taken out of rotation",
+			"endpoint", endpoint.Name, "consecutive_failures", endpoint.failures, "error", err)
+	}
+}
+
+// Run health checks every endpoint each HealthCheckInterval until ctx is
+// cancelled, returning endpoints that pass to rotation.
+func (p *Pool) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.config.HealthCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			p.CheckHealth(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// CheckHealth calls getHealth on every endpoint once.
+func (p *Pool) CheckHealth(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, endpoint := range p.endpoints {
+		wg.Go(func() {
+			checkCtx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+			defer cancel()
+			start := time.Now()
+			var status string
+			err := endpoint.client.CallForInto(checkCtx, &status, "getHealth", nil)
+			if err != nil {
+				p.failed(endpoint, err)
+				return
+			}
+			p.succeeded(endpoint, time.Since(start))
+
+			endpoint.mu.Lock()
+			restored := !endpoint.healthy
+			endpoint.healthy = true
+			endpoint.mu.Unlock()
+			if restored {
+				slog.InfoContext(ctx, "Solana RPC endpoint back in rotation", "endpoint", endpoint.Name)
+			}
+		})
+	}
+	wg.Wait()
+}
+
+// Healthy reports whether the named endpoint is in rotation
+func (p *Pool) Healthy(name string) bool {
+	for _, endpoint := range p.endpoints {
+		if endpoint.Name == name {
+			endpoint.mu.Lock()
+			defer endpoint.mu.Unlock()
+			return endpoint.healthy
+		}
+	}
+	return false
+}
+
+// EndpointStatus is an endpoint's place in rotation
+type EndpointStatus struct {
+	Name                string
+	Healthy             bool
+	ConsecutiveFailures int
+	Latency             time.Duration // Moving average of successful calls, 0 before the first
+}
+
+// Status returns the state of every endpoint, in priority order
+func (p *Pool) Status() []EndpointStatus {
+	statuses := make([]EndpointStatus, 0, len(p.endpoints))
+	for _, endpoint := range p.endpoints {
+		endpoint.mu.Lock()
+		statuses = append(statuses, EndpointStatus{
+			Name:                endpoint.Name,
+			Healthy:             endpoint.healthy,
+			ConsecutiveFailures: endpoint.failures,
+			Latency:             endpoint.latency,
+		})
+		endpoint.mu.Unlock()
+	}
+	return statuses
+}
@@ -0,0 +1,164 @@
+package solana
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeNode answers every JSON-RPC call with result, or fails with HTTP 503
+// while down is set
+type fakeNode struct {
+	*httptest.Server
+	down    atomic.Bool
+	calls   atomic.Int32
+	delay   time.Duration
+	rpcCode int // When set, every call fails with this JSON-RPC error
+}
+
+func newFakeNode(t *testing.T, result string) *fakeNode {
+	node := &fakeNode{}
+	node.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     any    `json:"id"`
+			Method string `json:"method"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		if req.Method != "getHealth" {
+			node.calls.Add(1)
+		}
+		time.Sleep(node.delay)
+		if node.down.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		response := map[string]any{"jsonrpc": "2.0", "id": req.ID, "result": result}
+		if node.rpcCode != 0 && req.Method != "getHealth" {
+			response = map[string]any{"jsonrpc": "2.0", "id": req.ID, "error": map[string]any{"code": node.rpcCode, "message": "failed"}}
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(response))
+	}))
+	t.Cleanup(node.Close)
+	return node
+}
+
+func newTestPool(t *testing.T, config PoolConfig, nodes ...*fakeNode) *Pool {
+	endpoints := make([]Endpoint, len(nodes))
+	for i, node := range nodes {
+		endpoints[i] = Endpoint{Name: string(rune('a' + i)), URL: node.URL}
+	}
+	pool, err := NewPool(endpoints, http.DefaultClient, nil, config)
+	require.NoError(t, err)
+	return pool
+}
+
+func getSlot(t *testing.T, pool *Pool) (string, error) {
+	var out string
+	err := pool.CallForInto(t.Context(), &out, "getSlot", nil)
+	return out, err
+}
+
+func TestPoolFailsOverAndRestores(t *testing.T) {
+	primary, fallback := newFakeNode(t, "primary"), newFakeNode(t, "fallback")
+	pool := newTestPool(t, PoolConfig{FailureThreshold: 2}, primary, fallback)
+
+	out, err := getSlot(t, pool)
+	require.NoError(t, err)
+	assert.Equal(t, "primary", out)
+
+	primary.down.Store(true)
+	for range 2 {
+		out, err = getSlot(t, pool)
+		require.NoError(t, err)
+		assert.Equal(t, "fallback", out)
+	}
+	assert.False(t, pool.Healthy("a"), "the primary leaves rotation after two failures in a row")
+	assert.True(t, pool.Healthy("b"))
+	status := pool.Status()
+	require.Len(t, status, 2)
+	assert.Equal(t, "a", status[0].Name)
+	assert.False(t, status[0].Healthy)
+	assert.Equal(t, 2, status[0].ConsecutiveFailures)
+	assert.True(t, status[1].Healthy)
+
+	// Out of rotation, the primary is not tried first anymore
+	before := primary.calls.Load()
+	_, err = getSlot(t, pool)
+	require.NoError(t, err)
+	assert.Equal(t, before, primary.calls.Load())
+
+	// A failed health check keeps it out, a passing one brings it back
+	pool.CheckHealth(context.Background())
+	assert.False(t, pool.Healthy("a"))
+	primary.down.Store(false)
+	pool.CheckHealth(context.Background())
+	assert.True(t, pool.Healthy("a"))
+	out, err = getSlot(t, pool)
+	require.NoError(t, err)
+	assert.Equal(t, "primary", out)
+}
+
+func TestPoolAllEndpointsDown(t *testing.T) {
+	primary, fallback := newFakeNode(t, "primary"), newFakeNode(t, "fallback")
+	primary.down.Store(true)
+	fallback.down.Store(true)
+	pool := newTestPool(t, PoolConfig{}, primary, fallback)
+
+	_, err := getSlot(t, pool)
+	assert.Error(t, err)
+	assert.Equal(t, int32(1), primary.calls.Load())
+	assert.Equal(t, int32(1), fallback.calls.Load())
+}
+
+func TestPoolDoesNotFailOverRequestErrors(t *testing.T) {
+	primary, fallback := newFakeNode(t, "primary"), newFakeNode(t, "fallback")
+	primary.rpcCode = -32602 // Invalid params, which every node would reject
+	pool := newTestPool(t, PoolConfig{FailureThreshold: 1}, primary, fallback)
+
+	_, err := getSlot(t, pool)
+	assert.Error(t, err)
+	assert.Zero(t, fallback.calls.Load())
+	assert.True(t, pool.Healthy("a"))
+}
+
+func TestPoolFailsOverUnhealthyNode(t *testing.T) {
+	primary, fallback := newFakeNode(t, "primary"), newFakeNode(t, "fallback")
+	primary.rpcCode = -32005 // Node is behind
+	pool := newTestPool(t, PoolConfig{}, primary, fallback)
+
+	out, err := getSlot(t, pool)
+	require.NoError(t, err)
+	assert.Equal(t, "fallback", out)
+}
+
+func TestPoolLatencyRouting(t *testing.T) {
+	slow, fast := newFakeNode(t, "slow"), newFakeNode(t, "fast")
+	slow.delay = 50 * time.Millisecond
+	pool := newTestPool(t, PoolConfig{LatencyRouting: true}, slow, fast)
+
+	pool.CheckHealth(context.Background())
+	out, err := getSlot(t, pool)
+	require.NoError(t, err)
+	assert.Equal(t, "fast", out)
+}
+
+func TestParseEndpoints(t *testing.T) {
+	endpoints, err := ParseEndpoints(" helius=https://mainnet.helius-rpc.com/?api-key=k , https://api.mainnet-beta.solana.com")
+	require.NoError(t, err)
+	assert.Equal(t, []Endpoint{
+		{Name: "helius", URL: "https://mainnet.helius-rpc.com/?api-key=k"},
+		{URL: "https://api.mainnet-beta.solana.com"},
+	}, endpoints)
+
+	_, err = ParseEndpoints("ftp://example.com")
+	assert.Error(t, err)
+
+	assert.Equal(t, "mainnet.helius-rpc.com", endpointName("https://mainnet.helius-rpc.com/?api-key=k"))
+}
@@ -0,0 +1,157 @@
+package solana
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"math"
+	"strconv"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	bmodel "github.com/nicolas-martin/dankfolio/backend/internal/model/blockchain"
+)
+
+const (
+	// stakeAccountSize is the size of every stake account
+	stakeAccountSize = 200
+	// stakeWithdrawerOffset is where the withdraw authority sits: after the
+	// 4 byte state tag, the 8 byte rent-exempt reserve and the staker
+	stakeWithdrawerOffset = 44
+)
+
+// parsedStakeAccount is a stake account in jsonParsed encoding. Numbers are
+// strings because they can exceed what JSON numbers hold exactly.
+type parsedStakeAccount struct {
+	Parsed struct {
+		Type string `json:"type"` // "initialized" or "delegated"
+		Info struct {
+			Stake *struct {
+				Delegation struct {
+					Voter             string `json:"voter"`
+					Stake             string `json:"stake"`
+					ActivationEpoch   string `json:"activationEpoch"`
+					DeactivationEpoch string `json:"deactivationEpoch"`
+				} `json:"delegation"`
+			} `json:"stake"`
+		} `json:"info"`
+	} `json:"parsed"`
+}
+
+// GetStakeAccounts implements clients.GenericClientAPI. It finds stake
+// accounts by withdraw authority, since that is who owns the SOL in them.
+func (c *Client) GetStakeAccounts(ctx context.Context, owner bmodel.Address, commitment string) ([]*bmodel.StakeAccount, error) {
+	ownerKey, err := solana.PublicKeyFromBase58(string(owner))
+	if err != nil {
+		return nil, fmt.Errorf("invalid owner address '%s': %w", owner, err)
+	}
+
+	var accounts []*bmodel.StakeAccount
+	err = c.tracker.InstrumentCall(ctx, "solana", "GetStakeAccounts", func(ctx context.Context) error {
+		rpcCommitment := model.ToRPCCommitment(commitment)
+		result, err := c.rpcConn.GetProgramAccountsWithOpts(ctx, solana.StakeProgramID, &rpc.GetProgramAccountsOpts{
+			Commitment: rpcCommitment,
+			Encoding:   solana.EncodingJSONParsed,
+			Filters: []rpc.RPCFilter{
+				{DataSize: stakeAccountSize},
+				{Memcmp: &rpc.RPCFilterMemcmp{Offset: stakeWithdrawerOffset, Bytes: ownerKey.Bytes()}},
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get stake accounts for %s: %w", owner, err)
+		}
+		if len(result) == 0 {
+			return nil
+		}
+
+		epochInfo, err := c.rpcConn.GetEpochInfo(ctx, rpcCommitment)
+		if err != nil {
+			return fmt.Errorf("failed to get epoch info: %w", err)
+		}
+
+		keys := make([]solana.PublicKey, 0, len(result))
+		for _, keyed := range result {
+			account, err := parseStakeAccount(keyed, epochInfo.Epoch)
+			if err != nil {
+				slog.WarnContext(ctx, "failed to parse stake account", "address", keyed.Pubkey.String(), "error", err)
+				continue
+			}
+			accounts = append(accounts, account)
+			keys = append(keys, keyed.Pubkey)
+		}
+
+		// Rewards are informational, so failing to read them keeps the accounts
+		rewards, err := c.rpcConn.GetInflationReward(ctx, keys, &rpc.GetInflationRewardOpts{Commitment: rpcCommitment})
+		if err != nil {
+			slog.WarnContext(ctx, "failed to get stake rewards", "owner", owner, "error", err)
+			return nil
+		}
+		for i, reward := range rewards {
+			if reward != nil && i < len(accounts) {
+				accounts[i].LastReward = reward.Amount
+			}
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return accounts, nil
+}
+
+// parseStakeAccount maps a jsonParsed stake account, deriving its status at
+// the current epoch
+func parseStakeAccount(keyed *rpc.KeyedAccount, epoch uint64) (*bmodel.StakeAccount, error) {
+	var parsed parsedStakeAccount
+	if err := json.Unmarshal(keyed.Account.Data.GetRawJSON(), &parsed); err != nil {
+		return nil, err
+	}
+
+	account := &bmodel.StakeAccount{
+		Address:  bmodel.Address(keyed.Pubkey.String()),
+		Lamports: keyed.Account.Lamports,
+		Status:   bmodel.StakeInactive,
+	}
+	if parsed.Parsed.Type != "delegated" || parsed.Parsed.Info.Stake == nil {
+		return account, nil
+	}
+
+	delegation := parsed.Parsed.Info.Stake.Delegation
+	stake, err := strconv.ParseUint(delegation.Stake, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid delegated stake %q: %w", delegation.Stake, err)
+	}
+	activation, err := strconv.ParseUint(delegation.ActivationEpoch, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid activation epoch %q: %w", delegation.ActivationEpoch, err)
+	}
+	deactivation, err := strconv.ParseUint(delegation.DeactivationEpoch, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid deactivation epoch %q: %w", delegation.DeactivationEpoch, err)
+	}
+
+	account.DelegatedStake = stake
+	account.Voter = bmodel.Address(delegation.Voter)
+	account.Status = stakeStatus(activation, deactivation, epoch)
+	return account, nil
+}
+
+// stakeStatus approximates a delegation's status at epoch. Warmup and cooldown
+// are taken to finish within one epoch, which holds unless a large share of
+// all stake moves at once.
+func stakeStatus(activation, deactivation, epoch uint64) string {
+	switch {
+	case deactivation != math.MaxUint64 && deactivation < epoch:
+		return bmodel.StakeInactive
+	case deactivation != math.MaxUint64:
+		return bmodel.StakeDeactivating
+	case activation >= epoch:
+		return bmodel.StakeActivating
+	default:
+		return bmodel.StakeActive
+	}
+}
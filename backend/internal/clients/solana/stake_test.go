@@ -0,0 +1,61 @@
+package solana
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	bmodel "github.com/nicolas-martin/dankfolio/backend/internal/model/blockchain"
+)
+
+func TestStakeStatus(t *testing.T) {
+	const epoch = 700
+	undeactivated := uint64(math.MaxUint64)
+	assert.Equal(t, bmodel.StakeActivating, stakeStatus(epoch, undeactivated, epoch))
+	assert.Equal(t, bmodel.StakeActive, stakeStatus(epoch-1, undeactivated, epoch))
+	assert.Equal(t, bmodel.StakeDeactivating, stakeStatus(epoch-10, epoch, epoch))
+	assert.Equal(t, bmodel.StakeInactive, stakeStatus(epoch-10, epoch-1, epoch))
+}
+
+func TestParseStakeAccount(t *testing.T) {
+	var keyed rpc.KeyedAccount
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"pubkey": "9yZXhgvJkFcJzNjzj3r6yR8cBMmF1RDXzLTy3yDy9jG1",
+		"account": {
+			"lamports": 5002282880,
+			"owner": "Stake11111111111111111111111111111111111111",
+			"executable": false,
+			"rentEpoch": 0,
+			"data": {
+				"program": "stake",
+				"space": 200,
+				"parsed": {
+					"type": "delegated",
+					"info": {
+						"meta": {"rentExemptReserve": "2282880"},
+						"stake": {
+							"delegation": {
+								"voter": "CertusDeBmqN8ZawdkxK5kFGMwBXdudvWHYwtNgNhvLu",
+								"stake": "5000000000",
+								"activationEpoch": "650",
+								"deactivationEpoch": "18446744073709551615"
+							}
+						}
+					}
+				}
+			}
+		}
+	}`), &keyed))
+
+	account, err := parseStakeAccount(&keyed, 700)
+	require.NoError(t, err)
+	assert.Equal(t, bmodel.Address("9yZXhgvJkFcJzNjzj3r6yR8cBMmF1RDXzLTy3yDy9jG1"), account.Address)
+	assert.Equal(t, uint64(5002282880), account.Lamports)
+	assert.Equal(t, uint64(5000000000), account.DelegatedStake)
+	assert.Equal(t, bmodel.Address("CertusDeBmqN8ZawdkxK5kFGMwBXdudvWHYwtNgNhvLu"), account.Voter)
+	assert.Equal(t, bmodel.StakeActive, account.Status)
+}
@@ -0,0 +1,199 @@
+package solana
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc/ws"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	bmodel "github.com/nicolas-martin/dankfolio/backend/internal/model/blockchain"
+)
+
+const (
+	minReconnectDelay = time.Second
+	maxReconnectDelay = 30 * time.Second
+)
+
+// Subscriber streams the transactions that mention a set of addresses from
+// the RPC node's websocket, one logsSubscribe per address. Dropped
+// connections are redialed and every address resubscribed.
+type Subscriber struct {
+	endpoint   string
+	header     http.Header
+	commitment string
+
+	mu      sync.Mutex
+	watched map[bmodel.Address]struct{}
+	changed chan struct{}
+}
+
+// NewSubscriber creates a subscriber for a websocket endpoint (wss://...).
+// header is sent with the handshake, e.g. for authorization.
+func NewSubscriber(endpoint string, header http.Header, commitment string) *Subscriber {
+	if commitment == "" {
+		commitment = bmodel.DefaultCommitment
+	}
+	return &Subscriber{
+		endpoint:   endpoint,
+		header:     header,
+		commitment: commitment,
+		watched:    make(map[bmodel.Address]struct{}),
+		changed:    make(chan struct{}, 1),
+	}
+}
+
+// SetWatched replaces the watched addresses. A running subscriber picks the
+// change up without waiting for a reconnect.
+func (s *Subscriber) SetWatched(addresses []bmodel.Address) {
+	watched := make(map[bmodel.Address]struct{}, len(addresses))
+	for _, address := range addresses {
+		watched[address] = struct{}{}
+	}
+	s.mu.Lock()
+	s.watched = watched
+	s.mu.Unlock()
+	select {
+	case s.changed <- struct{}{}:
+	default:
+	}
+}
+
+func (s *Subscriber) snapshot() map[bmodel.Address]struct{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.watched
+}
+
+// Run delivers activity to handle until ctx is cancelled. handle is called
+// from one goroutine per address and should return quickly: the node drops
+// subscriptions that are not read fast enough.
+func (s *Subscriber) Run(ctx context.Context, handle func(context.Context, bmodel.AddressActivity)) {
+	delay := minReconnectDelay
+	for {
+		started := time.Now()
+		err := s.session(ctx, handle)
+		if ctx.Err() != nil {
+			return
+		}
+		if err == nil {
+			// Addresses were dropped; resubscribe the rest right away
+			continue
+		}
+		if time.Since(started) > maxReconnectDelay {
+			delay = minReconnectDelay
+		}
+		slog.WarnContext(ctx, "Solana websocket session ended, reconnecting",
+			slog.Any("error", err), slog.Duration("delay", delay))
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return
+		}
+		delay = min(delay*2, maxReconnectDelay)
+	}
+}
+
+// session subscribes the watched addresses on one connection. It returns nil
+// when an address stops being watched, since the client cannot safely
+// unsubscribe while it may still be delivering, and an error when the
+// connection fails.
+func (s *Subscriber) session(ctx context.Context, handle func(context.Context, bmodel.AddressActivity)) error {
+	// Drain a change that happened before this session, it sees the latest set
+	select {
+	case <-s.changed:
+	default:
+	}
+	watched := s.snapshot()
+	if len(watched) == 0 {
+		select {
+		case <-s.changed:
+			return nil
+		case <-ctx.Done():
+			return nil
+		}
+	}
+
+	client, err := ws.ConnectWithOptions(ctx, s.endpoint, &ws.Options{HttpHeader: s.header})
+	if err != nil {
+		return fmt.Errorf("failed to connect to %s: %w", s.endpoint, err)
+	}
+	sessionCtx, cancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	defer func() {
+		cancel()
+		client.Close()
+		wg.Wait()
+	}()
+
+	failed := make(chan error, 1)
+	subscribed := make(map[bmodel.Address]struct{}, len(watched))
+	for {
+		for address := range subscribed {
+			if _, ok := watched[address]; !ok {
+				return nil
+			}
+		}
+		for address := range watched {
+			if _, ok := subscribed[address]; ok {
+				continue
+			}
+			key, err := solana.PublicKeyFromBase58(string(address))
+			if err != nil {
+				slog.WarnContext(ctx, "Not subscribing to invalid address", slog.String("address", string(address)))
+				subscribed[address] = struct{}{}
+				continue
+			}
+			sub, err := client.LogsSubscribeMentions(key, model.ToRPCCommitment(s.commitment))
+			if err != nil {
+				return fmt.Errorf("failed to subscribe to %s: %w", address, err)
+			}
+			subscribed[address] = struct{}{}
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				s.receive(sessionCtx, address, sub, handle, failed)
+			}()
+		}
+		slog.DebugContext(ctx, "Solana websocket subscriptions updated", slog.Int("addresses", len(subscribed)))
+
+		select {
+		case <-s.changed:
+			watched = s.snapshot()
+		case err := <-failed:
+			return err
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (s *Subscriber) receive(ctx context.Context, address bmodel.Address, sub *ws.LogSubscription, handle func(context.Context, bmodel.AddressActivity), failed chan<- error) {
+	for {
+		result, err := sub.Recv(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if err == nil && result == nil {
+			err = fmt.Errorf("subscription to %s closed", address)
+		}
+		if err != nil {
+			select {
+			case failed <- err:
+			default:
+			}
+			return
+		}
+		handle(ctx, bmodel.AddressActivity{
+			Address:   address,
+			Signature: bmodel.Signature(result.Value.Signature.String()),
+			Slot:      result.Context.Slot,
+			Err:       result.Value.Err,
+		})
+	}
+}
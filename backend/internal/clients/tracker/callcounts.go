@@ -0,0 +1,73 @@
+package tracker
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+type callCountsKey struct{}
+
+// CallCounts tallies upstream API calls per service made while serving a
+// single request, so load tests can measure how many external calls each
+// API request fans out to
+type CallCounts struct {
+	mu     sync.Mutex
+	counts map[string]int
+}
+
+// WithCallCounts returns a context whose tracked calls are tallied in the
+// returned CallCounts
+func WithCallCounts(ctx context.Context) (context.Context, *CallCounts) {
+	counts := &CallCounts{counts: make(map[string]int)}
+	return context.WithValue(ctx, callCountsKey{}, counts), counts
+}
+
+func countCall(ctx context.Context, serviceName string) {
+	if counts, ok := ctx.Value(callCountsKey{}).(*CallCounts); ok {
+		counts.mu.Lock()
+		counts.counts[serviceName]++
+		counts.mu.Unlock()
+	}
+}
+
+// String formats the counts as "service=n,..." sorted by service, the form
+// ParseCallCounts reads back
+func (c *CallCounts) String() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	services := make([]string, 0, len(c.counts))
+	for service := range c.counts {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+
+	parts := make([]string, len(services))
+	for i, service := range services {
+		parts[i] = service + "=" + strconv.Itoa(c.counts[service])
+	}
+	return strings.Join(parts, ",")
+}
+
+// ParseCallCounts parses the output of CallCounts.String
+func ParseCallCounts(s string) (map[string]int, error) {
+	counts := make(map[string]int)
+	for part := range strings.SplitSeq(s, ",") {
+		if part == "" {
+			continue
+		}
+		service, n, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid call count %q", part)
+		}
+		count, err := strconv.Atoi(n)
+		if err != nil {
+			return nil, fmt.Errorf("invalid call count %q: %w", part, err)
+		}
+		counts[service] += count
+	}
+	return counts, nil
+}
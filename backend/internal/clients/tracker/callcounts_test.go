@@ -0,0 +1,28 @@
+package tracker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCallCounts(t *testing.T) {
+	ctx, counts := WithCallCounts(context.Background())
+
+	// Counted even without a configured tracker
+	var tr *APITracker
+	tr.TrackCallWithContext(ctx, "solana", "GetBalance")
+	tr.TrackCallWithContext(ctx, "birdeye", "/defi/price")
+	tr.TrackCallWithContext(ctx, "birdeye", "/defi/history_price")
+	tr.TrackCallWithContext(context.Background(), "jupiter", "/quote")
+
+	assert.Equal(t, "birdeye=2,solana=1", counts.String())
+	parsed, err := ParseCallCounts(counts.String())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"birdeye": 2, "solana": 1}, parsed)
+
+	_, err = ParseCallCounts("birdeye")
+	assert.Error(t, err)
+}
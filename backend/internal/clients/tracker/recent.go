@@ -0,0 +1,117 @@
+package tracker
+
+import (
+	"math"
+	"slices"
+	"time"
+)
+
+// RecentWindow is how far back RecentStats look
+const RecentWindow = 5 * time.Minute
+
+// maxRecentEvents bounds the events kept per service. Under heavy traffic they
+// cover less than RecentWindow, but the error rate and percentiles still hold.
+const maxRecentEvents = 4096
+
+type recentKind uint8
+
+const (
+	recentCall recentKind = iota
+	recentFailure
+	recentTiming
+)
+
+type recentEvent struct {
+	at       time.Time
+	kind     recentKind
+	duration time.Duration // Timings only
+}
+
+// recentEvents is a ring of a service's latest calls, failures and timings
+type recentEvents struct {
+	events []recentEvent
+	next   int
+}
+
+func (r *recentEvents) add(e recentEvent) {
+	if len(r.events) < maxRecentEvents {
+		r.events = append(r.events, e)
+		return
+	}
+	r.events[r.next] = e
+	r.next = (r.next + 1) % maxRecentEvents
+}
+
+// RecentStats are a service's calls over the last RecentWindow
+type RecentStats struct {
+	Calls  int64
+	Errors int64
+	P50    time.Duration // Latency percentiles of the timed calls
+	P95    time.Duration
+	P99    time.Duration
+}
+
+// ErrorRate returns the share of calls that failed, 0 without calls
+func (s RecentStats) ErrorRate() float64 {
+	if s.Calls == 0 {
+		return 0
+	}
+	return float64(s.Errors) / float64(s.Calls)
+}
+
+func (t *APITracker) recordRecent(serviceName string, kind recentKind, duration time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.recent == nil {
+		t.recent = make(map[string]*recentEvents)
+	}
+	events, ok := t.recent[serviceName]
+	if !ok {
+		events = &recentEvents{}
+		t.recent[serviceName] = events
+	}
+	events.add(recentEvent{at: time.Now(), kind: kind, duration: duration})
+}
+
+// RecentStats returns the calls made to a service over the last RecentWindow
+func (t *APITracker) RecentStats(serviceName string) RecentStats {
+	if t == nil {
+		return RecentStats{}
+	}
+	since := time.Now().Add(-RecentWindow)
+	var stats RecentStats
+	var durations []time.Duration
+
+	t.mu.Lock()
+	if events, ok := t.recent[serviceName]; ok {
+		for _, e := range events.events {
+			if e.at.Before(since) {
+				continue
+			}
+			switch e.kind {
+			case recentCall:
+				stats.Calls++
+			case recentFailure:
+				stats.Errors++
+			case recentTiming:
+				durations = append(durations, e.duration)
+			}
+		}
+	}
+	t.mu.Unlock()
+
+	slices.Sort(durations)
+	stats.P50 = percentile(durations, 0.50)
+	stats.P95 = percentile(durations, 0.95)
+	stats.P99 = percentile(durations, 0.99)
+	return stats
+}
+
+// percentile returns the nearest-rank p-th percentile of sorted durations
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p*float64(len(sorted)))) - 1
+	return sorted[max(0, min(rank, len(sorted)-1))]
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"slices"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/nicolas-martin/dankfolio/backend/internal/telemetry/otel"
@@ -13,15 +16,46 @@ import (
 	"go.opentelemetry.io/otel/trace"
 )
 
-// APITracker tracks API calls using OpenTelemetry
+// RetryCountKey is the span attribute counting the earlier tries of a call,
+// 0 for the first
+const RetryCountKey = attribute.Key("retry.count")
+
+// APITracker tracks API calls using OpenTelemetry. Measurements are recorded
+// in the context of the call's span, so histograms carry exemplars linking
+// to the trace of a slow call.
 type APITracker struct {
 	telemetry *otel.Telemetry
 	metrics   struct {
 		apiCallCounter  metric.Int64Counter
 		apiCallDuration metric.Float64Histogram
+		requestSize     metric.Int64Histogram
+		responseSize    metric.Int64Histogram
 		activeRequests  metric.Int64UpDownCounter
 		errorCounter    metric.Int64Counter
 	}
+
+	// Totals per service since startup, for the admin dashboard, and the
+	// latest calls, for the service status
+	mu     sync.Mutex
+	stats  map[string]*ServiceStats
+	recent map[string]*recentEvents
+}
+
+// ServiceStats are the calls made to an upstream service since startup
+type ServiceStats struct {
+	Service       string
+	Calls         int64
+	Errors        int64
+	TotalDuration time.Duration // Over the calls whose duration was recorded
+	timedCalls    int64
+}
+
+// AverageDuration returns the mean duration of the timed calls
+func (s ServiceStats) AverageDuration() time.Duration {
+	if s.timedCalls == 0 {
+		return 0
+	}
+	return s.TotalDuration / time.Duration(s.timedCalls)
 }
 
 // NewAPITracker creates a new OpenTelemetry-based API tracker
@@ -66,6 +100,27 @@ func (t *APITracker) initMetrics() error {
 		slog.Warn("Failed to create api call duration histogram", "error", err)
 	}
 
+	payloadBuckets := metric.WithExplicitBucketBoundaries(256, 1024, 4096, 16384, 65536, 262144, 1048576, 4194304)
+	t.metrics.requestSize, err = t.telemetry.Meter.Int64Histogram(
+		"dankfolio.api_call_request_size_bytes",
+		metric.WithDescription("Size of API call request bodies"),
+		metric.WithUnit("By"),
+		payloadBuckets,
+	)
+	if err != nil {
+		slog.Warn("Failed to create api call request size histogram", "error", err)
+	}
+
+	t.metrics.responseSize, err = t.telemetry.Meter.Int64Histogram(
+		"dankfolio.api_call_response_size_bytes",
+		metric.WithDescription("Size of API call response bodies"),
+		metric.WithUnit("By"),
+		payloadBuckets,
+	)
+	if err != nil {
+		slog.Warn("Failed to create api call response size histogram", "error", err)
+	}
+
 	t.metrics.activeRequests, err = t.telemetry.Meter.Int64UpDownCounter(
 		"dankfolio.active_requests",
 		metric.WithDescription("Number of active requests"),
@@ -89,7 +144,13 @@ func (t *APITracker) initMetrics() error {
 
 // TrackCallWithContext tracks an API call with context
 func (t *APITracker) TrackCallWithContext(ctx context.Context, serviceName, endpointName string) {
-	if t == nil || t.metrics.apiCallCounter == nil {
+	countCall(ctx, serviceName)
+	if t == nil {
+		return
+	}
+	t.observe(serviceName, func(s *ServiceStats) { s.Calls++ })
+	t.recordRecent(serviceName, recentCall, 0)
+	if t.metrics.apiCallCounter == nil {
 		return
 	}
 
@@ -141,7 +202,9 @@ func (t *APITracker) EndSpan(span trace.Span, err error, serviceName string) {
 		return
 	}
 
-	ctx := context.Background()
+	// The caller's context may be canceled by now, but the span is all the
+	// measurements need to link their exemplars
+	ctx := trace.ContextWithSpan(context.Background(), span)
 
 	if t != nil && t.metrics.activeRequests != nil {
 		t.metrics.activeRequests.Add(ctx, -1, metric.WithAttributes(
@@ -153,6 +216,10 @@ func (t *APITracker) EndSpan(span trace.Span, err error, serviceName string) {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 
+		if t != nil {
+			t.observe(serviceName, func(s *ServiceStats) { s.Errors++ })
+			t.recordRecent(serviceName, recentFailure, 0)
+		}
 		if t != nil && t.metrics.errorCounter != nil {
 			t.metrics.errorCounter.Add(ctx, 1, metric.WithAttributes(
 				attribute.String("service.name", serviceName),
@@ -168,7 +235,15 @@ func (t *APITracker) EndSpan(span trace.Span, err error, serviceName string) {
 
 // RecordDuration records the duration of an API call
 func (t *APITracker) RecordDuration(ctx context.Context, serviceName, endpointName string, duration time.Duration) {
-	if t == nil || t.metrics.apiCallDuration == nil {
+	if t == nil {
+		return
+	}
+	t.observe(serviceName, func(s *ServiceStats) {
+		s.TotalDuration += duration
+		s.timedCalls++
+	})
+	t.recordRecent(serviceName, recentTiming, duration)
+	if t.metrics.apiCallDuration == nil {
 		return
 	}
 
@@ -180,13 +255,124 @@ func (t *APITracker) RecordDuration(ctx context.Context, serviceName, endpointNa
 	t.metrics.apiCallDuration.Record(ctx, duration.Seconds(), metric.WithAttributes(attrs...))
 }
 
+// RecordPayloadSizes records the body sizes of an API call. Negative sizes
+// are unknown and skipped.
+func (t *APITracker) RecordPayloadSizes(ctx context.Context, serviceName, endpointName string, requestBytes, responseBytes int64) {
+	if t == nil {
+		return
+	}
+	attrs := metric.WithAttributes(
+		attribute.String("service.name", serviceName),
+		attribute.String("endpoint.name", endpointName),
+	)
+	if requestBytes >= 0 && t.metrics.requestSize != nil {
+		t.metrics.requestSize.Record(ctx, requestBytes, attrs)
+	}
+	if responseBytes >= 0 && t.metrics.responseSize != nil {
+		t.metrics.responseSize.Record(ctx, responseBytes, attrs)
+	}
+}
+
+// RecordEndpointCall records one attempt against a single upstream endpoint
+// of a service spread over several. The call that made the attempt is already
+// tracked, so it is not counted again in CallCounts.
+func (t *APITracker) RecordEndpointCall(ctx context.Context, serviceName, endpointName string, duration time.Duration, err error) {
+	if t == nil {
+		return
+	}
+	t.observe(serviceName, func(s *ServiceStats) {
+		s.Calls++
+		if err != nil {
+			s.Errors++
+		}
+	})
+	t.recordRecent(serviceName, recentCall, 0)
+	if err != nil {
+		t.recordRecent(serviceName, recentFailure, 0)
+	}
+	attrs := metric.WithAttributes(
+		attribute.String("service.name", serviceName),
+		attribute.String("endpoint.name", endpointName),
+	)
+	if t.metrics.apiCallCounter != nil {
+		t.metrics.apiCallCounter.Add(ctx, 1, attrs)
+	}
+	t.RecordDuration(ctx, serviceName, endpointName, duration)
+	if err != nil && t.metrics.errorCounter != nil {
+		t.metrics.errorCounter.Add(ctx, 1, metric.WithAttributes(
+			attribute.String("service.name", serviceName),
+			attribute.String("endpoint.name", endpointName),
+			attribute.String("error.type", fmt.Sprintf("%T", err)),
+		))
+	}
+}
+
+// TraceEndpointCall runs attempt, try number retry of method against a single
+// upstream endpoint of a service spread over several, in a child span of the
+// call, and records it with RecordEndpointCall
+func (t *APITracker) TraceEndpointCall(ctx context.Context, serviceName, endpointName, method string, retry int, attempt func(context.Context) error) error {
+	if t == nil || t.telemetry == nil || t.telemetry.Tracer == nil {
+		start := time.Now()
+		err := attempt(ctx)
+		t.RecordEndpointCall(ctx, serviceName, endpointName, time.Since(start), err)
+		return err
+	}
+
+	ctx, span := t.telemetry.Tracer.Start(ctx, fmt.Sprintf("%s.%s %s", serviceName, endpointName, method),
+		trace.WithAttributes(
+			attribute.String("service.name", serviceName),
+			attribute.String("endpoint.name", endpointName),
+			attribute.String("rpc.method", method),
+			RetryCountKey.Int(retry),
+		),
+		trace.WithSpanKind(trace.SpanKindClient),
+	)
+	defer span.End()
+
+	start := time.Now()
+	err := attempt(ctx)
+	t.RecordEndpointCall(ctx, serviceName, endpointName, time.Since(start), err)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	} else {
+		span.SetStatus(codes.Ok, "")
+	}
+	return err
+}
+
+func (t *APITracker) observe(serviceName string, update func(*ServiceStats)) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.stats == nil {
+		t.stats = make(map[string]*ServiceStats)
+	}
+	stats, ok := t.stats[serviceName]
+	if !ok {
+		stats = &ServiceStats{Service: serviceName}
+		t.stats[serviceName] = stats
+	}
+	update(stats)
+}
+
+// Stats returns the totals of every service called since startup, by name
+func (t *APITracker) Stats() []ServiceStats {
+	if t == nil {
+		return nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	stats := make([]ServiceStats, 0, len(t.stats))
+	for _, s := range t.stats {
+		stats = append(stats, *s)
+	}
+	slices.SortFunc(stats, func(a, b ServiceStats) int { return strings.Compare(a.Service, b.Service) })
+	return stats
+}
+
 // InstrumentCall wraps a function call with OpenTelemetry instrumentation
 func (t *APITracker) InstrumentCall(ctx context.Context, serviceName, endpointName string, fn func(context.Context) error) error {
 	ctx, span := t.StartSpan(ctx, serviceName, endpointName)
-	defer func() {
-		t.EndSpan(span, nil, serviceName)
-	}()
-
 	t.TrackCallWithContext(ctx, serviceName, endpointName)
 
 	start := time.Now()
@@ -194,13 +380,8 @@ func (t *APITracker) InstrumentCall(ctx context.Context, serviceName, endpointNa
 	duration := time.Since(start)
 
 	t.RecordDuration(ctx, serviceName, endpointName, duration)
-
-	if err != nil {
-		t.EndSpan(span, err, serviceName)
-		return err
-	}
-
-	return nil
+	t.EndSpan(span, err, serviceName)
+	return err
 }
 
 func ExtractTraceID(ctx context.Context) string {
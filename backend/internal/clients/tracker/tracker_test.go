@@ -0,0 +1,61 @@
+package tracker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPITrackerStats(t *testing.T) {
+	tr, err := NewAPITracker(nil)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	_ = tr.InstrumentCall(ctx, "solana", "GetBalance", func(context.Context) error { return nil })
+	_ = tr.InstrumentCall(ctx, "solana", "GetBalance", func(context.Context) error { return errors.New("timeout") })
+	tr.RecordEndpointCall(ctx, "solana_rpc", "primary", 20*time.Millisecond, errors.New("unhealthy"))
+	tr.RecordEndpointCall(ctx, "solana_rpc", "backup", 40*time.Millisecond, nil)
+
+	stats := tr.Stats()
+	require.Len(t, stats, 2)
+	assert.Equal(t, "solana", stats[0].Service)
+	assert.Equal(t, int64(2), stats[0].Calls)
+	assert.Equal(t, int64(1), stats[0].Errors)
+	assert.Equal(t, "solana_rpc", stats[1].Service)
+	assert.Equal(t, int64(2), stats[1].Calls)
+	assert.Equal(t, int64(1), stats[1].Errors)
+	assert.Equal(t, 30*time.Millisecond, stats[1].AverageDuration())
+
+	var none *APITracker
+	assert.Nil(t, none.Stats())
+}
+
+func TestAPITrackerRecentStats(t *testing.T) {
+	tr, err := NewAPITracker(nil)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	for i := 1; i <= 100; i++ {
+		var callErr error
+		if i%10 == 0 {
+			callErr = errors.New("unhealthy")
+		}
+		tr.RecordEndpointCall(ctx, "solana_rpc", "primary", time.Duration(i)*time.Millisecond, callErr)
+	}
+
+	recent := tr.RecentStats("solana_rpc")
+	assert.Equal(t, int64(100), recent.Calls)
+	assert.Equal(t, int64(10), recent.Errors)
+	assert.InDelta(t, 0.1, recent.ErrorRate(), 1e-9)
+	assert.Equal(t, 50*time.Millisecond, recent.P50)
+	assert.Equal(t, 95*time.Millisecond, recent.P95)
+	assert.Equal(t, 99*time.Millisecond, recent.P99)
+
+	assert.Equal(t, RecentStats{}, tr.RecentStats("birdeye"))
+	var none *APITracker
+	assert.Equal(t, RecentStats{}, none.RecentStats("solana_rpc"))
+}
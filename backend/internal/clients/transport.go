@@ -0,0 +1,154 @@
+package clients
+
+import (
+	"cmp"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Transport defaults, tuned for a handful of API hosts called often: enough
+// idle connections per host that bursts reuse them instead of redialing
+const (
+	DefaultHTTPTimeout           = 10 * time.Second
+	DefaultMaxIdleConns          = 100
+	DefaultMaxIdleConnsPerHost   = 16
+	DefaultIdleConnTimeout       = 90 * time.Second
+	DefaultDialTimeout           = 5 * time.Second
+	DefaultKeepAlive             = 30 * time.Second
+	DefaultTLSHandshakeTimeout   = 5 * time.Second
+	DefaultHTTP2PingInterval     = 30 * time.Second
+	defaultExpectContinueTimeout = time.Second
+)
+
+// TransportConfig tunes an upstream's HTTP client and connections. Zero values
+// use the defaults. The envconfig names are the settings shared by every
+// upstream; an upstream's own take its name as a prefix, e.g.
+// BIRDEYE_HTTP_MAX_IDLE_CONNS_PER_HOST, and fall back to the shared ones.
+type TransportConfig struct {
+	Timeout               time.Duration `envconfig:"HTTP_TIMEOUT"` // Whole request, including reading the body
+	MaxIdleConns          int           `envconfig:"HTTP_MAX_IDLE_CONNS"`
+	MaxIdleConnsPerHost   int           `envconfig:"HTTP_MAX_IDLE_CONNS_PER_HOST"`
+	MaxConnsPerHost       int           `envconfig:"HTTP_MAX_CONNS_PER_HOST"` // 0 for no cap
+	IdleConnTimeout       time.Duration `envconfig:"HTTP_IDLE_CONN_TIMEOUT"`
+	DialTimeout           time.Duration `envconfig:"HTTP_DIAL_TIMEOUT"`
+	KeepAlive             time.Duration `envconfig:"HTTP_KEEP_ALIVE"`
+	TLSHandshakeTimeout   time.Duration `envconfig:"HTTP_TLS_HANDSHAKE_TIMEOUT"`
+	ResponseHeaderTimeout time.Duration `envconfig:"HTTP_RESPONSE_HEADER_TIMEOUT"` // 0 leaves it to Timeout
+	DisableHTTP2          bool          `envconfig:"HTTP_DISABLE_HTTP2"`           // HTTP/2 is negotiated over TLS unless set
+	HTTP2PingInterval     time.Duration `envconfig:"HTTP_HTTP2_PING_INTERVAL"`     // Idle HTTP/2 connections are pinged this often to catch dead ones
+}
+
+func (c TransportConfig) fill() TransportConfig {
+	c.Timeout = cmp.Or(c.Timeout, DefaultHTTPTimeout)
+	c.MaxIdleConns = cmp.Or(c.MaxIdleConns, DefaultMaxIdleConns)
+	c.MaxIdleConnsPerHost = cmp.Or(c.MaxIdleConnsPerHost, DefaultMaxIdleConnsPerHost)
+	c.IdleConnTimeout = cmp.Or(c.IdleConnTimeout, DefaultIdleConnTimeout)
+	c.DialTimeout = cmp.Or(c.DialTimeout, DefaultDialTimeout)
+	c.KeepAlive = cmp.Or(c.KeepAlive, DefaultKeepAlive)
+	c.TLSHandshakeTimeout = cmp.Or(c.TLSHandshakeTimeout, DefaultTLSHandshakeTimeout)
+	c.HTTP2PingInterval = cmp.Or(c.HTTP2PingInterval, DefaultHTTP2PingInterval)
+	return c
+}
+
+// Merge returns c with the settings override sets replacing its own
+func (c TransportConfig) Merge(override TransportConfig) TransportConfig {
+	c.Timeout = cmp.Or(override.Timeout, c.Timeout)
+	c.MaxIdleConns = cmp.Or(override.MaxIdleConns, c.MaxIdleConns)
+	c.MaxIdleConnsPerHost = cmp.Or(override.MaxIdleConnsPerHost, c.MaxIdleConnsPerHost)
+	c.MaxConnsPerHost = cmp.Or(override.MaxConnsPerHost, c.MaxConnsPerHost)
+	c.IdleConnTimeout = cmp.Or(override.IdleConnTimeout, c.IdleConnTimeout)
+	c.DialTimeout = cmp.Or(override.DialTimeout, c.DialTimeout)
+	c.KeepAlive = cmp.Or(override.KeepAlive, c.KeepAlive)
+	c.TLSHandshakeTimeout = cmp.Or(override.TLSHandshakeTimeout, c.TLSHandshakeTimeout)
+	c.ResponseHeaderTimeout = cmp.Or(override.ResponseHeaderTimeout, c.ResponseHeaderTimeout)
+	c.DisableHTTP2 = c.DisableHTTP2 || override.DisableHTTP2
+	c.HTTP2PingInterval = cmp.Or(override.HTTP2PingInterval, c.HTTP2PingInterval)
+	return c
+}
+
+// NewTransport returns a transport configured by config, resolving hosts
+// through resolver when it isn't nil
+func NewTransport(config TransportConfig, resolver *CachingResolver) *http.Transport {
+	config = config.fill()
+	dialer := &net.Dialer{Timeout: config.DialTimeout, KeepAlive: config.KeepAlive}
+	dial := dialer.DialContext
+	if resolver != nil {
+		dial = resolver.DialContext(dialer)
+	}
+	transport := &http.Transport{
+		Proxy:                 http.ProxyFromEnvironment,
+		DialContext:           dial,
+		MaxIdleConns:          config.MaxIdleConns,
+		MaxIdleConnsPerHost:   config.MaxIdleConnsPerHost,
+		MaxConnsPerHost:       config.MaxConnsPerHost,
+		IdleConnTimeout:       config.IdleConnTimeout,
+		TLSHandshakeTimeout:   config.TLSHandshakeTimeout,
+		ResponseHeaderTimeout: config.ResponseHeaderTimeout,
+		ExpectContinueTimeout: defaultExpectContinueTimeout,
+		// A custom dialer turns HTTP/2 off unless it is asked for
+		ForceAttemptHTTP2: !config.DisableHTTP2,
+		HTTP2:             &http.HTTP2Config{SendPingTimeout: config.HTTP2PingInterval},
+	}
+	if config.DisableHTTP2 {
+		transport.Protocols = new(http.Protocols)
+		transport.Protocols.SetHTTP1(true)
+	}
+	return transport
+}
+
+// ClientFactory builds each upstream's HTTP client on a transport of its own,
+// so one upstream's slow or churning connections can't starve another's
+type ClientFactory struct {
+	defaults  TransportConfig
+	upstreams map[string]TransportConfig
+	resolver  *CachingResolver
+
+	mu      sync.Mutex
+	clients map[string]*http.Client
+}
+
+// NewClientFactory returns a factory whose clients use defaults, with the
+// settings in upstreams, keyed by upstream name, replacing them
+func NewClientFactory(defaults TransportConfig, upstreams map[string]TransportConfig) *ClientFactory {
+	return &ClientFactory{
+		defaults:  defaults,
+		upstreams: upstreams,
+		clients:   make(map[string]*http.Client),
+	}
+}
+
+// SetResolver has clients built afterwards share resolver's DNS cache
+func (f *ClientFactory) SetResolver(resolver *CachingResolver) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.resolver = resolver
+}
+
+// Config returns the settings the upstream's client uses
+func (f *ClientFactory) Config(upstream string) TransportConfig {
+	return f.defaults.Merge(f.upstreams[upstream]).fill()
+}
+
+// Client returns the upstream's client, the same one on every call
+func (f *ClientFactory) Client(upstream string) *http.Client {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if client, ok := f.clients[upstream]; ok {
+		return client
+	}
+	config := f.Config(upstream)
+	client := &http.Client{Timeout: config.Timeout, Transport: NewTransport(config, f.resolver)}
+	f.clients[upstream] = client
+	return client
+}
+
+// CloseIdleConnections closes the idle connections of every client built
+func (f *ClientFactory) CloseIdleConnections() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, client := range f.clients {
+		client.CloseIdleConnections()
+	}
+}
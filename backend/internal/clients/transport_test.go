@@ -0,0 +1,44 @@
+package clients
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientFactory(t *testing.T) {
+	factory := NewClientFactory(
+		TransportConfig{MaxIdleConnsPerHost: 8, DialTimeout: 2 * time.Second},
+		map[string]TransportConfig{
+			"birdeye": {MaxIdleConnsPerHost: 64},
+			"legacy":  {DisableHTTP2: true, Timeout: 30 * time.Second},
+		},
+	)
+
+	birdeye := factory.Client("birdeye")
+	assert.Same(t, birdeye, factory.Client("birdeye"), "one client per upstream")
+	assert.NotSame(t, birdeye.Transport, factory.Client("jupiter").Transport, "upstreams don't share connections")
+
+	transport, ok := birdeye.Transport.(*http.Transport)
+	require.True(t, ok)
+	assert.Equal(t, 64, transport.MaxIdleConnsPerHost, "upstream settings replace the shared ones")
+	assert.Equal(t, DefaultMaxIdleConns, transport.MaxIdleConns)
+	assert.True(t, transport.ForceAttemptHTTP2)
+	assert.Equal(t, DefaultHTTPTimeout, birdeye.Timeout)
+
+	config := factory.Config("jupiter")
+	assert.Equal(t, 8, config.MaxIdleConnsPerHost, "shared settings apply to unlisted upstreams")
+	assert.Equal(t, 2*time.Second, config.DialTimeout)
+
+	legacy := factory.Client("legacy")
+	transport, ok = legacy.Transport.(*http.Transport)
+	require.True(t, ok)
+	assert.False(t, transport.ForceAttemptHTTP2)
+	require.NotNil(t, transport.Protocols)
+	assert.False(t, transport.Protocols.HTTP2())
+	assert.True(t, transport.Protocols.HTTP1())
+	assert.Equal(t, 30*time.Second, legacy.Timeout)
+}
@@ -0,0 +1,242 @@
+// Package cached wraps store repositories with read-through caches of their
+// point reads, dropped by the writes made through them and by invalidation
+// events for writes made elsewhere.
+package cached
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/cache"
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/events"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+// DefaultTTL is how long a read is served from the cache when no TTL is given
+const DefaultTTL = 30 * time.Second
+
+// maxIndexedEntities bounds the entities whose cache keys are tracked for
+// invalidation; past it, every entry is dropped and tracking starts over
+const maxIndexedEntities = 10000
+
+// Repository caches Get and GetByField of a db.Repository for a TTL. Other
+// reads pass through, since lists go stale on any write. Entries are
+// dropped when an entity is written through the repository, or by
+// Invalidate, e.g. on an event for a write made by another replica.
+//
+// Cached entities are shallow copies: callers mustn't modify their slices
+// or maps.
+type Repository[T db.Entity] struct {
+	db.Repository[T]
+	entries *entries[T]
+
+	// pending collects the entities written in a transaction, dropped again
+	// once it ends since readers outside it may cache them meanwhile. Reads
+	// in a transaction skip the cache. Nil outside transactions.
+	pending *pending
+}
+
+// entries is the cache of a Repository, shared with its transactions
+type entries[T any] struct {
+	cache cache.GenericCache[T]
+	ttl   time.Duration
+
+	mu         sync.Mutex
+	generation uint64              // Prefixes the cache keys; bumped to drop every entry
+	keys       map[string][]string // Cache keys by entity ID
+	writes     uint64              // Invalidations so far, so a read racing one isn't cached
+}
+
+type pending struct {
+	mu  sync.Mutex
+	ids []string // "" for every entity
+}
+
+// NewRepository caches the point reads of repo for ttl, DefaultTTL when zero.
+// The name labels the cache in metrics and cache summaries.
+func NewRepository[T db.Entity](repo db.Repository[T], name string, ttl time.Duration) (*Repository[T], error) {
+	c, err := cache.NewGoGenericCacheAdapter[T](name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s cache: %w", name, err)
+	}
+	return &Repository[T]{
+		Repository: repo,
+		entries: &entries[T]{
+			cache: c,
+			ttl:   cmp.Or(ttl, DefaultTTL),
+			keys:  make(map[string][]string),
+		},
+	}, nil
+}
+
+// inTransaction returns the repository over tx, the transaction's repository
+func (r *Repository[T]) inTransaction(tx db.Repository[T], p *pending) *Repository[T] {
+	return &Repository[T]{Repository: tx, entries: r.entries, pending: p}
+}
+
+func (r *Repository[T]) Get(ctx context.Context, id string) (*T, error) {
+	return r.read("id:"+id, func() (*T, error) { return r.Repository.Get(ctx, id) })
+}
+
+func (r *Repository[T]) GetByField(ctx context.Context, field string, value any) (*T, error) {
+	return r.read(fmt.Sprintf("field:%s=%v", field, value), func() (*T, error) {
+		return r.Repository.GetByField(ctx, field, value)
+	})
+}
+
+// read returns the entity cached under key, or loads and caches it
+func (r *Repository[T]) read(key string, load func() (*T, error)) (*T, error) {
+	if r.pending != nil {
+		return load()
+	}
+	e := r.entries
+	e.mu.Lock()
+	cacheKey := fmt.Sprintf("%d:%s", e.generation, key)
+	writes := e.writes
+	e.mu.Unlock()
+
+	if cached, ok := e.cache.Get(cacheKey); ok {
+		return &cached, nil
+	}
+	item, err := load()
+	if err != nil || item == nil {
+		return item, err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.writes != writes {
+		// Written since it was loaded; the next read caches the new version
+		return item, nil
+	}
+	if len(e.keys) >= maxIndexedEntities {
+		e.dropAll()
+		return item, nil
+	}
+	id := (*item).GetID()
+	e.keys[id] = append(e.keys[id], cacheKey)
+	e.cache.Set(cacheKey, *item, e.ttl)
+	return item, nil
+}
+
+// Invalidate drops the cached reads of the entity with id, or of every
+// entity when id is empty
+func (r *Repository[T]) Invalidate(id string) {
+	e := r.entries
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.writes++
+	if id == "" {
+		e.dropAll()
+		return
+	}
+	for _, key := range e.keys[id] {
+		e.cache.Delete(key)
+	}
+	delete(e.keys, id)
+}
+
+// dropAll orphans every entry; the cache evicts them as they expire
+func (e *entries[T]) dropAll() {
+	e.generation++
+	e.keys = make(map[string][]string)
+}
+
+// InvalidateOn drops cached reads as bus delivers events of the given types:
+// those of the entity id returns, or of every entity when it returns "".
+func (r *Repository[T]) InvalidateOn(bus events.Bus, id func(events.Event) string, types ...events.Type) (unsubscribe func()) {
+	return bus.Subscribe(func(_ context.Context, event events.Event) {
+		r.Invalidate(id(event))
+	}, types...)
+}
+
+// Stats returns the lookup counts of the cache
+func (r *Repository[T]) Stats() cache.Stats {
+	return r.entries.cache.Stats()
+}
+
+// written drops the cached reads of ids, now and, in a transaction, again
+// once it ends
+func (r *Repository[T]) written(ids ...string) {
+	for _, id := range ids {
+		r.Invalidate(id)
+	}
+	if r.pending != nil {
+		r.pending.mu.Lock()
+		r.pending.ids = append(r.pending.ids, ids...)
+		r.pending.mu.Unlock()
+	}
+}
+
+func (r *Repository[T]) Create(ctx context.Context, item *T) error {
+	defer r.written((*item).GetID())
+	return r.Repository.Create(ctx, item)
+}
+
+func (r *Repository[T]) Update(ctx context.Context, item *T) error {
+	defer r.written((*item).GetID())
+	return r.Repository.Update(ctx, item)
+}
+
+func (r *Repository[T]) Upsert(ctx context.Context, item *T) (int64, error) {
+	defer r.written((*item).GetID())
+	return r.Repository.Upsert(ctx, item)
+}
+
+func (r *Repository[T]) BulkUpsert(ctx context.Context, items *[]T) (int64, error) {
+	defer r.written(ids(items)...)
+	return r.Repository.BulkUpsert(ctx, items)
+}
+
+func (r *Repository[T]) BulkWrite(ctx context.Context, items *[]T, opts db.BulkWriteOptions) (int64, error) {
+	defer r.written(ids(items)...)
+	return r.Repository.BulkWrite(ctx, items, opts)
+}
+
+// The deletes and Restore take the primary key, which isn't always the ID
+// entities are cached by (coins are by address), so they drop every entry
+
+func (r *Repository[T]) Delete(ctx context.Context, id string) error {
+	defer r.written("")
+	return r.Repository.Delete(ctx, id)
+}
+
+func (r *Repository[T]) HardDelete(ctx context.Context, id string) error {
+	defer r.written("")
+	return r.Repository.HardDelete(ctx, id)
+}
+
+func (r *Repository[T]) SoftDelete(ctx context.Context, id string, deletion model.Deletion) error {
+	defer r.written("")
+	return r.Repository.SoftDelete(ctx, id, deletion)
+}
+
+func (r *Repository[T]) Restore(ctx context.Context, id string) error {
+	defer r.written("")
+	return r.Repository.Restore(ctx, id)
+}
+
+func ids[T db.Entity](items *[]T) []string {
+	if items == nil {
+		return nil
+	}
+	ids := make([]string, len(*items))
+	for i, item := range *items {
+		ids[i] = item.GetID()
+	}
+	return ids
+}
+
+// flush drops the reads of the entities written in a transaction again
+func (p *pending) flush(invalidate func(string)) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, id := range p.ids {
+		invalidate(id)
+	}
+	p.ids = nil
+}
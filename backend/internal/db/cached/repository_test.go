@@ -0,0 +1,99 @@
+package cached
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	dbmocks "github.com/nicolas-martin/dankfolio/backend/internal/db/mocks"
+	"github.com/nicolas-martin/dankfolio/backend/internal/events"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+// waitForCache lets the cache admit its pending writes
+func waitForCache() { time.Sleep(10 * time.Millisecond) }
+
+func TestRepository_CachesPointReadsUntilWritten(t *testing.T) {
+	ctx := context.Background()
+	inner := dbmocks.NewMockRepository[model.Coin](t)
+	inner.EXPECT().GetByField(mock.Anything, "address", "mint").Return(&model.Coin{Address: "mint", Name: "Old"}, nil).Once()
+	repo, err := NewRepository[model.Coin](inner, "test_coins", time.Minute)
+	require.NoError(t, err)
+
+	for range 2 {
+		coin, err := repo.GetByField(ctx, "address", "mint")
+		require.NoError(t, err)
+		assert.Equal(t, "Old", coin.Name)
+		waitForCache()
+	}
+	assert.EqualValues(t, 1, repo.Stats().Hits)
+
+	updated := model.Coin{Address: "mint", Name: "New"}
+	inner.EXPECT().Update(mock.Anything, &updated).Return(nil).Once()
+	require.NoError(t, repo.Update(ctx, &updated))
+
+	inner.EXPECT().GetByField(mock.Anything, "address", "mint").Return(&updated, nil).Once()
+	coin, err := repo.GetByField(ctx, "address", "mint")
+	require.NoError(t, err)
+	assert.Equal(t, "New", coin.Name, "a write drops the entity's entries")
+}
+
+func TestRepository_InvalidateOn(t *testing.T) {
+	ctx := context.Background()
+	inner := dbmocks.NewMockRepository[model.Coin](t)
+	inner.EXPECT().GetByField(mock.Anything, "address", "mint").Return(&model.Coin{Address: "mint"}, nil).Twice()
+	repo, err := NewRepository[model.Coin](inner, "test_coins_bus", time.Minute)
+	require.NoError(t, err)
+
+	bus := events.NewMemoryBus(0)
+	defer bus.Close()
+	invalidated := make(chan struct{}, 1)
+	defer repo.InvalidateOn(bus, func(events.Event) string {
+		defer func() { invalidated <- struct{}{} }()
+		return "mint"
+	}, events.CoinEnriched)()
+
+	_, err = repo.GetByField(ctx, "address", "mint")
+	require.NoError(t, err)
+	waitForCache()
+
+	bus.Publish(ctx, events.NewCoinEvent(events.CoinEnriched, model.Coin{Address: "mint"}))
+	<-invalidated
+	_, err = repo.GetByField(ctx, "address", "mint")
+	require.NoError(t, err, "the event dropped the entry, so the store is read again")
+}
+
+func TestStore_TransactionsSkipTheCache(t *testing.T) {
+	ctx := context.Background()
+	coins := dbmocks.NewMockRepository[model.Coin](t)
+	coins.EXPECT().GetByField(mock.Anything, "address", "mint").Return(&model.Coin{Address: "mint"}, nil).Times(3)
+	coins.EXPECT().Create(mock.Anything, mock.Anything).Return(nil).Once()
+	inner := dbmocks.NewMockStore(t)
+	inner.EXPECT().Coins().Return(coins)
+	inner.EXPECT().Settings().Return(dbmocks.NewMockRepository[model.Setting](t))
+	inner.EXPECT().NaughtyWords().Return(dbmocks.NewMockRepository[model.NaughtyWord](t))
+	inner.EXPECT().WithTransaction(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, fn func(db.Store) error) error {
+		return fn(inner)
+	})
+	store, err := NewStore(inner, time.Minute)
+	require.NoError(t, err)
+
+	_, err = store.Coins().GetByField(ctx, "address", "mint")
+	require.NoError(t, err)
+	waitForCache()
+
+	require.NoError(t, store.WithTransaction(ctx, func(tx db.Store) error {
+		if _, err := tx.Coins().GetByField(ctx, "address", "mint"); err != nil {
+			return err
+		}
+		return tx.Coins().Create(ctx, &model.Coin{Address: "mint"})
+	}))
+
+	_, err = store.Coins().GetByField(ctx, "address", "mint")
+	require.NoError(t, err, "the coin written in the transaction is read again")
+}
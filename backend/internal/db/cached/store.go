@@ -0,0 +1,93 @@
+package cached
+
+import (
+	"context"
+	"time"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/cache"
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/events"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+// Store caches the point reads of the coins, settings and naughty words
+// repositories of a db.Store. Its store methods that write coins drop them
+// too.
+type Store struct {
+	db.Store
+	coins        *Repository[model.Coin]
+	settings     *Repository[model.Setting]
+	naughtyWords *Repository[model.NaughtyWord]
+}
+
+// NewStore caches the reads of store for ttl, DefaultTTL when zero
+func NewStore(store db.Store, ttl time.Duration) (*Store, error) {
+	s := &Store{Store: store}
+	var err error
+	if s.coins, err = NewRepository(store.Coins(), "repo_coins", ttl); err != nil {
+		return nil, err
+	}
+	if s.settings, err = NewRepository(store.Settings(), "repo_settings", ttl); err != nil {
+		return nil, err
+	}
+	if s.naughtyWords, err = NewRepository(store.NaughtyWords(), "repo_naughty_words", ttl); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *Store) Coins() db.Repository[model.Coin]               { return s.coins }
+func (s *Store) Settings() db.Repository[model.Setting]         { return s.settings }
+func (s *Store) NaughtyWords() db.Repository[model.NaughtyWord] { return s.naughtyWords }
+
+// Caches returns the repository caches, for cache summaries
+func (s *Store) Caches() []cache.StatsReporter {
+	return []cache.StatsReporter{s.coins, s.settings, s.naughtyWords}
+}
+
+// WatchInvalidations drops cached reads on the events bus carries for writes
+// made elsewhere, e.g. by other replicas sharing it
+func (s *Store) WatchInvalidations(bus events.Bus) (unsubscribe func()) {
+	unsubscribeCoins := s.coins.InvalidateOn(bus, func(event events.Event) string {
+		if payload, ok := event.Payload.(events.CoinPayload); ok {
+			return payload.Coin.Address
+		}
+		return ""
+	}, events.CoinDiscovered, events.CoinEnriched, events.CoinTrending)
+	unsubscribeWords := s.naughtyWords.InvalidateOn(bus, func(events.Event) string { return "" }, events.NaughtyWordsChanged)
+	return func() {
+		unsubscribeCoins()
+		unsubscribeWords()
+	}
+}
+
+// WithTransaction runs fn with the transaction's repositories, whose reads
+// skip the caches. The entities written in it are dropped from the caches
+// again once it ends.
+func (s *Store) WithTransaction(ctx context.Context, fn func(db.Store) error) error {
+	coins, settings, naughtyWords := &pending{}, &pending{}, &pending{}
+	defer func() {
+		coins.flush(s.coins.Invalidate)
+		settings.flush(s.settings.Invalidate)
+		naughtyWords.flush(s.naughtyWords.Invalidate)
+	}()
+	return s.Store.WithTransaction(ctx, func(txStore db.Store) error {
+		return fn(&Store{
+			Store:        txStore,
+			coins:        s.coins.inTransaction(txStore.Coins(), coins),
+			settings:     s.settings.inTransaction(txStore.Settings(), settings),
+			naughtyWords: s.naughtyWords.inTransaction(txStore.NaughtyWords(), naughtyWords),
+		})
+	})
+}
+
+func (s *Store) SetCoinLogoSource(ctx context.Context, address, sourceURL string) error {
+	defer s.coins.written(address)
+	return s.Store.SetCoinLogoSource(ctx, address, sourceURL)
+}
+
+func (s *Store) ArchiveInactiveCoins(ctx context.Context, criteria db.ArchiveCriteria) ([]string, error) {
+	archived, err := s.Store.ArchiveInactiveCoins(ctx, criteria)
+	s.coins.written(archived...)
+	return archived, err
+}
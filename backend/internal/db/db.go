@@ -3,6 +3,7 @@ package db
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/nicolas-martin/dankfolio/backend/internal/model"
 )
@@ -10,6 +11,9 @@ import (
 // Predefined errors
 var (
 	ErrNotFound = errors.New("record not found")
+	// ErrNotSoftDeletable is returned by the soft delete operations of
+	// repositories whose entities are deleted outright
+	ErrNotSoftDeletable = errors.New("entity is not soft-deletable")
 )
 
 // Entity represents a storable entity with an ID
@@ -24,15 +28,72 @@ type Store interface {
 	Trades() Repository[model.Trade]
 	Wallet() Repository[model.Wallet]
 	NaughtyWords() Repository[model.NaughtyWord]
+	WebhookSubscriptions() Repository[model.WebhookSubscription]
+	WebhookDeadLetters() Repository[model.WebhookDeadLetter]
+	JobCheckpoints() Repository[model.JobCheckpoint]
+	JobRuns() Repository[model.JobRun]
+	Settings() Repository[model.Setting]
+	FeatureFlags() Repository[model.FeatureFlag]
+	SpamTokens() Repository[model.SpamToken]
+	BlockedMints() Repository[model.BlockedMint]
+	CoinDescriptions() Repository[model.CoinDescription]
+	PaymentRequests() Repository[model.PaymentRequest]
+	BurnWatches() Repository[model.BurnWatch]
+	BurnEvents() Repository[model.BurnEvent]
+	MintAuthorities() Repository[model.MintAuthority]
+	AuthorityChanges() Repository[model.AuthorityChange]
+	CoinRevisions() Repository[model.CoinRevision]
+	CoinOverrides() Repository[model.CoinOverride]
+	ImageHashes() Repository[model.ImageHash]
+	PlaceholderIcons() Repository[model.PlaceholderIcon]
+	SearchSynonyms() Repository[model.SearchSynonym]
+	CoinViews() Repository[model.CoinView]
+	PaperBalances() Repository[model.PaperBalance]
+	PaperTrades() Repository[model.PaperTrade]
+	FeeLedger() Repository[model.FeeLedgerEntry]
+	TwapOrders() Repository[model.TwapOrder]
+	TwapSlices() Repository[model.TwapSlice]
+	AuditLog() Repository[model.AuditEntry]
+	TradeLimits() Repository[model.TradeLimit]
+	TradeVolumes() Repository[model.TradeVolume]
+	NotificationPreferences() Repository[model.NotificationPreferences]
+	NotificationDeliveries() Repository[model.NotificationDelivery]
+	Announcements() Repository[model.Announcement]
+	AnnouncementReads() Repository[model.AnnouncementRead]
+	MEVIncidents() Repository[model.MEVIncident]
+	DataDeletions() Repository[model.DataDeletion]
+	DeletionCertificates() Repository[model.DeletionCertificate]
 
 	// Custom operations
 	ListTrendingCoins(ctx context.Context, opts ListOptions) ([]model.Coin, int32, error)
 	SearchCoins(ctx context.Context, query string, tags []string, minVolume24h float64, limit, offset int32, sortBy string, sortDesc bool) ([]model.Coin, error)
 	ListNewestCoins(ctx context.Context, opts ListOptions) ([]model.Coin, int32, error)
 	ListTopGainersCoins(ctx context.Context, opts ListOptions) ([]model.Coin, int32, error)
+	GetMarketTotals(ctx context.Context, excludeTags []string, listedSince time.Time) (*model.MarketTotals, error)
+	SetCoinLogoSource(ctx context.Context, address, sourceURL string) error
+
+	// Archival of inactive coins
+	ArchiveInactiveCoins(ctx context.Context, criteria ArchiveCriteria) ([]string, error)
+	SearchArchivedCoins(ctx context.Context, query string, limit, offset int32) ([]model.Coin, error)
+
+	// Price history
+	UpsertPricePoints(ctx context.Context, points []model.PricePoint) error
+	ListPricePoints(ctx context.Context, address string, maxResolution time.Duration, from, to time.Time) ([]model.PricePoint, error)
+	AddPriceHistoryRange(ctx context.Context, r model.PriceHistoryRange) error
+	ListPriceHistoryRanges(ctx context.Context, address string, maxResolution time.Duration, from, to time.Time) ([]model.PriceHistoryRange, error)
+	DownsamplePricePoints(ctx context.Context, from, to time.Duration, before time.Time) (int64, error)
+
+	// TWAP execution
+	ClaimTwapSlices(ctx context.Context, claim TwapClaim) ([]model.TwapSlice, error)
+	SaveClaimedTwapSlice(ctx context.Context, slice *model.TwapSlice) (bool, error)
+
+	// Trade limits
+	AddTradeVolume(ctx context.Context, wallet string, day time.Time, notionalUSD float64) error
 
 	// Account management
 	DeleteAccount(ctx context.Context, walletPublicKey string) error
+	ErasePersonalData(ctx context.Context, subject DataSubject) (map[string]int64, error) // Rows erased per category
+	CountPersonalData(ctx context.Context, subject DataSubject) (map[string]int64, error) // Rows left per category
 
 	// Transaction management
 	WithTransaction(ctx context.Context, fn func(s Store) error) error
@@ -46,8 +107,12 @@ type Repository[T Entity] interface {
 	Update(ctx context.Context, item *T) error
 	Upsert(ctx context.Context, item *T) (int64, error)
 	BulkUpsert(ctx context.Context, items *[]T) (int64, error)
+	BulkWrite(ctx context.Context, items *[]T, opts BulkWriteOptions) (int64, error)
 	Delete(ctx context.Context, id string) error
-	HardDelete(ctx context.Context, id string) error // Permanently delete without soft delete
+	HardDelete(ctx context.Context, id string) error                          // Permanently delete without soft delete
+	SoftDelete(ctx context.Context, id string, deletion model.Deletion) error // Hide from reads, recording who deleted it and why
+	Restore(ctx context.Context, id string) error                             // Undo a soft delete
+	PurgeDeleted(ctx context.Context, before time.Time) (int64, error)        // Permanently delete entities soft-deleted before
 	GetByField(ctx context.Context, field string, value any) (*T, error)
 	GetByAddresses(ctx context.Context, addresses []string) ([]T, error)    // Get multiple entities by address field
 	ListWithOpts(ctx context.Context, opts ListOptions) ([]T, int32, error) // Returns entities and total count
@@ -86,4 +151,49 @@ type ListOptions struct {
 	SortBy   *string        // Field name to sort by (e.g., "volume_24h", "created_at")
 	SortDesc *bool          // True for descending sort, false for ascending
 	Filters  []FilterOption // Slice of filter conditions to apply
+	Deleted  bool           // List only soft-deleted entities instead of live ones
+}
+
+// DefaultBulkBatchSize is how many rows BulkWrite puts in one INSERT statement
+const DefaultBulkBatchSize = 500
+
+// BulkWriteOptions tunes a BulkWrite. The zero value upserts on the
+// entity's key, updating the columns BulkUpsert does, DefaultBulkBatchSize
+// rows at a time.
+type BulkWriteOptions struct {
+	BatchSize       int      // Rows per INSERT statement
+	ConflictColumns []string // Columns a row conflicts on; the entity's key when empty
+	UpdateColumns   []string // Columns overwritten on conflict; every updatable column when empty
+	IgnoreConflicts bool     // Leave conflicting rows as they are instead of updating them
+	Returning       []string // Columns read back into the items, e.g. a generated "id"
+}
+
+// TwapClaim leases the TWAP slices due at Now to Worker for Lease. A slice
+// leased to a worker that stopped before saving it is claimed again once the
+// lease runs out.
+type TwapClaim struct {
+	Worker string
+	Now    time.Time
+	Lease  time.Duration
+	Limit  int
+}
+
+// ArchiveCriteria selects the coins ArchiveInactiveCoins moves out of the coins table.
+// A coin qualifies when its volume and liquidity are at or below the maximums
+// and it has been neither updated nor traded since InactiveSince.
+type ArchiveCriteria struct {
+	InactiveSince   time.Time
+	MaxVolume24hUSD float64
+	MaxLiquidity    float64
+	ExcludeTags     []string // Coins carrying any of these tags are kept
+	ExcludeMints    []string // Coins that are always kept, e.g. SOL and stablecoins
+	Limit           int      // Maximum coins to archive in one call; 0 for no limit
+	DryRun          bool     // Only report the coins that would be archived
+}
+
+// DataSubject identifies whose personal data is erased: the rows keyed by a
+// wallet address, and the coin views keyed by the wallet's viewer ID.
+type DataSubject struct {
+	WalletAddress string
+	ViewerID      string
 }
@@ -0,0 +1,139 @@
+// Package dbtest runs repository tests against a real PostgreSQL. A single
+// container is started per test binary via testcontainers; each test gets its
+// own migrated schema, so tests can run in parallel without cleaning up after
+// each other.
+//
+// Set DBTEST_URL to a postgres:// URL to use an existing server instead of a
+// container. Without either, tests using NewStore are skipped.
+package dbtest
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go"
+	tcpostgres "github.com/testcontainers/testcontainers-go/modules/postgres"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	pgstore "github.com/nicolas-martin/dankfolio/backend/internal/db/postgres"
+)
+
+// Image is the postgres image the shared container runs
+const Image = "postgres:16-alpine"
+
+var (
+	startOnce sync.Once
+	container *tcpostgres.PostgresContainer
+	baseURL   string
+	startErr  error
+)
+
+// Main runs the package's tests and stops the shared container afterwards.
+// Call it from TestMain; without it the container is reaped when the test
+// binary exits.
+func Main(m *testing.M) {
+	code := m.Run()
+	if container != nil {
+		_ = testcontainers.TerminateContainer(container)
+	}
+	os.Exit(code)
+}
+
+// NewStore returns a store backed by a fresh, migrated schema that is dropped
+// when the test ends
+func NewStore(t *testing.T) *pgstore.Store {
+	t.Helper()
+
+	base := serverURL(t)
+	ctx := context.Background()
+
+	admin, err := open(base)
+	if err != nil {
+		t.Fatalf("dbtest: failed to connect: %v", err)
+	}
+	schema := schemaName()
+	if err := admin.WithContext(ctx).Exec("CREATE SCHEMA " + schema).Error; err != nil {
+		t.Fatalf("dbtest: failed to create schema %s: %v", schema, err)
+	}
+
+	dsn, err := withSearchPath(base, schema)
+	if err != nil {
+		t.Fatalf("dbtest: %v", err)
+	}
+	database, err := open(dsn)
+	if err != nil {
+		t.Fatalf("dbtest: failed to connect to schema %s: %v", schema, err)
+	}
+	if err := pgstore.Migrate(database); err != nil {
+		t.Fatalf("dbtest: %v", err)
+	}
+
+	store := pgstore.NewStoreWithDB(database)
+	t.Cleanup(func() {
+		_ = store.Close()
+		_ = admin.Exec("DROP SCHEMA " + schema + " CASCADE").Error
+		if sqlDB, err := admin.DB(); err == nil {
+			_ = sqlDB.Close()
+		}
+	})
+	return store
+}
+
+// serverURL returns DBTEST_URL or starts the shared container on first use
+func serverURL(t *testing.T) string {
+	t.Helper()
+	if u := os.Getenv("DBTEST_URL"); u != "" {
+		return u
+	}
+
+	testcontainers.SkipIfProviderIsNotHealthy(t)
+	startOnce.Do(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+		defer cancel()
+		container, startErr = tcpostgres.Run(ctx, Image,
+			tcpostgres.WithDatabase("dankfolio_test"),
+			tcpostgres.WithUsername("dankfolio"),
+			tcpostgres.WithPassword("dankfolio"),
+			tcpostgres.BasicWaitStrategies(),
+		)
+		if startErr != nil {
+			return
+		}
+		baseURL, startErr = container.ConnectionString(ctx, "sslmode=disable")
+	})
+	if startErr != nil {
+		t.Fatalf("dbtest: failed to start postgres container: %v", startErr)
+	}
+	return baseURL
+}
+
+func open(dsn string) (*gorm.DB, error) {
+	return gorm.Open(postgres.Open(dsn), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+}
+
+// withSearchPath points every connection opened from dsn at schema
+func withSearchPath(dsn, schema string) (string, error) {
+	u, err := url.Parse(dsn)
+	if err != nil {
+		return "", fmt.Errorf("DBTEST_URL must be a postgres:// URL: %w", err)
+	}
+	q := u.Query()
+	q.Set("search_path", schema)
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
+func schemaName() string {
+	b := make([]byte, 6)
+	_, _ = rand.Read(b)
+	return "test_" + hex.EncodeToString(b)
+}
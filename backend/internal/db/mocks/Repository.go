@@ -6,8 +6,10 @@ package dbmocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -104,6 +106,78 @@ func (_c *MockRepository_BulkUpsert_Call[T]) RunAndReturn(run func(ctx context.C
 	return _c
 }
 
+// BulkWrite provides a mock function for the type MockRepository
+func (_mock *MockRepository[T]) BulkWrite(ctx context.Context, items *[]T, opts db.BulkWriteOptions) (int64, error) {
+	ret := _mock.Called(ctx, items, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkWrite")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *[]T, db.BulkWriteOptions) (int64, error)); ok {
+		return returnFunc(ctx, items, opts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *[]T, db.BulkWriteOptions) int64); ok {
+		r0 = returnFunc(ctx, items, opts)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *[]T, db.BulkWriteOptions) error); ok {
+		r1 = returnFunc(ctx, items, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_BulkWrite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkWrite'
+type MockRepository_BulkWrite_Call[T db.Entity] struct {
+	*mock.Call
+}
+
+// BulkWrite is a helper method to define mock.On call
+//   - ctx context.Context
+//   - items *[]T
+//   - opts db.BulkWriteOptions
+func (_e *MockRepository_Expecter[T]) BulkWrite(ctx interface{}, items interface{}, opts interface{}) *MockRepository_BulkWrite_Call[T] {
+	return &MockRepository_BulkWrite_Call[T]{Call: _e.mock.On("BulkWrite", ctx, items, opts)}
+}
+
+func (_c *MockRepository_BulkWrite_Call[T]) Run(run func(ctx context.Context, items *[]T, opts db.BulkWriteOptions)) *MockRepository_BulkWrite_Call[T] {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *[]T
+		if args[1] != nil {
+			arg1 = args[1].(*[]T)
+		}
+		var arg2 db.BulkWriteOptions
+		if args[2] != nil {
+			arg2 = args[2].(db.BulkWriteOptions)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_BulkWrite_Call[T]) Return(n int64, err error) *MockRepository_BulkWrite_Call[T] {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockRepository_BulkWrite_Call[T]) RunAndReturn(run func(ctx context.Context, items *[]T, opts db.BulkWriteOptions) (int64, error)) *MockRepository_BulkWrite_Call[T] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function for the type MockRepository
 func (_mock *MockRepository[T]) Create(ctx context.Context, item *T) error {
 	ret := _mock.Called(ctx, item)
@@ -633,6 +707,192 @@ func (_c *MockRepository_ListWithOpts_Call[T]) RunAndReturn(run func(ctx context
 	return _c
 }
 
+// PurgeDeleted provides a mock function for the type MockRepository
+func (_mock *MockRepository[T]) PurgeDeleted(ctx context.Context, before time.Time) (int64, error) {
+	ret := _mock.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeDeleted")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) (int64, error)); ok {
+		return returnFunc(ctx, before)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = returnFunc(ctx, before)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = returnFunc(ctx, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_PurgeDeleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeDeleted'
+type MockRepository_PurgeDeleted_Call[T db.Entity] struct {
+	*mock.Call
+}
+
+// PurgeDeleted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+func (_e *MockRepository_Expecter[T]) PurgeDeleted(ctx interface{}, before interface{}) *MockRepository_PurgeDeleted_Call[T] {
+	return &MockRepository_PurgeDeleted_Call[T]{Call: _e.mock.On("PurgeDeleted", ctx, before)}
+}
+
+func (_c *MockRepository_PurgeDeleted_Call[T]) Run(run func(ctx context.Context, before time.Time)) *MockRepository_PurgeDeleted_Call[T] {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_PurgeDeleted_Call[T]) Return(n int64, err error) *MockRepository_PurgeDeleted_Call[T] {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockRepository_PurgeDeleted_Call[T]) RunAndReturn(run func(ctx context.Context, before time.Time) (int64, error)) *MockRepository_PurgeDeleted_Call[T] {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function for the type MockRepository
+func (_mock *MockRepository[T]) Restore(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type MockRepository_Restore_Call[T db.Entity] struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockRepository_Expecter[T]) Restore(ctx interface{}, id interface{}) *MockRepository_Restore_Call[T] {
+	return &MockRepository_Restore_Call[T]{Call: _e.mock.On("Restore", ctx, id)}
+}
+
+func (_c *MockRepository_Restore_Call[T]) Run(run func(ctx context.Context, id string)) *MockRepository_Restore_Call[T] {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_Restore_Call[T]) Return(err error) *MockRepository_Restore_Call[T] {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_Restore_Call[T]) RunAndReturn(run func(ctx context.Context, id string) error) *MockRepository_Restore_Call[T] {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SoftDelete provides a mock function for the type MockRepository
+func (_mock *MockRepository[T]) SoftDelete(ctx context.Context, id string, deletion model.Deletion) error {
+	ret := _mock.Called(ctx, id, deletion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SoftDelete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, model.Deletion) error); ok {
+		r0 = returnFunc(ctx, id, deletion)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_SoftDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SoftDelete'
+type MockRepository_SoftDelete_Call[T db.Entity] struct {
+	*mock.Call
+}
+
+// SoftDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - deletion model.Deletion
+func (_e *MockRepository_Expecter[T]) SoftDelete(ctx interface{}, id interface{}, deletion interface{}) *MockRepository_SoftDelete_Call[T] {
+	return &MockRepository_SoftDelete_Call[T]{Call: _e.mock.On("SoftDelete", ctx, id, deletion)}
+}
+
+func (_c *MockRepository_SoftDelete_Call[T]) Run(run func(ctx context.Context, id string, deletion model.Deletion)) *MockRepository_SoftDelete_Call[T] {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 model.Deletion
+		if args[2] != nil {
+			arg2 = args[2].(model.Deletion)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_SoftDelete_Call[T]) Return(err error) *MockRepository_SoftDelete_Call[T] {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_SoftDelete_Call[T]) RunAndReturn(run func(ctx context.Context, id string, deletion model.Deletion) error) *MockRepository_SoftDelete_Call[T] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function for the type MockRepository
 func (_mock *MockRepository[T]) Update(ctx context.Context, item *T) error {
 	ret := _mock.Called(ctx, item)
@@ -6,6 +6,7 @@ package dbmocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/nicolas-martin/dankfolio/backend/internal/db"
 	"github.com/nicolas-martin/dankfolio/backend/internal/model"
@@ -39,82 +40,95 @@ func (_m *MockStore) EXPECT() *MockStore_Expecter {
 	return &MockStore_Expecter{mock: &_m.Mock}
 }
 
-// Coins provides a mock function for the type MockStore
-func (_mock *MockStore) Coins() db.Repository[model.Coin] {
-	ret := _mock.Called()
+// AddPriceHistoryRange provides a mock function for the type MockStore
+func (_mock *MockStore) AddPriceHistoryRange(ctx context.Context, r model.PriceHistoryRange) error {
+	ret := _mock.Called(ctx, r)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Coins")
+		panic("no return value specified for AddPriceHistoryRange")
 	}
 
-	var r0 db.Repository[model.Coin]
-	if returnFunc, ok := ret.Get(0).(func() db.Repository[model.Coin]); ok {
-		r0 = returnFunc()
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.PriceHistoryRange) error); ok {
+		r0 = returnFunc(ctx, r)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(db.Repository[model.Coin])
-		}
+		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockStore_Coins_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Coins'
-type MockStore_Coins_Call struct {
+// MockStore_AddPriceHistoryRange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddPriceHistoryRange'
+type MockStore_AddPriceHistoryRange_Call struct {
 	*mock.Call
 }
 
-// Coins is a helper method to define mock.On call
-func (_e *MockStore_Expecter) Coins() *MockStore_Coins_Call {
-	return &MockStore_Coins_Call{Call: _e.mock.On("Coins")}
+// AddPriceHistoryRange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - r model.PriceHistoryRange
+func (_e *MockStore_Expecter) AddPriceHistoryRange(ctx interface{}, r interface{}) *MockStore_AddPriceHistoryRange_Call {
+	return &MockStore_AddPriceHistoryRange_Call{Call: _e.mock.On("AddPriceHistoryRange", ctx, r)}
 }
 
-func (_c *MockStore_Coins_Call) Run(run func()) *MockStore_Coins_Call {
+func (_c *MockStore_AddPriceHistoryRange_Call) Run(run func(ctx context.Context, r model.PriceHistoryRange)) *MockStore_AddPriceHistoryRange_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.PriceHistoryRange
+		if args[1] != nil {
+			arg1 = args[1].(model.PriceHistoryRange)
+		}
+		run(
+			arg0,
+			arg1,
+		)
 	})
 	return _c
 }
 
-func (_c *MockStore_Coins_Call) Return(repository db.Repository[model.Coin]) *MockStore_Coins_Call {
-	_c.Call.Return(repository)
+func (_c *MockStore_AddPriceHistoryRange_Call) Return(err error) *MockStore_AddPriceHistoryRange_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStore_Coins_Call) RunAndReturn(run func() db.Repository[model.Coin]) *MockStore_Coins_Call {
+func (_c *MockStore_AddPriceHistoryRange_Call) RunAndReturn(run func(ctx context.Context, r model.PriceHistoryRange) error) *MockStore_AddPriceHistoryRange_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteAccount provides a mock function for the type MockStore
-func (_mock *MockStore) DeleteAccount(ctx context.Context, walletPublicKey string) error {
-	ret := _mock.Called(ctx, walletPublicKey)
+// AddTradeVolume provides a mock function for the type MockStore
+func (_mock *MockStore) AddTradeVolume(ctx context.Context, wallet string, day time.Time, notionalUSD float64) error {
+	ret := _mock.Called(ctx, wallet, day, notionalUSD)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteAccount")
+		panic("no return value specified for AddTradeVolume")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = returnFunc(ctx, walletPublicKey)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time, float64) error); ok {
+		r0 = returnFunc(ctx, wallet, day, notionalUSD)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockStore_DeleteAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAccount'
-type MockStore_DeleteAccount_Call struct {
+// MockStore_AddTradeVolume_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddTradeVolume'
+type MockStore_AddTradeVolume_Call struct {
 	*mock.Call
 }
 
-// DeleteAccount is a helper method to define mock.On call
+// AddTradeVolume is a helper method to define mock.On call
 //   - ctx context.Context
-//   - walletPublicKey string
-func (_e *MockStore_Expecter) DeleteAccount(ctx interface{}, walletPublicKey interface{}) *MockStore_DeleteAccount_Call {
-	return &MockStore_DeleteAccount_Call{Call: _e.mock.On("DeleteAccount", ctx, walletPublicKey)}
+//   - wallet string
+//   - day time.Time
+//   - notionalUSD float64
+func (_e *MockStore_Expecter) AddTradeVolume(ctx interface{}, wallet interface{}, day interface{}, notionalUSD interface{}) *MockStore_AddTradeVolume_Call {
+	return &MockStore_AddTradeVolume_Call{Call: _e.mock.On("AddTradeVolume", ctx, wallet, day, notionalUSD)}
 }
 
-func (_c *MockStore_DeleteAccount_Call) Run(run func(ctx context.Context, walletPublicKey string)) *MockStore_DeleteAccount_Call {
+func (_c *MockStore_AddTradeVolume_Call) Run(run func(ctx context.Context, wallet string, day time.Time, notionalUSD float64)) *MockStore_AddTradeVolume_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -124,227 +138,175 @@ func (_c *MockStore_DeleteAccount_Call) Run(run func(ctx context.Context, wallet
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 float64
+		if args[3] != nil {
+			arg3 = args[3].(float64)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStore_DeleteAccount_Call) Return(err error) *MockStore_DeleteAccount_Call {
+func (_c *MockStore_AddTradeVolume_Call) Return(err error) *MockStore_AddTradeVolume_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStore_DeleteAccount_Call) RunAndReturn(run func(ctx context.Context, walletPublicKey string) error) *MockStore_DeleteAccount_Call {
+func (_c *MockStore_AddTradeVolume_Call) RunAndReturn(run func(ctx context.Context, wallet string, day time.Time, notionalUSD float64) error) *MockStore_AddTradeVolume_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListNewestCoins provides a mock function for the type MockStore
-func (_mock *MockStore) ListNewestCoins(ctx context.Context, opts db.ListOptions) ([]model.Coin, int32, error) {
-	ret := _mock.Called(ctx, opts)
+// AnnouncementReads provides a mock function for the type MockStore
+func (_mock *MockStore) AnnouncementReads() db.Repository[model.AnnouncementRead] {
+	ret := _mock.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListNewestCoins")
+		panic("no return value specified for AnnouncementReads")
 	}
 
-	var r0 []model.Coin
-	var r1 int32
-	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, db.ListOptions) ([]model.Coin, int32, error)); ok {
-		return returnFunc(ctx, opts)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, db.ListOptions) []model.Coin); ok {
-		r0 = returnFunc(ctx, opts)
+	var r0 db.Repository[model.AnnouncementRead]
+	if returnFunc, ok := ret.Get(0).(func() db.Repository[model.AnnouncementRead]); ok {
+		r0 = returnFunc()
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]model.Coin)
+			r0 = ret.Get(0).(db.Repository[model.AnnouncementRead])
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, db.ListOptions) int32); ok {
-		r1 = returnFunc(ctx, opts)
-	} else {
-		r1 = ret.Get(1).(int32)
-	}
-	if returnFunc, ok := ret.Get(2).(func(context.Context, db.ListOptions) error); ok {
-		r2 = returnFunc(ctx, opts)
-	} else {
-		r2 = ret.Error(2)
-	}
-	return r0, r1, r2
+	return r0
 }
 
-// MockStore_ListNewestCoins_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListNewestCoins'
-type MockStore_ListNewestCoins_Call struct {
+// MockStore_AnnouncementReads_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AnnouncementReads'
+type MockStore_AnnouncementReads_Call struct {
 	*mock.Call
 }
 
-// ListNewestCoins is a helper method to define mock.On call
-//   - ctx context.Context
-//   - opts db.ListOptions
-func (_e *MockStore_Expecter) ListNewestCoins(ctx interface{}, opts interface{}) *MockStore_ListNewestCoins_Call {
-	return &MockStore_ListNewestCoins_Call{Call: _e.mock.On("ListNewestCoins", ctx, opts)}
+// AnnouncementReads is a helper method to define mock.On call
+func (_e *MockStore_Expecter) AnnouncementReads() *MockStore_AnnouncementReads_Call {
+	return &MockStore_AnnouncementReads_Call{Call: _e.mock.On("AnnouncementReads")}
 }
 
-func (_c *MockStore_ListNewestCoins_Call) Run(run func(ctx context.Context, opts db.ListOptions)) *MockStore_ListNewestCoins_Call {
+func (_c *MockStore_AnnouncementReads_Call) Run(run func()) *MockStore_AnnouncementReads_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 context.Context
-		if args[0] != nil {
-			arg0 = args[0].(context.Context)
-		}
-		var arg1 db.ListOptions
-		if args[1] != nil {
-			arg1 = args[1].(db.ListOptions)
-		}
-		run(
-			arg0,
-			arg1,
-		)
+		run()
 	})
 	return _c
 }
 
-func (_c *MockStore_ListNewestCoins_Call) Return(coins []model.Coin, n int32, err error) *MockStore_ListNewestCoins_Call {
-	_c.Call.Return(coins, n, err)
+func (_c *MockStore_AnnouncementReads_Call) Return(repository db.Repository[model.AnnouncementRead]) *MockStore_AnnouncementReads_Call {
+	_c.Call.Return(repository)
 	return _c
 }
 
-func (_c *MockStore_ListNewestCoins_Call) RunAndReturn(run func(ctx context.Context, opts db.ListOptions) ([]model.Coin, int32, error)) *MockStore_ListNewestCoins_Call {
+func (_c *MockStore_AnnouncementReads_Call) RunAndReturn(run func() db.Repository[model.AnnouncementRead]) *MockStore_AnnouncementReads_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListTopGainersCoins provides a mock function for the type MockStore
-func (_mock *MockStore) ListTopGainersCoins(ctx context.Context, opts db.ListOptions) ([]model.Coin, int32, error) {
-	ret := _mock.Called(ctx, opts)
+// Announcements provides a mock function for the type MockStore
+func (_mock *MockStore) Announcements() db.Repository[model.Announcement] {
+	ret := _mock.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListTopGainersCoins")
+		panic("no return value specified for Announcements")
 	}
 
-	var r0 []model.Coin
-	var r1 int32
-	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, db.ListOptions) ([]model.Coin, int32, error)); ok {
-		return returnFunc(ctx, opts)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, db.ListOptions) []model.Coin); ok {
-		r0 = returnFunc(ctx, opts)
+	var r0 db.Repository[model.Announcement]
+	if returnFunc, ok := ret.Get(0).(func() db.Repository[model.Announcement]); ok {
+		r0 = returnFunc()
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]model.Coin)
+			r0 = ret.Get(0).(db.Repository[model.Announcement])
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, db.ListOptions) int32); ok {
-		r1 = returnFunc(ctx, opts)
-	} else {
-		r1 = ret.Get(1).(int32)
-	}
-	if returnFunc, ok := ret.Get(2).(func(context.Context, db.ListOptions) error); ok {
-		r2 = returnFunc(ctx, opts)
-	} else {
-		r2 = ret.Error(2)
-	}
-	return r0, r1, r2
+	return r0
 }
 
-// MockStore_ListTopGainersCoins_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTopGainersCoins'
-type MockStore_ListTopGainersCoins_Call struct {
+// MockStore_Announcements_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Announcements'
+type MockStore_Announcements_Call struct {
 	*mock.Call
 }
 
-// ListTopGainersCoins is a helper method to define mock.On call
-//   - ctx context.Context
-//   - opts db.ListOptions
-func (_e *MockStore_Expecter) ListTopGainersCoins(ctx interface{}, opts interface{}) *MockStore_ListTopGainersCoins_Call {
-	return &MockStore_ListTopGainersCoins_Call{Call: _e.mock.On("ListTopGainersCoins", ctx, opts)}
+// Announcements is a helper method to define mock.On call
+func (_e *MockStore_Expecter) Announcements() *MockStore_Announcements_Call {
+	return &MockStore_Announcements_Call{Call: _e.mock.On("Announcements")}
 }
 
-func (_c *MockStore_ListTopGainersCoins_Call) Run(run func(ctx context.Context, opts db.ListOptions)) *MockStore_ListTopGainersCoins_Call {
+func (_c *MockStore_Announcements_Call) Run(run func()) *MockStore_Announcements_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 context.Context
-		if args[0] != nil {
-			arg0 = args[0].(context.Context)
-		}
-		var arg1 db.ListOptions
-		if args[1] != nil {
-			arg1 = args[1].(db.ListOptions)
-		}
-		run(
-			arg0,
-			arg1,
-		)
+		run()
 	})
 	return _c
 }
 
-func (_c *MockStore_ListTopGainersCoins_Call) Return(coins []model.Coin, n int32, err error) *MockStore_ListTopGainersCoins_Call {
-	_c.Call.Return(coins, n, err)
+func (_c *MockStore_Announcements_Call) Return(repository db.Repository[model.Announcement]) *MockStore_Announcements_Call {
+	_c.Call.Return(repository)
 	return _c
 }
 
-func (_c *MockStore_ListTopGainersCoins_Call) RunAndReturn(run func(ctx context.Context, opts db.ListOptions) ([]model.Coin, int32, error)) *MockStore_ListTopGainersCoins_Call {
+func (_c *MockStore_Announcements_Call) RunAndReturn(run func() db.Repository[model.Announcement]) *MockStore_Announcements_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListTrendingCoins provides a mock function for the type MockStore
-func (_mock *MockStore) ListTrendingCoins(ctx context.Context, opts db.ListOptions) ([]model.Coin, int32, error) {
-	ret := _mock.Called(ctx, opts)
+// ArchiveInactiveCoins provides a mock function for the type MockStore
+func (_mock *MockStore) ArchiveInactiveCoins(ctx context.Context, criteria db.ArchiveCriteria) ([]string, error) {
+	ret := _mock.Called(ctx, criteria)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListTrendingCoins")
+		panic("no return value specified for ArchiveInactiveCoins")
 	}
 
-	var r0 []model.Coin
-	var r1 int32
-	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, db.ListOptions) ([]model.Coin, int32, error)); ok {
-		return returnFunc(ctx, opts)
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.ArchiveCriteria) ([]string, error)); ok {
+		return returnFunc(ctx, criteria)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, db.ListOptions) []model.Coin); ok {
-		r0 = returnFunc(ctx, opts)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.ArchiveCriteria) []string); ok {
+		r0 = returnFunc(ctx, criteria)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]model.Coin)
+			r0 = ret.Get(0).([]string)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, db.ListOptions) int32); ok {
-		r1 = returnFunc(ctx, opts)
-	} else {
-		r1 = ret.Get(1).(int32)
-	}
-	if returnFunc, ok := ret.Get(2).(func(context.Context, db.ListOptions) error); ok {
-		r2 = returnFunc(ctx, opts)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.ArchiveCriteria) error); ok {
+		r1 = returnFunc(ctx, criteria)
 	} else {
-		r2 = ret.Error(2)
+		r1 = ret.Error(1)
 	}
-	return r0, r1, r2
+	return r0, r1
 }
 
-// MockStore_ListTrendingCoins_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTrendingCoins'
-type MockStore_ListTrendingCoins_Call struct {
+// MockStore_ArchiveInactiveCoins_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ArchiveInactiveCoins'
+type MockStore_ArchiveInactiveCoins_Call struct {
 	*mock.Call
 }
 
-// ListTrendingCoins is a helper method to define mock.On call
+// ArchiveInactiveCoins is a helper method to define mock.On call
 //   - ctx context.Context
-//   - opts db.ListOptions
-func (_e *MockStore_Expecter) ListTrendingCoins(ctx interface{}, opts interface{}) *MockStore_ListTrendingCoins_Call {
-	return &MockStore_ListTrendingCoins_Call{Call: _e.mock.On("ListTrendingCoins", ctx, opts)}
+//   - criteria db.ArchiveCriteria
+func (_e *MockStore_Expecter) ArchiveInactiveCoins(ctx interface{}, criteria interface{}) *MockStore_ArchiveInactiveCoins_Call {
+	return &MockStore_ArchiveInactiveCoins_Call{Call: _e.mock.On("ArchiveInactiveCoins", ctx, criteria)}
 }
 
-func (_c *MockStore_ListTrendingCoins_Call) Run(run func(ctx context.Context, opts db.ListOptions)) *MockStore_ListTrendingCoins_Call {
+func (_c *MockStore_ArchiveInactiveCoins_Call) Run(run func(ctx context.Context, criteria db.ArchiveCriteria)) *MockStore_ArchiveInactiveCoins_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 db.ListOptions
+		var arg1 db.ArchiveCriteria
 		if args[1] != nil {
-			arg1 = args[1].(db.ListOptions)
+			arg1 = args[1].(db.ArchiveCriteria)
 		}
 		run(
 			arg0,
@@ -354,120 +316,2223 @@ func (_c *MockStore_ListTrendingCoins_Call) Run(run func(ctx context.Context, op
 	return _c
 }
 
-func (_c *MockStore_ListTrendingCoins_Call) Return(coins []model.Coin, n int32, err error) *MockStore_ListTrendingCoins_Call {
-	_c.Call.Return(coins, n, err)
+func (_c *MockStore_ArchiveInactiveCoins_Call) Return(strings []string, err error) *MockStore_ArchiveInactiveCoins_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *MockStore_ListTrendingCoins_Call) RunAndReturn(run func(ctx context.Context, opts db.ListOptions) ([]model.Coin, int32, error)) *MockStore_ListTrendingCoins_Call {
+func (_c *MockStore_ArchiveInactiveCoins_Call) RunAndReturn(run func(ctx context.Context, criteria db.ArchiveCriteria) ([]string, error)) *MockStore_ArchiveInactiveCoins_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NaughtyWords provides a mock function for the type MockStore
-func (_mock *MockStore) NaughtyWords() db.Repository[model.NaughtyWord] {
+// AuditLog provides a mock function for the type MockStore
+func (_mock *MockStore) AuditLog() db.Repository[model.AuditEntry] {
 	ret := _mock.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for NaughtyWords")
+		panic("no return value specified for AuditLog")
 	}
 
-	var r0 db.Repository[model.NaughtyWord]
-	if returnFunc, ok := ret.Get(0).(func() db.Repository[model.NaughtyWord]); ok {
+	var r0 db.Repository[model.AuditEntry]
+	if returnFunc, ok := ret.Get(0).(func() db.Repository[model.AuditEntry]); ok {
 		r0 = returnFunc()
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(db.Repository[model.NaughtyWord])
+			r0 = ret.Get(0).(db.Repository[model.AuditEntry])
 		}
 	}
 	return r0
 }
 
-// MockStore_NaughtyWords_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NaughtyWords'
-type MockStore_NaughtyWords_Call struct {
+// MockStore_AuditLog_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AuditLog'
+type MockStore_AuditLog_Call struct {
 	*mock.Call
 }
 
-// NaughtyWords is a helper method to define mock.On call
-func (_e *MockStore_Expecter) NaughtyWords() *MockStore_NaughtyWords_Call {
-	return &MockStore_NaughtyWords_Call{Call: _e.mock.On("NaughtyWords")}
+// AuditLog is a helper method to define mock.On call
+func (_e *MockStore_Expecter) AuditLog() *MockStore_AuditLog_Call {
+	return &MockStore_AuditLog_Call{Call: _e.mock.On("AuditLog")}
 }
 
-func (_c *MockStore_NaughtyWords_Call) Run(run func()) *MockStore_NaughtyWords_Call {
+func (_c *MockStore_AuditLog_Call) Run(run func()) *MockStore_AuditLog_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run()
 	})
 	return _c
 }
 
-func (_c *MockStore_NaughtyWords_Call) Return(repository db.Repository[model.NaughtyWord]) *MockStore_NaughtyWords_Call {
+func (_c *MockStore_AuditLog_Call) Return(repository db.Repository[model.AuditEntry]) *MockStore_AuditLog_Call {
 	_c.Call.Return(repository)
 	return _c
 }
 
-func (_c *MockStore_NaughtyWords_Call) RunAndReturn(run func() db.Repository[model.NaughtyWord]) *MockStore_NaughtyWords_Call {
+func (_c *MockStore_AuditLog_Call) RunAndReturn(run func() db.Repository[model.AuditEntry]) *MockStore_AuditLog_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SearchCoins provides a mock function for the type MockStore
-func (_mock *MockStore) SearchCoins(ctx context.Context, query string, tags []string, minVolume24h float64, limit int32, offset int32, sortBy string, sortDesc bool) ([]model.Coin, error) {
-	ret := _mock.Called(ctx, query, tags, minVolume24h, limit, offset, sortBy, sortDesc)
+// AuthorityChanges provides a mock function for the type MockStore
+func (_mock *MockStore) AuthorityChanges() db.Repository[model.AuthorityChange] {
+	ret := _mock.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for SearchCoins")
+		panic("no return value specified for AuthorityChanges")
 	}
 
-	var r0 []model.Coin
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string, float64, int32, int32, string, bool) ([]model.Coin, error)); ok {
-		return returnFunc(ctx, query, tags, minVolume24h, limit, offset, sortBy, sortDesc)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string, float64, int32, int32, string, bool) []model.Coin); ok {
-		r0 = returnFunc(ctx, query, tags, minVolume24h, limit, offset, sortBy, sortDesc)
+	var r0 db.Repository[model.AuthorityChange]
+	if returnFunc, ok := ret.Get(0).(func() db.Repository[model.AuthorityChange]); ok {
+		r0 = returnFunc()
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]model.Coin)
+			r0 = ret.Get(0).(db.Repository[model.AuthorityChange])
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, []string, float64, int32, int32, string, bool) error); ok {
-		r1 = returnFunc(ctx, query, tags, minVolume24h, limit, offset, sortBy, sortDesc)
-	} else {
-		r1 = ret.Error(1)
-	}
-	return r0, r1
+	return r0
 }
 
-// MockStore_SearchCoins_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchCoins'
-type MockStore_SearchCoins_Call struct {
+// MockStore_AuthorityChanges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AuthorityChanges'
+type MockStore_AuthorityChanges_Call struct {
 	*mock.Call
 }
 
-// SearchCoins is a helper method to define mock.On call
-//   - ctx context.Context
-//   - query string
-//   - tags []string
-//   - minVolume24h float64
-//   - limit int32
-//   - offset int32
-//   - sortBy string
-//   - sortDesc bool
-func (_e *MockStore_Expecter) SearchCoins(ctx interface{}, query interface{}, tags interface{}, minVolume24h interface{}, limit interface{}, offset interface{}, sortBy interface{}, sortDesc interface{}) *MockStore_SearchCoins_Call {
-	return &MockStore_SearchCoins_Call{Call: _e.mock.On("SearchCoins", ctx, query, tags, minVolume24h, limit, offset, sortBy, sortDesc)}
+// AuthorityChanges is a helper method to define mock.On call
+func (_e *MockStore_Expecter) AuthorityChanges() *MockStore_AuthorityChanges_Call {
+	return &MockStore_AuthorityChanges_Call{Call: _e.mock.On("AuthorityChanges")}
 }
 
-func (_c *MockStore_SearchCoins_Call) Run(run func(ctx context.Context, query string, tags []string, minVolume24h float64, limit int32, offset int32, sortBy string, sortDesc bool)) *MockStore_SearchCoins_Call {
+func (_c *MockStore_AuthorityChanges_Call) Run(run func()) *MockStore_AuthorityChanges_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 context.Context
-		if args[0] != nil {
-			arg0 = args[0].(context.Context)
-		}
-		var arg1 string
-		if args[1] != nil {
-			arg1 = args[1].(string)
-		}
-		var arg2 []string
-		if args[2] != nil {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockStore_AuthorityChanges_Call) Return(repository db.Repository[model.AuthorityChange]) *MockStore_AuthorityChanges_Call {
+	_c.Call.Return(repository)
+	return _c
+}
+
+func (_c *MockStore_AuthorityChanges_Call) RunAndReturn(run func() db.Repository[model.AuthorityChange]) *MockStore_AuthorityChanges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BlockedMints provides a mock function for the type MockStore
+func (_mock *MockStore) BlockedMints() db.Repository[model.BlockedMint] {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BlockedMints")
+	}
+
+	var r0 db.Repository[model.BlockedMint]
+	if returnFunc, ok := ret.Get(0).(func() db.Repository[model.BlockedMint]); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(db.Repository[model.BlockedMint])
+		}
+	}
+	return r0
+}
+
+// MockStore_BlockedMints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BlockedMints'
+type MockStore_BlockedMints_Call struct {
+	*mock.Call
+}
+
+// BlockedMints is a helper method to define mock.On call
+func (_e *MockStore_Expecter) BlockedMints() *MockStore_BlockedMints_Call {
+	return &MockStore_BlockedMints_Call{Call: _e.mock.On("BlockedMints")}
+}
+
+func (_c *MockStore_BlockedMints_Call) Run(run func()) *MockStore_BlockedMints_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockStore_BlockedMints_Call) Return(repository db.Repository[model.BlockedMint]) *MockStore_BlockedMints_Call {
+	_c.Call.Return(repository)
+	return _c
+}
+
+func (_c *MockStore_BlockedMints_Call) RunAndReturn(run func() db.Repository[model.BlockedMint]) *MockStore_BlockedMints_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BurnEvents provides a mock function for the type MockStore
+func (_mock *MockStore) BurnEvents() db.Repository[model.BurnEvent] {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BurnEvents")
+	}
+
+	var r0 db.Repository[model.BurnEvent]
+	if returnFunc, ok := ret.Get(0).(func() db.Repository[model.BurnEvent]); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(db.Repository[model.BurnEvent])
+		}
+	}
+	return r0
+}
+
+// MockStore_BurnEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BurnEvents'
+type MockStore_BurnEvents_Call struct {
+	*mock.Call
+}
+
+// BurnEvents is a helper method to define mock.On call
+func (_e *MockStore_Expecter) BurnEvents() *MockStore_BurnEvents_Call {
+	return &MockStore_BurnEvents_Call{Call: _e.mock.On("BurnEvents")}
+}
+
+func (_c *MockStore_BurnEvents_Call) Run(run func()) *MockStore_BurnEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockStore_BurnEvents_Call) Return(repository db.Repository[model.BurnEvent]) *MockStore_BurnEvents_Call {
+	_c.Call.Return(repository)
+	return _c
+}
+
+func (_c *MockStore_BurnEvents_Call) RunAndReturn(run func() db.Repository[model.BurnEvent]) *MockStore_BurnEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BurnWatches provides a mock function for the type MockStore
+func (_mock *MockStore) BurnWatches() db.Repository[model.BurnWatch] {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BurnWatches")
+	}
+
+	var r0 db.Repository[model.BurnWatch]
+	if returnFunc, ok := ret.Get(0).(func() db.Repository[model.BurnWatch]); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(db.Repository[model.BurnWatch])
+		}
+	}
+	return r0
+}
+
+// MockStore_BurnWatches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BurnWatches'
+type MockStore_BurnWatches_Call struct {
+	*mock.Call
+}
+
+// BurnWatches is a helper method to define mock.On call
+func (_e *MockStore_Expecter) BurnWatches() *MockStore_BurnWatches_Call {
+	return &MockStore_BurnWatches_Call{Call: _e.mock.On("BurnWatches")}
+}
+
+func (_c *MockStore_BurnWatches_Call) Run(run func()) *MockStore_BurnWatches_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockStore_BurnWatches_Call) Return(repository db.Repository[model.BurnWatch]) *MockStore_BurnWatches_Call {
+	_c.Call.Return(repository)
+	return _c
+}
+
+func (_c *MockStore_BurnWatches_Call) RunAndReturn(run func() db.Repository[model.BurnWatch]) *MockStore_BurnWatches_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ClaimTwapSlices provides a mock function for the type MockStore
+func (_mock *MockStore) ClaimTwapSlices(ctx context.Context, claim db.TwapClaim) ([]model.TwapSlice, error) {
+	ret := _mock.Called(ctx, claim)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimTwapSlices")
+	}
+
+	var r0 []model.TwapSlice
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.TwapClaim) ([]model.TwapSlice, error)); ok {
+		return returnFunc(ctx, claim)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.TwapClaim) []model.TwapSlice); ok {
+		r0 = returnFunc(ctx, claim)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.TwapSlice)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.TwapClaim) error); ok {
+		r1 = returnFunc(ctx, claim)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_ClaimTwapSlices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimTwapSlices'
+type MockStore_ClaimTwapSlices_Call struct {
+	*mock.Call
+}
+
+// ClaimTwapSlices is a helper method to define mock.On call
+//   - ctx context.Context
+//   - claim db.TwapClaim
+func (_e *MockStore_Expecter) ClaimTwapSlices(ctx interface{}, claim interface{}) *MockStore_ClaimTwapSlices_Call {
+	return &MockStore_ClaimTwapSlices_Call{Call: _e.mock.On("ClaimTwapSlices", ctx, claim)}
+}
+
+func (_c *MockStore_ClaimTwapSlices_Call) Run(run func(ctx context.Context, claim db.TwapClaim)) *MockStore_ClaimTwapSlices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.TwapClaim
+		if args[1] != nil {
+			arg1 = args[1].(db.TwapClaim)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_ClaimTwapSlices_Call) Return(twapSlices []model.TwapSlice, err error) *MockStore_ClaimTwapSlices_Call {
+	_c.Call.Return(twapSlices, err)
+	return _c
+}
+
+func (_c *MockStore_ClaimTwapSlices_Call) RunAndReturn(run func(ctx context.Context, claim db.TwapClaim) ([]model.TwapSlice, error)) *MockStore_ClaimTwapSlices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CoinDescriptions provides a mock function for the type MockStore
+func (_mock *MockStore) CoinDescriptions() db.Repository[model.CoinDescription] {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CoinDescriptions")
+	}
+
+	var r0 db.Repository[model.CoinDescription]
+	if returnFunc, ok := ret.Get(0).(func() db.Repository[model.CoinDescription]); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(db.Repository[model.CoinDescription])
+		}
+	}
+	return r0
+}
+
+// MockStore_CoinDescriptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CoinDescriptions'
+type MockStore_CoinDescriptions_Call struct {
+	*mock.Call
+}
+
+// CoinDescriptions is a helper method to define mock.On call
+func (_e *MockStore_Expecter) CoinDescriptions() *MockStore_CoinDescriptions_Call {
+	return &MockStore_CoinDescriptions_Call{Call: _e.mock.On("CoinDescriptions")}
+}
+
+func (_c *MockStore_CoinDescriptions_Call) Run(run func()) *MockStore_CoinDescriptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockStore_CoinDescriptions_Call) Return(repository db.Repository[model.CoinDescription]) *MockStore_CoinDescriptions_Call {
+	_c.Call.Return(repository)
+	return _c
+}
+
+func (_c *MockStore_CoinDescriptions_Call) RunAndReturn(run func() db.Repository[model.CoinDescription]) *MockStore_CoinDescriptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CoinOverrides provides a mock function for the type MockStore
+func (_mock *MockStore) CoinOverrides() db.Repository[model.CoinOverride] {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CoinOverrides")
+	}
+
+	var r0 db.Repository[model.CoinOverride]
+	if returnFunc, ok := ret.Get(0).(func() db.Repository[model.CoinOverride]); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(db.Repository[model.CoinOverride])
+		}
+	}
+	return r0
+}
+
+// MockStore_CoinOverrides_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CoinOverrides'
+type MockStore_CoinOverrides_Call struct {
+	*mock.Call
+}
+
+// CoinOverrides is a helper method to define mock.On call
+func (_e *MockStore_Expecter) CoinOverrides() *MockStore_CoinOverrides_Call {
+	return &MockStore_CoinOverrides_Call{Call: _e.mock.On("CoinOverrides")}
+}
+
+func (_c *MockStore_CoinOverrides_Call) Run(run func()) *MockStore_CoinOverrides_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockStore_CoinOverrides_Call) Return(repository db.Repository[model.CoinOverride]) *MockStore_CoinOverrides_Call {
+	_c.Call.Return(repository)
+	return _c
+}
+
+func (_c *MockStore_CoinOverrides_Call) RunAndReturn(run func() db.Repository[model.CoinOverride]) *MockStore_CoinOverrides_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CoinRevisions provides a mock function for the type MockStore
+func (_mock *MockStore) CoinRevisions() db.Repository[model.CoinRevision] {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CoinRevisions")
+	}
+
+	var r0 db.Repository[model.CoinRevision]
+	if returnFunc, ok := ret.Get(0).(func() db.Repository[model.CoinRevision]); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(db.Repository[model.CoinRevision])
+		}
+	}
+	return r0
+}
+
+// MockStore_CoinRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CoinRevisions'
+type MockStore_CoinRevisions_Call struct {
+	*mock.Call
+}
+
+// CoinRevisions is a helper method to define mock.On call
+func (_e *MockStore_Expecter) CoinRevisions() *MockStore_CoinRevisions_Call {
+	return &MockStore_CoinRevisions_Call{Call: _e.mock.On("CoinRevisions")}
+}
+
+func (_c *MockStore_CoinRevisions_Call) Run(run func()) *MockStore_CoinRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockStore_CoinRevisions_Call) Return(repository db.Repository[model.CoinRevision]) *MockStore_CoinRevisions_Call {
+	_c.Call.Return(repository)
+	return _c
+}
+
+func (_c *MockStore_CoinRevisions_Call) RunAndReturn(run func() db.Repository[model.CoinRevision]) *MockStore_CoinRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CoinViews provides a mock function for the type MockStore
+func (_mock *MockStore) CoinViews() db.Repository[model.CoinView] {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CoinViews")
+	}
+
+	var r0 db.Repository[model.CoinView]
+	if returnFunc, ok := ret.Get(0).(func() db.Repository[model.CoinView]); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(db.Repository[model.CoinView])
+		}
+	}
+	return r0
+}
+
+// MockStore_CoinViews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CoinViews'
+type MockStore_CoinViews_Call struct {
+	*mock.Call
+}
+
+// CoinViews is a helper method to define mock.On call
+func (_e *MockStore_Expecter) CoinViews() *MockStore_CoinViews_Call {
+	return &MockStore_CoinViews_Call{Call: _e.mock.On("CoinViews")}
+}
+
+func (_c *MockStore_CoinViews_Call) Run(run func()) *MockStore_CoinViews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockStore_CoinViews_Call) Return(repository db.Repository[model.CoinView]) *MockStore_CoinViews_Call {
+	_c.Call.Return(repository)
+	return _c
+}
+
+func (_c *MockStore_CoinViews_Call) RunAndReturn(run func() db.Repository[model.CoinView]) *MockStore_CoinViews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Coins provides a mock function for the type MockStore
+func (_mock *MockStore) Coins() db.Repository[model.Coin] {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Coins")
+	}
+
+	var r0 db.Repository[model.Coin]
+	if returnFunc, ok := ret.Get(0).(func() db.Repository[model.Coin]); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(db.Repository[model.Coin])
+		}
+	}
+	return r0
+}
+
+// MockStore_Coins_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Coins'
+type MockStore_Coins_Call struct {
+	*mock.Call
+}
+
+// Coins is a helper method to define mock.On call
+func (_e *MockStore_Expecter) Coins() *MockStore_Coins_Call {
+	return &MockStore_Coins_Call{Call: _e.mock.On("Coins")}
+}
+
+func (_c *MockStore_Coins_Call) Run(run func()) *MockStore_Coins_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockStore_Coins_Call) Return(repository db.Repository[model.Coin]) *MockStore_Coins_Call {
+	_c.Call.Return(repository)
+	return _c
+}
+
+func (_c *MockStore_Coins_Call) RunAndReturn(run func() db.Repository[model.Coin]) *MockStore_Coins_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountPersonalData provides a mock function for the type MockStore
+func (_mock *MockStore) CountPersonalData(ctx context.Context, subject db.DataSubject) (map[string]int64, error) {
+	ret := _mock.Called(ctx, subject)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountPersonalData")
+	}
+
+	var r0 map[string]int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.DataSubject) (map[string]int64, error)); ok {
+		return returnFunc(ctx, subject)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.DataSubject) map[string]int64); ok {
+		r0 = returnFunc(ctx, subject)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]int64)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.DataSubject) error); ok {
+		r1 = returnFunc(ctx, subject)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_CountPersonalData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountPersonalData'
+type MockStore_CountPersonalData_Call struct {
+	*mock.Call
+}
+
+// CountPersonalData is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subject db.DataSubject
+func (_e *MockStore_Expecter) CountPersonalData(ctx interface{}, subject interface{}) *MockStore_CountPersonalData_Call {
+	return &MockStore_CountPersonalData_Call{Call: _e.mock.On("CountPersonalData", ctx, subject)}
+}
+
+func (_c *MockStore_CountPersonalData_Call) Run(run func(ctx context.Context, subject db.DataSubject)) *MockStore_CountPersonalData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.DataSubject
+		if args[1] != nil {
+			arg1 = args[1].(db.DataSubject)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_CountPersonalData_Call) Return(stringToInt64 map[string]int64, err error) *MockStore_CountPersonalData_Call {
+	_c.Call.Return(stringToInt64, err)
+	return _c
+}
+
+func (_c *MockStore_CountPersonalData_Call) RunAndReturn(run func(ctx context.Context, subject db.DataSubject) (map[string]int64, error)) *MockStore_CountPersonalData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DataDeletions provides a mock function for the type MockStore
+func (_mock *MockStore) DataDeletions() db.Repository[model.DataDeletion] {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DataDeletions")
+	}
+
+	var r0 db.Repository[model.DataDeletion]
+	if returnFunc, ok := ret.Get(0).(func() db.Repository[model.DataDeletion]); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(db.Repository[model.DataDeletion])
+		}
+	}
+	return r0
+}
+
+// MockStore_DataDeletions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DataDeletions'
+type MockStore_DataDeletions_Call struct {
+	*mock.Call
+}
+
+// DataDeletions is a helper method to define mock.On call
+func (_e *MockStore_Expecter) DataDeletions() *MockStore_DataDeletions_Call {
+	return &MockStore_DataDeletions_Call{Call: _e.mock.On("DataDeletions")}
+}
+
+func (_c *MockStore_DataDeletions_Call) Run(run func()) *MockStore_DataDeletions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockStore_DataDeletions_Call) Return(repository db.Repository[model.DataDeletion]) *MockStore_DataDeletions_Call {
+	_c.Call.Return(repository)
+	return _c
+}
+
+func (_c *MockStore_DataDeletions_Call) RunAndReturn(run func() db.Repository[model.DataDeletion]) *MockStore_DataDeletions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAccount provides a mock function for the type MockStore
+func (_mock *MockStore) DeleteAccount(ctx context.Context, walletPublicKey string) error {
+	ret := _mock.Called(ctx, walletPublicKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAccount")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, walletPublicKey)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStore_DeleteAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAccount'
+type MockStore_DeleteAccount_Call struct {
+	*mock.Call
+}
+
+// DeleteAccount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - walletPublicKey string
+func (_e *MockStore_Expecter) DeleteAccount(ctx interface{}, walletPublicKey interface{}) *MockStore_DeleteAccount_Call {
+	return &MockStore_DeleteAccount_Call{Call: _e.mock.On("DeleteAccount", ctx, walletPublicKey)}
+}
+
+func (_c *MockStore_DeleteAccount_Call) Run(run func(ctx context.Context, walletPublicKey string)) *MockStore_DeleteAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteAccount_Call) Return(err error) *MockStore_DeleteAccount_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStore_DeleteAccount_Call) RunAndReturn(run func(ctx context.Context, walletPublicKey string) error) *MockStore_DeleteAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletionCertificates provides a mock function for the type MockStore
+func (_mock *MockStore) DeletionCertificates() db.Repository[model.DeletionCertificate] {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletionCertificates")
+	}
+
+	var r0 db.Repository[model.DeletionCertificate]
+	if returnFunc, ok := ret.Get(0).(func() db.Repository[model.DeletionCertificate]); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(db.Repository[model.DeletionCertificate])
+		}
+	}
+	return r0
+}
+
+// MockStore_DeletionCertificates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletionCertificates'
+type MockStore_DeletionCertificates_Call struct {
+	*mock.Call
+}
+
+// DeletionCertificates is a helper method to define mock.On call
+func (_e *MockStore_Expecter) DeletionCertificates() *MockStore_DeletionCertificates_Call {
+	return &MockStore_DeletionCertificates_Call{Call: _e.mock.On("DeletionCertificates")}
+}
+
+func (_c *MockStore_DeletionCertificates_Call) Run(run func()) *MockStore_DeletionCertificates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockStore_DeletionCertificates_Call) Return(repository db.Repository[model.DeletionCertificate]) *MockStore_DeletionCertificates_Call {
+	_c.Call.Return(repository)
+	return _c
+}
+
+func (_c *MockStore_DeletionCertificates_Call) RunAndReturn(run func() db.Repository[model.DeletionCertificate]) *MockStore_DeletionCertificates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DownsamplePricePoints provides a mock function for the type MockStore
+func (_mock *MockStore) DownsamplePricePoints(ctx context.Context, from time.Duration, to time.Duration, before time.Time) (int64, error) {
+	ret := _mock.Called(ctx, from, to, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DownsamplePricePoints")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Duration, time.Duration, time.Time) (int64, error)); ok {
+		return returnFunc(ctx, from, to, before)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Duration, time.Duration, time.Time) int64); ok {
+		r0 = returnFunc(ctx, from, to, before)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Duration, time.Duration, time.Time) error); ok {
+		r1 = returnFunc(ctx, from, to, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_DownsamplePricePoints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DownsamplePricePoints'
+type MockStore_DownsamplePricePoints_Call struct {
+	*mock.Call
+}
+
+// DownsamplePricePoints is a helper method to define mock.On call
+//   - ctx context.Context
+//   - from time.Duration
+//   - to time.Duration
+//   - before time.Time
+func (_e *MockStore_Expecter) DownsamplePricePoints(ctx interface{}, from interface{}, to interface{}, before interface{}) *MockStore_DownsamplePricePoints_Call {
+	return &MockStore_DownsamplePricePoints_Call{Call: _e.mock.On("DownsamplePricePoints", ctx, from, to, before)}
+}
+
+func (_c *MockStore_DownsamplePricePoints_Call) Run(run func(ctx context.Context, from time.Duration, to time.Duration, before time.Time)) *MockStore_DownsamplePricePoints_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Duration
+		if args[1] != nil {
+			arg1 = args[1].(time.Duration)
+		}
+		var arg2 time.Duration
+		if args[2] != nil {
+			arg2 = args[2].(time.Duration)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_DownsamplePricePoints_Call) Return(n int64, err error) *MockStore_DownsamplePricePoints_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockStore_DownsamplePricePoints_Call) RunAndReturn(run func(ctx context.Context, from time.Duration, to time.Duration, before time.Time) (int64, error)) *MockStore_DownsamplePricePoints_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ErasePersonalData provides a mock function for the type MockStore
+func (_mock *MockStore) ErasePersonalData(ctx context.Context, subject db.DataSubject) (map[string]int64, error) {
+	ret := _mock.Called(ctx, subject)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ErasePersonalData")
+	}
+
+	var r0 map[string]int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.DataSubject) (map[string]int64, error)); ok {
+		return returnFunc(ctx, subject)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.DataSubject) map[string]int64); ok {
+		r0 = returnFunc(ctx, subject)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]int64)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.DataSubject) error); ok {
+		r1 = returnFunc(ctx, subject)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_ErasePersonalData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ErasePersonalData'
+type MockStore_ErasePersonalData_Call struct {
+	*mock.Call
+}
+
+// ErasePersonalData is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subject db.DataSubject
+func (_e *MockStore_Expecter) ErasePersonalData(ctx interface{}, subject interface{}) *MockStore_ErasePersonalData_Call {
+	return &MockStore_ErasePersonalData_Call{Call: _e.mock.On("ErasePersonalData", ctx, subject)}
+}
+
+func (_c *MockStore_ErasePersonalData_Call) Run(run func(ctx context.Context, subject db.DataSubject)) *MockStore_ErasePersonalData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.DataSubject
+		if args[1] != nil {
+			arg1 = args[1].(db.DataSubject)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_ErasePersonalData_Call) Return(stringToInt64 map[string]int64, err error) *MockStore_ErasePersonalData_Call {
+	_c.Call.Return(stringToInt64, err)
+	return _c
+}
+
+func (_c *MockStore_ErasePersonalData_Call) RunAndReturn(run func(ctx context.Context, subject db.DataSubject) (map[string]int64, error)) *MockStore_ErasePersonalData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FeatureFlags provides a mock function for the type MockStore
+func (_mock *MockStore) FeatureFlags() db.Repository[model.FeatureFlag] {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for FeatureFlags")
+	}
+
+	var r0 db.Repository[model.FeatureFlag]
+	if returnFunc, ok := ret.Get(0).(func() db.Repository[model.FeatureFlag]); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(db.Repository[model.FeatureFlag])
+		}
+	}
+	return r0
+}
+
+// MockStore_FeatureFlags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FeatureFlags'
+type MockStore_FeatureFlags_Call struct {
+	*mock.Call
+}
+
+// FeatureFlags is a helper method to define mock.On call
+func (_e *MockStore_Expecter) FeatureFlags() *MockStore_FeatureFlags_Call {
+	return &MockStore_FeatureFlags_Call{Call: _e.mock.On("FeatureFlags")}
+}
+
+func (_c *MockStore_FeatureFlags_Call) Run(run func()) *MockStore_FeatureFlags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockStore_FeatureFlags_Call) Return(repository db.Repository[model.FeatureFlag]) *MockStore_FeatureFlags_Call {
+	_c.Call.Return(repository)
+	return _c
+}
+
+func (_c *MockStore_FeatureFlags_Call) RunAndReturn(run func() db.Repository[model.FeatureFlag]) *MockStore_FeatureFlags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FeeLedger provides a mock function for the type MockStore
+func (_mock *MockStore) FeeLedger() db.Repository[model.FeeLedgerEntry] {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for FeeLedger")
+	}
+
+	var r0 db.Repository[model.FeeLedgerEntry]
+	if returnFunc, ok := ret.Get(0).(func() db.Repository[model.FeeLedgerEntry]); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(db.Repository[model.FeeLedgerEntry])
+		}
+	}
+	return r0
+}
+
+// MockStore_FeeLedger_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FeeLedger'
+type MockStore_FeeLedger_Call struct {
+	*mock.Call
+}
+
+// FeeLedger is a helper method to define mock.On call
+func (_e *MockStore_Expecter) FeeLedger() *MockStore_FeeLedger_Call {
+	return &MockStore_FeeLedger_Call{Call: _e.mock.On("FeeLedger")}
+}
+
+func (_c *MockStore_FeeLedger_Call) Run(run func()) *MockStore_FeeLedger_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockStore_FeeLedger_Call) Return(repository db.Repository[model.FeeLedgerEntry]) *MockStore_FeeLedger_Call {
+	_c.Call.Return(repository)
+	return _c
+}
+
+func (_c *MockStore_FeeLedger_Call) RunAndReturn(run func() db.Repository[model.FeeLedgerEntry]) *MockStore_FeeLedger_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMarketTotals provides a mock function for the type MockStore
+func (_mock *MockStore) GetMarketTotals(ctx context.Context, excludeTags []string, listedSince time.Time) (*model.MarketTotals, error) {
+	ret := _mock.Called(ctx, excludeTags, listedSince)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMarketTotals")
+	}
+
+	var r0 *model.MarketTotals
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string, time.Time) (*model.MarketTotals, error)); ok {
+		return returnFunc(ctx, excludeTags, listedSince)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string, time.Time) *model.MarketTotals); ok {
+		r0 = returnFunc(ctx, excludeTags, listedSince)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.MarketTotals)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string, time.Time) error); ok {
+		r1 = returnFunc(ctx, excludeTags, listedSince)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_GetMarketTotals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMarketTotals'
+type MockStore_GetMarketTotals_Call struct {
+	*mock.Call
+}
+
+// GetMarketTotals is a helper method to define mock.On call
+//   - ctx context.Context
+//   - excludeTags []string
+//   - listedSince time.Time
+func (_e *MockStore_Expecter) GetMarketTotals(ctx interface{}, excludeTags interface{}, listedSince interface{}) *MockStore_GetMarketTotals_Call {
+	return &MockStore_GetMarketTotals_Call{Call: _e.mock.On("GetMarketTotals", ctx, excludeTags, listedSince)}
+}
+
+func (_c *MockStore_GetMarketTotals_Call) Run(run func(ctx context.Context, excludeTags []string, listedSince time.Time)) *MockStore_GetMarketTotals_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_GetMarketTotals_Call) Return(marketTotals *model.MarketTotals, err error) *MockStore_GetMarketTotals_Call {
+	_c.Call.Return(marketTotals, err)
+	return _c
+}
+
+func (_c *MockStore_GetMarketTotals_Call) RunAndReturn(run func(ctx context.Context, excludeTags []string, listedSince time.Time) (*model.MarketTotals, error)) *MockStore_GetMarketTotals_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImageHashes provides a mock function for the type MockStore
+func (_mock *MockStore) ImageHashes() db.Repository[model.ImageHash] {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImageHashes")
+	}
+
+	var r0 db.Repository[model.ImageHash]
+	if returnFunc, ok := ret.Get(0).(func() db.Repository[model.ImageHash]); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(db.Repository[model.ImageHash])
+		}
+	}
+	return r0
+}
+
+// MockStore_ImageHashes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImageHashes'
+type MockStore_ImageHashes_Call struct {
+	*mock.Call
+}
+
+// ImageHashes is a helper method to define mock.On call
+func (_e *MockStore_Expecter) ImageHashes() *MockStore_ImageHashes_Call {
+	return &MockStore_ImageHashes_Call{Call: _e.mock.On("ImageHashes")}
+}
+
+func (_c *MockStore_ImageHashes_Call) Run(run func()) *MockStore_ImageHashes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockStore_ImageHashes_Call) Return(repository db.Repository[model.ImageHash]) *MockStore_ImageHashes_Call {
+	_c.Call.Return(repository)
+	return _c
+}
+
+func (_c *MockStore_ImageHashes_Call) RunAndReturn(run func() db.Repository[model.ImageHash]) *MockStore_ImageHashes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// JobCheckpoints provides a mock function for the type MockStore
+func (_mock *MockStore) JobCheckpoints() db.Repository[model.JobCheckpoint] {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for JobCheckpoints")
+	}
+
+	var r0 db.Repository[model.JobCheckpoint]
+	if returnFunc, ok := ret.Get(0).(func() db.Repository[model.JobCheckpoint]); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(db.Repository[model.JobCheckpoint])
+		}
+	}
+	return r0
+}
+
+// MockStore_JobCheckpoints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'JobCheckpoints'
+type MockStore_JobCheckpoints_Call struct {
+	*mock.Call
+}
+
+// JobCheckpoints is a helper method to define mock.On call
+func (_e *MockStore_Expecter) JobCheckpoints() *MockStore_JobCheckpoints_Call {
+	return &MockStore_JobCheckpoints_Call{Call: _e.mock.On("JobCheckpoints")}
+}
+
+func (_c *MockStore_JobCheckpoints_Call) Run(run func()) *MockStore_JobCheckpoints_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockStore_JobCheckpoints_Call) Return(repository db.Repository[model.JobCheckpoint]) *MockStore_JobCheckpoints_Call {
+	_c.Call.Return(repository)
+	return _c
+}
+
+func (_c *MockStore_JobCheckpoints_Call) RunAndReturn(run func() db.Repository[model.JobCheckpoint]) *MockStore_JobCheckpoints_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// JobRuns provides a mock function for the type MockStore
+func (_mock *MockStore) JobRuns() db.Repository[model.JobRun] {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for JobRuns")
+	}
+
+	var r0 db.Repository[model.JobRun]
+	if returnFunc, ok := ret.Get(0).(func() db.Repository[model.JobRun]); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(db.Repository[model.JobRun])
+		}
+	}
+	return r0
+}
+
+// MockStore_JobRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'JobRuns'
+type MockStore_JobRuns_Call struct {
+	*mock.Call
+}
+
+// JobRuns is a helper method to define mock.On call
+func (_e *MockStore_Expecter) JobRuns() *MockStore_JobRuns_Call {
+	return &MockStore_JobRuns_Call{Call: _e.mock.On("JobRuns")}
+}
+
+func (_c *MockStore_JobRuns_Call) Run(run func()) *MockStore_JobRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockStore_JobRuns_Call) Return(repository db.Repository[model.JobRun]) *MockStore_JobRuns_Call {
+	_c.Call.Return(repository)
+	return _c
+}
+
+func (_c *MockStore_JobRuns_Call) RunAndReturn(run func() db.Repository[model.JobRun]) *MockStore_JobRuns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListNewestCoins provides a mock function for the type MockStore
+func (_mock *MockStore) ListNewestCoins(ctx context.Context, opts db.ListOptions) ([]model.Coin, int32, error) {
+	ret := _mock.Called(ctx, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListNewestCoins")
+	}
+
+	var r0 []model.Coin
+	var r1 int32
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.ListOptions) ([]model.Coin, int32, error)); ok {
+		return returnFunc(ctx, opts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.ListOptions) []model.Coin); ok {
+		r0 = returnFunc(ctx, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Coin)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.ListOptions) int32); ok {
+		r1 = returnFunc(ctx, opts)
+	} else {
+		r1 = ret.Get(1).(int32)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, db.ListOptions) error); ok {
+		r2 = returnFunc(ctx, opts)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockStore_ListNewestCoins_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListNewestCoins'
+type MockStore_ListNewestCoins_Call struct {
+	*mock.Call
+}
+
+// ListNewestCoins is a helper method to define mock.On call
+//   - ctx context.Context
+//   - opts db.ListOptions
+func (_e *MockStore_Expecter) ListNewestCoins(ctx interface{}, opts interface{}) *MockStore_ListNewestCoins_Call {
+	return &MockStore_ListNewestCoins_Call{Call: _e.mock.On("ListNewestCoins", ctx, opts)}
+}
+
+func (_c *MockStore_ListNewestCoins_Call) Run(run func(ctx context.Context, opts db.ListOptions)) *MockStore_ListNewestCoins_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.ListOptions
+		if args[1] != nil {
+			arg1 = args[1].(db.ListOptions)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_ListNewestCoins_Call) Return(coins []model.Coin, n int32, err error) *MockStore_ListNewestCoins_Call {
+	_c.Call.Return(coins, n, err)
+	return _c
+}
+
+func (_c *MockStore_ListNewestCoins_Call) RunAndReturn(run func(ctx context.Context, opts db.ListOptions) ([]model.Coin, int32, error)) *MockStore_ListNewestCoins_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPriceHistoryRanges provides a mock function for the type MockStore
+func (_mock *MockStore) ListPriceHistoryRanges(ctx context.Context, address string, maxResolution time.Duration, from time.Time, to time.Time) ([]model.PriceHistoryRange, error) {
+	ret := _mock.Called(ctx, address, maxResolution, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPriceHistoryRanges")
+	}
+
+	var r0 []model.PriceHistoryRange
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Duration, time.Time, time.Time) ([]model.PriceHistoryRange, error)); ok {
+		return returnFunc(ctx, address, maxResolution, from, to)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Duration, time.Time, time.Time) []model.PriceHistoryRange); ok {
+		r0 = returnFunc(ctx, address, maxResolution, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.PriceHistoryRange)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, time.Duration, time.Time, time.Time) error); ok {
+		r1 = returnFunc(ctx, address, maxResolution, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_ListPriceHistoryRanges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPriceHistoryRanges'
+type MockStore_ListPriceHistoryRanges_Call struct {
+	*mock.Call
+}
+
+// ListPriceHistoryRanges is a helper method to define mock.On call
+//   - ctx context.Context
+//   - address string
+//   - maxResolution time.Duration
+//   - from time.Time
+//   - to time.Time
+func (_e *MockStore_Expecter) ListPriceHistoryRanges(ctx interface{}, address interface{}, maxResolution interface{}, from interface{}, to interface{}) *MockStore_ListPriceHistoryRanges_Call {
+	return &MockStore_ListPriceHistoryRanges_Call{Call: _e.mock.On("ListPriceHistoryRanges", ctx, address, maxResolution, from, to)}
+}
+
+func (_c *MockStore_ListPriceHistoryRanges_Call) Run(run func(ctx context.Context, address string, maxResolution time.Duration, from time.Time, to time.Time)) *MockStore_ListPriceHistoryRanges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Duration
+		if args[2] != nil {
+			arg2 = args[2].(time.Duration)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		var arg4 time.Time
+		if args[4] != nil {
+			arg4 = args[4].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_ListPriceHistoryRanges_Call) Return(priceHistoryRanges []model.PriceHistoryRange, err error) *MockStore_ListPriceHistoryRanges_Call {
+	_c.Call.Return(priceHistoryRanges, err)
+	return _c
+}
+
+func (_c *MockStore_ListPriceHistoryRanges_Call) RunAndReturn(run func(ctx context.Context, address string, maxResolution time.Duration, from time.Time, to time.Time) ([]model.PriceHistoryRange, error)) *MockStore_ListPriceHistoryRanges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPricePoints provides a mock function for the type MockStore
+func (_mock *MockStore) ListPricePoints(ctx context.Context, address string, maxResolution time.Duration, from time.Time, to time.Time) ([]model.PricePoint, error) {
+	ret := _mock.Called(ctx, address, maxResolution, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPricePoints")
+	}
+
+	var r0 []model.PricePoint
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Duration, time.Time, time.Time) ([]model.PricePoint, error)); ok {
+		return returnFunc(ctx, address, maxResolution, from, to)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Duration, time.Time, time.Time) []model.PricePoint); ok {
+		r0 = returnFunc(ctx, address, maxResolution, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.PricePoint)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, time.Duration, time.Time, time.Time) error); ok {
+		r1 = returnFunc(ctx, address, maxResolution, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_ListPricePoints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPricePoints'
+type MockStore_ListPricePoints_Call struct {
+	*mock.Call
+}
+
+// ListPricePoints is a helper method to define mock.On call
+//   - ctx context.Context
+//   - address string
+//   - maxResolution time.Duration
+//   - from time.Time
+//   - to time.Time
+func (_e *MockStore_Expecter) ListPricePoints(ctx interface{}, address interface{}, maxResolution interface{}, from interface{}, to interface{}) *MockStore_ListPricePoints_Call {
+	return &MockStore_ListPricePoints_Call{Call: _e.mock.On("ListPricePoints", ctx, address, maxResolution, from, to)}
+}
+
+func (_c *MockStore_ListPricePoints_Call) Run(run func(ctx context.Context, address string, maxResolution time.Duration, from time.Time, to time.Time)) *MockStore_ListPricePoints_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Duration
+		if args[2] != nil {
+			arg2 = args[2].(time.Duration)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		var arg4 time.Time
+		if args[4] != nil {
+			arg4 = args[4].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_ListPricePoints_Call) Return(pricePoints []model.PricePoint, err error) *MockStore_ListPricePoints_Call {
+	_c.Call.Return(pricePoints, err)
+	return _c
+}
+
+func (_c *MockStore_ListPricePoints_Call) RunAndReturn(run func(ctx context.Context, address string, maxResolution time.Duration, from time.Time, to time.Time) ([]model.PricePoint, error)) *MockStore_ListPricePoints_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTopGainersCoins provides a mock function for the type MockStore
+func (_mock *MockStore) ListTopGainersCoins(ctx context.Context, opts db.ListOptions) ([]model.Coin, int32, error) {
+	ret := _mock.Called(ctx, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTopGainersCoins")
+	}
+
+	var r0 []model.Coin
+	var r1 int32
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.ListOptions) ([]model.Coin, int32, error)); ok {
+		return returnFunc(ctx, opts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.ListOptions) []model.Coin); ok {
+		r0 = returnFunc(ctx, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Coin)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.ListOptions) int32); ok {
+		r1 = returnFunc(ctx, opts)
+	} else {
+		r1 = ret.Get(1).(int32)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, db.ListOptions) error); ok {
+		r2 = returnFunc(ctx, opts)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockStore_ListTopGainersCoins_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTopGainersCoins'
+type MockStore_ListTopGainersCoins_Call struct {
+	*mock.Call
+}
+
+// ListTopGainersCoins is a helper method to define mock.On call
+//   - ctx context.Context
+//   - opts db.ListOptions
+func (_e *MockStore_Expecter) ListTopGainersCoins(ctx interface{}, opts interface{}) *MockStore_ListTopGainersCoins_Call {
+	return &MockStore_ListTopGainersCoins_Call{Call: _e.mock.On("ListTopGainersCoins", ctx, opts)}
+}
+
+func (_c *MockStore_ListTopGainersCoins_Call) Run(run func(ctx context.Context, opts db.ListOptions)) *MockStore_ListTopGainersCoins_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.ListOptions
+		if args[1] != nil {
+			arg1 = args[1].(db.ListOptions)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_ListTopGainersCoins_Call) Return(coins []model.Coin, n int32, err error) *MockStore_ListTopGainersCoins_Call {
+	_c.Call.Return(coins, n, err)
+	return _c
+}
+
+func (_c *MockStore_ListTopGainersCoins_Call) RunAndReturn(run func(ctx context.Context, opts db.ListOptions) ([]model.Coin, int32, error)) *MockStore_ListTopGainersCoins_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTrendingCoins provides a mock function for the type MockStore
+func (_mock *MockStore) ListTrendingCoins(ctx context.Context, opts db.ListOptions) ([]model.Coin, int32, error) {
+	ret := _mock.Called(ctx, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTrendingCoins")
+	}
+
+	var r0 []model.Coin
+	var r1 int32
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.ListOptions) ([]model.Coin, int32, error)); ok {
+		return returnFunc(ctx, opts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.ListOptions) []model.Coin); ok {
+		r0 = returnFunc(ctx, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Coin)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.ListOptions) int32); ok {
+		r1 = returnFunc(ctx, opts)
+	} else {
+		r1 = ret.Get(1).(int32)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, db.ListOptions) error); ok {
+		r2 = returnFunc(ctx, opts)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockStore_ListTrendingCoins_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTrendingCoins'
+type MockStore_ListTrendingCoins_Call struct {
+	*mock.Call
+}
+
+// ListTrendingCoins is a helper method to define mock.On call
+//   - ctx context.Context
+//   - opts db.ListOptions
+func (_e *MockStore_Expecter) ListTrendingCoins(ctx interface{}, opts interface{}) *MockStore_ListTrendingCoins_Call {
+	return &MockStore_ListTrendingCoins_Call{Call: _e.mock.On("ListTrendingCoins", ctx, opts)}
+}
+
+func (_c *MockStore_ListTrendingCoins_Call) Run(run func(ctx context.Context, opts db.ListOptions)) *MockStore_ListTrendingCoins_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.ListOptions
+		if args[1] != nil {
+			arg1 = args[1].(db.ListOptions)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_ListTrendingCoins_Call) Return(coins []model.Coin, n int32, err error) *MockStore_ListTrendingCoins_Call {
+	_c.Call.Return(coins, n, err)
+	return _c
+}
+
+func (_c *MockStore_ListTrendingCoins_Call) RunAndReturn(run func(ctx context.Context, opts db.ListOptions) ([]model.Coin, int32, error)) *MockStore_ListTrendingCoins_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MEVIncidents provides a mock function for the type MockStore
+func (_mock *MockStore) MEVIncidents() db.Repository[model.MEVIncident] {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MEVIncidents")
+	}
+
+	var r0 db.Repository[model.MEVIncident]
+	if returnFunc, ok := ret.Get(0).(func() db.Repository[model.MEVIncident]); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(db.Repository[model.MEVIncident])
+		}
+	}
+	return r0
+}
+
+// MockStore_MEVIncidents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MEVIncidents'
+type MockStore_MEVIncidents_Call struct {
+	*mock.Call
+}
+
+// MEVIncidents is a helper method to define mock.On call
+func (_e *MockStore_Expecter) MEVIncidents() *MockStore_MEVIncidents_Call {
+	return &MockStore_MEVIncidents_Call{Call: _e.mock.On("MEVIncidents")}
+}
+
+func (_c *MockStore_MEVIncidents_Call) Run(run func()) *MockStore_MEVIncidents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockStore_MEVIncidents_Call) Return(repository db.Repository[model.MEVIncident]) *MockStore_MEVIncidents_Call {
+	_c.Call.Return(repository)
+	return _c
+}
+
+func (_c *MockStore_MEVIncidents_Call) RunAndReturn(run func() db.Repository[model.MEVIncident]) *MockStore_MEVIncidents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MintAuthorities provides a mock function for the type MockStore
+func (_mock *MockStore) MintAuthorities() db.Repository[model.MintAuthority] {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MintAuthorities")
+	}
+
+	var r0 db.Repository[model.MintAuthority]
+	if returnFunc, ok := ret.Get(0).(func() db.Repository[model.MintAuthority]); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(db.Repository[model.MintAuthority])
+		}
+	}
+	return r0
+}
+
+// MockStore_MintAuthorities_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MintAuthorities'
+type MockStore_MintAuthorities_Call struct {
+	*mock.Call
+}
+
+// MintAuthorities is a helper method to define mock.On call
+func (_e *MockStore_Expecter) MintAuthorities() *MockStore_MintAuthorities_Call {
+	return &MockStore_MintAuthorities_Call{Call: _e.mock.On("MintAuthorities")}
+}
+
+func (_c *MockStore_MintAuthorities_Call) Run(run func()) *MockStore_MintAuthorities_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockStore_MintAuthorities_Call) Return(repository db.Repository[model.MintAuthority]) *MockStore_MintAuthorities_Call {
+	_c.Call.Return(repository)
+	return _c
+}
+
+func (_c *MockStore_MintAuthorities_Call) RunAndReturn(run func() db.Repository[model.MintAuthority]) *MockStore_MintAuthorities_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NaughtyWords provides a mock function for the type MockStore
+func (_mock *MockStore) NaughtyWords() db.Repository[model.NaughtyWord] {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for NaughtyWords")
+	}
+
+	var r0 db.Repository[model.NaughtyWord]
+	if returnFunc, ok := ret.Get(0).(func() db.Repository[model.NaughtyWord]); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(db.Repository[model.NaughtyWord])
+		}
+	}
+	return r0
+}
+
+// MockStore_NaughtyWords_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NaughtyWords'
+type MockStore_NaughtyWords_Call struct {
+	*mock.Call
+}
+
+// NaughtyWords is a helper method to define mock.On call
+func (_e *MockStore_Expecter) NaughtyWords() *MockStore_NaughtyWords_Call {
+	return &MockStore_NaughtyWords_Call{Call: _e.mock.On("NaughtyWords")}
+}
+
+func (_c *MockStore_NaughtyWords_Call) Run(run func()) *MockStore_NaughtyWords_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockStore_NaughtyWords_Call) Return(repository db.Repository[model.NaughtyWord]) *MockStore_NaughtyWords_Call {
+	_c.Call.Return(repository)
+	return _c
+}
+
+func (_c *MockStore_NaughtyWords_Call) RunAndReturn(run func() db.Repository[model.NaughtyWord]) *MockStore_NaughtyWords_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NotificationDeliveries provides a mock function for the type MockStore
+func (_mock *MockStore) NotificationDeliveries() db.Repository[model.NotificationDelivery] {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for NotificationDeliveries")
+	}
+
+	var r0 db.Repository[model.NotificationDelivery]
+	if returnFunc, ok := ret.Get(0).(func() db.Repository[model.NotificationDelivery]); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(db.Repository[model.NotificationDelivery])
+		}
+	}
+	return r0
+}
+
+// MockStore_NotificationDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NotificationDeliveries'
+type MockStore_NotificationDeliveries_Call struct {
+	*mock.Call
+}
+
+// NotificationDeliveries is a helper method to define mock.On call
+func (_e *MockStore_Expecter) NotificationDeliveries() *MockStore_NotificationDeliveries_Call {
+	return &MockStore_NotificationDeliveries_Call{Call: _e.mock.On("NotificationDeliveries")}
+}
+
+func (_c *MockStore_NotificationDeliveries_Call) Run(run func()) *MockStore_NotificationDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockStore_NotificationDeliveries_Call) Return(repository db.Repository[model.NotificationDelivery]) *MockStore_NotificationDeliveries_Call {
+	_c.Call.Return(repository)
+	return _c
+}
+
+func (_c *MockStore_NotificationDeliveries_Call) RunAndReturn(run func() db.Repository[model.NotificationDelivery]) *MockStore_NotificationDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NotificationPreferences provides a mock function for the type MockStore
+func (_mock *MockStore) NotificationPreferences() db.Repository[model.NotificationPreferences] {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for NotificationPreferences")
+	}
+
+	var r0 db.Repository[model.NotificationPreferences]
+	if returnFunc, ok := ret.Get(0).(func() db.Repository[model.NotificationPreferences]); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(db.Repository[model.NotificationPreferences])
+		}
+	}
+	return r0
+}
+
+// MockStore_NotificationPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NotificationPreferences'
+type MockStore_NotificationPreferences_Call struct {
+	*mock.Call
+}
+
+// NotificationPreferences is a helper method to define mock.On call
+func (_e *MockStore_Expecter) NotificationPreferences() *MockStore_NotificationPreferences_Call {
+	return &MockStore_NotificationPreferences_Call{Call: _e.mock.On("NotificationPreferences")}
+}
+
+func (_c *MockStore_NotificationPreferences_Call) Run(run func()) *MockStore_NotificationPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockStore_NotificationPreferences_Call) Return(repository db.Repository[model.NotificationPreferences]) *MockStore_NotificationPreferences_Call {
+	_c.Call.Return(repository)
+	return _c
+}
+
+func (_c *MockStore_NotificationPreferences_Call) RunAndReturn(run func() db.Repository[model.NotificationPreferences]) *MockStore_NotificationPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PaperBalances provides a mock function for the type MockStore
+func (_mock *MockStore) PaperBalances() db.Repository[model.PaperBalance] {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PaperBalances")
+	}
+
+	var r0 db.Repository[model.PaperBalance]
+	if returnFunc, ok := ret.Get(0).(func() db.Repository[model.PaperBalance]); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(db.Repository[model.PaperBalance])
+		}
+	}
+	return r0
+}
+
+// MockStore_PaperBalances_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PaperBalances'
+type MockStore_PaperBalances_Call struct {
+	*mock.Call
+}
+
+// PaperBalances is a helper method to define mock.On call
+func (_e *MockStore_Expecter) PaperBalances() *MockStore_PaperBalances_Call {
+	return &MockStore_PaperBalances_Call{Call: _e.mock.On("PaperBalances")}
+}
+
+func (_c *MockStore_PaperBalances_Call) Run(run func()) *MockStore_PaperBalances_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockStore_PaperBalances_Call) Return(repository db.Repository[model.PaperBalance]) *MockStore_PaperBalances_Call {
+	_c.Call.Return(repository)
+	return _c
+}
+
+func (_c *MockStore_PaperBalances_Call) RunAndReturn(run func() db.Repository[model.PaperBalance]) *MockStore_PaperBalances_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PaperTrades provides a mock function for the type MockStore
+func (_mock *MockStore) PaperTrades() db.Repository[model.PaperTrade] {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PaperTrades")
+	}
+
+	var r0 db.Repository[model.PaperTrade]
+	if returnFunc, ok := ret.Get(0).(func() db.Repository[model.PaperTrade]); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(db.Repository[model.PaperTrade])
+		}
+	}
+	return r0
+}
+
+// MockStore_PaperTrades_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PaperTrades'
+type MockStore_PaperTrades_Call struct {
+	*mock.Call
+}
+
+// PaperTrades is a helper method to define mock.On call
+func (_e *MockStore_Expecter) PaperTrades() *MockStore_PaperTrades_Call {
+	return &MockStore_PaperTrades_Call{Call: _e.mock.On("PaperTrades")}
+}
+
+func (_c *MockStore_PaperTrades_Call) Run(run func()) *MockStore_PaperTrades_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockStore_PaperTrades_Call) Return(repository db.Repository[model.PaperTrade]) *MockStore_PaperTrades_Call {
+	_c.Call.Return(repository)
+	return _c
+}
+
+func (_c *MockStore_PaperTrades_Call) RunAndReturn(run func() db.Repository[model.PaperTrade]) *MockStore_PaperTrades_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PaymentRequests provides a mock function for the type MockStore
+func (_mock *MockStore) PaymentRequests() db.Repository[model.PaymentRequest] {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PaymentRequests")
+	}
+
+	var r0 db.Repository[model.PaymentRequest]
+	if returnFunc, ok := ret.Get(0).(func() db.Repository[model.PaymentRequest]); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(db.Repository[model.PaymentRequest])
+		}
+	}
+	return r0
+}
+
+// MockStore_PaymentRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PaymentRequests'
+type MockStore_PaymentRequests_Call struct {
+	*mock.Call
+}
+
+// PaymentRequests is a helper method to define mock.On call
+func (_e *MockStore_Expecter) PaymentRequests() *MockStore_PaymentRequests_Call {
+	return &MockStore_PaymentRequests_Call{Call: _e.mock.On("PaymentRequests")}
+}
+
+func (_c *MockStore_PaymentRequests_Call) Run(run func()) *MockStore_PaymentRequests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockStore_PaymentRequests_Call) Return(repository db.Repository[model.PaymentRequest]) *MockStore_PaymentRequests_Call {
+	_c.Call.Return(repository)
+	return _c
+}
+
+func (_c *MockStore_PaymentRequests_Call) RunAndReturn(run func() db.Repository[model.PaymentRequest]) *MockStore_PaymentRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PlaceholderIcons provides a mock function for the type MockStore
+func (_mock *MockStore) PlaceholderIcons() db.Repository[model.PlaceholderIcon] {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PlaceholderIcons")
+	}
+
+	var r0 db.Repository[model.PlaceholderIcon]
+	if returnFunc, ok := ret.Get(0).(func() db.Repository[model.PlaceholderIcon]); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(db.Repository[model.PlaceholderIcon])
+		}
+	}
+	return r0
+}
+
+// MockStore_PlaceholderIcons_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PlaceholderIcons'
+type MockStore_PlaceholderIcons_Call struct {
+	*mock.Call
+}
+
+// PlaceholderIcons is a helper method to define mock.On call
+func (_e *MockStore_Expecter) PlaceholderIcons() *MockStore_PlaceholderIcons_Call {
+	return &MockStore_PlaceholderIcons_Call{Call: _e.mock.On("PlaceholderIcons")}
+}
+
+func (_c *MockStore_PlaceholderIcons_Call) Run(run func()) *MockStore_PlaceholderIcons_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockStore_PlaceholderIcons_Call) Return(repository db.Repository[model.PlaceholderIcon]) *MockStore_PlaceholderIcons_Call {
+	_c.Call.Return(repository)
+	return _c
+}
+
+func (_c *MockStore_PlaceholderIcons_Call) RunAndReturn(run func() db.Repository[model.PlaceholderIcon]) *MockStore_PlaceholderIcons_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveClaimedTwapSlice provides a mock function for the type MockStore
+func (_mock *MockStore) SaveClaimedTwapSlice(ctx context.Context, slice *model.TwapSlice) (bool, error) {
+	ret := _mock.Called(ctx, slice)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveClaimedTwapSlice")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.TwapSlice) (bool, error)); ok {
+		return returnFunc(ctx, slice)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.TwapSlice) bool); ok {
+		r0 = returnFunc(ctx, slice)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *model.TwapSlice) error); ok {
+		r1 = returnFunc(ctx, slice)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_SaveClaimedTwapSlice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveClaimedTwapSlice'
+type MockStore_SaveClaimedTwapSlice_Call struct {
+	*mock.Call
+}
+
+// SaveClaimedTwapSlice is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slice *model.TwapSlice
+func (_e *MockStore_Expecter) SaveClaimedTwapSlice(ctx interface{}, slice interface{}) *MockStore_SaveClaimedTwapSlice_Call {
+	return &MockStore_SaveClaimedTwapSlice_Call{Call: _e.mock.On("SaveClaimedTwapSlice", ctx, slice)}
+}
+
+func (_c *MockStore_SaveClaimedTwapSlice_Call) Run(run func(ctx context.Context, slice *model.TwapSlice)) *MockStore_SaveClaimedTwapSlice_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.TwapSlice
+		if args[1] != nil {
+			arg1 = args[1].(*model.TwapSlice)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_SaveClaimedTwapSlice_Call) Return(b bool, err error) *MockStore_SaveClaimedTwapSlice_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockStore_SaveClaimedTwapSlice_Call) RunAndReturn(run func(ctx context.Context, slice *model.TwapSlice) (bool, error)) *MockStore_SaveClaimedTwapSlice_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchArchivedCoins provides a mock function for the type MockStore
+func (_mock *MockStore) SearchArchivedCoins(ctx context.Context, query string, limit int32, offset int32) ([]model.Coin, error) {
+	ret := _mock.Called(ctx, query, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchArchivedCoins")
+	}
+
+	var r0 []model.Coin
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int32, int32) ([]model.Coin, error)); ok {
+		return returnFunc(ctx, query, limit, offset)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int32, int32) []model.Coin); ok {
+		r0 = returnFunc(ctx, query, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Coin)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int32, int32) error); ok {
+		r1 = returnFunc(ctx, query, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_SearchArchivedCoins_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchArchivedCoins'
+type MockStore_SearchArchivedCoins_Call struct {
+	*mock.Call
+}
+
+// SearchArchivedCoins is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query string
+//   - limit int32
+//   - offset int32
+func (_e *MockStore_Expecter) SearchArchivedCoins(ctx interface{}, query interface{}, limit interface{}, offset interface{}) *MockStore_SearchArchivedCoins_Call {
+	return &MockStore_SearchArchivedCoins_Call{Call: _e.mock.On("SearchArchivedCoins", ctx, query, limit, offset)}
+}
+
+func (_c *MockStore_SearchArchivedCoins_Call) Run(run func(ctx context.Context, query string, limit int32, offset int32)) *MockStore_SearchArchivedCoins_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int32
+		if args[2] != nil {
+			arg2 = args[2].(int32)
+		}
+		var arg3 int32
+		if args[3] != nil {
+			arg3 = args[3].(int32)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_SearchArchivedCoins_Call) Return(coins []model.Coin, err error) *MockStore_SearchArchivedCoins_Call {
+	_c.Call.Return(coins, err)
+	return _c
+}
+
+func (_c *MockStore_SearchArchivedCoins_Call) RunAndReturn(run func(ctx context.Context, query string, limit int32, offset int32) ([]model.Coin, error)) *MockStore_SearchArchivedCoins_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchCoins provides a mock function for the type MockStore
+func (_mock *MockStore) SearchCoins(ctx context.Context, query string, tags []string, minVolume24h float64, limit int32, offset int32, sortBy string, sortDesc bool) ([]model.Coin, error) {
+	ret := _mock.Called(ctx, query, tags, minVolume24h, limit, offset, sortBy, sortDesc)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchCoins")
+	}
+
+	var r0 []model.Coin
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string, float64, int32, int32, string, bool) ([]model.Coin, error)); ok {
+		return returnFunc(ctx, query, tags, minVolume24h, limit, offset, sortBy, sortDesc)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string, float64, int32, int32, string, bool) []model.Coin); ok {
+		r0 = returnFunc(ctx, query, tags, minVolume24h, limit, offset, sortBy, sortDesc)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Coin)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, []string, float64, int32, int32, string, bool) error); ok {
+		r1 = returnFunc(ctx, query, tags, minVolume24h, limit, offset, sortBy, sortDesc)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_SearchCoins_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchCoins'
+type MockStore_SearchCoins_Call struct {
+	*mock.Call
+}
+
+// SearchCoins is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query string
+//   - tags []string
+//   - minVolume24h float64
+//   - limit int32
+//   - offset int32
+//   - sortBy string
+//   - sortDesc bool
+func (_e *MockStore_Expecter) SearchCoins(ctx interface{}, query interface{}, tags interface{}, minVolume24h interface{}, limit interface{}, offset interface{}, sortBy interface{}, sortDesc interface{}) *MockStore_SearchCoins_Call {
+	return &MockStore_SearchCoins_Call{Call: _e.mock.On("SearchCoins", ctx, query, tags, minVolume24h, limit, offset, sortBy, sortDesc)}
+}
+
+func (_c *MockStore_SearchCoins_Call) Run(run func(ctx context.Context, query string, tags []string, minVolume24h float64, limit int32, offset int32, sortBy string, sortDesc bool)) *MockStore_SearchCoins_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []string
+		if args[2] != nil {
 			arg2 = args[2].([]string)
 		}
 		var arg3 float64
@@ -486,30 +2551,323 @@ func (_c *MockStore_SearchCoins_Call) Run(run func(ctx context.Context, query st
 		if args[6] != nil {
 			arg6 = args[6].(string)
 		}
-		var arg7 bool
-		if args[7] != nil {
-			arg7 = args[7].(bool)
+		var arg7 bool
+		if args[7] != nil {
+			arg7 = args[7].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+			arg6,
+			arg7,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_SearchCoins_Call) Return(coins []model.Coin, err error) *MockStore_SearchCoins_Call {
+	_c.Call.Return(coins, err)
+	return _c
+}
+
+func (_c *MockStore_SearchCoins_Call) RunAndReturn(run func(ctx context.Context, query string, tags []string, minVolume24h float64, limit int32, offset int32, sortBy string, sortDesc bool) ([]model.Coin, error)) *MockStore_SearchCoins_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchSynonyms provides a mock function for the type MockStore
+func (_mock *MockStore) SearchSynonyms() db.Repository[model.SearchSynonym] {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchSynonyms")
+	}
+
+	var r0 db.Repository[model.SearchSynonym]
+	if returnFunc, ok := ret.Get(0).(func() db.Repository[model.SearchSynonym]); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(db.Repository[model.SearchSynonym])
+		}
+	}
+	return r0
+}
+
+// MockStore_SearchSynonyms_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchSynonyms'
+type MockStore_SearchSynonyms_Call struct {
+	*mock.Call
+}
+
+// SearchSynonyms is a helper method to define mock.On call
+func (_e *MockStore_Expecter) SearchSynonyms() *MockStore_SearchSynonyms_Call {
+	return &MockStore_SearchSynonyms_Call{Call: _e.mock.On("SearchSynonyms")}
+}
+
+func (_c *MockStore_SearchSynonyms_Call) Run(run func()) *MockStore_SearchSynonyms_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockStore_SearchSynonyms_Call) Return(repository db.Repository[model.SearchSynonym]) *MockStore_SearchSynonyms_Call {
+	_c.Call.Return(repository)
+	return _c
+}
+
+func (_c *MockStore_SearchSynonyms_Call) RunAndReturn(run func() db.Repository[model.SearchSynonym]) *MockStore_SearchSynonyms_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetCoinLogoSource provides a mock function for the type MockStore
+func (_mock *MockStore) SetCoinLogoSource(ctx context.Context, address string, sourceURL string) error {
+	ret := _mock.Called(ctx, address, sourceURL)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCoinLogoSource")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, address, sourceURL)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStore_SetCoinLogoSource_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCoinLogoSource'
+type MockStore_SetCoinLogoSource_Call struct {
+	*mock.Call
+}
+
+// SetCoinLogoSource is a helper method to define mock.On call
+//   - ctx context.Context
+//   - address string
+//   - sourceURL string
+func (_e *MockStore_Expecter) SetCoinLogoSource(ctx interface{}, address interface{}, sourceURL interface{}) *MockStore_SetCoinLogoSource_Call {
+	return &MockStore_SetCoinLogoSource_Call{Call: _e.mock.On("SetCoinLogoSource", ctx, address, sourceURL)}
+}
+
+func (_c *MockStore_SetCoinLogoSource_Call) Run(run func(ctx context.Context, address string, sourceURL string)) *MockStore_SetCoinLogoSource_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_SetCoinLogoSource_Call) Return(err error) *MockStore_SetCoinLogoSource_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStore_SetCoinLogoSource_Call) RunAndReturn(run func(ctx context.Context, address string, sourceURL string) error) *MockStore_SetCoinLogoSource_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Settings provides a mock function for the type MockStore
+func (_mock *MockStore) Settings() db.Repository[model.Setting] {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Settings")
+	}
+
+	var r0 db.Repository[model.Setting]
+	if returnFunc, ok := ret.Get(0).(func() db.Repository[model.Setting]); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(db.Repository[model.Setting])
+		}
+	}
+	return r0
+}
+
+// MockStore_Settings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Settings'
+type MockStore_Settings_Call struct {
+	*mock.Call
+}
+
+// Settings is a helper method to define mock.On call
+func (_e *MockStore_Expecter) Settings() *MockStore_Settings_Call {
+	return &MockStore_Settings_Call{Call: _e.mock.On("Settings")}
+}
+
+func (_c *MockStore_Settings_Call) Run(run func()) *MockStore_Settings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockStore_Settings_Call) Return(repository db.Repository[model.Setting]) *MockStore_Settings_Call {
+	_c.Call.Return(repository)
+	return _c
+}
+
+func (_c *MockStore_Settings_Call) RunAndReturn(run func() db.Repository[model.Setting]) *MockStore_Settings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SpamTokens provides a mock function for the type MockStore
+func (_mock *MockStore) SpamTokens() db.Repository[model.SpamToken] {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SpamTokens")
+	}
+
+	var r0 db.Repository[model.SpamToken]
+	if returnFunc, ok := ret.Get(0).(func() db.Repository[model.SpamToken]); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(db.Repository[model.SpamToken])
+		}
+	}
+	return r0
+}
+
+// MockStore_SpamTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SpamTokens'
+type MockStore_SpamTokens_Call struct {
+	*mock.Call
+}
+
+// SpamTokens is a helper method to define mock.On call
+func (_e *MockStore_Expecter) SpamTokens() *MockStore_SpamTokens_Call {
+	return &MockStore_SpamTokens_Call{Call: _e.mock.On("SpamTokens")}
+}
+
+func (_c *MockStore_SpamTokens_Call) Run(run func()) *MockStore_SpamTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockStore_SpamTokens_Call) Return(repository db.Repository[model.SpamToken]) *MockStore_SpamTokens_Call {
+	_c.Call.Return(repository)
+	return _c
+}
+
+func (_c *MockStore_SpamTokens_Call) RunAndReturn(run func() db.Repository[model.SpamToken]) *MockStore_SpamTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TradeLimits provides a mock function for the type MockStore
+func (_mock *MockStore) TradeLimits() db.Repository[model.TradeLimit] {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for TradeLimits")
+	}
+
+	var r0 db.Repository[model.TradeLimit]
+	if returnFunc, ok := ret.Get(0).(func() db.Repository[model.TradeLimit]); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(db.Repository[model.TradeLimit])
+		}
+	}
+	return r0
+}
+
+// MockStore_TradeLimits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TradeLimits'
+type MockStore_TradeLimits_Call struct {
+	*mock.Call
+}
+
+// TradeLimits is a helper method to define mock.On call
+func (_e *MockStore_Expecter) TradeLimits() *MockStore_TradeLimits_Call {
+	return &MockStore_TradeLimits_Call{Call: _e.mock.On("TradeLimits")}
+}
+
+func (_c *MockStore_TradeLimits_Call) Run(run func()) *MockStore_TradeLimits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockStore_TradeLimits_Call) Return(repository db.Repository[model.TradeLimit]) *MockStore_TradeLimits_Call {
+	_c.Call.Return(repository)
+	return _c
+}
+
+func (_c *MockStore_TradeLimits_Call) RunAndReturn(run func() db.Repository[model.TradeLimit]) *MockStore_TradeLimits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TradeVolumes provides a mock function for the type MockStore
+func (_mock *MockStore) TradeVolumes() db.Repository[model.TradeVolume] {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for TradeVolumes")
+	}
+
+	var r0 db.Repository[model.TradeVolume]
+	if returnFunc, ok := ret.Get(0).(func() db.Repository[model.TradeVolume]); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(db.Repository[model.TradeVolume])
 		}
-		run(
-			arg0,
-			arg1,
-			arg2,
-			arg3,
-			arg4,
-			arg5,
-			arg6,
-			arg7,
-		)
+	}
+	return r0
+}
+
+// MockStore_TradeVolumes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TradeVolumes'
+type MockStore_TradeVolumes_Call struct {
+	*mock.Call
+}
+
+// TradeVolumes is a helper method to define mock.On call
+func (_e *MockStore_Expecter) TradeVolumes() *MockStore_TradeVolumes_Call {
+	return &MockStore_TradeVolumes_Call{Call: _e.mock.On("TradeVolumes")}
+}
+
+func (_c *MockStore_TradeVolumes_Call) Run(run func()) *MockStore_TradeVolumes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
 	})
 	return _c
 }
 
-func (_c *MockStore_SearchCoins_Call) Return(coins []model.Coin, err error) *MockStore_SearchCoins_Call {
-	_c.Call.Return(coins, err)
+func (_c *MockStore_TradeVolumes_Call) Return(repository db.Repository[model.TradeVolume]) *MockStore_TradeVolumes_Call {
+	_c.Call.Return(repository)
 	return _c
 }
 
-func (_c *MockStore_SearchCoins_Call) RunAndReturn(run func(ctx context.Context, query string, tags []string, minVolume24h float64, limit int32, offset int32, sortBy string, sortDesc bool) ([]model.Coin, error)) *MockStore_SearchCoins_Call {
+func (_c *MockStore_TradeVolumes_Call) RunAndReturn(run func() db.Repository[model.TradeVolume]) *MockStore_TradeVolumes_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -560,6 +2918,155 @@ func (_c *MockStore_Trades_Call) RunAndReturn(run func() db.Repository[model.Tra
 	return _c
 }
 
+// TwapOrders provides a mock function for the type MockStore
+func (_mock *MockStore) TwapOrders() db.Repository[model.TwapOrder] {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for TwapOrders")
+	}
+
+	var r0 db.Repository[model.TwapOrder]
+	if returnFunc, ok := ret.Get(0).(func() db.Repository[model.TwapOrder]); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(db.Repository[model.TwapOrder])
+		}
+	}
+	return r0
+}
+
+// MockStore_TwapOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TwapOrders'
+type MockStore_TwapOrders_Call struct {
+	*mock.Call
+}
+
+// TwapOrders is a helper method to define mock.On call
+func (_e *MockStore_Expecter) TwapOrders() *MockStore_TwapOrders_Call {
+	return &MockStore_TwapOrders_Call{Call: _e.mock.On("TwapOrders")}
+}
+
+func (_c *MockStore_TwapOrders_Call) Run(run func()) *MockStore_TwapOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockStore_TwapOrders_Call) Return(repository db.Repository[model.TwapOrder]) *MockStore_TwapOrders_Call {
+	_c.Call.Return(repository)
+	return _c
+}
+
+func (_c *MockStore_TwapOrders_Call) RunAndReturn(run func() db.Repository[model.TwapOrder]) *MockStore_TwapOrders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TwapSlices provides a mock function for the type MockStore
+func (_mock *MockStore) TwapSlices() db.Repository[model.TwapSlice] {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for TwapSlices")
+	}
+
+	var r0 db.Repository[model.TwapSlice]
+	if returnFunc, ok := ret.Get(0).(func() db.Repository[model.TwapSlice]); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(db.Repository[model.TwapSlice])
+		}
+	}
+	return r0
+}
+
+// MockStore_TwapSlices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TwapSlices'
+type MockStore_TwapSlices_Call struct {
+	*mock.Call
+}
+
+// TwapSlices is a helper method to define mock.On call
+func (_e *MockStore_Expecter) TwapSlices() *MockStore_TwapSlices_Call {
+	return &MockStore_TwapSlices_Call{Call: _e.mock.On("TwapSlices")}
+}
+
+func (_c *MockStore_TwapSlices_Call) Run(run func()) *MockStore_TwapSlices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockStore_TwapSlices_Call) Return(repository db.Repository[model.TwapSlice]) *MockStore_TwapSlices_Call {
+	_c.Call.Return(repository)
+	return _c
+}
+
+func (_c *MockStore_TwapSlices_Call) RunAndReturn(run func() db.Repository[model.TwapSlice]) *MockStore_TwapSlices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpsertPricePoints provides a mock function for the type MockStore
+func (_mock *MockStore) UpsertPricePoints(ctx context.Context, points []model.PricePoint) error {
+	ret := _mock.Called(ctx, points)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertPricePoints")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []model.PricePoint) error); ok {
+		r0 = returnFunc(ctx, points)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStore_UpsertPricePoints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertPricePoints'
+type MockStore_UpsertPricePoints_Call struct {
+	*mock.Call
+}
+
+// UpsertPricePoints is a helper method to define mock.On call
+//   - ctx context.Context
+//   - points []model.PricePoint
+func (_e *MockStore_Expecter) UpsertPricePoints(ctx interface{}, points interface{}) *MockStore_UpsertPricePoints_Call {
+	return &MockStore_UpsertPricePoints_Call{Call: _e.mock.On("UpsertPricePoints", ctx, points)}
+}
+
+func (_c *MockStore_UpsertPricePoints_Call) Run(run func(ctx context.Context, points []model.PricePoint)) *MockStore_UpsertPricePoints_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []model.PricePoint
+		if args[1] != nil {
+			arg1 = args[1].([]model.PricePoint)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_UpsertPricePoints_Call) Return(err error) *MockStore_UpsertPricePoints_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStore_UpsertPricePoints_Call) RunAndReturn(run func(ctx context.Context, points []model.PricePoint) error) *MockStore_UpsertPricePoints_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Wallet provides a mock function for the type MockStore
 func (_mock *MockStore) Wallet() db.Repository[model.Wallet] {
 	ret := _mock.Called()
@@ -606,6 +3113,98 @@ func (_c *MockStore_Wallet_Call) RunAndReturn(run func() db.Repository[model.Wal
 	return _c
 }
 
+// WebhookDeadLetters provides a mock function for the type MockStore
+func (_mock *MockStore) WebhookDeadLetters() db.Repository[model.WebhookDeadLetter] {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for WebhookDeadLetters")
+	}
+
+	var r0 db.Repository[model.WebhookDeadLetter]
+	if returnFunc, ok := ret.Get(0).(func() db.Repository[model.WebhookDeadLetter]); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(db.Repository[model.WebhookDeadLetter])
+		}
+	}
+	return r0
+}
+
+// MockStore_WebhookDeadLetters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WebhookDeadLetters'
+type MockStore_WebhookDeadLetters_Call struct {
+	*mock.Call
+}
+
+// WebhookDeadLetters is a helper method to define mock.On call
+func (_e *MockStore_Expecter) WebhookDeadLetters() *MockStore_WebhookDeadLetters_Call {
+	return &MockStore_WebhookDeadLetters_Call{Call: _e.mock.On("WebhookDeadLetters")}
+}
+
+func (_c *MockStore_WebhookDeadLetters_Call) Run(run func()) *MockStore_WebhookDeadLetters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockStore_WebhookDeadLetters_Call) Return(repository db.Repository[model.WebhookDeadLetter]) *MockStore_WebhookDeadLetters_Call {
+	_c.Call.Return(repository)
+	return _c
+}
+
+func (_c *MockStore_WebhookDeadLetters_Call) RunAndReturn(run func() db.Repository[model.WebhookDeadLetter]) *MockStore_WebhookDeadLetters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WebhookSubscriptions provides a mock function for the type MockStore
+func (_mock *MockStore) WebhookSubscriptions() db.Repository[model.WebhookSubscription] {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for WebhookSubscriptions")
+	}
+
+	var r0 db.Repository[model.WebhookSubscription]
+	if returnFunc, ok := ret.Get(0).(func() db.Repository[model.WebhookSubscription]); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(db.Repository[model.WebhookSubscription])
+		}
+	}
+	return r0
+}
+
+// MockStore_WebhookSubscriptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WebhookSubscriptions'
+type MockStore_WebhookSubscriptions_Call struct {
+	*mock.Call
+}
+
+// WebhookSubscriptions is a helper method to define mock.On call
+func (_e *MockStore_Expecter) WebhookSubscriptions() *MockStore_WebhookSubscriptions_Call {
+	return &MockStore_WebhookSubscriptions_Call{Call: _e.mock.On("WebhookSubscriptions")}
+}
+
+func (_c *MockStore_WebhookSubscriptions_Call) Run(run func()) *MockStore_WebhookSubscriptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockStore_WebhookSubscriptions_Call) Return(repository db.Repository[model.WebhookSubscription]) *MockStore_WebhookSubscriptions_Call {
+	_c.Call.Return(repository)
+	return _c
+}
+
+func (_c *MockStore_WebhookSubscriptions_Call) RunAndReturn(run func() db.Repository[model.WebhookSubscription]) *MockStore_WebhookSubscriptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WithTransaction provides a mock function for the type MockStore
 func (_mock *MockStore) WithTransaction(ctx context.Context, fn func(s db.Store) error) error {
 	ret := _mock.Called(ctx, fn)
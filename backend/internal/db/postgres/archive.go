@@ -0,0 +1,162 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/db/postgres/schema"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+// ArchiveInactiveCoins moves the coins matching criteria from coins to
+// coins_archive and returns their addresses. Candidates are locked while they
+// are moved, so a coin a fetcher is refreshing is skipped rather than archived.
+func (s *Store) ArchiveInactiveCoins(ctx context.Context, criteria db.ArchiveCriteria) ([]string, error) {
+	columns, err := coinColumns(s.db)
+	if err != nil {
+		return nil, err
+	}
+
+	var addresses []string
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		query := archivableCoins(tx, criteria)
+		if !criteria.DryRun {
+			query = query.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"})
+		}
+		if err := query.Pluck("address", &addresses).Error; err != nil {
+			return fmt.Errorf("failed to select inactive coins: %w", err)
+		}
+		if criteria.DryRun || len(addresses) == 0 {
+			return nil
+		}
+
+		cols := strings.Join(columns, ", ")
+		updates := make([]string, 0, len(columns))
+		for _, col := range columns {
+			if col != "address" {
+				updates = append(updates, fmt.Sprintf("%s = EXCLUDED.%s", col, col))
+			}
+		}
+		insert := fmt.Sprintf(
+			"INSERT INTO coins_archive (%s, archived_at) SELECT %s, ? FROM coins WHERE address IN ? ON CONFLICT (address) DO UPDATE SET %s, archived_at = EXCLUDED.archived_at",
+			cols, cols, strings.Join(updates, ", "))
+		if err := tx.Exec(insert, time.Now(), addresses).Error; err != nil {
+			return fmt.Errorf("failed to copy coins to archive: %w", err)
+		}
+		if err := tx.Unscoped().Where("address IN ?", addresses).Delete(&schema.Coin{}).Error; err != nil {
+			return fmt.Errorf("failed to delete archived coins: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "PostgresStore: ArchiveInactiveCoins finished", "count", len(addresses), "dry_run", criteria.DryRun)
+	return addresses, nil
+}
+
+// archivableCoins builds the query for coins that match criteria, least
+// recently updated first
+func archivableCoins(tx *gorm.DB, criteria db.ArchiveCriteria) *gorm.DB {
+	query := tx.Model(&schema.Coin{}).
+		Where("volume_24h_usd <= ? AND liquidity <= ?", criteria.MaxVolume24hUSD, criteria.MaxLiquidity).
+		Where("last_updated < ? AND created_at < ?", criteria.InactiveSince, criteria.InactiveSince).
+		Where("NOT EXISTS (SELECT 1 FROM trades WHERE (trades.from_coin_mint_address = coins.address OR trades.to_coin_mint_address = coins.address) AND trades.created_at >= ?)", criteria.InactiveSince)
+	if len(criteria.ExcludeTags) > 0 {
+		query = query.Where("NOT (COALESCE(tags, '{}') && ?)", pq.Array(criteria.ExcludeTags))
+	}
+	if len(criteria.ExcludeMints) > 0 {
+		query = query.Where("address NOT IN ?", criteria.ExcludeMints)
+	}
+	query = query.Order("last_updated ASC")
+	if criteria.Limit > 0 {
+		query = query.Limit(criteria.Limit)
+	}
+	return query
+}
+
+// coinColumns returns the columns shared by coins and coins_archive
+func coinColumns(database *gorm.DB) ([]string, error) {
+	coins := &gorm.Statement{DB: database}
+	if err := coins.Parse(&schema.Coin{}); err != nil {
+		return nil, fmt.Errorf("failed to parse coin schema: %w", err)
+	}
+	archive := &gorm.Statement{DB: database}
+	if err := archive.Parse(&schema.ArchivedCoin{}); err != nil {
+		return nil, fmt.Errorf("failed to parse archived coin schema: %w", err)
+	}
+	columns := make([]string, 0, len(coins.Schema.DBNames))
+	for _, column := range coins.Schema.DBNames {
+		if _, ok := archive.Schema.FieldsByDBName[column]; ok {
+			columns = append(columns, column)
+		}
+	}
+	return columns, nil
+}
+
+// SearchArchivedCoins searches archived coins by name, symbol or address, most
+// recently archived first. Coins that have since been re-listed in coins are
+// left out, since the live row is authoritative.
+func (s *Store) SearchArchivedCoins(ctx context.Context, query string, limit, offset int32) ([]model.Coin, error) {
+	tx := s.db.WithContext(ctx).Model(&schema.ArchivedCoin{}).
+		Where("NOT EXISTS (SELECT 1 FROM coins WHERE coins.address = coins_archive.address)")
+	if query != "" {
+		searchQuery := "%" + strings.ToLower(query) + "%"
+		tx = tx.Where("LOWER(name) LIKE ? OR LOWER(symbol) LIKE ? OR LOWER(address) LIKE ?", searchQuery, searchQuery, searchQuery)
+	}
+	tx = tx.Order("archived_at DESC")
+	if limit > 0 {
+		tx = tx.Limit(int(limit))
+	}
+	if offset > 0 {
+		tx = tx.Offset(int(offset))
+	}
+
+	var archived []schema.ArchivedCoin
+	if err := tx.Find(&archived).Error; err != nil {
+		return nil, fmt.Errorf("failed to search archived coins: %w", err)
+	}
+
+	live := make([]schema.Coin, len(archived))
+	for i, ac := range archived {
+		live[i] = schema.Coin{
+			ID:                     ac.ID,
+			Address:                ac.Address,
+			Name:                   ac.Name,
+			Symbol:                 ac.Symbol,
+			Decimals:               ac.Decimals,
+			Description:            ac.Description,
+			LogoURI:                ac.LogoURI,
+			Tags:                   ac.Tags,
+			Price:                  ac.Price,
+			Price24hChangePercent:  ac.Price24hChangePercent,
+			Marketcap:              ac.Marketcap,
+			Volume24hUSD:           ac.Volume24hUSD,
+			Volume24hChangePercent: ac.Volume24hChangePercent,
+			Liquidity:              ac.Liquidity,
+			FDV:                    ac.FDV,
+			Rank:                   ac.Rank,
+			Website:                ac.Website,
+			Twitter:                ac.Twitter,
+			Telegram:               ac.Telegram,
+			Discord:                ac.Discord,
+			CreatedAt:              ac.CreatedAt,
+			LastUpdated:            ac.LastUpdated,
+			JupiterCreatedAt:       ac.JupiterCreatedAt,
+		}
+	}
+	coins := mapSchemaCoinsToModel(live)
+	for i := range coins {
+		coins[i].Archived = true
+	}
+	return coins, nil
+}
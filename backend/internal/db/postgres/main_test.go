@@ -0,0 +1,11 @@
+package postgres_test
+
+import (
+	"testing"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db/dbtest"
+)
+
+func TestMain(m *testing.M) {
+	dbtest.Main(m)
+}
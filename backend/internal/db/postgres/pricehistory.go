@@ -0,0 +1,155 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db/postgres/schema"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+// pricePointBatchSize keeps a single upsert under postgres' bind parameter limit
+const pricePointBatchSize = 1000
+
+// UpsertPricePoints stores price samples, replacing any sample already stored
+// for the same address, resolution and time.
+func (s *Store) UpsertPricePoints(ctx context.Context, points []model.PricePoint) error {
+	if len(points) == 0 {
+		return nil
+	}
+	rows := make([]schema.PricePoint, len(points))
+	for i, p := range points {
+		rows[i] = schema.PricePoint{
+			Address:    p.Address,
+			Resolution: int64(p.Resolution / time.Second),
+			UnixTime:   p.Time.Unix(),
+			Value:      p.Value,
+		}
+	}
+	err := s.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "address"}, {Name: "resolution"}, {Name: "unix_time"}},
+			DoUpdates: clause.AssignmentColumns([]string{"value"}),
+		}).
+		CreateInBatches(&rows, pricePointBatchSize).Error
+	if err != nil {
+		return fmt.Errorf("failed to upsert price points: %w", err)
+	}
+	return nil
+}
+
+// ListPricePoints returns the samples for address in [from, to] stored at
+// maxResolution or finer, oldest first.
+func (s *Store) ListPricePoints(ctx context.Context, address string, maxResolution time.Duration, from, to time.Time) ([]model.PricePoint, error) {
+	var rows []schema.PricePoint
+	err := s.db.WithContext(ctx).
+		Where("address = ? AND resolution <= ? AND unix_time BETWEEN ? AND ?", address, int64(maxResolution/time.Second), from.Unix(), to.Unix()).
+		Order("unix_time ASC, resolution ASC").
+		Find(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list price points: %w", err)
+	}
+	points := make([]model.PricePoint, len(rows))
+	for i, r := range rows {
+		points[i] = model.PricePoint{
+			Address:    r.Address,
+			Resolution: time.Duration(r.Resolution) * time.Second,
+			Time:       time.Unix(r.UnixTime, 0).UTC(),
+			Value:      r.Value,
+		}
+	}
+	return points, nil
+}
+
+// AddPriceHistoryRange records a fetched span, merging it with the overlapping
+// or adjacent spans already stored at the same resolution so each address keeps
+// only a handful of rows.
+func (s *Store) AddPriceHistoryRange(ctx context.Context, r model.PriceHistoryRange) error {
+	resolution := int64(r.Resolution / time.Second)
+	merged := schema.PriceHistoryRange{Address: r.Address, Resolution: resolution, FromUnix: r.From.Unix(), ToUnix: r.To.Unix()}
+	if merged.ToUnix < merged.FromUnix {
+		return fmt.Errorf("price history range ends before it starts: %s > %s", r.From, r.To)
+	}
+
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var overlapping []schema.PriceHistoryRange
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("address = ? AND resolution = ? AND from_unix <= ? AND to_unix >= ?", r.Address, resolution, merged.ToUnix, merged.FromUnix).
+			Find(&overlapping).Error
+		if err != nil {
+			return fmt.Errorf("failed to load price history ranges: %w", err)
+		}
+		ids := make([]uint, 0, len(overlapping))
+		for _, o := range overlapping {
+			merged.FromUnix = min(merged.FromUnix, o.FromUnix)
+			merged.ToUnix = max(merged.ToUnix, o.ToUnix)
+			ids = append(ids, o.ID)
+		}
+		if len(ids) > 0 {
+			if err := tx.Delete(&schema.PriceHistoryRange{}, ids).Error; err != nil {
+				return fmt.Errorf("failed to merge price history ranges: %w", err)
+			}
+		}
+		if err := tx.Create(&merged).Error; err != nil {
+			return fmt.Errorf("failed to save price history range: %w", err)
+		}
+		return nil
+	})
+}
+
+// ListPriceHistoryRanges returns the fetched spans for address overlapping
+// [from, to] at maxResolution or finer.
+func (s *Store) ListPriceHistoryRanges(ctx context.Context, address string, maxResolution time.Duration, from, to time.Time) ([]model.PriceHistoryRange, error) {
+	var rows []schema.PriceHistoryRange
+	err := s.db.WithContext(ctx).
+		Where("address = ? AND resolution <= ? AND from_unix <= ? AND to_unix >= ?", address, int64(maxResolution/time.Second), to.Unix(), from.Unix()).
+		Order("from_unix ASC").
+		Find(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list price history ranges: %w", err)
+	}
+	ranges := make([]model.PriceHistoryRange, len(rows))
+	for i, r := range rows {
+		ranges[i] = model.PriceHistoryRange{
+			Address:    r.Address,
+			Resolution: time.Duration(r.Resolution) * time.Second,
+			From:       time.Unix(r.FromUnix, 0).UTC(),
+			To:         time.Unix(r.ToUnix, 0).UTC(),
+		}
+	}
+	return ranges, nil
+}
+
+// DownsamplePricePoints replaces the samples at resolution from or coarser, but
+// finer than to, that are older than before with their per-bucket average at
+// resolution to, and returns how many samples were replaced. before is truncated to a whole to-bucket so a bucket
+// is never averaged from only part of its samples.
+func (s *Store) DownsamplePricePoints(ctx context.Context, from, to time.Duration, before time.Time) (int64, error) {
+	fromSeconds := int64(from / time.Second)
+	toSeconds := int64(to / time.Second)
+	if fromSeconds <= 0 || toSeconds <= fromSeconds {
+		return 0, fmt.Errorf("invalid downsampling from %s to %s", from, to)
+	}
+	cutoff := before.Unix() / toSeconds * toSeconds
+
+	var moved int64
+	err := s.db.WithContext(ctx).Raw(`
+WITH moved AS (
+	DELETE FROM price_points WHERE resolution >= ? AND resolution < ? AND unix_time < ?
+	RETURNING address, unix_time, value
+), inserted AS (
+	INSERT INTO price_points (address, resolution, unix_time, value)
+	SELECT address, ?, unix_time / ? * ?, AVG(value) FROM moved GROUP BY 1, 3
+	ON CONFLICT (address, resolution, unix_time) DO UPDATE SET value = EXCLUDED.value
+)
+SELECT COUNT(*) FROM moved`, fromSeconds, toSeconds, cutoff, toSeconds, toSeconds, toSeconds).
+		Scan(&moved).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to downsample price points from %s to %s: %w", from, to, err)
+	}
+	return moved, nil
+}
@@ -0,0 +1,274 @@
+package postgres
+
+import (
+	"log/slog"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"gorm.io/gorm"
+)
+
+// DefaultSlowQueryThreshold is how long a query runs before it is logged and
+// tracked as slow
+const DefaultSlowQueryThreshold = 200 * time.Millisecond
+
+const (
+	// maxTrackedSlowQueries bounds the slow query table; the query with the
+	// least total time is evicted to make room
+	maxTrackedSlowQueries = 200
+	// slowQueryLogInterval limits how often the same query is logged
+	slowQueryLogInterval = time.Minute
+
+	queryStartKey = "querystats:start"
+)
+
+// SlowQuery aggregates the slow executions of one normalized statement
+type SlowQuery struct {
+	SQL      string
+	Table    string
+	Count    int64
+	Total    time.Duration
+	Max      time.Duration
+	LastSeen time.Time
+
+	lastLogged time.Time
+	suppressed int64
+}
+
+// Avg returns the mean duration of the slow executions
+func (q SlowQuery) Avg() time.Duration {
+	if q.Count == 0 {
+		return 0
+	}
+	return q.Total / time.Duration(q.Count)
+}
+
+// QueryStats is a gorm plugin that records every query's duration by table and
+// keeps the queries over a threshold for the admin API
+type QueryStats struct {
+	threshold atomic.Int64 // nanoseconds
+
+	duration    metric.Float64Histogram
+	slowQueries metric.Int64Counter
+
+	mu   sync.Mutex
+	slow map[string]*SlowQuery
+	now  func() time.Time
+}
+
+var _ gorm.Plugin = (*QueryStats)(nil)
+
+// NewQueryStats creates a QueryStats reporting to the global meter provider,
+// so it can be installed before telemetry is initialized
+func NewQueryStats(threshold time.Duration) *QueryStats {
+	meter := otel.Meter("github.com/nicolas-martin/dankfolio/backend/internal/db/postgres")
+	duration, err := meter.Float64Histogram(
+		"dankfolio.db.query.duration",
+		metric.WithDescription("Duration of database queries by repository table and operation"),
+		metric.WithUnit("ms"),
+	)
+	if err != nil {
+		slog.Warn("Failed to create query duration histogram", "error", err)
+	}
+	slowQueries, err := meter.Int64Counter(
+		"dankfolio.db.slow_queries_total",
+		metric.WithDescription("Queries that ran longer than the slow query threshold"),
+		metric.WithUnit("{query}"),
+	)
+	if err != nil {
+		slog.Warn("Failed to create slow query counter", "error", err)
+	}
+
+	qs := &QueryStats{
+		duration:    duration,
+		slowQueries: slowQueries,
+		slow:        make(map[string]*SlowQuery),
+		now:         time.Now,
+	}
+	qs.SetThreshold(threshold)
+	return qs
+}
+
+// SetThreshold changes the slow query threshold; zero or less disables tracking
+func (qs *QueryStats) SetThreshold(threshold time.Duration) {
+	qs.threshold.Store(int64(threshold))
+}
+
+// Threshold returns the current slow query threshold
+func (qs *QueryStats) Threshold() time.Duration {
+	return time.Duration(qs.threshold.Load())
+}
+
+// Name implements gorm.Plugin
+func (qs *QueryStats) Name() string {
+	return "querystats"
+}
+
+// Initialize implements gorm.Plugin by timing every callback chain
+func (qs *QueryStats) Initialize(db *gorm.DB) error {
+	cb := db.Callback()
+	for _, err := range []error{
+		cb.Create().Before("*").Register("querystats:before_create", qs.before),
+		cb.Create().After("*").Register("querystats:after_create", qs.after("create")),
+		cb.Query().Before("*").Register("querystats:before_query", qs.before),
+		cb.Query().After("*").Register("querystats:after_query", qs.after("query")),
+		cb.Update().Before("*").Register("querystats:before_update", qs.before),
+		cb.Update().After("*").Register("querystats:after_update", qs.after("update")),
+		cb.Delete().Before("*").Register("querystats:before_delete", qs.before),
+		cb.Delete().After("*").Register("querystats:after_delete", qs.after("delete")),
+		cb.Row().Before("*").Register("querystats:before_row", qs.before),
+		cb.Row().After("*").Register("querystats:after_row", qs.after("row")),
+		cb.Raw().Before("*").Register("querystats:before_raw", qs.before),
+		cb.Raw().After("*").Register("querystats:after_raw", qs.after("raw")),
+	} {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (qs *QueryStats) before(db *gorm.DB) {
+	db.InstanceSet(queryStartKey, qs.now())
+}
+
+func (qs *QueryStats) after(operation string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		value, ok := db.InstanceGet(queryStartKey)
+		if !ok {
+			return
+		}
+		start, ok := value.(time.Time)
+		if !ok {
+			return
+		}
+		table := db.Statement.Table
+		if table == "" {
+			table = "raw"
+		}
+		qs.record(db, operation, table, db.Statement.SQL.String(), qs.now().Sub(start))
+	}
+}
+
+func (qs *QueryStats) record(db *gorm.DB, operation, table, sql string, elapsed time.Duration) {
+	ctx := db.Statement.Context
+	attrs := metric.WithAttributes(attribute.String("db.table", table), attribute.String("db.operation", operation))
+	if qs.duration != nil {
+		qs.duration.Record(ctx, float64(elapsed)/float64(time.Millisecond), attrs)
+	}
+
+	threshold := qs.Threshold()
+	if threshold <= 0 || elapsed < threshold || sql == "" {
+		return
+	}
+	if qs.slowQueries != nil {
+		qs.slowQueries.Add(ctx, 1, attrs)
+	}
+
+	normalized := NormalizeSQL(sql)
+	entry, logNow, suppressed := qs.track(normalized, table, elapsed)
+	if logNow {
+		slog.WarnContext(ctx, "Slow query",
+			"table", table,
+			"operation", operation,
+			"duration", elapsed,
+			"threshold", threshold,
+			"sql", normalized,
+			"slow_count", entry.Count,
+			"suppressed_since_last_log", suppressed)
+	}
+}
+
+// track adds a slow execution to the table and reports whether to log it
+func (qs *QueryStats) track(normalized, table string, elapsed time.Duration) (SlowQuery, bool, int64) {
+	qs.mu.Lock()
+	defer qs.mu.Unlock()
+
+	now := qs.now()
+	entry, ok := qs.slow[normalized]
+	if !ok {
+		if len(qs.slow) >= maxTrackedSlowQueries {
+			qs.evictLocked()
+		}
+		entry = &SlowQuery{SQL: normalized, Table: table}
+		qs.slow[normalized] = entry
+	}
+	entry.Count++
+	entry.Total += elapsed
+	entry.Max = max(entry.Max, elapsed)
+	entry.LastSeen = now
+
+	if now.Sub(entry.lastLogged) < slowQueryLogInterval {
+		entry.suppressed++
+		return *entry, false, 0
+	}
+	suppressed := entry.suppressed
+	entry.lastLogged = now
+	entry.suppressed = 0
+	return *entry, true, suppressed
+}
+
+func (qs *QueryStats) evictLocked() {
+	var victim *SlowQuery
+	for _, entry := range qs.slow {
+		if victim == nil || entry.Total < victim.Total {
+			victim = entry
+		}
+	}
+	if victim != nil {
+		delete(qs.slow, victim.SQL)
+	}
+}
+
+// Top returns up to n slow queries, most total time first
+func (qs *QueryStats) Top(n int) []SlowQuery {
+	qs.mu.Lock()
+	queries := make([]SlowQuery, 0, len(qs.slow))
+	for _, entry := range qs.slow {
+		queries = append(queries, *entry)
+	}
+	qs.mu.Unlock()
+
+	sort.Slice(queries, func(i, j int) bool {
+		if queries[i].Total != queries[j].Total {
+			return queries[i].Total > queries[j].Total
+		}
+		return queries[i].SQL < queries[j].SQL
+	})
+	if n > 0 && len(queries) > n {
+		queries = queries[:n]
+	}
+	return queries
+}
+
+// Reset clears the slow query table
+func (qs *QueryStats) Reset() {
+	qs.mu.Lock()
+	defer qs.mu.Unlock()
+	qs.slow = make(map[string]*SlowQuery)
+}
+
+var (
+	stringLiteral = regexp.MustCompile(`'(?:[^']|'')*'`)
+	placeholder   = regexp.MustCompile(`\$\d+`)
+	numberLiteral = regexp.MustCompile(`\b\d+(?:\.\d+)?\b`)
+	valueList     = regexp.MustCompile(`\(\s*\?(?:\s*,\s*\?)*\s*\)`)
+	whitespace    = regexp.MustCompile(`\s+`)
+)
+
+// NormalizeSQL replaces literals and placeholders with ? and collapses value
+// lists, so executions that differ only in their arguments group together
+func NormalizeSQL(sql string) string {
+	sql = stringLiteral.ReplaceAllString(sql, "?")
+	sql = placeholder.ReplaceAllString(sql, "?")
+	sql = numberLiteral.ReplaceAllString(sql, "?")
+	sql = valueList.ReplaceAllString(sql, "(...)")
+	return strings.TrimSpace(whitespace.ReplaceAllString(sql, " "))
+}
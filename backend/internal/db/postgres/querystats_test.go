@@ -0,0 +1,88 @@
+package postgres
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db/postgres/schema"
+)
+
+func TestNormalizeSQL(t *testing.T) {
+	tests := map[string]string{
+		`SELECT * FROM "coins" WHERE address = $1 LIMIT 1`:                          `SELECT * FROM "coins" WHERE address = ? LIMIT ?`,
+		"SELECT *\n  FROM coins\n  WHERE symbol ILIKE '%bonk%' AND price_24h > 0.5": `SELECT * FROM coins WHERE symbol ILIKE ? AND price_24h > ?`,
+		`SELECT * FROM "trades" WHERE id IN ($1,$2, $3)`:                            `SELECT * FROM "trades" WHERE id IN (...)`,
+		`SELECT 'it''s' FROM t`:                                                     `SELECT ? FROM t`,
+	}
+	for in, want := range tests {
+		assert.Equal(t, want, NormalizeSQL(in), in)
+	}
+}
+
+// newDryRunDB returns a gorm DB that builds statements without a database
+func newDryRunDB(t *testing.T, qs *QueryStats) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}), &gorm.Config{
+		DryRun:               true,
+		DisableAutomaticPing: true,
+		Logger:               logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err)
+	require.NoError(t, db.Use(qs))
+	return db
+}
+
+func TestQueryStatsTracksSlowQueries(t *testing.T) {
+	qs := NewQueryStats(500 * time.Millisecond)
+	clock := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	step := time.Second // every query appears to take a second
+	qs.now = func() time.Time {
+		clock = clock.Add(step)
+		return clock
+	}
+	db := newDryRunDB(t, qs)
+
+	for i := range 3 {
+		db.Where("address = ?", fmt.Sprintf("mint-%d", i)).First(&schema.Coin{})
+	}
+	db.Where("id = ?", 7).Find(&[]schema.Trade{})
+
+	top := qs.Top(10)
+	require.Len(t, top, 2)
+	assert.Equal(t, "coins", top[0].Table)
+	assert.Equal(t, int64(3), top[0].Count)
+	assert.Equal(t, 3*time.Second, top[0].Total)
+	assert.Equal(t, time.Second, top[0].Avg())
+	assert.Contains(t, top[0].SQL, "address = ?")
+	assert.Equal(t, "trades", top[1].Table)
+
+	assert.Len(t, qs.Top(1), 1)
+
+	// Queries under the threshold are not tracked
+	qs.Reset()
+	qs.SetThreshold(2 * time.Second)
+	db.First(&schema.Coin{})
+	assert.Empty(t, qs.Top(10))
+}
+
+func TestQueryStatsEvictsLeastTotalTime(t *testing.T) {
+	qs := NewQueryStats(time.Millisecond)
+	for i := range maxTrackedSlowQueries {
+		qs.track(fmt.Sprintf("q%d", i), "coins", time.Duration(i+10)*time.Millisecond)
+	}
+	qs.track("new", "coins", time.Second)
+
+	top := qs.Top(0)
+	require.Len(t, top, maxTrackedSlowQueries)
+	assert.Equal(t, "new", top[0].SQL)
+	for _, q := range top {
+		assert.NotEqual(t, "q0", q.SQL, "cheapest query should be evicted")
+	}
+}
@@ -1,6 +1,7 @@
 package postgres
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
@@ -20,24 +21,40 @@ import (
 // Repository implements the generic db.Repository interface using GORM.
 // S is the schema type (used with GORM), M is the model type (used in services).
 type Repository[S interface {
-	schema.Coin | schema.Trade | schema.Wallet | schema.NaughtyWord
+	schema.Coin | schema.Trade | schema.Wallet | schema.NaughtyWord | schema.WebhookSubscription | schema.WebhookDeadLetter | schema.JobCheckpoint | schema.JobRun | schema.Setting | schema.FeatureFlag | schema.SpamToken | schema.BlockedMint | schema.CoinDescription | schema.PaymentRequest | schema.BurnWatch | schema.BurnEvent | schema.MintAuthority | schema.AuthorityChange | schema.CoinRevision | schema.CoinOverride | schema.ImageHash | schema.PlaceholderIcon | schema.SearchSynonym | schema.CoinView | schema.PaperBalance | schema.PaperTrade | schema.FeeLedgerEntry | schema.TwapOrder | schema.TwapSlice | schema.AuditEntry | schema.TradeLimit | schema.TradeVolume | schema.NotificationPreferences | schema.NotificationDelivery | schema.Announcement | schema.AnnouncementRead | schema.MEVIncident | schema.DataDeletion | schema.DeletionCertificate
 	db.Entity
 }, M interface {
-	model.Coin | model.Trade | model.Wallet | model.NaughtyWord
+	model.Coin | model.Trade | model.Wallet | model.NaughtyWord | model.WebhookSubscription | model.WebhookDeadLetter | model.JobCheckpoint | model.JobRun | model.Setting | model.FeatureFlag | model.SpamToken | model.BlockedMint | model.CoinDescription | model.PaymentRequest | model.BurnWatch | model.BurnEvent | model.MintAuthority | model.AuthorityChange | model.CoinRevision | model.CoinOverride | model.ImageHash | model.PlaceholderIcon | model.SearchSynonym | model.CoinView | model.PaperBalance | model.PaperTrade | model.FeeLedgerEntry | model.TwapOrder | model.TwapSlice | model.AuditEntry | model.TradeLimit | model.TradeVolume | model.NotificationPreferences | model.NotificationDelivery | model.Announcement | model.AnnouncementRead | model.MEVIncident | model.DataDeletion | model.DeletionCertificate
 }] struct {
 	db *gorm.DB
 }
 
 // NewRepository creates a new GORM repository.
 func NewRepository[S interface {
-	schema.Coin | schema.Trade | schema.Wallet | schema.NaughtyWord
+	schema.Coin | schema.Trade | schema.Wallet | schema.NaughtyWord | schema.WebhookSubscription | schema.WebhookDeadLetter | schema.JobCheckpoint | schema.JobRun | schema.Setting | schema.FeatureFlag | schema.SpamToken | schema.BlockedMint | schema.CoinDescription | schema.PaymentRequest | schema.BurnWatch | schema.BurnEvent | schema.MintAuthority | schema.AuthorityChange | schema.CoinRevision | schema.CoinOverride | schema.ImageHash | schema.PlaceholderIcon | schema.SearchSynonym | schema.CoinView | schema.PaperBalance | schema.PaperTrade | schema.FeeLedgerEntry | schema.TwapOrder | schema.TwapSlice | schema.AuditEntry | schema.TradeLimit | schema.TradeVolume | schema.NotificationPreferences | schema.NotificationDelivery | schema.Announcement | schema.AnnouncementRead | schema.MEVIncident | schema.DataDeletion | schema.DeletionCertificate
 	db.Entity
 }, M interface {
-	model.Coin | model.Trade | model.Wallet | model.NaughtyWord
+	model.Coin | model.Trade | model.Wallet | model.NaughtyWord | model.WebhookSubscription | model.WebhookDeadLetter | model.JobCheckpoint | model.JobRun | model.Setting | model.FeatureFlag | model.SpamToken | model.BlockedMint | model.CoinDescription | model.PaymentRequest | model.BurnWatch | model.BurnEvent | model.MintAuthority | model.AuthorityChange | model.CoinRevision | model.CoinOverride | model.ImageHash | model.PlaceholderIcon | model.SearchSynonym | model.CoinView | model.PaperBalance | model.PaperTrade | model.FeeLedgerEntry | model.TwapOrder | model.TwapSlice | model.AuditEntry | model.TradeLimit | model.TradeVolume | model.NotificationPreferences | model.NotificationDelivery | model.Announcement | model.AnnouncementRead | model.MEVIncident | model.DataDeletion | model.DeletionCertificate
 }](db *gorm.DB) *Repository[S, M] {
 	return &Repository[S, M]{db: db}
 }
 
+// idColumn returns the primary key column of S, which Get and Delete look
+// entities up by and Upsert conflicts on
+func idColumn[S any]() string {
+	var s S
+	switch any(s).(type) {
+	case schema.NaughtyWord:
+		return "word"
+	case schema.BurnWatch, schema.MintAuthority:
+		return "mint"
+	case schema.NotificationPreferences:
+		return "wallet_address"
+	default:
+		return "id"
+	}
+}
+
 // Get retrieves an entity by its ID.
 func (r *Repository[S, M]) Get(ctx context.Context, id string) (*M, error) {
 	// Add repository-level tracing
@@ -53,7 +70,7 @@ func (r *Repository[S, M]) Get(ctx context.Context, id string) (*M, error) {
 	// This assumes that `id` parameter passed to Get is always for a column named "id".
 	// If a type S has a PK column named differently (e.g. "uuid"), this generic Get will fail for it.
 	// The original `fmt.Sprintf("%s = ?", schemaItem.GetID())` was problematic if GetID() returned the value of ID field from zero struct.
-	if err := r.db.WithContext(ctx).First(&schemaItem, "id = ?", id).Error; err != nil {
+	if err := r.db.WithContext(ctx).First(&schemaItem, idColumn[S]()+" = ?", id).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, fmt.Errorf("%w: item with id %s not found", db.ErrNotFound, id)
 		}
@@ -160,8 +177,8 @@ func (r *Repository[S, M]) Upsert(ctx context.Context, item *M) (int64, error) {
 		// and they are to be Upserted via this generic method using PK conflict.
 		// However, Coin/RawCoin have specific BulkUpsert logic using mint_address.
 		// For single Upsert, if they use 'id' as PK in DB table, this is fine.
-		conflictColumns = []clause.Column{{Name: "id"}} // Default to "id" for PK conflict
-		updateColumns = getColumnNames(schemaItem)      // Get all relevant columns for update
+		conflictColumns = []clause.Column{{Name: idColumn[S]()}} // Usually "id"
+		updateColumns = getColumnNames(schemaItem)               // Get all relevant columns for update
 	}
 
 	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{
@@ -183,7 +200,7 @@ func (r *Repository[S, M]) Delete(ctx context.Context, id string) error {
 	// If the GORM model `S` has a correctly tagged PK field (e.g. `gorm:"primaryKey"`),
 	// GORM's Delete might work correctly even with just `&schemaItem` and Where("id = ?", id).
 	// However, explicit is often better.
-	dbResult := r.db.WithContext(ctx).Where("id = ?", id).Delete(&schemaItem)
+	dbResult := r.db.WithContext(ctx).Where(idColumn[S]()+" = ?", id).Delete(&schemaItem)
 	if dbResult.Error != nil {
 		return fmt.Errorf("failed to delete item with id %s: %w", id, dbResult.Error)
 	}
@@ -197,7 +214,7 @@ func (r *Repository[S, M]) Delete(ctx context.Context, id string) error {
 func (r *Repository[S, M]) HardDelete(ctx context.Context, id string) error {
 	var schemaItem S
 	// Use Unscoped() to permanently delete instead of soft delete
-	dbResult := r.db.WithContext(ctx).Unscoped().Where("id = ?", id).Delete(&schemaItem)
+	dbResult := r.db.WithContext(ctx).Unscoped().Where(idColumn[S]()+" = ?", id).Delete(&schemaItem)
 	if dbResult.Error != nil {
 		return fmt.Errorf("failed to hard delete item with id %s: %w", id, dbResult.Error)
 	}
@@ -207,48 +224,138 @@ func (r *Repository[S, M]) HardDelete(ctx context.Context, id string) error {
 	return nil
 }
 
+// softDeletes reports whether S is soft-deleted, i.e. has a deleted_at
+// column that hides its rows from reads
+func softDeletes[S any]() bool {
+	var s S
+	switch any(s).(type) {
+	case schema.Coin, schema.Trade, schema.Wallet, schema.WebhookSubscription, schema.BurnWatch:
+		return true
+	default:
+		return false
+	}
+}
+
+// SoftDelete hides an entity from reads, recording who deleted it and why.
+// Deleting an entity that is already deleted returns db.ErrNotFound.
+func (r *Repository[S, M]) SoftDelete(ctx context.Context, id string, deletion model.Deletion) error {
+	if !softDeletes[S]() {
+		return fmt.Errorf("%w: %s", db.ErrNotSoftDeletable, getTableName[S]())
+	}
+	if deletion.At.IsZero() {
+		deletion.At = time.Now()
+	}
+	// Updates on a soft-deleted model only match live rows
+	result := r.db.WithContext(ctx).Model(new(S)).Where(idColumn[S]()+" = ?", id).Updates(map[string]any{
+		"deleted_at":      deletion.At,
+		"deleted_by":      deletion.By,
+		"deletion_reason": deletion.Reason,
+	})
+	if result.Error != nil {
+		return fmt.Errorf("failed to soft delete item with id %s: %w", id, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("%w: item with id %s not found for deletion", db.ErrNotFound, id)
+	}
+	return nil
+}
+
+// Restore makes a soft-deleted entity visible to reads again
+func (r *Repository[S, M]) Restore(ctx context.Context, id string) error {
+	if !softDeletes[S]() {
+		return fmt.Errorf("%w: %s", db.ErrNotSoftDeletable, getTableName[S]())
+	}
+	result := r.db.WithContext(ctx).Unscoped().Model(new(S)).
+		Where(idColumn[S]()+" = ? AND deleted_at IS NOT NULL", id).
+		Updates(map[string]any{"deleted_at": nil, "deleted_by": "", "deletion_reason": ""})
+	if result.Error != nil {
+		return fmt.Errorf("failed to restore item with id %s: %w", id, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("%w: deleted item with id %s not found", db.ErrNotFound, id)
+	}
+	return nil
+}
+
+// PurgeDeleted permanently removes the entities soft-deleted before before
+// and returns how many were removed
+func (r *Repository[S, M]) PurgeDeleted(ctx context.Context, before time.Time) (int64, error) {
+	if !softDeletes[S]() {
+		return 0, fmt.Errorf("%w: %s", db.ErrNotSoftDeletable, getTableName[S]())
+	}
+	ctx, span := withRepositorySpan(ctx, "purge_deleted", getTableName[S]())
+	defer span.End()
+
+	result := r.db.WithContext(ctx).Unscoped().Where("deleted_at < ?", before).Delete(new(S))
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to purge deleted items: %w", result.Error)
+	}
+	span.SetAttributes(attribute.Int64("db.rows", result.RowsAffected))
+	return result.RowsAffected, nil
+}
+
 // BulkUpsert inserts or updates multiple entities in batches.
 func (r *Repository[S, M]) BulkUpsert(ctx context.Context, items *[]M) (int64, error) {
+	return r.BulkWrite(ctx, items, db.BulkWriteOptions{})
+}
+
+// BulkWrite inserts multiple entities with multi-row INSERT ... ON CONFLICT
+// statements of opts.BatchSize rows, in a transaction when there is more than
+// one. With opts.Returning, items are replaced by the rows as written. Postgres
+// rejects a statement that updates a row twice, so items mustn't repeat a
+// conflict key.
+func (r *Repository[S, M]) BulkWrite(ctx context.Context, items *[]M, opts db.BulkWriteOptions) (int64, error) {
 	if items == nil || len(*items) == 0 {
 		return 0, nil // Nothing to do
 	}
+	ctx, span := withRepositorySpan(ctx, "bulk_write", getTableName[S]())
+	defer span.End()
+	span.SetAttributes(attribute.Int("db.rows", len(*items)))
 
-	// The schemaItems slice should hold actual schema type instances, not pointers,
-	// as CreateInBatches expects a slice of structs.
+	// CreateInBatches expects a slice of structs; fromModel returns a *S
 	schemaItems := make([]S, len(*items))
 	for i, modelItem := range *items {
-		// r.fromModel returns an interface (any) that holds a pointer to a schema object (e.g., *schema.Coin).
-		// We need to dereference this pointer to store the actual schema object in the slice.
-		schemaItemPtr := r.fromModel(modelItem).(*S) // Assert to *S, which is the type like *schema.Coin
-		schemaItems[i] = *schemaItemPtr              // Dereference to get S, like schema.Coin
+		schemaItems[i] = *r.fromModel(modelItem).(*S)
 	}
 
-	// Need a zero value of S to get column names and ID field name for the conflict clause.
-	// This works because GetID() and getColumnNames() are designed to work with S or *S.
 	var s S
-	var conflictColumns []clause.Column
-
-	switch any(s).(type) {
-	case schema.Coin:
-		conflictColumns = []clause.Column{{Name: "address"}}
-	// If it were, its PK is 'id'.
-	default:
-		// Defaulting to "id" for other types like Trade, Wallet.
-		// This assumes their primary key for conflict purposes is 'id'.
-		conflictColumns = []clause.Column{{Name: "id"}}
+	onConflict := clause.OnConflict{DoNothing: opts.IgnoreConflicts}
+	conflictColumns := opts.ConflictColumns
+	if len(conflictColumns) == 0 {
+		conflictColumns = []string{idColumn[S]()}
+		if _, ok := any(s).(schema.Coin); ok {
+			conflictColumns = []string{"address"}
+		}
+	}
+	for _, column := range conflictColumns {
+		onConflict.Columns = append(onConflict.Columns, clause.Column{Name: column})
+	}
+	if !opts.IgnoreConflicts {
+		updateColumns := opts.UpdateColumns
+		if len(updateColumns) == 0 {
+			updateColumns = getColumnNames(&s) // getColumnNames expects a pointer
+		}
+		onConflict.DoUpdates = clause.AssignmentColumns(updateColumns)
 	}
 
-	batchSize := len(schemaItems) // Process all items in a single batch as per GORM examples for full slice upsert.
-
-	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{
-		Columns:   conflictColumns,
-		DoUpdates: clause.AssignmentColumns(getColumnNames(&s)), // getColumnNames expects a pointer
-	}).CreateInBatches(schemaItems, batchSize)
-
+	query := r.db.WithContext(ctx).Clauses(onConflict)
+	if len(opts.Returning) > 0 {
+		returning := clause.Returning{}
+		for _, column := range opts.Returning {
+			returning.Columns = append(returning.Columns, clause.Column{Name: column})
+		}
+		query = query.Clauses(returning)
+	}
+	result := query.CreateInBatches(schemaItems, cmp.Or(opts.BatchSize, db.DefaultBulkBatchSize))
 	if result.Error != nil {
-		return 0, fmt.Errorf("failed to bulk upsert items: %w", result.Error)
+		return 0, fmt.Errorf("failed to bulk write items: %w", result.Error)
 	}
 
+	if len(opts.Returning) > 0 {
+		for i, item := range schemaItems {
+			(*items)[i] = *r.toModel(item).(*M)
+		}
+	}
 	return result.RowsAffected, nil
 }
 
@@ -259,6 +366,12 @@ func (r *Repository[S, M]) ListWithOpts(ctx context.Context, opts db.ListOptions
 
 	// Base query for the specific schema type S
 	query := r.db.WithContext(ctx).Model(new(S))
+	if opts.Deleted {
+		if !softDeletes[S]() {
+			return nil, 0, fmt.Errorf("%w: %s", db.ErrNotSoftDeletable, getTableName[S]())
+		}
+		query = query.Unscoped().Where("deleted_at IS NOT NULL")
+	}
 
 	// 16:09:25 /Users/nma/dev/dankfolio/backend/internal/db/postgres/repository.go:252 ERROR: operator does not exist: text[] ~~ unknown (SQLSTATE 42883)
 	// [rows:0] SELECT count(*) FROM "coins" WHERE tags LIKE '%new-coin%'
@@ -353,6 +466,15 @@ func (r *Repository[S, M]) GetByAddresses(ctx context.Context, addresses []strin
 
 // --- Mapping Functions ---
 
+// toDeletion returns the deletion recorded on a soft-deleted row, or nil for
+// a live one
+func toDeletion(deletedAt gorm.DeletedAt, by, reason string) *model.Deletion {
+	if !deletedAt.Valid {
+		return nil
+	}
+	return &model.Deletion{At: deletedAt.Time, By: by, Reason: reason}
+}
+
 // toModel converts a schema type (S) to a model type (M).
 // Requires type assertion on the result.
 func (r *Repository[S, M]) toModel(s S) any {
@@ -366,6 +488,7 @@ func (r *Repository[S, M]) toModel(s S) any {
 			Decimals:               v.Decimals,
 			Description:            v.Description,
 			LogoURI:                v.LogoURI, // Map LogoURI field
+			LogoSourceURL:          v.LogoSourceURL,
 			Tags:                   v.Tags,
 			Price:                  v.Price,
 			Price24hChangePercent:  v.Price24hChangePercent, // BirdEye standard
@@ -382,6 +505,8 @@ func (r *Repository[S, M]) toModel(s S) any {
 			CreatedAt:              v.CreatedAt.Format(time.RFC3339),
 			LastUpdated:            v.LastUpdated.Format(time.RFC3339),
 			JupiterListedAt:        v.JupiterCreatedAt, // Map JupiterCreatedAt to JupiterListedAt
+			MetadataSources:        v.MetadataSources,
+			Deleted:                toDeletion(v.DeletedAt, v.DeletedBy, v.DeletionReason),
 		}
 	case schema.Trade:
 		return &model.Trade{
@@ -395,6 +520,13 @@ func (r *Repository[S, M]) toModel(s S) any {
 			Type:                v.Type,
 			Amount:              v.Amount,
 			OutputAmount:        v.OutputAmount, // Add this field!
+			RawAmount:           v.RawAmount,
+			RawOutputAmount:     v.RawOutputAmount,
+			QuoteID:             v.QuoteID,
+			QuoteExpiresAt:      v.QuoteExpiresAt,
+			QuoteSlippageBps:    v.QuoteSlippageBps,
+			QuoteMultiHop:       v.QuoteMultiHop,
+			QuoteRoute:          v.QuoteRoute,
 			FromUSDPrice:        v.FromUSDPrice,
 			ToUSDPrice:          v.ToUSDPrice,
 			TotalUSDCost:        v.TotalUSDCost,
@@ -408,24 +540,432 @@ func (r *Repository[S, M]) toModel(s S) any {
 			TransactionHash:     v.TransactionHash,
 			UnsignedTransaction: v.UnsignedTransaction,
 			CreatedAt:           v.CreatedAt,
+			SubmittedAt:         v.SubmittedAt,
 			CompletedAt:         v.CompletedAt,
 			Confirmations:       v.Confirmations,
 			Finalized:           v.Finalized,
 			Error:               v.Error,
 			FromAddress:         v.FromAddress,
 			ToAddress:           v.ToAddress,
+			Memo:                v.Memo,
+			References:          v.References,
+
+			ExecutedAmount:       v.ExecutedAmount,
+			ExecutedOutputAmount: v.ExecutedOutputAmount,
+			ExecutedPlatformFee:  v.ExecutedPlatformFee,
+			ExecutedFeeMint:      v.ExecutedFeeMint,
+			NetworkFeeLamports:   v.NetworkFeeLamports,
+			SlippageBps:          v.SlippageBps,
+			ReceiptAt:            v.ReceiptAt,
+
+			Sponsored:           v.Sponsored,
+			SponsorFeeBps:       v.SponsorFeeBps,
+			SponsorCostLamports: v.SponsorCostLamports,
+
+			AcknowledgedRisks: v.AcknowledgedRisks,
+			Deleted:           toDeletion(v.DeletedAt, v.DeletedBy, v.DeletionReason),
 		}
 	case schema.Wallet:
 		return &model.Wallet{
 			ID:        v.ID,
 			PublicKey: v.PublicKey,
 			CreatedAt: v.CreatedAt,
+			Deleted:   toDeletion(v.DeletedAt, v.DeletedBy, v.DeletionReason),
 		}
 	case schema.NaughtyWord:
 		return &model.NaughtyWord{
 			Word:     v.Word,
 			Language: v.Language,
 		}
+	case schema.WebhookSubscription:
+		return &model.WebhookSubscription{
+			ID:            v.ID,
+			PartnerName:   v.PartnerName,
+			URL:           v.URL,
+			Secret:        v.Secret,
+			EventTypes:    v.EventTypes,
+			WalletAddress: v.WalletAddress,
+			CoinAddress:   v.CoinAddress,
+			Active:        v.Active,
+			CreatedAt:     v.CreatedAt,
+			UpdatedAt:     v.UpdatedAt,
+			Deleted:       toDeletion(v.DeletedAt, v.DeletedBy, v.DeletionReason),
+		}
+	case schema.WebhookDeadLetter:
+		return &model.WebhookDeadLetter{
+			ID:             v.ID,
+			SubscriptionID: v.SubscriptionID,
+			EventID:        v.EventID,
+			EventType:      v.EventType,
+			Payload:        v.Payload,
+			Attempts:       v.Attempts,
+			LastError:      v.LastError,
+			CreatedAt:      v.CreatedAt,
+		}
+	case schema.JobCheckpoint:
+		return &model.JobCheckpoint{
+			ID:        v.ID,
+			Cursor:    v.Cursor,
+			UpdatedAt: v.UpdatedAt,
+		}
+	case schema.JobRun:
+		return &model.JobRun{
+			ID:         v.ID,
+			Job:        v.Job,
+			Attempt:    v.Attempt,
+			Status:     v.Status,
+			Error:      v.Error,
+			StartedAt:  v.StartedAt,
+			FinishedAt: v.FinishedAt,
+			Duration:   time.Duration(v.DurationMs) * time.Millisecond,
+		}
+	case schema.Setting:
+		return &model.Setting{
+			ID:        v.ID,
+			Value:     v.Value,
+			UpdatedAt: v.UpdatedAt,
+			UpdatedBy: v.UpdatedBy,
+		}
+	case schema.FeatureFlag:
+		return &model.FeatureFlag{
+			ID:             v.ID,
+			Description:    v.Description,
+			Enabled:        v.Enabled,
+			RolloutPercent: v.RolloutPercent,
+			AllowList:      v.AllowList,
+			UpdatedAt:      v.UpdatedAt,
+		}
+	case schema.SpamToken:
+		return &model.SpamToken{
+			ID:        v.ID,
+			Verdict:   v.Verdict,
+			Reason:    v.Reason,
+			UpdatedBy: v.UpdatedBy,
+			UpdatedAt: v.UpdatedAt,
+		}
+	case schema.BlockedMint:
+		return &model.BlockedMint{
+			ID:        v.ID,
+			Mint:      v.Mint,
+			Source:    v.Source,
+			Reason:    v.Reason,
+			Allow:     v.Allow,
+			UpdatedBy: v.UpdatedBy,
+			UpdatedAt: v.UpdatedAt,
+		}
+	case schema.CoinDescription:
+		return &model.CoinDescription{
+			ID:          v.ID,
+			CoinAddress: v.CoinAddress,
+			Locale:      v.Locale,
+			Description: v.Description,
+			Source:      v.Source,
+			UpdatedBy:   v.UpdatedBy,
+			UpdatedAt:   v.UpdatedAt,
+		}
+	case schema.PaymentRequest:
+		return &model.PaymentRequest{
+			ID:        v.ID,
+			Recipient: v.Recipient,
+			Mint:      v.Mint,
+			Amount:    v.Amount,
+			Reference: v.Reference,
+			Label:     v.Label,
+			Message:   v.Message,
+			Memo:      v.Memo,
+			Status:    v.Status,
+			Signature: v.Signature,
+			Payer:     v.Payer,
+			CreatedAt: v.CreatedAt,
+			ExpiresAt: v.ExpiresAt,
+			PaidAt:    v.PaidAt,
+		}
+	case schema.BurnWatch:
+		return &model.BurnWatch{
+			Mint:           v.Mint,
+			CoinAddress:    v.CoinAddress,
+			Kind:           v.Kind,
+			Pool:           v.Pool,
+			Decimals:       v.Decimals,
+			Supply:         v.Supply,
+			Burned:         v.Burned,
+			BurnedPercent:  v.BurnedPercent,
+			LastBurnAt:     v.LastBurnAt,
+			CheckedAt:      v.CheckedAt,
+			PoolsCheckedAt: v.PoolsCheckedAt,
+			Deleted:        toDeletion(v.DeletedAt, v.DeletedBy, v.DeletionReason),
+		}
+	case schema.BurnEvent:
+		return &model.BurnEvent{
+			ID:            v.ID,
+			CoinAddress:   v.CoinAddress,
+			Kind:          v.Kind,
+			Mint:          v.Mint,
+			Pool:          v.Pool,
+			Amount:        v.Amount,
+			Decimals:      v.Decimals,
+			BurnedPercent: v.BurnedPercent,
+			DetectedAt:    v.DetectedAt,
+		}
+	case schema.MintAuthority:
+		return &model.MintAuthority{
+			Mint:            v.Mint,
+			MintAuthority:   v.MintAuthority,
+			FreezeAuthority: v.FreezeAuthority,
+			SafetyScore:     v.SafetyScore,
+			ChangedAt:       v.ChangedAt,
+			CheckedAt:       v.CheckedAt,
+		}
+	case schema.AuthorityChange:
+		return &model.AuthorityChange{
+			ID:          v.ID,
+			CoinAddress: v.CoinAddress,
+			Authority:   v.Authority,
+			Previous:    v.Previous,
+			Current:     v.Current,
+			SafetyScore: v.SafetyScore,
+			DetectedAt:  v.DetectedAt,
+		}
+	case schema.CoinRevision:
+		return &model.CoinRevision{
+			ID:          v.ID,
+			CoinAddress: v.CoinAddress,
+			Field:       v.Field,
+			Previous:    v.Previous,
+			Current:     v.Current,
+			Source:      v.Source,
+			ChangedAt:   v.ChangedAt,
+		}
+	case schema.CoinOverride:
+		return &model.CoinOverride{
+			ID:          v.ID,
+			CoinAddress: v.CoinAddress,
+			Field:       v.Field,
+			Value:       v.Value,
+			UpdatedBy:   v.UpdatedBy,
+			UpdatedAt:   v.UpdatedAt,
+		}
+	case schema.ImageHash:
+		return &model.ImageHash{
+			ID:          v.ID,
+			Hash:        uint64(v.Hash),
+			ObjectKey:   v.ObjectKey,
+			ContentType: v.ContentType,
+			Width:       v.Width,
+			Height:      v.Height,
+			UpdatedAt:   v.UpdatedAt,
+		}
+	case schema.PlaceholderIcon:
+		return &model.PlaceholderIcon{
+			ID:          v.ID,
+			OriginalURL: v.OriginalURL,
+			Reason:      v.Reason,
+			Attempts:    v.Attempts,
+			CreatedAt:   v.CreatedAt,
+			UpdatedAt:   v.UpdatedAt,
+		}
+	case schema.SearchSynonym:
+		return &model.SearchSynonym{
+			ID:        v.ID,
+			Target:    v.Target,
+			UpdatedBy: v.UpdatedBy,
+			UpdatedAt: v.UpdatedAt,
+		}
+	case schema.CoinView:
+		return &model.CoinView{
+			ID:           v.ID,
+			Viewer:       v.Viewer,
+			CoinAddress:  v.CoinAddress,
+			Views:        v.Views,
+			LastViewedAt: v.LastViewedAt,
+		}
+	case schema.PaperBalance:
+		return &model.PaperBalance{
+			ID:             v.ID,
+			WalletAddress:  v.WalletAddress,
+			Mint:           v.Mint,
+			Amount:         v.Amount,
+			CostBasisUSD:   v.CostBasisUSD,
+			RealizedPnLUSD: v.RealizedPnLUSD,
+			UpdatedAt:      v.UpdatedAt,
+		}
+	case schema.PaperTrade:
+		return &model.PaperTrade{
+			ID:                  v.ID,
+			WalletAddress:       v.WalletAddress,
+			QuoteID:             v.QuoteID,
+			FromCoinMintAddress: v.FromCoinMintAddress,
+			ToCoinMintAddress:   v.ToCoinMintAddress,
+			Amount:              v.Amount,
+			OutputAmount:        v.OutputAmount,
+			RawAmount:           v.RawAmount,
+			RawOutputAmount:     v.RawOutputAmount,
+			FromUSDPrice:        v.FromUSDPrice,
+			ToUSDPrice:          v.ToUSDPrice,
+			Status:              v.Status,
+			QuoteExpiresAt:      v.QuoteExpiresAt,
+			CreatedAt:           v.CreatedAt,
+			FilledAt:            v.FilledAt,
+		}
+	case schema.FeeLedgerEntry:
+		return &model.FeeLedgerEntry{
+			ID:              v.ID,
+			WalletAddress:   v.WalletAddress,
+			TradeID:         v.TradeID,
+			Kind:            v.Kind,
+			Mint:            v.Mint,
+			Amount:          v.Amount,
+			AmountUSD:       v.AmountUSD,
+			TransactionHash: v.TransactionHash,
+			CreatedAt:       v.CreatedAt,
+		}
+	case schema.TwapOrder:
+		return &model.TwapOrder{
+			ID:                  v.ID,
+			WalletAddress:       v.WalletAddress,
+			FromCoinMintAddress: v.FromCoinMintAddress,
+			ToCoinMintAddress:   v.ToCoinMintAddress,
+			RawAmount:           v.RawAmount,
+			Slices:              v.Slices,
+			Interval:            time.Duration(v.IntervalSeconds) * time.Second,
+			SlippageBps:         v.SlippageBps,
+			AllowMultiHop:       v.AllowMultiHop,
+			Status:              v.Status,
+			FilledSlices:        v.FilledSlices,
+			FilledRawAmount:     v.FilledRawAmount,
+			OutputAmount:        v.OutputAmount,
+			NextSliceAt:         v.NextSliceAt,
+			CreatedAt:           v.CreatedAt,
+			UpdatedAt:           v.UpdatedAt,
+		}
+	case schema.TwapSlice:
+		return &model.TwapSlice{
+			ID:                  v.ID,
+			OrderID:             v.OrderID,
+			Index:               v.Index,
+			RawAmount:           v.RawAmount,
+			Status:              v.Status,
+			DueAt:               v.DueAt,
+			QuoteID:             v.QuoteID,
+			UnsignedTransaction: v.UnsignedTransaction,
+			QuoteExpiresAt:      v.QuoteExpiresAt,
+			TransactionHash:     v.TransactionHash,
+			OutputAmount:        v.OutputAmount,
+			Error:               v.Error,
+			UpdatedAt:           v.UpdatedAt,
+			ClaimedBy:           v.ClaimedBy,
+			LeaseExpiresAt:      v.LeaseExpiresAt,
+			Attempts:            v.Attempts,
+		}
+	case schema.AuditEntry:
+		return &model.AuditEntry{
+			ID:            v.ID,
+			WalletAddress: v.WalletAddress,
+			Action:        v.Action,
+			Subject:       v.Subject,
+			Details:       v.Details,
+			CreatedAt:     v.CreatedAt,
+		}
+	case schema.TradeLimit:
+		return &model.TradeLimit{
+			ID:             v.ID,
+			DailyLimitUSD:  v.DailyLimitUSD,
+			WeeklyLimitUSD: v.WeeklyLimitUSD,
+			Exempt:         v.Exempt,
+			Reason:         v.Reason,
+			UpdatedBy:      v.UpdatedBy,
+			UpdatedAt:      v.UpdatedAt,
+		}
+	case schema.TradeVolume:
+		return &model.TradeVolume{
+			ID:            v.ID,
+			WalletAddress: v.WalletAddress,
+			Day:           v.Day,
+			NotionalUSD:   v.NotionalUSD,
+			Trades:        v.Trades,
+			UpdatedAt:     v.UpdatedAt,
+		}
+	case schema.NotificationPreferences:
+		return &model.NotificationPreferences{
+			WalletAddress:        v.WalletAddress,
+			DeviceTokens:         v.DeviceTokens,
+			Channel:              v.Channel,
+			DisabledTypes:        v.DisabledTypes,
+			TransferThresholdUSD: v.TransferThresholdUSD,
+			DumpThresholdPercent: v.DumpThresholdPercent,
+			QuietHoursStart:      v.QuietHoursStart,
+			QuietHoursEnd:        v.QuietHoursEnd,
+			Timezone:             v.Timezone,
+			UpdatedAt:            v.UpdatedAt,
+		}
+	case schema.NotificationDelivery:
+		return &model.NotificationDelivery{
+			ID:            v.ID,
+			WalletAddress: v.WalletAddress,
+			Type:          v.Type,
+			Title:         v.Title,
+			Body:          v.Body,
+			Status:        v.Status,
+			Detail:        v.Detail,
+			Devices:       v.Devices,
+			CreatedAt:     v.CreatedAt,
+		}
+	case schema.Announcement:
+		announcement := &model.Announcement{
+			ID:            v.ID,
+			Title:         v.Title,
+			Body:          v.Body,
+			Severity:      v.Severity,
+			MinAppVersion: v.MinAppVersion,
+			MaxAppVersion: v.MaxAppVersion,
+			StartsAt:      v.StartsAt,
+			CreatedBy:     v.CreatedBy,
+			CreatedAt:     v.CreatedAt,
+			UpdatedAt:     v.UpdatedAt,
+		}
+		if v.EndsAt != nil {
+			announcement.EndsAt = *v.EndsAt
+		}
+		return announcement
+	case schema.AnnouncementRead:
+		return &model.AnnouncementRead{
+			ID:             v.ID,
+			AnnouncementID: v.AnnouncementID,
+			WalletAddress:  v.WalletAddress,
+			ReadAt:         v.ReadAt,
+		}
+	case schema.MEVIncident:
+		return &model.MEVIncident{
+			ID:                v.ID,
+			TradeID:           v.TradeID,
+			Slot:              v.Slot,
+			Attacker:          v.Attacker,
+			FrontRunSignature: v.FrontRunSignature,
+			BackRunSignature:  v.BackRunSignature,
+			Pool:              v.Pool,
+			LossMint:          v.LossMint,
+			EstimatedLoss:     v.EstimatedLoss,
+			EstimatedLossUSD:  v.EstimatedLossUSD,
+			DetectedAt:        v.DetectedAt,
+		}
+	case schema.DataDeletion:
+		return &model.DataDeletion{
+			ID:            v.ID,
+			WalletAddress: v.WalletAddress,
+			Status:        v.Status,
+			Attempts:      v.Attempts,
+			Error:         v.Error,
+			RequestedAt:   v.RequestedAt,
+			UpdatedAt:     v.UpdatedAt,
+		}
+	case schema.DeletionCertificate:
+		return &model.DeletionCertificate{
+			ID:          v.ID,
+			SubjectHash: v.SubjectHash,
+			Erased:      v.Erased,
+			RequestedAt: v.RequestedAt,
+			CompletedAt: v.CompletedAt,
+		}
 	default:
 		panic(fmt.Sprintf("unsupported type for toModel: %T", s))
 	}
@@ -459,6 +999,7 @@ func (r *Repository[S, M]) fromModel(m M) any {
 			Discord:                v.Discord,
 			LastUpdated:            time.Now(),
 			JupiterCreatedAt:       v.JupiterListedAt, // Map JupiterListedAt to JupiterCreatedAt
+			MetadataSources:        v.MetadataSources,
 		}
 		if v.ID != 0 {
 			sCoin.ID = v.ID
@@ -476,6 +1017,13 @@ func (r *Repository[S, M]) fromModel(m M) any {
 			Type:                v.Type,
 			Amount:              v.Amount,
 			OutputAmount:        v.OutputAmount, // Add this field!
+			RawAmount:           v.RawAmount,
+			RawOutputAmount:     v.RawOutputAmount,
+			QuoteID:             v.QuoteID,
+			QuoteExpiresAt:      v.QuoteExpiresAt,
+			QuoteSlippageBps:    v.QuoteSlippageBps,
+			QuoteMultiHop:       v.QuoteMultiHop,
+			QuoteRoute:          v.QuoteRoute,
 			FromUSDPrice:        v.FromUSDPrice,
 			ToUSDPrice:          v.ToUSDPrice,
 			TotalUSDCost:        v.TotalUSDCost,
@@ -489,12 +1037,29 @@ func (r *Repository[S, M]) fromModel(m M) any {
 			TransactionHash:     v.TransactionHash,
 			UnsignedTransaction: v.UnsignedTransaction,
 			CreatedAt:           v.CreatedAt,
+			SubmittedAt:         v.SubmittedAt,
 			CompletedAt:         v.CompletedAt,
 			Confirmations:       v.Confirmations,
 			Finalized:           v.Finalized,
 			Error:               v.Error,
 			ToAddress:           v.ToAddress,
 			FromAddress:         v.FromAddress,
+			Memo:                v.Memo,
+			References:          v.References,
+
+			ExecutedAmount:       v.ExecutedAmount,
+			ExecutedOutputAmount: v.ExecutedOutputAmount,
+			ExecutedPlatformFee:  v.ExecutedPlatformFee,
+			ExecutedFeeMint:      v.ExecutedFeeMint,
+			NetworkFeeLamports:   v.NetworkFeeLamports,
+			SlippageBps:          v.SlippageBps,
+			ReceiptAt:            v.ReceiptAt,
+
+			Sponsored:           v.Sponsored,
+			SponsorFeeBps:       v.SponsorFeeBps,
+			SponsorCostLamports: v.SponsorCostLamports,
+
+			AcknowledgedRisks: v.AcknowledgedRisks,
 		}
 	case model.Wallet:
 		return &schema.Wallet{
@@ -507,6 +1072,393 @@ func (r *Repository[S, M]) fromModel(m M) any {
 			Word:     v.Word,
 			Language: v.Language,
 		}
+	case model.WebhookSubscription:
+		return &schema.WebhookSubscription{
+			ID:            v.ID,
+			PartnerName:   v.PartnerName,
+			URL:           v.URL,
+			Secret:        v.Secret,
+			EventTypes:    v.EventTypes,
+			WalletAddress: v.WalletAddress,
+			CoinAddress:   v.CoinAddress,
+			Active:        v.Active,
+			CreatedAt:     v.CreatedAt,
+			UpdatedAt:     time.Now(),
+		}
+	case model.WebhookDeadLetter:
+		return &schema.WebhookDeadLetter{
+			ID:             v.ID,
+			SubscriptionID: v.SubscriptionID,
+			EventID:        v.EventID,
+			EventType:      v.EventType,
+			Payload:        v.Payload,
+			Attempts:       v.Attempts,
+			LastError:      v.LastError,
+			CreatedAt:      v.CreatedAt,
+		}
+	case model.JobCheckpoint:
+		return &schema.JobCheckpoint{
+			ID:        v.ID,
+			Cursor:    v.Cursor,
+			UpdatedAt: time.Now(),
+		}
+	case model.JobRun:
+		return &schema.JobRun{
+			ID:         v.ID,
+			Job:        v.Job,
+			Attempt:    v.Attempt,
+			Status:     v.Status,
+			Error:      v.Error,
+			StartedAt:  v.StartedAt,
+			FinishedAt: v.FinishedAt,
+			DurationMs: v.Duration.Milliseconds(),
+		}
+	case model.Setting:
+		return &schema.Setting{
+			ID:        v.ID,
+			Value:     v.Value,
+			UpdatedAt: time.Now(),
+			UpdatedBy: v.UpdatedBy,
+		}
+	case model.FeatureFlag:
+		return &schema.FeatureFlag{
+			ID:             v.ID,
+			Description:    v.Description,
+			Enabled:        v.Enabled,
+			RolloutPercent: v.RolloutPercent,
+			AllowList:      v.AllowList,
+			UpdatedAt:      time.Now(),
+		}
+	case model.SpamToken:
+		return &schema.SpamToken{
+			ID:        v.ID,
+			Verdict:   v.Verdict,
+			Reason:    v.Reason,
+			UpdatedBy: v.UpdatedBy,
+			UpdatedAt: time.Now(),
+		}
+	case model.BlockedMint:
+		return &schema.BlockedMint{
+			ID:        v.ID,
+			Mint:      v.Mint,
+			Source:    v.Source,
+			Reason:    v.Reason,
+			Allow:     v.Allow,
+			UpdatedBy: v.UpdatedBy,
+			UpdatedAt: time.Now(),
+		}
+	case model.CoinDescription:
+		return &schema.CoinDescription{
+			ID:          v.ID,
+			CoinAddress: v.CoinAddress,
+			Locale:      v.Locale,
+			Description: v.Description,
+			Source:      v.Source,
+			UpdatedBy:   v.UpdatedBy,
+			UpdatedAt:   time.Now(),
+		}
+	case model.PaymentRequest:
+		return &schema.PaymentRequest{
+			ID:        v.ID,
+			Recipient: v.Recipient,
+			Mint:      v.Mint,
+			Amount:    v.Amount,
+			Reference: v.Reference,
+			Label:     v.Label,
+			Message:   v.Message,
+			Memo:      v.Memo,
+			Status:    v.Status,
+			Signature: v.Signature,
+			Payer:     v.Payer,
+			CreatedAt: v.CreatedAt,
+			ExpiresAt: v.ExpiresAt,
+			PaidAt:    v.PaidAt,
+		}
+	case model.BurnWatch:
+		return &schema.BurnWatch{
+			Mint:           v.Mint,
+			CoinAddress:    v.CoinAddress,
+			Kind:           v.Kind,
+			Pool:           v.Pool,
+			Decimals:       v.Decimals,
+			Supply:         v.Supply,
+			Burned:         v.Burned,
+			BurnedPercent:  v.BurnedPercent,
+			LastBurnAt:     v.LastBurnAt,
+			CheckedAt:      v.CheckedAt,
+			PoolsCheckedAt: v.PoolsCheckedAt,
+		}
+	case model.BurnEvent:
+		return &schema.BurnEvent{
+			ID:            v.ID,
+			CoinAddress:   v.CoinAddress,
+			Kind:          v.Kind,
+			Mint:          v.Mint,
+			Pool:          v.Pool,
+			Amount:        v.Amount,
+			Decimals:      v.Decimals,
+			BurnedPercent: v.BurnedPercent,
+			DetectedAt:    v.DetectedAt,
+		}
+	case model.MintAuthority:
+		return &schema.MintAuthority{
+			Mint:            v.Mint,
+			MintAuthority:   v.MintAuthority,
+			FreezeAuthority: v.FreezeAuthority,
+			SafetyScore:     v.SafetyScore,
+			ChangedAt:       v.ChangedAt,
+			CheckedAt:       v.CheckedAt,
+		}
+	case model.AuthorityChange:
+		return &schema.AuthorityChange{
+			ID:          v.ID,
+			CoinAddress: v.CoinAddress,
+			Authority:   v.Authority,
+			Previous:    v.Previous,
+			Current:     v.Current,
+			SafetyScore: v.SafetyScore,
+			DetectedAt:  v.DetectedAt,
+		}
+	case model.CoinRevision:
+		return &schema.CoinRevision{
+			ID:          v.ID,
+			CoinAddress: v.CoinAddress,
+			Field:       v.Field,
+			Previous:    v.Previous,
+			Current:     v.Current,
+			Source:      v.Source,
+			ChangedAt:   v.ChangedAt,
+		}
+	case model.CoinOverride:
+		return &schema.CoinOverride{
+			ID:          v.ID,
+			CoinAddress: v.CoinAddress,
+			Field:       v.Field,
+			Value:       v.Value,
+			UpdatedBy:   v.UpdatedBy,
+			UpdatedAt:   v.UpdatedAt,
+		}
+	case model.ImageHash:
+		return &schema.ImageHash{
+			ID:          v.ID,
+			Hash:        int64(v.Hash),
+			ObjectKey:   v.ObjectKey,
+			ContentType: v.ContentType,
+			Width:       v.Width,
+			Height:      v.Height,
+			UpdatedAt:   v.UpdatedAt,
+		}
+	case model.PlaceholderIcon:
+		return &schema.PlaceholderIcon{
+			ID:          v.ID,
+			OriginalURL: v.OriginalURL,
+			Reason:      v.Reason,
+			Attempts:    v.Attempts,
+			CreatedAt:   v.CreatedAt,
+			UpdatedAt:   v.UpdatedAt,
+		}
+	case model.SearchSynonym:
+		return &schema.SearchSynonym{
+			ID:        v.ID,
+			Target:    v.Target,
+			UpdatedBy: v.UpdatedBy,
+			UpdatedAt: v.UpdatedAt,
+		}
+	case model.CoinView:
+		return &schema.CoinView{
+			ID:           v.ID,
+			Viewer:       v.Viewer,
+			CoinAddress:  v.CoinAddress,
+			Views:        v.Views,
+			LastViewedAt: v.LastViewedAt,
+		}
+	case model.PaperBalance:
+		return &schema.PaperBalance{
+			ID:             v.ID,
+			WalletAddress:  v.WalletAddress,
+			Mint:           v.Mint,
+			Amount:         v.Amount,
+			CostBasisUSD:   v.CostBasisUSD,
+			RealizedPnLUSD: v.RealizedPnLUSD,
+			UpdatedAt:      v.UpdatedAt,
+		}
+	case model.PaperTrade:
+		return &schema.PaperTrade{
+			ID:                  v.ID,
+			WalletAddress:       v.WalletAddress,
+			QuoteID:             v.QuoteID,
+			FromCoinMintAddress: v.FromCoinMintAddress,
+			ToCoinMintAddress:   v.ToCoinMintAddress,
+			Amount:              v.Amount,
+			OutputAmount:        v.OutputAmount,
+			RawAmount:           v.RawAmount,
+			RawOutputAmount:     v.RawOutputAmount,
+			FromUSDPrice:        v.FromUSDPrice,
+			ToUSDPrice:          v.ToUSDPrice,
+			Status:              v.Status,
+			QuoteExpiresAt:      v.QuoteExpiresAt,
+			CreatedAt:           v.CreatedAt,
+			FilledAt:            v.FilledAt,
+		}
+	case model.FeeLedgerEntry:
+		return &schema.FeeLedgerEntry{
+			ID:              v.ID,
+			WalletAddress:   v.WalletAddress,
+			TradeID:         v.TradeID,
+			Kind:            v.Kind,
+			Mint:            v.Mint,
+			Amount:          v.Amount,
+			AmountUSD:       v.AmountUSD,
+			TransactionHash: v.TransactionHash,
+			CreatedAt:       v.CreatedAt,
+		}
+	case model.TwapOrder:
+		return &schema.TwapOrder{
+			ID:                  v.ID,
+			WalletAddress:       v.WalletAddress,
+			FromCoinMintAddress: v.FromCoinMintAddress,
+			ToCoinMintAddress:   v.ToCoinMintAddress,
+			RawAmount:           v.RawAmount,
+			Slices:              v.Slices,
+			IntervalSeconds:     int64(v.Interval / time.Second),
+			SlippageBps:         v.SlippageBps,
+			AllowMultiHop:       v.AllowMultiHop,
+			Status:              v.Status,
+			FilledSlices:        v.FilledSlices,
+			FilledRawAmount:     v.FilledRawAmount,
+			OutputAmount:        v.OutputAmount,
+			NextSliceAt:         v.NextSliceAt,
+			CreatedAt:           v.CreatedAt,
+			UpdatedAt:           v.UpdatedAt,
+		}
+	case model.TwapSlice:
+		return &schema.TwapSlice{
+			ID:                  v.ID,
+			OrderID:             v.OrderID,
+			Index:               v.Index,
+			RawAmount:           v.RawAmount,
+			Status:              v.Status,
+			DueAt:               v.DueAt,
+			QuoteID:             v.QuoteID,
+			UnsignedTransaction: v.UnsignedTransaction,
+			QuoteExpiresAt:      v.QuoteExpiresAt,
+			TransactionHash:     v.TransactionHash,
+			OutputAmount:        v.OutputAmount,
+			Error:               v.Error,
+			UpdatedAt:           v.UpdatedAt,
+			ClaimedBy:           v.ClaimedBy,
+			LeaseExpiresAt:      v.LeaseExpiresAt,
+			Attempts:            v.Attempts,
+		}
+	case model.AuditEntry:
+		return &schema.AuditEntry{
+			ID:            v.ID,
+			WalletAddress: v.WalletAddress,
+			Action:        v.Action,
+			Subject:       v.Subject,
+			Details:       v.Details,
+			CreatedAt:     v.CreatedAt,
+		}
+	case model.TradeLimit:
+		return &schema.TradeLimit{
+			ID:             v.ID,
+			DailyLimitUSD:  v.DailyLimitUSD,
+			WeeklyLimitUSD: v.WeeklyLimitUSD,
+			Exempt:         v.Exempt,
+			Reason:         v.Reason,
+			UpdatedBy:      v.UpdatedBy,
+			UpdatedAt:      v.UpdatedAt,
+		}
+	case model.TradeVolume:
+		return &schema.TradeVolume{
+			ID:            v.ID,
+			WalletAddress: v.WalletAddress,
+			Day:           v.Day,
+			NotionalUSD:   v.NotionalUSD,
+			Trades:        v.Trades,
+			UpdatedAt:     v.UpdatedAt,
+		}
+	case model.NotificationPreferences:
+		return &schema.NotificationPreferences{
+			WalletAddress:        v.WalletAddress,
+			DeviceTokens:         pq.StringArray(v.DeviceTokens),
+			Channel:              v.Channel,
+			DisabledTypes:        pq.StringArray(v.DisabledTypes),
+			TransferThresholdUSD: v.TransferThresholdUSD,
+			DumpThresholdPercent: v.DumpThresholdPercent,
+			QuietHoursStart:      v.QuietHoursStart,
+			QuietHoursEnd:        v.QuietHoursEnd,
+			Timezone:             v.Timezone,
+			UpdatedAt:            time.Now(),
+		}
+	case model.NotificationDelivery:
+		return &schema.NotificationDelivery{
+			ID:            v.ID,
+			WalletAddress: v.WalletAddress,
+			Type:          v.Type,
+			Title:         v.Title,
+			Body:          v.Body,
+			Status:        v.Status,
+			Detail:        v.Detail,
+			Devices:       v.Devices,
+			CreatedAt:     v.CreatedAt,
+		}
+	case model.Announcement:
+		announcement := &schema.Announcement{
+			ID:            v.ID,
+			Title:         v.Title,
+			Body:          v.Body,
+			Severity:      v.Severity,
+			MinAppVersion: v.MinAppVersion,
+			MaxAppVersion: v.MaxAppVersion,
+			StartsAt:      v.StartsAt,
+			CreatedBy:     v.CreatedBy,
+			CreatedAt:     v.CreatedAt,
+			UpdatedAt:     v.UpdatedAt,
+		}
+		if !v.EndsAt.IsZero() {
+			announcement.EndsAt = &v.EndsAt
+		}
+		return announcement
+	case model.AnnouncementRead:
+		return &schema.AnnouncementRead{
+			ID:             v.ID,
+			AnnouncementID: v.AnnouncementID,
+			WalletAddress:  v.WalletAddress,
+			ReadAt:         v.ReadAt,
+		}
+	case model.MEVIncident:
+		return &schema.MEVIncident{
+			ID:                v.ID,
+			TradeID:           v.TradeID,
+			Slot:              v.Slot,
+			Attacker:          v.Attacker,
+			FrontRunSignature: v.FrontRunSignature,
+			BackRunSignature:  v.BackRunSignature,
+			Pool:              v.Pool,
+			LossMint:          v.LossMint,
+			EstimatedLoss:     v.EstimatedLoss,
+			EstimatedLossUSD:  v.EstimatedLossUSD,
+			DetectedAt:        v.DetectedAt,
+		}
+	case model.DataDeletion:
+		return &schema.DataDeletion{
+			ID:            v.ID,
+			WalletAddress: v.WalletAddress,
+			Status:        v.Status,
+			Attempts:      v.Attempts,
+			Error:         v.Error,
+			RequestedAt:   v.RequestedAt,
+			UpdatedAt:     v.UpdatedAt,
+		}
+	case model.DeletionCertificate:
+		return &schema.DeletionCertificate{
+			ID:          v.ID,
+			SubjectHash: v.SubjectHash,
+			Erased:      v.Erased,
+			RequestedAt: v.RequestedAt,
+			CompletedAt: v.CompletedAt,
+		}
 	default:
 		panic(fmt.Sprintf("unsupported type for fromModel: %T", m))
 	}
@@ -523,16 +1475,20 @@ func getColumnNames(data any) []string {
 			"address", "name", "symbol", "decimals", "description", "logo_uri", "tags",
 			"price", "price_24h_change_percent", "marketcap", "volume_24h_usd", "volume_24h_change_percent",
 			"liquidity", "fdv", "rank", "website", "twitter", "telegram", "discord", "last_updated", "jupiter_created_at",
+			"metadata_sources",
 		}
 	case *schema.Trade:
 		// Explicitly list columns to update, excluding PK 'id'
 		return []string{
 			"user_id", "from_coin_mint_address", "from_coin_pk_id", "to_coin_mint_address", "to_coin_pk_id", "coin_symbol",
-			"type", "amount", "price", "fee", "total_fee_amount", "total_fee_mint",
-			"platform_fee_amount", "platform_fee_percent", "platform_fee_mint", "platform_fee_destination",
-			"route_fee_amount", "route_fee_mints", "route_fee_details", "price_impact_percent",
+			"type", "amount", "output_amount", "raw_amount", "raw_output_amount",
+			"quote_id", "quote_expires_at", "quote_slippage_bps", "quote_multi_hop", "quote_route",
+			"fee", "total_fee_amount", "total_fee_mint", "platform_fee_amount", "platform_fee_bps", "price_impact_percent",
+			"from_usd_price", "to_usd_price", "total_usd_cost",
 			"status", "transaction_hash", "unsigned_transaction",
-			"completed_at", "confirmations", "finalized", "error", // CreatedAt is usually set on create
+			"submitted_at", "completed_at", "confirmations", "finalized", "error", "from_address", "to_address", "memo", "reference_keys", // CreatedAt is usually set on create
+			"executed_amount", "executed_output_amount", "executed_platform_fee", "executed_fee_mint", "network_fee_lamports", "slippage_bps", "receipt_at",
+			"sponsored", "sponsor_fee_bps", "sponsor_cost_lamports", "acknowledged_risks",
 		}
 	case *schema.Wallet:
 		// Explicitly list columns to update, excluding PK 'id'
@@ -540,6 +1496,87 @@ func getColumnNames(data any) []string {
 	case *schema.NaughtyWord:
 		// Columns for NaughtyWord to update on conflict. Word is PK.
 		return []string{"language"}
+	case *schema.WebhookSubscription:
+		// Excluding PK 'id' and 'created_at'
+		return []string{"partner_name", "url", "secret", "event_types", "wallet_address", "coin_address", "active", "updated_at"}
+	case *schema.WebhookDeadLetter:
+		// Dead letters are append-only; only retry bookkeeping changes
+		return []string{"attempts", "last_error"}
+	case *schema.JobCheckpoint:
+		return []string{"cursor", "updated_at"}
+	case *schema.JobRun:
+		// A run is created when it starts and updated once when it finishes
+		return []string{"status", "error", "finished_at", "duration_ms"}
+	case *schema.Setting:
+		return []string{"value", "updated_at", "updated_by"}
+	case *schema.FeatureFlag:
+		return []string{"description", "enabled", "rollout_percent", "allow_list", "updated_at"}
+	case *schema.SpamToken:
+		return []string{"verdict", "reason", "updated_by", "updated_at"}
+	case *schema.BlockedMint:
+		return []string{"mint", "source", "reason", "allow", "updated_by", "updated_at"}
+	case *schema.CoinDescription:
+		return []string{"coin_address", "locale", "description", "source", "updated_by", "updated_at"}
+	case *schema.PaymentRequest:
+		return []string{"status", "signature", "payer", "paid_at"}
+	case *schema.BurnWatch:
+		return []string{"coin_address", "kind", "pool", "decimals", "supply", "burned", "burned_percent", "last_burn_at", "checked_at", "pools_checked_at"}
+	case *schema.BurnEvent:
+		// Burn events are append-only
+		return []string{"burned_percent"}
+	case *schema.MintAuthority:
+		return []string{"mint_authority", "freeze_authority", "safety_score", "changed_at", "checked_at"}
+	case *schema.AuthorityChange:
+		// Authority changes are append-only
+		return []string{"safety_score"}
+	case *schema.CoinRevision:
+		// Revisions are append-only
+		return []string{"source"}
+	case *schema.CoinOverride:
+		return []string{"value", "updated_by", "updated_at"}
+	case *schema.ImageHash:
+		return []string{"hash", "object_key", "content_type", "width", "height", "updated_at"}
+	case *schema.PlaceholderIcon:
+		return []string{"original_url", "reason", "attempts", "updated_at"}
+	case *schema.SearchSynonym:
+		return []string{"target", "updated_by", "updated_at"}
+	case *schema.CoinView:
+		return []string{"views", "last_viewed_at"}
+	case *schema.PaperBalance:
+		return []string{"amount", "cost_basis_usd", "realized_pnl_usd", "updated_at"}
+	case *schema.PaperTrade:
+		return []string{"status", "filled_at"}
+	case *schema.FeeLedgerEntry:
+		// Ledger entries are append-only
+		return []string{"transaction_hash"}
+	case *schema.TwapOrder:
+		return []string{"status", "filled_slices", "filled_raw_amount", "output_amount", "next_slice_at", "updated_at"}
+	case *schema.TwapSlice:
+		return []string{"status", "quote_id", "unsigned_transaction", "quote_expires_at", "transaction_hash", "output_amount", "error", "updated_at", "claimed_by", "lease_expires_at"}
+	case *schema.AuditEntry:
+		return []string{"details"} // Entries are append-only
+	case *schema.TradeLimit:
+		return []string{"daily_limit_usd", "weekly_limit_usd", "exempt", "reason", "updated_by", "updated_at"}
+	case *schema.TradeVolume:
+		return []string{"notional_usd", "trades", "updated_at"}
+	case *schema.NotificationPreferences:
+		return []string{"device_tokens", "channel", "disabled_types", "transfer_threshold_usd", "dump_threshold_percent", "quiet_hours_start", "quiet_hours_end", "timezone", "updated_at"}
+	case *schema.NotificationDelivery:
+		// Deliveries are append-only
+		return []string{"status", "detail"}
+	case *schema.Announcement:
+		return []string{"title", "body", "severity", "min_app_version", "max_app_version", "starts_at", "ends_at", "updated_at"}
+	case *schema.AnnouncementRead:
+		// The first read is kept
+		return []string{"announcement_id"}
+	case *schema.MEVIncident:
+		// Re-analysis refreshes the estimate
+		return []string{"estimated_loss", "estimated_loss_usd"}
+	case *schema.DataDeletion:
+		return []string{"status", "attempts", "error", "updated_at"}
+	case *schema.DeletionCertificate:
+		// Certificates are written once
+		return []string{"subject_hash"}
 	default:
 		panic(fmt.Sprintf("unsupported type for getColumnNames: %T", data))
 	}
@@ -0,0 +1,241 @@
+package postgres_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/db/dbtest"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+func ptr[T any](v T) *T { return &v }
+
+func TestCoins_BulkUpsertByAddress(t *testing.T) {
+	t.Parallel()
+	store := dbtest.NewStore(t)
+	ctx := context.Background()
+
+	coins := []model.Coin{
+		{Address: "mintA", Name: "Alpha", Symbol: "A", Decimals: 6, Tags: []string{"trending"}, Volume24hUSD: 100},
+		{Address: "mintB", Name: "Beta", Symbol: "B", Decimals: 9, Volume24hUSD: 50},
+	}
+	_, err := store.Coins().BulkUpsert(ctx, &coins)
+	require.NoError(t, err)
+
+	// A second upsert on the same address updates in place instead of inserting
+	updated := []model.Coin{{Address: "mintA", Name: "Alpha v2", Symbol: "A", Decimals: 6, Tags: []string{"trending"}, Volume24hUSD: 200}}
+	_, err = store.Coins().BulkUpsert(ctx, &updated)
+	require.NoError(t, err)
+
+	all, total, err := store.Coins().List(ctx, db.ListOptions{})
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, total)
+	assert.Len(t, all, 2)
+
+	got, err := store.Coins().GetByField(ctx, "address", "mintA")
+	require.NoError(t, err)
+	assert.Equal(t, "Alpha v2", got.Name)
+	assert.Equal(t, 200.0, got.Volume24hUSD)
+	assert.Equal(t, []string{"trending"}, got.Tags)
+
+	byAddress, err := store.Coins().GetByAddresses(ctx, []string{"mintB", "missing"})
+	require.NoError(t, err)
+	require.Len(t, byAddress, 1)
+	assert.Equal(t, "Beta", byAddress[0].Name)
+
+	_, err = store.Coins().GetByField(ctx, "address", "missing")
+	assert.ErrorIs(t, err, db.ErrNotFound)
+}
+
+func TestCoins_BulkWrite(t *testing.T) {
+	t.Parallel()
+	store := dbtest.NewStore(t)
+	ctx := context.Background()
+
+	coins := make([]model.Coin, 5)
+	for i := range coins {
+		coins[i] = model.Coin{Address: "mint" + strconv.Itoa(i), Name: "Coin", Symbol: "C" + strconv.Itoa(i), Decimals: 6}
+	}
+	affected, err := store.Coins().BulkWrite(ctx, &coins, db.BulkWriteOptions{BatchSize: 2, Returning: []string{"id"}})
+	require.NoError(t, err)
+	assert.EqualValues(t, 5, affected, "every batch is written")
+	for _, coin := range coins {
+		assert.NotZero(t, coin.ID, "generated IDs are read back")
+	}
+
+	renamed := []model.Coin{
+		{Address: "mint0", Name: "Renamed", Symbol: "R0", Decimals: 6},
+		{Address: "mint9", Name: "New", Symbol: "N", Decimals: 6},
+	}
+	_, err = store.Coins().BulkWrite(ctx, &renamed, db.BulkWriteOptions{UpdateColumns: []string{"name"}})
+	require.NoError(t, err)
+	got, err := store.Coins().GetByField(ctx, "address", "mint0")
+	require.NoError(t, err)
+	assert.Equal(t, "Renamed", got.Name)
+	assert.Equal(t, "C0", got.Symbol, "only the update columns change")
+
+	ignored := []model.Coin{{Address: "mint1", Name: "Ignored", Symbol: "I", Decimals: 6}}
+	affected, err = store.Coins().BulkWrite(ctx, &ignored, db.BulkWriteOptions{IgnoreConflicts: true})
+	require.NoError(t, err)
+	assert.Zero(t, affected)
+	got, err = store.Coins().GetByField(ctx, "address", "mint1")
+	require.NoError(t, err)
+	assert.Equal(t, "Coin", got.Name)
+}
+
+func TestCoins_ListWithOpts(t *testing.T) {
+	t.Parallel()
+	store := dbtest.NewStore(t)
+	ctx := context.Background()
+
+	coins := make([]model.Coin, 5)
+	for i := range coins {
+		coins[i] = model.Coin{Address: "mint" + strconv.Itoa(i), Name: "Coin", Symbol: "C" + strconv.Itoa(i), Decimals: 6, Volume24hUSD: float64(i * 10)}
+	}
+	_, err := store.Coins().BulkUpsert(ctx, &coins)
+	require.NoError(t, err)
+
+	page, total, err := store.Coins().ListWithOpts(ctx, db.ListOptions{
+		Limit:    ptr(2),
+		Offset:   ptr(1),
+		SortBy:   ptr("volume_24h_usd"),
+		SortDesc: ptr(true),
+		Filters:  []db.FilterOption{{Field: "volume_24h_usd", Operator: db.FilterOpGreaterEqual, Value: 10}},
+	})
+	require.NoError(t, err)
+	assert.EqualValues(t, 4, total, "total counts every match, not just the page")
+	require.Len(t, page, 2)
+	assert.Equal(t, "C3", page[0].Symbol)
+	assert.Equal(t, "C2", page[1].Symbol)
+}
+
+func TestCoins_SoftDeleteRestoreAndPurge(t *testing.T) {
+	t.Parallel()
+	store := dbtest.NewStore(t)
+	ctx := context.Background()
+
+	coins := []model.Coin{{Address: "mintA", Name: "Alpha", Symbol: "A", Decimals: 6}}
+	_, err := store.Coins().BulkWrite(ctx, &coins, db.BulkWriteOptions{Returning: []string{"id"}})
+	require.NoError(t, err)
+	id := strconv.FormatUint(coins[0].ID, 10)
+
+	deletedAt := time.Now().Add(-time.Hour).UTC().Truncate(time.Microsecond)
+	require.NoError(t, store.Coins().SoftDelete(ctx, id, model.Deletion{At: deletedAt, By: "admin", Reason: "scam"}))
+	assert.ErrorIs(t, store.Coins().SoftDelete(ctx, id, model.Deletion{}), db.ErrNotFound, "a deleted coin isn't deleted again")
+	_, err = store.Coins().GetByField(ctx, "address", "mintA")
+	assert.ErrorIs(t, err, db.ErrNotFound, "deleted coins are hidden from reads")
+
+	deleted, total, err := store.Coins().ListWithOpts(ctx, db.ListOptions{Deleted: true})
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, total)
+	require.Len(t, deleted, 1)
+	require.NotNil(t, deleted[0].Deleted)
+	assert.True(t, deletedAt.Equal(deleted[0].Deleted.At))
+	assert.Equal(t, "admin", deleted[0].Deleted.By)
+	assert.Equal(t, "scam", deleted[0].Deleted.Reason)
+
+	refreshed := []model.Coin{{Address: "mintA", Name: "Refreshed", Symbol: "A", Decimals: 6}}
+	_, err = store.Coins().BulkWrite(ctx, &refreshed, db.BulkWriteOptions{Returning: []string{"id", "deleted_at"}})
+	require.NoError(t, err)
+	assert.NotNil(t, refreshed[0].Deleted, "an upsert leaves the coin deleted")
+
+	require.NoError(t, store.Coins().Restore(ctx, id))
+	got, err := store.Coins().GetByField(ctx, "address", "mintA")
+	require.NoError(t, err)
+	assert.Nil(t, got.Deleted)
+	assert.ErrorIs(t, store.Coins().Restore(ctx, id), db.ErrNotFound, "only deleted coins are restored")
+
+	require.NoError(t, store.Coins().SoftDelete(ctx, id, model.Deletion{At: deletedAt}))
+	purged, err := store.Coins().PurgeDeleted(ctx, deletedAt)
+	require.NoError(t, err)
+	assert.Zero(t, purged, "coins deleted at the cutoff are kept")
+	purged, err = store.Coins().PurgeDeleted(ctx, time.Now())
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, purged)
+	assert.ErrorIs(t, store.Coins().Restore(ctx, id), db.ErrNotFound)
+
+	_, err = store.NaughtyWords().PurgeDeleted(ctx, time.Now())
+	assert.ErrorIs(t, err, db.ErrNotSoftDeletable)
+}
+
+func TestTrades_CreateUpdateAndSoftDelete(t *testing.T) {
+	t.Parallel()
+	store := dbtest.NewStore(t)
+	ctx := context.Background()
+
+	trade := &model.Trade{
+		UserID:              "wallet1",
+		FromCoinMintAddress: model.SolMint,
+		ToCoinMintAddress:   "mintA",
+		Type:                "swap",
+		Amount:              0.5,
+		RawAmount:           "500000000",
+		QuoteID:             "quote-1",
+		QuoteExpiresAt:      time.Now().Add(time.Minute).UTC().Truncate(time.Microsecond),
+		Status:              "prepared",
+		UnsignedTransaction: "unsigned",
+	}
+	require.NoError(t, store.Trades().Create(ctx, trade))
+	require.NotZero(t, trade.ID)
+
+	// Every field the trade service sets after preparing must survive an update
+	trade.Status = "finalized"
+	trade.TransactionHash = "sig1"
+	trade.OutputAmount = 42.5
+	trade.RawOutputAmount = "42500000"
+	trade.PlatformFeeBps = 10
+	trade.TotalUSDCost = 75
+	trade.Finalized = true
+	require.NoError(t, store.Trades().Update(ctx, trade))
+
+	got, err := store.Trades().GetByField(ctx, "quote_id", "quote-1")
+	require.NoError(t, err)
+	assert.Equal(t, trade.ID, got.ID)
+	assert.Equal(t, "finalized", got.Status)
+	assert.Equal(t, "sig1", got.TransactionHash)
+	assert.Equal(t, 42.5, got.OutputAmount)
+	assert.Equal(t, "500000000", got.RawAmount)
+	assert.Equal(t, "42500000", got.RawOutputAmount)
+	assert.Equal(t, 10, got.PlatformFeeBps)
+	assert.Equal(t, 75.0, got.TotalUSDCost)
+	assert.True(t, got.Finalized)
+	assert.True(t, trade.QuoteExpiresAt.Equal(got.QuoteExpiresAt))
+
+	id := strconv.FormatUint(uint64(trade.ID), 10)
+	require.NoError(t, store.Trades().Delete(ctx, id))
+	_, err = store.Trades().Get(ctx, id)
+	assert.ErrorIs(t, err, db.ErrNotFound)
+	require.NoError(t, store.Trades().HardDelete(ctx, id), "soft-deleted rows can still be purged")
+	assert.ErrorIs(t, store.Trades().HardDelete(ctx, id), db.ErrNotFound)
+}
+
+func TestNaughtyWords_CreateAndList(t *testing.T) {
+	t.Parallel()
+	store := dbtest.NewStore(t)
+	ctx := context.Background()
+
+	for _, word := range []string{"scam", "rug", "fraude"} {
+		language := "en"
+		if word == "fraude" {
+			language = "fr"
+		}
+		require.NoError(t, store.NaughtyWords().Create(ctx, &model.NaughtyWord{Word: word, Language: language}))
+	}
+	assert.Error(t, store.NaughtyWords().Create(ctx, &model.NaughtyWord{Word: "rug", Language: "en"}), "words are unique")
+
+	english, total, err := store.NaughtyWords().List(ctx, db.ListOptions{
+		Filters: []db.FilterOption{{Field: "language", Operator: db.FilterOpEqual, Value: "en"}},
+		SortBy:  ptr("word"),
+	})
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, total)
+	require.Len(t, english, 2)
+	assert.Equal(t, "rug", english[0].Word)
+	assert.Equal(t, "scam", english[1].Word)
+}
@@ -8,10 +8,12 @@ import (
 )
 
 type Wallet struct {
-	ID        string         `gorm:"primaryKey;column:id"`
-	PublicKey string         `gorm:"column:public_key;not null;unique"`
-	CreatedAt time.Time      `gorm:"column:created_at;default:CURRENT_TIMESTAMP"`
-	DeletedAt gorm.DeletedAt `gorm:"column:deleted_at;index"`
+	ID             string         `gorm:"primaryKey;column:id"`
+	PublicKey      string         `gorm:"column:public_key;not null;unique"`
+	CreatedAt      time.Time      `gorm:"column:created_at;default:CURRENT_TIMESTAMP"`
+	DeletedAt      gorm.DeletedAt `gorm:"column:deleted_at;index"`
+	DeletedBy      string         `gorm:"column:deleted_by"`
+	DeletionReason string         `gorm:"column:deletion_reason"`
 }
 
 func (w Wallet) GetID() string {
@@ -28,6 +30,7 @@ type Coin struct {
 	Decimals               int            `gorm:"column:decimals;not null"`
 	Description            string         `gorm:"column:description"`
 	LogoURI                string         `gorm:"column:logo_uri"`
+	LogoSourceURL          string         `gorm:"column:logo_source_url"` // Not among the columns Update writes; see Store.SetCoinLogoSource
 	Tags                   pq.StringArray `gorm:"column:tags;type:text[];index:idx_coins_tags,type:gin"`
 	Price                  float64        `gorm:"column:price;default:0.0"`
 	Price24hChangePercent  float64        `gorm:"column:price_24h_change_percent;default:0.0;index:idx_coins_price_change_desc"`
@@ -44,6 +47,10 @@ type Coin struct {
 	CreatedAt              time.Time      `gorm:"column:created_at;default:CURRENT_TIMESTAMP;index:idx_coins_created_at_desc"`
 	LastUpdated            time.Time      `gorm:"column:last_updated;default:CURRENT_TIMESTAMP"`
 	JupiterCreatedAt       *time.Time     `gorm:"column:jupiter_created_at;index"`
+	MetadataSources        map[string]string `gorm:"column:metadata_sources;type:jsonb;serializer:json"`
+	DeletedAt              gorm.DeletedAt `gorm:"column:deleted_at;index"`
+	DeletedBy              string         `gorm:"column:deleted_by"`
+	DeletionReason         string         `gorm:"column:deletion_reason"`
 }
 
 // TableName overrides the default table name generation.
@@ -73,6 +80,46 @@ func (c Coin) GetID() string {
 	return "id"
 }
 
+// ArchivedCoin represents the structure of the 'coins_archive' table, which
+// holds coins moved out of 'coins' after going inactive. Rows keep their
+// original ID so a coin can be restored without breaking trade references.
+type ArchivedCoin struct {
+	ID                     uint64         `gorm:"primaryKey;autoIncrement:false;not null"`
+	Address                string         `gorm:"column:address;not null;uniqueIndex:idx_coins_archive_address"`
+	Name                   string         `gorm:"column:name;not null"`
+	Symbol                 string         `gorm:"column:symbol;not null;index:idx_coins_archive_symbol"`
+	Decimals               int            `gorm:"column:decimals;not null"`
+	Description            string         `gorm:"column:description"`
+	LogoURI                string         `gorm:"column:logo_uri"`
+	Tags                   pq.StringArray `gorm:"column:tags;type:text[]"`
+	Price                  float64        `gorm:"column:price;default:0.0"`
+	Price24hChangePercent  float64        `gorm:"column:price_24h_change_percent;default:0.0"`
+	Marketcap              float64        `gorm:"column:marketcap;default:0.0"`
+	Volume24hUSD           float64        `gorm:"column:volume_24h_usd;default:0.0"`
+	Volume24hChangePercent float64        `gorm:"column:volume_24h_change_percent;default:0.0"`
+	Liquidity              float64        `gorm:"column:liquidity;default:0.0"`
+	FDV                    float64        `gorm:"column:fdv;default:0.0"`
+	Rank                   int            `gorm:"column:rank;default:0"`
+	Website                string         `gorm:"column:website"`
+	Twitter                string         `gorm:"column:twitter"`
+	Telegram               string         `gorm:"column:telegram"`
+	Discord                string         `gorm:"column:discord"`
+	CreatedAt              time.Time      `gorm:"column:created_at"`
+	LastUpdated            time.Time      `gorm:"column:last_updated"`
+	JupiterCreatedAt       *time.Time     `gorm:"column:jupiter_created_at"`
+	ArchivedAt             time.Time      `gorm:"column:archived_at;default:CURRENT_TIMESTAMP;index:idx_coins_archive_archived_at"`
+}
+
+// TableName overrides the default table name generation.
+func (ArchivedCoin) TableName() string {
+	return "coins_archive"
+}
+
+// GetID returns the primary key column name for ArchivedCoin
+func (c ArchivedCoin) GetID() string {
+	return "id"
+}
+
 // Trade represents the structure of the 'trades' table in the database.
 type Trade struct {
 	ID                  uint    `gorm:"primaryKey;autoIncrement;column:id"`
@@ -85,6 +132,15 @@ type Trade struct {
 	Type                string  `gorm:"column:type;not null"`             // e.g., "buy", "sell", "swap"
 	Amount              float64 `gorm:"column:amount;not null"`           // Amount of 'FromCoin' for sells/swaps, 'ToCoin' for buys
 	OutputAmount        float64 `gorm:"column:output_amount;default:0.0"` // Amount of 'ToCoin' received in swaps
+	RawAmount           string  `gorm:"column:raw_amount"`                // Exact Amount in base units
+	RawOutputAmount     string  `gorm:"column:raw_output_amount"`         // Exact OutputAmount in base units
+
+	// Quote the prepared transaction was built from, kept so it can expire and be re-quoted
+	QuoteID          string    `gorm:"column:quote_id;index:idx_trades_quote_id"`
+	QuoteExpiresAt   time.Time `gorm:"column:quote_expires_at"`
+	QuoteSlippageBps string    `gorm:"column:quote_slippage_bps"`
+	QuoteMultiHop    bool      `gorm:"column:quote_multi_hop;default:false"`
+	QuoteRoute       string    `gorm:"column:quote_route"`
 
 	// Fee Information
 	Fee            float64 `gorm:"column:fee;default:0.0"`              // Total fee in USD
@@ -107,13 +163,34 @@ type Trade struct {
 	TransactionHash     string         `gorm:"column:transaction_hash"`
 	UnsignedTransaction string         `gorm:"column:unsigned_transaction;index:idx_trades_unsigned_tx"` // For Solana, this could be base64 encoded transaction
 	CreatedAt           time.Time      `gorm:"column:created_at;default:CURRENT_TIMESTAMP;index:idx_trades_created_at"`
+	SubmittedAt         time.Time      `gorm:"column:submitted_at"`
 	CompletedAt         time.Time      `gorm:"column:completed_at"`
 	Confirmations       int32          `gorm:"column:confirmations;default:0"`
 	Finalized           bool           `gorm:"column:finalized;default:false"`
 	Error               string         `gorm:"column:error"`
 	FromAddress         string         `gorm:"column:from_address"`
 	ToAddress           string         `gorm:"column:to_address"`
+	Memo                string         `gorm:"column:memo"`
+	References          pq.StringArray `gorm:"column:reference_keys;type:text[]"` // REFERENCES is reserved in SQL
 	DeletedAt           gorm.DeletedAt `gorm:"column:deleted_at;index"`
+	DeletedBy           string         `gorm:"column:deleted_by"`
+	DeletionReason      string         `gorm:"column:deletion_reason"`
+
+	// Fill read back from the confirmed swap transaction
+	ExecutedAmount       float64   `gorm:"column:executed_amount;default:0.0"`
+	ExecutedOutputAmount float64   `gorm:"column:executed_output_amount;default:0.0"`
+	ExecutedPlatformFee  float64   `gorm:"column:executed_platform_fee;default:0.0"`
+	ExecutedFeeMint      string    `gorm:"column:executed_fee_mint"`
+	NetworkFeeLamports   uint64    `gorm:"column:network_fee_lamports;default:0"`
+	SlippageBps          float64   `gorm:"column:slippage_bps;default:0.0"`
+	ReceiptAt            time.Time `gorm:"column:receipt_at"`
+
+	// Fee payer sponsorship
+	Sponsored           bool   `gorm:"column:sponsored;default:false"`
+	SponsorFeeBps       int    `gorm:"column:sponsor_fee_bps;default:0"`
+	SponsorCostLamports uint64 `gorm:"column:sponsor_cost_lamports;default:0"`
+
+	AcknowledgedRisks pq.StringArray `gorm:"column:acknowledged_risks;type:text[]"`
 }
 
 // GetID returns the primary key column name for Trade
@@ -142,3 +219,805 @@ func (NaughtyWord) TableName() string {
 func (n NaughtyWord) GetID() string {
 	return n.Word
 }
+
+// WebhookSubscription represents the structure of the 'webhook_subscriptions' table.
+type WebhookSubscription struct {
+	ID             string         `gorm:"primaryKey;column:id"`
+	PartnerName    string         `gorm:"column:partner_name;not null"`
+	URL            string         `gorm:"column:url;not null"`
+	Secret         string         `gorm:"column:secret;not null"`
+	EventTypes     pq.StringArray `gorm:"column:event_types;type:text[];index:idx_webhook_subscriptions_event_types,type:gin"`
+	WalletAddress  string         `gorm:"column:wallet_address;index:idx_webhook_subscriptions_wallet"`
+	CoinAddress    string         `gorm:"column:coin_address"`
+	Active         bool           `gorm:"column:active;default:true;index:idx_webhook_subscriptions_active"`
+	CreatedAt      time.Time      `gorm:"column:created_at;default:CURRENT_TIMESTAMP"`
+	UpdatedAt      time.Time      `gorm:"column:updated_at;default:CURRENT_TIMESTAMP"`
+	DeletedAt      gorm.DeletedAt `gorm:"column:deleted_at;index"`
+	DeletedBy      string         `gorm:"column:deleted_by"`
+	DeletionReason string         `gorm:"column:deletion_reason"`
+}
+
+// TableName overrides the default table name generation.
+func (WebhookSubscription) TableName() string {
+	return "webhook_subscriptions"
+}
+
+// GetID returns the primary key column name for WebhookSubscription
+func (w WebhookSubscription) GetID() string {
+	return "id"
+}
+
+// WebhookDeadLetter represents the structure of the 'webhook_dead_letters' table.
+type WebhookDeadLetter struct {
+	ID             uint      `gorm:"primaryKey;autoIncrement;column:id"`
+	SubscriptionID string    `gorm:"column:subscription_id;not null;index:idx_webhook_dead_letters_subscription"`
+	EventID        string    `gorm:"column:event_id;not null"`
+	EventType      string    `gorm:"column:event_type;not null"`
+	Payload        string    `gorm:"column:payload;type:text"`
+	Attempts       int       `gorm:"column:attempts;default:0"`
+	LastError      string    `gorm:"column:last_error"`
+	CreatedAt      time.Time `gorm:"column:created_at;default:CURRENT_TIMESTAMP;index:idx_webhook_dead_letters_created_at"`
+}
+
+// TableName overrides the default table name generation.
+func (WebhookDeadLetter) TableName() string {
+	return "webhook_dead_letters"
+}
+
+// GetID returns the primary key column name for WebhookDeadLetter
+func (d WebhookDeadLetter) GetID() string {
+	return "id"
+}
+
+// JobCheckpoint represents the structure of the 'job_checkpoints' table.
+type JobCheckpoint struct {
+	ID        string    `gorm:"primaryKey;column:id"` // Job name
+	Cursor    string    `gorm:"column:cursor;type:text"`
+	UpdatedAt time.Time `gorm:"column:updated_at;default:CURRENT_TIMESTAMP"`
+}
+
+// TableName overrides the default table name generation.
+func (JobCheckpoint) TableName() string {
+	return "job_checkpoints"
+}
+
+// GetID returns the primary key column name for JobCheckpoint
+func (j JobCheckpoint) GetID() string {
+	return "id"
+}
+
+// JobRun represents the structure of the 'job_runs' table.
+type JobRun struct {
+	ID         string     `gorm:"primaryKey;column:id"`
+	Job        string     `gorm:"column:job;not null;index:idx_job_runs_job_started,priority:1"`
+	Attempt    int        `gorm:"column:attempt;not null;default:1"`
+	Status     string     `gorm:"column:status;not null;index"`
+	Error      string     `gorm:"column:error;type:text"`
+	StartedAt  time.Time  `gorm:"column:started_at;not null;index:idx_job_runs_job_started,priority:2;index"`
+	FinishedAt *time.Time `gorm:"column:finished_at"`
+	DurationMs int64      `gorm:"column:duration_ms"`
+}
+
+// TableName overrides the default table name generation.
+func (JobRun) TableName() string {
+	return "job_runs"
+}
+
+// GetID returns the primary key column name for JobRun
+func (r JobRun) GetID() string {
+	return "id"
+}
+
+// Setting represents the structure of the 'settings' table.
+type Setting struct {
+	ID        string    `gorm:"primaryKey;column:id"` // Setting name
+	Value     string    `gorm:"column:value;type:text;not null"`
+	UpdatedAt time.Time `gorm:"column:updated_at;default:CURRENT_TIMESTAMP"`
+	UpdatedBy string    `gorm:"column:updated_by"`
+}
+
+// TableName overrides the default table name generation.
+func (Setting) TableName() string {
+	return "settings"
+}
+
+// GetID returns the primary key column name for Setting
+func (s Setting) GetID() string {
+	return "id"
+}
+
+// FeatureFlag represents the structure of the 'feature_flags' table.
+type FeatureFlag struct {
+	ID             string         `gorm:"primaryKey;column:id"` // Flag key
+	Description    string         `gorm:"column:description"`
+	Enabled        bool           `gorm:"column:enabled;default:false"`
+	RolloutPercent int            `gorm:"column:rollout_percent;default:0"`
+	AllowList      pq.StringArray `gorm:"column:allow_list;type:text[]"`
+	UpdatedAt      time.Time      `gorm:"column:updated_at;default:CURRENT_TIMESTAMP"`
+}
+
+// TableName overrides the default table name generation.
+func (FeatureFlag) TableName() string {
+	return "feature_flags"
+}
+
+// GetID returns the primary key column name for FeatureFlag
+func (f FeatureFlag) GetID() string {
+	return "id"
+}
+
+// SpamToken represents the structure of the 'spam_tokens' table.
+type SpamToken struct {
+	ID        string    `gorm:"primaryKey;column:id"` // Mint address
+	Verdict   string    `gorm:"column:verdict;not null"`
+	Reason    string    `gorm:"column:reason"`
+	UpdatedBy string    `gorm:"column:updated_by"`
+	UpdatedAt time.Time `gorm:"column:updated_at;default:CURRENT_TIMESTAMP"`
+}
+
+// TableName overrides the default table name generation.
+func (SpamToken) TableName() string {
+	return "spam_tokens"
+}
+
+// GetID returns the primary key column name for SpamToken
+func (s SpamToken) GetID() string {
+	return "id"
+}
+
+// BlockedMint represents the structure of the 'blocked_mints' table.
+type BlockedMint struct {
+	ID        string    `gorm:"primaryKey;column:id"` // "<source>:<mint>"
+	Mint      string    `gorm:"column:mint;not null;index:idx_blocked_mints_mint"`
+	Source    string    `gorm:"column:source;not null;index:idx_blocked_mints_source"`
+	Reason    string    `gorm:"column:reason"`
+	Allow     bool      `gorm:"column:allow;default:false"`
+	UpdatedBy string    `gorm:"column:updated_by"`
+	UpdatedAt time.Time `gorm:"column:updated_at;default:CURRENT_TIMESTAMP"`
+}
+
+// TableName overrides the default table name generation.
+func (BlockedMint) TableName() string {
+	return "blocked_mints"
+}
+
+// GetID returns the primary key column name for BlockedMint
+func (b BlockedMint) GetID() string {
+	return "id"
+}
+
+// CoinDescription represents the structure of the 'coin_descriptions' table.
+type CoinDescription struct {
+	ID          string    `gorm:"primaryKey;column:id"` // "<coin_address>:<locale>"
+	CoinAddress string    `gorm:"column:coin_address;not null;index:idx_coin_descriptions_coin_address"`
+	Locale      string    `gorm:"column:locale;not null"`
+	Description string    `gorm:"column:description;type:text;not null"`
+	Source      string    `gorm:"column:source;not null"`
+	UpdatedBy   string    `gorm:"column:updated_by"`
+	UpdatedAt   time.Time `gorm:"column:updated_at;default:CURRENT_TIMESTAMP"`
+}
+
+// TableName overrides the default table name generation.
+func (CoinDescription) TableName() string {
+	return "coin_descriptions"
+}
+
+// GetID returns the primary key column name for CoinDescription
+func (c CoinDescription) GetID() string {
+	return "id"
+}
+
+// PaymentRequest represents the structure of the 'payment_requests' table.
+type PaymentRequest struct {
+	ID        string    `gorm:"primaryKey;column:id"`
+	Recipient string    `gorm:"column:recipient;not null;index:idx_payment_requests_recipient"`
+	Mint      string    `gorm:"column:mint"`
+	Amount    string    `gorm:"column:amount;not null"`
+	Reference string    `gorm:"column:reference;not null;uniqueIndex:idx_payment_requests_reference"`
+	Label     string    `gorm:"column:label"`
+	Message   string    `gorm:"column:message"`
+	Memo      string    `gorm:"column:memo"`
+	Status    string    `gorm:"column:status;not null;index:idx_payment_requests_status"`
+	Signature string    `gorm:"column:signature"`
+	Payer     string    `gorm:"column:payer"`
+	CreatedAt time.Time `gorm:"column:created_at;default:CURRENT_TIMESTAMP"`
+	ExpiresAt time.Time `gorm:"column:expires_at"`
+	PaidAt    time.Time `gorm:"column:paid_at"`
+}
+
+// TableName overrides the default table name generation.
+func (PaymentRequest) TableName() string {
+	return "payment_requests"
+}
+
+// GetID returns the primary key column name for PaymentRequest
+func (p PaymentRequest) GetID() string {
+	return "id"
+}
+
+// BurnWatch represents the structure of the 'burn_watches' table.
+type BurnWatch struct {
+	Mint           string         `gorm:"primaryKey;column:mint"`
+	CoinAddress    string         `gorm:"column:coin_address;not null;index:idx_burn_watches_coin_address"`
+	Kind           string         `gorm:"column:kind;not null"`
+	Pool           string         `gorm:"column:pool"`
+	Decimals       int            `gorm:"column:decimals"`
+	Supply         string         `gorm:"column:supply"`
+	Burned         string         `gorm:"column:burned"`
+	BurnedPercent  float64        `gorm:"column:burned_percent"`
+	LastBurnAt     time.Time      `gorm:"column:last_burn_at"`
+	CheckedAt      time.Time      `gorm:"column:checked_at"`
+	PoolsCheckedAt time.Time      `gorm:"column:pools_checked_at"`
+	DeletedAt      gorm.DeletedAt `gorm:"column:deleted_at;index"`
+	DeletedBy      string         `gorm:"column:deleted_by"`
+	DeletionReason string         `gorm:"column:deletion_reason"`
+}
+
+// TableName overrides the default table name generation.
+func (BurnWatch) TableName() string {
+	return "burn_watches"
+}
+
+// GetID returns the primary key column name for BurnWatch
+func (w BurnWatch) GetID() string {
+	return "mint"
+}
+
+// BurnEvent represents the structure of the 'burn_events' table.
+type BurnEvent struct {
+	ID            string    `gorm:"primaryKey;column:id"`
+	CoinAddress   string    `gorm:"column:coin_address;not null;index:idx_burn_events_coin_detected,priority:1"`
+	Kind          string    `gorm:"column:kind;not null"`
+	Mint          string    `gorm:"column:mint;not null"`
+	Pool          string    `gorm:"column:pool"`
+	Amount        string    `gorm:"column:amount;not null"`
+	Decimals      int       `gorm:"column:decimals"`
+	BurnedPercent float64   `gorm:"column:burned_percent"`
+	DetectedAt    time.Time `gorm:"column:detected_at;index:idx_burn_events_coin_detected,priority:2"`
+}
+
+// TableName overrides the default table name generation.
+func (BurnEvent) TableName() string {
+	return "burn_events"
+}
+
+// GetID returns the primary key column name for BurnEvent
+func (e BurnEvent) GetID() string {
+	return "id"
+}
+
+// MintAuthority represents the structure of the 'mint_authorities' table.
+type MintAuthority struct {
+	Mint            string    `gorm:"primaryKey;column:mint"`
+	MintAuthority   string    `gorm:"column:mint_authority"`
+	FreezeAuthority string    `gorm:"column:freeze_authority"`
+	SafetyScore     int       `gorm:"column:safety_score"`
+	ChangedAt       time.Time `gorm:"column:changed_at"`
+	CheckedAt       time.Time `gorm:"column:checked_at"`
+}
+
+// TableName overrides the default table name generation.
+func (MintAuthority) TableName() string {
+	return "mint_authorities"
+}
+
+// GetID returns the primary key column name for MintAuthority
+func (a MintAuthority) GetID() string {
+	return "mint"
+}
+
+// AuthorityChange represents the structure of the 'authority_changes' table.
+type AuthorityChange struct {
+	ID          string    `gorm:"primaryKey;column:id"`
+	CoinAddress string    `gorm:"column:coin_address;not null;index:idx_authority_changes_coin_detected,priority:1"`
+	Authority   string    `gorm:"column:authority;not null"`
+	Previous    string    `gorm:"column:previous"`
+	Current     string    `gorm:"column:current"`
+	SafetyScore int       `gorm:"column:safety_score"`
+	DetectedAt  time.Time `gorm:"column:detected_at;index:idx_authority_changes_coin_detected,priority:2"`
+}
+
+// TableName overrides the default table name generation.
+func (AuthorityChange) TableName() string {
+	return "authority_changes"
+}
+
+// GetID returns the primary key column name for AuthorityChange
+func (c AuthorityChange) GetID() string {
+	return "id"
+}
+
+// CoinRevision represents the structure of the 'coin_revisions' table.
+type CoinRevision struct {
+	ID          string    `gorm:"primaryKey;column:id"`
+	CoinAddress string    `gorm:"column:coin_address;not null;index:idx_coin_revisions_coin_changed,priority:1"`
+	Field       string    `gorm:"column:field;not null"`
+	Previous    string    `gorm:"column:previous"`
+	Current     string    `gorm:"column:current"`
+	Source      string    `gorm:"column:source;not null"`
+	ChangedAt   time.Time `gorm:"column:changed_at;index:idx_coin_revisions_coin_changed,priority:2"`
+}
+
+// TableName overrides the default table name generation.
+func (CoinRevision) TableName() string {
+	return "coin_revisions"
+}
+
+// GetID returns the primary key column name for CoinRevision
+func (r CoinRevision) GetID() string {
+	return "id"
+}
+
+// SearchSynonym represents the structure of the 'search_synonyms' table.
+type SearchSynonym struct {
+	ID        string    `gorm:"primaryKey;column:id"` // Lowercased term
+	Target    string    `gorm:"column:target;not null"`
+	UpdatedBy string    `gorm:"column:updated_by"`
+	UpdatedAt time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+// TableName overrides the default table name generation.
+func (SearchSynonym) TableName() string {
+	return "search_synonyms"
+}
+
+// GetID returns the primary key column name for SearchSynonym
+func (s SearchSynonym) GetID() string {
+	return "id"
+}
+
+// CoinView represents the structure of the 'coin_views' table.
+type CoinView struct {
+	ID           string    `gorm:"primaryKey;column:id"` // "<viewer>:<coin_address>"
+	Viewer       string    `gorm:"column:viewer;not null;index:idx_coin_views_viewer_viewed,priority:1"`
+	CoinAddress  string    `gorm:"column:coin_address;not null"`
+	Views        int       `gorm:"column:views;not null;default:0"`
+	LastViewedAt time.Time `gorm:"column:last_viewed_at;index:idx_coin_views_viewer_viewed,priority:2"`
+}
+
+// TableName overrides the default table name generation.
+func (CoinView) TableName() string {
+	return "coin_views"
+}
+
+// GetID returns the primary key column name for CoinView
+func (v CoinView) GetID() string {
+	return "id"
+}
+
+// PaperBalance represents the structure of the 'paper_balances' table.
+type PaperBalance struct {
+	ID             string    `gorm:"primaryKey;column:id"` // "<wallet_address>:<mint>"
+	WalletAddress  string    `gorm:"column:wallet_address;not null;index"`
+	Mint           string    `gorm:"column:mint;not null"`
+	Amount         float64   `gorm:"column:amount;not null;default:0"`
+	CostBasisUSD   float64   `gorm:"column:cost_basis_usd;not null;default:0"`
+	RealizedPnLUSD float64   `gorm:"column:realized_pnl_usd;not null;default:0"`
+	UpdatedAt      time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+// TableName overrides the default table name generation.
+func (PaperBalance) TableName() string {
+	return "paper_balances"
+}
+
+// GetID returns the primary key column name for PaperBalance
+func (b PaperBalance) GetID() string {
+	return "id"
+}
+
+// PaperTrade represents the structure of the 'paper_trades' table.
+type PaperTrade struct {
+	ID                  string    `gorm:"primaryKey;column:id"`
+	WalletAddress       string    `gorm:"column:wallet_address;not null;index:idx_paper_trades_wallet_created,priority:1"`
+	QuoteID             string    `gorm:"column:quote_id;not null;uniqueIndex"`
+	FromCoinMintAddress string    `gorm:"column:from_coin_mint_address;not null"`
+	ToCoinMintAddress   string    `gorm:"column:to_coin_mint_address;not null"`
+	Amount              float64   `gorm:"column:amount;not null"`
+	OutputAmount        float64   `gorm:"column:output_amount;not null"`
+	RawAmount           string    `gorm:"column:raw_amount"`
+	RawOutputAmount     string    `gorm:"column:raw_output_amount"`
+	FromUSDPrice        float64   `gorm:"column:from_usd_price"`
+	ToUSDPrice          float64   `gorm:"column:to_usd_price"`
+	Status              string    `gorm:"column:status;not null"`
+	QuoteExpiresAt      time.Time `gorm:"column:quote_expires_at"`
+	CreatedAt           time.Time `gorm:"column:created_at;index:idx_paper_trades_wallet_created,priority:2"`
+	FilledAt            time.Time `gorm:"column:filled_at"`
+}
+
+// TableName overrides the default table name generation.
+func (PaperTrade) TableName() string {
+	return "paper_trades"
+}
+
+// GetID returns the primary key column name for PaperTrade
+func (t PaperTrade) GetID() string {
+	return "id"
+}
+
+// FeeLedgerEntry represents the structure of the 'fee_ledger_entries' table.
+type FeeLedgerEntry struct {
+	ID              string    `gorm:"primaryKey;column:id"`
+	WalletAddress   string    `gorm:"column:wallet_address;not null;index:idx_fee_ledger_wallet_kind_created,priority:1"`
+	TradeID         uint      `gorm:"column:trade_id;index"`
+	Kind            string    `gorm:"column:kind;not null;index:idx_fee_ledger_wallet_kind_created,priority:2"`
+	Mint            string    `gorm:"column:mint"`
+	Amount          float64   `gorm:"column:amount;not null"`
+	AmountUSD       float64   `gorm:"column:amount_usd"`
+	TransactionHash string    `gorm:"column:transaction_hash"`
+	CreatedAt       time.Time `gorm:"column:created_at;index:idx_fee_ledger_wallet_kind_created,priority:3"`
+}
+
+// TableName overrides the default table name generation.
+func (FeeLedgerEntry) TableName() string {
+	return "fee_ledger_entries"
+}
+
+// GetID returns the primary key column name for FeeLedgerEntry
+func (e FeeLedgerEntry) GetID() string {
+	return "id"
+}
+
+// TwapOrder represents the structure of the 'twap_orders' table.
+type TwapOrder struct {
+	ID                  string    `gorm:"primaryKey;column:id"`
+	WalletAddress       string    `gorm:"column:wallet_address;not null;index:idx_twap_orders_wallet_created,priority:1"`
+	FromCoinMintAddress string    `gorm:"column:from_coin_mint_address;not null"`
+	ToCoinMintAddress   string    `gorm:"column:to_coin_mint_address;not null"`
+	RawAmount           string    `gorm:"column:raw_amount;not null"`
+	Slices              int       `gorm:"column:slices;not null"`
+	IntervalSeconds     int64     `gorm:"column:interval_seconds;not null"`
+	SlippageBps         string    `gorm:"column:slippage_bps"`
+	AllowMultiHop       bool      `gorm:"column:allow_multi_hop;default:false"`
+	Status              string    `gorm:"column:status;not null;index"`
+	FilledSlices        int       `gorm:"column:filled_slices;default:0"`
+	FilledRawAmount     string    `gorm:"column:filled_raw_amount"`
+	OutputAmount        float64   `gorm:"column:output_amount;default:0.0"`
+	NextSliceAt         time.Time `gorm:"column:next_slice_at"`
+	CreatedAt           time.Time `gorm:"column:created_at;index:idx_twap_orders_wallet_created,priority:2"`
+	UpdatedAt           time.Time `gorm:"column:updated_at"`
+}
+
+// TableName overrides the default table name generation.
+func (TwapOrder) TableName() string {
+	return "twap_orders"
+}
+
+// GetID returns the primary key column name for TwapOrder
+func (o TwapOrder) GetID() string {
+	return "id"
+}
+
+// TwapSlice represents the structure of the 'twap_slices' table.
+type TwapSlice struct {
+	ID                  string    `gorm:"primaryKey;column:id"`
+	OrderID             string    `gorm:"column:order_id;not null;index"`
+	Index               int       `gorm:"column:slice_index;not null"` // INDEX is reserved in SQL
+	RawAmount           string    `gorm:"column:raw_amount;not null"`
+	Status              string    `gorm:"column:status;not null;index:idx_twap_slices_status_due,priority:1"`
+	DueAt               time.Time `gorm:"column:due_at;index:idx_twap_slices_status_due,priority:2"`
+	QuoteID             string    `gorm:"column:quote_id"`
+	UnsignedTransaction string    `gorm:"column:unsigned_transaction"`
+	QuoteExpiresAt      time.Time `gorm:"column:quote_expires_at"`
+	TransactionHash     string    `gorm:"column:transaction_hash"`
+	OutputAmount        float64   `gorm:"column:output_amount;default:0.0"`
+	Error               string    `gorm:"column:error"`
+	UpdatedAt           time.Time `gorm:"column:updated_at"`
+	ClaimedBy           string    `gorm:"column:claimed_by"`
+	LeaseExpiresAt      time.Time `gorm:"column:lease_expires_at"`
+	Attempts            int       `gorm:"column:attempts;default:0"`
+}
+
+// TableName overrides the default table name generation.
+func (TwapSlice) TableName() string {
+	return "twap_slices"
+}
+
+// GetID returns the primary key column name for TwapSlice
+func (s TwapSlice) GetID() string {
+	return "id"
+}
+
+// AuditEntry represents the structure of the 'audit_log' table.
+type AuditEntry struct {
+	ID            string    `gorm:"primaryKey;column:id"`
+	WalletAddress string    `gorm:"column:wallet_address;not null;index:idx_audit_log_wallet_created,priority:1"`
+	Action        string    `gorm:"column:action;not null;index"`
+	Subject       string    `gorm:"column:subject"`
+	Details       string    `gorm:"column:details;type:jsonb"`
+	CreatedAt     time.Time `gorm:"column:created_at;index:idx_audit_log_wallet_created,priority:2"`
+}
+
+// TableName overrides the default table name generation.
+func (AuditEntry) TableName() string {
+	return "audit_log"
+}
+
+// GetID returns the primary key column name for AuditEntry
+func (e AuditEntry) GetID() string {
+	return "id"
+}
+
+// TradeLimit represents the structure of the 'trade_limits' table.
+type TradeLimit struct {
+	ID             string    `gorm:"primaryKey;column:id"` // Wallet address
+	DailyLimitUSD  float64   `gorm:"column:daily_limit_usd"`
+	WeeklyLimitUSD float64   `gorm:"column:weekly_limit_usd"`
+	Exempt         bool      `gorm:"column:exempt;not null;default:false"`
+	Reason         string    `gorm:"column:reason"`
+	UpdatedBy      string    `gorm:"column:updated_by"`
+	UpdatedAt      time.Time `gorm:"column:updated_at"`
+}
+
+// TableName overrides the default table name generation.
+func (TradeLimit) TableName() string {
+	return "trade_limits"
+}
+
+// GetID returns the primary key column name for TradeLimit
+func (l TradeLimit) GetID() string {
+	return "id"
+}
+
+// TradeVolume represents the structure of the 'trade_volumes' table.
+type TradeVolume struct {
+	ID            string    `gorm:"primaryKey;column:id"` // "<wallet>:<YYYY-MM-DD>"
+	WalletAddress string    `gorm:"column:wallet_address;not null;index:idx_trade_volumes_wallet_day,priority:1"`
+	Day           time.Time `gorm:"column:day;type:date;not null;index:idx_trade_volumes_wallet_day,priority:2"`
+	NotionalUSD   float64   `gorm:"column:notional_usd;not null;default:0"`
+	Trades        int       `gorm:"column:trades;not null;default:0"`
+	UpdatedAt     time.Time `gorm:"column:updated_at"`
+}
+
+// TableName overrides the default table name generation.
+func (TradeVolume) TableName() string {
+	return "trade_volumes"
+}
+
+// GetID returns the primary key column name for TradeVolume
+func (v TradeVolume) GetID() string {
+	return "id"
+}
+
+// ImageHash represents the structure of the 'image_hashes' table.
+type ImageHash struct {
+	ID          string    `gorm:"primaryKey;column:id"`       // Mint address
+	Hash        int64     `gorm:"column:hash;not null;index"` // Bit pattern of the uint64 hash; Postgres has no unsigned bigint
+	ObjectKey   string    `gorm:"column:object_key;not null"`
+	ContentType string    `gorm:"column:content_type"`
+	Width       int       `gorm:"column:width;not null;default:0"`
+	Height      int       `gorm:"column:height;not null;default:0"`
+	UpdatedAt   time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+// TableName overrides the default table name generation.
+func (ImageHash) TableName() string {
+	return "image_hashes"
+}
+
+// GetID returns the primary key column name for ImageHash
+func (h ImageHash) GetID() string {
+	return "id"
+}
+
+// PlaceholderIcon represents the structure of the 'placeholder_icons' table.
+type PlaceholderIcon struct {
+	ID          string    `gorm:"primaryKey;column:id"` // Mint address
+	OriginalURL string    `gorm:"column:original_url"`
+	Reason      string    `gorm:"column:reason"`
+	Attempts    int       `gorm:"column:attempts;not null;default:0;index"`
+	CreatedAt   time.Time `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt   time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+// TableName overrides the default table name generation.
+func (PlaceholderIcon) TableName() string {
+	return "placeholder_icons"
+}
+
+// GetID returns the primary key column name for PlaceholderIcon
+func (p PlaceholderIcon) GetID() string {
+	return "id"
+}
+
+// CoinOverride represents the structure of the 'coin_overrides' table.
+type CoinOverride struct {
+	ID          string    `gorm:"primaryKey;column:id"`
+	CoinAddress string    `gorm:"column:coin_address;not null;index"`
+	Field       string    `gorm:"column:field;not null"`
+	Value       string    `gorm:"column:value;not null"`
+	UpdatedBy   string    `gorm:"column:updated_by"`
+	UpdatedAt   time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+// TableName overrides the default table name generation.
+func (CoinOverride) TableName() string {
+	return "coin_overrides"
+}
+
+// GetID returns the primary key column name for CoinOverride
+func (o CoinOverride) GetID() string {
+	return "id"
+}
+
+// NotificationPreferences represents the structure of the 'notification_preferences' table.
+type NotificationPreferences struct {
+	WalletAddress        string         `gorm:"primaryKey;column:wallet_address"`
+	DeviceTokens         pq.StringArray `gorm:"column:device_tokens;type:text[]"`
+	Channel              string         `gorm:"column:channel;not null;default:push"`
+	DisabledTypes        pq.StringArray `gorm:"column:disabled_types;type:text[]"`
+	TransferThresholdUSD float64        `gorm:"column:transfer_threshold_usd"`
+	DumpThresholdPercent float64        `gorm:"column:dump_threshold_percent"`
+	QuietHoursStart      string         `gorm:"column:quiet_hours_start"`
+	QuietHoursEnd        string         `gorm:"column:quiet_hours_end"`
+	Timezone             string         `gorm:"column:timezone"`
+	UpdatedAt            time.Time      `gorm:"column:updated_at"`
+}
+
+// TableName overrides the default table name generation.
+func (NotificationPreferences) TableName() string {
+	return "notification_preferences"
+}
+
+// GetID returns the primary key column name for NotificationPreferences
+func (p NotificationPreferences) GetID() string {
+	return "wallet_address"
+}
+
+// NotificationDelivery represents the structure of the 'notification_deliveries' table.
+type NotificationDelivery struct {
+	ID            string    `gorm:"primaryKey;column:id"`
+	WalletAddress string    `gorm:"column:wallet_address;not null;index:idx_notification_deliveries_wallet_created,priority:1"`
+	Type          string    `gorm:"column:type;not null"`
+	Title         string    `gorm:"column:title"`
+	Body          string    `gorm:"column:body"`
+	Status        string    `gorm:"column:status;not null;index"`
+	Detail        string    `gorm:"column:detail"`
+	Devices       int       `gorm:"column:devices"`
+	CreatedAt     time.Time `gorm:"column:created_at;index:idx_notification_deliveries_wallet_created,priority:2"`
+}
+
+// TableName overrides the default table name generation.
+func (NotificationDelivery) TableName() string {
+	return "notification_deliveries"
+}
+
+// GetID returns the primary key column name for NotificationDelivery
+func (d NotificationDelivery) GetID() string {
+	return "id"
+}
+
+// Announcement represents the structure of the 'announcements' table.
+type Announcement struct {
+	ID            string     `gorm:"primaryKey;column:id"`
+	Title         string     `gorm:"column:title;not null"`
+	Body          string     `gorm:"column:body;type:text"`
+	Severity      string     `gorm:"column:severity;not null"`
+	MinAppVersion string     `gorm:"column:min_app_version"`
+	MaxAppVersion string     `gorm:"column:max_app_version"`
+	StartsAt      time.Time  `gorm:"column:starts_at;not null;index"`
+	EndsAt        *time.Time `gorm:"column:ends_at"`
+	CreatedBy     string     `gorm:"column:created_by"`
+	CreatedAt     time.Time  `gorm:"column:created_at"`
+	UpdatedAt     time.Time  `gorm:"column:updated_at"`
+}
+
+// TableName overrides the default table name generation.
+func (Announcement) TableName() string {
+	return "announcements"
+}
+
+// GetID returns the primary key column name for Announcement
+func (a Announcement) GetID() string {
+	return "id"
+}
+
+// MEVIncident represents the structure of the 'mev_incidents' table.
+type MEVIncident struct {
+	ID                string    `gorm:"primaryKey;column:id"`
+	TradeID           uint      `gorm:"column:trade_id;not null;index"`
+	Slot              uint64    `gorm:"column:slot"`
+	Attacker          string    `gorm:"column:attacker;not null;index"`
+	FrontRunSignature string    `gorm:"column:front_run_signature"`
+	BackRunSignature  string    `gorm:"column:back_run_signature"`
+	Pool              string    `gorm:"column:pool"`
+	LossMint          string    `gorm:"column:loss_mint"`
+	EstimatedLoss     float64   `gorm:"column:estimated_loss"`
+	EstimatedLossUSD  float64   `gorm:"column:estimated_loss_usd"`
+	DetectedAt        time.Time `gorm:"column:detected_at;index"`
+}
+
+// TableName overrides the default table name generation.
+func (MEVIncident) TableName() string {
+	return "mev_incidents"
+}
+
+// GetID returns the primary key column name for MEVIncident
+func (i MEVIncident) GetID() string {
+	return "id"
+}
+
+// DataDeletion represents the structure of the 'data_deletions' table.
+type DataDeletion struct {
+	ID            string    `gorm:"primaryKey;column:id"`
+	WalletAddress string    `gorm:"column:wallet_address;not null;index"`
+	Status        string    `gorm:"column:status;not null;index"`
+	Attempts      int       `gorm:"column:attempts;default:0"`
+	Error         string    `gorm:"column:error"`
+	RequestedAt   time.Time `gorm:"column:requested_at"`
+	UpdatedAt     time.Time `gorm:"column:updated_at"`
+}
+
+// TableName overrides the default table name generation.
+func (DataDeletion) TableName() string {
+	return "data_deletions"
+}
+
+// GetID returns the primary key column name for DataDeletion
+func (d DataDeletion) GetID() string {
+	return "id"
+}
+
+// DeletionCertificate represents the structure of the 'deletion_certificates' table.
+type DeletionCertificate struct {
+	ID          string           `gorm:"primaryKey;column:id"`
+	SubjectHash string           `gorm:"column:subject_hash;not null;index"`
+	Erased      map[string]int64 `gorm:"column:erased;type:jsonb;serializer:json"`
+	RequestedAt time.Time        `gorm:"column:requested_at"`
+	CompletedAt time.Time        `gorm:"column:completed_at"`
+}
+
+// TableName overrides the default table name generation.
+func (DeletionCertificate) TableName() string {
+	return "deletion_certificates"
+}
+
+// GetID returns the primary key column name for DeletionCertificate
+func (c DeletionCertificate) GetID() string {
+	return "id"
+}
+
+// AnnouncementRead represents the structure of the 'announcement_reads' table.
+type AnnouncementRead struct {
+	ID             string    `gorm:"primaryKey;column:id"` // "<announcement_id>:<wallet_address>"
+	AnnouncementID string    `gorm:"column:announcement_id;not null"`
+	WalletAddress  string    `gorm:"column:wallet_address;not null;index"`
+	ReadAt         time.Time `gorm:"column:read_at"`
+}
+
+// TableName overrides the default table name generation.
+func (AnnouncementRead) TableName() string {
+	return "announcement_reads"
+}
+
+// GetID returns the primary key column name for AnnouncementRead
+func (r AnnouncementRead) GetID() string {
+	return "id"
+}
+
+// PricePoint represents the structure of the 'price_points' table.
+type PricePoint struct {
+	Address    string  `gorm:"primaryKey;column:address"`
+	Resolution int64   `gorm:"primaryKey;column:resolution;autoIncrement:false"` // Seconds
+	UnixTime   int64   `gorm:"primaryKey;column:unix_time;autoIncrement:false"`
+	Value      float64 `gorm:"column:value;not null"`
+}
+
+// TableName overrides the default table name generation.
+func (PricePoint) TableName() string {
+	return "price_points"
+}
+
+// PriceHistoryRange represents the structure of the 'price_history_ranges' table.
+type PriceHistoryRange struct {
+	ID         uint   `gorm:"primaryKey;autoIncrement;column:id"`
+	Address    string `gorm:"column:address;not null;index:idx_price_history_ranges_address_resolution,priority:1"`
+	Resolution int64  `gorm:"column:resolution;not null;index:idx_price_history_ranges_address_resolution,priority:2"` // Seconds
+	FromUnix   int64  `gorm:"column:from_unix;not null"`
+	ToUnix     int64  `gorm:"column:to_unix;not null"`
+}
+
+// TableName overrides the default table name generation.
+func (PriceHistoryRange) TableName() string {
+	return "price_history_ranges"
+}
@@ -27,6 +27,42 @@ type Store struct {
 	tradesRepo       db.Repository[model.Trade]
 	walletRepo       db.Repository[model.Wallet]
 	naughtyWordsRepo db.Repository[model.NaughtyWord]
+	webhookSubsRepo  db.Repository[model.WebhookSubscription]
+	deadLettersRepo  db.Repository[model.WebhookDeadLetter]
+	checkpointsRepo  db.Repository[model.JobCheckpoint]
+	jobRunsRepo      db.Repository[model.JobRun]
+	settingsRepo     db.Repository[model.Setting]
+	featureFlagsRepo db.Repository[model.FeatureFlag]
+	spamTokensRepo   db.Repository[model.SpamToken]
+	blockedMintsRepo db.Repository[model.BlockedMint]
+	coinDescriptionsRepo db.Repository[model.CoinDescription]
+	paymentRequestsRepo db.Repository[model.PaymentRequest]
+	burnWatchesRepo     db.Repository[model.BurnWatch]
+	burnEventsRepo      db.Repository[model.BurnEvent]
+	mintAuthoritiesRepo db.Repository[model.MintAuthority]
+	authorityChangesRepo db.Repository[model.AuthorityChange]
+	coinRevisionsRepo    db.Repository[model.CoinRevision]
+	coinOverridesRepo    db.Repository[model.CoinOverride]
+	imageHashesRepo      db.Repository[model.ImageHash]
+	placeholderIconsRepo db.Repository[model.PlaceholderIcon]
+	searchSynonymsRepo   db.Repository[model.SearchSynonym]
+	coinViewsRepo        db.Repository[model.CoinView]
+	paperBalancesRepo    db.Repository[model.PaperBalance]
+	paperTradesRepo      db.Repository[model.PaperTrade]
+	feeLedgerRepo        db.Repository[model.FeeLedgerEntry]
+	twapOrdersRepo       db.Repository[model.TwapOrder]
+	twapSlicesRepo       db.Repository[model.TwapSlice]
+	auditLogRepo         db.Repository[model.AuditEntry]
+	tradeLimitsRepo      db.Repository[model.TradeLimit]
+	tradeVolumesRepo     db.Repository[model.TradeVolume]
+	notificationPrefsRepo db.Repository[model.NotificationPreferences]
+	notificationDeliveriesRepo db.Repository[model.NotificationDelivery]
+	announcementsRepo          db.Repository[model.Announcement]
+	announcementReadsRepo      db.Repository[model.AnnouncementRead]
+	mevIncidentsRepo           db.Repository[model.MEVIncident]
+	dataDeletionsRepo          db.Repository[model.DataDeletion]
+	deletionCertificatesRepo   db.Repository[model.DeletionCertificate]
+	queryStats       *QueryStats // Set by NewStore; nil for stores built on an existing DB
 }
 
 var _ db.Store = (*Store)(nil) // Compile-time check for interface implementation
@@ -40,6 +76,41 @@ func NewStoreWithDB(database *gorm.DB) *Store {
 		tradesRepo:       NewRepository[schema.Trade, model.Trade](database),
 		walletRepo:       NewRepository[schema.Wallet, model.Wallet](database),
 		naughtyWordsRepo: NewRepository[schema.NaughtyWord, model.NaughtyWord](database),
+		webhookSubsRepo:  NewRepository[schema.WebhookSubscription, model.WebhookSubscription](database),
+		deadLettersRepo:  NewRepository[schema.WebhookDeadLetter, model.WebhookDeadLetter](database),
+		checkpointsRepo:  NewRepository[schema.JobCheckpoint, model.JobCheckpoint](database),
+		jobRunsRepo:      NewRepository[schema.JobRun, model.JobRun](database),
+		settingsRepo:     NewRepository[schema.Setting, model.Setting](database),
+		featureFlagsRepo: NewRepository[schema.FeatureFlag, model.FeatureFlag](database),
+		spamTokensRepo:   NewRepository[schema.SpamToken, model.SpamToken](database),
+		blockedMintsRepo: NewRepository[schema.BlockedMint, model.BlockedMint](database),
+		coinDescriptionsRepo: NewRepository[schema.CoinDescription, model.CoinDescription](database),
+		paymentRequestsRepo: NewRepository[schema.PaymentRequest, model.PaymentRequest](database),
+		burnWatchesRepo:     NewRepository[schema.BurnWatch, model.BurnWatch](database),
+		burnEventsRepo:      NewRepository[schema.BurnEvent, model.BurnEvent](database),
+		mintAuthoritiesRepo: NewRepository[schema.MintAuthority, model.MintAuthority](database),
+		authorityChangesRepo: NewRepository[schema.AuthorityChange, model.AuthorityChange](database),
+		coinRevisionsRepo:    NewRepository[schema.CoinRevision, model.CoinRevision](database),
+		coinOverridesRepo:    NewRepository[schema.CoinOverride, model.CoinOverride](database),
+		imageHashesRepo:      NewRepository[schema.ImageHash, model.ImageHash](database),
+		placeholderIconsRepo: NewRepository[schema.PlaceholderIcon, model.PlaceholderIcon](database),
+		searchSynonymsRepo:   NewRepository[schema.SearchSynonym, model.SearchSynonym](database),
+		coinViewsRepo:        NewRepository[schema.CoinView, model.CoinView](database),
+		paperBalancesRepo:    NewRepository[schema.PaperBalance, model.PaperBalance](database),
+		paperTradesRepo:      NewRepository[schema.PaperTrade, model.PaperTrade](database),
+		feeLedgerRepo:        NewRepository[schema.FeeLedgerEntry, model.FeeLedgerEntry](database),
+		twapOrdersRepo:       NewRepository[schema.TwapOrder, model.TwapOrder](database),
+		twapSlicesRepo:       NewRepository[schema.TwapSlice, model.TwapSlice](database),
+		auditLogRepo:         NewRepository[schema.AuditEntry, model.AuditEntry](database),
+		tradeLimitsRepo:      NewRepository[schema.TradeLimit, model.TradeLimit](database),
+		tradeVolumesRepo:     NewRepository[schema.TradeVolume, model.TradeVolume](database),
+		notificationPrefsRepo: NewRepository[schema.NotificationPreferences, model.NotificationPreferences](database),
+		notificationDeliveriesRepo: NewRepository[schema.NotificationDelivery, model.NotificationDelivery](database),
+		announcementsRepo:          NewRepository[schema.Announcement, model.Announcement](database),
+		announcementReadsRepo:      NewRepository[schema.AnnouncementRead, model.AnnouncementRead](database),
+		mevIncidentsRepo:           NewRepository[schema.MEVIncident, model.MEVIncident](database),
+		dataDeletionsRepo:          NewRepository[schema.DataDeletion, model.DataDeletion](database),
+		deletionCertificatesRepo:   NewRepository[schema.DeletionCertificate, model.DeletionCertificate](database),
 	}
 }
 
@@ -62,6 +133,11 @@ func NewStore(dsn string, enableAutoMigrate bool, appLogLevel slog.Level, env st
 		return nil, fmt.Errorf("failed to connect to database: %w", err)
 	}
 
+	queryStats := NewQueryStats(DefaultSlowQueryThreshold)
+	if err := db.Use(queryStats); err != nil {
+		return nil, fmt.Errorf("failed to register query stats: %w", err)
+	}
+
 	// Add OpenTelemetry instrumentation if not in development
 	if env != "development" {
 		if err := db.Use(tracing.NewPlugin(
@@ -86,20 +162,35 @@ func NewStore(dsn string, enableAutoMigrate bool, appLogLevel slog.Level, env st
 	}
 
 	if enableAutoMigrate {
-		// Auto-migrate the schema, now including model.ApiStat and schema.NaughtyWord
-		if err := db.AutoMigrate(&schema.Coin{}, &schema.Trade{}, &schema.Wallet{}, &schema.NaughtyWord{}); err != nil {
-			return nil, fmt.Errorf("failed to auto-migrate schemas: %w", err)
-		}
-		
-		// Drop unused columns from trades table
-		if err := dropUnusedTradeColumns(db); err != nil {
-			slog.Warn("Failed to drop unused trade columns", "error", err)
-			// Don't fail startup - just log the warning
+		if err := Migrate(db); err != nil {
+			return nil, err
 		}
 	}
 
 	// Use NewStoreWithDB to initialize the repositories
-	return NewStoreWithDB(db), nil
+	store := NewStoreWithDB(db)
+	store.queryStats = queryStats
+	return store, nil
+}
+
+// QueryStats returns the store's query duration and slow query tracker
+func (s *Store) QueryStats() *QueryStats {
+	return s.queryStats
+}
+
+// Migrate creates or updates every table the store uses
+func Migrate(db *gorm.DB) error {
+	// Auto-migrate the schema, now including model.ApiStat and schema.NaughtyWord
+	if err := db.AutoMigrate(&schema.Coin{}, &schema.Trade{}, &schema.Wallet{}, &schema.NaughtyWord{}, &schema.WebhookSubscription{}, &schema.WebhookDeadLetter{}, &schema.JobCheckpoint{}, &schema.JobRun{}, &schema.Setting{}, &schema.FeatureFlag{}, &schema.SpamToken{}, &schema.BlockedMint{}, &schema.CoinDescription{}, &schema.PaymentRequest{}, &schema.BurnWatch{}, &schema.BurnEvent{}, &schema.MintAuthority{}, &schema.AuthorityChange{}, &schema.CoinRevision{}, &schema.CoinOverride{}, &schema.ImageHash{}, &schema.PlaceholderIcon{}, &schema.SearchSynonym{}, &schema.CoinView{}, &schema.PaperBalance{}, &schema.PaperTrade{}, &schema.FeeLedgerEntry{}, &schema.TwapOrder{}, &schema.TwapSlice{}, &schema.AuditEntry{}, &schema.TradeLimit{}, &schema.TradeVolume{}, &schema.NotificationPreferences{}, &schema.NotificationDelivery{}, &schema.Announcement{}, &schema.AnnouncementRead{}, &schema.MEVIncident{}, &schema.DataDeletion{}, &schema.DeletionCertificate{}, &schema.ArchivedCoin{}, &schema.PricePoint{}, &schema.PriceHistoryRange{}); err != nil {
+		return fmt.Errorf("failed to auto-migrate schemas: %w", err)
+	}
+
+	// Drop unused columns from trades table
+	if err := dropUnusedTradeColumns(db); err != nil {
+		slog.Warn("Failed to drop unused trade columns", "error", err)
+		// Don't fail startup - just log the warning
+	}
+	return nil
 }
 
 // DB returns the underlying GORM database instance
@@ -143,6 +234,181 @@ func (s *Store) NaughtyWords() db.Repository[model.NaughtyWord] { // <<< ADD THI
 	return s.naughtyWordsRepo
 }
 
+// WebhookSubscriptions returns the repository for partner webhook subscriptions.
+func (s *Store) WebhookSubscriptions() db.Repository[model.WebhookSubscription] {
+	return s.webhookSubsRepo
+}
+
+// WebhookDeadLetters returns the repository for failed webhook deliveries.
+func (s *Store) WebhookDeadLetters() db.Repository[model.WebhookDeadLetter] {
+	return s.deadLettersRepo
+}
+
+// JobCheckpoints returns the repository for background job checkpoints.
+func (s *Store) JobCheckpoints() db.Repository[model.JobCheckpoint] {
+	return s.checkpointsRepo
+}
+
+// JobRuns returns the repository for background job run records.
+func (s *Store) JobRuns() db.Repository[model.JobRun] {
+	return s.jobRunsRepo
+}
+
+// Settings returns the repository for runtime configuration overrides.
+func (s *Store) Settings() db.Repository[model.Setting] {
+	return s.settingsRepo
+}
+
+// FeatureFlags returns the repository for feature flag definitions.
+func (s *Store) FeatureFlags() db.Repository[model.FeatureFlag] {
+	return s.featureFlagsRepo
+}
+
+// SpamTokens returns the repository for the admin-maintained spam token deny/allow list.
+func (s *Store) SpamTokens() db.Repository[model.SpamToken] {
+	return s.spamTokensRepo
+}
+
+// BlockedMints returns the repository for scam blocklist entries and admin overrides.
+func (s *Store) BlockedMints() db.Repository[model.BlockedMint] {
+	return s.blockedMintsRepo
+}
+
+// CoinDescriptions returns the repository for per-locale coin descriptions.
+func (s *Store) CoinDescriptions() db.Repository[model.CoinDescription] {
+	return s.coinDescriptionsRepo
+}
+
+// PaymentRequests returns the repository for Solana Pay payment requests.
+func (s *Store) PaymentRequests() db.Repository[model.PaymentRequest] {
+	return s.paymentRequestsRepo
+}
+
+// BurnWatches returns the repository for the burn checker's per-mint state.
+func (s *Store) BurnWatches() db.Repository[model.BurnWatch] {
+	return s.burnWatchesRepo
+}
+
+// BurnEvents returns the repository for detected supply and liquidity burns.
+func (s *Store) BurnEvents() db.Repository[model.BurnEvent] {
+	return s.burnEventsRepo
+}
+
+// MintAuthorities returns the repository for coins' last observed mint and freeze authorities.
+func (s *Store) MintAuthorities() db.Repository[model.MintAuthority] {
+	return s.mintAuthoritiesRepo
+}
+
+// AuthorityChanges returns the repository for detected mint and freeze authority changes.
+func (s *Store) AuthorityChanges() db.Repository[model.AuthorityChange] {
+	return s.authorityChangesRepo
+}
+
+// CoinRevisions returns the repository for the change history of coin metadata.
+func (s *Store) CoinRevisions() db.Repository[model.CoinRevision] {
+	return s.coinRevisionsRepo
+}
+
+// CoinOverrides returns the repository for admin overrides of coin metadata fields.
+func (s *Store) CoinOverrides() db.Repository[model.CoinOverride] {
+	return s.coinOverridesRepo
+}
+
+// ImageHashes returns the repository for perceptual hashes of coin icons.
+func (s *Store) ImageHashes() db.Repository[model.ImageHash] {
+	return s.imageHashesRepo
+}
+
+// PlaceholderIcons returns the repository for coins served placeholder icons.
+func (s *Store) PlaceholderIcons() db.Repository[model.PlaceholderIcon] {
+	return s.placeholderIconsRepo
+}
+
+// SearchSynonyms returns the repository for admin-managed coin search synonyms.
+func (s *Store) SearchSynonyms() db.Repository[model.SearchSynonym] {
+	return s.searchSynonymsRepo
+}
+
+// CoinViews returns the repository for viewers' coin view history.
+func (s *Store) CoinViews() db.Repository[model.CoinView] {
+	return s.coinViewsRepo
+}
+
+// PaperBalances returns the repository for wallets' virtual paper trading balances.
+func (s *Store) PaperBalances() db.Repository[model.PaperBalance] {
+	return s.paperBalancesRepo
+}
+
+// PaperTrades returns the repository for simulated paper trading swaps.
+func (s *Store) PaperTrades() db.Repository[model.PaperTrade] {
+	return s.paperTradesRepo
+}
+
+// FeeLedger returns the repository for platform fee ledger entries.
+func (s *Store) FeeLedger() db.Repository[model.FeeLedgerEntry] {
+	return s.feeLedgerRepo
+}
+
+// TwapOrders returns the repository for swaps split into slices over time.
+func (s *Store) TwapOrders() db.Repository[model.TwapOrder] {
+	return s.twapOrdersRepo
+}
+
+// TwapSlices returns the repository for the individual swaps of TWAP orders.
+func (s *Store) TwapSlices() db.Repository[model.TwapSlice] {
+	return s.twapSlicesRepo
+}
+
+// AuditLog returns the repository for the audit log.
+func (s *Store) AuditLog() db.Repository[model.AuditEntry] {
+	return s.auditLogRepo
+}
+
+// TradeLimits returns the repository for per-wallet trade limit overrides.
+func (s *Store) TradeLimits() db.Repository[model.TradeLimit] {
+	return s.tradeLimitsRepo
+}
+
+// TradeVolumes returns the repository for wallets' daily trade volume counters.
+func (s *Store) TradeVolumes() db.Repository[model.TradeVolume] {
+	return s.tradeVolumesRepo
+}
+
+// NotificationPreferences returns the repository for wallets' push notification settings.
+func (s *Store) NotificationPreferences() db.Repository[model.NotificationPreferences] {
+	return s.notificationPrefsRepo
+}
+
+// NotificationDeliveries returns the repository for the notification delivery log.
+func (s *Store) NotificationDeliveries() db.Repository[model.NotificationDelivery] {
+	return s.notificationDeliveriesRepo
+}
+
+// Announcements returns the repository for in-app announcements.
+func (s *Store) Announcements() db.Repository[model.Announcement] {
+	return s.announcementsRepo
+}
+
+// AnnouncementReads returns the repository for wallets' read announcements.
+func (s *Store) AnnouncementReads() db.Repository[model.AnnouncementRead] {
+	return s.announcementReadsRepo
+}
+
+// MEVIncidents returns the repository for suspected sandwiches of our swaps.
+func (s *Store) MEVIncidents() db.Repository[model.MEVIncident] {
+	return s.mevIncidentsRepo
+}
+
+// DataDeletions returns the repository for queued erasures of users' personal data.
+func (s *Store) DataDeletions() db.Repository[model.DataDeletion] {
+	return s.dataDeletionsRepo
+}
+
+// DeletionCertificates returns the repository for records of completed erasures.
+func (s *Store) DeletionCertificates() db.Repository[model.DeletionCertificate] {
+	return s.deletionCertificatesRepo
+}
+
 // --- Custom Operations ---
 
 func loggableInt(p *int) any {
@@ -269,6 +535,7 @@ func mapSchemaCoinsToModel(schemaCoins []schema.Coin) []model.Coin {
 			Decimals:               sc.Decimals,
 			Description:            sc.Description,
 			LogoURI:                sc.LogoURI,
+			LogoSourceURL:          sc.LogoSourceURL,
 			Tags:                   sc.Tags,
 			Price:                  sc.Price,
 			Price24hChangePercent:  sc.Price24hChangePercent,
@@ -351,6 +618,37 @@ func (s *Store) ListTopGainersCoins(ctx context.Context, opts db.ListOptions) ([
 	return coins, int32(len(coins)), nil
 }
 
+// GetMarketTotals sums 24h volume over all coins except those carrying any of
+// excludeTags and SOL itself, and counts coins listed since listedSince.
+func (s *Store) GetMarketTotals(ctx context.Context, excludeTags []string, listedSince time.Time) (*model.MarketTotals, error) {
+	var row struct {
+		Volume      float64
+		NewListings int
+	}
+	tx := s.db.WithContext(ctx).Model(&schema.Coin{}).
+		Select("COALESCE(SUM(volume_24h_usd), 0) AS volume, COUNT(*) FILTER (WHERE COALESCE(jupiter_created_at, created_at) >= ?) AS new_listings", listedSince).
+		Where("address NOT IN ?", []string{model.SolMint, model.NativeSolMint})
+	if len(excludeTags) > 0 {
+		tx = tx.Where("NOT (tags && ?)", pq.Array(excludeTags))
+	}
+	if err := tx.Scan(&row).Error; err != nil {
+		return nil, fmt.Errorf("failed to get market totals: %w", err)
+	}
+	return &model.MarketTotals{Volume24hUSD: row.Volume, NewListings24h: row.NewListings}, nil
+}
+
+// SetCoinLogoSource records where a coin's logo was fetched from. It is the
+// only write of the column, so refreshing a coin from upstream data keeps it.
+func (s *Store) SetCoinLogoSource(ctx context.Context, address, sourceURL string) error {
+	err := s.db.WithContext(ctx).Model(&schema.Coin{}).
+		Where("address = ?", address).
+		Update("logo_source_url", sourceURL).Error
+	if err != nil {
+		return fmt.Errorf("failed to set logo source of coin %s: %w", address, err)
+	}
+	return nil
+}
+
 // DeleteAccount deletes all data associated with a wallet/account.
 // This includes the wallet record and all associated trades.
 // This operation is performed in a transaction for atomicity.
@@ -379,6 +677,70 @@ func (s *Store) DeleteAccount(ctx context.Context, walletPublicKey string) error
 	})
 }
 
+// personalData lists the tables holding a data subject's personal data and
+// the column that identifies the subject in each. Fee ledger entries, trade
+// limits and the audit log are kept as financial and operational records.
+var personalData = []struct {
+	table  string
+	model  any
+	column string
+	key    func(db.DataSubject) string
+}{
+	{"trades", &schema.Trade{}, "user_id", walletKey},
+	{"paper_trades", &schema.PaperTrade{}, "wallet_address", walletKey},
+	{"paper_balances", &schema.PaperBalance{}, "wallet_address", walletKey},
+	{"webhook_subscriptions", &schema.WebhookSubscription{}, "wallet_address", walletKey},
+	{"notification_deliveries", &schema.NotificationDelivery{}, "wallet_address", walletKey},
+	{"notification_preferences", &schema.NotificationPreferences{}, "wallet_address", walletKey},
+	{"announcement_reads", &schema.AnnouncementRead{}, "wallet_address", walletKey},
+	{"coin_views", &schema.CoinView{}, "viewer", func(s db.DataSubject) string { return s.ViewerID }},
+	{"wallets", &schema.Wallet{}, "public_key", walletKey},
+}
+
+func walletKey(s db.DataSubject) string { return s.WalletAddress }
+
+// ErasePersonalData permanently deletes the subject's personal data,
+// including soft-deleted rows, and returns how many rows were erased per table.
+func (s *Store) ErasePersonalData(ctx context.Context, subject db.DataSubject) (map[string]int64, error) {
+	erased := make(map[string]int64, len(personalData))
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, data := range personalData {
+			key := data.key(subject)
+			if key == "" {
+				continue
+			}
+			result := tx.Unscoped().Where(data.column+" = ?", key).Delete(data.model)
+			if result.Error != nil {
+				return fmt.Errorf("failed to erase %s: %w", data.table, result.Error)
+			}
+			erased[data.table] = result.RowsAffected
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return erased, nil
+}
+
+// CountPersonalData returns how many rows of the subject's personal data are
+// left per table, including soft-deleted rows.
+func (s *Store) CountPersonalData(ctx context.Context, subject db.DataSubject) (map[string]int64, error) {
+	counts := make(map[string]int64, len(personalData))
+	for _, data := range personalData {
+		key := data.key(subject)
+		if key == "" {
+			continue
+		}
+		var count int64
+		if err := s.db.WithContext(ctx).Unscoped().Model(data.model).Where(data.column+" = ?", key).Count(&count).Error; err != nil {
+			return nil, fmt.Errorf("failed to count %s: %w", data.table, err)
+		}
+		counts[data.table] = count
+	}
+	return counts, nil
+}
+
 // dropUnusedTradeColumns drops columns that are no longer used in the Trade model
 func dropUnusedTradeColumns(db *gorm.DB) error {
 	migrator := db.Migrator()
@@ -0,0 +1,315 @@
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/db/dbtest"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+func TestSearchCoins(t *testing.T) {
+	t.Parallel()
+	store := dbtest.NewStore(t)
+	ctx := context.Background()
+
+	coins := []model.Coin{
+		{Address: "bonkMint", Name: "Bonk", Symbol: "BONK", Decimals: 5, Tags: []string{"trending", "meme"}, Volume24hUSD: 900},
+		{Address: "wifMint", Name: "dogwifhat", Symbol: "WIF", Decimals: 6, Tags: []string{"meme"}, Volume24hUSD: 500},
+		{Address: "jupMint", Name: "Jupiter", Symbol: "JUP", Decimals: 6, Tags: []string{"trending"}, Volume24hUSD: 50},
+	}
+	_, err := store.Coins().BulkUpsert(ctx, &coins)
+	require.NoError(t, err)
+
+	byName, err := store.SearchCoins(ctx, "bon", nil, 0, 10, 0, "", false)
+	require.NoError(t, err)
+	require.Len(t, byName, 1)
+	assert.Equal(t, "BONK", byName[0].Symbol)
+
+	// Tags must all match, and volume filters and sorting apply on top
+	memes, err := store.SearchCoins(ctx, "", []string{"meme"}, 100, 10, 0, "volume24h", true)
+	require.NoError(t, err)
+	require.Len(t, memes, 2)
+	assert.Equal(t, "BONK", memes[0].Symbol)
+	assert.Equal(t, "WIF", memes[1].Symbol)
+
+	trending, total, err := store.ListTrendingCoins(ctx, db.ListOptions{Limit: ptr(1)})
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, total)
+	assert.Equal(t, "BONK", trending[0].Symbol)
+}
+
+func TestGetMarketTotals(t *testing.T) {
+	t.Parallel()
+	store := dbtest.NewStore(t)
+	ctx := context.Background()
+
+	coins := []model.Coin{
+		{Address: model.SolMint, Name: "Solana", Symbol: "SOL", Decimals: 9, Volume24hUSD: 1_000_000},
+		{Address: "mintA", Name: "Alpha", Symbol: "A", Decimals: 6, Volume24hUSD: 300},
+		{Address: "mintB", Name: "Beta", Symbol: "B", Decimals: 6, Tags: []string{"stablecoin"}, Volume24hUSD: 700},
+	}
+	_, err := store.Coins().BulkUpsert(ctx, &coins)
+	require.NoError(t, err)
+
+	totals, err := store.GetMarketTotals(ctx, []string{"stablecoin"}, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 300.0, totals.Volume24hUSD, "SOL and excluded tags are not counted")
+	assert.Equal(t, 1, totals.NewListings24h)
+}
+
+func TestDeleteAccount(t *testing.T) {
+	t.Parallel()
+	store := dbtest.NewStore(t)
+	ctx := context.Background()
+
+	require.NoError(t, store.Wallet().Create(ctx, &model.Wallet{ID: "w1", PublicKey: "wallet1"}))
+	for _, user := range []string{"wallet1", "wallet1", "wallet2"} {
+		require.NoError(t, store.Trades().Create(ctx, &model.Trade{UserID: user, Type: "swap", Amount: 1, Status: "finalized"}))
+	}
+
+	require.NoError(t, store.DeleteAccount(ctx, "wallet1"))
+
+	_, err := store.Wallet().GetByField(ctx, "public_key", "wallet1")
+	assert.ErrorIs(t, err, db.ErrNotFound)
+	remaining, total, err := store.Trades().List(ctx, db.ListOptions{})
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, total)
+	assert.Equal(t, "wallet2", remaining[0].UserID)
+}
+
+func TestErasePersonalData(t *testing.T) {
+	t.Parallel()
+	store := dbtest.NewStore(t)
+	ctx := context.Background()
+	subject := db.DataSubject{WalletAddress: "wallet1", ViewerID: "viewer1"}
+
+	require.NoError(t, store.Wallet().Create(ctx, &model.Wallet{ID: "w1", PublicKey: "wallet1"}))
+	require.NoError(t, store.Trades().Create(ctx, &model.Trade{UserID: "wallet1", Type: "swap", Amount: 1, Status: "finalized"}))
+	require.NoError(t, store.Trades().Create(ctx, &model.Trade{UserID: "wallet2", Type: "swap", Amount: 1, Status: "finalized"}))
+	require.NoError(t, store.NotificationPreferences().Create(ctx, &model.NotificationPreferences{WalletAddress: "wallet1", DeviceTokens: []string{"token"}}))
+	for _, viewer := range []string{"viewer1", "viewer2"} {
+		require.NoError(t, store.CoinViews().Create(ctx, &model.CoinView{ID: viewer + ":mintA", Viewer: viewer, CoinAddress: "mintA", Views: 1, LastViewedAt: time.Now()}))
+	}
+	require.NoError(t, store.DeleteAccount(ctx, "wallet1"), "soft-deleted rows are erased too")
+
+	erased, err := store.ErasePersonalData(ctx, subject)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, erased["wallets"])
+	assert.EqualValues(t, 1, erased["trades"])
+	assert.EqualValues(t, 1, erased["notification_preferences"])
+	assert.EqualValues(t, 1, erased["coin_views"])
+
+	left, err := store.CountPersonalData(ctx, subject)
+	require.NoError(t, err)
+	for table, count := range left {
+		assert.Zero(t, count, table)
+	}
+	others, err := store.CountPersonalData(ctx, db.DataSubject{WalletAddress: "wallet2", ViewerID: "viewer2"})
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, others["trades"], "other users' data is kept")
+	assert.EqualValues(t, 1, others["coin_views"])
+}
+
+func TestArchiveInactiveCoins(t *testing.T) {
+	t.Parallel()
+	store := dbtest.NewStore(t)
+	ctx := context.Background()
+
+	coins := []model.Coin{
+		{Address: "deadMint", Name: "Dead Dog", Symbol: "DEAD", Decimals: 6},
+		{Address: "tradedMint", Name: "Traded", Symbol: "TRD", Decimals: 6},
+		{Address: "stockMint", Name: "Stock", Symbol: "STK", Decimals: 6, Tags: []string{"xstocks"}},
+		{Address: "liveMint", Name: "Live", Symbol: "LIVE", Decimals: 6, Volume24hUSD: 5000, Liquidity: 20000},
+	}
+	_, err := store.Coins().BulkUpsert(ctx, &coins)
+	require.NoError(t, err)
+	old := time.Now().Add(-30 * 24 * time.Hour)
+	require.NoError(t, store.DB().Exec("UPDATE coins SET last_updated = ?, created_at = ?", old, old).Error)
+	require.NoError(t, store.Trades().Create(ctx, &model.Trade{UserID: "wallet1", ToCoinMintAddress: "tradedMint", Type: "swap", Amount: 1, Status: "finalized"}))
+
+	criteria := db.ArchiveCriteria{
+		InactiveSince:   time.Now().Add(-14 * 24 * time.Hour),
+		MaxVolume24hUSD: 1,
+		MaxLiquidity:    100,
+		ExcludeTags:     []string{"xstocks"},
+		DryRun:          true,
+	}
+	preview, err := store.ArchiveInactiveCoins(ctx, criteria)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"deadMint"}, preview)
+	_, err = store.Coins().GetByField(ctx, "address", "deadMint")
+	require.NoError(t, err, "dry run leaves coins in place")
+
+	criteria.DryRun = false
+	archived, err := store.ArchiveInactiveCoins(ctx, criteria)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"deadMint"}, archived)
+	_, err = store.Coins().GetByField(ctx, "address", "deadMint")
+	assert.ErrorIs(t, err, db.ErrNotFound)
+
+	found, err := store.SearchArchivedCoins(ctx, "dead", 10, 0)
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	assert.Equal(t, "DEAD", found[0].Symbol)
+	assert.True(t, found[0].Archived)
+
+	// A coin re-listed in the live table is no longer served from the archive
+	require.NoError(t, store.Coins().Create(ctx, &model.Coin{Address: "deadMint", Name: "Dead Dog", Symbol: "DEAD", Decimals: 6}))
+	found, err = store.SearchArchivedCoins(ctx, "dead", 10, 0)
+	require.NoError(t, err)
+	assert.Empty(t, found)
+}
+
+func TestPriceHistoryStorage(t *testing.T) {
+	t.Parallel()
+	store := dbtest.NewStore(t)
+	ctx := context.Background()
+	base := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	points := make([]model.PricePoint, 10)
+	for i := range points {
+		points[i] = model.PricePoint{Address: "mint", Resolution: time.Minute, Time: base.Add(time.Duration(i) * time.Minute), Value: float64(i)}
+	}
+	require.NoError(t, store.UpsertPricePoints(ctx, points))
+
+	// Adjacent ranges are merged into one
+	require.NoError(t, store.AddPriceHistoryRange(ctx, model.PriceHistoryRange{Address: "mint", Resolution: time.Minute, From: base, To: base.Add(5 * time.Minute)}))
+	require.NoError(t, store.AddPriceHistoryRange(ctx, model.PriceHistoryRange{Address: "mint", Resolution: time.Minute, From: base.Add(5 * time.Minute), To: base.Add(10 * time.Minute)}))
+	ranges, err := store.ListPriceHistoryRanges(ctx, "mint", time.Hour, base, base.Add(time.Hour))
+	require.NoError(t, err)
+	require.Len(t, ranges, 1)
+	assert.Equal(t, base.Add(10*time.Minute), ranges[0].To)
+
+	moved, err := store.DownsamplePricePoints(ctx, time.Minute, 5*time.Minute, base.Add(7*time.Minute))
+	require.NoError(t, err)
+	assert.EqualValues(t, 5, moved, "only whole 5m buckets are downsampled")
+
+	stored, err := store.ListPricePoints(ctx, "mint", 5*time.Minute, base, base.Add(time.Hour))
+	require.NoError(t, err)
+	require.Len(t, stored, 6)
+	assert.Equal(t, 5*time.Minute, stored[0].Resolution)
+	assert.Equal(t, 2.0, stored[0].Value, "average of the first five minutes")
+}
+
+func TestClaimTwapSlices_ReclaimsAfterWorkerDies(t *testing.T) {
+	t.Parallel()
+	store := dbtest.NewStore(t)
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	require.NoError(t, store.TwapOrders().Create(ctx, &model.TwapOrder{ID: "o1", WalletAddress: "wallet1", RawAmount: "300", Slices: 3, Status: model.TwapOrderActive}))
+	for i := range 3 {
+		require.NoError(t, store.TwapSlices().Create(ctx, &model.TwapSlice{
+			ID: fmt.Sprintf("o1:%d", i), OrderID: "o1", Index: i, RawAmount: "100",
+			Status: model.TwapSlicePending, DueAt: now.Add(time.Duration(i-1) * time.Hour),
+		}))
+	}
+
+	claimA, err := store.ClaimTwapSlices(ctx, db.TwapClaim{Worker: "a", Now: now, Lease: time.Minute})
+	require.NoError(t, err)
+	require.Len(t, claimA, 2, "the slice due in an hour isn't claimed")
+	assert.NotEmpty(t, claimA[0].QuoteID)
+
+	// Slices stay with worker A while its lease lasts
+	claimB, err := store.ClaimTwapSlices(ctx, db.TwapClaim{Worker: "b", Now: now.Add(30 * time.Second), Lease: time.Minute})
+	require.NoError(t, err)
+	assert.Empty(t, claimB)
+
+	// Worker A dies without saving; B takes over under the same quote IDs
+	claimB, err = store.ClaimTwapSlices(ctx, db.TwapClaim{Worker: "b", Now: now.Add(2 * time.Minute), Lease: time.Minute})
+	require.NoError(t, err)
+	require.Len(t, claimB, 2)
+	assert.Equal(t, claimA[0].QuoteID, claimB[0].QuoteID)
+	assert.Equal(t, 2, claimB[0].Attempts)
+
+	// A late save by worker A is refused
+	claimA[0].Status = model.TwapSlicePrepared
+	saved, err := store.SaveClaimedTwapSlice(ctx, &claimA[0])
+	require.NoError(t, err)
+	assert.False(t, saved)
+
+	claimB[0].Status = model.TwapSlicePrepared
+	saved, err = store.SaveClaimedTwapSlice(ctx, &claimB[0])
+	require.NoError(t, err)
+	assert.True(t, saved)
+	stored, err := store.TwapSlices().Get(ctx, claimB[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, model.TwapSlicePrepared, stored.Status)
+	assert.Empty(t, stored.ClaimedBy)
+}
+
+func TestClaimTwapSlices_ConcurrentWorkersClaimEachSliceOnce(t *testing.T) {
+	t.Parallel()
+	store := dbtest.NewStore(t)
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	require.NoError(t, store.TwapOrders().Create(ctx, &model.TwapOrder{ID: "o1", WalletAddress: "wallet1", RawAmount: "2000", Slices: 20, Status: model.TwapOrderActive}))
+	for i := range 20 {
+		require.NoError(t, store.TwapSlices().Create(ctx, &model.TwapSlice{
+			ID: fmt.Sprintf("o1:%d", i), OrderID: "o1", Index: i, RawAmount: "100",
+			Status: model.TwapSlicePending, DueAt: now.Add(-time.Minute),
+		}))
+	}
+
+	var (
+		mu      sync.Mutex
+		claimed = map[string]string{}
+		wg      sync.WaitGroup
+	)
+	for w := range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			worker := fmt.Sprintf("worker-%d", w)
+			for {
+				slices, err := store.ClaimTwapSlices(ctx, db.TwapClaim{Worker: worker, Now: now, Lease: time.Minute, Limit: 3})
+				if !assert.NoError(t, err) || len(slices) == 0 {
+					return
+				}
+				mu.Lock()
+				for _, s := range slices {
+					assert.NotContains(t, claimed, s.ID, "claimed by %s and %s", claimed[s.ID], worker)
+					claimed[s.ID] = worker
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Len(t, claimed, 20)
+}
+
+func TestAddTradeVolume_CountsConcurrentSwaps(t *testing.T) {
+	t.Parallel()
+	store := dbtest.NewStore(t)
+	ctx := context.Background()
+	day := time.Date(2026, 3, 4, 22, 30, 0, 0, time.UTC)
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, store.AddTradeVolume(ctx, "wallet1", day, 12.5))
+		}()
+	}
+	wg.Wait()
+	require.NoError(t, store.AddTradeVolume(ctx, "wallet1", day.Add(2*time.Hour), 1))
+
+	today, err := store.TradeVolumes().Get(ctx, "wallet1:2026-03-04")
+	require.NoError(t, err)
+	assert.Equal(t, 125.0, today.NotionalUSD)
+	assert.Equal(t, 10, today.Trades)
+	tomorrow, err := store.TradeVolumes().Get(ctx, "wallet1:2026-03-05")
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, tomorrow.NotionalUSD)
+}
@@ -25,6 +25,76 @@ func getTableName[S any]() string {
 		return "wallets"
 	case schema.NaughtyWord:
 		return "naughty_words"
+	case schema.WebhookSubscription:
+		return "webhook_subscriptions"
+	case schema.WebhookDeadLetter:
+		return "webhook_dead_letters"
+	case schema.JobCheckpoint:
+		return "job_checkpoints"
+	case schema.JobRun:
+		return "job_runs"
+	case schema.Setting:
+		return "settings"
+	case schema.FeatureFlag:
+		return "feature_flags"
+	case schema.SpamToken:
+		return "spam_tokens"
+	case schema.BlockedMint:
+		return "blocked_mints"
+	case schema.CoinDescription:
+		return "coin_descriptions"
+	case schema.PaymentRequest:
+		return "payment_requests"
+	case schema.BurnWatch:
+		return "burn_watches"
+	case schema.BurnEvent:
+		return "burn_events"
+	case schema.MintAuthority:
+		return "mint_authorities"
+	case schema.AuthorityChange:
+		return "authority_changes"
+	case schema.CoinRevision:
+		return "coin_revisions"
+	case schema.CoinOverride:
+		return "coin_overrides"
+	case schema.ImageHash:
+		return "image_hashes"
+	case schema.PlaceholderIcon:
+		return "placeholder_icons"
+	case schema.SearchSynonym:
+		return "search_synonyms"
+	case schema.CoinView:
+		return "coin_views"
+	case schema.PaperBalance:
+		return "paper_balances"
+	case schema.PaperTrade:
+		return "paper_trades"
+	case schema.FeeLedgerEntry:
+		return "fee_ledger_entries"
+	case schema.TwapOrder:
+		return "twap_orders"
+	case schema.TwapSlice:
+		return "twap_slices"
+	case schema.AuditEntry:
+		return "audit_log"
+	case schema.TradeLimit:
+		return "trade_limits"
+	case schema.TradeVolume:
+		return "trade_volumes"
+	case schema.NotificationPreferences:
+		return "notification_preferences"
+	case schema.NotificationDelivery:
+		return "notification_deliveries"
+	case schema.Announcement:
+		return "announcements"
+	case schema.AnnouncementRead:
+		return "announcement_reads"
+	case schema.MEVIncident:
+		return "mev_incidents"
+	case schema.DataDeletion:
+		return "data_deletions"
+	case schema.DeletionCertificate:
+		return "deletion_certificates"
 	default:
 		return "unknown"
 	}
@@ -0,0 +1,41 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db/postgres/schema"
+)
+
+// AddTradeVolume adds a swap worth notionalUSD to wallet's counter for the UTC
+// day of day. The counter is incremented in place, so concurrent swaps are
+// all counted.
+func (s *Store) AddTradeVolume(ctx context.Context, wallet string, day time.Time, notionalUSD float64) error {
+	day = day.UTC().Truncate(24 * time.Hour)
+	row := schema.TradeVolume{
+		ID:            fmt.Sprintf("%s:%s", wallet, day.Format(time.DateOnly)),
+		WalletAddress: wallet,
+		Day:           day,
+		NotionalUSD:   notionalUSD,
+		Trades:        1,
+		UpdatedAt:     time.Now(),
+	}
+	err := s.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "id"}},
+			DoUpdates: clause.Assignments(map[string]any{
+				"notional_usd": gorm.Expr("trade_volumes.notional_usd + EXCLUDED.notional_usd"),
+				"trades":       gorm.Expr("trade_volumes.trades + 1"),
+				"updated_at":   row.UpdatedAt,
+			}),
+		}).
+		Create(&row).Error
+	if err != nil {
+		return fmt.Errorf("failed to add trade volume for %s: %w", wallet, err)
+	}
+	return nil
+}
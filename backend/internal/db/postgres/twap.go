@@ -0,0 +1,92 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/db/postgres/schema"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+// ClaimTwapSlices leases the due slices that still have work to claim.Worker,
+// soonest due first. Rows are locked while they are claimed and rows another
+// worker is claiming are skipped, so concurrent workers never claim the same
+// slice. A pending slice is given its quote ID here, before it is prepared.
+func (s *Store) ClaimTwapSlices(ctx context.Context, claim db.TwapClaim) ([]model.TwapSlice, error) {
+	var rows []schema.TwapSlice
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		query := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status IN ? AND due_at <= ? AND lease_expires_at < ?",
+				[]string{model.TwapSlicePending, model.TwapSlicePrepared, model.TwapSliceSubmitted}, claim.Now, claim.Now).
+			Order("due_at ASC")
+		if claim.Limit > 0 {
+			query = query.Limit(claim.Limit)
+		}
+		if err := query.Find(&rows).Error; err != nil {
+			return fmt.Errorf("failed to select due TWAP slices: %w", err)
+		}
+		for i := range rows {
+			row := &rows[i]
+			row.ClaimedBy = claim.Worker
+			row.LeaseExpiresAt = claim.Now.Add(claim.Lease)
+			row.Attempts++
+			if row.QuoteID == "" && row.Status == model.TwapSlicePending {
+				row.QuoteID = uuid.NewString()
+			}
+			err := tx.Model(row).Updates(map[string]any{
+				"claimed_by":       row.ClaimedBy,
+				"lease_expires_at": row.LeaseExpiresAt,
+				"attempts":         row.Attempts,
+				"quote_id":         row.QuoteID,
+			}).Error
+			if err != nil {
+				return fmt.Errorf("failed to claim TWAP slice %s: %w", row.ID, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	repo := NewRepository[schema.TwapSlice, model.TwapSlice](s.db)
+	slices := make([]model.TwapSlice, len(rows))
+	for i, row := range rows {
+		slices[i] = *repo.toModel(row).(*model.TwapSlice)
+	}
+	return slices, nil
+}
+
+// SaveClaimedTwapSlice saves a claimed slice and releases its lease. It saves
+// nothing and returns false when slice.ClaimedBy no longer holds the lease:
+// it ran out and another worker claimed the slice, or the order was cancelled.
+func (s *Store) SaveClaimedTwapSlice(ctx context.Context, slice *model.TwapSlice) (bool, error) {
+	result := s.db.WithContext(ctx).Model(&schema.TwapSlice{}).
+		Where("id = ? AND claimed_by = ?", slice.ID, slice.ClaimedBy).
+		Updates(map[string]any{
+			"status":               slice.Status,
+			"quote_id":             slice.QuoteID,
+			"unsigned_transaction": slice.UnsignedTransaction,
+			"quote_expires_at":     slice.QuoteExpiresAt,
+			"transaction_hash":     slice.TransactionHash,
+			"output_amount":        slice.OutputAmount,
+			"error":                slice.Error,
+			"updated_at":           slice.UpdatedAt,
+			"claimed_by":           "",
+			"lease_expires_at":     time.Time{},
+		})
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to save TWAP slice %s: %w", slice.ID, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return false, nil
+	}
+	slice.ClaimedBy, slice.LeaseExpiresAt = "", time.Time{}
+	return true, nil
+}
@@ -0,0 +1,238 @@
+//go:build e2e
+
+package e2e
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	solanago "github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"github.com/gagliardetto/solana-go/programs/token"
+	"github.com/gagliardetto/solana-go/rpc"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/jupiter"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	"github.com/nicolas-martin/dankfolio/backend/internal/util/ata"
+	"github.com/nicolas-martin/dankfolio/backend/internal/util/money"
+)
+
+// MockJupiter serves Jupiter's quote and swap endpoints for SOL to Token swaps
+// at a fixed rate. Swap transactions are real: the user pays SOL into a pool
+// account and Token is minted to their associated token account, so ATA
+// creation, platform fee accounts and confirmations all run on the validator.
+type MockJupiter struct {
+	URL string
+
+	// Token is the mint bought with SOL; the mock holds its mint authority
+	Token solanago.PublicKey
+	// Pool receives the SOL paid for each swap
+	Pool solanago.PublicKey
+
+	validator     *Validator
+	mintAuthority solanago.PrivateKey
+	tokensPerSOL  int64
+}
+
+// NewMockJupiter creates Token with the given decimals and serves quotes
+// paying tokensPerSOL base units of it per SOL
+func NewMockJupiter(t testing.TB, v *Validator, decimals uint8, tokensPerSOL int64) *MockJupiter {
+	t.Helper()
+
+	authority := solanago.NewWallet().PrivateKey
+	v.Fund(t, authority.PublicKey(), solanago.LAMPORTS_PER_SOL)
+
+	m := &MockJupiter{
+		Pool:          solanago.NewWallet().PublicKey(),
+		validator:     v,
+		mintAuthority: authority,
+		tokensPerSOL:  tokensPerSOL,
+	}
+	m.Token = v.CreateMint(t, authority, authority.PublicKey(), decimals)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /swap/v1/quote", m.handleQuote)
+	mux.HandleFunc("POST /swap/v1/swap", m.handleSwap)
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	m.URL = server.URL
+
+	return m
+}
+
+// OutAmount returns the Token base units a swap of lamports pays before fees
+func (m *MockJupiter) OutAmount(lamports uint64) uint64 {
+	out, _ := money.ToBaseUnits(money.FromBaseUnits(lamports, money.SOLDecimals).Mul(money.FromInt(m.tokensPerSOL)), 0)
+	return out
+}
+
+func (m *MockJupiter) handleQuote(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	if q.Get("inputMint") != model.SolMint || q.Get("outputMint") != m.Token.String() {
+		http.Error(w, "mock only quotes SOL to its token", http.StatusBadRequest)
+		return
+	}
+	in, err := strconv.ParseUint(q.Get("amount"), 10, 64)
+	if err != nil {
+		http.Error(w, "invalid amount", http.StatusBadRequest)
+		return
+	}
+	slippageBps, _ := strconv.Atoi(q.Get("slippageBps"))
+	platformFeeBps, _ := strconv.Atoi(q.Get("platformFeeBps"))
+
+	// Like Jupiter, an ExactIn platform fee comes out of the output amount
+	out := m.OutAmount(in)
+	fee := out - money.MinAmountOut(out, platformFeeBps)
+	quote := jupiter.QuoteResponse{
+		InputMint:            model.SolMint,
+		OutputMint:           m.Token.String(),
+		InAmount:             strconv.FormatUint(in, 10),
+		OutAmount:            strconv.FormatUint(out-fee, 10),
+		OtherAmountThreshold: strconv.FormatUint(money.MinAmountOut(out-fee, slippageBps), 10),
+		SwapMode:             "ExactIn",
+		SlippageBps:          slippageBps,
+		PriceImpactPct:       "0",
+		RoutePlan: []jupiter.RoutePlan{
+			{SwapInfo: jupiter.SwapInfo{Label: "Mock AMM", FeeMint: model.SolMint, FeeAmount: "0"}},
+		},
+	}
+	if platformFeeBps > 0 {
+		quote.PlatformFee = &jupiter.PlatformFee{
+			Amount:  strconv.FormatUint(fee, 10),
+			FeeBps:  platformFeeBps,
+			FeeMint: m.Token.String(),
+		}
+	}
+	writeJSON(w, quote)
+}
+
+type swapRequest struct {
+	QuoteResponse jupiter.QuoteResponse `json:"quoteResponse"`
+	UserPublicKey string                `json:"userPublicKey"`
+	FeeAccount    string                `json:"feeAccount"`
+}
+
+func (m *MockJupiter) handleSwap(w http.ResponseWriter, r *http.Request) {
+	var req swapRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	tx, err := m.buildSwap(r.Context(), req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	encoded, err := tx.ToBase64()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writeJSON(w, jupiter.SwapResponse{SwapTransaction: encoded})
+}
+
+// buildSwap builds the swap transaction for the user to sign, already signed
+// by the mint authority
+func (m *MockJupiter) buildSwap(ctx context.Context, req swapRequest) (*solanago.Transaction, error) {
+	user, err := solanago.PublicKeyFromBase58(req.UserPublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid userPublicKey: %w", err)
+	}
+	in, err := strconv.ParseUint(req.QuoteResponse.InAmount, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid inAmount: %w", err)
+	}
+	out, err := strconv.ParseUint(req.QuoteResponse.OutAmount, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid outAmount: %w", err)
+	}
+
+	userATA, err := ata.Derive(user, m.Token)
+	if err != nil {
+		return nil, err
+	}
+	instructions := []solanago.Instruction{
+		system.NewTransferInstruction(in, user, m.Pool).Build(),
+	}
+	if _, err := m.validator.tokenAccount(ctx, userATA.Address); err != nil {
+		instructions = append(instructions, ata.CreateInstruction(user, userATA))
+	}
+	instructions = append(instructions,
+		token.NewMintToInstruction(out, m.Token, userATA.Address, m.mintAuthority.PublicKey(), nil).Build())
+
+	if req.FeeAccount != "" && req.QuoteResponse.PlatformFee != nil {
+		feeInstructions, err := m.platformFee(ctx, req, user, in)
+		if err != nil {
+			return nil, err
+		}
+		instructions = append(instructions, feeInstructions...)
+	}
+
+	blockhash, err := m.validator.RPC.GetLatestBlockhash(ctx, rpc.CommitmentConfirmed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get blockhash: %w", err)
+	}
+	tx, err := solanago.NewTransaction(instructions, blockhash.Value.Blockhash, solanago.TransactionPayer(user))
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tx.PartialSign(func(key solanago.PublicKey) *solanago.PrivateKey {
+		if key.Equals(m.mintAuthority.PublicKey()) {
+			return &m.mintAuthority
+		}
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to sign as mint authority: %w", err)
+	}
+	return tx, nil
+}
+
+// platformFee pays the fee into feeAccount in whichever mint it holds: Token
+// is minted directly, wSOL is charged from the input like Jupiter does when
+// the fee account is for the input mint.
+func (m *MockJupiter) platformFee(ctx context.Context, req swapRequest, user solanago.PublicKey, in uint64) ([]solanago.Instruction, error) {
+	feeAccount, err := solanago.PublicKeyFromBase58(req.FeeAccount)
+	if err != nil {
+		return nil, fmt.Errorf("invalid feeAccount: %w", err)
+	}
+
+	// The trade service creates the fee account just before asking for the swap and doesn't wait for it to land
+	var account *token.Account
+	deadline := time.Now().Add(15 * time.Second)
+	for account, err = m.validator.tokenAccount(ctx, feeAccount); err != nil; account, err = m.validator.tokenAccount(ctx, feeAccount) {
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("fee account %s not found: %w", feeAccount, err)
+		}
+		time.Sleep(200 * time.Millisecond)
+	}
+
+	switch account.Mint.String() {
+	case m.Token.String():
+		fee, err := strconv.ParseUint(req.QuoteResponse.PlatformFee.Amount, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid platform fee amount: %w", err)
+		}
+		return []solanago.Instruction{
+			token.NewMintToInstruction(fee, m.Token, feeAccount, m.mintAuthority.PublicKey(), nil).Build(),
+		}, nil
+	case model.SolMint:
+		fee := in - money.MinAmountOut(in, req.QuoteResponse.PlatformFee.FeeBps)
+		return []solanago.Instruction{
+			system.NewTransferInstruction(fee, user, feeAccount).Build(),
+			token.NewSyncNativeInstruction(feeAccount).Build(),
+		}, nil
+	default:
+		return nil, fmt.Errorf("fee account %s holds unsupported mint %s", feeAccount, account.Mint)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
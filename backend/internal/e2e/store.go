@@ -0,0 +1,94 @@
+//go:build e2e
+
+package e2e
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	dbmocks "github.com/nicolas-martin/dankfolio/backend/internal/db/mocks"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+// TradeStore keeps trade records in memory so e2e tests don't need Postgres.
+// Only the trades repository is backed; other repositories are unset.
+type TradeStore struct {
+	*dbmocks.MockStore
+
+	mu     sync.Mutex
+	trades map[uint]model.Trade
+	nextID uint
+}
+
+// NewTradeStore returns an empty in-memory store
+func NewTradeStore(t testing.TB) *TradeStore {
+	s := &TradeStore{
+		MockStore: dbmocks.NewMockStore(t),
+		trades:    make(map[uint]model.Trade),
+	}
+
+	repo := dbmocks.NewMockRepository[model.Trade](t)
+	repo.EXPECT().Create(mock.Anything, mock.Anything).RunAndReturn(s.create).Maybe()
+	repo.EXPECT().Update(mock.Anything, mock.Anything).RunAndReturn(s.update).Maybe()
+	repo.EXPECT().GetByField(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(s.getByField).Maybe()
+	s.MockStore.EXPECT().Trades().Return(repo).Maybe()
+
+	return s
+}
+
+// All returns every stored trade
+func (s *TradeStore) All() []model.Trade {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	trades := make([]model.Trade, 0, len(s.trades))
+	for _, trade := range s.trades {
+		trades = append(trades, trade)
+	}
+	return trades
+}
+
+func (s *TradeStore) create(_ context.Context, trade *model.Trade) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nextID++
+	trade.ID = s.nextID
+	s.trades[trade.ID] = *trade
+	return nil
+}
+
+func (s *TradeStore) update(_ context.Context, trade *model.Trade) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.trades[trade.ID]; !ok {
+		return fmt.Errorf("%w: trade %d", db.ErrNotFound, trade.ID)
+	}
+	s.trades[trade.ID] = *trade
+	return nil
+}
+
+func (s *TradeStore) getByField(_ context.Context, field string, value any) (*model.Trade, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, trade := range s.trades {
+		var got string
+		switch field {
+		case "unsigned_transaction":
+			got = trade.UnsignedTransaction
+		case "transaction_hash":
+			got = trade.TransactionHash
+		case "quote_id":
+			got = trade.QuoteID
+		default:
+			return nil, fmt.Errorf("lookup by %s not supported", field)
+		}
+		if got == value {
+			return &trade, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: item with %s = %v not found", db.ErrNotFound, field, value)
+}
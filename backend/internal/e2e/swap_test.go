@@ -0,0 +1,172 @@
+//go:build e2e
+
+package e2e
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	solanago "github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/jupiter"
+	solanaclient "github.com/nicolas-martin/dankfolio/backend/internal/clients/solana"
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/tracker"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	coinmocks "github.com/nicolas-martin/dankfolio/backend/internal/service/coin/mocks"
+	pricemocks "github.com/nicolas-martin/dankfolio/backend/internal/service/price/mocks"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/trade"
+	"github.com/nicolas-martin/dankfolio/backend/internal/signer"
+	"github.com/nicolas-martin/dankfolio/backend/internal/telemetry/otel"
+)
+
+const (
+	tokenDecimals  = 6
+	tokensPerSOL   = 1_000 * 1_000_000 // 1000 tokens per SOL
+	platformFeeBps = 10
+)
+
+type harness struct {
+	validator *Validator
+	jupiter   *MockJupiter
+	store     *TradeStore
+	service   *trade.Service
+	platform  solanago.PrivateKey
+}
+
+func newHarness(t *testing.T) *harness {
+	v := StartValidator(t, OptionsFromEnv())
+	jup := NewMockJupiter(t, v, tokenDecimals, tokensPerSOL)
+
+	platform := solanago.NewWallet().PrivateKey
+	v.Fund(t, platform.PublicKey(), solanago.LAMPORTS_PER_SOL)
+
+	apiTracker, err := tracker.NewAPITracker(otel.NewNoOpTelemetry("dankfolio-e2e"))
+	require.NoError(t, err)
+	chainClient := solanaclient.NewClient(v.RPC, apiTracker)
+
+	coins := coinmocks.NewMockCoinServiceAPI(t)
+	coins.EXPECT().GetCoinByAddress(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, address string) (*model.Coin, error) {
+		if address == jup.Token.String() {
+			return &model.Coin{Address: address, Symbol: "MOCK", Decimals: tokenDecimals, Price: 0.15}, nil
+		}
+		return &model.Coin{Address: address, Symbol: "SOL", Decimals: 9, Price: 150}, nil
+	}).Maybe()
+	prices := pricemocks.NewMockPriceServiceAPI(t)
+	prices.EXPECT().GetCoinPrices(mock.Anything, mock.Anything).Return(map[string]float64{model.SolMint: 150}, nil).Maybe()
+
+	store := NewTradeStore(t)
+	service := trade.NewService(
+		chainClient,
+		coins,
+		prices,
+		jupiter.NewClient(http.DefaultClient, jup.URL, ""),
+		store,
+		platformFeeBps,
+		platform.PublicKey().String(),
+		signer.NewLocalSigner(platform),
+		nil,
+		false,
+	)
+
+	return &harness{validator: v, jupiter: jup, store: store, service: service, platform: platform}
+}
+
+// sign adds the user's signature to a prepared transaction, as the app does
+func sign(t *testing.T, unsigned string, user solanago.PrivateKey) string {
+	t.Helper()
+	tx, err := solanago.TransactionFromBase64(unsigned)
+	require.NoError(t, err)
+	_, err = tx.PartialSign(func(key solanago.PublicKey) *solanago.PrivateKey {
+		if key.Equals(user.PublicKey()) {
+			return &user
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	signed, err := tx.ToBase64()
+	require.NoError(t, err)
+	return signed
+}
+
+func TestSwap_SOLToTokenSettlesWithPlatformFee(t *testing.T) {
+	h := newHarness(t)
+	ctx := context.Background()
+
+	user := solanago.NewWallet().PrivateKey
+	h.validator.Fund(t, user.PublicKey(), 2*solanago.LAMPORTS_PER_SOL)
+
+	const lamportsIn = solanago.LAMPORTS_PER_SOL / 10
+	prepared, err := h.service.PrepareSwap(ctx, model.PrepareSwapRequestData{
+		FromCoinMintAddress: model.NativeSolMint,
+		ToCoinMintAddress:   h.jupiter.Token.String(),
+		Amount:              strconv.FormatUint(lamportsIn, 10),
+		SlippageBps:         "50",
+		UserWalletAddress:   user.PublicKey().String(),
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, prepared.QuoteID)
+
+	executed, err := h.service.ExecuteTrade(ctx, model.TradeRequest{
+		FromCoinMintAddress: model.NativeSolMint,
+		ToCoinMintAddress:   h.jupiter.Token.String(),
+		Amount:              0.1,
+		SignedTransaction:   sign(t, prepared.UnsignedTransaction, user),
+		UnsignedTransaction: prepared.UnsignedTransaction,
+		QuoteID:             prepared.QuoteID,
+	})
+	require.NoError(t, err)
+	h.validator.WaitForConfirmation(t, solanago.MustSignatureFromBase58(executed.TransactionHash))
+
+	// The service learns the outcome by polling the chain, as the app's status screen does
+	require.Eventually(t, func() bool {
+		got, err := h.service.GetTradeByTransactionHash(ctx, executed.TransactionHash)
+		return err == nil && got.Status == model.TradeStatusFinalized.String()
+	}, 90*time.Second, time.Second, "trade was not finalized")
+
+	// The output ATA was created by the swap and received the quote net of the platform fee
+	out := h.jupiter.OutAmount(lamportsIn)
+	fee := out * platformFeeBps / 10_000
+	assert.Equal(t, out-fee, h.validator.TokenBalance(t, user.PublicKey(), h.jupiter.Token))
+	assert.Equal(t, lamportsIn, h.validator.Balance(t, h.jupiter.Pool))
+
+	// The trade service created the platform's wSOL fee account, and the fee landed in it
+	wsol := solanago.MustPublicKeyFromBase58(model.SolMint)
+	assert.Equal(t, uint64(lamportsIn*platformFeeBps/10_000), h.validator.TokenBalance(t, h.platform.PublicKey(), wsol))
+
+	trades := h.store.All()
+	require.Len(t, trades, 1)
+	assert.Equal(t, strconv.FormatUint(out-fee, 10), trades[0].RawOutputAmount)
+}
+
+func TestSwap_ExpiredQuoteIsRejected(t *testing.T) {
+	h := newHarness(t)
+	ctx := context.Background()
+	h.service.SetQuoteTTL(time.Millisecond)
+
+	user := solanago.NewWallet().PrivateKey
+	h.validator.Fund(t, user.PublicKey(), solanago.LAMPORTS_PER_SOL)
+
+	prepared, err := h.service.PrepareSwap(ctx, model.PrepareSwapRequestData{
+		FromCoinMintAddress: model.NativeSolMint,
+		ToCoinMintAddress:   h.jupiter.Token.String(),
+		Amount:              "1000000",
+		SlippageBps:         "50",
+		UserWalletAddress:   user.PublicKey().String(),
+	})
+	require.NoError(t, err)
+	time.Sleep(5 * time.Millisecond)
+
+	_, err = h.service.ExecuteTrade(ctx, model.TradeRequest{
+		SignedTransaction:   sign(t, prepared.UnsignedTransaction, user),
+		UnsignedTransaction: prepared.UnsignedTransaction,
+		QuoteID:             prepared.QuoteID,
+	})
+	require.ErrorIs(t, err, trade.ErrQuoteExpired)
+	assert.Zero(t, h.validator.TokenBalance(t, user.PublicKey(), h.jupiter.Token))
+}
@@ -0,0 +1,234 @@
+//go:build e2e
+
+// Package e2e drives the trade service end to end against a local
+// solana-test-validator and a mock Jupiter API, replacing manual swap runs
+// against mainnet with real keys. Tests only build with the e2e tag:
+//
+//	go test -tags e2e ./internal/e2e/...
+//
+// They skip when solana-test-validator is not on PATH. Validators bind fixed
+// local ports per test, so the tests in this package do not run in parallel.
+package e2e
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	bin "github.com/gagliardetto/binary"
+	solanago "github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"github.com/gagliardetto/solana-go/programs/token"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+// ValidatorOptions configures the accounts a validator starts with. Cloning
+// lets a test run against real Jupiter or Raydium programs and pools.
+type ValidatorOptions struct {
+	// CloneFrom is the RPC URL of the cluster accounts are cloned from
+	CloneFrom string
+	// CloneAccounts are account addresses copied at startup, e.g. pools and mints
+	CloneAccounts []string
+	// ClonePrograms are upgradeable program IDs copied at startup
+	ClonePrograms []string
+}
+
+// OptionsFromEnv reads clone settings from E2E_CLONE_URL, E2E_CLONE_ACCOUNTS
+// and E2E_CLONE_PROGRAMS, the latter two comma-separated. Without them the
+// validator starts with only the built-in programs.
+func OptionsFromEnv() ValidatorOptions {
+	return ValidatorOptions{
+		CloneFrom:     os.Getenv("E2E_CLONE_URL"),
+		CloneAccounts: splitList(os.Getenv("E2E_CLONE_ACCOUNTS")),
+		ClonePrograms: splitList(os.Getenv("E2E_CLONE_PROGRAMS")),
+	}
+}
+
+// Validator is a running solana-test-validator
+type Validator struct {
+	RPCURL string
+	RPC    *rpc.Client
+}
+
+// StartValidator starts a fresh validator with its ledger in a temp dir and
+// stops it when the test ends. The test is skipped if the binary is missing.
+func StartValidator(t testing.TB, opts ValidatorOptions) *Validator {
+	t.Helper()
+
+	binary, err := exec.LookPath("solana-test-validator")
+	if err != nil {
+		t.Skip("solana-test-validator not on PATH; install the Solana CLI to run e2e tests")
+	}
+
+	rpcPort := freePort(t)
+	args := []string{
+		"--ledger", t.TempDir(),
+		"--reset",
+		"--quiet",
+		"--bind-address", "127.0.0.1",
+		"--rpc-port", strconv.Itoa(rpcPort),
+		"--faucet-port", strconv.Itoa(freePort(t)),
+	}
+	if len(opts.CloneAccounts) > 0 || len(opts.ClonePrograms) > 0 {
+		require.NotEmpty(t, opts.CloneFrom, "cloning accounts needs CloneFrom")
+		args = append(args, "--url", opts.CloneFrom)
+		for _, account := range opts.CloneAccounts {
+			args = append(args, "--clone", account)
+		}
+		for _, program := range opts.ClonePrograms {
+			args = append(args, "--clone-upgradeable-program", program)
+		}
+	}
+
+	var output bytes.Buffer
+	cmd := exec.Command(binary, args...)
+	cmd.Stdout = &output
+	cmd.Stderr = &output
+	require.NoError(t, cmd.Start(), "failed to start solana-test-validator")
+	t.Cleanup(func() {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+		if t.Failed() {
+			t.Logf("solana-test-validator output:\n%s", output.String())
+		}
+	})
+
+	v := &Validator{RPCURL: fmt.Sprintf("http://127.0.0.1:%d", rpcPort)}
+	v.RPC = rpc.New(v.RPCURL)
+	require.Eventually(t, func() bool {
+		health, err := v.RPC.GetHealth(context.Background())
+		return err == nil && health == rpc.HealthOk
+	}, time.Minute, 250*time.Millisecond, "solana-test-validator did not become healthy")
+
+	return v
+}
+
+// Fund airdrops lamports to account and waits for it to land
+func (v *Validator) Fund(t testing.TB, account solanago.PublicKey, lamports uint64) {
+	t.Helper()
+	sig, err := v.RPC.RequestAirdrop(context.Background(), account, lamports, rpc.CommitmentConfirmed)
+	require.NoError(t, err)
+	v.WaitForConfirmation(t, sig)
+}
+
+// Send signs instructions with payer and any extra signers, submits them and
+// waits for confirmation
+func (v *Validator) Send(t testing.TB, payer solanago.PrivateKey, instructions []solanago.Instruction, signers ...solanago.PrivateKey) solanago.Signature {
+	t.Helper()
+	ctx := context.Background()
+
+	blockhash, err := v.RPC.GetLatestBlockhash(ctx, rpc.CommitmentConfirmed)
+	require.NoError(t, err)
+	tx, err := solanago.NewTransaction(instructions, blockhash.Value.Blockhash, solanago.TransactionPayer(payer.PublicKey()))
+	require.NoError(t, err)
+
+	keys := append([]solanago.PrivateKey{payer}, signers...)
+	_, err = tx.Sign(func(key solanago.PublicKey) *solanago.PrivateKey {
+		for i := range keys {
+			if keys[i].PublicKey().Equals(key) {
+				return &keys[i]
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	sig, err := v.RPC.SendTransaction(ctx, tx)
+	require.NoError(t, err)
+	v.WaitForConfirmation(t, sig)
+	return sig
+}
+
+// WaitForConfirmation waits until sig is confirmed and fails the test if the
+// transaction errored on-chain
+func (v *Validator) WaitForConfirmation(t testing.TB, sig solanago.Signature) {
+	t.Helper()
+	var txErr any
+	require.Eventually(t, func() bool {
+		statuses, err := v.RPC.GetSignatureStatuses(context.Background(), false, sig)
+		if err != nil || len(statuses.Value) == 0 || statuses.Value[0] == nil {
+			return false
+		}
+		status := statuses.Value[0]
+		txErr = status.Err
+		return status.ConfirmationStatus == rpc.ConfirmationStatusConfirmed ||
+			status.ConfirmationStatus == rpc.ConfirmationStatusFinalized
+	}, 30*time.Second, 200*time.Millisecond, "transaction %s was not confirmed", sig)
+	require.Nil(t, txErr, "transaction %s failed on-chain", sig)
+}
+
+// CreateMint creates an SPL token mint controlled by authority
+func (v *Validator) CreateMint(t testing.TB, payer solanago.PrivateKey, authority solanago.PublicKey, decimals uint8) solanago.PublicKey {
+	t.Helper()
+
+	rent, err := v.RPC.GetMinimumBalanceForRentExemption(context.Background(), token.MINT_SIZE, rpc.CommitmentConfirmed)
+	require.NoError(t, err)
+
+	mint := solanago.NewWallet().PrivateKey
+	v.Send(t, payer, []solanago.Instruction{
+		system.NewCreateAccountInstruction(rent, token.MINT_SIZE, solanago.TokenProgramID, payer.PublicKey(), mint.PublicKey()).Build(),
+		token.NewInitializeMint2Instruction(decimals, authority, authority, mint.PublicKey()).Build(),
+	}, mint)
+	return mint.PublicKey()
+}
+
+// Balance returns the lamports held by account
+func (v *Validator) Balance(t testing.TB, account solanago.PublicKey) uint64 {
+	t.Helper()
+	balance, err := v.RPC.GetBalance(context.Background(), account, rpc.CommitmentConfirmed)
+	require.NoError(t, err)
+	return balance.Value
+}
+
+// TokenBalance returns owner's balance of mint in base units, or zero when the
+// owner has no associated token account for it
+func (v *Validator) TokenBalance(t testing.TB, owner, mint solanago.PublicKey) uint64 {
+	t.Helper()
+	ata, _, err := solanago.FindAssociatedTokenAddress(owner, mint)
+	require.NoError(t, err)
+
+	account, err := v.tokenAccount(context.Background(), ata)
+	if err != nil {
+		return 0
+	}
+	return account.Amount
+}
+
+// tokenAccount fetches and decodes an SPL token account
+func (v *Validator) tokenAccount(ctx context.Context, address solanago.PublicKey) (*token.Account, error) {
+	info, err := v.RPC.GetAccountInfoWithOpts(ctx, address, &rpc.GetAccountInfoOpts{Commitment: rpc.CommitmentConfirmed})
+	if err != nil {
+		return nil, err
+	}
+	var account token.Account
+	if err := bin.NewBinDecoder(info.GetBinary()).Decode(&account); err != nil {
+		return nil, fmt.Errorf("failed to decode token account %s: %w", address, err)
+	}
+	return &account, nil
+}
+
+func freePort(t testing.TB) int {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	return listener.Addr().(*net.TCPAddr).Port
+}
+
+func splitList(s string) []string {
+	var items []string
+	for item := range strings.SplitSeq(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,133 @@
+package events
+
+import (
+	"context"
+	"time"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+// Type identifies the kind of domain event being published.
+type Type string
+
+const (
+	// CoinDiscovered is published when a coin is stored for the first time.
+	CoinDiscovered Type = "coin.discovered"
+	// CoinEnriched is published after a coin's metadata has been enriched.
+	CoinEnriched Type = "coin.enriched"
+	// CoinTrending is published for each coin tagged as trending during a refresh.
+	CoinTrending Type = "coin.trending"
+	// TrendingRefreshed is published once a trending refresh has been committed.
+	TrendingRefreshed Type = "coin.trending_refreshed"
+	// TradeExecuted is published once a trade has been submitted to the chain.
+	TradeExecuted Type = "trade.executed"
+	// TradeConfirmed is published when a submitted trade is observed as finalized on-chain.
+	TradeConfirmed Type = "trade.confirmed"
+	// CoinBurned is published when the burn checker sees a coin's supply or LP tokens burned.
+	CoinBurned Type = "coin.burned"
+	// CoinAuthorityChanged is published when a coin's mint or freeze authority is revoked, set or transferred.
+	CoinAuthorityChanged Type = "coin.authority_changed"
+	// WalletActivity is published when a watched wallet's transaction is seen on-chain.
+	WalletActivity Type = "wallet.activity"
+	// PriceAlert is published by the alerting subsystem when a coin crosses a watched threshold.
+	PriceAlert Type = "price.alert"
+	// NaughtyWordsChanged is published when the naughty word list is edited, so every instance reloads it.
+	NaughtyWordsChanged Type = "moderation.naughty_words_changed"
+)
+
+// Event is a single domain event. Payload holds one of the typed payloads below.
+type Event struct {
+	Type       Type
+	OccurredAt time.Time
+	Payload    any
+}
+
+// CoinPayload is carried by the coin lifecycle events.
+type CoinPayload struct {
+	Coin model.Coin
+}
+
+// TrendingPayload is carried by TrendingRefreshed.
+type TrendingPayload struct {
+	Addresses []string // Trending coins in rank order
+}
+
+// TradePayload is carried by TradeExecuted and TradeConfirmed.
+type TradePayload struct {
+	Trade model.Trade
+}
+
+// BurnPayload is carried by CoinBurned.
+type BurnPayload struct {
+	Burn model.BurnEvent
+}
+
+// AuthorityChangePayload is carried by CoinAuthorityChanged.
+type AuthorityChangePayload struct {
+	Change  model.AuthorityChange
+	Holders []string // Our users' wallets that hold the coin, for per-wallet alerts
+}
+
+// WalletActivityPayload is carried by WalletActivity.
+type WalletActivityPayload struct {
+	Wallet    string
+	Signature string
+}
+
+// PriceAlertPayload is carried by PriceAlert.
+type PriceAlertPayload struct {
+	CoinAddress    string  `json:"coin_address"`
+	Price          float64 `json:"price"`
+	ThresholdPrice float64 `json:"threshold_price"`
+	Direction      string  `json:"direction"` // "above" or "below"
+}
+
+// Handler processes an event delivered to a subscriber.
+type Handler func(ctx context.Context, event Event)
+
+// Bus publishes domain events to interested subscribers.
+// The in-process implementation lives in this package; other backends
+// (NATS, Redis streams) only need to satisfy this interface.
+type Bus interface {
+	// Publish hands the event to every subscriber of its type. It must not block on slow subscribers.
+	Publish(ctx context.Context, event Event)
+	// Subscribe registers a handler for the given event types and returns a function that removes it.
+	Subscribe(handler Handler, types ...Type) (unsubscribe func())
+	// Close stops delivery and waits for in-flight handlers to finish.
+	Close()
+}
+
+// NewCoinEvent builds a coin lifecycle event stamped with the current time.
+func NewCoinEvent(eventType Type, coin model.Coin) Event {
+	return Event{Type: eventType, OccurredAt: time.Now(), Payload: CoinPayload{Coin: coin}}
+}
+
+// NewTrendingRefreshedEvent builds a TrendingRefreshed event stamped with the current time.
+func NewTrendingRefreshedEvent(addresses []string) Event {
+	return Event{Type: TrendingRefreshed, OccurredAt: time.Now(), Payload: TrendingPayload{Addresses: addresses}}
+}
+
+// NewBurnEvent builds a CoinBurned event stamped with the current time.
+func NewBurnEvent(burn model.BurnEvent) Event {
+	return Event{Type: CoinBurned, OccurredAt: time.Now(), Payload: BurnPayload{Burn: burn}}
+}
+
+// NewAuthorityChangeEvent builds a CoinAuthorityChanged event stamped with the current time.
+func NewAuthorityChangeEvent(change model.AuthorityChange, holders []string) Event {
+	return Event{Type: CoinAuthorityChanged, OccurredAt: time.Now(), Payload: AuthorityChangePayload{Change: change, Holders: holders}}
+}
+
+// NewWalletActivityEvent builds a WalletActivity event stamped with the current time.
+func NewWalletActivityEvent(wallet, signature string) Event {
+	return Event{Type: WalletActivity, OccurredAt: time.Now(), Payload: WalletActivityPayload{Wallet: wallet, Signature: signature}}
+}
+
+// NewNaughtyWordsChangedEvent builds a NaughtyWordsChanged event stamped with the current time.
+func NewNaughtyWordsChangedEvent() Event {
+	return Event{Type: NaughtyWordsChanged, OccurredAt: time.Now()}
+}
+
+// NewTradeEvent builds a trade event (TradeExecuted or TradeConfirmed) stamped with the current time.
+func NewTradeEvent(eventType Type, trade model.Trade) Event {
+	return Event{Type: eventType, OccurredAt: time.Now(), Payload: TradePayload{Trade: trade}}
+}
@@ -0,0 +1,133 @@
+package events
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"sync"
+)
+
+const defaultSubscriberBuffer = 256
+
+// MemoryBus is an in-process Bus. Each subscriber gets its own buffered queue
+// and goroutine so a slow consumer never blocks publishers or other subscribers.
+// Events are dropped (and logged) when a subscriber's queue is full.
+type MemoryBus struct {
+	mu          sync.RWMutex
+	subscribers map[uint64]*subscriber
+	nextID      uint64
+	bufferSize  int
+	closed      bool
+	wg          sync.WaitGroup
+}
+
+type subscriber struct {
+	types   []Type
+	handler Handler
+	queue   chan queuedEvent
+}
+
+type queuedEvent struct {
+	ctx   context.Context
+	event Event
+}
+
+// NewMemoryBus creates an in-process event bus. A bufferSize <= 0 uses the default.
+func NewMemoryBus(bufferSize int) *MemoryBus {
+	if bufferSize <= 0 {
+		bufferSize = defaultSubscriberBuffer
+	}
+	return &MemoryBus{
+		subscribers: make(map[uint64]*subscriber),
+		bufferSize:  bufferSize,
+	}
+}
+
+// Publish implements Bus.
+func (b *MemoryBus) Publish(ctx context.Context, event Event) {
+	// Handlers run after the publishing request has returned, so keep the values but drop the cancellation.
+	deliveryCtx := context.WithoutCancel(ctx)
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if b.closed {
+		return
+	}
+	for _, sub := range b.subscribers {
+		if len(sub.types) > 0 && !slices.Contains(sub.types, event.Type) {
+			continue
+		}
+		select {
+		case sub.queue <- queuedEvent{ctx: deliveryCtx, event: event}:
+		default:
+			slog.WarnContext(ctx, "Event subscriber queue full, dropping event", slog.String("type", string(event.Type)))
+		}
+	}
+}
+
+// Subscribe implements Bus. Passing no types subscribes to every event.
+func (b *MemoryBus) Subscribe(handler Handler, types ...Type) func() {
+	sub := &subscriber{
+		types:   types,
+		handler: handler,
+		queue:   make(chan queuedEvent, b.bufferSize),
+	}
+
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		return func() {}
+	}
+	id := b.nextID
+	b.nextID++
+	b.subscribers[id] = sub
+	b.wg.Add(1)
+	b.mu.Unlock()
+
+	go b.run(sub)
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+			if _, ok := b.subscribers[id]; ok {
+				delete(b.subscribers, id)
+				close(sub.queue)
+			}
+		})
+	}
+}
+
+// Close implements Bus. Events already queued are still delivered.
+func (b *MemoryBus) Close() {
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		return
+	}
+	b.closed = true
+	for id, sub := range b.subscribers {
+		delete(b.subscribers, id)
+		close(sub.queue)
+	}
+	b.mu.Unlock()
+
+	b.wg.Wait()
+}
+
+func (b *MemoryBus) run(sub *subscriber) {
+	defer b.wg.Done()
+	for qe := range sub.queue {
+		b.deliver(sub, qe)
+	}
+}
+
+func (b *MemoryBus) deliver(sub *subscriber, qe queuedEvent) {
+	defer func() {
+		if r := recover(); r != nil {
+			slog.ErrorContext(qe.ctx, "Event handler panicked", slog.String("type", string(qe.event.Type)), slog.Any("panic", r))
+		}
+	}()
+	sub.handler(qe.ctx, qe.event)
+}
@@ -0,0 +1,82 @@
+package events
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+func TestMemoryBus_DeliversToMatchingSubscribers(t *testing.T) {
+	bus := NewMemoryBus(0)
+
+	var mu sync.Mutex
+	var coinEvents, allEvents []Type
+
+	bus.Subscribe(func(ctx context.Context, e Event) {
+		mu.Lock()
+		defer mu.Unlock()
+		coinEvents = append(coinEvents, e.Type)
+	}, CoinDiscovered, CoinTrending)
+	bus.Subscribe(func(ctx context.Context, e Event) {
+		mu.Lock()
+		defer mu.Unlock()
+		allEvents = append(allEvents, e.Type)
+	})
+
+	ctx := context.Background()
+	bus.Publish(ctx, NewCoinEvent(CoinDiscovered, model.Coin{Address: "mint1"}))
+	bus.Publish(ctx, NewTradeEvent(TradeExecuted, model.Trade{ID: 1}))
+	bus.Publish(ctx, NewCoinEvent(CoinTrending, model.Coin{Address: "mint2"}))
+
+	// Close drains queued events before returning
+	bus.Close()
+
+	assert.Equal(t, []Type{CoinDiscovered, CoinTrending}, coinEvents)
+	assert.Equal(t, []Type{CoinDiscovered, TradeExecuted, CoinTrending}, allEvents)
+}
+
+func TestMemoryBus_Unsubscribe(t *testing.T) {
+	bus := NewMemoryBus(0)
+	defer bus.Close()
+
+	received := make(chan Event, 1)
+	unsubscribe := bus.Subscribe(func(ctx context.Context, e Event) {
+		received <- e
+	})
+	unsubscribe()
+	unsubscribe() // safe to call twice
+
+	bus.Publish(context.Background(), NewCoinEvent(CoinEnriched, model.Coin{}))
+	assert.Empty(t, received)
+}
+
+func TestMemoryBus_HandlerPanicDoesNotStopDelivery(t *testing.T) {
+	bus := NewMemoryBus(0)
+
+	var count int
+	bus.Subscribe(func(ctx context.Context, e Event) {
+		count++
+		if count == 1 {
+			panic("boom")
+		}
+	})
+
+	bus.Publish(context.Background(), NewCoinEvent(CoinEnriched, model.Coin{}))
+	bus.Publish(context.Background(), NewCoinEvent(CoinEnriched, model.Coin{}))
+	bus.Close()
+
+	assert.Equal(t, 2, count)
+}
+
+func TestMemoryBus_PublishAfterCloseIsNoop(t *testing.T) {
+	bus := NewMemoryBus(0)
+	bus.Close()
+
+	assert.NotPanics(t, func() {
+		bus.Publish(context.Background(), NewCoinEvent(CoinEnriched, model.Coin{}))
+	})
+}
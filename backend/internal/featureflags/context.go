@@ -0,0 +1,38 @@
+package featureflags
+
+import "context"
+
+type contextKey string
+
+const (
+	identityKey  contextKey = "feature_flag_identity"
+	evaluatorKey contextKey = "feature_flag_evaluator"
+)
+
+// WithIdentity returns a context carrying the identity flags are evaluated for,
+// typically a wallet address or App Check subject.
+func WithIdentity(ctx context.Context, identity string) context.Context {
+	return context.WithValue(ctx, identityKey, identity)
+}
+
+// IdentityFromContext returns the identity stored by WithIdentity, or "".
+func IdentityFromContext(ctx context.Context) string {
+	identity, _ := ctx.Value(identityKey).(string)
+	return identity
+}
+
+// WithEvaluator returns a context carrying e so Enabled can be used without
+// threading the evaluator through every service.
+func WithEvaluator(ctx context.Context, e *Evaluator) context.Context {
+	return context.WithValue(ctx, evaluatorKey, e)
+}
+
+// Enabled reports whether flag is on for the request in ctx. It is false when
+// no evaluator is attached, e.g. in background jobs or tests.
+func Enabled(ctx context.Context, flag Flag) bool {
+	e, ok := ctx.Value(evaluatorKey).(*Evaluator)
+	if !ok || e == nil {
+		return false
+	}
+	return e.IsEnabled(flag, IdentityFromContext(ctx))
+}
@@ -0,0 +1,158 @@
+// Package featureflags evaluates per-user feature flags stored in postgres.
+//
+// Flags are cached in memory and refreshed periodically. Evaluation is
+// deterministic per identity: a user inside a 5% rollout stays inside it as
+// the percentage grows, and different flags bucket users independently.
+package featureflags
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"log/slog"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+// Flag is a feature flag key.
+type Flag string
+
+// Known flags
+const (
+	DetailedTradeBreakdown Flag = "trade.detailed_breakdown"
+)
+
+// DefaultRefreshInterval is how often flags are reloaded from the database.
+const DefaultRefreshInterval = 30 * time.Second
+
+// ErrInvalidFlag is returned when a flag definition fails validation.
+var ErrInvalidFlag = errors.New("invalid feature flag")
+
+// Evaluator caches flag definitions and decides whether a flag is on for an identity.
+type Evaluator struct {
+	repo            db.Repository[model.FeatureFlag]
+	refreshInterval time.Duration
+
+	mu    sync.RWMutex
+	flags map[Flag]model.FeatureFlag
+}
+
+// NewEvaluator creates an Evaluator. A refreshInterval <= 0 uses DefaultRefreshInterval.
+func NewEvaluator(repo db.Repository[model.FeatureFlag], refreshInterval time.Duration) *Evaluator {
+	if refreshInterval <= 0 {
+		refreshInterval = DefaultRefreshInterval
+	}
+	return &Evaluator{
+		repo:            repo,
+		refreshInterval: refreshInterval,
+		flags:           make(map[Flag]model.FeatureFlag),
+	}
+}
+
+// Load replaces the cached flags with the current database contents.
+func (e *Evaluator) Load(ctx context.Context) error {
+	rows, _, err := e.repo.List(ctx, db.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to load feature flags: %w", err)
+	}
+	flags := make(map[Flag]model.FeatureFlag, len(rows))
+	for _, row := range rows {
+		flags[Flag(row.ID)] = row
+	}
+	e.mu.Lock()
+	e.flags = flags
+	e.mu.Unlock()
+	return nil
+}
+
+// Watch reloads flags every refresh interval until ctx is cancelled.
+func (e *Evaluator) Watch(ctx context.Context) {
+	ticker := time.NewTicker(e.refreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := e.Load(ctx); err != nil && ctx.Err() == nil {
+				slog.WarnContext(ctx, "Failed to refresh feature flags", slog.Any("error", err))
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// IsEnabled reports whether flag is on for identity. Unknown flags are off.
+// An empty identity only receives flags rolled out to 100%.
+func (e *Evaluator) IsEnabled(flag Flag, identity string) bool {
+	e.mu.RLock()
+	def, ok := e.flags[flag]
+	e.mu.RUnlock()
+	if !ok || !def.Enabled {
+		return false
+	}
+	if identity != "" && slices.Contains(def.AllowList, identity) {
+		return true
+	}
+	if def.RolloutPercent >= 100 {
+		return true
+	}
+	if identity == "" || def.RolloutPercent <= 0 {
+		return false
+	}
+	return bucket(flag, identity) < def.RolloutPercent
+}
+
+// bucket maps identity to a stable value in [0, 100) for flag.
+func bucket(flag Flag, identity string) int {
+	h := fnv.New32a()
+	h.Write([]byte(flag))
+	h.Write([]byte{':'})
+	h.Write([]byte(identity))
+	return int(h.Sum32() % 100)
+}
+
+// List returns the cached flags sorted by key.
+func (e *Evaluator) List() []model.FeatureFlag {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	flags := make([]model.FeatureFlag, 0, len(e.flags))
+	for _, f := range e.flags {
+		flags = append(flags, f)
+	}
+	sort.Slice(flags, func(i, j int) bool { return flags[i].ID < flags[j].ID })
+	return flags
+}
+
+// Save validates and stores a flag definition, then refreshes the cache.
+func (e *Evaluator) Save(ctx context.Context, flag model.FeatureFlag) (*model.FeatureFlag, error) {
+	if flag.ID == "" {
+		return nil, fmt.Errorf("%w: key is required", ErrInvalidFlag)
+	}
+	if flag.RolloutPercent < 0 || flag.RolloutPercent > 100 {
+		return nil, fmt.Errorf("%w: rollout percent must be between 0 and 100", ErrInvalidFlag)
+	}
+	if _, err := e.repo.Upsert(ctx, &flag); err != nil {
+		return nil, fmt.Errorf("failed to save feature flag %s: %w", flag.ID, err)
+	}
+	if err := e.Load(ctx); err != nil {
+		return nil, err
+	}
+	e.mu.RLock()
+	saved := e.flags[Flag(flag.ID)]
+	e.mu.RUnlock()
+	return &saved, nil
+}
+
+// Delete removes a flag definition; the flag evaluates as off afterwards.
+func (e *Evaluator) Delete(ctx context.Context, key string) error {
+	if err := e.repo.HardDelete(ctx, key); err != nil {
+		return fmt.Errorf("failed to delete feature flag %s: %w", key, err)
+	}
+	return e.Load(ctx)
+}
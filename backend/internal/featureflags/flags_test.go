@@ -0,0 +1,62 @@
+package featureflags
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	dbmocks "github.com/nicolas-martin/dankfolio/backend/internal/db/mocks"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+func newTestEvaluator(t *testing.T, flags ...model.FeatureFlag) *Evaluator {
+	repo := dbmocks.NewMockRepository[model.FeatureFlag](t)
+	repo.EXPECT().List(mock.Anything, db.ListOptions{}).Return(flags, int32(len(flags)), nil).Once()
+	e := NewEvaluator(repo, 0)
+	require.NoError(t, e.Load(context.Background()))
+	return e
+}
+
+func TestEvaluator_IsEnabled(t *testing.T) {
+	e := newTestEvaluator(t,
+		model.FeatureFlag{ID: "off", Enabled: false, RolloutPercent: 100},
+		model.FeatureFlag{ID: "all", Enabled: true, RolloutPercent: 100},
+		model.FeatureFlag{ID: "allow", Enabled: true, AllowList: []string{"wallet-a"}},
+		model.FeatureFlag{ID: "partial", Enabled: true, RolloutPercent: 20},
+	)
+
+	assert.False(t, e.IsEnabled("unknown", "wallet-a"))
+	assert.False(t, e.IsEnabled("off", "wallet-a"))
+	assert.True(t, e.IsEnabled("all", ""))
+	assert.True(t, e.IsEnabled("allow", "wallet-a"))
+	assert.False(t, e.IsEnabled("allow", "wallet-b"))
+	assert.False(t, e.IsEnabled("partial", ""))
+
+	// Roughly 20% of identities land in the rollout, and the answer is stable
+	enabled := 0
+	for i := range 1000 {
+		id := fmt.Sprintf("wallet-%d", i)
+		got := e.IsEnabled("partial", id)
+		assert.Equal(t, got, e.IsEnabled("partial", id))
+		if got {
+			enabled++
+		}
+	}
+	assert.InDelta(t, 200, enabled, 50)
+}
+
+func TestEnabled_UsesContextIdentity(t *testing.T) {
+	e := newTestEvaluator(t, model.FeatureFlag{ID: string(DetailedTradeBreakdown), Enabled: true, AllowList: []string{"wallet-a"}})
+
+	ctx := context.Background()
+	assert.False(t, Enabled(ctx, DetailedTradeBreakdown), "no evaluator attached")
+
+	ctx = WithEvaluator(ctx, e)
+	assert.False(t, Enabled(ctx, DetailedTradeBreakdown))
+	assert.True(t, Enabled(WithIdentity(ctx, "wallet-a"), DetailedTradeBreakdown))
+}
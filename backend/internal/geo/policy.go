@@ -0,0 +1,188 @@
+package geo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+// What a region may call
+const (
+	ActionAllow    = "allow"     // Every procedure
+	ActionReadOnly = "read_only" // Everything but the restricted procedures, e.g. trading
+	ActionBlock    = "block"     // Only the exempt procedures, e.g. the app config explaining why
+)
+
+// Actions are the valid region rule actions
+var Actions = []string{ActionAllow, ActionReadOnly, ActionBlock}
+
+// UnknownRegionRule is the rule key applied to requests whose region couldn't
+// be resolved
+const UnknownRegionRule = "unknown"
+
+// Policy decides which procedures callers from each region may call. Regions
+// without a rule are allowed everything.
+type Policy struct {
+	resolver   *Resolver
+	restricted []string // Refused in read-only regions
+	exempt     []string // Served even in blocked regions
+	auditLog   db.Repository[model.AuditEntry]
+
+	mu    sync.RWMutex
+	rules map[string]string
+}
+
+// NewPolicy creates a policy for regions resolved by resolver. restricted are
+// the procedures read-only regions can't call, exempt those blocked regions
+// still can; an entry ending in "/" names every procedure of a service.
+// Refusals are recorded in auditLog when it's set.
+func NewPolicy(resolver *Resolver, restricted, exempt []string, auditLog db.Repository[model.AuditEntry]) *Policy {
+	return &Policy{resolver: resolver, restricted: restricted, exempt: exempt, auditLog: auditLog, rules: map[string]string{}}
+}
+
+// ParseRules parses region rules written REGION=action, e.g. "US=read_only"
+// or "unknown=block", where REGION is an ISO 3166-1 alpha-2 country code
+func ParseRules(entries []string) (map[string]string, error) {
+	rules := make(map[string]string, len(entries))
+	for _, entry := range entries {
+		region, action, ok := strings.Cut(entry, "=")
+		region, action = strings.TrimSpace(region), strings.ToLower(strings.TrimSpace(action))
+		if !ok || region == "" {
+			return nil, fmt.Errorf("region rule %q must be REGION=action", entry)
+		}
+		if !slices.Contains(Actions, action) {
+			return nil, fmt.Errorf("region rule %q has unknown action %q, expected one of %s", entry, action, strings.Join(Actions, ", "))
+		}
+		if !strings.EqualFold(region, UnknownRegionRule) {
+			if len(region) != 2 {
+				return nil, fmt.Errorf("region rule %q must name a two letter country code or %q", entry, UnknownRegionRule)
+			}
+			region = strings.ToUpper(region)
+		} else {
+			region = UnknownRegionRule
+		}
+		rules[region] = action
+	}
+	return rules, nil
+}
+
+// SetRules replaces the region rules. Entries are validated with ParseRules
+// when they are set, so invalid ones are logged and skipped here.
+func (p *Policy) SetRules(entries []string) {
+	rules := make(map[string]string, len(entries))
+	for _, entry := range entries {
+		parsed, err := ParseRules([]string{entry})
+		if err != nil {
+			slog.Warn("Ignoring invalid region rule", slog.Any("error", err))
+			continue
+		}
+		for region, action := range parsed {
+			rules[region] = action
+		}
+	}
+	p.mu.Lock()
+	p.rules = rules
+	p.mu.Unlock()
+}
+
+// Decision is the outcome of a request under the policy
+type Decision struct {
+	Location
+	Procedure string
+	Action    string // The rule applied to the request's region
+	Allowed   bool
+}
+
+// Check decides whether a request with headers from peerAddr may call
+// procedure. Refusals are logged and recorded in the audit log against
+// wallet, the caller's wallet when known. Requests are only located while
+// there are rules, as IP lookups are billed.
+func (p *Policy) Check(ctx context.Context, procedure string, headers http.Header, peerAddr, wallet string) Decision {
+	p.mu.RLock()
+	unrestricted := len(p.rules) == 0
+	p.mu.RUnlock()
+	if unrestricted || matchProcedure(p.exempt, procedure) {
+		return Decision{Procedure: procedure, Action: ActionAllow, Allowed: true}
+	}
+
+	d := p.Decide(p.resolver.Resolve(ctx, headers, peerAddr), procedure)
+	if !d.Allowed {
+		p.record(ctx, d, wallet)
+	}
+	return d
+}
+
+// Decide applies the rule of loc's region to procedure
+func (p *Policy) Decide(loc Location, procedure string) Decision {
+	key := loc.Region
+	if key == "" {
+		key = UnknownRegionRule
+	}
+	p.mu.RLock()
+	action, ok := p.rules[key]
+	p.mu.RUnlock()
+	if !ok {
+		action = ActionAllow
+	}
+
+	d := Decision{Location: loc, Procedure: procedure, Action: action}
+	switch action {
+	case ActionReadOnly:
+		d.Allowed = !matchProcedure(p.restricted, procedure)
+	case ActionBlock:
+		d.Allowed = matchProcedure(p.exempt, procedure)
+	default:
+		d.Allowed = true
+	}
+	return d
+}
+
+// matchProcedure reports whether procedure is in procedures, or in a service
+// named by an entry ending in "/"
+func matchProcedure(procedures []string, procedure string) bool {
+	return slices.ContainsFunc(procedures, func(entry string) bool {
+		return entry == procedure || strings.HasSuffix(entry, "/") && strings.HasPrefix(procedure, entry)
+	})
+}
+
+// record logs a refusal and adds it to the audit log. The request is refused
+// either way, so audit log failures are only logged.
+func (p *Policy) record(ctx context.Context, d Decision, wallet string) {
+	slog.WarnContext(ctx, "Request refused by region policy",
+		"region", d.Region, "source", d.Source, "action", d.Action, "procedure", d.Procedure, "wallet", wallet)
+	if p.auditLog == nil {
+		return
+	}
+	details, err := json.Marshal(map[string]string{
+		"region": d.Region,
+		"source": d.Source,
+		"action": d.Action,
+		"ip":     d.IP,
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to encode region policy decision", slog.Any("error", err))
+		return
+	}
+	entry := &model.AuditEntry{
+		ID:            uuid.NewString(),
+		WalletAddress: wallet,
+		Action:        model.AuditRegionDenied,
+		Subject:       d.Procedure,
+		Details:       string(details),
+		CreatedAt:     time.Now(),
+	}
+	if err := p.auditLog.Create(ctx, entry); err != nil {
+		slog.ErrorContext(ctx, "Failed to record region policy refusal in audit log", "procedure", d.Procedure, slog.Any("error", err))
+	}
+}
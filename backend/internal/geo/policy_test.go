@@ -0,0 +1,101 @@
+package geo
+
+import (
+	"context"
+	"net/http"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	dbmocks "github.com/nicolas-martin/dankfolio/backend/internal/db/mocks"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+const (
+	swapProcedure    = "/dankfolio.v1.TradeService/SubmitSwap"
+	pricesProcedure  = "/dankfolio.v1.PriceService/GetCoinPrices"
+	configProcedure  = "/dankfolio.v1.AppConfigService/GetAppConfig"
+	settingProcedure = "/dankfolio.v1.AdminService/UpdateSetting"
+)
+
+type countryLookup map[string]string
+
+func (l countryLookup) Country(_ context.Context, ip netip.Addr) (string, error) {
+	l["calls"] += "."
+	return l[ip.String()], nil
+}
+
+func newTestPolicy(t *testing.T, lookup CountryLookup, rules ...string) (*Policy, *dbmocks.MockRepository[model.AuditEntry]) {
+	auditLog := dbmocks.NewMockRepository[model.AuditEntry](t)
+	policy := NewPolicy(NewResolver("", lookup),
+		[]string{swapProcedure}, []string{configProcedure, "/dankfolio.v1.AdminService/"}, auditLog)
+	policy.SetRules(rules)
+	return policy, auditLog
+}
+
+func TestParseRules(t *testing.T) {
+	rules, err := ParseRules([]string{"us=read_only", " KP = Block ", "Unknown=block"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"US": ActionReadOnly, "KP": ActionBlock, UnknownRegionRule: ActionBlock}, rules)
+
+	for _, invalid := range []string{"US", "USA=block", "US=deny", "=block"} {
+		_, err := ParseRules([]string{invalid})
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestPolicy_Decide(t *testing.T) {
+	policy, _ := newTestPolicy(t, nil, "US=read_only", "KP=block", "unknown=read_only")
+
+	tests := []struct {
+		region, procedure string
+		allowed           bool
+	}{
+		{"US", pricesProcedure, true},
+		{"US", swapProcedure, false},
+		{"KP", pricesProcedure, false},
+		{"KP", configProcedure, true},
+		{"KP", settingProcedure, true},
+		{"FR", swapProcedure, true},
+		{"", swapProcedure, false},
+	}
+	for _, tt := range tests {
+		d := policy.Decide(Location{Region: tt.region}, tt.procedure)
+		assert.Equal(t, tt.allowed, d.Allowed, "%s calling %s", tt.region, tt.procedure)
+	}
+}
+
+func TestPolicy_CheckRecordsRefusals(t *testing.T) {
+	lookup := countryLookup{"203.0.113.7": "US"}
+	policy, auditLog := newTestPolicy(t, lookup, "US=read_only")
+	auditLog.EXPECT().Create(mock.Anything, mock.Anything).
+		Run(func(_ context.Context, entry *model.AuditEntry) {
+			assert.Equal(t, model.AuditRegionDenied, entry.Action)
+			assert.Equal(t, swapProcedure, entry.Subject)
+			assert.Equal(t, "wallet1", entry.WalletAddress)
+			assert.JSONEq(t, `{"region":"US","source":"lookup","action":"read_only","ip":"203.0.113.7"}`, entry.Details)
+		}).Return(nil).Once()
+	headers := http.Header{"X-Forwarded-For": {"203.0.113.7, 10.0.0.1"}}
+
+	d := policy.Check(context.Background(), swapProcedure, headers, "10.0.0.2:443", "wallet1")
+	assert.False(t, d.Allowed)
+	assert.True(t, policy.Check(context.Background(), pricesProcedure, headers, "10.0.0.2:443", "wallet1").Allowed)
+	assert.Equal(t, ".", lookup["calls"], "the lookup is cached")
+
+	// The edge's header wins over the lookup
+	headers.Set(DefaultRegionHeader, "fr")
+	d = policy.Check(context.Background(), swapProcedure, headers, "10.0.0.2:443", "wallet1")
+	assert.True(t, d.Allowed)
+	assert.Equal(t, Location{Region: "FR", Source: SourceHeader, IP: "203.0.113.7"}, d.Location)
+}
+
+func TestPolicy_CheckWithoutRulesSkipsLookup(t *testing.T) {
+	lookup := countryLookup{}
+	policy, _ := newTestPolicy(t, lookup)
+
+	assert.True(t, policy.Check(context.Background(), swapProcedure, http.Header{}, "203.0.113.7:443", "").Allowed)
+	assert.Empty(t, lookup["calls"])
+}
@@ -0,0 +1,142 @@
+// Package geo resolves the region requests come from and decides which
+// procedures each region may call.
+package geo
+
+import (
+	"context"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Where a request's region was resolved from
+const (
+	SourceHeader = "header" // The edge's country header
+	SourceLookup = "lookup" // A lookup of the client IP
+	SourceNone   = "none"   // The region couldn't be resolved
+)
+
+const (
+	// DefaultRegionHeader is the header Cloudflare sets to the caller's country
+	DefaultRegionHeader = "CF-IPCountry"
+	// unknownHeaderRegion is sent by Cloudflare when it doesn't know the country
+	unknownHeaderRegion = "XX"
+
+	lookupCacheTTL  = time.Hour
+	lookupCacheSize = 10_000
+)
+
+// CountryLookup returns the ISO 3166-1 alpha-2 country of an IP address, or
+// "" when it's unknown
+type CountryLookup interface {
+	Country(ctx context.Context, ip netip.Addr) (string, error)
+}
+
+// Location is where a request came from
+type Location struct {
+	Region string // ISO 3166-1 alpha-2 country code, "" when unknown
+	Source string
+	IP     string // The client IP, when it could be parsed
+}
+
+// Resolver resolves the region of requests from the header set by the edge,
+// falling back to looking up the client IP. Lookups are cached per IP.
+type Resolver struct {
+	header string
+	lookup CountryLookup // Optional; without it only the header is used
+
+	mu    sync.Mutex
+	cache map[netip.Addr]cachedCountry
+}
+
+type cachedCountry struct {
+	region    string
+	expiresAt time.Time
+}
+
+// NewResolver creates a resolver reading header, or DefaultRegionHeader when
+// empty, and looking up IPs with lookup when the header is missing
+func NewResolver(header string, lookup CountryLookup) *Resolver {
+	if header == "" {
+		header = DefaultRegionHeader
+	}
+	return &Resolver{header: header, lookup: lookup, cache: make(map[netip.Addr]cachedCountry)}
+}
+
+// Resolve returns the location of a request with headers from peerAddr
+func (r *Resolver) Resolve(ctx context.Context, headers http.Header, peerAddr string) Location {
+	loc := Location{Source: SourceNone}
+	ip, ok := clientIP(headers, peerAddr)
+	if ok {
+		loc.IP = ip.String()
+	}
+	if region := strings.ToUpper(strings.TrimSpace(headers.Get(r.header))); region != "" && region != unknownHeaderRegion {
+		loc.Region, loc.Source = region, SourceHeader
+		return loc
+	}
+	if !ok || r.lookup == nil {
+		return loc
+	}
+	if region := r.lookupRegion(ctx, ip); region != "" {
+		loc.Region, loc.Source = region, SourceLookup
+	}
+	return loc
+}
+
+// lookupRegion returns ip's country from the cache or the lookup. Failed
+// lookups aren't cached, so the next request retries.
+func (r *Resolver) lookupRegion(ctx context.Context, ip netip.Addr) string {
+	now := time.Now()
+	r.mu.Lock()
+	cached, ok := r.cache[ip]
+	r.mu.Unlock()
+	if ok && now.Before(cached.expiresAt) {
+		return cached.region
+	}
+
+	region, err := r.lookup.Country(ctx, ip)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to look up client region", "ip", ip.String(), slog.Any("error", err))
+		return ""
+	}
+	region = strings.ToUpper(region)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.cache) >= lookupCacheSize {
+		for cachedIP, entry := range r.cache {
+			if now.After(entry.expiresAt) {
+				delete(r.cache, cachedIP)
+			}
+		}
+		if len(r.cache) >= lookupCacheSize {
+			clear(r.cache)
+		}
+	}
+	r.cache[ip] = cachedCountry{region: region, expiresAt: now.Add(lookupCacheTTL)}
+	return region
+}
+
+// clientIP returns the address of the client that made a request: the first
+// X-Forwarded-For entry, added by the edge, else X-Real-IP, else the peer
+func clientIP(headers http.Header, peerAddr string) (netip.Addr, bool) {
+	candidate := peerAddr
+	if forwarded := headers.Get("X-Forwarded-For"); forwarded != "" {
+		candidate, _, _ = strings.Cut(forwarded, ",")
+	} else if realIP := headers.Get("X-Real-IP"); realIP != "" {
+		candidate = realIP
+	}
+	candidate = strings.TrimSpace(candidate)
+	if host, _, err := net.SplitHostPort(candidate); err == nil {
+		candidate = host
+	}
+	ip, err := netip.ParseAddr(candidate)
+	if err != nil {
+		return netip.Addr{}, false
+	}
+	return ip.Unmap(), true
+}
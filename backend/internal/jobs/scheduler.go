@@ -0,0 +1,340 @@
+// Package jobs runs background work on a schedule. A job runs every interval,
+// resuming from its checkpoint after a restart; failed runs are retried,
+// panics are recovered, and every attempt is recorded and measured.
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/lifecycle"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+// Run statuses
+const (
+	StatusRunning   = "running"
+	StatusSucceeded = "succeeded"
+	StatusFailed    = "failed"
+	StatusPanicked  = "panicked"
+)
+
+// DefaultRetryBackoff is the wait before the first retry of a failed run. It
+// doubles with each retry.
+const DefaultRetryBackoff = 5 * time.Second
+
+const (
+	defaultRunLimit = 50
+	maxRunLimit     = 500
+)
+
+// ErrRunsNotRecorded is returned when listing the runs of a scheduler without a database
+var ErrRunsNotRecorded = errors.New("job runs are not recorded without a database")
+
+// Job is a named unit of background work
+type Job struct {
+	Name string
+	// Interval is the time between runs; SetInterval changes it while the job
+	// is scheduled. Zero runs the job once.
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+	// Retries is how many times a failed run is retried before the job waits
+	// for its next interval
+	Retries      int
+	RetryBackoff time.Duration // 0 uses DefaultRetryBackoff
+}
+
+// Scheduler runs jobs. It doesn't own goroutines: Run blocks, so callers run
+// each job on their own tracked goroutine.
+type Scheduler struct {
+	runs        db.Repository[model.JobRun] // Nil records no runs
+	checkpoints *lifecycle.Checkpoints      // Nil starts every job right away
+
+	mu        sync.RWMutex
+	intervals map[string]time.Duration
+	changed   chan struct{} // Closed and replaced on every interval change
+
+	runsTotal metric.Int64Counter
+	duration  metric.Float64Histogram
+	now       func() time.Time
+}
+
+// NewScheduler creates a scheduler that records runs and checkpoints in store,
+// which may be nil
+func NewScheduler(store db.Store) *Scheduler {
+	meter := otel.Meter("github.com/nicolas-martin/dankfolio/backend/internal/jobs")
+	runsTotal, err := meter.Int64Counter(
+		"dankfolio.jobs.runs_total",
+		metric.WithDescription("Background job attempts by job and status"),
+		metric.WithUnit("{run}"),
+	)
+	if err != nil {
+		slog.Warn("Failed to create job run counter", "error", err)
+	}
+	duration, err := meter.Float64Histogram(
+		"dankfolio.jobs.run.duration",
+		metric.WithDescription("Duration of background job attempts by job and status"),
+		metric.WithUnit("ms"),
+	)
+	if err != nil {
+		slog.Warn("Failed to create job duration histogram", "error", err)
+	}
+
+	s := &Scheduler{
+		intervals: make(map[string]time.Duration),
+		changed:   make(chan struct{}),
+		runsTotal: runsTotal,
+		duration:  duration,
+		now:       time.Now,
+	}
+	if store != nil {
+		s.runs = store.JobRuns()
+		s.checkpoints = lifecycle.NewCheckpoints(store.JobCheckpoints())
+	}
+	return s
+}
+
+// SetInterval changes how often a job runs. A scheduled job reschedules its
+// next run relative to its last one; a job not yet scheduled starts with it.
+func (s *Scheduler) SetInterval(job string, interval time.Duration) {
+	if interval <= 0 {
+		slog.Warn("Ignoring non-positive job interval", slog.String("job", job), slog.Duration("interval", interval))
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.intervals[job] == interval {
+		return
+	}
+	s.intervals[job] = interval
+	close(s.changed)
+	s.changed = make(chan struct{})
+}
+
+// Interval returns the current interval of a job, or zero if it has none
+func (s *Scheduler) Interval(job string) time.Duration {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.intervals[job]
+}
+
+// intervalChanges returns a channel that is closed on the next interval change.
+// Take it before reading the interval so a concurrent change is never missed.
+func (s *Scheduler) intervalChanges() <-chan struct{} {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.changed
+}
+
+// Run runs job every interval until ctx is cancelled, or once if it has no
+// interval. The first run is scheduled from the job's checkpoint: a run
+// interrupted by a deploy, or one that is overdue, starts immediately instead
+// of waiting a full interval.
+func (s *Scheduler) Run(ctx context.Context, job Job) {
+	if job.Interval <= 0 {
+		s.execute(ctx, job)
+		return
+	}
+
+	s.mu.Lock()
+	if _, ok := s.intervals[job.Name]; !ok {
+		s.intervals[job.Name] = job.Interval
+	}
+	s.mu.Unlock()
+
+	intervalChanged := s.intervalChanges()
+	interval := s.Interval(job.Name)
+	initialDelay := s.initialDelay(ctx, job.Name, interval)
+	slog.InfoContext(ctx, "Starting job", slog.String("job", job.Name), slog.Duration("interval", interval), slog.Duration("first_run_in", initialDelay))
+
+	timer := time.NewTimer(initialDelay)
+	defer timer.Stop()
+	// Treat the schedule as if the previous run happened one interval before the first one
+	lastRun := s.now().Add(initialDelay - interval)
+
+	for {
+		select {
+		case <-timer.C:
+			s.saveCheckpoint(ctx, job.Name, lifecycle.CursorRunning)
+			if err := s.execute(ctx, job); err == nil {
+				s.saveCheckpoint(ctx, job.Name, lifecycle.CursorDone)
+			}
+			lastRun = s.now()
+			timer.Reset(interval)
+		case <-intervalChanged:
+			intervalChanged = s.intervalChanges()
+			interval = s.Interval(job.Name)
+			next := max(lastRun.Add(interval).Sub(s.now()), 0)
+			slog.InfoContext(ctx, "Job interval changed", slog.String("job", job.Name), slog.Duration("interval", interval), slog.Duration("next_run_in", next))
+			timer.Reset(next)
+		case <-ctx.Done():
+			slog.InfoContext(ctx, "Job stopping due to context cancellation.", slog.String("job", job.Name))
+			return
+		}
+	}
+}
+
+func (s *Scheduler) initialDelay(ctx context.Context, job string, interval time.Duration) time.Duration {
+	if s.checkpoints == nil {
+		return 0
+	}
+	cp, err := s.checkpoints.Load(ctx, job)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to load job checkpoint, using full interval", slog.String("job", job), slog.Any("error", err))
+		return interval
+	}
+	return lifecycle.InitialDelay(cp, interval, s.now())
+}
+
+func (s *Scheduler) saveCheckpoint(ctx context.Context, job, cursor string) {
+	if s.checkpoints == nil {
+		return
+	}
+	// Checkpoints must be written even while the job context is being cancelled
+	saveCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	defer cancel()
+	if err := s.checkpoints.Save(saveCtx, job, cursor); err != nil {
+		slog.WarnContext(ctx, "Failed to save job checkpoint", slog.String("job", job), slog.Any("error", err))
+	}
+}
+
+// execute runs job, retrying a failed run with backoff, and returns the error
+// of the last attempt
+func (s *Scheduler) execute(ctx context.Context, job Job) error {
+	backoff := job.RetryBackoff
+	if backoff <= 0 {
+		backoff = DefaultRetryBackoff
+	}
+	for attempt := 1; ; attempt++ {
+		err := s.attempt(ctx, job, attempt)
+		if err == nil || attempt > job.Retries || ctx.Err() != nil {
+			return err
+		}
+		slog.WarnContext(ctx, "Job run failed, retrying", slog.String("job", job.Name), slog.Int("attempt", attempt), slog.Duration("retry_in", backoff), slog.Any("error", err))
+		timer := time.NewTimer(backoff)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		}
+		backoff *= 2
+	}
+}
+
+// attempt runs job once, recovering a panic as a failure
+func (s *Scheduler) attempt(ctx context.Context, job Job, attempt int) (err error) {
+	run := &model.JobRun{
+		ID:        uuid.NewString(),
+		Job:       job.Name,
+		Attempt:   attempt,
+		Status:    StatusRunning,
+		StartedAt: s.now(),
+	}
+	s.record(ctx, run, true)
+
+	status := StatusSucceeded
+	func() {
+		defer func() {
+			if r := recover(); r != nil {
+				status = StatusPanicked
+				err = fmt.Errorf("panic: %v", r)
+				slog.ErrorContext(ctx, "PANIC in job - recovered", slog.String("job", job.Name), slog.Any("panic", r), slog.String("stack", string(debug.Stack())))
+			}
+		}()
+		err = job.Run(ctx)
+	}()
+	if err != nil && status != StatusPanicked {
+		status = StatusFailed
+		if ctx.Err() == nil {
+			slog.ErrorContext(ctx, "Job run failed", slog.String("job", job.Name), slog.Int("attempt", attempt), slog.Any("error", err))
+		}
+	}
+
+	finishedAt := s.now()
+	run.Status = status
+	run.FinishedAt = &finishedAt
+	run.Duration = finishedAt.Sub(run.StartedAt)
+	if err != nil {
+		run.Error = err.Error()
+	} else {
+		slog.InfoContext(ctx, "Job run succeeded", slog.String("job", job.Name), slog.Duration("took", run.Duration))
+	}
+	s.record(ctx, run, false)
+
+	attrs := metric.WithAttributes(attribute.String("job", job.Name), attribute.String("status", status))
+	if s.runsTotal != nil {
+		s.runsTotal.Add(ctx, 1, attrs)
+	}
+	if s.duration != nil {
+		s.duration.Record(ctx, float64(run.Duration.Microseconds())/1000, attrs)
+	}
+	return err
+}
+
+// record stores a run as it starts or finishes. Failing to store it doesn't
+// fail the job.
+func (s *Scheduler) record(ctx context.Context, run *model.JobRun, started bool) {
+	if s.runs == nil {
+		return
+	}
+	// Finished runs must be written even while the job context is being cancelled
+	recordCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	defer cancel()
+	var err error
+	if started {
+		err = s.runs.Create(recordCtx, run)
+	} else {
+		err = s.runs.Update(recordCtx, run)
+	}
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to record job run", slog.String("job", run.Job), slog.String("status", run.Status), slog.Any("error", err))
+	}
+}
+
+// RunFilter selects job runs; zero fields match everything
+type RunFilter struct {
+	Job    string
+	Status string
+	Limit  int // Defaults to 50, at most 500
+}
+
+// ListRuns returns the recorded runs matching filter, newest first
+func (s *Scheduler) ListRuns(ctx context.Context, filter RunFilter) ([]model.JobRun, error) {
+	if s.runs == nil {
+		return nil, ErrRunsNotRecorded
+	}
+	var filters []db.FilterOption
+	if filter.Job != "" {
+		filters = append(filters, db.FilterOption{Field: "job", Operator: db.FilterOpEqual, Value: filter.Job})
+	}
+	if filter.Status != "" {
+		filters = append(filters, db.FilterOption{Field: "status", Operator: db.FilterOpEqual, Value: filter.Status})
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = defaultRunLimit
+	}
+	filter.Limit = min(filter.Limit, maxRunLimit)
+	sortBy, desc := "started_at", true
+	runs, _, err := s.runs.ListWithOpts(ctx, db.ListOptions{
+		Limit:    &filter.Limit,
+		SortBy:   &sortBy,
+		SortDesc: &desc,
+		Filters:  filters,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list job runs: %w", err)
+	}
+	return runs, nil
+}
@@ -0,0 +1,111 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	dbmocks "github.com/nicolas-martin/dankfolio/backend/internal/db/mocks"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+func TestScheduler_RetriesFailedRunsAndRecordsEachAttempt(t *testing.T) {
+	runs := dbmocks.NewMockRepository[model.JobRun](t)
+	var finished []model.JobRun
+	runs.EXPECT().Create(mock.Anything, mock.MatchedBy(func(r *model.JobRun) bool {
+		return r.Job == "test" && r.Status == StatusRunning
+	})).Return(nil).Times(3)
+	runs.EXPECT().Update(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, r *model.JobRun) error {
+		finished = append(finished, *r)
+		return nil
+	}).Times(3)
+
+	s := NewScheduler(nil)
+	s.runs = runs
+
+	calls := 0
+	err := s.execute(context.Background(), Job{
+		Name: "test",
+		Run: func(context.Context) error {
+			calls++
+			switch calls {
+			case 1:
+				return errors.New("upstream unavailable")
+			case 2:
+				panic("nil map")
+			}
+			return nil
+		},
+		Retries:      2,
+		RetryBackoff: time.Millisecond,
+	})
+	require.NoError(t, err)
+	require.Len(t, finished, 3)
+	assert.Equal(t, StatusFailed, finished[0].Status)
+	assert.Equal(t, "upstream unavailable", finished[0].Error)
+	assert.Equal(t, StatusPanicked, finished[1].Status)
+	assert.Contains(t, finished[1].Error, "nil map")
+	assert.Equal(t, StatusSucceeded, finished[2].Status)
+	assert.Equal(t, 3, finished[2].Attempt)
+	assert.NotNil(t, finished[2].FinishedAt)
+}
+
+func TestScheduler_GivesUpAfterRetries(t *testing.T) {
+	s := NewScheduler(nil)
+	calls := 0
+	err := s.execute(context.Background(), Job{
+		Name:         "test",
+		Run:          func(context.Context) error { calls++; return errors.New("boom") },
+		Retries:      1,
+		RetryBackoff: time.Millisecond,
+	})
+	assert.EqualError(t, err, "boom")
+	assert.Equal(t, 2, calls)
+}
+
+func TestScheduler_RunsJobWithoutIntervalOnce(t *testing.T) {
+	s := NewScheduler(nil)
+	calls := 0
+	s.Run(context.Background(), Job{Name: "once", Run: func(context.Context) error { calls++; return nil }})
+	assert.Equal(t, 1, calls)
+}
+
+func TestScheduler_IntervalChangeReschedulesRunningJob(t *testing.T) {
+	s := NewScheduler(nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ran := make(chan struct{}, 10)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		s.Run(ctx, Job{Name: "periodic", Interval: time.Hour, Run: func(context.Context) error {
+			ran <- struct{}{}
+			return nil
+		}})
+	}()
+
+	// Without a checkpoint the first run starts right away
+	<-ran
+	s.SetInterval("periodic", -time.Second)
+	assert.Equal(t, time.Hour, s.Interval("periodic"), "non-positive intervals are ignored")
+
+	s.SetInterval("periodic", 10*time.Millisecond)
+	select {
+	case <-ran:
+	case <-time.After(5 * time.Second):
+		t.Fatal("job was not rescheduled after its interval changed")
+	}
+	cancel()
+	<-done
+}
+
+func TestScheduler_ListRunsRequiresDatabase(t *testing.T) {
+	_, err := NewScheduler(nil).ListRuns(context.Background(), RunFilter{})
+	assert.ErrorIs(t, err, ErrRunsNotRecorded)
+}
@@ -0,0 +1,61 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+// Checkpoint cursor values shared by periodic jobs.
+const (
+	CursorRunning = "running"
+	CursorDone    = "done"
+)
+
+// Checkpoints persists job progress so interrupted work resumes after a restart.
+type Checkpoints struct {
+	repo db.Repository[model.JobCheckpoint]
+}
+
+// NewCheckpoints creates a checkpoint store backed by the given repository.
+func NewCheckpoints(repo db.Repository[model.JobCheckpoint]) *Checkpoints {
+	return &Checkpoints{repo: repo}
+}
+
+// Load returns the checkpoint for a job, or nil if the job has never checkpointed.
+func (c *Checkpoints) Load(ctx context.Context, job string) (*model.JobCheckpoint, error) {
+	cp, err := c.repo.Get(ctx, job)
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to load checkpoint for %s: %w", job, err)
+	}
+	return cp, nil
+}
+
+// Save records the job's cursor.
+func (c *Checkpoints) Save(ctx context.Context, job, cursor string) error {
+	if _, err := c.repo.Upsert(ctx, &model.JobCheckpoint{ID: job, Cursor: cursor, UpdatedAt: time.Now()}); err != nil {
+		return fmt.Errorf("failed to save checkpoint for %s: %w", job, err)
+	}
+	return nil
+}
+
+// InitialDelay returns how long a periodic job should wait before its first run.
+// A job that was interrupted mid-run, or whose last completed run is older than
+// the interval, runs immediately; otherwise it waits out the rest of the interval.
+func InitialDelay(cp *model.JobCheckpoint, interval time.Duration, now time.Time) time.Duration {
+	if cp == nil || cp.Cursor != CursorDone {
+		return 0
+	}
+	elapsed := now.Sub(cp.UpdatedAt)
+	if elapsed >= interval {
+		return 0
+	}
+	return interval - elapsed
+}
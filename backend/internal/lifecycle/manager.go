@@ -0,0 +1,131 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// DefaultDrainTimeout bounds how long Shutdown waits for hooks and background work.
+const DefaultDrainTimeout = 25 * time.Second
+
+// Manager owns the process-wide context and coordinates an orderly shutdown:
+// the root context is cancelled, shutdown hooks run in reverse registration
+// order, and tracked goroutines are given until the drain deadline to return.
+type Manager struct {
+	ctx          context.Context
+	cancel       context.CancelFunc
+	drainTimeout time.Duration
+
+	mu      sync.Mutex
+	hooks   []hook
+	running map[string]int
+	wg      sync.WaitGroup
+}
+
+type hook struct {
+	name string
+	fn   func(ctx context.Context) error
+}
+
+// NewManager creates a lifecycle manager. A drainTimeout <= 0 uses DefaultDrainTimeout.
+func NewManager(drainTimeout time.Duration) *Manager {
+	if drainTimeout <= 0 {
+		drainTimeout = DefaultDrainTimeout
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Manager{
+		ctx:          ctx,
+		cancel:       cancel,
+		drainTimeout: drainTimeout,
+		running:      make(map[string]int),
+	}
+}
+
+// Context returns the root context, cancelled when shutdown begins.
+func (m *Manager) Context() context.Context {
+	return m.ctx
+}
+
+// Go runs fn in a tracked goroutine. fn must return promptly once ctx is cancelled.
+func (m *Manager) Go(name string, fn func(ctx context.Context)) {
+	m.mu.Lock()
+	m.running[name]++
+	m.mu.Unlock()
+	m.wg.Add(1)
+
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				slog.Error("PANIC in background task - recovered", slog.String("task", name), slog.Any("panic", r))
+			}
+			m.mu.Lock()
+			m.running[name]--
+			if m.running[name] == 0 {
+				delete(m.running, name)
+			}
+			m.mu.Unlock()
+			m.wg.Done()
+		}()
+		fn(m.ctx)
+	}()
+}
+
+// OnShutdown registers a hook. Hooks run in reverse order of registration,
+// so components should be registered in the order they are constructed.
+func (m *Manager) OnShutdown(name string, fn func(ctx context.Context) error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.hooks = append(m.hooks, hook{name: name, fn: fn})
+}
+
+// Shutdown cancels the root context, runs shutdown hooks, and waits for tracked
+// goroutines. Everything shares a single drain deadline.
+func (m *Manager) Shutdown() error {
+	slog.Info("Shutdown started", slog.Duration("drain_timeout", m.drainTimeout))
+	m.cancel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), m.drainTimeout)
+	defer cancel()
+
+	m.mu.Lock()
+	hooks := make([]hook, len(m.hooks))
+	copy(hooks, m.hooks)
+	m.mu.Unlock()
+
+	var errs []error
+	for i := len(hooks) - 1; i >= 0; i-- {
+		h := hooks[i]
+		start := time.Now()
+		if err := h.fn(ctx); err != nil {
+			slog.Error("Shutdown hook failed", slog.String("hook", h.name), slog.Any("error", err))
+			errs = append(errs, fmt.Errorf("%s: %w", h.name, err))
+			continue
+		}
+		slog.Info("Shutdown hook completed", slog.String("hook", h.name), slog.Duration("took", time.Since(start)))
+	}
+
+	done := make(chan struct{})
+	go func() {
+		m.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		slog.Info("All background tasks drained")
+	case <-ctx.Done():
+		m.mu.Lock()
+		stragglers := make([]string, 0, len(m.running))
+		for name := range m.running {
+			stragglers = append(stragglers, name)
+		}
+		m.mu.Unlock()
+		slog.Warn("Drain timeout exceeded; abandoning background tasks", slog.Any("tasks", stragglers))
+		errs = append(errs, fmt.Errorf("drain timeout exceeded with %d task(s) still running", len(stragglers)))
+	}
+
+	return errors.Join(errs...)
+}
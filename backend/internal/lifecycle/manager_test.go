@@ -0,0 +1,72 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+func TestManager_ShutdownRunsHooksInReverseOrderAndDrainsTasks(t *testing.T) {
+	m := NewManager(time.Second)
+
+	var order []string
+	m.OnShutdown("first", func(context.Context) error { order = append(order, "first"); return nil })
+	m.OnShutdown("second", func(context.Context) error { order = append(order, "second"); return nil })
+
+	stopped := make(chan struct{})
+	m.Go("worker", func(ctx context.Context) {
+		<-ctx.Done()
+		close(stopped)
+	})
+
+	require.NoError(t, m.Shutdown())
+	assert.Equal(t, []string{"second", "first"}, order)
+	select {
+	case <-stopped:
+	default:
+		t.Fatal("worker did not observe cancellation")
+	}
+}
+
+func TestManager_ShutdownReportsHookErrorsAndDrainTimeout(t *testing.T) {
+	m := NewManager(50 * time.Millisecond)
+
+	hookErr := errors.New("boom")
+	m.OnShutdown("failing", func(context.Context) error { return hookErr })
+
+	release := make(chan struct{})
+	defer close(release)
+	m.Go("stuck", func(ctx context.Context) { <-release })
+
+	err := m.Shutdown()
+	require.Error(t, err)
+	assert.ErrorIs(t, err, hookErr)
+	assert.Contains(t, err.Error(), "drain timeout exceeded")
+}
+
+func TestInitialDelay(t *testing.T) {
+	now := time.Now()
+	interval := 10 * time.Minute
+
+	tests := []struct {
+		name string
+		cp   *model.JobCheckpoint
+		want time.Duration
+	}{
+		{"never run", nil, 0},
+		{"interrupted run", &model.JobCheckpoint{Cursor: CursorRunning, UpdatedAt: now}, 0},
+		{"overdue", &model.JobCheckpoint{Cursor: CursorDone, UpdatedAt: now.Add(-time.Hour)}, 0},
+		{"recent", &model.JobCheckpoint{Cursor: CursorDone, UpdatedAt: now.Add(-4 * time.Minute)}, 6 * time.Minute},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, InitialDelay(tt.cp, interval, now))
+		})
+	}
+}
@@ -76,7 +76,7 @@ func (h *ColorHandler) Handle(_ context.Context, r slog.Record) error {
 		var regularAttrs []slog.Attr
 		
 		for _, attr := range attrs {
-			if attr.Key == "trace_id" || attr.Key == "span_id" || attr.Key == "trace_sampled" {
+			if attr.Key == "request_id" || attr.Key == "trace_id" || attr.Key == "span_id" || attr.Key == "trace_sampled" {
 				traceAttrs = append(traceAttrs, attr)
 			} else {
 				regularAttrs = append(regularAttrs, attr)
@@ -90,7 +90,9 @@ func (h *ColorHandler) Handle(_ context.Context, r slog.Record) error {
 				if i > 0 {
 					msg += " "
 				}
-				if attr.Key == "trace_id" {
+				if attr.Key == "request_id" {
+					msg += color.New(color.FgMagenta).Sprintf("req:%s", attr.Value.String())
+				} else if attr.Key == "trace_id" {
 					msg += color.New(color.FgMagenta).Sprintf("trace:%s", attr.Value.String())
 				} else if attr.Key == "span_id" {
 					msg += color.New(color.FgMagenta).Sprintf("span:%s", attr.Value.String())
@@ -5,9 +5,12 @@ import (
 	"log/slog"
 
 	"go.opentelemetry.io/otel/trace"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/requestid"
 )
 
 // OtelHandler wraps another slog.Handler and adds OpenTelemetry trace context
+// and the request's correlation ID
 type OtelHandler struct {
 	handler slog.Handler
 }
@@ -26,6 +29,10 @@ func (h *OtelHandler) Enabled(ctx context.Context, level slog.Level) bool {
 
 // Handle adds trace and span IDs to the record before passing it to the wrapped handler
 func (h *OtelHandler) Handle(ctx context.Context, record slog.Record) error {
+	if id := requestid.FromContext(ctx); id != "" {
+		record.AddAttrs(slog.String("request_id", id))
+	}
+
 	// Extract trace information from context
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
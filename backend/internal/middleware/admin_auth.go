@@ -0,0 +1,36 @@
+package middleware
+
+import (
+	"context"
+	"crypto/subtle"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"connectrpc.com/authn"
+)
+
+// AdminAuthenticatedUser represents a caller authenticated with the admin API key
+type AdminAuthenticatedUser struct{}
+
+// AdminKeyMiddleware authenticates partner/admin routes with a static bearer token.
+// An empty apiKey rejects every request so admin routes are never left open by accident.
+func AdminKeyMiddleware(apiKey string) *authn.Middleware {
+	return authn.NewMiddleware(func(ctx context.Context, req *http.Request) (any, error) {
+		if apiKey == "" {
+			slog.Error("Admin API key is not configured; rejecting admin request", "path", req.URL.Path)
+			return nil, authn.Errorf("admin API disabled")
+		}
+
+		token, ok := strings.CutPrefix(req.Header.Get("Authorization"), "Bearer ")
+		if !ok || token == "" {
+			slog.Warn("Missing admin bearer token", "remote_addr", req.RemoteAddr, "path", req.URL.Path)
+			return nil, authn.Errorf("missing auth header")
+		}
+		if subtle.ConstantTimeCompare([]byte(token), []byte(apiKey)) != 1 {
+			slog.Warn("Invalid admin bearer token", "remote_addr", req.RemoteAddr, "path", req.URL.Path)
+			return nil, authn.Errorf("invalid auth header")
+		}
+		return &AdminAuthenticatedUser{}, nil
+	})
+}
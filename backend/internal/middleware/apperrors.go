@@ -0,0 +1,27 @@
+package middleware
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/apperrors"
+)
+
+// AppErrorsInterceptor reports handler errors that carry an apperrors.Error
+// with its code and details, whatever code the handler wrapped them in, so
+// clients can branch on the error reason. Other errors pass through.
+func AppErrorsInterceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			resp, err := next(ctx, req)
+			if err == nil {
+				return resp, nil
+			}
+			if connectErr, ok := apperrors.ToConnect(err); ok {
+				return nil, connectErr
+			}
+			return resp, err
+		}
+	}
+}
@@ -10,8 +10,8 @@ func CORSMiddleware(next http.Handler) http.Handler {
 		// Set CORS headers for both Connect and gRPC-Web
 		w.Header().Set("Access-Control-Allow-Origin", "http://localhost:3000") // Update this with your frontend origin
 		w.Header().Set("Access-Control-Allow-Methods", "GET,POST")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type,Connect-Protocol-Version,Connect-Timeout-Ms,Grpc-Timeout,X-Grpc-Web,X-User-Agent,Authorization,X-Firebase-AppCheck")
-		w.Header().Set("Access-Control-Expose-Headers", "Grpc-Status,Grpc-Message,Grpc-Status-Details-Bin")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type,Connect-Protocol-Version,Connect-Timeout-Ms,Grpc-Timeout,X-Grpc-Web,X-User-Agent,Authorization,X-Firebase-AppCheck,Connect-Accept-Encoding,Connect-Content-Encoding,Grpc-Accept-Encoding,X-Request-ID")
+		w.Header().Set("Access-Control-Expose-Headers", "Grpc-Status,Grpc-Message,Grpc-Status-Details-Bin,Grpc-Encoding,Connect-Content-Encoding,X-Request-ID")
 		w.Header().Set("Access-Control-Max-Age", "7200")
 		w.Header().Add("Vary", "Origin")
 		w.Header().Add("Vary", "Access-Control-Request-Method")
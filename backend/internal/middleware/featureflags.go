@@ -0,0 +1,49 @@
+package middleware
+
+import (
+	"context"
+
+	"connectrpc.com/authn"
+	"connectrpc.com/connect"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/featureflags"
+)
+
+// Request messages that identify the calling wallet
+type userPublicKeyRequest interface{ GetUserPublicKey() string }
+type walletAddressRequest interface{ GetWalletAddress() string }
+
+// FeatureFlagInterceptor attaches the flag evaluator and the caller's identity to the context.
+// The identity is the x-wallet-address header, else the wallet named in the request,
+// else the App Check subject, so rollouts follow a user across devices when possible.
+func FeatureFlagInterceptor(evaluator *featureflags.Evaluator) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			ctx = featureflags.WithEvaluator(ctx, evaluator)
+			if identity := requestIdentity(ctx, req); identity != "" {
+				ctx = featureflags.WithIdentity(ctx, identity)
+			}
+			return next(ctx, req)
+		}
+	}
+}
+
+func requestIdentity(ctx context.Context, req connect.AnyRequest) string {
+	if wallet := req.Header().Get("x-wallet-address"); wallet != "" {
+		return wallet
+	}
+	switch msg := req.Any().(type) {
+	case userPublicKeyRequest:
+		if key := msg.GetUserPublicKey(); key != "" {
+			return key
+		}
+	case walletAddressRequest:
+		if addr := msg.GetWalletAddress(); addr != "" {
+			return addr
+		}
+	}
+	if user, ok := authn.GetInfo(ctx).(*AppCheckAuthenticatedUser); ok {
+		return user.Subject
+	}
+	return ""
+}
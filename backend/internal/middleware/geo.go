@@ -0,0 +1,24 @@
+package middleware
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/apperrors"
+	"github.com/nicolas-martin/dankfolio/backend/internal/geo"
+)
+
+// GeoPolicyInterceptor refuses calls the region access policy doesn't allow
+// from the caller's region, e.g. trading from a read-only region
+func GeoPolicyInterceptor(policy *geo.Policy) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			decision := policy.Check(ctx, req.Spec().Procedure, req.Header(), req.Peer().Addr, requestIdentity(ctx, req))
+			if !decision.Allowed {
+				return nil, apperrors.ErrRegionRestricted.With("region", decision.Region)
+			}
+			return next(ctx, req)
+		}
+	}
+}
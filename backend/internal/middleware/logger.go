@@ -2,180 +2,195 @@ package middleware
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
-	"github.com/fatih/color"
-
 	"connectrpc.com/connect"
-	pb "github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
 )
 
-// GRPCLoggerInterceptor creates an interceptor that logs all gRPC requests and responses
-func GRPCLoggerInterceptor() connect.UnaryInterceptorFunc {
-	interceptor := func(next connect.UnaryFunc) connect.UnaryFunc {
-		return func(
-			ctx context.Context,
-			req connect.AnyRequest,
-		) (connect.AnyResponse, error) {
-			// Log the request
-			startTime := time.Now()
-			reqDetails, err := structToJSON(req.Any())
-			if err != nil {
-				reqDetails = fmt.Sprintf("failed to marshal request: %v", err)
-			}
+// DefaultSlowRequestThreshold is how long a request runs before it is logged
+// whatever the sample rate
+const DefaultSlowRequestThreshold = time.Second
 
-			debugModeColor := color.New(color.FgGreen, color.Bold)
-			if req.Header().Get("x-debug-mode") == "true" {
-				debugModeColor = color.New(color.FgYellow, color.Bold)
-			}
+// defaultSampleRule is the sample rate entry applied to procedures without their own
+const defaultSampleRule = "*"
 
-			slog.Info("📤 gRPC Request",
-				slog.String("peer", req.Peer().Addr),
-				slog.String("procedure", debugModeColor.Sprintf("%s", req.Spec().Procedure)),
-				slog.String("request", reqDetails),
-			)
+// RequestLogger logs one line per unary request: the procedure, a hash of the
+// caller, latency, message sizes and the request's fields with secrets and
+// wallets scrubbed. Successful requests are sampled; failed, slow and debug
+// mode requests are always logged.
+type RequestLogger struct {
+	slowThreshold time.Duration
+	random        func() float64
 
-			// Call the handler
-			res, err := next(ctx, req)
+	mu          sync.RWMutex
+	defaultRate float64
+	rates       map[string]float64 // By procedure, or service when ending in "/"
+}
 
-			// Calculate duration
-			duration := time.Since(startTime)
+// NewRequestLogger creates a request logger keeping sampleRate of successful
+// requests, from 0 to 1, and every request slower than slowThreshold, or
+// DefaultSlowRequestThreshold when zero
+func NewRequestLogger(sampleRate float64, slowThreshold time.Duration) *RequestLogger {
+	if slowThreshold <= 0 {
+		slowThreshold = DefaultSlowRequestThreshold
+	}
+	return &RequestLogger{
+		slowThreshold: slowThreshold,
+		random:        rand.Float64,
+		defaultRate:   min(max(sampleRate, 0), 1),
+		rates:         map[string]float64{},
+	}
+}
 
-			// Log the response or error
-			if err != nil {
-				// Log the error
-				connectErr, ok := err.(*connect.Error)
-				var errDetails string
-				if ok {
-					// Create a map that includes both error details and message
-					errMap := map[string]any{
-						"code":    connectErr.Code(),
-						"message": connectErr.Message(),
-					}
-
-					// Add details if available
-					if details := connectErr.Details(); len(details) > 0 {
-						errMap["details"] = details
-					}
-
-					// Convert to JSON for structured logging
-					errJSON, jsonErr := json.Marshal(errMap)
-					if jsonErr == nil {
-						errDetails = string(errJSON)
-					} else {
-						errDetails = fmt.Sprintf("code: %s, message: %s", connectErr.Code(), connectErr.Message())
-					}
-				} else {
-					// For non-connect errors, just use the error message
-					errDetails = fmt.Sprintf(`{"message": "%s"}`, err.Error())
-				}
+// ParseSampleRates parses sample rates written PROCEDURE=rate, where PROCEDURE
+// is a full procedure name, a service name ending in "/" or "*" for every
+// other procedure, e.g. "/dankfolio.v1.PriceService/=0.1"
+func ParseSampleRates(entries []string) (map[string]float64, error) {
+	rates := make(map[string]float64, len(entries))
+	for _, entry := range entries {
+		procedure, value, ok := strings.Cut(entry, "=")
+		procedure = strings.TrimSpace(procedure)
+		if !ok || procedure != defaultSampleRule && !strings.HasPrefix(procedure, "/") {
+			return nil, fmt.Errorf("sample rate %q must be PROCEDURE=rate, with PROCEDURE a /service/method, a /service/ or *", entry)
+		}
+		rate, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil || rate < 0 || rate > 1 {
+			return nil, fmt.Errorf("sample rate %q must be between 0 and 1", entry)
+		}
+		rates[procedure] = rate
+	}
+	return rates, nil
+}
 
-				slog.Error("❌ gRPC Error",
-					slog.String("peer", req.Peer().Addr),
-					slog.String("procedure", debugModeColor.Sprintf("%s", req.Spec().Procedure)),
-					slog.Duration("duration", duration),
-					slog.String("error", errDetails),
-				)
-				return nil, err
-			}
+// SetSampleRates replaces the per procedure sample rates. Entries are
+// validated with ParseSampleRates when they are set, so invalid ones are
+// logged and skipped here.
+func (l *RequestLogger) SetSampleRates(entries []string) {
+	rates := make(map[string]float64, len(entries))
+	for _, entry := range entries {
+		parsed, err := ParseSampleRates([]string{entry})
+		if err != nil {
+			slog.Warn("Ignoring invalid request log sample rate", slog.Any("error", err))
+			continue
+		}
+		for procedure, rate := range parsed {
+			rates[procedure] = rate
+		}
+	}
+	l.mu.Lock()
+	l.rates = rates
+	l.mu.Unlock()
+}
 
-			// Log the successful response
-			var resDetails string
-			if req.Spec().Procedure == "/dankfolio.v1.PriceService/GetPriceHistory" {
-				if respTyped, ok := res.Any().(*pb.GetPriceHistoryResponse); ok && respTyped.GetData() != nil {
-					items := respTyped.GetData().GetItems()
-					count := len(items)
-					if count == 0 {
-						resDetails = "{ data: { items: [empty] }, ... }"
-					} else {
-						first, _ := structToJSON(items[0])
-						last, _ := structToJSON(items[count-1])
-						resDetails = fmt.Sprintf("{ data: { items: [count=%d, first=%s, last=%s] }, ... }", count, first, last)
-					}
+// sampleRate returns the share of procedure's successful requests to log
+func (l *RequestLogger) sampleRate(procedure string) float64 {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	if rate, ok := l.rates[procedure]; ok {
+		return rate
+	}
+	if i := strings.LastIndex(procedure, "/"); i > 0 {
+		if rate, ok := l.rates[procedure[:i+1]]; ok {
+			return rate
+		}
+	}
+	if rate, ok := l.rates[defaultSampleRule]; ok {
+		return rate
+	}
+	return l.defaultRate
+}
+
+// Interceptor returns the connect interceptor logging requests
+func (l *RequestLogger) Interceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			start := time.Now()
+			resp, err := next(ctx, req)
+			duration := time.Since(start)
+
+			procedure := req.Spec().Procedure
+			debug, _ := ctx.Value(model.DebugModeKey).(bool)
+			slow := duration >= l.slowThreshold
+			if err == nil && !slow && !debug {
+				if rate := l.sampleRate(procedure); rate < 1 && l.random() >= rate {
+					return resp, err
 				}
 			}
-			if req.Spec().Procedure == "/dankfolio.v1.PriceService/GetCoinPrices" {
-				if respTyped, ok := res.Any().(*pb.GetCoinPricesResponse); ok && respTyped.GetPrices() != nil {
-					prices := respTyped.GetPrices()
-					count := len(prices)
-					if count == 0 {
-						resDetails = "{ prices: [empty] }"
-					} else {
-						var firstEntry string
-						for coinId, price := range prices {
-							priceJSON, _ := structToJSON(price)
-							firstEntry = fmt.Sprintf("%s: %s", coinId, priceJSON)
-							break
-						}
-						resDetails = fmt.Sprintf("{ prices: [count=%d, first_entry=(%s), ...] }", count, firstEntry)
-					}
-				}
+
+			code := "ok"
+			level, msg := slog.LevelInfo, "gRPC request"
+			if err != nil {
+				code = connect.CodeOf(err).String()
+				level, msg = errorLevel(err), "gRPC request failed"
+			} else if slow {
+				level, msg = slog.LevelWarn, "Slow gRPC request"
 			}
-			if req.Spec().Procedure == "/dankfolio.v1.PriceService/GetPriceHistoriesByIDs" {
-				if respTyped, ok := res.Any().(*pb.GetPriceHistoriesByIDsResponse); ok && respTyped.GetResults() != nil {
-					results := respTyped.GetResults()
-					count := len(results)
-					if count == 0 {
-						resDetails = "{ results: [empty] }"
-					} else {
-						var firstEntry string
-						for address, result := range results {
-							if result.GetSuccess() && result.GetData() != nil {
-								itemCount := len(result.GetData().GetItems())
-								firstEntry = fmt.Sprintf("%s: {success=true, items_count=%d}", address, itemCount)
-							} else {
-								firstEntry = fmt.Sprintf("%s: {success=false, error=%s}", address, result.GetErrorMessage())
-							}
-							break
-						}
-						resDetails = fmt.Sprintf("{ results: [count=%d, first_entry=(%s), ...] }", count, firstEntry)
-					}
-				}
+			attrs := []slog.Attr{
+				slog.String("procedure", procedure),
+				slog.String("code", code),
+				slog.Duration("duration", duration),
+				slog.Int("request_bytes", messageSize(req.Any())),
 			}
-			if req.Spec().Procedure == "/dankfolio.v1.CoinService/GetAllCoins" {
-				// NOTE: skip logging for the GetAllCoins
-				return res, nil
+			if caller := requestIdentity(ctx, req); caller != "" {
+				attrs = append(attrs, slog.String("caller", hashIdentity(caller)))
 			}
-			// Add specific handling for GetProxiedImage responses
-			if req.Spec().Procedure == "/dankfolio.v1.UtilityService/GetProxiedImage" {
-				// NOTE: skip logging for the proxied image data
-				return res, nil
-				// if respTyped, ok := res.Any().(*pb.GetProxiedImageResponse); ok {
-				// 	dataLen := len(respTyped.GetImageData())
-				// 	contentType := respTyped.GetContentType()
-				// 	resDetails = fmt.Sprintf("{ imageData: [size=%d bytes], contentType: %q }", dataLen, contentType)
-				// }
+			if debug {
+				attrs = append(attrs, slog.Bool("debug", true))
 			}
-			if resDetails == "" {
-				var err error
-				resDetails, err = structToJSON(res.Any())
-				if err != nil {
-					resDetails = fmt.Sprintf("failed to marshal response: %v", err)
-				}
+			if fields, jsonErr := json.Marshal(scrubRequest(req.Any(), debug)); jsonErr == nil {
+				attrs = append(attrs, slog.String("request", string(fields)))
 			}
+			if err != nil {
+				attrs = append(attrs, slog.String("error", errorMessage(err)))
+			} else if resp != nil {
+				attrs = append(attrs, slog.Int("response_bytes", messageSize(resp.Any())))
+			}
+			slog.LogAttrs(ctx, level, msg, attrs...)
+			return resp, err
+		}
+	}
+}
 
-			slog.Info("📥 gRPC Response",
-				slog.String("peer", req.Peer().Addr),
-				slog.String("procedure", debugModeColor.Sprintf("%s", req.Spec().Procedure)),
-				slog.Duration("duration", duration),
-				slog.String("response", resDetails),
-			)
+// errorLevel logs client mistakes as warnings and server faults as errors
+func errorLevel(err error) slog.Level {
+	switch connect.CodeOf(err) {
+	case connect.CodeInternal, connect.CodeUnknown, connect.CodeDataLoss, connect.CodeUnavailable, connect.CodeDeadlineExceeded:
+		return slog.LevelError
+	}
+	return slog.LevelWarn
+}
 
-			return res, nil
-		}
+// errorMessage is err's message without the code connect prefixes it with
+func errorMessage(err error) string {
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		return connectErr.Message()
 	}
-	return interceptor
+	return err.Error()
+}
+
+// hashIdentity identifies a caller across log lines without logging the wallet
+func hashIdentity(identity string) string {
+	sum := sha256.Sum256([]byte(identity))
+	return hex.EncodeToString(sum[:8])
 }
 
-// Helper to convert structs to JSON
-func structToJSON(v any) (string, error) {
-	// For sensitive data, you might want to redact certain fields
-	bytes, err := json.Marshal(v)
-	if err != nil {
-		return "", err
+func messageSize(msg any) int {
+	if m, ok := msg.(proto.Message); ok {
+		return proto.Size(m)
 	}
-	return string(bytes), nil
+	return 0
 }
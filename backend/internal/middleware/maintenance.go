@@ -0,0 +1,24 @@
+package middleware
+
+import (
+	"context"
+	"slices"
+
+	"connectrpc.com/connect"
+)
+
+// MaintenanceInterceptor rejects calls to procedures with the error check
+// returns, e.g. trades and transfers while the database is being migrated.
+// Every other procedure keeps serving.
+func MaintenanceInterceptor(check func() error, procedures ...string) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if slices.Contains(procedures, req.Spec().Procedure) {
+				if err := check(); err != nil {
+					return nil, err
+				}
+			}
+			return next(ctx, req)
+		}
+	}
+}
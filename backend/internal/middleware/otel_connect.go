@@ -6,6 +6,7 @@ import (
 
 	"connectrpc.com/connect"
 	"github.com/nicolas-martin/dankfolio/backend/internal/clients/tracker"
+	"github.com/nicolas-martin/dankfolio/backend/internal/requestid"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/metric"
@@ -55,6 +56,9 @@ func NewOtelConnectInterceptor(tracer trace.Tracer, meter metric.Meter) (connect
 				),
 			)
 			defer span.End()
+			if id := requestid.FromContext(ctx); id != "" {
+				span.SetAttributes(attribute.String("request.id", id))
+			}
 
 			// Add trace ID to response headers
 			if traceID := tracker.ExtractTraceID(ctx); traceID != "" {
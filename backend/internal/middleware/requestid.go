@@ -0,0 +1,36 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/requestid"
+)
+
+// RequestIDInterceptor gives every unary request a correlation ID, reusing the
+// client's X-Request-ID when it sends a valid one. The ID is put in the
+// context for logs and upstream calls, and echoed in the response or error
+// metadata so users can quote it in reports.
+func RequestIDInterceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			id := req.Header().Get(requestid.Header)
+			if !requestid.Valid(id) {
+				id = requestid.New()
+			}
+			resp, err := next(requestid.WithID(ctx, id), req)
+			if err != nil {
+				var connectErr *connect.Error
+				if !errors.As(err, &connectErr) {
+					connectErr = connect.NewError(connect.CodeUnknown, err)
+				}
+				connectErr.Meta().Set(requestid.Header, id)
+				return nil, connectErr
+			}
+			resp.Header().Set(requestid.Header, id)
+			return resp, nil
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package middleware
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	redacted = "[REDACTED]"
+
+	// Longer strings and lists are cut short in request logs
+	maxLoggedStringLen = 128
+	maxLoggedListLen   = 5
+)
+
+// secretFieldParts mark fields that are never logged, whatever the mode
+var secretFieldParts = []string{"private_key", "secret", "mnemonic", "seed_phrase", "password", "api_key"}
+
+// blobFieldParts mark fields logged as their size only: signed transactions
+// are replayable and both are too long to be useful in a log line
+var blobFieldParts = []string{"transaction", "signature"}
+
+// walletFields hold a user's wallet address
+var walletFields = map[string]bool{
+	"wallet_address":    true,
+	"wallet_public_key": true,
+	"user_public_key":   true,
+	"public_key":        true,
+	"wallet":            true,
+	"from_address":      true,
+	"to_address":        true,
+	"owner":             true,
+	"payer":             true,
+}
+
+// walletProtoFile declares messages whose plain "address" fields are wallets,
+// while elsewhere they're coin mints
+const walletProtoFile = "dankfolio/v1/wallet.proto"
+
+// scrubber turns request messages into log-safe values
+type scrubber struct {
+	showWallets bool // Log wallet addresses in full, for debug mode requests
+}
+
+// message returns the populated fields of m keyed by their JSON names, with
+// secrets redacted, blobs replaced by their size and wallets shortened
+func (s scrubber) message(m protoreflect.Message) map[string]any {
+	fields := map[string]any{}
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		fields[fd.JSONName()] = s.field(fd, v)
+		return true
+	})
+	return fields
+}
+
+func (s scrubber) field(fd protoreflect.FieldDescriptor, v protoreflect.Value) any {
+	name := string(fd.Name())
+	switch {
+	case containsAny(name, secretFieldParts):
+		return redacted
+	case containsAny(name, blobFieldParts) && !fd.IsList() && !fd.IsMap():
+		return fmt.Sprintf("[%d bytes]", len(v.String()))
+	}
+	wallet := walletFields[name] || name == "address" && fd.ParentFile().Path() == walletProtoFile
+
+	switch {
+	case fd.IsList():
+		list := v.List()
+		items := make([]any, 0, min(list.Len(), maxLoggedListLen+1))
+		for i := 0; i < list.Len() && i < maxLoggedListLen; i++ {
+			items = append(items, s.value(fd, list.Get(i), wallet))
+		}
+		if list.Len() > maxLoggedListLen {
+			items = append(items, fmt.Sprintf("... %d more", list.Len()-maxLoggedListLen))
+		}
+		return items
+	case fd.IsMap():
+		return fmt.Sprintf("[%d entries]", v.Map().Len())
+	}
+	return s.value(fd, v, wallet)
+}
+
+// value scrubs a single value of fd, an element when fd is a list
+func (s scrubber) value(fd protoreflect.FieldDescriptor, v protoreflect.Value, wallet bool) any {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return s.message(v.Message())
+	case protoreflect.BytesKind:
+		return fmt.Sprintf("[%d bytes]", len(v.Bytes()))
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			return string(ev.Name())
+		}
+		return v.Enum()
+	case protoreflect.StringKind:
+		if wallet && !s.showWallets {
+			return shortenWallet(v.String())
+		}
+		if text := v.String(); len(text) > maxLoggedStringLen {
+			return text[:maxLoggedStringLen] + "..."
+		}
+		return v.String()
+	}
+	return v.Interface()
+}
+
+// scrubRequest returns msg's fields safe to log, or nil for a non-proto message
+func scrubRequest(msg any, showWallets bool) map[string]any {
+	m, ok := msg.(proto.Message)
+	if !ok {
+		return nil
+	}
+	return scrubber{showWallets: showWallets}.message(m.ProtoReflect())
+}
+
+// shortenWallet keeps enough of an address to tell wallets apart in a log
+// without identifying the user, e.g. "7xKX…AsU"
+func shortenWallet(address string) string {
+	if len(address) <= 8 {
+		return address
+	}
+	return address[:4] + "…" + address[len(address)-3:]
+}
+
+func containsAny(name string, parts []string) bool {
+	for _, part := range parts {
+		if strings.Contains(name, part) {
+			return true
+		}
+	}
+	return false
+}
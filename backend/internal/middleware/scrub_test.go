@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1"
+)
+
+const (
+	testWallet = "7xKXtg2CW87d97TXJSDpbD5jBkheTqA83TZRuJosgAsU"
+	testMint   = "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v"
+)
+
+func TestScrubRequest(t *testing.T) {
+	transfer := &pb.PrepareTransferRequest{
+		FromAddress: testWallet,
+		ToAddress:   testWallet,
+		CoinMint:    testMint,
+		Amount:      1.5,
+		References:  []string{"a", "b", "c", "d", "e", "f", "g"},
+	}
+	assert.Equal(t, map[string]any{
+		"fromAddress": "7xKX…AsU",
+		"toAddress":   "7xKX…AsU",
+		"coinMint":    testMint,
+		"amount":      1.5,
+		"references":  []any{"a", "b", "c", "d", "e", "... 2 more"},
+	}, scrubRequest(transfer, false))
+	assert.Equal(t, testWallet, scrubRequest(transfer, true)["fromAddress"], "debug mode logs full wallets")
+
+	submit := &pb.SubmitTransferRequest{SignedTransaction: "AQAB" + testWallet, UnsignedTransaction: "AQAB"}
+	assert.Equal(t, map[string]any{"signedTransaction": "[48 bytes]", "unsignedTransaction": "[4 bytes]"}, scrubRequest(submit, true))
+
+	// "address" is a wallet in the wallet service and a mint everywhere else
+	assert.Equal(t, "7xKX…AsU", scrubRequest(&pb.GetWalletBalancesRequest{Address: testWallet}, false)["address"])
+	assert.Equal(t, testMint, scrubRequest(&pb.GetCoinByIDRequest{Address: testMint}, false)["address"])
+}
+
+func TestRequestLogger_SampleRate(t *testing.T) {
+	_, err := ParseSampleRates([]string{"/dankfolio.v1.PriceService/=2"})
+	assert.Error(t, err)
+	_, err = ParseSampleRates([]string{"PriceService=0.5"})
+	assert.Error(t, err)
+
+	logger := NewRequestLogger(0.5, 0)
+	assert.Equal(t, DefaultSlowRequestThreshold, logger.slowThreshold)
+	assert.Equal(t, 0.5, logger.sampleRate("/dankfolio.v1.CoinService/GetCoinByID"))
+
+	rates := []string{"*=0.2", "/dankfolio.v1.PriceService/=0.1", "/dankfolio.v1.PriceService/GetPriceHistory=1"}
+	_, err = ParseSampleRates(rates)
+	require.NoError(t, err)
+	logger.SetSampleRates(rates)
+	assert.Equal(t, 0.2, logger.sampleRate("/dankfolio.v1.CoinService/GetCoinByID"))
+	assert.Equal(t, 0.1, logger.sampleRate("/dankfolio.v1.PriceService/GetCoinPrices"))
+	assert.Equal(t, 1.0, logger.sampleRate("/dankfolio.v1.PriceService/GetPriceHistory"))
+}
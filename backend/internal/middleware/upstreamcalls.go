@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/tracker"
+)
+
+const (
+	// ReportUpstreamCallsHeader asks the server to report upstream calls when "true"
+	ReportUpstreamCallsHeader = "X-Report-Upstream-Calls"
+	// UpstreamCallsHeader carries the upstream calls made for a request, e.g. "birdeye=2,solana=1"
+	UpstreamCallsHeader = "X-Upstream-Calls"
+)
+
+// UpstreamCallsInterceptor reports the external API calls a unary request
+// caused in UpstreamCallsHeader when the client sets ReportUpstreamCallsHeader.
+// Calls made by background work detached from the request are not counted.
+func UpstreamCallsInterceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if req.Header().Get(ReportUpstreamCallsHeader) != "true" {
+				return next(ctx, req)
+			}
+
+			ctx, counts := tracker.WithCallCounts(ctx)
+			resp, err := next(ctx, req)
+			if err != nil {
+				var connectErr *connect.Error
+				if errors.As(err, &connectErr) {
+					connectErr.Meta().Set(UpstreamCallsHeader, counts.String())
+				}
+				return resp, err
+			}
+			resp.Header().Set(UpstreamCallsHeader, counts.String())
+			return resp, nil
+		}
+	}
+}
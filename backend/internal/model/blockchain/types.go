@@ -1,6 +1,10 @@
 package blockchain
 
-import "strings"
+import (
+	"fmt"
+	"strings"
+	"time"
+)
 
 // BlockchainTransactionStatus represents the status of a blockchain transaction
 type BlockchainTransactionStatus int
@@ -56,6 +60,42 @@ func ParseBlockchainTransactionStatus(status string) BlockchainTransactionStatus
 	}
 }
 
+// Commitment levels, from fastest to most settled. Reads and preflight checks
+// at a level only see state that has reached it.
+const (
+	CommitmentProcessed = "processed"
+	CommitmentConfirmed = "confirmed"
+	CommitmentFinalized = "finalized"
+
+	// DefaultCommitment is used where no level is given. Confirmed is
+	// effectively final on Solana and lands 10-20s before finalized.
+	DefaultCommitment = CommitmentConfirmed
+)
+
+// ParseCommitment validates a commitment level; empty means DefaultCommitment
+func ParseCommitment(commitment string) (string, error) {
+	switch c := strings.ToLower(strings.TrimSpace(commitment)); c {
+	case "":
+		return DefaultCommitment, nil
+	case CommitmentProcessed, CommitmentConfirmed, CommitmentFinalized:
+		return c, nil
+	default:
+		return "", fmt.Errorf("unknown commitment %q: expected processed, confirmed or finalized", commitment)
+	}
+}
+
+// Reached reports whether a transaction status is at or past commitment
+func (s BlockchainTransactionStatus) Reached(commitment string) bool {
+	switch commitment {
+	case CommitmentProcessed:
+		return s == StatusProcessed || s == StatusConfirmed || s == StatusFinalized
+	case CommitmentConfirmed:
+		return s == StatusConfirmed || s == StatusFinalized
+	default:
+		return s == StatusFinalized
+	}
+}
+
 // Address represents a generic blockchain address.
 // For simplicity, using string. Could be a struct for more complex needs.
 type Address string
@@ -98,6 +138,12 @@ type TokenAccountInfo struct {
 	Amount      string  // Token amount as a string
 	Decimals    uint8   // Decimals for this token
 	UIAmount    float64 // User-friendly token amount
+	ProgramID   Address // Token program that owns the account (SPL Token or Token-2022)
+
+	// Delegate is the address allowed to move tokens out of this account, empty when none is approved
+	Delegate          Address
+	DelegatedAmount   string  // Raw amount the delegate may still transfer
+	DelegatedUIAmount float64 // User-friendly delegated amount
 }
 
 // TransactionInstruction represents a single instruction in a transaction.
@@ -155,7 +201,79 @@ type TokenAccountsOptions struct {
 	// If specific token mints are to be fetched for an owner, list them here.
 	// MintFilter []Address
 	// Encoding options if applicable and generic enough
-	Encoding string // e.g., "jsonParsed", "base64" (though specific encodings vary by chain)
+	Encoding   string  // e.g., "jsonParsed", "base64" (though specific encodings vary by chain)
+	Commitment string  // Empty means DefaultCommitment
+	ProgramID  Address // Token program whose accounts are listed; empty means the chain's default token program
+}
+
+// Stake account statuses
+const (
+	StakeInactive     = "inactive"     // Not delegated, or fully deactivated
+	StakeActivating   = "activating"   // Delegated this epoch; earns from the next one
+	StakeActive       = "active"       // Delegated and earning rewards
+	StakeDeactivating = "deactivating" // Deactivated this epoch; withdrawable from the next one
+)
+
+// StakeAccount is a native stake account.
+type StakeAccount struct {
+	Address        Address
+	Lamports       uint64  // Account balance, including the rent-exempt reserve
+	DelegatedStake uint64  // Lamports delegated to the validator, 0 when undelegated
+	Voter          Address // Vote account the stake is delegated to, empty when undelegated
+	Status         string  // One of the Stake statuses
+	LastReward     uint64  // Lamports earned in the previous epoch
+}
+
+// SimulationResult is the outcome of running a transaction against current
+// chain state without submitting it.
+type SimulationResult struct {
+	UnitsConsumed uint64   // Compute units the transaction used
+	Err           any      // Chain error the transaction failed with, nil on success
+	Logs          []string // Program logs, useful to explain Err
+}
+
+// SignatureInfo is a transaction that referenced an address.
+type SignatureInfo struct {
+	Signature Signature
+	Slot      uint64
+	BlockTime time.Time // Zero when the node does not know it
+	Err       any       // Chain error the transaction failed with, nil on success
+	Status    string    // Commitment the transaction has reached
+}
+
+// AddressActivity is a transaction that mentioned a watched address, as
+// streamed by a subscription.
+type AddressActivity struct {
+	Address   Address // The watched address the transaction mentioned
+	Signature Signature
+	Slot      uint64
+	Err       any // Chain error the transaction failed with, nil on success
+}
+
+// TokenBalance is a token account's balance before or after a transaction.
+type TokenBalance struct {
+	AccountIndex int // Index into TransactionDetail.Accounts
+	Mint         Address
+	Owner        Address
+	Amount       string // Base units
+	Decimals     uint8
+}
+
+// TransactionDetail is a processed transaction and the balance changes it made.
+type TransactionDetail struct {
+	Signature Signature
+	Slot      uint64
+	BlockTime time.Time
+	Err       any
+	Fee       uint64 // Lamports the fee payer, Accounts[0], was charged
+	// Accounts are the static keys followed by the writable and then the
+	// read-only keys loaded from lookup tables, the order balances index.
+	Accounts          []Address
+	PreBalances       []uint64 // Lamports, by account index
+	PostBalances      []uint64
+	PreTokenBalances  []TokenBalance
+	PostTokenBalances []TokenBalance
+	Memos             []string // Data of the top-level memo instructions
 }
 
 // PriceData represents generic price information for a token.
@@ -0,0 +1,30 @@
+package blockchain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCommitment(t *testing.T) {
+	commitment, err := ParseCommitment("")
+	require.NoError(t, err)
+	assert.Equal(t, DefaultCommitment, commitment)
+
+	commitment, err = ParseCommitment(" Finalized ")
+	require.NoError(t, err)
+	assert.Equal(t, CommitmentFinalized, commitment)
+
+	_, err = ParseCommitment("max")
+	assert.Error(t, err)
+}
+
+func TestStatusReached(t *testing.T) {
+	assert.True(t, StatusConfirmed.Reached(CommitmentConfirmed))
+	assert.True(t, StatusFinalized.Reached(CommitmentConfirmed))
+	assert.False(t, StatusProcessed.Reached(CommitmentConfirmed))
+	assert.False(t, StatusFailed.Reached(CommitmentProcessed))
+	assert.False(t, StatusConfirmed.Reached(CommitmentFinalized))
+	assert.True(t, ParseBlockchainTransactionStatus("Processed").Reached(CommitmentProcessed))
+}
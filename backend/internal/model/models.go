@@ -89,6 +89,10 @@ type Coin struct {
 	LogoURI     string   `json:"logoURI"` // Was: IconUrl (BirdEye uses logoURI)
 	Tags        []string `json:"tags,omitempty"`
 
+	// LogoSourceURL is where the logo was last fetched from, after redirects,
+	// so refetches skip the hops. Only Store.SetCoinLogoSource writes it.
+	LogoSourceURL string `json:"logoSourceURL,omitempty"`
+
 	// Price and Market Data (aligned with BirdEye)
 	Price                  float64 `json:"price"`
 	Price24hChangePercent  float64 `json:"price24hChangePercent,omitempty"`  // BirdEye standard
@@ -109,6 +113,22 @@ type Coin struct {
 	CreatedAt       string     `json:"created_at,omitempty"`        // System's created_at for enriched record
 	LastUpdated     string     `json:"last_updated,omitempty"`      // System's last_updated for enriched record
 	JupiterListedAt *time.Time `json:"jupiter_listed_at,omitempty"` // Time listed on Jupiter
+	Archived        bool       `json:"archived,omitempty"`          // Moved to the archive after going inactive
+
+	// MetadataSources records the source each metadata field was taken from,
+	// keyed by field name, as decided by the merge policy. Fields missing from
+	// it on a write are attributed to the source of the write.
+	MetadataSources map[string]string `json:"-"`
+
+	Deleted *Deletion `json:"deleted,omitempty"` // Set on soft-deleted coins, which only restore reads see
+}
+
+// Deletion records the soft deletion of a record, which hides it from reads
+// until it is restored or purged after the retention period
+type Deletion struct {
+	At     time.Time `json:"at"`
+	By     string    `json:"by,omitempty"`
+	Reason string    `json:"reason,omitempty"`
 }
 
 // GetID implements the Entity interface
@@ -129,6 +149,19 @@ type Trade struct {
 	Amount              float64 `json:"amount"`
 	OutputAmount        float64 `json:"output_amount"` // Amount of 'ToCoin' received in swaps
 
+	// Exact amounts in base units (lamports for SOL) as decimal strings. The float
+	// amounts above are for display and USD math only.
+	RawAmount       string `json:"raw_amount,omitempty"`
+	RawOutputAmount string `json:"raw_output_amount,omitempty"`
+
+	// Quote the prepared transaction was built from. It can't be submitted after
+	// QuoteExpiresAt; RefreshQuote prepares a new one from the same parameters.
+	QuoteID          string    `json:"quote_id,omitempty"`
+	QuoteExpiresAt   time.Time `json:"quote_expires_at,omitempty"`
+	QuoteSlippageBps string    `json:"quote_slippage_bps,omitempty"`
+	QuoteMultiHop    bool      `json:"quote_multi_hop,omitempty"`
+	QuoteRoute       string    `json:"quote_route,omitempty"` // DEXes the quote routed through, e.g. "Raydium>Orca"
+
 	// Fee Information
 	Fee            float64 `json:"fee"`                        // Total fee in USD
 	TotalFeeAmount float64 `json:"total_fee_amount,omitempty"` // Total fee amount in native units
@@ -150,6 +183,7 @@ type Trade struct {
 	TransactionHash     string    `json:"transaction_hash"`
 	UnsignedTransaction string    `json:"unsigned_transaction,omitempty"`
 	CreatedAt           time.Time `json:"created_at"`
+	SubmittedAt         time.Time `json:"submitted_at,omitempty"` // When the signed transaction was sent to the chain
 	CompletedAt         time.Time `json:"completed_at"`
 	Confirmations       int32     `json:"confirmations"`
 	Finalized           bool      `json:"finalized"`
@@ -157,6 +191,32 @@ type Trade struct {
 
 	FromAddress string `json:"fromAddress"`
 	ToAddress   string `json:"toAddress"`
+
+	// Transfers only: the on-chain memo and the Solana Pay reference keys
+	// attached to the transfer instruction
+	Memo       string   `json:"memo,omitempty"`
+	References []string `json:"references,omitempty"`
+
+	// Swaps only: the fill read back from the confirmed transaction.
+	// ReceiptAt is zero until it has been recorded.
+	ExecutedAmount       float64   `json:"executed_amount,omitempty"`        // Input actually spent
+	ExecutedOutputAmount float64   `json:"executed_output_amount,omitempty"` // Output actually received
+	ExecutedPlatformFee  float64   `json:"executed_platform_fee,omitempty"`  // Platform fee collected, in ExecutedFeeMint units
+	ExecutedFeeMint      string    `json:"executed_fee_mint,omitempty"`
+	NetworkFeeLamports   uint64    `json:"network_fee_lamports,omitempty"`
+	SlippageBps          float64   `json:"slippage_bps,omitempty"` // Shortfall of the executed price against the quoted one; negative when the fill beat the quote
+	ReceiptAt            time.Time `json:"receipt_at,omitempty"`
+
+	// Sponsored swaps only: the platform pays the network fees and rent, and
+	// SponsorFeeBps is added to the platform fee to recover them from the output
+	Sponsored           bool   `json:"sponsored,omitempty"`
+	SponsorFeeBps       int    `json:"sponsor_fee_bps,omitempty"`
+	SponsorCostLamports uint64 `json:"sponsor_cost_lamports,omitempty"` // Most the platform pays, with the priority fee at its cap
+
+	// Risk checks the user acknowledged to prepare the swap, carried over when it is re-quoted
+	AcknowledgedRisks []string `json:"acknowledged_risks,omitempty"`
+
+	Deleted *Deletion `json:"deleted,omitempty"`
 }
 
 // GetID implements the Entity interface
@@ -164,6 +224,27 @@ func (t Trade) GetID() string {
 	return fmt.Sprintf("%d", t.ID)
 }
 
+// MEVIncident is a suspected sandwich of one of our swaps: a single signer
+// traded the swap's pair against the same pool just before and just after it.
+type MEVIncident struct {
+	ID                string    `json:"id"` // The sandwiched transaction's signature
+	TradeID           uint      `json:"trade_id"`
+	Slot              uint64    `json:"slot"`
+	Attacker          string    `json:"attacker"` // Signer of the bracketing transactions
+	FrontRunSignature string    `json:"front_run_signature"`
+	BackRunSignature  string    `json:"back_run_signature"`
+	Pool              string    `json:"pool"`           // Pool token account all three traded against
+	LossMint          string    `json:"loss_mint"`      // The trade's input mint
+	EstimatedLoss     float64   `json:"estimated_loss"` // The attacker's profit in LossMint units, taken as the trader's loss
+	EstimatedLossUSD  float64   `json:"estimated_loss_usd"`
+	DetectedAt        time.Time `json:"detected_at"`
+}
+
+// GetID implements the Entity interface
+func (i MEVIncident) GetID() string {
+	return i.ID
+}
+
 // TradeRequest represents a request to execute a trade
 type TradeRequest struct {
 	FromCoinMintAddress string  `json:"from_coin_mint_address"` // Changed from FromCoinID
@@ -171,16 +252,86 @@ type TradeRequest struct {
 	Amount              float64 `json:"amount"`
 	SignedTransaction   string  `json:"signed_transaction"`
 	UnsignedTransaction string  `json:"unsigned_transaction"`
+	QuoteID             string  `json:"quote_id,omitempty"` // Optional; looked up instead of the unsigned transaction when set
 }
 
 // PrepareSwapRequestData represents the data required to prepare a swap transaction
 type PrepareSwapRequestData struct {
-	FromCoinMintAddress string `json:"from_coin_mint_address"`
-	ToCoinMintAddress   string `json:"to_coin_mint_address"`
-	Amount              string `json:"amount"` // Amount in smallest unit (e.g. lamports for SOL)
-	SlippageBps         string `json:"slippage_bps"`
-	UserWalletAddress   string `json:"user_wallet_address"`
-	AllowMultiHop       bool   `json:"allow_multi_hop"` // Allow routing through multiple pools
+	FromCoinMintAddress string   `json:"from_coin_mint_address"`
+	ToCoinMintAddress   string   `json:"to_coin_mint_address"`
+	Amount              string   `json:"amount"` // Amount in smallest unit (e.g. lamports for SOL)
+	SlippageBps         string   `json:"slippage_bps"`
+	UserWalletAddress   string   `json:"user_wallet_address"`
+	AllowMultiHop       bool     `json:"allow_multi_hop"`    // Allow routing through multiple pools
+	Paper               bool     `json:"paper"`              // Quote a paper trade against virtual balances
+	Sponsored           bool     `json:"sponsored"`          // Have the platform pay the network fees, recovered from the swap
+	QuoteID             string   `json:"-"`                  // Set by the order engine so a retried preparation can be recognized; generated when empty
+	AcknowledgedRisks   []string `json:"acknowledged_risks"` // Risk checks the user confirmed they accept, see trade.RiskPositionSize
+}
+
+// TradeWindow is a coin's trade activity over one rolling window
+type TradeWindow struct {
+	Window        string  `json:"window"` // "5m", "1h" or "24h"
+	Trades        int     `json:"trades"`
+	Buys          int     `json:"buys"`
+	Sells         int     `json:"sells"`
+	UniqueWallets int     `json:"unique_wallets"`
+	VolumeUSD     float64 `json:"volume_usd"`
+	BuyVolumeUSD  float64 `json:"buy_volume_usd"`
+	SellVolumeUSD float64 `json:"sell_volume_usd"`
+}
+
+// CoinTradeStats is a coin's recent buy/sell activity, shortest window first
+type CoinTradeStats struct {
+	Address   string        `json:"address"`
+	Windows   []TradeWindow `json:"windows"`
+	UpdatedAt time.Time     `json:"updated_at"`
+}
+
+// TopHolder is a wallet's net position in a coin, built from its recorded trades
+type TopHolder struct {
+	Wallet   string  `json:"wallet"`
+	Amount   float64 `json:"amount"`
+	ValueUSD float64 `json:"value_usd"`
+}
+
+// TopTrader is a wallet's profit on a coin from its recent trades
+type TopTrader struct {
+	Wallet           string  `json:"wallet"`
+	RealizedPnLUSD   float64 `json:"realized_pnl_usd"`
+	UnrealizedPnLUSD float64 `json:"unrealized_pnl_usd"`
+	VolumeUSD        float64 `json:"volume_usd"`
+	Trades           int     `json:"trades"`
+}
+
+// TotalPnLUSD returns realized plus unrealized profit
+func (t TopTrader) TotalPnLUSD() float64 {
+	return t.RealizedPnLUSD + t.UnrealizedPnLUSD
+}
+
+// CoinLeaderboard ranks the wallets trading a coin, largest first
+type CoinLeaderboard struct {
+	Address    string        `json:"address"`
+	Holders    []TopHolder   `json:"holders"`
+	Traders    []TopTrader   `json:"traders"`
+	Window     time.Duration `json:"window"` // Lookback for Traders
+	ComputedAt time.Time     `json:"computed_at"`
+}
+
+// MarketTotals are aggregates over the memecoins tracked in the database
+type MarketTotals struct {
+	Volume24hUSD   float64 `json:"volume24hUSD"`
+	NewListings24h int     `json:"new_listings_24h"`
+}
+
+// MarketOverview is the home screen summary, computed periodically
+type MarketOverview struct {
+	SolPrice                 float64      `json:"sol_price"`
+	SolPrice24hChangePercent float64      `json:"sol_price24h_change_percent"`
+	Totals                   MarketTotals `json:"totals"`
+	TopGainers               []Coin       `json:"top_gainers"`
+	TopLosers                []Coin       `json:"top_losers"`
+	ComputedAt               time.Time    `json:"computed_at"`
 }
 
 // FilterAndSortCoins filters and sorts a list of coins based on search criteria
@@ -398,3 +549,736 @@ type NaughtyWord struct {
 func (nw NaughtyWord) GetID() string {
 	return nw.Word
 }
+
+// WebhookSubscription is a partner endpoint registered to receive outbound event webhooks.
+type WebhookSubscription struct {
+	ID            string    `json:"id"`
+	PartnerName   string    `json:"partner_name"`
+	URL           string    `json:"url"`
+	Secret        string    `json:"-"` // HMAC signing secret, never serialized
+	EventTypes    []string  `json:"event_types"`
+	WalletAddress string    `json:"wallet_address,omitempty"` // Optional filter for trade events
+	CoinAddress   string    `json:"coin_address,omitempty"`   // Optional filter for price alerts
+	Active        bool      `json:"active"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+	Deleted       *Deletion `json:"deleted,omitempty"`
+}
+
+// GetID implements the Entity interface
+func (w WebhookSubscription) GetID() string {
+	return w.ID
+}
+
+// WebhookDeadLetter records a webhook delivery that failed after all retries.
+type WebhookDeadLetter struct {
+	ID             uint      `json:"id"`
+	SubscriptionID string    `json:"subscription_id"`
+	EventID        string    `json:"event_id"`
+	EventType      string    `json:"event_type"`
+	Payload        string    `json:"payload"`
+	Attempts       int       `json:"attempts"`
+	LastError      string    `json:"last_error"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// GetID implements the Entity interface
+func (d WebhookDeadLetter) GetID() string {
+	return fmt.Sprintf("%d", d.ID)
+}
+
+// JobCheckpoint records the progress of a background job so it can resume after a restart.
+type JobCheckpoint struct {
+	ID        string    `json:"id"`     // Job name
+	Cursor    string    `json:"cursor"` // Job-specific progress marker
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// GetID implements the Entity interface
+func (j JobCheckpoint) GetID() string {
+	return j.ID
+}
+
+// JobRun records one attempt of a scheduled background job.
+type JobRun struct {
+	ID         string        `json:"id"`
+	Job        string        `json:"job"`
+	Attempt    int           `json:"attempt"` // 1 for the scheduled run, then each retry
+	Status     string        `json:"status"`  // running, succeeded, failed or panicked
+	Error      string        `json:"error,omitempty"`
+	StartedAt  time.Time     `json:"started_at"`
+	FinishedAt *time.Time    `json:"finished_at,omitempty"`
+	Duration   time.Duration `json:"duration"`
+}
+
+// GetID implements the Entity interface
+func (r JobRun) GetID() string {
+	return r.ID
+}
+
+// Setting is a runtime override for a configuration value, keyed by setting name.
+type Setting struct {
+	ID        string    `json:"id"`    // Setting name
+	Value     string    `json:"value"` // Raw value, parsed by the settings key
+	UpdatedAt time.Time `json:"updated_at"`
+	UpdatedBy string    `json:"updated_by"`
+}
+
+// GetID implements the Entity interface
+func (s Setting) GetID() string {
+	return s.ID
+}
+
+// FeatureFlag gates a feature for a subset of users.
+// A user sees the feature when the flag is enabled and they are either on the
+// allow list or fall inside the rollout percentage.
+type FeatureFlag struct {
+	ID             string    `json:"id"` // Flag key
+	Description    string    `json:"description"`
+	Enabled        bool      `json:"enabled"`         // Kill switch; false disables the flag for everyone
+	RolloutPercent int       `json:"rollout_percent"` // 0-100
+	AllowList      []string  `json:"allow_list"`      // Identities that always get the feature
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// GetID implements the Entity interface
+func (f FeatureFlag) GetID() string {
+	return f.ID
+}
+
+// Spam list verdicts
+const (
+	SpamVerdictDeny  = "deny"  // Always treat the mint as spam
+	SpamVerdictAllow = "allow" // Never treat the mint as spam, whatever the heuristics say
+)
+
+// SpamToken is an admin override for the wallet spam classifier, keyed by mint address.
+type SpamToken struct {
+	ID        string    `json:"id"`      // Mint address
+	Verdict   string    `json:"verdict"` // SpamVerdictDeny or SpamVerdictAllow
+	Reason    string    `json:"reason"`
+	UpdatedBy string    `json:"updated_by"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// GetID implements the Entity interface
+func (s SpamToken) GetID() string {
+	return s.ID
+}
+
+// BlocklistSourceAdmin is the source recorded for blocklist entries made by admins
+const BlocklistSourceAdmin = "admin"
+
+// BlockedMint is a scam blocklist entry for a mint, attributed to the feed it came from.
+// Admin entries may instead allow a mint, overriding every feed that blocks it.
+type BlockedMint struct {
+	ID        string    `json:"id"` // "<source>:<mint>"
+	Mint      string    `json:"mint"`
+	Source    string    `json:"source"` // Feed name, or BlocklistSourceAdmin
+	Reason    string    `json:"reason"`
+	Allow     bool      `json:"allow"` // Admin override; only honoured for BlocklistSourceAdmin
+	UpdatedBy string    `json:"updated_by"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// GetID implements the Entity interface
+func (b BlockedMint) GetID() string {
+	return b.ID
+}
+
+// Sources of coin metadata changes
+const (
+	CoinSourceBirdeye  = "birdeye"
+	CoinSourceJupiter  = "jupiter"
+	CoinSourceMetaplex = "metaplex" // On-chain token metadata and the off-chain JSON it points to
+	CoinSourceAdmin    = "admin"
+)
+
+// CoinRevision is a change to one metadata field of a coin
+type CoinRevision struct {
+	ID          string    `json:"id"`
+	CoinAddress string    `json:"coin_address"`
+	Field       string    `json:"field"`              // e.g. "name", "logo_uri" or "description:fr"
+	Previous    string    `json:"previous,omitempty"` // Empty when the field was unset
+	Current     string    `json:"current,omitempty"`  // Empty when the field was cleared
+	Source      string    `json:"source"`
+	ChangedAt   time.Time `json:"changed_at"`
+}
+
+// GetID implements the Entity interface
+func (r CoinRevision) GetID() string {
+	return r.ID
+}
+
+// SearchSynonym makes coin search treat a term as another query, e.g.
+// "dogwifhat" as "$WIF"
+type SearchSynonym struct {
+	ID        string    `json:"id"` // Lowercased term
+	Target    string    `json:"target"`
+	UpdatedBy string    `json:"updated_by"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// GetID implements the Entity interface
+func (s SearchSynonym) GetID() string {
+	return s.ID
+}
+
+// CoinView counts a viewer's views of a coin's detail page. Viewer is a hash
+// of the caller's identity, so the table never holds wallet addresses.
+type CoinView struct {
+	ID           string    `json:"id"` // "<viewer>:<coin_address>"
+	Viewer       string    `json:"viewer"`
+	CoinAddress  string    `json:"coin_address"`
+	Views        int       `json:"views"`
+	LastViewedAt time.Time `json:"last_viewed_at"`
+}
+
+// GetID implements the Entity interface
+func (v CoinView) GetID() string {
+	return v.ID
+}
+
+// PaperBalance is a wallet's virtual holding of a mint in paper trading.
+// Amounts are in UI units.
+type PaperBalance struct {
+	ID             string    `json:"id"` // "<wallet_address>:<mint>"
+	WalletAddress  string    `json:"wallet_address"`
+	Mint           string    `json:"mint"`
+	Amount         float64   `json:"amount"`
+	CostBasisUSD   float64   `json:"cost_basis_usd"`   // USD paid for the amount still held
+	RealizedPnLUSD float64   `json:"realized_pnl_usd"` // Profit taken selling this mint
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// GetID implements the Entity interface
+func (b PaperBalance) GetID() string {
+	return b.ID
+}
+
+// PaperTrade is a simulated swap against a wallet's paper balances, filled at
+// the quote it was prepared with. Amounts are in UI units.
+type PaperTrade struct {
+	ID                  string    `json:"id"`
+	WalletAddress       string    `json:"wallet_address"`
+	QuoteID             string    `json:"quote_id"`
+	FromCoinMintAddress string    `json:"from_coin_mint_address"`
+	ToCoinMintAddress   string    `json:"to_coin_mint_address"`
+	Amount              float64   `json:"amount"`
+	OutputAmount        float64   `json:"output_amount"`
+	RawAmount           string    `json:"raw_amount"`
+	RawOutputAmount     string    `json:"raw_output_amount"`
+	FromUSDPrice        float64   `json:"from_usd_price"`
+	ToUSDPrice          float64   `json:"to_usd_price"`
+	Status              string    `json:"status"`
+	QuoteExpiresAt      time.Time `json:"quote_expires_at"`
+	CreatedAt           time.Time `json:"created_at"`
+	FilledAt            time.Time `json:"filled_at"`
+}
+
+// GetID implements the Entity interface
+func (t PaperTrade) GetID() string {
+	return t.ID
+}
+
+// Fee ledger entry kinds
+const (
+	FeeLedgerSponsorCost     = "sponsor_cost"     // SOL the platform spent as a sponsored swap's fee payer
+	FeeLedgerSponsorRecovery = "sponsor_recovery" // The part of a sponsored swap's platform fee that repays it
+)
+
+// FeeLedgerEntry is one movement of platform money. Amount is signed, in UI
+// units of Mint: negative when the platform pays, positive when it collects.
+type FeeLedgerEntry struct {
+	ID              string    `json:"id"`
+	WalletAddress   string    `json:"wallet_address"`
+	TradeID         uint      `json:"trade_id"`
+	Kind            string    `json:"kind"`
+	Mint            string    `json:"mint"`
+	Amount          float64   `json:"amount"`
+	AmountUSD       float64   `json:"amount_usd"`
+	TransactionHash string    `json:"transaction_hash,omitempty"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+// GetID implements the Entity interface
+func (e FeeLedgerEntry) GetID() string {
+	return e.ID
+}
+
+// TWAP order and slice statuses
+const (
+	TwapOrderActive    = "active"    // Slices are still due or in flight
+	TwapOrderCompleted = "completed" // Every slice filled, failed or expired
+	TwapOrderCancelled = "cancelled"
+
+	TwapSlicePending   = "pending"   // Not yet due
+	TwapSlicePrepared  = "prepared"  // Quoted and waiting for the user to sign
+	TwapSliceSubmitted = "submitted" // Sent, waiting to finalize
+	TwapSliceFilled    = "filled"
+	TwapSliceFailed    = "failed"  // Couldn't be prepared, or failed on chain
+	TwapSliceExpired   = "expired" // Not signed before its quote expired
+	TwapSliceCancelled = "cancelled"
+)
+
+// TwapOrder splits a large swap into Slices equal swaps spaced Interval
+// apart. Amounts are in base units of the from coin, except OutputAmount,
+// which is in UI units of the to coin.
+type TwapOrder struct {
+	ID                  string        `json:"id"`
+	WalletAddress       string        `json:"wallet_address"`
+	FromCoinMintAddress string        `json:"from_coin_mint_address"`
+	ToCoinMintAddress   string        `json:"to_coin_mint_address"`
+	RawAmount           string        `json:"raw_amount"`
+	Slices              int           `json:"slices"`
+	Interval            time.Duration `json:"interval"`
+	SlippageBps         string        `json:"slippage_bps"`
+	AllowMultiHop       bool          `json:"allow_multi_hop"`
+	Status              string        `json:"status"`
+	FilledSlices        int           `json:"filled_slices"`
+	FilledRawAmount     string        `json:"filled_raw_amount"`
+	OutputAmount        float64       `json:"output_amount"`
+	NextSliceAt         time.Time     `json:"next_slice_at,omitempty"` // Zero once no slice is pending
+	CreatedAt           time.Time     `json:"created_at"`
+	UpdatedAt           time.Time     `json:"updated_at"`
+}
+
+// GetID implements the Entity interface
+func (o TwapOrder) GetID() string {
+	return o.ID
+}
+
+// TwapSlice is one swap of a TwapOrder. Once due it is prepared like any
+// swap, and the user signs and submits it by QuoteID. The QuoteID is assigned
+// when the slice is first claimed, before it is prepared, so a preparation cut
+// short by a crash is found again rather than repeated.
+type TwapSlice struct {
+	ID                  string    `json:"id"` // "<order id>:<index>"
+	OrderID             string    `json:"order_id"`
+	Index               int       `json:"index"`
+	RawAmount           string    `json:"raw_amount"`
+	Status              string    `json:"status"`
+	DueAt               time.Time `json:"due_at"`
+	QuoteID             string    `json:"quote_id,omitempty"`
+	UnsignedTransaction string    `json:"unsigned_transaction,omitempty"`
+	QuoteExpiresAt      time.Time `json:"quote_expires_at,omitempty"`
+	TransactionHash     string    `json:"transaction_hash,omitempty"`
+	OutputAmount        float64   `json:"output_amount,omitempty"` // UI units of the to coin
+	Error               string    `json:"error,omitempty"`
+	UpdatedAt           time.Time `json:"updated_at"`
+
+	// Set while a worker is executing the slice; see db.TwapClaim
+	ClaimedBy      string    `json:"-"`
+	LeaseExpiresAt time.Time `json:"-"`
+	Attempts       int       `json:"-"` // Times the slice has been claimed
+}
+
+// GetID implements the Entity interface
+func (s TwapSlice) GetID() string {
+	return s.ID
+}
+
+// Audit log actions
+const (
+	AuditRiskOverride = "trade.risk_override"  // A swap was prepared past risk checks the user acknowledged
+	AuditRegionDenied = "access.region_denied" // A request was refused by the region access policy
+)
+
+// AuditEntry records an action taken by or on behalf of a wallet that may
+// need accounting for later
+type AuditEntry struct {
+	ID            string    `json:"id"`
+	WalletAddress string    `json:"wallet_address"`
+	Action        string    `json:"action"`
+	Subject       string    `json:"subject,omitempty"` // What the action was on, e.g. a quote ID
+	Details       string    `json:"details,omitempty"` // JSON, specific to the action
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// GetID implements the Entity interface
+func (e AuditEntry) GetID() string {
+	return e.ID
+}
+
+// TradeLimit overrides the notional trade limits of one wallet. Zero limits
+// use the configured ones.
+type TradeLimit struct {
+	ID             string    `json:"id"` // Wallet address
+	DailyLimitUSD  float64   `json:"daily_limit_usd"`
+	WeeklyLimitUSD float64   `json:"weekly_limit_usd"`
+	Exempt         bool      `json:"exempt"` // No limits apply to the wallet
+	Reason         string    `json:"reason"`
+	UpdatedBy      string    `json:"updated_by"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// GetID implements the Entity interface
+func (l TradeLimit) GetID() string {
+	return l.ID
+}
+
+// TradeVolume counts the USD notional of the swaps a wallet submitted in one
+// UTC day
+type TradeVolume struct {
+	ID            string    `json:"id"` // "<wallet>:<YYYY-MM-DD>"
+	WalletAddress string    `json:"wallet_address"`
+	Day           time.Time `json:"day"`
+	NotionalUSD   float64   `json:"notional_usd"`
+	Trades        int       `json:"trades"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// GetID implements the Entity interface
+func (v TradeVolume) GetID() string {
+	return v.ID
+}
+
+// ImageHash is the perceptual hash of a mint's icon and the S3 object serving it,
+// which belongs to another mint when the icon duplicates one already stored.
+type ImageHash struct {
+	ID          string    `json:"id"`   // Mint address
+	Hash        uint64    `json:"hash"` // Zero for formats that can't be hashed, e.g. WebP and SVG
+	ObjectKey   string    `json:"object_key"`
+	ContentType string    `json:"content_type"` // From the image's magic number
+	Width       int       `json:"width"`
+	Height      int       `json:"height"` // Zero, like Width, for SVGs that don't give a size
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// GetID implements the Entity interface
+func (h ImageHash) GetID() string {
+	return h.ID
+}
+
+// PlaceholderIcon records a coin served a generated placeholder icon because
+// its own couldn't be fetched or failed validation, so the upload is retried.
+type PlaceholderIcon struct {
+	ID          string    `json:"id"`           // Mint address
+	OriginalURL string    `json:"original_url"` // Empty for coins without a logo
+	Reason      string    `json:"reason"`       // Why the last upload failed
+	Attempts    int       `json:"attempts"`     // Failed uploads so far
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// GetID implements the Entity interface
+func (p PlaceholderIcon) GetID() string {
+	return p.ID
+}
+
+// CoinOverride is an admin-set value for a coin metadata field. It wins over
+// every provider until removed.
+type CoinOverride struct {
+	ID          string    `json:"id"` // "<coin_address>:<field>"
+	CoinAddress string    `json:"coin_address"`
+	Field       string    `json:"field"`
+	Value       string    `json:"value"`
+	UpdatedBy   string    `json:"updated_by"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// GetID implements the Entity interface
+func (o CoinOverride) GetID() string {
+	return o.ID
+}
+
+// Sources a coin description can come from
+const (
+	CoinDescriptionSourceMetadata = "metadata"
+	CoinDescriptionSourceAdmin    = "admin"
+)
+
+// CoinDescription is a coin's project description in one locale. Admin edits are
+// never overwritten by metadata refreshes.
+type CoinDescription struct {
+	ID          string    `json:"id"` // "<coin_address>:<locale>"
+	CoinAddress string    `json:"coin_address"`
+	Locale      string    `json:"locale"` // Lowercase BCP 47 tag, e.g. "en" or "pt-br"
+	Description string    `json:"description"`
+	Source      string    `json:"source"`
+	UpdatedBy   string    `json:"updated_by"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// GetID implements the Entity interface
+func (c CoinDescription) GetID() string {
+	return c.ID
+}
+
+// Payment request statuses
+const (
+	PaymentStatusPending = "pending"
+	PaymentStatusPaid    = "paid"
+	PaymentStatusExpired = "expired"
+)
+
+// PaymentRequest is a Solana Pay request for a merchant. The payer's transfer
+// carries Reference, which is how the payment is found on chain.
+type PaymentRequest struct {
+	ID        string    `json:"id"`
+	Recipient string    `json:"recipient"`
+	Mint      string    `json:"mint,omitempty"` // SPL token paid in; empty for SOL
+	Amount    string    `json:"amount"`         // Decimal amount in token units, e.g. "1.5"
+	Reference string    `json:"reference"`
+	Label     string    `json:"label,omitempty"`
+	Message   string    `json:"message,omitempty"`
+	Memo      string    `json:"memo,omitempty"`
+	Status    string    `json:"status"`
+	Signature string    `json:"signature,omitempty"` // Transaction that paid it
+	Payer     string    `json:"payer,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+	PaidAt    time.Time `json:"paid_at,omitempty"`
+}
+
+// GetID implements the Entity interface
+func (p PaymentRequest) GetID() string {
+	return p.ID
+}
+
+// Burn kinds
+const (
+	BurnKindSupply    = "supply"    // Tokens of the coin itself were burned
+	BurnKindLiquidity = "liquidity" // LP tokens of one of the coin's pools were burned
+)
+
+// BurnWatch is the burn checker's state for one watched mint: the coin's own
+// mint, or the LP mint of one of the coin's pools
+type BurnWatch struct {
+	Mint           string    `json:"mint"`
+	CoinAddress    string    `json:"coin_address"`
+	Kind           string    `json:"kind"`
+	Pool           string    `json:"pool,omitempty"` // Pool the LP mint belongs to; empty for supply
+	Decimals       int       `json:"decimals"`
+	Supply         string    `json:"supply"`         // Last observed supply in base units
+	Burned         string    `json:"burned"`         // Burned so far in base units
+	BurnedPercent  float64   `json:"burned_percent"` // Burned as a share of everything minted
+	LastBurnAt     time.Time `json:"last_burn_at,omitempty"`
+	CheckedAt      time.Time `json:"checked_at"`
+	PoolsCheckedAt time.Time `json:"pools_checked_at,omitempty"` // Supply watches only: last pool discovery
+	Deleted        *Deletion `json:"deleted,omitempty"`
+}
+
+// GetID implements the Entity interface
+func (w BurnWatch) GetID() string {
+	return w.Mint
+}
+
+// BurnEvent records a burn the checker detected
+type BurnEvent struct {
+	ID            string    `json:"id"`
+	CoinAddress   string    `json:"coin_address"`
+	Kind          string    `json:"kind"`
+	Mint          string    `json:"mint"`           // Burned mint: the coin, or a pool's LP mint
+	Pool          string    `json:"pool,omitempty"` // Liquidity burns only
+	Amount        string    `json:"amount"`         // Burned in base units
+	Decimals      int       `json:"decimals"`
+	BurnedPercent float64   `json:"burned_percent"` // Total burned after this event
+	DetectedAt    time.Time `json:"detected_at"`
+}
+
+// GetID implements the Entity interface
+func (e BurnEvent) GetID() string {
+	return e.ID
+}
+
+// Authorities a mint can hold
+const (
+	AuthorityMint   = "mint"   // Can mint more supply
+	AuthorityFreeze = "freeze" // Can freeze holders' token accounts
+)
+
+// MintAuthority is the last observed authorities of a coin's mint. An empty
+// authority has been revoked.
+type MintAuthority struct {
+	Mint            string    `json:"mint"`
+	MintAuthority   string    `json:"mint_authority,omitempty"`
+	FreezeAuthority string    `json:"freeze_authority,omitempty"`
+	SafetyScore     int       `json:"safety_score"` // 0-100, lower when authorities are held
+	ChangedAt       time.Time `json:"changed_at,omitempty"`
+	CheckedAt       time.Time `json:"checked_at"`
+}
+
+// GetID implements the Entity interface
+func (a MintAuthority) GetID() string {
+	return a.Mint
+}
+
+// AuthorityChange records a mint or freeze authority being revoked, set or
+// handed to someone else
+type AuthorityChange struct {
+	ID          string    `json:"id"`
+	CoinAddress string    `json:"coin_address"`
+	Authority   string    `json:"authority"`          // AuthorityMint or AuthorityFreeze
+	Previous    string    `json:"previous,omitempty"` // Empty when it had been revoked
+	Current     string    `json:"current,omitempty"`  // Empty when it is now revoked
+	SafetyScore int       `json:"safety_score"`       // Score after the change
+	DetectedAt  time.Time `json:"detected_at"`
+}
+
+// GetID implements the Entity interface
+func (c AuthorityChange) GetID() string {
+	return c.ID
+}
+
+// Notification types a wallet can receive
+const (
+	NotificationTransferReceived = "transfer_received"
+	NotificationTradeConfirmed   = "trade_confirmed"
+	NotificationTokenDump        = "token_dump"
+	NotificationPriceAlert       = "price_alert"
+	NotificationAnnouncement     = "announcement"
+)
+
+// Notification channels
+const (
+	NotificationChannelPush = "push"
+	NotificationChannelNone = "none" // Nothing is sent, e.g. while the app handles it in-app
+)
+
+// NotificationPreferences are a wallet's push notification settings and the
+// devices they are delivered to
+type NotificationPreferences struct {
+	WalletAddress        string   `json:"wallet_address"`
+	DeviceTokens         []string `json:"device_tokens,omitempty"`  // FCM registration tokens
+	Channel              string   `json:"channel"`                  // NotificationChannelPush or NotificationChannelNone
+	DisabledTypes        []string `json:"disabled_types,omitempty"` // Notification types the wallet opted out of
+	TransferThresholdUSD float64  `json:"transfer_threshold_usd"`
+	DumpThresholdPercent float64  `json:"dump_threshold_percent"`
+	// Quiet hours as "15:04" in Timezone; nothing is sent from start until
+	// end, which may be past midnight. Empty when not set.
+	QuietHoursStart string    `json:"quiet_hours_start,omitempty"`
+	QuietHoursEnd   string    `json:"quiet_hours_end,omitempty"`
+	Timezone        string    `json:"timezone,omitempty"` // IANA name, UTC when empty
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// GetID implements the Entity interface
+func (p NotificationPreferences) GetID() string {
+	return p.WalletAddress
+}
+
+// Notification delivery statuses
+const (
+	NotificationDeliverySent       = "sent"
+	NotificationDeliveryFailed     = "failed"
+	NotificationDeliverySuppressed = "suppressed" // Held back by the wallet's preferences
+)
+
+// NotificationDelivery records one notification sent, or held back, for a wallet.
+type NotificationDelivery struct {
+	ID            string    `json:"id"`
+	WalletAddress string    `json:"wallet_address"`
+	Type          string    `json:"type"`
+	Title         string    `json:"title"`
+	Body          string    `json:"body"`
+	Status        string    `json:"status"`
+	Detail        string    `json:"detail,omitempty"` // Why it failed or was suppressed
+	Devices       int       `json:"devices"`          // Devices it was sent to
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// GetID implements the Entity interface
+func (d NotificationDelivery) GetID() string {
+	return d.ID
+}
+
+// Announcement severities
+const (
+	AnnouncementSeverityInfo     = "info"
+	AnnouncementSeverityWarning  = "warning"
+	AnnouncementSeverityCritical = "critical"
+)
+
+// Announcement is an in-app banner or changelog entry written by an admin.
+type Announcement struct {
+	ID       string `json:"id"`
+	Title    string `json:"title"`
+	Body     string `json:"body"`
+	Severity string `json:"severity"`
+	// Only app versions in [MinAppVersion, MaxAppVersion] see it; empty bounds are open
+	MinAppVersion string    `json:"min_app_version,omitempty"`
+	MaxAppVersion string    `json:"max_app_version,omitempty"`
+	StartsAt      time.Time `json:"starts_at"`
+	EndsAt        time.Time `json:"ends_at,omitempty"` // Zero when it never expires
+	CreatedBy     string    `json:"created_by,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// GetID implements the Entity interface
+func (a Announcement) GetID() string {
+	return a.ID
+}
+
+// AnnouncementRead records that a wallet has read an announcement.
+type AnnouncementRead struct {
+	ID             string    `json:"id"` // "<announcement_id>:<wallet_address>"
+	AnnouncementID string    `json:"announcement_id"`
+	WalletAddress  string    `json:"wallet_address"`
+	ReadAt         time.Time `json:"read_at"`
+}
+
+// GetID implements the Entity interface
+func (r AnnouncementRead) GetID() string {
+	return r.ID
+}
+
+// Data deletion statuses
+const (
+	DataDeletionQueued    = "queued"
+	DataDeletionCompleted = "completed"
+	DataDeletionFailed    = "failed" // Gave up after repeated attempts; needs an operator
+)
+
+// DataDeletion is a user's queued request to erase their personal data. It
+// is removed once the data is erased, leaving its DeletionCertificate.
+type DataDeletion struct {
+	ID            string    `json:"id"`
+	WalletAddress string    `json:"wallet_address"`
+	Status        string    `json:"status"`
+	Attempts      int       `json:"attempts"`
+	Error         string    `json:"error,omitempty"` // Why the last attempt failed
+	RequestedAt   time.Time `json:"requested_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// GetID implements the Entity interface
+func (d DataDeletion) GetID() string {
+	return d.ID
+}
+
+// DeletionCertificate attests that a user's personal data was erased and
+// verified gone. It identifies the user only by a hash of their wallet, so
+// they can prove the erasure without it keeping their data.
+type DeletionCertificate struct {
+	ID          string           `json:"id"` // The DataDeletion's ID
+	SubjectHash string           `json:"subject_hash"`
+	Erased      map[string]int64 `json:"erased"` // Rows erased by kind of data
+	RequestedAt time.Time        `json:"requested_at"`
+	CompletedAt time.Time        `json:"completed_at"`
+}
+
+// GetID implements the Entity interface
+func (c DeletionCertificate) GetID() string {
+	return c.ID
+}
+
+// PricePoint is one stored price sample. Resolution is the width of the bucket
+// the sample stands for; finer samples are averaged into coarser ones as they age.
+type PricePoint struct {
+	Address    string        `json:"address"`
+	Resolution time.Duration `json:"resolution"`
+	Time       time.Time     `json:"time"`
+	Value      float64       `json:"value"`
+}
+
+// PriceHistoryRange records a span of price history already fetched upstream at
+// Resolution, so stored history can be told apart from gaps even where the token
+// has no data, e.g. before it launched
+type PriceHistoryRange struct {
+	Address    string        `json:"address"`
+	Resolution time.Duration `json:"resolution"`
+	From       time.Time     `json:"from"`
+	To         time.Time     `json:"to"`
+}
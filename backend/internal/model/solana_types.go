@@ -40,7 +40,7 @@ func ToRPCCommitment(commitment string) rpc.CommitmentType {
 	case "finalized":
 		return rpc.CommitmentFinalized
 	default:
-		// Default to confirmed, or handle error appropriately in client
+		// Empty or unknown levels use blockchain.DefaultCommitment
 		return rpc.CommitmentConfirmed
 	}
 }
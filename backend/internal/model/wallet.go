@@ -9,6 +9,7 @@ type Wallet struct {
 	ID        string
 	PublicKey string `json:"public_key"`
 	CreatedAt time.Time
+	Deleted   *Deletion `json:"deleted,omitempty"`
 }
 
 func (w Wallet) GetID() string {
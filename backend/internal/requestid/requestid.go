@@ -0,0 +1,69 @@
+// Package requestid carries a per-request correlation ID through the context
+// so one user report can be traced across logs, spans and upstream API calls.
+package requestid
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// Header carries the correlation ID on incoming requests, responses and
+// outgoing upstream calls
+const Header = "X-Request-ID"
+
+// maxLen bounds client-supplied IDs so they can't bloat every log line
+const maxLen = 128
+
+type contextKey struct{}
+
+// New returns a fresh correlation ID
+func New() string {
+	return uuid.NewString()
+}
+
+// WithID returns ctx carrying id
+func WithID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext returns the correlation ID in ctx, or "" outside a request
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(contextKey{}).(string)
+	return id
+}
+
+// Valid reports whether a client-supplied ID is safe to propagate: non-empty,
+// bounded and printable ASCII without spaces
+func Valid(id string) bool {
+	if id == "" || len(id) > maxLen {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] <= ' ' || id[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// Transport sets Header on outgoing requests whose context carries an ID
+type Transport struct {
+	Base http.RoundTripper
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	id := FromContext(req.Context())
+	if id == "" || req.Header.Get(Header) != "" {
+		return base.RoundTrip(req)
+	}
+	// RoundTrippers must not modify the caller's request
+	req = req.Clone(req.Context())
+	req.Header.Set(Header, id)
+	return base.RoundTrip(req)
+}
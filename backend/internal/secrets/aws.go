@@ -0,0 +1,48 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+)
+
+// AWSProvider reads secrets from AWS Secrets Manager using the default
+// credential chain.
+type AWSProvider struct {
+	client *secretsmanager.Client
+}
+
+// NewAWSProvider creates a provider for region. An empty region uses the SDK's
+// usual resolution (AWS_REGION, shared config, ...).
+func NewAWSProvider(ctx context.Context, region string) (*AWSProvider, error) {
+	var opts []func(*config.LoadOptions) error
+	if region != "" {
+		opts = append(opts, config.WithRegion(region))
+	}
+	awsCfg, err := config.LoadDefaultConfig(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS config: %w", err)
+	}
+	return &AWSProvider{client: secretsmanager.NewFromConfig(awsCfg)}, nil
+}
+
+// GetSecret returns the current version of the secret name (or ARN).
+func (p *AWSProvider) GetSecret(ctx context.Context, name string) (string, error) {
+	out, err := p.client.GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{SecretId: aws.String(name)})
+	if err != nil {
+		var notFound *types.ResourceNotFoundException
+		if errors.As(err, &notFound) {
+			return "", fmt.Errorf("%w: %s", ErrNotFound, name)
+		}
+		return "", fmt.Errorf("failed to get secret %s: %w", name, err)
+	}
+	if out.SecretString != nil {
+		return *out.SecretString, nil
+	}
+	return string(out.SecretBinary), nil
+}
@@ -0,0 +1,108 @@
+package secrets
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// DefaultRefreshInterval is how often cached secrets are re-read to pick up rotations.
+const DefaultRefreshInterval = 5 * time.Minute
+
+// CachingProvider caches secrets from an underlying provider and notifies
+// rotation hooks when a refreshed value differs from the cached one.
+type CachingProvider struct {
+	provider Provider
+
+	mu     sync.RWMutex
+	values map[string]string
+	hooks  map[string][]func(string)
+}
+
+var _ Provider = (*CachingProvider)(nil)
+
+// NewCachingProvider wraps provider with an in-memory cache.
+func NewCachingProvider(provider Provider) *CachingProvider {
+	return &CachingProvider{
+		provider: provider,
+		values:   make(map[string]string),
+		hooks:    make(map[string][]func(string)),
+	}
+}
+
+// GetSecret returns the cached value of name, fetching it on first use.
+func (c *CachingProvider) GetSecret(ctx context.Context, name string) (string, error) {
+	c.mu.RLock()
+	value, ok := c.values[name]
+	c.mu.RUnlock()
+	if ok {
+		return value, nil
+	}
+
+	value, err := c.provider.GetSecret(ctx, name)
+	if err != nil {
+		return "", err
+	}
+	c.mu.Lock()
+	c.values[name] = value
+	c.mu.Unlock()
+	return value, nil
+}
+
+// OnRotate registers fn to be called with the new value whenever name changes on refresh.
+func (c *CachingProvider) OnRotate(name string, fn func(value string)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.hooks[name] = append(c.hooks[name], fn)
+}
+
+// Refresh re-reads every cached secret and runs rotation hooks for changed values.
+// A secret that fails to refresh keeps its cached value.
+func (c *CachingProvider) Refresh(ctx context.Context) {
+	c.mu.RLock()
+	names := make([]string, 0, len(c.values))
+	for name := range c.values {
+		names = append(names, name)
+	}
+	c.mu.RUnlock()
+
+	for _, name := range names {
+		value, err := c.provider.GetSecret(ctx, name)
+		if err != nil {
+			slog.WarnContext(ctx, "Failed to refresh secret, keeping cached value", slog.String("secret", name), slog.Any("error", err))
+			continue
+		}
+
+		c.mu.Lock()
+		changed := c.values[name] != value
+		c.values[name] = value
+		hooks := append([]func(string){}, c.hooks[name]...)
+		c.mu.Unlock()
+
+		if changed {
+			slog.InfoContext(ctx, "Secret rotated", slog.String("secret", name), slog.Int("hooks", len(hooks)))
+			for _, fn := range hooks {
+				fn(value)
+			}
+		}
+	}
+}
+
+// Watch refreshes cached secrets every interval until ctx is cancelled.
+// An interval <= 0 uses DefaultRefreshInterval.
+func (c *CachingProvider) Watch(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultRefreshInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			c.Refresh(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
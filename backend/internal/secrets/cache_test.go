@@ -0,0 +1,71 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeProvider struct {
+	values map[string]string
+	calls  int
+	err    error
+}
+
+func (f *fakeProvider) GetSecret(_ context.Context, name string) (string, error) {
+	f.calls++
+	if f.err != nil {
+		return "", f.err
+	}
+	v, ok := f.values[name]
+	if !ok {
+		return "", ErrNotFound
+	}
+	return v, nil
+}
+
+func TestCachingProvider_CachesAndRunsRotationHooks(t *testing.T) {
+	ctx := context.Background()
+	backend := &fakeProvider{values: map[string]string{"key": "v1"}}
+	c := NewCachingProvider(backend)
+
+	var rotated []string
+	c.OnRotate("key", func(v string) { rotated = append(rotated, v) })
+
+	v, err := c.GetSecret(ctx, "key")
+	require.NoError(t, err)
+	assert.Equal(t, "v1", v)
+	_, _ = c.GetSecret(ctx, "key")
+	assert.Equal(t, 1, backend.calls, "second read is served from cache")
+
+	// Unchanged value does not fire hooks
+	c.Refresh(ctx)
+	assert.Empty(t, rotated)
+
+	backend.values["key"] = "v2"
+	c.Refresh(ctx)
+	assert.Equal(t, []string{"v2"}, rotated)
+	v, _ = c.GetSecret(ctx, "key")
+	assert.Equal(t, "v2", v)
+
+	// Backend failures keep the cached value
+	backend.err = errors.New("unavailable")
+	c.Refresh(ctx)
+	v, err = c.GetSecret(ctx, "key")
+	require.NoError(t, err)
+	assert.Equal(t, "v2", v)
+}
+
+func TestEnvProvider(t *testing.T) {
+	t.Setenv("DANKFOLIO_TEST_SECRET", "shh")
+
+	v, err := EnvProvider{}.GetSecret(context.Background(), "DANKFOLIO_TEST_SECRET")
+	require.NoError(t, err)
+	assert.Equal(t, "shh", v)
+
+	_, err = EnvProvider{}.GetSecret(context.Background(), "DANKFOLIO_TEST_SECRET_MISSING")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
@@ -0,0 +1,59 @@
+package secrets
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"google.golang.org/api/googleapi"
+	secretmanager "google.golang.org/api/secretmanager/v1"
+)
+
+// GCPProvider reads secrets from Google Cloud Secret Manager using
+// application default credentials.
+type GCPProvider struct {
+	projectID string
+	client    *secretmanager.Service
+}
+
+// NewGCPProvider creates a provider for secrets in projectID.
+func NewGCPProvider(ctx context.Context, projectID string) (*GCPProvider, error) {
+	if projectID == "" {
+		return nil, errors.New("gcp secrets backend requires a project ID")
+	}
+	client, err := secretmanager.NewService(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create secret manager client: %w", err)
+	}
+	return &GCPProvider{projectID: projectID, client: client}, nil
+}
+
+// GetSecret returns the latest version of name. name may be a short secret
+// name or a full "projects/.../secrets/.../versions/..." resource.
+func (p *GCPProvider) GetSecret(ctx context.Context, name string) (string, error) {
+	resource := name
+	if !strings.HasPrefix(name, "projects/") {
+		resource = fmt.Sprintf("projects/%s/secrets/%s/versions/latest", p.projectID, name)
+	}
+
+	resp, err := p.client.Projects.Secrets.Versions.Access(resource).Context(ctx).Do()
+	if err != nil {
+		var apiErr *googleapi.Error
+		if errors.As(err, &apiErr) && apiErr.Code == http.StatusNotFound {
+			return "", fmt.Errorf("%w: %s", ErrNotFound, name)
+		}
+		return "", fmt.Errorf("failed to access secret %s: %w", name, err)
+	}
+	if resp.Payload == nil {
+		return "", fmt.Errorf("secret %s has no payload", name)
+	}
+
+	data, err := base64.StdEncoding.DecodeString(resp.Payload.Data)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode secret %s payload: %w", name, err)
+	}
+	return string(data), nil
+}
@@ -0,0 +1,58 @@
+// Package secrets loads sensitive configuration (private keys, API keys) from
+// a secrets backend instead of plain environment variables.
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// ErrNotFound is returned when a secret does not exist in the backend.
+var ErrNotFound = errors.New("secret not found")
+
+// Provider resolves a secret by name.
+type Provider interface {
+	GetSecret(ctx context.Context, name string) (string, error)
+}
+
+// Backend names accepted by NewProvider
+const (
+	BackendEnv = "env"
+	BackendGCP = "gcp"
+	BackendAWS = "aws"
+)
+
+// Config selects and configures the secrets backend.
+type Config struct {
+	Backend      string // "env" (default), "gcp" or "aws"
+	GCPProjectID string // Required for the gcp backend
+	AWSRegion    string // Optional for the aws backend; defaults to the SDK's region resolution
+}
+
+// NewProvider creates the provider for cfg.Backend.
+func NewProvider(ctx context.Context, cfg Config) (Provider, error) {
+	switch cfg.Backend {
+	case "", BackendEnv:
+		return EnvProvider{}, nil
+	case BackendGCP:
+		return NewGCPProvider(ctx, cfg.GCPProjectID)
+	case BackendAWS:
+		return NewAWSProvider(ctx, cfg.AWSRegion)
+	default:
+		return nil, fmt.Errorf("unknown secrets backend %q", cfg.Backend)
+	}
+}
+
+// EnvProvider reads secrets from environment variables named after the secret.
+type EnvProvider struct{}
+
+// GetSecret returns the value of the environment variable name.
+func (EnvProvider) GetSecret(_ context.Context, name string) (string, error) {
+	value, ok := os.LookupEnv(name)
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrNotFound, name)
+	}
+	return value, nil
+}
@@ -0,0 +1,272 @@
+// Package announcement serves the in-app announcement and changelog feed.
+// Admins write announcements with a severity, an optional schedule and an
+// optional range of app versions; the app lists the ones that apply to it
+// and marks them read per wallet, so a banner no longer needs an app release.
+package announcement
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	"github.com/nicolas-martin/dankfolio/backend/internal/util"
+)
+
+const (
+	maxTitleLength = 200
+	maxBodyLength  = 10_000
+	// maxMarkRead bounds the announcements marked read in one call
+	maxMarkRead = 100
+)
+
+// Severities are the severities an announcement can have.
+var Severities = []string{
+	model.AnnouncementSeverityInfo,
+	model.AnnouncementSeverityWarning,
+	model.AnnouncementSeverityCritical,
+}
+
+// ErrInvalidAnnouncement is returned for announcements that fail validation.
+var ErrInvalidAnnouncement = errors.New("invalid announcement")
+
+// Item is an announcement as seen by one wallet.
+type Item struct {
+	model.Announcement
+	Read bool
+}
+
+// Params are the admin-editable fields of an announcement.
+type Params struct {
+	Title         string
+	Body          string
+	Severity      string // Defaults to info
+	MinAppVersion string
+	MaxAppVersion string
+	StartsAt      time.Time // Defaults to now
+	EndsAt        time.Time // Zero for no expiry
+}
+
+// Service manages announcements and which wallets have read them.
+type Service struct {
+	store db.Store
+	now   func() time.Time
+}
+
+// NewService creates a new announcement service
+func NewService(store db.Store) *Service {
+	return &Service{store: store, now: time.Now}
+}
+
+// Create stores a new announcement.
+func (s *Service) Create(ctx context.Context, params Params, createdBy string) (*model.Announcement, error) {
+	now := s.now()
+	announcement := &model.Announcement{
+		ID:        uuid.NewString(),
+		CreatedBy: createdBy,
+		CreatedAt: now,
+	}
+	if err := s.apply(announcement, params, now); err != nil {
+		return nil, err
+	}
+	if err := s.store.Announcements().Create(ctx, announcement); err != nil {
+		return nil, fmt.Errorf("failed to create announcement: %w", err)
+	}
+	return announcement, nil
+}
+
+// Update replaces the editable fields of an announcement. Wallets that
+// already read it keep it marked read.
+func (s *Service) Update(ctx context.Context, id string, params Params) (*model.Announcement, error) {
+	announcement, err := s.store.Announcements().Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get announcement %s: %w", id, err)
+	}
+	now := s.now()
+	if err := s.apply(announcement, params, now); err != nil {
+		return nil, err
+	}
+	if err := s.store.Announcements().Update(ctx, announcement); err != nil {
+		return nil, fmt.Errorf("failed to update announcement %s: %w", id, err)
+	}
+	return announcement, nil
+}
+
+// Delete removes an announcement. Its read records are left behind and
+// never match again.
+func (s *Service) Delete(ctx context.Context, id string) error {
+	if err := s.store.Announcements().HardDelete(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete announcement %s: %w", id, err)
+	}
+	return nil
+}
+
+// ListAll returns every announcement, newest first, including scheduled and
+// expired ones.
+func (s *Service) ListAll(ctx context.Context) ([]model.Announcement, error) {
+	sortBy, desc := "starts_at", true
+	announcements, _, err := s.store.Announcements().ListWithOpts(ctx, db.ListOptions{SortBy: &sortBy, SortDesc: &desc})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list announcements: %w", err)
+	}
+	return announcements, nil
+}
+
+// List returns the live announcements for an app version, newest first, with
+// the wallet's read state. Without an app version only announcements that do
+// not target versions are returned; without a wallet none are read.
+func (s *Service) List(ctx context.Context, walletAddress, appVersion string) ([]Item, error) {
+	if appVersion != "" && !util.IsValidVersion(appVersion) {
+		return nil, fmt.Errorf("%w: invalid app version %q", ErrInvalidAnnouncement, appVersion)
+	}
+	now := s.now()
+	sortBy, desc := "starts_at", true
+	announcements, _, err := s.store.Announcements().ListWithOpts(ctx, db.ListOptions{
+		SortBy:   &sortBy,
+		SortDesc: &desc,
+		Filters:  []db.FilterOption{{Field: "starts_at", Operator: db.FilterOpLessEqual, Value: now}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list announcements: %w", err)
+	}
+
+	items := make([]Item, 0, len(announcements))
+	ids := make([]string, 0, len(announcements))
+	for _, a := range announcements {
+		if !a.EndsAt.IsZero() && !now.Before(a.EndsAt) {
+			continue
+		}
+		if !targets(&a, appVersion) {
+			continue
+		}
+		items = append(items, Item{Announcement: a})
+		ids = append(ids, a.ID)
+	}
+	if walletAddress == "" || len(items) == 0 {
+		return items, nil
+	}
+
+	reads, _, err := s.store.AnnouncementReads().ListWithOpts(ctx, db.ListOptions{
+		Filters: []db.FilterOption{
+			{Field: "wallet_address", Operator: db.FilterOpEqual, Value: walletAddress},
+			{Field: "announcement_id", Operator: db.FilterOpIn, Value: ids},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list read announcements: %w", err)
+	}
+	read := make(map[string]bool, len(reads))
+	for _, r := range reads {
+		read[r.AnnouncementID] = true
+	}
+	for i := range items {
+		items[i].Read = read[items[i].ID]
+	}
+	return items, nil
+}
+
+// MarkRead marks announcements read for a wallet. Marking one twice keeps the
+// first read time.
+func (s *Service) MarkRead(ctx context.Context, walletAddress string, ids []string) error {
+	if len(ids) > maxMarkRead {
+		return fmt.Errorf("%w: at most %d announcements can be marked read at once", ErrInvalidAnnouncement, maxMarkRead)
+	}
+	now := s.now()
+	reads := make([]model.AnnouncementRead, 0, len(ids))
+	for _, id := range ids {
+		if _, err := uuid.Parse(id); err != nil {
+			return fmt.Errorf("%w: invalid announcement id %q", ErrInvalidAnnouncement, id)
+		}
+		readID := id + ":" + walletAddress
+		if slices.ContainsFunc(reads, func(r model.AnnouncementRead) bool { return r.ID == readID }) {
+			continue
+		}
+		reads = append(reads, model.AnnouncementRead{
+			ID:             readID,
+			AnnouncementID: id,
+			WalletAddress:  walletAddress,
+			ReadAt:         now,
+		})
+	}
+	if len(reads) == 0 {
+		return nil
+	}
+	if _, err := s.store.AnnouncementReads().BulkUpsert(ctx, &reads); err != nil {
+		return fmt.Errorf("failed to mark announcements read: %w", err)
+	}
+	return nil
+}
+
+// apply validates params and copies them onto announcement
+func (s *Service) apply(announcement *model.Announcement, params Params, now time.Time) error {
+	params.Title = strings.TrimSpace(params.Title)
+	params.Body = strings.TrimSpace(params.Body)
+	if params.Title == "" {
+		return fmt.Errorf("%w: title is required", ErrInvalidAnnouncement)
+	}
+	if len(params.Title) > maxTitleLength {
+		return fmt.Errorf("%w: title is longer than %d bytes", ErrInvalidAnnouncement, maxTitleLength)
+	}
+	if len(params.Body) > maxBodyLength {
+		return fmt.Errorf("%w: body is longer than %d bytes", ErrInvalidAnnouncement, maxBodyLength)
+	}
+	if params.Severity == "" {
+		params.Severity = model.AnnouncementSeverityInfo
+	}
+	if !slices.Contains(Severities, params.Severity) {
+		return fmt.Errorf("%w: unknown severity %q", ErrInvalidAnnouncement, params.Severity)
+	}
+	for _, version := range []string{params.MinAppVersion, params.MaxAppVersion} {
+		if version != "" && !util.IsValidVersion(version) {
+			return fmt.Errorf("%w: invalid app version %q", ErrInvalidAnnouncement, version)
+		}
+	}
+	if params.MinAppVersion != "" && params.MaxAppVersion != "" {
+		if cmp, _ := util.CompareVersions(params.MinAppVersion, params.MaxAppVersion); cmp > 0 {
+			return fmt.Errorf("%w: minimum app version is above the maximum", ErrInvalidAnnouncement)
+		}
+	}
+	if params.StartsAt.IsZero() {
+		params.StartsAt = now
+	}
+	if !params.EndsAt.IsZero() && !params.EndsAt.After(params.StartsAt) {
+		return fmt.Errorf("%w: ends before it starts", ErrInvalidAnnouncement)
+	}
+
+	announcement.Title = params.Title
+	announcement.Body = params.Body
+	announcement.Severity = params.Severity
+	announcement.MinAppVersion = params.MinAppVersion
+	announcement.MaxAppVersion = params.MaxAppVersion
+	announcement.StartsAt = params.StartsAt
+	announcement.EndsAt = params.EndsAt
+	announcement.UpdatedAt = now
+	return nil
+}
+
+// targets reports whether the announcement is shown to appVersion
+func targets(a *model.Announcement, appVersion string) bool {
+	if a.MinAppVersion == "" && a.MaxAppVersion == "" {
+		return true
+	}
+	if appVersion == "" {
+		return false
+	}
+	if a.MinAppVersion != "" {
+		if cmp, err := util.CompareVersions(appVersion, a.MinAppVersion); err != nil || cmp < 0 {
+			return false
+		}
+	}
+	if a.MaxAppVersion != "" {
+		if cmp, err := util.CompareVersions(appVersion, a.MaxAppVersion); err != nil || cmp > 0 {
+			return false
+		}
+	}
+	return true
+}
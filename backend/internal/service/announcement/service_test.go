@@ -0,0 +1,97 @@
+package announcement
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	dbmocks "github.com/nicolas-martin/dankfolio/backend/internal/db/mocks"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+const testWallet = "GgaBFkzjuvMV7RCrZyt65zx7iRo7W6Af4cGXZMKNxK2R"
+
+var testNow = time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func newTestService(t *testing.T) (*Service, *dbmocks.MockRepository[model.Announcement], *dbmocks.MockRepository[model.AnnouncementRead]) {
+	store := dbmocks.NewMockStore(t)
+	announcements := dbmocks.NewMockRepository[model.Announcement](t)
+	reads := dbmocks.NewMockRepository[model.AnnouncementRead](t)
+	store.EXPECT().Announcements().Return(announcements).Maybe()
+	store.EXPECT().AnnouncementReads().Return(reads).Maybe()
+	service := NewService(store)
+	service.now = func() time.Time { return testNow }
+	return service, announcements, reads
+}
+
+func TestCreate_Validation(t *testing.T) {
+	service, announcements, _ := newTestService(t)
+
+	invalid := []Params{
+		{Title: " "},
+		{Title: "Update", Severity: "urgent"},
+		{Title: "Update", MinAppVersion: "latest"},
+		{Title: "Update", MinAppVersion: "2.0", MaxAppVersion: "1.9.9"},
+		{Title: "Update", StartsAt: testNow, EndsAt: testNow},
+	}
+	for _, params := range invalid {
+		_, err := service.Create(context.Background(), params, "admin")
+		assert.ErrorIs(t, err, ErrInvalidAnnouncement, "%+v", params)
+	}
+
+	announcements.EXPECT().Create(mock.Anything, mock.Anything).Return(nil)
+	created, err := service.Create(context.Background(), Params{Title: " New charts ", MinAppVersion: "1.2"}, "admin")
+	require.NoError(t, err)
+	assert.Equal(t, "New charts", created.Title)
+	assert.Equal(t, model.AnnouncementSeverityInfo, created.Severity)
+	assert.Equal(t, testNow, created.StartsAt)
+	assert.NotEmpty(t, created.ID)
+}
+
+func TestList(t *testing.T) {
+	service, announcements, reads := newTestService(t)
+	announcements.EXPECT().ListWithOpts(mock.Anything, mock.Anything).Return([]model.Announcement{
+		{ID: "everyone"},
+		{ID: "expired", EndsAt: testNow},
+		{ID: "new-app", MinAppVersion: "1.5.0"},
+		{ID: "old-app", MaxAppVersion: "1.4"},
+	}, int32(4), nil)
+	reads.EXPECT().ListWithOpts(mock.Anything, mock.MatchedBy(func(opts db.ListOptions) bool {
+		return assert.ObjectsAreEqual([]string{"everyone", "old-app"}, opts.Filters[1].Value)
+	})).Return([]model.AnnouncementRead{{AnnouncementID: "old-app", WalletAddress: testWallet}}, int32(1), nil)
+
+	items, err := service.List(context.Background(), testWallet, "v1.4.0-beta.2")
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	assert.Equal(t, "everyone", items[0].ID)
+	assert.False(t, items[0].Read)
+	assert.Equal(t, "old-app", items[1].ID)
+	assert.True(t, items[1].Read)
+
+	// Without a version only untargeted announcements are shown, and
+	// without a wallet nothing is read
+	items, err = service.List(context.Background(), "", "")
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, "everyone", items[0].ID)
+
+	_, err = service.List(context.Background(), testWallet, "nightly")
+	assert.ErrorIs(t, err, ErrInvalidAnnouncement)
+}
+
+func TestMarkRead(t *testing.T) {
+	service, _, reads := newTestService(t)
+	id := "0b7c1c5e-3a43-4d4e-9a51-4f1d9c3b2a10"
+
+	assert.ErrorIs(t, service.MarkRead(context.Background(), testWallet, []string{"not-an-id"}), ErrInvalidAnnouncement)
+
+	reads.EXPECT().BulkUpsert(mock.Anything, mock.MatchedBy(func(items *[]model.AnnouncementRead) bool {
+		return len(*items) == 1 && (*items)[0].ID == id+":"+testWallet && (*items)[0].ReadAt.Equal(testNow)
+	})).Return(int64(1), nil)
+	require.NoError(t, service.MarkRead(context.Background(), testWallet, []string{id, id}))
+}
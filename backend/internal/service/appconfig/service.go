@@ -0,0 +1,142 @@
+// Package appconfig holds the remote configuration served to the app at
+// startup: the minimum supported app version, kill switches for features,
+// maintenance mode, the Solana RPC endpoint the app should use and the
+// default slippage. Values are pushed in from runtime settings so ops can
+// change them without a deploy or an app release.
+package appconfig
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/apperrors"
+	"github.com/nicolas-martin/dankfolio/backend/internal/util"
+)
+
+// Features that can be switched off remotely
+const (
+	FeatureTrading   = "trading"
+	FeatureTransfers = "transfers"
+	FeaturePayments  = "payments"
+	FeatureSearch    = "search"
+)
+
+// Features are the features a kill switch can disable.
+var Features = []string{FeatureTrading, FeatureTransfers, FeaturePayments, FeatureSearch}
+
+// DefaultSlippageBps is the slippage the app preselects until ops choose another.
+const DefaultSlippageBps = 50
+
+// Config is the remote configuration for the app.
+type Config struct {
+	MinimumAppVersion  string   // Older apps must update; empty when every version is supported
+	DisabledFeatures   []string // Features switched off, from Features
+	DisabledMessage    string   // Shown in the banner while any feature is disabled
+	Maintenance        bool     // Trades and transfers are rejected while read-only endpoints keep serving
+	MaintenanceMessage string   // Shown to users while in maintenance; a generic message when empty
+	ClientRPCEndpoint  string   // Solana RPC endpoint for the app; empty to keep its own
+	DefaultSlippageBps int
+}
+
+// Service holds the current app configuration. It is safe for concurrent use.
+type Service struct {
+	mu     sync.RWMutex
+	config Config
+}
+
+// NewService creates an app configuration with every feature enabled
+func NewService() *Service {
+	return &Service{config: Config{DefaultSlippageBps: DefaultSlippageBps}}
+}
+
+// Get returns a copy of the current configuration
+func (s *Service) Get() Config {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	config := s.config
+	config.DisabledFeatures = slices.Clone(s.config.DisabledFeatures)
+	return config
+}
+
+// SetMinimumAppVersion sets the oldest app version that is still supported
+func (s *Service) SetMinimumAppVersion(version string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.config.MinimumAppVersion = version
+}
+
+// SetDisabledFeatures replaces the features that are switched off
+func (s *Service) SetDisabledFeatures(features []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.config.DisabledFeatures = slices.Clone(features)
+}
+
+// SetDisabledMessage sets the banner shown while a feature is disabled
+func (s *Service) SetDisabledMessage(message string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.config.DisabledMessage = message
+}
+
+// SetMaintenance turns maintenance mode on or off
+func (s *Service) SetMaintenance(enabled bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.config.Maintenance = enabled
+}
+
+// SetMaintenanceMessage sets the message shown while in maintenance
+func (s *Service) SetMaintenanceMessage(message string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.config.MaintenanceMessage = message
+}
+
+// CheckMaintenance returns apperrors.ErrMaintenance, carrying the maintenance
+// message, while maintenance mode is on
+func (s *Service) CheckMaintenance() error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if !s.config.Maintenance {
+		return nil
+	}
+	if s.config.MaintenanceMessage != "" {
+		return apperrors.ErrMaintenance.WithMessage(s.config.MaintenanceMessage)
+	}
+	return apperrors.ErrMaintenance
+}
+
+// SetClientRPCEndpoint sets the Solana RPC endpoint handed to the app
+func (s *Service) SetClientRPCEndpoint(endpoint string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.config.ClientRPCEndpoint = endpoint
+}
+
+// SetDefaultSlippageBps sets the slippage the app preselects
+func (s *Service) SetDefaultSlippageBps(bps int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.config.DefaultSlippageBps = bps
+}
+
+// FeatureEnabled reports whether feature is not switched off
+func (s *Service) FeatureEnabled(feature string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return !slices.Contains(s.config.DisabledFeatures, feature)
+}
+
+// UpdateRequired reports whether appVersion is older than the minimum
+// supported version. Unknown or unparseable versions are not forced to update.
+func (s *Service) UpdateRequired(appVersion string) bool {
+	s.mu.RLock()
+	minimum := s.config.MinimumAppVersion
+	s.mu.RUnlock()
+	if minimum == "" || appVersion == "" {
+		return false
+	}
+	cmp, err := util.CompareVersions(appVersion, minimum)
+	return err == nil && cmp < 0
+}
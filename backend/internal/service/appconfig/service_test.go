@@ -0,0 +1,46 @@
+package appconfig
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/apperrors"
+)
+
+func TestUpdateRequired(t *testing.T) {
+	service := NewService()
+	assert.False(t, service.UpdateRequired("0.1.0"), "no minimum")
+
+	service.SetMinimumAppVersion("1.4.0")
+	assert.True(t, service.UpdateRequired("1.3.9"))
+	assert.False(t, service.UpdateRequired("1.4"))
+	assert.False(t, service.UpdateRequired("v1.10.0-beta.1"))
+	assert.False(t, service.UpdateRequired(""), "unknown versions are not forced")
+	assert.False(t, service.UpdateRequired("nightly"))
+}
+
+func TestFeatureEnabled(t *testing.T) {
+	service := NewService()
+	assert.True(t, service.FeatureEnabled(FeatureTrading))
+
+	features := []string{FeatureTrading}
+	service.SetDisabledFeatures(features)
+	features[0] = FeatureSearch
+	assert.False(t, service.FeatureEnabled(FeatureTrading), "the caller's slice is copied")
+	assert.True(t, service.FeatureEnabled(FeatureSearch))
+	assert.Equal(t, []string{FeatureTrading}, service.Get().DisabledFeatures)
+}
+
+func TestCheckMaintenance(t *testing.T) {
+	service := NewService()
+	assert.NoError(t, service.CheckMaintenance())
+
+	service.SetMaintenance(true)
+	err := service.CheckMaintenance()
+	assert.ErrorIs(t, err, apperrors.ErrMaintenance)
+	assert.Equal(t, apperrors.ErrMaintenance.Message, err.Error())
+
+	service.SetMaintenanceMessage("Back at 03:00 UTC")
+	assert.EqualError(t, service.CheckMaintenance(), "Back at 03:00 UTC")
+}
@@ -0,0 +1,284 @@
+// Package authority watches listed coins' mint accounts for mint and freeze
+// authority changes. A held mint authority lets its owner dilute holders at
+// will and a freeze authority lets it lock their tokens, so revoking either
+// raises a coin's safety score and setting one again lowers it.
+package authority
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/google/uuid"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/events"
+	"github.com/nicolas-martin/dankfolio/backend/internal/jobs"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	bmodel "github.com/nicolas-martin/dankfolio/backend/internal/model/blockchain"
+)
+
+const (
+	// DefaultInterval is how often mints are checked
+	DefaultInterval = 5 * time.Minute
+	// DefaultMaxCoins is how many coins are watched, by 24h volume
+	DefaultMaxCoins = 500
+
+	// Points a held authority costs out of 100
+	mintAuthorityPenalty   = 50
+	freezeAuthorityPenalty = 30
+
+	// maxAlertedHolders bounds the trades scanned for holders of a coin
+	maxAlertedHolders = 1000
+)
+
+// Chain is what the watcher reads from the chain. The Solana client
+// implements it.
+type Chain interface {
+	GetMultipleAccounts(ctx context.Context, addresses []bmodel.Address, commitment string) ([]*bmodel.AccountInfo, error)
+}
+
+// Config holds authority watcher settings. Zero values use the defaults.
+type Config struct {
+	Interval   time.Duration
+	MaxCoins   int
+	Commitment string
+}
+
+// Watcher compares each watched mint's authorities with what it saw last
+// time and records the changes.
+type Watcher struct {
+	config Config
+	store  db.Store
+	chain  Chain
+	bus    events.Bus // Optional
+	now    func() time.Time
+}
+
+// NewWatcher creates an authority watcher. bus may be nil.
+func NewWatcher(config Config, store db.Store, chain Chain, bus events.Bus) *Watcher {
+	if config.Interval <= 0 {
+		config.Interval = DefaultInterval
+	}
+	if config.MaxCoins <= 0 {
+		config.MaxCoins = DefaultMaxCoins
+	}
+	if config.Commitment == "" {
+		config.Commitment = bmodel.DefaultCommitment
+	}
+	return &Watcher{
+		config: config,
+		store:  store,
+		chain:  chain,
+		bus:    bus,
+		now:    time.Now,
+	}
+}
+
+// SafetyScore rates a mint's authorities from 0 to 100.
+func SafetyScore(mintAuthority, freezeAuthority string) int {
+	score := 100
+	if mintAuthority != "" {
+		score -= mintAuthorityPenalty
+	}
+	if freezeAuthority != "" {
+		score -= freezeAuthorityPenalty
+	}
+	return score
+}
+
+// JobName names the authority check for scheduling
+const JobName = "authority.check"
+
+// Job checks every Interval
+func (w *Watcher) Job() jobs.Job {
+	return jobs.Job{Name: JobName, Interval: w.config.Interval, Run: w.Check}
+}
+
+// Check reads every watched mint once and records the authority changes since
+// the last check. A mint seen for the first time only sets the baseline.
+func (w *Watcher) Check(ctx context.Context) error {
+	sortBy, desc, limit := "volume_24h_usd", true, w.config.MaxCoins
+	coins, _, err := w.store.Coins().ListWithOpts(ctx, db.ListOptions{Limit: &limit, SortBy: &sortBy, SortDesc: &desc})
+	if err != nil {
+		return fmt.Errorf("failed to list coins: %w", err)
+	}
+	addresses := make([]string, 0, len(coins))
+	for _, coin := range coins {
+		if coin.Address != model.SolMint && coin.Address != model.NativeSolMint {
+			addresses = append(addresses, coin.Address)
+		}
+	}
+	if len(addresses) == 0 {
+		return nil
+	}
+	stored, _, err := w.store.MintAuthorities().ListWithOpts(ctx, db.ListOptions{
+		Filters: []db.FilterOption{{Field: "mint", Operator: db.FilterOpIn, Value: addresses}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to load mint authorities: %w", err)
+	}
+	previous := make(map[string]model.MintAuthority, len(stored))
+	for _, authority := range stored {
+		previous[authority.Mint] = authority
+	}
+
+	mints := make([]bmodel.Address, len(addresses))
+	for i, address := range addresses {
+		mints[i] = bmodel.Address(address)
+	}
+	accounts, err := w.chain.GetMultipleAccounts(ctx, mints, w.config.Commitment)
+	if err != nil {
+		return fmt.Errorf("failed to read mints: %w", err)
+	}
+
+	now := w.now()
+	var checked []model.MintAuthority
+	var changes []model.AuthorityChange
+	for i, account := range accounts {
+		if account == nil {
+			continue
+		}
+		mintAuthority, freezeAuthority, err := parseAuthorities(account)
+		if err != nil {
+			slog.DebugContext(ctx, "Skipping mint in authority check", slog.String("mint", addresses[i]), slog.Any("error", err))
+			continue
+		}
+		current := model.MintAuthority{
+			Mint:            addresses[i],
+			MintAuthority:   mintAuthority,
+			FreezeAuthority: freezeAuthority,
+			SafetyScore:     SafetyScore(mintAuthority, freezeAuthority),
+			CheckedAt:       now,
+		}
+		last, seen := previous[addresses[i]]
+		current.ChangedAt = last.ChangedAt
+		if seen {
+			found := diff(last, current, now)
+			if len(found) > 0 {
+				current.ChangedAt = now
+				changes = append(changes, found...)
+			}
+		}
+		checked = append(checked, current)
+	}
+	if len(checked) == 0 {
+		return nil
+	}
+
+	err = w.store.WithTransaction(ctx, func(tx db.Store) error {
+		if _, err := tx.MintAuthorities().BulkUpsert(ctx, &checked); err != nil {
+			return fmt.Errorf("failed to save mint authorities: %w", err)
+		}
+		for i := range changes {
+			if err := tx.AuthorityChanges().Create(ctx, &changes[i]); err != nil {
+				return fmt.Errorf("failed to save authority change: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, change := range changes {
+		slog.WarnContext(ctx, "Mint authority changed",
+			slog.String("coin", change.CoinAddress), slog.String("authority", change.Authority),
+			slog.String("previous", change.Previous), slog.String("current", change.Current),
+			slog.Int("safety_score", change.SafetyScore))
+		if w.bus == nil {
+			continue
+		}
+		holders, err := w.holders(ctx, change.CoinAddress)
+		if err != nil {
+			// Coin-wide subscribers are still alerted
+			slog.WarnContext(ctx, "Failed to look up holders for authority alert", slog.String("coin", change.CoinAddress), slog.Any("error", err))
+		}
+		w.bus.Publish(ctx, events.NewAuthorityChangeEvent(change, holders))
+	}
+	slog.DebugContext(ctx, "Authority check finished", slog.Int("mints", len(checked)), slog.Int("changes", len(changes)))
+	return nil
+}
+
+// diff returns a change for each authority that differs between two readings
+func diff(last, current model.MintAuthority, now time.Time) []model.AuthorityChange {
+	var changes []model.AuthorityChange
+	for _, pair := range []struct {
+		authority     string
+		before, after string
+	}{
+		{model.AuthorityMint, last.MintAuthority, current.MintAuthority},
+		{model.AuthorityFreeze, last.FreezeAuthority, current.FreezeAuthority},
+	} {
+		if pair.before == pair.after {
+			continue
+		}
+		changes = append(changes, model.AuthorityChange{
+			ID:          uuid.NewString(),
+			CoinAddress: current.Mint,
+			Authority:   pair.authority,
+			Previous:    pair.before,
+			Current:     pair.after,
+			SafetyScore: current.SafetyScore,
+			DetectedAt:  now,
+		})
+	}
+	return changes
+}
+
+// holders returns the wallets of our users who bought the coin, newest
+// buyers first. Balances are not checked, so some may have sold since.
+func (w *Watcher) holders(ctx context.Context, coinAddress string) ([]string, error) {
+	limit, sortBy, desc := maxAlertedHolders, "created_at", true
+	trades, _, err := w.store.Trades().ListWithOpts(ctx, db.ListOptions{
+		Limit:    &limit,
+		SortBy:   &sortBy,
+		SortDesc: &desc,
+		Filters: []db.FilterOption{
+			{Field: "to_coin_mint_address", Operator: db.FilterOpEqual, Value: coinAddress},
+			{Field: "status", Operator: db.FilterOpIn, Value: []string{model.TradeStatusConfirmed.String(), model.TradeStatusFinalized.String()}},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list trades: %w", err)
+	}
+	holders := make([]string, 0, len(trades))
+	for _, trade := range trades {
+		if trade.UserID != "" && !slices.Contains(holders, trade.UserID) {
+			holders = append(holders, trade.UserID)
+		}
+	}
+	return holders, nil
+}
+
+// Get returns the last observed authorities of a coin, or db.ErrNotFound
+// before it has been checked.
+func (w *Watcher) Get(ctx context.Context, coinAddress string) (*model.MintAuthority, error) {
+	return w.store.MintAuthorities().Get(ctx, coinAddress)
+}
+
+// Offsets in the SPL mint layout, which Token-2022 mints share. Both
+// authorities are a COption<Pubkey>: a 4-byte tag followed by the key.
+const (
+	mintAuthorityOffset   = 0
+	freezeAuthorityOffset = 46
+	mintMinLength         = 82
+)
+
+// parseAuthorities returns a mint account's authorities, empty when revoked
+func parseAuthorities(account *bmodel.AccountInfo) (mintAuthority, freezeAuthority string, err error) {
+	if len(account.Data) < mintMinLength {
+		return "", "", fmt.Errorf("mint %s is too short: %d bytes", account.Address, len(account.Data))
+	}
+	return optionalKey(account.Data[mintAuthorityOffset:]), optionalKey(account.Data[freezeAuthorityOffset:]), nil
+}
+
+func optionalKey(data []byte) string {
+	if binary.LittleEndian.Uint32(data) == 0 {
+		return ""
+	}
+	return solana.PublicKeyFromBytes(data[4:36]).String()
+}
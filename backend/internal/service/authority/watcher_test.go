@@ -0,0 +1,139 @@
+package authority
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	dbmocks "github.com/nicolas-martin/dankfolio/backend/internal/db/mocks"
+	"github.com/nicolas-martin/dankfolio/backend/internal/events"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	bmodel "github.com/nicolas-martin/dankfolio/backend/internal/model/blockchain"
+)
+
+const testCoin = "7GCihgDB8fe6KNjn2MYtkzZcRjQy3t9GHdC8uHYmW2hr"
+
+func mintAccount(mintAuthority, freezeAuthority *solana.PublicKey) *bmodel.AccountInfo {
+	data := make([]byte, mintMinLength)
+	if mintAuthority != nil {
+		binary.LittleEndian.PutUint32(data[mintAuthorityOffset:], 1)
+		copy(data[mintAuthorityOffset+4:], mintAuthority.Bytes())
+	}
+	if freezeAuthority != nil {
+		binary.LittleEndian.PutUint32(data[freezeAuthorityOffset:], 1)
+		copy(data[freezeAuthorityOffset+4:], freezeAuthority.Bytes())
+	}
+	return &bmodel.AccountInfo{Address: testCoin, Data: data}
+}
+
+type fakeChain struct {
+	account *bmodel.AccountInfo
+}
+
+func (c *fakeChain) GetMultipleAccounts(_ context.Context, addresses []bmodel.Address, _ string) ([]*bmodel.AccountInfo, error) {
+	result := make([]*bmodel.AccountInfo, len(addresses))
+	for i := range addresses {
+		result[i] = c.account
+	}
+	return result, nil
+}
+
+type recordingBus struct {
+	published []events.Event
+}
+
+func (b *recordingBus) Publish(_ context.Context, event events.Event) {
+	b.published = append(b.published, event)
+}
+
+func (b *recordingBus) Subscribe(events.Handler, ...events.Type) func() { return func() {} }
+
+func (b *recordingBus) Close() {}
+
+func TestParseAuthorities(t *testing.T) {
+	mintKey := solana.NewWallet().PublicKey()
+	mintAuthority, freezeAuthority, err := parseAuthorities(mintAccount(&mintKey, nil))
+	require.NoError(t, err)
+	assert.Equal(t, mintKey.String(), mintAuthority)
+	assert.Empty(t, freezeAuthority)
+
+	_, _, err = parseAuthorities(&bmodel.AccountInfo{Data: make([]byte, 10)})
+	assert.Error(t, err)
+}
+
+func TestSafetyScore(t *testing.T) {
+	assert.Equal(t, 100, SafetyScore("", ""))
+	assert.Equal(t, 70, SafetyScore("", "freezer"))
+	assert.Equal(t, 20, SafetyScore("minter", "freezer"))
+}
+
+func TestCheck_AlertsHoldersWhenMintAuthorityIsRegained(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	newAuthority := solana.NewWallet().PublicKey()
+
+	store := dbmocks.NewMockStore(t)
+	coins := dbmocks.NewMockRepository[model.Coin](t)
+	authorities := dbmocks.NewMockRepository[model.MintAuthority](t)
+	changes := dbmocks.NewMockRepository[model.AuthorityChange](t)
+	trades := dbmocks.NewMockRepository[model.Trade](t)
+	store.EXPECT().Coins().Return(coins)
+	store.EXPECT().MintAuthorities().Return(authorities)
+	store.EXPECT().AuthorityChanges().Return(changes)
+	store.EXPECT().Trades().Return(trades)
+	store.EXPECT().WithTransaction(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, fn func(db.Store) error) error {
+		return fn(store)
+	})
+
+	coins.EXPECT().ListWithOpts(mock.Anything, mock.Anything).Return([]model.Coin{{Address: testCoin}}, int32(1), nil)
+	authorities.EXPECT().ListWithOpts(mock.Anything, mock.Anything).Return([]model.MintAuthority{
+		{Mint: testCoin, SafetyScore: 100, CheckedAt: now.Add(-time.Minute)},
+	}, int32(1), nil)
+	authorities.EXPECT().BulkUpsert(mock.Anything, mock.MatchedBy(func(items *[]model.MintAuthority) bool {
+		return len(*items) == 1 && (*items)[0].MintAuthority == newAuthority.String() && (*items)[0].SafetyScore == 50 && (*items)[0].ChangedAt.Equal(now)
+	})).Return(int64(1), nil)
+	changes.EXPECT().Create(mock.Anything, mock.MatchedBy(func(change *model.AuthorityChange) bool {
+		return change.Authority == model.AuthorityMint && change.Previous == "" && change.Current == newAuthority.String()
+	})).Return(nil)
+	trades.EXPECT().ListWithOpts(mock.Anything, mock.Anything).Return([]model.Trade{
+		{UserID: "wallet-a"}, {UserID: "wallet-b"}, {UserID: "wallet-a"},
+	}, int32(3), nil)
+
+	bus := &recordingBus{}
+	watcher := NewWatcher(Config{}, store, &fakeChain{account: mintAccount(&newAuthority, nil)}, bus)
+	watcher.now = func() time.Time { return now }
+
+	require.NoError(t, watcher.Check(context.Background()))
+	require.Len(t, bus.published, 1)
+	payload := bus.published[0].Payload.(events.AuthorityChangePayload)
+	assert.Equal(t, events.CoinAuthorityChanged, bus.published[0].Type)
+	assert.Equal(t, 50, payload.Change.SafetyScore)
+	assert.Equal(t, []string{"wallet-a", "wallet-b"}, payload.Holders)
+}
+
+func TestCheck_FirstReadingIsBaseline(t *testing.T) {
+	store := dbmocks.NewMockStore(t)
+	coins := dbmocks.NewMockRepository[model.Coin](t)
+	authorities := dbmocks.NewMockRepository[model.MintAuthority](t)
+	store.EXPECT().Coins().Return(coins)
+	store.EXPECT().MintAuthorities().Return(authorities)
+	store.EXPECT().WithTransaction(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, fn func(db.Store) error) error {
+		return fn(store)
+	})
+	coins.EXPECT().ListWithOpts(mock.Anything, mock.Anything).Return([]model.Coin{{Address: testCoin}}, int32(1), nil)
+	authorities.EXPECT().ListWithOpts(mock.Anything, mock.Anything).Return(nil, int32(0), nil)
+	authorities.EXPECT().BulkUpsert(mock.Anything, mock.Anything).Return(int64(1), nil)
+
+	bus := &recordingBus{}
+	freezer := solana.NewWallet().PublicKey()
+	watcher := NewWatcher(Config{}, store, &fakeChain{account: mintAccount(nil, &freezer)}, bus)
+
+	require.NoError(t, watcher.Check(context.Background()))
+	assert.Empty(t, bus.published)
+}
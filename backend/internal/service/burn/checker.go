@@ -0,0 +1,363 @@
+// Package burn watches listed coins for burns traders read as safety signals:
+// tokens burned out of the supply, and LP tokens burned so the liquidity
+// behind a pool can never be withdrawn.
+package burn
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/events"
+	"github.com/nicolas-martin/dankfolio/backend/internal/jobs"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	bmodel "github.com/nicolas-martin/dankfolio/backend/internal/model/blockchain"
+)
+
+const (
+	// DefaultInterval is how often coins are checked
+	DefaultInterval = 15 * time.Minute
+	// DefaultPoolRefreshInterval is how often a coin's pools are looked up again
+	DefaultPoolRefreshInterval = 24 * time.Hour
+	// DefaultMaxCoins is how many coins are watched, by 24h volume
+	DefaultMaxCoins = 500
+
+	// poolLookupsPerCheck bounds the pool lookups one check makes. Each is a
+	// getProgramAccounts scan per AMM, far heavier than an account read, so a
+	// large backlog is spread over several checks.
+	poolLookupsPerCheck = 25
+)
+
+// Chain is what the checker reads from the chain. The Solana client
+// implements it.
+type Chain interface {
+	GetMultipleAccounts(ctx context.Context, addresses []bmodel.Address, commitment string) ([]*bmodel.AccountInfo, error)
+	FindProgramAccounts(ctx context.Context, program bmodel.Address, dataSize, offset uint64, value bmodel.Address, commitment string) ([]*bmodel.AccountInfo, error)
+}
+
+// Config holds burn checker settings. Zero values use the defaults.
+type Config struct {
+	Interval            time.Duration
+	PoolRefreshInterval time.Duration
+	MaxCoins            int
+	Commitment          string
+}
+
+// Checker periodically compares each watched mint with what it saw last time
+// and records the burns in between.
+type Checker struct {
+	config Config
+	store  db.Store
+	chain  Chain
+	bus    events.Bus // Optional
+	now    func() time.Time
+}
+
+// NewChecker creates a burn checker. bus may be nil.
+func NewChecker(config Config, store db.Store, chain Chain, bus events.Bus) *Checker {
+	if config.Interval <= 0 {
+		config.Interval = DefaultInterval
+	}
+	if config.PoolRefreshInterval <= 0 {
+		config.PoolRefreshInterval = DefaultPoolRefreshInterval
+	}
+	if config.MaxCoins <= 0 {
+		config.MaxCoins = DefaultMaxCoins
+	}
+	if config.Commitment == "" {
+		config.Commitment = bmodel.DefaultCommitment
+	}
+	return &Checker{
+		config: config,
+		store:  store,
+		chain:  chain,
+		bus:    bus,
+		now:    time.Now,
+	}
+}
+
+// JobName names the burn check for scheduling
+const JobName = "burn.check"
+
+// Job checks every Interval
+func (c *Checker) Job() jobs.Job {
+	return jobs.Job{Name: JobName, Interval: c.config.Interval, Run: c.Check}
+}
+
+// Check reads every watched mint once, records the burns since the last
+// check and publishes a CoinBurned event for each. A mint seen for the first
+// time only sets the baseline: burns from before it was watched show up in
+// its burned share but do not raise events.
+func (c *Checker) Check(ctx context.Context) error {
+	sortBy, desc, limit := "volume_24h_usd", true, c.config.MaxCoins
+	coins, _, err := c.store.Coins().ListWithOpts(ctx, db.ListOptions{Limit: &limit, SortBy: &sortBy, SortDesc: &desc})
+	if err != nil {
+		return fmt.Errorf("failed to list coins: %w", err)
+	}
+	stored, _, err := c.store.BurnWatches().List(ctx, db.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to load burn watches: %w", err)
+	}
+	watches := make(map[string]*model.BurnWatch, len(stored))
+	for i := range stored {
+		watches[stored[i].Mint] = &stored[i]
+	}
+
+	now := c.now()
+	var supply, liquidity []*model.BurnWatch
+	lookups := 0
+	for _, coin := range coins {
+		if coin.Address == model.SolMint || coin.Address == model.NativeSolMint {
+			continue
+		}
+		watch := watches[coin.Address]
+		if watch == nil {
+			watch = &model.BurnWatch{Mint: coin.Address, CoinAddress: coin.Address, Kind: model.BurnKindSupply}
+		}
+		supply = append(supply, watch)
+		if now.Sub(watch.PoolsCheckedAt) >= c.config.PoolRefreshInterval && lookups < poolLookupsPerCheck {
+			lookups++
+			if err := c.watchPools(ctx, coin.Address, watches); err != nil {
+				slog.WarnContext(ctx, "Failed to look up pools for burn check", slog.String("coin", coin.Address), slog.Any("error", err))
+			} else {
+				watch.PoolsCheckedAt = now
+			}
+		}
+	}
+	for _, watch := range supply {
+		for _, w := range watches {
+			if w.Kind == model.BurnKindLiquidity && w.CoinAddress == watch.CoinAddress {
+				liquidity = append(liquidity, w)
+			}
+		}
+	}
+
+	// Each pool is read next to its LP mint, and the pairs come first so none
+	// is split across getMultipleAccounts calls and read at different slots
+	addresses := make([]bmodel.Address, 0, 2*len(liquidity)+len(supply))
+	for _, watch := range liquidity {
+		addresses = append(addresses, bmodel.Address(watch.Pool), bmodel.Address(watch.Mint))
+	}
+	for _, watch := range supply {
+		addresses = append(addresses, bmodel.Address(watch.Mint))
+	}
+	accounts, err := c.chain.GetMultipleAccounts(ctx, addresses, c.config.Commitment)
+	if err != nil {
+		return fmt.Errorf("failed to read mints: %w", err)
+	}
+
+	var checked []model.BurnWatch
+	var burns []model.BurnEvent
+	for i, watch := range liquidity {
+		burn, err := c.checkLiquidity(watch, accounts[2*i], accounts[2*i+1], now)
+		if err != nil {
+			slog.DebugContext(ctx, "Skipping pool in burn check", slog.String("pool", watch.Pool), slog.Any("error", err))
+			continue
+		}
+		checked = append(checked, *watch)
+		if burn != nil {
+			burns = append(burns, *burn)
+		}
+	}
+	for i, watch := range supply {
+		burn, err := c.checkSupply(watch, accounts[2*len(liquidity)+i], now)
+		if err != nil {
+			slog.DebugContext(ctx, "Skipping mint in burn check", slog.String("mint", watch.Mint), slog.Any("error", err))
+			continue
+		}
+		checked = append(checked, *watch)
+		if burn != nil {
+			burns = append(burns, *burn)
+		}
+	}
+	if len(checked) == 0 {
+		return nil
+	}
+
+	err = c.store.WithTransaction(ctx, func(tx db.Store) error {
+		if _, err := tx.BurnWatches().BulkUpsert(ctx, &checked); err != nil {
+			return fmt.Errorf("failed to save burn watches: %w", err)
+		}
+		for i := range burns {
+			if err := tx.BurnEvents().Create(ctx, &burns[i]); err != nil {
+				return fmt.Errorf("failed to save burn event: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, burn := range burns {
+		slog.InfoContext(ctx, "Burn detected",
+			slog.String("coin", burn.CoinAddress), slog.String("kind", burn.Kind),
+			slog.String("amount", burn.Amount), slog.Float64("burned_percent", burn.BurnedPercent))
+		if c.bus != nil {
+			c.bus.Publish(ctx, events.NewBurnEvent(burn))
+		}
+	}
+	slog.DebugContext(ctx, "Burn check finished",
+		slog.Int("mints", len(checked)), slog.Int("burns", len(burns)), slog.Int("pool_lookups", lookups))
+	return nil
+}
+
+// watchPools adds a liquidity watch for each supported pool the coin trades in
+func (c *Checker) watchPools(ctx context.Context, coin string, watches map[string]*model.BurnWatch) error {
+	for _, layout := range ammLayouts {
+		for _, offset := range layout.mintOffsets {
+			accounts, err := c.chain.FindProgramAccounts(ctx, layout.program, layout.dataSize, offset, bmodel.Address(coin), c.config.Commitment)
+			if err != nil {
+				return fmt.Errorf("failed to look up %s pools: %w", layout.name, err)
+			}
+			for _, account := range accounts {
+				pool, err := parsePool(account)
+				if err != nil {
+					continue
+				}
+				if _, ok := watches[string(pool.LPMint)]; !ok {
+					watches[string(pool.LPMint)] = &model.BurnWatch{
+						Mint:        string(pool.LPMint),
+						CoinAddress: coin,
+						Kind:        model.BurnKindLiquidity,
+						Pool:        string(pool.Address),
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// checkSupply updates a supply watch from its mint. Supply only ever drops
+// through burns, so any drop since the last check is one.
+func (c *Checker) checkSupply(watch *model.BurnWatch, mint *bmodel.AccountInfo, now time.Time) (*model.BurnEvent, error) {
+	if mint == nil {
+		return nil, fmt.Errorf("mint %s not found", watch.Mint)
+	}
+	supply, decimals, err := parseMint(mint)
+	if err != nil {
+		return nil, err
+	}
+	previous, burned := parseAmount(watch.Supply), parseAmount(watch.Burned)
+	baseline := watch.CheckedAt.IsZero()
+
+	var amount uint64
+	if !baseline && supply < previous {
+		amount = previous - supply
+		burned += amount
+	}
+	watch.Decimals = decimals
+	watch.Supply = strconv.FormatUint(supply, 10)
+	watch.Burned = strconv.FormatUint(burned, 10)
+	watch.BurnedPercent = percent(burned, supply+burned)
+	watch.CheckedAt = now
+	if amount == 0 {
+		return nil, nil
+	}
+	watch.LastBurnAt = now
+	return newBurnEvent(watch, amount, now), nil
+}
+
+// checkLiquidity updates a liquidity watch from its pool and LP mint. The LP
+// tokens burned are those the pool counts as outstanding but the mint no
+// longer has.
+func (c *Checker) checkLiquidity(watch *model.BurnWatch, poolAccount, mint *bmodel.AccountInfo, now time.Time) (*model.BurnEvent, error) {
+	if poolAccount == nil || mint == nil {
+		return nil, fmt.Errorf("pool %s or its LP mint not found", watch.Pool)
+	}
+	pool, err := parsePool(poolAccount)
+	if err != nil {
+		return nil, err
+	}
+	supply, decimals, err := parseMint(mint)
+	if err != nil {
+		return nil, err
+	}
+	var burned uint64
+	if pool.LPSupply > supply {
+		burned = pool.LPSupply - supply
+	}
+	previous := parseAmount(watch.Burned)
+	baseline := watch.CheckedAt.IsZero()
+
+	watch.Decimals = decimals
+	watch.Supply = strconv.FormatUint(supply, 10)
+	watch.Burned = strconv.FormatUint(burned, 10)
+	watch.BurnedPercent = percent(burned, pool.LPSupply)
+	watch.CheckedAt = now
+	if baseline || burned <= previous {
+		return nil, nil
+	}
+	watch.LastBurnAt = now
+	return newBurnEvent(watch, burned-previous, now), nil
+}
+
+func newBurnEvent(watch *model.BurnWatch, amount uint64, now time.Time) *model.BurnEvent {
+	return &model.BurnEvent{
+		ID:            uuid.NewString(),
+		CoinAddress:   watch.CoinAddress,
+		Kind:          watch.Kind,
+		Mint:          watch.Mint,
+		Pool:          watch.Pool,
+		Amount:        strconv.FormatUint(amount, 10),
+		Decimals:      watch.Decimals,
+		BurnedPercent: watch.BurnedPercent,
+		DetectedAt:    now,
+	}
+}
+
+// Summary is what coin detail shows about a coin's burns. Percentages are nil
+// until the coin or one of its pools has been checked.
+type Summary struct {
+	SupplyBurnedPercent *float64
+	// LPBurnedPercent is the smallest share burned across the coin's pools,
+	// so one pool with burned LP does not vouch for the others
+	LPBurnedPercent *float64
+	LastBurnAt      time.Time
+}
+
+// Summary returns the burns recorded for a coin.
+func (c *Checker) Summary(ctx context.Context, coinAddress string) (*Summary, error) {
+	watches, _, err := c.store.BurnWatches().ListWithOpts(ctx, db.ListOptions{
+		Filters: []db.FilterOption{{Field: "coin_address", Operator: db.FilterOpEqual, Value: coinAddress}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load burn watches: %w", err)
+	}
+	summary := &Summary{}
+	for _, watch := range watches {
+		if watch.CheckedAt.IsZero() {
+			continue
+		}
+		burnedPercent := watch.BurnedPercent
+		switch watch.Kind {
+		case model.BurnKindSupply:
+			summary.SupplyBurnedPercent = &burnedPercent
+		case model.BurnKindLiquidity:
+			if summary.LPBurnedPercent == nil || burnedPercent < *summary.LPBurnedPercent {
+				summary.LPBurnedPercent = &burnedPercent
+			}
+		}
+		if watch.LastBurnAt.After(summary.LastBurnAt) {
+			summary.LastBurnAt = watch.LastBurnAt
+		}
+	}
+	return summary, nil
+}
+
+func parseAmount(amount string) uint64 {
+	value, _ := strconv.ParseUint(amount, 10, 64)
+	return value
+}
+
+func percent(part, total uint64) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(part) / float64(total) * 100
+}
@@ -0,0 +1,157 @@
+package burn
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	dbmocks "github.com/nicolas-martin/dankfolio/backend/internal/db/mocks"
+	"github.com/nicolas-martin/dankfolio/backend/internal/events"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	bmodel "github.com/nicolas-martin/dankfolio/backend/internal/model/blockchain"
+)
+
+const testCoin = "7GCihgDB8fe6KNjn2MYtkzZcRjQy3t9GHdC8uHYmW2hr"
+
+func mintAccount(address string, supply uint64, decimals uint8) *bmodel.AccountInfo {
+	data := make([]byte, mintMinLength)
+	binary.LittleEndian.PutUint64(data[mintSupplyOffset:], supply)
+	data[mintDecimalsOffset] = decimals
+	return &bmodel.AccountInfo{Address: bmodel.Address(address), Data: data}
+}
+
+func raydiumPool(address string, lpMint solana.PublicKey, lpReserve uint64) *bmodel.AccountInfo {
+	layout := ammLayouts[0]
+	data := make([]byte, layout.dataSize)
+	copy(data[layout.lpMintOffset:], lpMint.Bytes())
+	binary.LittleEndian.PutUint64(data[layout.lpSupplyOffset:], lpReserve)
+	return &bmodel.AccountInfo{Address: bmodel.Address(address), Owner: layout.program, Data: data}
+}
+
+type fakeChain struct {
+	accounts map[bmodel.Address]*bmodel.AccountInfo
+}
+
+func (c *fakeChain) GetMultipleAccounts(_ context.Context, addresses []bmodel.Address, _ string) ([]*bmodel.AccountInfo, error) {
+	result := make([]*bmodel.AccountInfo, len(addresses))
+	for i, address := range addresses {
+		result[i] = c.accounts[address]
+	}
+	return result, nil
+}
+
+func (c *fakeChain) FindProgramAccounts(context.Context, bmodel.Address, uint64, uint64, bmodel.Address, string) ([]*bmodel.AccountInfo, error) {
+	return nil, nil
+}
+
+type recordingBus struct {
+	published []events.Event
+}
+
+func (b *recordingBus) Publish(_ context.Context, event events.Event) {
+	b.published = append(b.published, event)
+}
+
+func (b *recordingBus) Subscribe(events.Handler, ...events.Type) func() { return func() {} }
+
+func (b *recordingBus) Close() {}
+
+func TestCheckSupply(t *testing.T) {
+	checker := NewChecker(Config{}, nil, nil, nil)
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	watch := &model.BurnWatch{Mint: testCoin, CoinAddress: testCoin, Kind: model.BurnKindSupply}
+
+	burn, err := checker.checkSupply(watch, mintAccount(testCoin, 1_000_000, 6), now)
+	require.NoError(t, err)
+	assert.Nil(t, burn, "the first reading is only a baseline")
+	assert.Equal(t, "1000000", watch.Supply)
+
+	burn, err = checker.checkSupply(watch, mintAccount(testCoin, 750_000, 6), now.Add(time.Hour))
+	require.NoError(t, err)
+	require.NotNil(t, burn)
+	assert.Equal(t, "250000", burn.Amount)
+	assert.Equal(t, 6, burn.Decimals)
+	assert.InDelta(t, 25.0, burn.BurnedPercent, 1e-9)
+	assert.Equal(t, now.Add(time.Hour), watch.LastBurnAt)
+
+	// Minting more is not a burn and leaves the burned amount alone
+	burn, err = checker.checkSupply(watch, mintAccount(testCoin, 1_250_000, 6), now.Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.Nil(t, burn)
+	assert.Equal(t, "250000", watch.Burned)
+	assert.InDelta(t, 250_000.0/1_500_000*100, watch.BurnedPercent, 1e-9)
+}
+
+func TestCheckLiquidity(t *testing.T) {
+	checker := NewChecker(Config{}, nil, nil, nil)
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	lpMint := solana.NewWallet().PublicKey()
+	poolAddress := solana.NewWallet().PublicKey().String()
+	watch := &model.BurnWatch{Mint: lpMint.String(), CoinAddress: testCoin, Kind: model.BurnKindLiquidity, Pool: poolAddress}
+
+	burn, err := checker.checkLiquidity(watch, raydiumPool(poolAddress, lpMint, 1000), mintAccount(lpMint.String(), 1000, 9), now)
+	require.NoError(t, err)
+	assert.Nil(t, burn)
+	assert.Zero(t, watch.BurnedPercent)
+
+	// A withdrawal lowers the pool's count and the supply together
+	burn, err = checker.checkLiquidity(watch, raydiumPool(poolAddress, lpMint, 800), mintAccount(lpMint.String(), 800, 9), now)
+	require.NoError(t, err)
+	assert.Nil(t, burn)
+
+	// Burning LP tokens only lowers the supply
+	burn, err = checker.checkLiquidity(watch, raydiumPool(poolAddress, lpMint, 800), mintAccount(lpMint.String(), 0, 9), now)
+	require.NoError(t, err)
+	require.NotNil(t, burn)
+	assert.Equal(t, "800", burn.Amount)
+	assert.Equal(t, poolAddress, burn.Pool)
+	assert.InDelta(t, 100.0, burn.BurnedPercent, 1e-9)
+}
+
+func TestCheck_RecordsAndPublishesBurns(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	store := dbmocks.NewMockStore(t)
+	coins := dbmocks.NewMockRepository[model.Coin](t)
+	watches := dbmocks.NewMockRepository[model.BurnWatch](t)
+	burns := dbmocks.NewMockRepository[model.BurnEvent](t)
+	store.EXPECT().Coins().Return(coins)
+	store.EXPECT().BurnWatches().Return(watches)
+	store.EXPECT().BurnEvents().Return(burns)
+	store.EXPECT().WithTransaction(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, fn func(db.Store) error) error {
+		return fn(store)
+	})
+
+	coins.EXPECT().ListWithOpts(mock.Anything, mock.Anything).Return([]model.Coin{{Address: testCoin}, {Address: model.SolMint}}, int32(2), nil)
+	watches.EXPECT().List(mock.Anything, mock.Anything).Return([]model.BurnWatch{{
+		Mint:           testCoin,
+		CoinAddress:    testCoin,
+		Kind:           model.BurnKindSupply,
+		Supply:         "1000",
+		Burned:         "0",
+		CheckedAt:      now.Add(-time.Hour),
+		PoolsCheckedAt: now.Add(-time.Hour), // No pool lookup this time
+	}}, int32(1), nil)
+	watches.EXPECT().BulkUpsert(mock.Anything, mock.MatchedBy(func(items *[]model.BurnWatch) bool {
+		return len(*items) == 1 && (*items)[0].Supply == "900" && (*items)[0].Burned == "100"
+	})).Return(int64(1), nil)
+	burns.EXPECT().Create(mock.Anything, mock.MatchedBy(func(burn *model.BurnEvent) bool {
+		return burn.CoinAddress == testCoin && burn.Amount == "100" && burn.Kind == model.BurnKindSupply
+	})).Return(nil)
+
+	bus := &recordingBus{}
+	chain := &fakeChain{accounts: map[bmodel.Address]*bmodel.AccountInfo{testCoin: mintAccount(testCoin, 900, 6)}}
+	checker := NewChecker(Config{}, store, chain, bus)
+	checker.now = func() time.Time { return now }
+
+	require.NoError(t, checker.Check(context.Background()))
+	require.Len(t, bus.published, 1)
+	assert.Equal(t, events.CoinBurned, bus.published[0].Type)
+	assert.Equal(t, "100", bus.published[0].Payload.(events.BurnPayload).Burn.Amount)
+}
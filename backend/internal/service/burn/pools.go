@@ -0,0 +1,97 @@
+package burn
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/gagliardetto/solana-go"
+
+	bmodel "github.com/nicolas-martin/dankfolio/backend/internal/model/blockchain"
+)
+
+// ammLayout says where an AMM keeps a pool's mints and its own count of the
+// LP tokens it has issued. Withdrawals lower that count along with the LP
+// mint's supply, but burning LP tokens only lowers the supply, so the gap
+// between the two is what was burned.
+type ammLayout struct {
+	name           string
+	program        bmodel.Address
+	dataSize       uint64    // 0 when the pool account size differs across program versions
+	mintOffsets    [2]uint64 // Either side of the pair
+	lpMintOffset   uint64
+	lpSupplyOffset uint64
+}
+
+var ammLayouts = []ammLayout{
+	{
+		name:           "raydium-amm-v4",
+		program:        "675kPX9MHTjS2zt1qfr1NYHuzeLXfQM9H24wFSUt1Mp8",
+		dataSize:       752,
+		mintOffsets:    [2]uint64{400, 432},
+		lpMintOffset:   464,
+		lpSupplyOffset: 720, // lp_reserve
+	},
+	{
+		name:           "raydium-cpmm",
+		program:        "CPMMoo8L3F4NbTegBCKVNunggL7H1ZpdTHKxQB5qKP1C",
+		dataSize:       637,
+		mintOffsets:    [2]uint64{168, 200},
+		lpMintOffset:   136,
+		lpSupplyOffset: 333,
+	},
+	{
+		name:           "pumpswap",
+		program:        "pAMMBay6oceH9fJKBRHGP5D4bD4sWpmSwMn52FMfXEA",
+		mintOffsets:    [2]uint64{43, 75},
+		lpMintOffset:   107,
+		lpSupplyOffset: 203,
+	},
+}
+
+// layoutFor returns the layout of pools owned by program
+func layoutFor(program bmodel.Address) (ammLayout, bool) {
+	for _, layout := range ammLayouts {
+		if layout.program == program {
+			return layout, true
+		}
+	}
+	return ammLayout{}, false
+}
+
+// pool is the part of an AMM pool account the checker reads
+type pool struct {
+	Address  bmodel.Address
+	LPMint   bmodel.Address
+	LPSupply uint64 // LP tokens outstanding by the pool's own accounting
+}
+
+func parsePool(account *bmodel.AccountInfo) (pool, error) {
+	layout, ok := layoutFor(account.Owner)
+	if !ok {
+		return pool{}, fmt.Errorf("pool %s is owned by unsupported program %s", account.Address, account.Owner)
+	}
+	if uint64(len(account.Data)) < layout.lpSupplyOffset+8 {
+		return pool{}, fmt.Errorf("%s pool %s is too short: %d bytes", layout.name, account.Address, len(account.Data))
+	}
+	lpMint := solana.PublicKeyFromBytes(account.Data[layout.lpMintOffset : layout.lpMintOffset+32])
+	return pool{
+		Address:  account.Address,
+		LPMint:   bmodel.Address(lpMint.String()),
+		LPSupply: binary.LittleEndian.Uint64(account.Data[layout.lpSupplyOffset:]),
+	}, nil
+}
+
+// Offsets in the SPL mint layout, which Token-2022 mints share
+const (
+	mintSupplyOffset   = 36
+	mintDecimalsOffset = 44
+	mintMinLength      = 82
+)
+
+// parseMint returns a mint account's supply and decimals
+func parseMint(account *bmodel.AccountInfo) (supply uint64, decimals int, err error) {
+	if len(account.Data) < mintMinLength {
+		return 0, 0, fmt.Errorf("mint %s is too short: %d bytes", account.Address, len(account.Data))
+	}
+	return binary.LittleEndian.Uint64(account.Data[mintSupplyOffset:]), int(account.Data[mintDecimalsOffset]), nil
+}
@@ -0,0 +1,85 @@
+package coin
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+const (
+	// JobArchiveCoins moves coins that have gone inactive to the archive table
+	JobArchiveCoins = "coin.archive.inactive"
+
+	// DefaultArchiveInactiveFor is used when Config.ArchiveInactiveFor is unset
+	DefaultArchiveInactiveFor = 14 * 24 * time.Hour
+
+	// Coins at or below both of these are considered dead
+	ArchiveMaxVolume24hUSD = 1.0
+	ArchiveMaxLiquidity    = 100.0
+
+	// archiveBatchSize bounds how many coins one transaction moves, so a large
+	// backlog does not hold locks on the coins table for long
+	archiveBatchSize = 500
+	// archiveMaxBatches bounds one run; the rest is picked up by the next one
+	archiveMaxBatches = 20
+)
+
+// archiveExcludedTags are never archived, whatever their volume
+var archiveExcludedTags = []string{"xstocks"}
+
+// NewArchiveCriteria returns the criteria the archive job uses for coins that
+// have been inactive for inactiveFor as of now
+func NewArchiveCriteria(inactiveFor time.Duration, now time.Time) db.ArchiveCriteria {
+	return db.ArchiveCriteria{
+		InactiveSince:   now.Add(-inactiveFor),
+		MaxVolume24hUSD: ArchiveMaxVolume24hUSD,
+		MaxLiquidity:    ArchiveMaxLiquidity,
+		ExcludeTags:     archiveExcludedTags,
+		ExcludeMints:    []string{model.SolMint, model.NativeSolMint},
+		Limit:           archiveBatchSize,
+	}
+}
+
+// ArchiveInactiveCoins moves coins with no volume or liquidity that have not
+// been updated or traded for the configured period to the archive table.
+func (s *Service) ArchiveInactiveCoins(ctx context.Context) error {
+	inactiveFor := DefaultArchiveInactiveFor
+	if s.config != nil && s.config.ArchiveInactiveFor > 0 {
+		inactiveFor = s.config.ArchiveInactiveFor
+	}
+	criteria := NewArchiveCriteria(inactiveFor, time.Now())
+
+	total := 0
+	for range archiveMaxBatches {
+		archived, err := s.store.ArchiveInactiveCoins(ctx, criteria)
+		if err != nil {
+			return fmt.Errorf("failed to archive inactive coins after %d archived: %w", total, err)
+		}
+		total += len(archived)
+		if len(archived) < criteria.Limit {
+			break
+		}
+	}
+	slog.InfoContext(ctx, "Archived inactive coins", slog.Int("count", total), slog.Duration("inactive_for", inactiveFor))
+	return nil
+}
+
+func (s *Service) runArchiveJob(ctx context.Context) {
+	s.runPeriodicFetcher(ctx, JobArchiveCoins, s.ArchiveInactiveCoins)
+}
+
+// SearchArchivedCoins searches coins that were archived for inactivity, dropping blocklisted mints
+func (s *Service) SearchArchivedCoins(ctx context.Context, query string, limit, offset int32) ([]model.Coin, error) {
+	if len(query) > 256 {
+		return nil, fmt.Errorf("query string too long (max 256 chars): %d", len(query))
+	}
+	coins, err := s.store.SearchArchivedCoins(ctx, query, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search archived coins via store: %w", err)
+	}
+	return s.filterBlocked(coins), nil
+}
@@ -0,0 +1,35 @@
+package coin
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	dbmocks "github.com/nicolas-martin/dankfolio/backend/internal/db/mocks"
+)
+
+func TestArchiveInactiveCoins_BatchesUntilShortBatch(t *testing.T) {
+	store := dbmocks.NewMockStore(t)
+	s := &Service{store: store, config: &Config{ArchiveInactiveFor: 7 * 24 * time.Hour}}
+
+	full := make([]string, archiveBatchSize)
+	for i := range full {
+		full[i] = fmt.Sprintf("mint%d", i)
+	}
+	matchesCriteria := mock.MatchedBy(func(c db.ArchiveCriteria) bool {
+		since := time.Since(c.InactiveSince)
+		return !c.DryRun && c.Limit == archiveBatchSize &&
+			since > 7*24*time.Hour-time.Minute && since < 7*24*time.Hour+time.Minute &&
+			assert.ObjectsAreEqual(archiveExcludedTags, c.ExcludeTags)
+	})
+	store.EXPECT().ArchiveInactiveCoins(mock.Anything, matchesCriteria).Return(full, nil).Once()
+	store.EXPECT().ArchiveInactiveCoins(mock.Anything, matchesCriteria).Return([]string{"last"}, nil).Once()
+
+	require.NoError(t, s.ArchiveInactiveCoins(context.Background()))
+}
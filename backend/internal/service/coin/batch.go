@@ -2,13 +2,13 @@ package coin
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"log/slog"
 	"sync"
 
 	"github.com/nicolas-martin/dankfolio/backend/internal/clients/birdeye"
 	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/events"
 	"github.com/nicolas-martin/dankfolio/backend/internal/model"
 	"github.com/nicolas-martin/dankfolio/backend/internal/util"
 )
@@ -166,47 +166,23 @@ func (s *Service) fetchCoinsBatch(ctx context.Context, addresses []string) ([]mo
 		return []model.Coin{}, nil
 	}
 
-	maxWorkers := s.birdeyeClient.GetMaxWorkers()
-	const bufferSize = 10
+	s.fetchPool.SetMaxWorkers(s.birdeyeClient.GetMaxWorkers())
 
-	type coinFetchJob struct {
-		address string
-	}
-
-	// Create job and result channels
-	jobs := make(chan coinFetchJob, bufferSize)
+	// Queue one task per address; results are buffered so tasks never block
 	results := make(chan coinFetchResult, len(addresses))
-
-	// Start worker goroutines
-	var wg sync.WaitGroup
-	for i := 0; i < maxWorkers; i++ {
-		wg.Add(1)
-		go func(workerID int) {
-			defer wg.Done()
-			for job := range jobs {
-				result := s.fetchSingleCoin(ctx, job.address, workerID)
-				results <- result
-			}
-		}(i)
-	}
-
-	// Send jobs to workers
-	go func() {
-		defer close(jobs)
-		for _, address := range addresses {
-			select {
-			case jobs <- coinFetchJob{address: address}:
-			case <-ctx.Done():
-				return
-			}
+	group := s.fetchPool.Group()
+	for _, address := range addresses {
+		err := group.Go(ctx, func(ctx context.Context) error {
+			result := s.fetchSingleCoin(ctx, address)
+			results <- result
+			return result.err
+		})
+		if err != nil {
+			break // Cancelled while waiting for room in the queue
 		}
-	}()
-
-	// Wait for all workers to complete
-	go func() {
-		wg.Wait()
-		close(results)
-	}()
+	}
+	group.Wait()
+	close(results)
 
 	// Collect results
 	var allCoins []model.Coin
@@ -248,13 +224,13 @@ type coinUpdateResult struct {
 	err  error
 }
 
-// fetchSingleCoin fetches and enriches a single coin (called by worker goroutines)
-func (s *Service) fetchSingleCoin(ctx context.Context, address string, workerID int) coinFetchResult {
+// fetchSingleCoin fetches and enriches a single coin (run on the fetch pool)
+func (s *Service) fetchSingleCoin(ctx context.Context, address string) coinFetchResult {
 	// Special handling for native SOL
 	if address == model.NativeSolMint {
 		nativeSol, err := s.getNativeSolCoin(ctx)
 		if err != nil {
-			slog.WarnContext(ctx, "Worker failed to get native SOL", "worker_id", workerID, "error", err)
+			slog.WarnContext(ctx, "Worker failed to get native SOL", "error", err)
 			return coinFetchResult{coin: nil, err: err}
 		}
 		return coinFetchResult{coin: nativeSol, err: nil}
@@ -262,18 +238,18 @@ func (s *Service) fetchSingleCoin(ctx context.Context, address string, workerID
 
 	tokenOverview, err := s.birdeyeClient.GetTokenOverview(ctx, address)
 	if err != nil {
-		slog.WarnContext(ctx, "Worker failed to fetch token overview", "worker_id", workerID, "address", address, "error", err)
+		slog.WarnContext(ctx, "Worker failed to fetch token overview", "address", address, "error", err)
 		return coinFetchResult{coin: nil, err: err}
 	}
 
 	if tokenOverview == nil || !tokenOverview.Success || tokenOverview.Data.Address == "" {
-		slog.WarnContext(ctx, "Worker received invalid token overview", "worker_id", workerID, "address", address)
+		slog.WarnContext(ctx, "Worker received invalid token overview", "address", address)
 		return coinFetchResult{coin: nil, err: fmt.Errorf("invalid token overview response")}
 	}
 
 	// Check for naughty words
 	if s.coinContainsNaughtyWord(tokenOverview.Data.Name, "") {
-		slog.InfoContext(ctx, "Worker skipping token with inappropriate content", "worker_id", workerID, "address", address, "name", tokenOverview.Data.Name)
+		slog.InfoContext(ctx, "Worker skipping token with inappropriate content", "address", address, "name", tokenOverview.Data.Name)
 		return coinFetchResult{coin: nil, err: fmt.Errorf("inappropriate content")}
 	}
 
@@ -298,17 +274,17 @@ func (s *Service) fetchSingleCoin(ctx context.Context, address string, workerID
 	// Enrich the coin data
 	enrichedCoin, err := s.EnrichCoinData(ctx, tokenDetails)
 	if err != nil {
-		slog.ErrorContext(ctx, "Worker failed to enrich coin data", "worker_id", workerID, "address", address, "error", err)
+		slog.ErrorContext(ctx, "Worker failed to enrich coin data", "address", address, "error", err)
 		return coinFetchResult{coin: nil, err: err}
 	}
 
 	// Check for naughty words after enrichment
 	if s.coinContainsNaughtyWord(enrichedCoin.Name, enrichedCoin.Description) {
-		slog.InfoContext(ctx, "Worker skipping enriched token with inappropriate content", "worker_id", workerID, "address", enrichedCoin.Address, "name", enrichedCoin.Name)
+		slog.InfoContext(ctx, "Worker skipping enriched token with inappropriate content", "address", enrichedCoin.Address, "name", enrichedCoin.Name)
 		return coinFetchResult{coin: nil, err: fmt.Errorf("inappropriate content after enrichment")}
 	}
 
-	slog.DebugContext(ctx, "Worker successfully fetched and enriched coin", "worker_id", workerID, "address", address, "name", enrichedCoin.Name)
+	slog.DebugContext(ctx, "Worker successfully fetched and enriched coin", "address", address, "name", enrichedCoin.Name)
 	return coinFetchResult{coin: enrichedCoin, err: nil}
 }
 
@@ -320,37 +296,30 @@ func (s *Service) updateCoinsBatch(ctx context.Context, coins []model.Coin) ([]m
 
 	updatedCoins := make([]model.Coin, 0, len(coins))
 	var mu sync.Mutex
-	var wg sync.WaitGroup
-
-	// Use a semaphore to limit concurrent API calls
-	sem := make(chan struct{}, 5) // Limit to 5 concurrent updates
 
+	group := s.updatePool.Group()
 	for _, coin := range coins {
-		wg.Add(1)
-		coinCopy := coin // Important: capture loop variable
-
-		go func() {
-			defer wg.Done()
-			sem <- struct{}{}        // Acquire semaphore
-			defer func() { <-sem }() // Release semaphore
-
+		err := group.Go(ctx, func(ctx context.Context) error {
 			// Update market data for this coin
-			updated, err := s.updateCoinMarketData(ctx, &coinCopy)
+			updated, err := s.updateCoinMarketData(ctx, &coin)
 			if err != nil {
 				slog.WarnContext(ctx, "Failed to update coin market data in batch",
-					"address", coinCopy.Address,
+					"address", coin.Address,
 					"error", err)
 				// Use original coin if update fails
-				updated = &coinCopy
+				updated = &coin
 			}
 
 			mu.Lock()
 			updatedCoins = append(updatedCoins, *updated)
 			mu.Unlock()
-		}()
+			return err
+		})
+		if err != nil {
+			break // Cancelled while waiting for room in the queue
+		}
 	}
-
-	wg.Wait()
+	group.Wait()
 
 	slog.InfoContext(ctx, "Batch coin update completed",
 		"requested", len(coins),
@@ -361,43 +330,66 @@ func (s *Service) updateCoinsBatch(ctx context.Context, coins []model.Coin) ([]m
 
 // batchCreateCoinsInDB creates multiple coins in the database efficiently
 func (s *Service) batchCreateCoinsInDB(ctx context.Context, coins []model.Coin) error {
-	var coinsToCreate []model.Coin
-	var coinsToUpdate []model.Coin
-
 	// Process logo URLs through image proxy before saving
 	s.processLogoURLs(ctx, coins)
 
-	// Check which coins already exist
+	stored, discovered, err := upsertCoins(ctx, s.store.Coins(), coins)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to batch upsert coins", "count", len(coins), "error", err)
+		return nil
+	}
+	for _, coin := range discovered {
+		s.publishEvent(ctx, events.NewCoinEvent(events.CoinDiscovered, coin))
+	}
+
+	slog.DebugContext(ctx, "Successfully batch processed coins in database", "created", len(discovered), "updated", len(stored)-len(discovered))
+	return nil
+}
+
+// upsertCoins writes coins with batched upserts on their address, rather
+// than a lookup and a write per coin. It returns the coins as stored, with
+// their IDs, and those of them that weren't stored before. A coin repeating
+// an address is written once, as the last of them. Coins that were
+// soft-deleted are updated but stay deleted, and are left out of both.
+func upsertCoins(ctx context.Context, repo db.Repository[model.Coin], coins []model.Coin) (stored, discovered []model.Coin, err error) {
+	index := make(map[string]int, len(coins))
+	addresses := make([]string, 0, len(coins))
 	for _, coin := range coins {
-		existingCoin, getErr := s.store.Coins().GetByField(ctx, "address", coin.Address)
-		if getErr == nil && existingCoin != nil {
-			// Coin exists, prepare for update
-			coin.ID = existingCoin.ID
-			coinsToUpdate = append(coinsToUpdate, coin)
-		} else if errors.Is(getErr, db.ErrNotFound) {
-			// Coin doesn't exist, prepare for creation
-			coinsToCreate = append(coinsToCreate, coin)
+		if i, ok := index[coin.Address]; ok {
+			stored[i] = coin
+			continue
 		}
+		index[coin.Address] = len(stored)
+		stored = append(stored, coin)
+		addresses = append(addresses, coin.Address)
+	}
+	if len(stored) == 0 {
+		return nil, nil, nil
 	}
 
-	// Batch create new coins
-	if len(coinsToCreate) > 0 {
-		for _, coin := range coinsToCreate {
-			if createErr := s.store.Coins().Create(ctx, &coin); createErr != nil {
-				slog.WarnContext(ctx, "Failed to create coin in batch", "address", coin.Address, "error", createErr)
-			}
-		}
+	existing, err := repo.GetByAddresses(ctx, addresses)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to look up stored coins: %w", err)
+	}
+	known := make(map[string]bool, len(existing))
+	for _, coin := range existing {
+		known[coin.Address] = true
 	}
 
-	// Batch update existing coins
-	if len(coinsToUpdate) > 0 {
-		if _, err := s.store.Coins().BulkUpsert(ctx, &coinsToUpdate); err != nil {
-			slog.WarnContext(ctx, "Failed to bulk update existing coins", "error", err)
+	if _, err := repo.BulkWrite(ctx, &stored, db.BulkWriteOptions{Returning: []string{"id", "deleted_at"}}); err != nil {
+		return nil, nil, fmt.Errorf("failed to upsert coins: %w", err)
+	}
+	live := stored[:0]
+	for _, coin := range stored {
+		if coin.Deleted != nil {
+			continue
+		}
+		live = append(live, coin)
+		if !known[coin.Address] {
+			discovered = append(discovered, coin)
 		}
 	}
-
-	slog.DebugContext(ctx, "Successfully batch processed coins in database", "created", len(coinsToCreate), "updated", len(coinsToUpdate))
-	return nil
+	return live, discovered, nil
 }
 
 // fetchCoinsIndividually is a fallback method that fetches coins one by one
@@ -0,0 +1,40 @@
+package coin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	dbmocks "github.com/nicolas-martin/dankfolio/backend/internal/db/mocks"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+func TestUpsertCoins(t *testing.T) {
+	repo := dbmocks.NewMockRepository[model.Coin](t)
+	repo.EXPECT().GetByAddresses(mock.Anything, []string{"known", "new", "deleted"}).Return([]model.Coin{{ID: 1, Address: "known"}}, nil)
+	repo.EXPECT().BulkWrite(mock.Anything, mock.Anything, db.BulkWriteOptions{Returning: []string{"id", "deleted_at"}}).
+		RunAndReturn(func(_ context.Context, coins *[]model.Coin, _ db.BulkWriteOptions) (int64, error) {
+			for i := range *coins {
+				(*coins)[i].ID = uint64(i + 1)
+				if (*coins)[i].Address == "deleted" {
+					(*coins)[i].Deleted = &model.Deletion{By: "admin"}
+				}
+			}
+			return int64(len(*coins)), nil
+		}).Once()
+
+	stored, discovered, err := upsertCoins(context.Background(), repo, []model.Coin{
+		{Address: "known", Name: "Old"},
+		{Address: "new", Name: "New"},
+		{Address: "known", Name: "Latest"},
+		{Address: "deleted", Name: "Deleted"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []model.Coin{{ID: 1, Address: "known", Name: "Latest"}, {ID: 2, Address: "new", Name: "New"}}, stored,
+		"a repeated address is written once, as its last coin, and deleted coins are left out")
+	assert.Equal(t, []model.Coin{{ID: 2, Address: "new", Name: "New"}}, discovered)
+}
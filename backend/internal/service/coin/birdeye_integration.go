@@ -86,10 +86,9 @@ func (s *Service) UpdateTrendingTokensFromBirdeye(ctx context.Context) (*Trendin
 // processBirdeyeTokens takes Birdeye token details and enriches them using external APIs concurrently.
 // It now filters out tokens with naughty names or descriptions.
 func (s *Service) processBirdeyeTokens(ctx context.Context, tokensToEnrich []birdeye.TokenDetails) ([]model.Coin, error) {
-	scrapeMaxConcurrentEnrich := 3
 	slog.InfoContext(ctx, "Executing token enrichment with Birdeye data",
 		slog.Int("token_count", len(tokensToEnrich)),
-		slog.Int("concurrency", scrapeMaxConcurrentEnrich))
+		slog.Int("concurrency", s.enrichPool.Stats().Workers))
 
 	cleanedToken := make([]birdeye.TokenDetails, 0)
 	filteredCount := 0
@@ -112,9 +111,7 @@ func (s *Service) processBirdeyeTokens(ctx context.Context, tokensToEnrich []bir
 			slog.Int("remaining_count", len(cleanedToken)))
 	}
 	enrichedCoinsResult := make([]model.Coin, 0, len(cleanedToken))
-	var wg sync.WaitGroup
 	var mu sync.Mutex // Protects enrichedCoinsResult
-	sem := make(chan struct{}, scrapeMaxConcurrentEnrich)
 	var encounteredErrors []error
 	var errMu sync.Mutex // Mutex to protect encounteredErrors slice
 
@@ -122,25 +119,18 @@ func (s *Service) processBirdeyeTokens(ctx context.Context, tokensToEnrich []bir
 	enrichCtx, cancelEnrich := context.WithTimeout(ctx, enrichPhaseTimeout)
 	defer cancelEnrich()
 
-	for _, birdeyeTokenLoopVar := range cleanedToken {
-		token := birdeyeTokenLoopVar // Capture range variable
-
-		// Check name before starting goroutine and enrichment
-		if s.containsNaughtyWord(token.Name) {
+	group := s.enrichPool.Group()
+	for _, currentToken := range cleanedToken {
+		// Check name before queueing enrichment
+		if s.containsNaughtyWord(currentToken.Name) {
 			slog.InfoContext(ctx, "Skipping token due to naughty name (pre-enrichment)",
-				slog.String("name", token.Name),
-				slog.String("address", token.Address))
+				slog.String("name", currentToken.Name),
+				slog.String("address", currentToken.Address))
 			continue // Skip this token entirely
 		}
 
-		wg.Add(1)
-		sem <- struct{}{}
-
-		go func(currentToken birdeye.TokenDetails) { // Use currentToken which is a copy
-			defer wg.Done()
-			defer func() { <-sem }()
-
-			enrichTaskCtx, enrichTaskCancel := context.WithTimeout(enrichCtx, 45*time.Second)
+		queueErr := group.Go(enrichCtx, func(ctx context.Context) error {
+			enrichTaskCtx, enrichTaskCancel := context.WithTimeout(ctx, 45*time.Second)
 			defer enrichTaskCancel()
 
 			slog.DebugContext(enrichTaskCtx, "Enriching token from Birdeye data", "name", currentToken.Name, "mint_address", currentToken.Address)
@@ -156,7 +146,7 @@ func (s *Service) processBirdeyeTokens(ctx context.Context, tokensToEnrich []bir
 				encounteredErrors = append(encounteredErrors, fmt.Errorf("%s", errMessage))
 				errMu.Unlock()
 				if enriched == nil {
-					return
+					return err
 				}
 				slog.WarnContext(enrichTaskCtx, "Adding partially enriched data despite error", "name", currentToken.Name, "mint_address", currentToken.Address)
 			}
@@ -165,7 +155,7 @@ func (s *Service) processBirdeyeTokens(ctx context.Context, tokensToEnrich []bir
 				slog.ErrorContext(enrichTaskCtx, "EnrichCoinData returned nil without explicit error",
 					slog.String("name", currentToken.Name),
 					slog.String("mint_address", currentToken.Address))
-				return
+				return nil
 			}
 
 			// Check description after enrichment
@@ -174,18 +164,21 @@ func (s *Service) processBirdeyeTokens(ctx context.Context, tokensToEnrich []bir
 					slog.String("name", enriched.Name),
 					slog.String("address", enriched.Address),
 					slog.String("description_preview", enriched.Description[:min(len(enriched.Description), 100)]))
-				return // Skip this token
+				return err // Skip this token
 			}
 
 			mu.Lock()
 			enrichedCoinsResult = append(enrichedCoinsResult, *enriched)
 			mu.Unlock()
 			slog.InfoContext(enrichTaskCtx, "Successfully processed and kept token after enrichment", "name", enriched.Name, "address", enriched.Address)
-		}(token) // Pass the captured token to the goroutine
+			return err
+		})
+		if queueErr != nil {
+			break // The enrichment phase timed out while waiting for room in the queue
+		}
 	}
 
-	wg.Wait()
-	close(sem)
+	group.Wait()
 
 	if len(encounteredErrors) > 0 {
 		slog.WarnContext(ctx, "Enrichment finished with errors, proceeding with successfully enriched and non-naughty tokens",
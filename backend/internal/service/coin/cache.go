@@ -12,3 +12,12 @@ const CoinCacheExpiry = 2 * time.Minute
 
 // NewCoinCache creates a new coin cache instance
 var NewCoinCache = cache.NewCoinCache
+
+type TradeStatsCache = cache.TradeStatsCache
+
+// TradeStatsCacheExpiry keeps trade stats fresh enough for momentum indicators
+// while absorbing bursts of detail-screen views
+const TradeStatsCacheExpiry = 30 * time.Second
+
+// NewTradeStatsCache creates a new trade stats cache instance
+var NewTradeStatsCache = cache.NewTradeStatsCache
@@ -0,0 +1,218 @@
+package coin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+
+	"github.com/gagliardetto/solana-go"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+// maxDescriptionLength bounds a single stored description
+const maxDescriptionLength = 4000
+
+// ErrInvalidDescription is returned when a coin description fails validation.
+var ErrInvalidDescription = errors.New("invalid coin description")
+
+// metadataDescriptionKeys are the metadata keys projects publish localized
+// descriptions under, as objects keyed by locale
+var metadataDescriptionKeys = []string{"descriptions", "description_i18n", "localized_descriptions"}
+
+// NormalizeLocale lowercases a BCP 47 tag and accepts "_" as a separator, so
+// "pt_BR" and "pt-br" match. It returns "" for anything that isn't a plausible tag.
+func NormalizeLocale(locale string) string {
+	locale = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+	if locale == "" || len(locale) > 35 {
+		return ""
+	}
+	for subtag := range strings.SplitSeq(locale, "-") {
+		if subtag == "" || len(subtag) > 8 {
+			return ""
+		}
+		for _, r := range subtag {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') {
+				return ""
+			}
+		}
+	}
+	return locale
+}
+
+// localeCandidates returns the locales to try for a request, most specific first
+func localeCandidates(locale string) []string {
+	candidates := []string{locale}
+	if base, _, ok := strings.Cut(locale, "-"); ok {
+		candidates = append(candidates, base)
+	}
+	return candidates
+}
+
+// descriptionsFromMetadata extracts localized descriptions from off-chain metadata,
+// looking at the top level and under "extensions". A "description" given as an
+// object is treated as localized too.
+func descriptionsFromMetadata(metadata map[string]any) map[string]string {
+	descriptions := make(map[string]string)
+	collect := func(source map[string]any) {
+		for _, key := range append([]string{"description"}, metadataDescriptionKeys...) {
+			localized, ok := source[key].(map[string]any)
+			if !ok {
+				continue
+			}
+			for locale, value := range localized {
+				text, ok := value.(string)
+				locale = NormalizeLocale(locale)
+				text = strings.TrimSpace(text)
+				if !ok || locale == "" || text == "" || len(text) > maxDescriptionLength {
+					continue
+				}
+				if _, exists := descriptions[locale]; !exists {
+					descriptions[locale] = text
+				}
+			}
+		}
+	}
+	if metadata == nil {
+		return descriptions
+	}
+	collect(metadata)
+	if extensions, ok := metadata["extensions"].(map[string]any); ok {
+		collect(extensions)
+	}
+	return descriptions
+}
+
+// storeMetadataDescriptions saves descriptions found in a coin's metadata. Locales
+// an admin has edited are left alone. Failures are logged; descriptions are supplementary.
+func (s *Service) storeMetadataDescriptions(ctx context.Context, coinAddress string, descriptions map[string]string) {
+	if len(descriptions) == 0 {
+		return
+	}
+	existing, err := s.ListCoinDescriptions(ctx, coinAddress)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to load existing coin descriptions", "address", coinAddress, "error", err)
+		return
+	}
+	current := make(map[string]model.CoinDescription, len(existing))
+	for _, d := range existing {
+		current[d.Locale] = d
+	}
+
+	rows := make([]model.CoinDescription, 0, len(descriptions))
+	for locale, text := range descriptions {
+		if prev, ok := current[locale]; ok && (prev.Source == model.CoinDescriptionSourceAdmin || prev.Description == text) {
+			continue
+		}
+		rows = append(rows, model.CoinDescription{
+			ID:          descriptionID(coinAddress, locale),
+			CoinAddress: coinAddress,
+			Locale:      locale,
+			Description: text,
+			Source:      model.CoinDescriptionSourceMetadata,
+		})
+	}
+	if len(rows) == 0 {
+		return
+	}
+	if _, err := s.store.CoinDescriptions().BulkUpsert(ctx, &rows); err != nil {
+		slog.WarnContext(ctx, "Failed to store coin descriptions", "address", coinAddress, "error", err)
+		return
+	}
+	slog.DebugContext(ctx, "Stored localized coin descriptions", "address", coinAddress, "locales", len(rows))
+}
+
+// LocalizedDescriptions returns the best description for locale for each coin that
+// has one, keyed by coin address. An exact locale match wins over its base language.
+// Coins missing from the result should keep their default description.
+func (s *Service) LocalizedDescriptions(ctx context.Context, locale string, coinAddresses []string) (map[string]string, error) {
+	locale = NormalizeLocale(locale)
+	if locale == "" || len(coinAddresses) == 0 {
+		return nil, nil
+	}
+	candidates := localeCandidates(locale)
+	rows, _, err := s.store.CoinDescriptions().List(ctx, db.ListOptions{Filters: []db.FilterOption{
+		{Field: "coin_address", Operator: db.FilterOpIn, Value: coinAddresses},
+		{Field: "locale", Operator: db.FilterOpIn, Value: candidates},
+	}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get coin descriptions: %w", err)
+	}
+
+	localized := make(map[string]string, len(rows))
+	for _, row := range rows {
+		if _, ok := localized[row.CoinAddress]; ok && row.Locale != locale {
+			continue
+		}
+		localized[row.CoinAddress] = row.Description
+	}
+	return localized, nil
+}
+
+// ListCoinDescriptions returns every stored description for a coin, sorted by locale.
+func (s *Service) ListCoinDescriptions(ctx context.Context, coinAddress string) ([]model.CoinDescription, error) {
+	rows, _, err := s.store.CoinDescriptions().List(ctx, db.ListOptions{Filters: []db.FilterOption{
+		{Field: "coin_address", Operator: db.FilterOpEqual, Value: coinAddress},
+	}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list descriptions for %s: %w", coinAddress, err)
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].Locale < rows[j].Locale })
+	return rows, nil
+}
+
+// SetCoinDescription stores an admin-written description for a locale.
+func (s *Service) SetCoinDescription(ctx context.Context, coinAddress, locale, description, updatedBy string) (*model.CoinDescription, error) {
+	if _, err := solana.PublicKeyFromBase58(coinAddress); err != nil {
+		return nil, fmt.Errorf("%w: invalid coin address %q", ErrInvalidDescription, coinAddress)
+	}
+	normalized := NormalizeLocale(locale)
+	if normalized == "" {
+		return nil, fmt.Errorf("%w: invalid locale %q", ErrInvalidDescription, locale)
+	}
+	description = strings.TrimSpace(description)
+	if description == "" || len(description) > maxDescriptionLength {
+		return nil, fmt.Errorf("%w: description must be 1-%d bytes", ErrInvalidDescription, maxDescriptionLength)
+	}
+
+	var previous string
+	if existing, err := s.store.CoinDescriptions().Get(ctx, descriptionID(coinAddress, normalized)); err == nil {
+		previous = existing.Description
+	}
+	entry := model.CoinDescription{
+		ID:          descriptionID(coinAddress, normalized),
+		CoinAddress: coinAddress,
+		Locale:      normalized,
+		Description: description,
+		Source:      model.CoinDescriptionSourceAdmin,
+		UpdatedBy:   updatedBy,
+	}
+	if _, err := s.store.CoinDescriptions().Upsert(ctx, &entry); err != nil {
+		return nil, fmt.Errorf("failed to save description for %s (%s): %w", coinAddress, normalized, err)
+	}
+	s.recordRevision(ctx, coinAddress, "description:"+normalized, previous, description, model.CoinSourceAdmin)
+	return s.store.CoinDescriptions().Get(ctx, entry.ID)
+}
+
+// DeleteCoinDescription removes the description for a locale.
+func (s *Service) DeleteCoinDescription(ctx context.Context, coinAddress, locale string) error {
+	normalized := NormalizeLocale(locale)
+	id := descriptionID(coinAddress, normalized)
+	var previous string
+	if existing, err := s.store.CoinDescriptions().Get(ctx, id); err == nil {
+		previous = existing.Description
+	}
+	if err := s.store.CoinDescriptions().HardDelete(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete description for %s (%s): %w", coinAddress, locale, err)
+	}
+	s.recordRevision(ctx, coinAddress, "description:"+normalized, previous, "", model.CoinSourceAdmin)
+	return nil
+}
+
+func descriptionID(coinAddress, locale string) string {
+	return coinAddress + ":" + locale
+}
@@ -0,0 +1,84 @@
+package coin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	dbmocks "github.com/nicolas-martin/dankfolio/backend/internal/db/mocks"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+func TestNormalizeLocale(t *testing.T) {
+	for input, want := range map[string]string{
+		"en":         "en",
+		" pt_BR ":    "pt-br",
+		"zh-Hant":    "zh-hant",
+		"":           "",
+		"en-":        "",
+		"e n":        "",
+		"toolongtag": "",
+	} {
+		assert.Equal(t, want, NormalizeLocale(input), input)
+	}
+}
+
+func TestDescriptionsFromMetadata(t *testing.T) {
+	metadata := map[string]any{
+		"description":  map[string]any{"EN": "English", "es": " Español "},
+		"descriptions": map[string]any{"es": "ignored duplicate", "fr": "Français", "bad locale": "x"},
+		"extensions": map[string]any{
+			"description_i18n": map[string]any{"de": "Deutsch", "ja": 42},
+		},
+	}
+	assert.Equal(t, map[string]string{
+		"en": "English",
+		"es": "Español",
+		"fr": "Français",
+		"de": "Deutsch",
+	}, descriptionsFromMetadata(metadata))
+	assert.Empty(t, descriptionsFromMetadata(map[string]any{"description": "plain"}))
+}
+
+func TestLocalizedDescriptions_ExactLocaleWins(t *testing.T) {
+	repo := dbmocks.NewMockRepository[model.CoinDescription](t)
+	repo.EXPECT().List(mock.Anything, db.ListOptions{Filters: []db.FilterOption{
+		{Field: "coin_address", Operator: db.FilterOpIn, Value: []string{"a", "b"}},
+		{Field: "locale", Operator: db.FilterOpIn, Value: []string{"pt-br", "pt"}},
+	}}).Return([]model.CoinDescription{
+		{CoinAddress: "a", Locale: "pt-br", Description: "brasileiro"},
+		{CoinAddress: "a", Locale: "pt", Description: "português"},
+		{CoinAddress: "b", Locale: "pt", Description: "português b"},
+	}, int32(3), nil)
+	store := dbmocks.NewMockStore(t)
+	store.EXPECT().CoinDescriptions().Return(repo)
+
+	s := &Service{store: store}
+	got, err := s.LocalizedDescriptions(context.Background(), "pt_BR", []string{"a", "b"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"a": "brasileiro", "b": "português b"}, got)
+}
+
+func TestStoreMetadataDescriptions_KeepsAdminEdits(t *testing.T) {
+	repo := dbmocks.NewMockRepository[model.CoinDescription](t)
+	repo.EXPECT().List(mock.Anything, mock.Anything).Return([]model.CoinDescription{
+		{ID: "mint:en", CoinAddress: "mint", Locale: "en", Description: "edited", Source: model.CoinDescriptionSourceAdmin},
+		{ID: "mint:es", CoinAddress: "mint", Locale: "es", Description: "igual", Source: model.CoinDescriptionSourceMetadata},
+	}, int32(2), nil)
+	repo.EXPECT().BulkUpsert(mock.Anything, mock.MatchedBy(func(rows *[]model.CoinDescription) bool {
+		return len(*rows) == 1 && (*rows)[0].ID == "mint:fr" && (*rows)[0].Source == model.CoinDescriptionSourceMetadata
+	})).Return(int64(1), nil)
+	store := dbmocks.NewMockStore(t)
+	store.EXPECT().CoinDescriptions().Return(repo)
+
+	s := &Service{store: store}
+	s.storeMetadataDescriptions(context.Background(), "mint", map[string]string{
+		"en": "from metadata",
+		"es": "igual",
+		"fr": "nouveau",
+	})
+}
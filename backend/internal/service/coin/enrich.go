@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/nicolas-martin/dankfolio/backend/internal/clients/birdeye" // Added for birdeye.TokenDetails
+	"github.com/nicolas-martin/dankfolio/backend/internal/events"
 	"github.com/nicolas-martin/dankfolio/backend/internal/model"
 	bmodel "github.com/nicolas-martin/dankfolio/backend/internal/model/blockchain"
 )
@@ -21,6 +22,26 @@ import (
 func (s *Service) EnrichCoinData(
 	ctx context.Context,
 	initialData *birdeye.TokenDetails, // Changed from many params to this one
+) (*model.Coin, error) {
+	s.enrichRunning.Add(1)
+	defer s.enrichRunning.Add(-1)
+	coin, err := s.enrichCoinData(ctx, initialData)
+	if err != nil {
+		return nil, err
+	}
+	s.publishEvent(ctx, events.NewCoinEvent(events.CoinEnriched, *coin))
+	return coin, nil
+}
+
+// EnrichmentQueue returns how many coins are waiting for an enrichment worker
+// and how many are being enriched
+func (s *Service) EnrichmentQueue() (queued, running int64) {
+	return int64(s.fetchPool.Stats().Queued + s.enrichPool.Stats().Queued), s.enrichRunning.Load()
+}
+
+func (s *Service) enrichCoinData(
+	ctx context.Context,
+	initialData *birdeye.TokenDetails,
 ) (*model.Coin, error) {
 	if initialData == nil {
 		return nil, fmt.Errorf("initialData cannot be nil")
@@ -48,6 +69,8 @@ func (s *Service) EnrichCoinData(
 		CreatedAt:   time.Now().Format(time.RFC3339),
 		LastUpdated: time.Now().Format(time.RFC3339),
 	}
+	// Everything so far came from Birdeye
+	attributeChanges(&coin, metadataValues(&model.Coin{}), model.CoinSourceBirdeye)
 	slog.Debug("Initialized coin with pre-fetched data", "mintAddress", initialData.Address, "name", coin.Name, "symbol", coin.Symbol, "price", coin.Price, "volume", coin.Volume24hUSD)
 
 	// Jupiter info fetching removed. Relying on initial data and chain metadata.
@@ -69,22 +92,15 @@ func (s *Service) EnrichCoinData(
 		return &coin, nil // Return partially enriched coin (with initial data)
 	}
 
-	// Use decimals from genericMetadata if available and not already set by initialData (Birdeye)
-	// model.Coin.Decimals is int, genericMetadata.Decimals is uint8
-	if coin.Decimals == 0 && genericMetadata.Decimals > 0 {
-		coin.Decimals = int(genericMetadata.Decimals)
-	}
+	// The mint's on-chain metadata, including SPL decimals, goes through the
+	// merge policy like any other source
+	s.mergePolicy.offer(&coin, &model.Coin{
+		Name:     genericMetadata.Name,
+		Symbol:   genericMetadata.Symbol,
+		Decimals: int(genericMetadata.Decimals),
+	}, model.CoinSourceMetaplex)
 	// coin.Supply = genericMetadata.Supply // model.Coin doesn't have Supply currently, but could be added
 
-	// Override name/symbol from genericMetadata if they were empty after using initialData
-	// This is less likely now as Birdeye usually provides Name/Symbol.
-	if coin.Name == "" && genericMetadata.Name != "" {
-		coin.Name = genericMetadata.Name
-	}
-	if coin.Symbol == "" && genericMetadata.Symbol != "" {
-		coin.Symbol = genericMetadata.Symbol
-	}
-
 	// 4. Fetch off-chain metadata using the URI from the generic token metadata
 	uri := strings.TrimSpace(genericMetadata.URI)
 	slog.Info("Fetching off-chain metadata", slog.String("mintAddress", initialData.Address), slog.String("uri", uri))
@@ -111,7 +127,10 @@ func (s *Service) EnrichCoinData(
 	// IPFS resolution logic has been removed.
 	// coin.ResolvedIconUrl will not be populated by this backend service.
 
-	enrichFromMetadata(&coin, offchainMeta) // Pass original offchainMeta
+	candidate := metadataCandidate(offchainMeta)
+	s.mergePolicy.offer(&coin, &candidate, model.CoinSourceMetaplex)
+	populateDescriptionFromMetadata(&coin, nil) // Default description when no source had one
+	s.storeMetadataDescriptions(ctx, coin.Address, descriptionsFromMetadata(offchainMeta))
 	slog.Info("Coin metadata enriched from off-chain data", slog.Any("coin", coin))
 
 	// NOTE: IPFS URL resolution has been removed from this service.
@@ -139,12 +158,32 @@ func enrichFromMetadata(coin *model.Coin, metadata map[string]any) {
 	populateSocialLinksFromMetadata(coin, metadata) // Handles all social links
 }
 
+// metadataCandidate returns the metadata fields off-chain metadata provides,
+// leaving the others empty
+func metadataCandidate(metadata map[string]any) model.Coin {
+	candidate := model.Coin{Description: metadataDescription(metadata)}
+	populateWebsiteFromMetadata(&candidate, metadata)
+	populateIconFromMetadata(&candidate, metadata)
+	populateSocialLinksFromMetadata(&candidate, metadata)
+	return candidate
+}
+
+// metadataDescription returns the description from off-chain metadata, or "" if it has none
+func metadataDescription(metadata map[string]any) string {
+	if metadata == nil {
+		return ""
+	}
+	if description, ok := metadata["description"].(string); ok && description != "" {
+		return strings.TrimSpace(description)
+	}
+	// Localized descriptions only; English becomes the default
+	return descriptionsFromMetadata(metadata)["en"]
+}
+
 func populateDescriptionFromMetadata(coin *model.Coin, metadata map[string]any) {
-	if metadata != nil {
-		if description, ok := metadata["description"].(string); ok && description != "" {
-			coin.Description = strings.TrimSpace(description)
-			return // Description found in metadata
-		}
+	if description := metadataDescription(metadata); description != "" {
+		coin.Description = description
+		return // Description found in metadata
 	}
 	// Set default only if not already set (e.g., by Jupiter) and metadata missing/empty or description not found
 	if coin.Description == "" {
@@ -11,45 +11,34 @@ import (
 
 	"github.com/nicolas-martin/dankfolio/backend/internal/clients/birdeye"
 	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/events"
+	"github.com/nicolas-martin/dankfolio/backend/internal/jobs"
 	"github.com/nicolas-martin/dankfolio/backend/internal/model"
 )
 
 // Background fetcher methods for trending, new, and top gainer tokens
 
+// Job names for the background fetchers, used for checkpoints, run records and interval overrides
+const (
+	JobTrendingFetch   = "coin.fetch.trending"
+	JobNewCoinsFetch   = "coin.fetch.new"
+	JobTopGainersFetch = "coin.fetch.top_gainers"
+	// JobXStocksInitialize imports the xStocks tokens at startup
+	JobXStocksInitialize = "coin.xstocks.initialize"
+)
+
+// SetFetchInterval changes how often a periodic job runs. A running job
+// reschedules its next run relative to its last one.
+func (s *Service) SetFetchInterval(job string, interval time.Duration) {
+	s.jobs.SetInterval(job, interval)
+}
+
 func (s *Service) runTrendingTokenFetcher(ctx context.Context) {
-	// Add panic recovery to prevent goroutine from crashing
-	defer func() {
-		if r := recover(); r != nil {
-			slog.ErrorContext(ctx, "PANIC in trending token fetcher - recovered and restarting", slog.Any("panic", r))
-			// Restart the fetcher after a panic
-			go s.runTrendingTokenFetcher(ctx)
-		}
-	}()
-	
 	if s.config == nil {
 		slog.ErrorContext(ctx, "runTrendingTokenFetcher: service config is nil")
 		return
 	}
-	slog.InfoContext(ctx, "Starting trending token fetcher", slog.Duration("interval", s.config.TrendingFetchInterval))
-	ticker := time.NewTicker(s.config.TrendingFetchInterval)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-ticker.C:
-			slog.InfoContext(ctx, "Periodically fetching trending tokens...")
-			// Call the fetch function directly to get fresh data from Birdeye
-			err := s.FetchAndStoreTrendingTokens(ctx)
-			if err != nil {
-				slog.ErrorContext(ctx, "Failed to fetch and store trending tokens periodically", slog.Any("error", err))
-			} else {
-				slog.InfoContext(ctx, "Successfully fetched and stored trending tokens periodically.")
-			}
-		case <-ctx.Done():
-			slog.InfoContext(ctx, "Trending token fetcher stopping due to context cancellation.")
-			return
-		}
-	}
+	s.runPeriodicFetcher(ctx, JobTrendingFetch, s.FetchAndStoreTrendingTokens)
 }
 
 func (s *Service) runNewTokenFetcher(ctx context.Context) {
@@ -57,26 +46,7 @@ func (s *Service) runNewTokenFetcher(ctx context.Context) {
 		slog.ErrorContext(ctx, "runNewTokenFetcher: service config is nil")
 		return
 	}
-	slog.InfoContext(ctx, "Starting new token fetcher", slog.Duration("interval", s.config.NewCoinsFetchInterval))
-	ticker := time.NewTicker(s.config.NewCoinsFetchInterval)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-ticker.C:
-			slog.InfoContext(ctx, "Periodically fetching new tokens...")
-			// Call the fetch function directly to get fresh data from Birdeye
-			err := s.FetchAndStoreNewTokens(ctx)
-			if err != nil {
-				slog.ErrorContext(ctx, "Failed to fetch and store new tokens periodically", slog.Any("error", err))
-			} else {
-				slog.InfoContext(ctx, "Successfully fetched and stored new tokens periodically.")
-			}
-		case <-ctx.Done():
-			slog.InfoContext(ctx, "New token fetcher stopping due to context cancellation.")
-			return
-		}
-	}
+	s.runPeriodicFetcher(ctx, JobNewCoinsFetch, s.FetchAndStoreNewTokens)
 }
 
 func (s *Service) runTopGainersTokenFetcher(ctx context.Context) {
@@ -84,32 +54,40 @@ func (s *Service) runTopGainersTokenFetcher(ctx context.Context) {
 		slog.ErrorContext(ctx, "runTopGainersTokenFetcher: service config is nil")
 		return
 	}
-	slog.InfoContext(ctx, "Starting top gainers token fetcher", slog.Duration("interval", s.config.TopGainersFetchInterval))
-	ticker := time.NewTicker(s.config.TopGainersFetchInterval)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-ticker.C:
-			slog.InfoContext(ctx, "Periodically fetching top gainers tokens...")
-			// Call the fetch function directly to get fresh data from Birdeye
-			err := s.FetchAndStoreTopGainersTokens(ctx)
-			if err != nil {
-				slog.ErrorContext(ctx, "Failed to fetch and store top gainers tokens periodically", slog.Any("error", err))
-			} else {
-				slog.InfoContext(ctx, "Successfully fetched and stored top gainers tokens periodically.")
+	s.runPeriodicFetcher(ctx, JobTopGainersFetch, s.FetchAndStoreTopGainersTokens)
+}
+
+// runPeriodicFetcher runs fetch on the service's scheduler every job interval
+// until ctx is cancelled
+func (s *Service) runPeriodicFetcher(ctx context.Context, job string, fetch coinFetchFunc) {
+	s.jobs.Run(ctx, jobs.Job{Name: job, Interval: s.jobs.Interval(job), Run: fetch})
+}
+
+// runXStocksInitialization imports the xStocks tokens once, then enriches them
+func (s *Service) runXStocksInitialization(ctx context.Context) {
+	s.jobs.Run(ctx, jobs.Job{
+		Name:    JobXStocksInitialize,
+		Retries: 2,
+		Run: func(ctx context.Context) error {
+			if err := s.initializeXStocks(ctx); err != nil {
+				return fmt.Errorf("failed to initialize xStocks tokens: %w", err)
 			}
-		case <-ctx.Done():
-			slog.InfoContext(ctx, "Top gainers token fetcher stopping due to context cancellation.")
-			return
-		}
-	}
+			slog.InfoContext(ctx, "xStocks tokens initialized successfully")
+			// Enrichment is best-effort; the tokens are usable without it
+			if err := s.EnrichXStocksData(ctx); err != nil {
+				slog.WarnContext(ctx, "Failed to enrich xStocks data", slog.Any("error", err))
+			}
+			return nil
+		},
+	})
 }
 
 // Fetch and store operations for background processes
 
 func (s *Service) FetchAndStoreTrendingTokens(ctx context.Context) error {
-	return s.store.WithTransaction(ctx, func(txStore db.Store) error {
+	var trending []string
+	err := s.store.WithTransaction(ctx, func(txStore db.Store) error {
+		trending = trending[:0]
 		enrichedCoins, err := s.UpdateTrendingTokensFromBirdeye(ctx)
 		if err != nil {
 			return fmt.Errorf("failed to fetch and enrich trending coins: %w", err)
@@ -154,7 +132,7 @@ func (s *Service) FetchAndStoreTrendingTokens(ctx context.Context) error {
 		// Store/update enriched trending coins
 		if len(enrichedCoins.Coins) > 0 {
 			slog.DebugContext(ctx, "Storing/updating enriched trending coins", slog.Int("count", len(enrichedCoins.Coins)))
-			var storeErrors []string
+			batch := make([]model.Coin, 0, len(enrichedCoins.Coins))
 			for _, coin := range enrichedCoins.Coins {
 				currentCoin := coin
 				// Add "trending" tag
@@ -162,26 +140,19 @@ func (s *Service) FetchAndStoreTrendingTokens(ctx context.Context) error {
 
 				// Process logo through image proxy to upload to S3
 				s.processLogoURL(ctx, &currentCoin)
+				batch = append(batch, currentCoin)
+			}
 
-				existingCoin, getErr := txStore.Coins().GetByField(ctx, "address", currentCoin.Address)
-				if getErr == nil && existingCoin != nil {
-					currentCoin.ID = existingCoin.ID
-					if errUpdate := txStore.Coins().Update(ctx, &currentCoin); errUpdate != nil {
-						slog.WarnContext(ctx, "Failed to update trending coin", slog.String("address", currentCoin.Address), slog.Any("error", errUpdate))
-						storeErrors = append(storeErrors, errUpdate.Error())
-					}
-				} else if errors.Is(getErr, db.ErrNotFound) {
-					if errCreate := txStore.Coins().Create(ctx, &currentCoin); errCreate != nil {
-						slog.WarnContext(ctx, "Failed to create trending coin", slog.String("address", currentCoin.Address), slog.Any("error", errCreate))
-						storeErrors = append(storeErrors, errCreate.Error())
-					}
-				} else if getErr != nil {
-					slog.WarnContext(ctx, "Error checking coin before upsert during trending refresh", slog.String("address", currentCoin.Address), slog.Any("error", getErr))
-					storeErrors = append(storeErrors, getErr.Error())
-				}
+			stored, discovered, storeErr := upsertCoins(ctx, txStore.Coins(), batch)
+			if storeErr != nil {
+				slog.ErrorContext(ctx, "Failed to store trending coins in transaction", slog.Int("count", len(batch)), slog.Any("error", storeErr))
+			}
+			for _, coin := range discovered {
+				s.publishEvent(ctx, events.NewCoinEvent(events.CoinDiscovered, coin))
 			}
-			if len(storeErrors) > 0 {
-				slog.ErrorContext(ctx, "Encountered errors during storing trending coins in transaction", slog.Int("error_count", len(storeErrors)))
+			for _, coin := range stored {
+				s.publishEvent(ctx, events.NewCoinEvent(events.CoinTrending, coin))
+				trending = append(trending, coin.Address)
 			}
 		} else {
 			slog.InfoContext(ctx, "No new trending coins to store from this refresh.", slog.Time("fetch_timestamp", enrichedCoins.FetchTimestamp), slog.Int("incoming_enriched_coin_count", len(enrichedCoins.Coins)))
@@ -195,6 +166,11 @@ func (s *Service) FetchAndStoreTrendingTokens(ctx context.Context) error {
 
 		return nil
 	})
+	if err != nil {
+		return err
+	}
+	s.publishEvent(ctx, events.NewTrendingRefreshedEvent(trending))
+	return nil
 }
 
 func (s *Service) FetchAndStoreTopGainersTokens(ctx context.Context) error {
@@ -258,7 +234,7 @@ func (s *Service) FetchAndStoreTopGainersTokens(ctx context.Context) error {
 		// Store/update enriched top gainers coins
 		if len(enrichedCoins) > 0 {
 			slog.DebugContext(ctx, "Storing/updating enriched top gainers coins", slog.Int("count", len(enrichedCoins)))
-			var storeErrors []string
+			batch := make([]model.Coin, 0, len(enrichedCoins))
 			for _, coin := range enrichedCoins {
 				currentCoin := coin
 				// Add "top-gainer" tag
@@ -266,26 +242,15 @@ func (s *Service) FetchAndStoreTopGainersTokens(ctx context.Context) error {
 
 				// Process logo through image proxy to upload to S3
 				s.processLogoURL(ctx, &currentCoin)
+				batch = append(batch, currentCoin)
+			}
 
-				existingCoin, getErr := txStore.Coins().GetByField(ctx, "address", currentCoin.Address)
-				if getErr == nil && existingCoin != nil {
-					currentCoin.ID = existingCoin.ID
-					if errUpdate := txStore.Coins().Update(ctx, &currentCoin); errUpdate != nil {
-						slog.WarnContext(ctx, "Failed to update top gainer coin", slog.String("address", currentCoin.Address), slog.Any("error", errUpdate))
-						storeErrors = append(storeErrors, errUpdate.Error())
-					}
-				} else if errors.Is(getErr, db.ErrNotFound) {
-					if errCreate := txStore.Coins().Create(ctx, &currentCoin); errCreate != nil {
-						slog.WarnContext(ctx, "Failed to create top gainer coin", slog.String("address", currentCoin.Address), slog.Any("error", errCreate))
-						storeErrors = append(storeErrors, errCreate.Error())
-					}
-				} else if getErr != nil {
-					slog.WarnContext(ctx, "Error checking coin before upsert during top gainers refresh", slog.String("address", currentCoin.Address), slog.Any("error", getErr))
-					storeErrors = append(storeErrors, getErr.Error())
-				}
+			_, discovered, storeErr := upsertCoins(ctx, txStore.Coins(), batch)
+			if storeErr != nil {
+				slog.ErrorContext(ctx, "Failed to store top gainers coins in transaction", slog.Int("count", len(batch)), slog.Any("error", storeErr))
 			}
-			if len(storeErrors) > 0 {
-				slog.ErrorContext(ctx, "Encountered errors during storing top gainers coins in transaction", slog.Int("error_count", len(storeErrors)))
+			for _, coin := range discovered {
+				s.publishEvent(ctx, events.NewCoinEvent(events.CoinDiscovered, coin))
 			}
 		} else {
 			slog.InfoContext(ctx, "No new top gainers coins to store from this refresh.")
@@ -405,7 +370,7 @@ func (s *Service) FetchAndStoreNewTokens(ctx context.Context) error {
 		// Store/update enriched new coins
 		if len(enrichedCoins) > 0 {
 			slog.DebugContext(ctx, "Storing/updating enriched new coins from Birdeye source", slog.Int("count", len(enrichedCoins)))
-			var storeErrors []string
+			batch := make([]model.Coin, 0, len(enrichedCoins))
 			for _, coin := range enrichedCoins { // Iterate over copies
 				currentCoin := coin // Create a new instance or copy
 				// Add "new-coin" tag if not already present (processBirdeyeTokens might not add specific tags)
@@ -416,26 +381,15 @@ func (s *Service) FetchAndStoreNewTokens(ctx context.Context) error {
 
 				// Process logo through image proxy to upload to S3
 				s.processLogoURL(ctx, &currentCoin)
+				batch = append(batch, currentCoin)
+			}
 
-				existingCoin, getErr := txStore.Coins().GetByField(ctx, "address", currentCoin.Address)
-				if getErr == nil && existingCoin != nil {
-					currentCoin.ID = existingCoin.ID // Preserve existing primary key
-					if errUpdate := txStore.Coins().Update(ctx, &currentCoin); errUpdate != nil {
-						slog.WarnContext(ctx, "Failed to update new coin (Birdeye source)", slog.String("address", currentCoin.Address), slog.Any("error", errUpdate))
-						storeErrors = append(storeErrors, errUpdate.Error())
-					}
-				} else if errors.Is(getErr, db.ErrNotFound) {
-					if errCreate := txStore.Coins().Create(ctx, &currentCoin); errCreate != nil {
-						slog.WarnContext(ctx, "Failed to create new coin (Birdeye source)", slog.String("address", currentCoin.Address), slog.Any("error", errCreate))
-						storeErrors = append(storeErrors, errCreate.Error())
-					}
-				} else if getErr != nil {
-					slog.WarnContext(ctx, "Error checking coin before upsert during new coins refresh (Birdeye source)", slog.String("address", currentCoin.Address), slog.Any("error", getErr))
-					storeErrors = append(storeErrors, getErr.Error())
-				}
+			_, discovered, storeErr := upsertCoins(ctx, txStore.Coins(), batch)
+			if storeErr != nil {
+				slog.ErrorContext(ctx, "Failed to store new coins (Birdeye source) in transaction", slog.Int("count", len(batch)), slog.Any("error", storeErr))
 			}
-			if len(storeErrors) > 0 {
-				slog.ErrorContext(ctx, "Encountered errors during storing new coins (Birdeye source) in transaction", slog.Int("error_count", len(storeErrors)))
+			for _, coin := range discovered {
+				s.publishEvent(ctx, events.NewCoinEvent(events.CoinDiscovered, coin))
 			}
 		} else {
 			slog.InfoContext(ctx, "No new coins (Birdeye source) to store from this refresh after enrichment/filtering.")
@@ -553,7 +507,7 @@ func (s *Service) GetNewCoins(ctx context.Context, limit, offset int32) ([]model
 		s.store.ListNewestCoins,
 		s.FetchAndStoreNewTokens,
 		&s.newCoinsMutex,
-		s.config.NewCoinsFetchInterval,
+		s.jobs.Interval(JobNewCoinsFetch),
 		limit,
 		offset,
 	)
@@ -567,7 +521,7 @@ func (s *Service) GetTrendingCoinsRPC(ctx context.Context, limit, offset int32)
 		s.store.ListTrendingCoins,
 		s.FetchAndStoreTrendingTokens,
 		&s.trendingMutex,
-		s.config.TrendingFetchInterval,
+		s.jobs.Interval(JobTrendingFetch),
 		limit,
 		offset,
 	)
@@ -581,7 +535,7 @@ func (s *Service) GetTopGainersCoins(ctx context.Context, limit, offset int32) (
 		s.store.ListTopGainersCoins,
 		s.FetchAndStoreTopGainersTokens,
 		&s.topGainersMutex,
-		s.config.TopGainersFetchInterval,
+		s.jobs.Interval(JobTopGainersFetch),
 		limit,
 		offset,
 	)
@@ -6,7 +6,9 @@ import (
 	"log/slog"
 	"strings"
 
+	"github.com/nicolas-martin/dankfolio/backend/internal/blocklist"
 	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
 )
 
 // Content filtering functionality using naughty words
@@ -40,14 +42,51 @@ func (s *Service) loadNaughtyWords(ctx context.Context) error {
 		newSet[strings.ToLower(nwModel.Word)] = struct{}{}
 	}
 
+	s.naughtyWordsMu.Lock()
 	s.naughtyWordSet = newSet
-	slog.InfoContext(ctx, "Naughty words loaded into memory.", slog.Int("count", len(s.naughtyWordSet)))
+	s.naughtyWordsMu.Unlock()
+	slog.InfoContext(ctx, "Naughty words loaded into memory.", slog.Int("count", len(newSet)))
 	return nil
 }
 
+// ContainsNaughtyWord reports whether any word in text is on the naughty word list
+func (s *Service) ContainsNaughtyWord(text string) bool {
+	return s.containsNaughtyWord(text)
+}
+
+// SetBlocklist enables scam blocklist enforcement for search and coin detail
+func (s *Service) SetBlocklist(list *blocklist.Blocklist) {
+	s.blocklist = list
+}
+
+// CheckBlocked returns an error wrapping blocklist.ErrBlocked when address is a blocklisted mint
+func (s *Service) CheckBlocked(address string) error {
+	if s.blocklist == nil {
+		return nil
+	}
+	return s.blocklist.Check(address)
+}
+
+// filterBlocked returns coins without blocklisted mints. The input is not modified
+// since it may be backed by the search cache.
+func (s *Service) filterBlocked(coins []model.Coin) []model.Coin {
+	if s.blocklist == nil {
+		return coins
+	}
+	filtered := make([]model.Coin, 0, len(coins))
+	for _, c := range coins {
+		if !s.blocklist.IsBlocked(c.Address) {
+			filtered = append(filtered, c)
+		}
+	}
+	return filtered
+}
+
 // isWordNaughty checks if a single word is in the loaded naughty word set.
 // Assumes word is already normalized (e.g., lowercase).
 func (s *Service) isWordNaughty(word string) bool {
+	s.naughtyWordsMu.RLock()
+	defer s.naughtyWordsMu.RUnlock()
 	_, found := s.naughtyWordSet[strings.ToLower(word)] // Ensure word is lowercased before check
 	return found
 }
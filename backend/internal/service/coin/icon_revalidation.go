@@ -0,0 +1,318 @@
+package coin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+// Icon statuses a re-validation selects
+const (
+	IconStatusBroken      = "broken"      // No logo, a placeholder, or a logo not stored by the image proxy
+	IconStatusPlaceholder = "placeholder" // On a generated placeholder
+	IconStatusAll         = "all"
+)
+
+const (
+	revalidationPageSize = 500
+	// maxRevalidations is how many finished re-validations are kept for progress queries
+	maxRevalidations = 20
+)
+
+var (
+	// ErrImageProxyDisabled is returned for icon operations without an image proxy
+	ErrImageProxyDisabled = errors.New("image proxy is not configured")
+	// ErrRevalidationNotFound is returned for progress of an unknown re-validation
+	ErrRevalidationNotFound = errors.New("icon revalidation not found")
+	// ErrInvalidIconFilter is returned for a re-validation filter that selects nothing sensible
+	ErrInvalidIconFilter = errors.New("invalid icon filter")
+)
+
+// iconOutcome is how re-validating one icon ended
+type iconOutcome int
+
+const (
+	iconValid iconOutcome = iota
+	iconReplaced
+	iconPlaceholder
+	iconSkipped
+	iconFailed
+)
+
+// IconFilter selects the coins whose icons are re-validated
+type IconFilter struct {
+	Status  string    // IconStatusBroken, IconStatusPlaceholder or IconStatusAll; empty is broken
+	Gateway string    // Only icons whose source URL contains this, e.g. "gateway.pinata.cloud"
+	Since   time.Time // Only coins created at or after; zero for no bound
+	Until   time.Time // Only coins created before; zero for no bound
+	Limit   int       // At most this many coins; 0 for all
+}
+
+// IconRevalidation is the progress of re-validating a slice of the coins' icons
+type IconRevalidation struct {
+	ID     string
+	Filter IconFilter
+
+	Scanned      int // Coins read from the store
+	Matched      int // Coins matching the filter
+	Processed    int
+	Valid        int // Stored icons that are still fine
+	Replaced     int // Icons fetched again and stored over the old ones
+	Placeholders int // Coins moved onto placeholders
+	Skipped      int // Coins with no source to fetch
+	Failed       int
+
+	StartedAt  time.Time
+	FinishedAt time.Time // Zero while running
+	Err        string    // Why the run stopped early
+}
+
+func (r *IconRevalidation) count(outcome iconOutcome) {
+	r.Processed++
+	switch outcome {
+	case iconValid:
+		r.Valid++
+	case iconReplaced:
+		r.Replaced++
+	case iconPlaceholder:
+		r.Placeholders++
+	case iconSkipped:
+		r.Skipped++
+	case iconFailed:
+		r.Failed++
+	}
+}
+
+// Done reports whether the re-validation has finished
+func (r IconRevalidation) Done() bool {
+	return !r.FinishedAt.IsZero()
+}
+
+// revalidations keeps the progress of recent icon re-validations
+type revalidations struct {
+	mu    sync.Mutex
+	runs  map[string]*IconRevalidation
+	order []string // Oldest first
+}
+
+func (r *revalidations) start(filter IconFilter) IconRevalidation {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.runs == nil {
+		r.runs = make(map[string]*IconRevalidation)
+	}
+	run := &IconRevalidation{ID: uuid.NewString(), Filter: filter, StartedAt: time.Now()}
+	r.runs[run.ID] = run
+	r.order = append(r.order, run.ID)
+	// Drop the oldest finished runs; running ones are kept however many there are
+	for i := 0; len(r.order) > maxRevalidations && i < len(r.order); {
+		if old := r.runs[r.order[i]]; old.Done() {
+			delete(r.runs, old.ID)
+			r.order = append(r.order[:i], r.order[i+1:]...)
+			continue
+		}
+		i++
+	}
+	return *run
+}
+
+func (r *revalidations) update(id string, fn func(run *IconRevalidation)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if run, ok := r.runs[id]; ok {
+		fn(run)
+	}
+}
+
+func (r *revalidations) get(id string) (IconRevalidation, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	run, ok := r.runs[id]
+	if !ok {
+		return IconRevalidation{}, false
+	}
+	return *run, true
+}
+
+// RevalidateIcons starts re-validating the icons of the coins filter selects
+// in the background and returns its initial progress. Stored icons are checked
+// in place; the others are fetched again from their source through the same
+// gateways and validation as new logos, replacing the stored copy. Icons that
+// fail are served placeholders.
+func (s *Service) RevalidateIcons(filter IconFilter) (IconRevalidation, error) {
+	if s.imageProxy == nil {
+		return IconRevalidation{}, ErrImageProxyDisabled
+	}
+	switch filter.Status {
+	case "":
+		filter.Status = IconStatusBroken
+	case IconStatusBroken, IconStatusPlaceholder, IconStatusAll:
+	default:
+		return IconRevalidation{}, fmt.Errorf("%w: unknown status %q, want %s, %s or %s", ErrInvalidIconFilter, filter.Status, IconStatusBroken, IconStatusPlaceholder, IconStatusAll)
+	}
+	if filter.Limit < 0 {
+		return IconRevalidation{}, fmt.Errorf("%w: negative limit", ErrInvalidIconFilter)
+	}
+	if !filter.Since.IsZero() && !filter.Until.IsZero() && !filter.Since.Before(filter.Until) {
+		return IconRevalidation{}, fmt.Errorf("%w: since must be before until", ErrInvalidIconFilter)
+	}
+
+	run := s.revalidations.start(filter)
+	slog.Info("Starting icon revalidation", slog.String("id", run.ID), slog.String("status", filter.Status), slog.String("gateway", filter.Gateway))
+	s.goBackground(func(ctx context.Context) {
+		err := s.revalidateIcons(ctx, run.ID, filter)
+		s.revalidations.update(run.ID, func(run *IconRevalidation) {
+			run.FinishedAt = time.Now()
+			if err != nil {
+				run.Err = err.Error()
+			}
+		})
+		final, _ := s.revalidations.get(run.ID)
+		slog.Info("Finished icon revalidation",
+			slog.String("id", run.ID),
+			slog.Int("processed", final.Processed),
+			slog.Int("replaced", final.Replaced),
+			slog.Int("placeholders", final.Placeholders),
+			slog.Int("failed", final.Failed),
+			slog.Any("error", err))
+	})
+	return run, nil
+}
+
+// IconRevalidationProgress returns the progress of a re-validation started by RevalidateIcons
+func (s *Service) IconRevalidationProgress(id string) (IconRevalidation, error) {
+	run, ok := s.revalidations.get(id)
+	if !ok {
+		return IconRevalidation{}, fmt.Errorf("%w: %s", ErrRevalidationNotFound, id)
+	}
+	return run, nil
+}
+
+func (s *Service) revalidateIcons(ctx context.Context, id string, filter IconFilter) error {
+	sortBy, desc := "created_at", false
+	opts := db.ListOptions{SortBy: &sortBy, SortDesc: &desc}
+	if !filter.Since.IsZero() {
+		opts.Filters = append(opts.Filters, db.FilterOption{Field: "created_at", Operator: db.FilterOpGreaterEqual, Value: filter.Since})
+	}
+	if !filter.Until.IsZero() {
+		opts.Filters = append(opts.Filters, db.FilterOption{Field: "created_at", Operator: db.FilterOpLessThan, Value: filter.Until})
+	}
+
+	group := s.imageUploadPool.Group()
+	defer group.Wait()
+	matched := 0
+	for offset := 0; filter.Limit == 0 || matched < filter.Limit; offset += revalidationPageSize {
+		limit, pageOffset := revalidationPageSize, offset
+		opts.Limit, opts.Offset = &limit, &pageOffset
+		coins, _, err := s.store.Coins().List(ctx, opts)
+		if err != nil {
+			return fmt.Errorf("failed to list coins at offset %d: %w", offset, err)
+		}
+		for _, coin := range coins {
+			if filter.Limit > 0 && matched >= filter.Limit {
+				break
+			}
+			source, ok := s.iconSource(ctx, &coin, filter)
+			if !ok {
+				continue
+			}
+			matched++
+			s.revalidations.update(id, func(run *IconRevalidation) { run.Matched++ })
+			if err := group.Go(ctx, func(ctx context.Context) error {
+				outcome := s.revalidateIcon(ctx, coin, source)
+				s.revalidations.update(id, func(run *IconRevalidation) { run.count(outcome) })
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		s.revalidations.update(id, func(run *IconRevalidation) { run.Scanned += len(coins) })
+		if len(coins) < revalidationPageSize {
+			break
+		}
+	}
+	return nil
+}
+
+// iconSource returns the URL a coin's icon is fetched from, empty for stored
+// icons and coins with nothing to fetch, and whether filter selects the coin
+func (s *Service) iconSource(ctx context.Context, coin *model.Coin, filter IconFilter) (string, bool) {
+	var source string
+	placeholder := s.imageProxy.IsPlaceholderURL(coin.LogoURI)
+	switch {
+	case placeholder:
+		// Where the icon was last fetched from, if it ever was, skips redirects
+		source = coin.LogoSourceURL
+		if source == "" {
+			if record, err := s.imageProxy.PlaceholderRecord(ctx, coin.Address); err == nil {
+				source = record.OriginalURL
+			}
+		}
+	case !s.imageProxy.IsProxiedURL(coin.LogoURI):
+		source = coin.LogoURI
+	}
+
+	switch filter.Status {
+	case IconStatusPlaceholder:
+		if !placeholder {
+			return "", false
+		}
+	case IconStatusBroken:
+		if !placeholder && s.imageProxy.IsProxiedURL(coin.LogoURI) {
+			return "", false
+		}
+	}
+	if filter.Gateway != "" && !strings.Contains(source, filter.Gateway) {
+		return "", false
+	}
+	return source, true
+}
+
+// revalidateIcon checks or fetches one coin's icon
+func (s *Service) revalidateIcon(ctx context.Context, coin model.Coin, source string) iconOutcome {
+	if source == "" {
+		if coin.LogoURI == "" || s.imageProxy.IsPlaceholderURL(coin.LogoURI) {
+			return iconSkipped
+		}
+		err := s.imageProxy.ValidateStored(ctx, coin.LogoURI)
+		if err == nil {
+			return iconValid
+		}
+		slog.InfoContext(ctx, "Stored icon failed revalidation", slog.String("address", coin.Address), slog.Any("error", err))
+		if coin.LogoSourceURL == "" {
+			return s.revalidationFallback(ctx, coin.Address, "", err)
+		}
+		// Fetched again from where it came from
+		source = coin.LogoSourceURL
+	}
+
+	logoURI, err := s.uploadLogoWithFallback(ctx, source, coin.Address, coin.Symbol, true)
+	if err != nil {
+		return s.revalidationFallback(ctx, coin.Address, source, err)
+	}
+	s.setStoredLogo(ctx, coin.Address, logoURI)
+	if err := s.imageProxy.ClearPlaceholder(ctx, coin.Address); err != nil {
+		slog.WarnContext(ctx, "Failed to clear placeholder after revalidation", slog.String("address", coin.Address), slog.Any("error", err))
+	}
+	return iconReplaced
+}
+
+// revalidationFallback moves a coin whose icon failed onto its placeholder
+func (s *Service) revalidationFallback(ctx context.Context, address, source string, cause error) iconOutcome {
+	placeholderURL, err := s.imageProxy.UsePlaceholder(ctx, address, source, cause)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to store placeholder during revalidation", slog.String("address", address), slog.Any("error", err))
+		return iconFailed
+	}
+	s.setStoredLogo(ctx, address, placeholderURL)
+	return iconPlaceholder
+}
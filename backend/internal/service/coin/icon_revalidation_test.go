@@ -0,0 +1,80 @@
+package coin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/s3"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/imageproxy"
+)
+
+func newTestImageProxy(t *testing.T) *imageproxy.Service {
+	t.Helper()
+	s3Client, err := s3.NewClient(s3.Config{
+		Endpoint:        "https://us-ord-1.linodeobjects.com",
+		AccessKeyID:     "key",
+		SecretAccessKey: "secret",
+		BucketName:      "coin-icon",
+		Region:          "us-ord-1",
+		PublicURLPrefix: "https://coin-icon.us-ord-1.linodeobjects.com",
+	})
+	require.NoError(t, err)
+	return imageproxy.NewService(s3Client)
+}
+
+func TestRevalidateIcons_RejectsInvalidFilters(t *testing.T) {
+	_, err := (&Service{}).RevalidateIcons(IconFilter{})
+	assert.ErrorIs(t, err, ErrImageProxyDisabled)
+
+	s := &Service{imageProxy: newTestImageProxy(t)}
+	_, err = s.RevalidateIcons(IconFilter{Status: "stale"})
+	assert.ErrorIs(t, err, ErrInvalidIconFilter)
+	now := time.Now()
+	_, err = s.RevalidateIcons(IconFilter{Since: now, Until: now.Add(-time.Hour)})
+	assert.ErrorIs(t, err, ErrInvalidIconFilter)
+
+	_, err = s.IconRevalidationProgress("missing")
+	assert.ErrorIs(t, err, ErrRevalidationNotFound)
+}
+
+func TestIconSource(t *testing.T) {
+	proxy := newTestImageProxy(t)
+	s := &Service{imageProxy: proxy}
+	ctx := context.Background()
+
+	stored := &model.Coin{Address: "stored", LogoURI: proxy.GetS3URL("stored")}
+	gateway := &model.Coin{Address: "gateway", LogoURI: "https://gateway.pinata.cloud/ipfs/cid"}
+	missing := &model.Coin{Address: "missing"}
+	placeholder := &model.Coin{Address: "placeholder", LogoURI: proxy.PlaceholderURL("placeholder")}
+
+	tests := []struct {
+		name   string
+		coin   *model.Coin
+		filter IconFilter
+		source string
+		ok     bool
+	}{
+		{"stored icons aren't broken", stored, IconFilter{Status: IconStatusBroken}, "", false},
+		{"stored icons are checked in place", stored, IconFilter{Status: IconStatusAll}, "", true},
+		{"unstored icons are fetched from their URL", gateway, IconFilter{Status: IconStatusBroken}, gateway.LogoURI, true},
+		{"coins without an icon are broken", missing, IconFilter{Status: IconStatusBroken}, "", true},
+		{"placeholders without a record have no source", placeholder, IconFilter{Status: IconStatusPlaceholder}, "", true},
+		{"only placeholders", gateway, IconFilter{Status: IconStatusPlaceholder}, "", false},
+		{"gateway matches the source", gateway, IconFilter{Status: IconStatusBroken, Gateway: "pinata"}, gateway.LogoURI, true},
+		{"gateway excludes other sources", gateway, IconFilter{Status: IconStatusBroken, Gateway: "ipfs.io"}, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source, ok := s.iconSource(ctx, tt.coin, tt.filter)
+			assert.Equal(t, tt.ok, ok)
+			if ok {
+				assert.Equal(t, tt.source, source)
+			}
+		})
+	}
+}
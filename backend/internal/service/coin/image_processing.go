@@ -2,15 +2,41 @@ package coin
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/nicolas-martin/dankfolio/backend/internal/model"
-	"github.com/nicolas-martin/dankfolio/backend/internal/service/imageproxy"
 )
 
+// errNoLogo is recorded for coins on placeholders because they have no logo
+var errNoLogo = errors.New("coin has no logo")
+
+// DefaultIPFSFallbackGateways are tried, in order, when an IPFS logo fails to download
+var DefaultIPFSFallbackGateways = []string{
+	"https://ipfs.io/ipfs/",
+	"https://dweb.link/ipfs/",
+	"https://cloudflare-ipfs.com/ipfs/",
+	"https://w3s.link/ipfs/",
+}
+
+// SetIPFSFallbackGateways replaces the gateways tried for failed IPFS logo downloads.
+// Each gateway must end with the path prefix the CID is appended to (e.g. "/ipfs/").
+func (s *Service) SetIPFSFallbackGateways(gateways []string) {
+	s.ipfsGatewaysMu.Lock()
+	defer s.ipfsGatewaysMu.Unlock()
+	s.ipfsFallbackGateways = slices.Clone(gateways)
+}
+
+func (s *Service) ipfsGateways() []string {
+	s.ipfsGatewaysMu.RLock()
+	defer s.ipfsGatewaysMu.RUnlock()
+	return s.ipfsFallbackGateways
+}
+
 // processLogoURL processes a coin's logo URL through the image proxy service
 // It immediately sets the S3 URL and uploads asynchronously
 func (s *Service) processLogoURL(ctx context.Context, coin *model.Coin) {
@@ -22,48 +48,65 @@ func (s *Service) processLogoURL(ctx context.Context, coin *model.Coin) {
 	// Skip if logoURI is empty
 	if coin.LogoURI == "" {
 		// Set placeholder for coins with no logo
-		coin.LogoURI = s.getPlaceholderURL()
+		coin.LogoURI = s.usePlaceholder(coin.Address, errNoLogo)
 		return
 	}
 
-	// Skip if already an S3 URL
-	if imageproxy.IsS3URL(coin.LogoURI) {
+	// Skip if already stored on S3
+	if s.imageProxy.IsProxiedURL(coin.LogoURI) {
+		// Stored before its icon turned out to duplicate another
+		coin.LogoURI = s.imageProxy.DeduplicatedURL(coin.Address, coin.LogoURI)
 		return
 	}
 
 	// Store original URL for async processing
 	originalURL := coin.LogoURI
-	
-	// Immediately set the S3 URL (predictable based on mint address)
-	// This URL will work once the image is uploaded
-	coin.LogoURI = s.imageProxy.GetS3URL(coin.Address)
-	
-	// Try to download and upload asynchronously
-	go func() {
-		// Acquire rate limit token
-		s.imageUploadLimiter <- struct{}{}
-		defer func() {
-			// Release token
-			<-s.imageUploadLimiter
-		}()
-		
-		// Use background context so it's not cancelled with the request
-		bgCtx := context.Background()
-		
+	address, symbol := coin.Address, coin.Symbol
+	presetURL := s.imageProxy.GetS3URL(address)
+
+	// Try to download and upload asynchronously; tracked so shutdown lets queued and in-flight uploads finish.
+	// Uses background context so it's not cancelled with the request.
+	s.backgroundWG.Add(1)
+	err := s.imageUploadPool.TrySubmit(context.Background(), func(bgCtx context.Context) error {
+		defer s.backgroundWG.Done()
+
 		// Try to download from IPFS and upload to S3
-		err := s.uploadLogoWithFallback(bgCtx, originalURL, coin.Address, coin.Symbol)
+		s3URL, err := s.uploadLogoWithFallback(bgCtx, originalURL, address, symbol, false)
+		if err == nil && s3URL != presetURL {
+			// The icon duplicated a stored one, so nothing was uploaded under the preset URL
+			s.setStoredLogo(bgCtx, address, s3URL)
+		}
 		if err != nil {
 			slog.Error("Failed to download and upload logo after all retries",
-				"coin", coin.Symbol,
-				"address", coin.Address,
+				"coin", symbol,
+				"address", address,
 				"originalURL", originalURL,
 				"error", err)
-			
+
+			// Serve a generated placeholder until a retry uploads the logo
+			placeholderURL, placeholderErr := s.imageProxy.UsePlaceholder(bgCtx, address, originalURL, err)
+			if placeholderErr == nil {
+				s.setStoredLogo(bgCtx, address, placeholderURL)
+				return err
+			}
+			slog.Warn("Failed to store placeholder logo", "address", address, "error", placeholderErr)
+
 			// If download failed, transform to Pinata gateway URL for IPFS URLs
 			// This allows the frontend to try fetching directly later
-			s.updateLogoToPinataGateway(bgCtx, coin.Address, originalURL)
+			s.updateLogoToPinataGateway(bgCtx, address, originalURL)
 		}
-	}()
+		return err
+	})
+	if err != nil {
+		// Too many uploads pending; keep the original URL until a later refresh uploads it
+		s.backgroundWG.Done()
+		slog.Warn("Skipping logo upload", "address", address, "error", err)
+		return
+	}
+
+	// Immediately set the S3 URL (predictable based on mint address)
+	// This URL will work once the image is uploaded
+	coin.LogoURI = presetURL
 }
 
 // processLogoURLs processes multiple coins' logo URLs in parallel
@@ -79,26 +122,27 @@ func (s *Service) processLogoURLs(ctx context.Context, coins []model.Coin) {
 	}
 }
 
-// uploadLogoWithFallback tries to download a logo from IPFS (with fallback gateways) and upload to S3
-func (s *Service) uploadLogoWithFallback(ctx context.Context, originalURL, mintAddress, symbol string) error {
+// uploadLogoWithFallback tries to download a logo from IPFS (with fallback gateways) and upload to S3.
+// It returns the S3 URL serving the logo. With replace set, a logo already stored is replaced.
+func (s *Service) uploadLogoWithFallback(ctx context.Context, originalURL, mintAddress, symbol string, replace bool) (string, error) {
 	// If it's already a Pinata URL, try it first
 	if !strings.Contains(originalURL, "gateway.pinata.cloud") && strings.Contains(originalURL, "ipfs") {
 		cid := extractIPFSCID(originalURL)
 		if cid != "" {
 			// Try Pinata first since it's most reliable
 			pinataURL := "https://gateway.pinata.cloud/ipfs/" + cid
-			if err := s.tryUploadFromURL(ctx, pinataURL, mintAddress); err == nil {
+			if s3URL, err := s.tryUploadFromURL(ctx, pinataURL, mintAddress, replace); err == nil {
 				slog.Debug("Successfully uploaded via Pinata gateway",
 					"symbol", symbol)
-				return nil
+				return s3URL, nil
 			}
 		}
 	}
 	
 	// Try the original URL
-	err := s.tryUploadFromURL(ctx, originalURL, mintAddress)
+	s3URL, err := s.tryUploadFromURL(ctx, originalURL, mintAddress, replace)
 	if err == nil {
-		return nil
+		return s3URL, nil
 	}
 	
 	// If it's an IPFS URL and failed, try other gateways
@@ -107,12 +151,7 @@ func (s *Service) uploadLogoWithFallback(ctx context.Context, originalURL, mintA
 		cid := extractIPFSCID(originalURL)
 		if cid != "" {
 			// Try alternative IPFS gateways (excluding Pinata if we already tried it)
-			alternativeGateways := []string{
-				"https://ipfs.io/ipfs/",
-				"https://dweb.link/ipfs/",
-				"https://cloudflare-ipfs.com/ipfs/",
-				"https://w3s.link/ipfs/",
-			}
+			alternativeGateways := s.ipfsGateways()
 			
 			for _, gateway := range alternativeGateways {
 				// Skip if we already tried this gateway
@@ -126,32 +165,77 @@ func (s *Service) uploadLogoWithFallback(ctx context.Context, originalURL, mintA
 					"gateway", gateway,
 					"cid", cid)
 				
-				if err := s.tryUploadFromURL(ctx, alternativeURL, mintAddress); err == nil {
+				if s3URL, err := s.tryUploadFromURL(ctx, alternativeURL, mintAddress, replace); err == nil {
 					slog.Info("Successfully uploaded via alternative gateway",
 						"symbol", symbol,
 						"gateway", gateway)
-					return nil
+					return s3URL, nil
 				}
 			}
 		}
 	}
 	
-	return fmt.Errorf("failed to upload from all sources")
+	return "", fmt.Errorf("failed to upload from all sources")
 }
 
 // tryUploadFromURL attempts to upload an image from a specific URL
-func (s *Service) tryUploadFromURL(ctx context.Context, imageURL, mintAddress string) error {
+func (s *Service) tryUploadFromURL(ctx context.Context, imageURL, mintAddress string, replace bool) (string, error) {
 	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 	
-	_, err := s.imageProxy.ProcessAndUploadImage(ctx, imageURL, mintAddress)
-	return err
+	var s3URL string
+	var err error
+	if replace {
+		s3URL, err = s.imageProxy.ReplaceImage(ctx, imageURL, mintAddress)
+	} else {
+		s3URL, err = s.imageProxy.ProcessAndUploadImage(ctx, imageURL, mintAddress)
+	}
+	if err != nil {
+		return "", err
+	}
+	if canonical := s.imageProxy.CanonicalURL(imageURL); canonical != imageURL {
+		// Refetches go straight to where the redirects led
+		if err := s.store.SetCoinLogoSource(ctx, mintAddress, canonical); err != nil {
+			slog.Warn("Failed to record logo source", "address", mintAddress, "error", err)
+		}
+	}
+	return s3URL, nil
+}
+
+// usePlaceholder returns the URL of a coin's placeholder logo, storing it in
+// the background
+func (s *Service) usePlaceholder(address string, cause error) string {
+	s.backgroundWG.Add(1)
+	err := s.imageUploadPool.TrySubmit(context.Background(), func(bgCtx context.Context) error {
+		defer s.backgroundWG.Done()
+		_, err := s.imageProxy.UsePlaceholder(bgCtx, address, "", cause)
+		return err
+	})
+	if err != nil {
+		// Stored by a later refresh; the app shows its own fallback meanwhile
+		s.backgroundWG.Done()
+		slog.Warn("Skipping placeholder upload", "address", address, "error", err)
+	}
+	return s.imageProxy.PlaceholderURL(address)
 }
 
-// getPlaceholderURL returns the S3 URL for the placeholder image
-func (s *Service) getPlaceholderURL() string {
-	// Use a predictable placeholder URL in our S3 bucket
-	return s.imageProxy.GetS3URL("placeholder")
+// setStoredLogo points a stored coin's logo at logoURI and drops its cached copy
+func (s *Service) setStoredLogo(ctx context.Context, mintAddress, logoURI string) {
+	coin, err := s.store.Coins().GetByField(ctx, "address", mintAddress)
+	if err != nil {
+		slog.Warn("Failed to get coin for logo update",
+			"address", mintAddress,
+			"error", err)
+		return
+	}
+	coin.LogoURI = logoURI
+	if err := s.store.Coins().Update(ctx, coin); err != nil {
+		slog.Warn("Failed to update coin logo",
+			"address", mintAddress,
+			"error", err)
+		return
+	}
+	s.cache.Delete(fmt.Sprintf("coin:%s", mintAddress))
 }
 
 // updateLogoToPinataGateway updates a coin's logo to use Pinata gateway URL
@@ -0,0 +1,133 @@
+package coin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/jupiter"
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/events"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	bmodel "github.com/nicolas-martin/dankfolio/backend/internal/model/blockchain"
+)
+
+// DefaultIndexBudget bounds how long a search waits on indexing an unknown mint
+const DefaultIndexBudget = 2 * time.Second
+
+// TagProvisional marks a coin indexed from its on-chain metadata alone, until
+// its full enrichment lands
+const TagProvisional = "provisional"
+
+// indexRouteProbeLamports is the SOL amount quoted to check a mint is tradable
+const indexRouteProbeLamports = "10000000" // 0.01 SOL
+
+// maxQueuedIndexEnrichments bounds the full enrichments of indexed coins running at once
+const maxQueuedIndexEnrichments = 4
+
+// LookupMint returns a coin by mint address for search. Mints that aren't
+// stored yet are indexed on demand instead of fetched in full.
+func (s *Service) LookupMint(ctx context.Context, address string) (*model.Coin, error) {
+	if _, err := s.store.Coins().GetByField(ctx, "address", address); !errors.Is(err, db.ErrNotFound) {
+		return s.GetCoinByAddress(ctx, address)
+	}
+	result, err, _ := s.coinFetchGroup.Do("index:"+address, func() (any, error) {
+		return s.indexMint(ctx, address)
+	})
+	if err != nil {
+		return nil, err
+	}
+	coin := *result.(*model.Coin)
+	return &coin, nil
+}
+
+// indexMint stores a provisional coin for a mint from its on-chain metadata,
+// provided Jupiter can route a swap into it, and queues its full enrichment.
+// Both lookups share the index budget so a search never stalls on a slow RPC.
+func (s *Service) indexMint(ctx context.Context, address string) (*model.Coin, error) {
+	budget := DefaultIndexBudget
+	if s.config != nil && s.config.IndexBudget > 0 {
+		budget = s.config.IndexBudget
+	}
+	ctx, cancel := context.WithTimeout(ctx, budget)
+	defer cancel()
+
+	var metadata *bmodel.TokenMetadata
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		var err error
+		metadata, err = s.chainClient.GetTokenMetadata(gctx, bmodel.Address(address))
+		if err != nil {
+			return fmt.Errorf("failed to get token metadata: %w", err)
+		}
+		return nil
+	})
+	g.Go(func() error {
+		if _, err := s.jupiterClient.GetQuote(gctx, jupiter.QuoteParams{
+			InputMint:  model.SolMint,
+			OutputMint: address,
+			Amount:     indexRouteProbeLamports,
+		}); err != nil {
+			return fmt.Errorf("no swap route: %w", err)
+		}
+		return nil
+	})
+	if err := g.Wait(); err != nil {
+		return nil, fmt.Errorf("failed to index mint %s: %w", address, err)
+	}
+	if metadata.Name == "" && metadata.Symbol == "" {
+		return nil, fmt.Errorf("failed to index mint %s: no name or symbol in its metadata", address)
+	}
+	if s.coinContainsNaughtyWord(metadata.Name, "") || s.coinContainsNaughtyWord(metadata.Symbol, "") {
+		return nil, fmt.Errorf("token contains inappropriate content: %s", metadata.Name)
+	}
+
+	now := time.Now().Format(time.RFC3339)
+	coin := &model.Coin{
+		Address:     address,
+		Tags:        []string{TagProvisional},
+		CreatedAt:   now,
+		LastUpdated: now,
+	}
+	s.mergePolicy.offer(coin, &model.Coin{
+		Name:     metadata.Name,
+		Symbol:   metadata.Symbol,
+		Decimals: int(metadata.Decimals),
+	}, model.CoinSourceMetaplex)
+	if err := s.store.Coins().Create(ctx, coin); err != nil {
+		return nil, fmt.Errorf("failed to store provisional coin %s: %w", address, err)
+	}
+	slog.InfoContext(ctx, "Indexed unknown mint from search", slog.String("address", address), slog.String("symbol", coin.Symbol))
+	s.publishEvent(ctx, events.NewCoinEvent(events.CoinDiscovered, *coin))
+	s.queueIndexEnrichment(address)
+	return coin, nil
+}
+
+// queueIndexEnrichment fully enriches an indexed coin in the background. When
+// enough are already running the coin stays provisional until the fetchers or
+// a detail lookup refresh it.
+func (s *Service) queueIndexEnrichment(address string) {
+	select {
+	case s.indexEnrichLimiter <- struct{}{}:
+	default:
+		slog.Debug("Enrichment queue full, leaving indexed coin provisional", slog.String("address", address))
+		return
+	}
+	s.backgroundWG.Add(1)
+	go func() {
+		defer s.backgroundWG.Done()
+		defer func() { <-s.indexEnrichLimiter }()
+		ctx, cancel := context.WithTimeout(s.fetcherCtx, time.Minute)
+		defer cancel()
+		coin, err := s.fetchAndCacheCoin(ctx, address)
+		if err != nil {
+			slog.Warn("Failed to enrich indexed coin", slog.String("address", address), slog.Any("error", err))
+			return
+		}
+		s.cache.Set(fmt.Sprintf("coin:%s", address), []model.Coin{*coin}, CoinCacheExpiry)
+	}()
+}
@@ -0,0 +1,57 @@
+package coin
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/jupiter"
+	jupitermocks "github.com/nicolas-martin/dankfolio/backend/internal/clients/jupiter/mocks"
+	clientsmocks "github.com/nicolas-martin/dankfolio/backend/internal/clients/mocks"
+	dbmocks "github.com/nicolas-martin/dankfolio/backend/internal/db/mocks"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	bmodel "github.com/nicolas-martin/dankfolio/backend/internal/model/blockchain"
+)
+
+func TestIndexMint_StoresProvisionalCoin(t *testing.T) {
+	store := dbmocks.NewMockStore(t)
+	coins := dbmocks.NewMockRepository[model.Coin](t)
+	chain := clientsmocks.NewMockGenericClientAPI(t)
+	jup := jupitermocks.NewMockClientAPI(t)
+	s := &Service{store: store, chainClient: chain, jupiterClient: jup, mergePolicy: DefaultMergePolicy()}
+
+	chain.EXPECT().GetTokenMetadata(mock.Anything, bmodel.Address(bonkMint)).
+		Return(&bmodel.TokenMetadata{Name: "Bonk", Symbol: "BONK", Decimals: 5}, nil)
+	jup.EXPECT().GetQuote(mock.Anything, mock.MatchedBy(func(p jupiter.QuoteParams) bool {
+		return p.InputMint == model.SolMint && p.OutputMint == bonkMint
+	})).Return(&jupiter.QuoteResponse{}, nil)
+	store.EXPECT().Coins().Return(coins)
+	var stored *model.Coin
+	coins.EXPECT().Create(mock.Anything, mock.Anything).
+		Run(func(_ context.Context, c *model.Coin) { stored = c }).Return(nil)
+
+	coin, err := s.indexMint(context.Background(), bonkMint)
+	require.NoError(t, err)
+	assert.Same(t, stored, coin)
+	assert.Equal(t, "BONK", coin.Symbol)
+	assert.Equal(t, 5, coin.Decimals)
+	assert.Equal(t, []string{TagProvisional}, coin.Tags)
+	assert.Equal(t, model.CoinSourceMetaplex, coin.MetadataSources["symbol"])
+}
+
+func TestIndexMint_RequiresSwapRoute(t *testing.T) {
+	chain := clientsmocks.NewMockGenericClientAPI(t)
+	jup := jupitermocks.NewMockClientAPI(t)
+	s := &Service{chainClient: chain, jupiterClient: jup, mergePolicy: DefaultMergePolicy()}
+
+	chain.EXPECT().GetTokenMetadata(mock.Anything, mock.Anything).
+		Return(&bmodel.TokenMetadata{Name: "Bonk", Symbol: "BONK"}, nil).Maybe()
+	jup.EXPECT().GetQuote(mock.Anything, mock.Anything).Return(nil, errors.New("no route found"))
+
+	_, err := s.indexMint(context.Background(), bonkMint)
+	assert.ErrorContains(t, err, "no swap route")
+}
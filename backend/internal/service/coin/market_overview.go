@@ -0,0 +1,120 @@
+package coin
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+const (
+	// JobMarketOverview recomputes the home screen market overview
+	JobMarketOverview = "coin.compute.market_overview"
+
+	// DefaultMarketOverviewInterval is used when Config.MarketOverviewInterval is unset
+	DefaultMarketOverviewInterval = 5 * time.Minute
+
+	// marketOverviewMovers is how many gainers and losers the overview carries
+	marketOverviewMovers = 5
+	// marketOverviewLoserCandidates is how many of the worst performers are scanned
+	// for losers, leaving room for xStocks and dead coins that get skipped
+	marketOverviewLoserCandidates = 50
+)
+
+// marketOverviewExcludedTags keeps tokenized stocks out of memecoin totals and movers
+var marketOverviewExcludedTags = []string{"xstocks"}
+
+// GetMarketOverview returns the latest market overview. It is normally served from
+// the scheduled job; before the first run it is computed inline.
+func (s *Service) GetMarketOverview(ctx context.Context) (*model.MarketOverview, error) {
+	if overview := s.marketOverview.Load(); overview != nil {
+		return overview, nil
+	}
+	result, err, _ := s.marketOverviewGroup.Do(JobMarketOverview, func() (any, error) {
+		if overview := s.marketOverview.Load(); overview != nil {
+			return overview, nil
+		}
+		if err := s.ComputeMarketOverview(ctx); err != nil {
+			return nil, err
+		}
+		return s.marketOverview.Load(), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*model.MarketOverview), nil
+}
+
+// ComputeMarketOverview aggregates SOL price, memecoin totals and the day's biggest
+// movers, and stores the result for GetMarketOverview.
+func (s *Service) ComputeMarketOverview(ctx context.Context) error {
+	start := time.Now()
+	overview := &model.MarketOverview{}
+
+	sol, err := s.GetCoinByAddress(ctx, model.SolMint)
+	if err != nil {
+		return fmt.Errorf("failed to get SOL price: %w", err)
+	}
+	overview.SolPrice = sol.Price
+	overview.SolPrice24hChangePercent = sol.Price24hChangePercent
+
+	totals, err := s.store.GetMarketTotals(ctx, marketOverviewExcludedTags, start.Add(-24*time.Hour))
+	if err != nil {
+		return err
+	}
+	overview.Totals = *totals
+
+	gainers, _, err := s.GetTopGainersCoins(ctx, marketOverviewMovers*2, 0)
+	if err != nil {
+		return fmt.Errorf("failed to get top gainers: %w", err)
+	}
+	overview.TopGainers = topMovers(s.filterBlocked(gainers), func(c model.Coin) bool {
+		return c.Price24hChangePercent > 0
+	})
+
+	candidates, err := s.store.SearchCoins(ctx, "", nil, 0, marketOverviewLoserCandidates, 0, "price_24h_change_percent", false)
+	if err != nil {
+		return fmt.Errorf("failed to get top losers: %w", err)
+	}
+	overview.TopLosers = topMovers(s.filterBlocked(candidates), func(c model.Coin) bool {
+		return c.Price24hChangePercent < 0 && c.Volume24hUSD > 0
+	})
+
+	overview.ComputedAt = time.Now()
+	s.marketOverview.Store(overview)
+
+	slog.InfoContext(ctx, "Computed market overview",
+		"sol_price", overview.SolPrice,
+		"volume_24h_usd", overview.Totals.Volume24hUSD,
+		"new_listings_24h", overview.Totals.NewListings24h,
+		"gainers", len(overview.TopGainers),
+		"losers", len(overview.TopLosers),
+		"duration", time.Since(start))
+	return nil
+}
+
+func (s *Service) runMarketOverviewJob(ctx context.Context) {
+	s.runPeriodicFetcher(ctx, JobMarketOverview, s.ComputeMarketOverview)
+}
+
+// topMovers keeps the first marketOverviewMovers coins that match keep, skipping
+// SOL and excluded tags. coins must already be in ranking order.
+func topMovers(coins []model.Coin, keep func(model.Coin) bool) []model.Coin {
+	movers := make([]model.Coin, 0, marketOverviewMovers)
+	for _, c := range coins {
+		if len(movers) == marketOverviewMovers {
+			break
+		}
+		if c.Address == model.SolMint || c.Address == model.NativeSolMint || !keep(c) {
+			continue
+		}
+		if slices.ContainsFunc(c.Tags, func(tag string) bool { return slices.Contains(marketOverviewExcludedTags, tag) }) {
+			continue
+		}
+		movers = append(movers, c)
+	}
+	return movers
+}
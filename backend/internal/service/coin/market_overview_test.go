@@ -0,0 +1,43 @@
+package coin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	dbmocks "github.com/nicolas-martin/dankfolio/backend/internal/db/mocks"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+func TestTopMovers_SkipsSolAndXStocks(t *testing.T) {
+	coins := []model.Coin{
+		{Address: model.SolMint, Price24hChangePercent: -1},
+		{Address: "stock", Tags: []string{"xstocks"}, Price24hChangePercent: -30},
+		{Address: "dead", Price24hChangePercent: -99},
+		{Address: "a", Price24hChangePercent: -20, Volume24hUSD: 10},
+		{Address: "b", Price24hChangePercent: -10, Volume24hUSD: 10},
+		{Address: "up", Price24hChangePercent: 5, Volume24hUSD: 10},
+	}
+	losers := topMovers(coins, func(c model.Coin) bool {
+		return c.Price24hChangePercent < 0 && c.Volume24hUSD > 0
+	})
+	require.Len(t, losers, 2)
+	assert.Equal(t, "a", losers[0].Address)
+	assert.Equal(t, "b", losers[1].Address)
+}
+
+func TestGetMarketOverview_ServesComputedOverview(t *testing.T) {
+	store := dbmocks.NewMockStore(t)
+	s := &Service{store: store}
+	computed := &model.MarketOverview{SolPrice: 150, ComputedAt: time.Now()}
+	s.marketOverview.Store(computed)
+
+	got, err := s.GetMarketOverview(context.Background())
+	require.NoError(t, err)
+	assert.Same(t, computed, got)
+	store.AssertNotCalled(t, "GetMarketTotals", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,244 @@
+package coin
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+// MergePolicy decides which source's value a coin metadata field keeps when
+// providers disagree. Sources are listed most trusted first; sources missing
+// from a list, including values stored before sources were recorded, rank
+// below every listed one. Admin overrides win regardless of the policy.
+type MergePolicy struct {
+	// Fields holds the priority of the fields that don't follow Default, by field name
+	Fields  map[string][]string
+	Default []string
+}
+
+// DefaultMergePolicy trusts the token's own metadata over what aggregators
+// scraped, except for icons
+func DefaultMergePolicy() MergePolicy {
+	return MergePolicy{
+		Default: []string{model.CoinSourceMetaplex, model.CoinSourceJupiter, model.CoinSourceBirdeye},
+		Fields: map[string][]string{
+			// Aggregators serve icons from their own CDN, which loads far more
+			// reliably than the IPFS links metadata usually points at
+			"logo_uri": {model.CoinSourceBirdeye, model.CoinSourceJupiter, model.CoinSourceMetaplex},
+		},
+	}
+}
+
+// rank returns the position of source in the field's priority, lower is more trusted
+func (p MergePolicy) rank(field, source string) int {
+	priority, ok := p.Fields[field]
+	if !ok {
+		priority = p.Default
+	}
+	if i := slices.Index(priority, source); i >= 0 {
+		return i
+	}
+	return len(priority)
+}
+
+// keeps reports whether a set field from current should be kept over a
+// value from incoming. Equally trusted sources are replaced, so a provider
+// refreshing its own values always lands.
+func (p MergePolicy) keeps(field, current, incoming string) bool {
+	return p.rank(field, incoming) > p.rank(field, current)
+}
+
+// offer sets the fields candidate has a value for on coin, where source ranks
+// at least as high as the source of the coin's current value
+func (p MergePolicy) offer(coin, candidate *model.Coin, source string) {
+	for _, f := range metadataFields {
+		value := f.value(candidate)
+		if value == "" {
+			continue
+		}
+		if f.value(coin) != "" && p.keeps(f.name, coin.MetadataSources[f.name], source) {
+			continue
+		}
+		f.set(coin, value)
+		if coin.MetadataSources == nil {
+			coin.MetadataSources = make(map[string]string)
+		}
+		coin.MetadataSources[f.name] = source
+	}
+}
+
+// merge resolves each metadata field of incoming against the stored coin, nil
+// when new, and the coin's overrides by field. The winning values are left on
+// incoming, along with the source each was taken from.
+func (p MergePolicy) merge(ctx context.Context, stored, incoming *model.Coin, overrides map[string]string) {
+	sources := make(map[string]string, len(metadataFields))
+	for _, f := range metadataFields {
+		value, source := f.value(incoming), incoming.MetadataSources[f.name]
+		if source == "" {
+			source = defaultRevisionSource
+		}
+		if override, ok := overrides[f.name]; ok {
+			value, source = override, model.CoinSourceAdmin
+		} else if stored != nil {
+			storedValue, storedSource := f.value(stored), stored.MetadataSources[f.name]
+			if storedValue != "" && (value == "" || p.keeps(f.name, storedSource, source)) {
+				if value != "" && value != storedValue {
+					slog.DebugContext(ctx, "Kept stored coin field over a less trusted source",
+						slog.String("address", incoming.Address),
+						slog.String("field", f.name),
+						slog.String("stored_source", storedSource),
+						slog.String("rejected_source", source))
+				}
+				value, source = storedValue, storedSource
+			}
+		}
+		f.set(incoming, value)
+		if value != "" && source != "" {
+			sources[f.name] = source
+		}
+	}
+	incoming.MetadataSources = sources
+}
+
+// mergeStore resolves the coins written through it against their stored
+// versions and admin overrides, then records the metadata changes that made
+// it through. New coins have no history, so creating one records nothing.
+type mergeStore struct {
+	db.Store
+	policy MergePolicy
+}
+
+func (s mergeStore) Coins() db.Repository[model.Coin] {
+	return &mergeRepo{Repository: s.Store.Coins(), store: s.Store, policy: s.policy}
+}
+
+func (s mergeStore) WithTransaction(ctx context.Context, fn func(txStore db.Store) error) error {
+	return s.Store.WithTransaction(ctx, func(txStore db.Store) error {
+		return fn(mergeStore{Store: txStore, policy: s.policy})
+	})
+}
+
+type mergeRepo struct {
+	db.Repository[model.Coin]
+	store  db.Store // Overrides and revisions are read and written with the coins, in their transaction if any
+	policy MergePolicy
+}
+
+func (r *mergeRepo) Create(ctx context.Context, coin *model.Coin) error {
+	r.policy.merge(ctx, nil, coin, r.overrides(ctx, []string{coin.Address})[coin.Address])
+	return r.Repository.Create(ctx, coin)
+}
+
+func (r *mergeRepo) Update(ctx context.Context, coin *model.Coin) error {
+	stored := r.merge(ctx, []*model.Coin{coin})
+	if err := r.Repository.Update(ctx, coin); err != nil {
+		return err
+	}
+	r.record(ctx, stored, []model.Coin{*coin})
+	return nil
+}
+
+func (r *mergeRepo) Upsert(ctx context.Context, coin *model.Coin) (int64, error) {
+	stored := r.merge(ctx, []*model.Coin{coin})
+	affected, err := r.Repository.Upsert(ctx, coin)
+	if err != nil {
+		return affected, err
+	}
+	r.record(ctx, stored, []model.Coin{*coin})
+	return affected, nil
+}
+
+func (r *mergeRepo) BulkUpsert(ctx context.Context, coins *[]model.Coin) (int64, error) {
+	return r.bulk(ctx, coins, r.Repository.BulkUpsert)
+}
+
+func (r *mergeRepo) BulkWrite(ctx context.Context, coins *[]model.Coin, opts db.BulkWriteOptions) (int64, error) {
+	return r.bulk(ctx, coins, func(ctx context.Context, coins *[]model.Coin) (int64, error) {
+		return r.Repository.BulkWrite(ctx, coins, opts)
+	})
+}
+
+// bulk merges coins, writes them with write and records the changes
+func (r *mergeRepo) bulk(ctx context.Context, coins *[]model.Coin, write func(context.Context, *[]model.Coin) (int64, error)) (int64, error) {
+	batch := make([]*model.Coin, len(*coins))
+	for i := range *coins {
+		batch[i] = &(*coins)[i]
+	}
+	stored := r.merge(ctx, batch)
+	affected, err := write(ctx, coins)
+	if err != nil {
+		return affected, err
+	}
+	r.record(ctx, stored, *coins)
+	return affected, nil
+}
+
+// merge applies the policy to coins in place and returns their stored
+// versions by address. A failed lookup is logged and leaves the write to the
+// incoming values, unrecorded, rather than failing it.
+func (r *mergeRepo) merge(ctx context.Context, coins []*model.Coin) map[string]model.Coin {
+	addresses := make([]string, 0, len(coins))
+	for _, c := range coins {
+		addresses = append(addresses, c.Address)
+	}
+	var stored map[string]model.Coin
+	existing, err := r.Repository.GetByAddresses(ctx, addresses)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to load stored coins to merge with", slog.Int("count", len(addresses)), slog.Any("error", err))
+	} else {
+		stored = make(map[string]model.Coin, len(existing))
+		for _, c := range existing {
+			stored[c.Address] = c
+		}
+	}
+	overrides := r.overrides(ctx, addresses)
+	for _, c := range coins {
+		var previous *model.Coin
+		if s, ok := stored[c.Address]; ok {
+			previous = &s
+		}
+		r.policy.merge(ctx, previous, c, overrides[c.Address])
+	}
+	return stored
+}
+
+// overrides returns the admin overrides of the coins, by address then field
+func (r *mergeRepo) overrides(ctx context.Context, addresses []string) map[string]map[string]string {
+	rows, _, err := r.store.CoinOverrides().List(ctx, db.ListOptions{Filters: []db.FilterOption{
+		{Field: "coin_address", Operator: db.FilterOpIn, Value: addresses},
+	}})
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to load coin overrides", slog.Int("count", len(addresses)), slog.Any("error", err))
+		return nil
+	}
+	overrides := make(map[string]map[string]string)
+	for _, o := range rows {
+		if overrides[o.CoinAddress] == nil {
+			overrides[o.CoinAddress] = make(map[string]string)
+		}
+		overrides[o.CoinAddress][o.Field] = o.Value
+	}
+	return overrides
+}
+
+func (r *mergeRepo) record(ctx context.Context, stored map[string]model.Coin, coins []model.Coin) {
+	now := time.Now()
+	var revisions []model.CoinRevision
+	for i := range coins {
+		previous, ok := stored[coins[i].Address]
+		if !ok {
+			continue
+		}
+		revisions = append(revisions, diffRevisions(&previous, &coins[i], now)...)
+	}
+	if len(revisions) == 0 {
+		return
+	}
+	if _, err := r.store.CoinRevisions().BulkUpsert(ctx, &revisions); err != nil {
+		slog.WarnContext(ctx, "Failed to record coin revisions", slog.Int("count", len(revisions)), slog.Any("error", err))
+	}
+}
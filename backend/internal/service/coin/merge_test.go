@@ -0,0 +1,66 @@
+package coin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+func TestMergePolicy_Merge(t *testing.T) {
+	stored := model.Coin{
+		Address:     "mint",
+		Name:        "Bonk",
+		Symbol:      "BONK",
+		LogoURI:     "https://cdn/old.png",
+		Description: "The dog coin",
+		Website:     "https://bonk.example",
+		MetadataSources: map[string]string{
+			"name":        model.CoinSourceMetaplex,
+			"symbol":      model.CoinSourceMetaplex,
+			"logo_uri":    model.CoinSourceBirdeye,
+			"description": model.CoinSourceMetaplex,
+			"website":     model.CoinSourceAdmin, // Its override has since been removed
+		},
+	}
+	incoming := model.Coin{
+		Address: "mint",
+		Name:    "BONK TOKEN",
+		Symbol:  "BONK",
+		LogoURI: "https://cdn/new.png",
+		Website: "https://bonk.scraped",
+		Twitter: "https://twitter.com/bonk",
+	}
+
+	DefaultMergePolicy().merge(context.Background(), &stored, &incoming, map[string]string{"symbol": "Bonk"})
+
+	assert.Equal(t, "Bonk", incoming.Name, "verified metadata beats the aggregator")
+	assert.Equal(t, "Bonk", incoming.Symbol, "overrides always win")
+	assert.Equal(t, "https://cdn/new.png", incoming.LogoURI, "aggregators rank first for icons")
+	assert.Equal(t, "The dog coin", incoming.Description, "missing values keep the stored one")
+	assert.Equal(t, "https://bonk.scraped", incoming.Website, "a lapsed admin value ranks last")
+	assert.Equal(t, map[string]string{
+		"name":        model.CoinSourceMetaplex,
+		"symbol":      model.CoinSourceAdmin,
+		"logo_uri":    model.CoinSourceBirdeye,
+		"description": model.CoinSourceMetaplex,
+		"website":     model.CoinSourceBirdeye,
+		"twitter":     model.CoinSourceBirdeye,
+	}, incoming.MetadataSources)
+}
+
+func TestMergePolicy_Offer(t *testing.T) {
+	coin := model.Coin{Name: "BONK TOKEN", LogoURI: "https://cdn/bonk.png", Decimals: 5}
+	attributeChanges(&coin, metadataValues(&model.Coin{}), model.CoinSourceBirdeye)
+
+	DefaultMergePolicy().offer(&coin, &model.Coin{Name: "Bonk", Symbol: "BONK", LogoURI: "ipfs://bonk"}, model.CoinSourceMetaplex)
+
+	assert.Equal(t, "Bonk", coin.Name)
+	assert.Equal(t, "BONK", coin.Symbol)
+	assert.Equal(t, "https://cdn/bonk.png", coin.LogoURI)
+	assert.Equal(t, 5, coin.Decimals)
+	assert.Equal(t, model.CoinSourceMetaplex, coin.MetadataSources["name"])
+	assert.Equal(t, model.CoinSourceBirdeye, coin.MetadataSources["logo_uri"])
+}
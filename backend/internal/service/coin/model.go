@@ -14,6 +14,11 @@ type Config struct {
 	NewCoinsFetchInterval      time.Duration
 	TrendingFetchInterval      time.Duration
 	TopGainersFetchInterval    time.Duration
+	MarketOverviewInterval     time.Duration
+	ArchiveInterval            time.Duration // How often inactive coins are archived; 0 disables the job
+	ArchiveInactiveFor         time.Duration // How long a coin must be inactive before it is archived
+	IndexBudget                time.Duration // How long a search waits on indexing an unknown mint; 0 uses DefaultIndexBudget
+	PlaceholderRetryInterval   time.Duration // How often logos of coins on placeholders are retried; 0 disables the job
 	InitializeXStocksOnStartup bool
 }
 
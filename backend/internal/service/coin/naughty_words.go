@@ -0,0 +1,347 @@
+package coin
+
+import (
+	"bufio"
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/events"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+const (
+	// Column sizes of the naughty_words table
+	maxNaughtyWordLength     = 255
+	maxNaughtyLanguageLength = 10
+
+	defaultNaughtyWordLanguage = "en"
+	defaultNaughtyWordPage     = 100
+	maxNaughtyWordPage         = 1000
+)
+
+// ErrInvalidNaughtyWord is returned when a naughty word or language fails validation.
+var ErrInvalidNaughtyWord = errors.New("invalid naughty word")
+
+// NaughtyWordLanguage is a language the LDNOOBW repository has a word list for
+type NaughtyWordLanguage struct {
+	Code string
+	Name string
+}
+
+// NaughtyWordLanguages are the LDNOOBW word lists, by language code
+var NaughtyWordLanguages = []NaughtyWordLanguage{
+	{"ar", "Arabic"},
+	{"cs", "Czech"},
+	{"da", "Danish"},
+	{"de", "German"},
+	{"en", "English"},
+	{"eo", "Esperanto"},
+	{"es", "Spanish"},
+	{"fa", "Persian"},
+	{"fi", "Finnish"},
+	{"fil", "Filipino"},
+	{"fr", "French"},
+	{"fr-CA-u-sd-caqc", "Canadian French"},
+	{"hi", "Hindi"},
+	{"hu", "Hungarian"},
+	{"it", "Italian"},
+	{"ja", "Japanese"},
+	{"kab", "Kabyle"},
+	{"ko", "Korean"},
+	{"nl", "Dutch"},
+	{"no", "Norwegian"},
+	{"pl", "Polish"},
+	{"pt", "Portuguese"},
+	{"ru", "Russian"},
+	{"sv", "Swedish"},
+	{"th", "Thai"},
+	{"tlh", "Klingon"},
+	{"tr", "Turkish"},
+	{"zh", "Chinese"},
+}
+
+// NaughtyWordListURL is where a language's LDNOOBW list is fetched from, with the code appended
+var NaughtyWordListURL = "https://raw.githubusercontent.com/LDNOOBW/List-of-Dirty-Naughty-Obscene-and-Otherwise-Bad-Words/master/"
+
+var naughtyWordListClient = &http.Client{Timeout: 30 * time.Second}
+
+// NaughtyWordImport is the outcome of importing one language's LDNOOBW list
+type NaughtyWordImport struct {
+	Language string
+	Fetched  int
+	Added    int   // Words that weren't listed before, in any language
+	Err      error // Set when the list couldn't be fetched
+}
+
+// normalizeNaughtyWord folds a word the way the matcher compares them
+func normalizeNaughtyWord(word string) string {
+	return strings.ToLower(strings.Join(strings.Fields(word), " "))
+}
+
+// ListNaughtyWords returns a page of the naughty words sorted by word, with
+// the number matching. An empty language or query matches every word.
+func (s *Service) ListNaughtyWords(ctx context.Context, language, query string, limit, offset int) ([]model.NaughtyWord, int, error) {
+	words, err := s.naughtyWords(ctx, language)
+	if err != nil {
+		return nil, 0, err
+	}
+	if query = normalizeNaughtyWord(query); query != "" {
+		words = slices.DeleteFunc(words, func(w model.NaughtyWord) bool {
+			return !strings.Contains(w.Word, query)
+		})
+	}
+	total := len(words)
+	limit = min(cmp.Or(limit, defaultNaughtyWordPage), maxNaughtyWordPage)
+	offset = min(max(offset, 0), total)
+	return words[offset:min(offset+limit, total)], total, nil
+}
+
+// ExportNaughtyWords returns the naughty words in language, or all of them,
+// a word per line like the LDNOOBW lists
+func (s *Service) ExportNaughtyWords(ctx context.Context, language string) (string, int, error) {
+	words, err := s.naughtyWords(ctx, language)
+	if err != nil {
+		return "", 0, err
+	}
+	var b strings.Builder
+	for _, w := range words {
+		b.WriteString(w.Word)
+		b.WriteByte('\n')
+	}
+	return b.String(), len(words), nil
+}
+
+// naughtyWords returns the stored naughty words in language, or all of them, sorted
+func (s *Service) naughtyWords(ctx context.Context, language string) ([]model.NaughtyWord, error) {
+	words, _, err := s.store.NaughtyWords().List(ctx, db.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list naughty words: %w", err)
+	}
+	if language != "" {
+		words = slices.DeleteFunc(words, func(w model.NaughtyWord) bool { return w.Language != language })
+	}
+	slices.SortFunc(words, func(a, b model.NaughtyWord) int { return strings.Compare(a.Word, b.Word) })
+	return words, nil
+}
+
+// AddNaughtyWords lists words under language, "en" when empty, moving any
+// already listed under another. It returns how many weren't listed before.
+func (s *Service) AddNaughtyWords(ctx context.Context, words []string, language string) (int, error) {
+	language = cmp.Or(strings.TrimSpace(language), defaultNaughtyWordLanguage)
+	if len(language) > maxNaughtyLanguageLength {
+		return 0, fmt.Errorf("%w: language must be at most %d bytes", ErrInvalidNaughtyWord, maxNaughtyLanguageLength)
+	}
+	rows := make([]model.NaughtyWord, 0, len(words))
+	seen := make(map[string]bool, len(words))
+	for _, word := range words {
+		word = normalizeNaughtyWord(word)
+		if word == "" || seen[word] {
+			continue
+		}
+		if len(word) > maxNaughtyWordLength {
+			return 0, fmt.Errorf("%w: %q is over %d bytes", ErrInvalidNaughtyWord, word, maxNaughtyWordLength)
+		}
+		seen[word] = true
+		rows = append(rows, model.NaughtyWord{Word: word, Language: language})
+	}
+	if len(rows) == 0 {
+		return 0, fmt.Errorf("%w: no words given", ErrInvalidNaughtyWord)
+	}
+
+	existing, err := s.naughtyWords(ctx, "")
+	if err != nil {
+		return 0, err
+	}
+	added := len(rows)
+	for _, w := range existing {
+		if seen[w.Word] {
+			added--
+		}
+	}
+	if _, err := s.store.NaughtyWords().BulkUpsert(ctx, &rows); err != nil {
+		return 0, fmt.Errorf("failed to save naughty words: %w", err)
+	}
+	s.naughtyWordsChanged(ctx)
+	return added, nil
+}
+
+// RemoveNaughtyWords removes words from the list, returning how many were on it
+func (s *Service) RemoveNaughtyWords(ctx context.Context, words []string) (int, error) {
+	removed := 0
+	for _, word := range words {
+		word = normalizeNaughtyWord(word)
+		if word == "" {
+			continue
+		}
+		err := s.store.NaughtyWords().HardDelete(ctx, word)
+		switch {
+		case err == nil:
+			removed++
+		case !errors.Is(err, db.ErrNotFound):
+			if removed > 0 {
+				s.naughtyWordsChanged(ctx)
+			}
+			return removed, fmt.Errorf("failed to remove naughty word %q: %w", word, err)
+		}
+	}
+	if removed > 0 {
+		s.naughtyWordsChanged(ctx)
+	}
+	return removed, nil
+}
+
+// ImportNaughtyWords adds the LDNOOBW lists of languages, or of every
+// language when empty. Words already listed, in any language, are left as
+// they are. A list that can't be fetched is reported in its import and the
+// others are still added.
+func (s *Service) ImportNaughtyWords(ctx context.Context, languages []string) ([]NaughtyWordImport, error) {
+	selected := NaughtyWordLanguages
+	if len(languages) > 0 {
+		selected = make([]NaughtyWordLanguage, 0, len(languages))
+		for _, code := range languages {
+			i := slices.IndexFunc(NaughtyWordLanguages, func(l NaughtyWordLanguage) bool { return l.Code == code })
+			if i < 0 {
+				return nil, fmt.Errorf("%w: no LDNOOBW list for language %q", ErrInvalidNaughtyWord, code)
+			}
+			selected = append(selected, NaughtyWordLanguages[i])
+		}
+	}
+
+	listed, err := ListedNaughtyWords(ctx, s.store.NaughtyWords())
+	if err != nil {
+		return nil, err
+	}
+
+	imports := make([]NaughtyWordImport, 0, len(selected))
+	changed := false
+	for _, lang := range selected {
+		result := NaughtyWordImport{Language: lang.Code}
+		words, err := FetchNaughtyWordList(ctx, lang.Code)
+		if err != nil {
+			slog.WarnContext(ctx, "Failed to fetch naughty word list", slog.String("language", lang.Code), slog.Any("error", err))
+			result.Err = err
+			imports = append(imports, result)
+			continue
+		}
+		result.Fetched = len(words)
+
+		result.Added, err = SaveNewNaughtyWords(ctx, s.store.NaughtyWords(), words, lang.Code, listed)
+		if err != nil {
+			if changed {
+				s.naughtyWordsChanged(ctx)
+			}
+			return imports, err
+		}
+		changed = changed || result.Added > 0
+		imports = append(imports, result)
+	}
+	if changed {
+		s.naughtyWordsChanged(ctx)
+	}
+	return imports, nil
+}
+
+// ListedNaughtyWords returns the set of words on the list, in any language
+func ListedNaughtyWords(ctx context.Context, repo db.Repository[model.NaughtyWord]) (map[string]bool, error) {
+	words, _, err := repo.List(ctx, db.ListOptions{})
+	if err != nil && !errors.Is(err, db.ErrNotFound) {
+		return nil, fmt.Errorf("failed to list naughty words: %w", err)
+	}
+	listed := make(map[string]bool, len(words))
+	for _, w := range words {
+		listed[normalizeNaughtyWord(w.Word)] = true
+	}
+	return listed, nil
+}
+
+// SaveNewNaughtyWords saves the words not in listed under language with one
+// multi-row upsert, rather than an insert per word, and adds them to listed.
+// It returns how many it saved.
+func SaveNewNaughtyWords(ctx context.Context, repo db.Repository[model.NaughtyWord], words []string, language string, listed map[string]bool) (int, error) {
+	var rows []model.NaughtyWord
+	batch := make(map[string]bool)
+	for _, word := range words {
+		// Postgres rejects an upsert that touches a row twice, so duplicates are dropped here
+		if word = normalizeNaughtyWord(word); word != "" && len(word) <= maxNaughtyWordLength && !listed[word] && !batch[word] {
+			batch[word] = true
+			rows = append(rows, model.NaughtyWord{Word: word, Language: language})
+		}
+	}
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	if _, err := repo.BulkUpsert(ctx, &rows); err != nil {
+		return 0, fmt.Errorf("failed to save %s naughty words: %w", language, err)
+	}
+	for word := range batch {
+		listed[word] = true
+	}
+	return len(rows), nil
+}
+
+// FetchNaughtyWordList downloads a language's LDNOOBW list, skipping comments
+func FetchNaughtyWordList(ctx context.Context, code string) ([]string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, NaughtyWordListURL+code, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+	resp, err := naughtyWordListClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %w", req.URL, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch %s: status %d", req.URL, resp.StatusCode)
+	}
+
+	var words []string
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		if word := strings.TrimSpace(scanner.Text()); word != "" && !strings.HasPrefix(word, "#") {
+			words = append(words, word)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", req.URL, err)
+	}
+	return words, nil
+}
+
+// ReloadNaughtyWords has every instance sharing the event bus read the list again
+func (s *Service) ReloadNaughtyWords(ctx context.Context) {
+	s.naughtyWordsChanged(ctx)
+}
+
+// naughtyWordsChanged announces an edit of the list on the event bus, whose
+// subscribers reload it; see WatchNaughtyWordChanges. Without a bus only this
+// instance reloads.
+func (s *Service) naughtyWordsChanged(ctx context.Context) {
+	s.eventBusMu.RLock()
+	bus := s.eventBus
+	s.eventBusMu.RUnlock()
+	if bus != nil {
+		bus.Publish(ctx, events.NewNaughtyWordsChangedEvent())
+		return
+	}
+	if err := s.loadNaughtyWords(ctx); err != nil {
+		slog.ErrorContext(ctx, "Failed to reload naughty words", slog.Any("error", err))
+	}
+}
+
+// WatchNaughtyWordChanges reloads the naughty word list whenever an edit is
+// announced on bus, by this instance or, over a shared bus, any other
+func (s *Service) WatchNaughtyWordChanges(bus events.Bus) (unsubscribe func()) {
+	return bus.Subscribe(func(ctx context.Context, _ events.Event) {
+		if err := s.loadNaughtyWords(ctx); err != nil {
+			slog.ErrorContext(ctx, "Failed to reload naughty words", slog.Any("error", err))
+		}
+	}, events.NaughtyWordsChanged)
+}
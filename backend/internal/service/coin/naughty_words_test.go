@@ -0,0 +1,72 @@
+package coin
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	dbmocks "github.com/nicolas-martin/dankfolio/backend/internal/db/mocks"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+func TestImportNaughtyWords(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/fr" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, "# comment\nMerde\n\nbadword\nmerde\n")
+	}))
+	defer server.Close()
+	defer func(url string) { NaughtyWordListURL = url }(NaughtyWordListURL)
+	NaughtyWordListURL = server.URL + "/"
+
+	repo := dbmocks.NewMockRepository[model.NaughtyWord](t)
+	repo.EXPECT().List(mock.Anything, mock.Anything).Return([]model.NaughtyWord{{Word: "badword", Language: "en"}}, int32(1), nil)
+	repo.EXPECT().BulkUpsert(mock.Anything, &[]model.NaughtyWord{{Word: "merde", Language: "fr"}}).Return(int64(1), nil).Once()
+	store := dbmocks.NewMockStore(t)
+	store.EXPECT().NaughtyWords().Return(repo)
+
+	s := &Service{store: store}
+	imports, err := s.ImportNaughtyWords(context.Background(), []string{"fr", "de"})
+	require.NoError(t, err)
+	require.Len(t, imports, 2)
+	assert.Equal(t, NaughtyWordImport{Language: "fr", Fetched: 3, Added: 1}, imports[0])
+	assert.Error(t, imports[1].Err, "a missing list is reported, not fatal")
+	assert.True(t, s.isWordNaughty("badword"), "the matcher is reloaded without an event bus")
+
+	_, err = s.ImportNaughtyWords(context.Background(), []string{"xx"})
+	assert.ErrorIs(t, err, ErrInvalidNaughtyWord)
+}
+
+func TestAddNaughtyWords_Validates(t *testing.T) {
+	s := &Service{}
+	_, err := s.AddNaughtyWords(context.Background(), []string{" ", ""}, "en")
+	assert.ErrorIs(t, err, ErrInvalidNaughtyWord)
+	_, err = s.AddNaughtyWords(context.Background(), []string{"word"}, "not-a-language")
+	assert.ErrorIs(t, err, ErrInvalidNaughtyWord)
+}
+
+func TestSaveNewNaughtyWords_OneBatch(t *testing.T) {
+	repo := dbmocks.NewMockRepository[model.NaughtyWord](t)
+	repo.EXPECT().BulkUpsert(mock.Anything, &[]model.NaughtyWord{
+		{Word: "bad word", Language: "en"},
+		{Word: "other", Language: "en"},
+	}).Return(int64(2), nil).Once()
+
+	listed := map[string]bool{"listed": true}
+	added, err := SaveNewNaughtyWords(context.Background(), repo, []string{"Bad  Word", "listed", "bad word", "other", " "}, "en", listed)
+	require.NoError(t, err)
+	assert.Equal(t, 2, added)
+	assert.True(t, listed["other"])
+
+	added, err = SaveNewNaughtyWords(context.Background(), repo, []string{"other"}, "en", listed)
+	require.NoError(t, err)
+	assert.Zero(t, added, "nothing new makes no query")
+}
@@ -0,0 +1,102 @@
+package coin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+// maxOverrideLength bounds a single override value
+const maxOverrideLength = 4000
+
+// ErrInvalidOverride is returned when a coin override fails validation.
+var ErrInvalidOverride = errors.New("invalid coin override")
+
+// ListCoinOverrides returns the admin overrides of a coin, sorted by field.
+func (s *Service) ListCoinOverrides(ctx context.Context, coinAddress string) ([]model.CoinOverride, error) {
+	rows, _, err := s.store.CoinOverrides().List(ctx, db.ListOptions{Filters: []db.FilterOption{
+		{Field: "coin_address", Operator: db.FilterOpEqual, Value: coinAddress},
+	}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list overrides for %s: %w", coinAddress, err)
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].Field < rows[j].Field })
+	return rows, nil
+}
+
+// CoinMetadataSources returns the source each set metadata field of a stored
+// coin was taken from, by field.
+func (s *Service) CoinMetadataSources(ctx context.Context, coinAddress string) (map[string]string, error) {
+	coin, err := s.store.Coins().GetByField(ctx, "address", coinAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get coin %s: %w", coinAddress, err)
+	}
+	return coin.MetadataSources, nil
+}
+
+// SetCoinOverride pins a metadata field of a stored coin to value. The coin
+// is rewritten right away, and refreshes keep the value until the override is
+// deleted.
+func (s *Service) SetCoinOverride(ctx context.Context, coinAddress, field, value, updatedBy string) (*model.CoinOverride, error) {
+	if !isMetadataField(field) {
+		return nil, fmt.Errorf("%w: unknown field %q", ErrInvalidOverride, field)
+	}
+	value = strings.TrimSpace(value)
+	if value == "" || len(value) > maxOverrideLength {
+		return nil, fmt.Errorf("%w: value must be 1-%d bytes", ErrInvalidOverride, maxOverrideLength)
+	}
+	if decimals, err := strconv.Atoi(value); field == "decimals" && (err != nil || decimals <= 0) {
+		return nil, fmt.Errorf("%w: decimals must be a positive integer", ErrInvalidOverride)
+	}
+	coin, err := s.store.Coins().GetByField(ctx, "address", coinAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get coin %s: %w", coinAddress, err)
+	}
+
+	override := model.CoinOverride{
+		ID:          overrideID(coinAddress, field),
+		CoinAddress: coinAddress,
+		Field:       field,
+		Value:       value,
+		UpdatedBy:   updatedBy,
+	}
+	if _, err := s.store.CoinOverrides().Upsert(ctx, &override); err != nil {
+		return nil, fmt.Errorf("failed to save override of %s for %s: %w", field, coinAddress, err)
+	}
+	// The write picks the override up and records the change as an admin one
+	if err := s.store.Coins().Update(ctx, coin); err != nil {
+		return nil, fmt.Errorf("failed to apply override of %s to %s: %w", field, coinAddress, err)
+	}
+	if s.cache != nil {
+		s.cache.Delete(fmt.Sprintf("coin:%s", coinAddress))
+	}
+	return s.store.CoinOverrides().Get(ctx, override.ID)
+}
+
+// DeleteCoinOverride removes an override. The coin keeps the overridden
+// value until a provider next supplies the field.
+func (s *Service) DeleteCoinOverride(ctx context.Context, coinAddress, field string) error {
+	if err := s.store.CoinOverrides().HardDelete(ctx, overrideID(coinAddress, field)); err != nil {
+		return fmt.Errorf("failed to delete override of %s for %s: %w", field, coinAddress, err)
+	}
+	return nil
+}
+
+func isMetadataField(name string) bool {
+	for _, f := range metadataFields {
+		if f.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func overrideID(coinAddress, field string) string {
+	return coinAddress + ":" + field
+}
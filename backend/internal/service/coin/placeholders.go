@@ -0,0 +1,76 @@
+package coin
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+)
+
+const (
+	// JobRetryPlaceholderLogos retries the logos of coins served placeholders
+	JobRetryPlaceholderLogos = "coin.logos.retry_placeholders"
+
+	// placeholderRetryBatch bounds how many logos one run retries
+	placeholderRetryBatch = 50
+	// MaxPlaceholderAttempts is how many failed uploads a logo gets before it
+	// stays on its placeholder until the coin's metadata changes
+	MaxPlaceholderAttempts = 10
+)
+
+// RetryPlaceholderLogos retries uploading the logos of coins on placeholders,
+// least retried first. Coins whose logo uploads are pointed back at it; the
+// others stay on their placeholder and are retried by a later run.
+func (s *Service) RetryPlaceholderLogos(ctx context.Context) error {
+	if s.imageProxy == nil {
+		return nil
+	}
+	placeholders, err := s.imageProxy.Placeholders(ctx, placeholderRetryBatch, MaxPlaceholderAttempts)
+	if err != nil {
+		return err
+	}
+
+	restored := 0
+	for _, p := range placeholders {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if p.OriginalURL == "" {
+			// No logo to retry; the coin gets one when its metadata is refreshed
+			if s.hasOwnLogo(ctx, p.ID) {
+				if err := s.imageProxy.ClearPlaceholder(ctx, p.ID); err != nil {
+					return fmt.Errorf("failed after restoring %d logos: %w", restored, err)
+				}
+				restored++
+			}
+			continue
+		}
+		logoURI, uploadErr := s.uploadLogoWithFallback(ctx, p.OriginalURL, p.ID, p.ID, false)
+		if uploadErr != nil {
+			// Counts the attempt
+			if _, err := s.imageProxy.UsePlaceholder(ctx, p.ID, p.OriginalURL, uploadErr); err != nil {
+				slog.WarnContext(ctx, "Failed to record placeholder logo retry", slog.String("address", p.ID), slog.Any("error", err))
+			}
+			continue
+		}
+		s.setStoredLogo(ctx, p.ID, logoURI)
+		if err := s.imageProxy.ClearPlaceholder(ctx, p.ID); err != nil {
+			return fmt.Errorf("failed after restoring %d logos: %w", restored, err)
+		}
+		restored++
+	}
+	slog.InfoContext(ctx, "Retried placeholder logos", slog.Int("retried", len(placeholders)), slog.Int("restored", restored))
+	return nil
+}
+
+// hasOwnLogo reports whether a stored coin has a logo other than its placeholder
+func (s *Service) hasOwnLogo(ctx context.Context, address string) bool {
+	coin, err := s.store.Coins().GetByField(ctx, "address", address)
+	if err != nil {
+		return false
+	}
+	return coin.LogoURI != "" && !s.imageProxy.IsPlaceholderURL(coin.LogoURI)
+}
+
+func (s *Service) runPlaceholderRetryJob(ctx context.Context) {
+	s.runPeriodicFetcher(ctx, JobRetryPlaceholderLogos, s.RetryPlaceholderLogos)
+}
@@ -5,12 +5,15 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/nicolas-martin/dankfolio/backend/internal/apperrors"
 	"github.com/nicolas-martin/dankfolio/backend/internal/clients/birdeye"
 	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/events"
 	"github.com/nicolas-martin/dankfolio/backend/internal/model"
 	"github.com/nicolas-martin/dankfolio/backend/internal/util"
 )
@@ -74,7 +77,7 @@ func (s *Service) GetCoinByAddress(ctx context.Context, address string) (*model.
 	
 	if !util.IsValidSolanaAddress(address) {
 		slog.ErrorContext(ctx, "Invalid Solana address", slog.String("address", address))
-		return nil, fmt.Errorf("invalid address: %s", address)
+		return nil, apperrors.ErrInvalidAddress.WithMessage(fmt.Sprintf("invalid address: %s", address))
 	}
 
 	// Step 1: Check cache first for fresh data
@@ -86,8 +89,42 @@ func (s *Service) GetCoinByAddress(ctx context.Context, address string) (*model.
 		return &cachedCoins[0], nil
 	}
 
+	// Steps 2 and 3 hit the database and Birdeye. When a coin trends, many identical
+	// requests arrive at once, so collapse them into a single upstream fetch.
+	result, err, shared := s.coinFetchGroup.Do(cacheKey, func() (any, error) {
+		return s.loadCoinByAddress(ctx, address, cacheKey)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if shared {
+		slog.DebugContext(ctx, "Coin lookup coalesced with in-flight request", slog.String("address", address))
+	}
+
+	loaded, ok := result.(*model.Coin)
+	if !ok || loaded == nil {
+		return nil, fmt.Errorf("coin %s could not be loaded", address)
+	}
+	// Hand each caller its own copy so concurrent callers never share a mutable coin
+	coinCopy := *loaded
+	return &coinCopy, nil
+}
+
+// loadCoinByAddress resolves a coin that is not in the cache, first from the
+// database and then from Birdeye, caching whatever it finds.
+func (s *Service) loadCoinByAddress(ctx context.Context, address, cacheKey string) (*model.Coin, error) {
 	// Step 2: Check database if not in cache
 	coin, err := s.store.Coins().GetByField(ctx, "address", address)
+	if err == nil && slices.Contains(coin.Tags, TagProvisional) {
+		// Indexed from search, and its full enrichment hasn't landed yet
+		enriched, err := s.fetchAndCacheCoin(ctx, address)
+		if err != nil {
+			slog.WarnContext(ctx, "Failed to enrich provisional coin, returning it as is", slog.String("address", address), slog.Any("error", err))
+			return coin, nil
+		}
+		s.cache.Set(cacheKey, []model.Coin{*enriched}, CoinCacheExpiry)
+		return enriched, nil
+	}
 	if err == nil {
 		slog.InfoContext(ctx, "Coin found in database", 
 			slog.String("address", address),
@@ -194,15 +231,19 @@ func (s *Service) fetchNewCoin(ctx context.Context, address string) (*model.Coin
 	// Use the single token overview endpoint instead of batch (which requires premium)
 	tokenOverview, err := s.birdeyeClient.GetTokenOverview(ctx, address)
 	if err != nil {
+		// Rate limiting and outages are already classified by the client
+		if _, ok := apperrors.KindOf(err); ok {
+			return nil, fmt.Errorf("failed to fetch token overview for %s: %w", address, err)
+		}
 		// Check if it's an API key/permissions error
 		if strings.Contains(err.Error(), "401") || strings.Contains(err.Error(), "API key") || strings.Contains(err.Error(), "suspended") {
-			return nil, fmt.Errorf("unable to access market data at this time. Please try again later")
+			return nil, apperrors.ErrUpstreamUnavailable.WithMessage("unable to access market data at this time. Please try again later").Wrap(err)
 		}
-		return nil, fmt.Errorf("unable to fetch token data. Please try again")
+		return nil, apperrors.ErrUpstreamUnavailable.WithMessage("unable to fetch token data. Please try again").Wrap(err)
 	}
 
 	if !tokenOverview.Success || tokenOverview.Data.Address == "" {
-		return nil, fmt.Errorf("token not found. Please check the address and try again")
+		return nil, apperrors.ErrCoinNotFound.WithMessage("token not found. Please check the address and try again").With("address", address)
 	}
 
 	tokenData := tokenOverview.Data
@@ -269,6 +310,8 @@ func (s *Service) fetchNewCoin(ctx context.Context, address string) (*model.Coin
 	// Save to database
 	if createErr := s.store.Coins().Create(ctx, coin); createErr != nil {
 		slog.WarnContext(ctx, "Failed to create new coin in database", slog.String("address", coin.Address), slog.Any("error", createErr))
+	} else {
+		s.publishEvent(ctx, events.NewCoinEvent(events.CoinDiscovered, *coin))
 	}
 
 	slog.InfoContext(ctx, "Successfully fetched and saved new coin", slog.String("address", coin.Address))
@@ -335,6 +378,8 @@ func (s *Service) fetchAndCacheCoin(ctx context.Context, address string) (*model
 	} else {
 		if dbErr := s.store.Coins().Create(ctx, enrichedCoin); dbErr != nil {
 			slog.WarnContext(ctx, "Failed to create enriched coin in store", slog.String("address", enrichedCoin.Address), slog.Any("error", dbErr))
+		} else {
+			s.publishEvent(ctx, events.NewCoinEvent(events.CoinDiscovered, *enrichedCoin))
 		}
 	}
 	slog.DebugContext(ctx, "Dynamic coin enrichment and storage attempt complete", slog.String("address", address))
@@ -0,0 +1,147 @@
+package coin
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+// defaultRevisionSource is the source of the changes a write doesn't attribute;
+// every coin the service writes starts from Birdeye data
+const defaultRevisionSource = model.CoinSourceBirdeye
+
+const (
+	defaultHistoryLimit = 100
+	maxHistoryLimit     = 500
+)
+
+// metadataFields are the coin metadata fields resolved by the merge policy and
+// whose changes are recorded. An empty value means the field is unset.
+var metadataFields = []struct {
+	name  string
+	value func(*model.Coin) string
+	set   func(*model.Coin, string)
+}{
+	{"name", func(c *model.Coin) string { return c.Name }, func(c *model.Coin, v string) { c.Name = v }},
+	{"symbol", func(c *model.Coin) string { return c.Symbol }, func(c *model.Coin, v string) { c.Symbol = v }},
+	{"decimals", decimalsValue, setDecimals},
+	{"logo_uri", func(c *model.Coin) string { return c.LogoURI }, func(c *model.Coin, v string) { c.LogoURI = v }},
+	{"description", func(c *model.Coin) string { return c.Description }, func(c *model.Coin, v string) { c.Description = v }},
+	{"website", func(c *model.Coin) string { return c.Website }, func(c *model.Coin, v string) { c.Website = v }},
+	{"twitter", func(c *model.Coin) string { return c.Twitter }, func(c *model.Coin, v string) { c.Twitter = v }},
+	{"telegram", func(c *model.Coin) string { return c.Telegram }, func(c *model.Coin, v string) { c.Telegram = v }},
+	{"discord", func(c *model.Coin) string { return c.Discord }, func(c *model.Coin, v string) { c.Discord = v }},
+}
+
+// decimalsValue treats zero decimals as unset; no provider reports it for a real mint
+func decimalsValue(c *model.Coin) string {
+	if c.Decimals == 0 {
+		return ""
+	}
+	return strconv.Itoa(c.Decimals)
+}
+
+func setDecimals(c *model.Coin, v string) {
+	if d, err := strconv.Atoi(v); err == nil {
+		c.Decimals = d
+	}
+}
+
+// metadataValues returns the metadata fields of coin by name
+func metadataValues(coin *model.Coin) map[string]string {
+	values := make(map[string]string, len(metadataFields))
+	for _, f := range metadataFields {
+		values[f.name] = f.value(coin)
+	}
+	return values
+}
+
+// attributeChanges attributes the fields of coin that changed since before to source
+func attributeChanges(coin *model.Coin, before map[string]string, source string) {
+	for _, f := range metadataFields {
+		if f.value(coin) == before[f.name] {
+			continue
+		}
+		if coin.MetadataSources == nil {
+			coin.MetadataSources = make(map[string]string)
+		}
+		coin.MetadataSources[f.name] = source
+	}
+}
+
+// diffRevisions returns a revision for each metadata field that differs between
+// the stored coin and the one replacing it
+func diffRevisions(stored, updated *model.Coin, now time.Time) []model.CoinRevision {
+	var revisions []model.CoinRevision
+	for _, f := range metadataFields {
+		previous, current := f.value(stored), f.value(updated)
+		if previous == current {
+			continue
+		}
+		source := updated.MetadataSources[f.name]
+		if source == "" {
+			source = defaultRevisionSource
+		}
+		revisions = append(revisions, model.CoinRevision{
+			ID:          uuid.NewString(),
+			CoinAddress: updated.Address,
+			Field:       f.name,
+			Previous:    previous,
+			Current:     current,
+			Source:      source,
+			ChangedAt:   now,
+		})
+	}
+	return revisions
+}
+
+// recordRevision stores a single change made outside the coins table, such as
+// an admin description edit
+func (s *Service) recordRevision(ctx context.Context, coinAddress, field, previous, current, source string) {
+	if previous == current {
+		return
+	}
+	revision := model.CoinRevision{
+		ID:          uuid.NewString(),
+		CoinAddress: coinAddress,
+		Field:       field,
+		Previous:    previous,
+		Current:     current,
+		Source:      source,
+		ChangedAt:   time.Now(),
+	}
+	if err := s.store.CoinRevisions().Create(ctx, &revision); err != nil {
+		slog.WarnContext(ctx, "Failed to record coin revision", slog.String("address", coinAddress), slog.String("field", field), slog.Any("error", err))
+	}
+}
+
+// CoinHistory returns the recorded metadata changes of a coin, newest first,
+// optionally only those of one field. limit defaults to 100, at most 500.
+func (s *Service) CoinHistory(ctx context.Context, coinAddress, field string, limit int) ([]model.CoinRevision, error) {
+	filters := []db.FilterOption{{Field: "coin_address", Operator: db.FilterOpEqual, Value: coinAddress}}
+	if field != "" {
+		filters = append(filters, db.FilterOption{Field: "field", Operator: db.FilterOpEqual, Value: field})
+	}
+	if limit <= 0 {
+		limit = defaultHistoryLimit
+	}
+	limit = min(limit, maxHistoryLimit)
+	sortBy, desc := "changed_at", true
+	revisions, _, err := s.store.CoinRevisions().ListWithOpts(ctx, db.ListOptions{
+		Limit:    &limit,
+		SortBy:   &sortBy,
+		SortDesc: &desc,
+		Filters:  filters,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list revisions of coin %s: %w", coinAddress, err)
+	}
+	return revisions, nil
+}
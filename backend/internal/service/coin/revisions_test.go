@@ -0,0 +1,66 @@
+package coin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	dbmocks "github.com/nicolas-martin/dankfolio/backend/internal/db/mocks"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+func TestMergeRepo_RecordsChangedFieldsWithTheirSource(t *testing.T) {
+	stored := model.Coin{Address: "mint", Name: "Bonk", Symbol: "BONK", Decimals: 5, LogoURI: "https://old/icon.png"}
+
+	updated := stored
+	before := metadataValues(&updated)
+	updated.LogoURI = "ipfs://new-icon"
+	attributeChanges(&updated, before, model.CoinSourceMetaplex)
+	updated.Name = "Bonk Inu"
+
+	coins := dbmocks.NewMockRepository[model.Coin](t)
+	coins.EXPECT().GetByAddresses(mock.Anything, []string{"mint"}).Return([]model.Coin{stored}, nil)
+	coins.EXPECT().Update(mock.Anything, &updated).Return(nil)
+	var recorded []model.CoinRevision
+	revisions := dbmocks.NewMockRepository[model.CoinRevision](t)
+	revisions.EXPECT().BulkUpsert(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, rows *[]model.CoinRevision) (int64, error) {
+		recorded = *rows
+		return int64(len(*rows)), nil
+	})
+	store := dbmocks.NewMockStore(t)
+	store.EXPECT().Coins().Return(coins)
+	store.EXPECT().CoinOverrides().Return(noOverrides(t))
+	store.EXPECT().CoinRevisions().Return(revisions)
+
+	require.NoError(t, mergeStore{Store: store}.Coins().Update(context.Background(), &updated))
+
+	require.Len(t, recorded, 2)
+	assert.Equal(t, "name", recorded[0].Field)
+	assert.Equal(t, "Bonk", recorded[0].Previous)
+	assert.Equal(t, "Bonk Inu", recorded[0].Current)
+	assert.Equal(t, model.CoinSourceBirdeye, recorded[0].Source)
+	assert.Equal(t, "logo_uri", recorded[1].Field)
+	assert.Equal(t, model.CoinSourceMetaplex, recorded[1].Source)
+}
+
+func TestMergeRepo_NewCoinsRecordNothing(t *testing.T) {
+	coins := dbmocks.NewMockRepository[model.Coin](t)
+	coins.EXPECT().GetByAddresses(mock.Anything, []string{"mint"}).Return(nil, nil)
+	coins.EXPECT().BulkUpsert(mock.Anything, mock.Anything).Return(int64(1), nil)
+	store := dbmocks.NewMockStore(t)
+	store.EXPECT().Coins().Return(coins)
+	store.EXPECT().CoinOverrides().Return(noOverrides(t))
+
+	rows := []model.Coin{{Address: "mint", Name: "Bonk"}}
+	_, err := mergeStore{Store: store}.Coins().BulkUpsert(context.Background(), &rows)
+	require.NoError(t, err)
+}
+
+func noOverrides(t *testing.T) *dbmocks.MockRepository[model.CoinOverride] {
+	overrides := dbmocks.NewMockRepository[model.CoinOverride](t)
+	overrides.EXPECT().List(mock.Anything, mock.Anything).Return(nil, int32(0), nil)
+	return overrides
+}
@@ -5,16 +5,29 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/nicolas-martin/dankfolio/backend/internal/clients/birdeye"
 	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/events"
 	"github.com/nicolas-martin/dankfolio/backend/internal/model"
 )
 
 // Search functionality for coins
 
+// SearchCoins searches coins in the database, falling back to Birdeye, and drops blocklisted mints from the results
 func (s *Service) SearchCoins(ctx context.Context, query string, tags []string, minVolume24h float64, opts db.ListOptions) ([]model.Coin, int32, error) {
+	coins, total, err := s.searchCoins(ctx, query, tags, minVolume24h, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+	filtered := s.filterBlocked(coins)
+	return filtered, total - int32(len(coins)-len(filtered)), nil
+}
+
+func (s *Service) searchCoins(ctx context.Context, query string, tags []string, minVolume24h float64, opts db.ListOptions) ([]model.Coin, int32, error) {
 	if len(query) > 256 {
 		return nil, 0, fmt.Errorf("query string too long (max 256 chars): %d", len(query))
 	}
@@ -42,11 +55,34 @@ func (s *Service) SearchCoins(ctx context.Context, query string, tags []string,
 		sortDesc = *opts.SortDesc
 	}
 
+	var parsed searchQuery
+	if query != "" {
+		parsed = parseSearchQuery(s.resolveSynonym(ctx, query))
+		query = parsed.text
+	}
+	if parsed.address != "" && offset == 0 {
+		// A pasted mint is looked up directly, which indexes it if it's unknown
+		coin, err := s.LookupMint(ctx, parsed.address)
+		if err == nil {
+			return []model.Coin{*coin}, 1, nil
+		}
+		slog.DebugContext(ctx, "Mint lookup failed, searching for it as text",
+			slog.String("address", parsed.address),
+			slog.Any("error", err))
+	}
+
 	// First try database search
 	coins, err := s.store.SearchCoins(ctx, query, tags, minVolume24h, limit, offset, sortBy, sortDesc)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to search coins via store: %w", err)
 	}
+	s.personalizeRanking(ctx, coins)
+	if parsed.symbol != "" && sortBy == "" {
+		// A cashtag names a symbol, so exact matches go ahead of partial ones
+		sort.SliceStable(coins, func(i, j int) bool {
+			return strings.EqualFold(coins[i].Symbol, parsed.symbol) && !strings.EqualFold(coins[j].Symbol, parsed.symbol)
+		})
+	}
 
 	// If we have results or filters are applied, return database results
 	if len(coins) > 0 || len(tags) > 0 || minVolume24h > 0 {
@@ -144,6 +180,8 @@ func (s *Service) searchCoinsViaBirdeye(ctx context.Context, query string, limit
 				slog.WarnContext(ctx, "Failed to insert coin from search",
 					slog.String("address", token.Address),
 					slog.String("error", err.Error()))
+			} else {
+				s.publishEvent(ctx, events.NewCoinEvent(events.CoinDiscovered, coin))
 			}
 		}
 
@@ -0,0 +1,58 @@
+package coin
+
+import (
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/util"
+)
+
+// mintURLHosts are the explorers and charting sites whose token page URLs
+// carry the mint address in their path
+var mintURLHosts = []string{"solscan.io", "birdeye.so", "dexscreener.com", "explorer.solana.com", "solana.fm", "jup.ag", "pump.fun"}
+
+// searchQuery is a coin search query with the forms users paste resolved
+type searchQuery struct {
+	text    string // Free text to match against names, symbols and addresses
+	address string // Mint address, when the query names a single coin
+	symbol  string // Symbol from a "$SYMBOL" cashtag; exact matches rank first
+}
+
+// parseSearchQuery recognizes mint addresses, token page URLs and cashtags
+func parseSearchQuery(query string) searchQuery {
+	query = strings.TrimSpace(query)
+	if util.IsValidSolanaAddress(query) {
+		return searchQuery{text: query, address: query}
+	}
+	if mint, ok := mintFromURL(query); ok {
+		return searchQuery{text: mint, address: mint}
+	}
+	if symbol, ok := strings.CutPrefix(query, "$"); ok && symbol != "" && !strings.ContainsAny(symbol, " \t") {
+		return searchQuery{text: symbol, symbol: symbol}
+	}
+	return searchQuery{text: query}
+}
+
+// mintFromURL returns the mint address in the path of a token page URL. The
+// scheme may be left off. DexScreener pages can name a pair instead, which is
+// returned all the same; looking it up as a coin falls back to text search.
+func mintFromURL(raw string) (string, bool) {
+	if !strings.Contains(raw, "://") {
+		raw = "https://" + raw
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "", false
+	}
+	if !slices.Contains(mintURLHosts, strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")) {
+		return "", false
+	}
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	for i := len(segments) - 1; i >= 0; i-- {
+		if util.IsValidSolanaAddress(segments[i]) {
+			return segments[i], true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,54 @@
+package coin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	dbmocks "github.com/nicolas-martin/dankfolio/backend/internal/db/mocks"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+const bonkMint = "DezXAZ8z7PnrnRJjz3wXBoRgixCa6xjnB7YaB1pPB263"
+
+func TestParseSearchQuery(t *testing.T) {
+	tests := []struct {
+		query string
+		want  searchQuery
+	}{
+		{"  " + bonkMint + " ", searchQuery{text: bonkMint, address: bonkMint}},
+		{"https://solscan.io/token/" + bonkMint, searchQuery{text: bonkMint, address: bonkMint}},
+		{"birdeye.so/token/" + bonkMint + "?chain=solana", searchQuery{text: bonkMint, address: bonkMint}},
+		{"https://www.dexscreener.com/solana/" + bonkMint, searchQuery{text: bonkMint, address: bonkMint}},
+		{"https://example.com/token/" + bonkMint, searchQuery{text: "https://example.com/token/" + bonkMint}},
+		{"https://solscan.io/account/not-a-mint", searchQuery{text: "https://solscan.io/account/not-a-mint"}},
+		{"$WIF", searchQuery{text: "WIF", symbol: "WIF"}},
+		{"$", searchQuery{text: "$"}},
+		{"bonk", searchQuery{text: "bonk"}},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, parseSearchQuery(tt.query), tt.query)
+	}
+}
+
+func TestResolveSynonym(t *testing.T) {
+	synonyms := dbmocks.NewMockRepository[model.SearchSynonym](t)
+	synonyms.EXPECT().List(mock.Anything, mock.Anything).Return([]model.SearchSynonym{
+		{ID: "dogwifhat", Target: "$WIF"},
+		{ID: "dog wif hat", Target: "$WIF"},
+	}, int32(2), nil).Once()
+	store := dbmocks.NewMockStore(t)
+	store.EXPECT().SearchSynonyms().Return(synonyms)
+	s := &Service{store: store}
+	ctx := context.Background()
+
+	assert.Equal(t, "$WIF", s.resolveSynonym(ctx, "DogWifHat"))
+	assert.Equal(t, "$WIF", s.resolveSynonym(ctx, " dog  wif hat "), "whitespace is collapsed")
+	assert.Equal(t, "bonk", s.resolveSynonym(ctx, "bonk"), "synonyms are loaded once until stale")
+
+	_, err := s.SetSearchSynonym(ctx, "WIF", " wif ", "admin")
+	require.ErrorIs(t, err, ErrInvalidSynonym)
+}
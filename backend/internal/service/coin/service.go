@@ -2,16 +2,26 @@ package coin
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 	"sync"
+	"sync/atomic"
+	"time"
 
+	"golang.org/x/sync/singleflight"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/blocklist"
 	"github.com/nicolas-martin/dankfolio/backend/internal/clients"
 	"github.com/nicolas-martin/dankfolio/backend/internal/clients/birdeye"
 	"github.com/nicolas-martin/dankfolio/backend/internal/clients/jupiter"
 	"github.com/nicolas-martin/dankfolio/backend/internal/clients/offchain"
 	"github.com/nicolas-martin/dankfolio/backend/internal/clients/tracker"
 	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/events"
+	"github.com/nicolas-martin/dankfolio/backend/internal/jobs"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
 	"github.com/nicolas-martin/dankfolio/backend/internal/service/imageproxy"
+	"github.com/nicolas-martin/dankfolio/backend/internal/workerpool"
 )
 
 const (
@@ -29,20 +39,63 @@ type Service struct {
 	store          db.Store
 	fetcherCtx     context.Context
 	fetcherCancel  context.CancelFunc
+	backgroundWG   sync.WaitGroup
+	jobs           *jobs.Scheduler
 	birdeyeClient  birdeye.ClientAPI
 	apiTracker     *tracker.APITracker
 	cache          CoinCache
+	naughtyWordsMu sync.RWMutex
 	naughtyWordSet map[string]struct{}
 	xstocksConfig  *XStocksConfig
 	imageProxy     *imageproxy.Service
+	revalidations  revalidations // Icon re-validations started by RevalidateIcons
+
+	// Optional domain event bus; set after construction, read by background fetchers
+	eventBusMu sync.RWMutex
+	eventBus   events.Bus
+
+	// Decides which source's value each coin metadata field keeps
+	mergePolicy MergePolicy
+
+	// Optional scam blocklist; blocked mints are hidden from search and coin detail
+	blocklist *blocklist.Blocklist
+
+	// IPFS gateways tried when an image URL on another gateway fails
+	ipfsGatewaysMu       sync.RWMutex
+	ipfsFallbackGateways []string
 
 	// Mutexes to prevent duplicate API calls
 	trendingMutex   sync.Mutex
 	newCoinsMutex   sync.Mutex
 	topGainersMutex sync.Mutex
 
-	// Rate limiter for background image uploads
-	imageUploadLimiter chan struct{}
+	// Collapses concurrent identical upstream coin lookups into a single fetch
+	coinFetchGroup singleflight.Group
+
+	// Optional cache for GetCoinTradeStats, with its own request coalescing
+	tradeStatsCache TradeStatsCache
+	tradeStatsGroup singleflight.Group
+
+	// Latest home screen overview, replaced by the scheduled job
+	marketOverview      atomic.Pointer[model.MarketOverview]
+	marketOverviewGroup singleflight.Group
+
+	// Worker pools for upstream fan-outs, backing off when rate limited
+	fetchPool       *workerpool.Pool // Missing coins fetched from BirdEye
+	updatePool      *workerpool.Pool // Market data refreshes
+	enrichPool      *workerpool.Pool // Trending tokens enriched from chain and off-chain data
+	imageUploadPool *workerpool.Pool // Background logo uploads
+
+	// Slots for the full enrichment of coins indexed from search
+	indexEnrichLimiter chan struct{}
+
+	// Coins being enriched
+	enrichRunning atomic.Int64
+
+	// Admin search synonyms by term, read again once stale
+	synonymsMu       sync.RWMutex
+	synonyms         map[string]string
+	synonymsLoadedAt time.Time
 }
 
 // NewService creates a new CoinService instance
@@ -68,42 +121,59 @@ func NewService(
 		cache:              coinCache,
 		naughtyWordSet:     make(map[string]struct{}),
 		imageProxy:         imageProxy,
-		imageUploadLimiter: make(chan struct{}, 3), // Limit to 3 concurrent uploads
+		fetchPool:          workerpool.New(workerpool.Config{Name: "coin_fetch"}),
+		updatePool:         workerpool.New(workerpool.Config{Name: "coin_update"}),
+		enrichPool:         workerpool.New(workerpool.Config{Name: "coin_enrich", MaxWorkers: 3}),
+		imageUploadPool:    workerpool.New(workerpool.Config{Name: "image_upload", MaxWorkers: 3, QueueSize: 256}),
+		indexEnrichLimiter: make(chan struct{}, maxQueuedIndexEnrichments),
 	}
+	service.ipfsFallbackGateways = DefaultIPFSFallbackGateways
 	service.fetcherCtx, service.fetcherCancel = context.WithCancel(context.Background())
+	service.jobs = jobs.NewScheduler(store)
+	service.mergePolicy = DefaultMergePolicy()
+	if store != nil {
+		// Coin writes go through the merge policy and record the changes they make
+		service.store = mergeStore{Store: store, policy: service.mergePolicy}
+	}
+	if config != nil {
+		// Seeded up front so the cached list TTLs see them before the jobs start
+		intervals := map[string]time.Duration{
+			JobTrendingFetch:         config.TrendingFetchInterval,
+			JobNewCoinsFetch:         config.NewCoinsFetchInterval,
+			JobTopGainersFetch:       config.TopGainersFetchInterval,
+			JobMarketOverview:        config.MarketOverviewInterval,
+			JobArchiveCoins:          config.ArchiveInterval,
+			JobRetryPlaceholderLogos: config.PlaceholderRetryInterval,
+		}
+		if intervals[JobMarketOverview] <= 0 {
+			intervals[JobMarketOverview] = DefaultMarketOverviewInterval
+		}
+		for job, interval := range intervals {
+			if interval > 0 {
+				service.jobs.SetInterval(job, interval)
+			}
+		}
+	}
 
 	// Load naughty words during initialization
-	go func() {
-		backgroundCtx := context.Background()
+	service.goBackground(func(backgroundCtx context.Context) {
 		if err := service.loadNaughtyWords(backgroundCtx); err != nil {
 			slog.ErrorContext(backgroundCtx, "Failed to load naughty words during service initialization", slog.Any("error", err))
 		} else {
 			slog.InfoContext(backgroundCtx, "Naughty words loaded successfully during service initialization")
 		}
-	}()
+	})
 
 	// Ensure native SOL coin exists
-	go func() {
-		backgroundCtx := context.Background()
+	service.goBackground(func(backgroundCtx context.Context) {
 		if err := service.ensureNativeSolCoin(backgroundCtx); err != nil {
 			slog.ErrorContext(backgroundCtx, "Failed to ensure native SOL coin exists", slog.Any("error", err))
 		}
-	}()
+	})
 
 	// Initialize xStocks tokens during startup if enabled
 	if service.config != nil && service.config.InitializeXStocksOnStartup {
-		go func() {
-			backgroundCtx := context.Background()
-			if err := service.initializeXStocks(backgroundCtx); err != nil {
-				slog.ErrorContext(backgroundCtx, "Failed to initialize xStocks tokens", slog.Any("error", err))
-			} else {
-				slog.InfoContext(backgroundCtx, "xStocks tokens initialized successfully")
-				// Optionally enrich xStocks data in background
-				if err := service.EnrichXStocksData(backgroundCtx); err != nil {
-					slog.WarnContext(backgroundCtx, "Failed to enrich xStocks data", slog.Any("error", err))
-				}
-			}
-		}()
+		service.goBackground(service.runXStocksInitialization)
 	} else {
 		slog.Info("xStocks initialization on startup is disabled")
 	}
@@ -111,24 +181,38 @@ func NewService(
 	if service.config != nil {
 		if service.config.TrendingFetchInterval > 0 {
 			slog.Info("Starting trending token fetcher with configured interval", slog.Duration("interval", service.config.TrendingFetchInterval))
-			go service.runTrendingTokenFetcher(service.fetcherCtx)
+			service.goBackground(service.runTrendingTokenFetcher)
 		} else {
 			slog.Warn("Trending token fetcher is disabled as TrendingFetchInterval is not configured or is zero.")
 		}
 
 		if service.config.NewCoinsFetchInterval > 0 {
 			slog.Info("Starting new token fetcher with configured interval", slog.Duration("interval", service.config.NewCoinsFetchInterval))
-			go service.runNewTokenFetcher(service.fetcherCtx)
+			service.goBackground(service.runNewTokenFetcher)
 		} else {
 			slog.Warn("New token fetcher is disabled as NewCoinsFetchInterval is not configured or is zero.")
 		}
 
 		if service.config.TopGainersFetchInterval > 0 {
 			slog.Info("Starting top gainers token fetcher with configured interval", slog.Duration("interval", service.config.TopGainersFetchInterval))
-			go service.runTopGainersTokenFetcher(service.fetcherCtx)
+			service.goBackground(service.runTopGainersTokenFetcher)
 		} else {
 			slog.Warn("Top gainers token fetcher is disabled as TopGainersFetchInterval is not configured or is zero.")
 		}
+
+		if store != nil {
+			service.goBackground(service.runMarketOverviewJob)
+		}
+
+		if store != nil && service.config.ArchiveInterval > 0 {
+			slog.Info("Starting inactive coin archive job", slog.Duration("interval", service.config.ArchiveInterval))
+			service.goBackground(service.runArchiveJob)
+		}
+
+		if store != nil && imageProxy != nil && service.config.PlaceholderRetryInterval > 0 {
+			slog.Info("Starting placeholder logo retry job", slog.Duration("interval", service.config.PlaceholderRetryInterval))
+			service.goBackground(service.runPlaceholderRetryJob)
+		}
 	} else {
 		slog.Warn("Coin service config is nil. Fetchers will be disabled.")
 	}
@@ -136,12 +220,53 @@ func NewService(
 	return service
 }
 
-func (s *Service) Shutdown() {
+// SetEventBus sets the bus used to publish coin lifecycle events
+func (s *Service) SetEventBus(bus events.Bus) {
+	s.eventBusMu.Lock()
+	defer s.eventBusMu.Unlock()
+	s.eventBus = bus
+}
+
+// publishEvent publishes to the event bus if one has been configured
+func (s *Service) publishEvent(ctx context.Context, event events.Event) {
+	s.eventBusMu.RLock()
+	bus := s.eventBus
+	s.eventBusMu.RUnlock()
+	if bus != nil {
+		bus.Publish(ctx, event)
+	}
+}
+
+// goBackground runs fn on a tracked goroutine bound to the fetcher context,
+// so Shutdown can cancel it and wait for it to return.
+func (s *Service) goBackground(fn func(ctx context.Context)) {
+	s.backgroundWG.Add(1)
+	go func() {
+		defer s.backgroundWG.Done()
+		fn(s.fetcherCtx)
+	}()
+}
+
+// Shutdown cancels background fetchers and initialization work and waits for
+// them to return, or until ctx expires.
+func (s *Service) Shutdown(ctx context.Context) error {
 	slog.Info("Shutting down coin service...")
 	if s.fetcherCancel != nil {
 		slog.Info("Cancelling fetchers...")
 		s.fetcherCancel()
 	}
-	slog.Info("Coin service shutdown complete.")
+
+	done := make(chan struct{})
+	go func() {
+		s.backgroundWG.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		slog.Info("Coin service shutdown complete.")
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("coin service background work did not stop in time: %w", ctx.Err())
+	}
 }
 
@@ -0,0 +1,118 @@
+package coin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+// synonymRefreshInterval is how long loaded search synonyms are used before
+// they're read again, so edits made through another instance show up
+const synonymRefreshInterval = time.Minute
+
+const (
+	maxSynonymTermLength   = 64
+	maxSynonymTargetLength = 256
+)
+
+// ErrInvalidSynonym is returned when a search synonym fails validation.
+var ErrInvalidSynonym = errors.New("invalid search synonym")
+
+// normalizeSynonymTerm folds a query the way synonym terms are stored
+func normalizeSynonymTerm(term string) string {
+	return strings.ToLower(strings.Join(strings.Fields(term), " "))
+}
+
+// resolveSynonym returns the query a synonym maps query to, or query itself.
+// Failing to load synonyms is logged and searches the query as typed.
+func (s *Service) resolveSynonym(ctx context.Context, query string) string {
+	synonyms, err := s.loadSynonyms(ctx)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to load search synonyms", slog.Any("error", err))
+		return query
+	}
+	if target, ok := synonyms[normalizeSynonymTerm(query)]; ok {
+		return target
+	}
+	return query
+}
+
+// loadSynonyms returns the synonym targets by term, reading them again once stale
+func (s *Service) loadSynonyms(ctx context.Context) (map[string]string, error) {
+	s.synonymsMu.RLock()
+	synonyms, loadedAt := s.synonyms, s.synonymsLoadedAt
+	s.synonymsMu.RUnlock()
+	if synonyms != nil && time.Since(loadedAt) < synonymRefreshInterval {
+		return synonyms, nil
+	}
+
+	rows, _, err := s.store.SearchSynonyms().List(ctx, db.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list search synonyms: %w", err)
+	}
+	synonyms = make(map[string]string, len(rows))
+	for _, row := range rows {
+		synonyms[row.ID] = row.Target
+	}
+	s.synonymsMu.Lock()
+	s.synonyms, s.synonymsLoadedAt = synonyms, time.Now()
+	s.synonymsMu.Unlock()
+	return synonyms, nil
+}
+
+// invalidateSynonyms makes the next search read the synonyms again
+func (s *Service) invalidateSynonyms() {
+	s.synonymsMu.Lock()
+	s.synonyms = nil
+	s.synonymsMu.Unlock()
+}
+
+// ListSearchSynonyms returns every search synonym, sorted by term.
+func (s *Service) ListSearchSynonyms(ctx context.Context) ([]model.SearchSynonym, error) {
+	rows, _, err := s.store.SearchSynonyms().List(ctx, db.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list search synonyms: %w", err)
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].ID < rows[j].ID })
+	return rows, nil
+}
+
+// SetSearchSynonym makes searches for term run as target instead. The target
+// may be anything a search accepts, such as "$WIF" or a mint address.
+func (s *Service) SetSearchSynonym(ctx context.Context, term, target, updatedBy string) (*model.SearchSynonym, error) {
+	term = normalizeSynonymTerm(term)
+	if term == "" || len(term) > maxSynonymTermLength {
+		return nil, fmt.Errorf("%w: term must be 1-%d bytes", ErrInvalidSynonym, maxSynonymTermLength)
+	}
+	target = strings.TrimSpace(target)
+	if target == "" || len(target) > maxSynonymTargetLength {
+		return nil, fmt.Errorf("%w: target must be 1-%d bytes", ErrInvalidSynonym, maxSynonymTargetLength)
+	}
+	if normalizeSynonymTerm(target) == term {
+		return nil, fmt.Errorf("%w: %q maps to itself", ErrInvalidSynonym, term)
+	}
+
+	synonym := model.SearchSynonym{ID: term, Target: target, UpdatedBy: updatedBy}
+	if _, err := s.store.SearchSynonyms().Upsert(ctx, &synonym); err != nil {
+		return nil, fmt.Errorf("failed to save search synonym %q: %w", term, err)
+	}
+	s.invalidateSynonyms()
+	return s.store.SearchSynonyms().Get(ctx, term)
+}
+
+// DeleteSearchSynonym removes a search synonym.
+func (s *Service) DeleteSearchSynonym(ctx context.Context, term string) error {
+	term = normalizeSynonymTerm(term)
+	if err := s.store.SearchSynonyms().HardDelete(ctx, term); err != nil {
+		return fmt.Errorf("failed to delete search synonym %q: %w", term, err)
+	}
+	s.invalidateSynonyms()
+	return nil
+}
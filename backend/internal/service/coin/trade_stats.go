@@ -0,0 +1,87 @@
+package coin
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/birdeye"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+// SetTradeStatsCache enables caching of coin trade stats; without it every request goes to BirdEye
+func (s *Service) SetTradeStatsCache(tradeStatsCache TradeStatsCache) {
+	s.tradeStatsCache = tradeStatsCache
+}
+
+// GetCoinTradeStats returns buy/sell counts, unique wallets and volume for a coin
+// over 5m, 1h and 24h windows. Concurrent requests for the same coin share one
+// upstream call.
+func (s *Service) GetCoinTradeStats(ctx context.Context, address string) (*model.CoinTradeStats, error) {
+	cacheKey := "trade_stats:" + address
+	if s.tradeStatsCache != nil {
+		if cached, found := s.tradeStatsCache.Get(cacheKey); found && cached != nil {
+			return cached, nil
+		}
+	}
+
+	result, err, _ := s.tradeStatsGroup.Do(cacheKey, func() (any, error) {
+		raw, err := s.birdeyeClient.GetTokenTradeStats(ctx, address)
+		if err != nil {
+			return nil, err
+		}
+		stats := tradeStatsFromBirdeye(raw)
+		if s.tradeStatsCache != nil {
+			s.tradeStatsCache.Set(cacheKey, stats, TradeStatsCacheExpiry)
+		}
+		return stats, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get trade stats for %s: %w", address, err)
+	}
+
+	stats, ok := result.(*model.CoinTradeStats)
+	if !ok || stats == nil {
+		return nil, fmt.Errorf("trade stats for %s could not be loaded", address)
+	}
+	return stats, nil
+}
+
+func tradeStatsFromBirdeye(raw *birdeye.TokenTradeStats) *model.CoinTradeStats {
+	return &model.CoinTradeStats{
+		Address: raw.Address,
+		Windows: []model.TradeWindow{
+			{
+				Window:        "5m",
+				Trades:        raw.Trade5m,
+				Buys:          raw.Buy5m,
+				Sells:         raw.Sell5m,
+				UniqueWallets: raw.UniqueWallet5m,
+				VolumeUSD:     raw.Volume5mUSD,
+				BuyVolumeUSD:  raw.VolumeBuy5mUSD,
+				SellVolumeUSD: raw.VolumeSell5mUSD,
+			},
+			{
+				Window:        "1h",
+				Trades:        raw.Trade1h,
+				Buys:          raw.Buy1h,
+				Sells:         raw.Sell1h,
+				UniqueWallets: raw.UniqueWallet1h,
+				VolumeUSD:     raw.Volume1hUSD,
+				BuyVolumeUSD:  raw.VolumeBuy1hUSD,
+				SellVolumeUSD: raw.VolumeSell1hUSD,
+			},
+			{
+				Window:        "24h",
+				Trades:        raw.Trade24h,
+				Buys:          raw.Buy24h,
+				Sells:         raw.Sell24h,
+				UniqueWallets: raw.UniqueWallet24h,
+				VolumeUSD:     raw.Volume24hUSD,
+				BuyVolumeUSD:  raw.VolumeBuy24hUSD,
+				SellVolumeUSD: raw.VolumeSell24hUSD,
+			},
+		},
+		UpdatedAt: time.Now(),
+	}
+}
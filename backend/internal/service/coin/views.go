@@ -0,0 +1,178 @@
+package coin
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/featureflags"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	"github.com/nicolas-martin/dankfolio/backend/internal/util"
+)
+
+const (
+	// MaxRecentViews is how many viewed coins are kept per viewer; older ones are pruned
+	MaxRecentViews = 50
+	// recentViewRetention is how long a view is kept at all
+	recentViewRetention = 90 * 24 * time.Hour
+	// viewAffinityHalfLife is how quickly a view stops boosting a coin in search
+	viewAffinityHalfLife = 72 * time.Hour
+	// viewAffinityWeight is the most a coin's view history can add to its search
+	// score, where results are otherwise scored 1 at the top down to 0
+	viewAffinityWeight = 0.5
+)
+
+// viewerFromContext returns the key a caller's views are stored under: a hash of
+// their wallet, so coin_views never holds addresses. Callers without a wallet
+// identity aren't tracked; their App Check subject names the app, not a user.
+func viewerFromContext(ctx context.Context) string {
+	identity := featureflags.IdentityFromContext(ctx)
+	if !util.IsValidSolanaAddress(identity) {
+		return ""
+	}
+	return ViewerID(identity)
+}
+
+// ViewerID returns the key the views of a wallet are stored under
+func ViewerID(wallet string) string {
+	sum := sha256.Sum256([]byte(wallet))
+	return hex.EncodeToString(sum[:16])
+}
+
+// viewHistory returns a viewer's views, most recent first
+func (s *Service) viewHistory(ctx context.Context, viewer string) ([]model.CoinView, error) {
+	sortBy, desc := "last_viewed_at", true
+	views, _, err := s.store.CoinViews().ListWithOpts(ctx, db.ListOptions{
+		SortBy:   &sortBy,
+		SortDesc: &desc,
+		Filters:  []db.FilterOption{{Field: "viewer", Operator: db.FilterOpEqual, Value: viewer}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list coin views: %w", err)
+	}
+	return views, nil
+}
+
+// RecordView counts a view of a coin's detail page by the caller, then prunes
+// their history to the newest MaxRecentViews within the retention window.
+func (s *Service) RecordView(ctx context.Context, address string) error {
+	viewer := viewerFromContext(ctx)
+	if viewer == "" {
+		return nil
+	}
+	views, err := s.viewHistory(ctx, viewer)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	view := model.CoinView{ID: viewer + ":" + address, Viewer: viewer, CoinAddress: address, Views: 1, LastViewedAt: now}
+	kept := 1
+	for _, v := range views {
+		if v.CoinAddress == address {
+			view.Views += v.Views
+			continue
+		}
+		if kept < MaxRecentViews && now.Sub(v.LastViewedAt) < recentViewRetention {
+			kept++
+			continue
+		}
+		if err := s.store.CoinViews().HardDelete(ctx, v.ID); err != nil {
+			slog.WarnContext(ctx, "Failed to prune coin view", slog.Any("error", err))
+		}
+	}
+	if _, err := s.store.CoinViews().Upsert(ctx, &view); err != nil {
+		return fmt.Errorf("failed to record coin view: %w", err)
+	}
+	return nil
+}
+
+// RecentlyViewed returns the coins the caller viewed most recently, newest first
+func (s *Service) RecentlyViewed(ctx context.Context, limit int) ([]model.Coin, error) {
+	viewer := viewerFromContext(ctx)
+	if viewer == "" || limit <= 0 {
+		return nil, nil
+	}
+	views, err := s.viewHistory(ctx, viewer)
+	if err != nil {
+		return nil, err
+	}
+	addresses := make([]string, 0, min(limit, len(views)))
+	for _, v := range views {
+		if len(addresses) == limit || time.Since(v.LastViewedAt) >= recentViewRetention {
+			break
+		}
+		addresses = append(addresses, v.CoinAddress)
+	}
+	if len(addresses) == 0 {
+		return nil, nil
+	}
+
+	found, err := s.store.Coins().GetByAddresses(ctx, addresses)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get viewed coins: %w", err)
+	}
+	byAddress := make(map[string]model.Coin, len(found))
+	for _, c := range found {
+		byAddress[c.Address] = c
+	}
+	// Coins archived or removed since are left out
+	coins := make([]model.Coin, 0, len(addresses))
+	for _, address := range addresses {
+		if c, ok := byAddress[address]; ok {
+			coins = append(coins, c)
+		}
+	}
+	return s.filterBlocked(coins), nil
+}
+
+// personalizeRanking moves coins the caller viewed recently or often up a page
+// of search results. Failing to load their views leaves the order alone.
+func (s *Service) personalizeRanking(ctx context.Context, coins []model.Coin) {
+	if len(coins) < 2 {
+		return
+	}
+	viewer := viewerFromContext(ctx)
+	if viewer == "" {
+		return
+	}
+	views, err := s.viewHistory(ctx, viewer)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to load coin views for search ranking", slog.Any("error", err))
+		return
+	}
+	if len(views) == 0 {
+		return
+	}
+
+	now := time.Now()
+	affinity := make(map[string]float64, len(views))
+	for _, v := range views {
+		affinity[v.CoinAddress] = viewAffinity(v, now)
+	}
+	scores := make(map[string]float64, len(coins))
+	for i, c := range coins {
+		scores[c.Address] = 1 - float64(i)/float64(len(coins)) + viewAffinityWeight*affinity[c.Address]
+	}
+	sort.SliceStable(coins, func(i, j int) bool {
+		return scores[coins[i].Address] > scores[coins[j].Address]
+	})
+}
+
+// viewAffinity scores a viewed coin from 0 to 1: it halves every
+// viewAffinityHalfLife and grows with repeat views, from 0.5 for a single one
+func viewAffinity(v model.CoinView, now time.Time) float64 {
+	age := now.Sub(v.LastViewedAt)
+	if age >= recentViewRetention {
+		return 0
+	}
+	recency := math.Pow(0.5, age.Hours()/viewAffinityHalfLife.Hours())
+	frequency := 1 - 1/float64(1+v.Views)
+	return recency * frequency
+}
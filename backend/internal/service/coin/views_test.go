@@ -0,0 +1,77 @@
+package coin
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	dbmocks "github.com/nicolas-martin/dankfolio/backend/internal/db/mocks"
+	"github.com/nicolas-martin/dankfolio/backend/internal/featureflags"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+const viewerWallet = "9WzDXwBbmkg8ZTbNMqUxvQRAyrZzDsGYdLVL9zYtAWWM"
+
+func TestViewerFromContext_OnlyTracksWallets(t *testing.T) {
+	assert.Empty(t, viewerFromContext(context.Background()))
+	assert.Empty(t, viewerFromContext(featureflags.WithIdentity(context.Background(), "1:1234:ios:abcd")))
+
+	viewer := viewerFromContext(featureflags.WithIdentity(context.Background(), viewerWallet))
+	assert.Len(t, viewer, 32)
+	assert.NotContains(t, viewer, viewerWallet)
+}
+
+func TestPersonalizeRanking_MovesViewedCoinsUp(t *testing.T) {
+	ctx := featureflags.WithIdentity(context.Background(), viewerWallet)
+	store := dbmocks.NewMockStore(t)
+	views := dbmocks.NewMockRepository[model.CoinView](t)
+	store.EXPECT().CoinViews().Return(views)
+	views.EXPECT().ListWithOpts(mock.Anything, mock.Anything).Return([]model.CoinView{
+		{CoinAddress: "c", Views: 3, LastViewedAt: time.Now()},
+		{CoinAddress: "d", Views: 10, LastViewedAt: time.Now().Add(-60 * 24 * time.Hour)},
+	}, int32(2), nil)
+	s := &Service{store: store}
+
+	coins := []model.Coin{{Address: "a"}, {Address: "b"}, {Address: "c"}, {Address: "d"}}
+	s.personalizeRanking(ctx, coins)
+
+	order := make([]string, len(coins))
+	for i, c := range coins {
+		order[i] = c.Address
+	}
+	assert.Equal(t, []string{"a", "c", "b", "d"}, order)
+}
+
+func TestRecordView_IncrementsAndPrunes(t *testing.T) {
+	ctx := featureflags.WithIdentity(context.Background(), viewerWallet)
+	store := dbmocks.NewMockStore(t)
+	views := dbmocks.NewMockRepository[model.CoinView](t)
+	store.EXPECT().CoinViews().Return(views)
+
+	history := make([]model.CoinView, 0, MaxRecentViews+1)
+	for i := range MaxRecentViews + 1 {
+		history = append(history, model.CoinView{
+			ID:           fmt.Sprintf("v:%d", i),
+			CoinAddress:  fmt.Sprintf("mint%d", i),
+			Views:        2,
+			LastViewedAt: time.Now().Add(-time.Duration(i) * time.Minute),
+		})
+	}
+	views.EXPECT().ListWithOpts(mock.Anything, mock.Anything).Return(history, int32(len(history)), nil)
+	// mint3 is viewed again, so 49 of the other 50 fit alongside it
+	views.EXPECT().HardDelete(mock.Anything, "v:50").Return(nil)
+	var recorded *model.CoinView
+	views.EXPECT().Upsert(mock.Anything, mock.Anything).
+		Run(func(_ context.Context, v *model.CoinView) { recorded = v }).Return(int64(1), nil)
+	s := &Service{store: store}
+
+	require.NoError(t, s.RecordView(ctx, "mint3"))
+	require.NotNil(t, recorded)
+	assert.Equal(t, 3, recorded.Views)
+	assert.Equal(t, "mint3", recorded.CoinAddress)
+}
@@ -0,0 +1,137 @@
+// Package fx converts the USD values the backend works in to the fiat
+// currency a user displays prices in.
+package fx
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/apperrors"
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/fxrates"
+)
+
+// BaseCurrency is the currency every price and value is computed in
+const BaseCurrency = "USD"
+
+// DefaultRatesTTL is how long fetched rates are used; both providers publish
+// reference rates daily
+const DefaultRatesTTL = 24 * time.Hour
+
+// refreshRetryInterval is how long stale rates are served after a failed
+// refresh before the provider is asked again
+const refreshRetryInterval = 5 * time.Minute
+
+// Converter converts USD amounts to Currency
+type Converter struct {
+	Currency string
+	Rate     float64 // Units of Currency per USD
+}
+
+// Convert returns usd in the converter's currency
+func (c Converter) Convert(usd float64) float64 {
+	return usd * c.Rate
+}
+
+// Service caches the provider's rates rebased on USD
+type Service struct {
+	client fxrates.ClientAPI
+	ttl    time.Duration
+
+	mu          sync.Mutex
+	rates       *fxrates.Rates
+	fetchedAt   time.Time
+	lastAttempt time.Time
+	now         func() time.Time
+}
+
+// NewService creates a Service that refetches rates once they are older than ttl
+func NewService(client fxrates.ClientAPI, ttl time.Duration) *Service {
+	if ttl <= 0 {
+		ttl = DefaultRatesTTL
+	}
+	return &Service{
+		client: client,
+		ttl:    ttl,
+		now:    time.Now,
+	}
+}
+
+// NormalizeCurrency upper-cases an ISO 4217 code; empty means USD
+func NormalizeCurrency(code string) string {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if code == "" {
+		return BaseCurrency
+	}
+	return code
+}
+
+// Converter returns a Converter from USD to currency. A nil Service only
+// converts to USD. Unknown currencies fail with apperrors.ErrUnsupportedCurrency.
+func (s *Service) Converter(ctx context.Context, currency string) (Converter, error) {
+	currency = NormalizeCurrency(currency)
+	if currency == BaseCurrency {
+		return Converter{Currency: BaseCurrency, Rate: 1}, nil
+	}
+	if s == nil {
+		return Converter{}, apperrors.ErrUnsupportedCurrency.With("currency", currency)
+	}
+
+	rates, err := s.latest(ctx)
+	if err != nil {
+		return Converter{}, err
+	}
+	rate, ok := rates.Rates[currency]
+	if !ok {
+		return Converter{}, apperrors.ErrUnsupportedCurrency.With("currency", currency)
+	}
+	return Converter{Currency: currency, Rate: rate}, nil
+}
+
+// Currencies returns the supported currency codes, sorted
+func (s *Service) Currencies(ctx context.Context) ([]string, error) {
+	rates, err := s.latest(ctx)
+	if err != nil {
+		return nil, err
+	}
+	codes := []string{BaseCurrency}
+	for code := range rates.Rates {
+		codes = append(codes, code)
+	}
+	slices.Sort(codes)
+	return codes, nil
+}
+
+// latest returns the cached rates, refetching them once they expire. When the
+// refetch fails the expired rates are kept rather than failing every request.
+func (s *Service) latest(ctx context.Context) (*fxrates.Rates, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if s.rates != nil && (now.Sub(s.fetchedAt) < s.ttl || now.Sub(s.lastAttempt) < refreshRetryInterval) {
+		return s.rates, nil
+	}
+
+	s.lastAttempt = now
+	rates, err := s.client.GetLatestRates(ctx)
+	if err == nil {
+		rates, err = rates.Rebase(BaseCurrency)
+	}
+	if err != nil {
+		if s.rates != nil {
+			slog.WarnContext(ctx, "Failed to refresh exchange rates, using cached rates", "error", err, "rates_date", s.rates.Date)
+			return s.rates, nil
+		}
+		return nil, fmt.Errorf("failed to fetch exchange rates: %w", err)
+	}
+
+	slog.InfoContext(ctx, "Exchange rates refreshed", "currencies", len(rates.Rates), "rates_date", rates.Date)
+	s.rates = rates
+	s.fetchedAt = now
+	return rates, nil
+}
@@ -0,0 +1,102 @@
+package fx
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/apperrors"
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/fxrates"
+	fxratesmocks "github.com/nicolas-martin/dankfolio/backend/internal/clients/fxrates/mocks"
+)
+
+func eurRates() *fxrates.Rates {
+	return &fxrates.Rates{
+		Base:  "EUR",
+		Date:  time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC),
+		Rates: map[string]float64{"USD": 1.25, "JPY": 150, "GBP": 0.85},
+	}
+}
+
+func TestConverter(t *testing.T) {
+	ctx := context.Background()
+	client := fxratesmocks.NewMockClientAPI(t)
+	client.EXPECT().GetLatestRates(ctx).Return(eurRates(), nil).Once()
+	service := NewService(client, time.Hour)
+
+	usd, err := service.Converter(ctx, "")
+	require.NoError(t, err)
+	assert.Equal(t, Converter{Currency: "USD", Rate: 1}, usd)
+
+	eur, err := service.Converter(ctx, "eur")
+	require.NoError(t, err)
+	assert.Equal(t, "EUR", eur.Currency)
+	assert.InDelta(t, 80, eur.Convert(100), 1e-9)
+
+	jpy, err := service.Converter(ctx, "JPY")
+	require.NoError(t, err)
+	assert.InDelta(t, 1200, jpy.Convert(10), 1e-9)
+
+	_, err = service.Converter(ctx, "XYZ")
+	assert.True(t, errors.Is(err, apperrors.ErrUnsupportedCurrency))
+
+	codes, err := service.Currencies(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"EUR", "GBP", "JPY", "USD"}, codes)
+}
+
+func TestConverter_NilService(t *testing.T) {
+	var service *Service
+
+	usd, err := service.Converter(context.Background(), "usd")
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, usd.Rate)
+
+	_, err = service.Converter(context.Background(), "EUR")
+	assert.True(t, errors.Is(err, apperrors.ErrUnsupportedCurrency))
+}
+
+func TestConverter_RefreshesExpiredRates(t *testing.T) {
+	ctx := context.Background()
+	client := fxratesmocks.NewMockClientAPI(t)
+	service := NewService(client, DefaultRatesTTL)
+	now := time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC)
+	service.now = func() time.Time { return now }
+
+	client.EXPECT().GetLatestRates(ctx).Return(eurRates(), nil).Once()
+	eur, err := service.Converter(ctx, "EUR")
+	require.NoError(t, err)
+	assert.InDelta(t, 0.8, eur.Rate, 1e-9)
+
+	// Expired rates are kept while the provider is failing, and the provider
+	// is not retried on every request
+	now = now.Add(DefaultRatesTTL)
+	client.EXPECT().GetLatestRates(ctx).Return(nil, errors.New("provider down")).Once()
+	eur, err = service.Converter(ctx, "EUR")
+	require.NoError(t, err)
+	assert.InDelta(t, 0.8, eur.Rate, 1e-9)
+	_, err = service.Converter(ctx, "EUR")
+	require.NoError(t, err)
+
+	now = now.Add(refreshRetryInterval)
+	updated := eurRates()
+	updated.Rates["USD"] = 1.0
+	client.EXPECT().GetLatestRates(ctx).Return(updated, nil).Once()
+	eur, err = service.Converter(ctx, "EUR")
+	require.NoError(t, err)
+	assert.InDelta(t, 1.0, eur.Rate, 1e-9)
+}
+
+func TestConverter_NoRates(t *testing.T) {
+	ctx := context.Background()
+	client := fxratesmocks.NewMockClientAPI(t)
+	client.EXPECT().GetLatestRates(ctx).Return(nil, errors.New("provider down")).Once()
+
+	_, err := NewService(client, DefaultRatesTTL).Converter(ctx, "EUR")
+	require.Error(t, err)
+	assert.False(t, errors.Is(err, apperrors.ErrUnsupportedCurrency))
+}
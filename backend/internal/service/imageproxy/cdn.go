@@ -0,0 +1,77 @@
+package imageproxy
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/cdn"
+)
+
+// SetCDN serves stored icons through c: URLs handed out point at the CDN,
+// served URLs are signed when c signs them and replaced icons are invalidated
+func (s *Service) SetCDN(c *cdn.Client) {
+	s.cdn = c
+}
+
+// objectURL returns the public URL of the object at key
+func (s *Service) objectURL(key string) string {
+	if s.cdn != nil {
+		return s.cdn.URL(key)
+	}
+	return s.s3Client.GetImageURL(key)
+}
+
+// objectKey returns the object key of a stored icon's S3 or CDN URL
+func (s *Service) objectKey(url string) (string, bool) {
+	if key, ok := strings.CutPrefix(url, s.s3Client.GetImageURL("")); ok && key != "" {
+		return key, true
+	}
+	if s.cdn != nil && s.cdn.Owns(url) {
+		return strings.TrimPrefix(url, s.cdn.URL("")), true
+	}
+	return "", false
+}
+
+// IsProxiedURL reports whether url is a stored icon's, on S3 or the CDN
+func (s *Service) IsProxiedURL(url string) bool {
+	if IsS3URL(url) {
+		return true
+	}
+	_, ok := s.objectKey(url)
+	return ok
+}
+
+// ServedURL returns the URL clients should fetch a stored icon from: icons
+// stored before the CDN was set up are moved onto it, and CDN URLs are signed
+// when signing is on. Other URLs are returned as is.
+func (s *Service) ServedURL(url string) string {
+	if s.cdn == nil {
+		return url
+	}
+	if key, ok := s.objectKey(url); ok {
+		return s.cdn.Sign(s.cdn.URL(key))
+	}
+	return url
+}
+
+// ReplaceImage downloads imageURL and uploads it over the mint's stored icon,
+// whether or not one exists, then invalidates the CDN's cached copy. A failed
+// invalidation is logged; the CDN serves the new icon once the old one expires.
+func (s *Service) ReplaceImage(ctx context.Context, imageURL string, mintAddress string) (string, error) {
+	if imageURL == "" {
+		return "", fmt.Errorf("empty image URL")
+	}
+	key := s.generateS3Key(mintAddress)
+	url, uploaded, err := s.fetchAndUpload(ctx, imageURL, mintAddress, key)
+	if err != nil {
+		return "", err
+	}
+	if uploaded {
+		if err := s.cdn.Invalidate(ctx, key); err != nil {
+			slog.WarnContext(ctx, "Failed to invalidate replaced icon", slog.String("mint", mintAddress), slog.Any("error", err))
+		}
+	}
+	return url, nil
+}
@@ -0,0 +1,42 @@
+package imageproxy
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/cdn"
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/s3"
+)
+
+func TestServedURL(t *testing.T) {
+	s3Client, err := s3.NewClient(s3.Config{
+		Endpoint:        "https://us-ord-1.linodeobjects.com",
+		AccessKeyID:     "key",
+		SecretAccessKey: "secret",
+		BucketName:      "coin-icon",
+		Region:          "us-ord-1",
+		PublicURLPrefix: "https://coin-icon.us-ord-1.linodeobjects.com",
+	})
+	require.NoError(t, err)
+	s := NewService(s3Client)
+
+	const stored = "https://coin-icon.us-ord-1.linodeobjects.com/tokens/mint.png"
+	assert.Equal(t, stored, s.ServedURL(stored), "served from S3 without a CDN")
+	assert.Equal(t, stored, s.GetS3URL("mint"))
+
+	cdnClient, err := cdn.NewClient(http.DefaultClient, cdn.Config{Provider: cdn.ProviderCloudflare, URLPrefix: "https://icons.example.com"})
+	require.NoError(t, err)
+	s.SetCDN(cdnClient)
+
+	assert.Equal(t, "https://icons.example.com/tokens/mint.png", s.GetS3URL("mint"))
+	assert.Equal(t, "https://icons.example.com/tokens/mint.png", s.ServedURL(stored), "stored S3 URLs move onto the CDN")
+	assert.Equal(t, "https://icons.example.com/tokens/mint.png", s.ServedURL("https://icons.example.com/tokens/mint.png"))
+	assert.Equal(t, "https://ipfs.io/ipfs/cid", s.ServedURL("https://ipfs.io/ipfs/cid"))
+
+	assert.True(t, s.IsProxiedURL(stored))
+	assert.True(t, s.IsProxiedURL("https://icons.example.com/tokens/mint.png"))
+	assert.False(t, s.IsProxiedURL("https://ipfs.io/ipfs/cid"))
+}
@@ -0,0 +1,116 @@
+package imageproxy
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+// IconMatch is a mint whose icon looks like another's
+type IconMatch struct {
+	Mint     string
+	Distance int
+}
+
+// SetHashStore enables icon hashing: processed icons are hashed and recorded,
+// duplicates of a stored icon reuse its S3 object, and SimilarIcons can be queried
+func (s *Service) SetHashStore(hashes db.Repository[model.ImageHash]) {
+	s.hashMu.Lock()
+	defer s.hashMu.Unlock()
+	s.hashStore = hashes
+	s.hashes = make(map[string]model.ImageHash)
+}
+
+// LoadHashes replaces the in-memory hash index with the stored hashes
+func (s *Service) LoadHashes(ctx context.Context) error {
+	if s.hashStore == nil {
+		return nil
+	}
+	rows, _, err := s.hashStore.List(ctx, db.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to load image hashes: %w", err)
+	}
+	hashes := make(map[string]model.ImageHash, len(rows))
+	for _, h := range rows {
+		hashes[h.ID] = h
+	}
+	s.hashMu.Lock()
+	s.hashes = hashes
+	s.hashMu.Unlock()
+	slog.InfoContext(ctx, "Loaded image hashes", slog.Int("count", len(hashes)))
+	return nil
+}
+
+// SimilarIcons returns the other mints whose icon is within maxDistance bits of
+// the mint's, closest first. Mints without a hashed icon, or whose icon is too
+// plain to compare, match nothing.
+func (s *Service) SimilarIcons(mint string, maxDistance int) []IconMatch {
+	s.hashMu.RLock()
+	defer s.hashMu.RUnlock()
+	target, ok := s.hashes[mint]
+	if !ok || !distinctive(target.Hash) {
+		return nil
+	}
+	var matches []IconMatch
+	for other, h := range s.hashes {
+		if other == mint || !distinctive(h.Hash) {
+			continue
+		}
+		if d := HammingDistance(target.Hash, h.Hash); d <= maxDistance {
+			matches = append(matches, IconMatch{Mint: other, Distance: d})
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Distance != matches[j].Distance {
+			return matches[i].Distance < matches[j].Distance
+		}
+		return matches[i].Mint < matches[j].Mint
+	})
+	return matches
+}
+
+// hashed returns the recorded hash of a mint's icon
+func (s *Service) hashed(mint string) (model.ImageHash, bool) {
+	s.hashMu.RLock()
+	defer s.hashMu.RUnlock()
+	h, ok := s.hashes[mint]
+	return h, ok
+}
+
+// duplicateOf returns the object key of a stored icon that hash duplicates
+func (s *Service) duplicateOf(hash uint64) (string, bool) {
+	if !distinctive(hash) {
+		return "", false
+	}
+	s.hashMu.RLock()
+	defer s.hashMu.RUnlock()
+	for _, h := range s.hashes {
+		if HammingDistance(hash, h.Hash) <= DuplicateDistance {
+			return h.ObjectKey, true
+		}
+	}
+	return "", false
+}
+
+// recordHash stores the hash, type and size of a mint's icon and the object
+// serving it. A failed write is logged; the index still serves this process.
+func (s *Service) recordHash(ctx context.Context, mint string, hash uint64, objectKey string, info ImageInfo) {
+	entry := model.ImageHash{
+		ID:          mint,
+		Hash:        hash,
+		ObjectKey:   objectKey,
+		ContentType: info.ContentType,
+		Width:       info.Width,
+		Height:      info.Height,
+	}
+	if _, err := s.hashStore.Upsert(ctx, &entry); err != nil {
+		slog.WarnContext(ctx, "Failed to store image hash", slog.String("mint", mint), slog.Any("error", err))
+	}
+	s.hashMu.Lock()
+	s.hashes[mint] = entry
+	s.hashMu.Unlock()
+}
@@ -0,0 +1,89 @@
+package imageproxy
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	_ "image/gif" // Registers the decoders icons come in
+	_ "image/jpeg"
+	_ "image/png"
+	"math/bits"
+)
+
+// Distances between icon hashes, out of 64 bits
+const (
+	// DuplicateDistance is the most two icons may differ by to share one stored copy
+	DuplicateDistance = 0
+	// ImpersonationDistance is the most an icon may differ by to pass for another;
+	// re-encoded, resized or lightly edited copies land well within it
+	ImpersonationDistance = 6
+)
+
+// minHashBits bounds the set bits of a hash worth comparing; blank and flat
+// icons hash to nearly all zeros or ones and would match each other
+const minHashBits = 8
+
+// DifferenceHash returns the 64-bit difference hash (dHash) of an image: it is
+// shrunk to 9x8 grayscale and each bit records whether a pixel is brighter than
+// its right neighbour. Visually similar images have hashes a few bits apart.
+func DifferenceHash(data []byte) (uint64, error) {
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return 0, fmt.Errorf("failed to decode image: %w", err)
+	}
+	const width, height = 9, 8
+	var gray [height][width]float64
+	b := img.Bounds()
+	if b.Dx() == 0 || b.Dy() == 0 {
+		return 0, fmt.Errorf("empty image")
+	}
+	for y := range height {
+		y0, y1 := cell(b.Min.Y, b.Dy(), y, height)
+		for x := range width {
+			x0, x1 := cell(b.Min.X, b.Dx(), x, width)
+			gray[y][x] = averageLuminance(img, image.Rect(x0, y0, x1, y1))
+		}
+	}
+	var hash uint64
+	for y := range height {
+		for x := range width - 1 {
+			hash <<= 1
+			if gray[y][x] > gray[y][x+1] {
+				hash |= 1
+			}
+		}
+	}
+	return hash, nil
+}
+
+// cell returns the span of the i-th of n cells over size pixels starting at
+// origin, at least one pixel wide so images smaller than the grid still hash
+func cell(origin, size, i, n int) (int, int) {
+	lo, hi := origin+i*size/n, origin+(i+1)*size/n
+	return lo, max(hi, lo+1)
+}
+
+// averageLuminance averages the luminance of the pixels in r, with transparent
+// pixels blended onto white so logos on transparent backgrounds hash alike
+func averageLuminance(img image.Image, r image.Rectangle) float64 {
+	var sum float64
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		for x := r.Min.X; x < r.Max.X; x++ {
+			cr, cg, cb, ca := img.At(x, y).RGBA()
+			white := float64(0xffff - ca)
+			sum += 0.299*(float64(cr)+white) + 0.587*(float64(cg)+white) + 0.114*(float64(cb)+white)
+		}
+	}
+	return sum / float64(r.Dx()*r.Dy())
+}
+
+// HammingDistance returns the number of bits two hashes differ by
+func HammingDistance(a, b uint64) int {
+	return bits.OnesCount64(a ^ b)
+}
+
+// distinctive reports whether a hash carries enough detail to be compared
+func distinctive(hash uint64) bool {
+	n := bits.OnesCount64(hash)
+	return n >= minHashBits && n <= 64-minHashBits
+}
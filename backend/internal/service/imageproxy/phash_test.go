@@ -0,0 +1,72 @@
+package imageproxy
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+// encodeIcon renders a size x size PNG with a diagonal pattern, mirrored when flip is set
+func encodeIcon(t *testing.T, size int, flip bool) []byte {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, size, size))
+	for y := range size {
+		for x := range size {
+			px := x
+			if flip {
+				px = size - 1 - x
+			}
+			v := uint8((px*7 + y*3) * 255 / (size * 10))
+			if (px/(size/4)+y/(size/4))%2 == 0 {
+				v = 255 - v
+			}
+			img.Set(x, y, color.RGBA{R: v, G: v / 2, B: 255 - v, A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+func TestDifferenceHash(t *testing.T) {
+	original, err := DifferenceHash(encodeIcon(t, 256, false))
+	require.NoError(t, err)
+	resized, err := DifferenceHash(encodeIcon(t, 64, false))
+	require.NoError(t, err)
+	mirrored, err := DifferenceHash(encodeIcon(t, 256, true))
+	require.NoError(t, err)
+
+	assert.True(t, distinctive(original))
+	assert.LessOrEqual(t, HammingDistance(original, resized), ImpersonationDistance)
+	assert.Greater(t, HammingDistance(original, mirrored), ImpersonationDistance)
+
+	_, err = DifferenceHash([]byte("<svg></svg>"))
+	assert.Error(t, err)
+}
+
+func TestSimilarIcons(t *testing.T) {
+	const hash = 0x0f0f_f0f0_3c3c_c3c3
+	s := &Service{hashes: map[string]model.ImageHash{
+		"bonk":    {ID: "bonk", Hash: hash, ObjectKey: "tokens/bonk.png"},
+		"copycat": {ID: "copycat", Hash: hash ^ 0b101, ObjectKey: "tokens/copycat.png"},
+		"other":   {ID: "other", Hash: ^uint64(hash), ObjectKey: "tokens/other.png"},
+		"blank":   {ID: "blank", Hash: 0, ObjectKey: "tokens/blank.png"},
+	}}
+
+	assert.Equal(t, []IconMatch{{Mint: "copycat", Distance: 2}}, s.SimilarIcons("bonk", ImpersonationDistance))
+	assert.Nil(t, s.SimilarIcons("blank", 64), "plain icons match nothing")
+	assert.Nil(t, s.SimilarIcons("missing", ImpersonationDistance))
+
+	key, ok := s.duplicateOf(hash)
+	assert.True(t, ok)
+	assert.Equal(t, "tokens/bonk.png", key)
+	_, ok = s.duplicateOf(0)
+	assert.False(t, ok, "plain icons are never shared")
+}
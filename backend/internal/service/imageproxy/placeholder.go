@@ -0,0 +1,174 @@
+package imageproxy
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"log/slog"
+	"strings"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+const (
+	// PlaceholderSize is the width and height of placeholder icons in pixels
+	PlaceholderSize = 128
+
+	placeholderPrefix = "placeholders/"
+	placeholderGrid   = 5  // Cells per side of the identicon pattern
+	placeholderMargin = 14 // Pixels around the pattern
+)
+
+var placeholderBackground = color.RGBA{R: 240, G: 240, B: 240, A: 255}
+
+// Placeholder renders a mint's identicon as a PNG: a left-right mirrored
+// pattern of cells in one colour, both taken from the SHA-256 of the mint, so
+// a mint always gets the same icon and different mints rarely look alike.
+func Placeholder(mint string) []byte {
+	sum := sha256.Sum256([]byte(mint))
+	// Mid-range channels keep the colour visible on the light background
+	fill := color.RGBA{R: 48 + sum[0]%160, G: 48 + sum[1]%160, B: 48 + sum[2]%160, A: 255}
+
+	cell := (PlaceholderSize - 2*placeholderMargin) / placeholderGrid
+	img := image.NewRGBA(image.Rect(0, 0, PlaceholderSize, PlaceholderSize))
+	draw.Draw(img, img.Bounds(), image.NewUniform(placeholderBackground), image.Point{}, draw.Src)
+	half := (placeholderGrid + 1) / 2
+	for row := range placeholderGrid {
+		for col := range half {
+			// One bit of the hash per cell, after the colour bytes
+			bit := row*half + col
+			if sum[3+bit/8]&(1<<(bit%8)) == 0 {
+				continue
+			}
+			for _, c := range []int{col, placeholderGrid - 1 - col} {
+				x0, y0 := placeholderMargin+c*cell, placeholderMargin+row*cell
+				for y := y0; y < y0+cell; y++ {
+					for x := x0; x < x0+cell; x++ {
+						img.SetRGBA(x, y, fill)
+					}
+				}
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		// Encoding an in-memory RGBA image can't fail
+		panic(fmt.Sprintf("imageproxy: failed to encode placeholder: %v", err))
+	}
+	return buf.Bytes()
+}
+
+// SetPlaceholderStore records the coins served placeholder icons, so their
+// uploads can be retried
+func (s *Service) SetPlaceholderStore(placeholders db.Repository[model.PlaceholderIcon]) {
+	s.placeholderStore = placeholders
+}
+
+func placeholderKey(mint string) string {
+	return placeholderPrefix + mint + ".png"
+}
+
+// PlaceholderURL returns the URL of a mint's placeholder icon
+func (s *Service) PlaceholderURL(mint string) string {
+	return s.objectURL(placeholderKey(mint))
+}
+
+// IsPlaceholderURL reports whether url is a placeholder icon's
+func (s *Service) IsPlaceholderURL(url string) bool {
+	key, ok := s.objectKey(url)
+	return ok && strings.HasPrefix(key, placeholderPrefix)
+}
+
+// UsePlaceholder stores the mint's placeholder icon, unless it already is,
+// and returns its URL. With a placeholder store, the mint is recorded as on
+// its placeholder because originalURL, which may be empty, failed with cause.
+func (s *Service) UsePlaceholder(ctx context.Context, mint, originalURL string, cause error) (string, error) {
+	key := placeholderKey(mint)
+	exists, err := s.s3Client.ImageExists(ctx, key)
+	if err != nil {
+		slog.Warn("Failed to check if placeholder exists in S3", "mintAddress", mint, "error", err)
+	}
+	if !exists {
+		data := Placeholder(mint)
+		if _, err := s.s3Client.UploadStream(ctx, key, bytes.NewReader(data), int64(len(data)), "image/png"); err != nil {
+			return "", fmt.Errorf("failed to upload placeholder to S3: %w", err)
+		}
+	}
+	s.recordPlaceholder(ctx, mint, originalURL, cause)
+	return s.PlaceholderURL(mint), nil
+}
+
+// recordPlaceholder counts a failed upload of a mint's icon. A failed write is
+// logged; the mint is recorded once its icon fails again.
+func (s *Service) recordPlaceholder(ctx context.Context, mint, originalURL string, cause error) {
+	if s.placeholderStore == nil {
+		return
+	}
+	entry := model.PlaceholderIcon{ID: mint}
+	if existing, err := s.placeholderStore.Get(ctx, mint); err == nil {
+		if originalURL == "" {
+			// Nothing was tried; coins without a logo are recorded once
+			return
+		}
+		entry = *existing
+	} else if !errors.Is(err, db.ErrNotFound) {
+		slog.WarnContext(ctx, "Failed to get placeholder icon", slog.String("mint", mint), slog.Any("error", err))
+	}
+	entry.OriginalURL = originalURL
+	if originalURL != "" {
+		entry.Attempts++
+	}
+	if cause != nil {
+		entry.Reason = cause.Error()
+	}
+	if _, err := s.placeholderStore.Upsert(ctx, &entry); err != nil {
+		slog.WarnContext(ctx, "Failed to record placeholder icon", slog.String("mint", mint), slog.Any("error", err))
+	}
+}
+
+// Placeholders returns up to limit coins on placeholder icons whose uploads
+// failed fewer than maxAttempts times, least retried first
+func (s *Service) Placeholders(ctx context.Context, limit, maxAttempts int) ([]model.PlaceholderIcon, error) {
+	if s.placeholderStore == nil {
+		return nil, nil
+	}
+	sortBy, desc := "attempts", false
+	placeholders, _, err := s.placeholderStore.List(ctx, db.ListOptions{
+		Limit:    &limit,
+		SortBy:   &sortBy,
+		SortDesc: &desc,
+		Filters:  []db.FilterOption{{Field: "attempts", Operator: db.FilterOpLessThan, Value: maxAttempts}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list placeholder icons: %w", err)
+	}
+	return placeholders, nil
+}
+
+// ClearPlaceholder forgets that a mint is on its placeholder icon, once its own
+// has been stored
+func (s *Service) ClearPlaceholder(ctx context.Context, mint string) error {
+	if s.placeholderStore == nil {
+		return nil
+	}
+	if err := s.placeholderStore.Delete(ctx, mint); err != nil && !errors.Is(err, db.ErrNotFound) {
+		return fmt.Errorf("failed to clear placeholder icon of %s: %w", mint, err)
+	}
+	return nil
+}
+
+// PlaceholderRecord returns the record of a mint on its placeholder icon
+func (s *Service) PlaceholderRecord(ctx context.Context, mint string) (*model.PlaceholderIcon, error) {
+	if s.placeholderStore == nil {
+		return nil, fmt.Errorf("%w: placeholder icons are not recorded", db.ErrNotFound)
+	}
+	return s.placeholderStore.Get(ctx, mint)
+}
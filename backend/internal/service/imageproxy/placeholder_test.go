@@ -0,0 +1,29 @@
+package imageproxy
+
+import (
+	"bytes"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlaceholder(t *testing.T) {
+	const mint = "DezXAZ8z7PnrnRJjz3wXBoRgixCa6xjnB7YaB1pPB263"
+	icon := Placeholder(mint)
+	assert.Equal(t, icon, Placeholder(mint), "same mint, same icon")
+	assert.NotEqual(t, icon, Placeholder("So11111111111111111111111111111111111111112"))
+
+	img, err := png.Decode(bytes.NewReader(icon))
+	require.NoError(t, err)
+	require.Equal(t, PlaceholderSize, img.Bounds().Dx())
+	require.Equal(t, PlaceholderSize, img.Bounds().Dy())
+
+	// The pattern is mirrored left to right
+	for y := range PlaceholderSize {
+		for x := range PlaceholderSize / 2 {
+			require.Equal(t, img.At(x, y), img.At(PlaceholderSize-1-x, y), "pixel (%d, %d)", x, y)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package imageproxy
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"golang.org/x/net/publicsuffix"
+)
+
+// DefaultMaxRedirects is how many redirects an icon download follows, enough
+// for an http→https upgrade and a CDN hop or two
+const DefaultMaxRedirects = 3
+
+// maxCanonicalURLs bounds the redirected icon URLs remembered
+const maxCanonicalURLs = 4096
+
+// ErrRedirectRejected is returned for icon downloads redirected further, or
+// elsewhere, than the redirect policy allows
+var ErrRedirectRejected = errors.New("redirect rejected")
+
+// SetMaxRedirects sets how many redirects icon downloads follow. Each must
+// stay on the registrable domain of the URL asked for, e.g. from example.com
+// to cdn.example.com, and mustn't downgrade https to http. Zero rejects every
+// redirect.
+func (s *Service) SetMaxRedirects(n int) {
+	s.maxRedirects = max(n, 0)
+}
+
+// CanonicalURL returns the URL a download of imageURL was last redirected
+// to, or imageURL if it wasn't
+func (s *Service) CanonicalURL(imageURL string) string {
+	s.redirectMu.Lock()
+	defer s.redirectMu.Unlock()
+	if final, ok := s.redirects[imageURL]; ok {
+		return final
+	}
+	return imageURL
+}
+
+// recordRedirect remembers that imageURL redirected to final
+func (s *Service) recordRedirect(imageURL, final string) {
+	s.redirectMu.Lock()
+	defer s.redirectMu.Unlock()
+	if s.redirects == nil || len(s.redirects) >= maxCanonicalURLs {
+		// Callers look up the URL right after downloading it; older entries aren't needed
+		s.redirects = make(map[string]string)
+	}
+	s.redirects[imageURL] = final
+}
+
+// checkRedirect is the download client's redirect policy
+func (s *Service) checkRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) > s.maxRedirects {
+		return fmt.Errorf("%w: more than %d redirects", ErrRedirectRejected, s.maxRedirects)
+	}
+	origin := via[0].URL
+	if origin.Scheme == "https" && req.URL.Scheme != "https" {
+		return fmt.Errorf("%w: downgraded to %s", ErrRedirectRejected, req.URL.Redacted())
+	}
+	if registrableDomain(origin.Hostname()) != registrableDomain(req.URL.Hostname()) {
+		return fmt.Errorf("%w: left %s for %s", ErrRedirectRejected, origin.Hostname(), req.URL.Hostname())
+	}
+	return nil
+}
+
+// registrableDomain returns the domain under the host's public suffix, e.g.
+// example.co.uk for cdn.example.co.uk. IP addresses and hosts without a
+// known suffix are returned as they are.
+func registrableDomain(host string) string {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	if net.ParseIP(host) != nil {
+		return host
+	}
+	domain, err := publicsuffix.EffectiveTLDPlusOne(host)
+	if err != nil {
+		return host
+	}
+	return domain
+}
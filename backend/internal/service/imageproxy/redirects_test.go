@@ -0,0 +1,57 @@
+package imageproxy
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckRedirect(t *testing.T) {
+	s := NewService(nil)
+	request := func(url string) *http.Request {
+		req, err := http.NewRequest(http.MethodGet, url, nil)
+		require.NoError(t, err)
+		return req
+	}
+	origin := []*http.Request{request("http://example.co.uk/logo.png")}
+
+	assert.NoError(t, s.checkRedirect(request("https://cdn.example.co.uk/logo.png"), origin), "upgrade to a sibling host")
+	assert.ErrorIs(t, s.checkRedirect(request("https://other.co.uk/logo.png"), origin), ErrRedirectRejected, "another site under the same suffix")
+	assert.ErrorIs(t, s.checkRedirect(request("http://cdn.example.com/logo.png"), []*http.Request{request("https://example.com/logo.png")}),
+		ErrRedirectRejected, "downgrade to http")
+
+	hops := []*http.Request{origin[0], origin[0], origin[0], origin[0]}
+	assert.ErrorIs(t, s.checkRedirect(request("https://example.co.uk/logo.png"), hops), ErrRedirectRejected, "past the limit")
+
+	s.SetMaxRedirects(0)
+	assert.ErrorIs(t, s.checkRedirect(request("https://example.co.uk/logo.png"), origin), ErrRedirectRejected)
+}
+
+func TestDownloadImage_FollowsRedirects(t *testing.T) {
+	icon := encodeIcon(t, 32, false)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/old.png" {
+			http.Redirect(w, r, "/icon.png", http.StatusMovedPermanently)
+			return
+		}
+		w.Header().Set("Content-Type", "image/png")
+		_, _ = w.Write(icon)
+	}))
+	defer server.Close()
+
+	s := NewService(nil)
+	data, info, err := s.downloadImage(context.Background(), server.URL+"/old.png")
+	require.NoError(t, err)
+	assert.Equal(t, icon, data)
+	assert.Equal(t, "image/png", info.ContentType)
+	assert.Equal(t, server.URL+"/icon.png", s.CanonicalURL(server.URL+"/old.png"))
+	assert.Equal(t, server.URL+"/icon.png", s.CanonicalURL(server.URL+"/icon.png"), "URLs that weren't redirected are their own")
+
+	s.SetMaxRedirects(0)
+	_, _, err = s.downloadImage(context.Background(), server.URL+"/old.png")
+	assert.ErrorIs(t, err, ErrRedirectRejected)
+}
@@ -3,38 +3,62 @@ package imageproxy
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
 	"net/http"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/cdn"
 	"github.com/nicolas-martin/dankfolio/backend/internal/clients/s3"
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
 	"github.com/nicolas-martin/dankfolio/backend/internal/util"
 )
 
 type Service struct {
 	s3Client   *s3.Client
 	httpClient *http.Client
+	cdn        *cdn.Client // Optional; see SetCDN
+
+	// Optional perceptual hashes of processed icons, by mint
+	hashMu    sync.RWMutex
+	hashStore db.Repository[model.ImageHash]
+	hashes    map[string]model.ImageHash
+
+	placeholderStore db.Repository[model.PlaceholderIcon] // Optional; see SetPlaceholderStore
+
+	maxRedirects int // See SetMaxRedirects
+	redirectMu   sync.Mutex
+	redirects    map[string]string // Final URLs of redirected downloads; see CanonicalURL
 }
 
 // NewService creates a new image proxy service
 func NewService(s3Client *s3.Client) *Service {
-	return &Service{
-		s3Client: s3Client,
-		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
-		},
+	s := &Service{
+		s3Client:     s3Client,
+		maxRedirects: DefaultMaxRedirects,
 	}
+	s.httpClient = &http.Client{
+		Timeout:       30 * time.Second,
+		CheckRedirect: s.checkRedirect,
+	}
+	return s
 }
 
 // ProcessAndUploadImage downloads an image from the given URL and uploads it to S3
-// Returns the S3 URL or an error
+// Returns the S3 URL or an error. With hashing enabled, an image duplicating one
+// already stored isn't uploaded again and the URL of the stored copy is returned.
 func (s *Service) ProcessAndUploadImage(ctx context.Context, imageURL string, mintAddress string) (string, error) {
 	if imageURL == "" {
 		return "", fmt.Errorf("empty image URL")
 	}
+	if h, ok := s.hashed(mintAddress); ok {
+		return s.objectURL(h.ObjectKey), nil
+	}
 
 	// Generate S3 key for the image
 	key := s.generateS3Key(mintAddress)
@@ -48,13 +72,24 @@ func (s *Service) ProcessAndUploadImage(ctx context.Context, imageURL string, mi
 	}
 	if exists {
 		// Image already uploaded, return the S3 URL
-		s3URL := s.s3Client.GetImageURL(key)
+		s3URL := s.objectURL(key)
 		slog.Debug("Image already exists in S3",
 			"mintAddress", mintAddress,
 			"s3URL", s3URL)
+		if s.hashStore != nil {
+			// Uploaded before hashing was enabled
+			s.hashStoredImage(ctx, mintAddress, key)
+		}
 		return s3URL, nil
 	}
 
+	s3URL, _, err := s.fetchAndUpload(ctx, imageURL, mintAddress, key)
+	return s3URL, err
+}
+
+// fetchAndUpload downloads the image and uploads it under key, returning its
+// URL and whether anything was uploaded: a duplicate of a stored icon isn't.
+func (s *Service) fetchAndUpload(ctx context.Context, imageURL, mintAddress, key string) (string, bool, error) {
 	// Resolve IPFS URLs to HTTP gateway URLs
 	resolvedURL := s.resolveImageURL(imageURL)
 	
@@ -63,23 +98,45 @@ func (s *Service) ProcessAndUploadImage(ctx context.Context, imageURL string, mi
 		"resolved", resolvedURL)
 
 	// Download the image
-	imageData, contentType, err := s.downloadImage(ctx, resolvedURL)
+	imageData, info, err := s.downloadImage(ctx, resolvedURL)
 	if err != nil {
-		return "", fmt.Errorf("failed to download image: %w", err)
+		return "", false, fmt.Errorf("failed to download image: %w", err)
+	}
+
+	var hash uint64
+	if s.hashStore != nil {
+		if hash, err = DifferenceHash(imageData); err != nil {
+			// Formats the standard library can't decode, such as WebP and SVG,
+			// are recorded with a zero hash, which matches nothing
+			slog.Debug("Failed to hash image", "mintAddress", mintAddress, "error", err)
+		} else if duplicateKey, ok := s.duplicateOf(hash); ok {
+			s.recordHash(ctx, mintAddress, hash, duplicateKey, info)
+			s3URL := s.objectURL(duplicateKey)
+			slog.Info("Image duplicates a stored one, reusing it",
+				"mintAddress", mintAddress,
+				"s3URL", s3URL)
+			return s3URL, false, nil
+		}
 	}
 
 	// Upload to S3
-	s3URL, err := s.s3Client.UploadImage(ctx, key, bytes.NewReader(imageData), contentType)
-	if err != nil {
-		return "", fmt.Errorf("failed to upload image to S3: %w", err)
+	if _, err := s.s3Client.UploadStream(ctx, key, bytes.NewReader(imageData), int64(len(imageData)), info.ContentType); err != nil {
+		return "", false, fmt.Errorf("failed to upload image to S3: %w", err)
+	}
+	s3URL := s.objectURL(key)
+	if s.hashStore != nil {
+		s.recordHash(ctx, mintAddress, hash, key, info)
 	}
 
 	slog.Info("Successfully processed and uploaded image",
 		"mintAddress", mintAddress,
 		"originalURL", imageURL,
-		"s3URL", s3URL)
+		"s3URL", s3URL,
+		"contentType", info.ContentType,
+		"width", info.Width,
+		"height", info.Height)
 
-	return s3URL, nil
+	return s3URL, true, nil
 }
 
 // generateS3Key generates a consistent S3 key for a token image
@@ -95,7 +152,7 @@ func (s *Service) resolveImageURL(imageURL string) string {
 }
 
 // downloadImage downloads an image from the given URL with retry logic
-func (s *Service) downloadImage(ctx context.Context, imageURL string) ([]byte, string, error) {
+func (s *Service) downloadImage(ctx context.Context, imageURL string) ([]byte, ImageInfo, error) {
 	maxRetries := 3
 	baseDelay := time.Second
 
@@ -111,13 +168,13 @@ func (s *Service) downloadImage(ctx context.Context, imageURL string) ([]byte, s
 			case <-time.After(delay):
 				// Continue with retry
 			case <-ctx.Done():
-				return nil, "", ctx.Err()
+				return nil, ImageInfo{}, ctx.Err()
 			}
 		}
 
 		req, err := http.NewRequestWithContext(ctx, "GET", imageURL, nil)
 		if err != nil {
-			return nil, "", fmt.Errorf("failed to create request: %w", err)
+			return nil, ImageInfo{}, fmt.Errorf("failed to create request: %w", err)
 		}
 
 		// Set user agent to avoid blocks
@@ -125,13 +182,16 @@ func (s *Service) downloadImage(ctx context.Context, imageURL string) ([]byte, s
 
 		resp, err := s.httpClient.Do(req)
 		if err != nil {
+			if errors.Is(err, ErrRedirectRejected) {
+				return nil, ImageInfo{}, fmt.Errorf("failed to download image: %w", err)
+			}
 			if attempt < maxRetries-1 {
 				slog.Warn("Download failed, will retry",
 					"error", err,
 					"attempt", attempt+1)
 				continue
 			}
-			return nil, "", fmt.Errorf("failed to download image: %w", err)
+			return nil, ImageInfo{}, fmt.Errorf("failed to download image: %w", err)
 		}
 		defer resp.Body.Close()
 
@@ -144,73 +204,100 @@ func (s *Service) downloadImage(ctx context.Context, imageURL string) ([]byte, s
 		}
 
 		if resp.StatusCode != http.StatusOK {
-			return nil, "", fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+			return nil, ImageInfo{}, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
 		}
 
 		// Read the image data
 		data, err := io.ReadAll(io.LimitReader(resp.Body, 10*1024*1024)) // Limit to 10MB
 		if err != nil {
-			return nil, "", fmt.Errorf("failed to read image data: %w", err)
+			return nil, ImageInfo{}, fmt.Errorf("failed to read image data: %w", err)
 		}
 
-		// Determine content type
-		contentType := resp.Header.Get("Content-Type")
-		if contentType == "" {
-			// Try to detect from data
-			contentType = http.DetectContentType(data)
+		info, err := verifyImage(data, resp.Header.Get("Content-Type"), imageURL)
+		if err != nil {
+			return nil, ImageInfo{}, err
 		}
-
-		// Validate it's an image or handle common cases
-		if !strings.HasPrefix(contentType, "image/") {
-			// Sometimes IPFS returns application/octet-stream for images
-			if contentType == "application/octet-stream" {
-				// Try to detect from data
-				detectedType := http.DetectContentType(data)
-				if strings.HasPrefix(detectedType, "image/") {
-					contentType = detectedType
-					slog.Debug("Detected image type from content",
-						"originalType", "application/octet-stream",
-						"detectedType", detectedType)
-				} else {
-					// For IPFS URLs, if we get application/octet-stream and can't detect,
-					// assume it's a PNG (most common case)
-					if strings.Contains(imageURL, "gateway.pinata.cloud/ipfs/") || 
-					   strings.Contains(imageURL, "ipfs.io/ipfs/") ||
-					   strings.Contains(imageURL, "dweb.link/ipfs/") {
-						contentType = "image/png"
-						slog.Warn("IPFS image with unknown content type, defaulting to PNG",
-							"url", imageURL,
-							"originalType", "application/octet-stream",
-							"detectedType", detectedType)
-					} else {
-						slog.Debug("Failed to detect image type",
-							"contentType", contentType,
-							"detectedType", detectedType)
-						return nil, "", fmt.Errorf("invalid content type: %s (detected: %s)", contentType, detectedType)
-					}
-				}
-			} else {
-				return nil, "", fmt.Errorf("invalid content type: %s", contentType)
-			}
+		if final := resp.Request.URL.String(); final != imageURL {
+			slog.Debug("Image download was redirected", "url", imageURL, "final", final)
+			s.recordRedirect(imageURL, final)
 		}
 
-		return data, contentType, nil
+		return data, info, nil
 	}
 
-	return nil, "", fmt.Errorf("failed after %d attempts", maxRetries)
+	return nil, ImageInfo{}, fmt.Errorf("failed after %d attempts", maxRetries)
 }
 
-// GetS3URL returns the S3 URL for a given mint address without downloading
+// verifyImage checks that data, downloaded from imageURL with the given
+// Content-Type header, is an image by its magic number rather than the
+// header, which gateways also send with their HTML error pages
+func verifyImage(data []byte, contentType, imageURL string) (ImageInfo, error) {
+	if len(data) == 0 {
+		return ImageInfo{}, fmt.Errorf("empty image")
+	}
+	info, err := SniffImage(data)
+	if err != nil {
+		return ImageInfo{}, fmt.Errorf("invalid image served as %q: %w", contentType, err)
+	}
+	if contentType != "" && !strings.HasPrefix(contentType, info.ContentType) {
+		slog.Debug("Image served with another content type",
+			"url", imageURL,
+			"contentType", contentType,
+			"detectedType", info.ContentType)
+	}
+	return info, nil
+}
+
+// GetS3URL returns the S3 URL for a given mint address without downloading.
+// A mint whose icon duplicates another's gets the URL of the shared copy.
 func (s *Service) GetS3URL(mintAddress string) string {
+	if h, ok := s.hashed(mintAddress); ok {
+		return s.objectURL(h.ObjectKey)
+	}
 	key := s.generateS3Key(mintAddress)
-	return s.s3Client.GetImageURL(key)
+	return s.objectURL(key)
+}
+
+// DeduplicatedURL returns the URL of the shared copy when url is a duplicate
+// mint's own S3 or CDN URL, which nothing was uploaded to; otherwise url.
+func (s *Service) DeduplicatedURL(mintAddress, url string) string {
+	if key, ok := s.objectKey(url); ok && key == s.generateS3Key(mintAddress) {
+		return s.GetS3URL(mintAddress)
+	}
+	return url
+}
+
+// hashStoredImage hashes and sizes an icon already in S3 under key. Failures
+// are logged; the icon is served either way.
+func (s *Service) hashStoredImage(ctx context.Context, mintAddress, key string) {
+	object, err := s.s3Client.Download(ctx, key, 0, 0)
+	if err != nil {
+		slog.Debug("Failed to download stored image for hashing", "mintAddress", mintAddress, "error", err)
+		return
+	}
+	defer object.Body.Close()
+	data, err := io.ReadAll(object.Body)
+	if err != nil {
+		slog.Debug("Failed to read stored image for hashing", "mintAddress", mintAddress, "error", err)
+		return
+	}
+	info, err := SniffImage(data)
+	if err != nil {
+		slog.Debug("Stored image is invalid", "mintAddress", mintAddress, "error", err)
+		return
+	}
+	hash, err := DifferenceHash(data)
+	if err != nil {
+		slog.Debug("Failed to hash stored image", "mintAddress", mintAddress, "error", err)
+	}
+	s.recordHash(ctx, mintAddress, hash, key, info)
 }
 
 // MigrateImageToS3 migrates an existing image URL to S3
 // This is useful for batch migration of existing coins
 func (s *Service) MigrateImageToS3(ctx context.Context, imageURL string, mintAddress string) (string, error) {
 	// If it's already an S3 URL, skip migration
-	if strings.Contains(imageURL, "linodeobjects.com") {
+	if s.IsProxiedURL(imageURL) {
 		slog.Debug("Image already on S3, skipping migration",
 			"mintAddress", mintAddress,
 			"url", imageURL)
@@ -0,0 +1,193 @@
+package imageproxy
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// sniffLen is how much of an image identifies its format
+const sniffLen = 512
+
+// maxImageDimension bounds icon widths and heights; anything larger is a
+// decompression bomb or not an icon
+const maxImageDimension = 8192
+
+// ErrNotImage is returned for data that isn't a PNG, JPEG, GIF, WebP or SVG,
+// whatever Content-Type it was served with
+var ErrNotImage = errors.New("not an image")
+
+// ImageInfo is what an image's bytes say it is
+type ImageInfo struct {
+	ContentType string // From the magic number, e.g. "image/png"
+	Width       int    // Zero for SVGs that don't give a size
+	Height      int
+}
+
+var (
+	pngMagic  = []byte("\x89PNG\r\n\x1a\n")
+	jpegMagic = []byte{0xff, 0xd8, 0xff}
+
+	svgSizeAttr = regexp.MustCompile(`\s(width|height)\s*=\s*["']\s*([0-9.]+)\s*(px)?\s*["']`)
+	svgViewBox  = regexp.MustCompile(`\sviewbox\s*=\s*["']\s*[-0-9.]+[\s,]+[-0-9.]+[\s,]+([0-9.]+)[\s,]+([0-9.]+)\s*["']`)
+)
+
+// SniffImage identifies an image by the magic number in its first bytes and
+// reads its dimensions. JPEG dimensions can sit past the first bytes, behind
+// EXIF data, so data should be the whole image.
+func SniffImage(data []byte) (ImageInfo, error) {
+	head := data[:min(len(data), sniffLen)]
+	var info ImageInfo
+	var err error
+	switch {
+	case bytes.HasPrefix(head, pngMagic):
+		info, err = pngInfo(head)
+	case bytes.HasPrefix(head, jpegMagic):
+		info, err = jpegInfo(data)
+	case bytes.HasPrefix(head, []byte("GIF87a")), bytes.HasPrefix(head, []byte("GIF89a")):
+		info, err = gifInfo(head)
+	case len(head) >= 12 && string(head[:4]) == "RIFF" && string(head[8:12]) == "WEBP":
+		info, err = webpInfo(head)
+	default:
+		info, err = svgInfo(head)
+	}
+	if err != nil {
+		return ImageInfo{}, err
+	}
+	if info.ContentType == "image/svg+xml" {
+		// Vector images scale to any size, declared or not
+		return info, nil
+	}
+	if info.Width == 0 || info.Height == 0 {
+		return ImageInfo{}, fmt.Errorf("%w: %s has no pixels", ErrNotImage, info.ContentType)
+	}
+	if info.Width > maxImageDimension || info.Height > maxImageDimension {
+		return ImageInfo{}, fmt.Errorf("%s is %dx%d, over %d pixels a side", info.ContentType, info.Width, info.Height, maxImageDimension)
+	}
+	return info, nil
+}
+
+func pngInfo(head []byte) (ImageInfo, error) {
+	// The IHDR chunk comes first: length, type, then width and height
+	if len(head) < 24 || string(head[12:16]) != "IHDR" {
+		return ImageInfo{}, fmt.Errorf("%w: truncated PNG", ErrNotImage)
+	}
+	return ImageInfo{
+		ContentType: "image/png",
+		Width:       int(binary.BigEndian.Uint32(head[16:20])),
+		Height:      int(binary.BigEndian.Uint32(head[20:24])),
+	}, nil
+}
+
+func jpegInfo(data []byte) (ImageInfo, error) {
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return ImageInfo{}, fmt.Errorf("%w: corrupt JPEG: %v", ErrNotImage, err)
+	}
+	return ImageInfo{ContentType: "image/jpeg", Width: config.Width, Height: config.Height}, nil
+}
+
+func gifInfo(head []byte) (ImageInfo, error) {
+	if len(head) < 10 {
+		return ImageInfo{}, fmt.Errorf("%w: truncated GIF", ErrNotImage)
+	}
+	return ImageInfo{
+		ContentType: "image/gif",
+		Width:       int(binary.LittleEndian.Uint16(head[6:8])),
+		Height:      int(binary.LittleEndian.Uint16(head[8:10])),
+	}, nil
+}
+
+// webpInfo reads the size from the first chunk, which is lossy (VP8), lossless
+// (VP8L) or extended (VP8X)
+func webpInfo(head []byte) (ImageInfo, error) {
+	info := ImageInfo{ContentType: "image/webp"}
+	if len(head) < 30 {
+		return ImageInfo{}, fmt.Errorf("%w: truncated WebP", ErrNotImage)
+	}
+	switch string(head[12:16]) {
+	case "VP8 ":
+		if !bytes.Equal(head[23:26], []byte{0x9d, 0x01, 0x2a}) {
+			return ImageInfo{}, fmt.Errorf("%w: corrupt WebP", ErrNotImage)
+		}
+		info.Width = int(binary.LittleEndian.Uint16(head[26:28]) & 0x3fff)
+		info.Height = int(binary.LittleEndian.Uint16(head[28:30]) & 0x3fff)
+	case "VP8L":
+		if head[20] != 0x2f {
+			return ImageInfo{}, fmt.Errorf("%w: corrupt WebP", ErrNotImage)
+		}
+		bits := binary.LittleEndian.Uint32(head[21:25])
+		info.Width = int(bits&0x3fff) + 1
+		info.Height = int(bits>>14&0x3fff) + 1
+	case "VP8X":
+		info.Width = int(uint32(head[24])|uint32(head[25])<<8|uint32(head[26])<<16) + 1
+		info.Height = int(uint32(head[27])|uint32(head[28])<<8|uint32(head[29])<<16) + 1
+	default:
+		return ImageInfo{}, fmt.Errorf("%w: unknown WebP chunk %q", ErrNotImage, head[12:16])
+	}
+	return info, nil
+}
+
+// svgInfo accepts text whose first element, after any XML declaration,
+// doctype and comments, is <svg>. HTML pages, which gateways serve as error
+// pages whatever the Content-Type, are rejected.
+func svgInfo(head []byte) (ImageInfo, error) {
+	text := strings.ToLower(string(bytes.TrimPrefix(head, []byte("\xef\xbb\xbf"))))
+	rest := strings.TrimSpace(text)
+	for {
+		var end string
+		switch {
+		case strings.HasPrefix(rest, "<!--"):
+			end = "-->"
+		case strings.HasPrefix(rest, "<?xml"), strings.HasPrefix(rest, "<!doctype svg"):
+			end = ">"
+		}
+		if end == "" {
+			break
+		}
+		i := strings.Index(rest, end)
+		if i < 0 {
+			return ImageInfo{}, fmt.Errorf("%w: unrecognized data", ErrNotImage)
+		}
+		rest = strings.TrimSpace(rest[i+len(end):])
+	}
+	switch {
+	case strings.HasPrefix(rest, "<svg"):
+	case strings.HasPrefix(rest, "<!doctype html"), strings.HasPrefix(rest, "<html"), strings.Contains(text, "<body"):
+		return ImageInfo{}, fmt.Errorf("%w: HTML page", ErrNotImage)
+	default:
+		return ImageInfo{}, fmt.Errorf("%w: unrecognized data", ErrNotImage)
+	}
+
+	info := ImageInfo{ContentType: "image/svg+xml"}
+	tag := rest
+	if i := strings.IndexByte(tag, '>'); i >= 0 {
+		tag = tag[:i]
+	}
+	for _, m := range svgSizeAttr.FindAllStringSubmatch(tag, -1) {
+		size, err := strconv.ParseFloat(m[2], 64)
+		if err != nil {
+			continue
+		}
+		if m[1] == "width" {
+			info.Width = int(size)
+		} else {
+			info.Height = int(size)
+		}
+	}
+	if info.Width == 0 || info.Height == 0 {
+		if m := svgViewBox.FindStringSubmatch(tag); m != nil {
+			width, werr := strconv.ParseFloat(m[1], 64)
+			height, herr := strconv.ParseFloat(m[2], 64)
+			if werr == nil && herr == nil {
+				info.Width, info.Height = int(width), int(height)
+			}
+		}
+	}
+	return info, nil
+}
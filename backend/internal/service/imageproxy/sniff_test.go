@@ -0,0 +1,73 @@
+package imageproxy
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// webpHeader returns the first bytes of a lossless WebP of the given size
+func webpHeader(width, height int) []byte {
+	data := []byte("RIFF\x00\x00\x00\x00WEBPVP8L\x00\x00\x00\x00\x2f")
+	data = binary.LittleEndian.AppendUint32(data, uint32(width-1)|uint32(height-1)<<14)
+	return append(data, make([]byte, 8)...)
+}
+
+func TestSniffImage(t *testing.T) {
+	var jpg, gifData bytes.Buffer
+	require.NoError(t, jpeg.Encode(&jpg, image.NewGray(image.Rect(0, 0, 48, 32)), nil))
+	require.NoError(t, gif.Encode(&gifData, image.NewPaletted(image.Rect(0, 0, 20, 10), color.Palette{color.Black}), nil))
+
+	tests := []struct {
+		name string
+		data []byte
+		want ImageInfo
+	}{
+		{"png", encodeIcon(t, 64, false), ImageInfo{ContentType: "image/png", Width: 64, Height: 64}},
+		{"jpeg", jpg.Bytes(), ImageInfo{ContentType: "image/jpeg", Width: 48, Height: 32}},
+		{"gif", gifData.Bytes(), ImageInfo{ContentType: "image/gif", Width: 20, Height: 10}},
+		{"webp", webpHeader(300, 200), ImageInfo{ContentType: "image/webp", Width: 300, Height: 200}},
+		{
+			"svg with a size",
+			[]byte(`<?xml version="1.0"?><!-- logo --><svg xmlns="http://www.w3.org/2000/svg" width="120px" height="80"></svg>`),
+			ImageInfo{ContentType: "image/svg+xml", Width: 120, Height: 80},
+		},
+		{
+			"svg with a viewBox",
+			[]byte(`<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 256 128"><path d="M0 0"/></svg>`),
+			ImageInfo{ContentType: "image/svg+xml", Width: 256, Height: 128},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SniffImage(tt.data)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSniffImage_Rejects(t *testing.T) {
+	for name, data := range map[string][]byte{
+		"html error page": []byte("<!DOCTYPE html><html><head><title>504 Gateway Time-out</title></head></html>"),
+		"json":            []byte(`{"error":"not found"}`),
+		"truncated png":   pngMagic,
+		"empty":           nil,
+	} {
+		_, err := SniffImage(data)
+		assert.ErrorIs(t, err, ErrNotImage, name)
+	}
+
+	_, err := SniffImage(webpHeader(16384, 16384))
+	assert.Error(t, err, "images over the maximum dimension are rejected")
+
+	_, err = verifyImage([]byte("<html><body>Rate limited</body></html>"), "image/png", "https://gateway.example.com/ipfs/Qm")
+	assert.ErrorIs(t, err, ErrNotImage, "the Content-Type header isn't trusted")
+}
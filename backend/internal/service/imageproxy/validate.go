@@ -0,0 +1,34 @@
+package imageproxy
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+// maxStoredImageSize bounds reading a stored icon back for validation; larger
+// objects are treated as broken
+const maxStoredImageSize = 10 << 20
+
+// ValidateStored checks that the stored icon at url is still there and is an
+// image, the way downloaded icons are validated before they are stored
+func (s *Service) ValidateStored(ctx context.Context, url string) error {
+	key, ok := s.objectKey(url)
+	if !ok {
+		return fmt.Errorf("not a stored icon: %s", url)
+	}
+	object, err := s.s3Client.Download(ctx, key, 0, 0)
+	if err != nil {
+		return err
+	}
+	defer object.Body.Close()
+	data, err := io.ReadAll(io.LimitReader(object.Body, maxStoredImageSize+1))
+	if err != nil {
+		return fmt.Errorf("failed to read stored icon: %w", err)
+	}
+	if len(data) > maxStoredImageSize {
+		return fmt.Errorf("stored icon is over %d bytes", maxStoredImageSize)
+	}
+	_, err = verifyImage(data, object.ContentType, url)
+	return err
+}
@@ -0,0 +1,10 @@
+package leaderboard
+
+import (
+	"github.com/nicolas-martin/dankfolio/backend/internal/cache"
+)
+
+type Cache = cache.LeaderboardCache
+
+// NewCache creates a new leaderboard cache instance
+var NewCache = cache.NewLeaderboardCache
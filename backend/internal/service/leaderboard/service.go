@@ -0,0 +1,255 @@
+// Package leaderboard ranks the top holders and most profitable recent traders of a
+// coin from the trades recorded by the backend. Computing a board scans every trade
+// in the coin, so boards are cached and refreshed in the background once stale.
+package leaderboard
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	coinservice "github.com/nicolas-martin/dankfolio/backend/internal/service/coin"
+)
+
+const (
+	// DefaultWindow is how far back trades count towards trader profit.
+	DefaultWindow = 7 * 24 * time.Hour
+	// DefaultFreshFor is how long a board is served before a background refresh.
+	DefaultFreshFor = 10 * time.Minute
+	// MaxEntries is the most holders and traders kept on a board.
+	MaxEntries = 50
+
+	// staleFor is how long a board may be served while its refresh is in flight
+	staleFor = 6 * time.Hour
+	// refreshTimeout bounds a background refresh
+	refreshTimeout = 2 * time.Minute
+)
+
+// completedTradeStatuses are the trade statuses that moved tokens on-chain
+var completedTradeStatuses = []string{"completed", "finalized", "confirmed", "processed"}
+
+// Config tunes the leaderboard. Zero values use the defaults.
+type Config struct {
+	Window   time.Duration
+	FreshFor time.Duration
+}
+
+// Service computes and caches coin leaderboards.
+type Service struct {
+	store       db.Store
+	coinService coinservice.CoinServiceAPI
+	cache       Cache
+	window      time.Duration
+	freshFor    time.Duration
+
+	refreshGroup singleflight.Group
+}
+
+// NewService creates a leaderboard service.
+func NewService(store db.Store, coinService coinservice.CoinServiceAPI, boardCache Cache, config Config) *Service {
+	if config.Window <= 0 {
+		config.Window = DefaultWindow
+	}
+	if config.FreshFor <= 0 {
+		config.FreshFor = DefaultFreshFor
+	}
+	return &Service{
+		store:       store,
+		coinService: coinService,
+		cache:       boardCache,
+		window:      config.Window,
+		freshFor:    config.FreshFor,
+	}
+}
+
+// GetCoinLeaderboard returns the leaderboard for a coin. A cached board is returned
+// as-is; once it is older than FreshFor it is still returned while a refresh runs in
+// the background. Only a coin with no cached board is computed inline.
+func (s *Service) GetCoinLeaderboard(ctx context.Context, address string) (*model.CoinLeaderboard, error) {
+	if board, found := s.cache.Get(cacheKey(address)); found && board != nil {
+		if time.Since(board.ComputedAt) > s.freshFor {
+			s.refreshAsync(ctx, address)
+		}
+		return board, nil
+	}
+
+	result, err, _ := s.refreshGroup.Do(address, func() (any, error) {
+		return s.refresh(ctx, address)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*model.CoinLeaderboard), nil
+}
+
+func (s *Service) refreshAsync(ctx context.Context, address string) {
+	// Detached so the refresh outlives the request that noticed the stale board
+	refreshCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), refreshTimeout)
+	go func() {
+		defer cancel()
+		_, err, _ := s.refreshGroup.Do(address, func() (any, error) {
+			return s.refresh(refreshCtx, address)
+		})
+		if err != nil {
+			slog.WarnContext(refreshCtx, "Failed to refresh coin leaderboard", "address", address, "error", err)
+		}
+	}()
+}
+
+func (s *Service) refresh(ctx context.Context, address string) (*model.CoinLeaderboard, error) {
+	start := time.Now()
+	trades, err := s.listTrades(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	var price float64
+	if coin, err := s.coinService.GetCoinByAddress(ctx, address); err != nil {
+		// Ranking still works from trade history; values and unrealized profit read as zero
+		slog.WarnContext(ctx, "Failed to get coin price for leaderboard", "address", address, "error", err)
+	} else {
+		price = coin.Price
+	}
+
+	board := buildLeaderboard(address, trades, price, start.Add(-s.window))
+	board.Window = s.window
+	board.ComputedAt = time.Now()
+	s.cache.Set(cacheKey(address), board, staleFor)
+
+	slog.InfoContext(ctx, "Computed coin leaderboard",
+		"address", address,
+		"trades", len(trades),
+		"holders", len(board.Holders),
+		"traders", len(board.Traders),
+		"duration", time.Since(start))
+	return board, nil
+}
+
+// listTrades returns every completed trade into or out of the coin, oldest first
+func (s *Service) listTrades(ctx context.Context, address string) ([]model.Trade, error) {
+	var trades []model.Trade
+	for _, field := range []string{"to_coin_mint_address", "from_coin_mint_address"} {
+		rows, _, err := s.store.Trades().ListWithOpts(ctx, db.ListOptions{Filters: []db.FilterOption{
+			{Field: field, Operator: db.FilterOpEqual, Value: address},
+			{Field: "status", Operator: db.FilterOpIn, Value: completedTradeStatuses},
+		}})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list trades for leaderboard: %w", err)
+		}
+		trades = append(trades, rows...)
+	}
+	sort.SliceStable(trades, func(i, j int) bool { return trades[i].CreatedAt.Before(trades[j].CreatedAt) })
+	return trades, nil
+}
+
+// position tracks one wallet's holdings in the coin using average cost
+type position struct {
+	amount  float64
+	avgCost float64 // USD per token
+
+	// Activity since the trader window started
+	realizedPnL float64
+	volumeUSD   float64
+	trades      int
+}
+
+// buildLeaderboard replays trades oldest first. Holders rank by net amount bought
+// through the app; traders rank by realized profit on sells since windowStart plus
+// unrealized profit on what they still hold, and only wallets active in the window count.
+func buildLeaderboard(address string, trades []model.Trade, price float64, windowStart time.Time) *model.CoinLeaderboard {
+	positions := make(map[string]*position)
+	for _, trade := range trades {
+		if trade.UserID == "" || trade.FromCoinMintAddress == trade.ToCoinMintAddress {
+			continue
+		}
+		p, ok := positions[trade.UserID]
+		if !ok {
+			p = &position{}
+			positions[trade.UserID] = p
+		}
+		inWindow := !trade.CreatedAt.Before(windowStart)
+
+		switch {
+		case trade.ToCoinMintAddress == address && (trade.Type == "swap" || trade.Type == "buy"):
+			received := trade.OutputAmount
+			if received <= 0 {
+				received = trade.Amount
+			}
+			if received <= 0 {
+				continue
+			}
+			p.avgCost = (p.avgCost*p.amount + trade.TotalUSDCost) / (p.amount + received)
+			p.amount += received
+			if inWindow {
+				p.volumeUSD += trade.TotalUSDCost
+				p.trades++
+			}
+		case trade.FromCoinMintAddress == address && (trade.Type == "swap" || trade.Type == "sell"):
+			// Sells beyond the tracked position were bought outside the app; they have no known cost
+			sold := min(trade.Amount, p.amount)
+			proceeds := trade.TotalUSDCost
+			p.amount -= sold
+			if inWindow {
+				if trade.Amount > 0 {
+					p.realizedPnL += proceeds*sold/trade.Amount - sold*p.avgCost
+				}
+				p.volumeUSD += proceeds
+				p.trades++
+			}
+		}
+	}
+
+	board := &model.CoinLeaderboard{
+		Address: address,
+		Holders: make([]model.TopHolder, 0),
+		Traders: make([]model.TopTrader, 0),
+	}
+	for wallet, p := range positions {
+		if p.amount > 0 {
+			board.Holders = append(board.Holders, model.TopHolder{
+				Wallet:   wallet,
+				Amount:   p.amount,
+				ValueUSD: p.amount * price,
+			})
+		}
+		if p.trades > 0 {
+			trader := model.TopTrader{
+				Wallet:         wallet,
+				RealizedPnLUSD: p.realizedPnL,
+				VolumeUSD:      p.volumeUSD,
+				Trades:         p.trades,
+			}
+			if price > 0 {
+				trader.UnrealizedPnLUSD = p.amount * (price - p.avgCost)
+			}
+			board.Traders = append(board.Traders, trader)
+		}
+	}
+
+	sort.Slice(board.Holders, func(i, j int) bool {
+		if board.Holders[i].Amount != board.Holders[j].Amount {
+			return board.Holders[i].Amount > board.Holders[j].Amount
+		}
+		return board.Holders[i].Wallet < board.Holders[j].Wallet
+	})
+	sort.Slice(board.Traders, func(i, j int) bool {
+		pi, pj := board.Traders[i].TotalPnLUSD(), board.Traders[j].TotalPnLUSD()
+		if pi != pj {
+			return pi > pj
+		}
+		return board.Traders[i].Wallet < board.Traders[j].Wallet
+	})
+	board.Holders = board.Holders[:min(len(board.Holders), MaxEntries)]
+	board.Traders = board.Traders[:min(len(board.Traders), MaxEntries)]
+	return board
+}
+
+func cacheKey(address string) string {
+	return "leaderboard:" + address
+}
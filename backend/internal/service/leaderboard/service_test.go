@@ -0,0 +1,56 @@
+package leaderboard
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+func TestBuildLeaderboard(t *testing.T) {
+	const mint = "MINT"
+	now := time.Now()
+	windowStart := now.Add(-24 * time.Hour)
+	old := now.Add(-48 * time.Hour)
+
+	buy := func(wallet string, at time.Time, tokens, usd float64) model.Trade {
+		return model.Trade{UserID: wallet, Type: "swap", FromCoinMintAddress: model.SolMint, ToCoinMintAddress: mint, OutputAmount: tokens, TotalUSDCost: usd, CreatedAt: at}
+	}
+	sell := func(wallet string, at time.Time, tokens, usd float64) model.Trade {
+		return model.Trade{UserID: wallet, Type: "swap", FromCoinMintAddress: mint, ToCoinMintAddress: model.SolMint, Amount: tokens, TotalUSDCost: usd, CreatedAt: at}
+	}
+
+	trades := []model.Trade{
+		// whale bought before the window and only holds
+		buy("whale", old, 1000, 100),
+		// flipper bought before the window, sold half inside it at 3x
+		buy("flipper", old, 100, 10),
+		sell("flipper", now, 50, 15),
+		// dumper sells more than the app ever saw it buy
+		buy("dumper", now, 10, 10),
+		sell("dumper", now, 20, 4),
+	}
+
+	board := buildLeaderboard(mint, trades, 0.2, windowStart)
+
+	require.Len(t, board.Holders, 2)
+	assert.Equal(t, model.TopHolder{Wallet: "whale", Amount: 1000, ValueUSD: 200}, board.Holders[0])
+	assert.Equal(t, "flipper", board.Holders[1].Wallet)
+	assert.InDelta(t, 50, board.Holders[1].Amount, 1e-9)
+
+	// whale made no trades in the window so only flipper and dumper rank as traders
+	require.Len(t, board.Traders, 2)
+	flipper := board.Traders[0]
+	assert.Equal(t, "flipper", flipper.Wallet)
+	assert.InDelta(t, 10, flipper.RealizedPnLUSD, 1e-9)  // 15 proceeds - 50 * 0.1 cost
+	assert.InDelta(t, 5, flipper.UnrealizedPnLUSD, 1e-9) // 50 * (0.2 - 0.1)
+	assert.Equal(t, 1, flipper.Trades)
+
+	dumper := board.Traders[1]
+	assert.Equal(t, "dumper", dumper.Wallet)
+	assert.InDelta(t, -8, dumper.RealizedPnLUSD, 1e-9) // half the proceeds cover the 10 tracked tokens bought for 10
+	assert.Equal(t, 2, dumper.Trades)
+}
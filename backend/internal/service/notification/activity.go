@@ -0,0 +1,275 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/events"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	bmodel "github.com/nicolas-martin/dankfolio/backend/internal/model/blockchain"
+)
+
+const (
+	// DefaultRefreshInterval is how often the watched wallets are reloaded
+	DefaultRefreshInterval = time.Minute
+
+	// activityQueueSize bounds the activity waiting to be looked up; more is
+	// dropped so the websocket is never read slowly
+	activityQueueSize = 1024
+)
+
+// Chain reads the transactions activity refers to. The Solana client
+// implements it.
+type Chain interface {
+	GetTransaction(ctx context.Context, signature bmodel.Signature, commitment string) (*bmodel.TransactionDetail, error)
+}
+
+// Prices values tokens in USD. The price service implements it.
+type Prices interface {
+	GetCoinPrices(ctx context.Context, tokenAddresses []string) (map[string]float64, error)
+}
+
+// Subscriber streams transactions mentioning the watched addresses. The
+// Solana websocket subscriber implements it.
+type Subscriber interface {
+	SetWatched(addresses []bmodel.Address)
+	Run(ctx context.Context, handle func(context.Context, bmodel.AddressActivity))
+}
+
+// ActivityConfig holds activity stream settings. Zero values use the defaults.
+type ActivityConfig struct {
+	RefreshInterval time.Duration
+	Commitment      string
+}
+
+// ActivityStream notifies wallets of incoming transfers, from the
+// subscriber's stream of their transactions, and of their confirmed trades,
+// from the event bus.
+type ActivityStream struct {
+	config     ActivityConfig
+	service    *Service
+	subscriber Subscriber
+	chain      Chain
+	prices     Prices
+
+	activity chan bmodel.AddressActivity
+	trades   chan model.Trade
+	bus      events.Bus // Set by Run; activity is republished on it
+}
+
+// NewActivityStream creates an activity stream.
+func NewActivityStream(config ActivityConfig, service *Service, subscriber Subscriber, chain Chain, prices Prices) *ActivityStream {
+	if config.RefreshInterval <= 0 {
+		config.RefreshInterval = DefaultRefreshInterval
+	}
+	if config.Commitment == "" {
+		config.Commitment = bmodel.DefaultCommitment
+	}
+	return &ActivityStream{
+		config:     config,
+		service:    service,
+		subscriber: subscriber,
+		chain:      chain,
+		prices:     prices,
+		activity:   make(chan bmodel.AddressActivity, activityQueueSize),
+		trades:     make(chan model.Trade, activityQueueSize),
+	}
+}
+
+// Run streams and notifies until ctx is cancelled. Each transaction seen
+// is also published on bus as WalletActivity. bus may be nil, in which case
+// trades are not notified.
+func (a *ActivityStream) Run(ctx context.Context, bus events.Bus) {
+	a.bus = bus
+	if bus != nil {
+		unsubscribe := bus.Subscribe(a.handleEvent, events.TradeConfirmed)
+		defer unsubscribe()
+	}
+	a.refresh(ctx)
+	go a.subscriber.Run(ctx, a.enqueue)
+	slog.InfoContext(ctx, "Wallet activity notifications started")
+
+	ticker := time.NewTicker(a.config.RefreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case activity := <-a.activity:
+			if err := a.handleActivity(ctx, activity); err != nil && ctx.Err() == nil {
+				slog.WarnContext(ctx, "Failed to notify wallet activity",
+					slog.String("wallet", string(activity.Address)), slog.String("signature", string(activity.Signature)), slog.Any("error", err))
+			}
+		case trade := <-a.trades:
+			if err := a.service.Notify(ctx, trade.UserID, tradeNotification(trade)); err != nil && ctx.Err() == nil {
+				slog.WarnContext(ctx, "Failed to notify trade confirmation", slog.String("wallet", trade.UserID), slog.Any("error", err))
+			}
+		case <-ticker.C:
+			a.refresh(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// refresh subscribes to the wallets that want transfer notifications
+func (a *ActivityStream) refresh(ctx context.Context) {
+	watched, err := a.service.watchedWallets(ctx, model.NotificationTransferReceived)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to load wallets watched for transfers", slog.Any("error", err))
+		return
+	}
+	addresses := make([]bmodel.Address, len(watched))
+	for i, prefs := range watched {
+		addresses[i] = bmodel.Address(prefs.WalletAddress)
+	}
+	a.subscriber.SetWatched(addresses)
+}
+
+func (a *ActivityStream) enqueue(ctx context.Context, activity bmodel.AddressActivity) {
+	if activity.Err != nil {
+		return
+	}
+	if a.bus != nil {
+		a.bus.Publish(ctx, events.NewWalletActivityEvent(string(activity.Address), string(activity.Signature)))
+	}
+	select {
+	case a.activity <- activity:
+	default:
+		slog.WarnContext(ctx, "Dropping wallet activity, queue is full", slog.String("signature", string(activity.Signature)))
+	}
+}
+
+func (a *ActivityStream) handleEvent(ctx context.Context, event events.Event) {
+	payload, ok := event.Payload.(events.TradePayload)
+	if !ok || payload.Trade.UserID == "" {
+		return
+	}
+	select {
+	case a.trades <- payload.Trade:
+	default:
+		slog.WarnContext(ctx, "Dropping trade notification, queue is full", slog.Uint64("trade_id", uint64(payload.Trade.ID)))
+	}
+}
+
+func tradeNotification(trade model.Trade) Notification {
+	body := "Your trade is confirmed on-chain."
+	if trade.CoinSymbol != "" {
+		body = fmt.Sprintf("Your %s trade is confirmed on-chain.", trade.CoinSymbol)
+	}
+	return Notification{
+		Type:  model.NotificationTradeConfirmed,
+		Title: "Trade confirmed",
+		Body:  body,
+		Data: map[string]string{
+			"trade_id":         strconv.FormatUint(uint64(trade.ID), 10),
+			"transaction_hash": trade.TransactionHash,
+		},
+	}
+}
+
+// handleActivity notifies the most valuable amount a transaction sent to a
+// watched wallet, when it is worth at least the wallet's threshold
+func (a *ActivityStream) handleActivity(ctx context.Context, activity bmodel.AddressActivity) error {
+	prefs, err := a.service.GetPreferences(ctx, string(activity.Address))
+	if err != nil {
+		return err
+	}
+	if !wantsType(prefs, model.NotificationTransferReceived) {
+		return nil
+	}
+	tx, err := a.chain.GetTransaction(ctx, activity.Signature, a.config.Commitment)
+	if err != nil {
+		return err
+	}
+	if tx == nil || tx.Err != nil || len(tx.Accounts) == 0 {
+		return nil
+	}
+	// Transactions the wallet paid for are its own swaps and sends
+	if tx.Accounts[0] == activity.Address {
+		return nil
+	}
+	received := incomingTransfers(tx, activity.Address)
+	if len(received) == 0 {
+		return nil
+	}
+
+	mints := make([]string, 0, len(received))
+	for _, t := range received {
+		mints = append(mints, t.mint)
+	}
+	prices, err := a.prices.GetCoinPrices(ctx, mints)
+	if err != nil {
+		return fmt.Errorf("failed to price incoming transfer: %w", err)
+	}
+	var best *transfer
+	for i := range received {
+		received[i].valueUSD = received[i].amount * prices[received[i].mint]
+		if best == nil || received[i].valueUSD > best.valueUSD {
+			best = &received[i]
+		}
+	}
+	if best.valueUSD < prefs.TransferThresholdUSD || best.valueUSD == 0 {
+		return nil
+	}
+
+	_, err = a.service.deliver(ctx, prefs, Notification{
+		Type:  model.NotificationTransferReceived,
+		Title: fmt.Sprintf("Received %s %s", formatAmount(best.amount), a.service.coinSymbol(ctx, best.mint)),
+		Body:  fmt.Sprintf("Worth about $%.2f.", best.valueUSD),
+		Data: map[string]string{
+			"mint":      best.mint,
+			"signature": string(activity.Signature),
+		},
+	})
+	return err
+}
+
+func formatAmount(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', -1, 64)
+}
+
+// transfer is an amount a transaction added to a wallet
+type transfer struct {
+	mint     string
+	amount   float64
+	valueUSD float64
+}
+
+// incomingTransfers returns the SOL and tokens whose balance tx raised for
+// wallet, in UI units. Wrapped SOL counts as SOL.
+func incomingTransfers(tx *bmodel.TransactionDetail, wallet bmodel.Address) []transfer {
+	var received []transfer
+	if i := slices.Index(tx.Accounts, wallet); i >= 0 && i < len(tx.PreBalances) && i < len(tx.PostBalances) {
+		if tx.PostBalances[i] > tx.PreBalances[i] {
+			lamports := tx.PostBalances[i] - tx.PreBalances[i]
+			received = append(received, transfer{mint: model.SolMint, amount: float64(lamports) / 1e9})
+		}
+	}
+
+	pre := make(map[int]uint64, len(tx.PreTokenBalances))
+	for _, balance := range tx.PreTokenBalances {
+		amount, _ := strconv.ParseUint(balance.Amount, 10, 64)
+		pre[balance.AccountIndex] = amount
+	}
+	for _, balance := range tx.PostTokenBalances {
+		if balance.Owner != wallet {
+			continue
+		}
+		amount, err := strconv.ParseUint(balance.Amount, 10, 64)
+		if err != nil || amount <= pre[balance.AccountIndex] {
+			continue
+		}
+		delta := float64(amount-pre[balance.AccountIndex]) / math.Pow10(int(balance.Decimals))
+		mint := string(balance.Mint)
+		if j := slices.IndexFunc(received, func(t transfer) bool { return t.mint == mint }); j >= 0 {
+			received[j].amount += delta
+			continue
+		}
+		received = append(received, transfer{mint: mint, amount: delta})
+	}
+	return received
+}
@@ -0,0 +1,108 @@
+package notification
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	bmodel "github.com/nicolas-martin/dankfolio/backend/internal/model/blockchain"
+)
+
+const (
+	testSender = "7xKXtg2CW87d97TXJSDpbD5jBkheTqA83TZRuJosgAsU"
+	testMint   = "7GCihgDB8fe6KNjn2MYtkzZcRjQy3t9GHdC8uHYmW2hr"
+)
+
+type fakeChain struct {
+	tx *bmodel.TransactionDetail
+}
+
+func (c *fakeChain) GetTransaction(context.Context, bmodel.Signature, string) (*bmodel.TransactionDetail, error) {
+	return c.tx, nil
+}
+
+type fakePrices map[string]float64
+
+func (p fakePrices) GetCoinPrices(context.Context, []string) (map[string]float64, error) {
+	return p, nil
+}
+
+// transferTx sends lamports and tokens from testSender, who pays the fee, to testWallet
+func transferTx(lamports uint64, tokens string) *bmodel.TransactionDetail {
+	return &bmodel.TransactionDetail{
+		Accounts:     []bmodel.Address{testSender, testWallet, "recipientTokenAccount"},
+		PreBalances:  []uint64{5_000_000_000, 1_000_000_000, 2_039_280},
+		PostBalances: []uint64{5_000_000_000 - lamports - 5000, 1_000_000_000 + lamports, 2_039_280},
+		PreTokenBalances: []bmodel.TokenBalance{
+			{AccountIndex: 2, Mint: testMint, Owner: testWallet, Amount: "1000000", Decimals: 6},
+		},
+		PostTokenBalances: []bmodel.TokenBalance{
+			{AccountIndex: 2, Mint: testMint, Owner: testWallet, Amount: tokens, Decimals: 6},
+		},
+	}
+}
+
+func TestIncomingTransfers(t *testing.T) {
+	received := incomingTransfers(transferTx(500_000_000, "3500000"), testWallet)
+	require.Len(t, received, 2)
+	assert.Equal(t, model.SolMint, received[0].mint)
+	assert.InDelta(t, 0.5, received[0].amount, 1e-12)
+	assert.Equal(t, testMint, received[1].mint)
+	assert.InDelta(t, 2.5, received[1].amount, 1e-12)
+
+	// The sender's side of the transfer is not incoming
+	assert.Empty(t, incomingTransfers(transferTx(500_000_000, "1000000"), testSender))
+}
+
+func TestHandleActivity(t *testing.T) {
+	sender := &recordingSender{}
+	service, prefs, _ := newTestService(t, sender)
+	prefs.EXPECT().Get(mock.Anything, testWallet).Return(&model.NotificationPreferences{
+		WalletAddress:        testWallet,
+		DeviceTokens:         []string{"token-a"},
+		TransferThresholdUSD: 20,
+	}, nil)
+	chain := &fakeChain{}
+	stream := NewActivityStream(ActivityConfig{RefreshInterval: time.Hour}, service, nil, chain, fakePrices{model.SolMint: 150, testMint: 0.01})
+	activity := bmodel.AddressActivity{Address: testWallet, Signature: "sig"}
+
+	// 0.1 SOL is below the wallet's $20 threshold
+	chain.tx = transferTx(100_000_000, "1000000")
+	require.NoError(t, stream.handleActivity(context.Background(), activity))
+	assert.Empty(t, sender.sent)
+
+	// Transactions the wallet paid for are its own
+	chain.tx = transferTx(1_000_000_000, "1000000")
+	chain.tx.Accounts[0], chain.tx.Accounts[1] = testWallet, testSender
+	require.NoError(t, stream.handleActivity(context.Background(), activity))
+	assert.Empty(t, sender.sent)
+
+	chain.tx = transferTx(1_000_000_000, "9000000")
+	require.NoError(t, stream.handleActivity(context.Background(), activity))
+	require.Len(t, sender.sent, 1)
+	assert.Equal(t, model.NotificationTransferReceived, sender.sent[0].Type)
+	assert.Equal(t, "Received 1 SOL", sender.sent[0].Title)
+	assert.Equal(t, "Worth about $150.00.", sender.sent[0].Body)
+	assert.Equal(t, "sig", sender.sent[0].Data["signature"])
+}
+
+func TestDumpWatcherRecord(t *testing.T) {
+	watcher := NewDumpWatcher(DumpConfig{Window: time.Hour}, nil, nil, nil)
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	watcher.record(map[string]float64{testMint: 2.0}, start)
+	drops := watcher.record(map[string]float64{testMint: 1.5}, start.Add(30*time.Minute))
+	assert.InDelta(t, 25.0, drops[testMint], 1e-9)
+
+	// The high falls out of the window
+	drops = watcher.record(map[string]float64{testMint: 1.5}, start.Add(90*time.Minute))
+	assert.Zero(t, drops[testMint])
+
+	assert.True(t, watcher.shouldAlert(testWallet, testMint, start))
+	assert.False(t, watcher.shouldAlert(testWallet, testMint, start.Add(time.Minute)), "one alert per window")
+}
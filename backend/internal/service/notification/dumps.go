@@ -0,0 +1,222 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/jobs"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	bmodel "github.com/nicolas-martin/dankfolio/backend/internal/model/blockchain"
+)
+
+const (
+	// DefaultDumpInterval is how often held tokens are priced
+	DefaultDumpInterval = 5 * time.Minute
+	// DefaultDumpWindow is how far back a drop is measured from
+	DefaultDumpWindow = time.Hour
+
+	// minDumpHoldingUSD skips holdings too small to be worth a notification
+	minDumpHoldingUSD = 1.0
+)
+
+// Holdings reads a wallet's token accounts across token programs. The wallet
+// service implements it.
+type Holdings interface {
+	GetTokenAccounts(ctx context.Context, walletAddress string) ([]*bmodel.TokenAccountInfo, error)
+}
+
+// DumpConfig holds dump watcher settings. Zero values use the defaults.
+type DumpConfig struct {
+	Interval time.Duration
+	Window   time.Duration
+}
+
+type pricePoint struct {
+	at    time.Time
+	price float64
+}
+
+// DumpWatcher prices the tokens watched wallets hold and notifies a wallet
+// when one falls from its high within the window by at least the wallet's
+// threshold. Prices are only kept in memory, so after a restart drops are
+// measured from the first check.
+type DumpWatcher struct {
+	config   DumpConfig
+	service  *Service
+	holdings Holdings
+	prices   Prices
+	now      func() time.Time
+
+	mu      sync.Mutex
+	history map[string][]pricePoint // By mint
+	alerted map[string]time.Time    // By wallet and mint, when last notified
+}
+
+// NewDumpWatcher creates a dump watcher.
+func NewDumpWatcher(config DumpConfig, service *Service, holdings Holdings, prices Prices) *DumpWatcher {
+	if config.Interval <= 0 {
+		config.Interval = DefaultDumpInterval
+	}
+	if config.Window <= 0 {
+		config.Window = DefaultDumpWindow
+	}
+	return &DumpWatcher{
+		config:   config,
+		service:  service,
+		holdings: holdings,
+		prices:   prices,
+		now:      time.Now,
+		history:  make(map[string][]pricePoint),
+		alerted:  make(map[string]time.Time),
+	}
+}
+
+// JobDumpCheck names the dump check for scheduling
+const JobDumpCheck = "notification.dump_check"
+
+// Job checks every Interval
+func (w *DumpWatcher) Job() jobs.Job {
+	return jobs.Job{Name: JobDumpCheck, Interval: w.config.Interval, Run: w.Check}
+}
+
+// Check prices every token the watched wallets hold and notifies the dumps.
+func (w *DumpWatcher) Check(ctx context.Context) error {
+	watched, err := w.service.watchedWallets(ctx, model.NotificationTokenDump)
+	if err != nil {
+		return err
+	}
+	if len(watched) == 0 {
+		return nil
+	}
+
+	held := make(map[string]map[string]float64, len(watched)) // Wallet to mint to UI amount
+	var mints []string
+	seen := make(map[string]bool)
+	for _, prefs := range watched {
+		accounts, err := w.holdings.GetTokenAccounts(ctx, prefs.WalletAddress)
+		if err != nil {
+			// Partial holdings are still worth checking
+			slog.WarnContext(ctx, "Failed to read holdings for dump check", slog.String("wallet", prefs.WalletAddress), slog.Any("error", err))
+			if len(accounts) == 0 {
+				continue
+			}
+		}
+		balances := make(map[string]float64, len(accounts))
+		for _, account := range accounts {
+			mint := string(account.MintAddress)
+			if account.UIAmount <= 0 || mint == model.SolMint {
+				continue
+			}
+			balances[mint] += account.UIAmount
+			if !seen[mint] {
+				seen[mint] = true
+				mints = append(mints, mint)
+			}
+		}
+		held[prefs.WalletAddress] = balances
+	}
+	if len(mints) == 0 {
+		return nil
+	}
+	prices, err := w.prices.GetCoinPrices(ctx, mints)
+	if err != nil {
+		return fmt.Errorf("failed to price held tokens: %w", err)
+	}
+	now := w.now()
+	drops := w.record(prices, now)
+
+	sent := 0
+	for i := range watched {
+		prefs := &watched[i]
+		for mint, amount := range held[prefs.WalletAddress] {
+			drop, ok := drops[mint]
+			if !ok || drop < prefs.DumpThresholdPercent || amount*prices[mint] < minDumpHoldingUSD {
+				continue
+			}
+			if !w.shouldAlert(prefs.WalletAddress, mint, now) {
+				continue
+			}
+			status, err := w.service.deliver(ctx, prefs, w.dumpNotification(ctx, mint, drop))
+			if err != nil {
+				slog.WarnContext(ctx, "Failed to notify token dump", slog.String("wallet", prefs.WalletAddress), slog.String("mint", mint), slog.Any("error", err))
+				continue
+			}
+			if status == model.NotificationDeliverySent {
+				sent++
+			}
+		}
+	}
+	slog.DebugContext(ctx, "Dump check finished", slog.Int("wallets", len(watched)), slog.Int("mints", len(mints)), slog.Int("notified", sent))
+	return nil
+}
+
+// record adds the prices to each mint's window and returns, per mint, how
+// far in percent the price is below the window's high
+func (w *DumpWatcher) record(prices map[string]float64, now time.Time) map[string]float64 {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	cutoff := now.Add(-w.config.Window)
+	for mint, points := range w.history {
+		for len(points) > 0 && points[0].at.Before(cutoff) {
+			points = points[1:]
+		}
+		if len(points) == 0 {
+			delete(w.history, mint)
+			continue
+		}
+		w.history[mint] = points
+	}
+	for key, at := range w.alerted {
+		if at.Before(cutoff) {
+			delete(w.alerted, key)
+		}
+	}
+
+	drops := make(map[string]float64, len(prices))
+	for mint, price := range prices {
+		if price <= 0 {
+			continue
+		}
+		points := append(w.history[mint], pricePoint{at: now, price: price})
+		w.history[mint] = points
+		high := price
+		for _, point := range points {
+			high = max(high, point.price)
+		}
+		drops[mint] = (high - price) / high * 100
+	}
+	return drops
+}
+
+// shouldAlert reports whether the wallet was not already told about the
+// mint within the window, and marks it told
+func (w *DumpWatcher) shouldAlert(walletAddress, mint string, now time.Time) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	key := walletAddress + "/" + mint
+	if _, ok := w.alerted[key]; ok {
+		return false
+	}
+	w.alerted[key] = now
+	return true
+}
+
+func (w *DumpWatcher) dumpNotification(ctx context.Context, mint string, drop float64) Notification {
+	symbol := w.service.coinSymbol(ctx, mint)
+	return Notification{
+		Type:  model.NotificationTokenDump,
+		Title: fmt.Sprintf("%s is down %.0f%%", symbol, drop),
+		Body:  fmt.Sprintf("A token you hold fell %.0f%% in the last %s.", drop, formatWindow(w.config.Window)),
+		Data:  map[string]string{"mint": mint},
+	}
+}
+
+func formatWindow(window time.Duration) string {
+	if window == time.Hour {
+		return "hour"
+	}
+	return window.String()
+}
@@ -0,0 +1,59 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"firebase.google.com/go/v4/messaging"
+)
+
+// fcmMaxTokens is the most tokens one multicast send accepts
+const fcmMaxTokens = 500
+
+// FCMSender sends notifications with Firebase Cloud Messaging.
+type FCMSender struct {
+	client *messaging.Client
+}
+
+var _ Sender = (*FCMSender)(nil)
+
+// NewFCMSender creates a sender from a Firebase messaging client.
+func NewFCMSender(client *messaging.Client) *FCMSender {
+	return &FCMSender{client: client}
+}
+
+// Send implements Sender. It fails only when no device could be reached.
+func (s *FCMSender) Send(ctx context.Context, tokens []string, n Notification) ([]string, error) {
+	var unregistered []string
+	var lastErr error
+	delivered := 0
+	for start := 0; start < len(tokens); start += fcmMaxTokens {
+		batch := tokens[start:min(start+fcmMaxTokens, len(tokens))]
+		response, err := s.client.SendEachForMulticast(ctx, &messaging.MulticastMessage{
+			Tokens:       batch,
+			Notification: &messaging.Notification{Title: n.Title, Body: n.Body},
+			Data:         n.Data,
+		})
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		delivered += response.SuccessCount
+		for i, result := range response.Responses {
+			if result.Success {
+				continue
+			}
+			if messaging.IsUnregistered(result.Error) {
+				unregistered = append(unregistered, batch[i])
+				continue
+			}
+			lastErr = result.Error
+			slog.DebugContext(ctx, "FCM send failed for device", slog.Any("error", result.Error))
+		}
+	}
+	if delivered == 0 && lastErr != nil {
+		return unregistered, fmt.Errorf("fcm send failed: %w", lastErr)
+	}
+	return unregistered, nil
+}
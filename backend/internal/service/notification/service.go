@@ -0,0 +1,469 @@
+// Package notification sends push notifications to the devices a wallet
+// registered: incoming transfers, confirmed trades, sharp drops in a held
+// token's price, price alerts and system announcements. Each wallet chooses
+// its channel, quiet hours, which types it receives and the thresholds that
+// trigger them, and every notification is recorded in a delivery log.
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+	// Quiet hours are kept in the wallet's time zone whatever the host has installed
+	_ "time/tzdata"
+
+	"github.com/google/uuid"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+const (
+	// DefaultTransferThresholdUSD is the smallest incoming transfer notified
+	// until the wallet picks its own threshold
+	DefaultTransferThresholdUSD = 10.0
+	// DefaultDumpThresholdPercent is the smallest drop of a held token's
+	// price within the dump window that is notified
+	DefaultDumpThresholdPercent = 25.0
+
+	// maxDevicesPerWallet bounds the tokens kept per wallet; registering
+	// another one drops the oldest
+	maxDevicesPerWallet  = 10
+	maxDeviceTokenLength = 4096
+
+	// quietHoursLayout is the clock format of quiet hours
+	quietHoursLayout = "15:04"
+
+	defaultDeliveryLimit = 50
+	maxDeliveryLimit     = 500
+)
+
+// Types are the notification types a wallet can disable.
+var Types = []string{
+	model.NotificationTransferReceived,
+	model.NotificationTradeConfirmed,
+	model.NotificationTokenDump,
+	model.NotificationPriceAlert,
+	model.NotificationAnnouncement,
+}
+
+// Channels are the channels a wallet can choose.
+var Channels = []string{model.NotificationChannelPush, model.NotificationChannelNone}
+
+// ErrInvalidPreferences is returned for requests with invalid preferences or device tokens.
+var ErrInvalidPreferences = errors.New("invalid notification preferences")
+
+// Notification is one push message.
+type Notification struct {
+	Type  string
+	Title string
+	Body  string
+	Data  map[string]string // Delivered to the app alongside the message
+}
+
+// Sender delivers notifications to devices.
+type Sender interface {
+	// Send delivers n to every token and returns the tokens the push
+	// service reports as no longer registered.
+	Send(ctx context.Context, tokens []string, n Notification) (unregistered []string, err error)
+}
+
+// Service manages wallets' notification preferences and delivers their
+// notifications.
+type Service struct {
+	store  db.Store
+	sender Sender
+	now    func() time.Time
+}
+
+// NewService creates a notification service.
+func NewService(store db.Store, sender Sender) *Service {
+	return &Service{store: store, sender: sender, now: time.Now}
+}
+
+// GetPreferences returns a wallet's preferences, or the defaults when it has
+// none stored.
+func (s *Service) GetPreferences(ctx context.Context, walletAddress string) (*model.NotificationPreferences, error) {
+	prefs, err := s.store.NotificationPreferences().Get(ctx, walletAddress)
+	if errors.Is(err, db.ErrNotFound) {
+		return defaultPreferences(walletAddress), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get notification preferences: %w", err)
+	}
+	return prefs, nil
+}
+
+func defaultPreferences(walletAddress string) *model.NotificationPreferences {
+	return &model.NotificationPreferences{
+		WalletAddress:        walletAddress,
+		Channel:              model.NotificationChannelPush,
+		TransferThresholdUSD: DefaultTransferThresholdUSD,
+		DumpThresholdPercent: DefaultDumpThresholdPercent,
+	}
+}
+
+// RegisterDevice adds a device token to a wallet. Registering a token again
+// is a no-op.
+func (s *Service) RegisterDevice(ctx context.Context, walletAddress, token string) error {
+	if token == "" || len(token) > maxDeviceTokenLength {
+		return fmt.Errorf("%w: device token must be 1-%d characters", ErrInvalidPreferences, maxDeviceTokenLength)
+	}
+	prefs, err := s.GetPreferences(ctx, walletAddress)
+	if err != nil {
+		return err
+	}
+	if slices.Contains(prefs.DeviceTokens, token) {
+		return nil
+	}
+	prefs.DeviceTokens = append(prefs.DeviceTokens, token)
+	if len(prefs.DeviceTokens) > maxDevicesPerWallet {
+		prefs.DeviceTokens = prefs.DeviceTokens[len(prefs.DeviceTokens)-maxDevicesPerWallet:]
+	}
+	if _, err := s.store.NotificationPreferences().Upsert(ctx, prefs); err != nil {
+		return fmt.Errorf("failed to save device token: %w", err)
+	}
+	return nil
+}
+
+// UnregisterDevice removes a device token from a wallet.
+func (s *Service) UnregisterDevice(ctx context.Context, walletAddress, token string) error {
+	return s.removeTokens(ctx, walletAddress, []string{token})
+}
+
+func (s *Service) removeTokens(ctx context.Context, walletAddress string, tokens []string) error {
+	prefs, err := s.store.NotificationPreferences().Get(ctx, walletAddress)
+	if errors.Is(err, db.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get notification preferences: %w", err)
+	}
+	remaining := slices.DeleteFunc(slices.Clone(prefs.DeviceTokens), func(token string) bool {
+		return slices.Contains(tokens, token)
+	})
+	if len(remaining) == len(prefs.DeviceTokens) {
+		return nil
+	}
+	prefs.DeviceTokens = remaining
+	if _, err := s.store.NotificationPreferences().Upsert(ctx, prefs); err != nil {
+		return fmt.Errorf("failed to remove device tokens: %w", err)
+	}
+	return nil
+}
+
+// UpdatePreferencesParams replaces a wallet's preferences. Nil fields are
+// left unchanged.
+type UpdatePreferencesParams struct {
+	DisabledTypes        []string
+	TransferThresholdUSD *float64
+	DumpThresholdPercent *float64
+	Channel              *string
+	QuietHoursStart      *string // Set both, or both to "" to clear quiet hours
+	QuietHoursEnd        *string
+	Timezone             *string
+}
+
+// UpdatePreferences validates and saves a wallet's preferences.
+func (s *Service) UpdatePreferences(ctx context.Context, walletAddress string, params UpdatePreferencesParams) (*model.NotificationPreferences, error) {
+	disabled := make([]string, 0, len(params.DisabledTypes))
+	for _, notificationType := range params.DisabledTypes {
+		if !slices.Contains(Types, notificationType) {
+			return nil, fmt.Errorf("%w: unknown notification type %q, expected one of %s", ErrInvalidPreferences, notificationType, strings.Join(Types, ", "))
+		}
+		if !slices.Contains(disabled, notificationType) {
+			disabled = append(disabled, notificationType)
+		}
+	}
+	if t := params.TransferThresholdUSD; t != nil && *t < 0 {
+		return nil, fmt.Errorf("%w: transfer threshold must not be negative", ErrInvalidPreferences)
+	}
+	if t := params.DumpThresholdPercent; t != nil && (*t <= 0 || *t > 100) {
+		return nil, fmt.Errorf("%w: dump threshold must be above 0 and at most 100 percent", ErrInvalidPreferences)
+	}
+	if c := params.Channel; c != nil && !slices.Contains(Channels, *c) {
+		return nil, fmt.Errorf("%w: unknown channel %q, expected one of %s", ErrInvalidPreferences, *c, strings.Join(Channels, ", "))
+	}
+	if (params.QuietHoursStart == nil) != (params.QuietHoursEnd == nil) {
+		return nil, fmt.Errorf("%w: quiet hours need both a start and an end", ErrInvalidPreferences)
+	}
+	if params.QuietHoursStart != nil {
+		if err := validateQuietHours(*params.QuietHoursStart, *params.QuietHoursEnd); err != nil {
+			return nil, err
+		}
+	}
+	if tz := params.Timezone; tz != nil && *tz != "" {
+		if _, err := time.LoadLocation(*tz); err != nil {
+			return nil, fmt.Errorf("%w: unknown time zone %q", ErrInvalidPreferences, *tz)
+		}
+	}
+
+	prefs, err := s.GetPreferences(ctx, walletAddress)
+	if err != nil {
+		return nil, err
+	}
+	prefs.DisabledTypes = disabled
+	if params.TransferThresholdUSD != nil {
+		prefs.TransferThresholdUSD = *params.TransferThresholdUSD
+	}
+	if params.DumpThresholdPercent != nil {
+		prefs.DumpThresholdPercent = *params.DumpThresholdPercent
+	}
+	if params.Channel != nil {
+		prefs.Channel = *params.Channel
+	}
+	if params.QuietHoursStart != nil {
+		prefs.QuietHoursStart, prefs.QuietHoursEnd = *params.QuietHoursStart, *params.QuietHoursEnd
+	}
+	if params.Timezone != nil {
+		prefs.Timezone = *params.Timezone
+	}
+	if _, err := s.store.NotificationPreferences().Upsert(ctx, prefs); err != nil {
+		return nil, fmt.Errorf("failed to save notification preferences: %w", err)
+	}
+	return prefs, nil
+}
+
+func validateQuietHours(start, end string) error {
+	if start == "" && end == "" {
+		return nil
+	}
+	startAt, startErr := time.Parse(quietHoursLayout, start)
+	endAt, endErr := time.Parse(quietHoursLayout, end)
+	if startErr != nil || endErr != nil {
+		return fmt.Errorf("%w: quiet hours must be formatted as HH:MM", ErrInvalidPreferences)
+	}
+	if startAt.Equal(endAt) {
+		return fmt.Errorf("%w: quiet hours must not start and end at the same time", ErrInvalidPreferences)
+	}
+	return nil
+}
+
+// wantsType reports whether prefs has devices on a channel that sends and
+// has not disabled the type
+func wantsType(prefs *model.NotificationPreferences, notificationType string) bool {
+	return len(prefs.DeviceTokens) > 0 && suppressReason(prefs, notificationType) == ""
+}
+
+// suppressReason returns why prefs hold back every notification of the
+// type, empty when they don't
+func suppressReason(prefs *model.NotificationPreferences, notificationType string) string {
+	if prefs.Channel == model.NotificationChannelNone {
+		return "channel is none"
+	}
+	if slices.Contains(prefs.DisabledTypes, notificationType) {
+		return "type is disabled"
+	}
+	return ""
+}
+
+// inQuietHours reports whether now falls in prefs' quiet hours
+func inQuietHours(prefs *model.NotificationPreferences, now time.Time) bool {
+	start, startErr := time.Parse(quietHoursLayout, prefs.QuietHoursStart)
+	end, endErr := time.Parse(quietHoursLayout, prefs.QuietHoursEnd)
+	if startErr != nil || endErr != nil {
+		return false
+	}
+	location := time.UTC
+	if prefs.Timezone != "" {
+		if loaded, err := time.LoadLocation(prefs.Timezone); err == nil {
+			location = loaded
+		}
+	}
+	local := now.In(location)
+	minute := local.Hour()*60 + local.Minute()
+	from, until := start.Hour()*60+start.Minute(), end.Hour()*60+end.Minute()
+	if from < until {
+		return minute >= from && minute < until
+	}
+	// Quiet hours that run past midnight
+	return minute >= from || minute < until
+}
+
+// Notify sends n to a wallet's devices unless it disabled n's type.
+func (s *Service) Notify(ctx context.Context, walletAddress string, n Notification) error {
+	prefs, err := s.GetPreferences(ctx, walletAddress)
+	if err != nil {
+		return err
+	}
+	_, err = s.deliver(ctx, prefs, n)
+	return err
+}
+
+// deliver sends n to prefs' devices unless the preferences hold it back,
+// records the outcome in the delivery log and forgets the tokens that are
+// no longer registered. It returns the delivery status, empty when the
+// wallet has no devices.
+func (s *Service) deliver(ctx context.Context, prefs *model.NotificationPreferences, n Notification) (string, error) {
+	if len(prefs.DeviceTokens) == 0 {
+		return "", nil
+	}
+	reason := suppressReason(prefs, n.Type)
+	if reason == "" && inQuietHours(prefs, s.now()) {
+		reason = "quiet hours"
+	}
+	if reason != "" {
+		s.record(ctx, prefs, n, model.NotificationDeliverySuppressed, reason)
+		return model.NotificationDeliverySuppressed, nil
+	}
+
+	data := make(map[string]string, len(n.Data)+2)
+	for key, value := range n.Data {
+		data[key] = value
+	}
+	data["type"] = n.Type
+	data["wallet_address"] = prefs.WalletAddress
+	n.Data = data
+
+	unregistered, err := s.sender.Send(ctx, prefs.DeviceTokens, n)
+	if len(unregistered) > 0 {
+		if err := s.removeTokens(ctx, prefs.WalletAddress, unregistered); err != nil {
+			slog.WarnContext(ctx, "Failed to forget unregistered device tokens", slog.String("wallet", prefs.WalletAddress), slog.Any("error", err))
+		}
+	}
+	if err != nil {
+		s.record(ctx, prefs, n, model.NotificationDeliveryFailed, err.Error())
+		return model.NotificationDeliveryFailed, fmt.Errorf("failed to send %s notification: %w", n.Type, err)
+	}
+	s.record(ctx, prefs, n, model.NotificationDeliverySent, "")
+	slog.DebugContext(ctx, "Notification sent", slog.String("wallet", prefs.WalletAddress), slog.String("type", n.Type))
+	return model.NotificationDeliverySent, nil
+}
+
+// record adds a delivery to the log. The notification has been handled
+// either way, so failing to log it is only warned about.
+func (s *Service) record(ctx context.Context, prefs *model.NotificationPreferences, n Notification, status, detail string) {
+	delivery := &model.NotificationDelivery{
+		ID:            uuid.NewString(),
+		WalletAddress: prefs.WalletAddress,
+		Type:          n.Type,
+		Title:         n.Title,
+		Body:          n.Body,
+		Status:        status,
+		Detail:        detail,
+		CreatedAt:     s.now(),
+	}
+	if status == model.NotificationDeliverySent || status == model.NotificationDeliveryFailed {
+		delivery.Devices = len(prefs.DeviceTokens)
+	}
+	if err := s.store.NotificationDeliveries().Create(ctx, delivery); err != nil {
+		slog.WarnContext(ctx, "Failed to record notification delivery", slog.String("wallet", prefs.WalletAddress), slog.String("type", n.Type), slog.Any("error", err))
+	}
+}
+
+// BroadcastResult counts the outcomes of a broadcast.
+type BroadcastResult struct {
+	Wallets    int // Wallets with a registered device
+	Sent       int
+	Suppressed int
+	Failed     int
+}
+
+// Broadcast sends a system announcement to every wallet with a registered
+// device, subject to each wallet's preferences.
+func (s *Service) Broadcast(ctx context.Context, title, body string, data map[string]string) (*BroadcastResult, error) {
+	if strings.TrimSpace(title) == "" {
+		return nil, fmt.Errorf("%w: title is required", ErrInvalidPreferences)
+	}
+	all, _, err := s.store.NotificationPreferences().List(ctx, db.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notification preferences: %w", err)
+	}
+	n := Notification{Type: model.NotificationAnnouncement, Title: title, Body: body, Data: data}
+	result := &BroadcastResult{}
+	for i := range all {
+		if len(all[i].DeviceTokens) == 0 {
+			continue
+		}
+		result.Wallets++
+		status, err := s.deliver(ctx, &all[i], n)
+		switch status {
+		case model.NotificationDeliverySent:
+			result.Sent++
+		case model.NotificationDeliverySuppressed:
+			result.Suppressed++
+		case model.NotificationDeliveryFailed:
+			result.Failed++
+			slog.WarnContext(ctx, "Failed to deliver announcement", slog.String("wallet", all[i].WalletAddress), slog.Any("error", err))
+		}
+		if ctx.Err() != nil {
+			return result, ctx.Err()
+		}
+	}
+	slog.InfoContext(ctx, "Announcement broadcast",
+		slog.String("title", title), slog.Int("wallets", result.Wallets),
+		slog.Int("sent", result.Sent), slog.Int("suppressed", result.Suppressed), slog.Int("failed", result.Failed))
+	return result, nil
+}
+
+// DeliveryFilter selects deliveries from the log. Empty fields match all.
+type DeliveryFilter struct {
+	WalletAddress string
+	Type          string
+	Status        string
+	Limit         int
+}
+
+// ListDeliveries returns logged deliveries, newest first.
+func (s *Service) ListDeliveries(ctx context.Context, filter DeliveryFilter) ([]model.NotificationDelivery, error) {
+	var filters []db.FilterOption
+	if filter.WalletAddress != "" {
+		filters = append(filters, db.FilterOption{Field: "wallet_address", Operator: db.FilterOpEqual, Value: filter.WalletAddress})
+	}
+	if filter.Type != "" {
+		filters = append(filters, db.FilterOption{Field: "type", Operator: db.FilterOpEqual, Value: filter.Type})
+	}
+	if filter.Status != "" {
+		filters = append(filters, db.FilterOption{Field: "status", Operator: db.FilterOpEqual, Value: filter.Status})
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = defaultDeliveryLimit
+	}
+	filter.Limit = min(filter.Limit, maxDeliveryLimit)
+	sortBy, desc := "created_at", true
+	deliveries, _, err := s.store.NotificationDeliveries().ListWithOpts(ctx, db.ListOptions{
+		Limit:    &filter.Limit,
+		SortBy:   &sortBy,
+		SortDesc: &desc,
+		Filters:  filters,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notification deliveries: %w", err)
+	}
+	return deliveries, nil
+}
+
+// watchedWallets returns the preferences of every wallet with a device that
+// wants notificationType
+func (s *Service) watchedWallets(ctx context.Context, notificationType string) ([]model.NotificationPreferences, error) {
+	all, _, err := s.store.NotificationPreferences().List(ctx, db.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notification preferences: %w", err)
+	}
+	watched := all[:0]
+	for i := range all {
+		if wantsType(&all[i], notificationType) {
+			watched = append(watched, all[i])
+		}
+	}
+	return watched, nil
+}
+
+// coinSymbol returns a coin's ticker, or a shortened mint when it is not listed
+func (s *Service) coinSymbol(ctx context.Context, mint string) string {
+	if mint == model.SolMint {
+		return "SOL"
+	}
+	coins, err := s.store.Coins().GetByAddresses(ctx, []string{mint})
+	if err == nil && len(coins) > 0 && coins[0].Symbol != "" {
+		return coins[0].Symbol
+	}
+	if len(mint) <= 8 {
+		return mint
+	}
+	return mint[:4] + "…" + mint[len(mint)-4:]
+}
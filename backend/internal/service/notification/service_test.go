@@ -0,0 +1,180 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	dbmocks "github.com/nicolas-martin/dankfolio/backend/internal/db/mocks"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+const testWallet = "GgaBFkzjuvMV7RCrZyt65zx7iRo7W6Af4cGXZMKNxK2R"
+
+type recordingSender struct {
+	sent         []Notification
+	tokens       [][]string
+	unregistered []string
+}
+
+func (s *recordingSender) Send(_ context.Context, tokens []string, n Notification) ([]string, error) {
+	s.sent = append(s.sent, n)
+	s.tokens = append(s.tokens, tokens)
+	return s.unregistered, nil
+}
+
+type deliveryLog struct {
+	deliveries []model.NotificationDelivery
+}
+
+func newTestService(t *testing.T, sender Sender) (*Service, *dbmocks.MockRepository[model.NotificationPreferences], *deliveryLog) {
+	store := dbmocks.NewMockStore(t)
+	prefs := dbmocks.NewMockRepository[model.NotificationPreferences](t)
+	deliveries := dbmocks.NewMockRepository[model.NotificationDelivery](t)
+	store.EXPECT().NotificationPreferences().Return(prefs).Maybe()
+	store.EXPECT().NotificationDeliveries().Return(deliveries).Maybe()
+	log := &deliveryLog{}
+	deliveries.EXPECT().Create(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, d *model.NotificationDelivery) error {
+		log.deliveries = append(log.deliveries, *d)
+		return nil
+	}).Maybe()
+	return NewService(store, sender), prefs, log
+}
+
+func TestRegisterDevice_StartsFromDefaults(t *testing.T) {
+	service, prefs, _ := newTestService(t, nil)
+	prefs.EXPECT().Get(mock.Anything, testWallet).Return(nil, fmt.Errorf("wrapped: %w", db.ErrNotFound))
+	prefs.EXPECT().Upsert(mock.Anything, mock.MatchedBy(func(p *model.NotificationPreferences) bool {
+		return assert.ObjectsAreEqual([]string{"token-a"}, p.DeviceTokens) &&
+			p.Channel == model.NotificationChannelPush &&
+			p.TransferThresholdUSD == DefaultTransferThresholdUSD &&
+			p.DumpThresholdPercent == DefaultDumpThresholdPercent
+	})).Return(int64(1), nil)
+
+	require.NoError(t, service.RegisterDevice(context.Background(), testWallet, "token-a"))
+}
+
+func TestUpdatePreferences(t *testing.T) {
+	service, prefs, _ := newTestService(t, nil)
+
+	_, err := service.UpdatePreferences(context.Background(), testWallet, UpdatePreferencesParams{DisabledTypes: []string{"weather"}})
+	assert.ErrorIs(t, err, ErrInvalidPreferences)
+	tooHigh := 150.0
+	_, err = service.UpdatePreferences(context.Background(), testWallet, UpdatePreferencesParams{DumpThresholdPercent: &tooHigh})
+	assert.ErrorIs(t, err, ErrInvalidPreferences)
+	start, badEnd := "22:00", "7am"
+	_, err = service.UpdatePreferences(context.Background(), testWallet, UpdatePreferencesParams{QuietHoursStart: &start, QuietHoursEnd: &badEnd})
+	assert.ErrorIs(t, err, ErrInvalidPreferences)
+	_, err = service.UpdatePreferences(context.Background(), testWallet, UpdatePreferencesParams{QuietHoursStart: &start})
+	assert.ErrorIs(t, err, ErrInvalidPreferences)
+	timezone := "Mars/Olympus_Mons"
+	_, err = service.UpdatePreferences(context.Background(), testWallet, UpdatePreferencesParams{Timezone: &timezone})
+	assert.ErrorIs(t, err, ErrInvalidPreferences)
+
+	prefs.EXPECT().Get(mock.Anything, testWallet).Return(&model.NotificationPreferences{
+		WalletAddress:        testWallet,
+		DeviceTokens:         []string{"token-a"},
+		TransferThresholdUSD: 50,
+		DumpThresholdPercent: 30,
+	}, nil)
+	prefs.EXPECT().Upsert(mock.Anything, mock.Anything).Return(int64(1), nil)
+
+	threshold, end, timezone := 5.0, "07:00", "America/Toronto"
+	updated, err := service.UpdatePreferences(context.Background(), testWallet, UpdatePreferencesParams{
+		DisabledTypes:        []string{model.NotificationTradeConfirmed, model.NotificationTradeConfirmed},
+		TransferThresholdUSD: &threshold,
+		QuietHoursStart:      &start,
+		QuietHoursEnd:        &end,
+		Timezone:             &timezone,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{model.NotificationTradeConfirmed}, updated.DisabledTypes)
+	assert.Equal(t, 5.0, updated.TransferThresholdUSD)
+	assert.Equal(t, 30.0, updated.DumpThresholdPercent, "unset thresholds are kept")
+	assert.Equal(t, []string{"token-a"}, updated.DeviceTokens)
+	assert.Equal(t, "22:00", updated.QuietHoursStart)
+	assert.Equal(t, "America/Toronto", updated.Timezone)
+}
+
+func TestInQuietHours(t *testing.T) {
+	prefs := &model.NotificationPreferences{QuietHoursStart: "22:00", QuietHoursEnd: "07:00", Timezone: "America/Toronto"}
+	// 03:00 UTC is 22:00 the evening before in Toronto (EST)
+	assert.True(t, inQuietHours(prefs, time.Date(2025, 1, 15, 3, 0, 0, 0, time.UTC)))
+	assert.True(t, inQuietHours(prefs, time.Date(2025, 1, 15, 11, 59, 0, 0, time.UTC)))
+	assert.False(t, inQuietHours(prefs, time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC)))
+	assert.False(t, inQuietHours(prefs, time.Date(2025, 1, 15, 2, 59, 0, 0, time.UTC)))
+
+	daytime := &model.NotificationPreferences{QuietHoursStart: "09:00", QuietHoursEnd: "17:00"}
+	assert.True(t, inQuietHours(daytime, time.Date(2025, 1, 15, 9, 0, 0, 0, time.UTC)))
+	assert.False(t, inQuietHours(daytime, time.Date(2025, 1, 15, 17, 0, 0, 0, time.UTC)))
+	assert.False(t, inQuietHours(&model.NotificationPreferences{}, time.Now()))
+}
+
+func TestNotify(t *testing.T) {
+	sender := &recordingSender{unregistered: []string{"stale"}}
+	service, prefs, log := newTestService(t, sender)
+	stored := &model.NotificationPreferences{
+		WalletAddress: testWallet,
+		DeviceTokens:  []string{"fresh", "stale"},
+		DisabledTypes: []string{model.NotificationTokenDump},
+	}
+	prefs.EXPECT().Get(mock.Anything, testWallet).RunAndReturn(func(context.Context, string) (*model.NotificationPreferences, error) {
+		copied := *stored
+		return &copied, nil
+	})
+	prefs.EXPECT().Upsert(mock.Anything, mock.MatchedBy(func(p *model.NotificationPreferences) bool {
+		return assert.ObjectsAreEqual([]string{"fresh"}, p.DeviceTokens)
+	})).Return(int64(1), nil).Once()
+
+	// Disabled types are not sent
+	require.NoError(t, service.Notify(context.Background(), testWallet, Notification{Type: model.NotificationTokenDump}))
+	assert.Empty(t, sender.sent)
+	require.Len(t, log.deliveries, 1)
+	assert.Equal(t, model.NotificationDeliverySuppressed, log.deliveries[0].Status)
+	assert.Equal(t, "type is disabled", log.deliveries[0].Detail)
+
+	require.NoError(t, service.Notify(context.Background(), testWallet, Notification{
+		Type:  model.NotificationTradeConfirmed,
+		Title: "Trade confirmed",
+		Data:  map[string]string{"trade_id": "7"},
+	}))
+	require.Len(t, sender.sent, 1)
+	assert.Equal(t, []string{"fresh", "stale"}, sender.tokens[0])
+	assert.Equal(t, map[string]string{
+		"trade_id":       "7",
+		"type":           model.NotificationTradeConfirmed,
+		"wallet_address": testWallet,
+	}, sender.sent[0].Data)
+	require.Len(t, log.deliveries, 2)
+	assert.Equal(t, model.NotificationDeliverySent, log.deliveries[1].Status)
+	assert.Equal(t, 2, log.deliveries[1].Devices)
+}
+
+func TestBroadcast(t *testing.T) {
+	sender := &recordingSender{}
+	service, prefs, log := newTestService(t, sender)
+	service.now = func() time.Time { return time.Date(2025, 1, 15, 23, 0, 0, 0, time.UTC) }
+	prefs.EXPECT().List(mock.Anything, mock.Anything).Return([]model.NotificationPreferences{
+		{WalletAddress: "awake", DeviceTokens: []string{"a"}},
+		{WalletAddress: "asleep", DeviceTokens: []string{"b"}, QuietHoursStart: "22:00", QuietHoursEnd: "07:00"},
+		{WalletAddress: "opted-out", DeviceTokens: []string{"c"}, Channel: model.NotificationChannelNone},
+		{WalletAddress: "no-devices"},
+	}, int32(4), nil)
+
+	_, err := service.Broadcast(context.Background(), " ", "", nil)
+	assert.ErrorIs(t, err, ErrInvalidPreferences)
+
+	result, err := service.Broadcast(context.Background(), "Scheduled maintenance", "Trading pauses at 02:00 UTC.", nil)
+	require.NoError(t, err)
+	assert.Equal(t, BroadcastResult{Wallets: 3, Sent: 1, Suppressed: 2}, *result)
+	require.Len(t, sender.sent, 1)
+	assert.Equal(t, model.NotificationAnnouncement, sender.sent[0].Type)
+	require.Len(t, log.deliveries, 3)
+	assert.Equal(t, "quiet hours", log.deliveries[1].Detail)
+}
@@ -0,0 +1,97 @@
+package payment
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/wallet"
+	"github.com/nicolas-martin/dankfolio/backend/internal/util/money"
+)
+
+// maxTransactionRequestBody bounds the POST body, which only carries an account
+const maxTransactionRequestBody = 4 << 10
+
+// TransactionRequestHandler serves Solana Pay transaction requests under
+// TransactionRequestPath. Wallets GET the label and icon to show, then POST
+// the payer's account and receive the unsigned transfer to sign.
+func (s *Service) TransactionRequestHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+TransactionRequestPath+"{id}", s.handleTransactionRequestInfo)
+	mux.HandleFunc("POST "+TransactionRequestPath+"{id}", s.handleTransactionRequest)
+	return mux
+}
+
+func (s *Service) handleTransactionRequestInfo(w http.ResponseWriter, r *http.Request) {
+	request, ok := s.payableRequest(w, r)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]string{"label": request.Label, "icon": s.config.IconURL})
+}
+
+func (s *Service) handleTransactionRequest(w http.ResponseWriter, r *http.Request) {
+	request, ok := s.payableRequest(w, r)
+	if !ok {
+		return
+	}
+	var body struct {
+		Account string `json:"account"`
+	}
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxTransactionRequestBody)).Decode(&body); err != nil || body.Account == "" {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"message": "account is required"})
+		return
+	}
+	if s.transfers == nil {
+		writeJSON(w, http.StatusNotFound, map[string]string{"message": "transaction requests are disabled"})
+		return
+	}
+
+	amount, err := money.Parse(request.Amount)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "Stored payment request has an invalid amount", "id", request.ID, "error", err)
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"message": "failed to prepare payment"})
+		return
+	}
+	transaction, err := s.transfers.PrepareTransfer(r.Context(), body.Account, request.Recipient, request.Mint, money.Float(amount), wallet.TransferOptions{
+		Memo:       request.Memo,
+		References: []string{request.Reference},
+	})
+	if err != nil {
+		slog.WarnContext(r.Context(), "Failed to prepare payment transaction", "id", request.ID, "account", body.Account, "error", err)
+		writeJSON(w, http.StatusBadRequest, map[string]string{"message": "failed to prepare payment"})
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]string{"transaction": transaction, "message": request.Message})
+}
+
+// payableRequest loads the request named in the path, answering with an
+// error when it does not exist or can no longer be paid
+func (s *Service) payableRequest(w http.ResponseWriter, r *http.Request) (*model.PaymentRequest, bool) {
+	request, err := s.store.PaymentRequests().Get(r.Context(), r.PathValue("id"))
+	if errors.Is(err, db.ErrNotFound) {
+		writeJSON(w, http.StatusNotFound, map[string]string{"message": "payment request not found"})
+		return nil, false
+	}
+	if err != nil {
+		slog.ErrorContext(r.Context(), "Failed to load payment request", "error", err)
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"message": "failed to load payment request"})
+		return nil, false
+	}
+	if request.Status != model.PaymentStatusPending || s.now().After(request.ExpiresAt) {
+		writeJSON(w, http.StatusGone, map[string]string{"message": "payment request is no longer payable"})
+		return nil, false
+	}
+	return request, true
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		slog.Warn("Failed to write response", "error", err)
+	}
+}
@@ -0,0 +1,219 @@
+// Package payment lets merchants accept payments through Solana Pay. A
+// payment request gets a fresh reference key; the payer's wallet adds it to
+// the transfer, and the payment is found on chain by scanning for it.
+package payment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/google/uuid"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	bmodel "github.com/nicolas-martin/dankfolio/backend/internal/model/blockchain"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/wallet"
+	"github.com/nicolas-martin/dankfolio/backend/internal/util/money"
+)
+
+const (
+	// DefaultRequestTTL is how long a payment request can be paid
+	DefaultRequestTTL = 30 * time.Minute
+
+	// signaturesPerCheck is how many of the reference's newest transactions a
+	// status check looks at. Only the payer's wallet should use the reference,
+	// so more than one is already unusual.
+	signaturesPerCheck = 20
+	// maxLabelLength bounds the label and message shown in the payer's wallet
+	maxLabelLength = 128
+)
+
+// ErrInvalidPaymentRequest is returned when a payment request fails validation.
+var ErrInvalidPaymentRequest = errors.New("invalid payment request")
+
+// Chain is what finding a payment on chain needs. The Solana client
+// implements it.
+type Chain interface {
+	GetSignaturesForAddress(ctx context.Context, address bmodel.Address, limit int, commitment string) ([]bmodel.SignatureInfo, error)
+	GetTransaction(ctx context.Context, signature bmodel.Signature, commitment string) (*bmodel.TransactionDetail, error)
+}
+
+// TransferBuilder prepares the unsigned transfer a transaction request hands
+// the payer's wallet. The wallet service implements it.
+type TransferBuilder interface {
+	PrepareTransfer(ctx context.Context, fromAddress, toAddress, coinMintAddress string, amount float64, opts wallet.TransferOptions) (string, error)
+}
+
+// Config holds payment request settings. Zero values use the defaults.
+type Config struct {
+	// LinkBaseURL is the public https base the transaction request endpoint is
+	// served under. Empty disables transaction requests; transfer requests
+	// work without it.
+	LinkBaseURL string
+	IconURL     string        // Shown by wallets for transaction requests
+	RequestTTL  time.Duration // How long a request can be paid
+	Commitment  string        // Level a payment must reach to count
+}
+
+// Service creates payment requests and checks whether they were paid.
+type Service struct {
+	config    Config
+	store     db.Store
+	chain     Chain
+	transfers TransferBuilder
+	now       func() time.Time
+}
+
+// NewService creates a payment service. transfers may be nil when
+// transaction requests are disabled.
+func NewService(config Config, store db.Store, chain Chain, transfers TransferBuilder) *Service {
+	if config.RequestTTL <= 0 {
+		config.RequestTTL = DefaultRequestTTL
+	}
+	if config.Commitment == "" {
+		config.Commitment = bmodel.DefaultCommitment
+	}
+	config.LinkBaseURL = strings.TrimSuffix(config.LinkBaseURL, "/")
+	return &Service{
+		config:    config,
+		store:     store,
+		chain:     chain,
+		transfers: transfers,
+		now:       time.Now,
+	}
+}
+
+// CreatePaymentRequestParams describes a payment a merchant wants to receive.
+type CreatePaymentRequestParams struct {
+	Recipient string
+	Mint      string // SPL token to be paid in; empty for SOL
+	Amount    string // Decimal amount in token units
+	Label     string // Merchant name shown by the wallet
+	Message   string // Shown by the wallet, e.g. the order description
+	Memo      string // Recorded on chain with the payment
+}
+
+// CreatePaymentRequest stores a new payment request with a fresh reference key.
+func (s *Service) CreatePaymentRequest(ctx context.Context, params CreatePaymentRequestParams) (*model.PaymentRequest, error) {
+	if err := validate(params); err != nil {
+		return nil, err
+	}
+	if params.Mint == model.SolMint || params.Mint == model.NativeSolMint {
+		params.Mint = ""
+	}
+
+	now := s.now()
+	request := &model.PaymentRequest{
+		ID:        uuid.NewString(),
+		Recipient: params.Recipient,
+		Mint:      params.Mint,
+		Amount:    params.Amount,
+		Reference: solana.NewWallet().PublicKey().String(),
+		Label:     params.Label,
+		Message:   params.Message,
+		Memo:      params.Memo,
+		Status:    model.PaymentStatusPending,
+		CreatedAt: now,
+		ExpiresAt: now.Add(s.config.RequestTTL),
+	}
+	if err := s.store.PaymentRequests().Create(ctx, request); err != nil {
+		return nil, fmt.Errorf("failed to store payment request: %w", err)
+	}
+	slog.InfoContext(ctx, "Payment request created",
+		"id", request.ID, "recipient", request.Recipient, "mint", request.Mint, "amount", request.Amount)
+	return request, nil
+}
+
+func validate(params CreatePaymentRequestParams) error {
+	if _, err := solana.PublicKeyFromBase58(params.Recipient); err != nil {
+		return fmt.Errorf("%w: invalid recipient address", ErrInvalidPaymentRequest)
+	}
+	if params.Mint != "" {
+		if _, err := solana.PublicKeyFromBase58(params.Mint); err != nil {
+			return fmt.Errorf("%w: invalid mint address", ErrInvalidPaymentRequest)
+		}
+	}
+	amount, err := money.Parse(params.Amount)
+	if err != nil || !amount.IsPositive() {
+		return fmt.Errorf("%w: amount must be a positive decimal", ErrInvalidPaymentRequest)
+	}
+	if utf8.RuneCountInString(params.Label) > maxLabelLength || utf8.RuneCountInString(params.Message) > maxLabelLength {
+		return fmt.Errorf("%w: label and message must be at most %d characters", ErrInvalidPaymentRequest, maxLabelLength)
+	}
+	if len(params.Memo) > wallet.MaxMemoBytes {
+		return fmt.Errorf("%w: memo must be at most %d bytes", ErrInvalidPaymentRequest, wallet.MaxMemoBytes)
+	}
+	return nil
+}
+
+// GetPaymentStatus returns the payment request, first looking for its
+// payment on chain if it is still pending. A request nobody paid expires
+// after the TTL and is not checked again.
+func (s *Service) GetPaymentStatus(ctx context.Context, id string) (*model.PaymentRequest, error) {
+	request, err := s.store.PaymentRequests().Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if request.Status != model.PaymentStatusPending {
+		return request, nil
+	}
+
+	payment, err := s.findPayment(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case payment != nil:
+		request.Status = model.PaymentStatusPaid
+		request.Signature = string(payment.Signature)
+		request.Payer = string(payment.Accounts[0])
+		request.PaidAt = payment.BlockTime
+		if request.PaidAt.IsZero() {
+			request.PaidAt = s.now()
+		}
+		slog.InfoContext(ctx, "Payment request paid", "id", request.ID, "signature", request.Signature)
+	case s.now().After(request.ExpiresAt):
+		request.Status = model.PaymentStatusExpired
+	default:
+		return request, nil
+	}
+	if err := s.store.PaymentRequests().Update(ctx, request); err != nil {
+		return nil, fmt.Errorf("failed to update payment request: %w", err)
+	}
+	return request, nil
+}
+
+// findPayment returns the oldest transaction carrying the request's reference
+// that pays it in full, or nil if there is none yet
+func (s *Service) findPayment(ctx context.Context, request *model.PaymentRequest) (*bmodel.TransactionDetail, error) {
+	signatures, err := s.chain.GetSignaturesForAddress(ctx, bmodel.Address(request.Reference), signaturesPerCheck, s.config.Commitment)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up payment reference: %w", err)
+	}
+	for _, signature := range slices.Backward(signatures) {
+		if signature.Err != nil {
+			continue
+		}
+		tx, err := s.chain.GetTransaction(ctx, signature.Signature, s.config.Commitment)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get payment transaction: %w", err)
+		}
+		if tx == nil {
+			continue
+		}
+		if err := Validate(request, tx); err != nil {
+			slog.WarnContext(ctx, "Transaction with payment reference does not pay the request",
+				"id", request.ID, "signature", signature.Signature, "reason", err)
+			continue
+		}
+		return tx, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,189 @@
+package payment
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	dbmocks "github.com/nicolas-martin/dankfolio/backend/internal/db/mocks"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	bmodel "github.com/nicolas-martin/dankfolio/backend/internal/model/blockchain"
+)
+
+const (
+	testRecipient = "9WzDXwBbmkg8ZTbNMqUxvQRAyrZzDsGYdLVL9zYtAWWM"
+	testPayer     = "4Nd1mBQtrMJVYVfKf2PJy9NZUZdTAsp7D4xWLs4gDB4T"
+	testMint      = "EPjFWdd5AufqSSqeM2qFJEpgbxSr6Ljp4kAHEwV9JAzq"
+	testReference = "GHPRXcP1kbKUnamQB9Si2sjoVsFRaVtBLt2ja5k9MRoV"
+)
+
+type fakeChain struct {
+	signatures []bmodel.SignatureInfo
+	txs        map[bmodel.Signature]*bmodel.TransactionDetail
+}
+
+func (c *fakeChain) GetSignaturesForAddress(_ context.Context, _ bmodel.Address, _ int, _ string) ([]bmodel.SignatureInfo, error) {
+	return c.signatures, nil
+}
+
+func (c *fakeChain) GetTransaction(_ context.Context, signature bmodel.Signature, _ string) (*bmodel.TransactionDetail, error) {
+	return c.txs[signature], nil
+}
+
+func solPayment(signature bmodel.Signature, lamports uint64) *bmodel.TransactionDetail {
+	return &bmodel.TransactionDetail{
+		Signature:    signature,
+		Accounts:     []bmodel.Address{testPayer, testRecipient, testReference},
+		PreBalances:  []uint64{5_000_000_000, 1_000_000_000, 0},
+		PostBalances: []uint64{5_000_000_000 - lamports - 5000, 1_000_000_000 + lamports, 0},
+	}
+}
+
+func TestTransferRequestURL(t *testing.T) {
+	request := &model.PaymentRequest{
+		Recipient: testRecipient,
+		Mint:      testMint,
+		Amount:    "1.5",
+		Reference: testReference,
+		Label:     "Dank Shop",
+		Message:   "Order #42",
+	}
+	assert.Equal(t,
+		"solana:"+testRecipient+"?amount=1.5&spl-token="+testMint+"&reference="+testReference+"&label=Dank%20Shop&message=Order%20%2342",
+		TransferRequestURL(request))
+
+	svc := NewService(Config{}, nil, nil, nil)
+	assert.Empty(t, svc.TransactionRequestURL(&model.PaymentRequest{ID: "abc"}))
+
+	svc = NewService(Config{LinkBaseURL: "https://api.example.com/"}, nil, nil, nil)
+	assert.Equal(t, "solana:https%3A%2F%2Fapi.example.com%2Fsolana-pay%2Fabc", svc.TransactionRequestURL(&model.PaymentRequest{ID: "abc"}))
+}
+
+func TestValidate(t *testing.T) {
+	solRequest := &model.PaymentRequest{Recipient: testRecipient, Amount: "0.5"}
+	tokenRequest := &model.PaymentRequest{Recipient: testRecipient, Mint: testMint, Amount: "10", Memo: "order-42"}
+	tokenPayment := func(amount string, memos ...string) *bmodel.TransactionDetail {
+		return &bmodel.TransactionDetail{
+			Accounts:          []bmodel.Address{testPayer},
+			Memos:             memos,
+			PreTokenBalances:  []bmodel.TokenBalance{{Mint: testMint, Owner: testRecipient, Amount: "1000000", Decimals: 6}},
+			PostTokenBalances: []bmodel.TokenBalance{{Mint: testMint, Owner: testRecipient, Amount: amount, Decimals: 6}},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		request *model.PaymentRequest
+		tx      *bmodel.TransactionDetail
+		wantErr bool
+	}{
+		{"sol exact", solRequest, solPayment("a", 500_000_000), false},
+		{"sol overpaid", solRequest, solPayment("a", 600_000_000), false},
+		{"sol underpaid", solRequest, solPayment("a", 499_999_999), true},
+		{"sol failed", solRequest, func() *bmodel.TransactionDetail {
+			tx := solPayment("a", 500_000_000)
+			tx.Err = "InstructionError"
+			return tx
+		}(), true},
+		{"token paid", tokenRequest, tokenPayment("11000000", "order-42"), false},
+		{"token underpaid", tokenRequest, tokenPayment("10999999", "order-42"), true},
+		{"token wrong memo", tokenRequest, tokenPayment("11000000", "order-41"), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.request, tt.tx)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCreatePaymentRequest_RejectsInvalidParams(t *testing.T) {
+	svc := NewService(Config{}, dbmocks.NewMockStore(t), nil, nil)
+	for _, params := range []CreatePaymentRequestParams{
+		{Recipient: "not-an-address", Amount: "1"},
+		{Recipient: testRecipient, Amount: "0"},
+		{Recipient: testRecipient, Amount: "1", Mint: "bad"},
+	} {
+		_, err := svc.CreatePaymentRequest(context.Background(), params)
+		assert.ErrorIs(t, err, ErrInvalidPaymentRequest)
+	}
+}
+
+func TestGetPaymentStatus(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	pending := func() *model.PaymentRequest {
+		return &model.PaymentRequest{
+			ID:        "req-1",
+			Recipient: testRecipient,
+			Amount:    "0.5",
+			Reference: testReference,
+			Status:    model.PaymentStatusPending,
+			ExpiresAt: now.Add(time.Minute),
+		}
+	}
+
+	t.Run("marks the first valid payment as paid", func(t *testing.T) {
+		store := dbmocks.NewMockStore(t)
+		repo := dbmocks.NewMockRepository[model.PaymentRequest](t)
+		store.EXPECT().PaymentRequests().Return(repo)
+		repo.EXPECT().Get(mock.Anything, "req-1").Return(pending(), nil)
+		repo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(r *model.PaymentRequest) bool {
+			return r.Status == model.PaymentStatusPaid && r.Signature == "good" && r.Payer == testPayer
+		})).Return(nil)
+
+		// Newest first, as the RPC returns them
+		chain := &fakeChain{
+			signatures: []bmodel.SignatureInfo{{Signature: "good"}, {Signature: "short"}, {Signature: "failed", Err: "err"}},
+			txs: map[bmodel.Signature]*bmodel.TransactionDetail{
+				"good":  solPayment("good", 500_000_000),
+				"short": solPayment("short", 1),
+			},
+		}
+		svc := NewService(Config{}, store, chain, nil)
+		svc.now = func() time.Time { return now }
+
+		request, err := svc.GetPaymentStatus(context.Background(), "req-1")
+		require.NoError(t, err)
+		assert.Equal(t, model.PaymentStatusPaid, request.Status)
+		assert.Equal(t, now, request.PaidAt)
+	})
+
+	t.Run("stays pending before expiry", func(t *testing.T) {
+		store := dbmocks.NewMockStore(t)
+		repo := dbmocks.NewMockRepository[model.PaymentRequest](t)
+		store.EXPECT().PaymentRequests().Return(repo)
+		repo.EXPECT().Get(mock.Anything, "req-1").Return(pending(), nil)
+
+		svc := NewService(Config{}, store, &fakeChain{}, nil)
+		svc.now = func() time.Time { return now }
+
+		request, err := svc.GetPaymentStatus(context.Background(), "req-1")
+		require.NoError(t, err)
+		assert.Equal(t, model.PaymentStatusPending, request.Status)
+	})
+
+	t.Run("expires unpaid requests", func(t *testing.T) {
+		store := dbmocks.NewMockStore(t)
+		repo := dbmocks.NewMockRepository[model.PaymentRequest](t)
+		store.EXPECT().PaymentRequests().Return(repo)
+		repo.EXPECT().Get(mock.Anything, "req-1").Return(pending(), nil)
+		repo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(r *model.PaymentRequest) bool {
+			return r.Status == model.PaymentStatusExpired
+		})).Return(nil)
+
+		svc := NewService(Config{}, store, &fakeChain{}, nil)
+		svc.now = func() time.Time { return now.Add(time.Hour) }
+
+		request, err := svc.GetPaymentStatus(context.Background(), "req-1")
+		require.NoError(t, err)
+		assert.Equal(t, model.PaymentStatusExpired, request.Status)
+	})
+}
@@ -0,0 +1,51 @@
+package payment
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+// TransactionRequestPath is where the transaction request endpoint is served,
+// followed by the payment request ID
+const TransactionRequestPath = "/solana-pay/"
+
+// TransferRequestURL is the Solana Pay transfer request for request. Any
+// Solana Pay wallet can pay it without talking to us.
+func TransferRequestURL(request *model.PaymentRequest) string {
+	var b strings.Builder
+	b.WriteString("solana:")
+	b.WriteString(request.Recipient)
+	b.WriteString("?amount=")
+	b.WriteString(escape(request.Amount))
+	if request.Mint != "" {
+		b.WriteString("&spl-token=")
+		b.WriteString(escape(request.Mint))
+	}
+	b.WriteString("&reference=")
+	b.WriteString(escape(request.Reference))
+	for _, param := range [][2]string{{"label", request.Label}, {"message", request.Message}, {"memo", request.Memo}} {
+		if param[1] != "" {
+			b.WriteString("&" + param[0] + "=")
+			b.WriteString(escape(param[1]))
+		}
+	}
+	return b.String()
+}
+
+// TransactionRequestURL is the Solana Pay transaction request for request,
+// or empty when no LinkBaseURL is configured. The wallet fetches the
+// transaction to sign from our endpoint.
+func (s *Service) TransactionRequestURL(request *model.PaymentRequest) string {
+	if s.config.LinkBaseURL == "" {
+		return ""
+	}
+	return "solana:" + escape(s.config.LinkBaseURL+TransactionRequestPath+request.ID)
+}
+
+// escape percent-encodes a value the way encodeURIComponent does, which is
+// what Solana Pay wallets decode
+func escape(value string) string {
+	return strings.ReplaceAll(url.QueryEscape(value), "+", "%20")
+}
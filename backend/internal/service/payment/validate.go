@@ -0,0 +1,88 @@
+package payment
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	bmodel "github.com/nicolas-martin/dankfolio/backend/internal/model/blockchain"
+	"github.com/nicolas-martin/dankfolio/backend/internal/util/money"
+)
+
+// solDecimals scales lamports to SOL
+const solDecimals = 9
+
+// Validate checks that tx pays request: it succeeded, carries the request's
+// memo if it has one, and moved at least the requested amount to the
+// recipient. Paying more is accepted, as Solana Pay does.
+func Validate(request *model.PaymentRequest, tx *bmodel.TransactionDetail) error {
+	if tx.Err != nil {
+		return fmt.Errorf("transaction failed: %v", tx.Err)
+	}
+	if len(tx.Accounts) == 0 {
+		return errors.New("transaction has no accounts")
+	}
+	if request.Memo != "" && !slices.Contains(tx.Memos, request.Memo) {
+		return errors.New("memo does not match")
+	}
+
+	want, err := money.Parse(request.Amount)
+	if err != nil {
+		return fmt.Errorf("invalid requested amount: %w", err)
+	}
+	var received money.Decimal
+	if request.Mint == "" {
+		received, err = solReceived(tx, request.Recipient)
+	} else {
+		received, err = tokenReceived(tx, request.Recipient, request.Mint)
+	}
+	if err != nil {
+		return err
+	}
+	if received.LessThan(want) {
+		return fmt.Errorf("recipient received %s, %s was requested", received, want)
+	}
+	return nil
+}
+
+// solReceived is the recipient's lamport balance change in SOL
+func solReceived(tx *bmodel.TransactionDetail, recipient string) (money.Decimal, error) {
+	index := slices.Index(tx.Accounts, bmodel.Address(recipient))
+	if index < 0 || index >= len(tx.PreBalances) || index >= len(tx.PostBalances) {
+		return money.Decimal{}, errors.New("recipient is not in the transaction")
+	}
+	pre, post := tx.PreBalances[index], tx.PostBalances[index]
+	if post <= pre {
+		return money.FromInt(0), nil
+	}
+	return money.FromBaseUnits(post-pre, solDecimals), nil
+}
+
+// tokenReceived is the change across all of the recipient's token accounts
+// for mint, so a payment into an account other than the ATA still counts
+func tokenReceived(tx *bmodel.TransactionDetail, recipient, mint string) (money.Decimal, error) {
+	total := func(balances []bmodel.TokenBalance) (money.Decimal, error) {
+		sum := money.FromInt(0)
+		for _, balance := range balances {
+			if balance.Owner != bmodel.Address(recipient) || balance.Mint != bmodel.Address(mint) {
+				continue
+			}
+			amount, err := money.ParseBaseUnits(balance.Amount, balance.Decimals)
+			if err != nil {
+				return money.Decimal{}, fmt.Errorf("invalid token balance: %w", err)
+			}
+			sum = sum.Add(amount)
+		}
+		return sum, nil
+	}
+	pre, err := total(tx.PreTokenBalances)
+	if err != nil {
+		return money.Decimal{}, err
+	}
+	post, err := total(tx.PostTokenBalances)
+	if err != nil {
+		return money.Decimal{}, err
+	}
+	return post.Sub(pre), nil
+}
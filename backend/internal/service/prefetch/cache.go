@@ -0,0 +1,51 @@
+package prefetch
+
+import (
+	"strings"
+	"time"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/cache"
+)
+
+// KeyAddress extracts the coin address from a cache key; ok is false for keys
+// that are not about a single coin
+type KeyAddress func(key string) (address string, ok bool)
+
+// PrefixedKey matches keys of the form prefix + address, such as "coin:<address>"
+func PrefixedKey(prefix string) KeyAddress {
+	return func(key string) (string, bool) {
+		return strings.CutPrefix(key, prefix)
+	}
+}
+
+// PriceHistoryKey matches the price history cache keys, "<address>-<timeframe>"
+func PriceHistoryKey(key string) (string, bool) {
+	address, _, ok := strings.Cut(key, "-")
+	return address, ok
+}
+
+// instrumentedCache counts lookups by whether the coin was prefetched
+type instrumentedCache[T any] struct {
+	cache.GenericCache[T]
+	name      string
+	addressOf KeyAddress
+	prefetch  *Service
+}
+
+// InstrumentCache wraps a detail cache so its hit rate for prefetched coins
+// can be compared to the rest in dankfolio.prefetch.cache_lookups_total
+func InstrumentCache[T any](inner cache.GenericCache[T], name string, addressOf KeyAddress, prefetch *Service) cache.GenericCache[T] {
+	return &instrumentedCache[T]{GenericCache: inner, name: name, addressOf: addressOf, prefetch: prefetch}
+}
+
+func (c *instrumentedCache[T]) Get(key string) (T, bool) {
+	value, hit := c.GenericCache.Get(key)
+	if address, ok := c.addressOf(key); ok {
+		c.prefetch.recordLookup(c.name, address, hit)
+	}
+	return value, hit
+}
+
+func (c *instrumentedCache[T]) Set(key string, data T, expiration time.Duration) {
+	c.GenericCache.Set(key, data, expiration)
+}
@@ -0,0 +1,287 @@
+// Package prefetch warms the coin detail caches for the top trending coins
+// right after each trending refresh, so the detail screens users open from
+// the trending list are served from cache instead of cold upstream calls.
+package prefetch
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	pb "github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1"
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/birdeye"
+	"github.com/nicolas-martin/dankfolio/backend/internal/events"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/price"
+)
+
+const (
+	// DefaultTopN is how many trending coins are prefetched.
+	DefaultTopN = 10
+	// DefaultConcurrency is how many coins are prefetched at once.
+	DefaultConcurrency = 4
+
+	// coinTimeout bounds the prefetch of one coin
+	coinTimeout = 30 * time.Second
+)
+
+// DefaultTimeframes are the price history timeframes prefetched; the detail
+// screen opens on the four hour chart
+var DefaultTimeframes = []pb.GetPriceHistoryRequest_PriceHistoryType{pb.GetPriceHistoryRequest_FOUR_HOUR}
+
+// CoinDetails loads the coin data shown on the detail screen
+type CoinDetails interface {
+	GetCoinByAddress(ctx context.Context, address string) (*model.Coin, error)
+	GetCoinTradeStats(ctx context.Context, address string) (*model.CoinTradeStats, error)
+}
+
+// PriceHistory loads the detail screen chart
+type PriceHistory interface {
+	GetPriceHistory(ctx context.Context, address string, config price.BackendTimeframeConfig, time, addressType string) (*birdeye.PriceHistory, error)
+	Timeframe(historyType pb.GetPriceHistoryRequest_PriceHistoryType) (price.BackendTimeframeConfig, bool)
+}
+
+// Config tunes the prefetcher. Zero values use the defaults.
+type Config struct {
+	TopN        int
+	Concurrency int
+	Timeframes  []pb.GetPriceHistoryRequest_PriceHistoryType
+}
+
+// Service prefetches detail data for trending coins and tracks which coins
+// were prefetched, so cache lookups can be split by prefetched or not.
+type Service struct {
+	coins  CoinDetails
+	prices PriceHistory
+	config Config
+
+	pending chan []string // Latest trending list not yet prefetched
+
+	mu         sync.RWMutex
+	warming    map[string]bool // Coins being prefetched; their lookups are not counted
+	prefetched map[string]bool // Coins warmed by the last run
+
+	requests metric.Int64Counter
+	duration metric.Float64Histogram
+	lookups  metric.Int64Counter
+	now      func() time.Time
+}
+
+// NewService creates a prefetcher. Call SetSources before Run.
+func NewService(config Config) *Service {
+	if config.TopN <= 0 {
+		config.TopN = DefaultTopN
+	}
+	if config.Concurrency <= 0 {
+		config.Concurrency = DefaultConcurrency
+	}
+	if len(config.Timeframes) == 0 {
+		config.Timeframes = DefaultTimeframes
+	}
+
+	meter := otel.Meter("github.com/nicolas-martin/dankfolio/backend/internal/service/prefetch")
+	requests, err := meter.Int64Counter(
+		"dankfolio.prefetch.requests_total",
+		metric.WithDescription("Detail data prefetched for trending coins by kind and result"),
+		metric.WithUnit("{request}"),
+	)
+	if err != nil {
+		slog.Warn("Failed to create prefetch request counter", "error", err)
+	}
+	duration, err := meter.Float64Histogram(
+		"dankfolio.prefetch.run.duration",
+		metric.WithDescription("Duration of prefetching the trending coins after a refresh"),
+		metric.WithUnit("ms"),
+	)
+	if err != nil {
+		slog.Warn("Failed to create prefetch duration histogram", "error", err)
+	}
+	lookups, err := meter.Int64Counter(
+		"dankfolio.prefetch.cache_lookups_total",
+		metric.WithDescription("Detail cache lookups by cache, result and whether the coin was prefetched"),
+		metric.WithUnit("{lookup}"),
+	)
+	if err != nil {
+		slog.Warn("Failed to create prefetch cache lookup counter", "error", err)
+	}
+
+	return &Service{
+		config:     config,
+		pending:    make(chan []string, 1),
+		warming:    make(map[string]bool),
+		prefetched: make(map[string]bool),
+		requests:   requests,
+		duration:   duration,
+		lookups:    lookups,
+		now:        time.Now,
+	}
+}
+
+// SetSources sets the services whose caches are warmed
+func (s *Service) SetSources(coins CoinDetails, prices PriceHistory) {
+	s.coins = coins
+	s.prices = prices
+}
+
+// Run prefetches after every trending refresh published on bus until ctx is
+// cancelled. A refresh that arrives mid-run replaces any refresh still queued.
+func (s *Service) Run(ctx context.Context, bus events.Bus) {
+	unsubscribe := bus.Subscribe(s.handleEvent, events.TrendingRefreshed)
+	defer unsubscribe()
+	slog.InfoContext(ctx, "Trending prefetch started", slog.Int("top_n", s.config.TopN))
+
+	for {
+		select {
+		case addresses := <-s.pending:
+			s.Prefetch(ctx, addresses)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *Service) handleEvent(ctx context.Context, event events.Event) {
+	payload, ok := event.Payload.(events.TrendingPayload)
+	if !ok {
+		return
+	}
+	// The bus delivers one event at a time, so after draining there is room
+	select {
+	case <-s.pending:
+		slog.DebugContext(ctx, "Replacing queued trending prefetch with a newer refresh")
+	default:
+	}
+	s.pending <- payload.Addresses
+}
+
+// Prefetch warms the detail caches for the first TopN addresses
+func (s *Service) Prefetch(ctx context.Context, addresses []string) {
+	top := addresses[:min(len(addresses), s.config.TopN)]
+	if len(top) == 0 || s.coins == nil || s.prices == nil {
+		return
+	}
+	start := s.now()
+	s.setWarming(top, true)
+	defer s.setWarming(top, false)
+
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		warmed = make(map[string]bool, len(top))
+		sem    = make(chan struct{}, s.config.Concurrency)
+	)
+	for _, address := range top {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			wg.Wait()
+			return
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if s.prefetchCoin(ctx, address) {
+				mu.Lock()
+				warmed[address] = true
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	s.mu.Lock()
+	s.prefetched = warmed
+	s.mu.Unlock()
+
+	elapsed := s.now().Sub(start)
+	if s.duration != nil {
+		s.duration.Record(ctx, float64(elapsed)/float64(time.Millisecond))
+	}
+	slog.InfoContext(ctx, "Prefetched trending coin details", slog.Int("coins", len(top)), slog.Int("warmed", len(warmed)), slog.Duration("duration", elapsed))
+}
+
+// prefetchCoin loads every detail cache for address and reports whether all succeeded
+func (s *Service) prefetchCoin(ctx context.Context, address string) bool {
+	ctx, cancel := context.WithTimeout(ctx, coinTimeout)
+	defer cancel()
+
+	ok := s.record(ctx, "coin", address, func() error {
+		_, err := s.coins.GetCoinByAddress(ctx, address)
+		return err
+	})
+	ok = s.record(ctx, "trade_stats", address, func() error {
+		_, err := s.coins.GetCoinTradeStats(ctx, address)
+		return err
+	}) && ok
+	windowEnd := s.now().UTC().Format(time.RFC3339)
+	for _, timeframe := range s.config.Timeframes {
+		config, known := s.prices.Timeframe(timeframe)
+		if !known {
+			continue
+		}
+		ok = s.record(ctx, "price_history", address, func() error {
+			_, err := s.prices.GetPriceHistory(ctx, address, config, windowEnd, "token")
+			return err
+		}) && ok
+	}
+	return ok
+}
+
+func (s *Service) record(ctx context.Context, kind, address string, load func() error) bool {
+	err := load()
+	result := "ok"
+	if err != nil {
+		result = "error"
+		slog.DebugContext(ctx, "Prefetch failed", slog.String("kind", kind), slog.String("address", address), slog.Any("error", err))
+	}
+	if s.requests != nil {
+		s.requests.Add(ctx, 1, metric.WithAttributes(attribute.String("kind", kind), attribute.String("result", result)))
+	}
+	return err == nil
+}
+
+func (s *Service) setWarming(addresses []string, warming bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, address := range addresses {
+		if warming {
+			s.warming[address] = true
+		} else {
+			delete(s.warming, address)
+		}
+	}
+}
+
+// recordLookup counts a detail cache lookup for address, unless it was made
+// by the prefetcher itself
+func (s *Service) recordLookup(cacheName, address string, hit bool) {
+	s.mu.RLock()
+	warming, prefetched := s.warming[address], s.prefetched[address]
+	s.mu.RUnlock()
+	if warming || s.lookups == nil {
+		return
+	}
+
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	s.lookups.Add(context.Background(), 1, metric.WithAttributes(
+		attribute.String("cache", cacheName),
+		attribute.String("result", result),
+		attribute.Bool("prefetched", prefetched),
+	))
+}
+
+// Prefetched reports whether address was warmed by the last run
+func (s *Service) Prefetched(address string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.prefetched[address]
+}
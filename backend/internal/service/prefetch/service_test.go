@@ -0,0 +1,154 @@
+package prefetch
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1"
+	"github.com/nicolas-martin/dankfolio/backend/internal/cache"
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/birdeye"
+	"github.com/nicolas-martin/dankfolio/backend/internal/events"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/price"
+)
+
+// fakeSources records the addresses loaded and fails for the addresses in fail
+type fakeSources struct {
+	mu     sync.Mutex
+	loaded map[string][]string
+	fail   map[string]bool
+}
+
+func (f *fakeSources) load(kind, address string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.loaded == nil {
+		f.loaded = make(map[string][]string)
+	}
+	f.loaded[kind] = append(f.loaded[kind], address)
+	if f.fail[address] {
+		return errors.New("upstream failed")
+	}
+	return nil
+}
+
+func (f *fakeSources) GetCoinByAddress(_ context.Context, address string) (*model.Coin, error) {
+	return &model.Coin{Address: address}, f.load("coin", address)
+}
+
+func (f *fakeSources) GetCoinTradeStats(_ context.Context, address string) (*model.CoinTradeStats, error) {
+	return &model.CoinTradeStats{}, f.load("trade_stats", address)
+}
+
+func (f *fakeSources) GetPriceHistory(_ context.Context, address string, config price.BackendTimeframeConfig, _, _ string) (*birdeye.PriceHistory, error) {
+	return &birdeye.PriceHistory{}, f.load("price_history:"+config.HistoryType, address)
+}
+
+func (f *fakeSources) Timeframe(historyType pb.GetPriceHistoryRequest_PriceHistoryType) (price.BackendTimeframeConfig, bool) {
+	config, ok := price.TimeframeConfigMap[historyType]
+	return config, ok
+}
+
+func TestPrefetchWarmsTopN(t *testing.T) {
+	sources := &fakeSources{fail: map[string]bool{"mintB": true}}
+	s := NewService(Config{TopN: 3})
+	s.SetSources(sources, sources)
+
+	s.Prefetch(context.Background(), []string{"mintA", "mintB", "mintC", "mintD"})
+
+	for _, kind := range []string{"coin", "trade_stats", "price_history:FOUR_HOUR"} {
+		assert.ElementsMatch(t, []string{"mintA", "mintB", "mintC"}, sources.loaded[kind], kind)
+	}
+	assert.True(t, s.Prefetched("mintA"))
+	assert.False(t, s.Prefetched("mintB"), "coins that failed to prefetch are not counted as prefetched")
+	assert.True(t, s.Prefetched("mintC"))
+	assert.False(t, s.Prefetched("mintD"))
+
+	// The next run replaces the prefetched set
+	s.Prefetch(context.Background(), []string{"mintD"})
+	assert.False(t, s.Prefetched("mintA"))
+	assert.True(t, s.Prefetched("mintD"))
+}
+
+func TestRunPrefetchesOnTrendingRefresh(t *testing.T) {
+	sources := &fakeSources{}
+	s := NewService(Config{})
+	s.SetSources(sources, sources)
+	bus := events.NewMemoryBus(1)
+	defer bus.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		s.Run(ctx, bus)
+	}()
+
+	// Run subscribes asynchronously, so publish until the event is picked up
+	require.Eventually(t, func() bool {
+		bus.Publish(context.Background(), events.NewTrendingRefreshedEvent([]string{"mintA"}))
+		return s.Prefetched("mintA")
+	}, time.Second, 10*time.Millisecond)
+
+	cancel()
+	<-done
+}
+
+func TestInstrumentCache(t *testing.T) {
+	s := NewService(Config{})
+	inner := &mapCache{values: map[string]int{"coin:mintA": 1}}
+	c := InstrumentCache[int](inner, "coin", PrefixedKey("coin:"), s)
+
+	value, hit := c.Get("coin:mintA")
+	assert.True(t, hit)
+	assert.Equal(t, 1, value)
+
+	c.Set("coin:mintB", 2, time.Minute)
+	value, hit = c.Get("coin:mintB")
+	assert.True(t, hit)
+	assert.Equal(t, 2, value)
+
+	c.Delete("coin:mintB")
+	_, hit = c.Get("coin:mintB")
+	assert.False(t, hit)
+}
+
+func TestKeyAddress(t *testing.T) {
+	address, ok := PrefixedKey("trade_stats:")("trade_stats:mintA")
+	assert.True(t, ok)
+	assert.Equal(t, "mintA", address)
+
+	_, ok = PrefixedKey("coin:")("trending")
+	assert.False(t, ok)
+
+	address, ok = PriceHistoryKey("mintA-FOUR_HOUR")
+	assert.True(t, ok)
+	assert.Equal(t, "mintA", address)
+}
+
+type mapCache struct {
+	values map[string]int
+}
+
+func (m *mapCache) Get(key string) (int, bool) {
+	value, ok := m.values[key]
+	return value, ok
+}
+
+func (m *mapCache) Set(key string, data int, _ time.Duration) {
+	m.values[key] = data
+}
+
+func (m *mapCache) Delete(key string) {
+	delete(m.values, key)
+}
+
+func (m *mapCache) Stats() cache.Stats {
+	return cache.Stats{Name: "map"}
+}
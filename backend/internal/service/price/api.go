@@ -3,14 +3,22 @@ package price
 import (
 	"context"
 
+	pb "github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1"
 	"github.com/nicolas-martin/dankfolio/backend/internal/clients/birdeye"
 )
 
 // PriceServiceAPI defines the interface for price related operations.
 type PriceServiceAPI interface {
 	GetCoinPrices(ctx context.Context, tokenAddresses []string) (map[string]float64, error)
+	GetPricesBatch(ctx context.Context, tokenAddresses []string) (map[string]float64, error)
 	GetPriceHistory(ctx context.Context, address string, config BackendTimeframeConfig, time, addressType string) (*birdeye.PriceHistory, error)
+	GetPriceCandles(ctx context.Context, address string, config BackendTimeframeConfig, endTime string) (*birdeye.OHLCV, error)
 	GetPriceHistoriesByAddresses(ctx context.Context, requests []PriceHistoryBatchRequest) (map[string]*PriceHistoryBatchResult, error)
+	ConvertPrices(ctx context.Context, prices map[string]float64, currency string) (map[string]float64, string, error)
+	ConvertPriceHistory(ctx context.Context, history *birdeye.PriceHistory, currency string) (*birdeye.PriceHistory, string, error)
+	SubscribePrices(addresses []string, trades bool) (updates <-chan Update, cancel func(), err error)
+	CrossCheckPrices(ctx context.Context, addresses []string) ([]PriceDivergence, error)
+	Timeframe(historyType pb.GetPriceHistoryRequest_PriceHistoryType) (BackendTimeframeConfig, bool)
 }
 
 // PriceHistoryBatchRequest represents a single price history request within a batch
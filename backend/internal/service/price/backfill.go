@@ -0,0 +1,198 @@
+package price
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/apperrors"
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/birdeye"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+const (
+	// gapTolerance is how many consecutive buckets may be missing before the
+	// stretch is reported as a gap; single missing buckets are common for
+	// illiquid tokens and not worth a request
+	gapTolerance = 3
+
+	// maxPointsPerRequest bounds the window of one backfill request
+	maxPointsPerRequest = 1000
+
+	// DefaultBackfillRate is how many upstream requests a Backfiller makes per second
+	DefaultBackfillRate = 2
+	// maxRateLimitRetries is how often one window is retried after a 429
+	maxRateLimitRetries = 5
+	// defaultRateLimitWait is used when a 429 carries no Retry-After
+	defaultRateLimitWait = 5 * time.Second
+)
+
+// PriceGap is a window of a stored price series with no samples
+type PriceGap struct {
+	From time.Time
+	To   time.Time
+}
+
+// FindGaps returns the windows of [from, to] where points, sorted by time,
+// have no sample for more than gapTolerance buckets of resolution
+func FindGaps(points []model.PricePoint, resolution time.Duration, from, to time.Time) []PriceGap {
+	var gaps []PriceGap
+	limit := gapTolerance * resolution
+	cursor := from
+	for _, p := range points {
+		if p.Time.Before(from) || p.Time.After(to) {
+			continue
+		}
+		if p.Time.Sub(cursor) > limit {
+			gaps = append(gaps, PriceGap{From: cursor, To: p.Time})
+		}
+		cursor = p.Time
+	}
+	if to.Sub(cursor) > limit {
+		gaps = append(gaps, PriceGap{From: cursor, To: to})
+	}
+	return gaps
+}
+
+// DetectGaps scans the stored series for address at resolution for missing windows
+func (s *Service) DetectGaps(ctx context.Context, address string, resolution time.Duration, from, to time.Time) ([]PriceGap, error) {
+	points, err := s.store.ListPricePoints(ctx, address, resolution, from, to)
+	if err != nil {
+		return nil, err
+	}
+	return FindGaps(points, resolution, from, to), nil
+}
+
+// BackfillResult summarizes a Backfill run
+type BackfillResult struct {
+	Gaps      []PriceGap // Gaps found before backfilling
+	Requests  int        // Upstream requests made
+	Points    int        // Samples stored
+	Remaining []PriceGap // Gaps the provider had no data for either
+}
+
+// Backfiller re-requests the missing windows of stored price series from
+// Birdeye, pacing requests and waiting out rate limits
+type Backfiller struct {
+	service *Service
+	limiter *rate.Limiter
+}
+
+// NewBackfiller returns a Backfiller making at most requestsPerSecond upstream requests
+func NewBackfiller(service *Service, requestsPerSecond float64) *Backfiller {
+	if requestsPerSecond <= 0 {
+		requestsPerSecond = DefaultBackfillRate
+	}
+	return &Backfiller{
+		service: service,
+		limiter: rate.NewLimiter(rate.Limit(requestsPerSecond), 1),
+	}
+}
+
+// Backfill fills the gaps in the series for address at resolution within [from, to]
+func (b *Backfiller) Backfill(ctx context.Context, address string, resolution time.Duration, from, to time.Time) (*BackfillResult, error) {
+	historyType, ok := birdeyeHistoryType(resolution)
+	if !ok {
+		return nil, fmt.Errorf("no Birdeye history type for resolution %s", resolution)
+	}
+	if b.service.store == nil {
+		return nil, errors.New("price history backfill requires a store")
+	}
+
+	gaps, err := b.service.DetectGaps(ctx, address, resolution, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to detect price history gaps: %w", err)
+	}
+	result := &BackfillResult{Gaps: gaps}
+
+	for _, gap := range gaps {
+		for _, window := range splitWindow(gap, resolution*maxPointsPerRequest) {
+			params := birdeye.PriceHistoryParams{
+				Address:     address,
+				AddressType: "token",
+				HistoryType: historyType,
+				TimeFrom:    window.From,
+				TimeTo:      window.To,
+			}
+			history, requests, err := b.fetch(ctx, params)
+			result.Requests += requests
+			if err != nil {
+				return result, fmt.Errorf("failed to backfill %s to %s: %w", window.From.Format(time.RFC3339), window.To.Format(time.RFC3339), err)
+			}
+			points := toPricePoints(address, resolution, history)
+			b.service.saveFetchedHistory(ctx, address, resolution, window.From, window.To, points)
+			result.Points += len(points)
+		}
+	}
+
+	if result.Remaining, err = b.service.DetectGaps(ctx, address, resolution, from, to); err != nil {
+		return result, fmt.Errorf("failed to re-check price history gaps: %w", err)
+	}
+	slog.InfoContext(ctx, "Backfilled price history",
+		"address", address,
+		"resolution", resolution,
+		"gaps", len(result.Gaps),
+		"requests", result.Requests,
+		"points", result.Points,
+		"remaining_gaps", len(result.Remaining))
+	return result, nil
+}
+
+// fetch requests one window, retrying after rate limit responses
+func (b *Backfiller) fetch(ctx context.Context, params birdeye.PriceHistoryParams) (*birdeye.PriceHistory, int, error) {
+	for attempt := 1; ; attempt++ {
+		if err := b.limiter.Wait(ctx); err != nil {
+			return nil, attempt - 1, err
+		}
+		history, err := b.service.birdeyeClient.GetPriceHistory(ctx, params)
+		if err == nil || !errors.Is(err, apperrors.ErrUpstreamRateLimited) || attempt > maxRateLimitRetries {
+			return history, attempt, err
+		}
+
+		wait := defaultRateLimitWait * time.Duration(attempt)
+		var appErr *apperrors.Error
+		if errors.As(err, &appErr) && appErr.RetryAfter > 0 {
+			wait = appErr.RetryAfter
+		}
+		slog.WarnContext(ctx, "Price history backfill rate limited, waiting", "address", params.Address, "wait", wait, "attempt", attempt)
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return nil, attempt, ctx.Err()
+		}
+	}
+}
+
+// splitWindow splits gap into windows no longer than maxSpan
+func splitWindow(gap PriceGap, maxSpan time.Duration) []PriceGap {
+	var windows []PriceGap
+	for start := gap.From; start.Before(gap.To); start = start.Add(maxSpan) {
+		windows = append(windows, PriceGap{From: start, To: minTime(start.Add(maxSpan), gap.To)})
+	}
+	return windows
+}
+
+// birdeyeHistoryType returns the Birdeye history type with points every resolution
+func birdeyeHistoryType(resolution time.Duration) (string, bool) {
+	for historyType, r := range birdeyeResolutions {
+		if r == resolution {
+			return historyType, true
+		}
+	}
+	return "", false
+}
+
+// TierResolution returns the resolution stored history has at age, after
+// downsampling, so backfills request data at the spacing the store keeps
+func TierResolution(age time.Duration) time.Duration {
+	for _, tier := range historyTiers {
+		if tier.Retain == 0 || age < tier.Retain {
+			return tier.Resolution
+		}
+	}
+	return historyTiers[len(historyTiers)-1].Resolution
+}
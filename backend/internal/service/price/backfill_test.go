@@ -0,0 +1,98 @@
+package price
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/apperrors"
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/birdeye"
+	birdeye_mocks "github.com/nicolas-martin/dankfolio/backend/internal/clients/birdeye/mocks"
+	dbmocks "github.com/nicolas-martin/dankfolio/backend/internal/db/mocks"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+func TestFindGaps(t *testing.T) {
+	base := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	at := func(minutes int) time.Time { return base.Add(time.Duration(minutes) * time.Minute) }
+	var points []model.PricePoint
+	for _, m := range []int{0, 1, 2, 4, 20, 21, 22} {
+		points = append(points, model.PricePoint{Time: at(m)})
+	}
+
+	gaps := FindGaps(points, time.Minute, at(0), at(30))
+	assert.Equal(t, []PriceGap{
+		{From: at(4), To: at(20)},
+		{From: at(22), To: at(30)},
+	}, gaps, "a two minute hole is tolerated, longer ones are gaps")
+
+	assert.Equal(t, []PriceGap{{From: at(0), To: at(30)}}, FindGaps(nil, time.Minute, at(0), at(30)))
+}
+
+func TestSplitWindow(t *testing.T) {
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	windows := splitWindow(PriceGap{From: base, To: base.Add(25 * time.Hour)}, 10*time.Hour)
+	require.Len(t, windows, 3)
+	assert.Equal(t, base.Add(20*time.Hour), windows[2].From)
+	assert.Equal(t, base.Add(25*time.Hour), windows[2].To)
+}
+
+func TestBackfill_RetriesAfterRateLimit(t *testing.T) {
+	ctx := context.Background()
+	store := dbmocks.NewMockStore(t)
+	birdeyeClient := birdeye_mocks.NewMockClientAPI(t)
+	b := NewBackfiller(&Service{birdeyeClient: birdeyeClient, store: store}, 1000)
+
+	from := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	to := from.Add(time.Hour)
+	var filled []model.PricePoint
+	var items []birdeye.PriceHistoryItem
+	for ts := from; !ts.After(to); ts = ts.Add(5 * time.Minute) {
+		filled = append(filled, model.PricePoint{Time: ts})
+		items = append(items, birdeye.PriceHistoryItem{UnixTime: ts.Unix(), Value: 1})
+	}
+
+	store.EXPECT().ListPricePoints(ctx, "mint", 5*time.Minute, from, to).Return(nil, nil).Once()
+	rateLimited := apperrors.ErrUpstreamRateLimited.WithRetryAfter(time.Millisecond)
+	birdeyeClient.EXPECT().GetPriceHistory(ctx, mock.MatchedBy(func(p birdeye.PriceHistoryParams) bool {
+		return p.HistoryType == "5m" && p.TimeFrom.Equal(from) && p.TimeTo.Equal(to)
+	})).Return(nil, rateLimited).Once()
+	birdeyeClient.EXPECT().GetPriceHistory(ctx, mock.Anything).Return(&birdeye.PriceHistory{Data: birdeye.PriceHistoryData{Items: items}}, nil).Once()
+	store.EXPECT().UpsertPricePoints(ctx, mock.Anything).Return(nil).Once()
+	store.EXPECT().AddPriceHistoryRange(ctx, mock.Anything).Return(nil).Once()
+	store.EXPECT().ListPricePoints(ctx, "mint", 5*time.Minute, from, to).Return(filled, nil).Once()
+
+	result, err := b.Backfill(ctx, "mint", 5*time.Minute, from, to)
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Requests)
+	assert.Equal(t, len(items), result.Points)
+	assert.Len(t, result.Gaps, 1)
+	assert.Empty(t, result.Remaining)
+}
+
+func TestBackfill_GivesUpOnOtherErrors(t *testing.T) {
+	ctx := context.Background()
+	store := dbmocks.NewMockStore(t)
+	birdeyeClient := birdeye_mocks.NewMockClientAPI(t)
+	b := NewBackfiller(&Service{birdeyeClient: birdeyeClient, store: store}, 1000)
+
+	from := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	store.EXPECT().ListPricePoints(ctx, "mint", time.Hour, from, from.Add(24*time.Hour)).Return(nil, nil)
+	birdeyeClient.EXPECT().GetPriceHistory(ctx, mock.Anything).Return(nil, errors.New("boom")).Once()
+
+	result, err := b.Backfill(ctx, "mint", time.Hour, from, from.Add(24*time.Hour))
+	require.Error(t, err)
+	assert.Equal(t, 1, result.Requests)
+}
+
+func TestTierResolution(t *testing.T) {
+	assert.Equal(t, time.Minute, TierResolution(time.Hour))
+	assert.Equal(t, 5*time.Minute, TierResolution(3*24*time.Hour))
+	assert.Equal(t, time.Hour, TierResolution(30*24*time.Hour))
+	assert.Equal(t, 24*time.Hour, TierResolution(365*24*time.Hour))
+}
@@ -8,3 +8,6 @@ type PriceHistoryCache = cache.PriceHistoryCache
 
 // NewPriceHistoryCache creates a new price history cache instance
 var NewPriceHistoryCache = cache.NewPriceHistoryCache
+
+// NewCandleCache creates a new candle cache instance
+var NewCandleCache = cache.NewCandleCache
@@ -0,0 +1,85 @@
+package price
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/cache"
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/birdeye"
+)
+
+// SetCandleCache caches candles for GetPriceCandles. Without one every call
+// fetches from Birdeye.
+func (s *Service) SetCandleCache(candles cache.CandleCache) {
+	s.candles = candles
+}
+
+// GetPriceCandles returns the candles of a chart timeframe ending at
+// endTimeStr, an RFC3339 time, oldest first. Candles are cached until the
+// timeframe's rounding moves the window on.
+func (s *Service) GetPriceCandles(ctx context.Context, address string, config BackendTimeframeConfig, endTimeStr string) (*birdeye.OHLCV, error) {
+	cacheKey := fmt.Sprintf("%s-%s", address, config.HistoryType)
+	if s.candles != nil {
+		if cached, found := s.candles.Get(cacheKey); found {
+			return cached, nil
+		}
+	}
+
+	from, to, err := historyWindow(config, endTimeStr)
+	if err != nil {
+		return nil, err
+	}
+	params := birdeye.PriceHistoryParams{
+		Address:     address,
+		HistoryType: config.BirdeyeType,
+		TimeFrom:    from,
+		TimeTo:      to,
+	}
+
+	result, err, _ := s.fetchGroup.Do("candles:"+cacheKey, func() (any, error) {
+		candles, err := s.birdeyeClient.GetOHLCV(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		sanitizeCandles(ctx, address, candles, time.Now())
+		if s.candles != nil {
+			s.candles.Set(cacheKey, candles, config.Rounding)
+		}
+		return candles, nil
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to fetch candles from birdeye", "params", fmt.Sprintf("%+v", params), "error", err)
+		return nil, fmt.Errorf("failed to fetch candles from birdeye: %w", err)
+	}
+	return result.(*birdeye.OHLCV), nil
+}
+
+// historyWindow returns the span a timeframe's chart covers when it ends at
+// endTimeStr, rounded to the timeframe's rounding
+func historyWindow(config BackendTimeframeConfig, endTimeStr string) (time.Time, time.Time, error) {
+	windowEnd, err := time.Parse(time.RFC3339, endTimeStr)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("failed to parse end time: %w", err)
+	}
+	to := roundDateDown(windowEnd, config.Rounding)
+	return to.Add(-config.DefaultViewDuration), to, nil
+}
+
+// sanitizeCandles removes candles with impossible prices or timestamps in the
+// future, in place
+func sanitizeCandles(ctx context.Context, address string, candles *birdeye.OHLCV, now time.Time) {
+	latest := now.Add(maxClockSkew).Unix()
+	items := candles.Data.Items[:0]
+	for _, candle := range candles.Data.Items {
+		if validPrice(candle.Open) && validPrice(candle.High) && validPrice(candle.Low) && validPrice(candle.Close) &&
+			candle.Volume >= 0 && candle.UnixTime <= latest {
+			items = append(items, candle)
+		}
+	}
+	if dropped := len(candles.Data.Items) - len(items); dropped > 0 {
+		slog.WarnContext(ctx, "Dropping impossible candles", slog.String("address", address), slog.Int("candles", dropped))
+	}
+	candles.Data.Items = items
+}
@@ -0,0 +1,112 @@
+package price
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"maps"
+	"math"
+	"slices"
+	"sync"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/jupiter"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+// PriceDivergence is a token whose BirdEye price disagrees with Jupiter's.
+type PriceDivergence struct {
+	Address      string
+	BirdEyePrice float64
+	JupiterPrice float64
+	Divergence   float64 // Relative to the Jupiter price, e.g. 0.1 for 10%
+}
+
+// SetCrossCheckThreshold enables CrossCheckPrices: BirdEye prices that differ
+// from Jupiter's by more than threshold, a fraction such as 0.05, are
+// reported. Zero disables the cross-check.
+func (s *Service) SetCrossCheckThreshold(threshold float64) {
+	s.crossCheckThreshold = threshold
+}
+
+// CrossCheckPrices compares the BirdEye prices served for addresses with
+// Jupiter's and returns the tokens that diverge beyond the cross-check
+// threshold, logging each one. Tokens either source cannot price are skipped.
+// Nothing is checked while the cross-check is disabled.
+func (s *Service) CrossCheckPrices(ctx context.Context, addresses []string) ([]PriceDivergence, error) {
+	if s.crossCheckThreshold <= 0 || len(addresses) == 0 {
+		return nil, nil
+	}
+	if debugMode, ok := ctx.Value(model.DebugModeKey).(bool); ok && debugMode {
+		return nil, nil
+	}
+
+	mints := make([]string, 0, len(addresses))
+	for _, address := range addresses {
+		if mint := feedAddress(address); !slices.Contains(mints, mint) {
+			mints = append(mints, mint)
+		}
+	}
+
+	// Both sources are asked at once so the check adds one round trip
+	var (
+		wg                     sync.WaitGroup
+		birdeyePrices          map[string]float64
+		jupiterPrices          map[string]float64
+		birdeyeErr, jupiterErr error
+	)
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		birdeyePrices, birdeyeErr = s.GetPricesBatch(ctx, mints)
+	}()
+	go func() {
+		defer wg.Done()
+		jupiterPrices, jupiterErr = s.getJupiterPrices(ctx, mints)
+	}()
+	wg.Wait()
+	if birdeyeErr != nil {
+		return nil, fmt.Errorf("failed to get birdeye prices for cross-check: %w", birdeyeErr)
+	}
+	if jupiterErr != nil {
+		return nil, fmt.Errorf("failed to get jupiter prices for cross-check: %w", jupiterErr)
+	}
+
+	var divergences []PriceDivergence
+	for _, address := range addresses {
+		mint := feedAddress(address)
+		birdeyePrice, jupiterPrice := birdeyePrices[mint], jupiterPrices[mint]
+		if birdeyePrice <= 0 || jupiterPrice <= 0 {
+			continue
+		}
+		divergence := math.Abs(birdeyePrice-jupiterPrice) / jupiterPrice
+		if divergence <= s.crossCheckThreshold {
+			continue
+		}
+		slog.WarnContext(ctx, "BirdEye and Jupiter prices diverge",
+			slog.String("address", address),
+			slog.Float64("birdeye_price", birdeyePrice),
+			slog.Float64("jupiter_price", jupiterPrice),
+			slog.Float64("divergence", divergence),
+			slog.Float64("threshold", s.crossCheckThreshold))
+		divergences = append(divergences, PriceDivergence{
+			Address:      address,
+			BirdEyePrice: birdeyePrice,
+			JupiterPrice: jupiterPrice,
+			Divergence:   divergence,
+		})
+	}
+	return divergences, nil
+}
+
+// getJupiterPrices fetches prices from Jupiter in batches its price API accepts
+func (s *Service) getJupiterPrices(ctx context.Context, mints []string) (map[string]float64, error) {
+	prices := make(map[string]float64, len(mints))
+	for chunk := range slices.Chunk(mints, jupiter.MaxPriceIDs) {
+		chunkPrices, err := s.jupiterClient.GetCoinPrices(ctx, chunk)
+		if err != nil {
+			return nil, err
+		}
+		maps.Copy(prices, chunkPrices)
+	}
+	return prices, nil
+}
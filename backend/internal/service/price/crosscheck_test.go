@@ -0,0 +1,74 @@
+package price_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	birdeye_mocks "github.com/nicolas-martin/dankfolio/backend/internal/clients/birdeye/mocks"
+	jupitermocks "github.com/nicolas-martin/dankfolio/backend/internal/clients/jupiter/mocks"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/price"
+)
+
+func TestService_CrossCheckPrices(t *testing.T) {
+	ctx := context.Background()
+	const bonk = "DezXAZ8z7PnrnRJjz3wXBoRgixCa6xjnB7YaB1pPB263"
+
+	t.Run("reports tokens beyond the threshold", func(t *testing.T) {
+		birdeyeClient := birdeye_mocks.NewMockClientAPI(t)
+		jupiterClient := jupitermocks.NewMockClientAPI(t)
+		mints := []string{model.SolMint, bonk}
+		birdeyeClient.EXPECT().GetMultiPrice(ctx, mints).Return(map[string]float64{model.SolMint: 150, bonk: 0.000030}, nil)
+		jupiterClient.EXPECT().GetCoinPrices(ctx, mints).Return(map[string]float64{model.SolMint: 151, bonk: 0.000020}, nil)
+
+		service := price.NewService(birdeyeClient, jupiterClient, nil, nil)
+		service.SetCrossCheckThreshold(0.05)
+		divergences, err := service.CrossCheckPrices(ctx, []string{model.NativeSolMint, bonk})
+
+		require.NoError(t, err)
+		require.Len(t, divergences, 1)
+		assert.Equal(t, bonk, divergences[0].Address)
+		assert.Equal(t, 0.000030, divergences[0].BirdEyePrice)
+		assert.Equal(t, 0.000020, divergences[0].JupiterPrice)
+		assert.InDelta(t, 0.5, divergences[0].Divergence, 1e-9)
+	})
+
+	t.Run("skips tokens jupiter cannot price", func(t *testing.T) {
+		birdeyeClient := birdeye_mocks.NewMockClientAPI(t)
+		jupiterClient := jupitermocks.NewMockClientAPI(t)
+		birdeyeClient.EXPECT().GetMultiPrice(ctx, []string{bonk}).Return(map[string]float64{bonk: 0.000030}, nil)
+		jupiterClient.EXPECT().GetCoinPrices(ctx, []string{bonk}).Return(map[string]float64{}, nil)
+
+		service := price.NewService(birdeyeClient, jupiterClient, nil, nil)
+		service.SetCrossCheckThreshold(0.05)
+		divergences, err := service.CrossCheckPrices(ctx, []string{bonk})
+
+		require.NoError(t, err)
+		assert.Empty(t, divergences)
+	})
+
+	t.Run("returns jupiter errors", func(t *testing.T) {
+		birdeyeClient := birdeye_mocks.NewMockClientAPI(t)
+		jupiterClient := jupitermocks.NewMockClientAPI(t)
+		birdeyeClient.EXPECT().GetMultiPrice(ctx, []string{bonk}).Return(map[string]float64{bonk: 0.000030}, nil)
+		jupiterClient.EXPECT().GetCoinPrices(ctx, []string{bonk}).Return(nil, errors.New("jupiter down"))
+
+		service := price.NewService(birdeyeClient, jupiterClient, nil, nil)
+		service.SetCrossCheckThreshold(0.05)
+		_, err := service.CrossCheckPrices(ctx, []string{bonk})
+
+		assert.ErrorContains(t, err, "jupiter down")
+	})
+
+	t.Run("disabled without a threshold", func(t *testing.T) {
+		service := price.NewService(birdeye_mocks.NewMockClientAPI(t), jupitermocks.NewMockClientAPI(t), nil, nil)
+		divergences, err := service.CrossCheckPrices(ctx, []string{bonk})
+
+		require.NoError(t, err)
+		assert.Nil(t, divergences)
+	})
+}
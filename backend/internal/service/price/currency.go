@@ -0,0 +1,53 @@
+package price
+
+import (
+	"context"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/birdeye"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/fx"
+)
+
+// SetFX enables display currencies other than USD
+func (s *Service) SetFX(fxService *fx.Service) {
+	s.fx = fxService
+}
+
+// ConvertPrices returns USD prices quoted in currency, and the normalized
+// currency code. prices is not modified.
+func (s *Service) ConvertPrices(ctx context.Context, prices map[string]float64, currency string) (map[string]float64, string, error) {
+	converter, err := s.fx.Converter(ctx, currency)
+	if err != nil {
+		return nil, "", err
+	}
+	if converter.Currency == fx.BaseCurrency {
+		return prices, converter.Currency, nil
+	}
+
+	converted := make(map[string]float64, len(prices))
+	for address, usd := range prices {
+		converted[address] = converter.Convert(usd)
+	}
+	return converted, converter.Currency, nil
+}
+
+// ConvertPriceHistory returns a USD price history quoted in currency, and
+// the normalized currency code. history may be shared with the cache, so it
+// is copied rather than modified.
+func (s *Service) ConvertPriceHistory(ctx context.Context, history *birdeye.PriceHistory, currency string) (*birdeye.PriceHistory, string, error) {
+	converter, err := s.fx.Converter(ctx, currency)
+	if err != nil {
+		return nil, "", err
+	}
+	if converter.Currency == fx.BaseCurrency || history == nil {
+		return history, converter.Currency, nil
+	}
+
+	converted := &birdeye.PriceHistory{
+		Success: history.Success,
+		Data:    birdeye.PriceHistoryData{Items: make([]birdeye.PriceHistoryItem, len(history.Data.Items))},
+	}
+	for i, item := range history.Data.Items {
+		converted.Data.Items[i] = birdeye.PriceHistoryItem{UnixTime: item.UnixTime, Value: converter.Convert(item.Value)}
+	}
+	return converted, converter.Currency, nil
+}
@@ -0,0 +1,47 @@
+package price
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/apperrors"
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/birdeye"
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/fxrates"
+	fxratesmocks "github.com/nicolas-martin/dankfolio/backend/internal/clients/fxrates/mocks"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/fx"
+)
+
+func TestConvertToDisplayCurrency(t *testing.T) {
+	ctx := context.Background()
+	client := fxratesmocks.NewMockClientAPI(t)
+	client.EXPECT().GetLatestRates(mock.Anything).Return(&fxrates.Rates{Base: "USD", Rates: map[string]float64{"EUR": 0.8, "JPY": 150}}, nil).Once()
+	s := &Service{}
+	s.SetFX(fx.NewService(client, fx.DefaultRatesTTL))
+
+	prices := map[string]float64{"mintA": 10, "mintB": 0.5}
+	converted, currency, err := s.ConvertPrices(ctx, prices, "eur")
+	require.NoError(t, err)
+	assert.Equal(t, "EUR", currency)
+	assert.InDeltaMapValues(t, map[string]float64{"mintA": 8, "mintB": 0.4}, converted, 1e-9)
+	assert.Equal(t, 10.0, prices["mintA"], "input prices must not be modified")
+
+	history := &birdeye.PriceHistory{Success: true, Data: birdeye.PriceHistoryData{Items: []birdeye.PriceHistoryItem{{UnixTime: 100, Value: 2}}}}
+	convertedHistory, currency, err := s.ConvertPriceHistory(ctx, history, "JPY")
+	require.NoError(t, err)
+	assert.Equal(t, "JPY", currency)
+	assert.Equal(t, []birdeye.PriceHistoryItem{{UnixTime: 100, Value: 300}}, convertedHistory.Data.Items)
+	assert.Equal(t, 2.0, history.Data.Items[0].Value, "cached history must not be modified")
+
+	same, currency, err := s.ConvertPriceHistory(ctx, history, "")
+	require.NoError(t, err)
+	assert.Equal(t, "USD", currency)
+	assert.Same(t, history, same)
+
+	_, _, err = s.ConvertPrices(ctx, prices, "ABC")
+	assert.True(t, errors.Is(err, apperrors.ErrUnsupportedCurrency))
+}
@@ -0,0 +1,221 @@
+package price
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/birdeye"
+	"github.com/nicolas-martin/dankfolio/backend/internal/jobs"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+// historyTier is a resolution price history is kept at. Samples between one
+// tier and the next are averaged into the next tier once older than Retain.
+type historyTier struct {
+	Resolution time.Duration
+	Retain     time.Duration // 0 keeps samples forever
+}
+
+var historyTiers = []historyTier{
+	{Resolution: time.Minute, Retain: 24 * time.Hour},
+	{Resolution: 5 * time.Minute, Retain: 7 * 24 * time.Hour},
+	{Resolution: time.Hour, Retain: 90 * 24 * time.Hour},
+	{Resolution: 24 * time.Hour},
+}
+
+// DefaultHistoryDownsampleInterval is used when HistoryDownsampleJob is given no interval
+const DefaultHistoryDownsampleInterval = 15 * time.Minute
+
+// birdeyeResolutions maps Birdeye history types to the spacing of their points
+var birdeyeResolutions = map[string]time.Duration{
+	"1m":  time.Minute,
+	"3m":  3 * time.Minute,
+	"5m":  5 * time.Minute,
+	"15m": 15 * time.Minute,
+	"30m": 30 * time.Minute,
+	"1H":  time.Hour,
+	"2H":  2 * time.Hour,
+	"4H":  4 * time.Hour,
+	"6H":  6 * time.Hour,
+	"8H":  8 * time.Hour,
+	"12H": 12 * time.Hour,
+	"1D":  24 * time.Hour,
+}
+
+// SetPersistHistory makes price history be served from the store, fetching
+// from Birdeye only the spans that have not been fetched before
+func (s *Service) SetPersistHistory(enabled bool) {
+	s.persistHistory = enabled
+}
+
+// loadPriceHistory returns the price history for params, from the store when
+// persistence is enabled and from Birdeye otherwise
+func (s *Service) loadPriceHistory(ctx context.Context, params birdeye.PriceHistoryParams) (*birdeye.PriceHistory, error) {
+	resolution, known := birdeyeResolutions[params.HistoryType]
+	if !s.persistHistory || s.store == nil || !known {
+		return s.getBirdeyeHistory(ctx, params)
+	}
+
+	ranges, err := s.store.ListPriceHistoryRanges(ctx, params.Address, resolution, params.TimeFrom, params.TimeTo)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to load stored price history ranges, fetching from Birdeye", "address", params.Address, "error", err)
+		return s.getBirdeyeHistory(ctx, params)
+	}
+
+	var fetched []model.PricePoint
+	if gapFrom, gapTo, ok := uncoveredSpan(ranges, params.TimeFrom, params.TimeTo, resolution); ok {
+		gapParams := params
+		gapParams.TimeFrom, gapParams.TimeTo = gapFrom, gapTo
+		history, err := s.getBirdeyeHistory(ctx, gapParams)
+		if err != nil {
+			return nil, err
+		}
+		fetched = toPricePoints(params.Address, resolution, history)
+		s.saveFetchedHistory(ctx, params.Address, resolution, gapFrom, gapTo, fetched)
+	}
+
+	stored, err := s.store.ListPricePoints(ctx, params.Address, resolution, params.TimeFrom, params.TimeTo)
+	if err != nil {
+		if fetched == nil {
+			return nil, fmt.Errorf("failed to load stored price history: %w", err)
+		}
+		slog.WarnContext(ctx, "Failed to load stored price history, serving fetched points only", "address", params.Address, "error", err)
+	}
+	return toPriceHistory(append(stored, fetched...), resolution), nil
+}
+
+// getBirdeyeHistory fetches price history from Birdeye without its impossible points
+func (s *Service) getBirdeyeHistory(ctx context.Context, params birdeye.PriceHistoryParams) (*birdeye.PriceHistory, error) {
+	history, err := s.birdeyeClient.GetPriceHistory(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	sanitizeHistory(ctx, params.Address, history, time.Now())
+	return history, nil
+}
+
+// saveFetchedHistory stores fetched points and marks [from, to) as fetched.
+// The last bucket is left unmarked: Birdeye may not have published it yet, so
+// it is fetched again next time.
+func (s *Service) saveFetchedHistory(ctx context.Context, address string, resolution time.Duration, from, to time.Time, points []model.PricePoint) {
+	if err := s.store.UpsertPricePoints(ctx, points); err != nil {
+		slog.WarnContext(ctx, "Failed to store price history", "address", address, "points", len(points), "error", err)
+		return
+	}
+	coveredTo := to.Add(-resolution)
+	if !coveredTo.After(from) {
+		return
+	}
+	r := model.PriceHistoryRange{Address: address, Resolution: resolution, From: from, To: coveredTo}
+	if err := s.store.AddPriceHistoryRange(ctx, r); err != nil {
+		slog.WarnContext(ctx, "Failed to record fetched price history range", "address", address, "error", err)
+	}
+}
+
+// uncoveredSpan returns the smallest span of [from, to] holding every gap of
+// at least one resolution between the fetched ranges. Gaps are fetched in a
+// single upstream request.
+func uncoveredSpan(ranges []model.PriceHistoryRange, from, to time.Time, resolution time.Duration) (time.Time, time.Time, bool) {
+	ranges = slices.Clone(ranges)
+	slices.SortFunc(ranges, func(a, b model.PriceHistoryRange) int { return a.From.Compare(b.From) })
+
+	var gapFrom, gapTo time.Time
+	found := false
+	addGap := func(start, end time.Time) {
+		if end.Sub(start) < resolution {
+			return
+		}
+		if !found {
+			gapFrom = start
+		}
+		gapTo = end
+		found = true
+	}
+
+	cursor := from
+	for _, r := range ranges {
+		if !cursor.Before(to) {
+			break
+		}
+		if r.From.After(cursor) {
+			addGap(cursor, minTime(r.From, to))
+		}
+		if r.To.After(cursor) {
+			cursor = r.To
+		}
+	}
+	if cursor.Before(to) {
+		addGap(cursor, to)
+	}
+	return gapFrom, gapTo, found
+}
+
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
+
+func toPricePoints(address string, resolution time.Duration, history *birdeye.PriceHistory) []model.PricePoint {
+	if history == nil {
+		return []model.PricePoint{}
+	}
+	points := make([]model.PricePoint, len(history.Data.Items))
+	for i, item := range history.Data.Items {
+		points[i] = model.PricePoint{
+			Address:    address,
+			Resolution: resolution,
+			Time:       time.Unix(item.UnixTime, 0).UTC(),
+			Value:      item.Value,
+		}
+	}
+	return points
+}
+
+// toPriceHistory buckets points by resolution, keeping the latest sample in
+// each bucket, so finer stored samples line up with the requested spacing
+func toPriceHistory(points []model.PricePoint, resolution time.Duration) *birdeye.PriceHistory {
+	slices.SortStableFunc(points, func(a, b model.PricePoint) int { return a.Time.Compare(b.Time) })
+	step := int64(resolution / time.Second)
+	items := make([]birdeye.PriceHistoryItem, 0, len(points))
+	for _, p := range points {
+		bucket := p.Time.Unix() / step * step
+		if n := len(items); n > 0 && items[n-1].UnixTime == bucket {
+			items[n-1].Value = p.Value
+			continue
+		}
+		items = append(items, birdeye.PriceHistoryItem{UnixTime: bucket, Value: p.Value})
+	}
+	return &birdeye.PriceHistory{Data: birdeye.PriceHistoryData{Items: items}, Success: true}
+}
+
+// DownsampleHistory averages stored samples that have outlived their tier into the next tier
+func (s *Service) DownsampleHistory(ctx context.Context) error {
+	now := time.Now()
+	for i, tier := range historyTiers[:len(historyTiers)-1] {
+		next := historyTiers[i+1]
+		moved, err := s.store.DownsamplePricePoints(ctx, tier.Resolution, next.Resolution, now.Add(-tier.Retain))
+		if err != nil {
+			return err
+		}
+		if moved > 0 {
+			slog.InfoContext(ctx, "Downsampled price history", "from", tier.Resolution, "to", next.Resolution, "samples", moved)
+		}
+	}
+	return nil
+}
+
+// JobHistoryDownsample names the price history downsampling for scheduling
+const JobHistoryDownsample = "price.history.downsample"
+
+// HistoryDownsampleJob runs DownsampleHistory every interval
+func (s *Service) HistoryDownsampleJob(interval time.Duration) jobs.Job {
+	if interval <= 0 {
+		interval = DefaultHistoryDownsampleInterval
+	}
+	return jobs.Job{Name: JobHistoryDownsample, Interval: interval, Run: s.DownsampleHistory}
+}
@@ -0,0 +1,95 @@
+package price
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/birdeye"
+	birdeye_mocks "github.com/nicolas-martin/dankfolio/backend/internal/clients/birdeye/mocks"
+	dbmocks "github.com/nicolas-martin/dankfolio/backend/internal/db/mocks"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+func TestUncoveredSpan(t *testing.T) {
+	base := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	at := func(minutes int) time.Time { return base.Add(time.Duration(minutes) * time.Minute) }
+	span := func(from, to int) model.PriceHistoryRange {
+		return model.PriceHistoryRange{From: at(from), To: at(to)}
+	}
+
+	tests := []struct {
+		name     string
+		ranges   []model.PriceHistoryRange
+		wantGap  bool
+		from, to int
+	}{
+		{name: "nothing stored", wantGap: true, from: 0, to: 60},
+		{name: "fully covered", ranges: []model.PriceHistoryRange{span(-10, 70)}},
+		{name: "recent tail missing", ranges: []model.PriceHistoryRange{span(-10, 50)}, wantGap: true, from: 50, to: 60},
+		{name: "gaps on both sides fetched as one span", ranges: []model.PriceHistoryRange{span(20, 40)}, wantGap: true, from: 0, to: 60},
+		{name: "unordered overlapping ranges", ranges: []model.PriceHistoryRange{span(30, 60), span(0, 35)}},
+		{name: "gap shorter than a bucket is ignored", ranges: []model.PriceHistoryRange{span(0, 59)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			from, to, ok := uncoveredSpan(tt.ranges, at(0), at(60), 5*time.Minute)
+			require.Equal(t, tt.wantGap, ok)
+			if ok {
+				assert.Equal(t, at(tt.from), from)
+				assert.Equal(t, at(tt.to), to)
+			}
+		})
+	}
+}
+
+func TestToPriceHistory_BucketsByResolution(t *testing.T) {
+	base := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	points := []model.PricePoint{
+		{Time: base.Add(20 * time.Minute), Value: 3},
+		{Time: base, Value: 1},
+		{Time: base.Add(10 * time.Minute), Value: 2},
+	}
+	history := toPriceHistory(points, 15*time.Minute)
+	assert.Equal(t, []birdeye.PriceHistoryItem{
+		{UnixTime: base.Unix(), Value: 2},
+		{UnixTime: base.Add(15 * time.Minute).Unix(), Value: 3},
+	}, history.Data.Items)
+}
+
+func TestLoadPriceHistory_FetchesOnlyGaps(t *testing.T) {
+	ctx := context.Background()
+	store := dbmocks.NewMockStore(t)
+	birdeyeClient := birdeye_mocks.NewMockClientAPI(t)
+	s := &Service{birdeyeClient: birdeyeClient, store: store, persistHistory: true}
+
+	from := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	to := from.Add(time.Hour)
+	coveredTo := from.Add(50 * time.Minute)
+	params := birdeye.PriceHistoryParams{Address: "mint", HistoryType: "1m", TimeFrom: from, TimeTo: to}
+
+	store.EXPECT().ListPriceHistoryRanges(ctx, "mint", time.Minute, from, to).
+		Return([]model.PriceHistoryRange{{Address: "mint", Resolution: time.Minute, From: from.Add(-time.Hour), To: coveredTo}}, nil)
+	birdeyeClient.EXPECT().GetPriceHistory(ctx, mock.MatchedBy(func(p birdeye.PriceHistoryParams) bool {
+		return p.TimeFrom.Equal(coveredTo) && p.TimeTo.Equal(to)
+	})).Return(&birdeye.PriceHistory{Data: birdeye.PriceHistoryData{Items: []birdeye.PriceHistoryItem{
+		{UnixTime: to.Add(-time.Minute).Unix(), Value: 2},
+	}}, Success: true}, nil)
+	store.EXPECT().UpsertPricePoints(ctx, mock.Anything).Return(nil)
+	store.EXPECT().AddPriceHistoryRange(ctx, model.PriceHistoryRange{
+		Address: "mint", Resolution: time.Minute, From: coveredTo, To: to.Add(-time.Minute),
+	}).Return(nil)
+	store.EXPECT().ListPricePoints(ctx, "mint", time.Minute, from, to).
+		Return([]model.PricePoint{{Address: "mint", Resolution: time.Minute, Time: from, Value: 1}}, nil)
+
+	history, err := s.loadPriceHistory(ctx, params)
+	require.NoError(t, err)
+	assert.Equal(t, []birdeye.PriceHistoryItem{
+		{UnixTime: from.Unix(), Value: 1},
+		{UnixTime: to.Add(-time.Minute).Unix(), Value: 2},
+	}, history.Data.Items)
+}
@@ -0,0 +1,186 @@
+package price
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/birdeye"
+)
+
+// Technical indicators computed over candles
+const (
+	IndicatorSMA  = "sma"  // Simple moving average of closes
+	IndicatorEMA  = "ema"  // Exponential moving average of closes, seeded with the SMA
+	IndicatorRSI  = "rsi"  // Relative strength index with Wilder's smoothing
+	IndicatorVWAP = "vwap" // Volume-weighted average price since the first candle; takes no period
+)
+
+const (
+	// MaxIndicators is the most indicators one request may compute
+	MaxIndicators = 8
+	// MaxIndicatorPeriod is the longest period an indicator may use
+	MaxIndicatorPeriod = 200
+)
+
+// ErrInvalidIndicator is returned for indicator requests that cannot be computed.
+var ErrInvalidIndicator = errors.New("invalid indicator")
+
+// IndicatorRequest asks for one indicator over a chart's candles.
+type IndicatorRequest struct {
+	Kind   string // One of the Indicator constants
+	Period int    // Candles per value; ignored by VWAP
+}
+
+// IndicatorSeries is an indicator's values for a chart's candles. Values[i]
+// belongs to the candle at Start+i; the candles before Start are the warm-up
+// the period needs.
+type IndicatorSeries struct {
+	IndicatorRequest
+	Start  int
+	Values []float64
+}
+
+// ComputeIndicators computes the requested indicators over candles, oldest
+// first. Errors wrap ErrInvalidIndicator.
+func ComputeIndicators(candles []birdeye.OHLCVItem, requests []IndicatorRequest) ([]IndicatorSeries, error) {
+	if len(requests) > MaxIndicators {
+		return nil, fmt.Errorf("%w: at most %d indicators per request", ErrInvalidIndicator, MaxIndicators)
+	}
+	closes := make([]float64, len(candles))
+	for i, candle := range candles {
+		closes[i] = candle.Close
+	}
+
+	series := make([]IndicatorSeries, 0, len(requests))
+	for _, request := range requests {
+		minPeriod := 1
+		switch request.Kind {
+		case IndicatorVWAP:
+			request.Period = 0
+			series = append(series, IndicatorSeries{IndicatorRequest: request, Values: vwap(candles)})
+			continue
+		case IndicatorRSI:
+			minPeriod = 2
+		case IndicatorSMA, IndicatorEMA:
+		default:
+			return nil, fmt.Errorf("%w: unknown indicator %q", ErrInvalidIndicator, request.Kind)
+		}
+		if request.Period < minPeriod || request.Period > MaxIndicatorPeriod {
+			return nil, fmt.Errorf("%w: %s period must be between %d and %d", ErrInvalidIndicator, request.Kind, minPeriod, MaxIndicatorPeriod)
+		}
+
+		var start int
+		var values []float64
+		switch request.Kind {
+		case IndicatorSMA:
+			start, values = request.Period-1, sma(closes, request.Period)
+		case IndicatorEMA:
+			start, values = request.Period-1, ema(closes, request.Period)
+		case IndicatorRSI:
+			start, values = request.Period, rsi(closes, request.Period)
+		}
+		if len(values) == 0 {
+			start = len(candles)
+		}
+		series = append(series, IndicatorSeries{IndicatorRequest: request, Start: start, Values: values})
+	}
+	return series, nil
+}
+
+// sma returns the simple moving average of each full window of period values
+func sma(values []float64, period int) []float64 {
+	if len(values) < period {
+		return nil
+	}
+	out := make([]float64, 0, len(values)-period+1)
+	var sum float64
+	for i, value := range values {
+		sum += value
+		if i >= period {
+			sum -= values[i-period]
+		}
+		if i >= period-1 {
+			out = append(out, sum/float64(period))
+		}
+	}
+	return out
+}
+
+// ema returns the exponential moving average from the first full window,
+// which is seeded with its simple average
+func ema(values []float64, period int) []float64 {
+	if len(values) < period {
+		return nil
+	}
+	k := 2 / float64(period+1)
+	out := make([]float64, 0, len(values)-period+1)
+	var seed float64
+	for _, value := range values[:period] {
+		seed += value
+	}
+	current := seed / float64(period)
+	out = append(out, current)
+	for _, value := range values[period:] {
+		current = value*k + current*(1-k)
+		out = append(out, current)
+	}
+	return out
+}
+
+// rsi returns the relative strength index from the value after the first
+// period changes, smoothing gains and losses as Wilder did
+func rsi(values []float64, period int) []float64 {
+	if len(values) <= period {
+		return nil
+	}
+	var avgGain, avgLoss float64
+	for i := 1; i <= period; i++ {
+		change := values[i] - values[i-1]
+		if change > 0 {
+			avgGain += change
+		} else {
+			avgLoss -= change
+		}
+	}
+	avgGain /= float64(period)
+	avgLoss /= float64(period)
+
+	out := make([]float64, 0, len(values)-period)
+	out = append(out, rsiValue(avgGain, avgLoss))
+	for i := period + 1; i < len(values); i++ {
+		change := values[i] - values[i-1]
+		gain, loss := max(change, 0), max(-change, 0)
+		avgGain = (avgGain*float64(period-1) + gain) / float64(period)
+		avgLoss = (avgLoss*float64(period-1) + loss) / float64(period)
+		out = append(out, rsiValue(avgGain, avgLoss))
+	}
+	return out
+}
+
+func rsiValue(avgGain, avgLoss float64) float64 {
+	if avgLoss == 0 {
+		if avgGain == 0 {
+			return 50 // No movement at all
+		}
+		return 100
+	}
+	return 100 - 100/(1+avgGain/avgLoss)
+}
+
+// vwap returns the running volume-weighted average of each candle's typical
+// price. Until volume trades it follows the typical price.
+func vwap(candles []birdeye.OHLCVItem) []float64 {
+	out := make([]float64, len(candles))
+	var priceVolume, volume float64
+	for i, candle := range candles {
+		typical := (candle.High + candle.Low + candle.Close) / 3
+		priceVolume += typical * candle.Volume
+		volume += candle.Volume
+		if volume > 0 {
+			out[i] = priceVolume / volume
+		} else {
+			out[i] = typical
+		}
+	}
+	return out
+}
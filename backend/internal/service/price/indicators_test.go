@@ -0,0 +1,86 @@
+package price
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/birdeye"
+)
+
+func candlesFromCloses(closes ...float64) []birdeye.OHLCVItem {
+	candles := make([]birdeye.OHLCVItem, len(closes))
+	for i, c := range closes {
+		candles[i] = birdeye.OHLCVItem{UnixTime: int64(i * 60), Open: c, High: c, Low: c, Close: c, Volume: 1}
+	}
+	return candles
+}
+
+func TestComputeIndicators(t *testing.T) {
+	candles := candlesFromCloses(1, 2, 3, 4, 5)
+	series, err := ComputeIndicators(candles, []IndicatorRequest{
+		{Kind: IndicatorSMA, Period: 3},
+		{Kind: IndicatorEMA, Period: 3},
+		{Kind: IndicatorRSI, Period: 2},
+		{Kind: IndicatorVWAP, Period: 14},
+		{Kind: IndicatorSMA, Period: 10},
+	})
+	require.NoError(t, err)
+	require.Len(t, series, 5)
+
+	assert.Equal(t, 2, series[0].Start)
+	assert.Equal(t, []float64{2, 3, 4}, series[0].Values)
+
+	// Seeded with the SMA of 2, then k = 0.5
+	assert.Equal(t, 2, series[1].Start)
+	assert.Equal(t, []float64{2, 3, 4}, series[1].Values)
+
+	// Only gains
+	assert.Equal(t, 2, series[2].Start)
+	assert.Equal(t, []float64{100, 100, 100}, series[2].Values)
+
+	assert.Equal(t, 0, series[3].Period)
+	assert.Equal(t, []float64{1, 1.5, 2, 2.5, 3}, series[3].Values)
+
+	// Not enough candles for the period
+	assert.Equal(t, len(candles), series[4].Start)
+	assert.Empty(t, series[4].Values)
+}
+
+func TestRSI_WilderSmoothing(t *testing.T) {
+	values := rsi([]float64{10, 11, 10, 12, 11}, 2)
+	// Seed: gains 1, losses 1 -> 50; then +2: gain (0.5+2)/2=1.25, loss 0.5/2=0.25
+	// -> 100-100/6; then -1: gain 0.625, loss 0.625 -> 50
+	require.Len(t, values, 3)
+	assert.InDelta(t, 50, values[0], 1e-9)
+	assert.InDelta(t, 100-100.0/6, values[1], 1e-9)
+	assert.InDelta(t, 50, values[2], 1e-9)
+}
+
+func TestVWAP_WeightsByVolume(t *testing.T) {
+	values := vwap([]birdeye.OHLCVItem{
+		{High: 10, Low: 10, Close: 10, Volume: 3},
+		{High: 20, Low: 20, Close: 20, Volume: 1},
+		{High: 30, Low: 30, Close: 30, Volume: 0},
+	})
+	assert.Equal(t, []float64{10, 12.5, 12.5}, values)
+}
+
+func TestComputeIndicators_Invalid(t *testing.T) {
+	for name, request := range map[string]IndicatorRequest{
+		"unknown kind": {Kind: "macd", Period: 12},
+		"zero period":  {Kind: IndicatorSMA},
+		"long period":  {Kind: IndicatorEMA, Period: MaxIndicatorPeriod + 1},
+		"rsi of one":   {Kind: IndicatorRSI, Period: 1},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := ComputeIndicators(candlesFromCloses(1, 2, 3), []IndicatorRequest{request})
+			assert.True(t, errors.Is(err, ErrInvalidIndicator), err)
+		})
+	}
+
+	_, err := ComputeIndicators(nil, make([]IndicatorRequest, MaxIndicators+1))
+	assert.ErrorIs(t, err, ErrInvalidIndicator)
+}
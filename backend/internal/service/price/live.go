@@ -0,0 +1,320 @@
+package price
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/birdeye"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+const (
+	// DefaultLivePollInterval is how often watched tokens without a recent
+	// streamed price are polled
+	DefaultLivePollInterval = 15 * time.Second
+	// DefaultLiveMaxAge is how long a live price is served from memory
+	DefaultLiveMaxAge = 30 * time.Second
+
+	// liveUpdateBuffer is how many updates a subscriber can fall behind
+	// before further updates to it are dropped
+	liveUpdateBuffer = 64
+)
+
+// ErrLivePricesDisabled is returned by SubscribePrices when live prices are not set up.
+var ErrLivePricesDisabled = errors.New("live prices are not enabled")
+
+// SetLivePrices serves recent live prices without REST calls and enables
+// SubscribePrices
+func (s *Service) SetLivePrices(live *LivePrices) {
+	s.live = live
+}
+
+// SubscribePrices streams price updates, and trades when trades is set, for
+// addresses until cancel is called
+func (s *Service) SubscribePrices(addresses []string, trades bool) (updates <-chan Update, cancel func(), err error) {
+	if s.live == nil {
+		return nil, nil, ErrLivePricesDisabled
+	}
+	updates, cancel = s.live.Subscribe(addresses, trades)
+	return updates, cancel, nil
+}
+
+// LiveFeed streams real-time prices and trades for the tokens it is given,
+// e.g. a birdeye.Stream.
+type LiveFeed interface {
+	SetAddresses(prices, trades []string)
+}
+
+// LiveConfig configures LivePrices. Zero values use the defaults.
+type LiveConfig struct {
+	PollInterval time.Duration
+	MaxAge       time.Duration
+}
+
+// Update is one live event for a subscriber: a price or, for subscribers that
+// asked for them, a trade. Exactly one field is set.
+type Update struct {
+	Price *PriceUpdate
+	Trade *birdeye.TradeEvent
+}
+
+// PriceUpdate is the latest USD price of a token.
+type PriceUpdate struct {
+	Address string
+	Price   float64
+	Time    time.Time
+}
+
+type livePrice struct {
+	price float64
+	at    time.Time // When it was received
+}
+
+type liveSubscriber struct {
+	addresses []string
+	trades    bool
+	updates   chan Update
+}
+
+// LivePrices keeps the latest price of the tokens clients are viewing. Prices
+// come from a LiveFeed when one is set, and tokens the feed has not priced
+// recently are polled, so viewers get updates with or without the feed.
+// Recent prices also serve GetPricesBatch without a REST call.
+type LivePrices struct {
+	pollInterval time.Duration
+	maxAge       time.Duration
+	fetch        func(ctx context.Context, addresses []string) (map[string]float64, error)
+	now          func() time.Time
+
+	mu          sync.Mutex
+	feed        LiveFeed
+	latest      map[string]livePrice // Keyed by the address prices are fetched for; see feedAddress
+	subscribers map[uint64]*liveSubscriber
+	nextID      uint64
+}
+
+// NewLivePrices creates live prices that poll with fetch, typically
+// Service.GetPricesBatch
+func NewLivePrices(config LiveConfig, fetch func(ctx context.Context, addresses []string) (map[string]float64, error)) *LivePrices {
+	if config.PollInterval <= 0 {
+		config.PollInterval = DefaultLivePollInterval
+	}
+	if config.MaxAge <= 0 {
+		config.MaxAge = DefaultLiveMaxAge
+	}
+	return &LivePrices{
+		pollInterval: config.PollInterval,
+		maxAge:       config.MaxAge,
+		fetch:        fetch,
+		now:          time.Now,
+		latest:       make(map[string]livePrice),
+		subscribers:  make(map[uint64]*liveSubscriber),
+	}
+}
+
+// SetFeed streams prices and trades from feed for the tokens being viewed
+func (l *LivePrices) SetFeed(feed LiveFeed) {
+	l.mu.Lock()
+	l.feed = feed
+	l.mu.Unlock()
+	l.updateFeed()
+}
+
+// Subscribe delivers updates for addresses until cancel is called. Trades are
+// only delivered when trades is set. A subscriber that falls behind misses
+// updates rather than holding up the others.
+func (l *LivePrices) Subscribe(addresses []string, trades bool) (updates <-chan Update, cancel func()) {
+	subscriber := &liveSubscriber{
+		addresses: slices.Clone(addresses),
+		trades:    trades,
+		updates:   make(chan Update, liveUpdateBuffer),
+	}
+	l.mu.Lock()
+	l.nextID++
+	id := l.nextID
+	l.subscribers[id] = subscriber
+	l.mu.Unlock()
+	l.updateFeed()
+
+	return subscriber.updates, func() {
+		l.mu.Lock()
+		delete(l.subscribers, id)
+		l.mu.Unlock()
+		l.updateFeed()
+	}
+}
+
+// updateFeed points the feed at the tokens subscribers are viewing
+func (l *LivePrices) updateFeed() {
+	l.mu.Lock()
+	feed := l.feed
+	var prices, trades []string
+	for _, subscriber := range l.subscribers {
+		for _, address := range subscriber.addresses {
+			address = feedAddress(address)
+			if !slices.Contains(prices, address) {
+				prices = append(prices, address)
+			}
+			if subscriber.trades && !slices.Contains(trades, address) {
+				trades = append(trades, address)
+			}
+		}
+	}
+	l.mu.Unlock()
+	if feed != nil {
+		slices.Sort(prices)
+		slices.Sort(trades)
+		feed.SetAddresses(prices, trades)
+	}
+}
+
+// HandlePrice records a streamed price and forwards it to subscribers.
+// Invalid prices, future timestamps and large moves without volume are dropped.
+func (l *LivePrices) HandlePrice(event birdeye.PriceEvent) {
+	l.mu.Lock()
+	previous := l.latest[event.Address].price
+	l.mu.Unlock()
+	if !saneTick(event, previous, l.now()) {
+		slog.Warn("Dropping implausible streamed price",
+			slog.String("address", event.Address),
+			slog.Float64("price", event.Price),
+			slog.Float64("previous", previous),
+			slog.Float64("volume", event.Volume),
+			slog.Time("time", event.Time))
+		return
+	}
+	l.publishPrice(event.Address, event.Price, event.Time)
+}
+
+// HandleTrade forwards a streamed trade to subscribers that asked for trades
+func (l *LivePrices) HandleTrade(event birdeye.TradeEvent) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, subscriber := range l.subscribers {
+		if subscriber.trades && watches(subscriber, event.Address) {
+			trade := event
+			send(subscriber, Update{Trade: &trade})
+		}
+	}
+}
+
+// Store records prices fetched from the REST API so later lookups can reuse
+// them, and forwards changes to subscribers
+func (l *LivePrices) Store(prices map[string]float64) {
+	now := l.now()
+	for address, price := range prices {
+		if validPrice(price) {
+			l.publishPrice(feedAddress(address), price, now)
+		}
+	}
+}
+
+// publishPrice records a price for a feed address and sends it to the
+// subscribers watching it when it changed
+func (l *LivePrices) publishPrice(address string, price float64, at time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	previous, known := l.latest[address]
+	l.latest[address] = livePrice{price: price, at: l.now()}
+	if known && previous.price == price {
+		return
+	}
+	for _, subscriber := range l.subscribers {
+		for _, watched := range subscriber.addresses {
+			if feedAddress(watched) == address {
+				send(subscriber, Update{Price: &PriceUpdate{Address: watched, Price: price, Time: at}})
+			}
+		}
+	}
+}
+
+// Fresh returns the prices of addresses received within the max age and the
+// addresses that have none.
+func (l *LivePrices) Fresh(addresses []string) (prices map[string]float64, missing []string) {
+	cutoff := l.now().Add(-l.maxAge)
+	prices = make(map[string]float64, len(addresses))
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, address := range addresses {
+		if latest, ok := l.latest[feedAddress(address)]; ok && latest.at.After(cutoff) {
+			prices[address] = latest.price
+			continue
+		}
+		missing = append(missing, address)
+	}
+	return prices, missing
+}
+
+// Run polls the viewed tokens that have no fresh price until ctx is
+// cancelled, and forgets stale prices.
+func (l *LivePrices) Run(ctx context.Context) {
+	ticker := time.NewTicker(l.pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			l.poll(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (l *LivePrices) poll(ctx context.Context) {
+	cutoff := l.now().Add(-l.maxAge)
+	var viewed []string
+	l.mu.Lock()
+	for _, subscriber := range l.subscribers {
+		for _, address := range subscriber.addresses {
+			if !slices.Contains(viewed, address) {
+				viewed = append(viewed, address)
+			}
+		}
+	}
+	for address, latest := range l.latest {
+		if latest.at.Before(cutoff) {
+			delete(l.latest, address)
+		}
+	}
+	l.mu.Unlock()
+
+	_, stale := l.Fresh(viewed)
+	if len(stale) == 0 {
+		return
+	}
+	prices, err := l.fetch(ctx, stale)
+	if err != nil {
+		if ctx.Err() == nil {
+			slog.WarnContext(ctx, "Failed to poll live prices", slog.Int("tokens", len(stale)), slog.Any("error", err))
+		}
+		return
+	}
+	l.Store(prices)
+}
+
+// send must be called with l.mu held
+func send(subscriber *liveSubscriber, update Update) {
+	select {
+	case subscriber.updates <- update:
+	default:
+	}
+}
+
+func watches(subscriber *liveSubscriber, address string) bool {
+	return slices.ContainsFunc(subscriber.addresses, func(watched string) bool {
+		return feedAddress(watched) == address
+	})
+}
+
+// feedAddress is the address a token is priced under: native SOL is priced
+// as wrapped SOL
+func feedAddress(address string) string {
+	if address == model.NativeSolMint {
+		return model.SolMint
+	}
+	return address
+}
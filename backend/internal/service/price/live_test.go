@@ -0,0 +1,74 @@
+package price
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/birdeye"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+const testLiveMint = "7GCihgDB8fe6KNjn2MYtkzZcRjQy3t9GHdC8uHYmW2hr"
+
+type recordingFeed struct {
+	prices, trades []string
+}
+
+func (f *recordingFeed) SetAddresses(prices, trades []string) {
+	f.prices, f.trades = prices, trades
+}
+
+func TestLivePrices_Subscribe(t *testing.T) {
+	live := NewLivePrices(LiveConfig{}, nil)
+	feed := &recordingFeed{}
+	live.SetFeed(feed)
+
+	updates, cancel := live.Subscribe([]string{model.NativeSolMint, testLiveMint}, false)
+	_, cancelTrades := live.Subscribe([]string{testLiveMint}, true)
+	assert.Equal(t, []string{testLiveMint, model.SolMint}, feed.prices, "native SOL streams as wrapped SOL")
+	assert.Equal(t, []string{testLiveMint}, feed.trades)
+
+	live.HandlePrice(birdeye.PriceEvent{Address: model.SolMint, Price: 150})
+	live.HandlePrice(birdeye.PriceEvent{Address: model.SolMint, Price: 150})
+	live.HandleTrade(birdeye.TradeEvent{Address: testLiveMint, Side: "buy"})
+	require.Len(t, updates, 1, "unchanged prices and unrequested trades are not sent")
+	update := <-updates
+	require.NotNil(t, update.Price)
+	assert.Equal(t, model.NativeSolMint, update.Price.Address)
+	assert.Equal(t, 150.0, update.Price.Price)
+
+	cancelTrades()
+	assert.Empty(t, feed.trades)
+	cancel()
+	assert.Empty(t, feed.prices)
+}
+
+func TestLivePrices_FreshAndPoll(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	var polled []string
+	live := NewLivePrices(LiveConfig{MaxAge: 30 * time.Second}, func(_ context.Context, addresses []string) (map[string]float64, error) {
+		polled = append(polled, addresses...)
+		return map[string]float64{testLiveMint: 0.02}, nil
+	})
+	live.now = func() time.Time { return now }
+
+	live.Store(map[string]float64{model.SolMint: 150})
+	prices, missing := live.Fresh([]string{model.NativeSolMint, testLiveMint})
+	assert.Equal(t, map[string]float64{model.NativeSolMint: 150}, prices)
+	assert.Equal(t, []string{testLiveMint}, missing)
+
+	updates, cancel := live.Subscribe([]string{model.SolMint, testLiveMint}, false)
+	defer cancel()
+	live.poll(context.Background())
+	assert.Equal(t, []string{testLiveMint}, polled, "only tokens without a fresh price are polled")
+	require.Len(t, updates, 1)
+	assert.Equal(t, 0.02, (<-updates).Price.Price)
+
+	now = now.Add(time.Minute)
+	_, missing = live.Fresh([]string{model.SolMint})
+	assert.Equal(t, []string{model.SolMint}, missing)
+}
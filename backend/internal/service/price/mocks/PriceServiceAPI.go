@@ -7,6 +7,7 @@ package pricemocks
 import (
 	"context"
 
+	"github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1"
 	"github.com/nicolas-martin/dankfolio/backend/internal/clients/birdeye"
 	"github.com/nicolas-martin/dankfolio/backend/internal/service/price"
 	mock "github.com/stretchr/testify/mock"
@@ -39,6 +40,234 @@ func (_m *MockPriceServiceAPI) EXPECT() *MockPriceServiceAPI_Expecter {
 	return &MockPriceServiceAPI_Expecter{mock: &_m.Mock}
 }
 
+// ConvertPriceHistory provides a mock function for the type MockPriceServiceAPI
+func (_mock *MockPriceServiceAPI) ConvertPriceHistory(ctx context.Context, history *birdeye.PriceHistory, currency string) (*birdeye.PriceHistory, string, error) {
+	ret := _mock.Called(ctx, history, currency)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConvertPriceHistory")
+	}
+
+	var r0 *birdeye.PriceHistory
+	var r1 string
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *birdeye.PriceHistory, string) (*birdeye.PriceHistory, string, error)); ok {
+		return returnFunc(ctx, history, currency)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *birdeye.PriceHistory, string) *birdeye.PriceHistory); ok {
+		r0 = returnFunc(ctx, history, currency)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*birdeye.PriceHistory)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *birdeye.PriceHistory, string) string); ok {
+		r1 = returnFunc(ctx, history, currency)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, *birdeye.PriceHistory, string) error); ok {
+		r2 = returnFunc(ctx, history, currency)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockPriceServiceAPI_ConvertPriceHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertPriceHistory'
+type MockPriceServiceAPI_ConvertPriceHistory_Call struct {
+	*mock.Call
+}
+
+// ConvertPriceHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - history *birdeye.PriceHistory
+//   - currency string
+func (_e *MockPriceServiceAPI_Expecter) ConvertPriceHistory(ctx interface{}, history interface{}, currency interface{}) *MockPriceServiceAPI_ConvertPriceHistory_Call {
+	return &MockPriceServiceAPI_ConvertPriceHistory_Call{Call: _e.mock.On("ConvertPriceHistory", ctx, history, currency)}
+}
+
+func (_c *MockPriceServiceAPI_ConvertPriceHistory_Call) Run(run func(ctx context.Context, history *birdeye.PriceHistory, currency string)) *MockPriceServiceAPI_ConvertPriceHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *birdeye.PriceHistory
+		if args[1] != nil {
+			arg1 = args[1].(*birdeye.PriceHistory)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPriceServiceAPI_ConvertPriceHistory_Call) Return(priceHistory *birdeye.PriceHistory, s string, err error) *MockPriceServiceAPI_ConvertPriceHistory_Call {
+	_c.Call.Return(priceHistory, s, err)
+	return _c
+}
+
+func (_c *MockPriceServiceAPI_ConvertPriceHistory_Call) RunAndReturn(run func(ctx context.Context, history *birdeye.PriceHistory, currency string) (*birdeye.PriceHistory, string, error)) *MockPriceServiceAPI_ConvertPriceHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConvertPrices provides a mock function for the type MockPriceServiceAPI
+func (_mock *MockPriceServiceAPI) ConvertPrices(ctx context.Context, prices map[string]float64, currency string) (map[string]float64, string, error) {
+	ret := _mock.Called(ctx, prices, currency)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConvertPrices")
+	}
+
+	var r0 map[string]float64
+	var r1 string
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, map[string]float64, string) (map[string]float64, string, error)); ok {
+		return returnFunc(ctx, prices, currency)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, map[string]float64, string) map[string]float64); ok {
+		r0 = returnFunc(ctx, prices, currency)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]float64)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, map[string]float64, string) string); ok {
+		r1 = returnFunc(ctx, prices, currency)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, map[string]float64, string) error); ok {
+		r2 = returnFunc(ctx, prices, currency)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockPriceServiceAPI_ConvertPrices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertPrices'
+type MockPriceServiceAPI_ConvertPrices_Call struct {
+	*mock.Call
+}
+
+// ConvertPrices is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prices map[string]float64
+//   - currency string
+func (_e *MockPriceServiceAPI_Expecter) ConvertPrices(ctx interface{}, prices interface{}, currency interface{}) *MockPriceServiceAPI_ConvertPrices_Call {
+	return &MockPriceServiceAPI_ConvertPrices_Call{Call: _e.mock.On("ConvertPrices", ctx, prices, currency)}
+}
+
+func (_c *MockPriceServiceAPI_ConvertPrices_Call) Run(run func(ctx context.Context, prices map[string]float64, currency string)) *MockPriceServiceAPI_ConvertPrices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 map[string]float64
+		if args[1] != nil {
+			arg1 = args[1].(map[string]float64)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPriceServiceAPI_ConvertPrices_Call) Return(stringToFloat64 map[string]float64, s string, err error) *MockPriceServiceAPI_ConvertPrices_Call {
+	_c.Call.Return(stringToFloat64, s, err)
+	return _c
+}
+
+func (_c *MockPriceServiceAPI_ConvertPrices_Call) RunAndReturn(run func(ctx context.Context, prices map[string]float64, currency string) (map[string]float64, string, error)) *MockPriceServiceAPI_ConvertPrices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CrossCheckPrices provides a mock function for the type MockPriceServiceAPI
+func (_mock *MockPriceServiceAPI) CrossCheckPrices(ctx context.Context, addresses []string) ([]price.PriceDivergence, error) {
+	ret := _mock.Called(ctx, addresses)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CrossCheckPrices")
+	}
+
+	var r0 []price.PriceDivergence
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) ([]price.PriceDivergence, error)); ok {
+		return returnFunc(ctx, addresses)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) []price.PriceDivergence); ok {
+		r0 = returnFunc(ctx, addresses)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]price.PriceDivergence)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = returnFunc(ctx, addresses)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPriceServiceAPI_CrossCheckPrices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CrossCheckPrices'
+type MockPriceServiceAPI_CrossCheckPrices_Call struct {
+	*mock.Call
+}
+
+// CrossCheckPrices is a helper method to define mock.On call
+//   - ctx context.Context
+//   - addresses []string
+func (_e *MockPriceServiceAPI_Expecter) CrossCheckPrices(ctx interface{}, addresses interface{}) *MockPriceServiceAPI_CrossCheckPrices_Call {
+	return &MockPriceServiceAPI_CrossCheckPrices_Call{Call: _e.mock.On("CrossCheckPrices", ctx, addresses)}
+}
+
+func (_c *MockPriceServiceAPI_CrossCheckPrices_Call) Run(run func(ctx context.Context, addresses []string)) *MockPriceServiceAPI_CrossCheckPrices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPriceServiceAPI_CrossCheckPrices_Call) Return(priceDivergences []price.PriceDivergence, err error) *MockPriceServiceAPI_CrossCheckPrices_Call {
+	_c.Call.Return(priceDivergences, err)
+	return _c
+}
+
+func (_c *MockPriceServiceAPI_CrossCheckPrices_Call) RunAndReturn(run func(ctx context.Context, addresses []string) ([]price.PriceDivergence, error)) *MockPriceServiceAPI_CrossCheckPrices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetCoinPrices provides a mock function for the type MockPriceServiceAPI
 func (_mock *MockPriceServiceAPI) GetCoinPrices(ctx context.Context, tokenAddresses []string) (map[string]float64, error) {
 	ret := _mock.Called(ctx, tokenAddresses)
@@ -107,6 +336,86 @@ func (_c *MockPriceServiceAPI_GetCoinPrices_Call) RunAndReturn(run func(ctx cont
 	return _c
 }
 
+// GetPriceCandles provides a mock function for the type MockPriceServiceAPI
+func (_mock *MockPriceServiceAPI) GetPriceCandles(ctx context.Context, address string, config price.BackendTimeframeConfig, endTime string) (*birdeye.OHLCV, error) {
+	ret := _mock.Called(ctx, address, config, endTime)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPriceCandles")
+	}
+
+	var r0 *birdeye.OHLCV
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, price.BackendTimeframeConfig, string) (*birdeye.OHLCV, error)); ok {
+		return returnFunc(ctx, address, config, endTime)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, price.BackendTimeframeConfig, string) *birdeye.OHLCV); ok {
+		r0 = returnFunc(ctx, address, config, endTime)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*birdeye.OHLCV)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, price.BackendTimeframeConfig, string) error); ok {
+		r1 = returnFunc(ctx, address, config, endTime)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPriceServiceAPI_GetPriceCandles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPriceCandles'
+type MockPriceServiceAPI_GetPriceCandles_Call struct {
+	*mock.Call
+}
+
+// GetPriceCandles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - address string
+//   - config price.BackendTimeframeConfig
+//   - endTime string
+func (_e *MockPriceServiceAPI_Expecter) GetPriceCandles(ctx interface{}, address interface{}, config interface{}, endTime interface{}) *MockPriceServiceAPI_GetPriceCandles_Call {
+	return &MockPriceServiceAPI_GetPriceCandles_Call{Call: _e.mock.On("GetPriceCandles", ctx, address, config, endTime)}
+}
+
+func (_c *MockPriceServiceAPI_GetPriceCandles_Call) Run(run func(ctx context.Context, address string, config price.BackendTimeframeConfig, endTime string)) *MockPriceServiceAPI_GetPriceCandles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 price.BackendTimeframeConfig
+		if args[2] != nil {
+			arg2 = args[2].(price.BackendTimeframeConfig)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPriceServiceAPI_GetPriceCandles_Call) Return(oHLCV *birdeye.OHLCV, err error) *MockPriceServiceAPI_GetPriceCandles_Call {
+	_c.Call.Return(oHLCV, err)
+	return _c
+}
+
+func (_c *MockPriceServiceAPI_GetPriceCandles_Call) RunAndReturn(run func(ctx context.Context, address string, config price.BackendTimeframeConfig, endTime string) (*birdeye.OHLCV, error)) *MockPriceServiceAPI_GetPriceCandles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPriceHistoriesByAddresses provides a mock function for the type MockPriceServiceAPI
 func (_mock *MockPriceServiceAPI) GetPriceHistoriesByAddresses(ctx context.Context, requests []price.PriceHistoryBatchRequest) (map[string]*price.PriceHistoryBatchResult, error) {
 	ret := _mock.Called(ctx, requests)
@@ -260,3 +569,207 @@ func (_c *MockPriceServiceAPI_GetPriceHistory_Call) RunAndReturn(run func(ctx co
 	_c.Call.Return(run)
 	return _c
 }
+
+// GetPricesBatch provides a mock function for the type MockPriceServiceAPI
+func (_mock *MockPriceServiceAPI) GetPricesBatch(ctx context.Context, tokenAddresses []string) (map[string]float64, error) {
+	ret := _mock.Called(ctx, tokenAddresses)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPricesBatch")
+	}
+
+	var r0 map[string]float64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) (map[string]float64, error)); ok {
+		return returnFunc(ctx, tokenAddresses)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) map[string]float64); ok {
+		r0 = returnFunc(ctx, tokenAddresses)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]float64)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = returnFunc(ctx, tokenAddresses)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPriceServiceAPI_GetPricesBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPricesBatch'
+type MockPriceServiceAPI_GetPricesBatch_Call struct {
+	*mock.Call
+}
+
+// GetPricesBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenAddresses []string
+func (_e *MockPriceServiceAPI_Expecter) GetPricesBatch(ctx interface{}, tokenAddresses interface{}) *MockPriceServiceAPI_GetPricesBatch_Call {
+	return &MockPriceServiceAPI_GetPricesBatch_Call{Call: _e.mock.On("GetPricesBatch", ctx, tokenAddresses)}
+}
+
+func (_c *MockPriceServiceAPI_GetPricesBatch_Call) Run(run func(ctx context.Context, tokenAddresses []string)) *MockPriceServiceAPI_GetPricesBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPriceServiceAPI_GetPricesBatch_Call) Return(stringToFloat64 map[string]float64, err error) *MockPriceServiceAPI_GetPricesBatch_Call {
+	_c.Call.Return(stringToFloat64, err)
+	return _c
+}
+
+func (_c *MockPriceServiceAPI_GetPricesBatch_Call) RunAndReturn(run func(ctx context.Context, tokenAddresses []string) (map[string]float64, error)) *MockPriceServiceAPI_GetPricesBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SubscribePrices provides a mock function for the type MockPriceServiceAPI
+func (_mock *MockPriceServiceAPI) SubscribePrices(addresses []string, trades bool) (<-chan price.Update, func(), error) {
+	ret := _mock.Called(addresses, trades)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscribePrices")
+	}
+
+	var r0 <-chan price.Update
+	var r1 func()
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func([]string, bool) (<-chan price.Update, func(), error)); ok {
+		return returnFunc(addresses, trades)
+	}
+	if returnFunc, ok := ret.Get(0).(func([]string, bool) <-chan price.Update); ok {
+		r0 = returnFunc(addresses, trades)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan price.Update)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func([]string, bool) func()); ok {
+		r1 = returnFunc(addresses, trades)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(func())
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func([]string, bool) error); ok {
+		r2 = returnFunc(addresses, trades)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockPriceServiceAPI_SubscribePrices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribePrices'
+type MockPriceServiceAPI_SubscribePrices_Call struct {
+	*mock.Call
+}
+
+// SubscribePrices is a helper method to define mock.On call
+//   - addresses []string
+//   - trades bool
+func (_e *MockPriceServiceAPI_Expecter) SubscribePrices(addresses interface{}, trades interface{}) *MockPriceServiceAPI_SubscribePrices_Call {
+	return &MockPriceServiceAPI_SubscribePrices_Call{Call: _e.mock.On("SubscribePrices", addresses, trades)}
+}
+
+func (_c *MockPriceServiceAPI_SubscribePrices_Call) Run(run func(addresses []string, trades bool)) *MockPriceServiceAPI_SubscribePrices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []string
+		if args[0] != nil {
+			arg0 = args[0].([]string)
+		}
+		var arg1 bool
+		if args[1] != nil {
+			arg1 = args[1].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPriceServiceAPI_SubscribePrices_Call) Return(updates <-chan price.Update, cancel func(), err error) *MockPriceServiceAPI_SubscribePrices_Call {
+	_c.Call.Return(updates, cancel, err)
+	return _c
+}
+
+func (_c *MockPriceServiceAPI_SubscribePrices_Call) RunAndReturn(run func(addresses []string, trades bool) (<-chan price.Update, func(), error)) *MockPriceServiceAPI_SubscribePrices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Timeframe provides a mock function for the type MockPriceServiceAPI
+func (_mock *MockPriceServiceAPI) Timeframe(historyType v1.GetPriceHistoryRequest_PriceHistoryType) (price.BackendTimeframeConfig, bool) {
+	ret := _mock.Called(historyType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Timeframe")
+	}
+
+	var r0 price.BackendTimeframeConfig
+	var r1 bool
+	if returnFunc, ok := ret.Get(0).(func(v1.GetPriceHistoryRequest_PriceHistoryType) (price.BackendTimeframeConfig, bool)); ok {
+		return returnFunc(historyType)
+	}
+	if returnFunc, ok := ret.Get(0).(func(v1.GetPriceHistoryRequest_PriceHistoryType) price.BackendTimeframeConfig); ok {
+		r0 = returnFunc(historyType)
+	} else {
+		r0 = ret.Get(0).(price.BackendTimeframeConfig)
+	}
+	if returnFunc, ok := ret.Get(1).(func(v1.GetPriceHistoryRequest_PriceHistoryType) bool); ok {
+		r1 = returnFunc(historyType)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	return r0, r1
+}
+
+// MockPriceServiceAPI_Timeframe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Timeframe'
+type MockPriceServiceAPI_Timeframe_Call struct {
+	*mock.Call
+}
+
+// Timeframe is a helper method to define mock.On call
+//   - historyType v1.GetPriceHistoryRequest_PriceHistoryType
+func (_e *MockPriceServiceAPI_Expecter) Timeframe(historyType interface{}) *MockPriceServiceAPI_Timeframe_Call {
+	return &MockPriceServiceAPI_Timeframe_Call{Call: _e.mock.On("Timeframe", historyType)}
+}
+
+func (_c *MockPriceServiceAPI_Timeframe_Call) Run(run func(historyType v1.GetPriceHistoryRequest_PriceHistoryType)) *MockPriceServiceAPI_Timeframe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 v1.GetPriceHistoryRequest_PriceHistoryType
+		if args[0] != nil {
+			arg0 = args[0].(v1.GetPriceHistoryRequest_PriceHistoryType)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPriceServiceAPI_Timeframe_Call) Return(backendTimeframeConfig price.BackendTimeframeConfig, b bool) *MockPriceServiceAPI_Timeframe_Call {
+	_c.Call.Return(backendTimeframeConfig, b)
+	return _c
+}
+
+func (_c *MockPriceServiceAPI_Timeframe_Call) RunAndReturn(run func(historyType v1.GetPriceHistoryRequest_PriceHistoryType) (price.BackendTimeframeConfig, bool)) *MockPriceServiceAPI_Timeframe_Call {
+	_c.Call.Return(run)
+	return _c
+}
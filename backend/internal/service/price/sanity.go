@@ -0,0 +1,74 @@
+package price
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"time"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/birdeye"
+)
+
+const (
+	// maxTickJump is the largest relative move a streamed price may make from
+	// the last one when its candle has no volume; larger moves are glitches
+	maxTickJump = 0.5
+	// maxClockSkew is how far in the future a price's timestamp may be before
+	// it is treated as bogus
+	maxClockSkew = 5 * time.Minute
+)
+
+// validPrice reports whether price can be a token's USD price
+func validPrice(price float64) bool {
+	return price > 0 && !math.IsInf(price, 0) && !math.IsNaN(price)
+}
+
+// sanitizePrices removes impossible prices from prices, logging each one, so
+// they never reach caches or PnL math
+func sanitizePrices(ctx context.Context, prices map[string]float64) {
+	for address, price := range prices {
+		if !validPrice(price) {
+			slog.WarnContext(ctx, "Dropping impossible price", slog.String("address", address), slog.Float64("price", price))
+			delete(prices, address)
+		}
+	}
+}
+
+// sanitizeHistory removes points with impossible prices or timestamps in the
+// future from history, in place, before it is cached, stored or charted
+func sanitizeHistory(ctx context.Context, address string, history *birdeye.PriceHistory, now time.Time) {
+	if history == nil {
+		return
+	}
+	latest := now.Add(maxClockSkew).Unix()
+	items := history.Data.Items[:0]
+	var invalid, future int
+	for _, item := range history.Data.Items {
+		switch {
+		case !validPrice(item.Value):
+			invalid++
+		case item.UnixTime > latest:
+			future++
+		default:
+			items = append(items, item)
+		}
+	}
+	if invalid > 0 || future > 0 {
+		slog.WarnContext(ctx, "Dropping impossible price history points",
+			slog.String("address", address), slog.Int("invalid_prices", invalid), slog.Int("future_timestamps", future))
+	}
+	history.Data.Items = items
+}
+
+// saneTick reports whether a streamed price can follow previous: it must be a
+// valid price that is not in the future, and may only move by more than
+// maxTickJump when the candle traded volume
+func saneTick(event birdeye.PriceEvent, previous float64, now time.Time) bool {
+	if !validPrice(event.Price) || event.Time.After(now.Add(maxClockSkew)) {
+		return false
+	}
+	if previous > 0 && event.Volume <= 0 && math.Abs(event.Price-previous)/previous > maxTickJump {
+		return false
+	}
+	return true
+}
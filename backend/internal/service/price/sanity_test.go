@@ -0,0 +1,58 @@
+package price
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/birdeye"
+)
+
+func TestSanitizePrices(t *testing.T) {
+	prices := map[string]float64{"a": 1.5, "b": 0, "c": -2, "d": math.NaN(), "e": math.Inf(1)}
+	sanitizePrices(context.Background(), prices)
+	assert.Equal(t, map[string]float64{"a": 1.5}, prices)
+}
+
+func TestSanitizeHistory_DropsInvalidAndFuturePoints(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	history := &birdeye.PriceHistory{Data: birdeye.PriceHistoryData{Items: []birdeye.PriceHistoryItem{
+		{UnixTime: now.Add(-2 * time.Minute).Unix(), Value: 1.0},
+		{UnixTime: now.Add(-time.Minute).Unix(), Value: 0},
+		{UnixTime: now.Unix(), Value: 1.1},
+		{UnixTime: now.AddDate(1, 0, 0).Unix(), Value: 1.2},
+	}}}
+
+	sanitizeHistory(context.Background(), testLiveMint, history, now)
+
+	assert.Equal(t, []birdeye.PriceHistoryItem{
+		{UnixTime: now.Add(-2 * time.Minute).Unix(), Value: 1.0},
+		{UnixTime: now.Unix(), Value: 1.1},
+	}, history.Data.Items)
+}
+
+func TestSaneTick(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		event    birdeye.PriceEvent
+		previous float64
+		want     bool
+	}{
+		{"first price", birdeye.PriceEvent{Price: 2, Time: now}, 0, true},
+		{"small move", birdeye.PriceEvent{Price: 1.2, Time: now}, 1, true},
+		{"large move with volume", birdeye.PriceEvent{Price: 3, Volume: 5000, Time: now}, 1, true},
+		{"large move without volume", birdeye.PriceEvent{Price: 3, Time: now}, 1, false},
+		{"crash without volume", birdeye.PriceEvent{Price: 0.1, Time: now}, 1, false},
+		{"zero price", birdeye.PriceEvent{Price: 0, Volume: 5000, Time: now}, 1, false},
+		{"future timestamp", birdeye.PriceEvent{Price: 1, Time: now.Add(time.Hour)}, 1, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, saneTick(tt.event, tt.previous, now))
+		})
+	}
+}
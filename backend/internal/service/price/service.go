@@ -4,15 +4,22 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"maps"
 	"math"
 	"math/rand"
 	"sync"
 	"time"
 
+	"golang.org/x/sync/singleflight"
+
+	pb "github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1"
+	"github.com/nicolas-martin/dankfolio/backend/internal/cache"
 	"github.com/nicolas-martin/dankfolio/backend/internal/clients/birdeye"
 	"github.com/nicolas-martin/dankfolio/backend/internal/clients/jupiter"
 	"github.com/nicolas-martin/dankfolio/backend/internal/db"
 	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/fx"
+	"github.com/nicolas-martin/dankfolio/backend/internal/workerpool"
 )
 
 type Service struct {
@@ -20,6 +27,22 @@ type Service struct {
 	jupiterClient jupiter.ClientAPI
 	store         db.Store
 	cache         PriceHistoryCache
+	fetchGroup    singleflight.Group // Coalesces identical in-flight price history fetches
+	fetchPool     *workerpool.Pool   // Bounds parallel BirdEye price and history fetches
+
+	// Serve history from the store, fetching only gaps; see SetPersistHistory
+	persistHistory bool
+
+	fx *fx.Service // Converts to display currencies; see SetFX
+
+	live *LivePrices // Recent prices of viewed tokens; see SetLivePrices
+
+	crossCheckThreshold float64 // Divergence from Jupiter that is reported; see SetCrossCheckThreshold
+
+	candles cache.CandleCache // See SetCandleCache
+
+	timeframesMu sync.RWMutex
+	timeframes   map[pb.GetPriceHistoryRequest_PriceHistoryType]BackendTimeframeConfig // TimeframeConfigMap with overrides; see SetTimeframes
 }
 
 func NewService(birdeyeClient birdeye.ClientAPI, jupiterClient jupiter.ClientAPI, store db.Store, cache PriceHistoryCache) *Service {
@@ -28,6 +51,7 @@ func NewService(birdeyeClient birdeye.ClientAPI, jupiterClient jupiter.ClientAPI
 		jupiterClient: jupiterClient,
 		store:         store,
 		cache:         cache,
+		fetchPool:     workerpool.New(workerpool.Config{Name: "price_fetch"}),
 	}
 	return s
 }
@@ -84,17 +108,36 @@ func (s *Service) GetPriceHistory(ctx context.Context, address string, timeFrame
 		TimeTo:      roundedWindowEnd,
 	}
 
-	result, err := s.birdeyeClient.GetPriceHistory(ctx, params)
+	result, err := s.fetchPriceHistory(ctx, cacheKey, params, timeFrameConfig.Rounding)
 	if err != nil {
 		slog.Error("Failed to fetch price history from birdeye", "params", fmt.Sprintf("%+v", params), "error", err)
 		return nil, fmt.Errorf("failed to fetch price history from birdeye: %w", err)
 	}
 
-	s.cache.Set(cacheKey, result, timeFrameConfig.Rounding)
-
 	return result, nil
 }
 
+// fetchPriceHistory loads price history, from the store or Birdeye, and caches it. Concurrent
+// calls for the same (address, timeframe) key share a single upstream request.
+func (s *Service) fetchPriceHistory(ctx context.Context, cacheKey string, params birdeye.PriceHistoryParams, expiry time.Duration) (*birdeye.PriceHistory, error) {
+	result, err, shared := s.fetchGroup.Do(cacheKey, func() (any, error) {
+		history, err := s.loadPriceHistory(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		s.cache.Set(cacheKey, history, expiry)
+		return history, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if shared {
+		slog.DebugContext(ctx, "Price history fetch coalesced with in-flight request", "key", cacheKey)
+	}
+
+	return result.(*birdeye.PriceHistory), nil
+}
+
 // GetCoinPrices gets the prices for multiple coins
 // TODO: Should we update all the data instead of just returning the price?
 func (s *Service) GetCoinPrices(ctx context.Context, tokenAddresses []string) (map[string]float64, error) {
@@ -107,23 +150,20 @@ func (s *Service) GetCoinPrices(ctx context.Context, tokenAddresses []string) (m
 		return mockPrices, nil
 	}
 
-	// Use Birdeye's single token overview endpoint in parallel
+	// Recent live prices need no request
 	prices := make(map[string]float64)
+	if s.live != nil {
+		prices, tokenAddresses = s.live.Fresh(tokenAddresses)
+	}
+
+	// Use Birdeye's single token overview endpoint in parallel
+	fetched := make(map[string]float64, len(tokenAddresses))
 	var mu sync.Mutex
-	var wg sync.WaitGroup
 
 	// Limit concurrent requests to avoid rate limiting
-	semaphore := make(chan struct{}, 5)
-
-	for _, address := range tokenAddresses {
-		wg.Add(1)
-		go func(addr string) {
-			defer wg.Done()
-
-			// Acquire semaphore
-			semaphore <- struct{}{}
-			defer func() { <-semaphore }()
-
+	group := s.fetchPool.Group()
+	for _, addr := range tokenAddresses {
+		err := group.Go(ctx, func(ctx context.Context) error {
 			// Handle native SOL specially - use wrapped SOL address for price data
 			apiAddress := addr
 			if addr == model.NativeSolMint { // Native SOL
@@ -135,19 +175,27 @@ func (s *Service) GetCoinPrices(ctx context.Context, tokenAddresses []string) (m
 			overview, err := s.birdeyeClient.GetTokenOverview(ctx, apiAddress)
 			if err != nil {
 				slog.Warn("Failed to get price for token", "address", addr, "api_address", apiAddress, "error", err)
-				return
+				return err
 			}
 
 			// Store the price using the original address
 			mu.Lock()
-			prices[addr] = overview.Data.Price
+			fetched[addr] = overview.Data.Price
 			mu.Unlock()
 
 			slog.DebugContext(ctx, "Successfully fetched price", "address", addr, "api_address", apiAddress, "price", overview.Data.Price)
-		}(address)
+			return nil
+		})
+		if err != nil {
+			break // Cancelled while waiting for room in the queue
+		}
+	}
+	group.Wait()
+	sanitizePrices(ctx, fetched)
+	maps.Copy(prices, fetched)
+	if s.live != nil {
+		s.live.Store(fetched)
 	}
-
-	wg.Wait()
 
 	// Log any missing prices
 	for _, addr := range tokenAddresses {
@@ -159,6 +207,57 @@ func (s *Service) GetCoinPrices(ctx context.Context, tokenAddresses []string) (m
 	return prices, nil
 }
 
+// GetPricesBatch gets current USD prices for many tokens using Birdeye's multi price
+// endpoint (one call per birdeye.MaxMultiPriceAddresses tokens) instead of one overview call per token.
+func (s *Service) GetPricesBatch(ctx context.Context, tokenAddresses []string) (map[string]float64, error) {
+	if len(tokenAddresses) == 0 {
+		return map[string]float64{}, nil
+	}
+
+	if debugMode, ok := ctx.Value(model.DebugModeKey).(bool); ok && debugMode {
+		slog.Info("x-debug-mode: true for GetPricesBatch, returning random prices")
+		mockPrices := make(map[string]float64, len(tokenAddresses))
+		for _, addr := range tokenAddresses {
+			mockPrices[addr] = 1.0 + rand.Float64()
+		}
+		return mockPrices, nil
+	}
+
+	// Deduplicate while preserving order so repeated holdings don't count against the batch limit
+	seen := make(map[string]struct{}, len(tokenAddresses))
+	uniqueAddresses := make([]string, 0, len(tokenAddresses))
+	for _, addr := range tokenAddresses {
+		if _, ok := seen[addr]; ok {
+			continue
+		}
+		seen[addr] = struct{}{}
+		uniqueAddresses = append(uniqueAddresses, addr)
+	}
+
+	// Recent live prices need no request
+	prices := make(map[string]float64, len(uniqueAddresses))
+	if s.live != nil {
+		prices, uniqueAddresses = s.live.Fresh(uniqueAddresses)
+	}
+
+	// Larger requests are split into chunks the multi price endpoint accepts
+	for start := 0; start < len(uniqueAddresses); start += birdeye.MaxMultiPriceAddresses {
+		end := min(start+birdeye.MaxMultiPriceAddresses, len(uniqueAddresses))
+		chunkPrices, err := s.birdeyeClient.GetMultiPrice(ctx, uniqueAddresses[start:end])
+		if err != nil {
+			return nil, fmt.Errorf("failed to get batch prices from birdeye: %w", err)
+		}
+		sanitizePrices(ctx, chunkPrices)
+		maps.Copy(prices, chunkPrices)
+		if s.live != nil {
+			s.live.Store(chunkPrices)
+		}
+	}
+
+	slog.DebugContext(ctx, "Fetched batch prices", "requested", len(uniqueAddresses), "found", len(prices))
+	return prices, nil
+}
+
 func (s *Service) generateRandomPriceHistory() (*birdeye.PriceHistory, error) {
 	numPoints := 100
 	volatility := 0.03
@@ -224,56 +323,32 @@ func (s *Service) GetPriceHistoriesByAddresses(ctx context.Context, requests []P
 	}
 
 	// Use parallel processing with worker pool
-	maxWorkers := s.birdeyeClient.GetMaxWorkers()
-	const bufferSize = 10
-
-	type priceHistoryJob struct {
-		request PriceHistoryBatchRequest
-	}
+	s.fetchPool.SetMaxWorkers(s.birdeyeClient.GetMaxWorkers())
 
 	type priceHistoryResult struct {
 		address string
 		result  *PriceHistoryBatchResult
 	}
 
-	// Create job and result channels
-	jobs := make(chan priceHistoryJob, bufferSize)
+	// Results are buffered so tasks never block
 	results := make(chan priceHistoryResult, len(requests))
-
-	// Start worker goroutines
-	var wg sync.WaitGroup
-	for i := range maxWorkers {
-		wg.Add(1)
-		go func(workerID int) {
-			defer wg.Done()
-			for job := range jobs {
-				result := s.fetchSinglePriceHistory(ctx, job.request, workerID)
-				results <- priceHistoryResult{
-					address: job.request.Address,
-					result:  result,
-				}
-			}
-		}(i)
-	}
-
-	// Send jobs to workers
-	go func() {
-		defer close(jobs)
-		for _, request := range requests {
-			select {
-			case jobs <- priceHistoryJob{request: request}:
-			case <-ctx.Done():
-				slog.WarnContext(ctx, "Context cancelled while queueing price history jobs")
-				return
+	group := s.fetchPool.Group()
+	for _, request := range requests {
+		err := group.Go(ctx, func(ctx context.Context) error {
+			result, err := s.fetchSinglePriceHistory(ctx, request)
+			results <- priceHistoryResult{
+				address: request.Address,
+				result:  result,
 			}
+			return err
+		})
+		if err != nil {
+			slog.WarnContext(ctx, "Context cancelled while queueing price history jobs")
+			break
 		}
-	}()
-
-	// Wait for all workers to finish
-	go func() {
-		wg.Wait()
-		close(results)
-	}()
+	}
+	group.Wait()
+	close(results)
 
 	// Collect results
 	finalResults := make(map[string]*PriceHistoryBatchResult)
@@ -293,33 +368,33 @@ func (s *Service) GetPriceHistoriesByAddresses(ctx context.Context, requests []P
 	return finalResults, nil
 }
 
-// fetchSinglePriceHistory fetches price history for a single address (called by worker goroutines)
-func (s *Service) fetchSinglePriceHistory(ctx context.Context, request PriceHistoryBatchRequest, workerID int) *PriceHistoryBatchResult {
+// fetchSinglePriceHistory fetches price history for a single address (run on the fetch pool).
+// It also returns the BirdEye error of a failed fetch so the pool can back off.
+func (s *Service) fetchSinglePriceHistory(ctx context.Context, request PriceHistoryBatchRequest) (*PriceHistoryBatchResult, error) {
 	slog.DebugContext(ctx, "Worker fetching price history",
-		"worker_id", workerID,
 		"address", request.Address,
 		"type", request.Config.HistoryType)
 
 	// Check cache first
 	cacheKey := fmt.Sprintf("%s-%s", request.Address, request.Config.HistoryType)
 	if cachedData, found := s.cache.Get(cacheKey); found {
-		slog.DebugContext(ctx, "Worker found cached price history", "worker_id", workerID, "address", request.Address)
+		slog.DebugContext(ctx, "Worker found cached price history", "address", request.Address)
 		return &PriceHistoryBatchResult{
 			Data:         cachedData,
 			Success:      true,
 			ErrorMessage: "",
-		}
+		}, nil
 	}
 
 	// Parse the end time of the window (typically "now")
 	windowEnd, err := time.Parse(time.RFC3339, request.Time)
 	if err != nil {
-		slog.ErrorContext(ctx, "Worker failed to parse end time", "worker_id", workerID, "address", request.Address, "endTime", request.Time, "error", err)
+		slog.ErrorContext(ctx, "Worker failed to parse end time", "address", request.Address, "endTime", request.Time, "error", err)
 		return &PriceHistoryBatchResult{
 			Data:         nil,
 			Success:      false,
 			ErrorMessage: fmt.Sprintf("failed to parse end time: %v", err),
-		}
+		}, nil
 	}
 
 	// Calculate time window: [windowEnd - DefaultViewDuration, windowEnd]
@@ -334,7 +409,6 @@ func (s *Service) fetchSinglePriceHistory(ctx context.Context, request PriceHist
 	if roundedWindowEnd.Sub(roundedWindowStart) < minTimeSpan {
 		roundedWindowStart = roundedWindowEnd.Add(-request.Config.DefaultViewDuration)
 		slog.DebugContext(ctx, "Worker adjusted time range for minimum span",
-			"worker_id", workerID,
 			"address", request.Address,
 			"originalStart", roundedWindowStart.Add(request.Config.DefaultViewDuration),
 			"adjustedStart", roundedWindowStart,
@@ -349,10 +423,9 @@ func (s *Service) fetchSinglePriceHistory(ctx context.Context, request PriceHist
 		TimeTo:      roundedWindowEnd,
 	}
 
-	result, err := s.birdeyeClient.GetPriceHistory(ctx, params)
+	result, err := s.fetchPriceHistory(ctx, cacheKey, params, request.Config.Rounding)
 	if err != nil {
 		slog.ErrorContext(ctx, "Worker failed to fetch price history from birdeye",
-			"worker_id", workerID,
 			"address", request.Address,
 			"params", fmt.Sprintf("%+v", params),
 			"error", err)
@@ -360,14 +433,10 @@ func (s *Service) fetchSinglePriceHistory(ctx context.Context, request PriceHist
 			Data:         nil,
 			Success:      false,
 			ErrorMessage: fmt.Sprintf("failed to fetch price history: %v", err),
-		}
+		}, err
 	}
 
-	// Cache the result
-	s.cache.Set(cacheKey, result, request.Config.Rounding)
-
 	slog.DebugContext(ctx, "Worker successfully fetched price history",
-		"worker_id", workerID,
 		"address", request.Address,
 		"items_count", len(result.Data.Items))
 
@@ -375,7 +444,7 @@ func (s *Service) fetchSinglePriceHistory(ctx context.Context, request PriceHist
 		Data:         result,
 		Success:      true,
 		ErrorMessage: "",
-	}
+	}, nil
 }
 
 func roundDateDown(dateToRound time.Time, granularityMinutes time.Duration) time.Time {
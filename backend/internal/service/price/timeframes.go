@@ -0,0 +1,123 @@
+package price
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	pb "github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1"
+)
+
+// MaxHistoryPoints is the most points Birdeye returns for one price history request
+const MaxHistoryPoints = 1000
+
+// ErrInvalidHistoryRange is returned for custom price history ranges Birdeye cannot serve.
+var ErrInvalidHistoryRange = errors.New("invalid price history range")
+
+// granularities are the Birdeye history types, finest first
+var granularities = []string{"1m", "3m", "5m", "15m", "30m", "1H", "2H", "4H", "6H", "8H", "12H", "1D"}
+
+// ParseTimeframes parses timeframe overrides written as
+// TYPE=granularity/view/rounding, e.g. ONE_HOUR=1m/1h/2m: the timeframe's
+// chart shows the last view of granularity points, cached for rounding.
+func ParseTimeframes(entries []string) (map[pb.GetPriceHistoryRequest_PriceHistoryType]BackendTimeframeConfig, error) {
+	timeframes := make(map[pb.GetPriceHistoryRequest_PriceHistoryType]BackendTimeframeConfig, len(entries))
+	for _, entry := range entries {
+		name, spec, ok := strings.Cut(entry, "=")
+		value, known := pb.GetPriceHistoryRequest_PriceHistoryType_value[name]
+		if !ok || !known || value == int32(pb.GetPriceHistoryRequest_PRICE_HISTORY_TYPE_UNSPECIFIED) {
+			return nil, fmt.Errorf("%q must be a timeframe such as ONE_HOUR=1m/1h/2m", entry)
+		}
+		parts := strings.Split(spec, "/")
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("%q must be granularity/view/rounding", entry)
+		}
+		resolution, known := birdeyeResolutions[parts[0]]
+		if !known {
+			return nil, fmt.Errorf("%q: unknown granularity %q, expected one of %s", entry, parts[0], strings.Join(granularities, ", "))
+		}
+		view, err := time.ParseDuration(parts[1])
+		if err != nil || view <= 0 {
+			return nil, fmt.Errorf("%q: invalid view duration %q", entry, parts[1])
+		}
+		if view/resolution > MaxHistoryPoints {
+			return nil, fmt.Errorf("%q: %s of %s points exceeds the %d point limit", entry, view, parts[0], MaxHistoryPoints)
+		}
+		rounding, err := time.ParseDuration(parts[2])
+		if err != nil || rounding <= 0 {
+			return nil, fmt.Errorf("%q: invalid rounding %q", entry, parts[2])
+		}
+		historyType := pb.GetPriceHistoryRequest_PriceHistoryType(value)
+		timeframes[historyType] = BackendTimeframeConfig{
+			BirdeyeType:         parts[0],
+			DefaultViewDuration: view,
+			Rounding:            rounding,
+			HistoryType:         historyType.String(),
+		}
+	}
+	return timeframes, nil
+}
+
+// SetTimeframes overrides the configuration of the given timeframes; the
+// others keep their TimeframeConfigMap defaults
+func (s *Service) SetTimeframes(overrides map[pb.GetPriceHistoryRequest_PriceHistoryType]BackendTimeframeConfig) {
+	timeframes := maps.Clone(TimeframeConfigMap)
+	maps.Copy(timeframes, overrides)
+	s.timeframesMu.Lock()
+	s.timeframes = timeframes
+	s.timeframesMu.Unlock()
+}
+
+// Timeframe returns how the price history of a chart timeframe is fetched
+func (s *Service) Timeframe(historyType pb.GetPriceHistoryRequest_PriceHistoryType) (BackendTimeframeConfig, bool) {
+	s.timeframesMu.RLock()
+	defer s.timeframesMu.RUnlock()
+	timeframes := s.timeframes
+	if timeframes == nil {
+		timeframes = TimeframeConfigMap
+	}
+	config, ok := timeframes[historyType]
+	return config, ok
+}
+
+// CustomTimeframe returns the configuration for fetching the price history
+// between from and to at granularity, a Birdeye history type such as "5m".
+// Without a granularity the finest one within MaxHistoryPoints is used.
+// Errors wrap ErrInvalidHistoryRange.
+func CustomTimeframe(from, to time.Time, granularity string, now time.Time) (BackendTimeframeConfig, error) {
+	if !to.After(from) {
+		return BackendTimeframeConfig{}, fmt.Errorf("%w: from must be before to", ErrInvalidHistoryRange)
+	}
+	if to.After(now.Add(maxClockSkew)) {
+		return BackendTimeframeConfig{}, fmt.Errorf("%w: to is in the future", ErrInvalidHistoryRange)
+	}
+	span := to.Sub(from)
+	if granularity == "" {
+		i := slices.IndexFunc(granularities, func(g string) bool {
+			return span/birdeyeResolutions[g] <= MaxHistoryPoints
+		})
+		if i < 0 {
+			return BackendTimeframeConfig{}, fmt.Errorf("%w: range is too long", ErrInvalidHistoryRange)
+		}
+		granularity = granularities[i]
+	}
+	resolution, known := birdeyeResolutions[granularity]
+	if !known {
+		return BackendTimeframeConfig{}, fmt.Errorf("%w: unknown granularity %q, expected one of %s",
+			ErrInvalidHistoryRange, granularity, strings.Join(granularities, ", "))
+	}
+	if span/resolution > MaxHistoryPoints {
+		return BackendTimeframeConfig{}, fmt.Errorf("%w: %s of %s points exceeds the %d point limit, use a coarser granularity",
+			ErrInvalidHistoryRange, span, granularity, MaxHistoryPoints)
+	}
+	return BackendTimeframeConfig{
+		BirdeyeType:         granularity,
+		DefaultViewDuration: span,
+		Rounding:            resolution,
+		// Keys the cache by range so custom charts never share an entry with the standard ones
+		HistoryType: fmt.Sprintf("CUSTOM_%s_%d_%d", granularity, from.Unix(), to.Unix()),
+	}, nil
+}
@@ -0,0 +1,87 @@
+package price
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1"
+)
+
+func TestParseTimeframes(t *testing.T) {
+	timeframes, err := ParseTimeframes([]string{"ONE_HOUR=5m/2h/5m"})
+	require.NoError(t, err)
+	assert.Equal(t, BackendTimeframeConfig{
+		BirdeyeType:         "5m",
+		DefaultViewDuration: 2 * time.Hour,
+		Rounding:            5 * time.Minute,
+		HistoryType:         "ONE_HOUR",
+	}, timeframes[pb.GetPriceHistoryRequest_ONE_HOUR])
+
+	for _, entry := range []string{
+		"ONE_HOUR",
+		"TEN_HOUR=1m/1h/1m",
+		"PRICE_HISTORY_TYPE_UNSPECIFIED=1m/1h/1m",
+		"ONE_HOUR=1m/1h",
+		"ONE_HOUR=7m/1h/1m",
+		"ONE_HOUR=1m/soon/1m",
+		"ONE_HOUR=1m/48h/1m", // 2880 points
+	} {
+		_, err := ParseTimeframes([]string{entry})
+		assert.Error(t, err, entry)
+	}
+}
+
+func TestService_SetTimeframes(t *testing.T) {
+	s := NewService(nil, nil, nil, nil)
+	s.SetTimeframes(map[pb.GetPriceHistoryRequest_PriceHistoryType]BackendTimeframeConfig{
+		pb.GetPriceHistoryRequest_ONE_HOUR: {BirdeyeType: "5m", DefaultViewDuration: 2 * time.Hour, Rounding: 5 * time.Minute, HistoryType: "ONE_HOUR"},
+	})
+
+	config, ok := s.Timeframe(pb.GetPriceHistoryRequest_ONE_HOUR)
+	require.True(t, ok)
+	assert.Equal(t, "5m", config.BirdeyeType)
+
+	config, ok = s.Timeframe(pb.GetPriceHistoryRequest_ONE_DAY)
+	require.True(t, ok)
+	assert.Equal(t, TimeframeConfigMap[pb.GetPriceHistoryRequest_ONE_DAY], config)
+}
+
+func TestCustomTimeframe(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("picks the finest granularity within the point limit", func(t *testing.T) {
+		config, err := CustomTimeframe(now.Add(-48*time.Hour), now, "", now)
+		require.NoError(t, err)
+		assert.Equal(t, "3m", config.BirdeyeType)
+		assert.Equal(t, 48*time.Hour, config.DefaultViewDuration)
+		assert.Equal(t, 3*time.Minute, config.Rounding)
+	})
+
+	t.Run("keys each range separately", func(t *testing.T) {
+		a, err := CustomTimeframe(now.Add(-time.Hour), now, "1m", now)
+		require.NoError(t, err)
+		b, err := CustomTimeframe(now.Add(-2*time.Hour), now, "1m", now)
+		require.NoError(t, err)
+		assert.NotEqual(t, a.HistoryType, b.HistoryType)
+	})
+
+	for name, tc := range map[string]struct {
+		from, to    time.Time
+		granularity string
+	}{
+		"reversed":            {now, now.Add(-time.Hour), "1m"},
+		"future":              {now, now.Add(24 * time.Hour), "1H"},
+		"unknown granularity": {now.Add(-time.Hour), now, "7m"},
+		"too many points":     {now.Add(-30 * 24 * time.Hour), now, "1m"},
+		"too long":            {now.AddDate(-5, 0, 0), now, ""},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := CustomTimeframe(tc.from, tc.to, tc.granularity, now)
+			assert.True(t, errors.Is(err, ErrInvalidHistoryRange), err)
+		})
+	}
+}
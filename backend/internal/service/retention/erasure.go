@@ -0,0 +1,183 @@
+package retention
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/jobs"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/coin"
+	"github.com/nicolas-martin/dankfolio/backend/internal/util"
+)
+
+const (
+	// DefaultErasureInterval is the time between runs of queued erasures
+	DefaultErasureInterval = time.Minute
+
+	// maxErasureAttempts is how often an erasure is tried before it's
+	// marked failed for an operator to look at
+	maxErasureAttempts = 5
+	erasureBatchSize   = 20
+)
+
+// JobErasure names the processing of queued personal data erasures for scheduling
+const JobErasure = "retention.erasure"
+
+var (
+	// ErrInvalidWallet is returned when erasure is requested for a malformed address
+	ErrInvalidWallet = errors.New("invalid wallet address")
+	// ErrErasureIncomplete is returned when personal data is still found
+	// after it was erased
+	ErrErasureIncomplete = errors.New("personal data left after erasure")
+)
+
+// RequestErasure queues the erasure of a wallet's personal data: its linked
+// wallet record, trades, alerts, notification tokens and view history.
+// Requesting it again while queued returns the queued request; a failed
+// request is queued again.
+func (s *Service) RequestErasure(ctx context.Context, wallet string) (*model.DataDeletion, error) {
+	if !util.IsValidSolanaAddress(wallet) {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidWallet, wallet)
+	}
+	existing, err := s.store.DataDeletions().GetByField(ctx, "wallet_address", wallet)
+	switch {
+	case err == nil:
+		if existing.Status == model.DataDeletionQueued {
+			return existing, nil
+		}
+		existing.Status, existing.Attempts, existing.Error, existing.UpdatedAt = model.DataDeletionQueued, 0, "", s.now()
+		if err := s.store.DataDeletions().Update(ctx, existing); err != nil {
+			return nil, fmt.Errorf("failed to requeue data deletion %s: %w", existing.ID, err)
+		}
+		return existing, nil
+	case !errors.Is(err, db.ErrNotFound):
+		return nil, fmt.Errorf("failed to look up data deletion: %w", err)
+	}
+
+	now := s.now()
+	request := &model.DataDeletion{
+		ID:            uuid.NewString(),
+		WalletAddress: wallet,
+		Status:        model.DataDeletionQueued,
+		RequestedAt:   now,
+		UpdatedAt:     now,
+	}
+	if err := s.store.DataDeletions().Create(ctx, request); err != nil {
+		return nil, fmt.Errorf("failed to queue data deletion: %w", err)
+	}
+	slog.InfoContext(ctx, "Queued data deletion", "request_id", request.ID)
+	return request, nil
+}
+
+// ErasureStatus returns the erasure request with the ID, and its certificate
+// once completed. A completed request no longer names its wallet.
+func (s *Service) ErasureStatus(ctx context.Context, id string) (*model.DataDeletion, *model.DeletionCertificate, error) {
+	request, err := s.store.DataDeletions().Get(ctx, id)
+	if err == nil {
+		return request, nil, nil
+	}
+	if !errors.Is(err, db.ErrNotFound) {
+		return nil, nil, fmt.Errorf("failed to get data deletion %s: %w", id, err)
+	}
+	certificate, err := s.store.DeletionCertificates().Get(ctx, id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get deletion certificate %s: %w", id, err)
+	}
+	return &model.DataDeletion{
+		ID:          certificate.ID,
+		Status:      model.DataDeletionCompleted,
+		RequestedAt: certificate.RequestedAt,
+		UpdatedAt:   certificate.CompletedAt,
+	}, certificate, nil
+}
+
+// ProcessErasures erases the personal data of the queued requests. Each
+// request is erased, verified and certified in one transaction, so a failed
+// erasure leaves nothing half done and is retried on the next run.
+func (s *Service) ProcessErasures(ctx context.Context) error {
+	limit, sortBy := erasureBatchSize, "requested_at"
+	queued, _, err := s.store.DataDeletions().ListWithOpts(ctx, db.ListOptions{
+		Limit:   &limit,
+		SortBy:  &sortBy,
+		Filters: []db.FilterOption{{Field: "status", Operator: db.FilterOpEqual, Value: model.DataDeletionQueued}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list queued data deletions: %w", err)
+	}
+
+	var errs []error
+	for i := range queued {
+		request := &queued[i]
+		certificate, err := s.erase(ctx, request)
+		if err == nil {
+			slog.InfoContext(ctx, "Erased personal data", "request_id", request.ID, "erased", certificate.Erased)
+			continue
+		}
+		request.Attempts++
+		request.Error = err.Error()
+		request.UpdatedAt = s.now()
+		if request.Attempts >= maxErasureAttempts {
+			request.Status = model.DataDeletionFailed
+		}
+		slog.ErrorContext(ctx, "Failed to erase personal data", "request_id", request.ID, "attempts", request.Attempts, "error", err)
+		if err := s.store.DataDeletions().Update(ctx, request); err != nil {
+			errs = append(errs, fmt.Errorf("failed to record failed data deletion %s: %w", request.ID, err))
+			continue
+		}
+		errs = append(errs, fmt.Errorf("failed to erase data deletion %s: %w", request.ID, err))
+	}
+	return errors.Join(errs...)
+}
+
+func (s *Service) erase(ctx context.Context, request *model.DataDeletion) (*model.DeletionCertificate, error) {
+	subject := db.DataSubject{WalletAddress: request.WalletAddress, ViewerID: coin.ViewerID(request.WalletAddress)}
+	var certificate *model.DeletionCertificate
+	err := s.store.WithTransaction(ctx, func(tx db.Store) error {
+		erased, err := tx.ErasePersonalData(ctx, subject)
+		if err != nil {
+			return err
+		}
+		left, err := tx.CountPersonalData(ctx, subject)
+		if err != nil {
+			return err
+		}
+		for table, count := range left {
+			if count > 0 {
+				return fmt.Errorf("%w: %d rows in %s", ErrErasureIncomplete, count, table)
+			}
+		}
+
+		sum := sha256.Sum256([]byte(request.WalletAddress))
+		certificate = &model.DeletionCertificate{
+			ID:          request.ID,
+			SubjectHash: hex.EncodeToString(sum[:]),
+			Erased:      erased,
+			RequestedAt: request.RequestedAt,
+			CompletedAt: s.now(),
+		}
+		if err := tx.DeletionCertificates().Create(ctx, certificate); err != nil {
+			return fmt.Errorf("failed to write deletion certificate: %w", err)
+		}
+		return tx.DataDeletions().HardDelete(ctx, request.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return certificate, nil
+}
+
+// ErasureJob runs ProcessErasures every interval
+func (s *Service) ErasureJob(interval time.Duration) jobs.Job {
+	if interval <= 0 {
+		interval = DefaultErasureInterval
+	}
+	return jobs.Job{Name: JobErasure, Interval: interval, Run: s.ProcessErasures}
+}
@@ -0,0 +1,79 @@
+package retention
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	dbmocks "github.com/nicolas-martin/dankfolio/backend/internal/db/mocks"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/coin"
+)
+
+const erasedWallet = "So11111111111111111111111111111111111111112"
+
+func TestService_RequestErasureIsIdempotent(t *testing.T) {
+	ctx := context.Background()
+	deletions := dbmocks.NewMockRepository[model.DataDeletion](t)
+	store := dbmocks.NewMockStore(t)
+	store.EXPECT().DataDeletions().Return(deletions)
+	s := NewService(store, 0)
+
+	_, err := s.RequestErasure(ctx, "not-a-wallet")
+	assert.ErrorIs(t, err, ErrInvalidWallet)
+
+	deletions.EXPECT().GetByField(mock.Anything, "wallet_address", erasedWallet).Return(nil, db.ErrNotFound).Once()
+	deletions.EXPECT().Create(mock.Anything, mock.Anything).Return(nil).Once()
+	request, err := s.RequestErasure(ctx, erasedWallet)
+	require.NoError(t, err)
+	assert.Equal(t, model.DataDeletionQueued, request.Status)
+	assert.NotEmpty(t, request.ID)
+
+	deletions.EXPECT().GetByField(mock.Anything, "wallet_address", erasedWallet).Return(request, nil).Once()
+	again, err := s.RequestErasure(ctx, erasedWallet)
+	require.NoError(t, err)
+	assert.Equal(t, request.ID, again.ID, "a queued request is returned rather than queued twice")
+}
+
+func TestService_ProcessErasures(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	subject := db.DataSubject{WalletAddress: erasedWallet, ViewerID: coin.ViewerID(erasedWallet)}
+	erased := map[string]int64{"wallets": 1, "coin_views": 3}
+
+	deletions := dbmocks.NewMockRepository[model.DataDeletion](t)
+	certificates := dbmocks.NewMockRepository[model.DeletionCertificate](t)
+	store := dbmocks.NewMockStore(t)
+	store.EXPECT().DataDeletions().Return(deletions)
+	store.EXPECT().DeletionCertificates().Return(certificates)
+	store.EXPECT().WithTransaction(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, fn func(db.Store) error) error {
+		return fn(store)
+	})
+	s := NewService(store, 0)
+	s.now = func() time.Time { return now }
+
+	queued := []model.DataDeletion{{ID: "req-1", WalletAddress: erasedWallet, Status: model.DataDeletionQueued, RequestedAt: now.Add(-time.Hour)}}
+	deletions.EXPECT().ListWithOpts(mock.Anything, mock.Anything).Return(queued, int32(1), nil).Once()
+	store.EXPECT().ErasePersonalData(mock.Anything, subject).Return(erased, nil).Once()
+	store.EXPECT().CountPersonalData(mock.Anything, subject).Return(map[string]int64{"wallets": 0, "coin_views": 0}, nil).Once()
+	certificates.EXPECT().Create(mock.Anything, mock.MatchedBy(func(c *model.DeletionCertificate) bool {
+		return c.ID == "req-1" && c.Erased["coin_views"] == 3 && c.CompletedAt.Equal(now) && len(c.SubjectHash) == 64
+	})).Return(nil).Once()
+	deletions.EXPECT().HardDelete(mock.Anything, "req-1").Return(nil).Once()
+	require.NoError(t, s.ProcessErasures(ctx))
+
+	queued = []model.DataDeletion{{ID: "req-2", WalletAddress: erasedWallet, Status: model.DataDeletionQueued, Attempts: maxErasureAttempts - 1}}
+	deletions.EXPECT().ListWithOpts(mock.Anything, mock.Anything).Return(queued, int32(1), nil).Once()
+	store.EXPECT().ErasePersonalData(mock.Anything, subject).Return(erased, nil).Once()
+	store.EXPECT().CountPersonalData(mock.Anything, subject).Return(map[string]int64{"wallets": 1}, nil).Once()
+	deletions.EXPECT().Update(mock.Anything, mock.MatchedBy(func(d *model.DataDeletion) bool {
+		return d.Status == model.DataDeletionFailed && d.Attempts == maxErasureAttempts && d.Error != ""
+	})).Return(nil).Once()
+	err := s.ProcessErasures(ctx)
+	assert.ErrorIs(t, err, ErrErasureIncomplete, "data left after erasing fails verification")
+}
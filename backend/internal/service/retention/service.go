@@ -0,0 +1,255 @@
+// Package retention soft-deletes coins, burn watches and user data for
+// admins, so a mistaken deletion can be restored, and purges the records
+// for good once they have been deleted for longer than the retention period.
+// It also erases users' personal data on request, certifying each erasure.
+package retention
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/jobs"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+// Kinds of soft-deletable records
+const (
+	KindCoin                = "coin"
+	KindBurnWatch           = "burn_watch"
+	KindTrade               = "trade"
+	KindWallet              = "wallet"
+	KindWebhookSubscription = "webhook_subscription"
+)
+
+const (
+	// DefaultRetention is how long deleted records are kept for restoring
+	DefaultRetention = 30 * 24 * time.Hour
+	// DefaultPurgeInterval is the time between purges of expired records
+	DefaultPurgeInterval = 6 * time.Hour
+
+	defaultListLimit = 50
+	maxListLimit     = 500
+)
+
+// JobPurge names the purge of expired deleted records for scheduling
+const JobPurge = "retention.purge"
+
+// ErrUnknownKind is returned for a record kind that can't be soft-deleted
+var ErrUnknownKind = errors.New("unknown record kind")
+
+// Record is a soft-deleted record
+type Record struct {
+	Kind     string
+	Key      string // What admins identify the record by, e.g. a coin's address
+	Label    string // Human-readable summary, e.g. a coin's symbol
+	Deletion model.Deletion
+}
+
+// kind soft-deletes the records of one repository, which are looked up by
+// the key column admins know them by rather than their primary key
+type kind struct {
+	softDelete  func(ctx context.Context, store db.Store, key string, deletion model.Deletion) error
+	restore     func(ctx context.Context, store db.Store, key string) error
+	listDeleted func(ctx context.Context, store db.Store, limit, offset int) ([]Record, int, error)
+	purge       func(ctx context.Context, store db.Store, before time.Time) (int64, error)
+}
+
+func newKind[T db.Entity](name, keyColumn string, repo func(db.Store) db.Repository[T], id func(T) string, record func(T) Record) kind {
+	findDeleted := func(ctx context.Context, store db.Store, key string) (*T, error) {
+		limit := 1
+		items, _, err := repo(store).ListWithOpts(ctx, db.ListOptions{
+			Limit:   &limit,
+			Filters: []db.FilterOption{{Field: keyColumn, Operator: db.FilterOpEqual, Value: key}},
+			Deleted: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(items) == 0 {
+			return nil, fmt.Errorf("%w: deleted %s %s", db.ErrNotFound, name, key)
+		}
+		return &items[0], nil
+	}
+	return kind{
+		softDelete: func(ctx context.Context, store db.Store, key string, deletion model.Deletion) error {
+			item, err := repo(store).GetByField(ctx, keyColumn, key)
+			if err != nil {
+				return err
+			}
+			return repo(store).SoftDelete(ctx, id(*item), deletion)
+		},
+		restore: func(ctx context.Context, store db.Store, key string) error {
+			item, err := findDeleted(ctx, store, key)
+			if err != nil {
+				return err
+			}
+			return repo(store).Restore(ctx, id(*item))
+		},
+		listDeleted: func(ctx context.Context, store db.Store, limit, offset int) ([]Record, int, error) {
+			sortBy, desc := "deleted_at", true
+			items, total, err := repo(store).ListWithOpts(ctx, db.ListOptions{
+				Limit:    &limit,
+				Offset:   &offset,
+				SortBy:   &sortBy,
+				SortDesc: &desc,
+				Deleted:  true,
+			})
+			if err != nil {
+				return nil, 0, err
+			}
+			records := make([]Record, len(items))
+			for i, item := range items {
+				records[i] = record(item)
+				records[i].Kind = name
+			}
+			return records, int(total), nil
+		},
+		purge: func(ctx context.Context, store db.Store, before time.Time) (int64, error) {
+			return repo(store).PurgeDeleted(ctx, before)
+		},
+	}
+}
+
+func deletionOf(deleted *model.Deletion) model.Deletion {
+	if deleted == nil {
+		return model.Deletion{}
+	}
+	return *deleted
+}
+
+// kindOrder is the order kinds are listed and purged in
+var kindOrder = []string{KindCoin, KindBurnWatch, KindTrade, KindWallet, KindWebhookSubscription}
+
+var kinds = map[string]kind{
+	KindCoin: newKind(KindCoin, "address", db.Store.Coins,
+		func(c model.Coin) string { return strconv.FormatUint(c.ID, 10) },
+		func(c model.Coin) Record {
+			return Record{Key: c.Address, Label: c.Symbol, Deletion: deletionOf(c.Deleted)}
+		}),
+	KindBurnWatch: newKind(KindBurnWatch, "mint", db.Store.BurnWatches,
+		func(w model.BurnWatch) string { return w.Mint },
+		func(w model.BurnWatch) Record {
+			return Record{Key: w.Mint, Label: w.Kind + " " + w.CoinAddress, Deletion: deletionOf(w.Deleted)}
+		}),
+	KindTrade: newKind(KindTrade, "id", db.Store.Trades,
+		model.Trade.GetID,
+		func(t model.Trade) Record {
+			return Record{Key: t.GetID(), Label: t.Type + " " + t.CoinSymbol, Deletion: deletionOf(t.Deleted)}
+		}),
+	KindWallet: newKind(KindWallet, "public_key", db.Store.Wallet,
+		model.Wallet.GetID,
+		func(w model.Wallet) Record {
+			return Record{Key: w.PublicKey, Deletion: deletionOf(w.Deleted)}
+		}),
+	KindWebhookSubscription: newKind(KindWebhookSubscription, "id", db.Store.WebhookSubscriptions,
+		model.WebhookSubscription.GetID,
+		func(w model.WebhookSubscription) Record {
+			return Record{Key: w.ID, Label: w.PartnerName, Deletion: deletionOf(w.Deleted)}
+		}),
+}
+
+// Kinds returns the kinds of records that can be soft-deleted
+func Kinds() []string {
+	return slices.Clone(kindOrder)
+}
+
+// Service soft-deletes, restores and purges records
+type Service struct {
+	store     db.Store
+	retention time.Duration
+	now       func() time.Time
+}
+
+// NewService creates a retention service that keeps deleted records for
+// retention, DefaultRetention when zero
+func NewService(store db.Store, retention time.Duration) *Service {
+	return &Service{store: store, retention: cmp.Or(retention, DefaultRetention), now: time.Now}
+}
+
+func lookupKind(name string) (kind, error) {
+	k, ok := kinds[name]
+	if !ok {
+		return kind{}, fmt.Errorf("%w: %q", ErrUnknownKind, name)
+	}
+	return k, nil
+}
+
+// Delete soft-deletes the record of the kind identified by key
+func (s *Service) Delete(ctx context.Context, kindName, key, deletedBy, reason string) error {
+	k, err := lookupKind(kindName)
+	if err != nil {
+		return err
+	}
+	deletion := model.Deletion{At: s.now(), By: deletedBy, Reason: reason}
+	if err := k.softDelete(ctx, s.store, key, deletion); err != nil {
+		return fmt.Errorf("failed to delete %s %s: %w", kindName, key, err)
+	}
+	slog.InfoContext(ctx, "Soft-deleted record", "kind", kindName, "key", key, "by", deletedBy, "reason", reason)
+	return nil
+}
+
+// Restore undoes the deletion of the record of the kind identified by key
+func (s *Service) Restore(ctx context.Context, kindName, key string) error {
+	k, err := lookupKind(kindName)
+	if err != nil {
+		return err
+	}
+	if err := k.restore(ctx, s.store, key); err != nil {
+		return fmt.Errorf("failed to restore %s %s: %w", kindName, key, err)
+	}
+	slog.InfoContext(ctx, "Restored record", "kind", kindName, "key", key)
+	return nil
+}
+
+// ListDeleted returns a page of the deleted records of a kind, most
+// recently deleted first, and how many there are
+func (s *Service) ListDeleted(ctx context.Context, kindName string, limit, offset int) ([]Record, int, error) {
+	k, err := lookupKind(kindName)
+	if err != nil {
+		return nil, 0, err
+	}
+	limit = min(cmp.Or(limit, defaultListLimit), maxListLimit)
+	records, total, err := k.listDeleted(ctx, s.store, limit, max(offset, 0))
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list deleted %s records: %w", kindName, err)
+	}
+	return records, total, nil
+}
+
+// Retention returns how long deleted records are kept
+func (s *Service) Retention() time.Duration {
+	return s.retention
+}
+
+// Purge permanently removes the records deleted longer than the retention
+// period ago
+func (s *Service) Purge(ctx context.Context) error {
+	before := s.now().Add(-s.retention)
+	var errs []error
+	for _, name := range kindOrder {
+		purged, err := kinds[name].purge(ctx, s.store, before)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to purge deleted %s records: %w", name, err))
+			continue
+		}
+		if purged > 0 {
+			slog.InfoContext(ctx, "Purged deleted records", "kind", name, "count", purged, "deleted_before", before)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// PurgeJob runs Purge every interval
+func (s *Service) PurgeJob(interval time.Duration) jobs.Job {
+	if interval <= 0 {
+		interval = DefaultPurgeInterval
+	}
+	return jobs.Job{Name: JobPurge, Interval: interval, Run: s.Purge}
+}
@@ -0,0 +1,66 @@
+package retention
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	dbmocks "github.com/nicolas-martin/dankfolio/backend/internal/db/mocks"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+func TestService_DeleteAndRestoreByKey(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	coins := dbmocks.NewMockRepository[model.Coin](t)
+	store := dbmocks.NewMockStore(t)
+	store.EXPECT().Coins().Return(coins)
+	s := NewService(store, 0)
+	s.now = func() time.Time { return now }
+
+	coins.EXPECT().GetByField(mock.Anything, "address", "mintA").Return(&model.Coin{ID: 7, Address: "mintA"}, nil).Once()
+	coins.EXPECT().SoftDelete(mock.Anything, "7", model.Deletion{At: now, By: "admin", Reason: "scam"}).Return(nil).Once()
+	require.NoError(t, s.Delete(ctx, KindCoin, "mintA", "admin", "scam"), "coins are deleted by address, through their ID")
+
+	coins.EXPECT().ListWithOpts(mock.Anything, mock.MatchedBy(func(opts db.ListOptions) bool {
+		return opts.Deleted && len(opts.Filters) == 1 && opts.Filters[0].Value == "mintA"
+	})).Return([]model.Coin{{ID: 7, Address: "mintA"}}, int32(1), nil).Once()
+	coins.EXPECT().Restore(mock.Anything, "7").Return(nil).Once()
+	require.NoError(t, s.Restore(ctx, KindCoin, "mintA"))
+
+	coins.EXPECT().ListWithOpts(mock.Anything, mock.Anything).Return(nil, int32(0), nil).Once()
+	assert.ErrorIs(t, s.Restore(ctx, KindCoin, "gone"), db.ErrNotFound)
+
+	assert.ErrorIs(t, s.Delete(ctx, "naughty_word", "x", "admin", ""), ErrUnknownKind)
+}
+
+func TestService_PurgeEveryKind(t *testing.T) {
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	before := now.Add(-48 * time.Hour)
+	coins := dbmocks.NewMockRepository[model.Coin](t)
+	coins.EXPECT().PurgeDeleted(mock.Anything, before).Return(2, nil).Once()
+	watches := dbmocks.NewMockRepository[model.BurnWatch](t)
+	watches.EXPECT().PurgeDeleted(mock.Anything, before).Return(0, assert.AnError).Once()
+	trades := dbmocks.NewMockRepository[model.Trade](t)
+	trades.EXPECT().PurgeDeleted(mock.Anything, before).Return(0, nil).Once()
+	wallets := dbmocks.NewMockRepository[model.Wallet](t)
+	wallets.EXPECT().PurgeDeleted(mock.Anything, before).Return(1, nil).Once()
+	subscriptions := dbmocks.NewMockRepository[model.WebhookSubscription](t)
+	subscriptions.EXPECT().PurgeDeleted(mock.Anything, before).Return(0, nil).Once()
+	store := dbmocks.NewMockStore(t)
+	store.EXPECT().Coins().Return(coins)
+	store.EXPECT().BurnWatches().Return(watches)
+	store.EXPECT().Trades().Return(trades)
+	store.EXPECT().Wallet().Return(wallets)
+	store.EXPECT().WebhookSubscriptions().Return(subscriptions)
+
+	s := NewService(store, 48*time.Hour)
+	s.now = func() time.Time { return now }
+	err := s.Purge(context.Background())
+	assert.ErrorIs(t, err, assert.AnError, "a failing kind is reported once the others are purged")
+}
@@ -0,0 +1,194 @@
+// Package status reports the health of the backend's dependencies from the
+// calls recently made to them, for the app's degraded service banner and the
+// public status page
+package status
+
+import (
+	"context"
+	"log/slog"
+	"maps"
+	"sync"
+	"time"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/tracker"
+)
+
+// Level is how well a dependency, or the service as a whole, is doing
+type Level string
+
+const (
+	LevelOperational Level = "operational"
+	LevelDegraded    Level = "degraded" // Slow or failing some calls
+	LevelDown        Level = "down"     // Failing most calls
+	LevelUnknown     Level = "unknown"  // Too few recent calls to tell
+)
+
+// severity orders levels from best to worst; unknown says nothing either way
+func (l Level) severity() int {
+	switch l {
+	case LevelDegraded:
+		return 2
+	case LevelDown:
+		return 3
+	case LevelOperational:
+		return 1
+	}
+	return 0
+}
+
+// Dependency is an upstream the service relies on
+type Dependency struct {
+	Name      string        // As reported, e.g. "solana_rpc"
+	Service   string        // Name its calls are tracked under, e.g. "solana"
+	SlowAfter time.Duration // p95 latency from which it is degraded
+}
+
+// DefaultDependencies are the upstreams every deployment has, and S3 where
+// images are stored
+var DefaultDependencies = []Dependency{
+	{Name: "postgres", Service: "postgres", SlowAfter: 250 * time.Millisecond},
+	{Name: "birdeye", Service: "birdeye", SlowAfter: 2 * time.Second},
+	{Name: "jupiter", Service: "jupiter", SlowAfter: 2 * time.Second},
+	{Name: "solana_rpc", Service: "solana", SlowAfter: 1500 * time.Millisecond},
+	{Name: "s3", Service: "s3", SlowAfter: 2 * time.Second},
+}
+
+const (
+	DefaultDegradedErrorRate = 0.1
+	DefaultDownErrorRate     = 0.5
+	DefaultMinCalls          = 3
+	DefaultProbeInterval     = 30 * time.Second
+
+	// probeTimeout bounds a health probe; a slower one counts as failed
+	probeTimeout = 5 * time.Second
+)
+
+// Config decides how dependencies are judged. Zero values use the defaults.
+type Config struct {
+	Dependencies      []Dependency
+	DegradedErrorRate float64       // Error rate from which a dependency is degraded
+	DownErrorRate     float64       // Error rate from which a dependency is down
+	MinCalls          int64         // Recent calls needed to judge a dependency
+	ProbeInterval     time.Duration // How often probed dependencies are checked
+}
+
+func (c Config) withDefaults() Config {
+	if c.Dependencies == nil {
+		c.Dependencies = DefaultDependencies
+	}
+	if c.DegradedErrorRate <= 0 {
+		c.DegradedErrorRate = DefaultDegradedErrorRate
+	}
+	if c.DownErrorRate <= 0 {
+		c.DownErrorRate = DefaultDownErrorRate
+	}
+	if c.MinCalls <= 0 {
+		c.MinCalls = DefaultMinCalls
+	}
+	if c.ProbeInterval <= 0 {
+		c.ProbeInterval = DefaultProbeInterval
+	}
+	return c
+}
+
+// Probe checks a dependency that isn't otherwise called through the tracker,
+// e.g. by pinging the database
+type Probe func(ctx context.Context) error
+
+// DependencyStatus is a dependency's health over the last tracker.RecentWindow
+type DependencyStatus struct {
+	Name  string
+	Level Level
+	tracker.RecentStats
+}
+
+// Status is the health of the service and each of its dependencies
+type Status struct {
+	Level        Level // The worst of the dependencies'
+	Dependencies []DependencyStatus
+	Window       time.Duration
+	CheckedAt    time.Time
+}
+
+// Monitor judges dependencies by the calls the tracker recently saw
+type Monitor struct {
+	tracker *tracker.APITracker
+	config  Config
+
+	mu     sync.Mutex
+	probes map[string]Probe // By dependency name
+}
+
+// NewMonitor creates a monitor of the calls recorded by t
+func NewMonitor(t *tracker.APITracker, config Config) *Monitor {
+	return &Monitor{tracker: t, config: config.withDefaults(), probes: make(map[string]Probe)}
+}
+
+// SetProbe makes Run check the named dependency with probe. Its calls are
+// tracked like any other, so it is judged the same way.
+func (m *Monitor) SetProbe(dependency string, probe Probe) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.probes[dependency] = probe
+}
+
+// Run probes the dependencies that have a probe every ProbeInterval until ctx
+// is done
+func (m *Monitor) Run(ctx context.Context) {
+	ticker := time.NewTicker(m.config.ProbeInterval)
+	defer ticker.Stop()
+	for {
+		m.probe(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (m *Monitor) probe(ctx context.Context) {
+	m.mu.Lock()
+	probes := maps.Clone(m.probes)
+	m.mu.Unlock()
+	for _, dep := range m.config.Dependencies {
+		probe, ok := probes[dep.Name]
+		if !ok {
+			continue
+		}
+		err := m.tracker.InstrumentCall(ctx, dep.Service, "health", func(ctx context.Context) error {
+			ctx, cancel := context.WithTimeout(ctx, probeTimeout)
+			defer cancel()
+			return probe(ctx)
+		})
+		if err != nil && ctx.Err() == nil {
+			slog.WarnContext(ctx, "Dependency health probe failed", slog.String("dependency", dep.Name), slog.Any("error", err))
+		}
+	}
+}
+
+// Status judges every dependency by its recent calls
+func (m *Monitor) Status() Status {
+	status := Status{Level: LevelUnknown, Window: tracker.RecentWindow, CheckedAt: time.Now()}
+	for _, dep := range m.config.Dependencies {
+		recent := m.tracker.RecentStats(dep.Service)
+		level := m.judge(dep, recent)
+		status.Dependencies = append(status.Dependencies, DependencyStatus{Name: dep.Name, Level: level, RecentStats: recent})
+		if level.severity() > status.Level.severity() {
+			status.Level = level
+		}
+	}
+	return status
+}
+
+func (m *Monitor) judge(dep Dependency, recent tracker.RecentStats) Level {
+	switch {
+	case recent.Calls < m.config.MinCalls:
+		return LevelUnknown
+	case recent.ErrorRate() >= m.config.DownErrorRate:
+		return LevelDown
+	case recent.ErrorRate() >= m.config.DegradedErrorRate, dep.SlowAfter > 0 && recent.P95 >= dep.SlowAfter:
+		return LevelDegraded
+	}
+	return LevelOperational
+}
@@ -0,0 +1,68 @@
+package status
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/tracker"
+)
+
+func TestMonitorStatus(t *testing.T) {
+	tr, err := tracker.NewAPITracker(nil)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	// Healthy, a slow one, a failing one and one that wasn't called
+	for i := 0; i < 10; i++ {
+		tr.RecordEndpointCall(ctx, "birdeye", "price", 100*time.Millisecond, nil)
+		tr.RecordEndpointCall(ctx, "jupiter", "quote", 3*time.Second, nil)
+		var rpcErr error
+		if i%2 == 0 {
+			rpcErr = errors.New("unhealthy")
+		}
+		tr.RecordEndpointCall(ctx, "solana", "primary", 50*time.Millisecond, rpcErr)
+	}
+
+	monitor := NewMonitor(tr, Config{Dependencies: []Dependency{
+		{Name: "birdeye", Service: "birdeye", SlowAfter: time.Second},
+		{Name: "jupiter", Service: "jupiter", SlowAfter: time.Second},
+		{Name: "solana_rpc", Service: "solana"},
+		{Name: "s3", Service: "s3"},
+	}})
+	status := monitor.Status()
+
+	levels := make(map[string]Level)
+	for _, dep := range status.Dependencies {
+		levels[dep.Name] = dep.Level
+	}
+	assert.Equal(t, map[string]Level{
+		"birdeye":    LevelOperational,
+		"jupiter":    LevelDegraded,
+		"solana_rpc": LevelDown,
+		"s3":         LevelUnknown,
+	}, levels)
+	assert.Equal(t, LevelDown, status.Level)
+	assert.Equal(t, tracker.RecentWindow, status.Window)
+	assert.Equal(t, 3*time.Second, status.Dependencies[1].P95)
+}
+
+func TestMonitorProbe(t *testing.T) {
+	tr, err := tracker.NewAPITracker(nil)
+	require.NoError(t, err)
+
+	monitor := NewMonitor(tr, Config{Dependencies: []Dependency{{Name: "postgres", Service: "postgres"}}, MinCalls: 1})
+	assert.Equal(t, LevelUnknown, monitor.Status().Level)
+
+	monitor.SetProbe("postgres", func(context.Context) error { return nil })
+	monitor.probe(context.Background())
+	assert.Equal(t, LevelOperational, monitor.Status().Level)
+
+	monitor.SetProbe("postgres", func(context.Context) error { return errors.New("connection refused") })
+	monitor.probe(context.Background())
+	assert.Equal(t, LevelDown, monitor.Status().Level)
+}
@@ -0,0 +1,213 @@
+package trade
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"slices"
+
+	solanago "github.com/gagliardetto/solana-go"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients"
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/jupiter"
+	bmodel "github.com/nicolas-martin/dankfolio/backend/internal/model/blockchain"
+)
+
+const (
+	// MaxComputeUnitLimit is the most compute units a transaction may request.
+	MaxComputeUnitLimit = 1_400_000
+
+	// DefaultComputeUnitMargin is the fraction added on top of the simulated units.
+	DefaultComputeUnitMargin = 0.15
+	// DefaultPriorityFeePercentile is the percentile of recent prices paid.
+	DefaultPriorityFeePercentile = 75
+	// DefaultMaxPriorityFeeLamports caps the total priority fee, matching the
+	// ceiling Jupiter is asked to respect.
+	DefaultMaxPriorityFeeLamports = 1_000_000
+
+	// Compute budget program instruction discriminators
+	setComputeUnitLimitInstruction = 2
+	setComputeUnitPriceInstruction = 3
+	microLamportsPerLamport        = 1_000_000
+)
+
+// ComputeBudgetConfig tunes how swap transactions are budgeted. Zero values
+// use the defaults.
+type ComputeBudgetConfig struct {
+	Margin                 float64 // Fraction added to the simulated compute units
+	FeePercentile          int     // Percentile of recent prioritization fees to pay, 1-100
+	MinMicroLamports       uint64  // Floor on the compute unit price
+	MaxPriorityFeeLamports uint64  // Cap on limit × price
+}
+
+func (c ComputeBudgetConfig) withDefaults() ComputeBudgetConfig {
+	if c.Margin <= 0 {
+		c.Margin = DefaultComputeUnitMargin
+	}
+	if c.FeePercentile <= 0 || c.FeePercentile > 100 {
+		c.FeePercentile = DefaultPriorityFeePercentile
+	}
+	if c.MaxPriorityFeeLamports == 0 {
+		c.MaxPriorityFeeLamports = DefaultMaxPriorityFeeLamports
+	}
+	return c
+}
+
+// computeBudgeter replaces the compute budget Jupiter picks for a swap with
+// one measured by simulating the transaction, priced from the fees recently
+// paid to write the same accounts.
+type computeBudgeter struct {
+	chainClient clients.GenericClientAPI
+	config      ComputeBudgetConfig
+	commitment  string
+}
+
+func newComputeBudgeter(chainClient clients.GenericClientAPI, config ComputeBudgetConfig) *computeBudgeter {
+	return &computeBudgeter{
+		chainClient: chainClient,
+		config:      config.withDefaults(),
+		commitment:  bmodel.DefaultCommitment,
+	}
+}
+
+// apply rewrites the compute budget instructions of swap's transaction in
+// place. Only instructions Jupiter already added are changed, so the message
+// layout and its address lookups stay as they were.
+func (b *computeBudgeter) apply(ctx context.Context, swap *jupiter.SwapResponse) error {
+	tx, err := solanago.TransactionFromBase64(swap.SwapTransaction)
+	if err != nil {
+		return fmt.Errorf("failed to decode swap transaction: %w", err)
+	}
+	limitIx, priceIx := findComputeBudgetInstructions(tx)
+	if limitIx == nil {
+		return errors.New("swap transaction has no compute unit limit instruction")
+	}
+
+	// Simulate with the most units and no priority fee, so neither the
+	// current limit nor the fee payer's balance skews the measurement
+	setComputeUnitLimit(limitIx, MaxComputeUnitLimit)
+	if priceIx != nil {
+		setComputeUnitPrice(priceIx, 0)
+	}
+	raw, err := tx.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("failed to encode swap transaction: %w", err)
+	}
+	simulation, err := b.chainClient.SimulateTransaction(ctx, raw, b.commitment)
+	if err != nil {
+		return err
+	}
+	if simulation.Err != nil {
+		return fmt.Errorf("swap simulation failed: %v", simulation.Err)
+	}
+	if simulation.UnitsConsumed == 0 {
+		return errors.New("swap simulation reported no compute units consumed")
+	}
+
+	margin := uint64(math.Round(float64(simulation.UnitsConsumed) * b.config.Margin))
+	limit := min(simulation.UnitsConsumed+margin, MaxComputeUnitLimit)
+	setComputeUnitLimit(limitIx, uint32(limit))
+
+	var price uint64
+	if priceIx != nil {
+		price = b.price(ctx, tx, limit, uint64(swap.PrioritizationType.ComputeBudget.MicroLamports))
+		setComputeUnitPrice(priceIx, price)
+	}
+
+	encoded, err := tx.ToBase64()
+	if err != nil {
+		return fmt.Errorf("failed to encode swap transaction: %w", err)
+	}
+	slog.DebugContext(ctx, "Budgeted swap compute units",
+		"jupiter_limit", swap.ComputeUnitLimit,
+		"simulated_units", simulation.UnitsConsumed,
+		"limit", limit,
+		"micro_lamports", price)
+
+	swap.SwapTransaction = encoded
+	swap.ComputeUnitLimit = int64(limit)
+	swap.PrioritizationType.ComputeBudget.MicroLamports = int64(price)
+	swap.PrioritizationFeeLamports = int64(priorityFeeLamports(limit, price))
+	return nil
+}
+
+// price picks the compute unit price from the fees recently paid by
+// transactions writing the swap's accounts, keeping Jupiter's price if they
+// cannot be read. The total priority fee never exceeds the configured cap.
+func (b *computeBudgeter) price(ctx context.Context, tx *solanago.Transaction, limit, fallback uint64) uint64 {
+	price := fallback
+	fees, err := b.chainClient.GetRecentPrioritizationFees(ctx, writableAccounts(tx))
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to get recent prioritization fees, keeping Jupiter's price", "error", err)
+	} else if len(fees) > 0 {
+		price = percentile(fees, b.config.FeePercentile)
+	}
+	price = max(price, b.config.MinMicroLamports)
+	return min(price, b.config.MaxPriorityFeeLamports*microLamportsPerLamport/limit)
+}
+
+// findComputeBudgetInstructions returns the compute unit limit and price
+// instructions of tx, nil for any that is missing
+func findComputeBudgetInstructions(tx *solanago.Transaction) (limitIx, priceIx *solanago.CompiledInstruction) {
+	for i := range tx.Message.Instructions {
+		ix := &tx.Message.Instructions[i]
+		program, err := tx.Message.Program(ix.ProgramIDIndex)
+		if err != nil || !program.Equals(solanago.ComputeBudget) || len(ix.Data) == 0 {
+			continue
+		}
+		switch {
+		case ix.Data[0] == setComputeUnitLimitInstruction && len(ix.Data) == 5:
+			limitIx = ix
+		case ix.Data[0] == setComputeUnitPriceInstruction && len(ix.Data) == 9:
+			priceIx = ix
+		}
+	}
+	return limitIx, priceIx
+}
+
+func setComputeUnitLimit(ix *solanago.CompiledInstruction, units uint32) {
+	data := make([]byte, 5)
+	data[0] = setComputeUnitLimitInstruction
+	binary.LittleEndian.PutUint32(data[1:], units)
+	ix.Data = data
+}
+
+func setComputeUnitPrice(ix *solanago.CompiledInstruction, microLamports uint64) {
+	data := make([]byte, 9)
+	data[0] = setComputeUnitPriceInstruction
+	binary.LittleEndian.PutUint64(data[1:], microLamports)
+	ix.Data = data
+}
+
+// writableAccounts returns the writable accounts listed in tx's message.
+// Accounts loaded from lookup tables are left out, as resolving them takes
+// another RPC call and the static ones already include the pools' state.
+func writableAccounts(tx *solanago.Transaction) []bmodel.Address {
+	header := tx.Message.Header
+	signers := int(header.NumRequiredSignatures)
+	var accounts []bmodel.Address
+	for i, key := range tx.Message.AccountKeys {
+		writable := i < signers-int(header.NumReadonlySignedAccounts) ||
+			(i >= signers && i < len(tx.Message.AccountKeys)-int(header.NumReadonlyUnsignedAccounts))
+		if writable {
+			accounts = append(accounts, bmodel.Address(key.String()))
+		}
+	}
+	return accounts
+}
+
+// percentile returns the p-th percentile of values by nearest rank
+func percentile(values []uint64, p int) uint64 {
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+	rank := int(math.Ceil(float64(p)/100*float64(len(sorted)))) - 1
+	return sorted[max(rank, 0)]
+}
+
+// priorityFeeLamports is what a limit of units at price micro-lamports each costs
+func priorityFeeLamports(limit, price uint64) uint64 {
+	return (limit*price + microLamportsPerLamport - 1) / microLamportsPerLamport
+}
@@ -0,0 +1,140 @@
+package trade
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	solanago "github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/jupiter"
+	clientsmocks "github.com/nicolas-martin/dankfolio/backend/internal/clients/mocks"
+	bmodel "github.com/nicolas-martin/dankfolio/backend/internal/model/blockchain"
+)
+
+func computeBudgetInstruction(kind byte, value uint64) solanago.Instruction {
+	data := []byte{kind}
+	if kind == setComputeUnitLimitInstruction {
+		data = binary.LittleEndian.AppendUint32(data, uint32(value))
+	} else {
+		data = binary.LittleEndian.AppendUint64(data, value)
+	}
+	return solanago.NewInstruction(solanago.ComputeBudget, nil, data)
+}
+
+// jupiterSwap builds a swap transaction the way Jupiter lays them out, with a
+// compute unit limit and price ahead of the swap itself
+func jupiterSwap(t *testing.T, payer, pool solanago.PublicKey) *jupiter.SwapResponse {
+	t.Helper()
+	swapIx := solanago.NewInstruction(solanago.SPLAssociatedTokenAccountProgramID, solanago.AccountMetaSlice{
+		solanago.Meta(pool).WRITE(),
+		solanago.Meta(solanago.TokenProgramID),
+	}, []byte{9})
+	tx, err := solanago.NewTransaction([]solanago.Instruction{
+		computeBudgetInstruction(setComputeUnitLimitInstruction, 300_000),
+		computeBudgetInstruction(setComputeUnitPriceInstruction, 50_000),
+		swapIx,
+	}, solanago.Hash{}, solanago.TransactionPayer(payer))
+	require.NoError(t, err)
+	encoded, err := tx.ToBase64()
+	require.NoError(t, err)
+	return &jupiter.SwapResponse{
+		SwapTransaction:           encoded,
+		ComputeUnitLimit:          300_000,
+		PrioritizationFeeLamports: 15_000,
+		PrioritizationType: jupiter.PrioritizationType{
+			ComputeBudget: jupiter.ComputeBudget{MicroLamports: 50_000},
+		},
+	}
+}
+
+func budgetOf(t *testing.T, swap *jupiter.SwapResponse) (limit uint32, price uint64) {
+	t.Helper()
+	tx, err := solanago.TransactionFromBase64(swap.SwapTransaction)
+	require.NoError(t, err)
+	limitIx, priceIx := findComputeBudgetInstructions(tx)
+	require.NotNil(t, limitIx)
+	require.NotNil(t, priceIx)
+	return binary.LittleEndian.Uint32(limitIx.Data[1:]), binary.LittleEndian.Uint64(priceIx.Data[1:])
+}
+
+func TestComputeBudgetFromSimulation(t *testing.T) {
+	payer, pool := solanago.NewWallet().PublicKey(), solanago.NewWallet().PublicKey()
+	swap := jupiterSwap(t, payer, pool)
+	client := clientsmocks.NewMockGenericClientAPI(t)
+
+	client.EXPECT().SimulateTransaction(mock.Anything, mock.Anything, bmodel.CommitmentConfirmed).
+		RunAndReturn(func(_ context.Context, raw []byte, _ string) (*bmodel.SimulationResult, error) {
+			tx, err := solanago.TransactionFromBytes(raw)
+			require.NoError(t, err)
+			limitIx, priceIx := findComputeBudgetInstructions(tx)
+			assert.Equal(t, uint32(MaxComputeUnitLimit), binary.LittleEndian.Uint32(limitIx.Data[1:]), "simulated with the most units")
+			assert.Zero(t, binary.LittleEndian.Uint64(priceIx.Data[1:]), "simulated without a priority fee")
+			return &bmodel.SimulationResult{UnitsConsumed: 100_000}, nil
+		})
+	client.EXPECT().GetRecentPrioritizationFees(mock.Anything, []bmodel.Address{
+		bmodel.Address(payer.String()), bmodel.Address(pool.String()),
+	}).Return([]uint64{0, 1_000, 2_000, 3_000}, nil)
+
+	budgeter := newComputeBudgeter(client, ComputeBudgetConfig{})
+	require.NoError(t, budgeter.apply(context.Background(), swap))
+
+	limit, price := budgetOf(t, swap)
+	assert.Equal(t, uint32(115_000), limit)
+	assert.Equal(t, uint64(2_000), price)
+	assert.Equal(t, int64(115_000), swap.ComputeUnitLimit)
+	assert.Equal(t, int64(230), swap.PrioritizationFeeLamports)
+}
+
+func TestComputeBudgetCapsPriorityFee(t *testing.T) {
+	swap := jupiterSwap(t, solanago.NewWallet().PublicKey(), solanago.NewWallet().PublicKey())
+	client := clientsmocks.NewMockGenericClientAPI(t)
+	client.EXPECT().SimulateTransaction(mock.Anything, mock.Anything, mock.Anything).
+		Return(&bmodel.SimulationResult{UnitsConsumed: 200_000}, nil)
+	client.EXPECT().GetRecentPrioritizationFees(mock.Anything, mock.Anything).Return([]uint64{50_000_000}, nil)
+
+	budgeter := newComputeBudgeter(client, ComputeBudgetConfig{Margin: 0.5, MaxPriorityFeeLamports: 30_000})
+	require.NoError(t, budgeter.apply(context.Background(), swap))
+
+	limit, price := budgetOf(t, swap)
+	assert.Equal(t, uint32(300_000), limit)
+	assert.Equal(t, uint64(100_000), price)
+	assert.Equal(t, int64(30_000), swap.PrioritizationFeeLamports)
+}
+
+func TestComputeBudgetKeepsJupiterPriceWithoutFees(t *testing.T) {
+	swap := jupiterSwap(t, solanago.NewWallet().PublicKey(), solanago.NewWallet().PublicKey())
+	client := clientsmocks.NewMockGenericClientAPI(t)
+	client.EXPECT().SimulateTransaction(mock.Anything, mock.Anything, mock.Anything).
+		Return(&bmodel.SimulationResult{UnitsConsumed: 100_000}, nil)
+	client.EXPECT().GetRecentPrioritizationFees(mock.Anything, mock.Anything).Return(nil, errors.New("rpc down"))
+
+	require.NoError(t, newComputeBudgeter(client, ComputeBudgetConfig{}).apply(context.Background(), swap))
+	_, price := budgetOf(t, swap)
+	assert.Equal(t, uint64(50_000), price)
+}
+
+func TestComputeBudgetLeavesSwapOnSimulationFailure(t *testing.T) {
+	swap := jupiterSwap(t, solanago.NewWallet().PublicKey(), solanago.NewWallet().PublicKey())
+	original := *swap
+	client := clientsmocks.NewMockGenericClientAPI(t)
+	client.EXPECT().SimulateTransaction(mock.Anything, mock.Anything, mock.Anything).
+		Return(&bmodel.SimulationResult{Err: map[string]any{"InstructionError": []any{2, "Custom"}}}, nil)
+
+	err := newComputeBudgeter(client, ComputeBudgetConfig{}).apply(context.Background(), swap)
+	assert.ErrorContains(t, err, "simulation failed")
+	assert.Equal(t, original, *swap)
+}
+
+func TestPercentile(t *testing.T) {
+	values := []uint64{40, 10, 30, 20}
+	assert.Equal(t, uint64(10), percentile(values, 1))
+	assert.Equal(t, uint64(20), percentile(values, 50))
+	assert.Equal(t, uint64(30), percentile(values, 75))
+	assert.Equal(t, uint64(40), percentile(values, 100))
+	assert.Equal(t, []uint64{40, 10, 30, 20}, values, "input is not reordered")
+}
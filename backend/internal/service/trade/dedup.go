@@ -0,0 +1,89 @@
+package trade
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+// DefaultPrepareSwapDedupWindow is how long a prepared swap is handed back to
+// identical requests, long enough to absorb a double tap on the swap button.
+const DefaultPrepareSwapDedupWindow = 3 * time.Second
+
+// prepareSwapCall is an in-flight or recently finished PrepareSwap
+type prepareSwapCall struct {
+	done       chan struct{}
+	resp       *PrepareSwapResponse
+	err        error
+	finishedAt time.Time
+}
+
+// prepareSwapDedup collapses identical PrepareSwap requests made within a short
+// window onto one quote, one unsigned transaction and one trade record. Unlike
+// singleflight it also keeps the result for a moment after the first call
+// returns, since the second tap often arrives just after.
+type prepareSwapDedup struct {
+	mu     sync.Mutex
+	window time.Duration
+	calls  map[string]*prepareSwapCall
+	now    func() time.Time
+}
+
+func newPrepareSwapDedup(window time.Duration) *prepareSwapDedup {
+	return &prepareSwapDedup{
+		window: window,
+		calls:  make(map[string]*prepareSwapCall),
+		now:    time.Now,
+	}
+}
+
+func prepareSwapDedupKey(params model.PrepareSwapRequestData) string {
+	return strings.Join([]string{params.UserWalletAddress, params.FromCoinMintAddress, params.ToCoinMintAddress, params.Amount, strconv.FormatBool(params.Sponsored), strings.Join(params.AcknowledgedRisks, ",")}, "|")
+}
+
+// do runs fn unless an identical request is in flight or finished within the
+// window, in which case it waits for and returns that result with Deduplicated
+// set. Failures are not kept, so a retry after an error prepares afresh.
+func (d *prepareSwapDedup) do(ctx context.Context, key string, fn func() (*PrepareSwapResponse, error)) (*PrepareSwapResponse, error) {
+	d.mu.Lock()
+	now := d.now()
+	for k, c := range d.calls {
+		if !c.finishedAt.IsZero() && now.Sub(c.finishedAt) > d.window {
+			delete(d.calls, k)
+		}
+	}
+	if c, ok := d.calls[key]; ok {
+		d.mu.Unlock()
+		select {
+		case <-c.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		if c.err != nil {
+			return nil, c.err
+		}
+		dup := *c.resp
+		dup.Deduplicated = true
+		return &dup, nil
+	}
+	c := &prepareSwapCall{done: make(chan struct{})}
+	d.calls[key] = c
+	d.mu.Unlock()
+
+	c.resp, c.err = fn()
+
+	d.mu.Lock()
+	if c.err != nil {
+		delete(d.calls, key)
+	} else {
+		c.finishedAt = d.now()
+	}
+	d.mu.Unlock()
+	close(c.done)
+
+	return c.resp, c.err
+}
@@ -0,0 +1,76 @@
+package trade
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrepareSwapDedup_SharesConcurrentAndRecentResults(t *testing.T) {
+	d := newPrepareSwapDedup(time.Second)
+	now := time.Unix(0, 0)
+	d.now = func() time.Time { return now }
+
+	release := make(chan struct{})
+	calls := 0
+	fn := func() (*PrepareSwapResponse, error) {
+		calls++
+		<-release
+		return &PrepareSwapResponse{UnsignedTransaction: "tx"}, nil
+	}
+
+	var wg sync.WaitGroup
+	results := make([]*PrepareSwapResponse, 2)
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := d.do(context.Background(), "k", fn)
+			require.NoError(t, err)
+			results[i] = resp
+		}()
+		// Let the first call register before the second arrives
+		time.Sleep(10 * time.Millisecond)
+	}
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, "tx", results[0].UnsignedTransaction)
+	assert.Equal(t, "tx", results[1].UnsignedTransaction)
+	assert.NotEqual(t, results[0].Deduplicated, results[1].Deduplicated)
+
+	// A late second tap inside the window still gets the same transaction
+	now = now.Add(500 * time.Millisecond)
+	resp, err := d.do(context.Background(), "k", fn)
+	require.NoError(t, err)
+	assert.True(t, resp.Deduplicated)
+	assert.Equal(t, 1, calls)
+
+	// Past the window the request is prepared again
+	now = now.Add(2 * time.Second)
+	resp, err = d.do(context.Background(), "k", fn)
+	require.NoError(t, err)
+	assert.False(t, resp.Deduplicated)
+	assert.Equal(t, 2, calls)
+}
+
+func TestPrepareSwapDedup_DoesNotKeepErrors(t *testing.T) {
+	d := newPrepareSwapDedup(time.Minute)
+
+	_, err := d.do(context.Background(), "k", func() (*PrepareSwapResponse, error) {
+		return nil, errors.New("quote failed")
+	})
+	require.Error(t, err)
+
+	resp, err := d.do(context.Background(), "k", func() (*PrepareSwapResponse, error) {
+		return &PrepareSwapResponse{UnsignedTransaction: "tx"}, nil
+	})
+	require.NoError(t, err)
+	assert.False(t, resp.Deduplicated)
+}
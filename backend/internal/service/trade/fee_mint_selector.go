@@ -9,6 +9,8 @@ import (
 	solanago "github.com/gagliardetto/solana-go"
 
 	"github.com/nicolas-martin/dankfolio/backend/internal/clients/jupiter"
+	"github.com/nicolas-martin/dankfolio/backend/internal/signer"
+	"github.com/nicolas-martin/dankfolio/backend/internal/util/ata"
 )
 
 const (
@@ -20,21 +22,21 @@ const (
 // based on Jupiter's rules and the swap parameters
 type FeeMintSelector struct {
 	platformFeeAccount string
-	platformKey        *solanago.PrivateKey
+	platformSigner     signer.Signer
 	ataChecker         func(context.Context, solanago.PublicKey) bool
-	ataCreator         func(context.Context, solanago.PublicKey, solanago.PublicKey, *solanago.PrivateKey) error
+	ataCreator         func(context.Context, solanago.PublicKey, solanago.PublicKey, signer.Signer) error
 }
 
 // NewFeeMintSelector creates a new fee mint selector
 func NewFeeMintSelector(
 	platformFeeAccount string,
-	platformKey *solanago.PrivateKey,
+	platformSigner signer.Signer,
 	ataChecker func(context.Context, solanago.PublicKey) bool,
-	ataCreator func(context.Context, solanago.PublicKey, solanago.PublicKey, *solanago.PrivateKey) error,
+	ataCreator func(context.Context, solanago.PublicKey, solanago.PublicKey, signer.Signer) error,
 ) *FeeMintSelector {
 	return &FeeMintSelector{
 		platformFeeAccount: platformFeeAccount,
-		platformKey:        platformKey,
+		platformSigner:     platformSigner,
 		ataChecker:         ataChecker,
 		ataCreator:         ataCreator,
 	}
@@ -142,25 +144,20 @@ func (s *FeeMintSelector) calculateAndCheckATA(
 	owner solanago.PublicKey,
 	mint string,
 ) (string, error) {
-	// Convert native SOL to wSOL for ATA checking
-	if mint == "11111111111111111111111111111111" {
-		mint = WSOLMint
-		slog.Debug("Converting native SOL to wSOL for ATA check", "original", "11111111111111111111111111111111", "converted", mint)
-	}
-
 	mintPubKey, err := solanago.PublicKeyFromBase58(mint)
 	if err != nil {
 		return "", err
 	}
 
-	ata, _, err := solanago.FindAssociatedTokenAddress(owner, mintPubKey)
+	// Native SOL is derived as wSOL
+	account, err := ata.Derive(owner, mintPubKey)
 	if err != nil {
 		return "", err
 	}
 
 	// Check if ATA exists
-	if s.ataChecker(ctx, ata) {
-		return ata.String(), nil
+	if s.ataChecker(ctx, account.Address) {
+		return account.Address.String(), nil
 	}
 
 	return "", nil
@@ -172,42 +169,38 @@ func (s *FeeMintSelector) ensureATA(
 	owner solanago.PublicKey,
 	mint string,
 ) (string, error) {
-	// Convert native SOL to wSOL for ATA creation
-	if mint == "11111111111111111111111111111111" {
-		mint = WSOLMint
-		slog.Debug("Converting native SOL to wSOL for ATA creation", "original", "11111111111111111111111111111111", "converted", mint)
-	}
-
 	mintPubKey, err := solanago.PublicKeyFromBase58(mint)
 	if err != nil {
 		return "", err
 	}
 
-	ata, _, err := solanago.FindAssociatedTokenAddress(owner, mintPubKey)
+	// Native SOL is derived as wSOL
+	account, err := ata.Derive(owner, mintPubKey)
 	if err != nil {
 		return "", err
 	}
+	address := account.Address
 
 	// Check if ATA exists
-	if s.ataChecker(ctx, ata) {
-		slog.Debug("ATA already exists", "mint", mint, "ata", ata.String())
-		return ata.String(), nil
+	if s.ataChecker(ctx, address) {
+		slog.Debug("ATA already exists", "mint", mint, "ata", address.String())
+		return address.String(), nil
 	}
 
 	// ATA doesn't exist, create it if we have the creator function and platform key
-	if s.ataCreator != nil && s.platformKey != nil {
-		slog.Info("Creating platform ATA for fee collection", "mint", mint, "ata", ata.String())
+	if s.ataCreator != nil && s.platformSigner != nil {
+		slog.Info("Creating platform ATA for fee collection", "mint", mint, "ata", address.String())
 
-		if err := s.ataCreator(ctx, owner, mintPubKey, s.platformKey); err != nil {
+		if err := s.ataCreator(ctx, owner, account.Mint, s.platformSigner); err != nil {
 			return "", fmt.Errorf("failed to create ATA: %w", err)
 		}
 
-		slog.Info("Successfully created platform ATA", "mint", mint, "ata", ata.String())
-		return ata.String(), nil
+		slog.Info("Successfully created platform ATA", "mint", mint, "ata", address.String())
+		return address.String(), nil
 	}
 
 	// No ATA creator available
-	slog.Warn("ATA does not exist and no creator function available", "mint", mint, "ata", ata.String())
+	slog.Warn("ATA does not exist and no creator function available", "mint", mint, "ata", address.String())
 	return "", nil
 }
 
@@ -244,15 +237,15 @@ func AnalyzeQuoteFeeMint(quoteRaw []byte) string {
 func DetermineFeeMintForSwap(
 	ctx context.Context,
 	platformFeeAccount string,
-	platformKey *solanago.PrivateKey,
+	platformSigner signer.Signer,
 	inputMint string,
 	outputMint string,
 	swapMode string,
 	quoteRaw []byte,
 	ataChecker func(context.Context, solanago.PublicKey) bool,
-	ataCreator func(context.Context, solanago.PublicKey, solanago.PublicKey, *solanago.PrivateKey) error,
+	ataCreator func(context.Context, solanago.PublicKey, solanago.PublicKey, signer.Signer) error,
 ) (feeAccountATA string, selectedFeeMint string) {
-	selector := NewFeeMintSelector(platformFeeAccount, platformKey, ataChecker, ataCreator)
+	selector := NewFeeMintSelector(platformFeeAccount, platformSigner, ataChecker, ataCreator)
 
 	// Parse quote if available
 	var quote *jupiter.QuoteResponse
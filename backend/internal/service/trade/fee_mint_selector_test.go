@@ -6,6 +6,7 @@ import (
 
 	solanago "github.com/gagliardetto/solana-go"
 	"github.com/nicolas-martin/dankfolio/backend/internal/clients/jupiter"
+	"github.com/nicolas-martin/dankfolio/backend/internal/signer"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -27,7 +28,7 @@ func TestFeeMintSelector_NativeSolNormalization(t *testing.T) {
 	}
 
 	// Mock ATA creator
-	ataCreator := func(ctx context.Context, owner, mint solanago.PublicKey, signerKey *solanago.PrivateKey) error {
+	ataCreator := func(ctx context.Context, owner, mint solanago.PublicKey, platformSigner signer.Signer) error {
 		return nil
 	}
 
@@ -35,7 +36,7 @@ func TestFeeMintSelector_NativeSolNormalization(t *testing.T) {
 	dummyPrivKey := make([]byte, 64)
 	privateKey := solanago.PrivateKey(dummyPrivKey)
 
-	selector := NewFeeMintSelector(platformAccount, &privateKey, ataChecker, ataCreator)
+	selector := NewFeeMintSelector(platformAccount, signer.NewLocalSigner(privateKey), ataChecker, ataCreator)
 
 	tests := []struct {
 		name           string
@@ -115,7 +116,7 @@ func TestFeeMintSelector_ATAConversion(t *testing.T) {
 		return false // Always return false to trigger creation
 	}
 
-	ataCreator := func(ctx context.Context, owner, mint solanago.PublicKey, signerKey *solanago.PrivateKey) error {
+	ataCreator := func(ctx context.Context, owner, mint solanago.PublicKey, platformSigner signer.Signer) error {
 		createdATAs = append(createdATAs, mint.String())
 		return nil
 	}
@@ -123,7 +124,7 @@ func TestFeeMintSelector_ATAConversion(t *testing.T) {
 	dummyPrivKey := make([]byte, 64)
 	privateKey := solanago.PrivateKey(dummyPrivKey)
 
-	selector := NewFeeMintSelector(platformAccount, &privateKey, ataChecker, ataCreator)
+	selector := NewFeeMintSelector(platformAccount, signer.NewLocalSigner(privateKey), ataChecker, ataCreator)
 
 	// Test native SOL conversion in calculateAndCheckATA
 	platformPubKey, _ := solanago.PublicKeyFromBase58(platformAccount)
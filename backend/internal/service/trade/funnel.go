@@ -0,0 +1,114 @@
+package trade
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/apperrors"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	"github.com/nicolas-martin/dankfolio/backend/internal/telemetry/trademetrics"
+)
+
+// dropOffFailedOnChain is the funnel drop-off reason of a swap that landed
+// but failed, e.g. on a stale account or an exhausted compute budget
+const dropOffFailedOnChain = "failed_on_chain"
+
+// funnelLabels labels a swap between the two mints by the coin traded, the
+// side that isn't SOL, and the DEXes it was quoted through
+func funnelLabels(fromMint, toMint string, route []string) trademetrics.FunnelLabels {
+	coin := toMint
+	if toMint == model.SolMint || toMint == model.NativeSolMint {
+		coin = fromMint
+	}
+	return trademetrics.FunnelLabels{Coin: coin, Route: strings.Join(route, ">")}
+}
+
+func tradeFunnelLabels(trade *model.Trade) trademetrics.FunnelLabels {
+	labels := funnelLabels(trade.FromCoinMintAddress, trade.ToCoinMintAddress, nil)
+	labels.Route = trade.QuoteRoute
+	return labels
+}
+
+// dropOffReason names why a swap dropped out of the funnel: the kind of the
+// app error the user was shown, or "error" for anything unclassified
+func dropOffReason(err error) string {
+	if kind, ok := apperrors.KindOf(err); ok {
+		return strings.ToLower(string(kind))
+	}
+	switch {
+	case errors.Is(err, context.Canceled):
+		return "canceled"
+	case errors.Is(err, context.DeadlineExceeded):
+		return "timeout"
+	}
+	return "error"
+}
+
+func (s *Service) recordFunnelStage(ctx context.Context, stage trademetrics.FunnelStage, labels trademetrics.FunnelLabels, latency time.Duration) {
+	if s.metrics != nil {
+		s.metrics.RecordFunnelStage(ctx, stage, labels, latency)
+	}
+}
+
+func (s *Service) recordFunnelDropOff(ctx context.Context, stage trademetrics.FunnelStage, labels trademetrics.FunnelLabels, reason string) {
+	if s.metrics != nil {
+		s.metrics.RecordFunnelDropOff(ctx, stage, labels, reason)
+	}
+}
+
+// swapFunnel follows a prepared swap through signing and submission, so an
+// error can be recorded as a drop-off at the stage it didn't reach
+type swapFunnel struct {
+	labels trademetrics.FunnelLabels
+	stage  trademetrics.FunnelStage // Last stage reached; empty until the swap is found
+	at     time.Time                // When it was reached
+}
+
+// start picks up the prepared trade a signed swap was submitted for
+func (f *swapFunnel) start(trade *model.Trade) {
+	f.labels = tradeFunnelLabels(trade)
+	f.stage = trademetrics.FunnelStagePrepare
+	f.at = trade.CreatedAt
+}
+
+func (s *Service) reachFunnelStage(ctx context.Context, f *swapFunnel, stage trademetrics.FunnelStage) {
+	now := time.Now()
+	var latency time.Duration
+	if !f.at.IsZero() {
+		latency = now.Sub(f.at)
+	}
+	s.recordFunnelStage(ctx, stage, f.labels, latency)
+	f.stage, f.at = stage, now
+}
+
+// dropFunnel records err as the reason the swap didn't reach the stage after
+// the last one it did. Errors before the swap was found aren't attributed.
+func (s *Service) dropFunnel(ctx context.Context, f *swapFunnel, err error) {
+	var next trademetrics.FunnelStage
+	switch f.stage {
+	case trademetrics.FunnelStagePrepare:
+		next = trademetrics.FunnelStageSign
+	case trademetrics.FunnelStageSign:
+		next = trademetrics.FunnelStageSubmit
+	default:
+		return
+	}
+	s.recordFunnelDropOff(ctx, next, f.labels, dropOffReason(err))
+}
+
+// recordConfirmFunnel records a submitted swap whose transaction just reached
+// the service commitment, or just failed on chain
+func (s *Service) recordConfirmFunnel(ctx context.Context, trade *model.Trade, confirmed, failed bool) {
+	switch {
+	case failed:
+		s.recordFunnelDropOff(ctx, trademetrics.FunnelStageConfirm, tradeFunnelLabels(trade), dropOffFailedOnChain)
+	case confirmed:
+		var latency time.Duration
+		if !trade.SubmittedAt.IsZero() {
+			latency = time.Since(trade.SubmittedAt)
+		}
+		s.recordFunnelStage(ctx, trademetrics.FunnelStageConfirm, tradeFunnelLabels(trade), latency)
+	}
+}
@@ -0,0 +1,100 @@
+package trade
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	clientsmocks "github.com/nicolas-martin/dankfolio/backend/internal/clients/mocks"
+	dbmocks "github.com/nicolas-martin/dankfolio/backend/internal/db/mocks"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	"github.com/nicolas-martin/dankfolio/backend/internal/telemetry/trademetrics"
+)
+
+// funnelCounts collects the funnel counter as "stage outcome reason" → count
+func funnelCounts(t *testing.T, reader *sdkmetric.ManualReader) map[string]int64 {
+	var metrics metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &metrics))
+	counts := make(map[string]int64)
+	for _, m := range metrics.ScopeMetrics[0].Metrics {
+		if m.Name != "dankfolio.trade_funnel_total" {
+			continue
+		}
+		for _, point := range m.Data.(metricdata.Sum[int64]).DataPoints {
+			stage, _ := point.Attributes.Value("stage")
+			outcome, _ := point.Attributes.Value("outcome")
+			reason, _ := point.Attributes.Value("reason")
+			counts[stage.AsString()+" "+outcome.AsString()+" "+reason.AsString()] += point.Value
+		}
+	}
+	return counts
+}
+
+func TestExecuteTrade_RecordsFunnel(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	metrics, err := trademetrics.New(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)).Meter("test"))
+	require.NoError(t, err)
+
+	store := dbmocks.NewMockStore(t)
+	trades := dbmocks.NewMockRepository[model.Trade](t)
+	store.EXPECT().Trades().Return(trades)
+	chain := clientsmocks.NewMockGenericClientAPI(t)
+	svc := &Service{store: store, chainClient: chain, metrics: metrics}
+
+	prepared := func(quoteID string, expiresAt time.Time) *model.Trade {
+		return &model.Trade{
+			ID:                  1,
+			FromCoinMintAddress: model.SolMint,
+			ToCoinMintAddress:   paperBonk,
+			Status:              tradeStatusPrepared,
+			UnsignedTransaction: "unsigned",
+			QuoteID:             quoteID,
+			QuoteExpiresAt:      expiresAt,
+			QuoteRoute:          "Raydium",
+			CreatedAt:           time.Now().Add(-5 * time.Second),
+		}
+	}
+	req := func(quoteID string) model.TradeRequest {
+		return model.TradeRequest{
+			QuoteID:             quoteID,
+			UnsignedTransaction: "unsigned",
+			SignedTransaction:   base64.StdEncoding.EncodeToString([]byte("signed")),
+		}
+	}
+
+	// Signed too late: dropped before signing counts
+	trades.EXPECT().GetByField(mock.Anything, "quote_id", "stale").Return(prepared("stale", time.Now().Add(-time.Second)), nil)
+	trades.EXPECT().Update(mock.Anything, mock.Anything).Return(nil)
+	_, err = svc.ExecuteTrade(context.Background(), req("stale"))
+	require.ErrorIs(t, err, ErrQuoteExpired)
+
+	// Signed and submitted
+	trades.EXPECT().GetByField(mock.Anything, "quote_id", "fresh").Return(prepared("fresh", time.Now().Add(time.Minute)), nil)
+	chain.EXPECT().SendRawTransaction(mock.Anything, []byte("signed"), mock.Anything).Return("sig", nil)
+	submitted, err := svc.ExecuteTrade(context.Background(), req("fresh"))
+	require.NoError(t, err)
+	assert.False(t, submitted.SubmittedAt.IsZero())
+
+	assert.Equal(t, map[string]int64{
+		"sign dropped quote_expired": 1,
+		"sign reached ":              1,
+		"submit reached ":            1,
+	}, funnelCounts(t, reader))
+
+	// Confirmation is timed from submission
+	svc.recordConfirmFunnel(context.Background(), submitted, true, false)
+	assert.Equal(t, int64(1), funnelCounts(t, reader)["confirm reached "])
+}
+
+func TestFunnelLabels(t *testing.T) {
+	assert.Equal(t, trademetrics.FunnelLabels{Coin: paperBonk, Route: "Raydium>Orca"},
+		funnelLabels(paperBonk, model.NativeSolMint, []string{"Raydium", "Orca"}))
+	assert.Equal(t, trademetrics.FunnelLabels{Coin: paperBonk}, funnelLabels(model.SolMint, paperBonk, nil))
+}
@@ -0,0 +1,177 @@
+package trade
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/apperrors"
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	"github.com/nicolas-martin/dankfolio/backend/internal/util"
+)
+
+const (
+	// DefaultTradeLimitDailyUSD is the notional a wallet can swap in a UTC day
+	DefaultTradeLimitDailyUSD = 10_000
+	// DefaultTradeLimitWeeklyUSD is the notional a wallet can swap in seven UTC days
+	DefaultTradeLimitWeeklyUSD = 50_000
+
+	tradeLimitWeekDays = 7
+)
+
+// ErrInvalidTradeLimit is returned for a trade limit override with invalid values
+var ErrInvalidTradeLimit = errors.New("invalid trade limit")
+
+// TradeLimitsConfig sets how much notional each wallet can swap. Limits are
+// counted over UTC days: today, and today with the six days before it. Zero
+// limits use the defaults.
+type TradeLimitsConfig struct {
+	Enabled   bool
+	DailyUSD  float64
+	WeeklyUSD float64
+}
+
+func (c TradeLimitsConfig) withDefaults() TradeLimitsConfig {
+	if c.DailyUSD <= 0 {
+		c.DailyUSD = DefaultTradeLimitDailyUSD
+	}
+	if c.WeeklyUSD <= 0 {
+		c.WeeklyUSD = DefaultTradeLimitWeeklyUSD
+	}
+	return c
+}
+
+// SetTradeLimits configures the notional trade limits enforced by ExecuteTrade
+func (s *Service) SetTradeLimits(config TradeLimitsConfig) {
+	s.tradeLimits = config.withDefaults()
+}
+
+// TradeLimitStatus is a wallet's effective trade limits and what it has used
+// of them
+type TradeLimitStatus struct {
+	WalletAddress  string
+	Override       *model.TradeLimit // The admin override, nil without one
+	Enforced       bool              // Limits are enabled and the wallet isn't exempt
+	DailyLimitUSD  float64
+	WeeklyLimitUSD float64
+	DailyUsedUSD   float64
+	WeeklyUsedUSD  float64
+}
+
+// GetTradeLimit returns wallet's trade limits and usage
+func (s *Service) GetTradeLimit(ctx context.Context, wallet string) (*TradeLimitStatus, error) {
+	if !util.IsValidSolanaAddress(wallet) {
+		return nil, fmt.Errorf("%w: invalid wallet address %q", ErrInvalidTradeLimit, wallet)
+	}
+	return s.tradeLimitStatus(ctx, wallet, time.Now())
+}
+
+// SetTradeLimit saves an override of limit.ID's trade limits
+func (s *Service) SetTradeLimit(ctx context.Context, limit model.TradeLimit) (*TradeLimitStatus, error) {
+	if !util.IsValidSolanaAddress(limit.ID) {
+		return nil, fmt.Errorf("%w: invalid wallet address %q", ErrInvalidTradeLimit, limit.ID)
+	}
+	if limit.DailyLimitUSD < 0 || limit.WeeklyLimitUSD < 0 {
+		return nil, fmt.Errorf("%w: limits can't be negative", ErrInvalidTradeLimit)
+	}
+	limit.UpdatedAt = time.Now()
+	_, err := s.store.TradeLimits().Get(ctx, limit.ID)
+	switch {
+	case errors.Is(err, db.ErrNotFound):
+		err = s.store.TradeLimits().Create(ctx, &limit)
+	case err == nil:
+		err = s.store.TradeLimits().Update(ctx, &limit)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to save trade limit for %s: %w", limit.ID, err)
+	}
+	slog.InfoContext(ctx, "Trade limit override saved", "wallet", limit.ID, "daily_usd", limit.DailyLimitUSD,
+		"weekly_usd", limit.WeeklyLimitUSD, "exempt", limit.Exempt, "updated_by", limit.UpdatedBy)
+	return s.tradeLimitStatus(ctx, limit.ID, time.Now())
+}
+
+// DeleteTradeLimit removes wallet's override, returning it to the configured limits
+func (s *Service) DeleteTradeLimit(ctx context.Context, wallet string) error {
+	return s.store.TradeLimits().Delete(ctx, wallet)
+}
+
+// tradeLimitStatus applies wallet's override to the configured limits and
+// totals its usage in the UTC day and week containing now
+func (s *Service) tradeLimitStatus(ctx context.Context, wallet string, now time.Time) (*TradeLimitStatus, error) {
+	status := &TradeLimitStatus{
+		WalletAddress:  wallet,
+		Enforced:       s.tradeLimits.Enabled,
+		DailyLimitUSD:  s.tradeLimits.DailyUSD,
+		WeeklyLimitUSD: s.tradeLimits.WeeklyUSD,
+	}
+	override, err := s.store.TradeLimits().Get(ctx, wallet)
+	switch {
+	case err == nil:
+		status.Override = override
+		status.Enforced = status.Enforced && !override.Exempt
+		if override.DailyLimitUSD > 0 {
+			status.DailyLimitUSD = override.DailyLimitUSD
+		}
+		if override.WeeklyLimitUSD > 0 {
+			status.WeeklyLimitUSD = override.WeeklyLimitUSD
+		}
+	case !errors.Is(err, db.ErrNotFound):
+		return nil, fmt.Errorf("failed to get trade limit for %s: %w", wallet, err)
+	}
+
+	today := now.UTC().Truncate(24 * time.Hour)
+	volumes, _, err := s.store.TradeVolumes().ListWithOpts(ctx, db.ListOptions{
+		Filters: []db.FilterOption{
+			{Field: "wallet_address", Operator: db.FilterOpEqual, Value: wallet},
+			{Field: "day", Operator: db.FilterOpGreaterEqual, Value: today.AddDate(0, 0, 1-tradeLimitWeekDays)},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read trade volume for %s: %w", wallet, err)
+	}
+	for _, v := range volumes {
+		status.WeeklyUsedUSD += v.NotionalUSD
+		if v.Day.UTC().Equal(today) {
+			status.DailyUsedUSD += v.NotionalUSD
+		}
+	}
+	return status, nil
+}
+
+// checkTradeLimits refuses a swap worth notionalUSD that would take wallet
+// over its daily or weekly limit
+func (s *Service) checkTradeLimits(ctx context.Context, wallet string, notionalUSD float64) error {
+	now := time.Now()
+	status, err := s.tradeLimitStatus(ctx, wallet, now)
+	if err != nil {
+		return err
+	}
+	if !status.Enforced {
+		return nil
+	}
+	period, limit, used := "daily", status.DailyLimitUSD, status.DailyUsedUSD
+	if status.DailyUsedUSD+notionalUSD <= status.DailyLimitUSD {
+		if status.WeeklyUsedUSD+notionalUSD <= status.WeeklyLimitUSD {
+			return nil
+		}
+		period, limit, used = "weekly", status.WeeklyLimitUSD, status.WeeklyUsedUSD
+	}
+	// Usage only drops as days roll over, so that's the soonest to try again
+	tomorrow := now.UTC().Truncate(24*time.Hour).AddDate(0, 0, 1)
+	return apperrors.ErrTradeLimitExceeded.
+		With("period", period).
+		WithMessage(fmt.Sprintf("this swap would take you over your %s trading limit of $%.2f, $%.2f remains", period, limit, max(limit-used, 0))).
+		WithRetryAfter(tomorrow.Sub(now))
+}
+
+// recordTradeVolume counts a submitted swap towards its wallet's limits. The
+// swap is already on chain, so failures are only logged.
+func (s *Service) recordTradeVolume(ctx context.Context, trade *model.Trade) {
+	if err := s.store.AddTradeVolume(ctx, trade.FromAddress, time.Now(), trade.TotalUSDCost); err != nil {
+		slog.ErrorContext(ctx, "Failed to count swap towards trade limits",
+			"trade_id", trade.ID, "wallet", trade.FromAddress, slog.Any("error", err))
+	}
+}
@@ -0,0 +1,73 @@
+package trade
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/apperrors"
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	dbmocks "github.com/nicolas-martin/dankfolio/backend/internal/db/mocks"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+// limitsService returns a service with limits of $1000 a day and $3000 a
+// week, for a wallet with override and the given daily volumes
+func limitsService(t *testing.T, override *model.TradeLimit, volumes ...model.TradeVolume) *Service {
+	store := dbmocks.NewMockStore(t)
+	limits := dbmocks.NewMockRepository[model.TradeLimit](t)
+	counters := dbmocks.NewMockRepository[model.TradeVolume](t)
+	store.EXPECT().TradeLimits().Return(limits)
+	store.EXPECT().TradeVolumes().Return(counters)
+	if override != nil {
+		limits.EXPECT().Get(mock.Anything, paperWallet).Return(override, nil)
+	} else {
+		limits.EXPECT().Get(mock.Anything, paperWallet).Return(nil, db.ErrNotFound)
+	}
+	counters.EXPECT().ListWithOpts(mock.Anything, mock.Anything).Return(volumes, int32(len(volumes)), nil)
+	svc := &Service{store: store}
+	svc.SetTradeLimits(TradeLimitsConfig{Enabled: true, DailyUSD: 1000, WeeklyUSD: 3000})
+	return svc
+}
+
+func TestCheckTradeLimits(t *testing.T) {
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	volumes := []model.TradeVolume{
+		{Day: today, NotionalUSD: 600},
+		{Day: today.AddDate(0, 0, -3), NotionalUSD: 2000},
+	}
+
+	t.Run("within limits", func(t *testing.T) {
+		svc := limitsService(t, nil, volumes...)
+		assert.NoError(t, svc.checkTradeLimits(context.Background(), paperWallet, 300))
+	})
+
+	t.Run("over daily limit", func(t *testing.T) {
+		svc := limitsService(t, nil, volumes...)
+		err := svc.checkTradeLimits(context.Background(), paperWallet, 500)
+		var appErr *apperrors.Error
+		require.True(t, errors.As(err, &appErr))
+		assert.Equal(t, apperrors.KindTradeLimitExceeded, appErr.Kind)
+		assert.Equal(t, "daily", appErr.Metadata["period"])
+		assert.Contains(t, appErr.Message, "$400.00 remains")
+		assert.Positive(t, appErr.RetryAfter)
+	})
+
+	t.Run("over weekly limit with a raised daily limit", func(t *testing.T) {
+		svc := limitsService(t, &model.TradeLimit{ID: paperWallet, DailyLimitUSD: 5000}, volumes...)
+		err := svc.checkTradeLimits(context.Background(), paperWallet, 500)
+		var appErr *apperrors.Error
+		require.True(t, errors.As(err, &appErr))
+		assert.Equal(t, "weekly", appErr.Metadata["period"])
+	})
+
+	t.Run("exempt wallet", func(t *testing.T) {
+		svc := limitsService(t, &model.TradeLimit{ID: paperWallet, Exempt: true}, volumes...)
+		assert.NoError(t, svc.checkTradeLimits(context.Background(), paperWallet, 1_000_000))
+	})
+}
@@ -0,0 +1,174 @@
+package trade
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	solanago "github.com/gagliardetto/solana-go"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/apperrors"
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	bmodel "github.com/nicolas-martin/dankfolio/backend/internal/model/blockchain"
+	"github.com/nicolas-martin/dankfolio/backend/internal/util"
+	"github.com/nicolas-martin/dankfolio/backend/internal/util/ata"
+	"github.com/nicolas-martin/dankfolio/backend/internal/util/money"
+)
+
+// SOL a swap needs besides its input, in lamports
+const (
+	tokenAccountRentLamports   = 2_039_280 // Rent-exempt minimum of each token account the swap creates
+	baseTransactionFeeLamports = 5_000     // Signature fee of the swap transaction
+	// walletRentExemptLamports is kept in the wallet: a system account can't be
+	// left with a balance between zero and its rent-exempt minimum, and fees
+	// leave a few lamports behind
+	walletRentExemptLamports = 890_880
+)
+
+// maxSpendPresets are the percentages of the max spendable amount offered as quick picks
+var maxSpendPresets = []int64{25, 50, 75, 100}
+
+// MaxSpendable is the most of a mint a wallet can put into a swap once the
+// SOL for its fees and rent is set aside
+type MaxSpendable struct {
+	RawAmount        string   // Base units of the input mint
+	Amount           float64  // UI units, for display
+	Decimals         int      // Of the input mint
+	ReservedLamports uint64   // SOL set aside for network fees and rent
+	AccountsToCreate int      // Token accounts the swap creates, whose rent is reserved
+	PlatformFeeBps   int      // Taken out of the swap itself, so it doesn't reduce the max
+	Presets          []string // Raw amounts at maxSpendPresets percent of the max
+
+	// SOLShortfallLamports is the SOL missing to pay a token swap's fees and
+	// rent; such a swap fails until the wallet is topped up
+	SOLShortfallLamports uint64
+}
+
+// isSOL reports whether mint is native or wrapped SOL, which swaps spend from
+// the wallet's lamports
+func isSOL(mint string) bool {
+	return mint == model.NativeSolMint || mint == model.SolMint
+}
+
+// GetMaxSpendable returns the largest amount of fromMint the wallet can swap
+// into toMint. SOL inputs keep back the worst case of the swap's fees: the
+// signature fee, the priority fee cap, rent for the token accounts it creates
+// and the wallet's own rent-exempt minimum. Paper accounts have no fees, so
+// their whole balance is spendable.
+func (s *Service) GetMaxSpendable(ctx context.Context, wallet, fromMint, toMint string, paper bool) (*MaxSpendable, error) {
+	if !util.IsValidSolanaAddress(wallet) {
+		return nil, apperrors.ErrInvalidAddress.WithMessage(fmt.Sprintf("invalid wallet address: %s", wallet))
+	}
+	if !util.IsValidSolanaAddress(fromMint) || !util.IsValidSolanaAddress(toMint) {
+		return nil, apperrors.ErrInvalidAddress.WithMessage("invalid mint address")
+	}
+	decimals := money.SOLDecimals
+	if !isSOL(fromMint) {
+		fromCoin, err := s.coinService.GetCoinByAddress(ctx, fromMint)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get fromCoin details for %s: %w", fromMint, err)
+		}
+		decimals = fromCoin.Decimals
+	}
+
+	result := &MaxSpendable{Decimals: decimals, PlatformFeeBps: int(s.platformFeeBps.Load())}
+	if shouldDisablePlatformFees(fromMint, toMint) {
+		result.PlatformFeeBps = 0
+	}
+	var maxRaw uint64
+	if paper {
+		balances, err := s.paperBalances(ctx, s.store, wallet)
+		if err != nil {
+			return nil, err
+		}
+		if balance, ok := balances[paperMint(fromMint)]; ok {
+			if maxRaw, err = money.ToBaseUnits(money.FromFloat(balance.Amount), uint8(decimals)); err != nil {
+				return nil, fmt.Errorf("failed to convert paper balance: %w", err)
+			}
+		}
+		result.PlatformFeeBps = 0
+	} else {
+		lamports, err := s.lamportBalance(ctx, wallet)
+		if err != nil {
+			return nil, err
+		}
+		accounts, err := s.checkRequiredATAs(ctx, wallet, fromMint, toMint)
+		if err != nil {
+			return nil, err
+		}
+		result.AccountsToCreate = accounts
+		result.ReservedLamports = uint64(accounts)*tokenAccountRentLamports +
+			baseTransactionFeeLamports +
+			s.computeBudget.config.MaxPriorityFeeLamports +
+			walletRentExemptLamports
+
+		if isSOL(fromMint) {
+			if lamports > result.ReservedLamports {
+				maxRaw = lamports - result.ReservedLamports
+			}
+		} else {
+			if maxRaw, err = s.tokenBalance(ctx, wallet, fromMint); err != nil {
+				return nil, err
+			}
+			if lamports < result.ReservedLamports {
+				result.SOLShortfallLamports = result.ReservedLamports - lamports
+			}
+		}
+	}
+
+	result.RawAmount = strconv.FormatUint(maxRaw, 10)
+	result.Amount = money.Float(money.FromBaseUnits(maxRaw, uint8(decimals)))
+	for _, percent := range maxSpendPresets {
+		// Integer math so the 100% preset is exactly the max
+		preset := maxRaw / 100 * uint64(percent)
+		preset += maxRaw % 100 * uint64(percent) / 100
+		result.Presets = append(result.Presets, strconv.FormatUint(preset, 10))
+	}
+	return result, nil
+}
+
+// lamportBalance returns a wallet's SOL balance in lamports
+func (s *Service) lamportBalance(ctx context.Context, wallet string) (uint64, error) {
+	balance, err := s.chainClient.GetBalance(ctx, bmodel.Address(wallet), s.commitment)
+	if err != nil {
+		if errors.Is(err, clients.ErrAccountNotFound) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to get SOL balance: %w", err)
+	}
+	lamports, err := strconv.ParseUint(balance.Amount, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid SOL balance %q: %w", balance.Amount, err)
+	}
+	return lamports, nil
+}
+
+// tokenBalance returns a wallet's balance of a mint in base units; a wallet
+// without a token account for it holds none
+func (s *Service) tokenBalance(ctx context.Context, wallet, mint string) (uint64, error) {
+	account, err := ata.Derive(solanago.MustPublicKeyFromBase58(wallet), solanago.MustPublicKeyFromBase58(mint))
+	if err != nil {
+		return 0, err
+	}
+	exists, err := s.ataManager.Exists(ctx, account.Address)
+	if err != nil {
+		return 0, fmt.Errorf("failed to check token account: %w", err)
+	}
+	if !exists {
+		return 0, nil
+	}
+	balance, err := s.chainClient.GetTokenBalance(ctx, bmodel.Address(wallet), bmodel.Address(mint), s.commitment)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get token balance: %w", err)
+	}
+	if balance.Amount == "" {
+		return 0, nil
+	}
+	raw, err := strconv.ParseUint(balance.Amount, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid token balance %q: %w", balance.Amount, err)
+	}
+	return raw, nil
+}
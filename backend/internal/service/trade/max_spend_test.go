@@ -0,0 +1,56 @@
+package trade
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients"
+	clientsmocks "github.com/nicolas-martin/dankfolio/backend/internal/clients/mocks"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	bmodel "github.com/nicolas-martin/dankfolio/backend/internal/model/blockchain"
+	"github.com/nicolas-martin/dankfolio/backend/internal/util/ata"
+)
+
+func newMaxSpendService(t *testing.T, lamports string) (*Service, *clientsmocks.MockGenericClientAPI) {
+	chain := clientsmocks.NewMockGenericClientAPI(t)
+	chain.EXPECT().GetBalance(mock.Anything, bmodel.Address(paperWallet), mock.Anything).
+		Return(&bmodel.Balance{Amount: lamports, Decimals: 9}, nil)
+	svc := &Service{
+		chainClient:   chain,
+		ataManager:    ata.NewManager(chain),
+		computeBudget: newComputeBudgeter(chain, ComputeBudgetConfig{}),
+	}
+	svc.platformFeeBps.Store(50)
+	return svc, chain
+}
+
+func TestGetMaxSpendable_SOLReservesFeesAndRent(t *testing.T) {
+	svc, chain := newMaxSpendService(t, "1000000000")
+	// The wallet holds no BONK yet, so the swap creates its token account
+	chain.EXPECT().GetAccountInfo(mock.Anything, mock.Anything, mock.Anything).Return(nil, clients.ErrAccountNotFound)
+
+	result, err := svc.GetMaxSpendable(context.Background(), paperWallet, model.NativeSolMint, paperBonk, false)
+	require.NoError(t, err)
+
+	reserved := uint64(tokenAccountRentLamports + baseTransactionFeeLamports + DefaultMaxPriorityFeeLamports + walletRentExemptLamports)
+	assert.Equal(t, 1, result.AccountsToCreate)
+	assert.Equal(t, reserved, result.ReservedLamports)
+	assert.Equal(t, "996064840", result.RawAmount)
+	assert.Equal(t, 50, result.PlatformFeeBps)
+	assert.Equal(t, []string{"249016210", "498032420", "747048630", "996064840"}, result.Presets)
+	assert.Zero(t, result.SOLShortfallLamports)
+}
+
+func TestGetMaxSpendable_SOLBelowReserve(t *testing.T) {
+	svc, chain := newMaxSpendService(t, "1000000")
+	chain.EXPECT().GetAccountInfo(mock.Anything, mock.Anything, mock.Anything).Return(nil, clients.ErrAccountNotFound)
+
+	result, err := svc.GetMaxSpendable(context.Background(), paperWallet, model.NativeSolMint, paperBonk, false)
+	require.NoError(t, err)
+	assert.Equal(t, "0", result.RawAmount)
+	assert.Equal(t, []string{"0", "0", "0", "0"}, result.Presets)
+}
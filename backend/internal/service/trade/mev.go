@@ -0,0 +1,217 @@
+package trade
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	bmodel "github.com/nicolas-martin/dankfolio/backend/internal/model/blockchain"
+	"github.com/nicolas-martin/dankfolio/backend/internal/util/money"
+)
+
+// BlockReader reads every transaction of a block in execution order. The
+// Solana client implements it.
+type BlockReader interface {
+	GetBlockTransactions(ctx context.Context, slot uint64, commitment string) ([]*bmodel.TransactionDetail, error)
+}
+
+// SetBlockReader enables sandwich detection on trade receipts
+func (s *Service) SetBlockReader(reader BlockReader) {
+	s.blocks = reader
+}
+
+// sandwich is a pair of transactions by one signer bracketing a swap
+type sandwich struct {
+	attacker string
+	frontRun bmodel.Signature
+	backRun  bmodel.Signature
+	pool     bmodel.Address
+	profit   money.Decimal // In the swap's input mint
+}
+
+// checkSandwich looks for a sandwich around the trade's transaction in its
+// block and records one if found. It only logs failures, since the receipt
+// stands without it.
+func (s *Service) checkSandwich(ctx context.Context, trade *model.Trade, tx *bmodel.TransactionDetail) {
+	block, err := s.blocks.GetBlockTransactions(ctx, tx.Slot, s.commitment)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to read block for sandwich detection", "trade_id", trade.ID, "slot", tx.Slot, "error", err)
+		return
+	}
+	if block == nil {
+		slog.WarnContext(ctx, "Block for sandwich detection is not available", "trade_id", trade.ID, "slot", tx.Slot)
+		return
+	}
+
+	found, err := findSandwich(block, tx, trade)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to analyze block for sandwiches", "trade_id", trade.ID, "slot", tx.Slot, "error", err)
+		return
+	}
+	if found == nil {
+		if s.metrics != nil {
+			s.metrics.RecordMEVCheck(ctx, false, 0)
+		}
+		return
+	}
+
+	var loss float64
+	if found.profit.IsPositive() {
+		loss = money.Float(found.profit)
+	}
+	incident := &model.MEVIncident{
+		ID:                trade.TransactionHash,
+		TradeID:           trade.ID,
+		Slot:              tx.Slot,
+		Attacker:          found.attacker,
+		FrontRunSignature: string(found.frontRun),
+		BackRunSignature:  string(found.backRun),
+		Pool:              string(found.pool),
+		LossMint:          trade.FromCoinMintAddress,
+		EstimatedLoss:     loss,
+		EstimatedLossUSD:  loss * trade.FromUSDPrice,
+		DetectedAt:        time.Now(),
+	}
+	slog.WarnContext(ctx, "Suspected sandwich of trade",
+		"trade_id", trade.ID,
+		"attacker", incident.Attacker,
+		"estimated_loss", incident.EstimatedLoss,
+		"estimated_loss_usd", incident.EstimatedLossUSD)
+	if s.metrics != nil {
+		s.metrics.RecordMEVCheck(ctx, true, incident.EstimatedLossUSD)
+	}
+	if _, err := s.store.MEVIncidents().Upsert(ctx, incident); err != nil {
+		slog.WarnContext(ctx, "Failed to store MEV incident", "trade_id", trade.ID, "error", err)
+	}
+}
+
+// getMEVIncident returns the sandwich recorded for a trade, or nil
+func (s *Service) getMEVIncident(ctx context.Context, trade *model.Trade) *model.MEVIncident {
+	incident, err := s.store.MEVIncidents().Get(ctx, trade.TransactionHash)
+	if err != nil {
+		if !errors.Is(err, db.ErrNotFound) {
+			slog.WarnContext(ctx, "Failed to get MEV incident", "trade_id", trade.ID, "error", err)
+		}
+		return nil
+	}
+	return incident
+}
+
+// findSandwich looks for a signer whose transaction right before the swap
+// traded the same way, spending the swap's input for its output, and whose
+// transaction right after traded back, both against a pool the swap used.
+// The signer's input-mint gain over the pair is its profit, which came out
+// of the swap's price.
+func findSandwich(block []*bmodel.TransactionDetail, victim *bmodel.TransactionDetail, trade *model.Trade) (*sandwich, error) {
+	position := -1
+	for i, tx := range block {
+		if tx.Signature == victim.Signature {
+			position = i
+			break
+		}
+	}
+	if position < 0 {
+		return nil, nil
+	}
+
+	input, output := trade.FromCoinMintAddress, trade.ToCoinMintAddress
+	pools := poolAccounts(victim, output, trade.UserID)
+	if len(pools) == 0 {
+		return nil, nil
+	}
+
+	// The nearest front-run wins; bundles place it right before the victim
+	for i := position - 1; i >= 0; i-- {
+		front := block[i]
+		attacker := feePayer(front)
+		if front.Err != nil || attacker == "" || attacker == trade.UserID {
+			continue
+		}
+		frontIn, frontOut, err := swapLegs(front, attacker, input, output)
+		if err != nil {
+			return nil, err
+		}
+		if !frontIn.IsNegative() || !frontOut.IsPositive() {
+			continue
+		}
+		frontPools := poolAccounts(front, output, attacker)
+
+		for _, back := range block[position+1:] {
+			if back.Err != nil || feePayer(back) != attacker {
+				continue
+			}
+			backIn, backOut, err := swapLegs(back, attacker, input, output)
+			if err != nil {
+				return nil, err
+			}
+			if !backIn.IsPositive() || !backOut.IsNegative() {
+				continue
+			}
+			for pool := range poolAccounts(back, output, attacker) {
+				if pools[pool] && frontPools[pool] {
+					return &sandwich{
+						attacker: attacker,
+						frontRun: front.Signature,
+						backRun:  back.Signature,
+						pool:     pool,
+						profit:   frontIn.Add(backIn),
+					}, nil
+				}
+			}
+		}
+	}
+	return nil, nil
+}
+
+// swapLegs is how much of the input and output mints owner gained in tx
+func swapLegs(tx *bmodel.TransactionDetail, owner, input, output string) (money.Decimal, money.Decimal, error) {
+	in, err := balanceChange(tx, owner, input)
+	if err != nil {
+		return money.Decimal{}, money.Decimal{}, err
+	}
+	out, err := balanceChange(tx, owner, output)
+	if err != nil {
+		return money.Decimal{}, money.Decimal{}, err
+	}
+	return in, out, nil
+}
+
+// poolAccounts are the token accounts of mint whose balance tx changed and
+// that trader doesn't own: the pool vaults it traded against. Native SOL
+// pools hold wrapped SOL.
+func poolAccounts(tx *bmodel.TransactionDetail, mint, trader string) map[bmodel.Address]bool {
+	if mint == model.NativeSolMint {
+		mint = model.SolMint
+	}
+	pre := make(map[int]string)
+	for _, balance := range tx.PreTokenBalances {
+		if string(balance.Mint) == mint {
+			pre[balance.AccountIndex] = balance.Amount
+		}
+	}
+	pools := make(map[bmodel.Address]bool)
+	for _, balance := range tx.PostTokenBalances {
+		if string(balance.Mint) != mint || string(balance.Owner) == trader || balance.AccountIndex >= len(tx.Accounts) {
+			continue
+		}
+		before, ok := pre[balance.AccountIndex]
+		if !ok {
+			before = "0"
+		}
+		if before != balance.Amount {
+			pools[tx.Accounts[balance.AccountIndex]] = true
+		}
+	}
+	return pools
+}
+
+// feePayer is the first signer of tx
+func feePayer(tx *bmodel.TransactionDetail) string {
+	if len(tx.Accounts) == 0 {
+		return ""
+	}
+	return string(tx.Accounts[0])
+}
@@ -0,0 +1,74 @@
+package trade
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	bmodel "github.com/nicolas-martin/dankfolio/backend/internal/model/blockchain"
+)
+
+const (
+	mevAttacker = "Bot11111111111111111111111111111111111111111"
+	mevPool     = "PoolBonkVault"
+	mevPoolAuth = "PoolAuthority"
+)
+
+// poolSwap buys (or sells, for negative bonk) BONK from the pool for SOL.
+// Base units: BONK has 5 decimals, SOL 9.
+func poolSwap(sig, trader string, lamports int64, bonk int64) *bmodel.TransactionDetail {
+	const poolBonk, traderBonk = 1_000_000_000, 100_000_000
+	return &bmodel.TransactionDetail{
+		Signature:    bmodel.Signature(sig),
+		Accounts:     []bmodel.Address{bmodel.Address(trader), "TraderBonk", mevPool},
+		PreBalances:  []uint64{10_000_000_000, 2_039_280, 2_039_280},
+		PostBalances: []uint64{uint64(10_000_000_000 - lamports), 2_039_280, 2_039_280},
+		PreTokenBalances: []bmodel.TokenBalance{
+			{AccountIndex: 1, Mint: receiptBonk, Owner: bmodel.Address(trader), Amount: itoa(traderBonk), Decimals: 5},
+			{AccountIndex: 2, Mint: receiptBonk, Owner: mevPoolAuth, Amount: itoa(poolBonk), Decimals: 5},
+		},
+		PostTokenBalances: []bmodel.TokenBalance{
+			{AccountIndex: 1, Mint: receiptBonk, Owner: bmodel.Address(trader), Amount: itoa(traderBonk + bonk), Decimals: 5},
+			{AccountIndex: 2, Mint: receiptBonk, Owner: mevPoolAuth, Amount: itoa(poolBonk - bonk), Decimals: 5},
+		},
+	}
+}
+
+func itoa(n int64) string {
+	return fmt.Sprintf("%d", n)
+}
+
+func TestFindSandwich(t *testing.T) {
+	trade := &model.Trade{UserID: receiptUser, FromCoinMintAddress: model.NativeSolMint, ToCoinMintAddress: receiptBonk}
+	front := poolSwap("front", mevAttacker, 2_000_000_000, 5_000_000)
+	victim := poolSwap("victim", receiptUser, 1_000_000_000, 2_000_000)
+	back := poolSwap("back", mevAttacker, -2_050_000_000, -5_000_000)
+	unrelated := poolSwap("other", "Someone111111111111111111111111111111111111", 500_000_000, 1_000_000)
+
+	found, err := findSandwich([]*bmodel.TransactionDetail{front, unrelated, victim, back}, victim, trade)
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	assert.Equal(t, mevAttacker, found.attacker)
+	assert.Equal(t, bmodel.Signature("front"), found.frontRun)
+	assert.Equal(t, bmodel.Signature("back"), found.backRun)
+	assert.Equal(t, bmodel.Address(mevPool), found.pool)
+	assert.Equal(t, "0.05", found.profit.String())
+
+	// Without the back-run, or with the back-run first, there is no sandwich
+	found, err = findSandwich([]*bmodel.TransactionDetail{front, victim, unrelated}, victim, trade)
+	require.NoError(t, err)
+	assert.Nil(t, found)
+	found, err = findSandwich([]*bmodel.TransactionDetail{back, victim, front}, victim, trade)
+	require.NoError(t, err)
+	assert.Nil(t, found)
+
+	// A bracketing pair against another pool is not a sandwich of this swap
+	elsewhere := poolSwap("back", mevAttacker, -2_050_000_000, -5_000_000)
+	elsewhere.Accounts[2] = "OtherPoolVault"
+	found, err = findSandwich([]*bmodel.TransactionDetail{front, victim, elsewhere}, victim, trade)
+	require.NoError(t, err)
+	assert.Nil(t, found)
+}
@@ -1,5 +1,11 @@
 package trade
 
+import (
+	"time"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/price"
+)
+
 // PrepareSwapRequestData holds the parameters for a PrepareSwap operation.
 type PrepareSwapRequestData struct {
 	FromCoinMintAddress string
@@ -15,6 +21,21 @@ type PrepareSwapResponse struct {
 	SolFeeBreakdown     *SolFeeBreakdown `json:"solFeeBreakdown,omitempty"`
 	TotalSolRequired    string           `json:"totalSolRequired"`
 	TradingFeeSol       string           `json:"tradingFeeSol"`
+	QuoteID             string           `json:"quoteId"`   // Pass to SubmitSwap or RefreshQuote
+	ExpiresAt           time.Time        `json:"expiresAt"` // SubmitSwap rejects the transaction after this
+
+	// Sponsored swaps only: the fee added to recover the platform's cost, and that cost
+	SponsorFeeBps       int    `json:"sponsorFeeBps,omitempty"`
+	SponsorCostLamports uint64 `json:"sponsorCostLamports,omitempty"`
+
+	// RiskWarnings are the risk checks the swap failed. Blocking ones were acknowledged.
+	RiskWarnings []RiskWarning `json:"riskWarnings,omitempty"`
+
+	// RoutePlan lists the DEXes the swap is routed through
+	RoutePlan []string `json:"routePlan,omitempty"`
+
+	// Deduplicated is set when this is the result of an identical request made moments earlier
+	Deduplicated bool `json:"deduplicated"`
 }
 
 // TradeQuote represents a quote for a trade
@@ -28,10 +49,17 @@ type TradeQuote struct {
 	OutputMint      string   `json:"outputMint"`
 	Raw             []byte   `json:"-"` // Full raw quote response for Jupiter swap
 
+	// Exact amounts in base units as quoted; EstimatedAmount is rounded for display
+	InputRawAmount     string `json:"inputRawAmount"`
+	EstimatedRawAmount string `json:"estimatedRawAmount"`
+
 	// Enhanced SOL fee breakdown
 	SolFeeBreakdown  *SolFeeBreakdown `json:"solFeeBreakdown,omitempty"`
 	TotalSolRequired string           `json:"totalSolRequired"` // Total SOL needed for transaction
 	TradingFeeSol    string           `json:"tradingFeeSol"`    // Trading fees in SOL
+
+	// Tokens whose BirdEye price disagrees with Jupiter's; USD values may be off when set
+	PriceDivergences []price.PriceDivergence `json:"priceDivergences,omitempty"`
 }
 
 // SolFeeBreakdown provides detailed breakdown of all SOL costs
@@ -0,0 +1,378 @@
+package trade
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/apperrors"
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	"github.com/nicolas-martin/dankfolio/backend/internal/util"
+	"github.com/nicolas-martin/dankfolio/backend/internal/util/money"
+)
+
+// DefaultPaperStartingSOL is the virtual SOL a paper trading account starts with
+const DefaultPaperStartingSOL = 10.0
+
+const (
+	paperStatusPrepared = "prepared"
+	paperStatusFilled   = "filled"
+	paperStatusExpired  = "expired"
+)
+
+// PaperHolding is a paper balance valued at its coin's current price
+type PaperHolding struct {
+	model.PaperBalance
+	Symbol           string
+	Price            float64
+	ValueUSD         float64
+	UnrealizedPnLUSD float64
+}
+
+// PaperPortfolio is a wallet's paper balances and their PnL
+type PaperPortfolio struct {
+	Holdings         []PaperHolding // Largest value first; sold-out coins are left out
+	TotalValueUSD    float64
+	UnrealizedPnLUSD float64
+	RealizedPnLUSD   float64 // Includes coins since sold out
+	StartingSOL      float64
+}
+
+// SetPaperStartingSOL sets the virtual SOL new paper trading accounts are funded with
+func (s *Service) SetPaperStartingSOL(sol float64) {
+	if sol <= 0 {
+		sol = DefaultPaperStartingSOL
+	}
+	s.paperStartingSOL = sol
+}
+
+// paperMint returns the mint a paper balance is kept under; native SOL and
+// wrapped SOL share one balance
+func paperMint(mint string) string {
+	if mint == model.NativeSolMint {
+		return model.SolMint
+	}
+	return mint
+}
+
+// paperBalances returns a wallet's paper balances by mint, funding the account
+// with its starting SOL the first time it's used
+func (s *Service) paperBalances(ctx context.Context, store db.Store, wallet string) (map[string]*model.PaperBalance, error) {
+	rows, _, err := store.PaperBalances().ListWithOpts(ctx, db.ListOptions{
+		Filters: []db.FilterOption{{Field: "wallet_address", Operator: db.FilterOpEqual, Value: wallet}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list paper balances: %w", err)
+	}
+	balances := make(map[string]*model.PaperBalance, len(rows))
+	for i := range rows {
+		balances[rows[i].Mint] = &rows[i]
+	}
+	if len(balances) > 0 {
+		return balances, nil
+	}
+
+	// The starting SOL is its own cost basis, so PnL is measured against it
+	var solPrice float64
+	if sol, err := s.coinService.GetCoinByAddress(ctx, model.SolMint); err == nil {
+		solPrice = sol.Price
+	} else {
+		slog.WarnContext(ctx, "Failed to price starting paper SOL", "error", err)
+	}
+	funding := &model.PaperBalance{
+		ID:            wallet + ":" + model.SolMint,
+		WalletAddress: wallet,
+		Mint:          model.SolMint,
+		Amount:        s.paperStartingSOL,
+		CostBasisUSD:  s.paperStartingSOL * solPrice,
+	}
+	if _, err := store.PaperBalances().Upsert(ctx, funding); err != nil {
+		return nil, fmt.Errorf("failed to fund paper account: %w", err)
+	}
+	slog.InfoContext(ctx, "Funded paper trading account", "wallet", wallet, "sol", s.paperStartingSOL)
+	balances[model.SolMint] = funding
+	return balances, nil
+}
+
+// preparePaperSwap quotes a swap the way prepareSwap does but, instead of
+// building a transaction, records a paper trade to fill against the wallet's
+// virtual balances when it's submitted before the quote expires.
+func (s *Service) preparePaperSwap(ctx context.Context, params model.PrepareSwapRequestData) (*PrepareSwapResponse, error) {
+	if !util.IsValidSolanaAddress(params.UserWalletAddress) {
+		return nil, fmt.Errorf("invalid user_wallet_address: %s", params.UserWalletAddress)
+	}
+	if !util.IsValidSolanaAddress(params.FromCoinMintAddress) {
+		return nil, apperrors.ErrInvalidAddress.WithMessage(fmt.Sprintf("invalid from_coin_mint_address: %s", params.FromCoinMintAddress))
+	}
+	if !util.IsValidSolanaAddress(params.ToCoinMintAddress) {
+		return nil, apperrors.ErrInvalidAddress.WithMessage(fmt.Sprintf("invalid to_coin_mint_address: %s", params.ToCoinMintAddress))
+	}
+	if s.blocklist != nil {
+		if err := s.blocklist.Check(params.FromCoinMintAddress, params.ToCoinMintAddress); err != nil {
+			return nil, err
+		}
+	}
+	amountInt, err := strconv.ParseUint(params.Amount, 10, 64)
+	if err != nil || amountInt == 0 {
+		return nil, fmt.Errorf("invalid amount (must be positive integer in raw units): %s", params.Amount)
+	}
+
+	fromCoin, err := s.coinService.GetCoinByAddress(ctx, params.FromCoinMintAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get fromCoin details for %s: %w", params.FromCoinMintAddress, err)
+	}
+	toCoin, err := s.coinService.GetCoinByAddress(ctx, params.ToCoinMintAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get toCoin details for %s: %w", params.ToCoinMintAddress, err)
+	}
+	quote, err := s.getSwapQuote(ctx, params.FromCoinMintAddress, params.ToCoinMintAddress, params.Amount, params.SlippageBps, false, "", params.AllowMultiHop)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get trade quote: %w", err)
+	}
+	outputAmount, err := strconv.ParseFloat(quote.EstimatedAmount, 64)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse estimated output amount: %w", err)
+	}
+	inputAmount := money.Float(money.FromBaseUnits(amountInt, uint8(fromCoin.Decimals)))
+
+	balances, err := s.paperBalances(ctx, s.store, params.UserWalletAddress)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkPaperBalance(balances, params.FromCoinMintAddress, inputAmount); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	paperTrade := &model.PaperTrade{
+		ID:                  uuid.NewString(),
+		WalletAddress:       params.UserWalletAddress,
+		QuoteID:             uuid.NewString(),
+		FromCoinMintAddress: params.FromCoinMintAddress,
+		ToCoinMintAddress:   params.ToCoinMintAddress,
+		Amount:              inputAmount,
+		OutputAmount:        outputAmount,
+		RawAmount:           params.Amount,
+		RawOutputAmount:     quote.EstimatedRawAmount,
+		FromUSDPrice:        fromCoin.Price,
+		ToUSDPrice:          toCoin.Price,
+		Status:              paperStatusPrepared,
+		QuoteExpiresAt:      now.Add(time.Duration(s.quoteTTL.Load())),
+		CreatedAt:           now,
+	}
+	if err := s.store.PaperTrades().Create(ctx, paperTrade); err != nil {
+		return nil, fmt.Errorf("failed to create paper trade: %w", err)
+	}
+	return &PrepareSwapResponse{
+		TotalSolRequired: "0",
+		TradingFeeSol:    "0",
+		QuoteID:          paperTrade.QuoteID,
+		ExpiresAt:        paperTrade.QuoteExpiresAt,
+	}, nil
+}
+
+// checkPaperBalance rejects spending more of a mint than the paper account holds
+func checkPaperBalance(balances map[string]*model.PaperBalance, mint string, amount float64) error {
+	var held float64
+	if balance, ok := balances[paperMint(mint)]; ok {
+		held = balance.Amount
+	}
+	// Spending a whole balance converted from base units can overshoot it by a rounding error
+	if amount > held*(1+1e-9) {
+		return apperrors.ErrInsufficientBalance.WithMessage(fmt.Sprintf("insufficient paper balance: have %g, need %g", held, amount))
+	}
+	return nil
+}
+
+// FillPaperSwap fills a prepared paper trade at its quote: the input leaves the
+// wallet's paper balances with its share of their cost basis, realizing the
+// difference from its quoted USD value, and the output arrives at that value.
+// Filling an already filled trade returns it unchanged.
+func (s *Service) FillPaperSwap(ctx context.Context, quoteID string) (*model.PaperTrade, error) {
+	if quoteID == "" {
+		return nil, fmt.Errorf("quote_id cannot be empty")
+	}
+	s.paperMu.Lock()
+	defer s.paperMu.Unlock()
+
+	paperTrade, err := s.store.PaperTrades().GetByField(ctx, "quote_id", quoteID)
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return nil, ErrQuoteNotFound.With("quote_id", quoteID).Wrap(err)
+		}
+		return nil, fmt.Errorf("failed to find paper quote %s: %w", quoteID, err)
+	}
+	if paperTrade.Status == paperStatusFilled {
+		return paperTrade, nil
+	}
+	if paperTrade.Status == paperStatusExpired || time.Now().After(paperTrade.QuoteExpiresAt) {
+		if paperTrade.Status != paperStatusExpired {
+			paperTrade.Status = paperStatusExpired
+			if err := s.store.PaperTrades().Update(ctx, paperTrade); err != nil {
+				slog.WarnContext(ctx, "Failed to mark paper quote expired", "quote_id", quoteID, "error", err)
+			}
+		}
+		return nil, ErrQuoteExpired.With("quote_id", quoteID).Wrap(fmt.Errorf("paper quote %s expired at %s", quoteID, paperTrade.QuoteExpiresAt.Format(time.RFC3339)))
+	}
+
+	err = s.store.WithTransaction(ctx, func(txStore db.Store) error {
+		balances, err := s.paperBalances(ctx, txStore, paperTrade.WalletAddress)
+		if err != nil {
+			return err
+		}
+		if err := checkPaperBalance(balances, paperTrade.FromCoinMintAddress, paperTrade.Amount); err != nil {
+			return err
+		}
+		from := balances[paperMint(paperTrade.FromCoinMintAddress)]
+		to, ok := balances[paperMint(paperTrade.ToCoinMintAddress)]
+		if !ok {
+			mint := paperMint(paperTrade.ToCoinMintAddress)
+			to = &model.PaperBalance{ID: paperTrade.WalletAddress + ":" + mint, WalletAddress: paperTrade.WalletAddress, Mint: mint}
+		}
+
+		spent := min(paperTrade.Amount, from.Amount)
+		costMoved := from.CostBasisUSD * spent / from.Amount
+		valueUSD := spent * paperTrade.FromUSDPrice
+		from.Amount -= spent
+		from.CostBasisUSD -= costMoved
+		from.RealizedPnLUSD += valueUSD - costMoved
+		to.Amount += paperTrade.OutputAmount
+		to.CostBasisUSD += valueUSD
+
+		if _, err := txStore.PaperBalances().Upsert(ctx, from); err != nil {
+			return fmt.Errorf("failed to debit paper balance: %w", err)
+		}
+		if _, err := txStore.PaperBalances().Upsert(ctx, to); err != nil {
+			return fmt.Errorf("failed to credit paper balance: %w", err)
+		}
+		paperTrade.Status = paperStatusFilled
+		paperTrade.FilledAt = time.Now()
+		if err := txStore.PaperTrades().Update(ctx, paperTrade); err != nil {
+			return fmt.Errorf("failed to mark paper trade filled: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	slog.InfoContext(ctx, "Paper trade filled",
+		"wallet", paperTrade.WalletAddress,
+		"from", paperTrade.FromCoinMintAddress,
+		"to", paperTrade.ToCoinMintAddress,
+		"amount", paperTrade.Amount,
+		"output_amount", paperTrade.OutputAmount)
+	return paperTrade, nil
+}
+
+// ListPaperTrades returns a wallet's filled paper trades, newest first
+func (s *Service) ListPaperTrades(ctx context.Context, wallet string, limit, offset int) ([]model.PaperTrade, int32, error) {
+	sortBy, desc := "created_at", true
+	opts := db.ListOptions{
+		SortBy:   &sortBy,
+		SortDesc: &desc,
+		Filters: []db.FilterOption{
+			{Field: "wallet_address", Operator: db.FilterOpEqual, Value: wallet},
+			{Field: "status", Operator: db.FilterOpEqual, Value: paperStatusFilled},
+		},
+	}
+	if limit > 0 {
+		opts.Limit = &limit
+	}
+	if offset > 0 {
+		opts.Offset = &offset
+	}
+	trades, total, err := s.store.PaperTrades().ListWithOpts(ctx, opts)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list paper trades: %w", err)
+	}
+	return trades, total, nil
+}
+
+// GetPaperPortfolio values a wallet's paper balances at current prices
+func (s *Service) GetPaperPortfolio(ctx context.Context, wallet string) (*PaperPortfolio, error) {
+	if !util.IsValidSolanaAddress(wallet) {
+		return nil, apperrors.ErrInvalidAddress.WithMessage(fmt.Sprintf("invalid wallet address: %s", wallet))
+	}
+	balances, err := s.paperBalances(ctx, s.store, wallet)
+	if err != nil {
+		return nil, err
+	}
+
+	portfolio := &PaperPortfolio{StartingSOL: s.paperStartingSOL}
+	mints := make([]string, 0, len(balances))
+	for mint, balance := range balances {
+		portfolio.RealizedPnLUSD += balance.RealizedPnLUSD
+		if balance.Amount > 0 {
+			mints = append(mints, mint)
+		}
+	}
+	coins, err := s.coinService.GetCoinsByAddresses(ctx, mints, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to price paper balances: %w", err)
+	}
+	coinsByMint := make(map[string]model.Coin, len(coins))
+	for _, c := range coins {
+		coinsByMint[c.Address] = c
+	}
+
+	for _, mint := range mints {
+		holding := PaperHolding{PaperBalance: *balances[mint]}
+		if c, ok := coinsByMint[mint]; ok {
+			holding.Symbol = c.Symbol
+			holding.Price = c.Price
+		}
+		holding.ValueUSD = holding.Amount * holding.Price
+		holding.UnrealizedPnLUSD = holding.ValueUSD - holding.CostBasisUSD
+		portfolio.Holdings = append(portfolio.Holdings, holding)
+		portfolio.TotalValueUSD += holding.ValueUSD
+		portfolio.UnrealizedPnLUSD += holding.UnrealizedPnLUSD
+	}
+	sort.Slice(portfolio.Holdings, func(i, j int) bool {
+		return portfolio.Holdings[i].ValueUSD > portfolio.Holdings[j].ValueUSD
+	})
+	return portfolio, nil
+}
+
+// ResetPaperPortfolio deletes a wallet's paper balances and trades, so its
+// next use funds it again with the starting SOL
+func (s *Service) ResetPaperPortfolio(ctx context.Context, wallet string) (*PaperPortfolio, error) {
+	if !util.IsValidSolanaAddress(wallet) {
+		return nil, apperrors.ErrInvalidAddress.WithMessage(fmt.Sprintf("invalid wallet address: %s", wallet))
+	}
+	byWallet := []db.FilterOption{{Field: "wallet_address", Operator: db.FilterOpEqual, Value: wallet}}
+	s.paperMu.Lock()
+	err := s.store.WithTransaction(ctx, func(txStore db.Store) error {
+		balances, _, err := txStore.PaperBalances().ListWithOpts(ctx, db.ListOptions{Filters: byWallet})
+		if err != nil {
+			return fmt.Errorf("failed to list paper balances: %w", err)
+		}
+		for _, b := range balances {
+			if err := txStore.PaperBalances().HardDelete(ctx, b.ID); err != nil {
+				return fmt.Errorf("failed to delete paper balance: %w", err)
+			}
+		}
+		trades, _, err := txStore.PaperTrades().ListWithOpts(ctx, db.ListOptions{Filters: byWallet})
+		if err != nil {
+			return fmt.Errorf("failed to list paper trades: %w", err)
+		}
+		for _, t := range trades {
+			if err := txStore.PaperTrades().HardDelete(ctx, t.ID); err != nil {
+				return fmt.Errorf("failed to delete paper trade: %w", err)
+			}
+		}
+		return nil
+	})
+	s.paperMu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	slog.InfoContext(ctx, "Reset paper trading account", "wallet", wallet)
+	return s.GetPaperPortfolio(ctx, wallet)
+}
@@ -0,0 +1,96 @@
+package trade
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/apperrors"
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	dbmocks "github.com/nicolas-martin/dankfolio/backend/internal/db/mocks"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+const (
+	paperWallet = "9WzDXwBbmkg8ZTbNMqUxvQRAyrZzDsGYdLVL9zYtAWWM"
+	paperBonk   = "DezXAZ8z7PnrnRJjz3wXBoRgixCa6xjnB7YaB1pPB263"
+)
+
+func TestCheckPaperBalance(t *testing.T) {
+	balances := map[string]*model.PaperBalance{model.SolMint: {Mint: model.SolMint, Amount: 1}}
+
+	require.NoError(t, checkPaperBalance(balances, model.NativeSolMint, 1))
+	assert.ErrorIs(t, checkPaperBalance(balances, model.SolMint, 1.5), apperrors.ErrInsufficientBalance)
+	assert.ErrorIs(t, checkPaperBalance(balances, paperBonk, 1), apperrors.ErrInsufficientBalance)
+}
+
+func TestFillPaperSwap_MovesCostBasisAndRealizesPnL(t *testing.T) {
+	store := dbmocks.NewMockStore(t)
+	trades := dbmocks.NewMockRepository[model.PaperTrade](t)
+	balances := dbmocks.NewMockRepository[model.PaperBalance](t)
+	store.EXPECT().PaperTrades().Return(trades)
+	store.EXPECT().PaperBalances().Return(balances)
+	store.EXPECT().WithTransaction(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, fn func(db.Store) error) error { return fn(store) })
+	svc := &Service{store: store}
+
+	prepared := &model.PaperTrade{
+		ID:                  "t1",
+		WalletAddress:       paperWallet,
+		QuoteID:             "q1",
+		FromCoinMintAddress: model.NativeSolMint,
+		ToCoinMintAddress:   paperBonk,
+		Amount:              2,
+		OutputAmount:        1000,
+		FromUSDPrice:        150,
+		Status:              paperStatusPrepared,
+		QuoteExpiresAt:      time.Now().Add(time.Minute),
+	}
+	trades.EXPECT().GetByField(mock.Anything, "quote_id", "q1").Return(prepared, nil)
+	// 10 SOL bought at $100
+	balances.EXPECT().ListWithOpts(mock.Anything, mock.Anything).Return([]model.PaperBalance{
+		{ID: paperWallet + ":" + model.SolMint, WalletAddress: paperWallet, Mint: model.SolMint, Amount: 10, CostBasisUSD: 1000},
+	}, int32(1), nil)
+	saved := map[string]model.PaperBalance{}
+	balances.EXPECT().Upsert(mock.Anything, mock.Anything).
+		Run(func(_ context.Context, b *model.PaperBalance) { saved[b.Mint] = *b }).Return(int64(1), nil)
+	trades.EXPECT().Update(mock.Anything, mock.Anything).Return(nil)
+
+	filled, err := svc.FillPaperSwap(context.Background(), "q1")
+	require.NoError(t, err)
+	assert.Equal(t, paperStatusFilled, filled.Status)
+
+	sol := saved[model.SolMint]
+	assert.InDelta(t, 8, sol.Amount, 1e-9)
+	assert.InDelta(t, 800, sol.CostBasisUSD, 1e-9)
+	assert.InDelta(t, 100, sol.RealizedPnLUSD, 1e-9) // Sold $200 of cost for $300
+	bonk := saved[paperBonk]
+	assert.InDelta(t, 1000, bonk.Amount, 1e-9)
+	assert.InDelta(t, 300, bonk.CostBasisUSD, 1e-9)
+
+	// Submitting again returns the fill without applying it twice
+	filledAgain, err := svc.FillPaperSwap(context.Background(), "q1")
+	require.NoError(t, err)
+	assert.Equal(t, "t1", filledAgain.ID)
+}
+
+func TestFillPaperSwap_ExpiredQuote(t *testing.T) {
+	store := dbmocks.NewMockStore(t)
+	trades := dbmocks.NewMockRepository[model.PaperTrade](t)
+	store.EXPECT().PaperTrades().Return(trades)
+	svc := &Service{store: store}
+
+	trades.EXPECT().GetByField(mock.Anything, "quote_id", "q1").Return(&model.PaperTrade{
+		QuoteID: "q1", Status: paperStatusPrepared, QuoteExpiresAt: time.Now().Add(-time.Second),
+	}, nil)
+	trades.EXPECT().Update(mock.Anything, mock.MatchedBy(func(t *model.PaperTrade) bool {
+		return t.Status == paperStatusExpired
+	})).Return(nil)
+
+	_, err := svc.FillPaperSwap(context.Background(), "q1")
+	assert.ErrorIs(t, err, ErrQuoteExpired)
+}
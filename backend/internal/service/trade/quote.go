@@ -0,0 +1,98 @@
+package trade
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/apperrors"
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+// DefaultQuoteTTL is how long a prepared swap can be submitted. Prices on
+// thin pools move within a minute, and the transaction's blockhash stops
+// landing not long after.
+const DefaultQuoteTTL = 45 * time.Second
+
+const (
+	tradeStatusPrepared = "prepared"
+	tradeStatusExpired  = "expired"
+)
+
+var (
+	// ErrQuoteExpired is returned when submitting a prepared swap whose quote
+	// has expired; the client should call RefreshQuote and sign the new one.
+	ErrQuoteExpired = apperrors.ErrQuoteExpired
+	// ErrQuoteNotFound is returned for an unknown quote ID
+	ErrQuoteNotFound = apperrors.ErrQuoteNotFound
+	// ErrQuoteNotRefreshable is returned when refreshing a quote whose swap was already submitted
+	ErrQuoteNotRefreshable = apperrors.ErrQuoteNotRefreshable
+)
+
+// SetQuoteTTL sets how long swaps prepared from now on can be submitted
+func (s *Service) SetQuoteTTL(ttl time.Duration) {
+	s.quoteTTL.Store(int64(ttl))
+}
+
+// checkQuoteFresh rejects a prepared trade whose quote has expired, marking it
+// expired so the audit trail shows why it never executed. Trades prepared
+// before quotes carried an expiry are let through.
+func (s *Service) checkQuoteFresh(ctx context.Context, trade *model.Trade) error {
+	if trade.Status != tradeStatusExpired && (trade.QuoteExpiresAt.IsZero() || time.Now().Before(trade.QuoteExpiresAt)) {
+		return nil
+	}
+	if trade.Status != tradeStatusExpired {
+		trade.Status = tradeStatusExpired
+		if err := s.store.Trades().Update(ctx, trade); err != nil {
+			slog.WarnContext(ctx, "Failed to mark trade quote expired", "trade_id", trade.ID, "error", err)
+		}
+	}
+	return ErrQuoteExpired.With("quote_id", trade.QuoteID).Wrap(fmt.Errorf("quote %s expired at %s", trade.QuoteID, trade.QuoteExpiresAt.Format(time.RFC3339)))
+}
+
+// RefreshQuote re-quotes a prepared swap with its original parameters and
+// returns a new unsigned transaction under a new quote ID. The old quote is
+// retired so only the refreshed transaction can be submitted.
+func (s *Service) RefreshQuote(ctx context.Context, quoteID string) (*PrepareSwapResponse, error) {
+	if quoteID == "" {
+		return nil, fmt.Errorf("quote_id cannot be empty")
+	}
+	trade, err := s.store.Trades().GetByField(ctx, "quote_id", quoteID)
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return nil, ErrQuoteNotFound.With("quote_id", quoteID).Wrap(err)
+		}
+		return nil, fmt.Errorf("failed to find quote %s: %w", quoteID, err)
+	}
+	if trade.Status != tradeStatusPrepared && trade.Status != tradeStatusExpired {
+		return nil, ErrQuoteNotRefreshable.With("quote_id", quoteID).Wrap(fmt.Errorf("trade %d is %s", trade.ID, trade.Status))
+	}
+
+	resp, err := s.prepareSwap(ctx, model.PrepareSwapRequestData{
+		FromCoinMintAddress: trade.FromCoinMintAddress,
+		ToCoinMintAddress:   trade.ToCoinMintAddress,
+		Amount:              trade.RawAmount,
+		SlippageBps:         trade.QuoteSlippageBps,
+		UserWalletAddress:   trade.FromAddress,
+		AllowMultiHop:       trade.QuoteMultiHop,
+		Sponsored:           trade.Sponsored,
+		AcknowledgedRisks:   trade.AcknowledgedRisks,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if trade.Status != tradeStatusExpired {
+		trade.Status = tradeStatusExpired
+		trade.QuoteExpiresAt = time.Now()
+		if err := s.store.Trades().Update(ctx, trade); err != nil {
+			slog.WarnContext(ctx, "Failed to retire refreshed quote", "quote_id", quoteID, "error", err)
+		}
+	}
+
+	slog.InfoContext(ctx, "Quote refreshed", "old_quote_id", quoteID, "new_quote_id", resp.QuoteID)
+	return resp, nil
+}
@@ -0,0 +1,55 @@
+package trade
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	dbmocks "github.com/nicolas-martin/dankfolio/backend/internal/db/mocks"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+func TestCheckQuoteFresh(t *testing.T) {
+	store := dbmocks.NewMockStore(t)
+	trades := dbmocks.NewMockRepository[model.Trade](t)
+	svc := &Service{store: store}
+
+	// Fresh quotes and trades prepared before quotes had an expiry pass untouched
+	require.NoError(t, svc.checkQuoteFresh(context.Background(), &model.Trade{Status: tradeStatusPrepared, QuoteExpiresAt: time.Now().Add(time.Minute)}))
+	require.NoError(t, svc.checkQuoteFresh(context.Background(), &model.Trade{Status: tradeStatusPrepared}))
+
+	store.EXPECT().Trades().Return(trades)
+	trades.EXPECT().Update(mock.Anything, mock.MatchedBy(func(t *model.Trade) bool {
+		return t.Status == tradeStatusExpired
+	})).Return(nil).Once()
+
+	stale := &model.Trade{ID: 1, Status: tradeStatusPrepared, QuoteID: "q1", QuoteExpiresAt: time.Now().Add(-time.Second)}
+	err := svc.checkQuoteFresh(context.Background(), stale)
+	assert.ErrorIs(t, err, ErrQuoteExpired)
+
+	// Already marked expired: rejected again without another write
+	assert.ErrorIs(t, svc.checkQuoteFresh(context.Background(), stale), ErrQuoteExpired)
+}
+
+func TestRefreshQuote_Errors(t *testing.T) {
+	store := dbmocks.NewMockStore(t)
+	trades := dbmocks.NewMockRepository[model.Trade](t)
+	store.EXPECT().Trades().Return(trades)
+	svc := &Service{store: store}
+
+	trades.EXPECT().GetByField(mock.Anything, "quote_id", "missing").
+		Return(nil, fmt.Errorf("%w: item with quote_id = missing not found", db.ErrNotFound))
+	_, err := svc.RefreshQuote(context.Background(), "missing")
+	assert.ErrorIs(t, err, ErrQuoteNotFound)
+
+	trades.EXPECT().GetByField(mock.Anything, "quote_id", "submitted").
+		Return(&model.Trade{ID: 2, QuoteID: "submitted", Status: "pending"}, nil)
+	_, err = svc.RefreshQuote(context.Background(), "submitted")
+	assert.ErrorIs(t, err, ErrQuoteNotRefreshable)
+}
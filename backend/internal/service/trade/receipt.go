@@ -0,0 +1,243 @@
+package trade
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/apperrors"
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	bmodel "github.com/nicolas-martin/dankfolio/backend/internal/model/blockchain"
+	"github.com/nicolas-martin/dankfolio/backend/internal/util/money"
+)
+
+// ErrTradeNotComplete is returned for receipts of swaps that have not landed
+var ErrTradeNotComplete = apperrors.ErrTradeNotComplete
+
+// TransactionReader reads a processed transaction with its balance changes.
+// The Solana client implements it.
+type TransactionReader interface {
+	GetTransaction(ctx context.Context, signature bmodel.Signature, commitment string) (*bmodel.TransactionDetail, error)
+}
+
+// TradeReceipt compares what a swap was quoted with how it actually filled
+type TradeReceipt struct {
+	TradeID         uint
+	TransactionHash string
+	FromMint        string
+	ToMint          string
+
+	QuotedAmount       float64
+	QuotedOutputAmount float64
+	QuotedPrice        float64 // Output per unit of input
+
+	ExecutedAmount       float64
+	ExecutedOutputAmount float64
+	ExecutedPrice        float64 // Output per unit of input
+
+	PlatformFee     float64 // In PlatformFeeMint units
+	PlatformFeeMint string
+	NetworkFeeSOL   float64
+	// SlippageBps is how far the executed price fell short of the quoted one;
+	// negative when the fill beat the quote
+	SlippageBps float64
+	AnalyzedAt  time.Time
+	// MEVIncident is the sandwich suspected around the swap, if any
+	MEVIncident *model.MEVIncident
+}
+
+// SetTransactionReader enables trade receipts. Without one, trades complete
+// without their fill being analyzed.
+func (s *Service) SetTransactionReader(reader TransactionReader) {
+	s.transactions = reader
+}
+
+// GetTradeReceipt returns the fill analysis of a completed swap, reading its
+// transaction the first time it is asked for
+func (s *Service) GetTradeReceipt(ctx context.Context, id string) (*TradeReceipt, error) {
+	trade, err := s.GetTrade(ctx, id)
+	if errors.Is(err, db.ErrNotFound) {
+		return nil, apperrors.ErrTradeNotFound.With("trade_id", id).Wrap(err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get trade %s: %w", id, err)
+	}
+	if trade.Type != "swap" || !trade.Finalized || trade.TransactionHash == "" || trade.Status == model.TradeStatusFailed.String() {
+		return nil, ErrTradeNotComplete.With("trade_id", id)
+	}
+
+	if trade.ReceiptAt.IsZero() {
+		if err := s.recordReceipt(ctx, trade); err != nil {
+			return nil, err
+		}
+		if err := s.store.Trades().Update(ctx, trade); err != nil {
+			slog.WarnContext(ctx, "Failed to store trade receipt", "trade_id", trade.ID, "error", err)
+		}
+	}
+	receipt := newTradeReceipt(trade)
+	receipt.MEVIncident = s.getMEVIncident(ctx, trade)
+	return receipt, nil
+}
+
+// recordReceipt reads the trade's confirmed transaction and records the fill
+// on trade. The caller stores it.
+func (s *Service) recordReceipt(ctx context.Context, trade *model.Trade) error {
+	if s.transactions == nil {
+		return fmt.Errorf("trade receipts are not enabled")
+	}
+	tx, err := s.transactions.GetTransaction(ctx, bmodel.Signature(trade.TransactionHash), s.commitment)
+	if err != nil {
+		return fmt.Errorf("failed to read transaction %s: %w", trade.TransactionHash, err)
+	}
+	if tx == nil {
+		return ErrTradeNotComplete.Wrap(fmt.Errorf("transaction %s is not available yet", trade.TransactionHash))
+	}
+
+	fill, err := analyzeFill(tx, trade, s.platformFeeAccountAddress)
+	if err != nil {
+		return fmt.Errorf("failed to analyze transaction %s: %w", trade.TransactionHash, err)
+	}
+	trade.ExecutedAmount = money.Float(fill.input)
+	trade.ExecutedOutputAmount = money.Float(fill.output)
+	trade.ExecutedPlatformFee = money.Float(fill.platformFee)
+	trade.ExecutedFeeMint = fill.platformFeeMint
+	trade.NetworkFeeLamports = tx.Fee
+	trade.SlippageBps = slippageBps(trade.Amount, trade.OutputAmount, fill.input, fill.output)
+	trade.ReceiptAt = time.Now()
+
+	if s.blocks != nil {
+		s.checkSandwich(ctx, trade, tx)
+	}
+	return nil
+}
+
+func newTradeReceipt(trade *model.Trade) *TradeReceipt {
+	return &TradeReceipt{
+		TradeID:              trade.ID,
+		TransactionHash:      trade.TransactionHash,
+		FromMint:             trade.FromCoinMintAddress,
+		ToMint:               trade.ToCoinMintAddress,
+		QuotedAmount:         trade.Amount,
+		QuotedOutputAmount:   trade.OutputAmount,
+		QuotedPrice:          unitPrice(trade.Amount, trade.OutputAmount),
+		ExecutedAmount:       trade.ExecutedAmount,
+		ExecutedOutputAmount: trade.ExecutedOutputAmount,
+		ExecutedPrice:        unitPrice(trade.ExecutedAmount, trade.ExecutedOutputAmount),
+		PlatformFee:          trade.ExecutedPlatformFee,
+		PlatformFeeMint:      trade.ExecutedFeeMint,
+		NetworkFeeSOL:        money.Float(money.FromBaseUnits(trade.NetworkFeeLamports, money.SOLDecimals)),
+		SlippageBps:          trade.SlippageBps,
+		AnalyzedAt:           trade.ReceiptAt,
+	}
+}
+
+// fill is what a swap transaction moved for the trader
+type fill struct {
+	input           money.Decimal // Spent, in UI units
+	output          money.Decimal // Received, in UI units
+	platformFee     money.Decimal
+	platformFeeMint string
+}
+
+// analyzeFill reads the trader's spent input and received output off the
+// transaction's balance changes, and the platform fee off the fee account's.
+func analyzeFill(tx *bmodel.TransactionDetail, trade *model.Trade, platformFeeAccount string) (fill, error) {
+	spent, err := balanceChange(tx, trade.UserID, trade.FromCoinMintAddress)
+	if err != nil {
+		return fill{}, err
+	}
+	received, err := balanceChange(tx, trade.UserID, trade.ToCoinMintAddress)
+	if err != nil {
+		return fill{}, err
+	}
+	result := fill{input: spent.Neg(), output: received}
+
+	if platformFeeAccount == "" {
+		return result, nil
+	}
+	changes, err := tokenChanges(tx, platformFeeAccount)
+	if err != nil {
+		return fill{}, err
+	}
+	for mint, change := range changes {
+		if change.IsPositive() {
+			result.platformFee, result.platformFeeMint = change, mint
+		}
+	}
+	return result, nil
+}
+
+// balanceChange is how much of mint the owner gained across its token
+// accounts. For SOL this includes the owner's native lamports, with the
+// network fee added back so it isn't counted as swapped; rent for token
+// accounts the swap opened still is.
+func balanceChange(tx *bmodel.TransactionDetail, owner, mint string) (money.Decimal, error) {
+	changes, err := tokenChanges(tx, owner)
+	if err != nil {
+		return money.Decimal{}, err
+	}
+	if mint != model.NativeSolMint && mint != model.SolMint {
+		return changes[mint], nil
+	}
+
+	change := changes[model.SolMint]
+	for i, account := range tx.Accounts {
+		if string(account) != owner || i >= len(tx.PreBalances) || i >= len(tx.PostBalances) {
+			continue
+		}
+		lamports := int64(tx.PostBalances[i]) - int64(tx.PreBalances[i])
+		if i == 0 {
+			lamports += int64(tx.Fee)
+		}
+		change = change.Add(money.FromInt(lamports).Shift(-money.SOLDecimals))
+		break
+	}
+	return change, nil
+}
+
+// tokenChanges sums the change of every token account owner has in tx, by mint
+func tokenChanges(tx *bmodel.TransactionDetail, owner string) (map[string]money.Decimal, error) {
+	changes := make(map[string]money.Decimal)
+	add := func(balances []bmodel.TokenBalance, sign int64) error {
+		for _, balance := range balances {
+			if string(balance.Owner) != owner {
+				continue
+			}
+			amount, err := money.ParseBaseUnits(balance.Amount, balance.Decimals)
+			if err != nil {
+				return fmt.Errorf("invalid token balance: %w", err)
+			}
+			mint := string(balance.Mint)
+			changes[mint] = changes[mint].Add(amount.Mul(money.FromInt(sign)))
+		}
+		return nil
+	}
+	if err := add(tx.PreTokenBalances, -1); err != nil {
+		return nil, err
+	}
+	if err := add(tx.PostTokenBalances, 1); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+// slippageBps compares the executed price with the quoted one in basis points
+func slippageBps(quotedInput, quotedOutput float64, input, output money.Decimal) float64 {
+	quoted := unitPrice(quotedInput, quotedOutput)
+	executed := unitPrice(money.Float(input), money.Float(output))
+	if quoted <= 0 || executed <= 0 {
+		return 0
+	}
+	return (quoted - executed) / quoted * 10_000
+}
+
+// unitPrice is output per unit of input, 0 when nothing was spent
+func unitPrice(input, output float64) float64 {
+	if input <= 0 {
+		return 0
+	}
+	return output / input
+}
@@ -0,0 +1,109 @@
+package trade
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/apperrors"
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	dbmocks "github.com/nicolas-martin/dankfolio/backend/internal/db/mocks"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	bmodel "github.com/nicolas-martin/dankfolio/backend/internal/model/blockchain"
+)
+
+const (
+	receiptUser     = "User1111111111111111111111111111111111111111"
+	receiptFeeOwner = "Fee11111111111111111111111111111111111111111"
+	receiptBonk     = "DezXAZ8z7PnrnRJjz3wXBoRgixCa6xjnB7YaB1pPB263"
+)
+
+type fakeTransactionReader struct {
+	tx *bmodel.TransactionDetail
+}
+
+func (f *fakeTransactionReader) GetTransaction(context.Context, bmodel.Signature, string) (*bmodel.TransactionDetail, error) {
+	return f.tx, nil
+}
+
+// swapTx sells 1 SOL for 990 BONK, paying 10 BONK to the platform and a
+// 5000 lamport network fee
+func swapTx() *bmodel.TransactionDetail {
+	return &bmodel.TransactionDetail{
+		Fee:          5000,
+		Accounts:     []bmodel.Address{receiptUser, "BonkAta", "FeeAta"},
+		PreBalances:  []uint64{3_000_000_000, 2_039_280, 2_039_280},
+		PostBalances: []uint64{1_999_995_000, 2_039_280, 2_039_280},
+		PreTokenBalances: []bmodel.TokenBalance{
+			{AccountIndex: 1, Mint: receiptBonk, Owner: receiptUser, Amount: "0", Decimals: 5},
+			{AccountIndex: 2, Mint: receiptBonk, Owner: receiptFeeOwner, Amount: "500000", Decimals: 5},
+		},
+		PostTokenBalances: []bmodel.TokenBalance{
+			{AccountIndex: 1, Mint: receiptBonk, Owner: receiptUser, Amount: "99000000", Decimals: 5},
+			{AccountIndex: 2, Mint: receiptBonk, Owner: receiptFeeOwner, Amount: "1500000", Decimals: 5},
+		},
+	}
+}
+
+func TestAnalyzeFill(t *testing.T) {
+	trade := &model.Trade{UserID: receiptUser, FromCoinMintAddress: model.SolMint, ToCoinMintAddress: receiptBonk, Amount: 1, OutputAmount: 1000}
+
+	fill, err := analyzeFill(swapTx(), trade, receiptFeeOwner)
+	require.NoError(t, err)
+	assert.Equal(t, "1", fill.input.String(), "the network fee is not part of the swapped SOL")
+	assert.Equal(t, "990", fill.output.String())
+	assert.Equal(t, "10", fill.platformFee.String())
+	assert.Equal(t, receiptBonk, fill.platformFeeMint)
+
+	// 990 received against 1000 quoted
+	assert.InDelta(t, 100, slippageBps(trade.Amount, trade.OutputAmount, fill.input, fill.output), 1e-9)
+}
+
+func TestGetTradeReceipt(t *testing.T) {
+	ctx := context.Background()
+	store := dbmocks.NewMockStore(t)
+	trades := dbmocks.NewMockRepository[model.Trade](t)
+	incidents := dbmocks.NewMockRepository[model.MEVIncident](t)
+	store.EXPECT().Trades().Return(trades)
+	store.EXPECT().MEVIncidents().Return(incidents)
+	incidents.EXPECT().Get(mock.Anything, "sig").Return(nil, db.ErrNotFound)
+	svc := &Service{store: store, platformFeeAccountAddress: receiptFeeOwner}
+	svc.SetTransactionReader(&fakeTransactionReader{tx: swapTx()})
+
+	trades.EXPECT().Get(mock.Anything, "missing").Return(nil, db.ErrNotFound).Once()
+	_, err := svc.GetTradeReceipt(ctx, "missing")
+	assert.ErrorIs(t, err, apperrors.ErrTradeNotFound)
+
+	pending := &model.Trade{ID: 1, Type: "swap", Status: model.TradeStatusPending.String(), TransactionHash: "sig"}
+	trades.EXPECT().Get(mock.Anything, "1").Return(pending, nil).Once()
+	_, err = svc.GetTradeReceipt(ctx, "1")
+	assert.ErrorIs(t, err, ErrTradeNotComplete)
+
+	done := &model.Trade{
+		ID: 2, Type: "swap", Status: model.TradeStatusFinalized.String(), Finalized: true, TransactionHash: "sig",
+		UserID: receiptUser, FromCoinMintAddress: model.NativeSolMint, ToCoinMintAddress: receiptBonk, Amount: 1, OutputAmount: 1000,
+	}
+	trades.EXPECT().Get(mock.Anything, "2").Return(done, nil).Once()
+	trades.EXPECT().Update(mock.Anything, mock.MatchedBy(func(t *model.Trade) bool {
+		return !t.ReceiptAt.IsZero() && t.NetworkFeeLamports == 5000
+	})).Return(nil).Once()
+
+	receipt, err := svc.GetTradeReceipt(ctx, "2")
+	require.NoError(t, err)
+	assert.Equal(t, 1000.0, receipt.QuotedPrice)
+	assert.Equal(t, 990.0, receipt.ExecutedPrice)
+	assert.Equal(t, 10.0, receipt.PlatformFee)
+	assert.Equal(t, 0.000005, receipt.NetworkFeeSOL)
+	assert.InDelta(t, 100, receipt.SlippageBps, 1e-9)
+	assert.Nil(t, receipt.MEVIncident)
+
+	// Stored receipts are served without reading the transaction again
+	svc.SetTransactionReader(nil)
+	trades.EXPECT().Get(mock.Anything, "2").Return(done, nil).Once()
+	again, err := svc.GetTradeReceipt(ctx, "2")
+	require.NoError(t, err)
+	assert.Equal(t, receipt.SlippageBps, again.SlippageBps)
+}
@@ -0,0 +1,220 @@
+package trade
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/apperrors"
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+// Risk checks run on every prepared swap
+const (
+	RiskPositionSize = "position_size" // The swap is a large share of the wallet
+	RiskPriceImpact  = "price_impact"  // The swap moves the pool's price a lot
+	RiskNewCoin      = "new_coin"      // Buying a recently listed coin with a low safety score
+)
+
+const (
+	// DefaultRiskMaxPositionPercent is the share of a wallet's value one swap can spend unflagged
+	DefaultRiskMaxPositionPercent = 50
+	// DefaultRiskMaxPriceImpactPercent is the price impact a swap can have unflagged
+	DefaultRiskMaxPriceImpactPercent = 5
+	// DefaultRiskNewCoinAge is how long after listing a coin counts as new
+	DefaultRiskNewCoinAge = 24 * time.Hour
+	// DefaultRiskNewCoinMinSafetyScore is the safety score below which a new coin is flagged
+	DefaultRiskNewCoinMinSafetyScore = 50
+)
+
+// RiskConfig sets when a swap is flagged as risky. Flagged swaps are prepared
+// with a warning, or refused until the user acknowledges the check when it is
+// listed in Blocking. Zero thresholds use the defaults.
+type RiskConfig struct {
+	Enabled               bool
+	MaxPositionPercent    float64       // Swap value as a percentage of the wallet's
+	MaxPriceImpactPercent float64       // Quoted price impact, in percent
+	NewCoinAge            time.Duration // Coins listed more recently than this are new
+	NewCoinMinSafetyScore int           // New coins scoring below this are flagged
+	Blocking              []string      // Checks that refuse the swap until acknowledged
+}
+
+func (c RiskConfig) withDefaults() RiskConfig {
+	if c.MaxPositionPercent <= 0 {
+		c.MaxPositionPercent = DefaultRiskMaxPositionPercent
+	}
+	if c.MaxPriceImpactPercent <= 0 {
+		c.MaxPriceImpactPercent = DefaultRiskMaxPriceImpactPercent
+	}
+	if c.NewCoinAge <= 0 {
+		c.NewCoinAge = DefaultRiskNewCoinAge
+	}
+	if c.NewCoinMinSafetyScore <= 0 {
+		c.NewCoinMinSafetyScore = DefaultRiskNewCoinMinSafetyScore
+	}
+	return c
+}
+
+// WalletValueFunc returns the USD value of a wallet's holdings
+type WalletValueFunc func(ctx context.Context, wallet string) (float64, error)
+
+// SetRiskChecks configures the risk checks on prepared swaps. walletValue
+// values wallets for the position size check, which is skipped without it.
+func (s *Service) SetRiskChecks(config RiskConfig, walletValue WalletValueFunc) {
+	s.risk = config.withDefaults()
+	s.walletValue = walletValue
+}
+
+// RiskWarning is a risk check a swap failed
+type RiskWarning struct {
+	Check        string
+	Message      string // Safe to show users
+	Blocking     bool   // The swap is refused unless the check is acknowledged
+	Acknowledged bool
+}
+
+// assessRisk runs the risk checks on a swap of inputUSD worth of fromCoin
+// into toCoin quoted at priceImpact percent. Checks that can't be evaluated,
+// because a value or score is unavailable, are skipped.
+func (s *Service) assessRisk(ctx context.Context, params model.PrepareSwapRequestData, toCoin *model.Coin, inputUSD, priceImpact float64) []RiskWarning {
+	if !s.risk.Enabled {
+		return nil
+	}
+	var warnings []RiskWarning
+	if s.walletValue != nil && inputUSD > 0 {
+		walletUSD, err := s.walletValue(ctx, params.UserWalletAddress)
+		switch {
+		case err != nil:
+			slog.WarnContext(ctx, "Skipping position size check, wallet value unavailable", "wallet", params.UserWalletAddress, slog.Any("error", err))
+		case walletUSD > 0 && inputUSD/walletUSD*100 > s.risk.MaxPositionPercent:
+			warnings = append(warnings, RiskWarning{
+				Check:   RiskPositionSize,
+				Message: fmt.Sprintf("this swap is %.0f%% of your wallet's value", inputUSD/walletUSD*100),
+			})
+		}
+	}
+	if priceImpact > s.risk.MaxPriceImpactPercent {
+		warnings = append(warnings, RiskWarning{
+			Check:   RiskPriceImpact,
+			Message: fmt.Sprintf("this swap moves the price by %.2f%%, you'll receive noticeably less than the market rate", priceImpact),
+		})
+	}
+	if warning := s.checkNewCoin(ctx, params.ToCoinMintAddress, toCoin); warning != nil {
+		warnings = append(warnings, *warning)
+	}
+
+	for i := range warnings {
+		warnings[i].Blocking = slices.Contains(s.risk.Blocking, warnings[i].Check)
+		warnings[i].Acknowledged = slices.Contains(params.AcknowledgedRisks, warnings[i].Check)
+	}
+	return warnings
+}
+
+// checkNewCoin flags buying a coin listed within NewCoinAge whose mint
+// authorities score below NewCoinMinSafetyScore. A coin whose authorities
+// haven't been checked yet is flagged too: new coins are the ones most
+// likely to still hold them.
+func (s *Service) checkNewCoin(ctx context.Context, mint string, coin *model.Coin) *RiskWarning {
+	if isSOL(mint) || coin == nil || coin.JupiterListedAt == nil {
+		return nil
+	}
+	age := time.Since(*coin.JupiterListedAt)
+	if age >= s.risk.NewCoinAge {
+		return nil
+	}
+	score := 0
+	authorities, err := s.store.MintAuthorities().Get(ctx, mint)
+	switch {
+	case err == nil:
+		score = authorities.SafetyScore
+	case !errors.Is(err, db.ErrNotFound):
+		slog.WarnContext(ctx, "Skipping new coin check, mint authorities unavailable", "mint", mint, slog.Any("error", err))
+		return nil
+	}
+	if score >= s.risk.NewCoinMinSafetyScore {
+		return nil
+	}
+	return &RiskWarning{
+		Check:   RiskNewCoin,
+		Message: fmt.Sprintf("%s was listed %s ago and its creator can still mint or freeze it", coin.Symbol, formatAge(age)),
+	}
+}
+
+// requireRiskAcknowledged refuses a swap with a blocking warning the user
+// hasn't acknowledged, naming the checks to acknowledge
+func requireRiskAcknowledged(warnings []RiskWarning) error {
+	var checks, messages []string
+	for _, w := range warnings {
+		if w.Blocking && !w.Acknowledged {
+			checks = append(checks, w.Check)
+			messages = append(messages, w.Message)
+		}
+	}
+	if len(checks) == 0 {
+		return nil
+	}
+	return apperrors.ErrRiskNotAcknowledged.
+		With("checks", strings.Join(checks, ",")).
+		WithMessage(strings.Join(messages, "; "))
+}
+
+// recordRiskOverrides adds the acknowledged warnings of a prepared swap to the
+// audit log. The swap is prepared either way, so failures are only logged.
+func (s *Service) recordRiskOverrides(ctx context.Context, trade *model.Trade, warnings []RiskWarning) {
+	type override struct {
+		Check   string `json:"check"`
+		Message string `json:"message"`
+	}
+	var overrides []override
+	for _, w := range warnings {
+		if w.Acknowledged {
+			overrides = append(overrides, override{Check: w.Check, Message: w.Message})
+		}
+	}
+	if len(overrides) == 0 {
+		return
+	}
+	details, err := json.Marshal(map[string]any{
+		"trade_id":       strconv.FormatUint(uint64(trade.ID), 10),
+		"from_mint":      trade.FromCoinMintAddress,
+		"to_mint":        trade.ToCoinMintAddress,
+		"amount":         trade.RawAmount,
+		"total_usd_cost": trade.TotalUSDCost,
+		"overrides":      overrides,
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to encode risk overrides", "quote_id", trade.QuoteID, slog.Any("error", err))
+		return
+	}
+	entry := &model.AuditEntry{
+		ID:            uuid.NewString(),
+		WalletAddress: trade.FromAddress,
+		Action:        model.AuditRiskOverride,
+		Subject:       trade.QuoteID,
+		Details:       string(details),
+		CreatedAt:     time.Now(),
+	}
+	if err := s.store.AuditLog().Create(ctx, entry); err != nil {
+		slog.ErrorContext(ctx, "Failed to record risk overrides in audit log", "quote_id", trade.QuoteID, slog.Any("error", err))
+	}
+}
+
+// formatAge renders a coin's age in the largest whole unit, e.g. "3 hours"
+func formatAge(age time.Duration) string {
+	if age < time.Hour {
+		return fmt.Sprintf("%d minutes", int(age.Minutes()))
+	}
+	if hours := int(age.Hours()); hours != 1 {
+		return fmt.Sprintf("%d hours", hours)
+	}
+	return "1 hour"
+}
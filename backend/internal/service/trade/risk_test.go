@@ -0,0 +1,91 @@
+package trade
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/apperrors"
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	dbmocks "github.com/nicolas-martin/dankfolio/backend/internal/db/mocks"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+func riskService(t *testing.T, blocking ...string) (*Service, *dbmocks.MockStore) {
+	store := dbmocks.NewMockStore(t)
+	svc := &Service{store: store}
+	svc.SetRiskChecks(RiskConfig{Enabled: true, Blocking: blocking}, func(context.Context, string) (float64, error) {
+		return 1000, nil
+	})
+	return svc, store
+}
+
+func TestAssessRisk_FlagsEachCheck(t *testing.T) {
+	svc, store := riskService(t, RiskPriceImpact)
+	authorities := dbmocks.NewMockRepository[model.MintAuthority](t)
+	store.EXPECT().MintAuthorities().Return(authorities)
+	authorities.EXPECT().Get(mock.Anything, paperBonk).Return(nil, db.ErrNotFound)
+	listed := time.Now().Add(-3 * time.Hour)
+	params := model.PrepareSwapRequestData{UserWalletAddress: paperWallet, ToCoinMintAddress: paperBonk}
+
+	warnings := svc.assessRisk(context.Background(), params, &model.Coin{Symbol: "BONK", JupiterListedAt: &listed}, 600, 7.5)
+
+	require.Len(t, warnings, 3)
+	assert.Equal(t, RiskPositionSize, warnings[0].Check)
+	assert.Equal(t, "this swap is 60% of your wallet's value", warnings[0].Message)
+	assert.Equal(t, RiskPriceImpact, warnings[1].Check)
+	assert.True(t, warnings[1].Blocking)
+	assert.Equal(t, RiskNewCoin, warnings[2].Check)
+	assert.Contains(t, warnings[2].Message, "listed 3 hours ago")
+
+	err := requireRiskAcknowledged(warnings)
+	var appErr *apperrors.Error
+	require.True(t, errors.As(err, &appErr))
+	assert.Equal(t, apperrors.KindRiskNotAcknowledged, appErr.Kind)
+	assert.Equal(t, RiskPriceImpact, appErr.Metadata["checks"])
+}
+
+func TestAssessRisk_AcknowledgedAndSafeCoins(t *testing.T) {
+	svc, store := riskService(t, RiskPositionSize)
+	authorities := dbmocks.NewMockRepository[model.MintAuthority](t)
+	store.EXPECT().MintAuthorities().Return(authorities)
+	authorities.EXPECT().Get(mock.Anything, paperBonk).Return(&model.MintAuthority{SafetyScore: 90}, nil)
+	listed := time.Now().Add(-time.Hour)
+	params := model.PrepareSwapRequestData{
+		UserWalletAddress: paperWallet,
+		ToCoinMintAddress: paperBonk,
+		AcknowledgedRisks: []string{RiskPositionSize},
+	}
+
+	warnings := svc.assessRisk(context.Background(), params, &model.Coin{JupiterListedAt: &listed}, 900, 1)
+
+	require.Len(t, warnings, 1)
+	assert.True(t, warnings[0].Blocking)
+	assert.True(t, warnings[0].Acknowledged)
+	assert.NoError(t, requireRiskAcknowledged(warnings))
+}
+
+func TestRecordRiskOverrides(t *testing.T) {
+	store := dbmocks.NewMockStore(t)
+	audit := dbmocks.NewMockRepository[model.AuditEntry](t)
+	store.EXPECT().AuditLog().Return(audit)
+	audit.EXPECT().Create(mock.Anything, mock.Anything).
+		Run(func(_ context.Context, entry *model.AuditEntry) {
+			assert.Equal(t, model.AuditRiskOverride, entry.Action)
+			assert.Equal(t, paperWallet, entry.WalletAddress)
+			assert.Equal(t, "q1", entry.Subject)
+			assert.Contains(t, entry.Details, `"check":"price_impact"`)
+			assert.NotContains(t, entry.Details, RiskNewCoin)
+		}).Return(nil).Once()
+	svc := &Service{store: store}
+
+	svc.recordRiskOverrides(context.Background(), &model.Trade{FromAddress: paperWallet, QuoteID: "q1"}, []RiskWarning{
+		{Check: RiskPriceImpact, Message: "moves the price", Acknowledged: true},
+		{Check: RiskNewCoin, Message: "new coin"},
+	})
+}
@@ -11,22 +11,31 @@ import (
 	"math"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	solanago "github.com/gagliardetto/solana-go"
-	associatedtokenaccount "github.com/gagliardetto/solana-go/programs/associated-token-account"
 	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/google/uuid"
 
+	"github.com/nicolas-martin/dankfolio/backend/internal/apperrors"
+	"github.com/nicolas-martin/dankfolio/backend/internal/blocklist"
 	"github.com/nicolas-martin/dankfolio/backend/internal/clients"
 	"github.com/nicolas-martin/dankfolio/backend/internal/clients/jupiter"
 
 	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/events"
+	"github.com/nicolas-martin/dankfolio/backend/internal/featureflags"
 	"github.com/nicolas-martin/dankfolio/backend/internal/model"
 	bmodel "github.com/nicolas-martin/dankfolio/backend/internal/model/blockchain"
 	"github.com/nicolas-martin/dankfolio/backend/internal/service/coin"
 	"github.com/nicolas-martin/dankfolio/backend/internal/service/price"
+	"github.com/nicolas-martin/dankfolio/backend/internal/signer"
 	"github.com/nicolas-martin/dankfolio/backend/internal/telemetry/trademetrics"
 	"github.com/nicolas-martin/dankfolio/backend/internal/util"
+	"github.com/nicolas-martin/dankfolio/backend/internal/util/ata"
+	"github.com/nicolas-martin/dankfolio/backend/internal/util/money"
 )
 
 // Service handles trade-related operations
@@ -36,12 +45,30 @@ type Service struct {
 	priceService              price.PriceServiceAPI    // Use PriceServiceAPI interface from price package
 	jupiterClient             jupiter.ClientAPI
 	store                     db.Store
-	platformFeeBps            int                        // Platform fee in basis points
+	platformFeeBps            atomic.Int64               // Platform fee in basis points; adjustable at runtime
 	platformFeeAccountAddress string                     // Solana address for collecting platform fees
-	platformPrivateKey        *solanago.PrivateKey       // Platform private key for ATA creation
 	feeMintSelector           *FeeMintSelector           // Handles fee mint selection logic
+	ataManager                *ata.Manager               // Checks and creates associated token accounts
 	metrics                   *trademetrics.TradeMetrics // Trade-related metrics
-	showDetailedBreakdown     bool                       // Feature flag for detailed trade breakdown
+	showDetailedBreakdown     atomic.Bool                // Feature flag for detailed trade breakdown
+	eventBus                  events.Bus                 // Optional; receives TradeExecuted events
+	blocklist                 *blocklist.Blocklist       // Optional; rejects swaps involving known scam mints
+	prepareSwapDedup          *prepareSwapDedup          // Collapses double-tapped PrepareSwap calls
+	quoteTTL                  atomic.Int64               // How long a prepared swap can be submitted, as a time.Duration
+	commitment                string                     // Preflight commitment, and the status at which a trade is complete
+	computeBudget             *computeBudgeter           // Sizes and prices swap compute units by simulation
+	transactions              TransactionReader          // Optional; reads confirmed swaps for their receipts
+	blocks                    BlockReader                // Optional; reads swaps' blocks for sandwiches
+	paperMu                   sync.Mutex                 // Serializes paper fills and resets against each wallet's balances
+	paperStartingSOL          float64                    // Virtual SOL a new paper trading account is funded with
+	platformSigner            signer.Signer              // Optional; pays for platform ATAs and sponsored swaps
+	sponsorship               SponsorshipConfig          // When the platform pays a swap's network fees
+	sponsorMu                 sync.Mutex                 // Serializes sponsored submissions against the daily limits
+	twapWorker                string                     // Claims TWAP slices for this instance
+	risk                      RiskConfig                 // When prepared swaps are flagged as risky
+	walletValue               WalletValueFunc            // Optional; values wallets for the position size check
+	tradeLimits               TradeLimitsConfig          // Notional each wallet can swap per day and week
+	tradeLimitsMu             sync.Mutex                 // Serializes submissions against the trade limits
 }
 
 // NewService creates a new TradeService instance
@@ -53,23 +80,10 @@ func NewService(
 	store db.Store,
 	configuredPlatformFeeBps int, // Platform fee in basis points
 	configuredPlatformFeeAccountAddress string, // Platform fee account address
-	platformPrivateKeyBase64 string, // Platform private key for ATA creation
+	platformSigner signer.Signer, // Signs platform ATA creation; nil disables it
 	metrics *trademetrics.TradeMetrics,
 	showDetailedBreakdown bool, // Feature flag for detailed trade breakdown
 ) *Service {
-	// Parse platform private key
-	var platformKey *solanago.PrivateKey
-	if platformPrivateKeyBase64 != "" {
-		keyBytes, err := base64.StdEncoding.DecodeString(platformPrivateKeyBase64)
-		if err != nil {
-			slog.Error("Failed to decode platform private key", "error", err)
-		} else if len(keyBytes) != 64 {
-			slog.Error("Invalid platform private key length", "expected", 64, "got", len(keyBytes))
-		} else {
-			key := solanago.PrivateKey(keyBytes)
-			platformKey = &key
-		}
-	}
 
 	service := &Service{
 		chainClient:               chainClient,
@@ -77,25 +91,39 @@ func NewService(
 		priceService:              ps,
 		jupiterClient:             jc,
 		store:                     store,
-		platformFeeBps:            configuredPlatformFeeBps,
 		platformFeeAccountAddress: configuredPlatformFeeAccountAddress,
-		platformPrivateKey:        platformKey,
 		metrics:                   metrics,
-		showDetailedBreakdown:     showDetailedBreakdown,
-	}
+		prepareSwapDedup:          newPrepareSwapDedup(DefaultPrepareSwapDedupWindow),
+		commitment:                bmodel.DefaultCommitment,
+		computeBudget:             newComputeBudgeter(chainClient, ComputeBudgetConfig{}),
+		paperStartingSOL:          DefaultPaperStartingSOL,
+		platformSigner:            platformSigner,
+		sponsorship:               SponsorshipConfig{}.withDefaults(),
+		tradeLimits:               TradeLimitsConfig{}.withDefaults(),
+		twapWorker:                uuid.NewString(),
+	}
+	service.platformFeeBps.Store(int64(configuredPlatformFeeBps))
+	service.showDetailedBreakdown.Store(showDetailedBreakdown)
+	service.quoteTTL.Store(int64(DefaultQuoteTTL))
 
 	// Initialize fee mint selector with ATA checker and creator
-	ataChecker := func(ctx context.Context, ata solanago.PublicKey) bool {
-		return service.ataExists(ctx, ata)
+	service.ataManager = ata.NewManager(chainClient)
+	ataChecker := func(ctx context.Context, address solanago.PublicKey) bool {
+		return service.ataExists(ctx, address)
 	}
 
-	ataCreator := func(ctx context.Context, owner, mint solanago.PublicKey, signerKey *solanago.PrivateKey) error {
-		return service.createATA(ctx, owner, mint, signerKey)
+	ataCreator := func(ctx context.Context, owner, mint solanago.PublicKey, platformSigner signer.Signer) error {
+		account, err := ata.Derive(owner, mint)
+		if err != nil {
+			return err
+		}
+		_, err = service.ataManager.Ensure(ctx, platformSigner, []ata.Account{account})
+		return err
 	}
 
 	service.feeMintSelector = NewFeeMintSelector(
 		configuredPlatformFeeAccountAddress,
-		platformKey,
+		platformSigner,
 		ataChecker,
 		ataCreator,
 	)
@@ -103,6 +131,46 @@ func NewService(
 	return service
 }
 
+// SetCommitment sets the commitment used for preflight checks and ATA reads.
+// A trade is reported complete once its transaction reaches it.
+func (s *Service) SetCommitment(commitment string) {
+	s.commitment = commitment
+	s.ataManager.SetCommitment(commitment)
+	s.computeBudget.commitment = commitment
+}
+
+// SetComputeBudget configures how swap compute units are sized and priced
+func (s *Service) SetComputeBudget(config ComputeBudgetConfig) {
+	commitment := s.computeBudget.commitment
+	s.computeBudget = newComputeBudgeter(s.chainClient, config)
+	s.computeBudget.commitment = commitment
+}
+
+// SetPlatformFeeBps updates the platform fee applied to subsequent quotes and trades
+func (s *Service) SetPlatformFeeBps(bps int) {
+	s.platformFeeBps.Store(int64(bps))
+}
+
+// SetShowDetailedBreakdown toggles the detailed fee breakdown in trade quotes
+func (s *Service) SetShowDetailedBreakdown(enabled bool) {
+	s.showDetailedBreakdown.Store(enabled)
+}
+
+// detailedBreakdownEnabled reports whether the detailed breakdown is on globally or rolled out to this caller
+func (s *Service) detailedBreakdownEnabled(ctx context.Context) bool {
+	return s.showDetailedBreakdown.Load() || featureflags.Enabled(ctx, featureflags.DetailedTradeBreakdown)
+}
+
+// SetEventBus sets the bus used to publish TradeExecuted events
+func (s *Service) SetEventBus(bus events.Bus) {
+	s.eventBus = bus
+}
+
+// SetBlocklist makes PrepareSwap reject swaps into or out of blocklisted mints
+func (s *Service) SetBlocklist(list *blocklist.Blocklist) {
+	s.blocklist = list
+}
+
 // GetTrade retrieves a trade by its ID
 func (s *Service) GetTrade(ctx context.Context, id string) (*model.Trade, error) {
 	// TODO: If trade IDs have a known format (e.g., UUID), add validation.
@@ -137,16 +205,47 @@ func (s *Service) DeleteTrade(ctx context.Context, id string) error {
 	return s.store.Trades().Delete(ctx, id)
 }
 
-// PrepareSwap prepares an unsigned swap transaction and creates a trade record
+// PrepareSwap prepares an unsigned swap transaction and creates a trade record.
+// Identical requests from the same wallet within a few seconds share the first
+// one's result, marked Deduplicated, instead of preparing a second trade.
+// Paper swaps are quoted against the wallet's virtual balances instead.
 func (s *Service) PrepareSwap(ctx context.Context, params model.PrepareSwapRequestData) (*PrepareSwapResponse, error) {
+	if params.Paper {
+		return s.preparePaperSwap(ctx, params)
+	}
+	start := time.Now()
+	resp, err := s.prepareSwapDedup.do(ctx, prepareSwapDedupKey(params), func() (*PrepareSwapResponse, error) {
+		return s.prepareSwap(ctx, params)
+	})
+	switch {
+	case err != nil:
+		s.recordFunnelDropOff(ctx, trademetrics.FunnelStagePrepare, funnelLabels(params.FromCoinMintAddress, params.ToCoinMintAddress, nil), dropOffReason(err))
+	case resp.Deduplicated:
+		slog.InfoContext(ctx, "PrepareSwap deduplicated with a recent identical request",
+			"wallet", params.UserWalletAddress,
+			"from", params.FromCoinMintAddress,
+			"to", params.ToCoinMintAddress,
+			"amount", params.Amount)
+	default:
+		s.recordFunnelStage(ctx, trademetrics.FunnelStagePrepare, funnelLabels(params.FromCoinMintAddress, params.ToCoinMintAddress, resp.RoutePlan), time.Since(start))
+	}
+	return resp, err
+}
+
+func (s *Service) prepareSwap(ctx context.Context, params model.PrepareSwapRequestData) (*PrepareSwapResponse, error) {
 	if !util.IsValidSolanaAddress(params.UserWalletAddress) {
 		return nil, fmt.Errorf("invalid user_wallet_address: %s", params.UserWalletAddress)
 	}
 	if !util.IsValidSolanaAddress(params.FromCoinMintAddress) {
-		return nil, fmt.Errorf("invalid from_coin_mint_address: %s", params.FromCoinMintAddress)
+		return nil, apperrors.ErrInvalidAddress.WithMessage(fmt.Sprintf("invalid from_coin_mint_address: %s", params.FromCoinMintAddress))
 	}
 	if !util.IsValidSolanaAddress(params.ToCoinMintAddress) {
-		return nil, fmt.Errorf("invalid to_coin_mint_address: %s", params.ToCoinMintAddress)
+		return nil, apperrors.ErrInvalidAddress.WithMessage(fmt.Sprintf("invalid to_coin_mint_address: %s", params.ToCoinMintAddress))
+	}
+	if s.blocklist != nil {
+		if err := s.blocklist.Check(params.FromCoinMintAddress, params.ToCoinMintAddress); err != nil {
+			return nil, err
+		}
 	}
 
 	// Fetch coin models to get their PKIDs and decimals for conversion
@@ -175,6 +274,14 @@ func (s *Service) PrepareSwap(ctx context.Context, params model.PrepareSwapReque
 		"raw_amount", rawAmount,
 		"token_decimals", fromCoinModel.Decimals)
 
+	var sponsor *sponsorPlan
+	if params.Sponsored {
+		if sponsor, err = s.planSponsorship(ctx, params, fromCoinModel, amountInt); err != nil {
+			return nil, err
+		}
+		ctx = withSponsorFeeBps(ctx, sponsor.FeeBps)
+	}
+
 	slippageBpsInt, err := strconv.Atoi(params.SlippageBps) // Atoi implies base 10
 	if err != nil {
 		return nil, fmt.Errorf("invalid slippage_bps: %w", err)
@@ -191,12 +298,20 @@ func (s *Service) PrepareSwap(ctx context.Context, params model.PrepareSwapReque
 
 	// 1. Use the TradeService's GetSwapQuote with converted raw amount
 	// For PrepareSwap, we don't need fee breakdown since we'll calculate it from the actual swap transaction
-	tradeQuote, err := s.GetSwapQuote(ctx, params.FromCoinMintAddress, params.ToCoinMintAddress, rawAmount, params.SlippageBps, false, "", params.AllowMultiHop)
+	tradeQuote, err := s.getSwapQuote(ctx, params.FromCoinMintAddress, params.ToCoinMintAddress, rawAmount, params.SlippageBps, false, "", params.AllowMultiHop)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get trade quote: %w", err)
 	}
 	slog.Debug("tradeQuote.Raw after GetSwapQuote", "trade_quote", string(tradeQuote.Raw))
 
+	// Flag risky swaps, refusing those with blocking warnings the user hasn't acknowledged
+	quotedPriceImpact, _ := strconv.ParseFloat(tradeQuote.PriceImpact, 64)
+	inputUSD := money.Float(money.FromBaseUnits(amountInt, uint8(fromCoinModel.Decimals)).Mul(money.FromFloat(fromCoinModel.Price)))
+	riskWarnings := s.assessRisk(ctx, params, toCoinModel, inputUSD, quotedPriceImpact)
+	if err := requireRiskAcknowledged(riskWarnings); err != nil {
+		return nil, err
+	}
+
 	// Use FeeMintSelector to determine optimal fee collection setup
 	var feeAccount string
 	var actualFeeMint string // Track the actual mint used for fee collection
@@ -251,7 +366,7 @@ func (s *Service) PrepareSwap(ctx context.Context, params model.PrepareSwapReque
 				"platform_account", s.platformFeeAccountAddress,
 				"fee_ata", feeAccount,
 				"fee_mint", selectedFeeMint,
-				"fee_bps", s.platformFeeBps,
+				"fee_bps", s.platformFeeBps.Load(),
 				"swap_mode", swapMode)
 		} else {
 			slog.Info("Platform fee collection disabled - no suitable ATA found",
@@ -268,16 +383,29 @@ func (s *Service) PrepareSwap(ctx context.Context, params model.PrepareSwapReque
 			"output_mint", params.ToCoinMintAddress)
 	}
 
-	swapResponse, err := s.jupiterClient.CreateSwapTransaction(ctx, tradeQuote.Raw, fromPubKey, feeAccount)
+	var swapResponse *jupiter.SwapResponse
+	if sponsor != nil {
+		// Without a fee account the cost couldn't be recovered
+		if feeAccount == "" {
+			return nil, apperrors.ErrSponsorshipDenied.WithMessage("this swap can't be sponsored")
+		}
+		swapResponse, err = s.jupiterClient.CreateSponsoredSwapTransaction(ctx, tradeQuote.Raw, fromPubKey, feeAccount, s.platformSigner.PublicKey())
+	} else {
+		swapResponse, err = s.jupiterClient.CreateSwapTransaction(ctx, tradeQuote.Raw, fromPubKey, feeAccount)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to create swap transaction: %w", err)
 	}
+	// Jupiter's own estimate is kept if the swap cannot be simulated here
+	if err := s.computeBudget.apply(ctx, swapResponse); err != nil {
+		slog.Warn("Failed to budget swap compute units, using Jupiter's", "error", err)
+	}
 
 	// Calculate comprehensive SOL fee breakdown only if feature flag is enabled
 	var feeBreakdown *SolFeeBreakdown
 	var totalSolRequired, tradingFeeSol string
 
-	if s.showDetailedBreakdown {
+	if s.detailedBreakdownEnabled(ctx) {
 		feeBreakdown, totalSolRequired, tradingFeeSol, err = s.calculateSolFeeBreakdown(ctx, tradeQuote, swapResponse, params.UserWalletAddress, params.FromCoinMintAddress, params.ToCoinMintAddress)
 		if err != nil {
 			slog.Warn("Failed to calculate SOL fee breakdown", "error", err)
@@ -303,7 +431,8 @@ func (s *Service) PrepareSwap(ctx context.Context, params model.PrepareSwapReque
 		return nil, fmt.Errorf("failed to parse fee: %w", err)
 	}
 	// Calculate input amount in decimal form
-	inputAmountDecimal := float64(amountInt) / math.Pow(10, float64(fromCoinModel.Decimals))
+	inputAmount := money.FromBaseUnits(amountInt, uint8(fromCoinModel.Decimals))
+	inputAmountDecimal := money.Float(inputAmount)
 
 	// For swaps, we need to get the output amount from the quote
 	// The input amount is what we're spending, but we store what we're receiving
@@ -321,7 +450,7 @@ func (s *Service) PrepareSwap(ctx context.Context, params model.PrepareSwapReque
 	// Calculate USD values for the trade
 	// Cost basis should be what we spent (input value), not what we received
 	// This represents the actual cost of acquiring the tokens
-	totalUSDCost := inputAmountDecimal * fromCoinModel.Price
+	totalUSDCost := money.Float(inputAmount.Mul(money.FromFloat(fromCoinModel.Price)))
 
 	slog.Info("Trade amount tracking",
 		"from_token", fromCoinModel.Symbol,
@@ -335,6 +464,10 @@ func (s *Service) PrepareSwap(ctx context.Context, params model.PrepareSwapReque
 		"slippage_cost_usd", totalUSDCost-(outputAmount*toCoinModel.Price))
 
 	// Create trade record with essential information
+	now := time.Now()
+	if params.QuoteID == "" {
+		params.QuoteID = uuid.NewString()
+	}
 	trade := &model.Trade{
 		UserID:              fromPubKey.String(),
 		FromCoinMintAddress: params.FromCoinMintAddress,
@@ -349,13 +482,25 @@ func (s *Service) PrepareSwap(ctx context.Context, params model.PrepareSwapReque
 		ToUSDPrice:          toCoinModel.Price,   // USD price of TO token at trade time
 		TotalUSDCost:        totalUSDCost,        // Total USD cost of the trade
 		Fee:                 fee,
-		Status:              "prepared",
+		Status:              tradeStatusPrepared,
 		UnsignedTransaction: swapResponse.SwapTransaction,
-		CreatedAt:           time.Now(),
+		CreatedAt:           now,
 		Confirmations:       0,
 		Finalized:           false,
 		FromAddress:         params.UserWalletAddress,
 		ToAddress:           params.UserWalletAddress,
+		RawAmount:           rawAmount,
+		RawOutputAmount:     tradeQuote.EstimatedRawAmount, // Quoted; the settled amount may differ within slippage
+		QuoteID:             params.QuoteID,
+		QuoteExpiresAt:      now.Add(time.Duration(s.quoteTTL.Load())),
+		QuoteSlippageBps:    params.SlippageBps,
+		QuoteMultiHop:       params.AllowMultiHop,
+		QuoteRoute:          strings.Join(tradeQuote.RoutePlan, ">"),
+	}
+	if sponsor != nil {
+		trade.Sponsored = true
+		trade.SponsorFeeBps = sponsor.FeeBps
+		trade.SponsorCostLamports = sponsor.CostLamports
 	}
 
 	// Apply comprehensive fee breakdown if available
@@ -373,14 +518,14 @@ func (s *Service) PrepareSwap(ctx context.Context, params model.PrepareSwapReque
 					platformFeeMint = jupiterQuote.PlatformFee.FeeMint
 					if platformAmount, err := strconv.ParseUint(jupiterQuote.PlatformFee.Amount, 10, 64); err == nil {
 						// Get decimals for the fee mint token
-						decimals := 9.0 // Default to SOL decimals
+						decimals := uint8(money.SOLDecimals) // Default to SOL decimals
 						// NOTE: Shortcut
 						// if platformFeeMint != "" {
 						// 	if feeTokenModel, err := s.coinService.GetCoinByAddress(ctx, platformFeeMint); err == nil && feeTokenModel != nil {
-						// 		decimals = float64(feeTokenModel.Decimals)
+						// 		decimals = uint8(feeTokenModel.Decimals)
 						// 	}
 						// }
-						actualPlatformFee = float64(platformAmount) / math.Pow(10, decimals)
+						actualPlatformFee = money.Float(money.FromBaseUnits(platformAmount, decimals))
 					}
 				}
 
@@ -455,9 +600,9 @@ func (s *Service) PrepareSwap(ctx context.Context, params model.PrepareSwapReque
 	}
 
 	// Set platform fee fallback
-	if trade.PlatformFeeBps == 0 && s.platformFeeBps > 0 {
-		trade.PlatformFeeBps = s.platformFeeBps
-		slog.Debug("Using configured platform fee", "fee_bps", s.platformFeeBps)
+	if feeBps := int(s.platformFeeBps.Load()); trade.PlatformFeeBps == 0 && feeBps > 0 {
+		trade.PlatformFeeBps = feeBps
+		slog.Debug("Using configured platform fee", "fee_bps", feeBps)
 	}
 
 	// Log comprehensive fee breakdown
@@ -466,9 +611,16 @@ func (s *Service) PrepareSwap(ctx context.Context, params model.PrepareSwapReque
 		"trading_fee_sol", tradingFeeSol,
 		"fee_breakdown", feeBreakdown)
 
+	for _, w := range riskWarnings {
+		if w.Acknowledged {
+			trade.AcknowledgedRisks = append(trade.AcknowledgedRisks, w.Check)
+		}
+	}
+
 	if err := s.store.Trades().Create(ctx, trade); err != nil {
 		return nil, fmt.Errorf("failed to create trade record: %w", err)
 	}
+	s.recordRiskOverrides(ctx, trade, riskWarnings)
 
 	// Debug log the created trade to verify OutputAmount is set
 	slog.Info("Trade record created in PrepareSwap",
@@ -484,11 +636,26 @@ func (s *Service) PrepareSwap(ctx context.Context, params model.PrepareSwapReque
 		SolFeeBreakdown:     feeBreakdown,
 		TotalSolRequired:    totalSolRequired,
 		TradingFeeSol:       tradingFeeSol,
+		QuoteID:             trade.QuoteID,
+		ExpiresAt:           trade.QuoteExpiresAt,
+		SponsorFeeBps:       trade.SponsorFeeBps,
+		SponsorCostLamports: trade.SponsorCostLamports,
+		RiskWarnings:        riskWarnings,
+		RoutePlan:           tradeQuote.RoutePlan,
 	}, nil
 }
 
 // ExecuteTrade executes a trade based on the provided request
 func (s *Service) ExecuteTrade(ctx context.Context, req model.TradeRequest) (*model.Trade, error) {
+	var funnel swapFunnel
+	trade, err := s.executeTrade(ctx, req, &funnel)
+	if err != nil {
+		s.dropFunnel(ctx, &funnel, err)
+	}
+	return trade, err
+}
+
+func (s *Service) executeTrade(ctx context.Context, req model.TradeRequest, funnel *swapFunnel) (*model.Trade, error) {
 	// Check for debug header in context
 	if debugMode, ok := ctx.Value(model.DebugModeKey).(bool); ok && debugMode {
 		if !util.IsValidSolanaAddress(req.FromCoinMintAddress) {
@@ -544,17 +711,29 @@ func (s *Service) ExecuteTrade(ctx context.Context, req model.TradeRequest) (*mo
 		}
 
 		slog.Info("Simulated trade completed")
+		s.publishTradeExecuted(ctx, trade)
 		return trade, nil
 	}
 
-	// Find existing trade record by unsigned transaction
-	trade, err := s.store.Trades().GetByField(ctx, "unsigned_transaction", req.UnsignedTransaction)
+	// Find existing trade record by quote ID, or by unsigned transaction for older clients
+	lookupField, lookupValue := "unsigned_transaction", req.UnsignedTransaction
+	if req.QuoteID != "" {
+		lookupField, lookupValue = "quote_id", req.QuoteID
+	}
+	trade, err := s.store.Trades().GetByField(ctx, lookupField, lookupValue)
 	if err != nil {
 		return nil, fmt.Errorf("failed to find existing trade record: %w", err)
 	}
 	if trade == nil {
 		return nil, fmt.Errorf("no trade record found for the given transaction")
 	}
+	funnel.start(trade)
+	if req.QuoteID != "" && trade.UnsignedTransaction != req.UnsignedTransaction {
+		return nil, fmt.Errorf("unsigned_transaction does not match quote %s", req.QuoteID)
+	}
+	if err := s.checkQuoteFresh(ctx, trade); err != nil {
+		return nil, err
+	}
 
 	// Debug log to check if OutputAmount is preserved
 	slog.Info("Found existing trade in ExecuteTrade",
@@ -574,11 +753,28 @@ func (s *Service) ExecuteTrade(ctx context.Context, req model.TradeRequest) (*mo
 	if err != nil {
 		return nil, fmt.Errorf("failed to decode base64 signed transaction: %w", err)
 	}
+	s.reachFunnelStage(ctx, funnel, trademetrics.FunnelStageSign)
+
+	if s.tradeLimits.Enabled {
+		s.tradeLimitsMu.Lock()
+		defer s.tradeLimitsMu.Unlock()
+		if err := s.checkTradeLimits(ctx, trade.FromAddress, trade.TotalUSDCost); err != nil {
+			return nil, err
+		}
+	}
+
+	if trade.Sponsored {
+		s.sponsorMu.Lock()
+		defer s.sponsorMu.Unlock()
+		if rawTxBytes, err = s.cosignSponsoredSwap(ctx, trade, rawTxBytes); err != nil {
+			return nil, err
+		}
+	}
 
 	// Execute signed transaction on blockchain using SendRawTransaction
 	opts := bmodel.TransactionOptions{
-		SkipPreflight:       false,       // Default, or from config/req
-		PreflightCommitment: "confirmed", // Default, or from config/req
+		SkipPreflight:       false, // Default, or from config/req
+		PreflightCommitment: s.commitment,
 		// MaxRetries can be set if needed, e.g. 3
 	}
 	sig, err := s.chainClient.SendRawTransaction(ctx, rawTxBytes, opts)
@@ -586,11 +782,13 @@ func (s *Service) ExecuteTrade(ctx context.Context, req model.TradeRequest) (*mo
 		originalChainError := err // Store the original error
 		errStr := originalChainError.Error()
 
-		// Check if error is due to insufficient funds
-		insufficientFundsError := strings.Contains(strings.ToLower(errStr), "insufficient") ||
-			strings.Contains(strings.ToLower(errStr), "0x1") // Solana error code for insufficient funds
+		// Check if error is due to insufficient funds. Jupiter's slippage error 0x1771
+		// also starts with 0x1, so rule it out first.
+		insufficientFundsError := !isSlippageError(originalChainError) &&
+			(strings.Contains(strings.ToLower(errStr), "insufficient") ||
+				strings.Contains(strings.ToLower(errStr), "0x1")) // Solana error code for insufficient funds
 
-		if insufficientFundsError && !s.showDetailedBreakdown {
+		if insufficientFundsError && !s.detailedBreakdownEnabled(ctx) {
 			// Hard delete the trade record for insufficient funds errors since the transaction was never executed
 			if deleteErr := s.store.Trades().HardDelete(ctx, fmt.Sprintf("%d", trade.ID)); deleteErr != nil {
 				slog.Warn("Failed to hard delete trade record after insufficient funds error",
@@ -611,20 +809,29 @@ func (s *Service) ExecuteTrade(ctx context.Context, req model.TradeRequest) (*mo
 			}
 		}
 
-		// Check for insufficient funds error and provide user-friendly message
-		if isInsufficientFundsError(originalChainError) {
-			return nil, fmt.Errorf("insufficient SOL balance to complete this transaction. Please add more SOL to your wallet to cover network fees and try again")
+		switch {
+		case isSlippageError(originalChainError):
+			return nil, apperrors.ErrSlippageExceeded.Wrap(originalChainError)
+		case isInsufficientFundsError(originalChainError):
+			return nil, apperrors.ErrInsufficientBalance.
+				WithMessage("insufficient SOL balance to complete this transaction. Please add more SOL to your wallet to cover network fees and try again").
+				Wrap(originalChainError)
+		case isBlockhashExpiredError(originalChainError):
+			return nil, apperrors.ErrTransactionExpired.Wrap(originalChainError)
 		}
 
 		return nil, fmt.Errorf("failed to execute trade on blockchain: %w", originalChainError)
 	}
 
+	s.reachFunnelStage(ctx, funnel, trademetrics.FunnelStageSubmit)
+
 	// Update trade record with transaction hash and status "submitted"
 	trade.Status = "submitted"
 	trade.TransactionHash = string(sig) // sig is bmodel.Signature
 	trade.Error = ""                    // Clear any previous error as submission was successful
-	trade.CompletedAt = time.Time{}     // Not completed yet
-	trade.Finalized = false             // Not finalized yet
+	trade.SubmittedAt = funnel.at
+	trade.CompletedAt = time.Time{} // Not completed yet
+	trade.Finalized = false         // Not finalized yet
 
 	if errUpdate := s.store.Trades().Update(ctx, trade); errUpdate != nil {
 		// Log the warning but proceed to return the trade and nil error,
@@ -632,14 +839,46 @@ func (s *Service) ExecuteTrade(ctx context.Context, req model.TradeRequest) (*mo
 		slog.Warn("Failed to update trade status to 'submitted'", "error", errUpdate, "trade_id", trade.ID, "tx_hash", trade.TransactionHash)
 	}
 
+	if trade.Sponsored {
+		s.recordSponsorship(ctx, trade)
+	}
+	if s.tradeLimits.Enabled {
+		s.recordTradeVolume(ctx, trade)
+	}
+
 	// Log blockchain explorer URL
 	slog.Info("Trade submitted", "tx_hash", trade.TransactionHash, "solscan_url", fmt.Sprintf("https://solscan.io/tx/%s", trade.TransactionHash))
 
+	s.publishTradeExecuted(ctx, trade)
 	return trade, nil
 }
 
+func (s *Service) publishTradeExecuted(ctx context.Context, trade *model.Trade) {
+	s.publishTradeEvent(ctx, events.TradeExecuted, trade)
+}
+
+func (s *Service) publishTradeEvent(ctx context.Context, eventType events.Type, trade *model.Trade) {
+	if s.eventBus == nil {
+		return
+	}
+	s.eventBus.Publish(ctx, events.NewTradeEvent(eventType, *trade))
+}
+
 // GetSwapQuote gets a quote for a potential trade
 func (s *Service) GetSwapQuote(ctx context.Context, fromCoinMintAddress, toCoinMintAddress string, inputAmount string, slippageBsp string, includeFeeBreakdown bool, userPublicKey string, allowMultiHop bool) (*TradeQuote, error) {
+	start := time.Now()
+	quote, err := s.getSwapQuote(ctx, fromCoinMintAddress, toCoinMintAddress, inputAmount, slippageBsp, includeFeeBreakdown, userPublicKey, allowMultiHop)
+	if err != nil {
+		s.recordFunnelDropOff(ctx, trademetrics.FunnelStageQuote, funnelLabels(fromCoinMintAddress, toCoinMintAddress, nil), dropOffReason(err))
+		return nil, err
+	}
+	s.recordFunnelStage(ctx, trademetrics.FunnelStageQuote, funnelLabels(fromCoinMintAddress, toCoinMintAddress, quote.RoutePlan), time.Since(start))
+	return quote, nil
+}
+
+// getSwapQuote quotes a swap without counting it in the trade funnel, for
+// quotes made to prepare one
+func (s *Service) getSwapQuote(ctx context.Context, fromCoinMintAddress, toCoinMintAddress string, inputAmount string, slippageBsp string, includeFeeBreakdown bool, userPublicKey string, allowMultiHop bool) (*TradeQuote, error) {
 	if !util.IsValidSolanaAddress(fromCoinMintAddress) {
 		return nil, fmt.Errorf("invalid from_coin_mint_address: %s", fromCoinMintAddress)
 	}
@@ -718,7 +957,7 @@ func (s *Service) GetSwapQuote(ctx context.Context, fromCoinMintAddress, toCoinM
 
 	// Get quote from Jupiter with enhanced parameters
 	// Determine if platform fees should be disabled for Token2022 tokens
-	platformFeeBps := s.platformFeeBps
+	platformFeeBps := int(s.platformFeeBps.Load()) + sponsorFeeBps(ctx)
 	if shouldDisablePlatformFees(fromCoinMintAddress, toCoinMintAddress) {
 		platformFeeBps = 0
 		slog.Info("Disabling platform fees for Token2022 swap in quote",
@@ -741,6 +980,12 @@ func (s *Service) GetSwapQuote(ctx context.Context, fromCoinMintAddress, toCoinM
 		return nil, fmt.Errorf("failed to get Jupiter quote: %w", err)
 	}
 
+	// A BirdEye price that disagrees with Jupiter's flags the quote rather than failing it
+	priceDivergences, err := s.priceService.CrossCheckPrices(ctx, []string{fromCoinMintAddress, toCoinMintAddress})
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to cross-check quote prices", slog.Any("error", err))
+	}
+
 	// Collect all unique feeMint addresses
 	feeMints := make(map[string]bool)
 	for _, route := range quote.RoutePlan {
@@ -759,12 +1004,12 @@ func (s *Service) GetSwapQuote(ctx context.Context, fromCoinMintAddress, toCoinM
 		return nil, fmt.Errorf("failed to get token prices: %w", err)
 	}
 
-	var totalFeeInUSD float64
+	totalFeeInUSD := money.Zero
 	var routeSummary []string
 	for _, route := range quote.RoutePlan {
 		routeSummary = append(routeSummary, route.SwapInfo.Label)
 
-		feeAmount, err := strconv.ParseFloat(route.SwapInfo.FeeAmount, 64)
+		feeAmount, err := money.Parse(route.SwapInfo.FeeAmount)
 		if err != nil {
 			slog.Warn("Couldn't parse route fee", "error", err)
 			continue
@@ -778,35 +1023,33 @@ func (s *Service) GetSwapQuote(ctx context.Context, fromCoinMintAddress, toCoinM
 		}
 
 		// Convert fee to USD
-		feeInUSD := feeAmount * price
-		totalFeeInUSD += feeInUSD
+		totalFeeInUSD = totalFeeInUSD.Add(feeAmount.Mul(money.FromFloat(price)))
 	}
 
 	// Add platform fee if present
 	if quote.PlatformFee != nil {
-		platformFeeAmount, err := strconv.ParseFloat(quote.PlatformFee.Amount, 64)
+		platformFeeAmount, err := money.Parse(quote.PlatformFee.Amount)
 		if err != nil {
 			slog.Warn("Couldn't parse platform fee", "error", err)
 		} else {
 			if price, exists := prices[quote.PlatformFee.FeeMint]; exists {
-				platformFeeInUSD := platformFeeAmount * price
-				totalFeeInUSD += platformFeeInUSD
+				totalFeeInUSD = totalFeeInUSD.Add(platformFeeAmount.Mul(money.FromFloat(price)))
 			}
 		}
 	}
 
-	// Convert outAmount to float64
-	outAmount, err := strconv.ParseFloat(quote.OutAmount, 64)
+	// Parse outAmount, in the output token's base units
+	outAmount, err := money.Parse(quote.OutAmount)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse out amount: %w", err)
 	}
 
-	estimatedAmountInCoin := outAmount / math.Pow10(int(toCoin.Decimals))
-	totalFeeInUSDCoin := totalFeeInUSD / math.Pow10(9)
+	estimatedAmountInCoin := outAmount.Shift(-int32(toCoin.Decimals))
+	totalFeeInUSDCoin := totalFeeInUSD.Shift(-money.SOLDecimals)
 
 	// Calculate exchange rate
-	initialAmount := float64(amountInt)
-	exchangeRate := outAmount / initialAmount
+	initialAmount := money.FromBaseUnits(amountInt, 0)
+	exchangeRate := money.Ratio(outAmount, initialAmount)
 
 	truncatedPriceImpact := truncateDecimals(quote.PriceImpactPct, 6)
 
@@ -814,7 +1057,7 @@ func (s *Service) GetSwapQuote(ctx context.Context, fromCoinMintAddress, toCoinM
 	var feeBreakdown *SolFeeBreakdown
 	var totalSolRequired, tradingFeeSol string
 
-	if includeFeeBreakdown && s.showDetailedBreakdown {
+	if includeFeeBreakdown && s.detailedBreakdownEnabled(ctx) {
 		if userPublicKey == "" {
 			return nil, fmt.Errorf("user_public_key is required when includeFeeBreakdown=true")
 		}
@@ -857,11 +1100,11 @@ func (s *Service) GetSwapQuote(ctx context.Context, fromCoinMintAddress, toCoinM
 					// For SOL, we still need to use the wrapped SOL ATA
 					feeMintPubKey, err := solanago.PublicKeyFromBase58(feeMint)
 					if err == nil {
-						feeAccountATA, _, err := solanago.FindAssociatedTokenAddress(platformFeePubKey, feeMintPubKey)
+						feeAccountATA, err := ata.Derive(platformFeePubKey, feeMintPubKey)
 						if err == nil {
 							// Check if ATA exists before using it
-							if s.ataExists(ctx, feeAccountATA) {
-								feeAccount = feeAccountATA.String()
+							if s.ataExists(ctx, feeAccountATA.Address) {
+								feeAccount = feeAccountATA.Address.String()
 							}
 						}
 					}
@@ -869,11 +1112,11 @@ func (s *Service) GetSwapQuote(ctx context.Context, fromCoinMintAddress, toCoinM
 					// For SPL tokens, calculate the ATA
 					feeMintPubKey, err := solanago.PublicKeyFromBase58(feeMint)
 					if err == nil {
-						feeAccountATA, _, err := solanago.FindAssociatedTokenAddress(platformFeePubKey, feeMintPubKey)
+						feeAccountATA, err := ata.Derive(platformFeePubKey, feeMintPubKey)
 						if err == nil {
 							// Check if ATA exists before using it
-							if s.ataExists(ctx, feeAccountATA) {
-								feeAccount = feeAccountATA.String()
+							if s.ataExists(ctx, feeAccountATA.Address) {
+								feeAccount = feeAccountATA.Address.String()
 							}
 						}
 					}
@@ -929,8 +1172,8 @@ func (s *Service) GetSwapQuote(ctx context.Context, fromCoinMintAddress, toCoinM
 
 		// Convert to SOL (divide by 10^9)
 		totalFeeLamports := routeFee + platformFee
-		totalSolRequired = fmt.Sprintf("%.9f", float64(totalFeeLamports)/1e9)
-		tradingFeeSol = fmt.Sprintf("%.9f", float64(routeFee+platformFee)/1e9)
+		totalSolRequired = money.FormatLamports(totalFeeLamports)
+		tradingFeeSol = money.FormatLamports(routeFee + platformFee)
 	}
 
 	// Log detailed quote information
@@ -944,13 +1187,12 @@ func (s *Service) GetSwapQuote(ctx context.Context, fromCoinMintAddress, toCoinM
 		"trading_fee_sol", tradingFeeSol)
 
 	// Use the actual decimals from the tokens for formatting
-	estimatedAmountFormat := fmt.Sprintf("%%.%df", toCoin.Decimals)
-	exchangeRateFormat := fmt.Sprintf("%%.%df", int(math.Max(float64(fromCoin.Decimals), float64(toCoin.Decimals))))
+	exchangeRatePlaces := int32(max(fromCoin.Decimals, toCoin.Decimals))
 
 	return &TradeQuote{
-		EstimatedAmount:  fmt.Sprintf(estimatedAmountFormat, estimatedAmountInCoin),
-		ExchangeRate:     fmt.Sprintf(exchangeRateFormat, exchangeRate),
-		Fee:              fmt.Sprintf("%.9f", totalFeeInUSDCoin), // Keep SOL fee at 9 decimals
+		EstimatedAmount:  estimatedAmountInCoin.StringFixed(int32(toCoin.Decimals)),
+		ExchangeRate:     exchangeRate.StringFixed(exchangeRatePlaces),
+		Fee:              totalFeeInUSDCoin.StringFixed(money.SOLDecimals), // Keep SOL fee at 9 decimals
 		PriceImpact:      truncatedPriceImpact,
 		RoutePlan:        routeSummary,
 		InputMint:        quote.InputMint,
@@ -959,13 +1201,17 @@ func (s *Service) GetSwapQuote(ctx context.Context, fromCoinMintAddress, toCoinM
 		SolFeeBreakdown:  feeBreakdown,     // Now calculated from quote
 		TotalSolRequired: totalSolRequired, // Now calculated from quote
 		TradingFeeSol:    tradingFeeSol,    // Now calculated from quote
+
+		InputRawAmount:     inputAmount,
+		EstimatedRawAmount: quote.OutAmount,
+		PriceDivergences:   priceDivergences,
 	}, nil
 }
 
 // Helper functions for string manipulation
 // isInsufficientFundsError checks if the error is due to insufficient lamports/SOL
 func isInsufficientFundsError(err error) bool {
-	if err == nil {
+	if err == nil || isSlippageError(err) {
 		return false
 	}
 
@@ -990,6 +1236,26 @@ func isInsufficientFundsError(err error) bool {
 	return false
 }
 
+// isSlippageError checks if the swap failed because the price moved past the
+// slippage tolerance (Jupiter's SlippageToleranceExceeded, error 6001)
+func isSlippageError(err error) bool {
+	if err == nil {
+		return false
+	}
+	errorString := err.Error()
+	return strings.Contains(errorString, "custom program error: 0x1771") ||
+		strings.Contains(errorString, "SlippageToleranceExceeded")
+}
+
+// isBlockhashExpiredError checks if the transaction's blockhash expired before it was sent
+func isBlockhashExpiredError(err error) bool {
+	if err == nil {
+		return false
+	}
+	errorString := err.Error()
+	return strings.Contains(errorString, "Blockhash not found") || strings.Contains(errorString, "BlockhashNotFound")
+}
+
 func truncateDecimals(input string, digits int) string {
 	i := strings.IndexByte(input, '.')
 	if i == -1 || len(input) < i+digits+1 {
@@ -1058,6 +1324,8 @@ func (s *Service) GetTradeByTransactionHash(ctx context.Context, txHash string)
 
 		// Update trade based on detailed blockchain status
 		statusChanged := false
+		justFinalized := false
+		justFailed := false
 		now := time.Now()
 
 		// Update confirmations if available (do this for ALL statuses)
@@ -1088,6 +1356,7 @@ func (s *Service) GetTradeByTransactionHash(ctx context.Context, txHash string)
 				trade.CompletedAt = now
 				trade.Finalized = true
 				statusChanged = true
+				justFailed = true
 			}
 
 		case "Finalized":
@@ -1098,6 +1367,7 @@ func (s *Service) GetTradeByTransactionHash(ctx context.Context, txHash string)
 				trade.Finalized = true
 				trade.Error = "" // Clear any previous error
 				statusChanged = true
+				justFinalized = true
 			}
 
 		case "Confirmed":
@@ -1136,12 +1406,39 @@ func (s *Service) GetTradeByTransactionHash(ctx context.Context, txHash string)
 			slog.Info("Transaction has unexpected status", "trade_id", trade.ID, "status", chainStatus.Status, "confirmations", trade.Confirmations)
 		}
 
+		// The trade is complete once it reaches the service commitment, which
+		// at the default of confirmed is 10-20s before finalized
+		if !trade.Finalized && bmodel.ParseBlockchainTransactionStatus(chainStatus.Status).Reached(s.commitment) {
+			slog.Info("Transaction reached completion commitment", "trade_id", trade.ID, "status", chainStatus.Status, "commitment", s.commitment)
+			if trade.CompletedAt.IsZero() {
+				trade.CompletedAt = now
+			}
+			trade.Finalized = true
+			trade.Error = ""
+			statusChanged = true
+			justFinalized = true
+		}
+
+		// Record how the swap actually filled while the transaction is fresh;
+		// GetTradeReceipt retries if the node doesn't serve it yet
+		if justFinalized && trade.Type == "swap" && s.transactions != nil {
+			if err := s.recordReceipt(ctx, trade); err != nil {
+				slog.Warn("Failed to record trade receipt", "trade_id", trade.ID, "error", err)
+			}
+		}
+
 		// Update database if any changes were made
 		if statusChanged {
 			if errUpdate := s.store.Trades().Update(ctx, trade); errUpdate != nil {
 				slog.Warn("Failed to update trade", "trade_id", trade.ID, "error", errUpdate)
 			} else {
 				slog.Info("Successfully updated trade", "trade_id", trade.ID, "status", trade.Status, "confirmations", trade.Confirmations, "finalized", trade.Finalized)
+				if justFinalized {
+					s.publishTradeEvent(ctx, events.TradeConfirmed, trade)
+				}
+				if trade.Type == "swap" {
+					s.recordConfirmFunnel(ctx, trade, justFinalized, justFailed)
+				}
 			}
 		}
 	}
@@ -1169,56 +1466,37 @@ func (s *Service) checkRequiredATAs(ctx context.Context, userPublicKey, inputMin
 		return 0, fmt.Errorf("invalid user public key: %w", err)
 	}
 
-	atasToCreate := 0
-
-	// Check input mint ATA (skip ONLY if it's native SOL)
-	if inputMint != model.NativeSolMint {
-		// wSOL and all other tokens need ATAs
-		inputMintPubkey, err := solanago.PublicKeyFromBase58(inputMint)
-		if err != nil {
-			return 0, fmt.Errorf("invalid input mint: %w", err)
+	// Native SOL needs no token account; wSOL and every other mint do
+	var accounts []ata.Account
+	for _, mint := range []string{inputMint, outputMint} {
+		if mint == model.NativeSolMint {
+			slog.Debug("Native SOL, no ATA needed", "mint", mint)
+			continue
 		}
-
-		inputATA, _, err := solanago.FindAssociatedTokenAddress(userPubkey, inputMintPubkey)
+		mintPubkey, err := solanago.PublicKeyFromBase58(mint)
 		if err != nil {
-			return 0, fmt.Errorf("failed to derive input ATA address: %w", err)
-		}
-
-		// Check if ATA exists
-		if !s.ataExists(ctx, inputATA) {
-			atasToCreate++
-			slog.Debug("Input ATA needs creation",
-				"ata", inputATA.String(),
-				"mint", inputMint,
-				"is_wsol", inputMint == model.SolMint)
+			return 0, fmt.Errorf("invalid mint %s: %w", mint, err)
 		}
-	} else {
-		slog.Debug("Input is native SOL, no ATA needed", "mint", inputMint)
-	}
-
-	// Check output mint ATA (skip ONLY if it's native SOL)
-	if outputMint != model.NativeSolMint {
-		// wSOL and all other tokens need ATAs
-		outputMintPubkey, err := solanago.PublicKeyFromBase58(outputMint)
+		account, err := ata.Derive(userPubkey, mintPubkey)
 		if err != nil {
-			return 0, fmt.Errorf("invalid output mint: %w", err)
-		}
-
-		outputATA, _, err := solanago.FindAssociatedTokenAddress(userPubkey, outputMintPubkey)
-		if err != nil {
-			return 0, fmt.Errorf("failed to derive output ATA address: %w", err)
+			return 0, err
 		}
+		accounts = append(accounts, account)
+	}
 
-		// Check if ATA exists
-		if !s.ataExists(ctx, outputATA) {
-			atasToCreate++
-			slog.Debug("Output ATA needs creation",
-				"ata", outputATA.String(),
-				"mint", outputMint,
-				"is_wsol", outputMint == model.SolMint)
-		}
-	} else {
-		slog.Debug("Output is native SOL, no ATA needed", "mint", outputMint)
+	// Both accounts are checked in one batch
+	missing, err := s.ataManager.Missing(ctx, accounts)
+	if err != nil {
+		// Assume the worst so the fee estimate covers the rent
+		slog.Warn("Failed to check swap ATAs, assuming they all need creation", "user", userPublicKey, "error", err)
+		missing = accounts
+	}
+	atasToCreate := len(missing)
+	for _, account := range missing {
+		slog.Debug("ATA needs creation",
+			"ata", account.Address.String(),
+			"mint", account.Mint.String(),
+			"is_wsol", account.Mint.String() == model.SolMint)
 	}
 
 	slog.Info("Dynamic ATA calculation",
@@ -1234,102 +1512,14 @@ func (s *Service) checkRequiredATAs(ctx context.Context, userPublicKey, inputMin
 	return atasToCreate, nil
 }
 
-// ataExists checks if an Associated Token Account exists on-chain
-func (s *Service) ataExists(ctx context.Context, ataAddress solanago.PublicKey) bool {
-	accountInfo, err := s.chainClient.GetAccountInfo(ctx, bmodel.Address(ataAddress.String()))
+// ataExists reports whether an associated token account has been created.
+// Lookup failures count as missing.
+func (s *Service) ataExists(ctx context.Context, address solanago.PublicKey) bool {
+	exists, err := s.ataManager.Exists(ctx, address)
 	if err != nil {
-		slog.Debug("Failed to get ATA account info", "ata", ataAddress.String(), "error", err)
-		return false
+		slog.Debug("Failed to check ATA", "ata", address.String(), "error", err)
 	}
-
-	// Account exists if it's not nil and not owned by the system program (uninitialized)
-	systemProgram := bmodel.Address(solanago.SystemProgramID.String())
-	return accountInfo != nil && accountInfo.Owner != systemProgram
-}
-
-// createATA creates an Associated Token Account for the given owner and mint
-func (s *Service) createATA(ctx context.Context, owner, mint solanago.PublicKey, signerKey *solanago.PrivateKey) error {
-	if signerKey == nil {
-		return fmt.Errorf("signer key is required for ATA creation")
-	}
-
-	// Safety check: Convert native SOL to wSOL for ATA creation
-	if mint.String() == "11111111111111111111111111111111" {
-		// Native SOL doesn't have ATAs, use wSOL instead
-		wsolMint, _ := solanago.PublicKeyFromBase58(model.SolMint)
-		mint = wsolMint
-		slog.Debug("Converting native SOL to wSOL for ATA creation", "original", "11111111111111111111111111111111", "converted", mint.String())
-	}
-
-	// Calculate ATA address
-	ata, _, err := solanago.FindAssociatedTokenAddress(owner, mint)
-	if err != nil {
-		return fmt.Errorf("failed to calculate ATA: %w", err)
-	}
-
-	// Check if ATA already exists
-	if s.ataExists(ctx, ata) {
-		slog.Debug("ATA already exists, skipping creation", "ata", ata.String(), "mint", mint.String())
-		return nil
-	}
-
-	slog.Info("Creating ATA", "ata", ata.String(), "owner", owner.String(), "mint", mint.String())
-
-	// Create ATA instruction
-	instruction := associatedtokenaccount.NewCreateInstruction(
-		owner, // payer (platform account pays for creation)
-		owner, // wallet (owner of the ATA)
-		mint,  // mint
-	).Build()
-
-	// Get recent blockhash
-	recentBlockhash, err := s.chainClient.GetLatestBlockhash(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to get blockhash: %w", err)
-	}
-
-	blockhash, err := solanago.HashFromBase58(string(recentBlockhash))
-	if err != nil {
-		return fmt.Errorf("failed to parse blockhash: %w", err)
-	}
-
-	// Create transaction
-	tx, err := solanago.NewTransaction(
-		[]solanago.Instruction{instruction},
-		blockhash,
-		solanago.TransactionPayer(owner),
-	)
-	if err != nil {
-		return fmt.Errorf("failed to create transaction: %w", err)
-	}
-
-	// Sign transaction
-	_, err = tx.Sign(func(key solanago.PublicKey) *solanago.PrivateKey {
-		if key == owner {
-			return signerKey
-		}
-		return nil
-	})
-	if err != nil {
-		return fmt.Errorf("failed to sign transaction: %w", err)
-	}
-
-	// Send transaction
-	txBytes, err := tx.MarshalBinary()
-	if err != nil {
-		return fmt.Errorf("failed to marshal transaction: %w", err)
-	}
-
-	sig, err := s.chainClient.SendRawTransaction(ctx, txBytes, bmodel.TransactionOptions{
-		SkipPreflight:       false,
-		PreflightCommitment: "confirmed",
-	})
-	if err != nil {
-		return fmt.Errorf("failed to send transaction: %w", err)
-	}
-
-	slog.Info("ATA creation transaction sent", "signature", sig, "ata", ata.String())
-	return nil
+	return exists
 }
 
 // calculateSolFeeBreakdown sums all SOL lamport needs and returns formatted breakdown
@@ -1406,9 +1596,7 @@ func (s *Service) calculateSolFeeBreakdown(
 	totalLam := routeFee + platformFee + baseFee + prioFee + netRent
 
 	// 6. Format lamports to SOL strings
-	fmtSol := func(v uint64) string {
-		return TruncateAndFormatFloat(float64(v)/1e9, 9)
-	}
+	fmtSol := money.FormatLamports
 
 	bd := &SolFeeBreakdown{
 		TradingFee:         fmtSol(routeFee),
@@ -1492,9 +1680,7 @@ func (s *Service) calculateSolFeeBreakdownFromQuote(
 	totalLam := routeFee + platformFee + baseFee + prioFee + netRent
 
 	// 6. Format lamports to SOL strings
-	fmtSol := func(v uint64) string {
-		return TruncateAndFormatFloat(float64(v)/1e9, 9)
-	}
+	fmtSol := money.FormatLamports
 
 	bd := &SolFeeBreakdown{
 		TradingFee:         fmtSol(routeFee + platformFee), // Combine route and platform fees
@@ -0,0 +1,260 @@
+package trade
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"time"
+
+	solanago "github.com/gagliardetto/solana-go"
+	"github.com/google/uuid"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/apperrors"
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	"github.com/nicolas-martin/dankfolio/backend/internal/signer"
+	"github.com/nicolas-martin/dankfolio/backend/internal/util/money"
+)
+
+const (
+	// DefaultSponsorMaxSwapsPerDay is how many swaps a wallet can have sponsored in a day
+	DefaultSponsorMaxSwapsPerDay = 3
+	// DefaultSponsorMaxLamportsPerDay caps what the platform spends on one wallet's swaps in a day
+	DefaultSponsorMaxLamportsPerDay = 20_000_000
+	// DefaultSponsorMaxFeeBps is the most a sponsorship can add to a swap's
+	// platform fee; swaps too small to recover their cost below it are refused
+	DefaultSponsorMaxFeeBps = 300
+
+	// sponsorWindow is the period the per-wallet limits apply to
+	sponsorWindow = 24 * time.Hour
+	// sponsoredSignatures is the user's and the fee payer's
+	sponsoredSignatures = 2
+)
+
+// SponsorshipConfig sets when the platform pays a swap's network fees and
+// rent for wallets without the SOL to. Zero limits use the defaults.
+type SponsorshipConfig struct {
+	Enabled           bool
+	MaxSwapsPerDay    int    // Sponsored swaps per wallet in 24 hours
+	MaxLamportsPerDay uint64 // Platform SOL spent per wallet in 24 hours
+	MaxFeeBps         int    // Cap on the fee added to recover the cost
+}
+
+func (c SponsorshipConfig) withDefaults() SponsorshipConfig {
+	if c.MaxSwapsPerDay <= 0 {
+		c.MaxSwapsPerDay = DefaultSponsorMaxSwapsPerDay
+	}
+	if c.MaxLamportsPerDay == 0 {
+		c.MaxLamportsPerDay = DefaultSponsorMaxLamportsPerDay
+	}
+	if c.MaxFeeBps <= 0 {
+		c.MaxFeeBps = DefaultSponsorMaxFeeBps
+	}
+	return c
+}
+
+// SetSponsorship configures sponsored swaps. They also need the platform
+// signer, which pays for them, and a platform fee account to recover the cost.
+func (s *Service) SetSponsorship(config SponsorshipConfig) {
+	s.sponsorship = config.withDefaults()
+}
+
+// sponsorPlan is what sponsoring one swap costs and the fee that recovers it
+type sponsorPlan struct {
+	CostLamports uint64 // Most the platform pays, with the priority fee at its cap
+	FeeBps       int    // Added to the platform fee
+}
+
+type sponsorFeeKey struct{}
+
+// withSponsorFeeBps makes swap quotes under ctx charge feeBps on top of the platform fee
+func withSponsorFeeBps(ctx context.Context, feeBps int) context.Context {
+	return context.WithValue(ctx, sponsorFeeKey{}, feeBps)
+}
+
+func sponsorFeeBps(ctx context.Context) int {
+	feeBps, _ := ctx.Value(sponsorFeeKey{}).(int)
+	return feeBps
+}
+
+// planSponsorship decides whether to pay for a swap of rawAmount of fromCoin
+// and prices it. Only wallets that can't pay their own fees are sponsored, and
+// only while the platform fee can be collected to recover the cost.
+func (s *Service) planSponsorship(ctx context.Context, params model.PrepareSwapRequestData, fromCoin *model.Coin, rawAmount uint64) (*sponsorPlan, error) {
+	switch {
+	case !s.sponsorship.Enabled || s.platformSigner == nil || s.platformFeeAccountAddress == "":
+		return nil, apperrors.ErrSponsorshipDenied.WithMessage("sponsored swaps are not available")
+	case isSOL(params.FromCoinMintAddress):
+		return nil, apperrors.ErrSponsorshipDenied.WithMessage("swaps from SOL pay their own network fees")
+	case shouldDisablePlatformFees(params.FromCoinMintAddress, params.ToCoinMintAddress):
+		return nil, apperrors.ErrSponsorshipDenied.WithMessage("swaps of Token-2022 coins can't be sponsored")
+	}
+
+	accounts, err := s.checkRequiredATAs(ctx, params.UserWalletAddress, params.FromCoinMintAddress, params.ToCoinMintAddress)
+	if err != nil {
+		return nil, err
+	}
+	rent := uint64(accounts) * tokenAccountRentLamports
+	priority := s.computeBudget.config.MaxPriorityFeeLamports
+	lamports, err := s.lamportBalance(ctx, params.UserWalletAddress)
+	if err != nil {
+		return nil, err
+	}
+	if lamports >= rent+baseTransactionFeeLamports+priority {
+		return nil, apperrors.ErrSponsorshipDenied.WithMessage("your wallet has enough SOL to pay this swap's network fees")
+	}
+	plan := &sponsorPlan{CostLamports: rent + sponsoredSignatures*baseTransactionFeeLamports + priority}
+	if err := s.checkSponsorLimits(ctx, params.UserWalletAddress, plan.CostLamports); err != nil {
+		return nil, err
+	}
+
+	// The fee is sized against the input's value, which the output's tracks
+	// within the quote's price impact
+	solPrice, err := s.solPrice(ctx)
+	if err != nil {
+		return nil, err
+	}
+	inputUSD := money.Float(money.FromBaseUnits(rawAmount, uint8(fromCoin.Decimals))) * fromCoin.Price
+	if inputUSD <= 0 || solPrice <= 0 {
+		return nil, apperrors.ErrSponsorshipDenied.WithMessage("this swap can't be priced for sponsorship")
+	}
+	costUSD := money.Float(money.FromBaseUnits(plan.CostLamports, money.SOLDecimals)) * solPrice
+	plan.FeeBps = int(math.Ceil(costUSD / inputUSD * 10_000))
+	if plan.FeeBps > s.sponsorship.MaxFeeBps {
+		return nil, apperrors.ErrSponsorshipDenied.
+			With("fee_bps", fmt.Sprint(plan.FeeBps)).
+			WithMessage("this swap is too small to sponsor, swap a larger amount or add SOL")
+	}
+	return plan, nil
+}
+
+// checkSponsorLimits refuses a sponsorship costing costLamports that would take
+// wallet over its daily limits
+func (s *Service) checkSponsorLimits(ctx context.Context, wallet string, costLamports uint64) error {
+	swaps, spent, err := s.sponsorUsage(ctx, wallet)
+	if err != nil {
+		return err
+	}
+	if swaps >= s.sponsorship.MaxSwapsPerDay || spent+costLamports > s.sponsorship.MaxLamportsPerDay {
+		return apperrors.ErrSponsorshipDenied.
+			WithMessage("you've reached today's limit of sponsored swaps, add SOL to keep trading").
+			WithRetryAfter(sponsorWindow)
+	}
+	return nil
+}
+
+// sponsorUsage returns how many of wallet's swaps were sponsored in the last
+// sponsorWindow and the lamports they cost
+func (s *Service) sponsorUsage(ctx context.Context, wallet string) (int, uint64, error) {
+	entries, _, err := s.store.FeeLedger().ListWithOpts(ctx, db.ListOptions{
+		Filters: []db.FilterOption{
+			{Field: "wallet_address", Operator: db.FilterOpEqual, Value: wallet},
+			{Field: "kind", Operator: db.FilterOpEqual, Value: model.FeeLedgerSponsorCost},
+			{Field: "created_at", Operator: db.FilterOpGreaterEqual, Value: time.Now().Add(-sponsorWindow)},
+		},
+	})
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to read sponsored swaps: %w", err)
+	}
+	var spent uint64
+	for _, e := range entries {
+		lamports, err := money.ToBaseUnits(money.FromFloat(-e.Amount), money.SOLDecimals)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid fee ledger entry %s: %w", e.ID, err)
+		}
+		spent += lamports
+	}
+	return len(entries), spent, nil
+}
+
+// solPrice returns SOL's USD price
+func (s *Service) solPrice(ctx context.Context) (float64, error) {
+	sol, err := s.coinService.GetCoinByAddress(ctx, model.SolMint)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get SOL price: %w", err)
+	}
+	return sol.Price, nil
+}
+
+// cosignSponsoredSwap adds the platform's fee payer signature to a sponsored
+// swap the user signed. The message must be the one prepared for them, so the
+// platform only ever pays for its own swaps.
+func (s *Service) cosignSponsoredSwap(ctx context.Context, trade *model.Trade, signed []byte) ([]byte, error) {
+	if s.platformSigner == nil {
+		return nil, apperrors.ErrSponsorshipDenied.WithMessage("sponsored swaps are not available")
+	}
+	tx, err := solanago.TransactionFromBytes(signed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode signed transaction: %w", err)
+	}
+	prepared, err := solanago.TransactionFromBase64(trade.UnsignedTransaction)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode prepared transaction: %w", err)
+	}
+	signedMessage, err := tx.Message.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode signed message: %w", err)
+	}
+	preparedMessage, err := prepared.Message.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode prepared message: %w", err)
+	}
+	if !bytes.Equal(signedMessage, preparedMessage) {
+		return nil, apperrors.ErrInvalidSignature.WithMessage("signed transaction doesn't match the prepared swap")
+	}
+	if len(tx.Message.AccountKeys) == 0 || !tx.Message.AccountKeys[0].Equals(s.platformSigner.PublicKey()) {
+		return nil, apperrors.ErrInvalidSignature.WithMessage("sponsored swap isn't paid by the platform")
+	}
+	if err := s.checkSponsorLimits(ctx, trade.FromAddress, trade.SponsorCostLamports); err != nil {
+		return nil, err
+	}
+
+	if err := signer.SignTransaction(ctx, s.platformSigner, tx); err != nil {
+		return nil, fmt.Errorf("failed to sign as fee payer: %w", err)
+	}
+	raw, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode sponsored transaction: %w", err)
+	}
+	return raw, nil
+}
+
+// recordSponsorship enters a submitted sponsored swap in the fee ledger: the
+// SOL the platform paid, and the part of the platform fee that pays it back.
+// The swap is already on chain, so failures are only logged.
+func (s *Service) recordSponsorship(ctx context.Context, trade *model.Trade) {
+	now := time.Now()
+	costSOL := money.Float(money.FromBaseUnits(trade.SponsorCostLamports, money.SOLDecimals))
+	solPrice, err := s.solPrice(ctx)
+	if err != nil {
+		slog.WarnContext(ctx, "Recording sponsorship cost without a USD value", "trade_id", trade.ID, slog.Any("error", err))
+	}
+	recovered := trade.OutputAmount * float64(trade.SponsorFeeBps) / 10_000
+	entries := []model.FeeLedgerEntry{
+		{
+			Kind:      model.FeeLedgerSponsorCost,
+			Mint:      model.NativeSolMint,
+			Amount:    -costSOL,
+			AmountUSD: -costSOL * solPrice,
+		},
+		{
+			Kind:      model.FeeLedgerSponsorRecovery,
+			Mint:      trade.ToCoinMintAddress,
+			Amount:    recovered,
+			AmountUSD: recovered * trade.ToUSDPrice,
+		},
+	}
+	for _, entry := range entries {
+		entry.ID = uuid.NewString()
+		entry.WalletAddress = trade.FromAddress
+		entry.TradeID = trade.ID
+		entry.TransactionHash = trade.TransactionHash
+		entry.CreatedAt = now
+		if err := s.store.FeeLedger().Create(ctx, &entry); err != nil {
+			slog.ErrorContext(ctx, "Failed to record sponsorship in fee ledger",
+				"trade_id", trade.ID, "kind", entry.Kind, slog.Any("error", err))
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package trade
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	solanago "github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/apperrors"
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients"
+	dbmocks "github.com/nicolas-martin/dankfolio/backend/internal/db/mocks"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	bmodel "github.com/nicolas-martin/dankfolio/backend/internal/model/blockchain"
+	coinmocks "github.com/nicolas-martin/dankfolio/backend/internal/service/coin/mocks"
+	"github.com/nicolas-martin/dankfolio/backend/internal/signer"
+)
+
+const sponsorFeeAccount = "9WzDXwBbmkg8ZTbNMqUxvQRAyrZzDsGYdLVL9zYtAWWM"
+
+func newSponsorService(t *testing.T, ledger []model.FeeLedgerEntry) *Service {
+	svc, chain := newMaxSpendService(t, "0")
+	store := dbmocks.NewMockStore(t)
+	entries := dbmocks.NewMockRepository[model.FeeLedgerEntry](t)
+	store.EXPECT().FeeLedger().Return(entries)
+	entries.EXPECT().ListWithOpts(mock.Anything, mock.Anything).Return(ledger, int32(len(ledger)), nil)
+	// The wallet holds BONK but no USDC account yet
+	chain.EXPECT().GetAccountInfo(mock.Anything, mock.Anything, mock.Anything).
+		Return(&bmodel.AccountInfo{Owner: bmodel.Address(solanago.TokenProgramID.String())}, nil).Once()
+	chain.EXPECT().GetAccountInfo(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, clients.ErrAccountNotFound).Once()
+
+	svc.store = store
+	svc.platformSigner = signer.NewLocalSigner(solanago.NewWallet().PrivateKey)
+	svc.platformFeeAccountAddress = sponsorFeeAccount
+	svc.sponsorship = SponsorshipConfig{Enabled: true}.withDefaults()
+	return svc
+}
+
+func sponsoredSwapParams() model.PrepareSwapRequestData {
+	return model.PrepareSwapRequestData{
+		FromCoinMintAddress: paperBonk,
+		ToCoinMintAddress:   "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v",
+		UserWalletAddress:   paperWallet,
+		Sponsored:           true,
+	}
+}
+
+func TestPlanSponsorship_PricesCostIntoFee(t *testing.T) {
+	svc := newSponsorService(t, nil)
+	coins := coinmocks.NewMockCoinServiceAPI(t)
+	coins.EXPECT().GetCoinByAddress(mock.Anything, model.SolMint).Return(&model.Coin{Price: 150}, nil)
+	svc.coinService = coins
+
+	// $100 of BONK
+	plan, err := svc.planSponsorship(context.Background(), sponsoredSwapParams(), &model.Coin{Decimals: 5, Price: 0.00002}, 500_000_000_000)
+	require.NoError(t, err)
+
+	// Rent for the USDC account, both signatures and the priority fee cap: $0.457 of SOL
+	assert.Equal(t, uint64(tokenAccountRentLamports+2*baseTransactionFeeLamports+DefaultMaxPriorityFeeLamports), plan.CostLamports)
+	assert.Equal(t, 46, plan.FeeBps)
+}
+
+func TestPlanSponsorship_DailyLimit(t *testing.T) {
+	ledger := make([]model.FeeLedgerEntry, DefaultSponsorMaxSwapsPerDay)
+	for i := range ledger {
+		ledger[i] = model.FeeLedgerEntry{Kind: model.FeeLedgerSponsorCost, Amount: -0.001, CreatedAt: time.Now()}
+	}
+	svc := newSponsorService(t, ledger)
+
+	_, err := svc.planSponsorship(context.Background(), sponsoredSwapParams(), &model.Coin{Decimals: 5, Price: 0.00002}, 500_000_000_000)
+	assert.ErrorIs(t, err, apperrors.ErrSponsorshipDenied)
+}
+
+func TestCosignSponsoredSwap(t *testing.T) {
+	platform := solanago.NewWallet().PrivateKey
+	user := solanago.NewWallet().PrivateKey
+	store := dbmocks.NewMockStore(t)
+	entries := dbmocks.NewMockRepository[model.FeeLedgerEntry](t)
+	store.EXPECT().FeeLedger().Return(entries).Maybe()
+	entries.EXPECT().ListWithOpts(mock.Anything, mock.Anything).Return(nil, int32(0), nil).Maybe()
+	svc := &Service{
+		store:          store,
+		platformSigner: signer.NewLocalSigner(platform),
+		sponsorship:    SponsorshipConfig{Enabled: true}.withDefaults(),
+	}
+
+	// userSigned returns a transfer from the user paid for by the platform, signed by the user only
+	userSigned := func(lamports uint64) *solanago.Transaction {
+		tx, err := solanago.NewTransaction([]solanago.Instruction{
+			system.NewTransferInstruction(lamports, user.PublicKey(), solanago.NewWallet().PublicKey()).Build(),
+		}, solanago.Hash{}, solanago.TransactionPayer(platform.PublicKey()))
+		require.NoError(t, err)
+		message, err := tx.Message.MarshalBinary()
+		require.NoError(t, err)
+		userSignature, err := user.Sign(message)
+		require.NoError(t, err)
+		tx.Signatures = []solanago.Signature{{}, userSignature}
+		return tx
+	}
+	prepared := userSigned(1)
+	trade := &model.Trade{FromAddress: user.PublicKey().String(), Sponsored: true, UnsignedTransaction: prepared.MustToBase64()}
+
+	raw, err := svc.cosignSponsoredSwap(context.Background(), trade, mustMarshal(t, prepared))
+	require.NoError(t, err)
+	cosigned, err := solanago.TransactionFromBytes(raw)
+	require.NoError(t, err)
+	assert.NoError(t, cosigned.VerifySignatures())
+
+	// A different transaction signed by the user isn't paid for
+	_, err = svc.cosignSponsoredSwap(context.Background(), trade, mustMarshal(t, userSigned(2)))
+	assert.ErrorIs(t, err, apperrors.ErrInvalidSignature)
+}
+
+func mustMarshal(t *testing.T, tx *solanago.Transaction) []byte {
+	raw, err := tx.MarshalBinary()
+	require.NoError(t, err)
+	return raw
+}
@@ -0,0 +1,512 @@
+package trade
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/apperrors"
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/jobs"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	"github.com/nicolas-martin/dankfolio/backend/internal/util"
+)
+
+const (
+	// MinTwapSlices and MaxTwapSlices bound how many swaps an order is split into
+	MinTwapSlices = 2
+	MaxTwapSlices = 50
+	// MinTwapInterval keeps slices apart by more than a quote's lifetime, so
+	// the user has signed or let lapse one slice before the next is prepared
+	MinTwapInterval = time.Minute
+	// MaxTwapDuration caps how long an order runs from its first slice to its last
+	MaxTwapDuration = 24 * time.Hour
+
+	// JobTwapExecute names the TWAP runner for scheduling
+	JobTwapExecute = "trade.twap.execute"
+	// DefaultTwapRunInterval is how often due slices are prepared and fills checked
+	DefaultTwapRunInterval = 10 * time.Second
+
+	// twapSliceLease is how long a worker holds a slice. It outlasts the
+	// slowest preparation, so only a slice whose worker died is reclaimed.
+	twapSliceLease = 2 * time.Minute
+	// twapClaimLimit caps the slices one run works on
+	twapClaimLimit = 100
+)
+
+// ErrInvalidTwapOrder is returned for a TWAP order with invalid parameters
+var ErrInvalidTwapOrder = errors.New("invalid TWAP order")
+
+// TwapOrderRequest is a swap of RawAmount to split into Slices swaps over Duration
+type TwapOrderRequest struct {
+	WalletAddress       string
+	FromCoinMintAddress string
+	ToCoinMintAddress   string
+	RawAmount           string // Base units of the from coin, in total
+	Slices              int
+	Duration            time.Duration // From the first slice to the last
+	SlippageBps         string
+	AllowMultiHop       bool
+}
+
+// TwapOrderWithSlices is an order and its slices in order
+type TwapOrderWithSlices struct {
+	Order  model.TwapOrder
+	Slices []model.TwapSlice
+}
+
+// CreateTwapOrder splits a swap into equal slices spaced evenly over the
+// requested duration, the last taking the remainder. The first slice is
+// prepared on the next run; each prepared slice waits for the user to sign and
+// submit it by its quote ID, like any prepared swap.
+func (s *Service) CreateTwapOrder(ctx context.Context, req TwapOrderRequest) (*TwapOrderWithSlices, error) {
+	if !util.IsValidSolanaAddress(req.WalletAddress) {
+		return nil, apperrors.ErrInvalidAddress.WithMessage(fmt.Sprintf("invalid wallet address: %s", req.WalletAddress))
+	}
+	for _, mint := range []string{req.FromCoinMintAddress, req.ToCoinMintAddress} {
+		if !util.IsValidSolanaAddress(mint) {
+			return nil, apperrors.ErrInvalidAddress.WithMessage(fmt.Sprintf("invalid coin address: %s", mint))
+		}
+	}
+	if s.blocklist != nil {
+		if err := s.blocklist.Check(req.FromCoinMintAddress, req.ToCoinMintAddress); err != nil {
+			return nil, err
+		}
+	}
+	if req.Slices < MinTwapSlices || req.Slices > MaxTwapSlices {
+		return nil, fmt.Errorf("%w: slices must be between %d and %d", ErrInvalidTwapOrder, MinTwapSlices, MaxTwapSlices)
+	}
+	if req.Duration > MaxTwapDuration {
+		return nil, fmt.Errorf("%w: duration must be at most %s", ErrInvalidTwapOrder, MaxTwapDuration)
+	}
+	interval := req.Duration / time.Duration(req.Slices-1)
+	if interval < MinTwapInterval {
+		return nil, fmt.Errorf("%w: slices must be at least %s apart", ErrInvalidTwapOrder, MinTwapInterval)
+	}
+	total, err := strconv.ParseUint(req.RawAmount, 10, 64)
+	if err != nil || total == 0 {
+		return nil, fmt.Errorf("%w: amount must be a positive integer in raw units", ErrInvalidTwapOrder)
+	}
+	sliceAmount := total / uint64(req.Slices)
+	if sliceAmount == 0 {
+		return nil, fmt.Errorf("%w: amount is too small to split into %d slices", ErrInvalidTwapOrder, req.Slices)
+	}
+
+	now := time.Now()
+	order := model.TwapOrder{
+		ID:                  uuid.NewString(),
+		WalletAddress:       req.WalletAddress,
+		FromCoinMintAddress: req.FromCoinMintAddress,
+		ToCoinMintAddress:   req.ToCoinMintAddress,
+		RawAmount:           req.RawAmount,
+		Slices:              req.Slices,
+		Interval:            interval,
+		SlippageBps:         req.SlippageBps,
+		AllowMultiHop:       req.AllowMultiHop,
+		Status:              model.TwapOrderActive,
+		FilledRawAmount:     "0",
+		NextSliceAt:         now,
+		CreatedAt:           now,
+		UpdatedAt:           now,
+	}
+	slices := make([]model.TwapSlice, req.Slices)
+	for i := range slices {
+		amount := sliceAmount
+		if i == req.Slices-1 {
+			amount = total - sliceAmount*uint64(req.Slices-1)
+		}
+		slices[i] = model.TwapSlice{
+			ID:        fmt.Sprintf("%s:%d", order.ID, i),
+			OrderID:   order.ID,
+			Index:     i,
+			RawAmount: strconv.FormatUint(amount, 10),
+			Status:    model.TwapSlicePending,
+			DueAt:     now.Add(time.Duration(i) * interval),
+			UpdatedAt: now,
+		}
+	}
+
+	err = s.store.WithTransaction(ctx, func(txStore db.Store) error {
+		if err := txStore.TwapOrders().Create(ctx, &order); err != nil {
+			return fmt.Errorf("failed to create TWAP order: %w", err)
+		}
+		for i := range slices {
+			if err := txStore.TwapSlices().Create(ctx, &slices[i]); err != nil {
+				return fmt.Errorf("failed to create TWAP slice: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	slog.InfoContext(ctx, "TWAP order created",
+		"order_id", order.ID, "wallet", order.WalletAddress, "slices", order.Slices, "interval", interval)
+	return &TwapOrderWithSlices{Order: order, Slices: slices}, nil
+}
+
+// ListTwapOrders returns wallet's TWAP orders, newest first, with their slices
+func (s *Service) ListTwapOrders(ctx context.Context, wallet string) ([]TwapOrderWithSlices, error) {
+	sortBy, desc := "created_at", true
+	orders, _, err := s.store.TwapOrders().ListWithOpts(ctx, db.ListOptions{
+		Filters:  []db.FilterOption{{Field: "wallet_address", Operator: db.FilterOpEqual, Value: wallet}},
+		SortBy:   &sortBy,
+		SortDesc: &desc,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list TWAP orders: %w", err)
+	}
+	if len(orders) == 0 {
+		return nil, nil
+	}
+	ids := make([]string, len(orders))
+	for i, o := range orders {
+		ids[i] = o.ID
+	}
+	slices, err := s.listTwapSlices(ctx, db.FilterOption{Field: "order_id", Operator: db.FilterOpIn, Value: ids})
+	if err != nil {
+		return nil, err
+	}
+	byOrder := make(map[string][]model.TwapSlice, len(orders))
+	for _, slice := range slices {
+		byOrder[slice.OrderID] = append(byOrder[slice.OrderID], slice)
+	}
+	result := make([]TwapOrderWithSlices, len(orders))
+	for i, o := range orders {
+		result[i] = TwapOrderWithSlices{Order: o, Slices: byOrder[o.ID]}
+	}
+	return result, nil
+}
+
+// CancelTwapOrder stops wallet's order from preparing more slices. Prepared
+// slices are withdrawn so they can no longer be submitted; slices already
+// submitted still settle and count towards the fill. Cancelling takes over the
+// slices' claims, so a worker preparing one when the order is cancelled
+// withdraws what it prepared instead of saving it.
+func (s *Service) CancelTwapOrder(ctx context.Context, wallet, orderID string) (*TwapOrderWithSlices, error) {
+	order, err := s.store.TwapOrders().Get(ctx, orderID)
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return nil, apperrors.ErrTradeNotFound.WithMessage("TWAP order not found").With("twap_order_id", orderID).Wrap(err)
+		}
+		return nil, fmt.Errorf("failed to get TWAP order %s: %w", orderID, err)
+	}
+	if order.WalletAddress != wallet {
+		// Other wallets' orders are reported missing rather than forbidden
+		return nil, apperrors.ErrTradeNotFound.WithMessage("TWAP order not found").With("twap_order_id", orderID)
+	}
+	slices, err := s.listTwapSlices(ctx, db.FilterOption{Field: "order_id", Operator: db.FilterOpEqual, Value: orderID})
+	if err != nil {
+		return nil, err
+	}
+	if order.Status != model.TwapOrderActive {
+		return &TwapOrderWithSlices{Order: *order, Slices: slices}, nil
+	}
+
+	// Cancelled first, so a worker claiming a slice from here on cancels it itself
+	now := time.Now()
+	order.Status = model.TwapOrderCancelled
+	order.UpdatedAt = now
+	if err := s.store.TwapOrders().Update(ctx, order); err != nil {
+		return nil, fmt.Errorf("failed to cancel TWAP order %s: %w", orderID, err)
+	}
+	for i := range slices {
+		slice := &slices[i]
+		if slice.Status != model.TwapSlicePending && slice.Status != model.TwapSlicePrepared {
+			continue
+		}
+		s.cancelTwapSlice(ctx, slice)
+		slice.ClaimedBy, slice.LeaseExpiresAt = "", time.Time{}
+		slice.UpdatedAt = now
+		if err := s.store.TwapSlices().Update(ctx, slice); err != nil {
+			return nil, fmt.Errorf("failed to cancel TWAP slice %s: %w", slice.ID, err)
+		}
+	}
+	summarizeTwapOrder(order, slices, now)
+	if err := s.store.TwapOrders().Update(ctx, order); err != nil {
+		return nil, fmt.Errorf("failed to update TWAP order %s: %w", orderID, err)
+	}
+	slog.InfoContext(ctx, "TWAP order cancelled", "order_id", orderID, "filled_slices", order.FilledSlices)
+	return &TwapOrderWithSlices{Order: *order, Slices: slices}, nil
+}
+
+// cancelTwapSlice withdraws a pending or prepared slice's quote and cancels
+// it. A slice the user already submitted is followed to its fill instead.
+func (s *Service) cancelTwapSlice(ctx context.Context, slice *model.TwapSlice) {
+	if submitted := s.withdrawTwapQuote(ctx, slice.QuoteID); submitted != nil {
+		slice.Status = model.TwapSliceSubmitted
+		slice.TransactionHash = submitted.TransactionHash
+	} else {
+		slice.Status = model.TwapSliceCancelled
+	}
+	slice.UnsignedTransaction = ""
+}
+
+// withdrawTwapQuote expires the trade prepared under quoteID, if any, so
+// SubmitSwap rejects it. A trade the user already submitted has moved on and
+// is returned instead.
+func (s *Service) withdrawTwapQuote(ctx context.Context, quoteID string) *model.Trade {
+	if quoteID == "" {
+		return nil
+	}
+	trade, err := s.findTwapTrade(ctx, quoteID)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to find TWAP slice quote", "quote_id", quoteID, slog.Any("error", err))
+		return nil
+	}
+	switch {
+	case trade == nil:
+		return nil
+	case trade.TransactionHash != "":
+		return trade
+	case trade.Status == tradeStatusPrepared:
+		trade.Status = tradeStatusExpired
+		if err := s.store.Trades().Update(ctx, trade); err != nil {
+			slog.WarnContext(ctx, "Failed to expire TWAP slice quote", "quote_id", quoteID, slog.Any("error", err))
+		}
+	}
+	return nil
+}
+
+// findTwapTrade returns the trade prepared under quoteID, or nil if there is none
+func (s *Service) findTwapTrade(ctx context.Context, quoteID string) (*model.Trade, error) {
+	trade, err := s.store.Trades().GetByField(ctx, "quote_id", quoteID)
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find trade for quote %s: %w", quoteID, err)
+	}
+	return trade, nil
+}
+
+// TwapJob runs RunTwapOrders every DefaultTwapRunInterval
+func (s *Service) TwapJob() jobs.Job {
+	return jobs.Job{Name: JobTwapExecute, Interval: DefaultTwapRunInterval, Run: s.RunTwapOrders}
+}
+
+// RunTwapOrders claims the slices with work due, prepares the pending ones,
+// follows prepared slices to submission and submitted ones to their fill, and
+// completes orders with no slice left to settle. A slice that can't be
+// prepared, or isn't signed before its quote expires, is not retried: the
+// order fills partially.
+//
+// Every step is safe to repeat. Slices are claimed for twapSliceLease, so
+// instances never work on the same slice at once, and a slice left claimed by
+// a worker that died is picked up again once its lease runs out.
+func (s *Service) RunTwapOrders(ctx context.Context) error {
+	now := time.Now()
+	claimed, err := s.store.ClaimTwapSlices(ctx, db.TwapClaim{
+		Worker: s.twapWorker,
+		Now:    now,
+		Lease:  twapSliceLease,
+		Limit:  twapClaimLimit,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to claim TWAP slices: %w", err)
+	}
+
+	orders := make(map[string]*model.TwapOrder)
+	for i := range claimed {
+		slice := &claimed[i]
+		order, ok := orders[slice.OrderID]
+		if !ok {
+			order, err = s.store.TwapOrders().Get(ctx, slice.OrderID)
+			if err != nil {
+				// Left to its lease, so it's retried
+				slog.ErrorContext(ctx, "Failed to get TWAP order", "order_id", slice.OrderID, slog.Any("error", err))
+				continue
+			}
+			orders[slice.OrderID] = order
+		}
+
+		before := slice.Status
+		if order.Status != model.TwapOrderActive && slice.Status == model.TwapSlicePending {
+			s.cancelTwapSlice(ctx, slice)
+		} else {
+			s.advanceTwapSlice(ctx, order, slice, now)
+		}
+		if slice.Status != before {
+			slice.UpdatedAt = now
+		}
+		saved, err := s.store.SaveClaimedTwapSlice(ctx, slice)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to save TWAP slice", "slice_id", slice.ID, slog.Any("error", err))
+			continue
+		}
+		if !saved {
+			s.releaseLostTwapSlice(ctx, slice)
+		}
+	}
+
+	for id, order := range orders {
+		all, err := s.listTwapSlices(ctx, db.FilterOption{Field: "order_id", Operator: db.FilterOpEqual, Value: id})
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to list TWAP slices", "order_id", id, slog.Any("error", err))
+			continue
+		}
+		summarizeTwapOrder(order, all, now)
+		if err := s.store.TwapOrders().Update(ctx, order); err != nil {
+			slog.ErrorContext(ctx, "Failed to update TWAP order", "order_id", id, slog.Any("error", err))
+			continue
+		}
+		if order.Status == model.TwapOrderCompleted {
+			slog.InfoContext(ctx, "TWAP order completed",
+				"order_id", id, "filled_slices", order.FilledSlices, "slices", order.Slices)
+		}
+	}
+	return nil
+}
+
+// releaseLostTwapSlice handles a slice whose claim was taken over while it was
+// being worked on. If its order was cancelled meanwhile, a quote this worker
+// prepared is withdrawn; otherwise the slice's new claimant picks it up.
+func (s *Service) releaseLostTwapSlice(ctx context.Context, slice *model.TwapSlice) {
+	slog.WarnContext(ctx, "Lost claim on TWAP slice", "slice_id", slice.ID, "status", slice.Status)
+	current, err := s.store.TwapSlices().Get(ctx, slice.ID)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to reload TWAP slice", "slice_id", slice.ID, slog.Any("error", err))
+		return
+	}
+	if current.Status == model.TwapSliceCancelled {
+		s.withdrawTwapQuote(ctx, slice.QuoteID)
+	}
+}
+
+// advanceTwapSlice moves slice along by one step, recording why it failed.
+// Lookups that fail leave the slice as it is for the next run.
+func (s *Service) advanceTwapSlice(ctx context.Context, order *model.TwapOrder, slice *model.TwapSlice, now time.Time) {
+	switch slice.Status {
+	case model.TwapSlicePending:
+		// A worker that stopped between preparing the slice and saving it left
+		// the trade under the slice's quote ID
+		trade, err := s.findTwapTrade(ctx, slice.QuoteID)
+		if err != nil {
+			slog.WarnContext(ctx, "Failed to check for a prepared TWAP slice", "slice_id", slice.ID, slog.Any("error", err))
+			return
+		}
+		if trade != nil {
+			slog.InfoContext(ctx, "Recovered prepared TWAP slice", "slice_id", slice.ID, "quote_id", slice.QuoteID, "attempts", slice.Attempts)
+			syncTwapSliceQuote(slice, trade, now)
+			return
+		}
+		resp, err := s.prepareSwap(ctx, model.PrepareSwapRequestData{
+			FromCoinMintAddress: order.FromCoinMintAddress,
+			ToCoinMintAddress:   order.ToCoinMintAddress,
+			Amount:              slice.RawAmount,
+			SlippageBps:         order.SlippageBps,
+			UserWalletAddress:   order.WalletAddress,
+			AllowMultiHop:       order.AllowMultiHop,
+			QuoteID:             slice.QuoteID,
+		})
+		if err != nil {
+			slog.WarnContext(ctx, "Failed to prepare TWAP slice", "slice_id", slice.ID, slog.Any("error", err))
+			slice.Status = model.TwapSliceFailed
+			slice.Error = twapSliceError(err)
+			return
+		}
+		slice.Status = model.TwapSlicePrepared
+		slice.QuoteID = resp.QuoteID
+		slice.UnsignedTransaction = resp.UnsignedTransaction
+		slice.QuoteExpiresAt = resp.ExpiresAt
+
+	case model.TwapSlicePrepared:
+		trade, err := s.findTwapTrade(ctx, slice.QuoteID)
+		if err != nil || trade == nil {
+			slog.WarnContext(ctx, "Failed to find TWAP slice trade", "slice_id", slice.ID, slog.Any("error", err))
+			return
+		}
+		syncTwapSliceQuote(slice, trade, now)
+
+	case model.TwapSliceSubmitted:
+		trade, err := s.GetTradeByTransactionHash(ctx, slice.TransactionHash)
+		if err != nil {
+			slog.WarnContext(ctx, "Failed to check TWAP slice fill", "slice_id", slice.ID, slog.Any("error", err))
+			return
+		}
+		switch {
+		case strings.EqualFold(trade.Status, model.TradeStatusFailed.String()):
+			slice.Status = model.TwapSliceFailed
+			slice.Error = trade.Error
+		case trade.Finalized:
+			slice.Status = model.TwapSliceFilled
+			slice.OutputAmount = trade.ExecutedOutputAmount
+			if slice.OutputAmount == 0 {
+				slice.OutputAmount = trade.OutputAmount
+			}
+		}
+	}
+}
+
+// syncTwapSliceQuote brings slice up to date with the trade prepared for it:
+// submitted once the user sent it, expired once its quote lapsed unsigned,
+// and otherwise prepared
+func syncTwapSliceQuote(slice *model.TwapSlice, trade *model.Trade, now time.Time) {
+	switch {
+	case trade.TransactionHash != "":
+		slice.Status = model.TwapSliceSubmitted
+		slice.TransactionHash = trade.TransactionHash
+		slice.UnsignedTransaction = ""
+	case trade.Status == tradeStatusExpired || (!trade.QuoteExpiresAt.IsZero() && now.After(trade.QuoteExpiresAt)):
+		slice.Status = model.TwapSliceExpired
+		slice.Error = "not signed before its quote expired"
+		slice.UnsignedTransaction = ""
+	default:
+		slice.Status = model.TwapSlicePrepared
+		slice.UnsignedTransaction = trade.UnsignedTransaction
+		slice.QuoteExpiresAt = trade.QuoteExpiresAt
+	}
+}
+
+// summarizeTwapOrder totals order's fills from its slices and completes it
+// once none is left to prepare or settle
+func summarizeTwapOrder(order *model.TwapOrder, slices []model.TwapSlice, now time.Time) {
+	var filled uint64
+	order.FilledSlices, order.OutputAmount, order.NextSliceAt = 0, 0, time.Time{}
+	settled := true
+	for _, slice := range slices {
+		switch slice.Status {
+		case model.TwapSliceFilled:
+			amount, _ := strconv.ParseUint(slice.RawAmount, 10, 64)
+			filled += amount
+			order.FilledSlices++
+			order.OutputAmount += slice.OutputAmount
+		case model.TwapSlicePending:
+			settled = false
+			if order.NextSliceAt.IsZero() || slice.DueAt.Before(order.NextSliceAt) {
+				order.NextSliceAt = slice.DueAt
+			}
+		case model.TwapSlicePrepared, model.TwapSliceSubmitted:
+			settled = false
+		}
+	}
+	order.FilledRawAmount = strconv.FormatUint(filled, 10)
+	if settled && order.Status == model.TwapOrderActive {
+		order.Status = model.TwapOrderCompleted
+	}
+	order.UpdatedAt = now
+}
+
+func (s *Service) listTwapSlices(ctx context.Context, filters ...db.FilterOption) ([]model.TwapSlice, error) {
+	sortBy, desc := "slice_index", false
+	slices, _, err := s.store.TwapSlices().ListWithOpts(ctx, db.ListOptions{Filters: filters, SortBy: &sortBy, SortDesc: &desc})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list TWAP slices: %w", err)
+	}
+	return slices, nil
+}
+
+// twapSliceError is the reason a slice failed, as shown to the user
+func twapSliceError(err error) string {
+	var appErr *apperrors.Error
+	if errors.As(err, &appErr) {
+		return appErr.Message
+	}
+	return "the swap could not be prepared"
+}
@@ -0,0 +1,168 @@
+package trade
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	dbmocks "github.com/nicolas-martin/dankfolio/backend/internal/db/mocks"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+func TestCreateTwapOrder_SplitsAmountOverDuration(t *testing.T) {
+	store := dbmocks.NewMockStore(t)
+	orders := dbmocks.NewMockRepository[model.TwapOrder](t)
+	slices := dbmocks.NewMockRepository[model.TwapSlice](t)
+	store.EXPECT().TwapOrders().Return(orders)
+	store.EXPECT().TwapSlices().Return(slices)
+	store.EXPECT().WithTransaction(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, fn func(db.Store) error) error { return fn(store) })
+	orders.EXPECT().Create(mock.Anything, mock.Anything).Return(nil)
+	slices.EXPECT().Create(mock.Anything, mock.Anything).Return(nil).Times(4)
+	svc := &Service{store: store}
+
+	result, err := svc.CreateTwapOrder(context.Background(), TwapOrderRequest{
+		WalletAddress:       paperWallet,
+		FromCoinMintAddress: model.NativeSolMint,
+		ToCoinMintAddress:   paperBonk,
+		RawAmount:           "1000000003",
+		Slices:              4,
+		Duration:            30 * time.Minute,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 10*time.Minute, result.Order.Interval)
+	assert.Equal(t, model.TwapOrderActive, result.Order.Status)
+	require.Len(t, result.Slices, 4)
+	amounts := make([]string, len(result.Slices))
+	for i, slice := range result.Slices {
+		amounts[i] = slice.RawAmount
+		assert.Equal(t, result.Order.CreatedAt.Add(time.Duration(i)*10*time.Minute), slice.DueAt)
+	}
+	assert.Equal(t, []string{"250000000", "250000000", "250000000", "250000003"}, amounts)
+}
+
+func TestCreateTwapOrder_RejectsSlicesTooClose(t *testing.T) {
+	svc := &Service{}
+
+	_, err := svc.CreateTwapOrder(context.Background(), TwapOrderRequest{
+		WalletAddress:       paperWallet,
+		FromCoinMintAddress: model.NativeSolMint,
+		ToCoinMintAddress:   paperBonk,
+		RawAmount:           "1000000000",
+		Slices:              10,
+		Duration:            5 * time.Minute,
+	})
+	assert.ErrorIs(t, err, ErrInvalidTwapOrder)
+}
+
+func TestSummarizeTwapOrder_CompletesPartialFill(t *testing.T) {
+	now := time.Now()
+	order := &model.TwapOrder{Status: model.TwapOrderActive}
+	slices := []model.TwapSlice{
+		{RawAmount: "100", Status: model.TwapSliceFilled, OutputAmount: 5},
+		{RawAmount: "100", Status: model.TwapSliceExpired},
+		{RawAmount: "100", Status: model.TwapSliceSubmitted, DueAt: now},
+		{RawAmount: "100", Status: model.TwapSlicePending, DueAt: now.Add(time.Minute)},
+	}
+
+	summarizeTwapOrder(order, slices, now)
+	assert.Equal(t, model.TwapOrderActive, order.Status)
+	assert.Equal(t, now.Add(time.Minute), order.NextSliceAt)
+
+	slices[2].Status, slices[2].OutputAmount = model.TwapSliceFilled, 4
+	slices[3].Status = model.TwapSliceFailed
+	summarizeTwapOrder(order, slices, now)
+	assert.Equal(t, model.TwapOrderCompleted, order.Status)
+	assert.Equal(t, 2, order.FilledSlices)
+	assert.Equal(t, "200", order.FilledRawAmount)
+	assert.Equal(t, 9.0, order.OutputAmount)
+	assert.True(t, order.NextSliceAt.IsZero())
+}
+
+// twapRun sets up a run that claims slice of an active order, with trade
+// prepared under the slice's quote ID, and whose save of the slice reports
+// saved. The returned slice holds what the run saved.
+func twapRun(t *testing.T, slice model.TwapSlice, trade *model.Trade, saved bool) (*Service, *dbmocks.MockRepository[model.TwapSlice], *dbmocks.MockRepository[model.Trade], *model.TwapSlice) {
+	store := dbmocks.NewMockStore(t)
+	orders := dbmocks.NewMockRepository[model.TwapOrder](t)
+	slices := dbmocks.NewMockRepository[model.TwapSlice](t)
+	trades := dbmocks.NewMockRepository[model.Trade](t)
+	store.EXPECT().TwapOrders().Return(orders).Maybe()
+	store.EXPECT().TwapSlices().Return(slices).Maybe()
+	store.EXPECT().Trades().Return(trades).Maybe()
+
+	order := &model.TwapOrder{ID: slice.OrderID, WalletAddress: paperWallet, Slices: 2, Status: model.TwapOrderActive}
+	store.EXPECT().ClaimTwapSlices(mock.Anything, mock.MatchedBy(func(c db.TwapClaim) bool { return c.Worker == "worker-b" })).
+		Return([]model.TwapSlice{slice}, nil)
+	orders.EXPECT().Get(mock.Anything, slice.OrderID).Return(order, nil)
+	trades.EXPECT().GetByField(mock.Anything, "quote_id", slice.QuoteID).Return(trade, nil)
+	result := &model.TwapSlice{}
+	store.EXPECT().SaveClaimedTwapSlice(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, s *model.TwapSlice) (bool, error) {
+			*result = *s
+			return saved, nil
+		})
+	slices.EXPECT().ListWithOpts(mock.Anything, mock.Anything).
+		RunAndReturn(func(context.Context, db.ListOptions) ([]model.TwapSlice, int32, error) {
+			return []model.TwapSlice{*result}, 1, nil
+		}).Maybe()
+	orders.EXPECT().Update(mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	return &Service{store: store, twapWorker: "worker-b"}, slices, trades, result
+}
+
+func TestRunTwapOrders_RecoversSliceWhoseWorkerDied(t *testing.T) {
+	// Worker A claimed the slice and prepared its swap, then died before
+	// saving it. Worker B claims it once A's lease runs out.
+	slice := model.TwapSlice{
+		ID: "o1:0", OrderID: "o1", RawAmount: "100", Status: model.TwapSlicePending, DueAt: time.Now().Add(-time.Minute),
+		QuoteID: "q1", ClaimedBy: "worker-b", Attempts: 2,
+	}
+	prepared := &model.Trade{QuoteID: "q1", Status: tradeStatusPrepared, UnsignedTransaction: "unsigned", QuoteExpiresAt: time.Now().Add(time.Minute)}
+	// The service has no coin service or Jupiter client, so preparing the swap
+	// a second time would fail the test
+	svc, _, _, saved := twapRun(t, slice, prepared, true)
+
+	require.NoError(t, svc.RunTwapOrders(context.Background()))
+	assert.Equal(t, model.TwapSlicePrepared, saved.Status)
+	assert.Equal(t, "q1", saved.QuoteID)
+	assert.Equal(t, "unsigned", saved.UnsignedTransaction)
+}
+
+func TestRunTwapOrders_SubmittedWhileWorkerWasDown(t *testing.T) {
+	slice := model.TwapSlice{
+		ID: "o1:0", OrderID: "o1", RawAmount: "100", Status: model.TwapSlicePending, DueAt: time.Now().Add(-time.Minute),
+		QuoteID: "q1", ClaimedBy: "worker-b", Attempts: 2,
+	}
+	submitted := &model.Trade{QuoteID: "q1", Status: "submitted", TransactionHash: "sig1"}
+	svc, _, _, saved := twapRun(t, slice, submitted, true)
+
+	require.NoError(t, svc.RunTwapOrders(context.Background()))
+	assert.Equal(t, model.TwapSliceSubmitted, saved.Status)
+	assert.Equal(t, "sig1", saved.TransactionHash)
+}
+
+func TestRunTwapOrders_WithdrawsQuoteWhenCancelledMidRun(t *testing.T) {
+	slice := model.TwapSlice{
+		ID: "o1:0", OrderID: "o1", RawAmount: "100", Status: model.TwapSlicePending, DueAt: time.Now().Add(-time.Minute),
+		QuoteID: "q1", ClaimedBy: "worker-b", Attempts: 1,
+	}
+	prepared := &model.Trade{QuoteID: "q1", Status: tradeStatusPrepared, QuoteExpiresAt: time.Now().Add(time.Minute)}
+	// CancelTwapOrder took over the claim while the slice was being prepared
+	svc, slices, trades, _ := twapRun(t, slice, prepared, false)
+	cancelled := slice
+	cancelled.Status, cancelled.ClaimedBy = model.TwapSliceCancelled, ""
+	slices.EXPECT().Get(mock.Anything, "o1:0").Return(&cancelled, nil)
+	trades.EXPECT().Update(mock.Anything, mock.Anything).
+		Run(func(_ context.Context, trade *model.Trade) {
+			assert.Equal(t, tradeStatusExpired, trade.Status)
+		}).Return(nil).Once()
+
+	require.NoError(t, svc.RunTwapOrders(context.Background()))
+}
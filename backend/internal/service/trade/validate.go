@@ -0,0 +1,158 @@
+package trade
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+
+	solanago "github.com/gagliardetto/solana-go"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/apperrors"
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients"
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients/jupiter"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	bmodel "github.com/nicolas-martin/dankfolio/backend/internal/model/blockchain"
+	"github.com/nicolas-martin/dankfolio/backend/internal/util"
+	"github.com/nicolas-martin/dankfolio/backend/internal/util/ata"
+	"github.com/nicolas-martin/dankfolio/backend/internal/util/money"
+)
+
+const (
+	// tokenAccountStateOffset is where an SPL token account stores its state
+	tokenAccountStateOffset = 108
+	// tokenAccountFrozen is the state of an account its mint's freeze authority froze
+	tokenAccountFrozen = 2
+)
+
+// TradeProblem is a reason a swap would fail, reported before it is quoted.
+// Kind is the error the swap itself would be rejected with.
+type TradeProblem struct {
+	Kind    apperrors.Kind
+	Message string // Safe to show users
+	Mint    string // The coin the problem is with, when there is one
+}
+
+// ValidateTrade checks whether swapping amount (base units of fromMint) into
+// toMint could succeed for wallet, without building a transaction: that both
+// coins are off the blocklist and tradable, the wallet's token accounts for
+// them aren't frozen, it holds the amount plus the SOL for fees and rent, and
+// Jupiter has a route. An empty result means no problem was found. Failing to
+// reach Jupiter skips the route check rather than reporting a problem.
+func (s *Service) ValidateTrade(ctx context.Context, wallet, fromMint, toMint, amount string, paper bool) ([]TradeProblem, error) {
+	if !util.IsValidSolanaAddress(wallet) {
+		return []TradeProblem{{Kind: apperrors.KindInvalidAddress, Message: "invalid wallet address"}}, nil
+	}
+	var problems []TradeProblem
+	for _, mint := range []string{fromMint, toMint} {
+		if !util.IsValidSolanaAddress(mint) {
+			problems = append(problems, TradeProblem{Kind: apperrors.KindInvalidAddress, Message: "invalid coin address", Mint: mint})
+			continue
+		}
+		if s.blocklist != nil && s.blocklist.Check(mint) != nil {
+			problems = append(problems, TradeProblem{Kind: apperrors.KindTokenBlocked, Message: apperrors.ErrTokenBlocked.Message, Mint: mint})
+		}
+	}
+	if len(problems) > 0 {
+		return problems, nil
+	}
+	rawAmount, err := strconv.ParseUint(amount, 10, 64)
+	if err != nil || rawAmount == 0 {
+		return nil, fmt.Errorf("invalid amount (must be positive integer in raw units): %s", amount)
+	}
+
+	spendable, err := s.GetMaxSpendable(ctx, wallet, fromMint, toMint, paper)
+	if err != nil {
+		return nil, err
+	}
+	maxRaw, err := strconv.ParseUint(spendable.RawAmount, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid max spendable amount %q: %w", spendable.RawAmount, err)
+	}
+	if rawAmount > maxRaw {
+		message := "insufficient balance"
+		if isSOL(fromMint) && spendable.ReservedLamports > 0 {
+			message = fmt.Sprintf("insufficient SOL: %s SOL is kept back for network fees and rent",
+				money.FormatLamports(spendable.ReservedLamports))
+		}
+		problems = append(problems, TradeProblem{Kind: apperrors.KindInsufficientBalance, Message: message, Mint: fromMint})
+	}
+	if spendable.SOLShortfallLamports > 0 {
+		problems = append(problems, TradeProblem{
+			Kind:    apperrors.KindInsufficientBalance,
+			Message: fmt.Sprintf("%s more SOL is needed for network fees and rent", money.FormatLamports(spendable.SOLShortfallLamports)),
+			Mint:    model.NativeSolMint,
+		})
+	}
+
+	if !paper {
+		for _, mint := range []string{fromMint, toMint} {
+			frozen, err := s.tokenAccountFrozen(ctx, wallet, mint)
+			if err != nil {
+				slog.WarnContext(ctx, "Failed to check token account state", "mint", mint, slog.Any("error", err))
+				continue
+			}
+			if frozen {
+				problems = append(problems, TradeProblem{
+					Kind:    apperrors.KindTokenNotTradable,
+					Message: "your token account for this coin is frozen by its issuer",
+					Mint:    mint,
+				})
+			}
+		}
+	}
+
+	if problem := s.checkRoute(ctx, fromMint, toMint, amount); problem != nil {
+		problems = append(problems, *problem)
+	}
+	return problems, nil
+}
+
+// tokenAccountFrozen reports whether wallet's token account for mint exists
+// and is frozen. Native SOL has no token account.
+func (s *Service) tokenAccountFrozen(ctx context.Context, wallet, mint string) (bool, error) {
+	if mint == model.NativeSolMint {
+		return false, nil
+	}
+	account, err := ata.Derive(solanago.MustPublicKeyFromBase58(wallet), solanago.MustPublicKeyFromBase58(mint))
+	if err != nil {
+		return false, err
+	}
+	info, err := s.chainClient.GetAccountInfo(ctx, bmodel.Address(account.Address.String()), s.commitment)
+	if err != nil {
+		if errors.Is(err, clients.ErrAccountNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return info != nil && len(info.Data) > tokenAccountStateOffset && info.Data[tokenAccountStateOffset] == tokenAccountFrozen, nil
+}
+
+// checkRoute asks Jupiter for a quote of the swap and reports a missing route
+// or an untradable coin
+func (s *Service) checkRoute(ctx context.Context, fromMint, toMint, amount string) *TradeProblem {
+	inputMint, outputMint := fromMint, toMint
+	if inputMint == model.NativeSolMint {
+		inputMint = model.SolMint
+	}
+	if outputMint == model.NativeSolMint {
+		outputMint = model.SolMint
+	}
+	_, err := s.jupiterClient.GetQuote(ctx, jupiter.QuoteParams{
+		InputMint:  inputMint,
+		OutputMint: outputMint,
+		Amount:     amount,
+		SwapMode:   "ExactIn",
+	})
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, apperrors.ErrNoRoute):
+		return &TradeProblem{Kind: apperrors.KindNoRoute, Message: apperrors.ErrNoRoute.Message}
+	case errors.Is(err, apperrors.ErrTokenNotTradable):
+		return &TradeProblem{Kind: apperrors.KindTokenNotTradable, Message: apperrors.ErrTokenNotTradable.Message}
+	}
+	slog.WarnContext(ctx, "Skipping route check, Jupiter quote failed", "from", fromMint, "to", toMint, slog.Any("error", err))
+	return nil
+}
@@ -0,0 +1,53 @@
+package trade
+
+import (
+	"context"
+	"testing"
+
+	solanago "github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/apperrors"
+	jupitermocks "github.com/nicolas-martin/dankfolio/backend/internal/clients/jupiter/mocks"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	bmodel "github.com/nicolas-martin/dankfolio/backend/internal/model/blockchain"
+)
+
+func TestValidateTrade_ReportsEveryProblem(t *testing.T) {
+	svc, chain := newMaxSpendService(t, "1000000000")
+	jup := jupitermocks.NewMockClientAPI(t)
+	svc.jupiterClient = jup
+
+	// The wallet's BONK account exists but its issuer froze it
+	frozen := make([]byte, 165)
+	frozen[tokenAccountStateOffset] = tokenAccountFrozen
+	chain.EXPECT().GetAccountInfo(mock.Anything, mock.Anything, mock.Anything).
+		Return(&bmodel.AccountInfo{Owner: bmodel.Address(solanago.TokenProgramID.String()), Data: frozen}, nil)
+	jup.EXPECT().GetQuote(mock.Anything, mock.Anything).Return(nil, apperrors.ErrNoRoute)
+
+	// 1 SOL less the reserve for fees is less than 0.999 SOL
+	problems, err := svc.ValidateTrade(context.Background(), paperWallet, model.NativeSolMint, paperBonk, "999000000", false)
+	require.NoError(t, err)
+
+	kinds := make([]apperrors.Kind, len(problems))
+	for i, p := range problems {
+		kinds[i] = p.Kind
+	}
+	assert.Equal(t, []apperrors.Kind{
+		apperrors.KindInsufficientBalance,
+		apperrors.KindTokenNotTradable,
+		apperrors.KindNoRoute,
+	}, kinds)
+	assert.Equal(t, paperBonk, problems[1].Mint)
+}
+
+func TestValidateTrade_InvalidMint(t *testing.T) {
+	svc := &Service{}
+
+	problems, err := svc.ValidateTrade(context.Background(), paperWallet, "not-a-mint", paperBonk, "1", false)
+	require.NoError(t, err)
+	require.Len(t, problems, 1)
+	assert.Equal(t, apperrors.KindInvalidAddress, problems[0].Kind)
+}
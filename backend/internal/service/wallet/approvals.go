@@ -0,0 +1,187 @@
+package wallet
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/token"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+// maxRevokesPerTransaction keeps a revoke transaction comfortably under the 1232 byte packet limit
+const maxRevokesPerTransaction = 20
+
+// ErrNoApproval is returned when a revoke targets a token account without an active delegate
+var ErrNoApproval = errors.New("no active token approval")
+
+// TokenApproval is a token account with an active delegate
+type TokenApproval struct {
+	TokenAccount    string
+	Mint            string
+	Delegate        string
+	DelegatedAmount float64
+	ProgramID       string
+}
+
+// RevokeApprovals is an unsigned transaction revoking one or more token delegates
+type RevokeApprovals struct {
+	UnsignedTransaction  string
+	RevokedTokenAccounts []string
+	Remaining            int
+}
+
+// GetTokenApprovals scans a wallet's token accounts for active delegates.
+// A delegate can move tokens without the owner's signature, which makes stale
+// approvals a common way wallets get drained.
+func (s *Service) GetTokenApprovals(ctx context.Context, walletAddress string) ([]TokenApproval, error) {
+	owner, err := s.parseAddress(walletAddress, "wallet")
+	if err != nil {
+		return nil, err
+	}
+
+	tokenCtx, cancel := context.WithTimeout(ctx, 45*time.Second)
+	defer cancel()
+
+	// A partial list would hide delegates, so any failed program fails the scan
+	accounts, err := s.GetTokenAccounts(tokenCtx, owner.String())
+	if err != nil {
+		return nil, err
+	}
+
+	approvals := make([]TokenApproval, 0)
+	for _, account := range accounts {
+		if account.Delegate == "" {
+			continue
+		}
+		approvals = append(approvals, TokenApproval{
+			TokenAccount:    string(account.Address),
+			Mint:            string(account.MintAddress),
+			Delegate:        string(account.Delegate),
+			DelegatedAmount: account.DelegatedUIAmount,
+			ProgramID:       string(account.ProgramID),
+		})
+	}
+
+	slog.Debug("Scanned token approvals", "wallet", walletAddress, "accounts", len(accounts), "approvals", len(approvals))
+	return approvals, nil
+}
+
+// PrepareRevokeApprovals builds an unsigned transaction revoking delegates on the
+// given token accounts, or on every approved account when none are given. Accounts
+// are checked against the on-chain scan so only the wallet's own delegated accounts
+// end up in the transaction. Approvals that don't fit are reported in Remaining.
+func (s *Service) PrepareRevokeApprovals(ctx context.Context, walletAddress string, tokenAccounts []string) (*RevokeApprovals, error) {
+	owner, err := s.parseAddress(walletAddress, "wallet")
+	if err != nil {
+		return nil, err
+	}
+
+	approvals, err := s.GetTokenApprovals(ctx, walletAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tokenAccounts) > 0 {
+		requested := make([]TokenApproval, 0, len(tokenAccounts))
+		for _, tokenAccount := range tokenAccounts {
+			idx := slices.IndexFunc(approvals, func(a TokenApproval) bool { return a.TokenAccount == tokenAccount })
+			if idx == -1 {
+				return nil, fmt.Errorf("%w on token account %s for wallet %s", ErrNoApproval, tokenAccount, walletAddress)
+			}
+			if !slices.ContainsFunc(requested, func(a TokenApproval) bool { return a.TokenAccount == tokenAccount }) {
+				requested = append(requested, approvals[idx])
+			}
+		}
+		approvals = requested
+	}
+
+	if len(approvals) == 0 {
+		return nil, fmt.Errorf("%w to revoke for wallet %s", ErrNoApproval, walletAddress)
+	}
+
+	batch := approvals[:min(len(approvals), maxRevokesPerTransaction)]
+	instructions := make([]solana.Instruction, 0, len(batch))
+	revoked := make([]string, 0, len(batch))
+	for _, approval := range batch {
+		instruction, err := newRevokeInstruction(approval, owner)
+		if err != nil {
+			return nil, err
+		}
+		instructions = append(instructions, instruction)
+		revoked = append(revoked, approval.TokenAccount)
+	}
+
+	tx, err := s.buildTransaction(ctx, owner, instructions)
+	if err != nil {
+		return nil, err
+	}
+
+	txBytes, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize transaction: %w", err)
+	}
+	unsignedTx := base64.StdEncoding.EncodeToString(txBytes)
+
+	// Recorded like a transfer so the signed transaction can go through SubmitTransfer
+	defaultFeeLamports := uint64(5000)
+	feeSOL := float64(defaultFeeLamports) / float64(solana.LAMPORTS_PER_SOL)
+	trade := &model.Trade{
+		Fee:                 feeSOL,
+		TotalFeeAmount:      feeSOL,
+		TotalFeeMint:        model.SolMint,
+		FromCoinMintAddress: model.SolMint,
+		ToCoinMintAddress:   model.SolMint,
+		Type:                "revoke",
+		Status:              "pending",
+		UnsignedTransaction: unsignedTx,
+		CreatedAt:           time.Now(),
+		FromAddress:         walletAddress,
+		ToAddress:           walletAddress,
+	}
+	if err := s.store.Trades().Create(ctx, trade); err != nil {
+		slog.Warn("Failed to create trade record for revoke", "wallet", walletAddress, "error", err)
+	}
+
+	slog.Info("Prepared revoke approvals transaction",
+		"wallet", walletAddress,
+		"revoked", len(revoked),
+		"remaining", len(approvals)-len(batch))
+
+	return &RevokeApprovals{
+		UnsignedTransaction:  unsignedTx,
+		RevokedTokenAccounts: revoked,
+		Remaining:            len(approvals) - len(batch),
+	}, nil
+}
+
+// newRevokeInstruction builds a Revoke for the approval's token program. SPL Token and
+// Token-2022 share the instruction layout, so only the program ID differs.
+func newRevokeInstruction(approval TokenApproval, owner solana.PublicKey) (solana.Instruction, error) {
+	tokenAccount, err := solana.PublicKeyFromBase58(approval.TokenAccount)
+	if err != nil {
+		return nil, fmt.Errorf("invalid token account %s: %w", approval.TokenAccount, err)
+	}
+	programID := solana.TokenProgramID
+	if approval.ProgramID != "" {
+		programID, err = solana.PublicKeyFromBase58(approval.ProgramID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid token program %s: %w", approval.ProgramID, err)
+		}
+	}
+
+	return solana.NewInstruction(
+		programID,
+		solana.AccountMetaSlice{
+			solana.Meta(tokenAccount).WRITE(),
+			solana.Meta(owner).SIGNER(),
+		},
+		[]byte{token.Instruction_Revoke},
+	), nil
+}
@@ -0,0 +1,70 @@
+package wallet
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/token"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	clientsmocks "github.com/nicolas-martin/dankfolio/backend/internal/clients/mocks"
+	dbmocks "github.com/nicolas-martin/dankfolio/backend/internal/db/mocks"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	bmodel "github.com/nicolas-martin/dankfolio/backend/internal/model/blockchain"
+)
+
+const token2022Program = "TokenzQdBNbLqP5VEhdkAS6EPFLC1PHnBqCXEpPxuEb"
+
+func TestPrepareRevokeApprovals(t *testing.T) {
+	ctx := context.Background()
+	owner := solana.NewWallet().PublicKey()
+	delegated := solana.NewWallet().PublicKey()
+	delegated2022 := solana.NewWallet().PublicKey()
+
+	chainClient := clientsmocks.NewMockGenericClientAPI(t)
+	chainClient.EXPECT().GetTokenAccountsByOwner(mock.Anything, bmodel.Address(owner.String()), programOption(solana.TokenProgramID.String())).Return([]*bmodel.TokenAccountInfo{
+		{Address: bmodel.Address(solana.NewWallet().PublicKey().String()), ProgramID: bmodel.Address(solana.TokenProgramID.String())},
+		{Address: bmodel.Address(delegated.String()), Delegate: "Delegate111", DelegatedUIAmount: 5, ProgramID: bmodel.Address(solana.TokenProgramID.String())},
+	}, nil)
+	chainClient.EXPECT().GetTokenAccountsByOwner(mock.Anything, bmodel.Address(owner.String()), programOption(token2022Program)).Return([]*bmodel.TokenAccountInfo{
+		{Address: bmodel.Address(delegated2022.String()), Delegate: "Delegate222", ProgramID: token2022Program},
+	}, nil)
+	chainClient.EXPECT().GetLatestBlockhash(mock.Anything, mock.Anything).Return(bmodel.Blockhash(solana.Hash{}.String()), nil).Maybe()
+
+	trades := dbmocks.NewMockRepository[model.Trade](t)
+	trades.EXPECT().Create(mock.Anything, mock.Anything).Return(nil).Maybe()
+	store := dbmocks.NewMockStore(t)
+	store.EXPECT().Trades().Return(trades).Maybe()
+
+	s := New(chainClient, store, nil, nil, nil)
+
+	approvals, err := s.GetTokenApprovals(ctx, owner.String())
+	require.NoError(t, err)
+	require.Len(t, approvals, 2)
+	assert.Equal(t, 5.0, approvals[0].DelegatedAmount)
+
+	_, err = s.PrepareRevokeApprovals(ctx, owner.String(), []string{solana.NewWallet().PublicKey().String()})
+	assert.ErrorIs(t, err, ErrNoApproval)
+
+	revoke, err := s.PrepareRevokeApprovals(ctx, owner.String(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{delegated.String(), delegated2022.String()}, revoke.RevokedTokenAccounts)
+	assert.Zero(t, revoke.Remaining)
+
+	txBytes, err := base64.StdEncoding.DecodeString(revoke.UnsignedTransaction)
+	require.NoError(t, err)
+	tx, err := solana.TransactionFromBytes(txBytes)
+	require.NoError(t, err)
+	require.Len(t, tx.Message.Instructions, 2)
+	for i, program := range []solana.PublicKey{solana.TokenProgramID, solana.MustPublicKeyFromBase58(token2022Program)} {
+		instruction := tx.Message.Instructions[i]
+		programID, err := tx.Message.Program(instruction.ProgramIDIndex)
+		require.NoError(t, err)
+		assert.Equal(t, program, programID)
+		assert.Equal(t, []byte{token.Instruction_Revoke}, []byte(instruction.Data))
+	}
+}
@@ -0,0 +1,112 @@
+package wallet
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/events"
+)
+
+// DefaultBalanceCacheTTL is how long balances are served from memory when no
+// transaction invalidates them first
+const DefaultBalanceCacheTTL = 30 * time.Second
+
+// balanceCache keeps each wallet's balances for a short TTL so foregrounding
+// the app doesn't hit getTokenAccountsByOwner every time.
+type balanceCache struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]cachedBalances
+	// generation is bumped on every invalidation so a lookup that started
+	// before a transaction landed doesn't cache balances from before it
+	generation uint64
+}
+
+type cachedBalances struct {
+	balance   WalletBalance
+	expiresAt time.Time
+}
+
+func newBalanceCache(ttl time.Duration) *balanceCache {
+	return &balanceCache{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[string]cachedBalances),
+	}
+}
+
+// get returns a copy of the wallet's cached balance and the generation to
+// pass to set
+func (c *balanceCache) get(wallet string) (*WalletBalance, uint64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[wallet]
+	if ok && c.now().After(entry.expiresAt) {
+		delete(c.entries, wallet)
+		ok = false
+	}
+	balance := entry.balance
+	balance.Balances = slices.Clone(balance.Balances)
+	return &balance, c.generation, ok
+}
+
+// set caches balance unless anything was invalidated since generation was read
+func (c *balanceCache) set(wallet string, generation uint64, balance WalletBalance) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.generation != generation {
+		return
+	}
+	// Drop expired entries so wallets that stop asking don't accumulate
+	now := c.now()
+	for address, entry := range c.entries {
+		if now.After(entry.expiresAt) {
+			delete(c.entries, address)
+		}
+	}
+	balance.Balances = slices.Clone(balance.Balances)
+	c.entries[wallet] = cachedBalances{balance: balance, expiresAt: now.Add(c.ttl)}
+}
+
+func (c *balanceCache) invalidate(wallet string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, wallet)
+	c.generation++
+}
+
+// SetBalanceCacheTTL caches GetWalletBalances results per wallet for ttl.
+// Zero disables the cache.
+func (s *Service) SetBalanceCacheTTL(ttl time.Duration) {
+	if ttl <= 0 {
+		s.balances = nil
+		return
+	}
+	s.balances = newBalanceCache(ttl)
+}
+
+// InvalidateBalances drops the wallet's cached balances so the next lookup
+// reads the chain
+func (s *Service) InvalidateBalances(wallet string) {
+	if s.balances != nil {
+		s.balances.invalidate(wallet)
+	}
+}
+
+// WatchBalanceChanges invalidates cached balances as soon as a wallet's trade
+// or transfer confirms, or its transactions are seen on the websocket, until
+// unsubscribe is called
+func (s *Service) WatchBalanceChanges(bus events.Bus) (unsubscribe func()) {
+	return bus.Subscribe(func(_ context.Context, event events.Event) {
+		switch payload := event.Payload.(type) {
+		case events.TradePayload:
+			s.InvalidateBalances(payload.Trade.UserID)
+		case events.WalletActivityPayload:
+			s.InvalidateBalances(payload.Wallet)
+		}
+	}, events.TradeConfirmed, events.WalletActivity)
+}
@@ -0,0 +1,77 @@
+package wallet
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/events"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+const testBalanceWallet = "GgaBFkzjuvMV7RCrZyt65zx7iRo7W6Af4cGXZMKNxK2R"
+
+func TestBalanceCache(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	cache := newBalanceCache(30 * time.Second)
+	cache.now = func() time.Time { return now }
+	balance := WalletBalance{Balances: []Balance{{ID: model.NativeSolMint, Amount: 1.5}}}
+
+	_, generation, ok := cache.get(testBalanceWallet)
+	require.False(t, ok)
+	cache.set(testBalanceWallet, generation, balance)
+
+	cached, _, ok := cache.get(testBalanceWallet)
+	require.True(t, ok)
+	assert.Equal(t, balance, *cached)
+
+	t.Run("expires after the ttl", func(t *testing.T) {
+		now = now.Add(31 * time.Second)
+		_, _, ok := cache.get(testBalanceWallet)
+		assert.False(t, ok)
+	})
+
+	t.Run("invalidation drops the entry", func(t *testing.T) {
+		_, generation, _ := cache.get(testBalanceWallet)
+		cache.set(testBalanceWallet, generation, balance)
+		cache.invalidate(testBalanceWallet)
+		_, _, ok := cache.get(testBalanceWallet)
+		assert.False(t, ok)
+	})
+
+	t.Run("a lookup that raced an invalidation is not cached", func(t *testing.T) {
+		_, generation, _ := cache.get(testBalanceWallet)
+		cache.invalidate(testBalanceWallet)
+		cache.set(testBalanceWallet, generation, balance)
+		_, _, ok := cache.get(testBalanceWallet)
+		assert.False(t, ok)
+	})
+}
+
+func TestService_WatchBalanceChanges(t *testing.T) {
+	bus := events.NewMemoryBus(0)
+	defer bus.Close()
+	s := &Service{}
+	s.SetBalanceCacheTTL(time.Minute)
+	s.WatchBalanceChanges(bus)
+
+	cacheBalances := func() {
+		_, generation, _ := s.balances.get(testBalanceWallet)
+		s.balances.set(testBalanceWallet, generation, WalletBalance{Balances: []Balance{{ID: model.NativeSolMint, Amount: 1}}})
+	}
+	cached := func() bool {
+		_, _, ok := s.balances.get(testBalanceWallet)
+		return ok
+	}
+
+	cacheBalances()
+	bus.Publish(context.Background(), events.NewTradeEvent(events.TradeConfirmed, model.Trade{UserID: testBalanceWallet}))
+	assert.Eventually(t, func() bool { return !cached() }, time.Second, 5*time.Millisecond)
+
+	cacheBalances()
+	bus.Publish(context.Background(), events.NewWalletActivityEvent(testBalanceWallet, "sig"))
+	assert.Eventually(t, func() bool { return !cached() }, time.Second, 5*time.Millisecond)
+}
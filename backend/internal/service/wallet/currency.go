@@ -0,0 +1,39 @@
+package wallet
+
+import (
+	"context"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/fx"
+)
+
+// SetFX enables display currencies other than USD
+func (s *Service) SetFX(fxService *fx.Service) {
+	s.fx = fxService
+}
+
+// GetPortfolioPnLIn is GetPortfolioPnL with the values quoted in currency
+// instead of USD. Amounts held and percentages are unchanged. The normalized
+// currency code is returned with the values.
+func (s *Service) GetPortfolioPnLIn(ctx context.Context, walletAddress, currency string) (totalValue float64, totalCostBasis float64, totalUnrealizedPnL float64, totalPnLPercentage float64, totalHoldings int32, tokenPnLs []TokenPnLData, quotedIn string, err error) {
+	// Resolve the rate first so an unsupported currency fails before the PnL work
+	converter, err := s.fx.Converter(ctx, currency)
+	if err != nil {
+		return 0, 0, 0, 0, 0, nil, "", err
+	}
+
+	totalValue, totalCostBasis, totalUnrealizedPnL, totalPnLPercentage, totalHoldings, tokenPnLs, err = s.GetPortfolioPnL(ctx, walletAddress)
+	if err != nil {
+		return 0, 0, 0, 0, 0, nil, "", err
+	}
+	if converter.Currency == fx.BaseCurrency {
+		return totalValue, totalCostBasis, totalUnrealizedPnL, totalPnLPercentage, totalHoldings, tokenPnLs, converter.Currency, nil
+	}
+
+	for i := range tokenPnLs {
+		tokenPnLs[i].CostBasis = converter.Convert(tokenPnLs[i].CostBasis)
+		tokenPnLs[i].CurrentPrice = converter.Convert(tokenPnLs[i].CurrentPrice)
+		tokenPnLs[i].CurrentValue = converter.Convert(tokenPnLs[i].CurrentValue)
+		tokenPnLs[i].UnrealizedPnL = converter.Convert(tokenPnLs[i].UnrealizedPnL)
+	}
+	return converter.Convert(totalValue), converter.Convert(totalCostBasis), converter.Convert(totalUnrealizedPnL), totalPnLPercentage, totalHoldings, tokenPnLs, converter.Currency, nil
+}
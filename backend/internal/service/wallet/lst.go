@@ -0,0 +1,102 @@
+package wallet
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+// Portfolio categories balances and PnL rows are tagged with
+const (
+	CategorySOL           = "sol"
+	CategoryLiquidStaking = "liquid_staking"
+	CategoryToken         = "token" // Everything else, mostly memecoins
+)
+
+// LiquidStakingToken is a token for SOL staked through a stake pool. Its SOL
+// exchange rate grows as the pool earns staking rewards.
+type LiquidStakingToken struct {
+	Symbol string
+	// EstimatedAPY is the pool's typical staking yield, e.g. 0.07 for 7%.
+	// It only feeds yield estimates.
+	EstimatedAPY float64
+}
+
+// LiquidStakingTokens are the major liquid staking tokens by mint
+var LiquidStakingTokens = map[string]LiquidStakingToken{
+	"mSoLzYCxHdYgdzU16g5QSh3i5K3z3KZK7ytfqcJm7So":  {Symbol: "mSOL", EstimatedAPY: 0.072},
+	"J1toso1uCk3RLmjorhTtrVwY9HJ7X8V9yYac6Y7kGCPn": {Symbol: "jitoSOL", EstimatedAPY: 0.078},
+	"bSo13r4TkiE4KumL71LsHTPpL2euBYLFx6h9HP3piy1":  {Symbol: "bSOL", EstimatedAPY: 0.069},
+}
+
+// LiquidStaking values a liquid staking token balance in the SOL it stands for
+type LiquidStaking struct {
+	ExchangeRate       float64 `json:"exchange_rate"` // SOL per token
+	StakedSOL          float64 `json:"staked_sol"`    // Amount times ExchangeRate
+	EstimatedAPY       float64 `json:"estimated_apy"`
+	EstimatedYearlySOL float64 `json:"estimated_yearly_sol"` // SOL the stake earns in a year at EstimatedAPY
+}
+
+// Category returns the portfolio category of a mint
+func Category(mint string) string {
+	switch {
+	case mint == model.NativeSolMint || mint == model.SolMint:
+		return CategorySOL
+	case isLiquidStakingToken(mint):
+		return CategoryLiquidStaking
+	default:
+		return CategoryToken
+	}
+}
+
+func isLiquidStakingToken(mint string) bool {
+	_, ok := LiquidStakingTokens[mint]
+	return ok
+}
+
+// annotateStaking tags balances with their category and values liquid staking
+// token balances in SOL, returning the total effective staked SOL. Exchange
+// rates come from the tokens' market prices against SOL; without prices the
+// balances are only tagged.
+func (s *Service) annotateStaking(ctx context.Context, balances []Balance) float64 {
+	var mints []string
+	for i := range balances {
+		balances[i].Category = Category(balances[i].ID)
+		if balances[i].Category == CategoryLiquidStaking {
+			mints = append(mints, balances[i].ID)
+		}
+	}
+	if len(mints) == 0 || s.priceService == nil {
+		return 0
+	}
+
+	prices, err := s.priceService.GetPricesBatch(ctx, append(mints, model.SolMint))
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to get liquid staking token exchange rates", "error", err)
+		return 0
+	}
+	solPrice := prices[model.SolMint]
+	if solPrice <= 0 {
+		return 0
+	}
+
+	var stakedSOL float64
+	for i := range balances {
+		balance := &balances[i]
+		price := prices[balance.ID]
+		if balance.Category != CategoryLiquidStaking || price <= 0 {
+			continue
+		}
+		lst := LiquidStakingTokens[balance.ID]
+		rate := price / solPrice
+		balance.LiquidStaking = &LiquidStaking{
+			ExchangeRate:       rate,
+			StakedSOL:          balance.Amount * rate,
+			EstimatedAPY:       lst.EstimatedAPY,
+			EstimatedYearlySOL: balance.Amount * rate * lst.EstimatedAPY,
+		}
+		stakedSOL += balance.LiquidStaking.StakedSOL
+	}
+	return stakedSOL
+}
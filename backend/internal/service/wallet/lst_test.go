@@ -0,0 +1,48 @@
+package wallet
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	pricemocks "github.com/nicolas-martin/dankfolio/backend/internal/service/price/mocks"
+)
+
+const jitoSOLMint = "J1toso1uCk3RLmjorhTtrVwY9HJ7X8V9yYac6Y7kGCPn"
+
+func TestAnnotateStaking(t *testing.T) {
+	prices := pricemocks.NewMockPriceServiceAPI(t)
+	prices.EXPECT().GetPricesBatch(mock.Anything, []string{jitoSOLMint, model.SolMint}).
+		Return(map[string]float64{jitoSOLMint: 240, model.SolMint: 200}, nil)
+	s := New(nil, nil, nil, prices, nil)
+
+	balances := []Balance{
+		{ID: model.NativeSolMint, Amount: 1},
+		{ID: jitoSOLMint, Amount: 10},
+		{ID: "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v", Amount: 5},
+	}
+	stakedSOL := s.annotateStaking(context.Background(), balances)
+
+	assert.Equal(t, CategorySOL, balances[0].Category)
+	assert.Equal(t, CategoryLiquidStaking, balances[1].Category)
+	assert.Equal(t, CategoryToken, balances[2].Category)
+
+	require.NotNil(t, balances[1].LiquidStaking)
+	assert.InDelta(t, 1.2, balances[1].LiquidStaking.ExchangeRate, 1e-9)
+	assert.InDelta(t, 12, balances[1].LiquidStaking.StakedSOL, 1e-9)
+	assert.InDelta(t, 12*LiquidStakingTokens[jitoSOLMint].EstimatedAPY, balances[1].LiquidStaking.EstimatedYearlySOL, 1e-9)
+	assert.InDelta(t, 12, stakedSOL, 1e-9)
+	assert.Nil(t, balances[2].LiquidStaking)
+}
+
+func TestAnnotateStaking_WithoutPrices(t *testing.T) {
+	s := New(nil, nil, nil, nil, nil)
+	balances := []Balance{{ID: jitoSOLMint, Amount: 10}}
+	assert.Zero(t, s.annotateStaking(context.Background(), balances))
+	assert.Equal(t, CategoryLiquidStaking, balances[0].Category)
+	assert.Nil(t, balances[0].LiquidStaking)
+}
@@ -0,0 +1,86 @@
+package wallet
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/gagliardetto/solana-go"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/apperrors"
+)
+
+const (
+	// MaxMemoBytes bounds a transfer memo. Exchanges use short deposit tags,
+	// and the memo shares the transaction size limit with everything else.
+	MaxMemoBytes = 256
+	// MaxReferences bounds the reference keys attached to one transfer
+	MaxReferences = 8
+)
+
+// TransferOptions are the optional parts of a transfer
+type TransferOptions struct {
+	// Memo is recorded on chain by the memo program, e.g. the deposit tag an
+	// exchange asks for
+	Memo string
+	// References are Solana Pay reference keys. They are added to the transfer
+	// instruction as read-only accounts so the payee can find the transaction
+	// with getSignaturesForAddress.
+	References []string
+}
+
+// parse validates the options and returns the reference keys
+func (o TransferOptions) parse() ([]solana.PublicKey, error) {
+	if len(o.Memo) > MaxMemoBytes {
+		return nil, fmt.Errorf("memo is %d bytes, the limit is %d", len(o.Memo), MaxMemoBytes)
+	}
+	if !utf8.ValidString(o.Memo) {
+		return nil, fmt.Errorf("memo must be valid UTF-8")
+	}
+	if len(o.References) > MaxReferences {
+		return nil, fmt.Errorf("%d references given, the limit is %d", len(o.References), MaxReferences)
+	}
+	references := make([]solana.PublicKey, len(o.References))
+	for i, reference := range o.References {
+		key, err := solana.PublicKeyFromBase58(reference)
+		if err != nil {
+			return nil, apperrors.ErrInvalidAddress.WithMessage("invalid reference address").Wrap(err)
+		}
+		references[i] = key
+	}
+	return references, nil
+}
+
+// memoInstruction records memo on chain, signed by signer. The memo program
+// takes the raw UTF-8 bytes as instruction data.
+func memoInstruction(signer solana.PublicKey, memo string) solana.Instruction {
+	return solana.NewInstruction(solana.MemoProgramID, solana.AccountMetaSlice{
+		solana.Meta(signer).SIGNER(),
+	}, []byte(memo))
+}
+
+// withReferences returns ix with the reference keys appended as read-only,
+// non-signer accounts. Programs ignore accounts past the ones they expect.
+func withReferences(ix solana.Instruction, references []solana.PublicKey) (solana.Instruction, error) {
+	if len(references) == 0 {
+		return ix, nil
+	}
+	data, err := ix.Data()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode instruction: %w", err)
+	}
+	accounts := append(solana.AccountMetaSlice{}, ix.Accounts()...)
+	for _, reference := range references {
+		accounts = append(accounts, solana.Meta(reference))
+	}
+	return solana.NewInstruction(ix.ProgramID(), accounts, data), nil
+}
+
+// transferInstructions orders a transfer's instructions: account setup, then
+// the memo if any, then the transfer
+func transferInstructions(setup []solana.Instruction, from solana.PublicKey, memo string, transferIx solana.Instruction) []solana.Instruction {
+	instructions := append([]solana.Instruction{}, setup...)
+	if memo != "" {
+		instructions = append(instructions, memoInstruction(from, memo))
+	}
+	return append(instructions, transferIx)
+}
@@ -0,0 +1,74 @@
+package wallet
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/apperrors"
+	clientsmocks "github.com/nicolas-martin/dankfolio/backend/internal/clients/mocks"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	bmodel "github.com/nicolas-martin/dankfolio/backend/internal/model/blockchain"
+)
+
+func TestTransferWithMemoAndReferences(t *testing.T) {
+	from, to := solana.NewWallet().PublicKey(), solana.NewWallet().PublicKey()
+	reference := solana.NewWallet().PublicKey()
+	chainClient := clientsmocks.NewMockGenericClientAPI(t)
+	chainClient.EXPECT().GetLatestBlockhash(mock.Anything, mock.Anything).Return(bmodel.Blockhash(solana.Hash{}.String()), nil)
+	s := New(chainClient, nil, nil, nil, nil)
+
+	opts := TransferOptions{Memo: "deposit 12345", References: []string{reference.String()}}
+	references, err := opts.parse()
+	require.NoError(t, err)
+	tx, err := s.createTokenTransfer(context.Background(), from, to, model.SolMint, 0.5, opts.Memo, references)
+	require.NoError(t, err)
+
+	require.Len(t, tx.Message.Instructions, 2)
+	memoIx, transferIx := tx.Message.Instructions[0], tx.Message.Instructions[1]
+
+	program, err := tx.Message.Program(memoIx.ProgramIDIndex)
+	require.NoError(t, err)
+	assert.Equal(t, solana.MemoProgramID, program)
+	assert.Equal(t, []byte("deposit 12345"), []byte(memoIx.Data))
+
+	program, err = tx.Message.Program(transferIx.ProgramIDIndex)
+	require.NoError(t, err)
+	assert.Equal(t, solana.SystemProgramID, program)
+	require.Len(t, transferIx.Accounts, 3)
+	assert.Equal(t, reference, tx.Message.AccountKeys[transferIx.Accounts[2]])
+	writable, err := tx.Message.IsWritable(reference)
+	require.NoError(t, err)
+	assert.False(t, writable)
+	assert.False(t, tx.Message.IsSigner(reference))
+}
+
+func TestTransferWithoutOptions(t *testing.T) {
+	chainClient := clientsmocks.NewMockGenericClientAPI(t)
+	chainClient.EXPECT().GetLatestBlockhash(mock.Anything, mock.Anything).Return(bmodel.Blockhash(solana.Hash{}.String()), nil)
+	s := New(chainClient, nil, nil, nil, nil)
+
+	tx, err := s.createTokenTransfer(context.Background(), solana.NewWallet().PublicKey(), solana.NewWallet().PublicKey(), model.SolMint, 0.5, "", nil)
+	require.NoError(t, err)
+	require.Len(t, tx.Message.Instructions, 1)
+	assert.Len(t, tx.Message.Instructions[0].Accounts, 2)
+}
+
+func TestTransferOptionsValidation(t *testing.T) {
+	_, err := TransferOptions{Memo: strings.Repeat("a", MaxMemoBytes+1)}.parse()
+	assert.ErrorContains(t, err, "memo")
+
+	_, err = TransferOptions{Memo: "\xff"}.parse()
+	assert.ErrorContains(t, err, "UTF-8")
+
+	_, err = TransferOptions{References: []string{"not-a-key"}}.parse()
+	assert.ErrorIs(t, err, apperrors.ErrInvalidAddress)
+
+	_, err = TransferOptions{References: make([]string, MaxReferences+1)}.parse()
+	assert.ErrorContains(t, err, "references")
+}
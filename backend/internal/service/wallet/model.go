@@ -3,12 +3,62 @@ package wallet
 // Balance represents information about a token balance
 type Balance struct {
 	ID     string  `json:"id"`
-	Amount float64 `json:"amount"`
+	Amount float64 `json:"amount"` // UI amount, for display only
+
+	// Exact balance in base units (lamports for SOL) and the decimals to scale it by
+	RawAmount string `json:"raw_amount"`
+	Decimals  uint8  `json:"decimals"`
+
+	// Set by the spam classifier; spam balances are hidden from clients unless requested
+	IsSpam      bool     `json:"is_spam,omitempty"`
+	SpamReasons []string `json:"spam_reasons,omitempty"`
+
+	// Set on the native SOL balance, which includes any wrapped SOL
+	SOLBreakdown *SOLBreakdown `json:"sol_breakdown,omitempty"`
+	// Actions worth suggesting to the user for this balance, e.g. ActionUnwrap
+	SuggestedActions []string `json:"suggested_actions,omitempty"`
+
+	// Portfolio category, one of the Category constants
+	Category string `json:"category,omitempty"`
+	// Set on liquid staking tokens whose SOL exchange rate is known
+	LiquidStaking *LiquidStaking `json:"liquid_staking,omitempty"`
+}
+
+// SOLBreakdown splits a SOL balance into native SOL and wrapped SOL held in
+// token accounts
+type SOLBreakdown struct {
+	NativeAmount     float64 `json:"native_amount"`
+	NativeRawAmount  string  `json:"native_raw_amount"` // Lamports
+	WrappedAmount    float64 `json:"wrapped_amount"`
+	WrappedRawAmount string  `json:"wrapped_raw_amount"` // Lamports
 }
 
 // WalletBalance represents a wallet's complete balance
 type WalletBalance struct {
 	Balances []Balance `json:"balances"`
+	// StakedSOL is the effective SOL staked through liquid staking tokens
+	StakedSOL float64 `json:"staked_sol"`
+	// NativeStake holds the wallet's stake accounts, nil when it has none
+	NativeStake *NativeStake `json:"native_stake,omitempty"`
+}
+
+// NativeStake is the wallet's native stake accounts and their totals in SOL
+type NativeStake struct {
+	Accounts            []StakeAccount `json:"accounts"`
+	TotalSOL            float64        `json:"total_sol"` // Everything held in stake accounts, whatever their status
+	ActiveSOL           float64        `json:"active_sol"`
+	ActivatingSOL       float64        `json:"activating_sol"`
+	LastEpochRewardsSOL float64        `json:"last_epoch_rewards_sol"`
+}
+
+// StakeAccount is a native stake account the wallet can withdraw from
+type StakeAccount struct {
+	Address       string  `json:"address"`
+	Amount        float64 `json:"amount"`          // SOL in the account, including its rent-exempt reserve
+	DelegatedSOL  float64 `json:"delegated_sol"`   // SOL delegated to the validator
+	Validator     string  `json:"validator"`       // Vote account, empty when undelegated
+	Status        string  `json:"status"`          // "inactive", "activating", "active" or "deactivating"
+	LastRewardSOL float64 `json:"last_reward_sol"` // SOL earned in the previous epoch
 }
 
 // WalletInfo has been removed for security reasons
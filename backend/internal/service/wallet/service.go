@@ -6,23 +6,26 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
-	"math"
+	"slices"
 	"strings"
 	"time"
 
 	bin "github.com/gagliardetto/binary"
 	"github.com/gagliardetto/solana-go"
-	associatedtokenaccount "github.com/gagliardetto/solana-go/programs/associated-token-account"
 	"github.com/gagliardetto/solana-go/programs/system"
 	"github.com/gagliardetto/solana-go/programs/token"
 	"github.com/google/uuid"
 
+	"github.com/nicolas-martin/dankfolio/backend/internal/apperrors"
 	bclient "github.com/nicolas-martin/dankfolio/backend/internal/clients"
 	"github.com/nicolas-martin/dankfolio/backend/internal/db"
 	"github.com/nicolas-martin/dankfolio/backend/internal/model"
 	bmodel "github.com/nicolas-martin/dankfolio/backend/internal/model/blockchain"
 	coinservice "github.com/nicolas-martin/dankfolio/backend/internal/service/coin" // Added for CoinServiceAPI
+	"github.com/nicolas-martin/dankfolio/backend/internal/service/fx"
 	"github.com/nicolas-martin/dankfolio/backend/internal/service/price"            // Added for PriceServiceAPI
+	"github.com/nicolas-martin/dankfolio/backend/internal/util/ata"
+	"github.com/nicolas-martin/dankfolio/backend/internal/util/money"
 )
 
 // Service handles wallet-related operations
@@ -32,6 +35,12 @@ type Service struct {
 	coinService  coinservice.CoinServiceAPI // Added CoinService
 	priceService price.PriceServiceAPI      // Added PriceService for efficient price fetching
 	coinCache    coinservice.CoinCache      // Added coin cache for price optimization
+	ataManager   *ata.Manager               // Checks token accounts before transfers
+	commitment   string                     // Commitment for chain reads and preflight
+
+	spamClassifier *SpamClassifier // Optional; balances are not tagged when nil
+	fx             *fx.Service     // Optional; only USD can be displayed when nil
+	balances       *balanceCache   // Optional; see SetBalanceCacheTTL
 }
 
 // New creates a new wallet service
@@ -42,9 +51,17 @@ func New(chainClient bclient.GenericClientAPI, store db.Store, coinService coins
 		coinService:  coinService,  // Store injected CoinService
 		priceService: priceService, // Store injected PriceService for efficient price fetching
 		coinCache:    coinCache,    // Store injected coin cache for price optimization
+		ataManager:   ata.NewManager(chainClient),
+		commitment:   bmodel.DefaultCommitment,
 	}
 }
 
+// SetCommitment sets the commitment chain reads and transaction preflight use
+func (s *Service) SetCommitment(commitment string) {
+	s.commitment = commitment
+	s.ataManager.SetCommitment(commitment)
+}
+
 // ValidatePublicKey validates that a string is a valid Solana public key
 func (s *Service) ValidatePublicKey(ctx context.Context, publicKey string) error {
 	_, err := solana.PublicKeyFromBase58(publicKey)
@@ -98,7 +115,7 @@ func (s *Service) parseAddress(address, label string) (solana.PublicKey, error)
 	pubKey, err := solana.PublicKeyFromBase58(address)
 	if err != nil {
 		slog.Error("Invalid address", "label", label, "error", err)
-		return solana.PublicKey{}, fmt.Errorf("invalid %s address: %w", label, err)
+		return solana.PublicKey{}, apperrors.ErrInvalidAddress.WithMessage(fmt.Sprintf("invalid %s address", label)).Wrap(err)
 	}
 	slog.Debug("Address parsed", "label", label, "address", pubKey.String())
 	return pubKey, nil
@@ -106,49 +123,31 @@ func (s *Service) parseAddress(address, label string) (solana.PublicKey, error)
 
 // getOrCreateATA gets an Associated Token Account, creating it if it doesn't exist
 func (s *Service) getOrCreateATA(ctx context.Context, payer, owner, mint solana.PublicKey) (solana.PublicKey, []solana.Instruction, error) {
-	ata, _, err := solana.FindAssociatedTokenAddress(owner, mint)
+	account, err := ata.Derive(owner, mint)
 	if err != nil {
 		return solana.PublicKey{}, nil, fmt.Errorf("failed to find token account: %w", err)
 	}
 
-	// Use generic GetAccountInfo
-	bAccInfo, err := s.chainClient.GetAccountInfo(ctx, bmodel.Address(ata.String()))
-	var instructions []solana.Instruction
-
+	exists, err := s.ataManager.Exists(ctx, account.Address)
 	if err != nil {
-		if errors.Is(err, bclient.ErrAccountNotFound) { // Ensure bclient or your chosen alias is used
-			slog.Debug("Token account not found by chainClient.GetAccountInfo, planning creation", "address", ata.String(), "error_received", err)
-		} else {
-			return solana.PublicKey{}, nil, fmt.Errorf("failed to check token account %s: %w", ata.String(), err)
-		}
+		return solana.PublicKey{}, nil, err
 	}
-
-	// If account doesn't exist (bAccInfo is nil from a "not found" error) or is uninitialized (owned by SystemProgramID)
-	if bAccInfo == nil || (bAccInfo != nil && bmodel.Address(solana.SystemProgramID.String()) == bAccInfo.Owner) {
-		slog.Debug("Creating token account", "address", ata.String())
-		createATAIx, err := associatedtokenaccount.NewCreateInstruction(
-			payer,
-			owner,
-			mint,
-		).ValidateAndBuild()
-		if err != nil {
-			return solana.PublicKey{}, nil, fmt.Errorf("failed to create ATA instruction: %w", err)
-		}
-		instructions = append(instructions, createATAIx)
-	} else {
-		// Account exists and is not owned by system program - validate it's a proper token account
-		if err := s.validateTokenAccount(ctx, ata, owner, mint); err != nil {
-			return solana.PublicKey{}, nil, fmt.Errorf("invalid token account %s: %w", ata.String(), err)
-		}
+	if !exists {
+		slog.Debug("Creating token account", "address", account.Address.String())
+		return account.Address, []solana.Instruction{ata.CreateInstruction(payer, account)}, nil
 	}
 
-	return ata, instructions, nil
+	// Account exists and is not owned by system program - validate it's a proper token account
+	if err := s.validateTokenAccount(ctx, account.Address, owner, account.Mint); err != nil {
+		return solana.PublicKey{}, nil, fmt.Errorf("invalid token account %s: %w", account.Address.String(), err)
+	}
+	return account.Address, nil, nil
 }
 
 // validateTokenAccount validates that a token account has correct data structure and ownership
 func (s *Service) validateTokenAccount(ctx context.Context, ata, expectedOwner, expectedMint solana.PublicKey) error {
 	// Get account info with full data
-	accInfo, err := s.chainClient.GetAccountInfo(ctx, bmodel.Address(ata.String()))
+	accInfo, err := s.chainClient.GetAccountInfo(ctx, bmodel.Address(ata.String()), s.commitment)
 	if err != nil {
 		return fmt.Errorf("failed to get account info: %w", err)
 	}
@@ -203,38 +202,25 @@ func (s *Service) validateTokenAccount(ctx context.Context, ata, expectedOwner,
 
 // getTokenAccount gets or creates a token account for a given mint and owner
 func (s *Service) getTokenAccount(ctx context.Context, mint, owner solana.PublicKey) (solana.PublicKey, []solana.Instruction, error) {
-	ata, _, err := solana.FindAssociatedTokenAddress(owner, mint)
+	account, err := ata.Derive(owner, mint)
 	if err != nil {
 		return solana.PublicKey{}, nil, fmt.Errorf("failed to find associated token address: %w", err)
 	}
 
-	bAccInfo, err := s.chainClient.GetAccountInfo(ctx, bmodel.Address(ata.String()))
+	exists, err := s.ataManager.Exists(ctx, account.Address)
 	if err != nil {
-		if !strings.Contains(err.Error(), "account not found") && !strings.Contains(err.Error(), "nil value") {
-			return solana.PublicKey{}, nil, fmt.Errorf("failed to check token account %s: %w", ata.String(), err)
-		}
+		return solana.PublicKey{}, nil, err
 	}
-
-	var instructions []solana.Instruction
-	if bAccInfo == nil || bmodel.Address(solana.SystemProgramID.String()) == bAccInfo.Owner {
-		slog.Debug("Creating token account", "address", ata.String())
-		createATAIx, err := associatedtokenaccount.NewCreateInstruction(
-			owner,
-			owner,
-			mint,
-		).ValidateAndBuild()
-		if err != nil {
-			return solana.PublicKey{}, nil, fmt.Errorf("failed to create ATA instruction: %w", err)
-		}
-		instructions = append(instructions, createATAIx)
+	if exists {
+		return account.Address, nil, nil
 	}
-
-	return ata, instructions, nil
+	slog.Debug("Creating token account", "address", account.Address.String())
+	return account.Address, []solana.Instruction{ata.CreateInstruction(owner, account)}, nil
 }
 
 // getMintInfo retrieves and parses mint account information
 func (s *Service) getMintInfo(ctx context.Context, mint solana.PublicKey) (uint8, error) {
-	bAccInfo, err := s.chainClient.GetAccountInfo(ctx, bmodel.Address(mint.String()))
+	bAccInfo, err := s.chainClient.GetAccountInfo(ctx, bmodel.Address(mint.String()), s.commitment)
 	if err != nil {
 		return 0, fmt.Errorf("failed to get mint info for %s: %w", mint.String(), err)
 	}
@@ -288,7 +274,7 @@ func (s *Service) getMintInfo(ctx context.Context, mint solana.PublicKey) (uint8
 
 // buildTransaction creates a transaction with the given instructions
 func (s *Service) buildTransaction(ctx context.Context, payer solana.PublicKey, instructions []solana.Instruction) (*solana.Transaction, error) {
-	genericBlockhash, err := s.chainClient.GetLatestBlockhash(ctx)
+	genericBlockhash, err := s.chainClient.GetLatestBlockhash(ctx, s.commitment)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get recent blockhash: %w", err)
 	}
@@ -317,13 +303,16 @@ func (s *Service) buildTransaction(ctx context.Context, payer solana.PublicKey,
 // Wallets should be generated client-side to ensure private keys never leave the user's device
 // Use RegisterWallet instead to register client-generated wallets
 
-// PrepareTransfer prepares an unsigned transfer transaction
-func (s *Service) PrepareTransfer(ctx context.Context, fromAddress, toAddress, coinMintAddress string, amount float64) (string, error) {
+// PrepareTransfer prepares an unsigned transfer transaction, with the memo and
+// reference keys in opts if set
+func (s *Service) PrepareTransfer(ctx context.Context, fromAddress, toAddress, coinMintAddress string, amount float64, opts TransferOptions) (string, error) {
 	slog.Info("Preparing transfer",
 		"from", fromAddress,
 		"to", toAddress,
 		"amount", amount,
-		"coinMintAddress", coinMintAddress)
+		"coinMintAddress", coinMintAddress,
+		"has_memo", opts.Memo != "",
+		"references", len(opts.References))
 
 	from, err := s.parseAddress(fromAddress, "from")
 	if err != nil {
@@ -335,6 +324,11 @@ func (s *Service) PrepareTransfer(ctx context.Context, fromAddress, toAddress, c
 		return "", err
 	}
 
+	references, err := opts.parse()
+	if err != nil {
+		return "", err
+	}
+
 	// Determine coin PKIDs and final mint addresses for the trade record
 	var fromCoinPKID, toCoinPKID uint64
 	var finalFromCoinMint, finalToCoinMint string
@@ -363,7 +357,7 @@ func (s *Service) PrepareTransfer(ctx context.Context, fromAddress, toAddress, c
 	toCoinPKID = coinModel.ID
 	coinSymbol = coinModel.Symbol
 
-	tx, err := s.createTokenTransfer(ctx, from, to, coinMintAddress, amount) // createTokenTransfer still uses original coinMintAddress for SPL mint logic
+	tx, err := s.createTokenTransfer(ctx, from, to, coinMintAddress, amount, opts.Memo, references) // createTokenTransfer still uses original coinMintAddress for SPL mint logic
 	if err != nil {
 		slog.Error("Failed to create transfer transaction", "error", err)
 		return "", fmt.Errorf("failed to create transfer transaction: %w", err)
@@ -411,6 +405,8 @@ func (s *Service) PrepareTransfer(ctx context.Context, fromAddress, toAddress, c
 		CreatedAt:              time.Now(),
 		FromAddress:            fromAddress,
 		ToAddress:              toAddress,
+		Memo:                   opts.Memo,
+		References:             opts.References,
 	}
 
 	if err := s.store.Trades().Create(ctx, trade); err != nil {
@@ -420,8 +416,10 @@ func (s *Service) PrepareTransfer(ctx context.Context, fromAddress, toAddress, c
 	return unsignedTx, nil
 }
 
-// createTokenTransfer creates a token transfer transaction
-func (s *Service) createTokenTransfer(ctx context.Context, from, to solana.PublicKey, tokenMint string, amount float64) (*solana.Transaction, error) {
+// createTokenTransfer creates a token transfer transaction. A non-empty memo
+// goes in a memo instruction ahead of the transfer, and references are added
+// to the transfer instruction itself, as Solana Pay expects.
+func (s *Service) createTokenTransfer(ctx context.Context, from, to solana.PublicKey, tokenMint string, amount float64, memo string, references []solana.PublicKey) (*solana.Transaction, error) {
 	// Log input parameters for debugging
 	slog.Debug("createTokenTransfer called",
 		"from", from.String(),
@@ -439,13 +437,16 @@ func (s *Service) createTokenTransfer(ctx context.Context, from, to solana.Publi
 		lamports := uint64(amount * float64(solana.LAMPORTS_PER_SOL))
 		slog.Debug("Amount in lamports", "lamports", lamports)
 
-		transferIx := system.NewTransferInstruction(
+		transferIx, err := withReferences(system.NewTransferInstruction(
 			lamports,
 			from,
 			to,
-		).Build()
+		).Build(), references)
+		if err != nil {
+			return nil, err
+		}
 
-		return s.buildTransaction(ctx, from, []solana.Instruction{transferIx})
+		return s.buildTransaction(ctx, from, transferInstructions(nil, from, memo, transferIx))
 	}
 
 	// Handle SPL token transfer
@@ -485,10 +486,12 @@ func (s *Service) createTokenTransfer(ctx context.Context, from, to solana.Publi
 		return nil, err
 	}
 
-	// Convert amount to raw units
-	// Use math.Pow to avoid bit shifting issues with large decimals
-	multiplier := math.Pow(10, float64(decimals))
-	rawAmount := uint64(amount * multiplier)
+	// Convert amount to raw units. Going through a decimal keeps e.g. 0.3 from
+	// becoming 299999999 lamports the way amount * 1e9 would.
+	rawAmount, err := money.ToBaseUnits(money.FromFloat(amount), decimals)
+	if err != nil {
+		return nil, fmt.Errorf("invalid transfer amount: %w", err)
+	}
 
 	// Log transfer details before building instruction
 	slog.Info("Building TransferChecked instruction",
@@ -501,7 +504,7 @@ func (s *Service) createTokenTransfer(ctx context.Context, from, to solana.Publi
 		"from", from.String())
 
 	// Build transfer instruction with explicit signer
-	transferIx := token.NewTransferCheckedInstruction(
+	transferIx, err := withReferences(token.NewTransferCheckedInstruction(
 		rawAmount,
 		decimals,
 		fromATA,
@@ -509,10 +512,13 @@ func (s *Service) createTokenTransfer(ctx context.Context, from, to solana.Publi
 		toATA,
 		from,
 		[]solana.PublicKey{}, // No additional signers needed, from is already a required signer
-	).Build()
+	).Build(), references)
+	if err != nil {
+		return nil, err
+	}
 
 	// Combine all instructions
-	instructions := append(createInstructions, transferIx)
+	instructions := transferInstructions(createInstructions, from, memo, transferIx)
 
 	// Build transaction with from as fee payer and signer
 	tx, err := s.buildTransaction(ctx, from, instructions)
@@ -565,7 +571,7 @@ func (s *Service) SubmitTransfer(ctx context.Context, req *TransferRequest) (str
 	maxRetries := uint(3)
 	sig, sendErr := s.chainClient.SendRawTransaction(ctx, txBytes, bmodel.TransactionOptions{
 		SkipPreflight:       false,
-		PreflightCommitment: "confirmed",
+		PreflightCommitment: s.commitment,
 		MaxRetries:          maxRetries,
 	})
 
@@ -578,7 +584,7 @@ func (s *Service) SubmitTransfer(ctx context.Context, req *TransferRequest) (str
 			strings.Contains(errMsg, "BlockhashNotFound") {
 			slog.Warn("Transaction failed due to expired blockhash", "trade_id", trade.ID)
 			// Don't mark trade as failed - this is recoverable by re-preparing
-			return "", fmt.Errorf("BLOCKHASH_EXPIRED: Transaction blockhash has expired. Please try again.")
+			return "", apperrors.ErrTransactionExpired.Wrap(sendErr)
 		}
 
 		// For other errors, mark trade as failed
@@ -588,7 +594,7 @@ func (s *Service) SubmitTransfer(ctx context.Context, req *TransferRequest) (str
 		if updateErr := s.store.Trades().Update(ctx, trade); updateErr != nil {
 			slog.Warn("Failed to update trade status to failed after SendRawTransaction error", "trade_id", trade.ID, "update_error", updateErr)
 		}
-		return "", fmt.Errorf("failed to submit transaction: %w", sendErr) // Return the original sendErr
+		return "", classifySendError(fmt.Errorf("failed to submit transaction: %w", sendErr))
 	}
 
 	// Transaction submitted successfully, update trade status to "submitted"
@@ -607,16 +613,49 @@ func (s *Service) SubmitTransfer(ctx context.Context, req *TransferRequest) (str
 	return string(sig), nil
 }
 
+// classifySendError tags preflight failures the user can act on
+func classifySendError(err error) error {
+	errMsg := err.Error()
+	switch {
+	case strings.Contains(errMsg, "signature verification failure") || strings.Contains(errMsg, "invalid signature"):
+		return apperrors.ErrInvalidSignature.Wrap(err)
+	case strings.Contains(errMsg, "insufficient lamports") || strings.Contains(errMsg, "insufficient funds"):
+		return apperrors.ErrInsufficientBalance.WithMessage("insufficient funds for transfer").Wrap(err)
+	default:
+		return err
+	}
+}
+
+// GetWalletBalances returns the wallet's balances, from the balance cache when
+// one is set and the wallet has no newer transactions
 func (s *Service) GetWalletBalances(ctx context.Context, address string) (*WalletBalance, error) {
+	if s.balances == nil {
+		balance, _, err := s.fetchWalletBalances(ctx, address)
+		return balance, err
+	}
+	cached, generation, ok := s.balances.get(address)
+	if ok {
+		return cached, nil
+	}
+	balance, complete, err := s.fetchWalletBalances(ctx, address)
+	if err == nil && complete {
+		s.balances.set(address, generation, *balance)
+	}
+	return balance, err
+}
+
+// fetchWalletBalances reads the wallet's balances from the chain. complete is
+// false when some token balances could not be read and are missing.
+func (s *Service) fetchWalletBalances(ctx context.Context, address string) (balance *WalletBalance, complete bool, err error) {
 	// Validate address format first
 	pubKey, err := solana.PublicKeyFromBase58(address)
 	if err != nil {
-		return nil, fmt.Errorf("INVALID_ADDRESS: %v", err)
+		return nil, false, apperrors.ErrInvalidAddress.WithMessage("invalid wallet address").Wrap(err)
 	}
 
 	// Check if address is on curve (valid Solana address)
 	if !solana.PublicKey(pubKey).IsOnCurve() {
-		return nil, fmt.Errorf("INVALID_ADDRESS: address is not on curve")
+		return nil, false, apperrors.ErrInvalidAddress.WithMessage("invalid wallet address: address is not on curve")
 	}
 
 	// Get combined SOL balance (native SOL + any wSOL tokens)
@@ -628,86 +667,96 @@ func (s *Service) GetWalletBalances(ctx context.Context, address string) (*Walle
 			// Address is valid but has never been used on-chain
 			return &WalletBalance{
 				Balances: []Balance{}, // Empty balance array for unused address
-			}, nil
+			}, true, nil
+		}
+		return nil, false, apperrors.ErrUpstreamUnavailable.Wrap(fmt.Errorf("failed to get SOL balance: %w", err))
+	}
+
+	// Stake accounts are read while the token balances are
+	stakeResult := make(chan error, 1)
+	var nativeStake *NativeStake
+	go func() {
+		var err error
+		nativeStake, err = s.getNativeStake(ctx, address)
+		stakeResult <- err
+	}()
+	waitForStake := func() bool {
+		if err := <-stakeResult; err != nil {
+			slog.Warn("Failed to get stake accounts, returning balances without them", "address", address, "error", err)
+			return false
 		}
-		return nil, fmt.Errorf("NETWORK_ERROR: failed to get SOL balance: %w", err)
+		return true
 	}
-	solValue := combinedSOLBalance.Amount // Combined SOL amount
 
 	// Get other token balances
-	tokenBalances, err := s.getTokenBalances(ctx, address) // address is string
+	tokenBalances, complete, err := s.getTokenBalances(ctx, address) // address is string
 	if err != nil {
 		// For token balance errors, we can still return SOL balance if we have it
 		slog.Warn("Failed to get token balances, returning SOL balance only", "address", address, "error", err)
-		if solValue > 0 {
-			return &WalletBalance{
-				Balances: []Balance{{
-					ID:     model.NativeSolMint, // Use native SOL representation for user display
-					Amount: solValue,
-				}},
-			}, nil
-		}
+		solBalances := presentSOL(combinedSOLBalance, nil)
+		s.annotateStaking(ctx, solBalances)
+		waitForStake()
 		return &WalletBalance{
-			Balances: []Balance{},
-		}, nil
+			Balances:    solBalances,
+			NativeStake: nativeStake,
+		}, false, nil
 	}
 
-	var allBalances []Balance
-	if solValue > 0 {
-		nativeSolBalance := Balance{ // This is wallet.Balance, not bmodel.Balance
-			ID:     model.NativeSolMint, // Use distinct identifier for native SOL
-			Amount: solValue,
-		}
-		allBalances = append([]Balance{nativeSolBalance}, tokenBalances...)
-	} else {
-		allBalances = tokenBalances
-	}
+	allBalances := presentSOL(combinedSOLBalance, tokenBalances)
+	s.tagSpam(ctx, allBalances)
+	stakedSOL := s.annotateStaking(ctx, allBalances)
+	stakeComplete := waitForStake()
 
 	return &WalletBalance{
-		Balances: allBalances,
-	}, nil
+		Balances:    allBalances,
+		StakedSOL:   stakedSOL,
+		NativeStake: nativeStake,
+	}, complete && stakeComplete, nil
 }
 
-// getTokenBalances is a helper function that gets just the token balances
-func (s *Service) getTokenBalances(ctx context.Context, address string) ([]Balance, error) {
+// getTokenBalances is a helper function that gets just the token balances.
+// complete is false when some token program's accounts could not be read.
+func (s *Service) getTokenBalances(ctx context.Context, address string) (tokens []Balance, complete bool, err error) {
 	// Validate wallet address
 	pubKey, err := solana.PublicKeyFromBase58(address)
 	if err != nil {
-		return nil, fmt.Errorf("invalid wallet address: %v", err)
+		return nil, false, fmt.Errorf("invalid wallet address: %v", err)
 	}
 
-	// Create a timeout context specifically for the GetTokenAccountsByOwner call
+	// Create a timeout context specifically for the GetTokenAccountsByOwner calls
 	// This operation can be resource-intensive for addresses with many token accounts
 	tokenCtx, cancel := context.WithTimeout(ctx, 45*time.Second)
 	defer cancel()
 
-	// Get token accounts using generic client
-	tokenAccounts, err := s.chainClient.GetTokenAccountsByOwner(
-		tokenCtx,
-		bmodel.Address(pubKey.String()),
-		bmodel.TokenAccountsOptions{Encoding: string(solana.EncodingJSONParsed)}, // Using solana specific encoding
-	)
-	if err != nil {
+	// Get SPL Token and Token-2022 accounts
+	tokenAccounts, err := s.GetTokenAccounts(tokenCtx, pubKey.String())
+	complete = err == nil
+	if err != nil && len(tokenAccounts) == 0 {
 		// Check if it's a timeout error and provide a more helpful message
 		if errors.Is(err, context.DeadlineExceeded) || strings.Contains(err.Error(), "context canceled") || strings.Contains(err.Error(), "context deadline exceeded") {
-			return []Balance{}, fmt.Errorf("failed to get token accounts: request timed out after 45 seconds - this address may have too many token accounts")
+			return []Balance{}, false, fmt.Errorf("failed to get token accounts: request timed out after 45 seconds - this address may have too many token accounts")
 		}
-		return []Balance{}, fmt.Errorf("failed to get token accounts: %w", err)
+		return []Balance{}, false, err
+	}
+	if err != nil {
+		slog.Warn("Failed to get some token accounts, returning partial token balances", "address", address, "error", err)
 	}
 
-	tokens := make([]Balance, 0)
+	tokens = make([]Balance, 0)
 	for _, accInfo := range tokenAccounts {
 		if accInfo.UIAmount > 0 { // Filter out zero balance tokens
 			tokens = append(tokens, Balance{ // This is wallet.Balance
-				ID:     string(accInfo.MintAddress),
-				Amount: accInfo.UIAmount,
+				ID:        string(accInfo.MintAddress),
+				Amount:    accInfo.UIAmount,
+				RawAmount: accInfo.Amount,
+				Decimals:  accInfo.Decimals,
 				// Symbol and other details might need to be fetched based on MintAddress
 				// if not already part of a richer bmodel.TokenAccountInfo
 			})
 		}
 	}
 
-	return tokens, nil
+	return tokens, complete, nil
 }
 
 // TokenPnLData represents PnL data for a single token
@@ -722,8 +771,12 @@ type TokenPnLData struct {
 	UnrealizedPnL   float64
 	PnLPercentage   float64
 	HasPurchaseData bool
+	Category        string // One of the Category constants
 }
 
+// dustAmount is the size below which holdings and PnL are treated as rounding noise
+var dustAmount = money.FromFloat(0.00000001)
+
 // GetPortfolioPnL calculates profit and loss for a wallet
 func (s *Service) GetPortfolioPnL(ctx context.Context, walletAddress string) (totalValue float64, totalCostBasis float64, totalUnrealizedPnL float64, totalPnLPercentage float64, totalHoldings int32, tokenPnLs []TokenPnLData, err error) {
 	// Note: We calculate PnL based only on trades in our database
@@ -754,13 +807,14 @@ func (s *Service) GetPortfolioPnL(ctx context.Context, walletAddress string) (to
 	}
 
 	// Build holdings based on our trade records (not wallet balances)
-	// This ensures we only calculate PnL for amounts we can track
-	holdings := make(map[string]float64)
+	// This ensures we only calculate PnL for amounts we can track.
+	// Sums stay in decimals so many small trades don't drift the cost basis.
+	holdings := make(map[string]money.Decimal)
 
 	// Calculate cost basis for each token from trade history
 	costBasisMap := make(map[string]struct {
-		totalCost   float64
-		totalAmount float64
+		totalCost   money.Decimal
+		totalAmount money.Decimal
 	})
 
 	for _, trade := range trades {
@@ -792,12 +846,12 @@ func (s *Service) GetPortfolioPnL(ctx context.Context, walletAddress string) (to
 				amountReceived = trade.OutputAmount
 			}
 
-			data.totalCost += costInUSD
-			data.totalAmount += amountReceived
+			data.totalCost = data.totalCost.Add(money.FromFloat(costInUSD))
+			data.totalAmount = data.totalAmount.Add(money.FromFloat(amountReceived))
 			costBasisMap[tokenID] = data
 
 			// Track holdings based on trades
-			holdings[tokenID] += amountReceived
+			holdings[tokenID] = holdings[tokenID].Add(money.FromFloat(amountReceived))
 			slog.Debug("Added to holdings from trade",
 				"token", tokenID,
 				"amount", amountReceived,
@@ -809,7 +863,7 @@ func (s *Service) GetPortfolioPnL(ctx context.Context, walletAddress string) (to
 		// For swaps, also track what we spent
 		if trade.Type == "swap" && trade.FromCoinMintAddress != "" {
 			// Use the actual input amount (what we spent)
-			holdings[trade.FromCoinMintAddress] -= trade.Amount
+			holdings[trade.FromCoinMintAddress] = holdings[trade.FromCoinMintAddress].Sub(money.FromFloat(trade.Amount))
 			slog.Debug("Subtracted from holdings for swap",
 				"token", trade.FromCoinMintAddress,
 				"amount", trade.Amount,
@@ -827,8 +881,8 @@ func (s *Service) GetPortfolioPnL(ctx context.Context, walletAddress string) (to
 
 	// Get current prices for all held tokens
 	var tokenPnLList []TokenPnLData
-	totalPortfolioValue := 0.0
-	totalPortfolioCostBasis := 0.0
+	tradedPortfolioValue := money.Zero
+	tradedPortfolioCostBasis := money.Zero
 
 	slog.Info("Portfolio holdings from trades",
 		"wallet", walletAddress,
@@ -839,7 +893,7 @@ func (s *Service) GetPortfolioPnL(ctx context.Context, walletAddress string) (to
 	var coinIDsToFetch []string
 	for coinID, amount := range holdings {
 		// Skip if no balance or very small (rounding errors)
-		if amount <= 0.00000001 {
+		if amount.LessThanOrEqual(dustAmount) {
 			continue
 		}
 
@@ -865,7 +919,7 @@ func (s *Service) GetPortfolioPnL(ctx context.Context, walletAddress string) (to
 	// Process holdings with fetched coin data
 	for coinID, amount := range holdings {
 		// Skip if no balance or very small (rounding errors)
-		if amount <= 0.00000001 {
+		if amount.LessThanOrEqual(dustAmount) {
 			continue
 		}
 
@@ -890,13 +944,13 @@ func (s *Service) GetPortfolioPnL(ctx context.Context, walletAddress string) (to
 		}
 
 		// Calculate cost basis and historical cost
-		costBasis := 0.0
-		proportionalCost := 0.0
+		costBasis := money.Zero
+		proportionalCost := money.Zero
 		hasPurchaseData := false
-		if data, exists := costBasisMap[coinID]; exists && data.totalAmount > 0 {
-			costBasis = data.totalCost / data.totalAmount // Cost per token for display
+		if data, exists := costBasisMap[coinID]; exists && data.totalAmount.IsPositive() {
+			costBasis = data.totalCost.Div(data.totalAmount) // Cost per token for display
 			// Calculate proportional historical cost based on current holdings
-			proportionalCost = (actualBalance / data.totalAmount) * data.totalCost
+			proportionalCost = money.FromFloat(actualBalance).Mul(data.totalCost).Div(data.totalAmount)
 			hasPurchaseData = true
 		}
 
@@ -909,25 +963,21 @@ func (s *Service) GetPortfolioPnL(ctx context.Context, walletAddress string) (to
 		displayAmount := actualBalance
 
 		// Calculate PnL using proportional historical cost vs current value
-		currentValue := displayAmount * currentPrice
-		unrealizedPnL := currentValue - proportionalCost
+		currentValue := money.FromFloat(displayAmount).Mul(money.FromFloat(currentPrice))
+		unrealizedPnL := currentValue.Sub(proportionalCost).Round(8) // Round to 8 decimal places
 
-		// Round to avoid floating point precision issues
-		unrealizedPnL = math.Round(unrealizedPnL*1e8) / 1e8 // Round to 8 decimal places
-
-		pnlPercentage := 0.0
-		if proportionalCost > 0 && math.Abs(unrealizedPnL) > 0.00000001 { // Ignore tiny differences
-			pnlPercentage = unrealizedPnL / proportionalCost        // Keep as decimal (0.2534 for 25.34%)
-			pnlPercentage = math.Round(pnlPercentage*10000) / 10000 // Round to 4 decimal places
+		pnlPercentage := money.Zero
+		if proportionalCost.IsPositive() && unrealizedPnL.Abs().GreaterThan(dustAmount) { // Ignore tiny differences
+			pnlPercentage = unrealizedPnL.Div(proportionalCost).Round(4) // Keep as decimal (0.2534 for 25.34%), 4 decimal places
 		}
 
 		// Add to totals
-		totalPortfolioValue += currentValue
+		tradedPortfolioValue = tradedPortfolioValue.Add(currentValue)
 		if hasPurchaseData {
-			totalPortfolioCostBasis += proportionalCost // Use proportional cost, not recalculated cost
+			tradedPortfolioCostBasis = tradedPortfolioCostBasis.Add(proportionalCost) // Use proportional cost, not recalculated cost
 		} else {
 			// For tokens without purchase data, use current value as cost basis
-			totalPortfolioCostBasis += currentValue
+			tradedPortfolioCostBasis = tradedPortfolioCostBasis.Add(currentValue)
 		}
 
 		// Create token PnL data
@@ -936,12 +986,13 @@ func (s *Service) GetPortfolioPnL(ctx context.Context, walletAddress string) (to
 			Symbol:          coin.Symbol,
 			Name:            coin.Name,
 			AmountHeld:      displayAmount, // Use actual wallet balance
-			CostBasis:       costBasis,
+			CostBasis:       money.Float(costBasis),
 			CurrentPrice:    currentPrice,
-			CurrentValue:    currentValue,
-			UnrealizedPnL:   unrealizedPnL,
-			PnLPercentage:   pnlPercentage,
+			CurrentValue:    money.Float(currentValue),
+			UnrealizedPnL:   money.Float(unrealizedPnL),
+			PnLPercentage:   money.Float(pnlPercentage),
 			HasPurchaseData: hasPurchaseData,
+			Category:        Category(coinID),
 		}
 
 		tokenPnLList = append(tokenPnLList, tokenPnL)
@@ -949,7 +1000,7 @@ func (s *Service) GetPortfolioPnL(ctx context.Context, walletAddress string) (to
 
 	// Now calculate the ACTUAL total portfolio value from ALL wallet balances
 	// This includes tokens we may not have trade history for
-	actualTotalPortfolioValue := 0.0
+	actualTotalPortfolioValue := money.Zero
 
 	// Collect all wallet balance coin IDs that need price data
 	var walletCoinIDs []string
@@ -958,6 +1009,14 @@ func (s *Service) GetPortfolioPnL(ctx context.Context, walletAddress string) (to
 			walletCoinIDs = append(walletCoinIDs, balance.ID)
 		}
 	}
+	// SOL in stake accounts counts towards the portfolio too
+	stakedSOL := 0.0
+	if walletBalances.NativeStake != nil {
+		stakedSOL = walletBalances.NativeStake.TotalSOL
+	}
+	if stakedSOL > 0 && !slices.Contains(walletCoinIDs, model.NativeSolMint) {
+		walletCoinIDs = append(walletCoinIDs, model.NativeSolMint)
+	}
 
 	// Optimized batch fetch prices for all wallet balances using same strategy
 	var walletCoinDataMap map[string]*model.Coin
@@ -981,29 +1040,26 @@ func (s *Service) GetPortfolioPnL(ctx context.Context, walletAddress string) (to
 		}
 
 		if coin.Price > 0 {
-			currentValue := balance.Amount * coin.Price
-			actualTotalPortfolioValue += currentValue
+			currentValue := money.FromFloat(balance.Amount).Mul(money.FromFloat(coin.Price))
+			actualTotalPortfolioValue = actualTotalPortfolioValue.Add(currentValue)
 		}
 	}
+	if sol := walletCoinDataMap[model.NativeSolMint]; stakedSOL > 0 && sol != nil && sol.Price > 0 {
+		actualTotalPortfolioValue = actualTotalPortfolioValue.Add(money.FromFloat(stakedSOL).Mul(money.FromFloat(sol.Price)))
+	}
 
 	// Calculate overall portfolio metrics
 	// IMPORTANT: PnL calculations are based ONLY on tokens with trade history
-	// totalPortfolioValue from traded tokens was already calculated in the loop above
-	totalTradedPortfolioValue := totalPortfolioValue // Save the traded portfolio value for PnL calculation
-
-	totalUnrealizedPnL = totalTradedPortfolioValue - totalPortfolioCostBasis
-	totalUnrealizedPnL = math.Round(totalUnrealizedPnL*1e8) / 1e8 // Round to 8 decimal places
+	// tradedPortfolioValue from traded tokens was already calculated in the loop above
+	unrealized := tradedPortfolioValue.Sub(tradedPortfolioCostBasis).Round(8) // Round to 8 decimal places
 
-	totalPnLPercentage = 0.0
-	if totalPortfolioCostBasis > 0 && math.Abs(totalUnrealizedPnL) > 0.00000001 {
-		totalPnLPercentage = totalUnrealizedPnL / totalPortfolioCostBasis // Keep as decimal
-		totalPnLPercentage = math.Round(totalPnLPercentage*10000) / 10000 // Round to 4 decimal places
+	percentage := money.Zero
+	if tradedPortfolioCostBasis.IsPositive() && unrealized.Abs().GreaterThan(dustAmount) {
+		percentage = unrealized.Div(tradedPortfolioCostBasis).Round(4) // Keep as decimal, 4 decimal places
 	}
 
 	// Now use the actual portfolio value from ALL wallet balances for the total
-	totalPortfolioValue = actualTotalPortfolioValue
-
-	return totalPortfolioValue, totalPortfolioCostBasis, totalUnrealizedPnL, totalPnLPercentage, int32(len(tokenPnLList)), tokenPnLList, nil
+	return money.Float(actualTotalPortfolioValue), money.Float(tradedPortfolioCostBasis), money.Float(unrealized), money.Float(percentage), int32(len(tokenPnLList)), tokenPnLList, nil
 }
 
 // getOptimizedCoinDataForPnL efficiently fetches coin data for PnL calculations
@@ -1074,8 +1130,8 @@ func (s *Service) getOptimizedCoinDataForPnL(ctx context.Context, coinIDs []stri
 	if len(addressesToUpdate) > 0 {
 		slog.InfoContext(ctx, "Updating stale prices for PnL calculation", "addresses_to_update", len(addressesToUpdate))
 
-		// Get fresh prices from price service
-		freshPrices, err := s.priceService.GetCoinPrices(ctx, addressesToUpdate)
+		// Get fresh prices from price service in a single batch call
+		freshPrices, err := s.priceService.GetPricesBatch(ctx, addressesToUpdate)
 		if err != nil {
 			slog.WarnContext(ctx, "Failed to get fresh prices for PnL, using stale data", "error", err)
 		} else {
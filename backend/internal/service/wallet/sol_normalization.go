@@ -9,6 +9,7 @@ import (
 	bclient "github.com/nicolas-martin/dankfolio/backend/internal/clients"
 	"github.com/nicolas-martin/dankfolio/backend/internal/model"
 	bmodel "github.com/nicolas-martin/dankfolio/backend/internal/model/blockchain"
+	"github.com/nicolas-martin/dankfolio/backend/internal/util/money"
 )
 
 // SOLNormalizer handles the complexity of native SOL vs wSOL for balance operations
@@ -27,29 +28,35 @@ func NewSOLNormalizer(chainClient bclient.GenericClientAPI) *SOLNormalizer {
 func (n *SOLNormalizer) GetCombinedSOLBalance(ctx context.Context, address string) (*Balance, error) {
 	var totalUIAmount float64
 	var totalRawAmount uint64
+	breakdown := &SOLBreakdown{NativeRawAmount: "0", WrappedRawAmount: "0"}
 
 	slog.Debug("Getting combined SOL balance", "address", address)
 
 	// 1. Get native SOL balance
-	nativeBalance, err := n.chainClient.GetBalance(ctx, bmodel.Address(address), "confirmed")
+	nativeBalance, err := n.chainClient.GetBalance(ctx, bmodel.Address(address), bmodel.DefaultCommitment)
 	if err != nil {
 		slog.Warn("Failed to get native SOL balance", "address", address, "error", err)
 		// Don't fail entirely - wSOL might still exist
 	} else {
 		totalUIAmount += nativeBalance.UIAmount
+		breakdown.NativeAmount = nativeBalance.UIAmount
 		if rawAmount, parseErr := parseRawAmount(nativeBalance.Amount); parseErr == nil {
 			totalRawAmount += rawAmount
+			breakdown.NativeRawAmount = strconv.FormatUint(rawAmount, 10)
 		}
 		slog.Debug("Native SOL balance", "ui_amount", nativeBalance.UIAmount, "raw_amount", nativeBalance.Amount)
 	}
 
 	// 2. Get wSOL token balance (if any wSOL ATA exists)
-	wsolBalance, err := n.getWSOLTokenBalance(ctx, address)
+	wsolBalance, wsolRawAmount, err := n.getWSOLTokenBalance(ctx, address)
 	if err != nil {
 		slog.Debug("No wSOL token account found", "address", address)
 		// This is normal - many wallets don't have wSOL ATAs
 	} else {
 		totalUIAmount += wsolBalance
+		totalRawAmount += wsolRawAmount
+		breakdown.WrappedAmount = wsolBalance
+		breakdown.WrappedRawAmount = strconv.FormatUint(wsolRawAmount, 10)
 		slog.Debug("wSOL token balance", "ui_amount", wsolBalance)
 	}
 
@@ -66,13 +73,17 @@ func (n *SOLNormalizer) GetCombinedSOLBalance(ctx context.Context, address strin
 		}())
 
 	return &Balance{
-		ID:     model.NativeSolMint, // Always represent as native SOL to the user
-		Amount: totalUIAmount,
+		ID:           model.NativeSolMint, // Always represent as native SOL to the user
+		Amount:       totalUIAmount,
+		RawAmount:    strconv.FormatUint(totalRawAmount, 10),
+		Decimals:     money.SOLDecimals,
+		SOLBreakdown: breakdown,
 	}, nil
 }
 
-// getWSOLTokenBalance checks if the wallet has any wSOL token accounts
-func (n *SOLNormalizer) getWSOLTokenBalance(ctx context.Context, address string) (float64, error) {
+// getWSOLTokenBalance checks if the wallet has any wSOL token accounts, returning
+// the UI amount and the exact amount in lamports
+func (n *SOLNormalizer) getWSOLTokenBalance(ctx context.Context, address string) (float64, uint64, error) {
 	// Get all token accounts for this address filtered by wSOL mint
 	opts := bmodel.TokenAccountsOptions{
 		Encoding: "jsonParsed",
@@ -81,21 +92,25 @@ func (n *SOLNormalizer) getWSOLTokenBalance(ctx context.Context, address string)
 	// For now, get all token accounts and filter for wSOL
 	accounts, err := n.chainClient.GetTokenAccountsByOwner(ctx, bmodel.Address(address), opts)
 	if err != nil {
-		return 0, fmt.Errorf("failed to get wSOL token accounts: %w", err)
+		return 0, 0, fmt.Errorf("failed to get wSOL token accounts: %w", err)
 	}
 
 	var totalBalance float64
+	var totalRaw uint64
 	for _, account := range accounts {
 		// Only include wSOL accounts
 		if string(account.MintAddress) == model.SolMint {
 			totalBalance += account.UIAmount
+			if raw, parseErr := parseRawAmount(account.Amount); parseErr == nil {
+				totalRaw += raw
+			}
 			slog.Debug("Found wSOL token account", 
 				"account", account.MintAddress,
 				"balance", account.UIAmount)
 		}
 	}
 
-	return totalBalance, nil
+	return totalBalance, totalRaw, nil
 }
 
 // parseRawAmount safely parses the raw amount string to uint64
@@ -1,9 +1,16 @@
 package wallet
 
 import (
+	"context"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	clientsmocks "github.com/nicolas-martin/dankfolio/backend/internal/clients/mocks"
 	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	bmodel "github.com/nicolas-martin/dankfolio/backend/internal/model/blockchain"
 )
 
 func TestSOLNormalizationFunctions(t *testing.T) {
@@ -128,4 +135,26 @@ func TestSOLMintConstants(t *testing.T) {
 	if model.NativeSolMint == model.SolMint {
 		t.Error("NativeSolMint and SolMint should be different")
 	}
-}
\ No newline at end of file
+}
+
+func TestGetCombinedSOLBalance_KeepsLamportPrecision(t *testing.T) {
+	const address = "4Nd1mBQtrMJVYVfKf2PJy9NZUZdTAsp7D4xWLs4gDB4T"
+	chainClient := clientsmocks.NewMockGenericClientAPI(t)
+	// 0.1 + 0.2 is not exactly 0.3 as a float; the raw sum must still be exact
+	chainClient.EXPECT().GetBalance(mock.Anything, bmodel.Address(address), "confirmed").
+		Return(&bmodel.Balance{Amount: "100000001", Decimals: 9, UIAmount: 0.100000001}, nil)
+	chainClient.EXPECT().GetTokenAccountsByOwner(mock.Anything, bmodel.Address(address), mock.Anything).
+		Return([]*bmodel.TokenAccountInfo{
+			{MintAddress: model.SolMint, Amount: "200000002", Decimals: 9, UIAmount: 0.200000002},
+			{MintAddress: "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v", Amount: "5", Decimals: 6, UIAmount: 0.000005},
+		}, nil)
+
+	balance, err := NewSOLNormalizer(chainClient).GetCombinedSOLBalance(context.Background(), address)
+	require.NoError(t, err)
+	assert.Equal(t, model.NativeSolMint, balance.ID)
+	assert.Equal(t, "300000003", balance.RawAmount)
+	assert.Equal(t, uint8(9), balance.Decimals)
+	assert.Equal(t, &SOLBreakdown{
+		NativeAmount: 0.100000001, NativeRawAmount: "100000001", WrappedAmount: 0.200000002, WrappedRawAmount: "200000002",
+	}, balance.SOLBreakdown)
+}
@@ -0,0 +1,35 @@
+package wallet
+
+import (
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+// ActionUnwrap suggests closing the wallet's wrapped SOL accounts so the SOL
+// in them becomes native again
+const ActionUnwrap = "unwrap"
+
+// minUnwrapLamports is the least wrapped SOL worth suggesting an unwrap for;
+// smaller amounts are dust left over by swaps
+const minUnwrapLamports = 1_000_000 // 0.001 SOL
+
+// presentSOL shows native and wrapped SOL as the one SOL balance users expect.
+// sol is the combined balance from the SOLNormalizer, so the wrapped SOL rows
+// among tokens are dropped rather than counted twice. The SOL balance comes
+// first and is omitted when empty.
+func presentSOL(sol *Balance, tokens []Balance) []Balance {
+	balances := make([]Balance, 0, len(tokens)+1)
+	if sol.Amount > 0 {
+		if breakdown := sol.SOLBreakdown; breakdown != nil {
+			if wrapped, err := parseRawAmount(breakdown.WrappedRawAmount); err == nil && wrapped >= minUnwrapLamports {
+				sol.SuggestedActions = append(sol.SuggestedActions, ActionUnwrap)
+			}
+		}
+		balances = append(balances, *sol)
+	}
+	for _, token := range tokens {
+		if token.ID != model.SolMint {
+			balances = append(balances, token)
+		}
+	}
+	return balances
+}
@@ -0,0 +1,39 @@
+package wallet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+func TestPresentSOL(t *testing.T) {
+	usdc := Balance{ID: "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v", Amount: 5}
+	wsol := Balance{ID: model.SolMint, Amount: 0.5, RawAmount: "500000000"}
+
+	t.Run("wrapped SOL is folded into the SOL row", func(t *testing.T) {
+		sol := &Balance{ID: model.NativeSolMint, Amount: 1.5, RawAmount: "1500000000", SOLBreakdown: &SOLBreakdown{
+			NativeAmount: 1, NativeRawAmount: "1000000000", WrappedAmount: 0.5, WrappedRawAmount: "500000000",
+		}}
+		balances := presentSOL(sol, []Balance{wsol, usdc})
+		assert.Len(t, balances, 2)
+		assert.Equal(t, model.NativeSolMint, balances[0].ID)
+		assert.Equal(t, []string{ActionUnwrap}, balances[0].SuggestedActions)
+		assert.Equal(t, usdc, balances[1])
+	})
+
+	t.Run("wrapped dust is not worth an unwrap", func(t *testing.T) {
+		sol := &Balance{ID: model.NativeSolMint, Amount: 1.0000001, SOLBreakdown: &SOLBreakdown{
+			NativeRawAmount: "1000000000", WrappedRawAmount: "100",
+		}}
+		balances := presentSOL(sol, nil)
+		assert.Len(t, balances, 1)
+		assert.Empty(t, balances[0].SuggestedActions)
+	})
+
+	t.Run("empty SOL is omitted", func(t *testing.T) {
+		balances := presentSOL(&Balance{ID: model.NativeSolMint}, []Balance{usdc})
+		assert.Equal(t, []Balance{usdc}, balances)
+	})
+}
@@ -0,0 +1,273 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+// Reasons a balance is classified as spam
+const (
+	SpamReasonDenyList      = "deny_list"
+	SpamReasonNaughtyWord   = "naughty_word"
+	SpamReasonZeroLiquidity = "zero_liquidity"
+	SpamReasonUnverified    = "unverified_metadata"
+	SpamReasonImpersonator  = "impersonator"
+)
+
+// DefaultSpamListRefreshInterval is how often the deny/allow list is reloaded from the database.
+const DefaultSpamListRefreshInterval = time.Minute
+
+// ErrInvalidSpamToken is returned when a spam list entry fails validation.
+var ErrInvalidSpamToken = errors.New("invalid spam token")
+
+// verifiedTags mark coins whose metadata has been vetted upstream
+var verifiedTags = []string{"verified", "strict", "native", "lst"}
+
+// SpamClassifier flags airdropped scam tokens in wallet balances. Admin deny and
+// allow entries win; otherwise a token is spam when its name or symbol contains a
+// naughty word, when it has no liquidity and no verified metadata, or when its
+// unverified icon copies a verified coin's.
+type SpamClassifier struct {
+	store               db.Store
+	containsNaughtyWord func(text string) bool
+	refreshInterval     time.Duration
+	similarIcons        func(mint string) []string // Optional
+
+	mu   sync.RWMutex
+	list map[string]model.SpamToken
+}
+
+// NewSpamClassifier creates a SpamClassifier. containsNaughtyWord may be nil to skip
+// the naughty-word check; a refreshInterval <= 0 uses DefaultSpamListRefreshInterval.
+func NewSpamClassifier(store db.Store, containsNaughtyWord func(text string) bool, refreshInterval time.Duration) *SpamClassifier {
+	if refreshInterval <= 0 {
+		refreshInterval = DefaultSpamListRefreshInterval
+	}
+	return &SpamClassifier{
+		store:               store,
+		containsNaughtyWord: containsNaughtyWord,
+		refreshInterval:     refreshInterval,
+		list:                make(map[string]model.SpamToken),
+	}
+}
+
+// Load replaces the cached deny/allow list with the current database contents.
+func (c *SpamClassifier) Load(ctx context.Context) error {
+	rows, _, err := c.store.SpamTokens().List(ctx, db.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to load spam list: %w", err)
+	}
+	list := make(map[string]model.SpamToken, len(rows))
+	for _, row := range rows {
+		list[row.ID] = row
+	}
+	c.mu.Lock()
+	c.list = list
+	c.mu.Unlock()
+	return nil
+}
+
+// Watch reloads the deny/allow list every refresh interval until ctx is cancelled.
+func (c *SpamClassifier) Watch(ctx context.Context) {
+	ticker := time.NewTicker(c.refreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := c.Load(ctx); err != nil && ctx.Err() == nil {
+				slog.WarnContext(ctx, "Failed to refresh spam list", slog.Any("error", err))
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// List returns the cached deny/allow entries sorted by mint.
+func (c *SpamClassifier) List() []model.SpamToken {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	tokens := make([]model.SpamToken, 0, len(c.list))
+	for _, t := range c.list {
+		tokens = append(tokens, t)
+	}
+	sort.Slice(tokens, func(i, j int) bool { return tokens[i].ID < tokens[j].ID })
+	return tokens
+}
+
+// Save validates and stores a deny/allow entry, then refreshes the cache.
+func (c *SpamClassifier) Save(ctx context.Context, token model.SpamToken) (*model.SpamToken, error) {
+	if _, err := solana.PublicKeyFromBase58(token.ID); err != nil {
+		return nil, fmt.Errorf("%w: invalid mint address %q", ErrInvalidSpamToken, token.ID)
+	}
+	if token.Verdict != model.SpamVerdictDeny && token.Verdict != model.SpamVerdictAllow {
+		return nil, fmt.Errorf("%w: verdict must be %q or %q", ErrInvalidSpamToken, model.SpamVerdictDeny, model.SpamVerdictAllow)
+	}
+	if _, err := c.store.SpamTokens().Upsert(ctx, &token); err != nil {
+		return nil, fmt.Errorf("failed to save spam token %s: %w", token.ID, err)
+	}
+	if err := c.Load(ctx); err != nil {
+		return nil, err
+	}
+	c.mu.RLock()
+	saved := c.list[token.ID]
+	c.mu.RUnlock()
+	return &saved, nil
+}
+
+// Delete removes a deny/allow entry so the mint falls back to the heuristics.
+func (c *SpamClassifier) Delete(ctx context.Context, mint string) error {
+	if err := c.store.SpamTokens().HardDelete(ctx, mint); err != nil {
+		return fmt.Errorf("failed to delete spam token %s: %w", mint, err)
+	}
+	return c.Load(ctx)
+}
+
+// Classify returns the spam reasons for each mint judged to be spam. Mints missing
+// from the result are not spam. Coin data is read from the database only, so
+// classifying a wallet never triggers upstream metadata fetches.
+func (c *SpamClassifier) Classify(ctx context.Context, mints []string) (map[string][]string, error) {
+	spam := make(map[string][]string)
+	unlisted := make([]string, 0, len(mints))
+
+	c.mu.RLock()
+	for _, mint := range mints {
+		if mint == model.NativeSolMint || mint == model.SolMint {
+			continue
+		}
+		entry, listed := c.list[mint]
+		switch {
+		case !listed:
+			unlisted = append(unlisted, mint)
+		case entry.Verdict == model.SpamVerdictDeny:
+			spam[mint] = []string{SpamReasonDenyList}
+		}
+	}
+	c.mu.RUnlock()
+
+	if len(unlisted) == 0 {
+		return spam, nil
+	}
+
+	coins, err := c.store.Coins().GetByAddresses(ctx, unlisted)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get coins for spam classification: %w", err)
+	}
+	coinsByMint := make(map[string]*model.Coin, len(coins))
+	for i := range coins {
+		coinsByMint[coins[i].Address] = &coins[i]
+	}
+
+	var suspects []string
+	for _, mint := range unlisted {
+		if reasons := c.heuristics(coinsByMint[mint]); len(reasons) > 0 {
+			spam[mint] = reasons
+		} else if !isVerified(coinsByMint[mint]) {
+			suspects = append(suspects, mint)
+		}
+	}
+	for _, mint := range c.impersonators(ctx, suspects) {
+		spam[mint] = []string{SpamReasonImpersonator}
+	}
+	return spam, nil
+}
+
+// SetSimilarIcons enables impersonator detection. similar returns the mints whose
+// icon looks like the given mint's.
+func (c *SpamClassifier) SetSimilarIcons(similar func(mint string) []string) {
+	c.similarIcons = similar
+}
+
+// impersonators returns the mints whose icon looks like a verified coin's. Lookup
+// failures are logged and flag nothing.
+func (c *SpamClassifier) impersonators(ctx context.Context, mints []string) []string {
+	if c.similarIcons == nil || len(mints) == 0 {
+		return nil
+	}
+	lookalikes := make(map[string][]string)
+	var candidates []string
+	for _, mint := range mints {
+		if similar := c.similarIcons(mint); len(similar) > 0 {
+			lookalikes[mint] = similar
+			candidates = append(candidates, similar...)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+	coins, err := c.store.Coins().GetByAddresses(ctx, candidates)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to get lookalike coins for impersonator check", "error", err)
+		return nil
+	}
+	verified := make(map[string]bool, len(coins))
+	for i := range coins {
+		verified[coins[i].Address] = isVerified(&coins[i])
+	}
+	var flagged []string
+	for _, mint := range mints {
+		if slices.ContainsFunc(lookalikes[mint], func(other string) bool { return verified[other] }) {
+			flagged = append(flagged, mint)
+		}
+	}
+	return flagged
+}
+
+// heuristics returns the spam reasons for a coin. A mint missing from the database
+// counts as unverified with no liquidity.
+func (c *SpamClassifier) heuristics(coin *model.Coin) []string {
+	if coin == nil {
+		return []string{SpamReasonZeroLiquidity, SpamReasonUnverified}
+	}
+	if c.containsNaughtyWord != nil && (c.containsNaughtyWord(coin.Name) || c.containsNaughtyWord(coin.Symbol)) {
+		return []string{SpamReasonNaughtyWord}
+	}
+	if coin.Liquidity <= 0 && !isVerified(coin) {
+		return []string{SpamReasonZeroLiquidity, SpamReasonUnverified}
+	}
+	return nil
+}
+
+// isVerified reports whether a coin carries a verified tag
+func isVerified(coin *model.Coin) bool {
+	return coin != nil && slices.ContainsFunc(coin.Tags, func(tag string) bool { return slices.Contains(verifiedTags, tag) })
+}
+
+// SetSpamClassifier enables spam tagging of wallet balances
+func (s *Service) SetSpamClassifier(classifier *SpamClassifier) {
+	s.spamClassifier = classifier
+}
+
+// tagSpam marks balances the spam classifier flags. Classification failures are
+// logged and leave balances untagged rather than failing the balance lookup.
+func (s *Service) tagSpam(ctx context.Context, balances []Balance) {
+	if s.spamClassifier == nil || len(balances) == 0 {
+		return
+	}
+	mints := make([]string, 0, len(balances))
+	for _, b := range balances {
+		mints = append(mints, b.ID)
+	}
+	spam, err := s.spamClassifier.Classify(ctx, mints)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to classify spam balances", "error", err)
+		return
+	}
+	for i := range balances {
+		if reasons, ok := spam[balances[i].ID]; ok {
+			balances[i].IsSpam = true
+			balances[i].SpamReasons = reasons
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package wallet
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	dbmocks "github.com/nicolas-martin/dankfolio/backend/internal/db/mocks"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+func TestSpamClassifier_Classify(t *testing.T) {
+	ctx := context.Background()
+
+	spamTokens := dbmocks.NewMockRepository[model.SpamToken](t)
+	spamTokens.EXPECT().List(mock.Anything, db.ListOptions{}).Return([]model.SpamToken{
+		{ID: "denied", Verdict: model.SpamVerdictDeny},
+		{ID: "allowed", Verdict: model.SpamVerdictAllow},
+	}, int32(2), nil)
+
+	coins := dbmocks.NewMockRepository[model.Coin](t)
+	coins.EXPECT().GetByAddresses(mock.Anything, []string{"rude", "dust", "verified", "liquid", "unknown"}).Return([]model.Coin{
+		{Address: "rude", Name: "Free Scam Airdrop", Liquidity: 1000},
+		{Address: "dust", Name: "Dust"},
+		{Address: "verified", Name: "Verified", Tags: []string{"verified"}},
+		{Address: "liquid", Name: "Liquid", Liquidity: 5000},
+	}, nil)
+
+	store := dbmocks.NewMockStore(t)
+	store.EXPECT().SpamTokens().Return(spamTokens)
+	store.EXPECT().Coins().Return(coins)
+
+	naughty := func(text string) bool { return strings.Contains(strings.ToLower(text), "scam") }
+	classifier := NewSpamClassifier(store, naughty, 0)
+	require.NoError(t, classifier.Load(ctx))
+
+	spam, err := classifier.Classify(ctx, []string{
+		model.NativeSolMint, "denied", "allowed", "rude", "dust", "verified", "liquid", "unknown",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string][]string{
+		"denied":  {SpamReasonDenyList},
+		"rude":    {SpamReasonNaughtyWord},
+		"dust":    {SpamReasonZeroLiquidity, SpamReasonUnverified},
+		"unknown": {SpamReasonZeroLiquidity, SpamReasonUnverified},
+	}, spam)
+}
+
+func TestSpamClassifier_FlagsIconImpersonators(t *testing.T) {
+	ctx := context.Background()
+
+	coins := dbmocks.NewMockRepository[model.Coin](t)
+	coins.EXPECT().GetByAddresses(mock.Anything, []string{"copycat", "fanart"}).Return([]model.Coin{
+		{Address: "copycat", Name: "Bonk", Liquidity: 100},
+		{Address: "fanart", Name: "Bonk Fan", Liquidity: 100},
+	}, nil)
+	coins.EXPECT().GetByAddresses(mock.Anything, []string{"bonk", "otherfan"}).Return([]model.Coin{
+		{Address: "bonk", Name: "Bonk", Tags: []string{"verified"}},
+		{Address: "otherfan", Name: "Other Fan", Liquidity: 100},
+	}, nil)
+	store := dbmocks.NewMockStore(t)
+	store.EXPECT().Coins().Return(coins)
+
+	classifier := NewSpamClassifier(store, nil, 0)
+	classifier.SetSimilarIcons(func(mint string) []string {
+		return map[string][]string{"copycat": {"bonk"}, "fanart": {"otherfan"}}[mint]
+	})
+
+	spam, err := classifier.Classify(ctx, []string{"copycat", "fanart"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{"copycat": {SpamReasonImpersonator}}, spam)
+}
@@ -0,0 +1,54 @@
+package wallet
+
+import (
+	"context"
+	"fmt"
+
+	bmodel "github.com/nicolas-martin/dankfolio/backend/internal/model/blockchain"
+	"github.com/nicolas-martin/dankfolio/backend/internal/util/money"
+)
+
+// getNativeStake reads the wallet's stake accounts, returning nil when it has none
+func (s *Service) getNativeStake(ctx context.Context, address string) (*NativeStake, error) {
+	accounts, err := s.chainClient.GetStakeAccounts(ctx, bmodel.Address(address), s.commitment)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stake accounts: %w", err)
+	}
+	if len(accounts) == 0 {
+		return nil, nil
+	}
+
+	var total, active, activating, rewards money.Decimal
+	stake := &NativeStake{Accounts: make([]StakeAccount, 0, len(accounts))}
+	for _, account := range accounts {
+		amount := lamportsToSOL(account.Lamports)
+		delegated := lamportsToSOL(account.DelegatedStake)
+		reward := lamportsToSOL(account.LastReward)
+		stake.Accounts = append(stake.Accounts, StakeAccount{
+			Address:       string(account.Address),
+			Amount:        money.Float(amount),
+			DelegatedSOL:  money.Float(delegated),
+			Validator:     string(account.Voter),
+			Status:        account.Status,
+			LastRewardSOL: money.Float(reward),
+		})
+
+		total = total.Add(amount)
+		rewards = rewards.Add(reward)
+		switch account.Status {
+		case bmodel.StakeActive:
+			active = active.Add(delegated)
+		case bmodel.StakeActivating:
+			activating = activating.Add(delegated)
+		}
+	}
+	stake.TotalSOL = money.Float(total)
+	stake.ActiveSOL = money.Float(active)
+	stake.ActivatingSOL = money.Float(activating)
+	stake.LastEpochRewardsSOL = money.Float(rewards)
+	return stake, nil
+}
+
+func lamportsToSOL(lamports uint64) money.Decimal {
+	return money.FromBaseUnits(lamports, money.SOLDecimals)
+}
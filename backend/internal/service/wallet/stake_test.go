@@ -0,0 +1,46 @@
+package wallet
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	clientsmocks "github.com/nicolas-martin/dankfolio/backend/internal/clients/mocks"
+	bmodel "github.com/nicolas-martin/dankfolio/backend/internal/model/blockchain"
+)
+
+func TestGetNativeStake(t *testing.T) {
+	owner := solana.NewWallet().PublicKey().String()
+	chainClient := clientsmocks.NewMockGenericClientAPI(t)
+	chainClient.EXPECT().GetStakeAccounts(mock.Anything, bmodel.Address(owner), mock.Anything).Return([]*bmodel.StakeAccount{
+		{Address: "Active1", Lamports: 2_002_282_880, DelegatedStake: 2_000_000_000, Voter: "Vote1", Status: bmodel.StakeActive, LastReward: 1_000_000},
+		{Address: "Activating1", Lamports: 1_002_282_880, DelegatedStake: 1_000_000_000, Voter: "Vote1", Status: bmodel.StakeActivating},
+		{Address: "Inactive1", Lamports: 502_282_880, Status: bmodel.StakeInactive},
+	}, nil)
+
+	s := New(chainClient, nil, nil, nil, nil)
+	stake, err := s.getNativeStake(context.Background(), owner)
+	require.NoError(t, err)
+	require.NotNil(t, stake)
+
+	assert.Len(t, stake.Accounts, 3)
+	assert.InDelta(t, 3.50684864, stake.TotalSOL, 1e-9)
+	assert.InDelta(t, 2, stake.ActiveSOL, 1e-9)
+	assert.InDelta(t, 1, stake.ActivatingSOL, 1e-9)
+	assert.InDelta(t, 0.001, stake.LastEpochRewardsSOL, 1e-9)
+	assert.Equal(t, "Vote1", stake.Accounts[0].Validator)
+}
+
+func TestGetNativeStake_NoAccounts(t *testing.T) {
+	owner := solana.NewWallet().PublicKey().String()
+	chainClient := clientsmocks.NewMockGenericClientAPI(t)
+	chainClient.EXPECT().GetStakeAccounts(mock.Anything, bmodel.Address(owner), mock.Anything).Return(nil, nil)
+
+	stake, err := New(chainClient, nil, nil, nil, nil).getNativeStake(context.Background(), owner)
+	require.NoError(t, err)
+	assert.Nil(t, stake)
+}
@@ -0,0 +1,57 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/gagliardetto/solana-go"
+
+	bmodel "github.com/nicolas-martin/dankfolio/backend/internal/model/blockchain"
+)
+
+// Token2022ProgramID owns Token-2022 mints and accounts, such as xStocks
+var Token2022ProgramID = bmodel.Address("TokenzQdBNbLqP5VEhdkAS6EPFLC1PHnBqCXEpPxuEb")
+
+// TokenPrograms are the token programs a wallet's holdings are read from
+var TokenPrograms = []bmodel.Address{
+	bmodel.Address(solana.TokenProgramID.String()),
+	Token2022ProgramID,
+}
+
+// GetTokenAccounts lists the wallet's token accounts under every token
+// program, querying the programs concurrently. If some programs fail, the
+// accounts of the others are returned together with an error naming the
+// failed programs.
+func (s *Service) GetTokenAccounts(ctx context.Context, walletAddress string) ([]*bmodel.TokenAccountInfo, error) {
+	results := make([][]*bmodel.TokenAccountInfo, len(TokenPrograms))
+	errs := make([]error, len(TokenPrograms))
+	var wg sync.WaitGroup
+	for i, program := range TokenPrograms {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			accounts, err := s.chainClient.GetTokenAccountsByOwner(ctx, bmodel.Address(walletAddress), bmodel.TokenAccountsOptions{
+				Encoding:   string(solana.EncodingJSONParsed),
+				Commitment: s.commitment,
+				ProgramID:  program,
+			})
+			if err != nil {
+				errs[i] = fmt.Errorf("program %s: %w", program, err)
+				return
+			}
+			results[i] = accounts
+		}()
+	}
+	wg.Wait()
+
+	var accounts []*bmodel.TokenAccountInfo
+	for _, programAccounts := range results {
+		accounts = append(accounts, programAccounts...)
+	}
+	if err := errors.Join(errs...); err != nil {
+		return accounts, fmt.Errorf("failed to get token accounts: %w", err)
+	}
+	return accounts, nil
+}
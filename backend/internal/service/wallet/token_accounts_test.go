@@ -0,0 +1,79 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	clientsmocks "github.com/nicolas-martin/dankfolio/backend/internal/clients/mocks"
+	bmodel "github.com/nicolas-martin/dankfolio/backend/internal/model/blockchain"
+)
+
+const xStockMint = "XsDoVfqeBukxuZHWhdvWHBhgEHjGNst4MLodqsJHzoB"
+
+// programOption matches token account lookups of one token program
+func programOption(program string) any {
+	return mock.MatchedBy(func(opts bmodel.TokenAccountsOptions) bool {
+		return opts.ProgramID == bmodel.Address(program)
+	})
+}
+
+func TestGetWalletBalances_IncludesToken2022(t *testing.T) {
+	ctx := context.Background()
+	owner := solana.NewWallet().PublicKey().String()
+
+	chainClient := clientsmocks.NewMockGenericClientAPI(t)
+	chainClient.EXPECT().GetBalance(mock.Anything, bmodel.Address(owner), mock.Anything).
+		Return(&bmodel.Balance{Amount: "0", Decimals: 9}, nil)
+	chainClient.EXPECT().GetTokenAccountsByOwner(mock.Anything, bmodel.Address(owner), programOption(solana.TokenProgramID.String())).
+		Return([]*bmodel.TokenAccountInfo{
+			{MintAddress: "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v", Amount: "5000000", Decimals: 6, UIAmount: 5},
+		}, nil)
+	chainClient.EXPECT().GetTokenAccountsByOwner(mock.Anything, bmodel.Address(owner), programOption(token2022Program)).
+		Return([]*bmodel.TokenAccountInfo{
+			{MintAddress: xStockMint, Amount: "2500000000", Decimals: 8, UIAmount: 25, ProgramID: token2022Program},
+			{MintAddress: "ZeroBalance2022Mint1111111111111111111111111", Amount: "0", Decimals: 6},
+		}, nil)
+	// The SOL normalizer looks for wSOL under the default token program
+	chainClient.EXPECT().GetTokenAccountsByOwner(mock.Anything, bmodel.Address(owner), programOption("")).
+		Return(nil, nil)
+	chainClient.EXPECT().GetStakeAccounts(mock.Anything, bmodel.Address(owner), mock.Anything).Return(nil, nil)
+
+	s := New(chainClient, nil, nil, nil, nil)
+	balance, err := s.GetWalletBalances(ctx, owner)
+	require.NoError(t, err)
+
+	mints := make([]string, 0, len(balance.Balances))
+	for _, b := range balance.Balances {
+		mints = append(mints, b.ID)
+	}
+	assert.ElementsMatch(t, []string{"EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v", xStockMint}, mints)
+}
+
+func TestGetTokenAccounts_PartialFailure(t *testing.T) {
+	ctx := context.Background()
+	owner := solana.NewWallet().PublicKey().String()
+	rpcErr := errors.New("rpc unavailable")
+
+	chainClient := clientsmocks.NewMockGenericClientAPI(t)
+	chainClient.EXPECT().GetTokenAccountsByOwner(mock.Anything, bmodel.Address(owner), programOption(solana.TokenProgramID.String())).
+		Return([]*bmodel.TokenAccountInfo{{MintAddress: "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v", UIAmount: 1}}, nil)
+	chainClient.EXPECT().GetTokenAccountsByOwner(mock.Anything, bmodel.Address(owner), programOption(token2022Program)).
+		Return(nil, rpcErr)
+
+	s := New(chainClient, nil, nil, nil, nil)
+	accounts, err := s.GetTokenAccounts(ctx, owner)
+	assert.ErrorIs(t, err, rpcErr)
+	assert.Len(t, accounts, 1)
+
+	// Partial token balances are served but flagged so they aren't cached
+	tokens, complete, err := s.getTokenBalances(ctx, owner)
+	require.NoError(t, err)
+	assert.False(t, complete)
+	assert.Len(t, tokens, 1)
+}
@@ -0,0 +1,233 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/events"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+// Headers sent with every delivery. Partners verify the signature by computing
+// HMAC-SHA256(secret, timestamp + "." + body) and comparing it to the hex digest.
+const (
+	HeaderEvent     = "X-Dankfolio-Event"
+	HeaderDelivery  = "X-Dankfolio-Delivery"
+	HeaderTimestamp = "X-Dankfolio-Timestamp"
+	HeaderSignature = "X-Dankfolio-Signature"
+)
+
+// envelope is the JSON body delivered to partners.
+type envelope struct {
+	ID         string    `json:"id"`
+	Type       string    `json:"type"`
+	OccurredAt time.Time `json:"occurred_at"`
+	Data       any       `json:"data"`
+}
+
+// Sign returns the signature header value for a payload.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// handleEvent fans an event out to every matching active subscription.
+func (s *Service) handleEvent(ctx context.Context, event events.Event) {
+	active := true
+	subs, _, err := s.store.WebhookSubscriptions().ListWithOpts(ctx, db.ListOptions{
+		Filters: []db.FilterOption{{Field: "active", Operator: db.FilterOpEqual, Value: active}},
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to load webhook subscriptions", slog.String("event_type", string(event.Type)), slog.Any("error", err))
+		return
+	}
+
+	eventID := uuid.New().String()
+	body, err := json.Marshal(envelope{
+		ID:         eventID,
+		Type:       string(event.Type),
+		OccurredAt: event.OccurredAt,
+		Data:       eventData(event),
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to marshal webhook payload", slog.String("event_type", string(event.Type)), slog.Any("error", err))
+		return
+	}
+
+	for _, sub := range subs {
+		if !matches(sub, event) {
+			continue
+		}
+		if !s.startDelivery() {
+			return
+		}
+		go func(sub model.WebhookSubscription) {
+			defer s.wg.Done()
+			defer func() { <-s.limiter }()
+			s.deliver(sub, string(event.Type), eventID, body)
+		}(sub)
+	}
+}
+
+// startDelivery reserves a delivery slot, returning false once the service is closing.
+func (s *Service) startDelivery() bool {
+	select {
+	case s.limiter <- struct{}{}:
+	case <-s.ctx.Done():
+		return false
+	}
+	s.closeMu.RLock()
+	defer s.closeMu.RUnlock()
+	if s.closed {
+		<-s.limiter
+		return false
+	}
+	s.wg.Add(1)
+	return true
+}
+
+// deliver POSTs the body to the subscription, retrying with exponential backoff,
+// and records a dead letter when every attempt fails.
+func (s *Service) deliver(sub model.WebhookSubscription, eventType, eventID string, body []byte) {
+	backoff := s.config.InitialBackoff
+	var lastErr error
+	attempts := 0
+	for attempts < s.config.MaxAttempts {
+		attempts++
+		lastErr = s.post(s.ctx, sub, eventType, eventID, body)
+		if lastErr == nil {
+			slog.Debug("Webhook delivered", slog.String("subscription_id", sub.ID), slog.String("event_type", eventType), slog.Int("attempt", attempts))
+			return
+		}
+		slog.Warn("Webhook delivery attempt failed",
+			slog.String("subscription_id", sub.ID), slog.String("event_type", eventType),
+			slog.Int("attempt", attempts), slog.Any("error", lastErr))
+
+		if attempts == s.config.MaxAttempts {
+			break
+		}
+		select {
+		case <-time.After(backoff):
+		case <-s.ctx.Done():
+			lastErr = fmt.Errorf("delivery interrupted by shutdown: %w", lastErr)
+		}
+		if s.ctx.Err() != nil {
+			break
+		}
+		backoff = min(backoff*2, s.config.MaxBackoff)
+	}
+
+	// Use a fresh context so dead letters are still written during shutdown
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	deadLetter := &model.WebhookDeadLetter{
+		SubscriptionID: sub.ID,
+		EventID:        eventID,
+		EventType:      eventType,
+		Payload:        string(body),
+		Attempts:       attempts,
+		LastError:      lastErr.Error(),
+		CreatedAt:      time.Now(),
+	}
+	if err := s.store.WebhookDeadLetters().Create(ctx, deadLetter); err != nil {
+		slog.ErrorContext(ctx, "Failed to record webhook dead letter",
+			slog.String("subscription_id", sub.ID), slog.String("event_id", eventID), slog.Any("error", err))
+		return
+	}
+	slog.WarnContext(ctx, "Webhook dead-lettered",
+		slog.String("subscription_id", sub.ID), slog.String("event_id", eventID), slog.Int("attempts", attempts))
+}
+
+func (s *Service) post(ctx context.Context, sub model.WebhookSubscription, eventType, deliveryID string, body []byte) error {
+	if s.config.RequestTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.config.RequestTimeout)
+		defer cancel()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, eventType)
+	req.Header.Set(HeaderDelivery, deliveryID)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(sub.Secret, timestamp, body))
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// matches reports whether a subscription wants this event, applying its optional filters.
+func matches(sub model.WebhookSubscription, event events.Event) bool {
+	if !slices.Contains(sub.EventTypes, string(event.Type)) {
+		return false
+	}
+	switch p := event.Payload.(type) {
+	case events.TradePayload:
+		if sub.WalletAddress != "" && sub.WalletAddress != p.Trade.UserID && sub.WalletAddress != p.Trade.FromAddress {
+			return false
+		}
+	case events.PriceAlertPayload:
+		if sub.CoinAddress != "" && sub.CoinAddress != p.CoinAddress {
+			return false
+		}
+	case events.BurnPayload:
+		if sub.CoinAddress != "" && sub.CoinAddress != p.Burn.CoinAddress {
+			return false
+		}
+	case events.AuthorityChangePayload:
+		if sub.CoinAddress != "" && sub.CoinAddress != p.Change.CoinAddress {
+			return false
+		}
+		if sub.WalletAddress != "" && !slices.Contains(p.Holders, sub.WalletAddress) {
+			return false
+		}
+	}
+	return true
+}
+
+// eventData returns the partner-facing representation of an event payload.
+func eventData(event events.Event) any {
+	switch p := event.Payload.(type) {
+	case events.CoinPayload:
+		return p.Coin
+	case events.BurnPayload:
+		return p.Burn
+	case events.AuthorityChangePayload:
+		return p.Change // Holders are only used for matching, never sent to partners
+	case events.TradePayload:
+		trade := p.Trade
+		trade.UnsignedTransaction = "" // Internal; not part of the partner contract
+		return trade
+	default:
+		return p
+	}
+}
@@ -0,0 +1,111 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	dbmocks "github.com/nicolas-martin/dankfolio/backend/internal/db/mocks"
+	"github.com/nicolas-martin/dankfolio/backend/internal/events"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+func testConfig() Config {
+	return Config{
+		MaxAttempts:       3,
+		InitialBackoff:    time.Millisecond,
+		MaxBackoff:        5 * time.Millisecond,
+		RequestTimeout:    time.Second,
+		MaxConcurrent:     2,
+		AllowInsecureURLs: true,
+	}
+}
+
+func TestHandleEvent_DeliversSignedPayload(t *testing.T) {
+	var received atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		ts, err := strconv.ParseInt(r.Header.Get(HeaderTimestamp), 10, 64)
+		assert.NoError(t, err)
+		assert.Equal(t, Sign("secret", ts, body), r.Header.Get(HeaderSignature))
+		assert.Equal(t, string(events.CoinDiscovered), r.Header.Get(HeaderEvent))
+		received.Add(1)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	store := dbmocks.NewMockStore(t)
+	subsRepo := dbmocks.NewMockRepository[model.WebhookSubscription](t)
+	store.EXPECT().WebhookSubscriptions().Return(subsRepo)
+	subsRepo.EXPECT().ListWithOpts(mock.Anything, mock.Anything).Return([]model.WebhookSubscription{
+		{ID: "sub-1", URL: server.URL, Secret: "secret", EventTypes: []string{string(events.CoinDiscovered)}, Active: true},
+		{ID: "sub-2", URL: server.URL, Secret: "secret", EventTypes: []string{string(events.TradeConfirmed)}, Active: true},
+	}, int32(2), nil)
+
+	svc := NewService(testConfig(), store, server.Client())
+	svc.handleEvent(context.Background(), events.NewCoinEvent(events.CoinDiscovered, model.Coin{Address: "mint"}))
+	svc.wg.Wait()
+	svc.Close()
+
+	assert.Equal(t, int32(1), received.Load())
+}
+
+func TestHandleEvent_DeadLettersAfterRetries(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	store := dbmocks.NewMockStore(t)
+	subsRepo := dbmocks.NewMockRepository[model.WebhookSubscription](t)
+	deadLetters := dbmocks.NewMockRepository[model.WebhookDeadLetter](t)
+	store.EXPECT().WebhookSubscriptions().Return(subsRepo)
+	store.EXPECT().WebhookDeadLetters().Return(deadLetters)
+	subsRepo.EXPECT().ListWithOpts(mock.Anything, mock.Anything).Return([]model.WebhookSubscription{
+		{ID: "sub-1", URL: server.URL, Secret: "secret", EventTypes: []string{string(events.TradeConfirmed)}, WalletAddress: "wallet", Active: true},
+	}, int32(1), nil)
+	deadLetters.EXPECT().Create(mock.Anything, mock.MatchedBy(func(d *model.WebhookDeadLetter) bool {
+		return d.SubscriptionID == "sub-1" && d.Attempts == 3 && d.EventType == string(events.TradeConfirmed)
+	})).Return(nil)
+
+	svc := NewService(testConfig(), store, server.Client())
+	// Trade for another wallet is filtered out
+	svc.handleEvent(context.Background(), events.NewTradeEvent(events.TradeConfirmed, model.Trade{UserID: "other"}))
+	svc.handleEvent(context.Background(), events.NewTradeEvent(events.TradeConfirmed, model.Trade{UserID: "wallet"}))
+	svc.wg.Wait()
+	svc.Close()
+
+	assert.Equal(t, int32(3), attempts.Load())
+}
+
+func TestCreateSubscription_Validation(t *testing.T) {
+	svc := NewService(DefaultConfig(), nil, nil)
+	defer svc.Close()
+
+	tests := []struct {
+		name   string
+		params CreateSubscriptionParams
+	}{
+		{"missing partner", CreateSubscriptionParams{URL: "https://example.com", EventTypes: []string{"coin.discovered"}}},
+		{"insecure url", CreateSubscriptionParams{PartnerName: "p", URL: "http://example.com", EventTypes: []string{"coin.discovered"}}},
+		{"no events", CreateSubscriptionParams{PartnerName: "p", URL: "https://example.com"}},
+		{"unknown event", CreateSubscriptionParams{PartnerName: "p", URL: "https://example.com", EventTypes: []string{"coin.enriched"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.CreateSubscription(context.Background(), tt.params)
+			require.ErrorIs(t, err, ErrInvalidSubscription)
+		})
+	}
+}
@@ -0,0 +1,244 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/events"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	"github.com/nicolas-martin/dankfolio/backend/internal/util"
+)
+
+// SubscribableEvents are the event types partners may register for.
+var SubscribableEvents = []events.Type{
+	events.CoinDiscovered,
+	events.CoinBurned,
+	events.CoinAuthorityChanged,
+	events.TradeConfirmed,
+	events.PriceAlert,
+}
+
+// ErrInvalidSubscription is returned when a subscription request fails validation.
+var ErrInvalidSubscription = errors.New("invalid webhook subscription")
+
+// Config holds delivery settings for outbound webhooks.
+type Config struct {
+	MaxAttempts       int           // Total delivery attempts before dead-lettering
+	InitialBackoff    time.Duration // Delay before the first retry; doubles on each attempt
+	MaxBackoff        time.Duration // Upper bound for the retry delay
+	RequestTimeout    time.Duration // Per-attempt HTTP timeout
+	MaxConcurrent     int           // Maximum in-flight deliveries
+	AllowInsecureURLs bool          // Permit http:// endpoints (development only)
+}
+
+// DefaultConfig returns the delivery settings used in production.
+func DefaultConfig() Config {
+	return Config{
+		MaxAttempts:    5,
+		InitialBackoff: 2 * time.Second,
+		MaxBackoff:     time.Minute,
+		RequestTimeout: 10 * time.Second,
+		MaxConcurrent:  16,
+	}
+}
+
+// Service manages partner webhook subscriptions and delivers events to them.
+type Service struct {
+	config     Config
+	store      db.Store
+	httpClient *http.Client
+
+	ctx         context.Context
+	cancel      context.CancelFunc
+	unsubscribe func()
+	limiter     chan struct{}
+	wg          sync.WaitGroup
+	closeMu     sync.RWMutex
+	closed      bool
+}
+
+// NewService creates a webhook service. Call Start to begin delivering events.
+func NewService(config Config, store db.Store, httpClient *http.Client) *Service {
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = 1
+	}
+	if config.MaxConcurrent <= 0 {
+		config.MaxConcurrent = 1
+	}
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: config.RequestTimeout}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Service{
+		config:     config,
+		store:      store,
+		httpClient: httpClient,
+		ctx:        ctx,
+		cancel:     cancel,
+		limiter:    make(chan struct{}, config.MaxConcurrent),
+	}
+}
+
+// Start subscribes the service to the event bus.
+func (s *Service) Start(bus events.Bus) {
+	s.unsubscribe = bus.Subscribe(s.handleEvent, SubscribableEvents...)
+	slog.Info("Webhook delivery started", slog.Int("max_attempts", s.config.MaxAttempts))
+}
+
+// Close stops accepting events and waits for in-flight deliveries.
+// Deliveries interrupted mid-retry are dead-lettered.
+func (s *Service) Close() {
+	if s.unsubscribe != nil {
+		s.unsubscribe()
+	}
+	s.cancel()
+
+	s.closeMu.Lock()
+	s.closed = true
+	s.closeMu.Unlock()
+
+	s.wg.Wait()
+}
+
+// CreateSubscriptionParams describes a new partner subscription.
+type CreateSubscriptionParams struct {
+	PartnerName   string
+	URL           string
+	EventTypes    []string
+	Secret        string // Generated when empty
+	WalletAddress string
+	CoinAddress   string
+}
+
+// CreateSubscription validates and stores a new subscription, returning it with its secret populated.
+func (s *Service) CreateSubscription(ctx context.Context, params CreateSubscriptionParams) (*model.WebhookSubscription, error) {
+	if err := s.validate(params); err != nil {
+		return nil, err
+	}
+
+	secret := params.Secret
+	if secret == "" {
+		var err error
+		secret, err = generateSecret()
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate webhook secret: %w", err)
+		}
+	}
+
+	now := time.Now()
+	sub := &model.WebhookSubscription{
+		ID:            uuid.New().String(),
+		PartnerName:   strings.TrimSpace(params.PartnerName),
+		URL:           params.URL,
+		Secret:        secret,
+		EventTypes:    dedupe(params.EventTypes),
+		WalletAddress: params.WalletAddress,
+		CoinAddress:   params.CoinAddress,
+		Active:        true,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	if err := s.store.WebhookSubscriptions().Create(ctx, sub); err != nil {
+		return nil, fmt.Errorf("failed to create webhook subscription: %w", err)
+	}
+
+	slog.InfoContext(ctx, "Webhook subscription created",
+		slog.String("id", sub.ID), slog.String("partner", sub.PartnerName), slog.Any("event_types", sub.EventTypes))
+	return sub, nil
+}
+
+// ListSubscriptions lists subscriptions, optionally filtered by partner name.
+func (s *Service) ListSubscriptions(ctx context.Context, partnerName string, limit, offset int) ([]model.WebhookSubscription, int32, error) {
+	opts := db.ListOptions{Limit: &limit, Offset: &offset}
+	if partnerName != "" {
+		opts.Filters = append(opts.Filters, db.FilterOption{Field: "partner_name", Operator: db.FilterOpEqual, Value: partnerName})
+	}
+	subs, total, err := s.store.WebhookSubscriptions().ListWithOpts(ctx, opts)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list webhook subscriptions: %w", err)
+	}
+	return subs, total, nil
+}
+
+// DeleteSubscription removes a subscription.
+func (s *Service) DeleteSubscription(ctx context.Context, id string) error {
+	if err := s.store.WebhookSubscriptions().Delete(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete webhook subscription %s: %w", id, err)
+	}
+	slog.InfoContext(ctx, "Webhook subscription deleted", slog.String("id", id))
+	return nil
+}
+
+// ListDeadLetters lists failed deliveries, newest first, optionally for a single subscription.
+func (s *Service) ListDeadLetters(ctx context.Context, subscriptionID string, limit, offset int) ([]model.WebhookDeadLetter, int32, error) {
+	sortBy := "created_at"
+	sortDesc := true
+	opts := db.ListOptions{Limit: &limit, Offset: &offset, SortBy: &sortBy, SortDesc: &sortDesc}
+	if subscriptionID != "" {
+		opts.Filters = append(opts.Filters, db.FilterOption{Field: "subscription_id", Operator: db.FilterOpEqual, Value: subscriptionID})
+	}
+	letters, total, err := s.store.WebhookDeadLetters().ListWithOpts(ctx, opts)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list webhook dead letters: %w", err)
+	}
+	return letters, total, nil
+}
+
+func (s *Service) validate(params CreateSubscriptionParams) error {
+	if strings.TrimSpace(params.PartnerName) == "" {
+		return fmt.Errorf("%w: partner name is required", ErrInvalidSubscription)
+	}
+	parsed, err := url.Parse(params.URL)
+	if err != nil || parsed.Host == "" {
+		return fmt.Errorf("%w: url must be absolute", ErrInvalidSubscription)
+	}
+	if parsed.Scheme != "https" && !(s.config.AllowInsecureURLs && parsed.Scheme == "http") {
+		return fmt.Errorf("%w: url must use https", ErrInvalidSubscription)
+	}
+	if len(params.EventTypes) == 0 {
+		return fmt.Errorf("%w: at least one event type is required", ErrInvalidSubscription)
+	}
+	for _, t := range params.EventTypes {
+		if !slices.Contains(SubscribableEvents, events.Type(t)) {
+			return fmt.Errorf("%w: unsupported event type %q", ErrInvalidSubscription, t)
+		}
+	}
+	if params.WalletAddress != "" && !util.IsValidSolanaAddress(params.WalletAddress) {
+		return fmt.Errorf("%w: invalid wallet address", ErrInvalidSubscription)
+	}
+	if params.CoinAddress != "" && !util.IsValidSolanaAddress(params.CoinAddress) {
+		return fmt.Errorf("%w: invalid coin address", ErrInvalidSubscription)
+	}
+	return nil
+}
+
+func generateSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(b), nil
+}
+
+func dedupe(values []string) []string {
+	out := make([]string, 0, len(values))
+	for _, v := range values {
+		if !slices.Contains(out, v) {
+			out = append(out, v)
+		}
+	}
+	return out
+}
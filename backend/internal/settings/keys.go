@@ -0,0 +1,106 @@
+package settings
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidValue is returned when a raw value cannot be parsed or fails validation.
+var ErrInvalidValue = errors.New("invalid setting value")
+
+// Kind describes how a setting's raw string value is interpreted.
+type Kind string
+
+const (
+	KindString     Kind = "string"
+	KindInt        Kind = "int"
+	KindBool       Kind = "bool"
+	KindDuration   Kind = "duration"
+	KindStringList Kind = "string_list" // comma-separated
+)
+
+// Key is a typed handle to a setting. Values are stored as strings and
+// converted with the key's parse/format functions.
+type Key[T any] struct {
+	Name        string
+	Kind        Kind
+	Description string
+	parse       func(string) (T, error)
+	format      func(T) string
+	validate    func(T) error
+}
+
+// WithValidation returns a copy of the key that rejects values failing fn.
+func (k Key[T]) WithValidation(fn func(T) error) Key[T] {
+	k.validate = fn
+	return k
+}
+
+func (k Key[T]) decode(raw string) (T, error) {
+	v, err := k.parse(raw)
+	if err != nil {
+		return v, fmt.Errorf("%w: %s expects a %s: %v", ErrInvalidValue, k.Name, k.Kind, err)
+	}
+	if k.validate != nil {
+		if err := k.validate(v); err != nil {
+			return v, fmt.Errorf("%w: %s: %v", ErrInvalidValue, k.Name, err)
+		}
+	}
+	return v, nil
+}
+
+// StringKey defines a string setting.
+func StringKey(name, description string) Key[string] {
+	return Key[string]{
+		Name: name, Kind: KindString, Description: description,
+		parse:  func(s string) (string, error) { return s, nil },
+		format: func(v string) string { return v },
+	}
+}
+
+// IntKey defines an integer setting.
+func IntKey(name, description string) Key[int] {
+	return Key[int]{
+		Name: name, Kind: KindInt, Description: description,
+		parse:  func(s string) (int, error) { return strconv.Atoi(strings.TrimSpace(s)) },
+		format: strconv.Itoa,
+	}
+}
+
+// BoolKey defines a boolean setting.
+func BoolKey(name, description string) Key[bool] {
+	return Key[bool]{
+		Name: name, Kind: KindBool, Description: description,
+		parse:  func(s string) (bool, error) { return strconv.ParseBool(strings.TrimSpace(s)) },
+		format: strconv.FormatBool,
+	}
+}
+
+// DurationKey defines a duration setting using Go duration syntax (e.g. "90s").
+func DurationKey(name, description string) Key[time.Duration] {
+	return Key[time.Duration]{
+		Name: name, Kind: KindDuration, Description: description,
+		parse:  func(s string) (time.Duration, error) { return time.ParseDuration(strings.TrimSpace(s)) },
+		format: func(d time.Duration) string { return d.String() },
+	}
+}
+
+// StringListKey defines a comma-separated list setting. Blank entries are dropped.
+func StringListKey(name, description string) Key[[]string] {
+	return Key[[]string]{
+		Name: name, Kind: KindStringList, Description: description,
+		parse: func(s string) ([]string, error) {
+			var out []string
+			for part := range strings.SplitSeq(s, ",") {
+				if p := strings.TrimSpace(part); p != "" {
+					out = append(out, p)
+				}
+			}
+			return out, nil
+		},
+		format: func(v []string) string { return strings.Join(v, ",") },
+	}
+}
@@ -0,0 +1,279 @@
+package settings
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+// ErrUnknownSetting is returned when reading or writing a setting that was never defined.
+var ErrUnknownSetting = errors.New("unknown setting")
+
+// DefaultPollInterval is how often the settings table is checked for changes.
+const DefaultPollInterval = 30 * time.Second
+
+// Entry describes the effective state of one setting.
+type Entry struct {
+	Name        string
+	Kind        Kind
+	Description string
+	Value       string // Effective value
+	Default     string // Value from env/defaults
+	Overridden  bool   // True when Value comes from the settings table
+	UpdatedAt   time.Time
+	UpdatedBy   string
+}
+
+// definition is the type-erased form of a Key registered with the Manager.
+type definition struct {
+	kind        Kind
+	description string
+	defaultRaw  string
+	decode      func(string) (any, error)
+}
+
+type override struct {
+	raw       string
+	value     any
+	updatedAt time.Time
+	updatedBy string
+}
+
+// Manager layers settings-table overrides on top of env-derived defaults and
+// notifies subscribers when an effective value changes.
+type Manager struct {
+	repo         db.Repository[model.Setting]
+	pollInterval time.Duration
+
+	mu          sync.RWMutex
+	defs        map[string]definition
+	defaults    map[string]any
+	overrides   map[string]override
+	subscribers map[string]map[uint64]func(any)
+	nextSubID   uint64
+}
+
+// NewManager creates a settings manager. A pollInterval <= 0 uses DefaultPollInterval.
+func NewManager(repo db.Repository[model.Setting], pollInterval time.Duration) *Manager {
+	if pollInterval <= 0 {
+		pollInterval = DefaultPollInterval
+	}
+	return &Manager{
+		repo:         repo,
+		pollInterval: pollInterval,
+		defs:         make(map[string]definition),
+		defaults:     make(map[string]any),
+		overrides:    make(map[string]override),
+		subscribers:  make(map[string]map[uint64]func(any)),
+	}
+}
+
+// Define registers a key with its default (usually taken from env config).
+// Defining a key twice replaces its default.
+func Define[T any](m *Manager, key Key[T], def T) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.defs[key.Name] = definition{
+		kind:        key.Kind,
+		description: key.Description,
+		defaultRaw:  key.format(def),
+		decode: func(raw string) (any, error) {
+			return key.decode(raw)
+		},
+	}
+	m.defaults[key.Name] = def
+}
+
+// Get returns the effective value for key.
+func Get[T any](m *Manager, key Key[T]) T {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.effective(key.Name).(T)
+}
+
+// Subscribe calls fn with the current value immediately and again whenever
+// the effective value changes. It returns a function that removes the subscription.
+func Subscribe[T any](m *Manager, key Key[T], fn func(T)) func() {
+	m.mu.Lock()
+	if _, ok := m.defs[key.Name]; !ok {
+		m.mu.Unlock()
+		panic(fmt.Sprintf("settings: subscribe to undefined key %q", key.Name))
+	}
+	if m.subscribers[key.Name] == nil {
+		m.subscribers[key.Name] = make(map[uint64]func(any))
+	}
+	m.nextSubID++
+	id := m.nextSubID
+	m.subscribers[key.Name][id] = func(v any) { fn(v.(T)) }
+	current := m.effective(key.Name)
+	m.mu.Unlock()
+
+	fn(current.(T))
+
+	return func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		delete(m.subscribers[key.Name], id)
+	}
+}
+
+// effective must be called with m.mu held.
+func (m *Manager) effective(name string) any {
+	if o, ok := m.overrides[name]; ok {
+		return o.value
+	}
+	return m.defaults[name]
+}
+
+// Load reads the settings table and applies any changes. Invalid rows are
+// logged and ignored so a bad value never takes a service down.
+func (m *Manager) Load(ctx context.Context) error {
+	rows, _, err := m.repo.List(ctx, db.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to load settings: %w", err)
+	}
+
+	next := make(map[string]override, len(rows))
+	m.mu.RLock()
+	for _, row := range rows {
+		def, ok := m.defs[row.ID]
+		if !ok {
+			continue // Settings for keys this binary does not know about
+		}
+		value, decodeErr := def.decode(row.Value)
+		if decodeErr != nil {
+			slog.WarnContext(ctx, "Ignoring invalid setting override", slog.String("key", row.ID), slog.Any("error", decodeErr))
+			continue
+		}
+		next[row.ID] = override{raw: row.Value, value: value, updatedAt: row.UpdatedAt, updatedBy: row.UpdatedBy}
+	}
+	m.mu.RUnlock()
+
+	m.apply(ctx, next)
+	return nil
+}
+
+// apply swaps in a new override set and notifies subscribers of changed keys.
+func (m *Manager) apply(ctx context.Context, next map[string]override) {
+	type notification struct {
+		name  string
+		value any
+		subs  []func(any)
+	}
+	var notify []notification
+
+	m.mu.Lock()
+	for name, def := range m.defs {
+		oldRaw, newRaw := def.defaultRaw, def.defaultRaw
+		if o, ok := m.overrides[name]; ok {
+			oldRaw = o.raw
+		}
+		if o, ok := next[name]; ok {
+			newRaw = o.raw
+		}
+		if oldRaw == newRaw {
+			continue
+		}
+		var value any = m.defaults[name]
+		if o, ok := next[name]; ok {
+			value = o.value
+		}
+		subs := make([]func(any), 0, len(m.subscribers[name]))
+		for _, fn := range m.subscribers[name] {
+			subs = append(subs, fn)
+		}
+		notify = append(notify, notification{name: name, value: value, subs: subs})
+		slog.InfoContext(ctx, "Setting changed", slog.String("key", name), slog.String("from", oldRaw), slog.String("to", newRaw))
+	}
+	m.overrides = next
+	m.mu.Unlock()
+
+	// Notify outside the lock so subscribers may read other settings
+	for _, n := range notify {
+		for _, fn := range n.subs {
+			fn(n.value)
+		}
+	}
+}
+
+// Watch polls the settings table until ctx is cancelled.
+func (m *Manager) Watch(ctx context.Context) {
+	ticker := time.NewTicker(m.pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := m.Load(ctx); err != nil && ctx.Err() == nil {
+				slog.WarnContext(ctx, "Failed to refresh settings", slog.Any("error", err))
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Set validates and stores an override, then applies it immediately.
+func (m *Manager) Set(ctx context.Context, name, raw, updatedBy string) error {
+	m.mu.RLock()
+	def, ok := m.defs[name]
+	m.mu.RUnlock()
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownSetting, name)
+	}
+	if _, err := def.decode(raw); err != nil {
+		return err
+	}
+
+	setting := &model.Setting{ID: name, Value: raw, UpdatedAt: time.Now(), UpdatedBy: updatedBy}
+	if _, err := m.repo.Upsert(ctx, setting); err != nil {
+		return fmt.Errorf("failed to save setting %s: %w", name, err)
+	}
+	return m.Load(ctx)
+}
+
+// Reset removes an override so the setting falls back to its default.
+func (m *Manager) Reset(ctx context.Context, name string) error {
+	m.mu.RLock()
+	_, ok := m.defs[name]
+	m.mu.RUnlock()
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownSetting, name)
+	}
+	if err := m.repo.HardDelete(ctx, name); err != nil && !errors.Is(err, db.ErrNotFound) {
+		return fmt.Errorf("failed to reset setting %s: %w", name, err)
+	}
+	return m.Load(ctx)
+}
+
+// List returns every defined setting sorted by name.
+func (m *Manager) List() []Entry {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	entries := make([]Entry, 0, len(m.defs))
+	for name, def := range m.defs {
+		entry := Entry{
+			Name:        name,
+			Kind:        def.kind,
+			Description: def.description,
+			Value:       def.defaultRaw,
+			Default:     def.defaultRaw,
+		}
+		if o, ok := m.overrides[name]; ok {
+			entry.Value = o.raw
+			entry.Overridden = true
+			entry.UpdatedAt = o.updatedAt
+			entry.UpdatedBy = o.updatedBy
+		}
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name < entries[j].Name })
+	return entries
+}
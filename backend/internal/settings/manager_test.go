@@ -0,0 +1,73 @@
+package settings
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/db"
+	dbmocks "github.com/nicolas-martin/dankfolio/backend/internal/db/mocks"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+)
+
+func TestManager_OverridesNotifySubscribers(t *testing.T) {
+	ctx := context.Background()
+	repo := dbmocks.NewMockRepository[model.Setting](t)
+	m := NewManager(repo, time.Minute)
+
+	interval := DurationKey("fetch.interval", "")
+	Define(m, interval, 5*time.Minute)
+
+	var seen []time.Duration
+	Subscribe(m, interval, func(d time.Duration) { seen = append(seen, d) })
+
+	// Override applied
+	repo.EXPECT().List(mock.Anything, db.ListOptions{}).Return([]model.Setting{{ID: "fetch.interval", Value: "90s"}}, 1, nil).Once()
+	require.NoError(t, m.Load(ctx))
+	assert.Equal(t, 90*time.Second, Get(m, interval))
+
+	// Unchanged reload does not notify; invalid and unknown rows are ignored
+	repo.EXPECT().List(mock.Anything, db.ListOptions{}).Return([]model.Setting{{ID: "fetch.interval", Value: "90s"}, {ID: "other", Value: "x"}}, 2, nil).Once()
+	require.NoError(t, m.Load(ctx))
+
+	// Override removed falls back to the default
+	repo.EXPECT().List(mock.Anything, db.ListOptions{}).Return([]model.Setting{{ID: "fetch.interval", Value: "soon"}}, 1, nil).Once()
+	require.NoError(t, m.Load(ctx))
+
+	assert.Equal(t, []time.Duration{5 * time.Minute, 90 * time.Second, 5 * time.Minute}, seen)
+}
+
+func TestManager_SetValidatesBeforeSaving(t *testing.T) {
+	ctx := context.Background()
+	repo := dbmocks.NewMockRepository[model.Setting](t)
+	m := NewManager(repo, time.Minute)
+
+	fee := IntKey("fee.bps", "").WithValidation(func(v int) error {
+		if v > 10000 {
+			return assert.AnError
+		}
+		return nil
+	})
+	Define(m, fee, 100)
+
+	assert.ErrorIs(t, m.Set(ctx, "missing", "1", "ops"), ErrUnknownSetting)
+	assert.ErrorIs(t, m.Set(ctx, "fee.bps", "abc", "ops"), ErrInvalidValue)
+	assert.ErrorIs(t, m.Set(ctx, "fee.bps", "20000", "ops"), ErrInvalidValue)
+
+	repo.EXPECT().Upsert(mock.Anything, mock.MatchedBy(func(s *model.Setting) bool {
+		return s.ID == "fee.bps" && s.Value == "250" && s.UpdatedBy == "ops"
+	})).Return(1, nil).Once()
+	repo.EXPECT().List(mock.Anything, db.ListOptions{}).Return([]model.Setting{{ID: "fee.bps", Value: "250", UpdatedBy: "ops"}}, 1, nil).Once()
+	require.NoError(t, m.Set(ctx, "fee.bps", "250", "ops"))
+
+	assert.Equal(t, 250, Get(m, fee))
+	entries := m.List()
+	require.Len(t, entries, 1)
+	assert.True(t, entries[0].Overridden)
+	assert.Equal(t, "100", entries[0].Default)
+	assert.Equal(t, "ops", entries[0].UpdatedBy)
+}
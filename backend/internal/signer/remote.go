@@ -0,0 +1,71 @@
+package signer
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"connectrpc.com/connect"
+	solanago "github.com/gagliardetto/solana-go"
+
+	pb "github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1"
+	dankfoliov1connect "github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1/v1connect"
+)
+
+// RemoteSigner delegates signing to the signing sidecar over Connect RPC.
+type RemoteSigner struct {
+	client    dankfoliov1connect.SignerServiceClient
+	publicKey solanago.PublicKey
+}
+
+var _ Signer = (*RemoteSigner)(nil)
+
+// NewRemoteSigner connects to the sidecar at baseURL and fetches its public key.
+// authToken is sent as a bearer token on every call.
+func NewRemoteSigner(ctx context.Context, httpClient *http.Client, baseURL, authToken string) (*RemoteSigner, error) {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	client := dankfoliov1connect.NewSignerServiceClient(httpClient, baseURL,
+		connect.WithInterceptors(bearerTokenInterceptor(authToken)))
+
+	resp, err := client.GetPublicKey(ctx, connect.NewRequest(&pb.GetPublicKeyRequest{}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch signer public key: %w", err)
+	}
+	publicKey, err := solanago.PublicKeyFromBase58(resp.Msg.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("signer returned invalid public key: %w", err)
+	}
+	return &RemoteSigner{client: client, publicKey: publicKey}, nil
+}
+
+// PublicKey returns the sidecar's public key as fetched at construction.
+func (s *RemoteSigner) PublicKey() solanago.PublicKey {
+	return s.publicKey
+}
+
+// SignMessage asks the sidecar to sign message and verifies the result.
+func (s *RemoteSigner) SignMessage(ctx context.Context, message []byte) (solanago.Signature, error) {
+	resp, err := s.client.SignMessage(ctx, connect.NewRequest(&pb.SignMessageRequest{Message: message}))
+	if err != nil {
+		return solanago.Signature{}, fmt.Errorf("remote signer failed: %w", err)
+	}
+	signature := solanago.SignatureFromBytes(resp.Msg.Signature)
+	// Guard against a misconfigured sidecar signing with a different key
+	if !signature.Verify(s.publicKey, message) {
+		return solanago.Signature{}, fmt.Errorf("remote signer returned a signature that does not verify for %s", s.publicKey)
+	}
+	return signature, nil
+}
+
+func bearerTokenInterceptor(token string) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if token != "" {
+				req.Header().Set("Authorization", "Bearer "+token)
+			}
+			return next(ctx, req)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package signer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"connectrpc.com/connect"
+	bin "github.com/gagliardetto/binary"
+	solanago "github.com/gagliardetto/solana-go"
+
+	pb "github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1"
+	dankfoliov1connect "github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1/v1connect"
+)
+
+// DefaultAllowedPrograms are the programs the platform key signs for:
+// creating fee ATAs, plus the system and compute budget programs.
+var DefaultAllowedPrograms = []solanago.PublicKey{
+	solanago.SPLAssociatedTokenAccountProgramID,
+	solanago.SystemProgramID,
+	solanago.ComputeBudget,
+}
+
+// Server implements SignerService for the signing sidecar.
+type Server struct {
+	dankfoliov1connect.UnimplementedSignerServiceHandler
+	signer          Signer
+	allowedPrograms map[solanago.PublicKey]struct{}
+}
+
+// NewServer creates a SignerService that signs with signer, restricted to
+// messages whose instructions only call allowedPrograms.
+func NewServer(signer Signer, allowedPrograms []solanago.PublicKey) *Server {
+	allowed := make(map[solanago.PublicKey]struct{}, len(allowedPrograms))
+	for _, program := range allowedPrograms {
+		allowed[program] = struct{}{}
+	}
+	return &Server{signer: signer, allowedPrograms: allowed}
+}
+
+// GetPublicKey returns the signer's public key
+func (s *Server) GetPublicKey(
+	ctx context.Context,
+	req *connect.Request[pb.GetPublicKeyRequest],
+) (*connect.Response[pb.GetPublicKeyResponse], error) {
+	return connect.NewResponse(&pb.GetPublicKeyResponse{PublicKey: s.signer.PublicKey().String()}), nil
+}
+
+// SignMessage validates and signs a transaction message
+func (s *Server) SignMessage(
+	ctx context.Context,
+	req *connect.Request[pb.SignMessageRequest],
+) (*connect.Response[pb.SignMessageResponse], error) {
+	if err := s.checkMessage(req.Msg.Message); err != nil {
+		slog.WarnContext(ctx, "Rejected signing request", slog.Any("error", err))
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+	signature, err := s.signer.SignMessage(ctx, req.Msg.Message)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&pb.SignMessageResponse{Signature: signature[:]}), nil
+}
+
+// checkMessage ensures the message is well formed, expects our signature and
+// only calls allowed programs.
+func (s *Server) checkMessage(data []byte) error {
+	var message solanago.Message
+	if err := message.UnmarshalWithDecoder(bin.NewBinDecoder(data)); err != nil {
+		return fmt.Errorf("invalid transaction message: %w", err)
+	}
+	if message.IsVersioned() && message.NumLookups() > 0 {
+		return errors.New("messages with address lookup tables are not signed")
+	}
+	if !message.IsSigner(s.signer.PublicKey()) {
+		return errors.New("message does not require the platform signature")
+	}
+	for _, instruction := range message.Instructions {
+		program, err := message.Program(instruction.ProgramIDIndex)
+		if err != nil {
+			return fmt.Errorf("invalid program index: %w", err)
+		}
+		if _, ok := s.allowedPrograms[program]; !ok {
+			return fmt.Errorf("program %s is not allowed", program)
+		}
+	}
+	return nil
+}
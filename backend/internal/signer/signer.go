@@ -0,0 +1,100 @@
+// Package signer abstracts signing with the platform key so the API process
+// does not need to hold the key bytes.
+package signer
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"sync/atomic"
+
+	solanago "github.com/gagliardetto/solana-go"
+)
+
+// Signer signs Solana transaction messages with the platform key.
+type Signer interface {
+	// PublicKey returns the key signatures are produced for.
+	PublicKey() solanago.PublicKey
+	// SignMessage signs a serialized transaction message.
+	SignMessage(ctx context.Context, message []byte) (solanago.Signature, error)
+}
+
+// SignTransaction signs tx with s and stores the signature in the slot of s's
+// public key. Other signature slots are left untouched.
+func SignTransaction(ctx context.Context, s Signer, tx *solanago.Transaction) error {
+	pubKey := s.PublicKey()
+	signers := tx.Message.Signers()
+	slot := -1
+	for i, key := range signers {
+		if key.Equals(pubKey) {
+			slot = i
+			break
+		}
+	}
+	if slot < 0 {
+		return fmt.Errorf("%s is not a required signer of the transaction", pubKey)
+	}
+
+	message, err := tx.Message.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("failed to serialize transaction message: %w", err)
+	}
+	signature, err := s.SignMessage(ctx, message)
+	if err != nil {
+		return err
+	}
+
+	if len(tx.Signatures) != len(signers) {
+		signatures := make([]solanago.Signature, len(signers))
+		copy(signatures, tx.Signatures)
+		tx.Signatures = signatures
+	}
+	tx.Signatures[slot] = signature
+	return nil
+}
+
+// ParsePrivateKey decodes a base64 encoded 64-byte ed25519 private key.
+func ParsePrivateKey(privateKeyBase64 string) (solanago.PrivateKey, error) {
+	keyBytes, err := base64.StdEncoding.DecodeString(privateKeyBase64)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode private key: %w", err)
+	}
+	if len(keyBytes) != 64 {
+		return nil, fmt.Errorf("invalid private key length: expected 64, got %d", len(keyBytes))
+	}
+	return solanago.PrivateKey(keyBytes), nil
+}
+
+// LocalSigner signs in-process with a private key. It is meant for local
+// development and for the signing sidecar itself, not for the API server in production.
+type LocalSigner struct {
+	key atomic.Pointer[solanago.PrivateKey]
+}
+
+var _ Signer = (*LocalSigner)(nil)
+
+// NewLocalSigner creates a signer for key.
+func NewLocalSigner(key solanago.PrivateKey) *LocalSigner {
+	s := &LocalSigner{}
+	s.key.Store(&key)
+	return s
+}
+
+// SetPrivateKey replaces the signing key, e.g. after a secret rotation.
+func (s *LocalSigner) SetPrivateKey(key solanago.PrivateKey) {
+	s.key.Store(&key)
+}
+
+// PublicKey returns the current key's public key.
+func (s *LocalSigner) PublicKey() solanago.PublicKey {
+	return s.key.Load().PublicKey()
+}
+
+// SignMessage signs message with the current key.
+func (s *LocalSigner) SignMessage(_ context.Context, message []byte) (solanago.Signature, error) {
+	signature, err := s.key.Load().Sign(message)
+	if err != nil {
+		return solanago.Signature{}, fmt.Errorf("failed to sign message: %w", err)
+	}
+	return signature, nil
+}
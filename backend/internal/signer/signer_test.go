@@ -0,0 +1,58 @@
+package signer
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	solanago "github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dankfoliov1connect "github.com/nicolas-martin/dankfolio/backend/gen/proto/go/dankfolio/v1/v1connect"
+	"github.com/nicolas-martin/dankfolio/backend/internal/middleware"
+)
+
+func newTransferTx(t *testing.T, payer solanago.PublicKey, program solanago.PublicKey) *solanago.Transaction {
+	t.Helper()
+	var instruction solanago.Instruction = system.NewTransferInstruction(1, payer, solanago.NewWallet().PublicKey()).Build()
+	if !program.Equals(solanago.SystemProgramID) {
+		instruction = solanago.NewInstruction(program, solanago.AccountMetaSlice{solanago.Meta(payer).SIGNER().WRITE()}, nil)
+	}
+	tx, err := solanago.NewTransaction([]solanago.Instruction{instruction}, solanago.Hash{}, solanago.TransactionPayer(payer))
+	require.NoError(t, err)
+	return tx
+}
+
+func TestRemoteSigner_SignsThroughSidecar(t *testing.T) {
+	ctx := context.Background()
+	key := solanago.NewWallet().PrivateKey
+
+	path, handler := dankfoliov1connect.NewSignerServiceHandler(NewServer(NewLocalSigner(key), DefaultAllowedPrograms))
+	srv := httptest.NewServer(middleware.AdminKeyMiddleware("token").Wrap(handler))
+	defer srv.Close()
+	require.Equal(t, "/dankfolio.v1.SignerService/", path)
+
+	_, err := NewRemoteSigner(ctx, srv.Client(), srv.URL, "wrong")
+	require.Error(t, err, "sidecar rejects bad tokens")
+
+	remote, err := NewRemoteSigner(ctx, srv.Client(), srv.URL, "token")
+	require.NoError(t, err)
+	assert.Equal(t, key.PublicKey(), remote.PublicKey())
+
+	tx := newTransferTx(t, key.PublicKey(), solanago.SystemProgramID)
+	require.NoError(t, SignTransaction(ctx, remote, tx))
+	require.NoError(t, tx.VerifySignatures())
+
+	// Programs outside the allow list are refused
+	tx = newTransferTx(t, key.PublicKey(), solanago.TokenProgramID)
+	assert.Error(t, SignTransaction(ctx, remote, tx))
+}
+
+func TestSignTransaction_RequiresSignerSlot(t *testing.T) {
+	local := NewLocalSigner(solanago.NewWallet().PrivateKey)
+	tx := newTransferTx(t, solanago.NewWallet().PublicKey(), solanago.SystemProgramID)
+
+	assert.ErrorContains(t, SignTransaction(context.Background(), local, tx), "not a required signer")
+}
@@ -13,6 +13,7 @@ import (
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/propagation"
 	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/exemplar"
 	"go.opentelemetry.io/otel/sdk/resource"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
@@ -119,6 +120,9 @@ func newMeterProvider(ctx context.Context, res *resource.Resource, endpoint stri
 			sdkmetric.WithTimeout(10*time.Second),
 		)),
 		sdkmetric.WithResource(res),
+		// Histogram buckets keep the trace of a measurement made in a sampled
+		// span, so a slow upstream call on a dashboard links to its trace
+		sdkmetric.WithExemplarFilter(exemplar.TraceBasedFilter),
 	)
 
 	return mp, nil
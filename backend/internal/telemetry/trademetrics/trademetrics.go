@@ -2,6 +2,7 @@ package trademetrics
 
 import (
 	"context"
+	"time"
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
@@ -11,8 +12,33 @@ import (
 type TradeMetrics struct {
 	tradesTotal       metric.Int64Counter
 	platformFeesTotal metric.Float64Counter
+	mevChecksTotal    metric.Int64Counter
+	mevLossUSDTotal   metric.Float64Counter
+	funnelTotal       metric.Int64Counter
+	funnelLatency     metric.Float64Histogram
 }
 
+// FunnelStage is a step a swap goes through, in order, from quote to confirmation
+type FunnelStage string
+
+const (
+	FunnelStageQuote   FunnelStage = "quote"   // Quoted
+	FunnelStagePrepare FunnelStage = "prepare" // Unsigned transaction built
+	FunnelStageSign    FunnelStage = "sign"    // Signed by the user and sent back
+	FunnelStageSubmit  FunnelStage = "submit"  // Accepted by an RPC node
+	FunnelStageConfirm FunnelStage = "confirm" // Reached the service commitment
+)
+
+// FunnelLabels identify the kind of swap a funnel stage is recorded for
+type FunnelLabels struct {
+	Coin  string // Mint of the coin bought or sold
+	Route string // DEXes of the quoted route, e.g. "Raydium>Orca"; empty before it is known
+}
+
+// funnelLatencyBuckets span a quote taking tens of milliseconds to a user
+// taking minutes to sign
+var funnelLatencyBuckets = []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 20, 30, 45, 60, 120, 300}
+
 // New creates a new TradeMetrics instance
 func New(meter metric.Meter) (*TradeMetrics, error) {
 	tradesTotal, err := meter.Int64Counter(
@@ -33,9 +59,50 @@ func New(meter metric.Meter) (*TradeMetrics, error) {
 		return nil, err
 	}
 
+	mevChecksTotal, err := meter.Int64Counter(
+		"dankfolio.mev_checks_total",
+		metric.WithDescription("Total number of confirmed swaps checked for sandwiches, by whether one was found"),
+		metric.WithUnit("{trade}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	mevLossUSDTotal, err := meter.Float64Counter(
+		"dankfolio.mev_estimated_loss_usd_total",
+		metric.WithDescription("Estimated USD lost by users to sandwiches, which private bundle submission would have avoided"),
+		metric.WithUnit("USD"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	funnelTotal, err := meter.Int64Counter(
+		"dankfolio.trade_funnel_total",
+		metric.WithDescription("Swaps reaching or dropping out at each funnel stage, by reason for drop-offs"),
+		metric.WithUnit("{trade}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	funnelLatency, err := meter.Float64Histogram(
+		"dankfolio.trade_funnel_stage_latency_seconds",
+		metric.WithDescription("Time from a swap's previous funnel stage to this one, or to quote and prepare it for the first two"),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(funnelLatencyBuckets...),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	return &TradeMetrics{
 		tradesTotal:       tradesTotal,
 		platformFeesTotal: platformFeesTotal,
+		mevChecksTotal:    mevChecksTotal,
+		mevLossUSDTotal:   mevLossUSDTotal,
+		funnelTotal:       funnelTotal,
+		funnelLatency:     funnelLatency,
 	}, nil
 }
 
@@ -51,3 +118,45 @@ func (tm *TradeMetrics) RecordPlatformFee(ctx context.Context, amount float64, t
 	)
 	tm.platformFeesTotal.Add(ctx, amount, attrs)
 }
+
+// RecordMEVCheck counts a swap checked for a sandwich and adds the estimated
+// loss of a sandwiched one
+func (tm *TradeMetrics) RecordMEVCheck(ctx context.Context, sandwiched bool, lossUSD float64) {
+	tm.mevChecksTotal.Add(ctx, 1, metric.WithAttributes(attribute.Bool("sandwiched", sandwiched)))
+	if sandwiched && lossUSD > 0 {
+		tm.mevLossUSDTotal.Add(ctx, lossUSD)
+	}
+}
+
+// RecordFunnelStage counts a swap reaching stage, latency after its previous
+// one. A zero latency, for a swap whose previous stage wasn't timed, is not
+// recorded.
+func (tm *TradeMetrics) RecordFunnelStage(ctx context.Context, stage FunnelStage, labels FunnelLabels, latency time.Duration) {
+	attrs := funnelAttributes(stage, labels)
+	tm.funnelTotal.Add(ctx, 1, metric.WithAttributes(append(attrs, attribute.String("outcome", "reached"))...))
+	if latency > 0 {
+		tm.funnelLatency.Record(ctx, latency.Seconds(), metric.WithAttributes(attrs...))
+	}
+}
+
+// RecordFunnelDropOff counts a swap that failed to reach stage, e.g. a submit
+// rejected with reason "slippage_exceeded"
+func (tm *TradeMetrics) RecordFunnelDropOff(ctx context.Context, stage FunnelStage, labels FunnelLabels, reason string) {
+	attrs := append(funnelAttributes(stage, labels),
+		attribute.String("outcome", "dropped"),
+		attribute.String("reason", reason),
+	)
+	tm.funnelTotal.Add(ctx, 1, metric.WithAttributes(attrs...))
+}
+
+func funnelAttributes(stage FunnelStage, labels FunnelLabels) []attribute.KeyValue {
+	route := labels.Route
+	if route == "" {
+		route = "unknown"
+	}
+	return []attribute.KeyValue{
+		attribute.String("stage", string(stage)),
+		attribute.String("coin", labels.Coin),
+		attribute.String("route", route),
+	}
+}
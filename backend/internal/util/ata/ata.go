@@ -0,0 +1,239 @@
+// Package ata derives, checks and creates associated token accounts (ATAs).
+// Existence checks are batched and creation uses the idempotent instruction,
+// so ensuring an account that already exists, or that another transaction
+// creates first, is never an error.
+package ata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	solanago "github.com/gagliardetto/solana-go"
+	associatedtokenaccount "github.com/gagliardetto/solana-go/programs/associated-token-account"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	bmodel "github.com/nicolas-martin/dankfolio/backend/internal/model/blockchain"
+	"github.com/nicolas-martin/dankfolio/backend/internal/signer"
+)
+
+const (
+	// MaxAccountsPerCheck is the most accounts one getMultipleAccounts call accepts
+	MaxAccountsPerCheck = 100
+	// MaxCreatesPerTransaction keeps a batch of create instructions well under
+	// the 1232 byte transaction size limit
+	MaxCreatesPerTransaction = 8
+
+	// createIdempotent is the ATA program instruction that succeeds when the
+	// account already exists
+	createIdempotent byte = 1
+)
+
+// Account is the associated token account of Owner for Mint
+type Account struct {
+	Owner   solanago.PublicKey
+	Mint    solanago.PublicKey
+	Address solanago.PublicKey
+}
+
+// Derive returns the associated token account of owner for mint. Native SOL
+// has no token accounts, so it is derived for wSOL instead.
+func Derive(owner, mint solanago.PublicKey) (Account, error) {
+	if mint == solanago.SystemProgramID {
+		mint = solanago.MustPublicKeyFromBase58(model.SolMint)
+	}
+	address, _, err := solanago.FindAssociatedTokenAddress(owner, mint)
+	if err != nil {
+		return Account{}, fmt.Errorf("failed to derive ATA for owner %s and mint %s: %w", owner, mint, err)
+	}
+	return Account{Owner: owner, Mint: mint, Address: address}, nil
+}
+
+// CreateInstruction returns an idempotent instruction creating account, paid by payer
+func CreateInstruction(payer solanago.PublicKey, account Account) solanago.Instruction {
+	create := associatedtokenaccount.NewCreateInstruction(payer, account.Owner, account.Mint).Build()
+	return solanago.NewInstruction(associatedtokenaccount.ProgramID, create.Accounts(), []byte{createIdempotent})
+}
+
+// MultipleAccountsGetter is implemented by chain clients that can read many
+// accounts in one RPC call. Results are in request order, nil where the
+// account does not exist.
+type MultipleAccountsGetter interface {
+	GetMultipleAccounts(ctx context.Context, addresses []bmodel.Address, commitment string) ([]*bmodel.AccountInfo, error)
+}
+
+// Manager checks and creates associated token accounts
+type Manager struct {
+	chainClient clients.GenericClientAPI
+	commitment  string
+}
+
+// NewManager creates a Manager. Existence checks use GetMultipleAccounts when
+// chainClient implements MultipleAccountsGetter and one GetAccountInfo per
+// account otherwise.
+func NewManager(chainClient clients.GenericClientAPI) *Manager {
+	return &Manager{chainClient: chainClient, commitment: bmodel.DefaultCommitment}
+}
+
+// SetCommitment sets the commitment existence checks and preflight use
+func (m *Manager) SetCommitment(commitment string) {
+	m.commitment = commitment
+}
+
+// Exists reports whether the account at address has been created
+func (m *Manager) Exists(ctx context.Context, address solanago.PublicKey) (bool, error) {
+	exists, err := m.existing(ctx, []solanago.PublicKey{address})
+	if err != nil {
+		return false, err
+	}
+	return exists[0], nil
+}
+
+// Missing returns the accounts that have not been created, in order
+func (m *Manager) Missing(ctx context.Context, accounts []Account) ([]Account, error) {
+	addresses := make([]solanago.PublicKey, len(accounts))
+	for i, account := range accounts {
+		addresses[i] = account.Address
+	}
+	exists, err := m.existing(ctx, addresses)
+	if err != nil {
+		return nil, err
+	}
+
+	var missing []Account
+	for i, account := range accounts {
+		if !exists[i] {
+			missing = append(missing, account)
+		}
+	}
+	return missing, nil
+}
+
+// CreateInstructions returns the instructions creating the accounts that are
+// missing, paid by payer, for bundling into a caller's transaction
+func (m *Manager) CreateInstructions(ctx context.Context, payer solanago.PublicKey, accounts []Account) ([]solanago.Instruction, error) {
+	missing, err := m.Missing(ctx, accounts)
+	if err != nil {
+		return nil, err
+	}
+	instructions := make([]solanago.Instruction, len(missing))
+	for i, account := range missing {
+		instructions[i] = CreateInstruction(payer, account)
+	}
+	return instructions, nil
+}
+
+// Ensure creates the accounts that are missing, several per transaction, paid
+// and signed by payer. It returns the accounts it submitted for creation; the
+// transactions are sent but not awaited.
+func (m *Manager) Ensure(ctx context.Context, payer signer.Signer, accounts []Account) ([]Account, error) {
+	if payer == nil {
+		return nil, fmt.Errorf("a payer is required to create ATAs")
+	}
+	missing, err := m.Missing(ctx, accounts)
+	if err != nil {
+		return nil, err
+	}
+
+	var created []Account
+	for start := 0; start < len(missing); start += MaxCreatesPerTransaction {
+		batch := missing[start:min(start+MaxCreatesPerTransaction, len(missing))]
+		signature, err := m.sendCreates(ctx, payer, batch)
+		if err != nil {
+			return created, err
+		}
+		for _, account := range batch {
+			slog.InfoContext(ctx, "ATA creation transaction sent",
+				"signature", signature,
+				"ata", account.Address.String(),
+				"owner", account.Owner.String(),
+				"mint", account.Mint.String())
+		}
+		created = append(created, batch...)
+	}
+	return created, nil
+}
+
+func (m *Manager) sendCreates(ctx context.Context, payer signer.Signer, accounts []Account) (bmodel.Signature, error) {
+	instructions := make([]solanago.Instruction, len(accounts))
+	for i, account := range accounts {
+		instructions[i] = CreateInstruction(payer.PublicKey(), account)
+	}
+
+	recentBlockhash, err := m.chainClient.GetLatestBlockhash(ctx, m.commitment)
+	if err != nil {
+		return "", fmt.Errorf("failed to get blockhash: %w", err)
+	}
+	blockhash, err := solanago.HashFromBase58(string(recentBlockhash))
+	if err != nil {
+		return "", fmt.Errorf("failed to parse blockhash: %w", err)
+	}
+
+	tx, err := solanago.NewTransaction(instructions, blockhash, solanago.TransactionPayer(payer.PublicKey()))
+	if err != nil {
+		return "", fmt.Errorf("failed to create transaction: %w", err)
+	}
+	// The key itself may live outside this process
+	if err := signer.SignTransaction(ctx, payer, tx); err != nil {
+		return "", fmt.Errorf("failed to sign transaction: %w", err)
+	}
+	txBytes, err := tx.MarshalBinary()
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal transaction: %w", err)
+	}
+
+	signature, err := m.chainClient.SendRawTransaction(ctx, txBytes, bmodel.TransactionOptions{
+		SkipPreflight:       false,
+		PreflightCommitment: m.commitment,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to send transaction: %w", err)
+	}
+	return signature, nil
+}
+
+// existing reports, for each address, whether an initialized account is there
+func (m *Manager) existing(ctx context.Context, addresses []solanago.PublicKey) ([]bool, error) {
+	exists := make([]bool, len(addresses))
+	getter, batched := m.chainClient.(MultipleAccountsGetter)
+	if !batched {
+		for i, address := range addresses {
+			info, err := m.chainClient.GetAccountInfo(ctx, bmodel.Address(address.String()), m.commitment)
+			if errors.Is(err, clients.ErrAccountNotFound) {
+				continue
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to check ATA %s: %w", address, err)
+			}
+			exists[i] = initialized(info)
+		}
+		return exists, nil
+	}
+
+	for start := 0; start < len(addresses); start += MaxAccountsPerCheck {
+		chunk := addresses[start:min(start+MaxAccountsPerCheck, len(addresses))]
+		request := make([]bmodel.Address, len(chunk))
+		for i, address := range chunk {
+			request[i] = bmodel.Address(address.String())
+		}
+		infos, err := getter.GetMultipleAccounts(ctx, request, m.commitment)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check %d ATAs: %w", len(chunk), err)
+		}
+		if len(infos) != len(chunk) {
+			return nil, fmt.Errorf("getMultipleAccounts returned %d accounts for %d addresses", len(infos), len(chunk))
+		}
+		for i, info := range infos {
+			exists[start+i] = initialized(info)
+		}
+	}
+	return exists, nil
+}
+
+// initialized reports whether info is a created account; an address that only
+// holds lamports is still owned by the system program
+func initialized(info *bmodel.AccountInfo) bool {
+	return info != nil && info.Owner != bmodel.Address(solanago.SystemProgramID.String())
+}
@@ -0,0 +1,163 @@
+package ata
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	solanago "github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/clients"
+	clientsmocks "github.com/nicolas-martin/dankfolio/backend/internal/clients/mocks"
+	"github.com/nicolas-martin/dankfolio/backend/internal/model"
+	bmodel "github.com/nicolas-martin/dankfolio/backend/internal/model/blockchain"
+	"github.com/nicolas-martin/dankfolio/backend/internal/signer"
+)
+
+var tokenProgram = bmodel.Address(solanago.TokenProgramID.String())
+
+// batchedClient answers GetMultipleAccounts from created and records each call
+type batchedClient struct {
+	*clientsmocks.MockGenericClientAPI
+	created map[bmodel.Address]bool
+	calls   [][]bmodel.Address
+}
+
+func (c *batchedClient) GetMultipleAccounts(_ context.Context, addresses []bmodel.Address, _ string) ([]*bmodel.AccountInfo, error) {
+	c.calls = append(c.calls, addresses)
+	infos := make([]*bmodel.AccountInfo, len(addresses))
+	for i, address := range addresses {
+		if c.created[address] {
+			infos[i] = &bmodel.AccountInfo{Address: address, Owner: tokenProgram}
+		}
+	}
+	return infos, nil
+}
+
+func accounts(t *testing.T, owner solanago.PublicKey, n int) []Account {
+	t.Helper()
+	result := make([]Account, n)
+	for i := range result {
+		account, err := Derive(owner, solanago.NewWallet().PublicKey())
+		require.NoError(t, err)
+		result[i] = account
+	}
+	return result
+}
+
+func TestDeriveNativeSOL(t *testing.T) {
+	owner := solanago.NewWallet().PublicKey()
+	account, err := Derive(owner, solanago.SystemProgramID)
+	require.NoError(t, err)
+
+	wsol := solanago.MustPublicKeyFromBase58(model.SolMint)
+	expected, _, err := solanago.FindAssociatedTokenAddress(owner, wsol)
+	require.NoError(t, err)
+	assert.Equal(t, wsol, account.Mint)
+	assert.Equal(t, expected, account.Address)
+}
+
+func TestCreateInstructionIsIdempotent(t *testing.T) {
+	owner := solanago.NewWallet().PublicKey()
+	account, err := Derive(owner, solanago.NewWallet().PublicKey())
+	require.NoError(t, err)
+
+	instruction := CreateInstruction(owner, account)
+	data, err := instruction.Data()
+	require.NoError(t, err)
+	assert.Equal(t, []byte{createIdempotent}, data)
+	assert.Equal(t, solanago.SPLAssociatedTokenAccountProgramID, instruction.ProgramID())
+	assert.Equal(t, account.Address, instruction.Accounts()[1].PublicKey)
+}
+
+func TestMissingBatchesChecks(t *testing.T) {
+	owner := solanago.NewWallet().PublicKey()
+	all := accounts(t, owner, MaxAccountsPerCheck+5)
+	client := &batchedClient{MockGenericClientAPI: clientsmocks.NewMockGenericClientAPI(t), created: map[bmodel.Address]bool{}}
+	for _, account := range all[1:] {
+		client.created[bmodel.Address(account.Address.String())] = true
+	}
+
+	missing, err := NewManager(client).Missing(context.Background(), all)
+	require.NoError(t, err)
+	assert.Equal(t, all[:1], missing)
+	require.Len(t, client.calls, 2)
+	assert.Len(t, client.calls[0], MaxAccountsPerCheck)
+	assert.Len(t, client.calls[1], 5)
+}
+
+func TestExistsFallsBackToGetAccountInfo(t *testing.T) {
+	client := clientsmocks.NewMockGenericClientAPI(t)
+	m := NewManager(client)
+	owner := solanago.NewWallet().PublicKey()
+	all := accounts(t, owner, 3)
+
+	client.EXPECT().GetAccountInfo(mock.Anything, bmodel.Address(all[0].Address.String()), bmodel.CommitmentConfirmed).
+		Return(&bmodel.AccountInfo{Owner: tokenProgram}, nil)
+	client.EXPECT().GetAccountInfo(mock.Anything, bmodel.Address(all[1].Address.String()), bmodel.CommitmentConfirmed).
+		Return(nil, clients.ErrAccountNotFound)
+	// Lamports sent to the address do not create the token account
+	client.EXPECT().GetAccountInfo(mock.Anything, bmodel.Address(all[2].Address.String()), bmodel.CommitmentConfirmed).
+		Return(&bmodel.AccountInfo{Owner: bmodel.Address(solanago.SystemProgramID.String())}, nil)
+
+	exists, err := m.Exists(context.Background(), all[0].Address)
+	require.NoError(t, err)
+	assert.True(t, exists)
+	exists, err = m.Exists(context.Background(), all[1].Address)
+	require.NoError(t, err)
+	assert.False(t, exists)
+	exists, err = m.Exists(context.Background(), all[2].Address)
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestExistsError(t *testing.T) {
+	client := clientsmocks.NewMockGenericClientAPI(t)
+	client.EXPECT().GetAccountInfo(mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("rpc down"))
+
+	_, err := NewManager(client).Exists(context.Background(), solanago.NewWallet().PublicKey())
+	assert.ErrorContains(t, err, "rpc down")
+}
+
+func TestEnsureBatchesCreates(t *testing.T) {
+	payer := signer.NewLocalSigner(solanago.NewWallet().PrivateKey)
+	owner := solanago.NewWallet().PublicKey()
+	all := accounts(t, owner, MaxCreatesPerTransaction+2)
+	client := &batchedClient{MockGenericClientAPI: clientsmocks.NewMockGenericClientAPI(t), created: map[bmodel.Address]bool{
+		bmodel.Address(all[0].Address.String()): true,
+	}}
+
+	var sent []*solanago.Transaction
+	client.EXPECT().GetLatestBlockhash(mock.Anything, bmodel.CommitmentConfirmed).Return(bmodel.Blockhash(solanago.Hash{}.String()), nil)
+	client.EXPECT().SendRawTransaction(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, raw []byte, _ bmodel.TransactionOptions) (bmodel.Signature, error) {
+			tx, err := solanago.TransactionFromBytes(raw)
+			require.NoError(t, err)
+			sent = append(sent, tx)
+			return bmodel.Signature(tx.Signatures[0].String()), nil
+		})
+
+	created, err := NewManager(client).Ensure(context.Background(), payer, all)
+	require.NoError(t, err)
+	assert.Equal(t, all[1:], created)
+	require.Len(t, sent, 2)
+	assert.Len(t, sent[0].Message.Instructions, MaxCreatesPerTransaction)
+	assert.Len(t, sent[1].Message.Instructions, 1)
+	assert.Equal(t, payer.PublicKey(), sent[0].Message.AccountKeys[0])
+}
+
+func TestEnsureNothingMissing(t *testing.T) {
+	payer := signer.NewLocalSigner(solanago.NewWallet().PrivateKey)
+	all := accounts(t, solanago.NewWallet().PublicKey(), 2)
+	client := &batchedClient{MockGenericClientAPI: clientsmocks.NewMockGenericClientAPI(t), created: map[bmodel.Address]bool{}}
+	for _, account := range all {
+		client.created[bmodel.Address(account.Address.String())] = true
+	}
+
+	created, err := NewManager(client).Ensure(context.Background(), payer, all)
+	require.NoError(t, err)
+	assert.Empty(t, created)
+}
@@ -0,0 +1,128 @@
+// Package money does exact decimal arithmetic for token amounts, fees, PnL and
+// slippage. Amounts should stay in Decimal from parsing until they are handed to
+// a client or a float-only API; Float is the single lossy conversion.
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/shopspring/decimal"
+)
+
+// Decimal is an arbitrary-precision decimal number. It is an alias so callers
+// depend on this package rather than the decimal library behind it.
+type Decimal = decimal.Decimal
+
+const (
+	// SOLDecimals is the number of decimals in a SOL amount; 1 SOL is 1e9 lamports
+	SOLDecimals = 9
+
+	// bpsPerUnit is the number of basis points in 100%
+	bpsPerUnit = 10000
+)
+
+// Zero is the zero amount
+var Zero = decimal.Zero
+
+// ErrOutOfRange is returned when an amount can't be represented in base units.
+var ErrOutOfRange = errors.New("amount out of range for base units")
+
+// Parse parses a decimal string such as "0.000123" or "42".
+func Parse(s string) (Decimal, error) {
+	d, err := decimal.NewFromString(s)
+	if err != nil {
+		return Zero, fmt.Errorf("invalid decimal %q: %w", s, err)
+	}
+	return d, nil
+}
+
+// FromFloat converts a float using its shortest representation, so 0.3 becomes
+// exactly 0.3 rather than 0.299999999999999988897769753748.
+func FromFloat(f float64) Decimal {
+	return decimal.NewFromFloat(f)
+}
+
+// FromInt converts an integer.
+func FromInt(i int64) Decimal {
+	return decimal.NewFromInt(i)
+}
+
+// FromBaseUnits scales an integer amount of base units (lamports for SOL) to
+// whole tokens.
+func FromBaseUnits(raw uint64, decimals uint8) Decimal {
+	return decimal.NewFromUint64(raw).Shift(-int32(decimals))
+}
+
+// ParseBaseUnits is FromBaseUnits for a raw amount given as a decimal string,
+// as token programs and Jupiter report them.
+func ParseBaseUnits(raw string, decimals uint8) (Decimal, error) {
+	d, err := decimal.NewFromString(raw)
+	if err != nil || !d.IsInteger() || d.IsNegative() {
+		return Zero, fmt.Errorf("invalid base unit amount %q", raw)
+	}
+	return d.Shift(-int32(decimals)), nil
+}
+
+// ToBaseUnits converts whole tokens to base units, truncating any precision
+// beyond the token's decimals so a conversion never rounds up past a balance.
+func ToBaseUnits(amount Decimal, decimals uint8) (uint64, error) {
+	raw := amount.Shift(int32(decimals)).Truncate(0)
+	if raw.IsNegative() || raw.GreaterThan(decimal.NewFromUint64(math.MaxUint64)) {
+		return 0, fmt.Errorf("%w: %s with %d decimals", ErrOutOfRange, amount, decimals)
+	}
+	return raw.BigInt().Uint64(), nil
+}
+
+// FormatLamports formats lamports as a SOL amount with all nine decimals.
+func FormatLamports(lamports uint64) string {
+	return FormatTruncated(FromBaseUnits(lamports, SOLDecimals), SOLDecimals)
+}
+
+// Bps returns bps basis points of amount, e.g. Bps(x, 50) is 0.5% of x.
+func Bps(amount Decimal, bps int) Decimal {
+	return amount.Mul(decimal.NewFromInt(int64(bps))).Div(decimal.NewFromInt(bpsPerUnit))
+}
+
+// MinAmountOut returns the least a swap quoted at out base units may return
+// with slippageBps of tolerance, rounded down.
+func MinAmountOut(out uint64, slippageBps int) uint64 {
+	if slippageBps <= 0 {
+		return out
+	}
+	if slippageBps >= bpsPerUnit {
+		return 0
+	}
+	minOut := decimal.NewFromUint64(out).Sub(Bps(decimal.NewFromUint64(out), slippageBps)).Floor()
+	return minOut.BigInt().Uint64()
+}
+
+// Ratio returns numerator / denominator, or zero when the denominator is zero.
+func Ratio(numerator, denominator Decimal) Decimal {
+	if denominator.IsZero() {
+		return Zero
+	}
+	return numerator.Div(denominator)
+}
+
+// Sum adds amounts.
+func Sum(amounts ...Decimal) Decimal {
+	total := Zero
+	for _, a := range amounts {
+		total = total.Add(a)
+	}
+	return total
+}
+
+// Float converts to float64 for display and float-only APIs. This is the only
+// place precision is given up.
+func Float(d Decimal) float64 {
+	return d.InexactFloat64()
+}
+
+// FormatTruncated formats with exactly places decimals, truncating rather than
+// rounding so a displayed amount never exceeds the real one.
+func FormatTruncated(d Decimal, places int32) string {
+	return d.Truncate(places).StringFixed(places)
+}
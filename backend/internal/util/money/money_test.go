@@ -0,0 +1,47 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBaseUnitsRoundTrip(t *testing.T) {
+	// 0.3 * 1e9 is 299999999.99999994 as a float and truncates to one lamport short
+	raw, err := ToBaseUnits(FromFloat(0.3), SOLDecimals)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(300_000_000), raw)
+
+	assert.Equal(t, "0.300000000", FormatLamports(raw))
+
+	amount, err := ParseBaseUnits("123456789", 6)
+	require.NoError(t, err)
+	assert.Equal(t, "123.456789", amount.String())
+
+	_, err = ParseBaseUnits("1.5", 6)
+	assert.Error(t, err)
+
+	_, err = ToBaseUnits(FromInt(-1), SOLDecimals)
+	assert.ErrorIs(t, err, ErrOutOfRange)
+}
+
+func TestFeeSumsStayExact(t *testing.T) {
+	total := Zero
+	for range 10 {
+		total = total.Add(FromFloat(0.1))
+	}
+	assert.True(t, total.Equal(FromInt(1)))
+	assert.True(t, Sum(FromFloat(0.1), FromFloat(0.2)).Equal(FromFloat(0.3)))
+}
+
+func TestSlippage(t *testing.T) {
+	assert.Equal(t, "0.5", Bps(FromInt(100), 50).String())
+
+	assert.Equal(t, uint64(995), MinAmountOut(1000, 50))
+	assert.Equal(t, uint64(994), MinAmountOut(999, 50)) // 994.005 rounds down
+	assert.Equal(t, uint64(1000), MinAmountOut(1000, 0))
+	assert.Equal(t, uint64(0), MinAmountOut(1000, 10000))
+
+	assert.True(t, Ratio(FromInt(1), Zero).IsZero())
+}
@@ -0,0 +1,64 @@
+package util
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// CompareVersions compares two dotted app versions such as "1.4.2" and
+// returns -1, 0 or 1. Missing components count as zero, so "1.4" equals
+// "1.4.0". A leading "v" and any pre-release or build suffix ("-beta.1",
+// "+42") are ignored.
+func CompareVersions(a, b string) (int, error) {
+	partsA, err := parseVersion(a)
+	if err != nil {
+		return 0, err
+	}
+	partsB, err := parseVersion(b)
+	if err != nil {
+		return 0, err
+	}
+	for i := range max(len(partsA), len(partsB)) {
+		var x, y int
+		if i < len(partsA) {
+			x = partsA[i]
+		}
+		if i < len(partsB) {
+			y = partsB[i]
+		}
+		if x != y {
+			if x < y {
+				return -1, nil
+			}
+			return 1, nil
+		}
+	}
+	return 0, nil
+}
+
+// IsValidVersion reports whether CompareVersions accepts the version.
+func IsValidVersion(version string) bool {
+	_, err := parseVersion(version)
+	return err == nil
+}
+
+func parseVersion(version string) ([]int, error) {
+	trimmed := strings.TrimPrefix(strings.TrimSpace(version), "v")
+	if i := strings.IndexAny(trimmed, "-+"); i >= 0 {
+		trimmed = trimmed[:i]
+	}
+	if trimmed == "" {
+		return nil, fmt.Errorf("invalid version %q", version)
+	}
+	fields := strings.Split(trimmed, ".")
+	parts := make([]int, len(fields))
+	for i, field := range fields {
+		n, err := strconv.Atoi(field)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("invalid version %q", version)
+		}
+		parts[i] = n
+	}
+	return parts, nil
+}
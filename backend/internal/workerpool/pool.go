@@ -0,0 +1,300 @@
+// Package workerpool runs tasks with bounded concurrency. Submitters wait
+// while the queue is full, and a pool backs off when its upstream rate limits
+// it, growing back one worker at a time once calls succeed again.
+package workerpool
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/apperrors"
+)
+
+const (
+	DefaultMaxWorkers = 5
+	DefaultGrowAfter  = 10
+)
+
+// Config sizes a pool. Zero values use the defaults.
+type Config struct {
+	Name       string // Labels the pool's metrics and logs, e.g. "coin_fetch"
+	MaxWorkers int    // Tasks run at once while not rate limited
+	MinWorkers int    // Floor when backing off; 1 by default
+	QueueSize  int    // Tasks waiting for a worker before Submit blocks; twice MaxWorkers by default
+	GrowAfter  int    // Successful tasks before a backed off pool adds a worker
+}
+
+func (c Config) withDefaults() Config {
+	if c.MaxWorkers <= 0 {
+		c.MaxWorkers = DefaultMaxWorkers
+	}
+	if c.MinWorkers <= 0 {
+		c.MinWorkers = 1
+	}
+	c.MinWorkers = min(c.MinWorkers, c.MaxWorkers)
+	if c.QueueSize <= 0 {
+		c.QueueSize = 2 * c.MaxWorkers
+	}
+	if c.GrowAfter <= 0 {
+		c.GrowAfter = DefaultGrowAfter
+	}
+	return c
+}
+
+// Task is work run on a pool. Returning an error wrapping
+// apperrors.ErrUpstreamRateLimited makes the pool back off.
+type Task func(ctx context.Context) error
+
+// ErrQueueFull is returned by TrySubmit when no worker or queue slot is free
+var ErrQueueFull = errors.New("worker pool queue is full")
+
+type queuedTask struct {
+	ctx    context.Context
+	task   Task
+	finish func() // Called once the task ran or was skipped
+}
+
+// Pool runs each task on its own goroutine, at most Workers at a time. It holds
+// no goroutines while idle, so it needs no shutdown.
+type Pool struct {
+	config Config
+	attrs  attribute.Set
+
+	mu        sync.Mutex
+	queue     []queuedTask
+	waiting   int           // Submitters blocked on a full queue
+	room      chan struct{} // Closed and replaced when a queued task starts
+	running   int
+	workers   int // Current limit, between MinWorkers and MaxWorkers
+	successes int // Since the last change of workers
+
+	backoffs metric.Int64Counter
+}
+
+// New creates a pool and registers its metrics with the global meter provider
+func New(config Config) *Pool {
+	config = config.withDefaults()
+	p := &Pool{
+		config:  config,
+		attrs:   attribute.NewSet(attribute.String("pool", config.Name)),
+		room:    make(chan struct{}),
+		workers: config.MaxWorkers,
+	}
+	p.instrument()
+	return p
+}
+
+// instrument registers the pool's gauges and backoff counter. Pools still
+// work, uninstrumented, if it fails.
+func (p *Pool) instrument() {
+	meter := otel.Meter("github.com/nicolas-martin/dankfolio/backend/internal/workerpool")
+	var err error
+	p.backoffs, err = meter.Int64Counter(
+		"dankfolio.worker_pool.backoffs_total",
+		metric.WithDescription("Times a worker pool halved its workers after its upstream rate limited it"),
+		metric.WithUnit("{backoff}"),
+	)
+	if err != nil {
+		slog.Warn("Failed to create worker pool backoff counter", slog.String("pool", p.config.Name), slog.Any("error", err))
+	}
+
+	queued, err := meter.Int64ObservableGauge(
+		"dankfolio.worker_pool.queue_depth",
+		metric.WithDescription("Tasks waiting for a worker, including submitters blocked on a full queue"),
+		metric.WithUnit("{task}"),
+	)
+	if err != nil {
+		slog.Warn("Failed to create worker pool queue gauge", slog.String("pool", p.config.Name), slog.Any("error", err))
+		return
+	}
+	running, err := meter.Int64ObservableGauge(
+		"dankfolio.worker_pool.running",
+		metric.WithDescription("Tasks running on a worker pool"),
+		metric.WithUnit("{task}"),
+	)
+	if err != nil {
+		slog.Warn("Failed to create worker pool running gauge", slog.String("pool", p.config.Name), slog.Any("error", err))
+		return
+	}
+	workers, err := meter.Int64ObservableGauge(
+		"dankfolio.worker_pool.workers",
+		metric.WithDescription("Tasks a worker pool currently runs at once, lowered while rate limited"),
+		metric.WithUnit("{worker}"),
+	)
+	if err != nil {
+		slog.Warn("Failed to create worker pool size gauge", slog.String("pool", p.config.Name), slog.Any("error", err))
+		return
+	}
+	_, err = meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		stats := p.Stats()
+		o.ObserveInt64(queued, int64(stats.Queued), metric.WithAttributeSet(p.attrs))
+		o.ObserveInt64(running, int64(stats.Running), metric.WithAttributeSet(p.attrs))
+		o.ObserveInt64(workers, int64(stats.Workers), metric.WithAttributeSet(p.attrs))
+		return nil
+	}, queued, running, workers)
+	if err != nil {
+		slog.Warn("Failed to register worker pool gauges", slog.String("pool", p.config.Name), slog.Any("error", err))
+	}
+}
+
+// Stats are a pool's current load
+type Stats struct {
+	Queued  int // Waiting for a worker, including submitters blocked on a full queue
+	Running int
+	Workers int // Tasks run at once
+}
+
+// Stats returns the pool's current load
+func (p *Pool) Stats() Stats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return Stats{Queued: len(p.queue) + p.waiting, Running: p.running, Workers: p.workers}
+}
+
+// SetMaxWorkers changes how many tasks run at once while not rate limited.
+// A backed off pool keeps its lower limit and grows back to the new one.
+func (p *Pool) SetMaxWorkers(n int) {
+	if n <= 0 {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.workers == p.config.MaxWorkers || p.workers > n {
+		p.workers = n
+	}
+	p.config.MaxWorkers = n
+	p.config.MinWorkers = min(p.config.MinWorkers, n)
+	p.dispatch()
+}
+
+// Submit runs task once a worker is free, waiting while the queue is full. It
+// returns ctx's error if ctx is done first; a queued task whose ctx is done by
+// the time a worker is free is skipped.
+func (p *Pool) Submit(ctx context.Context, task Task) error {
+	return p.submit(ctx, task, nil, true)
+}
+
+// TrySubmit runs task once a worker is free, or returns ErrQueueFull right
+// away if the queue is full
+func (p *Pool) TrySubmit(ctx context.Context, task Task) error {
+	return p.submit(ctx, task, nil, false)
+}
+
+func (p *Pool) submit(ctx context.Context, task Task, finish func(), wait bool) error {
+	p.mu.Lock()
+	for len(p.queue) >= p.config.QueueSize {
+		if !wait {
+			p.mu.Unlock()
+			return ErrQueueFull
+		}
+		room := p.room
+		p.waiting++
+		p.mu.Unlock()
+		select {
+		case <-room:
+		case <-ctx.Done():
+			p.mu.Lock()
+			p.waiting--
+			p.mu.Unlock()
+			return ctx.Err()
+		}
+		p.mu.Lock()
+		p.waiting--
+	}
+	defer p.mu.Unlock()
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	p.queue = append(p.queue, queuedTask{ctx: ctx, task: task, finish: finish})
+	p.dispatch()
+	return nil
+}
+
+// dispatch starts queued tasks while workers are free. Callers hold mu.
+func (p *Pool) dispatch() {
+	started := false
+	for p.running < p.workers && len(p.queue) > 0 {
+		next := p.queue[0]
+		p.queue[0] = queuedTask{}
+		p.queue = p.queue[1:]
+		p.running++
+		started = true
+		go p.run(next)
+	}
+	if started {
+		close(p.room)
+		p.room = make(chan struct{})
+	}
+}
+
+func (p *Pool) run(t queuedTask) {
+	var err error
+	if t.ctx.Err() == nil {
+		err = t.task(t.ctx)
+	}
+	if t.finish != nil {
+		t.finish()
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.running--
+	p.adjust(t.ctx, err)
+	p.dispatch()
+}
+
+// adjust halves the workers when a task was rate limited, and adds one back
+// after GrowAfter successes. Callers hold mu.
+func (p *Pool) adjust(ctx context.Context, err error) {
+	switch {
+	case errors.Is(err, apperrors.ErrUpstreamRateLimited):
+		p.successes = 0
+		if p.workers == p.config.MinWorkers {
+			return
+		}
+		p.workers = max(p.config.MinWorkers, p.workers/2)
+		if p.backoffs != nil {
+			p.backoffs.Add(context.Background(), 1, metric.WithAttributeSet(p.attrs))
+		}
+		slog.WarnContext(ctx, "Worker pool rate limited, backing off",
+			slog.String("pool", p.config.Name), slog.Int("workers", p.workers))
+	case err == nil && p.workers < p.config.MaxWorkers:
+		p.successes++
+		if p.successes >= p.config.GrowAfter {
+			p.successes = 0
+			p.workers++
+		}
+	}
+}
+
+// Group submits related tasks to a pool and waits for them
+type Group struct {
+	pool *Pool
+	wg   sync.WaitGroup
+}
+
+// Group creates a group of tasks run on p
+func (p *Pool) Group() *Group {
+	return &Group{pool: p}
+}
+
+// Go submits task like Pool.Submit, and Wait waits for it if it was queued
+func (g *Group) Go(ctx context.Context, task Task) error {
+	g.wg.Add(1)
+	if err := g.pool.submit(ctx, task, g.wg.Done, true); err != nil {
+		g.wg.Done()
+		return err
+	}
+	return nil
+}
+
+// Wait waits for every task queued by Go to run or be skipped
+func (g *Group) Wait() {
+	g.wg.Wait()
+}
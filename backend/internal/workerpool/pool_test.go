@@ -0,0 +1,97 @@
+package workerpool
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicolas-martin/dankfolio/backend/internal/apperrors"
+)
+
+func TestPool_BoundsConcurrency(t *testing.T) {
+	pool := New(Config{Name: "test", MaxWorkers: 2, QueueSize: 1})
+	var running, peak atomic.Int64
+
+	group := pool.Group()
+	for range 10 {
+		require.NoError(t, group.Go(context.Background(), func(context.Context) error {
+			n := running.Add(1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			running.Add(-1)
+			return nil
+		}))
+	}
+	group.Wait()
+
+	assert.Equal(t, int64(2), peak.Load())
+	assert.Equal(t, Stats{Workers: 2}, pool.Stats())
+}
+
+func TestPool_Backpressure(t *testing.T) {
+	pool := New(Config{Name: "test", MaxWorkers: 1, QueueSize: 1})
+	release := make(chan struct{})
+	block := func(context.Context) error { <-release; return nil }
+
+	require.NoError(t, pool.Submit(context.Background(), block)) // Running
+	require.NoError(t, pool.Submit(context.Background(), block)) // Queued
+	assert.ErrorIs(t, pool.TrySubmit(context.Background(), block), ErrQueueFull)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, pool.Submit(ctx, block), context.DeadlineExceeded)
+	assert.Equal(t, 1, pool.Stats().Queued)
+
+	close(release)
+	require.Eventually(t, func() bool { return pool.Stats() == Stats{Workers: 1} }, time.Second, time.Millisecond)
+}
+
+func TestPool_BacksOffWhenRateLimited(t *testing.T) {
+	pool := New(Config{Name: "test", MaxWorkers: 8, GrowAfter: 2})
+	run := func(err error) {
+		group := pool.Group()
+		require.NoError(t, group.Go(context.Background(), func(context.Context) error { return err }))
+		group.Wait()
+	}
+
+	run(apperrors.ErrUpstreamRateLimited.Wrap(assert.AnError))
+	assert.Equal(t, 4, pool.Stats().Workers)
+	run(apperrors.ErrUpstreamRateLimited)
+	assert.Equal(t, 2, pool.Stats().Workers)
+
+	// Other errors neither shrink nor grow it
+	run(assert.AnError)
+	assert.Equal(t, 2, pool.Stats().Workers)
+
+	run(nil)
+	run(nil)
+	assert.Equal(t, 3, pool.Stats().Workers)
+
+	pool.SetMaxWorkers(2)
+	assert.Equal(t, 2, pool.Stats().Workers)
+}
+
+func TestGroup_SkipsCancelledTasks(t *testing.T) {
+	pool := New(Config{Name: "test", MaxWorkers: 1})
+	release := make(chan struct{})
+	require.NoError(t, pool.Submit(context.Background(), func(context.Context) error { <-release; return nil }))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var ran atomic.Bool
+	group := pool.Group()
+	require.NoError(t, group.Go(ctx, func(context.Context) error { ran.Store(true); return nil }))
+	cancel()
+	close(release)
+	group.Wait()
+
+	assert.False(t, ran.Load())
+}